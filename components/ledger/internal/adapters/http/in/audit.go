@@ -34,7 +34,7 @@ type AuditHandler struct {
 //
 // It deliberately excludes internal-only fields (EventType, TenantID,
 // ActorType, and the AffectedKeyIDs/ProviderReference/ErrorCode details),
-// lifting only the previous/new status out of Details.
+// lifting only the previous/new status and the subject out of Details.
 type auditEventResponse struct {
 	ID         string `json:"id"`
 	Action     string `json:"action"`
@@ -43,6 +43,7 @@ type auditEventResponse struct {
 	Reason     string `json:"reason"`
 	FromStatus string `json:"from_status"`
 	ToStatus   string `json:"to_status"`
+	SubjectID  string `json:"subject_id,omitempty"`
 	Timestamp  string `json:"timestamp"`
 	RequestID  string `json:"request_id"`
 }
@@ -258,13 +259,14 @@ func parseAuditTime(value string, isEndDate bool) (time.Time, error) {
 }
 
 // toAuditEventResponse maps a domain audit event to its API representation,
-// lifting the previous/new status out of Details and excluding internal-only
-// fields. A nil Details yields empty status strings.
+// lifting the previous/new status and the subject out of Details and excluding
+// internal-only fields. A nil Details yields empty strings.
 func toAuditEventResponse(event *mmodel.ProtectionAuditEvent) auditEventResponse {
-	var fromStatus, toStatus string
+	var fromStatus, toStatus, subjectID string
 	if event.Details != nil {
 		fromStatus = event.Details.PreviousStatus
 		toStatus = event.Details.NewStatus
+		subjectID = event.Details.SubjectID
 	}
 
 	return auditEventResponse{
@@ -275,6 +277,7 @@ func toAuditEventResponse(event *mmodel.ProtectionAuditEvent) auditEventResponse
 		Reason:     event.Reason,
 		FromStatus: fromStatus,
 		ToStatus:   toStatus,
+		SubjectID:  subjectID,
 		Timestamp:  event.Timestamp.UTC().Format(time.RFC3339),
 		RequestID:  event.RequestID,
 	}
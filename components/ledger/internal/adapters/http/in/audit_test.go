@@ -132,6 +132,42 @@ func TestAuditHandler_GetAuditEvents(t *testing.T) {
 				assert.Equal(t, "desc", stub.gotQuery.SortOrder)
 			},
 		},
+		{
+			name:  "erasure event exposes the erased holder as subject_id",
+			query: "",
+			fake: &auditServiceStub{
+				events: []*mmodel.ProtectionAuditEvent{
+					{
+						ID:             fixedEventID,
+						OrganizationID: orgID,
+						EventType:      mmodel.AuditEventTypeErasure,
+						Action:         mmodel.AuditActionErase,
+						Outcome:        mmodel.AuditOutcomeSuccess,
+						ActorID:        "dpo@example.com",
+						Reason:         "holder personal data erased",
+						Timestamp:      ts,
+						Details: &mmodel.AuditDetails{
+							SubjectID: "00000000-0000-0000-0000-000000000042",
+							NewStatus: "erased",
+						},
+					},
+				},
+			},
+			expectedStatus: 200,
+			validateBody: func(t *testing.T, body []byte) {
+				var env map[string]any
+				require.NoError(t, json.Unmarshal(body, &env))
+
+				items, ok := env["items"].([]any)
+				require.True(t, ok, "items should be an array")
+				require.Len(t, items, 1)
+
+				item := items[0].(map[string]any)
+				assert.Equal(t, "erase", item["action"])
+				assert.Equal(t, "00000000-0000-0000-0000-000000000042", item["subject_id"])
+				assert.Equal(t, "erased", item["to_status"])
+			},
+		},
 		{
 			name:  "absent limit and sort_order default to 20 and desc",
 			query: "",
@@ -0,0 +1,266 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// BillingInvoiceUseCase defines the billing-invoice operations consumed by the
+// billing-invoice handler.
+type BillingInvoiceUseCase interface {
+	Issue(ctx context.Context, req model.BillingInvoiceRequest) (*model.BillingInvoiceIssueResult, error)
+	GetInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error)
+	ListInvoices(ctx context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error)
+	Collect(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error)
+	WriteOff(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error)
+	RunDunning(ctx context.Context, organizationID, ledgerID uuid.UUID) (*model.BillingDunningResult, error)
+}
+
+// BillingInvoiceHandler exposes billing invoices over HTTP. Issuing and
+// collecting post transactions, so like billing runs its routes carry a tenant
+// middleware spanning the transaction stores (see
+// RegisterBillingInvoiceRoutesToApp).
+type BillingInvoiceHandler struct {
+	Service BillingInvoiceUseCase
+}
+
+// issueBillingInvoices is the transport-agnostic core of the issue op. It
+// stamps the path org onto the request and reuses the calculate endpoint's
+// validation before issuing.
+func (handler *BillingInvoiceHandler) issueBillingInvoices(ctx context.Context, organizationID uuid.UUID, payload *model.BillingInvoiceRequest) (*model.BillingInvoiceIssueResult, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.issue_billing_invoices")
+	defer span.End()
+
+	payload.OrganizationID = organizationID.String()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", payload.OrganizationID),
+		attribute.String("app.request.ledger_id", payload.LedgerID),
+		attribute.String("app.request.period", payload.Period),
+		attribute.String("app.request.type", payload.Type),
+	)
+
+	calculateRequest := payload.CalculateRequest()
+	if errValidation := validateBillingCalculateRequest(&calculateRequest); errValidation != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice request validation failed", errValidation)
+
+		return nil, errValidation
+	}
+
+	result, err := handler.Service.Issue(ctx, *payload)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to issue billing invoices", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getBillingInvoice is the transport-agnostic core of the get op.
+func (handler *BillingInvoiceHandler) getBillingInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_billing_invoice")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	result, err := handler.Service.GetInvoice(ctx, organizationID, invoiceID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to retrieve billing invoice", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// collectBillingInvoice is the transport-agnostic core of the collect op.
+func (handler *BillingInvoiceHandler) collectBillingInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.collect_billing_invoice")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	result, err := handler.Service.Collect(ctx, organizationID, invoiceID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to collect billing invoice", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// writeOffBillingInvoice is the transport-agnostic core of the write-off op.
+func (handler *BillingInvoiceHandler) writeOffBillingInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.write_off_billing_invoice")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	result, err := handler.Service.WriteOff(ctx, organizationID, invoiceID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to write off billing invoice", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// runBillingDunning is the transport-agnostic core of the dunning op.
+func (handler *BillingInvoiceHandler) runBillingDunning(ctx context.Context, organizationID uuid.UUID, payload *model.BillingDunningRequest) (*model.BillingDunningResult, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.run_billing_dunning")
+	defer span.End()
+
+	payload.OrganizationID = organizationID.String()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", payload.OrganizationID),
+		attribute.String("app.request.ledger_id", payload.LedgerID),
+	)
+
+	ledgerID, errParse := uuid.Parse(payload.LedgerID)
+	if errParse != nil {
+		errValidation := feeerrors.ValidateBusinessError(feeconstant.ErrInvalidLedgerID, "BillingInvoice", "ledgerId")
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing dunning request validation failed", errValidation)
+
+		return nil, errValidation
+	}
+
+	result, err := handler.Service.RunDunning(ctx, organizationID, ledgerID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to run billing dunning", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// listBillingInvoices is the transport-agnostic core of the list op. It owns
+// the filter/limit/page query validation (same bounds as the billing-run
+// statements list) and builds the pagination envelope.
+func (handler *BillingInvoiceHandler) listBillingInvoices(ctx context.Context, organizationID uuid.UUID, queries map[string]string) (model.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.list_billing_invoices")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	const maxPaginationLimit = 100
+
+	limit := 10
+	page := 1
+
+	if l := queries["limit"]; l != "" {
+		parsed, errParse := strconv.Atoi(l)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingInvoice", "limit")
+		}
+
+		if parsed > maxPaginationLimit {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrPaginationLimitExceeded, "BillingInvoice", maxPaginationLimit)
+		}
+
+		limit = parsed
+	}
+
+	if p := queries["page"]; p != "" {
+		parsed, errParse := strconv.Atoi(p)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingInvoice", "page")
+		}
+
+		page = parsed
+	}
+
+	filter := model.BillingInvoiceFilter{
+		OrganizationID: organizationID.String(),
+		LedgerID:       queries["ledgerId"],
+		Period:         queries["period"],
+		AccountAlias:   queries["accountAlias"],
+	}
+
+	if filter.LedgerID != "" {
+		if _, errParse := uuid.Parse(filter.LedgerID); errParse != nil {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingInvoice", "ledgerId")
+		}
+	}
+
+	switch status := queries["status"]; status {
+	case "":
+	case model.BillingInvoiceStatusIssued, model.BillingInvoiceStatusPartiallyPaid, model.BillingInvoiceStatusPaid,
+		model.BillingInvoiceStatusOverdue, model.BillingInvoiceStatusWrittenOff:
+		filter.Statuses = []string{status}
+	default:
+		return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingInvoice", "status")
+	}
+
+	span.SetAttributes(
+		attribute.String("app.request.ledger_id", filter.LedgerID),
+		attribute.String("app.request.period", filter.Period),
+		attribute.String("app.request.status", queries["status"]),
+		attribute.Int("app.request.limit", limit),
+		attribute.Int("app.request.page", page),
+	)
+
+	results, total, err := handler.Service.ListInvoices(ctx, filter, limit, page)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to list billing invoices", err)
+
+		return model.Pagination{}, err
+	}
+
+	pagination := model.Pagination{
+		Limit: limit,
+		Page:  page,
+	}
+
+	pagination.SetItems(results)
+	pagination.SetTotal(int(total))
+
+	return pagination, nil
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of billing invoices. It follows the billing-run
+// sibling (raw body decoded through decodeFeeBodyInSpan, the list query bound
+// imperatively via Resolve, errors through pkgHTTP.HumaProblem).
+// Billing-invoice-specific notes:
+//
+//  1. AUTH is appName "plugin-fees", resource "billing-invoices". The runtime
+//     guard is the Fiber chain attached by RegisterBillingInvoiceRoutesToApp;
+//     Security here is SPEC metadata only.
+//  2. Issue, collect and dunning post ledger transactions but take no
+//     X-Idempotency header: an invoice is keyed by (period, account, asset) and
+//     each payment by (invoice, attempt), so repeating a call never re-bills.
+
+// --- POST /billing/invoices ----------------------------------------------------
+
+// IssueBillingInvoicesInputHuma is the Huma request envelope for POST.
+type IssueBillingInvoicesInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// IssueBillingInvoicesOutputHuma carries the result of an invoicing pass.
+type IssueBillingInvoicesOutputHuma struct {
+	Status int
+	Body   *model.BillingInvoiceIssueResult
+}
+
+// IssueBillingInvoicesHuma decodes the raw body with the fee validator then
+// delegates to the shared issueBillingInvoices core. Invoices that could not be
+// collected at issue are still a 201: collection failures are data on the
+// invoice, not an error.
+func (handler *BillingInvoiceHandler) IssueBillingInvoicesHuma(ctx context.Context, in *IssueBillingInvoicesInputHuma) (*IssueBillingInvoicesOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.BillingInvoiceRequest)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.issueBillingInvoices(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &IssueBillingInvoicesOutputHuma{Status: http.StatusCreated, Body: result}, nil
+}
+
+// --- GET /billing/invoices -----------------------------------------------------
+
+// ListBillingInvoicesInputHuma advertises the list query params in the spec
+// (doc-only) and captures the raw query via Resolve for the imperative binder.
+type ListBillingInvoicesInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	LedgerID       string `query:"ledgerId" doc:"Filter by ledger ID (UUID)"`
+	Period         string `query:"period" doc:"Filter by billing period"`
+	AccountAlias   string `query:"accountAlias" doc:"Filter by invoiced account alias"`
+	Status         string `query:"status" doc:"Filter by invoice status (ISSUED, PARTIALLY_PAID, PAID, OVERDUE, WRITTEN_OFF)"`
+	Limit          string `query:"limit" doc:"Number of items per page (default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler; validation stays in the
+// listBillingInvoices core.
+func (in *ListBillingInvoicesInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// ListBillingInvoicesOutputHuma carries the pagination envelope verbatim.
+type ListBillingInvoicesOutputHuma struct {
+	Status int
+	Body   model.Pagination
+}
+
+// ListBillingInvoicesHuma delegates to listBillingInvoices.
+func (handler *BillingInvoiceHandler) ListBillingInvoicesHuma(ctx context.Context, in *ListBillingInvoicesInputHuma) (*ListBillingInvoicesOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.listBillingInvoices(ctx, orgID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListBillingInvoicesOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// --- GET /billing/invoices/{id} ------------------------------------------------
+
+// BillingInvoiceIDInputHuma is the by-id request envelope shared by get,
+// collect and write-off.
+type BillingInvoiceIDInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Billing invoice ID (UUID)"`
+}
+
+// BillingInvoiceOutputHuma carries a billing invoice.
+type BillingInvoiceOutputHuma struct {
+	Status int
+	Body   *model.BillingInvoice
+}
+
+// GetBillingInvoiceHuma delegates to getBillingInvoice.
+func (handler *BillingInvoiceHandler) GetBillingInvoiceHuma(ctx context.Context, in *BillingInvoiceIDInputHuma) (*BillingInvoiceOutputHuma, error) {
+	orgID, invoiceID, err := parseBillingInvoicePath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.getBillingInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingInvoiceOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// --- POST /billing/invoices/{id}/collect ---------------------------------------
+
+// CollectBillingInvoiceHuma delegates to collectBillingInvoice. An attempt that
+// collects nothing is a 200 carrying the recorded failure.
+func (handler *BillingInvoiceHandler) CollectBillingInvoiceHuma(ctx context.Context, in *BillingInvoiceIDInputHuma) (*BillingInvoiceOutputHuma, error) {
+	orgID, invoiceID, err := parseBillingInvoicePath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.collectBillingInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingInvoiceOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// --- POST /billing/invoices/{id}/write-off -------------------------------------
+
+// WriteOffBillingInvoiceHuma delegates to writeOffBillingInvoice.
+func (handler *BillingInvoiceHandler) WriteOffBillingInvoiceHuma(ctx context.Context, in *BillingInvoiceIDInputHuma) (*BillingInvoiceOutputHuma, error) {
+	orgID, invoiceID, err := parseBillingInvoicePath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.writeOffBillingInvoice(ctx, orgID, invoiceID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingInvoiceOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// --- POST /billing/invoices/dunning --------------------------------------------
+
+// RunBillingDunningInputHuma is the Huma request envelope for the dunning pass.
+type RunBillingDunningInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// RunBillingDunningOutputHuma carries the summary of a dunning pass.
+type RunBillingDunningOutputHuma struct {
+	Status int
+	Body   *model.BillingDunningResult
+}
+
+// RunBillingDunningHuma decodes the raw body with the fee validator then
+// delegates to runBillingDunning.
+func (handler *BillingInvoiceHandler) RunBillingDunningHuma(ctx context.Context, in *RunBillingDunningInputHuma) (*RunBillingDunningOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.BillingDunningRequest)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.runBillingDunning(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &RunBillingDunningOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// parseBillingInvoicePath re-parses the org and invoice path params.
+func parseBillingInvoicePath(orgStr, idStr string) (orgID, invoiceID uuid.UUID, err error) {
+	orgID, err = parseOrg(orgStr)
+	if err != nil {
+		return orgID, invoiceID, err
+	}
+
+	invoiceID, err = parsePathUUID(idStr, "id")
+
+	return orgID, invoiceID, err
+}
+
+// RegisterBillingInvoiceRoutes registers the billing-invoice operations on the
+// shared Huma API. Paths are GROUP-RELATIVE; the auth + tenant +
+// ParseUUIDPathParameters chain is attached on the /v1 group by
+// RegisterBillingInvoiceRoutesToApp.
+func RegisterBillingInvoiceRoutes(api huma.API, h *BillingInvoiceHandler) {
+	const (
+		invoicesPath = "/organizations/{organization_id}/billing/invoices"
+		invoicePath  = invoicesPath + "/{id}"
+		tag          = "Billing Invoices"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "issueBillingInvoices",
+		Method:           http.MethodPost,
+		Path:             invoicesPath,
+		Summary:          "Issue the billing invoices of a period and collect them",
+		Tags:             []string{tag},
+		Security:         secBillingBearer,
+		SkipValidateBody: true,
+	}, h.IssueBillingInvoicesHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listBillingInvoices",
+		Method:      http.MethodGet,
+		Path:        invoicesPath,
+		Summary:     "List billing invoices",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.ListBillingInvoicesHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "runBillingDunning",
+		Method:           http.MethodPost,
+		Path:             invoicesPath + "/dunning",
+		Summary:          "Mark past-due invoices overdue and retry scheduled collections",
+		Tags:             []string{tag},
+		Security:         secBillingBearer,
+		SkipValidateBody: true,
+	}, h.RunBillingDunningHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getBillingInvoice",
+		Method:      http.MethodGet,
+		Path:        invoicePath,
+		Summary:     "Get a billing invoice",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.GetBillingInvoiceHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "collectBillingInvoice",
+		Method:      http.MethodPost,
+		Path:        invoicePath + "/collect",
+		Summary:     "Attempt to collect a billing invoice now",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.CollectBillingInvoiceHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "writeOffBillingInvoice",
+		Method:      http.MethodPost,
+		Path:        invoicePath + "/write-off",
+		Summary:     "Write off an unpaid billing invoice",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.WriteOffBillingInvoiceHuma)
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// stubBillingInvoiceService is a hand-rolled BillingInvoiceUseCase fake
+// recording the arguments of each call.
+type stubBillingInvoiceService struct {
+	issued  *model.BillingInvoiceIssueResult
+	invoice *model.BillingInvoice
+	dunning *model.BillingDunningResult
+	items   []*model.BillingInvoice
+	total   int64
+	err     error
+
+	gotRequest   model.BillingInvoiceRequest
+	gotFilter    model.BillingInvoiceFilter
+	gotOrg       uuid.UUID
+	gotInvoiceID uuid.UUID
+	gotLedgerID  uuid.UUID
+	gotLimit     int
+	gotPage      int
+	called       string
+}
+
+func (s *stubBillingInvoiceService) Issue(_ context.Context, req model.BillingInvoiceRequest) (*model.BillingInvoiceIssueResult, error) {
+	s.called = "issue"
+	s.gotRequest = req
+
+	return s.issued, s.err
+}
+
+func (s *stubBillingInvoiceService) GetInvoice(_ context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	s.called = "get"
+	s.gotOrg, s.gotInvoiceID = organizationID, invoiceID
+
+	return s.invoice, s.err
+}
+
+func (s *stubBillingInvoiceService) ListInvoices(_ context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error) {
+	s.called = "list"
+	s.gotFilter, s.gotLimit, s.gotPage = filter, limit, page
+
+	return s.items, s.total, s.err
+}
+
+func (s *stubBillingInvoiceService) Collect(_ context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	s.called = "collect"
+	s.gotOrg, s.gotInvoiceID = organizationID, invoiceID
+
+	return s.invoice, s.err
+}
+
+func (s *stubBillingInvoiceService) WriteOff(_ context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	s.called = "write-off"
+	s.gotOrg, s.gotInvoiceID = organizationID, invoiceID
+
+	return s.invoice, s.err
+}
+
+func (s *stubBillingInvoiceService) RunDunning(_ context.Context, organizationID, ledgerID uuid.UUID) (*model.BillingDunningResult, error) {
+	s.called = "dunning"
+	s.gotOrg, s.gotLedgerID = organizationID, ledgerID
+
+	return s.dunning, s.err
+}
+
+// buildHumaBillingInvoiceApp mounts the six billing-invoice Huma operations
+// behind the auth shim, mirroring RegisterBillingInvoiceRoutesToApp. Same
+// MUST-NOT-PARALLELIZE constraint as buildHumaBillingPackageApp.
+func buildHumaBillingInvoiceApp(t *testing.T, handler *BillingInvoiceHandler, authOK bool) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+
+	apiV1.Use(feesAuthShim(authOK))
+
+	parse := pkgHTTP.ParseUUIDPathParameters("billing-invoices")
+
+	invoicesPath := "/organizations/:organization_id/billing/invoices"
+	invoicePath := invoicesPath + "/:id"
+
+	apiV1.Post(invoicesPath, parse)
+	apiV1.Get(invoicesPath, parse)
+	apiV1.Post(invoicesPath+"/dunning", parse)
+	apiV1.Get(invoicePath, parse)
+	apiV1.Post(invoicePath+"/collect", parse)
+	apiV1.Post(invoicePath+"/write-off", parse)
+
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	RegisterBillingInvoiceRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_IssueBillingInvoices_Success(t *testing.T) {
+	orgID := uuid.New()
+	ledgerID := validLedgerUUID()
+
+	stub := &stubBillingInvoiceService{issued: &model.BillingInvoiceIssueResult{Period: "2026-01", TotalIssued: 2}}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	body := `{"ledgerId":"` + ledgerID + `","period":"2026-01","dueInDays":30}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/invoices", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "issue", stub.called)
+	assert.Equal(t, orgID.String(), stub.gotRequest.OrganizationID, "handler must stamp path org onto the request")
+	assert.Equal(t, ledgerID, stub.gotRequest.LedgerID)
+	assert.Equal(t, 30, stub.gotRequest.GetDueInDays())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.EqualValues(t, 2, got["totalIssued"])
+}
+
+func TestHuma_IssueBillingInvoices_InvalidPeriod_NoServiceCall(t *testing.T) {
+	stub := &stubBillingInvoiceService{}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	body := `{"ledgerId":"` + validLedgerUUID() + `","period":"january"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+uuid.NewString()+"/billing/invoices", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+	assert.Empty(t, stub.called, "validation must reject before the service runs")
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrInvalidBillingPeriod.Error(), got["code"])
+}
+
+func TestHuma_IssueBillingInvoices_AuthPreserved(t *testing.T) {
+	stub := &stubBillingInvoiceService{}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, false)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+uuid.NewString()+"/billing/invoices", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "auth middleware must reject before Huma")
+	assert.Empty(t, stub.called)
+}
+
+func TestHuma_ListBillingInvoices_Success(t *testing.T) {
+	orgID := uuid.New()
+	ledgerID := validLedgerUUID()
+
+	stub := &stubBillingInvoiceService{
+		items: []*model.BillingInvoice{{ID: uuid.NewString(), Status: model.BillingInvoiceStatusOverdue}},
+		total: 1,
+	}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/billing/invoices?ledgerId="+ledgerID+"&period=2026-01&accountAlias=@alice&status=OVERDUE&limit=5&page=2", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, model.BillingInvoiceFilter{
+		OrganizationID: orgID.String(),
+		LedgerID:       ledgerID,
+		Period:         "2026-01",
+		AccountAlias:   "@alice",
+		Statuses:       []string{model.BillingInvoiceStatusOverdue},
+	}, stub.gotFilter)
+	assert.Equal(t, 5, stub.gotLimit)
+	assert.Equal(t, 2, stub.gotPage)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.EqualValues(t, 1, got["total"])
+	assert.Len(t, got["items"], 1)
+}
+
+func TestHuma_ListBillingInvoices_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		code  string
+	}{
+		{name: "unknown status", query: "?status=POSTED", code: constant.ErrInvalidQueryParameter.Error()},
+		{name: "malformed ledger", query: "?ledgerId=ledger", code: constant.ErrInvalidQueryParameter.Error()},
+		{name: "limit above maximum", query: "?limit=101", code: constant.ErrPaginationLimitExceeded.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubBillingInvoiceService{}
+			app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+uuid.NewString()+"/billing/invoices"+tt.query, nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+			assert.Empty(t, stub.called)
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+			assert.Equal(t, tt.code, got["code"])
+		})
+	}
+}
+
+func TestHuma_GetBillingInvoice_NotFound(t *testing.T) {
+	orgID := uuid.New()
+	invoiceID := uuid.New()
+
+	stub := &stubBillingInvoiceService{err: pkg.ValidateBusinessError(constant.ErrBillingInvoiceNotFound, "BillingInvoice", invoiceID.String())}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/billing/invoices/"+invoiceID.String(), nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, orgID, stub.gotOrg)
+	assert.Equal(t, invoiceID, stub.gotInvoiceID)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrBillingInvoiceNotFound.Error(), got["code"])
+}
+
+func TestHuma_CollectBillingInvoice_Success(t *testing.T) {
+	invoiceID := uuid.New()
+
+	stub := &stubBillingInvoiceService{invoice: &model.BillingInvoice{ID: invoiceID.String(), Status: model.BillingInvoiceStatusPaid}}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+uuid.NewString()+"/billing/invoices/"+invoiceID.String()+"/collect", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "collect", stub.called)
+	assert.Equal(t, invoiceID, stub.gotInvoiceID)
+}
+
+func TestHuma_WriteOffBillingInvoice_PaidInvoice(t *testing.T) {
+	invoiceID := uuid.New()
+
+	stub := &stubBillingInvoiceService{err: pkg.ValidateBusinessError(constant.ErrBillingInvoiceTransitionInvalid, "BillingInvoice",
+		invoiceID.String(), model.BillingInvoiceStatusPaid, "written off")}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+uuid.NewString()+"/billing/invoices/"+invoiceID.String()+"/write-off", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "write-off", stub.called)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrBillingInvoiceTransitionInvalid.Error(), got["code"])
+}
+
+func TestHuma_RunBillingDunning_Success(t *testing.T) {
+	orgID := uuid.New()
+	ledgerID := validLedgerUUID()
+
+	stub := &stubBillingInvoiceService{dunning: &model.BillingDunningResult{MarkedOverdue: 1, Attempted: 2, Paid: 1, Failed: 1}}
+	app := buildHumaBillingInvoiceApp(t, &BillingInvoiceHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/invoices/dunning", bytes.NewBufferString(`{"ledgerId":"`+ledgerID+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "dunning", stub.called)
+	assert.Equal(t, orgID, stub.gotOrg)
+	assert.Equal(t, ledgerID, stub.gotLedgerID.String())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.EqualValues(t, 1, got["markedOverdue"])
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// BillingRunUseCase defines the billing-run operations consumed by the
+// billing-run handler.
+type BillingRunUseCase interface {
+	Run(ctx context.Context, req model.BillingRunRequest) (*model.BillingRun, error)
+	Retry(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error)
+	GetRun(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error)
+	ListStatements(ctx context.Context, organizationID, runID uuid.UUID, status string, limit, page int) ([]*model.BillingStatement, int64, error)
+}
+
+// BillingRunHandler exposes billing runs over HTTP. Unlike the calculate
+// endpoint it posts transactions, so its routes carry a tenant middleware that
+// spans the transaction stores as well as the fee Mongo (see
+// RegisterBillingRunRoutesToApp).
+type BillingRunHandler struct {
+	Service BillingRunUseCase
+}
+
+// createBillingRun is the transport-agnostic core of the run op. It stamps the
+// path org onto the request, reuses the calculate endpoint's validation (a run
+// accepts exactly the calculate request), and runs the billing.
+func (handler *BillingRunHandler) createBillingRun(ctx context.Context, organizationID uuid.UUID, payload *model.BillingRunRequest) (*model.BillingRun, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.create_billing_run")
+	defer span.End()
+
+	payload.OrganizationID = organizationID.String()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", payload.OrganizationID),
+		attribute.String("app.request.ledger_id", payload.LedgerID),
+		attribute.String("app.request.period", payload.Period),
+		attribute.String("app.request.type", payload.Type),
+	)
+
+	calculateRequest := payload.CalculateRequest()
+	if errValidation := validateBillingCalculateRequest(&calculateRequest); errValidation != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing run request validation failed", errValidation)
+
+		return nil, errValidation
+	}
+
+	result, err := handler.Service.Run(ctx, *payload)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to run billing", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getBillingRun is the transport-agnostic core of the get op.
+func (handler *BillingRunHandler) getBillingRun(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_billing_run")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+	)
+
+	result, err := handler.Service.GetRun(ctx, organizationID, runID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to retrieve billing run", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// retryBillingRun is the transport-agnostic core of the retry op.
+func (handler *BillingRunHandler) retryBillingRun(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.retry_billing_run")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+	)
+
+	result, err := handler.Service.Retry(ctx, organizationID, runID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to retry billing run", err)
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// listBillingStatements is the transport-agnostic core of the statements op.
+// It owns the status/limit/page query validation (same bounds as the
+// billing-package list) and builds the pagination envelope.
+func (handler *BillingRunHandler) listBillingStatements(ctx context.Context, organizationID, runID uuid.UUID, queries map[string]string) (model.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.list_billing_statements")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+	)
+
+	const maxPaginationLimit = 100
+
+	limit := 10
+	page := 1
+
+	if l := queries["limit"]; l != "" {
+		parsed, errParse := strconv.Atoi(l)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingRun", "limit")
+		}
+
+		if parsed > maxPaginationLimit {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrPaginationLimitExceeded, "BillingRun", maxPaginationLimit)
+		}
+
+		limit = parsed
+	}
+
+	if p := queries["page"]; p != "" {
+		parsed, errParse := strconv.Atoi(p)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingRun", "page")
+		}
+
+		page = parsed
+	}
+
+	status := queries["status"]
+
+	switch status {
+	case "", model.BillingStatementStatusPending, model.BillingStatementStatusPosted, model.BillingStatementStatusFailed:
+	default:
+		return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, "BillingRun", "status")
+	}
+
+	span.SetAttributes(
+		attribute.String("app.request.status", status),
+		attribute.Int("app.request.limit", limit),
+		attribute.Int("app.request.page", page),
+	)
+
+	results, total, err := handler.Service.ListStatements(ctx, organizationID, runID, status, limit, page)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to list billing statements", err)
+
+		return model.Pagination{}, err
+	}
+
+	pagination := model.Pagination{
+		Limit: limit,
+		Page:  page,
+	}
+
+	pagination.SetItems(results)
+	pagination.SetTotal(int(total))
+
+	return pagination, nil
+}
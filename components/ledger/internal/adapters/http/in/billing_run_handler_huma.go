@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of billing runs. It follows the billing-calculate
+// and billing-package siblings (raw body decoded through decodeFeeBodyInSpan, the
+// list query bound imperatively via Resolve, errors through pkgHTTP.HumaProblem).
+// Billing-run-specific notes:
+//
+//  1. AUTH is appName "plugin-fees", resource "billing-runs". The runtime guard is
+//     the Fiber chain attached by RegisterBillingRunRoutesToApp; Security here is
+//     SPEC metadata only.
+//  2. The create and retry ops post ledger transactions but take no X-Idempotency
+//     header: idempotency is per charge, derived from (package, period, account)
+//     and enforced by the service, so repeating either call never re-charges.
+
+// --- POST /billing/runs --------------------------------------------------------
+
+// CreateBillingRunInputHuma is the Huma request envelope for POST.
+type CreateBillingRunInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// BillingRunOutputHuma carries a billing run.
+type BillingRunOutputHuma struct {
+	Status int
+	Body   *model.BillingRun
+}
+
+// CreateBillingRunHuma decodes the raw body with the fee validator then delegates
+// to the shared createBillingRun core. A run that completes with failed
+// statements is still a 201: the failures are data on the run, not an error.
+func (handler *BillingRunHandler) CreateBillingRunHuma(ctx context.Context, in *CreateBillingRunInputHuma) (*BillingRunOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.BillingRunRequest)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.createBillingRun(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingRunOutputHuma{Status: http.StatusCreated, Body: result}, nil
+}
+
+// --- GET /billing/runs/{id} ----------------------------------------------------
+
+// BillingRunIDInputHuma is the by-id request envelope shared by get and retry.
+type BillingRunIDInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Billing run ID (UUID)"`
+}
+
+// GetBillingRunHuma delegates to getBillingRun.
+func (handler *BillingRunHandler) GetBillingRunHuma(ctx context.Context, in *BillingRunIDInputHuma) (*BillingRunOutputHuma, error) {
+	orgID, runID, err := parseBillingRunPath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.getBillingRun(ctx, orgID, runID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingRunOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// --- POST /billing/runs/{id}/retry --------------------------------------------
+
+// RetryBillingRunHuma delegates to retryBillingRun.
+func (handler *BillingRunHandler) RetryBillingRunHuma(ctx context.Context, in *BillingRunIDInputHuma) (*BillingRunOutputHuma, error) {
+	orgID, runID, err := parseBillingRunPath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	result, err := handler.retryBillingRun(ctx, orgID, runID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BillingRunOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// --- GET /billing/runs/{id}/statements ----------------------------------------
+
+// ListBillingStatementsInputHuma advertises the list query params in the spec
+// (doc-only) and captures the raw query via Resolve for the imperative binder.
+type ListBillingStatementsInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Billing run ID (UUID)"`
+	Status         string `query:"status" doc:"Filter by statement status (PENDING, POSTED, FAILED)"`
+	Limit          string `query:"limit" doc:"Number of items per page (default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler; validation stays in the
+// listBillingStatements core.
+func (in *ListBillingStatementsInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// ListBillingStatementsOutputHuma carries the pagination envelope verbatim.
+type ListBillingStatementsOutputHuma struct {
+	Status int
+	Body   model.Pagination
+}
+
+// ListBillingStatementsHuma delegates to listBillingStatements.
+func (handler *BillingRunHandler) ListBillingStatementsHuma(ctx context.Context, in *ListBillingStatementsInputHuma) (*ListBillingStatementsOutputHuma, error) {
+	orgID, runID, err := parseBillingRunPath(in.OrganizationID, in.ID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.listBillingStatements(ctx, orgID, runID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListBillingStatementsOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// parseBillingRunPath re-parses the org and run path params.
+func parseBillingRunPath(orgStr, idStr string) (orgID, runID uuid.UUID, err error) {
+	orgID, err = parseOrg(orgStr)
+	if err != nil {
+		return orgID, runID, err
+	}
+
+	runID, err = parsePathUUID(idStr, "id")
+
+	return orgID, runID, err
+}
+
+// RegisterBillingRunRoutes registers the billing-run operations on the shared Huma
+// API. Paths are GROUP-RELATIVE; the auth + tenant + ParseUUIDPathParameters chain
+// is attached on the /v1 group by RegisterBillingRunRoutesToApp.
+func RegisterBillingRunRoutes(api huma.API, h *BillingRunHandler) {
+	const (
+		runsPath = "/organizations/{organization_id}/billing/runs"
+		runPath  = runsPath + "/{id}"
+		tag      = "Billing Runs"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "createBillingRun",
+		Method:           http.MethodPost,
+		Path:             runsPath,
+		Summary:          "Run billing for a period and post the charges",
+		Tags:             []string{tag},
+		Security:         secBillingBearer,
+		SkipValidateBody: true,
+	}, h.CreateBillingRunHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getBillingRun",
+		Method:      http.MethodGet,
+		Path:        runPath,
+		Summary:     "Get a billing run",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.GetBillingRunHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listBillingStatements",
+		Method:      http.MethodGet,
+		Path:        runPath + "/statements",
+		Summary:     "List the statements of a billing run",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.ListBillingStatementsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "retryBillingRun",
+		Method:      http.MethodPost,
+		Path:        runPath + "/retry",
+		Summary:     "Retry the unposted statements of a billing run",
+		Tags:        []string{tag},
+		Security:    secBillingBearer,
+	}, h.RetryBillingRunHuma)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// stubBillingRunService is a hand-rolled BillingRunUseCase fake recording the
+// arguments of each call.
+type stubBillingRunService struct {
+	run   *model.BillingRun
+	items []*model.BillingStatement
+	total int64
+	err   error
+
+	gotRequest model.BillingRunRequest
+	gotOrg     uuid.UUID
+	gotRunID   uuid.UUID
+	gotStatus  string
+	gotLimit   int
+	gotPage    int
+	called     string
+}
+
+func (s *stubBillingRunService) Run(_ context.Context, req model.BillingRunRequest) (*model.BillingRun, error) {
+	s.called = "run"
+	s.gotRequest = req
+
+	return s.run, s.err
+}
+
+func (s *stubBillingRunService) Retry(_ context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	s.called = "retry"
+	s.gotOrg, s.gotRunID = organizationID, runID
+
+	return s.run, s.err
+}
+
+func (s *stubBillingRunService) GetRun(_ context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	s.called = "get"
+	s.gotOrg, s.gotRunID = organizationID, runID
+
+	return s.run, s.err
+}
+
+func (s *stubBillingRunService) ListStatements(_ context.Context, organizationID, runID uuid.UUID, status string, limit, page int) ([]*model.BillingStatement, int64, error) {
+	s.called = "list"
+	s.gotOrg, s.gotRunID = organizationID, runID
+	s.gotStatus, s.gotLimit, s.gotPage = status, limit, page
+
+	return s.items, s.total, s.err
+}
+
+// buildHumaBillingRunApp mounts the four billing-run Huma operations behind the
+// auth shim, mirroring RegisterBillingRunRoutesToApp. Same MUST-NOT-PARALLELIZE
+// constraint as buildHumaBillingPackageApp.
+func buildHumaBillingRunApp(t *testing.T, handler *BillingRunHandler, authOK bool) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+
+	apiV1.Use(feesAuthShim(authOK))
+
+	parse := pkgHTTP.ParseUUIDPathParameters("billing-runs")
+
+	runsPath := "/organizations/:organization_id/billing/runs"
+	runPath := runsPath + "/:id"
+
+	apiV1.Post(runsPath, parse)
+	apiV1.Get(runPath, parse)
+	apiV1.Get(runPath+"/statements", parse)
+	apiV1.Post(runPath+"/retry", parse)
+
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	RegisterBillingRunRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_CreateBillingRun_Success(t *testing.T) {
+	orgID := uuid.New()
+	ledgerID := validLedgerUUID()
+
+	stub := &stubBillingRunService{run: &model.BillingRun{ID: uuid.NewString(), Status: model.BillingRunStatusCompleted}}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+	body := `{"ledgerId":"` + ledgerID + `","period":"2026-01","type":"volume"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/runs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "run", stub.called)
+	assert.Equal(t, orgID.String(), stub.gotRequest.OrganizationID, "handler must stamp path org onto the request")
+	assert.Equal(t, ledgerID, stub.gotRequest.LedgerID)
+	assert.Equal(t, "2026-01", stub.gotRequest.Period)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, model.BillingRunStatusCompleted, got["status"])
+}
+
+func TestHuma_CreateBillingRun_InvalidPeriod_NoServiceCall(t *testing.T) {
+	orgID := uuid.New()
+
+	stub := &stubBillingRunService{}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+	body := `{"ledgerId":"` + validLedgerUUID() + `","period":"january"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/runs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+	assert.Empty(t, stub.called, "validation must reject before the service runs")
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrInvalidBillingPeriod.Error(), got["code"])
+}
+
+func TestHuma_CreateBillingRun_AuthPreserved(t *testing.T) {
+	orgID := uuid.New()
+
+	stub := &stubBillingRunService{}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, false)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/runs", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "auth middleware must reject before Huma")
+	assert.Empty(t, stub.called)
+}
+
+func TestHuma_GetBillingRun_NotFound(t *testing.T) {
+	orgID := uuid.New()
+	runID := uuid.New()
+
+	stub := &stubBillingRunService{err: pkg.ValidateBusinessError(constant.ErrBillingRunNotFound, "BillingRun", runID.String())}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/billing/runs/"+runID.String(), nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, orgID, stub.gotOrg)
+	assert.Equal(t, runID, stub.gotRunID)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrBillingRunNotFound.Error(), got["code"])
+}
+
+func TestHuma_RetryBillingRun_Success(t *testing.T) {
+	orgID := uuid.New()
+	runID := uuid.New()
+
+	stub := &stubBillingRunService{run: &model.BillingRun{ID: runID.String(), Status: model.BillingRunStatusCompleted, TotalPosted: 2}}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/billing/runs/"+runID.String()+"/retry", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, "retry", stub.called)
+	assert.Equal(t, runID, stub.gotRunID)
+}
+
+func TestHuma_ListBillingStatements_Success(t *testing.T) {
+	orgID := uuid.New()
+	runID := uuid.New()
+
+	stub := &stubBillingRunService{
+		items: []*model.BillingStatement{{ID: uuid.NewString(), Status: model.BillingStatementStatusFailed}},
+		total: 1,
+	}
+	app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/billing/runs/"+runID.String()+"/statements?status=FAILED&limit=5&page=2", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+	assert.Equal(t, model.BillingStatementStatusFailed, stub.gotStatus)
+	assert.Equal(t, 5, stub.gotLimit)
+	assert.Equal(t, 2, stub.gotPage)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.EqualValues(t, 1, got["total"])
+	assert.Len(t, got["items"], 1)
+}
+
+func TestHuma_ListBillingStatements_InvalidQuery(t *testing.T) {
+	orgID := uuid.New()
+	runID := uuid.New()
+
+	tests := []struct {
+		name  string
+		query string
+		code  string
+	}{
+		{name: "unknown status", query: "?status=PAID", code: constant.ErrInvalidQueryParameter.Error()},
+		{name: "non-numeric limit", query: "?limit=ten", code: constant.ErrInvalidQueryParameter.Error()},
+		{name: "limit above maximum", query: "?limit=101", code: constant.ErrPaginationLimitExceeded.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubBillingRunService{}
+			app := buildHumaBillingRunApp(t, &BillingRunHandler{Service: stub}, true)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/billing/runs/"+runID.String()+"/statements"+tt.query, nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+			assert.Empty(t, stub.called)
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+			assert.Equal(t, tt.code, got["code"])
+		})
+	}
+}
@@ -87,13 +87,15 @@ func buildUnifiedHumaAPI() (*fiber.App, huma.API) {
 	// Wave-3 (additive) Huma-migrated resources (CRM holders/instruments/holder-
 	// accounts/encryption/audit, fees/billing, composition) are mounted via the same
 	// /v1 group + shared Huma API the unified server's humaMount uses. The conditional
-	// CRM handlers (holder-accounts, encryption, audit) get non-nil zero-value handlers
+	// CRM handlers (holder-accounts, encryption, audit, screening) get non-nil zero-value handlers
 	// so the FULL surface is registered.
 	RegisterCRMRoutesToApp(apiV1, humaAPI, auth,
 		&HolderHandler{}, &InstrumentHandler{}, &HolderAccountsHandler{},
-		&EncryptionHandler{}, &AuditHandler{}, nil)
+		&EncryptionHandler{}, &AuditHandler{}, &ScreeningHandler{}, nil)
 	RegisterFeesRoutesToApp(apiV1, humaAPI, auth,
 		&PackageHandler{}, &FeeHandler{}, &BillingPackageHandler{}, &BillingCalculateHandler{}, nil)
+	RegisterBillingRunRoutesToApp(apiV1, humaAPI, auth, &BillingRunHandler{}, nil)
+	RegisterBillingInvoiceRoutesToApp(apiV1, humaAPI, auth, &BillingInvoiceHandler{}, nil)
 	RegisterCompositionRoutesToApp(apiV1, humaAPI, auth, &CompositionHandler{}, nil)
 
 	return app, humaAPI
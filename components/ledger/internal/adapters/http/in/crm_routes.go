@@ -36,13 +36,28 @@ const ApplicationName = "midaz"
 // guard.
 //
 // eh and auditHandler are non-nil only in envelope encryption mode
-// (KMS_VENDOR=hashicorp-vault); when nil the encryption/audit routes stay unregistered,
+// (KMS_VENDOR=hashicorp-vault, pkcs11 or local); when nil the encryption/audit routes stay unregistered,
 // matching the legacy-mode posture where no KMS provisioning surface exists.
-func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.AuthClient, hh *HolderHandler, ah *InstrumentHandler, hah *HolderAccountsHandler, eh *EncryptionHandler, auditHandler *AuditHandler, routeOptions *http.ProtectedRouteOptions) {
+//
+// sh may be nil (screening not wired); when nil the screening routes stay
+// unregistered.
+func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.AuthClient, hh *HolderHandler, ah *InstrumentHandler, hah *HolderAccountsHandler, eh *EncryptionHandler, auditHandler *AuditHandler, sh *ScreeningHandler, routeOptions *http.ProtectedRouteOptions) {
 	const (
 		holdersPath  = "/organizations/:organization_id/holders"
 		holderIDPath = holdersPath + "/:id"
 		acctsPath    = holderIDPath + "/accounts"
+		erasePath    = holderIDPath + "/erase"
+		kycPath      = holderIDPath + "/kyc"
+		kycDuePath   = "/organizations/:organization_id/kyc/reviews-due"
+		relsPath     = holderIDPath + "/relationships"
+		relIDPath    = relsPath + "/:relationship_id"
+		ownersPath   = holderIDPath + "/beneficial-owners"
+		exportsPath  = holderIDPath + "/exports"
+		exportIDPath = exportsPath + "/:export_id"
+		dupsPath     = holderIDPath + "/duplicates"
+		mergePath    = holderIDPath + "/merge"
+		mergesPath   = holderIDPath + "/merges"
+		positionPath = holderIDPath + "/position"
 
 		instrumentsPath   = "/organizations/:organization_id/instruments"
 		holderInstruments = holdersPath + "/:holder_id/instruments"
@@ -51,7 +66,17 @@ func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.A
 
 		encProvisionPath = "/organizations/:organization_id/encryption/provision"
 		encStatusPath    = "/organizations/:organization_id/encryption/status"
+		encRotatePath    = "/organizations/:organization_id/encryption/rotate"
+		encRotationPath  = "/organizations/:organization_id/encryption/rotation"
+		encResumePath    = "/organizations/:organization_id/encryption/rotation/resume"
+		encRetirePath    = "/organizations/:organization_id/encryption/retire"
 		auditPath        = "/organizations/:organization_id/protection/audit"
+
+		watchListsPath = "/organizations/:organization_id/screening/lists"
+		watchListPath  = watchListsPath + "/:name"
+		alertsPath     = "/organizations/:organization_id/screening/alerts"
+		alertIDPath    = alertsPath + "/:id"
+		pendingPath    = "/organizations/:organization_id/screening/pending"
 	)
 
 	holderParse := http.ParseUUIDPathParameters("holder")
@@ -63,10 +88,51 @@ func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.A
 	group.Get(holderIDPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
 	group.Patch(holderIDPath, protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
 	group.Delete(holderIDPath, protectedMidaz(auth, "holders", "delete", routeOptions, holderParse)...)
+	group.Post(erasePath, protectedMidaz(auth, "holders", "delete", routeOptions, holderParse)...)
 	group.Get(holdersPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
 
 	RegisterHolderRoutes(api, hh)
 
+	// Holder KYC: reads under "get", workflow steps under "patch".
+	group.Get(kycPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Post(kycPath+"/open", protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Post(kycPath+"/documents", protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Post(kycPath+"/submit", protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Post(kycPath+"/decision", protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Get(kycDuePath, protectedMidaz(auth, "holders", "get", routeOptions, orgParse)...)
+
+	RegisterHolderKYCRoutes(api, hh)
+
+	// Beneficial ownership: reads under "get", relationship changes under "patch".
+	group.Post(relsPath, protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Get(relsPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Get(relIDPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Patch(relIDPath, protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Delete(relIDPath, protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Get(ownersPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+
+	RegisterHolderOwnershipRoutes(api, hh)
+
+	// Holder data export: every step only discloses data, so all under "get".
+	group.Post(exportsPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Get(exportsPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Get(exportIDPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Get(exportIDPath+"/archive", protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+
+	RegisterHolderExportRoutes(api, hh)
+
+	// Duplicate holders: detection under "get", the merge under "patch".
+	group.Get(dupsPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+	group.Post(mergePath, protectedMidaz(auth, "holders", "patch", routeOptions, holderParse)...)
+	group.Get(mergesPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+
+	RegisterHolderMergeRoutes(api, hh)
+
+	// Holder position: a read under "get".
+	group.Get(positionPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
+
+	RegisterHolderPositionRoutes(api, hh)
+
 	if hah != nil {
 		group.Get(acctsPath, protectedMidaz(auth, "holders", "get", routeOptions, holderParse)...)
 		RegisterHolderAccountsRoutes(api, hah)
@@ -82,11 +148,22 @@ func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.A
 
 	RegisterInstrumentRoutes(api, ah)
 
+	// Instrument lifecycle: every transition under "patch".
+	group.Post(instrumentIDPath+"/block", protectedMidaz(auth, "instruments", "patch", routeOptions, instrumentParse)...)
+	group.Post(instrumentIDPath+"/unblock", protectedMidaz(auth, "instruments", "patch", routeOptions, instrumentParse)...)
+	group.Post(instrumentIDPath+"/close", protectedMidaz(auth, "instruments", "patch", routeOptions, instrumentParse)...)
+
+	RegisterInstrumentLifecycleRoutes(api, ah)
+
 	// Encryption provisioning + protection audit (envelope mode only). In legacy mode
 	// eh and auditHandler are nil, so these routes stay unregistered.
 	if eh != nil {
 		group.Post(encProvisionPath, protectedMidaz(auth, "encryption", "post", routeOptions, orgParse)...)
 		group.Get(encStatusPath, protectedMidaz(auth, "encryption", "get", routeOptions, orgParse)...)
+		group.Post(encRotatePath, protectedMidaz(auth, "encryption", "post", routeOptions, orgParse)...)
+		group.Get(encRotationPath, protectedMidaz(auth, "encryption", "get", routeOptions, orgParse)...)
+		group.Post(encResumePath, protectedMidaz(auth, "encryption", "post", routeOptions, orgParse)...)
+		group.Post(encRetirePath, protectedMidaz(auth, "encryption", "post", routeOptions, orgParse)...)
 		RegisterEncryptionRoutes(api, eh)
 	}
 
@@ -94,4 +171,16 @@ func RegisterCRMRoutesToApp(group fiber.Router, api huma.API, auth *middleware.A
 		group.Get(auditPath, protectedMidaz(auth, "protection", "get", routeOptions, orgParse)...)
 		RegisterAuditRoutes(api, auditHandler)
 	}
+
+	// Sanctions and watch-list screening (auth resource "screening" under midaz).
+	if sh != nil {
+		group.Put(watchListPath, protectedMidaz(auth, "screening", "put", routeOptions, orgParse)...)
+		group.Get(watchListsPath, protectedMidaz(auth, "screening", "get", routeOptions, orgParse)...)
+		group.Delete(watchListPath, protectedMidaz(auth, "screening", "delete", routeOptions, orgParse)...)
+		group.Get(alertsPath, protectedMidaz(auth, "screening", "get", routeOptions, orgParse)...)
+		group.Get(alertIDPath, protectedMidaz(auth, "screening", "get", routeOptions, http.ParseUUIDPathParameters("screening_alert"))...)
+		group.Get(pendingPath, protectedMidaz(auth, "screening", "get", routeOptions, orgParse)...)
+		group.Post(alertIDPath+"/review", protectedMidaz(auth, "screening", "patch", routeOptions, http.ParseUUIDPathParameters("screening_alert"))...)
+		RegisterScreeningRoutes(api, sh)
+	}
 }
@@ -20,9 +20,11 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-// EncryptionHandler handles HTTP requests for encryption provisioning operations.
+// EncryptionHandler handles HTTP requests for encryption provisioning and key
+// rotation operations.
 type EncryptionHandler struct {
 	ProvisioningService encryption.ProvisioningService
+	RotationService     encryption.KeyRotationService
 }
 
 // Provision handles the provisioning of an organization for envelope encryption.
@@ -163,3 +165,225 @@ func (handler *EncryptionHandler) getProvisioningStatus(ctx context.Context, org
 
 	return response, nil
 }
+
+// RotateKeys handles the rotation of an organization's encryption keysets.
+func (handler *EncryptionHandler) RotateKeys(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.RotateEncryptionKeysInput)
+	if !ok || payload == nil {
+		return http.WithError(c, cn.ErrInternalServer)
+	}
+
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	response, err := handler.rotateKeys(c.UserContext(), organizationID, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Accepted(c, response)
+}
+
+// rotateKeys is the transport-agnostic core for the key rotation operation. The
+// tenant id is resolved exactly as for provisioning (see provision). The
+// re-encryption of existing records continues in the background, so the
+// response is the rotation's initial progress.
+func (handler *EncryptionHandler) rotateKeys(ctx context.Context, organizationID uuid.UUID, payload *mmodel.RotateEncryptionKeysInput) (*mmodel.KeyRotationResponse, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.rotate_encryption_keys")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	if err := payload.Validate(); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Validation failed", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Validation failed", libLog.Err(err))
+
+		return nil, err
+	}
+
+	tenantID, err := encryption.ResolveProvisionTenantID(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "reserved tenant id supplied", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "reserved tenant id supplied")
+
+		return nil, err
+	}
+
+	rotation, err := handler.RotationService.Rotate(ctx, encryption.RotateInput{
+		TenantID:       tenantID,
+		OrganizationID: organizationID.String(),
+		Actor:          payload.Actor,
+		Reason:         payload.Reason,
+	})
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to rotate encryption keys", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to rotate encryption keys", libLog.Err(err))
+
+		return nil, err
+	}
+
+	return mmodel.NewKeyRotationResponse(rotation), nil
+}
+
+// GetKeyRotation handles the retrieval of an organization's latest key rotation.
+func (handler *EncryptionHandler) GetKeyRotation(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	response, err := handler.getKeyRotation(c.UserContext(), organizationID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, response)
+}
+
+// getKeyRotation is the transport-agnostic core for the key rotation progress read.
+func (handler *EncryptionHandler) getKeyRotation(ctx context.Context, organizationID uuid.UUID) (*mmodel.KeyRotationResponse, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_key_rotation")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	rotation, err := handler.RotationService.GetRotation(ctx, organizationID.String())
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get key rotation", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to get key rotation", libLog.Err(err))
+
+		return nil, err
+	}
+
+	return mmodel.NewKeyRotationResponse(rotation), nil
+}
+
+// ResumeKeyRotation handles restarting the re-encryption of an organization's latest key rotation.
+func (handler *EncryptionHandler) ResumeKeyRotation(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	response, err := handler.resumeKeyRotation(c.UserContext(), organizationID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Accepted(c, response)
+}
+
+// resumeKeyRotation is the transport-agnostic core for resuming a key rotation's
+// re-encryption sweep.
+func (handler *EncryptionHandler) resumeKeyRotation(ctx context.Context, organizationID uuid.UUID) (*mmodel.KeyRotationResponse, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.resume_key_rotation")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	rotation, err := handler.RotationService.Resume(ctx, organizationID.String())
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to resume key rotation", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to resume key rotation", libLog.Err(err))
+
+		return nil, err
+	}
+
+	return mmodel.NewKeyRotationResponse(rotation), nil
+}
+
+// RetireKeys handles retiring an organization's superseded keyset versions.
+func (handler *EncryptionHandler) RetireKeys(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.RetireEncryptionKeysInput)
+	if !ok || payload == nil {
+		return http.WithError(c, cn.ErrInternalServer)
+	}
+
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	response, err := handler.retireKeys(c.UserContext(), organizationID, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, response)
+}
+
+// retireKeys is the transport-agnostic core for the keyset retirement operation.
+// Nothing is retired while records still reference a superseded version; the
+// response then lists the stale record counts.
+func (handler *EncryptionHandler) retireKeys(ctx context.Context, organizationID uuid.UUID, payload *mmodel.RetireEncryptionKeysInput) (*mmodel.RetireEncryptionKeysResponse, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.retire_encryption_keys")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	if err := payload.Validate(); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Validation failed", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Validation failed", libLog.Err(err))
+
+		return nil, err
+	}
+
+	tenantID, err := encryption.ResolveProvisionTenantID(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "reserved tenant id supplied", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "reserved tenant id supplied")
+
+		return nil, err
+	}
+
+	result, err := handler.RotationService.Retire(ctx, encryption.RetireInput{
+		TenantID:       tenantID,
+		OrganizationID: organizationID.String(),
+		Actor:          payload.Actor,
+		Reason:         payload.Reason,
+	})
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to retire encryption keys", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to retire encryption keys", libLog.Err(err))
+
+		return nil, err
+	}
+
+	return &mmodel.RetireEncryptionKeysResponse{
+		OrganizationID:   result.OrganizationID,
+		CurrentVersion:   result.CurrentVersion,
+		RetiredVersions:  result.RetiredVersions,
+		ReadableVersions: result.ReadableVersions,
+		StaleRecords:     result.StaleRecords,
+	}, nil
+}
@@ -15,7 +15,7 @@ import (
 )
 
 // This file is the ledger's Huma adoption of the CRM envelope-encryption
-// provisioning resource (provision + status). It mirrors the asset exemplar
+// resource (provision + status, key rotation + progress + resume, retirement). It mirrors the asset exemplar
 // (asset_handler_huma.go); see that file's header for the full conventions.
 // Encryption-specific notes:
 //
@@ -108,7 +108,132 @@ func (handler *EncryptionHandler) GetProvisioningStatusHuma(ctx context.Context,
 	return &GetProvisioningStatusOutputHuma{Status: http.StatusOK, Body: response}, nil
 }
 
-// RegisterEncryptionRoutes registers the two migrated encryption operations on the
+// --- POST /encryption/rotate -------------------------------------------------
+
+// RotateEncryptionKeysInputHuma is the Huma request envelope for the rotation POST.
+type RotateEncryptionKeysInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Authorization  string `header:"Authorization" doc:"Bearer token; only required when the auth plugin is enabled"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// KeyRotationOutputHuma pins 202 (matching http.Accepted): the re-encryption
+// continues in the background.
+type KeyRotationOutputHuma struct {
+	Status int
+	Body   *mmodel.KeyRotationResponse
+}
+
+// RotateKeysHuma decodes+validates the raw body imperatively then delegates to the
+// shared rotateKeys core.
+func (handler *EncryptionHandler) RotateKeysHuma(ctx context.Context, in *RotateEncryptionKeysInputHuma) (*KeyRotationOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.RotateEncryptionKeysInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	response, err := handler.rotateKeys(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &KeyRotationOutputHuma{Status: http.StatusAccepted, Body: response}, nil
+}
+
+// --- GET /encryption/rotation -------------------------------------------------
+
+// GetKeyRotationInputHuma is the rotation progress request envelope (org only).
+type GetKeyRotationInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Authorization  string `header:"Authorization" doc:"Bearer token; only required when the auth plugin is enabled"`
+}
+
+// GetKeyRotationOutputHuma carries the rotation verbatim (200, matching http.OK).
+type GetKeyRotationOutputHuma struct {
+	Status int
+	Body   *mmodel.KeyRotationResponse
+}
+
+// GetKeyRotationHuma delegates to the shared getKeyRotation core.
+func (handler *EncryptionHandler) GetKeyRotationHuma(ctx context.Context, in *GetKeyRotationInputHuma) (*GetKeyRotationOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	response, err := handler.getKeyRotation(ctx, orgID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &GetKeyRotationOutputHuma{Status: http.StatusOK, Body: response}, nil
+}
+
+// --- POST /encryption/rotation/resume -----------------------------------------
+
+// ResumeKeyRotationInputHuma is the resume request envelope (org only, no body).
+type ResumeKeyRotationInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Authorization  string `header:"Authorization" doc:"Bearer token; only required when the auth plugin is enabled"`
+}
+
+// ResumeKeyRotationHuma delegates to the shared resumeKeyRotation core.
+func (handler *EncryptionHandler) ResumeKeyRotationHuma(ctx context.Context, in *ResumeKeyRotationInputHuma) (*KeyRotationOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	response, err := handler.resumeKeyRotation(ctx, orgID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &KeyRotationOutputHuma{Status: http.StatusAccepted, Body: response}, nil
+}
+
+// --- POST /encryption/retire --------------------------------------------------
+
+// RetireEncryptionKeysInputHuma is the Huma request envelope for the retirement POST.
+type RetireEncryptionKeysInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Authorization  string `header:"Authorization" doc:"Bearer token; only required when the auth plugin is enabled"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// RetireEncryptionKeysOutputHuma carries the retirement outcome (200, matching http.OK).
+type RetireEncryptionKeysOutputHuma struct {
+	Status int
+	Body   *mmodel.RetireEncryptionKeysResponse
+}
+
+// RetireKeysHuma decodes+validates the raw body imperatively then delegates to the
+// shared retireKeys core.
+func (handler *EncryptionHandler) RetireKeysHuma(ctx context.Context, in *RetireEncryptionKeysInputHuma) (*RetireEncryptionKeysOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.RetireEncryptionKeysInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	response, err := handler.retireKeys(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &RetireEncryptionKeysOutputHuma{Status: http.StatusOK, Body: response}, nil
+}
+
+// RegisterEncryptionRoutes registers the encryption operations on the
 // shared Huma API. It is the per-file seam the unified server calls (conditionally,
 // only in envelope encryption mode — mirroring the Fiber `if eh != nil` guard in
 // crm_routes.go); the auth ("midaz","encryption",verb) + tenant +
@@ -119,6 +244,10 @@ func RegisterEncryptionRoutes(api huma.API, h *EncryptionHandler) {
 	const (
 		provisionPath = "/organizations/{organization_id}/encryption/provision"
 		statusPath    = "/organizations/{organization_id}/encryption/status"
+		rotatePath    = "/organizations/{organization_id}/encryption/rotate"
+		rotationPath  = "/organizations/{organization_id}/encryption/rotation"
+		resumePath    = "/organizations/{organization_id}/encryption/rotation/resume"
+		retirePath    = "/organizations/{organization_id}/encryption/retire"
 		tag           = "Encryption"
 	)
 
@@ -141,4 +270,47 @@ func RegisterEncryptionRoutes(api huma.API, h *EncryptionHandler) {
 		Tags:        []string{tag},
 		Security:    secEncryptionBearer,
 	}, h.GetProvisioningStatusHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "rotateEncryptionKeys",
+		Method:      http.MethodPost,
+		Path:        rotatePath,
+		Summary:     "Rotate an Organization's Encryption Keys",
+		Description: "Creates a new keyset version used for all new writes and starts re-encrypting the organization's existing records in the background.",
+		Tags:        []string{tag},
+		Security:    secEncryptionBearer,
+		// Body validated imperatively (http.DecodeAndValidate) — see file header.
+		SkipValidateBody: true,
+	}, h.RotateKeysHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getKeyRotation",
+		Method:      http.MethodGet,
+		Path:        rotationPath,
+		Summary:     "Get Key Rotation Progress",
+		Tags:        []string{tag},
+		Security:    secEncryptionBearer,
+	}, h.GetKeyRotationHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "resumeKeyRotation",
+		Method:      http.MethodPost,
+		Path:        resumePath,
+		Summary:     "Resume Key Rotation Re-encryption",
+		Description: "Restarts the re-encryption of the latest key rotation from its saved progress; a completed rotation is swept again.",
+		Tags:        []string{tag},
+		Security:    secEncryptionBearer,
+	}, h.ResumeKeyRotationHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "retireEncryptionKeys",
+		Method:      http.MethodPost,
+		Path:        retirePath,
+		Summary:     "Retire Superseded Encryption Keys",
+		Description: "Removes superseded keyset versions from the readable set once no record references them; otherwise reports the remaining record counts.",
+		Tags:        []string{tag},
+		Security:    secEncryptionBearer,
+		// Body validated imperatively (http.DecodeAndValidate) — see file header.
+		SkipValidateBody: true,
+	}, h.RetireKeysHuma)
 }
@@ -25,7 +25,7 @@ import (
 	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
 )
 
-// buildHumaEncryptionApp mounts the two encryption Huma operations on a /v1 group,
+// buildHumaEncryptionApp mounts the encryption Huma operations on a /v1 group,
 // faithfully mirroring the production wiring in unified-server.go: problem.Install()
 // runs before any huma.Register, the Huma API is built with openapi.New over a /v1
 // group, an auth-shim middleware stands in for auth.Authorize("midaz","encryption",
@@ -61,6 +61,10 @@ func buildHumaEncryptionApp(t *testing.T, handler *EncryptionHandler, authOK boo
 	parse := pkgHTTP.ParseUUIDPathParameters("organization")
 	apiV1.Post("/organizations/:organization_id/encryption/provision", parse)
 	apiV1.Get("/organizations/:organization_id/encryption/status", parse)
+	apiV1.Post("/organizations/:organization_id/encryption/rotate", parse)
+	apiV1.Get("/organizations/:organization_id/encryption/rotation", parse)
+	apiV1.Post("/organizations/:organization_id/encryption/rotation/resume", parse)
+	apiV1.Post("/organizations/:organization_id/encryption/retire", parse)
 
 	RegisterEncryptionRoutes(hAPI, handler)
 
@@ -0,0 +1,316 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+func testKeyRotation(orgID string) *mmodel.OrganizationKeyRotation {
+	return &mmodel.OrganizationKeyRotation{
+		TenantID:       "default",
+		OrganizationID: orgID,
+		FromVersion:    1,
+		ToVersion:      2,
+		Status:         mmodel.KeyRotationStatusRunning,
+		Targets: []mmodel.KeyRotationTargetProgress{
+			{Name: "holders", Total: 10, Processed: 4, Cursor: uuid.NewString()},
+			{Name: "instruments", Total: 5},
+		},
+		Revision:  3,
+		StartedBy: "admin@example.com",
+		Reason:    "Annual rotation",
+		StartedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+}
+
+func TestHuma_RotateEncryptionKeys_Accepted(t *testing.T) {
+	// NOT parallel: buildHumaEncryptionApp mutates process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	mockService := NewMockKeyRotationService(ctrl)
+	mockService.EXPECT().
+		Rotate(gomock.Any(), gomock.Cond(func(x any) bool {
+			req, ok := x.(encryption.RotateInput)
+			return ok && req.OrganizationID == orgID.String() &&
+				req.Actor == "admin@example.com" && req.Reason == "Annual rotation"
+		})).
+		Return(testKeyRotation(orgID.String()), nil).
+		Times(1)
+
+	handler := &EncryptionHandler{RotationService: mockService}
+	app := buildHumaEncryptionApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"actor": "admin@example.com", "reason": "Annual rotation"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/encryption/rotate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var got mmodel.KeyRotationResponse
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, orgID.String(), got.OrganizationID)
+	assert.Equal(t, 2, got.ToVersion)
+	assert.Equal(t, string(mmodel.KeyRotationStatusRunning), got.Status)
+	assert.NotContains(t, string(respBody), "cursor", "sweep cursors must not leak into the response")
+}
+
+func TestHuma_RotateEncryptionKeys_ValidationRejectedByCore(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	// No Rotate expectation: validation must fail before the service is called.
+	handler := &EncryptionHandler{RotationService: NewMockKeyRotationService(ctrl)}
+	app := buildHumaEncryptionApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"actor": "admin@example.com"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/encryption/rotate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestHuma_RotateEncryptionKeys_InProgressConflict(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	mockService := NewMockKeyRotationService(ctrl)
+	mockService.EXPECT().
+		Rotate(gomock.Any(), gomock.Any()).
+		Return(nil, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, encryption.EntityOrganizationEncryption)).
+		Times(1)
+
+	handler := &EncryptionHandler{RotationService: mockService}
+	app := buildHumaEncryptionApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"actor": "admin@example.com", "reason": "Annual rotation"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/encryption/rotate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestHuma_GetKeyRotation(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	tests := []struct {
+		name       string
+		rotation   *mmodel.OrganizationKeyRotation
+		err        error
+		wantStatus int
+	}{
+		{name: "found", rotation: testKeyRotation(""), wantStatus: http.StatusOK},
+		{name: "never rotated", err: pkg.ValidateBusinessError(constant.ErrKeyRotationNotFound, encryption.EntityOrganizationEncryption), wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			orgID := uuid.New()
+			if tt.rotation != nil {
+				tt.rotation.OrganizationID = orgID.String()
+			}
+
+			mockService := NewMockKeyRotationService(ctrl)
+			mockService.EXPECT().
+				GetRotation(gomock.Any(), orgID.String()).
+				Return(tt.rotation, tt.err).
+				Times(1)
+
+			handler := &EncryptionHandler{RotationService: mockService}
+			app := buildHumaEncryptionApp(t, handler, true)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/encryption/rotation", nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.rotation == nil {
+				return
+			}
+
+			var got mmodel.KeyRotationResponse
+			respBody, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+			require.Len(t, got.Targets, 2)
+			assert.Equal(t, "holders", got.Targets[0].Name)
+			assert.Equal(t, int64(10), got.Targets[0].Total)
+			assert.Equal(t, int64(4), got.Targets[0].Processed)
+		})
+	}
+}
+
+func TestHuma_ResumeKeyRotation_Accepted(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	mockService := NewMockKeyRotationService(ctrl)
+	mockService.EXPECT().
+		Resume(gomock.Any(), orgID.String()).
+		Return(testKeyRotation(orgID.String()), nil).
+		Times(1)
+
+	handler := &EncryptionHandler{RotationService: mockService}
+	app := buildHumaEncryptionApp(t, handler, true)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/encryption/rotation/resume", nil)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestHuma_RetireEncryptionKeys(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	mockService := NewMockKeyRotationService(ctrl)
+	mockService.EXPECT().
+		Retire(gomock.Any(), gomock.Cond(func(x any) bool {
+			req, ok := x.(encryption.RetireInput)
+			return ok && req.OrganizationID == orgID.String() && req.Actor == "admin@example.com"
+		})).
+		Return(encryption.RetireResult{
+			OrganizationID:   orgID.String(),
+			CurrentVersion:   2,
+			ReadableVersions: []int{1, 2},
+			StaleRecords:     map[string]int64{"holders": 3, "instruments": 0},
+		}, nil).
+		Times(1)
+
+	handler := &EncryptionHandler{RotationService: mockService}
+	app := buildHumaEncryptionApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"actor": "admin@example.com", "reason": "Rotation complete"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/encryption/retire", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got mmodel.RetireEncryptionKeysResponse
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Empty(t, got.RetiredVersions)
+	assert.Equal(t, []int{1, 2}, got.ReadableVersions)
+	assert.Equal(t, int64(3), got.StaleRecords["holders"])
+}
+
+// MockKeyRotationService is a mock implementation of the KeyRotationService interface.
+type MockKeyRotationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyRotationServiceRecorder
+}
+
+type MockKeyRotationServiceRecorder struct {
+	mock *MockKeyRotationService
+}
+
+func NewMockKeyRotationService(ctrl *gomock.Controller) *MockKeyRotationService {
+	mock := &MockKeyRotationService{ctrl: ctrl}
+	mock.recorder = &MockKeyRotationServiceRecorder{mock}
+	return mock
+}
+
+func (m *MockKeyRotationService) EXPECT() *MockKeyRotationServiceRecorder {
+	return m.recorder
+}
+
+func (m *MockKeyRotationService) Rotate(ctx context.Context, req encryption.RotateInput) (*mmodel.OrganizationKeyRotation, error) {
+	ret := m.ctrl.Call(m, "Rotate", ctx, req)
+	rotation, _ := ret[0].(*mmodel.OrganizationKeyRotation)
+	return rotation, errOrNil(ret[1])
+}
+
+func (mr *MockKeyRotationServiceRecorder) Rotate(ctx, req any) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockKeyRotationService)(nil).Rotate), ctx, req)
+}
+
+func (m *MockKeyRotationService) GetRotation(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	ret := m.ctrl.Call(m, "GetRotation", ctx, organizationID)
+	rotation, _ := ret[0].(*mmodel.OrganizationKeyRotation)
+	return rotation, errOrNil(ret[1])
+}
+
+func (mr *MockKeyRotationServiceRecorder) GetRotation(ctx, organizationID any) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRotation", reflect.TypeOf((*MockKeyRotationService)(nil).GetRotation), ctx, organizationID)
+}
+
+func (m *MockKeyRotationService) Resume(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	ret := m.ctrl.Call(m, "Resume", ctx, organizationID)
+	rotation, _ := ret[0].(*mmodel.OrganizationKeyRotation)
+	return rotation, errOrNil(ret[1])
+}
+
+func (mr *MockKeyRotationServiceRecorder) Resume(ctx, organizationID any) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockKeyRotationService)(nil).Resume), ctx, organizationID)
+}
+
+func (m *MockKeyRotationService) Retire(ctx context.Context, req encryption.RetireInput) (encryption.RetireResult, error) {
+	ret := m.ctrl.Call(m, "Retire", ctx, req)
+	return ret[0].(encryption.RetireResult), errOrNil(ret[1])
+}
+
+func (mr *MockKeyRotationServiceRecorder) Retire(ctx, req any) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retire", reflect.TypeOf((*MockKeyRotationService)(nil).Retire), ctx, req)
+}
@@ -129,6 +129,44 @@ func (s *stubBillingPackageService) DeleteBillingPackage(_ context.Context, id,
 	return s.deleteErr
 }
 
+func (s *stubPackageService) SchedulePackageVersion(_ context.Context, id, organizationID uuid.UUID, in *model.CreatePackageVersionInput) (*pack.Package, error) {
+	s.scheduleCalled = true
+	s.gotSchedule = in
+
+	return s.scheduleResult, s.scheduleErr
+}
+
+func (s *stubPackageService) CancelPackageVersion(_ context.Context, id, organizationID uuid.UUID, version int) error {
+	s.cancelCalled = true
+	s.gotCancelID = id
+	s.gotCancelVer = version
+
+	return s.cancelErr
+}
+
+func (s *stubPackageService) CreatePromotion(_ context.Context, id, organizationID uuid.UUID, in *model.CreatePromotionInput) (*pack.Package, error) {
+	s.promotionCalled = true
+	s.gotPromotion = in
+
+	return s.promotionResult, s.promotionErr
+}
+
+func (s *stubPackageService) DeletePromotion(_ context.Context, id, organizationID, promotionID uuid.UUID) error {
+	s.deletePromoCalled = true
+	s.gotPromotionID = promotionID
+
+	return s.promotionErr
+}
+
+func (s *stubPackageService) GetPromotionUsage(_ context.Context, id, organizationID, promotionID uuid.UUID, limit, page int) ([]*model.PromotionUsage, int64, error) {
+	s.usageCalled = true
+	s.gotPromotionID = promotionID
+	s.gotUsageLimit = limit
+	s.gotUsagePage = page
+
+	return s.usageResult, s.usageTotal, s.usageErr
+}
+
 func TestBillingPackageHandler_CreateBillingPackage(t *testing.T) {
 	orgUUID := uuid.New()
 
@@ -701,6 +739,12 @@ type stubFeeService struct {
 	result *model.FeeEstimateResult
 	err    error
 
+	quote      *model.FeeQuote
+	gotQuoteID uuid.UUID
+
+	revenue         *model.FeeRevenueReport
+	gotRevenueQuery *model.FeeRevenueQuery
+
 	gotEstimate *model.FeeEstimate
 	gotOrg      uuid.UUID
 	called      bool
@@ -714,6 +758,30 @@ func (s *stubFeeService) EstimateFeeCalculation(_ context.Context, cf *model.Fee
 	return s.result, s.err
 }
 
+func (s *stubFeeService) CreateFeeQuote(_ context.Context, in *model.FeeEstimate, organizationID uuid.UUID) (*model.FeeQuote, error) {
+	s.called = true
+	s.gotEstimate = in
+	s.gotOrg = organizationID
+
+	return s.quote, s.err
+}
+
+func (s *stubFeeService) GetFeeQuote(_ context.Context, id, organizationID uuid.UUID) (*model.FeeQuote, error) {
+	s.called = true
+	s.gotQuoteID = id
+	s.gotOrg = organizationID
+
+	return s.quote, s.err
+}
+
+func (s *stubFeeService) GetFeeRevenueReport(_ context.Context, organizationID uuid.UUID, query *model.FeeRevenueQuery) (*model.FeeRevenueReport, error) {
+	s.called = true
+	s.gotRevenueQuery = query
+	s.gotOrg = organizationID
+
+	return s.revenue, s.err
+}
+
 func TestFeeHandler_EstimateFeeCalculation(t *testing.T) {
 	orgUUID := uuid.New()
 	packageID := uuid.New()
@@ -825,6 +893,16 @@ type stubPackageService struct {
 
 	deleteErr error
 
+	scheduleResult *pack.Package
+	scheduleErr    error
+	cancelErr      error
+
+	promotionResult *pack.Package
+	promotionErr    error
+	usageResult     []*model.PromotionUsage
+	usageTotal      int64
+	usageErr        error
+
 	gotCreate       *model.CreatePackageInput
 	gotCreateLedger uuid.UUID
 	gotCreateSeg    uuid.UUID
@@ -833,9 +911,22 @@ type stubPackageService struct {
 	gotGetByIDID    uuid.UUID
 	gotUpdate       *model.UpdatePackageInput
 	gotDeleteID     uuid.UUID
+	gotSchedule     *model.CreatePackageVersionInput
+	gotCancelID     uuid.UUID
+	gotCancelVer    int
 	createCalled    bool
 	updateCalled    bool
 	deleteCalled    bool
+	scheduleCalled  bool
+	cancelCalled    bool
+
+	gotPromotion      *model.CreatePromotionInput
+	gotPromotionID    uuid.UUID
+	gotUsageLimit     int
+	gotUsagePage      int
+	promotionCalled   bool
+	deletePromoCalled bool
+	usageCalled       bool
 }
 
 func (s *stubPackageService) CreatePackage(_ context.Context, cpi *model.CreatePackageInput, organizationID, ledgerID, segmentID uuid.UUID) (*pack.Package, error) {
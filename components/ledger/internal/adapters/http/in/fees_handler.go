@@ -20,14 +20,19 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-// FeeService defines the fee-estimate operation consumed by the fee handler. In
-// the unified binary the fee calculation itself runs in-process via the
-// transaction seam, so only the dry-run estimate is exposed over HTTP.
+// FeeService defines the fee-estimate, fee-quote and fee revenue operations
+// consumed by the fee handler. In the unified binary the fee calculation itself
+// runs in-process via the transaction seam, so only the dry-run estimate, the
+// quotes that freeze one and the revenue report are exposed over HTTP.
 type FeeService interface {
 	EstimateFeeCalculation(ctx context.Context, cf *model.FeeEstimate, organizationID uuid.UUID) (*model.FeeEstimateResult, error)
+	CreateFeeQuote(ctx context.Context, in *model.FeeEstimate, organizationID uuid.UUID) (*model.FeeQuote, error)
+	GetFeeQuote(ctx context.Context, id, organizationID uuid.UUID) (*model.FeeQuote, error)
+	GetFeeRevenueReport(ctx context.Context, organizationID uuid.UUID, query *model.FeeRevenueQuery) (*model.FeeRevenueReport, error)
 }
 
-// FeeHandler exposes the fee-estimate (dry-run) endpoint over HTTP.
+// FeeHandler exposes the fee-estimate (dry-run), fee-quote and fee revenue
+// endpoints over HTTP.
 type FeeHandler struct {
 	Service FeeService
 }
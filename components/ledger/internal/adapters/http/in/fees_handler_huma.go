@@ -132,6 +132,134 @@ func (handler *FeeHandler) EstimateFeeCalculationHuma(ctx context.Context, in *E
 	return &EstimateFeeOutputHuma{Status: http.StatusOK, Body: body}, nil
 }
 
+// --- POST /quotes --------------------------------------------------------------
+
+// CreateFeeQuoteInputHuma is the create-quote request envelope. The body is the
+// estimate payload (RawBody, see EstimateFeeInputHuma).
+type CreateFeeQuoteInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// FeeQuoteOutputHuma carries a quote. Body is pre-serialized for the same reason
+// as EstimateFeeOutputHuma: the quote embeds the fee-adjusted transaction tree.
+type FeeQuoteOutputHuma struct {
+	Status int
+	Body   []byte `contentType:"application/json"`
+}
+
+// CreateFeeQuoteHuma decodes+validates the raw body with the fee-package validator
+// then delegates to the shared createFeeQuote core.
+func (handler *FeeHandler) CreateFeeQuoteHuma(ctx context.Context, in *CreateFeeQuoteInputHuma) (*FeeQuoteOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.FeeEstimate)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	quote, err := handler.createFeeQuote(ctx, orgID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return feeQuoteOutput(http.StatusCreated, quote)
+}
+
+// --- GET /quotes/{id} -----------------------------------------------------------
+
+// GetFeeQuoteInputHuma is the get-quote request envelope.
+type GetFeeQuoteInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Fee quote ID (UUID)"`
+}
+
+// GetFeeQuoteHuma delegates to the shared getFeeQuote core.
+func (handler *FeeHandler) GetFeeQuoteHuma(ctx context.Context, in *GetFeeQuoteInputHuma) (*FeeQuoteOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.ID, "id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	quote, err := handler.getFeeQuote(ctx, orgID, id)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return feeQuoteOutput(http.StatusOK, quote)
+}
+
+// feeQuoteOutput serializes a quote into its output envelope.
+func feeQuoteOutput(status int, quote *model.FeeQuote) (*FeeQuoteOutputHuma, error) {
+	body, err := json.Marshal(quote)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(feeerrors.ValidateInternalError(feeconstant.ErrInternalServer, feeconstant.EntityFeeQuote))
+	}
+
+	return &FeeQuoteOutputHuma{Status: status, Body: body}, nil
+}
+
+// --- GET /fee-revenue -------------------------------------------------------------
+
+// GetFeeRevenueReportInputHuma is the fee revenue report request envelope.
+type GetFeeRevenueReportInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	From           string `query:"from" doc:"Include fees from this date (yyyy-mm-dd or RFC3339, default: start of the current month)"`
+	To             string `query:"to" doc:"Include fees before this date; a date-only value includes the whole day (default: now)"`
+	LedgerID       string `query:"ledgerId" doc:"Only fees charged on this ledger (UUID)"`
+	PackageID      string `query:"packageId" doc:"Only fees of this package (UUID)"`
+	FeeLabel       string `query:"feeLabel" doc:"Only fees with this label"`
+	Route          string `query:"route" doc:"Only fees of transactions on this route"`
+	SegmentID      string `query:"segmentId" doc:"Only fees of packages scoped to this segment (UUID)"`
+	Asset          string `query:"asset" doc:"Only fees in this asset"`
+	GroupBy        string `query:"groupBy" doc:"Comma-separated dimensions to group by (package, feeLabel, route, segment). Rows are always split by asset."`
+	Period         string `query:"period" doc:"Split rows by day, month or none (default: month)"`
+	Format         string `query:"format" doc:"Output format (json, csv; default: json)"`
+}
+
+// FeeRevenueReportOutputHuma carries the report. The body is pre-encoded so the
+// same operation can serve JSON (model.FeeRevenueReport) or CSV.
+type FeeRevenueReportOutputHuma struct {
+	Status      int
+	ContentType string `header:"Content-Type"`
+	Body        []byte
+}
+
+// GetFeeRevenueReportHuma delegates to the shared getFeeRevenueReport core.
+func (handler *FeeHandler) GetFeeRevenueReportHuma(ctx context.Context, in *GetFeeRevenueReportInputHuma) (*FeeRevenueReportOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	body, contentType, err := handler.getFeeRevenueReport(ctx, orgID, FeeRevenueReportQuery{
+		From:      in.From,
+		To:        in.To,
+		LedgerID:  in.LedgerID,
+		PackageID: in.PackageID,
+		FeeLabel:  in.FeeLabel,
+		Route:     in.Route,
+		SegmentID: in.SegmentID,
+		Asset:     in.Asset,
+		GroupBy:   in.GroupBy,
+		Period:    in.Period,
+		Format:    in.Format,
+	})
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &FeeRevenueReportOutputHuma{Status: http.StatusOK, ContentType: contentType, Body: body}, nil
+}
+
 // RegisterFeeEstimateRoutes registers the migrated fee-estimate operation on the
 // shared Huma API. It is the per-file seam the unified server calls; the auth
 // ("plugin-fees","estimates","post") + tenant + ParseUUIDPathParameters("estimates")
@@ -150,3 +278,48 @@ func RegisterFeeEstimateRoutes(api huma.API, h *FeeHandler) {
 		SkipValidateBody: true,
 	}, h.EstimateFeeCalculationHuma)
 }
+
+// RegisterFeeQuoteRoutes registers the two fee-quote operations on the shared Huma
+// API. The ("plugin-fees","quotes",verb) + tenant + ParseUUIDPathParameters("quotes")
+// chain is attached on the /v1 group BEFORE the Huma terminal, as for estimates.
+func RegisterFeeQuoteRoutes(api huma.API, h *FeeHandler) {
+	const quotesPath = "/organizations/{organization_id}/quotes"
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "createFeeQuote",
+		Method:        http.MethodPost,
+		Path:          quotesPath,
+		Summary:       "Create a fee quote",
+		Description:   "Estimates the fees of a transaction and freezes them until the quote expires. A transaction created with the quote's feeQuoteId is charged the quoted fees instead of recalculated ones; each quote can be used once.",
+		Tags:          []string{"Fees"},
+		Security:      secFeeBearer,
+		DefaultStatus: http.StatusCreated,
+		// Body validated imperatively (feehttp.DecodeValidateBody) — see file header.
+		SkipValidateBody: true,
+	}, h.CreateFeeQuoteHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getFeeQuote",
+		Method:      http.MethodGet,
+		Path:        quotesPath + "/{id}",
+		Summary:     "Retrieve a fee quote",
+		Description: "Returns the quoted fees, the expiry and whether the quote is open, used or expired.",
+		Tags:        []string{"Fees"},
+		Security:    secFeeBearer,
+	}, h.GetFeeQuoteHuma)
+}
+
+// RegisterFeeRevenueRoutes registers the fee revenue report on the shared Huma
+// API. The ("plugin-fees","fee-revenue","get") + tenant chain is attached on the
+// /v1 group BEFORE the Huma terminal, as for estimates.
+func RegisterFeeRevenueRoutes(api huma.API, h *FeeHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "getFeeRevenueReport",
+		Method:      http.MethodGet,
+		Path:        "/organizations/{organization_id}/fee-revenue",
+		Summary:     "Report fee revenue",
+		Description: "Sums the fees charged, refunded by reverts and waived by exemptions or promotions over a range, split by period and asset and optionally grouped by package, fee label, route or segment. Returns JSON, or CSV with format=csv.",
+		Tags:        []string{"Fees"},
+		Security:    secFeeBearer,
+	}, h.GetFeeRevenueReportHuma)
+}
@@ -11,11 +11,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
 	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -41,7 +43,7 @@ func feesAuthShim(authOK bool) fiber.Handler {
 // The stubPackageService / stubFeeService fakes and the validCreatePackageInput
 // helper live in fees_billing_handlers_test.go; these Huma tests reuse them.
 
-// buildHumaPackageApp mounts the five package Huma operations on a /v1 group,
+// buildHumaPackageApp mounts the seven package Huma operations on a /v1 group,
 // mirroring production (fees_routes.go/unified-server.go): problem.Install() before
 // any huma.Register, the Huma API built with openapi.New over a /v1 group, an
 // auth-shim standing in for auth.Authorize("plugin-fees","packages",verb) + tenant,
@@ -74,6 +76,11 @@ func buildHumaPackageApp(t *testing.T, handler *PackageHandler, authOK bool) *fi
 	apiV1.Get(idPath, parse)
 	apiV1.Patch(idPath, parse)
 	apiV1.Delete(idPath, parse)
+	apiV1.Post(idPath+"/versions", parse)
+	apiV1.Delete(idPath+"/versions/:version", parse)
+	apiV1.Post(idPath+"/promotions", parse)
+	apiV1.Delete(idPath+"/promotions/:promotion_id", parse)
+	apiV1.Get(idPath+"/promotions/:promotion_id/usage", parse)
 
 	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
 
@@ -105,6 +112,56 @@ func buildHumaFeeEstimateApp(t *testing.T, handler *FeeHandler, authOK bool) *fi
 	return f
 }
 
+// buildHumaFeeQuoteApp mounts the two fee-quote Huma operations.
+func buildHumaFeeQuoteApp(t *testing.T, handler *FeeHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+
+	apiV1.Use(feesAuthShim(true))
+
+	parse := pkgHTTP.ParseUUIDPathParameters("quotes")
+
+	apiV1.Post("/organizations/:organization_id/quotes", parse)
+	apiV1.Get("/organizations/:organization_id/quotes/:id", parse)
+
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	RegisterFeeQuoteRoutes(hAPI, handler)
+
+	return f
+}
+
+// buildHumaFeeRevenueApp mounts the fee revenue report Huma operation.
+func buildHumaFeeRevenueApp(t *testing.T, handler *FeeHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+
+	apiV1.Use(feesAuthShim(true))
+	apiV1.Get("/organizations/:organization_id/fee-revenue", pkgHTTP.ParseUUIDPathParameters("fee-revenue"))
+
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	RegisterFeeRevenueRoutes(hAPI, handler)
+
+	return f
+}
+
 func validLedgerUUID() string { return "00000000-0000-0000-0000-000000000009" }
 
 func TestHuma_CreatePackage_Success(t *testing.T) {
@@ -416,3 +473,463 @@ func validSendJSON() string {
 func validCreatePackageJSON() string {
 	return `{"feeGroupLabel":"Standard","ledgerId":"` + validLedgerUUID() + `","minimumAmount":"100.00","maximumAmount":"1000.00","enable":true,"fees":{"f1":{"feeLabel":"Admin","referenceAmount":"afterFeesAmount","priority":2,"isDeductibleFrom":false,"creditAccount":"conta_receita","calculationModel":{"applicationRule":"flatFee","calculations":[{"type":"flat","value":"50.00"}]}}}}`
 }
+
+func TestHuma_SchedulePackageVersion_Success(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+
+	stub := &stubPackageService{scheduleResult: &pack.Package{ID: packID, Version: 1}}
+	handler := &PackageHandler{Service: stub}
+
+	app := buildHumaPackageApp(t, handler, true)
+
+	body := `{"effectiveFrom":"2030-01-01T00:00:00Z","minimumAmount":"10","maximumAmount":"500"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/versions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode, "body: %s", string(respBody))
+	require.True(t, stub.scheduleCalled)
+	require.NotNil(t, stub.gotSchedule.MinAmount)
+	assert.Equal(t, "10", *stub.gotSchedule.MinAmount)
+	assert.Equal(t, 2030, stub.gotSchedule.EffectiveFrom.Year())
+}
+
+func TestHuma_SchedulePackageVersion_MinAboveMax_NoServiceCall(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+
+	stub := &stubPackageService{}
+	handler := &PackageHandler{Service: stub}
+
+	app := buildHumaPackageApp(t, handler, true)
+
+	body := `{"effectiveFrom":"2030-01-01T00:00:00Z","minimumAmount":"900","maximumAmount":"10"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/versions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, "body: %s", string(respBody))
+	assert.False(t, stub.scheduleCalled)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, constant.ErrMinAmountGreaterThanMaxAmount.Error(), got["code"])
+}
+
+func TestHuma_CancelPackageVersion(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+
+	tests := []struct {
+		name       string
+		version    string
+		stubErr    error
+		wantStatus int
+		wantCode   string
+		wantCalled bool
+	}{
+		{name: "cancels a scheduled version", version: "3", wantStatus: http.StatusNoContent, wantCalled: true},
+		{name: "non-numeric version", version: "latest", wantStatus: http.StatusBadRequest, wantCode: constant.ErrInvalidPathParameter.Error()},
+		{
+			name:       "version already in force",
+			version:    "1",
+			stubErr:    pkg.ValidateBusinessError(constant.ErrPackageVersionNotInFuture, constant.EntityPackage),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   constant.ErrPackageVersionNotInFuture.Error(),
+			wantCalled: true,
+		},
+		{
+			name:       "unknown version",
+			version:    "9",
+			stubErr:    pkg.ValidateBusinessError(constant.ErrPackageVersionNotFound, constant.EntityPackage, "9"),
+			wantStatus: http.StatusNotFound,
+			wantCode:   constant.ErrPackageVersionNotFound.Error(),
+			wantCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubPackageService{cancelErr: tt.stubErr}
+			app := buildHumaPackageApp(t, &PackageHandler{Service: stub}, true)
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/versions/"+tt.version, nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "body: %s", string(respBody))
+			assert.Equal(t, tt.wantCalled, stub.cancelCalled)
+
+			if tt.wantCode != "" {
+				var got map[string]any
+				require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+				assert.Equal(t, tt.wantCode, got["code"])
+			}
+
+			if tt.wantStatus == http.StatusNoContent {
+				assert.Equal(t, packID, stub.gotCancelID)
+				assert.Equal(t, 3, stub.gotCancelVer)
+			}
+		})
+	}
+}
+
+func TestHuma_CreatePackagePromotion_Success(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+
+	stub := &stubPackageService{promotionResult: &pack.Package{ID: packID}}
+	app := buildHumaPackageApp(t, &PackageHandler{Service: stub}, true)
+
+	body := `{"label":"Launch","accountAlias":"@customer","freeTransactions":10,"period":"month","endsAt":"2030-01-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/promotions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode, "body: %s", string(respBody))
+	require.True(t, stub.promotionCalled)
+	require.NotNil(t, stub.gotPromotion.FreeTransactions)
+	assert.Equal(t, 10, *stub.gotPromotion.FreeTransactions)
+	assert.Equal(t, "month", stub.gotPromotion.Period)
+}
+
+func TestHuma_CreatePackagePromotion_UnknownPeriod_NoServiceCall(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+
+	stub := &stubPackageService{}
+	app := buildHumaPackageApp(t, &PackageHandler{Service: stub}, true)
+
+	body := `{"label":"Launch","accountAlias":"@customer","period":"fortnight"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/promotions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+	assert.False(t, stub.promotionCalled)
+}
+
+func TestHuma_DeletePackagePromotion(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+	promotionID := uuid.New()
+
+	tests := []struct {
+		name        string
+		promotionID string
+		stubErr     error
+		wantStatus  int
+		wantCode    string
+		wantCalled  bool
+	}{
+		{name: "deletes the promotion", promotionID: promotionID.String(), wantStatus: http.StatusNoContent, wantCalled: true},
+		{name: "malformed promotion id", promotionID: "launch", wantStatus: http.StatusBadRequest, wantCode: constant.ErrInvalidPathParameter.Error()},
+		{
+			name:        "unknown promotion",
+			promotionID: promotionID.String(),
+			stubErr:     pkg.ValidateBusinessError(constant.ErrPromotionNotFound, constant.EntityPackage, promotionID.String()),
+			wantStatus:  http.StatusNotFound,
+			wantCode:    constant.ErrPromotionNotFound.Error(),
+			wantCalled:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubPackageService{promotionErr: tt.stubErr}
+			app := buildHumaPackageApp(t, &PackageHandler{Service: stub}, true)
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/promotions/"+tt.promotionID, nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "body: %s", string(respBody))
+			assert.Equal(t, tt.wantCalled, stub.deletePromoCalled)
+
+			if tt.wantCode != "" {
+				var got map[string]any
+				require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+				assert.Equal(t, tt.wantCode, got["code"])
+			}
+
+			if tt.wantCalled {
+				assert.Equal(t, promotionID, stub.gotPromotionID)
+			}
+		})
+	}
+}
+
+func TestHuma_ListPackagePromotionUsage(t *testing.T) {
+	orgID := uuid.New()
+	packID := uuid.New()
+	promotionID := uuid.New()
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCalled bool
+		wantLimit  int
+		wantPage   int
+	}{
+		{name: "defaults", wantStatus: http.StatusOK, wantCalled: true, wantLimit: 10, wantPage: 1},
+		{name: "explicit page", query: "?limit=5&page=3", wantStatus: http.StatusOK, wantCalled: true, wantLimit: 5, wantPage: 3},
+		{name: "limit above maximum", query: "?limit=500", wantStatus: http.StatusBadRequest},
+		{name: "non-numeric page", query: "?page=last", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubPackageService{
+				usageResult: []*model.PromotionUsage{{PromotionID: promotionID.String(), Subject: "@customer", Period: "2026-10", Transactions: 2}},
+				usageTotal:  1,
+			}
+			app := buildHumaPackageApp(t, &PackageHandler{Service: stub}, true)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/packages/"+packID.String()+"/promotions/"+promotionID.String()+"/usage"+tt.query, nil)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "body: %s", string(respBody))
+			assert.Equal(t, tt.wantCalled, stub.usageCalled)
+
+			if !tt.wantCalled {
+				return
+			}
+
+			assert.Equal(t, tt.wantLimit, stub.gotUsageLimit)
+			assert.Equal(t, tt.wantPage, stub.gotUsagePage)
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+			assert.EqualValues(t, 1, got["total"])
+			assert.Len(t, got["items"], 1)
+		})
+	}
+}
+
+func TestHuma_CreateFeeQuote_Success(t *testing.T) {
+	orgID := uuid.New()
+	quoteID := uuid.New()
+
+	quote := &model.FeeQuote{
+		ID:          quoteID.String(),
+		Status:      model.FeeQuoteStatusOpen,
+		FeesApplied: model.FeeEstimateResult{Transaction: model.FeeAdjustedTransaction{Metadata: map[string]any{"packageAppliedID": "abc"}}},
+	}
+
+	stub := &stubFeeService{quote: quote}
+	app := buildHumaFeeQuoteApp(t, &FeeHandler{Service: stub})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/quotes", bytes.NewBufferString(estimateBodyJSON()))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode, "body: %s", string(respBody))
+	assert.True(t, stub.called)
+	assert.Equal(t, orgID, stub.gotOrg)
+	require.NotNil(t, stub.gotEstimate)
+	assert.Equal(t, validLedgerUUID(), stub.gotEstimate.LedgerID.String())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(respBody, &got), "body: %s", string(respBody))
+	assert.Equal(t, quoteID.String(), got["id"])
+	assert.Equal(t, model.FeeQuoteStatusOpen, got["status"])
+	assert.NotNil(t, got["feesApplied"])
+	assert.NotContains(t, got, "send", "the quoted send is internal")
+}
+
+func TestHuma_CreateFeeQuote_MalformedBody_NoServiceCall(t *testing.T) {
+	stub := &stubFeeService{}
+	app := buildHumaFeeQuoteApp(t, &FeeHandler{Service: stub})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+uuid.New().String()+"/quotes", bytes.NewBufferString(`{"packageId":`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.False(t, stub.called)
+}
+
+func TestHuma_GetFeeQuote(t *testing.T) {
+	orgID := uuid.New()
+	quoteID := uuid.New()
+
+	t.Run("found", func(t *testing.T) {
+		stub := &stubFeeService{quote: &model.FeeQuote{ID: quoteID.String(), Status: model.FeeQuoteStatusUsed}}
+		app := buildHumaFeeQuoteApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/quotes/"+quoteID.String(), nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+		assert.Equal(t, quoteID, stub.gotQuoteID)
+		assert.Equal(t, orgID, stub.gotOrg)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(respBody, &got))
+		assert.Equal(t, model.FeeQuoteStatusUsed, got["status"])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		stub := &stubFeeService{err: pkg.ValidateBusinessError(constant.ErrFeeQuoteNotFound, constant.EntityFeeQuote, quoteID.String())}
+		app := buildHumaFeeQuoteApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/quotes/"+quoteID.String(), nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, "body: %s", string(respBody))
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(respBody, &got))
+		assert.Equal(t, constant.ErrFeeQuoteNotFound.Error(), got["code"])
+	})
+}
+
+func TestHuma_GetFeeRevenueReport(t *testing.T) {
+	orgID := uuid.New()
+	packageID := uuid.New()
+
+	report := &model.FeeRevenueReport{
+		From:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		GroupBy: []string{model.FeeRevenueByPackage},
+		Period:  model.FeeRevenuePeriodMonth,
+		Items: []*model.FeeRevenueRow{{
+			Period:          "2026-10",
+			PackageID:       packageID.String(),
+			Asset:           "BRL",
+			Charged:         decimal.RequireFromString("12.50"),
+			Refunded:        decimal.RequireFromString("2.50"),
+			Net:             decimal.RequireFromString("10"),
+			Waived:          decimal.RequireFromString("3"),
+			WaivedExemption: decimal.RequireFromString("1"),
+			WaivedPromotion: decimal.RequireFromString("2"),
+			Transactions:    4,
+		}},
+	}
+
+	path := "/v1/organizations/" + orgID.String() + "/fee-revenue?from=2026-10-01&to=2026-10-31&groupBy=package&packageId=" + packageID.String()
+
+	t.Run("json", func(t *testing.T) {
+		stub := &stubFeeService{revenue: report}
+		app := buildHumaFeeRevenueApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+		assert.Contains(t, resp.Header.Get("Content-Type"), "application/json")
+		assert.Equal(t, orgID, stub.gotOrg)
+
+		require.NotNil(t, stub.gotRevenueQuery)
+		assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), stub.gotRevenueQuery.From)
+		assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), stub.gotRevenueQuery.To, "a date-only to includes the whole day")
+		assert.Equal(t, []string{model.FeeRevenueByPackage}, stub.gotRevenueQuery.GroupBy)
+		assert.Equal(t, model.FeeRevenuePeriodMonth, stub.gotRevenueQuery.Period)
+		assert.Equal(t, packageID.String(), stub.gotRevenueQuery.PackageID)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(respBody, &got))
+		require.Len(t, got["items"], 1)
+		item := got["items"].([]any)[0].(map[string]any)
+		assert.Equal(t, "10", item["net"])
+		assert.EqualValues(t, 4, item["transactions"])
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		stub := &stubFeeService{revenue: report}
+		app := buildHumaFeeRevenueApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path+"&format=csv", nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t,
+			"period,package_id,fee_label,route,segment_id,asset,charged,refunded,net,waived,waived_exemption,waived_promotion,transactions\n"+
+				"2026-10,"+packageID.String()+",,,,BRL,12.5,2.5,10,3,1,2,4\n",
+			string(respBody))
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		stub := &stubFeeService{revenue: report}
+		app := buildHumaFeeRevenueApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path+"&format=xlsx", nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.False(t, stub.called)
+	})
+
+	t.Run("invalid package id", func(t *testing.T) {
+		stub := &stubFeeService{revenue: report}
+		app := buildHumaFeeRevenueApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/fee-revenue?packageId=nope", nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.False(t, stub.called)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		stub := &stubFeeService{err: pkg.ValidateBusinessError(constant.ErrFeeRevenueRangeInvalid, constant.EntityFeeRevenue)}
+		app := buildHumaFeeRevenueApp(t, &FeeHandler{Service: stub})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/v1/organizations/"+orgID.String()+"/fee-revenue?from=2026-10-10&to=2026-10-01", nil), -1)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "body: %s", string(respBody))
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(respBody, &got))
+		assert.Equal(t, constant.ErrFeeRevenueRangeInvalid.Error(), got["code"])
+	})
+}
@@ -34,6 +34,11 @@ type PackageService interface {
 	GetPackageByID(ctx context.Context, id, organizationID uuid.UUID) (*pack.Package, error)
 	UpdatePackageByID(ctx context.Context, id, organizationID uuid.UUID, up *model.UpdatePackageInput) error
 	DeletePackageByID(ctx context.Context, id, organizationID uuid.UUID) error
+	SchedulePackageVersion(ctx context.Context, id, organizationID uuid.UUID, in *model.CreatePackageVersionInput) (*pack.Package, error)
+	CancelPackageVersion(ctx context.Context, id, organizationID uuid.UUID, version int) error
+	CreatePromotion(ctx context.Context, id, organizationID uuid.UUID, in *model.CreatePromotionInput) (*pack.Package, error)
+	DeletePromotion(ctx context.Context, id, organizationID, promotionID uuid.UUID) error
+	GetPromotionUsage(ctx context.Context, id, organizationID, promotionID uuid.UUID, limit, page int) ([]*model.PromotionUsage, int64, error)
 }
 
 // PackageHandler exposes the fee-package CRUD surface over HTTP.
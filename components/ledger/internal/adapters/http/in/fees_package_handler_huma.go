@@ -10,6 +10,7 @@ import (
 	"net/url"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
 
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
 	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
@@ -236,17 +237,220 @@ func (handler *PackageHandler) DeletePackageByIDHuma(ctx context.Context, in *Ge
 	return &DeletePackageOutputHuma{}, nil
 }
 
-// RegisterPackageRoutes registers the five migrated fee-package operations on the
-// shared Huma API. It is the per-file seam the unified server calls; the auth
+// --- POST /packages/{id}/versions --------------------------------------------
+
+// SchedulePackageVersionInputHuma is the schedule-version request envelope (RawBody,
+// see Create).
+type SchedulePackageVersionInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Package ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// SchedulePackageVersionOutputHuma carries the package resolved at the current
+// time, with the new version listed in its history (201).
+type SchedulePackageVersionOutputHuma struct {
+	Status int
+	Body   *pack.Package
+}
+
+// SchedulePackageVersionHuma decodes+validates the raw body imperatively (fee
+// validator, inside the replicated body-parsing span) then delegates to the shared
+// schedulePackageVersion core.
+func (handler *PackageHandler) SchedulePackageVersionHuma(ctx context.Context, in *SchedulePackageVersionInputHuma) (*SchedulePackageVersionOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.ID, "id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.CreatePackageVersionInput)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	packOut, err := handler.schedulePackageVersion(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &SchedulePackageVersionOutputHuma{Status: http.StatusCreated, Body: packOut}, nil
+}
+
+// --- DELETE /packages/{id}/versions/{version} ---------------------------------
+
+// CancelPackageVersionInputHuma is the cancel-version request envelope. version is
+// a string so the core, not Huma, owns its validation.
+type CancelPackageVersionInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Package ID (UUID)"`
+	Version        string `path:"version" doc:"Package version number"`
+}
+
+// CancelPackageVersionHuma delegates to cancelPackageVersion; returns a bodiless 204
+// on success.
+func (handler *PackageHandler) CancelPackageVersionHuma(ctx context.Context, in *CancelPackageVersionInputHuma) (*DeletePackageOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.ID, "id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	if err := handler.cancelPackageVersion(ctx, orgID, id, in.Version); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &DeletePackageOutputHuma{}, nil
+}
+
+// --- POST /packages/{id}/promotions ------------------------------------------
+
+// CreatePackagePromotionInputHuma is the create-promotion request envelope (RawBody,
+// see Create).
+type CreatePackagePromotionInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Package ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// CreatePackagePromotionOutputHuma carries the package with the new promotion (201).
+type CreatePackagePromotionOutputHuma struct {
+	Status int
+	Body   *pack.Package
+}
+
+// CreatePackagePromotionHuma decodes+validates the raw body imperatively then
+// delegates to the shared createPackagePromotion core.
+func (handler *PackageHandler) CreatePackagePromotionHuma(ctx context.Context, in *CreatePackagePromotionInputHuma) (*CreatePackagePromotionOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.ID, "id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(model.CreatePromotionInput)
+	if err := decodeFeeBodyInSpan(ctx, in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	packOut, err := handler.createPackagePromotion(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &CreatePackagePromotionOutputHuma{Status: http.StatusCreated, Body: packOut}, nil
+}
+
+// --- DELETE /packages/{id}/promotions/{promotion_id} ---------------------------
+
+// PackagePromotionInputHuma is the envelope of the ops addressing one promotion.
+type PackagePromotionInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Package ID (UUID)"`
+	PromotionID    string `path:"promotion_id" doc:"Promotion ID (UUID)"`
+}
+
+// DeletePackagePromotionHuma delegates to deletePackagePromotion; returns a bodiless
+// 204 on success.
+func (handler *PackageHandler) DeletePackagePromotionHuma(ctx context.Context, in *PackagePromotionInputHuma) (*DeletePackageOutputHuma, error) {
+	orgID, id, promotionID, err := parsePromotionPath(in.OrganizationID, in.ID, in.PromotionID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	if err := handler.deletePackagePromotion(ctx, orgID, id, promotionID); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &DeletePackageOutputHuma{}, nil
+}
+
+// --- GET /packages/{id}/promotions/{promotion_id}/usage ------------------------
+
+// ListPackagePromotionUsageInputHuma advertises the list query params in the spec
+// (doc-only) and captures the raw query via Resolve for the imperative binder.
+type ListPackagePromotionUsageInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Package ID (UUID)"`
+	PromotionID    string `path:"promotion_id" doc:"Promotion ID (UUID)"`
+	Limit          string `query:"limit" doc:"Number of items per page (default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler; validation stays in the
+// listPackagePromotionUsage core.
+func (in *ListPackagePromotionUsageInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// ListPackagePromotionUsageOutputHuma carries the pagination envelope verbatim.
+type ListPackagePromotionUsageOutputHuma struct {
+	Status int
+	Body   model.Pagination
+}
+
+// ListPackagePromotionUsageHuma delegates to listPackagePromotionUsage.
+func (handler *PackageHandler) ListPackagePromotionUsageHuma(ctx context.Context, in *ListPackagePromotionUsageInputHuma) (*ListPackagePromotionUsageOutputHuma, error) {
+	orgID, id, promotionID, err := parsePromotionPath(in.OrganizationID, in.ID, in.PromotionID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.listPackagePromotionUsage(ctx, orgID, id, promotionID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListPackagePromotionUsageOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// parsePromotionPath re-parses the org, package and promotion path params.
+func parsePromotionPath(orgStr, idStr, promotionStr string) (orgID, id, promotionID uuid.UUID, err error) {
+	orgID, err = parseOrg(orgStr)
+	if err != nil {
+		return orgID, id, promotionID, err
+	}
+
+	id, err = parsePathUUID(idStr, "id")
+	if err != nil {
+		return orgID, id, promotionID, err
+	}
+
+	promotionID, err = parsePathUUID(promotionStr, "promotion_id")
+
+	return orgID, id, promotionID, err
+}
+
+// RegisterPackageRoutes registers the ten fee-package operations (CRUD, version
+// scheduling and promotions) on the shared Huma API. It is the per-file seam the unified server calls; the auth
 // ("plugin-fees","packages",verb) + tenant + ParseUUIDPathParameters("packages")
 // middleware chain is attached on the /v1 group (Fiber-level) BEFORE the Huma
 // terminal, not here. Paths are GROUP-RELATIVE (see asset_handler_huma.go's
 // RegisterAssetRoutes header for the /v1 rationale).
 func RegisterPackageRoutes(api huma.API, h *PackageHandler) {
 	const (
-		listPath = "/organizations/{organization_id}/packages"
-		idPath   = listPath + "/{id}"
-		tag      = "Packages"
+		listPath     = "/organizations/{organization_id}/packages"
+		idPath       = listPath + "/{id}"
+		versionsPath = idPath + "/versions"
+		promoPath    = idPath + "/promotions"
+		tag          = "Packages"
 	)
 
 	huma.Register(api, huma.Operation{
@@ -298,4 +502,58 @@ func RegisterPackageRoutes(api huma.API, h *PackageHandler) {
 		// DefaultStatus 204 + an Out struct with no Body field => bodiless 204.
 		DefaultStatus: http.StatusNoContent,
 	}, h.DeletePackageByIDHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "schedulePackageVersion",
+		Method:           http.MethodPost,
+		Path:             versionsPath,
+		Summary:          "Schedule a package version",
+		Description:      "Records a fee schedule that takes effect at effectiveFrom. Omitted fields are inherited from the version in force just before that instant.",
+		Tags:             []string{tag},
+		Security:         secPackageBearer,
+		SkipValidateBody: true, // body validated imperatively — see createPackage.
+	}, h.SchedulePackageVersionHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "cancelPackageVersion",
+		Method:        http.MethodDelete,
+		Path:          versionsPath + "/{version}",
+		Summary:       "Cancel a scheduled package version",
+		Description:   "Removes a version that has not taken effect yet. Versions already in force cannot be removed.",
+		Tags:          []string{tag},
+		Security:      secPackageBearer,
+		DefaultStatus: http.StatusNoContent,
+	}, h.CancelPackageVersionHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "createPackagePromotion",
+		Method:           http.MethodPost,
+		Path:             promoPath,
+		Summary:          "Create a package promotion",
+		Description:      "Waives the package's fees for an account alias or for every account of a holder during a time window, optionally limited to a number of free transactions or to a fee cap per period.",
+		Tags:             []string{tag},
+		Security:         secPackageBearer,
+		SkipValidateBody: true, // body validated imperatively — see createPackage.
+	}, h.CreatePackagePromotionHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "deletePackagePromotion",
+		Method:        http.MethodDelete,
+		Path:          promoPath + "/{promotion_id}",
+		Summary:       "Delete a package promotion",
+		Description:   "Stops the promotion from applying to new transactions. Its usage counters are kept.",
+		Tags:          []string{tag},
+		Security:      secPackageBearer,
+		DefaultStatus: http.StatusNoContent,
+	}, h.DeletePackagePromotionHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listPackagePromotionUsage",
+		Method:      http.MethodGet,
+		Path:        promoPath + "/{promotion_id}/usage",
+		Summary:     "List the usage of a package promotion",
+		Description: "Returns the transactions counted and the fees charged and waived per account or holder and period.",
+		Tags:        []string{tag},
+		Security:    secPackageBearer,
+	}, h.ListPackagePromotionUsageHuma)
 }
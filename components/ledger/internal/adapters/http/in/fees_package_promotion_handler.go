@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// createPackagePromotion is the transport-agnostic core of the create-promotion op.
+// Target, limit and window validation live in the service, which also checks the
+// targeted account exists.
+func (handler *PackageHandler) createPackagePromotion(ctx context.Context, organizationID, id uuid.UUID, payload *model.CreatePromotionInput) (*pack.Package, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.create_package_promotion")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+	)
+
+	packOut, err := handler.Service.CreatePromotion(ctx, id, organizationID, payload)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to create package promotion", err)
+
+		return nil, err
+	}
+
+	return packOut, nil
+}
+
+// deletePackagePromotion is the transport-agnostic core of the delete-promotion op.
+// Usage counters are kept so past waivers stay auditable.
+func (handler *PackageHandler) deletePackagePromotion(ctx context.Context, organizationID, id, promotionID uuid.UUID) error {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.delete_package_promotion")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.String("app.request.promotion_id", promotionID.String()),
+	)
+
+	if err := handler.Service.DeletePromotion(ctx, id, organizationID, promotionID); err != nil {
+		handleSpanByErrorClass(span, "Failed to delete package promotion", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Failed to delete package promotion",
+			libLog.String("package_id", id.String()), libLog.String("promotion_id", promotionID.String()))
+
+		return err
+	}
+
+	return nil
+}
+
+// listPackagePromotionUsage is the transport-agnostic core of the promotion-usage op.
+// It owns the limit/page query validation (same bounds as the billing lists) and
+// builds the pagination envelope.
+func (handler *PackageHandler) listPackagePromotionUsage(ctx context.Context, organizationID, id, promotionID uuid.UUID, queries map[string]string) (model.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.list_package_promotion_usage")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.String("app.request.promotion_id", promotionID.String()),
+	)
+
+	const maxPaginationLimit = 100
+
+	limit := 10
+	page := 1
+
+	if l := queries["limit"]; l != "" {
+		parsed, errParse := strconv.Atoi(l)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityPackage, "limit")
+		}
+
+		if parsed > maxPaginationLimit {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrPaginationLimitExceeded, feeconstant.EntityPackage, maxPaginationLimit)
+		}
+
+		limit = parsed
+	}
+
+	if p := queries["page"]; p != "" {
+		parsed, errParse := strconv.Atoi(p)
+		if errParse != nil || parsed < 1 {
+			return model.Pagination{}, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityPackage, "page")
+		}
+
+		page = parsed
+	}
+
+	results, total, err := handler.Service.GetPromotionUsage(ctx, id, organizationID, promotionID, limit, page)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to list package promotion usage", err)
+
+		return model.Pagination{}, err
+	}
+
+	pagination := model.Pagination{
+		Limit: limit,
+		Page:  page,
+	}
+
+	pagination.SetItems(results)
+	pagination.SetTotal(int(total))
+
+	return pagination, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// schedulePackageVersion is the transport-agnostic core of the schedule-version op.
+// It owns the span and the payload-level amount validation; inheritance from the
+// version in force and the fee validation that depends on it live in the service.
+func (handler *PackageHandler) schedulePackageVersion(ctx context.Context, organizationID, id uuid.UUID, payload *model.CreatePackageVersionInput) (*pack.Package, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.schedule_package_version")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+	)
+
+	if errAmount := payload.ValidateMinAndMaxAmount(); errAmount != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid values for maxAmount and minAmount", errAmount)
+
+		return nil, errAmount
+	}
+
+	packOut, err := handler.Service.SchedulePackageVersion(ctx, id, organizationID, payload)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to schedule package version", err)
+
+		return nil, err
+	}
+
+	return packOut, nil
+}
+
+// cancelPackageVersion is the transport-agnostic core of the cancel-version op. The
+// version path segment is a positive integer, not a UUID, so it is parsed here
+// rather than by ParseUUIDPathParameters.
+func (handler *PackageHandler) cancelPackageVersion(ctx context.Context, organizationID, id uuid.UUID, rawVersion string) error {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.cancel_package_version")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+	)
+
+	version, errParse := strconv.Atoi(rawVersion)
+	if errParse != nil || version < 1 {
+		err := feeerrors.ValidateBusinessError(feeconstant.ErrInvalidPathParameter, "", "version")
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid package version path parameter", err)
+
+		return err
+	}
+
+	if err := handler.Service.CancelPackageVersion(ctx, id, organizationID, version); err != nil {
+		handleSpanByErrorClass(span, "Failed to cancel package version", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Failed to cancel package version",
+			libLog.String("package_id", id.String()), libLog.Int("version", version))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// createFeeQuote is the transport-agnostic core of the create-quote op. The
+// estimate and its validation live in the service, which also stores the quote.
+func (handler *FeeHandler) createFeeQuote(ctx context.Context, organizationID uuid.UUID, payload *model.FeeEstimate) (*model.FeeQuote, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.create_fee_quote")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", payload.PackageID.String()),
+		attribute.String("app.request.ledger_id", payload.LedgerID.String()),
+	)
+
+	quote, err := handler.Service.CreateFeeQuote(ctx, payload, organizationID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to create fee quote", err)
+
+		return nil, err
+	}
+
+	if quote == nil {
+		return nil, feeerrors.ValidateInternalError(feeconstant.ErrInternalServer, feeconstant.EntityFeeQuote)
+	}
+
+	return quote, nil
+}
+
+// getFeeQuote is the transport-agnostic core of the get-quote op.
+func (handler *FeeHandler) getFeeQuote(ctx context.Context, organizationID, id uuid.UUID) (*model.FeeQuote, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_fee_quote")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.fee_quote_id", id.String()),
+	)
+
+	quote, err := handler.Service.GetFeeQuote(ctx, id, organizationID)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to get fee quote", err)
+
+		return nil, err
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	feeerrors "github.com/LerianStudio/midaz/v4/pkg"
+	feeconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Fee revenue report formats accepted by the format query parameter.
+const (
+	feeRevenueFormatJSON = "json"
+	feeRevenueFormatCSV  = "csv"
+)
+
+// FeeRevenueReportQuery holds the raw query parameters of the fee revenue report.
+type FeeRevenueReportQuery struct {
+	From      string
+	To        string
+	LedgerID  string
+	PackageID string
+	FeeLabel  string
+	Route     string
+	SegmentID string
+	Asset     string
+	GroupBy   string
+	Period    string
+	Format    string
+}
+
+// getFeeRevenueReport is the transport-agnostic core of the fee revenue report.
+// It returns the encoded body and its content type: JSON by default, CSV when
+// format=csv so finance can load the report into a spreadsheet.
+func (handler *FeeHandler) getFeeRevenueReport(ctx context.Context, organizationID uuid.UUID, q FeeRevenueReportQuery) ([]byte, string, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_fee_revenue_report")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	format := strings.ToLower(strings.TrimSpace(q.Format))
+	if format == "" {
+		format = feeRevenueFormatJSON
+	}
+
+	if format != feeRevenueFormatJSON && format != feeRevenueFormatCSV {
+		err := feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityFeeRevenue, "format")
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid fee revenue format", err)
+
+		return nil, "", err
+	}
+
+	query, err := parseFeeRevenueQuery(q, time.Now().UTC())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid fee revenue query", err)
+
+		return nil, "", err
+	}
+
+	report, err := handler.Service.GetFeeRevenueReport(ctx, organizationID, query)
+	if err != nil {
+		handleSpanByErrorClass(span, "Failed to get fee revenue report", err)
+
+		return nil, "", err
+	}
+
+	if report == nil {
+		return nil, "", feeerrors.ValidateInternalError(feeconstant.ErrInternalServer, feeconstant.EntityFeeRevenue)
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+
+	if format == feeRevenueFormatCSV {
+		body, err = encodeFeeRevenueCSV(report.Items)
+		contentType = "text/csv; charset=utf-8"
+	} else {
+		body, err = json.Marshal(report)
+		contentType = fiber.MIMEApplicationJSON
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to encode fee revenue report", err)
+
+		return nil, "", feeerrors.ValidateInternalError(feeconstant.ErrInternalServer, feeconstant.EntityFeeRevenue)
+	}
+
+	return body, contentType, nil
+}
+
+// parseFeeRevenueQuery turns the raw query parameters into a report query. The
+// range defaults to the current month so far; a date-only "to" includes the
+// whole day. Range and grouping are validated by the service.
+func parseFeeRevenueQuery(q FeeRevenueReportQuery, now time.Time) (*model.FeeRevenueQuery, error) {
+	query := &model.FeeRevenueQuery{
+		From:     time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		To:       now,
+		FeeLabel: strings.TrimSpace(q.FeeLabel),
+		Route:    strings.TrimSpace(q.Route),
+		Asset:    strings.TrimSpace(q.Asset),
+		Period:   strings.ToLower(strings.TrimSpace(q.Period)),
+	}
+
+	if query.Period == "" {
+		query.Period = model.FeeRevenuePeriodMonth
+	}
+
+	if from := strings.TrimSpace(q.From); from != "" {
+		parsed, _, err := libCommons.ParseDateTime(from, false)
+		if err != nil {
+			return nil, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityFeeRevenue, "from")
+		}
+
+		query.From = parsed.UTC()
+	}
+
+	if to := strings.TrimSpace(q.To); to != "" {
+		parsed, hasTime, err := libCommons.ParseDateTime(to, false)
+		if err != nil {
+			return nil, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityFeeRevenue, "to")
+		}
+
+		if !hasTime {
+			parsed = parsed.AddDate(0, 0, 1)
+		}
+
+		query.To = parsed.UTC()
+	}
+
+	for _, filter := range []struct {
+		name   string
+		raw    string
+		target *string
+	}{
+		{"ledgerId", q.LedgerID, &query.LedgerID},
+		{"packageId", q.PackageID, &query.PackageID},
+		{"segmentId", q.SegmentID, &query.SegmentID},
+	} {
+		raw := strings.TrimSpace(filter.raw)
+		if raw == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, feeerrors.ValidateBusinessError(feeconstant.ErrInvalidQueryParameter, feeconstant.EntityFeeRevenue, filter.name)
+		}
+
+		*filter.target = id.String()
+	}
+
+	for _, dimension := range strings.Split(q.GroupBy, ",") {
+		if dimension = strings.TrimSpace(dimension); dimension != "" && !query.Groups(dimension) {
+			query.GroupBy = append(query.GroupBy, dimension)
+		}
+	}
+
+	return query, nil
+}
+
+// feeRevenueCSVHeader is the column order of the CSV export.
+var feeRevenueCSVHeader = []string{
+	"period", "package_id", "fee_label", "route", "segment_id", "asset",
+	"charged", "refunded", "net", "waived", "waived_exemption", "waived_promotion", "transactions",
+}
+
+// encodeFeeRevenueCSV renders the report rows as RFC 4180 CSV. Dimensions the
+// report is not grouped by are empty columns.
+func encodeFeeRevenueCSV(rows []*model.FeeRevenueRow) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(feeRevenueCSVHeader); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if err := w.Write([]string{
+			row.Period,
+			row.PackageID,
+			row.FeeLabel,
+			row.Route,
+			row.SegmentID,
+			row.Asset,
+			row.Charged.String(),
+			row.Refunded.String(),
+			row.Net.String(),
+			row.Waived.String(),
+			row.WaivedExemption.String(),
+			row.WaivedPromotion.String(),
+			strconv.FormatInt(row.Transactions, 10),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
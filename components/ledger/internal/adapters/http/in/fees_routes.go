@@ -29,7 +29,8 @@ const feesApplicationName = "plugin-fees"
 //
 // The fee calculate endpoint (POST /v1/fees) is intentionally NOT mounted: in the
 // unified binary fees run in-process via the transaction seam, so only the dry-run
-// estimate (POST /v1/.../estimates) is exposed over HTTP.
+// estimate (POST /v1/.../estimates), the quotes that freeze one and the fee revenue
+// report are exposed over HTTP.
 func RegisterFeesRoutesToApp(
 	group fiber.Router,
 	api huma.API,
@@ -43,7 +44,11 @@ func RegisterFeesRoutesToApp(
 	const (
 		packagesPath   = "/organizations/:organization_id/packages"
 		packageIDPath  = packagesPath + "/:id"
+		pkgVersions    = packageIDPath + "/versions"
+		pkgPromotions  = packageIDPath + "/promotions"
 		estimatesPath  = "/organizations/:organization_id/estimates"
+		quotesPath     = "/organizations/:organization_id/quotes"
+		revenuePath    = "/organizations/:organization_id/fee-revenue"
 		billingPkgPath = "/organizations/:organization_id/billing-packages"
 		billingPkgID   = billingPkgPath + "/:id"
 		billingCalc    = "/organizations/:organization_id/billing/calculate"
@@ -57,6 +62,14 @@ func RegisterFeesRoutesToApp(
 	group.Get(packageIDPath, protectedFees(auth, "packages", "get", routeOptions, pkgParse)...)
 	group.Patch(packageIDPath, protectedFees(auth, "packages", "patch", routeOptions, pkgParse)...)
 	group.Delete(packageIDPath, protectedFees(auth, "packages", "delete", routeOptions, pkgParse)...)
+	// Scheduling and cancelling versions change the package, so both are authorized
+	// as a package patch.
+	group.Post(pkgVersions, protectedFees(auth, "packages", "patch", routeOptions, pkgParse)...)
+	group.Delete(pkgVersions+"/:version", protectedFees(auth, "packages", "patch", routeOptions, pkgParse)...)
+	// Promotions are part of the package as well; reading their usage is a package get.
+	group.Post(pkgPromotions, protectedFees(auth, "packages", "patch", routeOptions, pkgParse)...)
+	group.Delete(pkgPromotions+"/:promotion_id", protectedFees(auth, "packages", "patch", routeOptions, pkgParse)...)
+	group.Get(pkgPromotions+"/:promotion_id/usage", protectedFees(auth, "packages", "get", routeOptions, pkgParse)...)
 
 	RegisterPackageRoutes(api, ph)
 
@@ -65,6 +78,18 @@ func RegisterFeesRoutesToApp(
 
 	RegisterFeeEstimateRoutes(api, fh)
 
+	// Fee quotes freeze an estimate for the transaction that references them.
+	quoteParse := http.ParseUUIDPathParameters("quotes")
+	group.Post(quotesPath, protectedFees(auth, "quotes", "post", routeOptions, quoteParse)...)
+	group.Get(quotesPath+"/:id", protectedFees(auth, "quotes", "get", routeOptions, quoteParse)...)
+
+	RegisterFeeQuoteRoutes(api, fh)
+
+	// Fee revenue report
+	group.Get(revenuePath, protectedFees(auth, "fee-revenue", "get", routeOptions, http.ParseUUIDPathParameters("fee-revenue"))...)
+
+	RegisterFeeRevenueRoutes(api, fh)
+
 	// Billing packages
 	billingParse := http.ParseUUIDPathParameters("billing-packages")
 	group.Post(billingPkgPath, protectedFees(auth, "billing-packages", "post", routeOptions, billingParse)...)
@@ -86,3 +111,57 @@ func RegisterFeesRoutesToApp(
 func protectedFees(auth *middleware.AuthClient, resource, action string, routeOptions *http.ProtectedRouteOptions, handlers ...fiber.Handler) []fiber.Handler {
 	return http.ProtectedRouteChain(auth.Authorize(feesApplicationName, resource, action), routeOptions, handlers...)
 }
+
+// RegisterBillingRunRoutesToApp wires the billing-run surface. It is kept apart
+// from RegisterFeesRoutesToApp because its routes need a different tenant scope:
+// a run reads the fee Mongo AND posts ledger transactions, so routeOptions must
+// carry a tenant middleware spanning the onboarding/transaction stores as well
+// as the fee Mongo (bootstrap's billingRunRouteOptions), not the fees-only one.
+func RegisterBillingRunRoutesToApp(
+	group fiber.Router,
+	api huma.API,
+	auth *middleware.AuthClient,
+	brh *BillingRunHandler,
+	routeOptions *http.ProtectedRouteOptions,
+) {
+	const (
+		runsPath = "/organizations/:organization_id/billing/runs"
+		runPath  = runsPath + "/:id"
+	)
+
+	runParse := http.ParseUUIDPathParameters("billing-runs")
+
+	group.Post(runsPath, protectedFees(auth, "billing-runs", "post", routeOptions, runParse)...)
+	group.Get(runPath, protectedFees(auth, "billing-runs", "get", routeOptions, runParse)...)
+	group.Get(runPath+"/statements", protectedFees(auth, "billing-runs", "get", routeOptions, runParse)...)
+	group.Post(runPath+"/retry", protectedFees(auth, "billing-runs", "post", routeOptions, runParse)...)
+
+	RegisterBillingRunRoutes(api, brh)
+}
+
+// RegisterBillingInvoiceRoutesToApp wires the billing-invoice surface. Issuing
+// and collecting post ledger transactions, so like RegisterBillingRunRoutesToApp
+// routeOptions must carry bootstrap's billingRunRouteOptions tenant scope.
+func RegisterBillingInvoiceRoutesToApp(
+	group fiber.Router,
+	api huma.API,
+	auth *middleware.AuthClient,
+	bih *BillingInvoiceHandler,
+	routeOptions *http.ProtectedRouteOptions,
+) {
+	const (
+		invoicesPath = "/organizations/:organization_id/billing/invoices"
+		invoicePath  = invoicesPath + "/:id"
+	)
+
+	invoiceParse := http.ParseUUIDPathParameters("billing-invoices")
+
+	group.Post(invoicesPath, protectedFees(auth, "billing-invoices", "post", routeOptions, invoiceParse)...)
+	group.Get(invoicesPath, protectedFees(auth, "billing-invoices", "get", routeOptions, invoiceParse)...)
+	group.Post(invoicesPath+"/dunning", protectedFees(auth, "billing-invoices", "post", routeOptions, invoiceParse)...)
+	group.Get(invoicePath, protectedFees(auth, "billing-invoices", "get", routeOptions, invoiceParse)...)
+	group.Post(invoicePath+"/collect", protectedFees(auth, "billing-invoices", "post", routeOptions, invoiceParse)...)
+	group.Post(invoicePath+"/write-off", protectedFees(auth, "billing-invoices", "post", routeOptions, invoiceParse)...)
+
+	RegisterBillingInvoiceRoutes(api, bih)
+}
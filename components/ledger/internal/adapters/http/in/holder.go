@@ -289,6 +289,56 @@ func (handler *HolderHandler) DeleteHolderByID(c *fiber.Ctx) error {
 	return http.NoContent(c)
 }
 
+// eraseHolder is the transport-agnostic core for the holder right-to-erasure.
+func (handler *HolderHandler) eraseHolder(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.EraseHolderInput) (*mmodel.HolderErasure, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.erase_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	erasure, err := handler.Service.EraseHolder(ctx, organizationID.String(), id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to erase holder", err)
+
+		return nil, err
+	}
+
+	return erasure, nil
+}
+
+// EraseHolder is a method that erases the personal data of a Holder by a given id.
+func (handler *HolderHandler) EraseHolder(p any, c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := http.GetUUIDFromLocals(c, "id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	payload, ok := p.(*mmodel.EraseHolderInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolder))
+	}
+
+	erasure, err := handler.eraseHolder(ctx, organizationID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, erasure)
+}
+
 // getAllHolders is the transport-agnostic core for the holder list. queries is the
 // map[string]string the caller derived from its transport (Fiber c.Queries() or the
 // Huma raw-query rebuild); http.ValidateParameters is the sole query binder so the
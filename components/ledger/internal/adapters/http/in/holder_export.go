@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// createHolderExport is the transport-agnostic core for requesting a data
+// export of a holder.
+func (handler *HolderHandler) createHolderExport(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.CreateHolderExportInput) (*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.create_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	export, err := handler.Service.CreateHolderExport(ctx, organizationID.String(), id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to create holder export", err)
+
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// getHolderExports is the transport-agnostic core for listing a holder's
+// exports.
+func (handler *HolderHandler) getHolderExports(ctx context.Context, organizationID, id uuid.UUID) ([]*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_exports")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	exports, err := handler.Service.GetHolderExports(ctx, organizationID.String(), id)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder exports", err)
+
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// getHolderExport is the transport-agnostic core for reading the status of an
+// export.
+func (handler *HolderHandler) getHolderExport(ctx context.Context, organizationID, id, exportID uuid.UUID) (*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.export_id", exportID.String()),
+	)
+
+	export, err := handler.Service.GetHolderExport(ctx, organizationID.String(), id, exportID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder export", err)
+
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// downloadHolderExport is the transport-agnostic core for downloading the
+// archive of a completed export.
+func (handler *HolderHandler) downloadHolderExport(ctx context.Context, organizationID, id, exportID uuid.UUID) ([]byte, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.download_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.export_id", exportID.String()),
+	)
+
+	archive, err := handler.Service.DownloadHolderExport(ctx, organizationID.String(), id, exportID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to download holder export", err)
+
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// holderExportFileName is the download file name of an export archive.
+func holderExportFileName(exportID uuid.UUID) string {
+	return "holder-export-" + exportID.String() + ".zip"
+}
+
+// CreateHolderExport requests a data export of a Holder. The archive is
+// assembled asynchronously.
+func (handler *HolderHandler) CreateHolderExport(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.CreateHolderExportInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolderExport))
+	}
+
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	export, err := handler.createHolderExport(c.UserContext(), organizationID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Accepted(c, export)
+}
+
+// GetHolderExports lists the data exports of a Holder, newest first.
+func (handler *HolderHandler) GetHolderExports(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	exports, err := handler.getHolderExports(c.UserContext(), organizationID, id)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, exports)
+}
+
+// GetHolderExport returns the status of a data export of a Holder.
+func (handler *HolderHandler) GetHolderExport(c *fiber.Ctx) error {
+	organizationID, id, exportID, err := exportPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	export, err := handler.getHolderExport(c.UserContext(), organizationID, id, exportID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, export)
+}
+
+// DownloadHolderExport returns the ZIP archive of a completed data export.
+func (handler *HolderHandler) DownloadHolderExport(c *fiber.Ctx) error {
+	organizationID, id, exportID, err := exportPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	archive, err := handler.downloadHolderExport(c.UserContext(), organizationID, id, exportID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, mmodel.HolderExportContentType)
+	c.Attachment(holderExportFileName(exportID))
+
+	return c.Send(archive)
+}
+
+// exportPathIDs reads the organization, holder and export ids parsed from the
+// path.
+func exportPathIDs(c *fiber.Ctx) (organizationID, id, exportID uuid.UUID, err error) {
+	organizationID, id, err = holderPathIDs(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	exportID, err = http.GetUUIDFromLocals(c, "export_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, exportID, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of the holder data export. It follows the
+// holder KYC conventions (holder_kyc_handler_huma.go): auth resource "holders"
+// attached on the Fiber group in crm_routes.go, path ids resolved via
+// parsePathUUID, and the request body decoded+validated imperatively through
+// http.DecodeAndValidate (SkipValidateBody).
+
+// HolderExportPathHuma is the path of one export of a holder.
+type HolderExportPathHuma struct {
+	HolderKYCPathHuma
+
+	ExportID string `path:"export_id" doc:"Export ID (UUID)"`
+}
+
+// resolve parses the export path ids.
+func (in *HolderExportPathHuma) resolve() (organizationID, id, exportID uuid.UUID, err error) {
+	organizationID, id, err = in.HolderKYCPathHuma.resolve()
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	exportID, err = parsePathUUID(in.ExportID, "export_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, exportID, nil
+}
+
+// HolderExportOutputHuma carries one export; Status is 202 on create and 200
+// otherwise.
+type HolderExportOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderExport
+}
+
+// CreateHolderExportHuma decodes a CreateHolderExportInput then delegates to
+// createHolderExport.
+func (handler *HolderHandler) CreateHolderExportHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderExportOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.CreateHolderExportInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	export, err := handler.createHolderExport(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderExportOutputHuma{Status: http.StatusAccepted, Body: export}, nil
+}
+
+// ListHolderExportsOutputHuma carries the exports (200).
+type ListHolderExportsOutputHuma struct {
+	Status int
+	Body   []*mmodel.HolderExport
+}
+
+// ListHolderExportsHuma delegates to getHolderExports.
+func (handler *HolderHandler) ListHolderExportsHuma(ctx context.Context, in *HolderKYCPathHuma) (*ListHolderExportsOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	exports, err := handler.getHolderExports(ctx, orgID, id)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListHolderExportsOutputHuma{Status: http.StatusOK, Body: exports}, nil
+}
+
+// GetHolderExportHuma delegates to getHolderExport.
+func (handler *HolderHandler) GetHolderExportHuma(ctx context.Context, in *HolderExportPathHuma) (*HolderExportOutputHuma, error) {
+	orgID, id, exportID, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	export, err := handler.getHolderExport(ctx, orgID, id, exportID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderExportOutputHuma{Status: http.StatusOK, Body: export}, nil
+}
+
+// HolderExportArchiveOutputHuma carries the ZIP archive as an attachment.
+type HolderExportArchiveOutputHuma struct {
+	Status             int
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+// DownloadHolderExportHuma delegates to downloadHolderExport.
+func (handler *HolderHandler) DownloadHolderExportHuma(ctx context.Context, in *HolderExportPathHuma) (*HolderExportArchiveOutputHuma, error) {
+	orgID, id, exportID, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	archive, err := handler.downloadHolderExport(ctx, orgID, id, exportID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderExportArchiveOutputHuma{
+		Status:             http.StatusOK,
+		ContentType:        mmodel.HolderExportContentType,
+		ContentDisposition: `attachment; filename="` + holderExportFileName(exportID) + `"`,
+		Body:               archive,
+	}, nil
+}
+
+// RegisterHolderExportRoutes registers the holder data export operations on
+// the shared Huma API. Auth is ("midaz","holders","get"): an export discloses
+// the holder's data without changing it. It is attached BEFORE the Huma
+// terminal in crm_routes.go.
+func RegisterHolderExportRoutes(api huma.API, h *HolderHandler) {
+	const (
+		exportsPath = "/organizations/{organization_id}/holders/{id}/exports"
+		exportPath  = exportsPath + "/{export_id}"
+		tag         = "Holders"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "createHolderExport",
+		Method:      http.MethodPost,
+		Path:        exportsPath,
+		Summary:     "Export all data held about a Holder",
+		Description: "Starts assembling, in the background, a ZIP archive of the decrypted holder, its instruments, " +
+			"the instrument entries in which it appears as a related party, its accounts, balances and a CSV of " +
+			"their transactions, for a subject access request. Poll the export until it is COMPLETED, then " +
+			"download the archive. A holder has at most one export in progress.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		DefaultStatus:    http.StatusAccepted,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.CreateHolderExportHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listHolderExports",
+		Method:      http.MethodGet,
+		Path:        exportsPath,
+		Summary:     "List a Holder's data exports",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.ListHolderExportsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getHolderExport",
+		Method:      http.MethodGet,
+		Path:        exportPath,
+		Summary:     "Retrieve a Holder data export",
+		Description: "Returns the export status and, once completed, the record counts, size and SHA-256 digest of the archive.",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetHolderExportHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "downloadHolderExport",
+		Method:      http.MethodGet,
+		Path:        exportPath + "/archive",
+		Summary:     "Download a Holder data export archive",
+		Description: "Returns the ZIP archive of a COMPLETED export. Archives are removed seven days after completion.",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+		Responses: map[string]*huma.Response{
+			"200": {
+				Description: "The export archive",
+				Content:     map[string]*huma.MediaType{mmodel.HolderExportContentType: {}},
+			},
+		},
+	}, h.DownloadHolderExportHuma)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/export"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaHolderExportApp mounts the holder data export Huma operations on a
+// /v1 group, mirroring buildHumaHolderKYCApp (same MUST-NOT-PARALLELIZE
+// rationale).
+func buildHumaHolderExportApp(t *testing.T, handler *HolderHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("holder")
+	exports := "/organizations/:organization_id/holders/:id/exports"
+	apiV1.Post(exports, parse)
+	apiV1.Get(exports, parse)
+	apiV1.Get(exports+"/:export_id", parse)
+	apiV1.Get(exports+"/:export_id/archive", parse)
+
+	RegisterHolderExportRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_CreateHolderExport_RequiresActor(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	handler, _ := newHolderHandler(t, ctrl)
+	handler.Service.ExportRepo = export.NewMockRepository(ctrl)
+
+	app := buildHumaHolderExportApp(t, handler)
+
+	path := "/v1/organizations/" + uuid.NewString() + "/holders/" + uuid.NewString() + "/exports"
+
+	status, _ := doHolderKYC(t, app, http.MethodPost, path, map[string]any{})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_DownloadHolderExport(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID, exportID, runningID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	archive := []byte("PK\x03\x04archive")
+	digest := sha256.Sum256(archive)
+	expiresAt := time.Now().Add(time.Hour)
+
+	completed := &mmodel.HolderExport{
+		ID: exportID, HolderID: holderID, Status: mmodel.HolderExportCompleted,
+		ArchiveSize: int64(len(archive)), ArchiveSHA256: hex.EncodeToString(digest[:]), ExpiresAt: &expiresAt,
+	}
+
+	handler, _ := newHolderHandler(t, ctrl)
+	exportRepo := export.NewMockRepository(ctrl)
+	handler.Service.ExportRepo = exportRepo
+
+	exportRepo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, exportID).Return(completed, nil).Times(1)
+	exportRepo.EXPECT().LoadArchive(gomock.Any(), orgID.String(), completed).Return(archive, nil).Times(1)
+	exportRepo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, runningID).
+		Return(&mmodel.HolderExport{ID: runningID, HolderID: holderID, Status: mmodel.HolderExportRunning}, nil).Times(1)
+
+	app := buildHumaHolderExportApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/exports/"
+
+	status, body := doHolderKYC(t, app, http.MethodGet, path+exportID.String()+"/archive", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	assert.Equal(t, archive, body)
+
+	status, body = doHolderKYC(t, app, http.MethodGet, path+runningID.String()+"/archive", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Contains(t, string(body), "CRM-0073")
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"not-a-uuid", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
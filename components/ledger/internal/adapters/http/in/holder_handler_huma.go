@@ -235,6 +235,48 @@ func (handler *HolderHandler) DeleteHolderByIDHuma(ctx context.Context, in *Dele
 	return &DeleteHolderOutputHuma{}, nil
 }
 
+// --- POST /holders/{id}/erase -------------------------------------------------
+
+// EraseHolderInputHuma is the erasure request envelope (RawBody, see Create).
+type EraseHolderInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Holder ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// EraseHolderOutputHuma carries the erasure outcome (200, matching http.OK).
+type EraseHolderOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderErasure
+}
+
+// EraseHolderHuma decodes+validates the raw body imperatively then delegates to
+// eraseHolder.
+func (handler *HolderHandler) EraseHolderHuma(ctx context.Context, in *EraseHolderInputHuma) (*EraseHolderOutputHuma, error) {
+	orgID, err := parsePathUUID(in.OrganizationID, "organization_id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.ID, "id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.EraseHolderInput)
+
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	erasure, err := handler.eraseHolder(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &EraseHolderOutputHuma{Status: http.StatusOK, Body: erasure}, nil
+}
+
 // --- GET /holders (list) ------------------------------------------------------
 
 // ListHoldersInputHuma advertises the list query params (doc-only, no validation
@@ -335,7 +377,7 @@ func (handler *HolderAccountsHandler) GetAccountsByHolderHuma(ctx context.Contex
 	return &ListHolderAccountsOutputHuma{Status: http.StatusOK, Body: pagination}, nil
 }
 
-// RegisterHolderRoutes registers the five migrated holder operations on the shared
+// RegisterHolderRoutes registers the six holder operations on the shared
 // Huma API. It is the per-file seam the unified server calls; the auth
 // ("midaz","holders",verb) + tenant + ParseUUIDPathParameters("holder") middleware
 // chain is attached on the /v1 group (Fiber-level) BEFORE the Huma terminal, not here.
@@ -389,6 +431,18 @@ func RegisterHolderRoutes(api huma.API, h *HolderHandler) {
 		DefaultStatus: http.StatusNoContent,
 	}, h.DeleteHolderByIDHuma)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "eraseHolder",
+		Method:      http.MethodPost,
+		Path:        idPath + "/erase",
+		Summary:     "Erase a Holder's personal data",
+		Description: "Right-to-erasure: removes the personal data and search tokens of the holder and its instruments, " +
+			"soft-deletes the holder and destroys its data key. Identifiers used by the ledger are kept. Idempotent.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.EraseHolderHuma)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "listHolders",
 		Method:      http.MethodGet,
@@ -30,7 +30,7 @@ import (
 	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
 )
 
-// buildHumaHolderApp mounts the five holder Huma operations on a /v1 group,
+// buildHumaHolderApp mounts the six holder Huma operations on a /v1 group,
 // faithfully mirroring the production wiring in unified-server.go: problem.Install()
 // runs before any huma.Register, the Huma API is built with openapi.New over a /v1
 // group, an auth-shim middleware stands in for auth.Authorize("midaz","holders",verb)
@@ -70,6 +70,7 @@ func buildHumaHolderApp(t *testing.T, handler *HolderHandler, authOK bool) *fibe
 	apiV1.Get(base+"/:id", parse)
 	apiV1.Patch(base+"/:id", parse)
 	apiV1.Delete(base+"/:id", parse)
+	apiV1.Post(base+"/:id/erase", parse)
 
 	RegisterHolderRoutes(hAPI, handler)
 
@@ -314,6 +315,69 @@ func TestHuma_DeleteHolder_204Empty(t *testing.T) {
 	assert.Empty(t, respBody, "DELETE 204 must have an empty body")
 }
 
+func TestHuma_EraseHolder_Success(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+	holderID := uuid.New()
+	erasedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	holderRepo := holderrepo.NewMockRepository(ctrl)
+	instrumentRepo := instrumentrepo.NewMockRepository(ctrl)
+	holderRepo.EXPECT().Erase(gomock.Any(), orgID.String(), holderID, gomock.Any()).Return(erasedAt, nil).Times(1)
+	instrumentRepo.EXPECT().EraseByHolder(gomock.Any(), orgID.String(), holderID, erasedAt).Return(int64(2), nil).Times(1)
+
+	handler := &HolderHandler{Service: &services.UseCase{
+		InstrumentRepo: instrumentRepo,
+		HolderRepo:     holderRepo,
+	}}
+
+	app := buildHumaHolderApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"actor": "dpo@example.com", "reason": "DSR-2026-0042"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/holders/"+holderID.String()+"/erase", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "body: %s", string(respBody))
+
+	var got mmodel.HolderErasure
+	require.NoError(t, json.Unmarshal(respBody, &got))
+	assert.Equal(t, holderID, got.HolderID)
+	assert.Equal(t, int64(2), got.InstrumentsErased)
+	assert.False(t, got.KeyDestroyed, "no holder key manager wired => scrub-only erasure")
+	assert.True(t, erasedAt.Equal(got.ErasedAt))
+}
+
+func TestHuma_EraseHolder_MissingActor(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	// No repository expectation: validation rejects the body before the service runs.
+	handler, _ := newHolderHandler(t, ctrl)
+
+	app := buildHumaHolderApp(t, handler, true)
+
+	body, _ := json.Marshal(map[string]any{"reason": "DSR-2026-0042"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/organizations/"+orgID.String()+"/holders/"+uuid.NewString()+"/erase", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestHuma_GetAllHolders_Success(t *testing.T) {
 	// NOT parallel: process-global huma state.
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// kycStep is one mutating step of the holder KYC workflow, bound to its payload.
+type kycStep func(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.HolderKYCView, error)
+
+// getHolderKYC is the transport-agnostic core for the holder KYC read.
+func (handler *HolderHandler) getHolderKYC(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+	return handler.runHolderKYC(ctx, "handler.get_holder_kyc", organizationID, id, handler.Service.GetHolderKYC)
+}
+
+// openHolderKYC is the transport-agnostic core for opening a verification case.
+func (handler *HolderHandler) openHolderKYC(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.OpenKYCInput) (*mmodel.HolderKYCView, error) {
+	return handler.runHolderKYC(ctx, "handler.open_holder_kyc", organizationID, id, func(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.Service.OpenHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// submitHolderKYCDocument is the transport-agnostic core for adding evidence.
+func (handler *HolderHandler) submitHolderKYCDocument(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.SubmitKYCDocumentInput) (*mmodel.HolderKYCView, error) {
+	return handler.runHolderKYC(ctx, "handler.submit_holder_kyc_document", organizationID, id, func(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.Service.SubmitHolderKYCDocument(ctx, organizationID, id, payload)
+	})
+}
+
+// submitHolderKYC is the transport-agnostic core for sending a case to review.
+func (handler *HolderHandler) submitHolderKYC(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.SubmitKYCInput) (*mmodel.HolderKYCView, error) {
+	return handler.runHolderKYC(ctx, "handler.submit_holder_kyc", organizationID, id, func(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.Service.SubmitHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// decideHolderKYC is the transport-agnostic core for the reviewer's decision.
+func (handler *HolderHandler) decideHolderKYC(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.DecideKYCInput) (*mmodel.HolderKYCView, error) {
+	return handler.runHolderKYC(ctx, "handler.decide_holder_kyc", organizationID, id, func(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.Service.DecideHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// runHolderKYC runs one KYC operation under a handler span.
+func (handler *HolderHandler) runHolderKYC(ctx context.Context, spanName string, organizationID, id uuid.UUID, step kycStep) (*mmodel.HolderKYCView, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, spanName)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	view, err := step(ctx, organizationID.String(), id)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to process holder KYC", err)
+
+		return nil, err
+	}
+
+	return view, nil
+}
+
+// listKYCReviewsDue is the transport-agnostic core for the re-verification
+// queue. due_before is an RFC3339 timestamp or date and defaults to now; it is
+// kept away from http.ValidateParameters, which binds only limit/page here.
+func (handler *HolderHandler) listKYCReviewsDue(ctx context.Context, organizationID uuid.UUID, queries map[string]string) (http.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.list_kyc_reviews_due")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	validateQueries := make(map[string]string, len(queries))
+
+	for key, value := range queries {
+		if key == "due_before" {
+			continue
+		}
+
+		validateQueries[key] = value
+	}
+
+	headerParams, err := http.ValidateParameters(validateQueries)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to validate query parameters", err)
+
+		return http.Pagination{}, err
+	}
+
+	dueBefore, err := parseAuditTime(queries["due_before"], true)
+	if err != nil {
+		err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, "", "due_before")
+
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected unparseable due_before", err)
+
+		return http.Pagination{}, err
+	}
+
+	if dueBefore.IsZero() {
+		dueBefore = time.Now().UTC()
+	}
+
+	pagination := http.Pagination{
+		Limit: headerParams.Limit,
+		Page:  headerParams.Page,
+	}
+
+	reviews, err := handler.Service.ListKYCReviewsDue(ctx, organizationID.String(), dueBefore, *headerParams)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list KYC reviews due", err)
+
+		return http.Pagination{}, err
+	}
+
+	pagination.SetItems(reviews)
+
+	return pagination, nil
+}
+
+// GetHolderKYC returns the KYC verification state of a Holder.
+func (handler *HolderHandler) GetHolderKYC(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	view, err := handler.getHolderKYC(c.UserContext(), organizationID, id)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, view)
+}
+
+// OpenHolderKYC opens a KYC verification case for a Holder.
+func (handler *HolderHandler) OpenHolderKYC(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.OpenKYCInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolder))
+	}
+
+	return handler.respondHolderKYC(c, func(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.openHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// SubmitHolderKYCDocument adds a document to a Holder's pending KYC case.
+func (handler *HolderHandler) SubmitHolderKYCDocument(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.SubmitKYCDocumentInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolder))
+	}
+
+	return handler.respondHolderKYC(c, func(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.submitHolderKYCDocument(ctx, organizationID, id, payload)
+	})
+}
+
+// SubmitHolderKYC sends a Holder's pending KYC case to review.
+func (handler *HolderHandler) SubmitHolderKYC(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.SubmitKYCInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolder))
+	}
+
+	return handler.respondHolderKYC(c, func(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.submitHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// DecideHolderKYC records a reviewer's decision on a Holder's KYC case.
+func (handler *HolderHandler) DecideHolderKYC(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.DecideKYCInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolder))
+	}
+
+	return handler.respondHolderKYC(c, func(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error) {
+		return handler.decideHolderKYC(ctx, organizationID, id, payload)
+	})
+}
+
+// ListKYCReviewsDue lists the holders due for KYC re-verification.
+func (handler *HolderHandler) ListKYCReviewsDue(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	pagination, err := handler.listKYCReviewsDue(c.UserContext(), organizationID, c.Queries())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, pagination)
+}
+
+// respondHolderKYC resolves the holder path ids, runs core and writes its view.
+func (handler *HolderHandler) respondHolderKYC(c *fiber.Ctx, core func(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.HolderKYCView, error)) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	view, err := core(c.UserContext(), organizationID, id)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, view)
+}
+
+// holderPathIDs reads the organization and holder ids parsed from the path.
+func holderPathIDs(c *fiber.Ctx) (organizationID, id uuid.UUID, err error) {
+	id, err = http.GetUUIDFromLocals(c, "id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	organizationID, err = http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, nil
+}
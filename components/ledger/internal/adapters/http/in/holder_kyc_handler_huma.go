@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of the holder KYC workflow. It follows the
+// holder conventions (holder_handler_huma.go): auth resource "holders" attached
+// on the Fiber group in crm_routes.go, org-scoped paths resolved via
+// parsePathUUID, and request bodies decoded+validated imperatively through
+// http.DecodeAndValidate (SkipValidateBody).
+
+// HolderKYCPathHuma is the path of a holder's KYC resource.
+type HolderKYCPathHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Holder ID (UUID)"`
+}
+
+// HolderKYCBodyInputHuma is the envelope of the mutating KYC steps (RawBody, see
+// holder Create).
+type HolderKYCBodyInputHuma struct {
+	HolderKYCPathHuma
+
+	RawBody []byte `contentType:"application/json"`
+}
+
+// HolderKYCOutputHuma carries a holder's KYC state (200, matching http.OK).
+type HolderKYCOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderKYCView
+}
+
+// resolve parses the holder path ids.
+func (in *HolderKYCPathHuma) resolve() (organizationID, id uuid.UUID, err error) {
+	organizationID, err = parsePathUUID(in.OrganizationID, "organization_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	id, err = parsePathUUID(in.ID, "id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, nil
+}
+
+// GetHolderKYCHuma delegates to getHolderKYC.
+func (handler *HolderHandler) GetHolderKYCHuma(ctx context.Context, in *HolderKYCPathHuma) (*HolderKYCOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	view, err := handler.getHolderKYC(ctx, orgID, id)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderKYCOutputHuma{Status: http.StatusOK, Body: view}, nil
+}
+
+// OpenHolderKYCHuma decodes an OpenKYCInput then delegates to openHolderKYC.
+func (handler *HolderHandler) OpenHolderKYCHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderKYCOutputHuma, error) {
+	return runHolderKYCHuma(ctx, in, new(mmodel.OpenKYCInput), handler.openHolderKYC)
+}
+
+// SubmitHolderKYCDocumentHuma decodes a SubmitKYCDocumentInput then delegates
+// to submitHolderKYCDocument.
+func (handler *HolderHandler) SubmitHolderKYCDocumentHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderKYCOutputHuma, error) {
+	return runHolderKYCHuma(ctx, in, new(mmodel.SubmitKYCDocumentInput), handler.submitHolderKYCDocument)
+}
+
+// SubmitHolderKYCHuma decodes a SubmitKYCInput then delegates to submitHolderKYC.
+func (handler *HolderHandler) SubmitHolderKYCHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderKYCOutputHuma, error) {
+	return runHolderKYCHuma(ctx, in, new(mmodel.SubmitKYCInput), handler.submitHolderKYC)
+}
+
+// DecideHolderKYCHuma decodes a DecideKYCInput then delegates to decideHolderKYC.
+func (handler *HolderHandler) DecideHolderKYCHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderKYCOutputHuma, error) {
+	return runHolderKYCHuma(ctx, in, new(mmodel.DecideKYCInput), handler.decideHolderKYC)
+}
+
+// runHolderKYCHuma resolves the path, decodes+validates the body into payload
+// and runs core.
+func runHolderKYCHuma[T any](ctx context.Context, in *HolderKYCBodyInputHuma, payload *T, core func(context.Context, uuid.UUID, uuid.UUID, *T) (*mmodel.HolderKYCView, error)) (*HolderKYCOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	view, err := core(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderKYCOutputHuma{Status: http.StatusOK, Body: view}, nil
+}
+
+// ListKYCReviewsDueInputHuma advertises the queue's query params (doc-only) and
+// captures the raw query via Resolve for the imperative binder.
+type ListKYCReviewsDueInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	DueBefore      string `query:"due_before" doc:"List verifications expiring at or before this time (RFC3339 or YYYY-MM-DD, default now)"`
+	Limit          string `query:"limit" doc:"Max items per page (1-100, default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler (no validation; canonical
+// rejection stays in listKYCReviewsDue).
+func (in *ListKYCReviewsDueInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// ListKYCReviewsDueOutputHuma carries the pagination envelope verbatim.
+type ListKYCReviewsDueOutputHuma struct {
+	Status int
+	Body   pkgHTTP.Pagination
+}
+
+// ListKYCReviewsDueHuma binds the query imperatively then delegates to
+// listKYCReviewsDue.
+func (handler *HolderHandler) ListKYCReviewsDueHuma(ctx context.Context, in *ListKYCReviewsDueInputHuma) (*ListKYCReviewsDueOutputHuma, error) {
+	orgID, err := parsePathUUID(in.OrganizationID, "organization_id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.listKYCReviewsDue(ctx, orgID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListKYCReviewsDueOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// RegisterHolderKYCRoutes registers the holder KYC operations on the shared
+// Huma API. Auth is ("midaz","holders","get") for reads and
+// ("midaz","holders","patch") for workflow steps, attached BEFORE the Huma
+// terminal in crm_routes.go.
+func RegisterHolderKYCRoutes(api huma.API, h *HolderHandler) {
+	const (
+		kycPath    = "/organizations/{organization_id}/holders/{id}/kyc"
+		reviewPath = "/organizations/{organization_id}/kyc/reviews-due"
+		tag        = "Holders"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getHolderKYC",
+		Method:      http.MethodGet,
+		Path:        kycPath,
+		Summary:     "Retrieve a Holder's KYC verification",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetHolderKYCHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "openHolderKYC",
+		Method:      http.MethodPost,
+		Path:        kycPath + "/open",
+		Summary:     "Open a KYC verification case",
+		Description: "Opens a case at the requested level for onboarding, a level upgrade or a re-verification. " +
+			"A standing verification stays valid until it expires or the new case is rejected.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.OpenHolderKYCHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "submitHolderKYCDocument",
+		Method:           http.MethodPost,
+		Path:             kycPath + "/documents",
+		Summary:          "Submit a KYC document",
+		Description:      "Adds a document reference to a PENDING case, replacing any document of the same type.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true,
+	}, h.SubmitHolderKYCDocumentHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "submitHolderKYC",
+		Method:           http.MethodPost,
+		Path:             kycPath + "/submit",
+		Summary:          "Submit a KYC case for review",
+		Description:      "Moves a PENDING case to IN_REVIEW once every document of its checklist was submitted.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true,
+	}, h.SubmitHolderKYCHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "decideHolderKYC",
+		Method:      http.MethodPost,
+		Path:        kycPath + "/decision",
+		Summary:     "Decide a KYC case",
+		Description: "Approves or rejects a case in review. The reviewer must differ from the actor who submitted the case " +
+			"(four-eyes). Approval grants the case's level until the re-verification date.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true,
+	}, h.DecideHolderKYCHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listKYCReviewsDue",
+		Method:      http.MethodGet,
+		Path:        reviewPath,
+		Summary:     "List Holders due for KYC re-verification",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.ListKYCReviewsDueHuma)
+}
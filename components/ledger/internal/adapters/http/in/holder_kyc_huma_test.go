@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaHolderKYCApp mounts the holder KYC Huma operations on a /v1 group,
+// mirroring buildHumaHolderApp (same MUST-NOT-PARALLELIZE rationale).
+func buildHumaHolderKYCApp(t *testing.T, handler *HolderHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("holder")
+	kyc := "/organizations/:organization_id/holders/:id/kyc"
+	apiV1.Get(kyc, parse)
+	apiV1.Post(kyc+"/open", parse)
+	apiV1.Post(kyc+"/documents", parse)
+	apiV1.Post(kyc+"/submit", parse)
+	apiV1.Post(kyc+"/decision", parse)
+	apiV1.Get("/organizations/:organization_id/kyc/reviews-due", pkgHTTP.ParseUUIDPathParameters("organization"))
+
+	RegisterHolderKYCRoutes(hAPI, handler)
+
+	return f
+}
+
+// doHolderKYC sends a JSON request to the KYC app and returns status and body.
+func doHolderKYC(t *testing.T, app *fiber.App, method, path string, body any) (int, []byte) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		raw, _ := json.Marshal(body)
+		reader = bytes.NewReader(raw)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, respBody
+}
+
+func TestHuma_OpenHolderKYC_Success(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+	holderType := mmodel.HolderTypeLegalPerson
+
+	handler, repo := newHolderHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).
+		Return(&mmodel.Holder{ID: &holderID, Type: &holderType}, nil).Times(1)
+	repo.EXPECT().UpdateKYC(gomock.Any(), orgID.String(), holderID, gomock.Any(), int64(0)).Return(nil).Times(1)
+
+	app := buildHumaHolderKYCApp(t, handler)
+
+	status, body := doHolderKYC(t, app, http.MethodPost,
+		"/v1/organizations/"+orgID.String()+"/holders/"+holderID.String()+"/kyc/open",
+		map[string]any{"level": "STANDARD", "actor": "ops@example.com"})
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var got mmodel.HolderKYCView
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, holderID, got.HolderID)
+	assert.Equal(t, mmodel.KYCStatusPending, got.Status)
+	assert.Equal(t, int64(1), got.Revision)
+	assert.Len(t, got.Checklist, 3)
+}
+
+func TestHuma_DecideHolderKYC_RejectWithoutReason(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+	holderType := mmodel.HolderTypeNaturalPerson
+
+	// The service rejects the decision before anything is written.
+	handler, repo := newHolderHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).
+		Return(&mmodel.Holder{ID: &holderID, Type: &holderType, KYC: &mmodel.HolderKYC{Status: mmodel.KYCStatusInReview, Revision: 2}}, nil).Times(1)
+
+	app := buildHumaHolderKYCApp(t, handler)
+
+	status, _ := doHolderKYC(t, app, http.MethodPost,
+		"/v1/organizations/"+orgID.String()+"/holders/"+holderID.String()+"/kyc/decision",
+		map[string]any{"decision": "REJECT", "reviewer": "compliance@example.com"})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_DecideHolderKYC_SelfReview422(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+	holderType, level := mmodel.HolderTypeNaturalPerson, mmodel.KYCLevelBasic
+
+	handler, repo := newHolderHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).
+		Return(&mmodel.Holder{ID: &holderID, Type: &holderType, KYC: &mmodel.HolderKYC{
+			Status:   mmodel.KYCStatusInReview,
+			Level:    &level,
+			History:  []mmodel.KYCTransition{{To: mmodel.KYCStatusInReview, Actor: "ops@example.com"}},
+			Revision: 4,
+		}}, nil).Times(1)
+
+	app := buildHumaHolderKYCApp(t, handler)
+
+	status, body := doHolderKYC(t, app, http.MethodPost,
+		"/v1/organizations/"+orgID.String()+"/holders/"+holderID.String()+"/kyc/decision",
+		map[string]any{"decision": "APPROVE", "reviewer": "ops@example.com"})
+	assert.Equal(t, http.StatusUnprocessableEntity, status, "body: %s", string(body))
+	assert.Contains(t, string(body), "CRM-0052")
+}
+
+func TestHuma_ListKYCReviewsDue(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+	dueBefore := time.Date(2026, 6, 30, 23, 59, 59, 0, time.UTC)
+
+	handler, repo := newHolderHandler(t, ctrl)
+	repo.EXPECT().FindKYCReviewsDue(gomock.Any(), orgID.String(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, before time.Time, query pkgHTTP.QueryHeader) ([]*mmodel.KYCReviewDue, error) {
+			assert.Equal(t, dueBefore, before.Truncate(time.Second))
+			assert.Equal(t, 5, query.Limit)
+
+			return []*mmodel.KYCReviewDue{{HolderID: uuid.New(), Status: mmodel.KYCStatusVerified, ExpiresAt: time.Now().Add(-time.Hour)}}, nil
+		}).Times(1)
+
+	app := buildHumaHolderKYCApp(t, handler)
+
+	status, body := doHolderKYC(t, app, http.MethodGet,
+		"/v1/organizations/"+orgID.String()+"/kyc/reviews-due?due_before=2026-06-30&limit=5", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	assert.Contains(t, string(body), `"status":"EXPIRED"`)
+
+	status, _ = doHolderKYC(t, app, http.MethodGet,
+		"/v1/organizations/"+orgID.String()+"/kyc/reviews-due?due_before=yesterday", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// getHolderDuplicates is the transport-agnostic core for listing the possible
+// duplicates of a holder. rawMinScore is the min_score query value.
+func (handler *HolderHandler) getHolderDuplicates(ctx context.Context, organizationID, id uuid.UUID, rawMinScore string) ([]*mmodel.HolderDuplicateCandidate, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_duplicates")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	minScore, err := parseMinScore(rawMinScore)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid min_score", err)
+
+		return nil, err
+	}
+
+	candidates, err := handler.Service.GetHolderDuplicateCandidates(ctx, organizationID.String(), id, minScore)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder duplicates", err)
+
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// mergeHolder is the transport-agnostic core for merging a duplicate holder
+// into the holder of the path.
+func (handler *HolderHandler) mergeHolder(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.MergeHolderInput) (*mmodel.HolderMerge, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.merge_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.duplicate_id", payload.DuplicateID),
+	)
+
+	merge, err := handler.Service.MergeHolder(ctx, organizationID.String(), id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to merge holder", err)
+
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// getHolderMerges is the transport-agnostic core for listing the duplicates
+// merged into a holder.
+func (handler *HolderHandler) getHolderMerges(ctx context.Context, organizationID, id uuid.UUID) ([]*mmodel.HolderMerge, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_merges")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	merges, err := handler.Service.GetHolderMerges(ctx, organizationID.String(), id)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder merges", err)
+
+		return nil, err
+	}
+
+	return merges, nil
+}
+
+// parseMinScore reads the min_score query value, a number between 0 and 1
+// defaulting to services.DefaultHolderDuplicateMinScore.
+func parseMinScore(raw string) (float64, error) {
+	if raw == "" {
+		return services.DefaultHolderDuplicateMinScore, nil
+	}
+
+	minScore, err := strconv.ParseFloat(raw, 64)
+	if err != nil || minScore < 0 || minScore > 1 {
+		return 0, pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolder, "min_score")
+	}
+
+	return minScore, nil
+}
+
+// GetHolderDuplicates lists the possible duplicates of a Holder, best first.
+func (handler *HolderHandler) GetHolderDuplicates(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	candidates, err := handler.getHolderDuplicates(c.UserContext(), organizationID, id, c.Query("min_score"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, candidates)
+}
+
+// MergeHolder merges a duplicate Holder into the Holder of the path.
+func (handler *HolderHandler) MergeHolder(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.MergeHolderInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolderMerge))
+	}
+
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	merge, err := handler.mergeHolder(c.UserContext(), organizationID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, merge)
+}
+
+// GetHolderMerges lists the duplicates merged into a Holder, newest first.
+func (handler *HolderHandler) GetHolderMerges(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	merges, err := handler.getHolderMerges(c.UserContext(), organizationID, id)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, merges)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of duplicate holder detection and merge. It
+// follows the holder KYC conventions (holder_kyc_handler_huma.go): auth
+// resource "holders" attached on the Fiber group in crm_routes.go, path ids
+// resolved via parsePathUUID, and the request body decoded+validated
+// imperatively through http.DecodeAndValidate (SkipValidateBody).
+
+// HolderDuplicatesInputHuma is the duplicates request envelope; min_score is
+// parsed imperatively, matching the Fiber c.Query read.
+type HolderDuplicatesInputHuma struct {
+	HolderKYCPathHuma
+
+	MinScore string `query:"min_score" doc:"Minimum score, between 0 and 1, of the reported candidates (default 0.5)"`
+}
+
+// HolderDuplicatesOutputHuma carries the duplicate candidates (200).
+type HolderDuplicatesOutputHuma struct {
+	Status int
+	Body   []*mmodel.HolderDuplicateCandidate
+}
+
+// GetHolderDuplicatesHuma delegates to getHolderDuplicates.
+func (handler *HolderHandler) GetHolderDuplicatesHuma(ctx context.Context, in *HolderDuplicatesInputHuma) (*HolderDuplicatesOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	candidates, err := handler.getHolderDuplicates(ctx, orgID, id, in.MinScore)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderDuplicatesOutputHuma{Status: http.StatusOK, Body: candidates}, nil
+}
+
+// HolderMergeOutputHuma carries one merge (200).
+type HolderMergeOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderMerge
+}
+
+// MergeHolderHuma decodes a MergeHolderInput then delegates to mergeHolder.
+func (handler *HolderHandler) MergeHolderHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderMergeOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.MergeHolderInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	merge, err := handler.mergeHolder(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderMergeOutputHuma{Status: http.StatusOK, Body: merge}, nil
+}
+
+// ListHolderMergesOutputHuma carries the merges (200).
+type ListHolderMergesOutputHuma struct {
+	Status int
+	Body   []*mmodel.HolderMerge
+}
+
+// ListHolderMergesHuma delegates to getHolderMerges.
+func (handler *HolderHandler) ListHolderMergesHuma(ctx context.Context, in *HolderKYCPathHuma) (*ListHolderMergesOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	merges, err := handler.getHolderMerges(ctx, orgID, id)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListHolderMergesOutputHuma{Status: http.StatusOK, Body: merges}, nil
+}
+
+// RegisterHolderMergeRoutes registers duplicate detection and merge on the
+// shared Huma API. Auth is ("midaz","holders","get") for the reads and
+// ("midaz","holders","patch") for the merge, which changes both holders. It
+// is attached BEFORE the Huma terminal in crm_routes.go.
+func RegisterHolderMergeRoutes(api huma.API, h *HolderHandler) {
+	const (
+		holderPath = "/organizations/{organization_id}/holders/{id}"
+		tag        = "Holders"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listHolderDuplicates",
+		Method:      http.MethodGet,
+		Path:        holderPath + "/duplicates",
+		Summary:     "List possible duplicates of a Holder",
+		Description: "Returns the active holders of the same type sharing the holder's document, primary email or " +
+			"mobile phone, scored with the similarity of their names, best first. Matching runs on the " +
+			"encrypted search tokens; holders created before email and phone tokens existed are matched on " +
+			"them once updated.",
+		Tags:     []string{tag},
+		Security: secHolderBearer,
+	}, h.GetHolderDuplicatesHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "mergeHolder",
+		Method:      http.MethodPost,
+		Path:        holderPath + "/merge",
+		Summary:     "Merge a duplicate Holder into this Holder",
+		Description: "Moves the duplicate's accounts and instruments to this holder, renames instrument related-party " +
+			"entries naming the duplicate, and tombstones the duplicate: reads of its id then report this holder. " +
+			"Both holders must be of the same type and the duplicate must have no ownership relationships. " +
+			"Repeating the request resumes an interrupted merge.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.MergeHolderHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listHolderMerges",
+		Method:      http.MethodGet,
+		Path:        holderPath + "/merges",
+		Summary:     "List the duplicates merged into a Holder",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.ListHolderMergesHuma)
+}
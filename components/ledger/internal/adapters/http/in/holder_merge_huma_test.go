@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/merge"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaHolderMergeApp mounts the duplicate holder Huma operations on a /v1
+// group, mirroring buildHumaHolderKYCApp (same MUST-NOT-PARALLELIZE
+// rationale).
+func buildHumaHolderMergeApp(t *testing.T, handler *HolderHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("holder")
+	holder := "/organizations/:organization_id/holders/:id"
+	apiV1.Get(holder+"/duplicates", parse)
+	apiV1.Post(holder+"/merge", parse)
+	apiV1.Get(holder+"/merges", parse)
+
+	RegisterHolderMergeRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_GetHolderDuplicates(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID, otherID := uuid.New(), uuid.New(), uuid.New()
+	holderType := mmodel.HolderTypeNaturalPerson
+	name, document, otherDocument := "John Doe", "91315026015", "11111111111"
+	email := "john.doe@example.com"
+
+	target := &mmodel.Holder{ID: &holderID, Type: &holderType, Name: &name, Document: &document, Contact: &mmodel.Contact{PrimaryEmail: &email}}
+	other := &mmodel.Holder{ID: &otherID, Type: &holderType, Name: &name, Document: &otherDocument, Contact: &mmodel.Contact{PrimaryEmail: &email}}
+
+	handler, repo := newHolderHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).Return(target, nil).Times(1)
+	repo.EXPECT().FindDuplicateCandidates(gomock.Any(), orgID.String(), target, gomock.Any()).Return([]*mmodel.Holder{other}, nil).Times(1)
+
+	app := buildHumaHolderMergeApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/duplicates"
+
+	status, body := doHolderKYC(t, app, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var candidates []mmodel.HolderDuplicateCandidate
+	require.NoError(t, json.Unmarshal(body, &candidates))
+	require.Len(t, candidates, 1)
+	assert.Equal(t, otherID, candidates[0].HolderID)
+	assert.Equal(t, []string{mmodel.HolderDuplicateByEmail, mmodel.HolderDuplicateByName}, candidates[0].MatchedOn)
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"?min_score=2", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_MergeHolder_RejectsInvalidBody(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	handler, _ := newHolderHandler(t, ctrl)
+	handler.Service.MergeRepo = merge.NewMockRepository(ctrl)
+
+	app := buildHumaHolderMergeApp(t, handler)
+
+	holderID := uuid.NewString()
+	path := "/v1/organizations/" + uuid.NewString() + "/holders/" + holderID + "/merge"
+
+	status, _ := doHolderKYC(t, app, http.MethodPost, path, map[string]any{"duplicateId": uuid.NewString()})
+	assert.Equal(t, http.StatusBadRequest, status, "actor is required")
+
+	status, _ = doHolderKYC(t, app, http.MethodPost, path, map[string]any{"duplicateId": "not-a-uuid", "actor": "compliance@example.com"})
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, body := doHolderKYC(t, app, http.MethodPost, path, map[string]any{"duplicateId": holderID, "actor": "compliance@example.com"})
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Contains(t, string(body), "CRM-0076")
+}
+
+func TestHuma_ListHolderMerges(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+	merged := &mmodel.HolderMerge{ID: uuid.New(), SurvivorID: holderID, DuplicateID: uuid.New(), Status: mmodel.HolderMergeCompleted}
+
+	handler, repo := newHolderHandler(t, ctrl)
+	mergeRepo := merge.NewMockRepository(ctrl)
+	handler.Service.MergeRepo = mergeRepo
+
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).Return(&mmodel.Holder{ID: &holderID}, nil).Times(1)
+	mergeRepo.EXPECT().FindAll(gomock.Any(), orgID.String(), holderID).Return([]*mmodel.HolderMerge{merged}, nil).Times(1)
+
+	app := buildHumaHolderMergeApp(t, handler)
+
+	status, body := doHolderKYC(t, app, http.MethodGet, "/v1/organizations/"+orgID.String()+"/holders/"+holderID.String()+"/merges", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var merges []mmodel.HolderMerge
+	require.NoError(t, json.Unmarshal(body, &merges))
+	require.Len(t, merges, 1)
+	assert.Equal(t, merged.DuplicateID, merges[0].DuplicateID)
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// createHolderRelationship is the transport-agnostic core for recording that a
+// holder owns or controls a legal-person holder.
+func (handler *HolderHandler) createHolderRelationship(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.CreateHolderRelationshipInput) (*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.create_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	relationship, err := handler.Service.CreateHolderRelationship(ctx, organizationID.String(), id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to create holder relationship", err)
+
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// getHolderRelationships is the transport-agnostic core for listing the
+// relationships in which a holder is owned or controlled.
+func (handler *HolderHandler) getHolderRelationships(ctx context.Context, organizationID, id uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_relationships")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.Bool("app.request.include_deleted", includeDeleted),
+	)
+
+	relationships, err := handler.Service.GetHolderRelationships(ctx, organizationID.String(), id, includeDeleted)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder relationships", err)
+
+		return nil, err
+	}
+
+	return relationships, nil
+}
+
+// getHolderRelationship is the transport-agnostic core for reading one
+// relationship, deleted or not.
+func (handler *HolderHandler) getHolderRelationship(ctx context.Context, organizationID, id, relationshipID uuid.UUID) (*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.relationship_id", relationshipID.String()),
+	)
+
+	relationship, err := handler.Service.GetHolderRelationship(ctx, organizationID.String(), id, relationshipID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder relationship", err)
+
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// updateHolderRelationship is the transport-agnostic core for changing the
+// terms of a relationship.
+func (handler *HolderHandler) updateHolderRelationship(ctx context.Context, organizationID, id, relationshipID uuid.UUID, payload *mmodel.UpdateHolderRelationshipInput) (*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.update_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.relationship_id", relationshipID.String()),
+	)
+
+	relationship, err := handler.Service.UpdateHolderRelationship(ctx, organizationID.String(), id, relationshipID, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update holder relationship", err)
+
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// deleteHolderRelationship is the transport-agnostic core for deleting a
+// relationship recorded in error.
+func (handler *HolderHandler) deleteHolderRelationship(ctx context.Context, organizationID, id, relationshipID uuid.UUID, actor string) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.delete_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.relationship_id", relationshipID.String()),
+	)
+
+	if err := handler.Service.DeleteHolderRelationship(ctx, organizationID.String(), id, relationshipID, actor); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to delete holder relationship", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getBeneficialOwners is the transport-agnostic core for resolving a
+// legal-person holder's ultimate beneficial owners. as_of is a YYYY-MM-DD
+// date and threshold a percentage; both are optional.
+func (handler *HolderHandler) getBeneficialOwners(ctx context.Context, organizationID, id uuid.UUID, queries map[string]string) (*mmodel.BeneficialOwnership, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_beneficial_owners")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	var asOf mmodel.Date
+
+	if value := queries["as_of"]; value != "" {
+		day, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "as_of")
+
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected unparseable as_of", err)
+
+			return nil, err
+		}
+
+		asOf = mmodel.Date{Time: day}
+	}
+
+	var threshold float64
+
+	if value := queries["threshold"]; value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed <= 0 {
+			err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "threshold")
+
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected invalid threshold", err)
+
+			return nil, err
+		}
+
+		threshold = parsed
+	}
+
+	ownership, err := handler.Service.GetBeneficialOwners(ctx, organizationID.String(), id, asOf, threshold)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get beneficial owners", err)
+
+		return nil, err
+	}
+
+	return ownership, nil
+}
+
+// CreateHolderRelationship records that a holder owns or controls a
+// legal-person Holder.
+func (handler *HolderHandler) CreateHolderRelationship(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.CreateHolderRelationshipInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolderRelationship))
+	}
+
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	relationship, err := handler.createHolderRelationship(c.UserContext(), organizationID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Created(c, relationship)
+}
+
+// GetHolderRelationships lists the relationships in which a Holder is owned or
+// controlled.
+func (handler *HolderHandler) GetHolderRelationships(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	relationships, err := handler.getHolderRelationships(c.UserContext(), organizationID, id, http.GetBooleanParam(c, "include_deleted"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, relationships)
+}
+
+// GetHolderRelationship returns a relationship of a Holder with its history.
+func (handler *HolderHandler) GetHolderRelationship(c *fiber.Ctx) error {
+	organizationID, id, relationshipID, err := relationshipPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	relationship, err := handler.getHolderRelationship(c.UserContext(), organizationID, id, relationshipID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, relationship)
+}
+
+// UpdateHolderRelationship changes the terms of a relationship of a Holder.
+func (handler *HolderHandler) UpdateHolderRelationship(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.UpdateHolderRelationshipInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityHolderRelationship))
+	}
+
+	organizationID, id, relationshipID, err := relationshipPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	relationship, err := handler.updateHolderRelationship(c.UserContext(), organizationID, id, relationshipID, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, relationship)
+}
+
+// DeleteHolderRelationship deletes a relationship of a Holder recorded in error.
+func (handler *HolderHandler) DeleteHolderRelationship(c *fiber.Ctx) error {
+	organizationID, id, relationshipID, err := relationshipPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	if err := handler.deleteHolderRelationship(c.UserContext(), organizationID, id, relationshipID, c.Query("actor")); err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.NoContent(c)
+}
+
+// GetBeneficialOwners resolves the ultimate beneficial owners of a legal-person
+// Holder.
+func (handler *HolderHandler) GetBeneficialOwners(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	ownership, err := handler.getBeneficialOwners(c.UserContext(), organizationID, id, c.Queries())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, ownership)
+}
+
+// relationshipPathIDs reads the organization, holder and relationship ids
+// parsed from the path.
+func relationshipPathIDs(c *fiber.Ctx) (organizationID, id, relationshipID uuid.UUID, err error) {
+	organizationID, id, err = holderPathIDs(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	relationshipID, err = http.GetUUIDFromLocals(c, "relationship_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, relationshipID, nil
+}
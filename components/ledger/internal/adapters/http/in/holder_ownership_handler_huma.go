@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of the beneficial-ownership graph. It follows
+// the holder KYC conventions (holder_kyc_handler_huma.go): auth resource
+// "holders" attached on the Fiber group in crm_routes.go, path ids resolved via
+// parsePathUUID, and request bodies decoded+validated imperatively through
+// http.DecodeAndValidate (SkipValidateBody).
+
+// HolderRelationshipPathHuma is the path of one relationship of a holder.
+type HolderRelationshipPathHuma struct {
+	HolderKYCPathHuma
+
+	RelationshipID string `path:"relationship_id" doc:"Relationship ID (UUID)"`
+}
+
+// resolve parses the relationship path ids.
+func (in *HolderRelationshipPathHuma) resolve() (organizationID, id, relationshipID uuid.UUID, err error) {
+	organizationID, id, err = in.HolderKYCPathHuma.resolve()
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	relationshipID, err = parsePathUUID(in.RelationshipID, "relationship_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, relationshipID, nil
+}
+
+// HolderRelationshipOutputHuma carries one relationship; Status is 201 on
+// create and 200 otherwise.
+type HolderRelationshipOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderRelationship
+}
+
+// CreateHolderRelationshipHuma decodes a CreateHolderRelationshipInput then
+// delegates to createHolderRelationship.
+func (handler *HolderHandler) CreateHolderRelationshipHuma(ctx context.Context, in *HolderKYCBodyInputHuma) (*HolderRelationshipOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.CreateHolderRelationshipInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	relationship, err := handler.createHolderRelationship(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderRelationshipOutputHuma{Status: http.StatusCreated, Body: relationship}, nil
+}
+
+// ListHolderRelationshipsInputHuma is the list request envelope.
+type ListHolderRelationshipsInputHuma struct {
+	HolderKYCPathHuma
+
+	IncludeDeleted string `query:"include_deleted" doc:"Return includes deleted relationships (true,false)"`
+}
+
+// ListHolderRelationshipsOutputHuma carries the relationships (200).
+type ListHolderRelationshipsOutputHuma struct {
+	Status int
+	Body   []*mmodel.HolderRelationship
+}
+
+// ListHolderRelationshipsHuma delegates to getHolderRelationships.
+func (handler *HolderHandler) ListHolderRelationshipsHuma(ctx context.Context, in *ListHolderRelationshipsInputHuma) (*ListHolderRelationshipsOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	relationships, err := handler.getHolderRelationships(ctx, orgID, id, strings.EqualFold(in.IncludeDeleted, "true"))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ListHolderRelationshipsOutputHuma{Status: http.StatusOK, Body: relationships}, nil
+}
+
+// GetHolderRelationshipHuma delegates to getHolderRelationship.
+func (handler *HolderHandler) GetHolderRelationshipHuma(ctx context.Context, in *HolderRelationshipPathHuma) (*HolderRelationshipOutputHuma, error) {
+	orgID, id, relationshipID, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	relationship, err := handler.getHolderRelationship(ctx, orgID, id, relationshipID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderRelationshipOutputHuma{Status: http.StatusOK, Body: relationship}, nil
+}
+
+// UpdateHolderRelationshipInputHuma is the update request envelope (RawBody,
+// see holder Create).
+type UpdateHolderRelationshipInputHuma struct {
+	HolderRelationshipPathHuma
+
+	RawBody []byte `contentType:"application/json"`
+}
+
+// UpdateHolderRelationshipHuma decodes an UpdateHolderRelationshipInput then
+// delegates to updateHolderRelationship.
+func (handler *HolderHandler) UpdateHolderRelationshipHuma(ctx context.Context, in *UpdateHolderRelationshipInputHuma) (*HolderRelationshipOutputHuma, error) {
+	orgID, id, relationshipID, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.UpdateHolderRelationshipInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	relationship, err := handler.updateHolderRelationship(ctx, orgID, id, relationshipID, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderRelationshipOutputHuma{Status: http.StatusOK, Body: relationship}, nil
+}
+
+// DeleteHolderRelationshipInputHuma is the delete request envelope; the actor
+// is recorded in the relationship history.
+type DeleteHolderRelationshipInputHuma struct {
+	HolderRelationshipPathHuma
+
+	Actor string `query:"actor" doc:"The actor deleting the relationship (required)"`
+}
+
+// DeleteHolderRelationshipOutputHuma has NO Body field: bodiless 204, matching
+// Fiber http.NoContent.
+type DeleteHolderRelationshipOutputHuma struct{}
+
+// DeleteHolderRelationshipHuma delegates to deleteHolderRelationship.
+func (handler *HolderHandler) DeleteHolderRelationshipHuma(ctx context.Context, in *DeleteHolderRelationshipInputHuma) (*DeleteHolderRelationshipOutputHuma, error) {
+	orgID, id, relationshipID, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	if err := handler.deleteHolderRelationship(ctx, orgID, id, relationshipID, in.Actor); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &DeleteHolderRelationshipOutputHuma{}, nil
+}
+
+// GetBeneficialOwnersInputHuma advertises the resolution query params
+// (doc-only) and captures the raw query via Resolve for the imperative binder.
+type GetBeneficialOwnersInputHuma struct {
+	HolderKYCPathHuma
+
+	AsOf      string `query:"as_of" doc:"Resolve the ownership effective on this day (YYYY-MM-DD, default today)"`
+	Threshold string `query:"threshold" doc:"Effective ownership, in percent, from which a natural person is reported (default 25)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler (no validation; canonical
+// rejection stays in getBeneficialOwners).
+func (in *GetBeneficialOwnersInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// BeneficialOwnershipOutputHuma carries the resolved owners (200).
+type BeneficialOwnershipOutputHuma struct {
+	Status int
+	Body   *mmodel.BeneficialOwnership
+}
+
+// GetBeneficialOwnersHuma binds the query imperatively then delegates to
+// getBeneficialOwners.
+func (handler *HolderHandler) GetBeneficialOwnersHuma(ctx context.Context, in *GetBeneficialOwnersInputHuma) (*BeneficialOwnershipOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	ownership, err := handler.getBeneficialOwners(ctx, orgID, id, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &BeneficialOwnershipOutputHuma{Status: http.StatusOK, Body: ownership}, nil
+}
+
+// RegisterHolderOwnershipRoutes registers the beneficial-ownership operations
+// on the shared Huma API. Auth is ("midaz","holders","get") for reads and
+// ("midaz","holders","patch") for relationship changes, attached BEFORE the
+// Huma terminal in crm_routes.go.
+func RegisterHolderOwnershipRoutes(api huma.API, h *HolderHandler) {
+	const (
+		relationshipsPath = "/organizations/{organization_id}/holders/{id}/relationships"
+		relationshipPath  = relationshipsPath + "/{relationship_id}"
+		ownersPath        = "/organizations/{organization_id}/holders/{id}/beneficial-owners"
+		tag               = "Holders"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "createHolderRelationship",
+		Method:      http.MethodPost,
+		Path:        relationshipsPath,
+		Summary:     "Record an ownership or control relationship",
+		Description: "Records that the owner holds a stake in, or otherwise controls, the legal-person holder. " +
+			"Rejects stakes taking the holder above 100% on any day, duplicates over an overlapping period and cycles.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		DefaultStatus:    http.StatusCreated,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.CreateHolderRelationshipHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listHolderRelationships",
+		Method:      http.MethodGet,
+		Path:        relationshipsPath,
+		Summary:     "List a Holder's owners and controllers",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.ListHolderRelationshipsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getHolderRelationship",
+		Method:      http.MethodGet,
+		Path:        relationshipPath,
+		Summary:     "Retrieve an ownership or control relationship",
+		Description: "Returns the relationship with its full change history, including when it was deleted.",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetHolderRelationshipHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "updateHolderRelationship",
+		Method:      http.MethodPatch,
+		Path:        relationshipPath,
+		Summary:     "Update an ownership or control relationship",
+		Description: "Changes the share, description or end date. Set effectiveTo when a stake is sold; " +
+			"the owner, type and start are fixed.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true,
+	}, h.UpdateHolderRelationshipHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "deleteHolderRelationship",
+		Method:        http.MethodDelete,
+		Path:          relationshipPath,
+		Summary:       "Delete an ownership or control relationship",
+		Description:   "Deletes a relationship recorded in error. It stays readable by id with its history.",
+		Tags:          []string{tag},
+		Security:      secHolderBearer,
+		DefaultStatus: http.StatusNoContent,
+	}, h.DeleteHolderRelationshipHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getBeneficialOwners",
+		Method:      http.MethodGet,
+		Path:        ownersPath,
+		Summary:     "Resolve a Holder's ultimate beneficial owners",
+		Description: "Walks the ownership graph effective on as_of and reports the natural persons whose effective " +
+			"ownership, multiplied along each chain and summed over chains, reaches the threshold, and those " +
+			"who control the holder through control relationships or majority stakes.",
+		Tags:     []string{tag},
+		Security: secHolderBearer,
+	}, h.GetBeneficialOwnersHuma)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaHolderOwnershipApp mounts the beneficial-ownership Huma operations
+// on a /v1 group, mirroring buildHumaHolderKYCApp (same MUST-NOT-PARALLELIZE
+// rationale).
+func buildHumaHolderOwnershipApp(t *testing.T, handler *HolderHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("holder")
+	relationships := "/organizations/:organization_id/holders/:id/relationships"
+	apiV1.Post(relationships, parse)
+	apiV1.Get(relationships, parse)
+	apiV1.Get(relationships+"/:relationship_id", parse)
+	apiV1.Patch(relationships+"/:relationship_id", parse)
+	apiV1.Delete(relationships+"/:relationship_id", parse)
+	apiV1.Get("/organizations/:organization_id/holders/:id/beneficial-owners", parse)
+
+	RegisterHolderOwnershipRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_CreateHolderRelationship(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, companyID, ownerID := uuid.New(), uuid.New(), uuid.New()
+	legal, natural := mmodel.HolderTypeLegalPerson, mmodel.HolderTypeNaturalPerson
+
+	handler, repo := newHolderHandler(t, ctrl)
+	ownershipRepo := ownership.NewMockRepository(ctrl)
+	handler.Service.OwnershipRepo = ownershipRepo
+
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), companyID, false).
+		Return(&mmodel.Holder{ID: &companyID, Type: &legal}, nil).Times(1)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), ownerID, false).
+		Return(&mmodel.Holder{ID: &ownerID, Type: &natural}, nil).Times(1)
+	ownershipRepo.EXPECT().FindByHolders(gomock.Any(), orgID.String(), []uuid.UUID{companyID}, false).
+		Return([]*mmodel.HolderRelationship{}, nil).Times(1)
+	ownershipRepo.EXPECT().FindByHolders(gomock.Any(), orgID.String(), []uuid.UUID{ownerID}, false).
+		Return([]*mmodel.HolderRelationship{}, nil).Times(1)
+	ownershipRepo.EXPECT().Create(gomock.Any(), orgID.String(), gomock.Any()).Return(nil).Times(1)
+
+	app := buildHumaHolderOwnershipApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + companyID.String() + "/relationships"
+
+	status, body := doHolderKYC(t, app, http.MethodPost, path, map[string]any{
+		"ownerId": ownerID.String(), "type": "OWNERSHIP", "percentage": 60, "effectiveFrom": "2025-01-01", "actor": "compliance",
+	})
+	require.Equal(t, http.StatusCreated, status, "body: %s", string(body))
+
+	var got mmodel.HolderRelationship
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, ownerID, got.OwnerID)
+	assert.Equal(t, mmodel.HolderTypeNaturalPerson, got.OwnerType)
+	assert.Equal(t, "2025-01-01", got.EffectiveFrom.Format("2006-01-02"))
+
+	// An unknown type is rejected before the service is called.
+	status, _ = doHolderKYC(t, app, http.MethodPost, path, map[string]any{"ownerId": ownerID.String(), "type": "VOTING", "actor": "compliance"})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_DeleteHolderRelationship(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, companyID, relationshipID := uuid.New(), uuid.New(), uuid.New()
+
+	handler, _ := newHolderHandler(t, ctrl)
+	ownershipRepo := ownership.NewMockRepository(ctrl)
+	handler.Service.OwnershipRepo = ownershipRepo
+
+	ownershipRepo.EXPECT().Find(gomock.Any(), orgID.String(), companyID, relationshipID, false).
+		Return(nil, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship)).Times(1)
+
+	app := buildHumaHolderOwnershipApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + companyID.String() + "/relationships/" + relationshipID.String()
+
+	status, _ := doHolderKYC(t, app, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusBadRequest, status, "actor is required")
+
+	status, body := doHolderKYC(t, app, http.MethodDelete, path+"?actor=compliance", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Contains(t, string(body), "CRM-0063")
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, "/v1/organizations/"+orgID.String()+"/holders/"+companyID.String()+"/relationships/not-a-uuid", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_GetBeneficialOwners(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, companyID, aliceID := uuid.New(), uuid.New(), uuid.New()
+	legal, name := mmodel.HolderTypeLegalPerson, "Alice"
+	stake := 80.0
+
+	handler, repo := newHolderHandler(t, ctrl)
+	ownershipRepo := ownership.NewMockRepository(ctrl)
+	handler.Service.OwnershipRepo = ownershipRepo
+
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), companyID, false).
+		Return(&mmodel.Holder{ID: &companyID, Type: &legal}, nil).Times(1)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), aliceID, true).
+		Return(&mmodel.Holder{ID: &aliceID, Name: &name}, nil).Times(1)
+	ownershipRepo.EXPECT().FindByHolders(gomock.Any(), orgID.String(), []uuid.UUID{companyID}, false).
+		Return([]*mmodel.HolderRelationship{{
+			ID: uuid.New(), HolderID: companyID, OwnerID: aliceID, OwnerType: mmodel.HolderTypeNaturalPerson,
+			Type: mmodel.HolderRelationshipOwnership, Percentage: &stake, EffectiveFrom: mmodel.Date{},
+		}}, nil).Times(1)
+
+	app := buildHumaHolderOwnershipApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + companyID.String() + "/beneficial-owners"
+
+	status, body := doHolderKYC(t, app, http.MethodGet, path+"?as_of=2025-06-30&threshold=10", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var got mmodel.BeneficialOwnership
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, "2025-06-30", got.AsOf.Format("2006-01-02"))
+	assert.Equal(t, 10.0, got.Threshold)
+	assert.Equal(t, 20.0, got.UnattributedPercentage)
+	require.Len(t, got.Owners, 1)
+	assert.Equal(t, "Alice", got.Owners[0].Name)
+	assert.Equal(t, []string{mmodel.BeneficialOwnerByOwnership, mmodel.BeneficialOwnerByControl}, got.Owners[0].Reasons)
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"?as_of=30/06/2025", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"?threshold=abc", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// getHolderPosition is the transport-agnostic core for reading a holder's
+// consolidated position. as_of is a "yyyy-mm-dd hh:mm:ss" point in time, as
+// for the balance history, and reference_asset an asset code; both are
+// optional.
+func (handler *HolderHandler) getHolderPosition(ctx context.Context, organizationID, id uuid.UUID, queries map[string]string) (*mmodel.HolderPosition, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_holder_position")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	var asOf *time.Time
+
+	if value := queries["as_of"]; value != "" {
+		at, hasTime, err := libCommons.ParseDateTime(value, false)
+		if err != nil || !hasTime {
+			err := pkg.ValidateBusinessError(cn.ErrInvalidDatetimeFormat, cn.EntityHolder, "as_of", "yyyy-mm-dd hh:mm:ss")
+
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected unparseable as_of", err)
+
+			return nil, err
+		}
+
+		asOf = &at
+	}
+
+	position, err := handler.Service.GetHolderPosition(ctx, organizationID.String(), id, asOf, strings.TrimSpace(queries["reference_asset"]))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get holder position", err)
+
+		return nil, err
+	}
+
+	return position, nil
+}
+
+// GetHolderPosition reads the consolidated position of a Holder across its
+// accounts and assets.
+func (handler *HolderHandler) GetHolderPosition(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	position, err := handler.getHolderPosition(c.UserContext(), organizationID, id, c.Queries())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, position)
+}
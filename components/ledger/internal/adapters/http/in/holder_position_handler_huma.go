@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of the holder position view. It follows the
+// beneficial-ownership conventions (holder_ownership_handler_huma.go): auth
+// resource "holders" attached on the Fiber group in crm_routes.go, path ids
+// resolved via parsePathUUID, and the query bound imperatively.
+
+// GetHolderPositionInputHuma advertises the position query params (doc-only)
+// and captures the raw query via Resolve for the imperative binder.
+type GetHolderPositionInputHuma struct {
+	HolderKYCPathHuma
+
+	AsOf           string `query:"as_of" doc:"Read the balances as they stood at this time (yyyy-mm-dd hh:mm:ss, default now)"`
+	ReferenceAsset string `query:"reference_asset" doc:"Also convert the totals into this asset with the ledgers' asset rates"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler (no validation; canonical
+// rejection stays in getHolderPosition).
+func (in *GetHolderPositionInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// HolderPositionOutputHuma carries the holder position (200).
+type HolderPositionOutputHuma struct {
+	Status int
+	Body   *mmodel.HolderPosition
+}
+
+// GetHolderPositionHuma binds the query imperatively then delegates to
+// getHolderPosition.
+func (handler *HolderHandler) GetHolderPositionHuma(ctx context.Context, in *GetHolderPositionInputHuma) (*HolderPositionOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	position, err := handler.getHolderPosition(ctx, orgID, id, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &HolderPositionOutputHuma{Status: http.StatusOK, Body: position}, nil
+}
+
+// RegisterHolderPositionRoutes registers the holder position view on the
+// shared Huma API. Auth is ("midaz","holders","get"), attached BEFORE the Huma
+// terminal in crm_routes.go.
+func RegisterHolderPositionRoutes(api huma.API, h *HolderHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "getHolderPosition",
+		Method:      http.MethodGet,
+		Path:        "/organizations/{organization_id}/holders/{id}/position",
+		Summary:     "Retrieve a Holder's consolidated position",
+		Description: "Returns every active account of the holder across the organization's ledgers with its " +
+			"balances per key and asset, pending holds and overdraft used, and the totals per asset. With " +
+			"as_of the balances are read as they stood at that time (without overdraft usage). With " +
+			"reference_asset the totals are also converted with the current rates of each account's ledger; " +
+			"assets without a rate are listed as unconverted.",
+		Tags:     []string{"Holders"},
+		Security: secHolderBearer,
+	}, h.GetHolderPositionHuma)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// stubHolderPositionReader is a hand-written stub for
+// services.HolderPositionReader. It serves one account with one balance and
+// captures the time historical balances are read at.
+type stubHolderPositionReader struct {
+	account *mmodel.Account
+	balance *mmodel.Balance
+
+	gotAt *time.Time
+}
+
+func (s *stubHolderPositionReader) ListAccountsByHolder(_ context.Context, _, _ uuid.UUID) ([]*mmodel.Account, error) {
+	return []*mmodel.Account{s.account}, nil
+}
+
+func (s *stubHolderPositionReader) ListBalancesByAccounts(_ context.Context, _, _ uuid.UUID, _ []uuid.UUID) ([]*mmodel.Balance, error) {
+	return []*mmodel.Balance{s.balance}, nil
+}
+
+func (s *stubHolderPositionReader) ListAccountBalancesAt(_ context.Context, _, _, _ uuid.UUID, at time.Time) ([]*mmodel.Balance, error) {
+	s.gotAt = &at
+
+	return []*mmodel.Balance{s.balance}, nil
+}
+
+func (s *stubHolderPositionReader) GetAssetRate(_ context.Context, _, _ uuid.UUID, _, _ string) (*decimal.Decimal, error) {
+	rate := decimal.RequireFromString("0.2")
+
+	return &rate, nil
+}
+
+// buildHumaHolderPositionApp mounts the holder position Huma operation on a
+// /v1 group, mirroring buildHumaHolderMergeApp (same MUST-NOT-PARALLELIZE
+// rationale).
+func buildHumaHolderPositionApp(t *testing.T, handler *HolderHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	apiV1.Get("/organizations/:organization_id/holders/:id/position", pkgHTTP.ParseUUIDPathParameters("holder"))
+
+	RegisterHolderPositionRoutes(hAPI, handler)
+
+	return f
+}
+
+func newHolderPositionApp(t *testing.T, orgID, holderID uuid.UUID) (*fiber.App, *stubHolderPositionReader) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	accountID, ledgerID := uuid.New(), uuid.New()
+	reader := &stubHolderPositionReader{
+		account: &mmodel.Account{ID: accountID.String(), LedgerID: ledgerID.String(), AssetCode: "BRL", CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		balance: &mmodel.Balance{AccountID: accountID.String(), Key: "default", AssetCode: "BRL", Available: decimal.NewFromInt(1000), OnHold: decimal.NewFromInt(500)},
+	}
+
+	handler, repo := newHolderHandler(t, ctrl)
+	handler.Service.HolderPositions = reader
+
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, false).Return(&mmodel.Holder{ID: &holderID}, nil).AnyTimes()
+
+	return buildHumaHolderPositionApp(t, handler), reader
+}
+
+func TestHuma_GetHolderPosition(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	orgID, holderID := uuid.New(), uuid.New()
+	app, _ := newHolderPositionApp(t, orgID, holderID)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/position?reference_asset=USD"
+
+	status, body := doHolderKYC(t, app, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var position mmodel.HolderPosition
+	require.NoError(t, json.Unmarshal(body, &position))
+	require.Len(t, position.Accounts, 1)
+	require.Len(t, position.Totals, 1)
+	assert.True(t, decimal.NewFromInt(1500).Equal(position.Totals[0].Net))
+	require.NotNil(t, position.Converted)
+	assert.True(t, decimal.NewFromInt(300).Equal(position.Converted.Net))
+}
+
+func TestHuma_GetHolderPosition_AsOf(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	orgID, holderID := uuid.New(), uuid.New()
+	app, reader := newHolderPositionApp(t, orgID, holderID)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/position"
+
+	status, body := doHolderKYC(t, app, http.MethodGet, path+"?as_of=2026-03-01%2010:30:00", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	require.NotNil(t, reader.gotAt)
+	assert.Equal(t, time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC), reader.gotAt.UTC())
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"?as_of=2026-03-01", nil)
+	assert.Equal(t, http.StatusBadRequest, status, "as_of needs a time component")
+
+	status, _ = doHolderKYC(t, app, http.MethodGet, path+"?reference_asset=us-dollar", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
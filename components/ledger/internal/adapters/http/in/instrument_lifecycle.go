@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// instrumentTransitionStep is one lifecycle transition of the instrument
+// service (BlockInstrument, UnblockInstrument or CloseInstrument).
+type instrumentTransitionStep func(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error)
+
+// blockInstrument is the transport-agnostic core for blocking an instrument.
+func (handler *InstrumentHandler) blockInstrument(ctx context.Context, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return handler.transitionInstrument(ctx, "handler.block_instrument", organizationID, holderID, id, payload, handler.Service.BlockInstrument)
+}
+
+// unblockInstrument is the transport-agnostic core for unblocking an instrument.
+func (handler *InstrumentHandler) unblockInstrument(ctx context.Context, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return handler.transitionInstrument(ctx, "handler.unblock_instrument", organizationID, holderID, id, payload, handler.Service.UnblockInstrument)
+}
+
+// closeInstrument is the transport-agnostic core for closing an instrument.
+func (handler *InstrumentHandler) closeInstrument(ctx context.Context, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return handler.transitionInstrument(ctx, "handler.close_instrument", organizationID, holderID, id, payload, handler.Service.CloseInstrument)
+}
+
+// transitionInstrument runs one lifecycle transition under a handler span.
+func (handler *InstrumentHandler) transitionInstrument(ctx context.Context, spanName string, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput, step instrumentTransitionStep) (*mmodel.Instrument, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, spanName)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.instrument_id", id.String()),
+	)
+
+	instrument, err := step(ctx, organizationID.String(), holderID, id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to transition instrument", err)
+
+		return nil, err
+	}
+
+	return instrument, nil
+}
+
+// BlockInstrument blocks an Instrument and, unless opted out, its ledger account.
+func (handler *InstrumentHandler) BlockInstrument(p any, c *fiber.Ctx) error {
+	return handler.respondInstrumentTransition(p, c, handler.blockInstrument)
+}
+
+// UnblockInstrument unblocks an Instrument and, unless opted out, its ledger account.
+func (handler *InstrumentHandler) UnblockInstrument(p any, c *fiber.Ctx) error {
+	return handler.respondInstrumentTransition(p, c, handler.unblockInstrument)
+}
+
+// CloseInstrument closes an Instrument and, unless opted out, its ledger account.
+func (handler *InstrumentHandler) CloseInstrument(p any, c *fiber.Ctx) error {
+	return handler.respondInstrumentTransition(p, c, handler.closeInstrument)
+}
+
+// respondInstrumentTransition reads the path ids and payload of a Fiber
+// request, runs core and writes the transitioned instrument.
+func (handler *InstrumentHandler) respondInstrumentTransition(p any, c *fiber.Ctx, core func(ctx context.Context, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error)) error {
+	payload, ok := p.(*mmodel.InstrumentTransitionInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityInstrument))
+	}
+
+	id, err := http.GetUUIDFromLocals(c, "instrument_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	holderID, err := http.GetUUIDFromLocals(c, "holder_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	instrument, err := core(c.UserContext(), organizationID, holderID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, instrument)
+}
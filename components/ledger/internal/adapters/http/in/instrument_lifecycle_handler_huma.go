@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of the instrument lifecycle. It follows the
+// instrument conventions (instrument_handler_huma.go): auth resource
+// "instruments" attached on the Fiber group in crm_routes.go, path ids resolved
+// via parsePathUUID, and the request body decoded+validated imperatively
+// through http.DecodeAndValidate (SkipValidateBody).
+
+// InstrumentTransitionInputHuma is the envelope of the lifecycle transitions
+// (RawBody, see instrument Create).
+type InstrumentTransitionInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	HolderID       string `path:"holder_id" doc:"Holder ID (UUID)"`
+	InstrumentID   string `path:"instrument_id" doc:"Instrument ID (UUID)"`
+	RawBody        []byte `contentType:"application/json"`
+}
+
+// InstrumentTransitionOutputHuma carries the transitioned instrument (200,
+// matching http.OK).
+type InstrumentTransitionOutputHuma struct {
+	Status int
+	Body   *mmodel.Instrument
+}
+
+// BlockInstrumentHuma delegates to blockInstrument.
+func (handler *InstrumentHandler) BlockInstrumentHuma(ctx context.Context, in *InstrumentTransitionInputHuma) (*InstrumentTransitionOutputHuma, error) {
+	return handler.transitionInstrumentHuma(ctx, in, handler.blockInstrument)
+}
+
+// UnblockInstrumentHuma delegates to unblockInstrument.
+func (handler *InstrumentHandler) UnblockInstrumentHuma(ctx context.Context, in *InstrumentTransitionInputHuma) (*InstrumentTransitionOutputHuma, error) {
+	return handler.transitionInstrumentHuma(ctx, in, handler.unblockInstrument)
+}
+
+// CloseInstrumentHuma delegates to closeInstrument.
+func (handler *InstrumentHandler) CloseInstrumentHuma(ctx context.Context, in *InstrumentTransitionInputHuma) (*InstrumentTransitionOutputHuma, error) {
+	return handler.transitionInstrumentHuma(ctx, in, handler.closeInstrument)
+}
+
+// transitionInstrumentHuma parses the path ids, decodes an
+// InstrumentTransitionInput then delegates to core.
+func (handler *InstrumentHandler) transitionInstrumentHuma(ctx context.Context, in *InstrumentTransitionInputHuma, core func(ctx context.Context, organizationID, holderID, id uuid.UUID, payload *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error)) (*InstrumentTransitionOutputHuma, error) {
+	orgID, err := parsePathUUID(in.OrganizationID, "organization_id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	holderID, err := parsePathUUID(in.HolderID, "holder_id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	id, err := parsePathUUID(in.InstrumentID, "instrument_id")
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.InstrumentTransitionInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	instrument, err := core(ctx, orgID, holderID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &InstrumentTransitionOutputHuma{Status: http.StatusOK, Body: instrument}, nil
+}
+
+// RegisterInstrumentLifecycleRoutes registers the lifecycle transitions on the
+// shared Huma API. Auth is ("midaz","instruments","patch") for every
+// transition. It is attached BEFORE the Huma terminal in crm_routes.go.
+func RegisterInstrumentLifecycleRoutes(api huma.API, h *InstrumentHandler) {
+	const (
+		idPath = "/organizations/{organization_id}/holders/{holder_id}/instruments/{instrument_id}"
+		tag    = "Instruments"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "blockInstrument",
+		Method:      http.MethodPost,
+		Path:        idPath + "/block",
+		Summary:     "Block an Instrument",
+		Description: "Moves an ACTIVE instrument to BLOCKED and records the transition with its reason. Unless " +
+			"propagateToAccount is false or propagation is disabled for the deployment, the linked ledger " +
+			"account is blocked first.",
+		Tags:             []string{tag},
+		Security:         secInstrumentBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.BlockInstrumentHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "unblockInstrument",
+		Method:      http.MethodPost,
+		Path:        idPath + "/unblock",
+		Summary:     "Unblock an Instrument",
+		Description: "Returns a BLOCKED instrument to ACTIVE and records the transition with its reason. Unless " +
+			"propagateToAccount is false or propagation is disabled for the deployment, the linked ledger " +
+			"account is unblocked first.",
+		Tags:             []string{tag},
+		Security:         secInstrumentBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.UnblockInstrumentHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "closeInstrument",
+		Method:      http.MethodPost,
+		Path:        idPath + "/close",
+		Summary:     "Close an Instrument",
+		Description: "Moves an ACTIVE or BLOCKED instrument to CLOSED for good, records the transition with its " +
+			"reason and sets the banking details' closing date when none was set. Unless propagateToAccount " +
+			"is false or propagation is disabled for the deployment, every balance of the linked ledger " +
+			"account must be empty and the account is soft-closed first.",
+		Tags:             []string{tag},
+		Security:         secInstrumentBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.CloseInstrumentHuma)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaInstrumentLifecycleApp mounts the instrument lifecycle Huma
+// operations on a /v1 group, mirroring buildHumaHolderMergeApp (same
+// MUST-NOT-PARALLELIZE rationale).
+func buildHumaInstrumentLifecycleApp(t *testing.T, handler *InstrumentHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("instruments")
+	instrument := "/organizations/:organization_id/holders/:holder_id/instruments/:instrument_id"
+	apiV1.Post(instrument+"/block", parse)
+	apiV1.Post(instrument+"/unblock", parse)
+	apiV1.Post(instrument+"/close", parse)
+
+	RegisterInstrumentLifecycleRoutes(hAPI, handler)
+
+	return f
+}
+
+func TestHuma_BlockInstrument_Success(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID, instrumentID := uuid.New(), uuid.New(), uuid.New()
+
+	handler, repo := newInstrumentHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, instrumentID, false).
+		Return(&mmodel.Instrument{ID: &instrumentID, HolderID: &holderID}, nil).Times(1)
+	repo.EXPECT().UpdateLifecycle(gomock.Any(), orgID.String(), holderID, instrumentID, gomock.Any(), nil, int64(0)).
+		Return(nil).Times(1)
+
+	app := buildHumaInstrumentLifecycleApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/instruments/" + instrumentID.String() + "/block"
+
+	status, body := doHolderKYC(t, app, http.MethodPost, path, map[string]any{"actor": "ops@example.com", "reason": "card reported stolen"})
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var instrument mmodel.Instrument
+	require.NoError(t, json.Unmarshal(body, &instrument))
+	require.NotNil(t, instrument.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, instrument.Lifecycle.Status)
+	require.Len(t, instrument.Lifecycle.History, 1)
+	assert.Equal(t, "card reported stolen", instrument.Lifecycle.History[0].Reason)
+}
+
+func TestHuma_BlockInstrument_RejectsInvalidBody(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	handler, _ := newInstrumentHandler(t, ctrl)
+
+	app := buildHumaInstrumentLifecycleApp(t, handler)
+
+	path := "/v1/organizations/" + uuid.NewString() + "/holders/" + uuid.NewString() + "/instruments/" + uuid.NewString() + "/block"
+
+	status, _ := doHolderKYC(t, app, http.MethodPost, path, map[string]any{"actor": "ops@example.com"})
+	assert.Equal(t, http.StatusBadRequest, status, "reason is required")
+}
+
+func TestHuma_CloseInstrument_AlreadyClosed(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID, instrumentID := uuid.New(), uuid.New(), uuid.New()
+
+	handler, repo := newInstrumentHandler(t, ctrl)
+	repo.EXPECT().Find(gomock.Any(), orgID.String(), holderID, instrumentID, false).
+		Return(&mmodel.Instrument{
+			ID:        &instrumentID,
+			HolderID:  &holderID,
+			Lifecycle: &mmodel.InstrumentLifecycle{Status: mmodel.InstrumentStatusClosed, Revision: 1},
+		}, nil).Times(1)
+
+	app := buildHumaInstrumentLifecycleApp(t, handler)
+
+	path := "/v1/organizations/" + orgID.String() + "/holders/" + holderID.String() + "/instruments/" + instrumentID.String() + "/close"
+
+	status, body := doHolderKYC(t, app, http.MethodPost, path, map[string]any{"actor": "ops@example.com", "reason": "customer request"})
+	assert.Equal(t, http.StatusUnprocessableEntity, status, "body: %s", string(body))
+	assert.Contains(t, string(body), "CRM-0080")
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	mongoScreening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/screening"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WatchListMaxPayloadSize bounds a watch-list upload. Published sanctions
+// lists are far larger than JSON payloads, so the server body limit is sized
+// for them (see NewUnifiedServer).
+const WatchListMaxPayloadSize = 32 * 1024 * 1024
+
+// ScreeningHandler handles HTTP requests for watch lists and screening alerts.
+type ScreeningHandler struct {
+	Service screening.Service
+}
+
+// screeningAlertStatuses are the accepted values of the alerts status filter.
+var screeningAlertStatuses = map[string]struct{}{
+	mmodel.ScreeningAlertOpen:      {},
+	mmodel.ScreeningAlertConfirmed: {},
+	mmodel.ScreeningAlertDismissed: {},
+}
+
+// loadWatchList is the transport-agnostic core for a watch-list upload.
+func (handler *ScreeningHandler) loadWatchList(ctx context.Context, organizationID uuid.UUID, name, format, uploadedBy string, data []byte) (*mmodel.WatchList, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.load_watch_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.watch_list", name),
+		attribute.Int("app.request.payload_size", len(data)),
+	)
+
+	if len(data) > WatchListMaxPayloadSize {
+		err := pkg.ValidateBusinessError(cn.ErrPayloadTooLarge, "request")
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected oversized watch list", err)
+
+		return nil, err
+	}
+
+	list, err := handler.Service.LoadList(ctx, organizationID.String(), name, strings.ToUpper(format), uploadedBy, data)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to load watch list", err)
+
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// getWatchLists is the transport-agnostic core for the watch-list listing.
+func (handler *ScreeningHandler) getWatchLists(ctx context.Context, organizationID uuid.UUID) ([]*mmodel.WatchList, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_watch_lists")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	lists, err := handler.Service.GetLists(ctx, organizationID.String())
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get watch lists", err)
+
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// deleteWatchList is the transport-agnostic core for a watch-list removal.
+func (handler *ScreeningHandler) deleteWatchList(ctx context.Context, organizationID uuid.UUID, name string) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.delete_watch_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.watch_list", name),
+	)
+
+	if err := handler.Service.DeleteList(ctx, organizationID.String(), name); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to delete watch list", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// getScreeningAlerts is the transport-agnostic core for the alerts listing.
+// status, holder_id and list are bound here; http.ValidateParameters only
+// sees limit/page.
+func (handler *ScreeningHandler) getScreeningAlerts(ctx context.Context, organizationID uuid.UUID, queries map[string]string) (http.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_screening_alerts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	validateQueries := make(map[string]string, len(queries))
+
+	for key, value := range queries {
+		if key == "status" || key == "holder_id" || key == "list" {
+			continue
+		}
+
+		validateQueries[key] = value
+	}
+
+	headerParams, err := http.ValidateParameters(validateQueries)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to validate query parameters", err)
+
+		return http.Pagination{}, err
+	}
+
+	query := mongoScreening.AlertQuery{
+		Status:   strings.ToUpper(queries["status"]),
+		ListName: queries["list"],
+		Page:     *headerParams,
+	}
+
+	if query.Status != "" {
+		if _, ok := screeningAlertStatuses[query.Status]; !ok {
+			err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, "", "status")
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected unsupported alert status filter", err)
+
+			return http.Pagination{}, err
+		}
+	}
+
+	if value := queries["holder_id"]; value != "" {
+		holderID, err := uuid.Parse(value)
+		if err != nil {
+			err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, "", "holder_id")
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected invalid holder_id filter", err)
+
+			return http.Pagination{}, err
+		}
+
+		query.HolderID = &holderID
+	}
+
+	pagination := http.Pagination{
+		Limit: headerParams.Limit,
+		Page:  headerParams.Page,
+	}
+
+	alerts, err := handler.Service.GetAlerts(ctx, organizationID.String(), query)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get screening alerts", err)
+
+		return http.Pagination{}, err
+	}
+
+	pagination.SetItems(alerts)
+
+	return pagination, nil
+}
+
+// getPendingScreenings is the transport-agnostic core for the listing of the
+// subjects whose screening failed.
+func (handler *ScreeningHandler) getPendingScreenings(ctx context.Context, organizationID uuid.UUID, queries map[string]string) (http.Pagination, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_pending_screenings")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	headerParams, err := http.ValidateParameters(queries)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to validate query parameters", err)
+
+		return http.Pagination{}, err
+	}
+
+	pagination := http.Pagination{
+		Limit: headerParams.Limit,
+		Page:  headerParams.Page,
+	}
+
+	pending, err := handler.Service.GetPending(ctx, organizationID.String(), *headerParams)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get pending screenings", err)
+
+		return http.Pagination{}, err
+	}
+
+	pagination.SetItems(pending)
+
+	return pagination, nil
+}
+
+// getScreeningAlert is the transport-agnostic core for a single alert read.
+func (handler *ScreeningHandler) getScreeningAlert(ctx context.Context, organizationID, id uuid.UUID) (*mmodel.ScreeningAlert, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.get_screening_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.alert_id", id.String()),
+	)
+
+	alert, err := handler.Service.GetAlert(ctx, organizationID.String(), id)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get screening alert", err)
+
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// reviewScreeningAlert is the transport-agnostic core for an alert review.
+func (handler *ScreeningHandler) reviewScreeningAlert(ctx context.Context, organizationID, id uuid.UUID, payload *mmodel.ReviewScreeningAlertInput) (*mmodel.ScreeningAlert, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.review_screening_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.alert_id", id.String()),
+		attribute.String("app.request.decision", payload.Decision),
+	)
+
+	alert, err := handler.Service.ReviewAlert(ctx, organizationID.String(), id, payload)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to review screening alert", err)
+
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// LoadWatchList stores the request body as the new version of a watch list.
+func (handler *ScreeningHandler) LoadWatchList(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	list, err := handler.loadWatchList(c.UserContext(), organizationID, c.Params("name"), c.Query("format"), c.Query("uploaded_by"), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, list)
+}
+
+// GetWatchLists lists the watch lists of an organization.
+func (handler *ScreeningHandler) GetWatchLists(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	lists, err := handler.getWatchLists(c.UserContext(), organizationID)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, lists)
+}
+
+// DeleteWatchList removes a watch list.
+func (handler *ScreeningHandler) DeleteWatchList(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	if err := handler.deleteWatchList(c.UserContext(), organizationID, c.Params("name")); err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.NoContent(c)
+}
+
+// GetScreeningAlerts lists the screening alerts of an organization.
+func (handler *ScreeningHandler) GetScreeningAlerts(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	pagination, err := handler.getScreeningAlerts(c.UserContext(), organizationID, c.Queries())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, pagination)
+}
+
+// GetPendingScreenings lists the subjects whose screening failed.
+func (handler *ScreeningHandler) GetPendingScreenings(c *fiber.Ctx) error {
+	organizationID, err := http.GetUUIDFromLocals(c, "organization_id")
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	pagination, err := handler.getPendingScreenings(c.UserContext(), organizationID, c.Queries())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, pagination)
+}
+
+// GetScreeningAlert retrieves a screening alert.
+func (handler *ScreeningHandler) GetScreeningAlert(c *fiber.Ctx) error {
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	alert, err := handler.getScreeningAlert(c.UserContext(), organizationID, id)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, alert)
+}
+
+// ReviewScreeningAlert confirms or dismisses an open screening alert.
+func (handler *ScreeningHandler) ReviewScreeningAlert(p any, c *fiber.Ctx) error {
+	payload, ok := p.(*mmodel.ReviewScreeningAlertInput)
+	if !ok || payload == nil {
+		return http.WithError(c, pkg.ValidateInternalError(nil, cn.EntityScreeningAlert))
+	}
+
+	organizationID, id, err := holderPathIDs(c)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	alert, err := handler.reviewScreeningAlert(c.UserContext(), organizationID, id, payload)
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, alert)
+}
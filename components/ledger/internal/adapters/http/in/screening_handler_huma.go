@@ -0,0 +1,345 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// This file is the Huma surface of sanctions and watch-list screening. It
+// follows the holder KYC conventions (holder_kyc_handler_huma.go): auth
+// resource "screening" attached on the Fiber group in crm_routes.go, org-scoped
+// paths resolved via parsePathUUID, and the review body decoded+validated
+// imperatively through http.DecodeAndValidate (SkipValidateBody). The list
+// upload takes the raw file as the body, bounded by WatchListMaxPayloadSize.
+
+// LoadWatchListInputHuma is the upload envelope: the list file is the raw body.
+type LoadWatchListInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Name           string `path:"name" doc:"Watch list name (lowercase letters, digits, '-' and '_')"`
+	Format         string `query:"format" doc:"File format: OFAC_SDN_CSV, OFAC_SDN_XML, UN_CONSOLIDATED_XML or CUSTOM_CSV"`
+	UploadedBy     string `query:"uploaded_by" doc:"Actor recorded as the uploader"`
+	RawBody        []byte `contentType:"application/octet-stream"`
+}
+
+// WatchListOutputHuma carries a watch list (200, matching http.OK).
+type WatchListOutputHuma struct {
+	Status int
+	Body   *mmodel.WatchList
+}
+
+// LoadWatchListHuma delegates to loadWatchList.
+func (handler *ScreeningHandler) LoadWatchListHuma(ctx context.Context, in *LoadWatchListInputHuma) (*WatchListOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	list, err := handler.loadWatchList(ctx, orgID, in.Name, in.Format, in.UploadedBy, in.RawBody)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &WatchListOutputHuma{Status: http.StatusOK, Body: list}, nil
+}
+
+// GetWatchListsInputHuma is the watch-list listing envelope.
+type GetWatchListsInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+}
+
+// GetWatchListsOutputHuma carries the organization's watch lists.
+type GetWatchListsOutputHuma struct {
+	Status int
+	Body   []*mmodel.WatchList
+}
+
+// GetWatchListsHuma delegates to getWatchLists.
+func (handler *ScreeningHandler) GetWatchListsHuma(ctx context.Context, in *GetWatchListsInputHuma) (*GetWatchListsOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	lists, err := handler.getWatchLists(ctx, orgID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &GetWatchListsOutputHuma{Status: http.StatusOK, Body: lists}, nil
+}
+
+// DeleteWatchListInputHuma is the watch-list removal envelope.
+type DeleteWatchListInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Name           string `path:"name" doc:"Watch list name"`
+}
+
+// DeleteWatchListOutputHuma has NO Body field: paired with DefaultStatus 204 it
+// makes Huma emit a bodiless 204, matching the Fiber http.NoContent path.
+type DeleteWatchListOutputHuma struct{}
+
+// DeleteWatchListHuma delegates to deleteWatchList.
+func (handler *ScreeningHandler) DeleteWatchListHuma(ctx context.Context, in *DeleteWatchListInputHuma) (*DeleteWatchListOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	if err := handler.deleteWatchList(ctx, orgID, in.Name); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &DeleteWatchListOutputHuma{}, nil
+}
+
+// GetScreeningAlertsInputHuma advertises the alert filters (doc-only) and
+// captures the raw query via Resolve for the imperative binder.
+type GetScreeningAlertsInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Status         string `query:"status" doc:"Filter by status: OPEN, CONFIRMED or DISMISSED"`
+	HolderID       string `query:"holder_id" doc:"Filter by Holder ID (UUID)"`
+	List           string `query:"list" doc:"Filter by watch list name"`
+	Limit          string `query:"limit" doc:"Max items per page (1-100, default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler (no validation; canonical
+// rejection stays in getScreeningAlerts).
+func (in *GetScreeningAlertsInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// GetScreeningAlertsOutputHuma carries the pagination envelope verbatim.
+type GetScreeningAlertsOutputHuma struct {
+	Status int
+	Body   pkgHTTP.Pagination
+}
+
+// GetScreeningAlertsHuma binds the query imperatively then delegates to
+// getScreeningAlerts.
+func (handler *ScreeningHandler) GetScreeningAlertsHuma(ctx context.Context, in *GetScreeningAlertsInputHuma) (*GetScreeningAlertsOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.getScreeningAlerts(ctx, orgID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &GetScreeningAlertsOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// GetPendingScreeningsInputHuma advertises the pagination (doc-only) and
+// captures the raw query via Resolve for the imperative binder.
+type GetPendingScreeningsInputHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	Limit          string `query:"limit" doc:"Max items per page (1-100, default 10)"`
+	Page           string `query:"page" doc:"Page number (default 1)"`
+
+	rawQuery url.Values
+}
+
+// Resolve captures the raw query before the handler (no validation; canonical
+// rejection stays in getPendingScreenings).
+func (in *GetPendingScreeningsInputHuma) Resolve(ctx huma.Context) []error {
+	u := ctx.URL()
+	in.rawQuery = u.Query()
+
+	return nil
+}
+
+// GetPendingScreeningsOutputHuma carries the pagination envelope verbatim.
+type GetPendingScreeningsOutputHuma struct {
+	Status int
+	Body   pkgHTTP.Pagination
+}
+
+// GetPendingScreeningsHuma binds the query imperatively then delegates to
+// getPendingScreenings.
+func (handler *ScreeningHandler) GetPendingScreeningsHuma(ctx context.Context, in *GetPendingScreeningsInputHuma) (*GetPendingScreeningsOutputHuma, error) {
+	orgID, err := parseOrg(in.OrganizationID)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	pagination, err := handler.getPendingScreenings(ctx, orgID, queriesFromValues(in.rawQuery))
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &GetPendingScreeningsOutputHuma{Status: http.StatusOK, Body: pagination}, nil
+}
+
+// ScreeningAlertPathHuma is the path of a screening alert.
+type ScreeningAlertPathHuma struct {
+	OrganizationID string `path:"organization_id" doc:"Organization ID (UUID)"`
+	ID             string `path:"id" doc:"Screening alert ID (UUID)"`
+}
+
+// resolve parses the alert path ids.
+func (in *ScreeningAlertPathHuma) resolve() (organizationID, id uuid.UUID, err error) {
+	organizationID, err = parseOrg(in.OrganizationID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	id, err = parsePathUUID(in.ID, "id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return organizationID, id, nil
+}
+
+// ScreeningAlertOutputHuma carries a screening alert (200, matching http.OK).
+type ScreeningAlertOutputHuma struct {
+	Status int
+	Body   *mmodel.ScreeningAlert
+}
+
+// GetScreeningAlertHuma delegates to getScreeningAlert.
+func (handler *ScreeningHandler) GetScreeningAlertHuma(ctx context.Context, in *ScreeningAlertPathHuma) (*ScreeningAlertOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	alert, err := handler.getScreeningAlert(ctx, orgID, id)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ScreeningAlertOutputHuma{Status: http.StatusOK, Body: alert}, nil
+}
+
+// ReviewScreeningAlertInputHuma is the review envelope (RawBody, see holder
+// Create).
+type ReviewScreeningAlertInputHuma struct {
+	ScreeningAlertPathHuma
+
+	RawBody []byte `contentType:"application/json"`
+}
+
+// ReviewScreeningAlertHuma decodes a ReviewScreeningAlertInput then delegates
+// to reviewScreeningAlert.
+func (handler *ScreeningHandler) ReviewScreeningAlertHuma(ctx context.Context, in *ReviewScreeningAlertInputHuma) (*ScreeningAlertOutputHuma, error) {
+	orgID, id, err := in.resolve()
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	payload := new(mmodel.ReviewScreeningAlertInput)
+	if _, err := pkgHTTP.DecodeAndValidate(in.RawBody, payload); err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	alert, err := handler.reviewScreeningAlert(ctx, orgID, id, payload)
+	if err != nil {
+		return nil, pkgHTTP.HumaProblem(err)
+	}
+
+	return &ScreeningAlertOutputHuma{Status: http.StatusOK, Body: alert}, nil
+}
+
+// RegisterScreeningRoutes registers the screening operations on the shared
+// Huma API. Auth is ("midaz","screening",verb) with the verb of each method,
+// attached BEFORE the Huma terminal in crm_routes.go.
+func RegisterScreeningRoutes(api huma.API, h *ScreeningHandler) {
+	const (
+		listsPath   = "/organizations/{organization_id}/screening/lists"
+		alertsPath  = "/organizations/{organization_id}/screening/alerts"
+		pendingPath = "/organizations/{organization_id}/screening/pending"
+		tag         = "Screening"
+	)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "loadWatchList",
+		Method:      http.MethodPut,
+		Path:        listsPath + "/{name}",
+		Summary:     "Upload a watch list",
+		Description: "Parses the body as a watch-list file of the given format and stores it as the next version of the named list. " +
+			"The whole holder base is then screened again in the background; the list's last_rescreen reports the progress.",
+		Tags:         []string{tag},
+		Security:     secHolderBearer,
+		MaxBodyBytes: WatchListMaxPayloadSize,
+	}, h.LoadWatchListHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getWatchLists",
+		Method:      http.MethodGet,
+		Path:        listsPath,
+		Summary:     "List watch lists",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetWatchListsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "deleteWatchList",
+		Method:        http.MethodDelete,
+		Path:          listsPath + "/{name}",
+		Summary:       "Delete a watch list",
+		Description:   "Removes the list and its entries. Alerts raised from the list are kept.",
+		Tags:          []string{tag},
+		Security:      secHolderBearer,
+		DefaultStatus: http.StatusNoContent,
+	}, h.DeleteWatchListHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getScreeningAlerts",
+		Method:      http.MethodGet,
+		Path:        alertsPath,
+		Summary:     "List screening alerts",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetScreeningAlertsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getPendingScreenings",
+		Method:      http.MethodGet,
+		Path:        pendingPath,
+		Summary:     "List pending screenings",
+		Description: "Lists the holders and instruments whose screening failed, oldest first. " +
+			"They are retried in the background and leave the list once screened, or once a list upload re-screens the holder base.",
+		Tags:     []string{tag},
+		Security: secHolderBearer,
+	}, h.GetPendingScreeningsHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getScreeningAlert",
+		Method:      http.MethodGet,
+		Path:        alertsPath + "/{id}",
+		Summary:     "Retrieve a screening alert",
+		Tags:        []string{tag},
+		Security:    secHolderBearer,
+	}, h.GetScreeningAlertHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "reviewScreeningAlert",
+		Method:      http.MethodPost,
+		Path:        alertsPath + "/{id}/review",
+		Summary:     "Review a screening alert",
+		Description: "Confirms an OPEN alert as a true match or dismisses it as a false positive. " +
+			"A reviewed alert is not raised again for the same list entry.",
+		Tags:             []string{tag},
+		Security:         secHolderBearer,
+		SkipValidateBody: true, // body validated imperatively (http.DecodeAndValidate).
+	}, h.ReviewScreeningAlertHuma)
+}
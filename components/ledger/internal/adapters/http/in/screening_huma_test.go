@@ -0,0 +1,262 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	openapi "github.com/LerianStudio/lib-commons/v5/commons/net/http/openapi"
+	libProblem "github.com/LerianStudio/lib-commons/v5/commons/net/http/problem"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mongoScreening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/screening"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	pkgHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// buildHumaScreeningApp mounts the screening Huma operations on a /v1 group,
+// mirroring buildHumaHolderKYCApp (same MUST-NOT-PARALLELIZE rationale).
+func buildHumaScreeningApp(t *testing.T, handler *ScreeningHandler) *fiber.App {
+	t.Helper()
+
+	f := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          pkgHTTP.CanonicalFiberErrorHandler,
+		BodyLimit:             WatchListMaxPayloadSize,
+	})
+
+	libProblem.Install()
+
+	apiV1 := f.Group("/v1")
+	hAPI := openapi.New(f, apiV1, openapi.Config{Title: "ledger-test", Version: "test", Servers: []string{"/v1"}})
+
+	parse := pkgHTTP.ParseUUIDPathParameters("organization")
+	lists := "/organizations/:organization_id/screening/lists"
+	alerts := "/organizations/:organization_id/screening/alerts"
+	pending := "/organizations/:organization_id/screening/pending"
+	apiV1.Put(lists+"/:name", parse)
+	apiV1.Get(lists, parse)
+	apiV1.Delete(lists+"/:name", parse)
+	apiV1.Get(alerts, parse)
+	apiV1.Get(alerts+"/:id", parse)
+	apiV1.Post(alerts+"/:id/review", parse)
+	apiV1.Get(pending, parse)
+
+	RegisterScreeningRoutes(hAPI, handler)
+
+	return f
+}
+
+// doScreening sends a request with the given content type and returns status
+// and body.
+func doScreening(t *testing.T, app *fiber.App, method, path, contentType string, body []byte) (int, []byte) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, respBody
+}
+
+func TestHuma_LoadWatchList(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+	data := []byte("name,type\nFulano de Tal,person\n")
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().LoadList(gomock.Any(), orgID.String(), "internal", mmodel.WatchListFormatCustomCSV, "compliance@example.com", data).
+		Return(&mmodel.WatchList{Name: "internal", Format: mmodel.WatchListFormatCustomCSV, Version: 2, EntryCount: 1}, nil).Times(1)
+	service.EXPECT().LoadList(gomock.Any(), orgID.String(), "internal", mmodel.WatchListFormatOFACSDNCSV, "", gomock.Any()).
+		Return(nil, pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, mmodel.WatchListFormatOFACSDNCSV, "line 1 has 2 columns, expected at least 4")).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	path := "/v1/organizations/" + orgID.String() + "/screening/lists/internal"
+
+	status, body := doScreening(t, app, http.MethodPut, path+"?format=custom_csv&uploaded_by=compliance@example.com", "text/csv", data)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+
+	var got mmodel.WatchList
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, int64(2), got.Version)
+	assert.Equal(t, 1, got.EntryCount)
+
+	status, body = doScreening(t, app, http.MethodPut, path+"?format=OFAC_SDN_CSV", "text/csv", []byte("36,NAME\n"))
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Contains(t, string(body), "CRM-0056")
+}
+
+func TestHuma_LoadWatchList_LargerThanJSONLimit(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+	data := []byte("name\n" + strings.Repeat("Fulano de Tal\n", 200_000))
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().LoadList(gomock.Any(), orgID.String(), "internal", mmodel.WatchListFormatCustomCSV, "", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, body []byte) (*mmodel.WatchList, error) {
+			assert.Len(t, body, len(data))
+
+			return &mmodel.WatchList{Name: "internal", Version: 1}, nil
+		}).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	status, body := doScreening(t, app, http.MethodPut,
+		"/v1/organizations/"+orgID.String()+"/screening/lists/internal?format=CUSTOM_CSV", "text/csv", data)
+	assert.Equal(t, http.StatusOK, status, "body: %s", string(body))
+}
+
+func TestHuma_DeleteWatchList(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID := uuid.New()
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().DeleteList(gomock.Any(), orgID.String(), "internal").Return(nil).Times(1)
+	service.EXPECT().DeleteList(gomock.Any(), orgID.String(), "missing").
+		Return(pkg.ValidateBusinessError(cn.ErrWatchListNotFound, cn.EntityWatchList, "missing")).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	status, _ := doScreening(t, app, http.MethodDelete, "/v1/organizations/"+orgID.String()+"/screening/lists/internal", "", nil)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	status, body := doScreening(t, app, http.MethodDelete, "/v1/organizations/"+orgID.String()+"/screening/lists/missing", "", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Contains(t, string(body), "CRM-0059")
+}
+
+func TestHuma_GetScreeningAlerts(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().GetAlerts(gomock.Any(), orgID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, query mongoScreening.AlertQuery) ([]*mmodel.ScreeningAlert, error) {
+			assert.Equal(t, mmodel.ScreeningAlertOpen, query.Status)
+			assert.Equal(t, "ofac-sdn", query.ListName)
+			require.NotNil(t, query.HolderID)
+			assert.Equal(t, holderID, *query.HolderID)
+			assert.Equal(t, 5, query.Page.Limit)
+
+			return []*mmodel.ScreeningAlert{{ID: uuid.New(), HolderID: holderID, Status: mmodel.ScreeningAlertOpen}}, nil
+		}).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	path := "/v1/organizations/" + orgID.String() + "/screening/alerts"
+
+	status, body := doScreening(t, app, http.MethodGet, path+"?status=open&list=ofac-sdn&holder_id="+holderID.String()+"&limit=5", "", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	assert.Contains(t, string(body), `"status":"OPEN"`)
+
+	status, _ = doScreening(t, app, http.MethodGet, path+"?status=ESCALATED", "", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = doScreening(t, app, http.MethodGet, path+"?holder_id=not-a-uuid", "", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_GetPendingScreenings(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, holderID := uuid.New(), uuid.New()
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().GetPending(gomock.Any(), orgID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, page pkgHTTP.QueryHeader) ([]*mmodel.PendingScreening, error) {
+			assert.Equal(t, 5, page.Limit)
+			assert.Equal(t, 2, page.Page)
+
+			return []*mmodel.PendingScreening{{HolderID: holderID, SubjectType: mmodel.ScreeningSubjectHolder, Attempts: 2}}, nil
+		}).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	path := "/v1/organizations/" + orgID.String() + "/screening/pending"
+
+	status, body := doScreening(t, app, http.MethodGet, path+"?limit=5&page=2", "", nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	assert.Contains(t, string(body), `"holderId":"`+holderID.String()+`"`)
+	assert.Contains(t, string(body), `"attempts":2`)
+
+	status, _ = doScreening(t, app, http.MethodGet, path+"?limit=0", "", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestHuma_ReviewScreeningAlert(t *testing.T) {
+	// NOT parallel: process-global huma state.
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	orgID, alertID := uuid.New(), uuid.New()
+
+	service := screening.NewMockService(ctrl)
+	service.EXPECT().ReviewAlert(gomock.Any(), orgID.String(), alertID, &mmodel.ReviewScreeningAlertInput{
+		Decision: mmodel.ScreeningDecisionDismiss,
+		Reviewer: "compliance@example.com",
+		Note:     "different date of birth",
+	}).Return(&mmodel.ScreeningAlert{ID: alertID, Status: mmodel.ScreeningAlertDismissed}, nil).Times(1)
+	service.EXPECT().ReviewAlert(gomock.Any(), orgID.String(), alertID, gomock.Any()).
+		Return(nil, pkg.ValidateBusinessError(cn.ErrScreeningAlertAlreadyReviewed, cn.EntityScreeningAlert, mmodel.ScreeningAlertDismissed)).Times(1)
+
+	app := buildHumaScreeningApp(t, &ScreeningHandler{Service: service})
+
+	path := "/v1/organizations/" + orgID.String() + "/screening/alerts/" + alertID.String() + "/review"
+	review, _ := json.Marshal(map[string]any{"decision": "DISMISS", "reviewer": "compliance@example.com", "note": "different date of birth"})
+
+	status, body := doScreening(t, app, http.MethodPost, path, "application/json", review)
+	require.Equal(t, http.StatusOK, status, "body: %s", string(body))
+	assert.Contains(t, string(body), `"status":"DISMISSED"`)
+
+	status, body = doScreening(t, app, http.MethodPost, path, "application/json", review)
+	assert.Equal(t, http.StatusConflict, status)
+	assert.Contains(t, string(body), "CRM-0061")
+
+	// An unknown decision is rejected before the service is called.
+	invalid, _ := json.Marshal(map[string]any{"decision": "ESCALATE", "reviewer": "compliance@example.com"})
+	status, _ = doScreening(t, app, http.MethodPost, path, "application/json", invalid)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
@@ -31,11 +31,31 @@ type TransactionHandler struct {
 	// injected at bootstrap from the fee use case; a nil applier disables fee
 	// application (the create path stays unchanged).
 	FeeApplier FeeApplier
+	// FeeQuoteApplier charges transactions that reference a fee quote the quoted
+	// fees. It is injected at bootstrap from the fee use case; without it a
+	// transaction carrying a feeQuoteId is rejected.
+	FeeQuoteApplier FeeQuoteApplier
+	// FeeReverser applies the packages' fee reversal policies to reverts. It is
+	// injected at bootstrap from the fee use case; a nil reverser refunds every
+	// fee on revert.
+	FeeReverser FeeReverser
+	// FeeRevenueRecorder records the fees a posted transaction charged, waived
+	// or refunded. It is injected at bootstrap from the fee use case; a nil
+	// recorder disables fee revenue recording.
+	FeeRevenueRecorder FeeRevenueRecorder
+	// FeePromotionReleaser gives back the fee promotion usage reserved by a
+	// transaction that does not post. It is injected at bootstrap from the fee
+	// use case; a nil releaser leaves the counters untouched.
+	FeePromotionReleaser FeePromotionReleaser
 	// TracerReserver drives the tracer two-phase reservation lifecycle from the
 	// create seam. It is injected at bootstrap from the tracer HTTP client; a
 	// nil reserver means the tracer integration is disabled (the create path
 	// stays unchanged). The per-ledger tracer.mode gate lives at the call site.
 	TracerReserver TracerReserver
+	// HolderKYC enforces the per-ledger kyc.requiredLevel from the create seam.
+	// It is injected at bootstrap from the CRM holder service; a nil reader
+	// disables the KYC gate (the create path stays unchanged).
+	HolderKYC HolderKYCReader
 	// FeesMongoManager resolves the CURRENT tenant's fee Mongo database at the
 	// fee seam when MultiTenantEnabled is true. The fee pack/billing repos read
 	// the GENERIC tmcore MB key, which the route-scoped feesTenantMiddleware
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+)
+
+// billingIdempotencyTTL is the idempotency window, in seconds (the unit
+// ParseIdempotencyTTL yields), for billing-run postings. It is deliberately far
+// longer than the 300s header default: a retry of a failed statement may come
+// hours after the first attempt and must still replay instead of re-posting.
+const billingIdempotencyTTL = 7 * 24 * 60 * 60
+
+// PostBillingTransaction posts a billing charge through the same
+// transport-neutral createTransaction core as POST /transactions/json, so a
+// billing run gets the full transaction path (validation, fee seam, balance
+// locking, idempotency) instead of a side channel. It returns the ID of the
+// created transaction and the amount it moved, or those of the original one
+// when the idempotency key replays. It satisfies the fee services'
+// BillingTransactionPoster port.
+func (handler *TransactionHandler) PostBillingTransaction(ctx context.Context, organizationID, ledgerID uuid.UUID, input mtransaction.Transaction, idempotencyKey string) (*model.BillingPosting, error) {
+	params := &transactionPathParams{OrganizationID: organizationID, LedgerID: ledgerID, TransactionID: uuid.Nil}
+
+	tran, _, err := handler.createTransaction(ctx, params, input, input.InitialStatus(), idempotencyKey, billingIdempotencyTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	posting := &model.BillingPosting{TransactionID: tran.ID}
+	if tran.Amount != nil {
+		posting.Amount = *tran.Amount
+	}
+
+	return posting, nil
+}
@@ -1202,6 +1202,18 @@ func (handler *TransactionHandler) executeCreateTransaction(ctx context.Context,
 		return nil, false, err
 	}
 
+	// Promotion anchor: pricing reserved the usage of the promotions that
+	// waived fees, so every exit before the balance commit succeeds gives it
+	// back. A quoted transaction was not priced by the engine and reserved
+	// nothing.
+	promotionsReserved := transactionInput.FeeQuoteID == nil && handler.feesApply(isRevert, transactionStatus == constant.NOTED, honoredFeeSkip)
+
+	defer func() {
+		if promotionsReserved {
+			handler.releasePromotionUsage(ctx, logger, &transactionInput, params.OrganizationID, transactionID.String())
+		}
+	}()
+
 	// Normalize the fee-mutated send: applyFees rebuilds Source.From/Distribute.To
 	// from the engine output with BARE aliases and without IsFrom, so the same
 	// normalization the raw input received (default balance keys, IsFrom on
@@ -1298,6 +1310,21 @@ func (handler *TransactionHandler) executeCreateTransaction(ctx context.Context,
 		return nil, false, err
 	}
 
+	// KYC gate: when the ledger requires a verification level, every holder
+	// owning a touched account must hold it. Reverts are exempt so a posting can
+	// always be unwound, even after a holder's verification lapsed.
+	if !isRevert {
+		if err := rejectUnverifiedHolders(ctx, handler.HolderKYC, handler.Query.ListAccountsByIDs, params.OrganizationID, params.LedgerID, balances, ledgerSettings.KYC.RequiredLevel); err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Rejected transaction for unverified holder", err)
+			logger.Log(ctx, libLog.LevelWarn, "Rejected transaction for unverified holder", libLog.Err(err))
+
+			handler.deleteIdempotencyKey(ctx, idempotencyResult.InternalKey)
+			handler.Command.RemoveTransactionFromRedisQueue(ctx, logger, params.OrganizationID, params.LedgerID, transactionID.String())
+
+			return nil, false, err
+		}
+	}
+
 	balanceOps := buildBalanceOperations(ctx, params.OrganizationID, params.LedgerID, validate, balances)
 
 	// Overdraft enrichment: when a source debit exceeds available funds on a
@@ -1354,6 +1381,24 @@ func (handler *TransactionHandler) executeCreateTransaction(ctx context.Context,
 		return nil, false, reservation.Err
 	}
 
+	// Fee quote anchor: a quoted transaction consumes its quote only now, right
+	// before the balance commit, so a transaction rejected above leaves the
+	// quote open for a retry. Losing the quote to a concurrent transaction
+	// rejects this one before any balance moves.
+	quoted := transactionInput.FeeQuoteID != nil && handler.feesApply(isRevert, transactionStatus == constant.NOTED, honoredFeeSkip)
+	if quoted {
+		if err := handler.consumeFeeQuote(ctx, &transactionInput, params.OrganizationID); err != nil {
+			handleSpanByErrorClass(span, "Failed to consume fee quote", err)
+			logger.Log(ctx, libLog.LevelWarn, "Failed to consume fee quote", libLog.Err(err))
+
+			handler.deleteIdempotencyKey(ctx, idempotencyResult.InternalKey)
+			handler.Command.RemoveTransactionFromRedisQueue(ctx, logger, params.OrganizationID, params.LedgerID, transactionID.String())
+			handler.releaseReservations(ctx, span, logger, reservation.Handle)
+
+			return nil, false, err
+		}
+	}
+
 	result, err := handler.Command.ProcessBalanceOperations(ctx, command.ProcessBalanceOperationsInput{
 		OrganizationID:    params.OrganizationID,
 		LedgerID:          params.LedgerID,
@@ -1375,9 +1420,18 @@ func (handler *TransactionHandler) executeCreateTransaction(ctx context.Context,
 		// reconciled by the TTL reaper.
 		handler.releaseReservations(ctx, span, logger, reservation.Handle)
 
+		// Nor was the fee quote charged, so reopen it for a retry.
+		if quoted {
+			handler.releaseFeeQuote(ctx, logger, &transactionInput, params.OrganizationID)
+		}
+
 		return nil, false, err
 	}
 
+	// The balance moved, so the transaction keeps its promotion usage even if
+	// persisting it fails below: the backup queue reconstructs it.
+	promotionsReserved = false
+
 	// Confirm anchor (F3-T14, success phase): the balance commit succeeded, so
 	// the held capacity is consumed. PENDING transactions defer the confirm to
 	// /commit (and release to /cancel) — see F3-T15 — so the reservation stays
@@ -1486,6 +1540,12 @@ func (handler *TransactionHandler) executeCreateTransaction(ctx context.Context,
 		return nil, false, sanitizedErr
 	}
 
+	// Pending transactions are recorded when committed; annotations move no
+	// balance, so they charge no fee.
+	if transactionStatus != constant.PENDING && transactionStatus != constant.NOTED {
+		handler.recordFeeRevenue(ctx, logger, &transactionInput, params.OrganizationID, params.LedgerID, tran.ID, isRevert, transactionDate)
+	}
+
 	bgCtx := tmcore.ContextWithTenantID(context.Background(), tmcore.GetTenantIDContext(ctx))
 
 	go handler.Command.SetTransactionIdempotencyValue(bgCtx, params.OrganizationID, params.LedgerID, idempotencyKey, idempotencyHash, *tran, idempotencyTTL)
@@ -7,12 +7,17 @@ package in
 import (
 	"context"
 	"fmt"
+	"time"
 
 	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 
 	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
 	"github.com/LerianStudio/midaz/v4/pkg/mtransaction"
 )
 
@@ -35,6 +40,45 @@ type FeeApplier interface {
 	CalculateFee(ctx context.Context, cf *model.FeeCalculate, organizationID uuid.UUID) error
 }
 
+// FeeQuoteApplier charges a transaction the fees frozen in a fee quote instead of
+// recalculating them. It is the narrow port the create seam depends on for
+// transactions carrying a feeQuoteId; the ApplyFeeQuote signature mirrors
+// FeeApplier, with the quote to honor. A missing, expired, used or mismatched
+// quote is a business error.
+//
+// Applying only checks the quote. It is consumed right before the balance
+// commit and released when that commit fails, mirroring the tracer
+// reservation lifecycle.
+type FeeQuoteApplier interface {
+	ApplyFeeQuote(ctx context.Context, cf *model.FeeCalculate, organizationID, quoteID uuid.UUID) error
+	ConsumeFeeQuote(ctx context.Context, organizationID, quoteID uuid.UUID) error
+	ReleaseFeeQuote(ctx context.Context, organizationID, quoteID uuid.UUID) error
+}
+
+// FeeReverser applies the fee reversal policies of the packages that charged a
+// transaction to the revert built from its operations. It is the narrow port the
+// revert path depends on so the fee use case can be injected at bootstrap and
+// faked in tests. The revert's legs are rewritten in place: kept fees leave both
+// sides, and a reversal fee is carved out of the payer's refund.
+type FeeReverser interface {
+	ApplyFeeReversalPolicy(ctx context.Context, organizationID uuid.UUID, revert *mtransaction.Transaction) error
+}
+
+// FeeRevenueRecorder records what the package fees of a posted transaction
+// charged, waived or refunded, for fee revenue reports. It is the narrow port
+// the create and commit paths depend on so the fee use case can be injected at
+// bootstrap and faked in tests.
+type FeeRevenueRecorder interface {
+	RecordFeeRevenue(ctx context.Context, organizationID, ledgerID uuid.UUID, transactionID string, t *mtransaction.Transaction, revert bool, at time.Time) error
+}
+
+// FeePromotionReleaser gives back the fee promotion usage reserved when a
+// transaction was priced. It is the narrow port the create and cancel paths
+// depend on, so a promotion is only used up by a transaction that posts.
+type FeePromotionReleaser interface {
+	ReleasePromotionUsage(ctx context.Context, organizationID uuid.UUID, t *mtransaction.Transaction) error
+}
+
 // applyFees drives the fee engine on the validated transaction and folds the
 // resulting fee legs back into transactionInput. It mirrors the shape of
 // enrichOverdraftOperations: a single seam that loads packages, runs the
@@ -50,7 +94,8 @@ type FeeApplier interface {
 //
 // On isRevert=true this is a no-op: the reverse transaction already carries the
 // reversed fee legs reconstructed by TransactionRevert from the persisted
-// parent operations, so re-charging here would double the fees.
+// parent operations (as adjusted by applyFeeReversal), so re-charging here would
+// double the fees.
 //
 // On isAnnotation=true (NOTED transactions) this is also a no-op: an annotation
 // is one-sided and records no real balance movement, so charging it a fee would
@@ -65,17 +110,18 @@ type FeeApplier interface {
 // agreed at the resolution point upstream) bypasses the entire engine: no package
 // lookup, no tenant resolution, no send mutation. The transaction posts as
 // authored.
+//
+// A transaction carrying a feeQuoteId is charged the quoted fees through
+// FeeQuoteApplier instead of the engine, so it pays exactly what was displayed
+// when the quote was issued. Without a FeeQuoteApplier no quote can be honored
+// and the transaction is rejected rather than silently recalculated.
 func (handler *TransactionHandler) applyFees(
 	ctx context.Context,
 	transactionInput *mtransaction.Transaction,
 	organizationID, ledgerID uuid.UUID,
 	isRevert, isAnnotation, honoredFeeSkip bool,
 ) error {
-	if honoredFeeSkip {
-		return nil
-	}
-
-	if isRevert || isAnnotation || handler.FeeApplier == nil {
+	if !handler.feesApply(isRevert, isAnnotation, honoredFeeSkip) {
 		return nil
 	}
 
@@ -95,7 +141,11 @@ func (handler *TransactionHandler) applyFees(
 
 	// The error is logged once by the seam caller (executeCreateTransaction);
 	// recording it here too would double-log the same failure (T8).
-	if err := handler.FeeApplier.CalculateFee(feesCtx, cf, organizationID); err != nil {
+	if transactionInput.FeeQuoteID != nil {
+		if err := handler.applyFeeQuote(feesCtx, cf, organizationID, *transactionInput.FeeQuoteID); err != nil {
+			return err
+		}
+	} else if err := handler.FeeApplier.CalculateFee(feesCtx, cf, organizationID); err != nil {
 		return err
 	}
 
@@ -107,6 +157,146 @@ func (handler *TransactionHandler) applyFees(
 	return nil
 }
 
+// feesApply reports whether applyFees runs the fee engine (or honors a fee
+// quote) on a transaction, as opposed to one of its no-op cases.
+func (handler *TransactionHandler) feesApply(isRevert, isAnnotation, honoredFeeSkip bool) bool {
+	return !honoredFeeSkip && !isRevert && !isAnnotation && handler.FeeApplier != nil
+}
+
+// applyFeeQuote charges cf the fees of the quote referenced by the transaction.
+func (handler *TransactionHandler) applyFeeQuote(ctx context.Context, cf *model.FeeCalculate, organizationID uuid.UUID, feeQuoteID string) error {
+	quoteID, err := uuid.Parse(feeQuoteID)
+	if err != nil || handler.FeeQuoteApplier == nil {
+		return pkg.ValidateBusinessError(constant.ErrFeeQuoteNotFound, constant.EntityFeeQuote, feeQuoteID)
+	}
+
+	return handler.FeeQuoteApplier.ApplyFeeQuote(ctx, cf, organizationID, quoteID)
+}
+
+// consumeFeeQuote marks the fee quote a transaction was charged as used, right
+// before its balance commit. A quote another transaction used first, or that
+// expired since it was applied, rejects the transaction.
+func (handler *TransactionHandler) consumeFeeQuote(ctx context.Context, transactionInput *mtransaction.Transaction, organizationID uuid.UUID) error {
+	quoteID, ok := feeQuoteID(transactionInput)
+	if !ok || handler.FeeQuoteApplier == nil {
+		return nil
+	}
+
+	feesCtx, err := handler.resolveFeesTenantContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return handler.FeeQuoteApplier.ConsumeFeeQuote(feesCtx, organizationID, quoteID)
+}
+
+// releaseFeeQuote reopens the fee quote of a transaction that did not post. It
+// is best-effort: the quote expires on its own, so a failure is only logged.
+func (handler *TransactionHandler) releaseFeeQuote(ctx context.Context, logger libLog.Logger, transactionInput *mtransaction.Transaction, organizationID uuid.UUID) {
+	quoteID, ok := feeQuoteID(transactionInput)
+	if !ok || handler.FeeQuoteApplier == nil {
+		return
+	}
+
+	feesCtx, err := handler.resolveFeesTenantContext(ctx)
+	if err == nil {
+		err = handler.FeeQuoteApplier.ReleaseFeeQuote(feesCtx, organizationID, quoteID)
+	}
+
+	if err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Failed to release fee quote",
+			libLog.String("fee_quote_id", quoteID.String()), libLog.Err(err))
+	}
+}
+
+// feeQuoteID returns the fee quote a transaction references, if any.
+func feeQuoteID(transactionInput *mtransaction.Transaction) (uuid.UUID, bool) {
+	if transactionInput == nil || transactionInput.FeeQuoteID == nil {
+		return uuid.Nil, false
+	}
+
+	quoteID, err := uuid.Parse(*transactionInput.FeeQuoteID)
+
+	return quoteID, err == nil
+}
+
+// applyFeeReversal runs the packages' fee reversal policies over a revert before
+// it is created. A nil reverser refunds every fee, as reverts always did.
+func (handler *TransactionHandler) applyFeeReversal(ctx context.Context, revert *mtransaction.Transaction, organizationID uuid.UUID) error {
+	if handler.FeeReverser == nil {
+		return nil
+	}
+
+	feesCtx, err := handler.resolveFeesTenantContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return handler.FeeReverser.ApplyFeeReversalPolicy(feesCtx, organizationID, revert)
+}
+
+// recordFeeRevenue records the fee revenue of a transaction once it is posted,
+// in the background so the package lookups and writes stay off the request.
+// The transaction is already committed, so a failure is never surfaced to the
+// caller: the recorder keeps revenue it cannot write pending for a retry, and
+// only a failure to do so is logged. transactionInput must not change once
+// handed over. A nil recorder disables recording.
+func (handler *TransactionHandler) recordFeeRevenue(
+	ctx context.Context,
+	logger libLog.Logger,
+	transactionInput *mtransaction.Transaction,
+	organizationID, ledgerID uuid.UUID,
+	transactionID string,
+	isRevert bool,
+	at time.Time,
+) {
+	if handler.FeeRevenueRecorder == nil || transactionInput == nil {
+		return
+	}
+
+	feesCtx, err := handler.resolveFeesTenantContext(ctx)
+	if err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Failed to record fee revenue",
+			libLog.String("transaction_id", transactionID), libLog.Err(err))
+
+		return
+	}
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(feesCtx), logger, "fees", "fee_revenue.record",
+		libRuntime.KeepRunning, func(c context.Context) {
+			if err := handler.FeeRevenueRecorder.RecordFeeRevenue(c, organizationID, ledgerID, transactionID, transactionInput, isRevert, at); err != nil {
+				logger.Log(c, libLog.LevelWarn, "Failed to record fee revenue",
+					libLog.String("transaction_id", transactionID), libLog.Err(err))
+			}
+		})
+}
+
+// releasePromotionUsage gives back the promotion usage reserved when a
+// transaction was priced, once it is clear the transaction will not post. Like
+// releasing a fee quote it is best-effort, so a failure is only logged. A nil
+// releaser disables releasing.
+func (handler *TransactionHandler) releasePromotionUsage(
+	ctx context.Context,
+	logger libLog.Logger,
+	transactionInput *mtransaction.Transaction,
+	organizationID uuid.UUID,
+	transactionID string,
+) {
+	if handler.FeePromotionReleaser == nil || transactionInput == nil {
+		return
+	}
+
+	feesCtx, err := handler.resolveFeesTenantContext(ctx)
+	if err == nil {
+		err = handler.FeePromotionReleaser.ReleasePromotionUsage(feesCtx, organizationID, transactionInput)
+	}
+
+	if err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Failed to release fee promotion usage",
+			libLog.String("transaction_id", transactionID), libLog.Err(err))
+	}
+}
+
 // resolveFeesTenantContext returns a ctx carrying the CURRENT tenant's fee Mongo
 // database on the GENERIC tmcore MB key, for use ONLY at the fee seam. The fee
 // repos read GetMBContext(ctx) on the generic key, but the route-scoped
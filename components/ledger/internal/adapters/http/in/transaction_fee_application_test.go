@@ -7,8 +7,11 @@ package in
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	libLog "github.com/LerianStudio/lib-observability/log"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
 	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
 	"github.com/LerianStudio/midaz/v4/pkg/mtransaction"
 )
 
@@ -183,3 +187,293 @@ func TestApplyFees_PropagatesBusinessError(t *testing.T) {
 	assert.Equal(t, "0199", businessErr.Code)
 	assert.Equal(t, "transaction value is outside the package range", businessErr.Message)
 }
+
+// fakeFeeQuoteApplier records the quote it was asked to honor and rewrites the
+// send the way a quote does.
+type fakeFeeQuoteApplier struct {
+	calls      int
+	lastQuote  uuid.UUID
+	err        error
+	consumed   int
+	consumeErr error
+	released   int
+	releaseErr error
+}
+
+func (f *fakeFeeQuoteApplier) ConsumeFeeQuote(_ context.Context, _, _ uuid.UUID) error {
+	f.consumed++
+
+	return f.consumeErr
+}
+
+func (f *fakeFeeQuoteApplier) ReleaseFeeQuote(_ context.Context, _, _ uuid.UUID) error {
+	f.released++
+
+	return f.releaseErr
+}
+
+func (f *fakeFeeQuoteApplier) ApplyFeeQuote(_ context.Context, cf *model.FeeCalculate, _ uuid.UUID, quoteID uuid.UUID) error {
+	f.calls++
+	f.lastQuote = quoteID
+
+	if f.err != nil {
+		return f.err
+	}
+
+	cf.Transaction.Send.Distribute.To = append(cf.Transaction.Send.Distribute.To, mtransaction.FromTo{AccountAlias: "@quoted_fee"})
+
+	return nil
+}
+
+func TestApplyFees_QuotedTransactionSkipsEngine(t *testing.T) {
+	applier := &fakeFeeApplier{}
+	quoter := &fakeFeeQuoteApplier{}
+	handler := &TransactionHandler{FeeApplier: applier, FeeQuoteApplier: quoter}
+
+	quoteID := uuid.New()
+	quoteIDStr := quoteID.String()
+	input := baseTransaction()
+	input.FeeQuoteID = &quoteIDStr
+
+	err := handler.applyFees(context.Background(), &input, uuid.New(), uuid.New(), false, false, false /* honoredFeeSkip */)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, applier.calls, "a quoted transaction must not be recalculated")
+	assert.Equal(t, 1, quoter.calls)
+	assert.Equal(t, 0, quoter.consumed, "applying only checks the quote; it is consumed at the balance commit")
+	assert.Equal(t, quoteID, quoter.lastQuote)
+	require.Len(t, input.Send.Distribute.To, 2, "the quoted legs must fold back into the caller's input")
+	assert.Equal(t, "@quoted_fee", input.Send.Distribute.To[1].AccountAlias)
+}
+
+func TestApplyFees_QuoteRejected(t *testing.T) {
+	quoteIDStr := uuid.New().String()
+
+	t.Run("quote error propagates", func(t *testing.T) {
+		quoter := &fakeFeeQuoteApplier{err: pkg.ValidateBusinessError(constant.ErrFeeQuoteExpired, constant.EntityFeeQuote, quoteIDStr)}
+		handler := &TransactionHandler{FeeApplier: &fakeFeeApplier{}, FeeQuoteApplier: quoter}
+
+		input := baseTransaction()
+		input.FeeQuoteID = &quoteIDStr
+
+		err := handler.applyFees(context.Background(), &input, uuid.New(), uuid.New(), false, false, false)
+
+		var unprocessable pkg.UnprocessableOperationError
+		require.True(t, errors.As(err, &unprocessable))
+		assert.Equal(t, constant.ErrFeeQuoteExpired.Error(), unprocessable.Code)
+		assert.Len(t, input.Send.Distribute.To, 1, "a rejected quote must leave the transaction unmutated")
+	})
+
+	t.Run("no quote applier", func(t *testing.T) {
+		handler := &TransactionHandler{FeeApplier: &fakeFeeApplier{}}
+
+		input := baseTransaction()
+		input.FeeQuoteID = &quoteIDStr
+
+		err := handler.applyFees(context.Background(), &input, uuid.New(), uuid.New(), false, false, false)
+
+		var notFound pkg.EntityNotFoundError
+		require.True(t, errors.As(err, &notFound), "a quote that cannot be honored must not be silently recalculated")
+		assert.Equal(t, constant.ErrFeeQuoteNotFound.Error(), notFound.Code)
+	})
+}
+
+// fakeFeeReverser records invocations and returns a scripted error.
+type fakeFeeReverser struct {
+	calls   int
+	lastOrg uuid.UUID
+	err     error
+}
+
+func (f *fakeFeeReverser) ApplyFeeReversalPolicy(_ context.Context, organizationID uuid.UUID, revert *mtransaction.Transaction) error {
+	f.calls++
+	f.lastOrg = organizationID
+
+	if f.err != nil {
+		return f.err
+	}
+
+	revert.Send.Value = decimal.NewFromInt(990)
+
+	return nil
+}
+
+func TestApplyFeeReversal_NoOpWhenReverserNil(t *testing.T) {
+	handler := &TransactionHandler{}
+
+	input := baseTransaction()
+
+	require.NoError(t, handler.applyFeeReversal(context.Background(), &input, uuid.New()))
+	assert.True(t, input.Send.Value.Equal(decimal.NewFromInt(1000)))
+}
+
+func TestApplyFeeReversal_RewritesRevertInPlace(t *testing.T) {
+	reverser := &fakeFeeReverser{}
+	handler := &TransactionHandler{FeeReverser: reverser}
+
+	input := baseTransaction()
+	orgID := uuid.New()
+
+	require.NoError(t, handler.applyFeeReversal(context.Background(), &input, orgID))
+	assert.Equal(t, 1, reverser.calls)
+	assert.Equal(t, orgID, reverser.lastOrg)
+	assert.True(t, input.Send.Value.Equal(decimal.NewFromInt(990)), "the policy-adjusted revert must be the one created")
+}
+
+func TestApplyFeeReversal_PropagatesBusinessError(t *testing.T) {
+	reverser := &fakeFeeReverser{err: pkg.UnprocessableOperationError{Code: "0539"}}
+	handler := &TransactionHandler{FeeReverser: reverser}
+
+	input := baseTransaction()
+
+	err := handler.applyFeeReversal(context.Background(), &input, uuid.New())
+
+	var businessErr pkg.UnprocessableOperationError
+
+	require.True(t, errors.As(err, &businessErr))
+	assert.Equal(t, "0539", businessErr.Code)
+}
+
+func TestConsumeFeeQuote(t *testing.T) {
+	quoteIDStr := uuid.New().String()
+
+	t.Run("quote lost to a concurrent transaction rejects", func(t *testing.T) {
+		quoter := &fakeFeeQuoteApplier{consumeErr: pkg.ValidateBusinessError(constant.ErrFeeQuoteAlreadyUsed, constant.EntityFeeQuote, quoteIDStr)}
+		handler := &TransactionHandler{FeeQuoteApplier: quoter}
+
+		input := baseTransaction()
+		input.FeeQuoteID = &quoteIDStr
+
+		err := handler.consumeFeeQuote(context.Background(), &input, uuid.New())
+
+		var conflict pkg.EntityConflictError
+		require.True(t, errors.As(err, &conflict))
+		assert.Equal(t, constant.ErrFeeQuoteAlreadyUsed.Error(), conflict.Code)
+	})
+
+	t.Run("unquoted transaction consumes nothing", func(t *testing.T) {
+		quoter := &fakeFeeQuoteApplier{}
+		handler := &TransactionHandler{FeeQuoteApplier: quoter}
+
+		input := baseTransaction()
+
+		require.NoError(t, handler.consumeFeeQuote(context.Background(), &input, uuid.New()))
+		assert.Equal(t, 0, quoter.consumed)
+	})
+}
+
+func TestReleaseFeeQuote_FailureIsNotSurfaced(t *testing.T) {
+	quoteIDStr := uuid.New().String()
+	quoter := &fakeFeeQuoteApplier{releaseErr: errors.New("mongo down")}
+	handler := &TransactionHandler{FeeQuoteApplier: quoter}
+
+	input := baseTransaction()
+	input.FeeQuoteID = &quoteIDStr
+
+	assert.NotPanics(t, func() {
+		handler.releaseFeeQuote(context.Background(), &libLog.NopLogger{}, &input, uuid.New())
+	})
+	assert.Equal(t, 1, quoter.released)
+}
+
+// fakeFeeRevenueRecorder records invocations and returns a scripted error.
+// Recording runs in the background, so its fields are guarded by mu.
+type fakeFeeRevenueRecorder struct {
+	mu         sync.Mutex
+	calls      int
+	lastTxID   string
+	lastRevert bool
+	err        error
+}
+
+func (f *fakeFeeRevenueRecorder) RecordFeeRevenue(_ context.Context, _, _ uuid.UUID, transactionID string, _ *mtransaction.Transaction, revert bool, _ time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	f.lastTxID = transactionID
+	f.lastRevert = revert
+
+	return f.err
+}
+
+func (f *fakeFeeRevenueRecorder) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls
+}
+
+func TestRecordFeeRevenue_NoOpWhenRecorderNil(t *testing.T) {
+	handler := &TransactionHandler{}
+
+	input := baseTransaction()
+
+	assert.NotPanics(t, func() {
+		handler.recordFeeRevenue(context.Background(), &libLog.NopLogger{}, &input, uuid.New(), uuid.New(), "tx", false, time.Now())
+	})
+}
+
+func TestRecordFeeRevenue_ForwardsPostedTransaction(t *testing.T) {
+	recorder := &fakeFeeRevenueRecorder{}
+	handler := &TransactionHandler{FeeRevenueRecorder: recorder}
+
+	input := baseTransaction()
+
+	handler.recordFeeRevenue(context.Background(), &libLog.NopLogger{}, &input, uuid.New(), uuid.New(), "tx-1", true, time.Now())
+
+	assert.Eventually(t, func() bool { return recorder.callCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	assert.Equal(t, "tx-1", recorder.lastTxID)
+	assert.True(t, recorder.lastRevert)
+}
+
+func TestRecordFeeRevenue_FailureIsNotSurfaced(t *testing.T) {
+	recorder := &fakeFeeRevenueRecorder{err: errors.New("mongo down")}
+	handler := &TransactionHandler{FeeRevenueRecorder: recorder}
+
+	input := baseTransaction()
+
+	assert.NotPanics(t, func() {
+		handler.recordFeeRevenue(context.Background(), &libLog.NopLogger{}, &input, uuid.New(), uuid.New(), "tx-1", false, time.Now())
+	})
+	assert.Eventually(t, func() bool { return recorder.callCount() == 1 }, time.Second, 10*time.Millisecond,
+		"a committed transaction is never failed by revenue recording")
+}
+
+// fakeFeePromotionReleaser records invocations and returns a scripted error.
+type fakeFeePromotionReleaser struct {
+	calls int
+	err   error
+}
+
+func (f *fakeFeePromotionReleaser) ReleasePromotionUsage(_ context.Context, _ uuid.UUID, _ *mtransaction.Transaction) error {
+	f.calls++
+
+	return f.err
+}
+
+func TestReleasePromotionUsage_NoOpWhenReleaserNil(t *testing.T) {
+	handler := &TransactionHandler{}
+
+	input := baseTransaction()
+
+	assert.NotPanics(t, func() {
+		handler.releasePromotionUsage(context.Background(), &libLog.NopLogger{}, &input, uuid.New(), "tx")
+	})
+}
+
+func TestReleasePromotionUsage_FailureIsNotSurfaced(t *testing.T) {
+	releaser := &fakeFeePromotionReleaser{err: errors.New("mongo down")}
+	handler := &TransactionHandler{FeePromotionReleaser: releaser}
+
+	input := baseTransaction()
+
+	assert.NotPanics(t, func() {
+		handler.releasePromotionUsage(context.Background(), &libLog.NopLogger{}, &input, uuid.New(), "tx-1")
+	})
+	assert.Equal(t, 1, releaser.calls, "a transaction that did not post is never failed by releasing its promotion usage")
+}
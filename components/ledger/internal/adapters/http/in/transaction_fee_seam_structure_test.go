@@ -363,6 +363,165 @@ func executeCreateTransaction() {
 	}
 }
 
+// firstCallPositions returns the top-level statement index of the first call to
+// each method in the named function of src, or -1 for a method it never calls.
+func firstCallPositions(t *testing.T, src, funcName string, methods ...string) map[string]int {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "src.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+
+	positions := make(map[string]int, len(methods))
+	for _, method := range methods {
+		positions[method] = -1
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != funcName || fn.Body == nil {
+			continue
+		}
+
+		for i, stmt := range fn.Body.List {
+			for _, method := range methods {
+				if positions[method] == -1 && stmtCallsMethod(stmt, method) {
+					positions[method] = i
+				}
+			}
+		}
+
+		return positions
+	}
+
+	t.Fatalf("function %q not found or has no body", funcName)
+
+	return nil
+}
+
+// promotionUsageReleasedUntilCommit reports whether the named function defers
+// releasing the promotion usage it reserved right after pricing, and disarms
+// the release right after the balance commit succeeds, so every exit in
+// between gives the usage back.
+func promotionUsageReleasedUntilCommit(t *testing.T, src, funcName string) bool {
+	t.Helper()
+
+	pos := firstCallPositions(t, src, funcName, "applyFees", "releasePromotionUsage", "ProcessBalanceOperations")
+
+	if pos["applyFees"] == -1 || pos["releasePromotionUsage"] < pos["applyFees"] || pos["releasePromotionUsage"] > pos["ProcessBalanceOperations"] {
+		return false
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "src.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != funcName || pos["ProcessBalanceOperations"]+2 >= len(fn.Body.List) {
+			continue
+		}
+
+		if _, ok := fn.Body.List[pos["releasePromotionUsage"]].(*ast.DeferStmt); !ok {
+			return false
+		}
+
+		disarm, ok := fn.Body.List[pos["ProcessBalanceOperations"]+2].(*ast.AssignStmt)
+		if !ok || len(disarm.Lhs) != 1 {
+			return false
+		}
+
+		ident, ok := disarm.Lhs[0].(*ast.Ident)
+
+		return ok && ident.Name == "promotionsReserved"
+	}
+
+	return false
+}
+
+// TestFeeSeamStructure_PromotionUsageReleasedUntilCommit guards that pricing
+// only uses up a promotion for a transaction that posts: the create path
+// releases the usage pricing reserved on every exit before the balance commit
+// succeeds, and the cancel path releases it for a cancelled transaction.
+func TestFeeSeamStructure_PromotionUsageReleasedUntilCommit(t *testing.T) {
+	if !promotionUsageReleasedUntilCommit(t, readSeamSource(t), seamFuncName) {
+		t.Errorf("%s must defer releasing promotion usage after applyFees and disarm it after ProcessBalanceOperations", seamFuncName)
+	}
+
+	pos := firstCallPositions(t, readStateHandlersSource(t), commitCancelFuncName, "WriteTransaction", "releasePromotionUsage")
+
+	if pos["releasePromotionUsage"] == -1 || pos["releasePromotionUsage"] < pos["WriteTransaction"] {
+		t.Errorf("%s must release promotion usage of a cancelled transaction after WriteTransaction", commitCancelFuncName)
+	}
+}
+
+// TestFeeSeamStructure_PromotionUsageGateBites proves the gate fails when a
+// failed balance commit keeps the reserved usage.
+func TestFeeSeamStructure_PromotionUsageGateBites(t *testing.T) {
+	kept := `package in
+func executeCreateTransaction() {
+	_ = handler.applyFees()
+	promotionsReserved := true
+	_, err := handler.Command.ProcessBalanceOperations()
+	if err != nil {
+		return
+	}
+	_ = handler.Command.WriteTransaction(validate)
+	handler.releasePromotionUsage() // BUG: a failed commit returns before it
+}`
+
+	if promotionUsageReleasedUntilCommit(t, kept, seamFuncName) {
+		t.Error("promotion usage gate failed to bite: a release skipped by a failed balance commit was not detected")
+	}
+}
+
+// TestFeeSeamStructure_FeeQuoteConsumedAtCommit guards the fee quote lifecycle
+// of the create path: the quote is consumed after every rejection that can
+// precede the balance commit, immediately before ProcessBalanceOperations, and
+// a failed balance commit releases it, so a transaction that does not post
+// never spends its quote.
+func TestFeeSeamStructure_FeeQuoteConsumedAtCommit(t *testing.T) {
+	src := readSeamSource(t)
+
+	pos := firstCallPositions(t, src, seamFuncName, "applyFees", "reserveTransaction", "consumeFeeQuote", "ProcessBalanceOperations")
+
+	if pos["consumeFeeQuote"] == -1 || pos["consumeFeeQuote"] < pos["reserveTransaction"] || pos["consumeFeeQuote"] > pos["ProcessBalanceOperations"] {
+		t.Errorf("the fee quote must be consumed between the tracer reservation and the balance commit, got %v", pos)
+	}
+
+	if !processBalanceFailureCalls(t, src, seamFuncName, "releaseFeeQuote") {
+		t.Error("a failed ProcessBalanceOperations must release the consumed fee quote")
+	}
+}
+
+// processBalanceFailureCalls reports whether the error branch right after the
+// ProcessBalanceOperations call of the named function calls method.
+func processBalanceFailureCalls(t *testing.T, src, funcName, method string) bool {
+	t.Helper()
+
+	pos := firstCallPositions(t, src, funcName, "ProcessBalanceOperations")
+
+	file, err := parser.ParseFile(token.NewFileSet(), "src.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != funcName || pos["ProcessBalanceOperations"] == -1 || pos["ProcessBalanceOperations"]+1 >= len(fn.Body.List) {
+			continue
+		}
+
+		ifStmt, ok := fn.Body.List[pos["ProcessBalanceOperations"]+1].(*ast.IfStmt)
+
+		return ok && blockCallsMethod(ifStmt.Body, method)
+	}
+
+	return false
+}
+
 // readSeamSource reads transaction_create.go from disk so the gates run against
 // the live source, not a snapshot, and fail the moment the seam is edited.
 func readSeamSource(t *testing.T) string {
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// HolderKYCReader reports which holders lack a standing KYC verification. It is
+// satisfied at bootstrap by an adapter over the CRM holder service, so the
+// transaction path never imports the CRM package.
+type HolderKYCReader interface {
+	// UnverifiedHolders returns the holders among holderIDs not verified at
+	// level or above; holders that do not exist are reported as unverified.
+	UnverifiedHolders(ctx context.Context, organizationID string, holderIDs []uuid.UUID, level string) ([]uuid.UUID, error)
+}
+
+// accountsByIDsFunc loads the accounts of a ledger by id (query.ListAccountsByIDs).
+type accountsByIDsFunc func(ctx context.Context, organizationID, ledgerID uuid.UUID, ids []uuid.UUID) ([]*mmodel.Account, error)
+
+// rejectUnverifiedHolders enforces the ledger's kyc.requiredLevel: every
+// account a transaction touches must be owned by a holder verified at that
+// level. External accounts and accounts owned by the organization's self-holder
+// are exempt; an account without a holder fails the gate. A nil reader or an
+// empty level disables the gate.
+func rejectUnverifiedHolders(ctx context.Context, reader HolderKYCReader, listAccounts accountsByIDsFunc, organizationID, ledgerID uuid.UUID, balances []*mmodel.Balance, level string) error {
+	if reader == nil || level == "" {
+		return nil
+	}
+
+	logger := libObservability.NewLoggerFromContext(ctx)
+
+	seen := make(map[uuid.UUID]bool, len(balances))
+	accountIDs := make([]uuid.UUID, 0, len(balances))
+
+	for _, b := range balances {
+		if b == nil || b.AccountType == constant.ExternalAccountType {
+			continue
+		}
+
+		id, err := uuid.Parse(b.AccountID)
+		if err != nil || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		accountIDs = append(accountIDs, id)
+	}
+
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	accounts, err := listAccounts(ctx, organizationID, ledgerID, accountIDs)
+	if err != nil {
+		return err
+	}
+
+	selfHolderID := command.DeriveSelfHolderID(organizationID)
+	aliasByHolder := make(map[uuid.UUID]string, len(accounts))
+	holderIDs := make([]uuid.UUID, 0, len(accounts))
+
+	for _, account := range accounts {
+		alias := accountAlias(account)
+
+		if account.HolderID == nil {
+			logger.Log(ctx, libLog.LevelWarn, "Rejected transaction on account without holder", libLog.String("alias", alias))
+
+			return pkg.ValidateBusinessError(constant.ErrHolderNotVerified, constant.EntityTransaction, alias, level)
+		}
+
+		holderID, err := uuid.Parse(*account.HolderID)
+		if err != nil {
+			return pkg.ValidateBusinessError(constant.ErrHolderNotVerified, constant.EntityTransaction, alias, level)
+		}
+
+		if holderID == selfHolderID {
+			continue
+		}
+
+		if _, ok := aliasByHolder[holderID]; !ok {
+			aliasByHolder[holderID] = alias
+			holderIDs = append(holderIDs, holderID)
+		}
+	}
+
+	if len(holderIDs) == 0 {
+		return nil
+	}
+
+	unverified, err := reader.UnverifiedHolders(ctx, organizationID.String(), holderIDs, level)
+	if err != nil {
+		return err
+	}
+
+	if len(unverified) == 0 {
+		return nil
+	}
+
+	alias := aliasByHolder[unverified[0]]
+
+	logger.Log(ctx, libLog.LevelWarn, "Rejected transaction on account of unverified holder",
+		libLog.String("alias", alias),
+		libLog.String("required_level", level))
+
+	return pkg.ValidateBusinessError(constant.ErrHolderNotVerified, constant.EntityTransaction, alias, level)
+}
+
+// accountAlias returns the account's alias, or its id when it has none.
+func accountAlias(account *mmodel.Account) string {
+	if account.Alias != nil && *account.Alias != "" {
+		return *account.Alias
+	}
+
+	return account.ID
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// fakeHolderKYCReader reports the configured holders as unverified and records
+// the holders it was asked about.
+type fakeHolderKYCReader struct {
+	unverified map[uuid.UUID]bool
+	asked      []uuid.UUID
+}
+
+func (f *fakeHolderKYCReader) UnverifiedHolders(_ context.Context, _ string, holderIDs []uuid.UUID, _ string) ([]uuid.UUID, error) {
+	f.asked = append(f.asked, holderIDs...)
+
+	var out []uuid.UUID
+
+	for _, id := range holderIDs {
+		if f.unverified[id] {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
+func TestRejectUnverifiedHolders(t *testing.T) {
+	orgID, ledgerID := uuid.New(), uuid.New()
+	verified, unverified := uuid.New(), uuid.New()
+	selfHolder := command.DeriveSelfHolderID(orgID)
+
+	holderRef := func(id uuid.UUID) *string { s := id.String(); return &s }
+	alias := func(a string) *string { return &a }
+
+	accounts := map[string]*mmodel.Account{}
+	addAccount := func(name string, holderID *string) *mmodel.Balance {
+		id := uuid.NewString()
+		accounts[id] = &mmodel.Account{ID: id, Alias: alias(name), HolderID: holderID}
+
+		return &mmodel.Balance{AccountID: id, Alias: name, AccountType: "deposit"}
+	}
+
+	listAccounts := func(_ context.Context, _, _ uuid.UUID, ids []uuid.UUID) ([]*mmodel.Account, error) {
+		out := make([]*mmodel.Account, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, accounts[id.String()])
+		}
+
+		return out, nil
+	}
+
+	alice := addAccount("@alice", holderRef(verified))
+	bob := addAccount("@bob", holderRef(unverified))
+	treasury := addAccount("@treasury", holderRef(selfHolder))
+	orphan := addAccount("@orphan", nil)
+	external := &mmodel.Balance{AccountID: uuid.NewString(), Alias: "@external/USD", AccountType: constant.ExternalAccountType}
+
+	testCases := []struct {
+		name        string
+		reader      *fakeHolderKYCReader
+		level       string
+		balances    []*mmodel.Balance
+		expectedErr error
+		expectAsked []uuid.UUID
+	}{
+		{
+			name:     "gate disabled without a required level",
+			reader:   &fakeHolderKYCReader{unverified: map[uuid.UUID]bool{unverified: true}},
+			balances: []*mmodel.Balance{bob},
+		},
+		{
+			name:        "verified holder, self-holder and external accounts pass",
+			reader:      &fakeHolderKYCReader{},
+			level:       mmodel.KYCLevelBasic,
+			balances:    []*mmodel.Balance{alice, treasury, external, alice},
+			expectAsked: []uuid.UUID{verified},
+		},
+		{
+			name:        "unverified holder is rejected with its alias",
+			reader:      &fakeHolderKYCReader{unverified: map[uuid.UUID]bool{unverified: true}},
+			level:       mmodel.KYCLevelStandard,
+			balances:    []*mmodel.Balance{alice, bob},
+			expectedErr: pkg.ValidateBusinessError(constant.ErrHolderNotVerified, constant.EntityTransaction, "@bob", mmodel.KYCLevelStandard),
+			expectAsked: []uuid.UUID{verified, unverified},
+		},
+		{
+			name:        "account without holder is rejected",
+			reader:      &fakeHolderKYCReader{},
+			level:       mmodel.KYCLevelBasic,
+			balances:    []*mmodel.Balance{orphan},
+			expectedErr: pkg.ValidateBusinessError(constant.ErrHolderNotVerified, constant.EntityTransaction, "@orphan", mmodel.KYCLevelBasic),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := rejectUnverifiedHolders(context.Background(), tc.reader, listAccounts, orgID, ledgerID, tc.balances, tc.level)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectAsked, tc.reader.asked)
+		})
+	}
+}
+
+func TestRejectUnverifiedHolders_NilReaderDisablesGate(t *testing.T) {
+	listAccounts := func(context.Context, uuid.UUID, uuid.UUID, []uuid.UUID) ([]*mmodel.Account, error) {
+		t.Fatal("accounts must not be loaded when the gate is disabled")
+
+		return nil, nil
+	}
+
+	err := rejectUnverifiedHolders(context.Background(), nil, listAccounts, uuid.New(), uuid.New(),
+		[]*mmodel.Balance{{AccountID: uuid.NewString()}}, mmodel.KYCLevelBasic)
+	assert.NoError(t, err)
+}
@@ -233,6 +233,14 @@ func (handler *TransactionHandler) revertTransaction(ctx context.Context, organi
 		}
 	}
 
+	// Fee legs are reversed like principal legs unless the package that charged
+	// them keeps some or all of them, or charges for the revert.
+	if err = handler.applyFeeReversal(ctx, &transactionReverted, organizationID); err != nil {
+		handleSpanByErrorClass(span, "Failed to apply fee reversal policy", err)
+
+		return nil, err
+	}
+
 	params := &transactionPathParams{OrganizationID: organizationID, LedgerID: ledgerID, TransactionID: transactionID}
 
 	tranReverted, _, err := handler.createRevertTransaction(ctx, params, transactionReverted, constant.CREATED, "", http.ParseIdempotencyTTL(""))
@@ -574,6 +582,16 @@ func (handler *TransactionHandler) commitOrCancelTransaction(ctx context.Context
 		return nil, err
 	}
 
+	if transactionStatus == constant.APPROVED {
+		handler.recordFeeRevenue(ctx, logger, &transactionInput, organizationID, ledgerID, tran.ID, false, time.Now())
+	}
+
+	// A cancelled transaction never posts, so it gives back the promotion
+	// usage reserved when it was priced.
+	if transactionStatus == constant.CANCELED {
+		handler.releasePromotionUsage(ctx, logger, &transactionInput, organizationID, tran.ID)
+	}
+
 	tenantCtx := tmcore.ContextWithTenantID(context.Background(), tmcore.GetTenantIDContext(ctx))
 
 	go handler.Command.SendLogTransactionAuditQueue(tenantCtx, operations, organizationID, ledgerID, tran.IDtoUUID())
@@ -38,9 +38,11 @@ func mountWave3Routes(app *fiber.App, auth *middleware.AuthClient) huma.API {
 
 	RegisterCRMRoutesToApp(apiV1, hAPI, auth,
 		&HolderHandler{}, &InstrumentHandler{}, &HolderAccountsHandler{},
-		&EncryptionHandler{}, &AuditHandler{}, nil)
+		&EncryptionHandler{}, &AuditHandler{}, &ScreeningHandler{}, nil)
 	RegisterFeesRoutesToApp(apiV1, hAPI, auth,
 		&PackageHandler{}, &FeeHandler{}, &BillingPackageHandler{}, &BillingCalculateHandler{}, nil)
+	RegisterBillingRunRoutesToApp(apiV1, hAPI, auth, &BillingRunHandler{}, nil)
+	RegisterBillingInvoiceRoutesToApp(apiV1, hAPI, auth, &BillingInvoiceHandler{}, nil)
 	RegisterCompositionRoutesToApp(apiV1, hAPI, auth, &CompositionHandler{}, nil)
 
 	return hAPI
@@ -55,11 +57,12 @@ const (
 // mount when every conditional handler is present. Paths + methods are preserved
 // from the pre-Huma inline Fiber routes; only the transport changed.
 var wave3FullRoutes = []string{
-	// CRM holders (5)
+	// CRM holders (6)
 	"POST:" + wave3Org + "/holders",
 	"GET:" + wave3Org + "/holders/:id",
 	"PATCH:" + wave3Org + "/holders/:id",
 	"DELETE:" + wave3Org + "/holders/:id",
+	"POST:" + wave3Org + "/holders/:id/erase",
 	"GET:" + wave3Org + "/holders",
 	// CRM holder-accounts (1, conditional on hah)
 	"GET:" + wave3Org + "/holders/:id/accounts",
@@ -70,17 +73,23 @@ var wave3FullRoutes = []string{
 	"PATCH:" + wave3Org + "/holders/:holder_id/instruments/:instrument_id",
 	"DELETE:" + wave3Org + "/holders/:holder_id/instruments/:instrument_id",
 	"DELETE:" + wave3Org + "/holders/:holder_id/instruments/:instrument_id/related-parties/:related_party_id",
-	// CRM encryption (2, conditional on eh)
+	// CRM encryption (6, conditional on eh)
 	"POST:" + wave3Org + "/encryption/provision",
 	"GET:" + wave3Org + "/encryption/status",
+	"POST:" + wave3Org + "/encryption/rotate",
+	"GET:" + wave3Org + "/encryption/rotation",
+	"POST:" + wave3Org + "/encryption/rotation/resume",
+	"POST:" + wave3Org + "/encryption/retire",
 	// CRM audit (1, conditional on auditHandler)
 	"GET:" + wave3Org + "/protection/audit",
-	// Fees packages (5)
+	// Fees packages (7)
 	"POST:" + wave3Org + "/packages",
 	"GET:" + wave3Org + "/packages",
 	"GET:" + wave3Org + "/packages/:id",
 	"PATCH:" + wave3Org + "/packages/:id",
 	"DELETE:" + wave3Org + "/packages/:id",
+	"POST:" + wave3Org + "/packages/:id/versions",
+	"DELETE:" + wave3Org + "/packages/:id/versions/:version",
 	// Fees estimate (1)
 	"POST:" + wave3Org + "/estimates",
 	// Billing packages (5)
@@ -91,6 +100,18 @@ var wave3FullRoutes = []string{
 	"DELETE:" + wave3Org + "/billing-packages/:id",
 	// Billing calculate (1)
 	"POST:" + wave3Org + "/billing/calculate",
+	// Billing runs (4)
+	"POST:" + wave3Org + "/billing/runs",
+	"GET:" + wave3Org + "/billing/runs/:id",
+	"GET:" + wave3Org + "/billing/runs/:id/statements",
+	"POST:" + wave3Org + "/billing/runs/:id/retry",
+	// Billing invoices (6)
+	"POST:" + wave3Org + "/billing/invoices",
+	"GET:" + wave3Org + "/billing/invoices",
+	"POST:" + wave3Org + "/billing/invoices/dunning",
+	"GET:" + wave3Org + "/billing/invoices/:id",
+	"POST:" + wave3Org + "/billing/invoices/:id/collect",
+	"POST:" + wave3Org + "/billing/invoices/:id/write-off",
 	// Composition (1)
 	"POST:" + wave3OrgLedger + "/holders/:id/accounts",
 }
@@ -129,9 +150,9 @@ func TestWave3RoutesRespectNilGuards(t *testing.T) {
 	hAPI := openapi.New(app, apiV1, openapi.Config{Title: "ledger-nilguard", Version: "test", Servers: []string{"/v1"}})
 	pkgHTTP.InstallLedgerSchemaNamer(hAPI)
 
-	// hah, eh, auditHandler all nil -> holder-accounts + encryption + audit absent.
+	// hah, eh, auditHandler, sh all nil -> holder-accounts + encryption + audit + screening absent.
 	RegisterCRMRoutesToApp(apiV1, hAPI, auth,
-		&HolderHandler{}, &InstrumentHandler{}, nil, nil, nil, nil)
+		&HolderHandler{}, &InstrumentHandler{}, nil, nil, nil, nil, nil)
 
 	routeSet := make(map[string]bool)
 	for _, r := range app.GetRoutes() {
@@ -149,8 +170,14 @@ func TestWave3RoutesRespectNilGuards(t *testing.T) {
 		"encryption provision route must NOT mount when eh is nil")
 	assert.False(t, routeSet["GET:"+wave3Org+"/encryption/status"],
 		"encryption status route must NOT mount when eh is nil")
+	assert.False(t, routeSet["POST:"+wave3Org+"/encryption/rotate"],
+		"encryption rotate route must NOT mount when eh is nil")
+	assert.False(t, routeSet["POST:"+wave3Org+"/encryption/retire"],
+		"encryption retire route must NOT mount when eh is nil")
 	assert.False(t, routeSet["GET:"+wave3Org+"/protection/audit"],
 		"audit route must NOT mount when auditHandler is nil")
+	assert.False(t, routeSet["GET:"+wave3Org+"/screening/alerts"],
+		"screening routes must NOT mount when sh is nil")
 }
 
 // TestRegisterFeesRoutesToApp_DoesNotMountFeeCalculate asserts POST /v1/fees stays
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_invoice (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=./billing_invoice_mock.go --package=billing_invoice . Repository
+//
+
+// Package billing_invoice is a generated GoMock package.
+package billing_invoice
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindInvoiceByID mocks base method.
+func (m *MockRepository) FindInvoiceByID(ctx context.Context, id, organizationID string) (*model.BillingInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInvoiceByID", ctx, id, organizationID)
+	ret0, _ := ret[0].(*model.BillingInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInvoiceByID indicates an expected call of FindInvoiceByID.
+func (mr *MockRepositoryMockRecorder) FindInvoiceByID(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvoiceByID", reflect.TypeOf((*MockRepository)(nil).FindInvoiceByID), ctx, id, organizationID)
+}
+
+// FindInvoices mocks base method.
+func (m *MockRepository) FindInvoices(ctx context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInvoices", ctx, filter, limit, page)
+	ret0, _ := ret[0].([]*model.BillingInvoice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindInvoices indicates an expected call of FindInvoices.
+func (mr *MockRepositoryMockRecorder) FindInvoices(ctx, filter, limit, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvoices", reflect.TypeOf((*MockRepository)(nil).FindInvoices), ctx, filter, limit, page)
+}
+
+// InsertOrGetInvoice mocks base method.
+func (m *MockRepository) InsertOrGetInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrGetInvoice", ctx, invoice)
+	ret0, _ := ret[0].(*model.BillingInvoice)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InsertOrGetInvoice indicates an expected call of InsertOrGetInvoice.
+func (mr *MockRepositoryMockRecorder) InsertOrGetInvoice(ctx, invoice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrGetInvoice", reflect.TypeOf((*MockRepository)(nil).InsertOrGetInvoice), ctx, invoice)
+}
+
+// UpdateInvoice mocks base method.
+func (m *MockRepository) UpdateInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInvoice", ctx, invoice)
+	ret0, _ := ret[0].(*model.BillingInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInvoice indicates an expected call of UpdateInvoice.
+func (mr *MockRepositoryMockRecorder) UpdateInvoice(ctx, invoice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInvoice", reflect.TypeOf((*MockRepository)(nil).UpdateInvoice), ctx, invoice)
+}
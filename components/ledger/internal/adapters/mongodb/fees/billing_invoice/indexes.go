@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_invoice
+
+import (
+	"context"
+	"strings"
+
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// EnsureIndexes creates the billing invoice indexes. The unique invoice key
+// index is load-bearing: it is what makes a second invoicing pass for the same
+// (period, account, asset) reuse the existing invoice instead of billing the
+// account again.
+func EnsureIndexes(ctx context.Context, mc *mmongoDB.MongoConnection) error {
+	db, err := mc.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	indexes := []mongo.IndexModel{
+		// Index 1: _id + org (for FindInvoiceByID / UpdateInvoice)
+		{
+			Keys: bson.D{
+				{Key: "_id", Value: 1},
+				{Key: "organization_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_bi_id_org"),
+		},
+
+		// Index 2: org + ledger + invoice_key UNIQUE (one invoice per account, period, type and asset)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "ledger_id", Value: 1},
+				{Key: "invoice_key", Value: 1},
+			},
+			Options: options.Index().
+				SetName("uidx_bi_org_ledger_invoice_key").
+				SetUnique(true),
+		},
+
+		// Index 3: org + ledger + status + due_date (for the dunning overdue sweep)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "ledger_id", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "due_date", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_bi_org_ledger_status_due"),
+		},
+
+		// Index 4: org + ledger + status + next_payment_attempt_at (for the dunning retry sweep)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "ledger_id", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "next_payment_attempt_at", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_bi_org_ledger_status_next_attempt"),
+		},
+	}
+
+	_, err = db.Database(strings.ToLower(mc.Database)).
+		Collection(strings.ToLower(feeconstant.BillingInvoiceCollection)).
+		Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_invoice
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// InsertOrGetInvoice persists a new invoice, or — when an invoice with the
+// same (organization, ledger, invoice key) already exists — returns the stored
+// one untouched. The boolean reports whether the invoice was created by this
+// call. Losing the insert race to a concurrent pass lands in the same branch,
+// so two passes for the same period converge on one invoice.
+func (r *BillingInvoiceMongoDBRepository) InsertOrGetInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error) {
+	if invoice == nil {
+		return nil, false, errors.New("billing invoice cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_invoice.insert_or_get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", invoice.OrganizationID),
+		attribute.String("app.request.period", invoice.Period),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, false, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingInvoiceCollection))
+
+	record := &BillingInvoiceMongoDBModel{}
+	record.FromEntity(invoice)
+
+	_, err = coll.InsertOne(ctx, record)
+	if err == nil {
+		entity, errConv := record.ToEntity()
+
+		return entity, true, errConv
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		libOpentelemetry.HandleSpanError(span, "Failed to insert billing invoice", err)
+
+		return nil, false, err
+	}
+
+	filter := bson.M{
+		"organization_id": invoice.OrganizationID,
+		"ledger_id":       invoice.LedgerID,
+		"invoice_key":     invoice.InvoiceKey,
+	}
+
+	var existing BillingInvoiceMongoDBModel
+
+	if err = coll.FindOne(ctx, filter).Decode(&existing); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to load existing billing invoice", err)
+
+		return nil, false, err
+	}
+
+	entity, err := existing.ToEntity()
+
+	return entity, false, err
+}
+
+// UpdateInvoice replaces the stored invoice with its current collection state
+// and bumps its revision. The replace only matches the revision invoice was
+// read at, so an update made from a stale copy returns
+// constant.ErrBillingInvoiceRevisionConflict instead of overwriting the writer
+// that got there first. It returns mongo.ErrNoDocuments when the invoice does
+// not exist.
+func (r *BillingInvoiceMongoDBRepository) UpdateInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, error) {
+	if invoice == nil {
+		return nil, errors.New("billing invoice cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_invoice.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", invoice.OrganizationID),
+		attribute.String("app.request.billing_invoice_id", invoice.ID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingInvoiceCollection))
+
+	// The record takes the next revision, not the caller's invoice, so a
+	// rejected update leaves the caller's copy as it was read.
+	record := &BillingInvoiceMongoDBModel{}
+	record.FromEntity(invoice)
+	record.Revision = invoice.Revision + 1
+
+	filter := bson.M{"_id": invoice.ID, "organization_id": invoice.OrganizationID, "revision": invoice.Revision}
+
+	// Invoices written before revisions were tracked have none; they are at
+	// revision zero.
+	if invoice.Revision == 0 {
+		filter["revision"] = bson.M{"$in": bson.A{int64(0), nil}}
+	}
+
+	result, err := coll.ReplaceOne(ctx, filter, record)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update billing invoice", err)
+
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		exists, errCount := coll.CountDocuments(ctx, bson.M{"_id": invoice.ID, "organization_id": invoice.OrganizationID})
+		if errCount != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to check billing invoice existence", errCount)
+
+			return nil, errCount
+		}
+
+		if exists == 0 {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice not found", mongo.ErrNoDocuments)
+
+			return nil, mongo.ErrNoDocuments
+		}
+
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice revision conflict", constant.ErrBillingInvoiceRevisionConflict)
+
+		return nil, constant.ErrBillingInvoiceRevisionConflict
+	}
+
+	return record.ToEntity()
+}
+
+// FindInvoiceByID finds a billing invoice by ID and organization ID. It returns
+// mongo.ErrNoDocuments when the invoice does not exist.
+func (r *BillingInvoiceMongoDBRepository) FindInvoiceByID(ctx context.Context, id, organizationID string) (*model.BillingInvoice, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_invoice.find_by_id")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.billing_invoice_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	var record BillingInvoiceMongoDBModel
+
+	filter := bson.M{"_id": id, "organization_id": organizationID}
+
+	if err = db.Collection(strings.ToLower(feeconstant.BillingInvoiceCollection)).FindOne(ctx, filter).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice not found", err)
+
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find billing invoice by ID", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
+
+// FindInvoices returns a page of the invoices matching filter, oldest first,
+// with the total number of matches. A non-positive limit returns every
+// matching invoice.
+func (r *BillingInvoiceMongoDBRepository) FindInvoices(ctx context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_invoice.find")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", filter.OrganizationID),
+		attribute.String("app.request.ledger_id", filter.LedgerID),
+		attribute.Int("app.request.limit", limit),
+		attribute.Int("app.request.page", page),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, 0, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingInvoiceCollection))
+
+	query := invoiceQuery(filter)
+
+	total, err := coll.CountDocuments(ctx, query)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to count billing invoices", err)
+
+		return nil, 0, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	if limit > 0 {
+		if page < 1 {
+			page = 1
+		}
+
+		opts.SetLimit(int64(limit)).SetSkip(int64(page*limit - limit))
+	}
+
+	cur, err := coll.Find(ctx, query, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find billing invoices", err)
+
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	invoices := make([]*model.BillingInvoice, 0)
+
+	for cur.Next(ctx) {
+		var record BillingInvoiceMongoDBModel
+		if err := cur.Decode(&record); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decode billing invoice", err)
+
+			return nil, 0, err
+		}
+
+		entity, err := record.ToEntity()
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert billing invoice record to entity", err)
+
+			return nil, 0, err
+		}
+
+		invoices = append(invoices, entity)
+	}
+
+	if err := cur.Err(); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to iterate billing invoices", err)
+
+		return nil, 0, err
+	}
+
+	return invoices, total, nil
+}
+
+// invoiceQuery translates an invoice filter into its MongoDB query. RFC3339
+// instants in UTC order lexically, so the date bounds compare as strings.
+func invoiceQuery(filter model.BillingInvoiceFilter) bson.M {
+	query := bson.M{"organization_id": filter.OrganizationID}
+
+	if filter.LedgerID != "" {
+		query["ledger_id"] = filter.LedgerID
+	}
+
+	if filter.Period != "" {
+		query["period"] = filter.Period
+	}
+
+	if filter.AccountAlias != "" {
+		query["account_alias"] = filter.AccountAlias
+	}
+
+	if len(filter.Statuses) > 0 {
+		query["status"] = bson.M{"$in": filter.Statuses}
+	}
+
+	if filter.DueBefore != "" {
+		query["due_date"] = bson.M{"$lt": filter.DueBefore}
+	}
+
+	if filter.PaymentAttemptDueBefore != "" {
+		query["next_payment_attempt_at"] = bson.M{"$lte": filter.PaymentAttemptDueBefore}
+	}
+
+	return query
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_invoice
+
+import (
+	"fmt"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillingInvoiceLineMongoDBModel represents an invoice line embedded in its invoice document.
+type BillingInvoiceLineMongoDBModel struct {
+	BillingPackageID    string  `bson:"billing_package_id"`
+	BillingPackageLabel string  `bson:"billing_package_label"`
+	BillingType         string  `bson:"billing_type"`
+	CreditAccountAlias  string  `bson:"credit_account_alias"`
+	PricingModel        *string `bson:"pricing_model,omitempty"`
+	TotalEvents         int64   `bson:"total_events,omitempty"`
+	FreeQuotaUsed       int64   `bson:"free_quota_used,omitempty"`
+	Quantity            int64   `bson:"quantity"`
+	UnitPrice           string  `bson:"unit_price"`
+	GrossAmount         string  `bson:"gross_amount"`
+	DiscountPercentage  *string `bson:"discount_percentage,omitempty"`
+	DiscountAmount      string  `bson:"discount_amount"`
+	NetAmount           string  `bson:"net_amount"`
+	PaidAmount          string  `bson:"paid_amount"`
+}
+
+// BillingInvoicePaymentMongoDBModel represents a payment embedded in its invoice document.
+type BillingInvoicePaymentMongoDBModel struct {
+	Amount         string `bson:"amount"`
+	TransactionID  string `bson:"transaction_id"`
+	IdempotencyKey string `bson:"idempotency_key"`
+	PaidAt         string `bson:"paid_at"`
+}
+
+// BillingInvoicePendingPaymentMongoDBModel represents the collection an invoice
+// document has in flight.
+type BillingInvoicePendingPaymentMongoDBModel struct {
+	Amount         string `bson:"amount"`
+	IdempotencyKey string `bson:"idempotency_key"`
+	StartedAt      string `bson:"started_at"`
+}
+
+// BillingInvoiceMongoDBModel represents the MongoDB document for a billing invoice.
+type BillingInvoiceMongoDBModel struct {
+	ID                   string                                    `bson:"_id"`
+	OrganizationID       string                                    `bson:"organization_id"`
+	LedgerID             string                                    `bson:"ledger_id"`
+	Period               string                                    `bson:"period"`
+	Type                 string                                    `bson:"type,omitempty"`
+	AccountAlias         string                                    `bson:"account_alias"`
+	AssetCode            string                                    `bson:"asset_code"`
+	Status               string                                    `bson:"status"`
+	Lines                []BillingInvoiceLineMongoDBModel          `bson:"lines"`
+	GrossAmount          string                                    `bson:"gross_amount"`
+	DiscountAmount       string                                    `bson:"discount_amount"`
+	TotalAmount          string                                    `bson:"total_amount"`
+	PaidAmount           string                                    `bson:"paid_amount"`
+	Payments             []BillingInvoicePaymentMongoDBModel       `bson:"payments"`
+	PaymentAttempts      int                                       `bson:"payment_attempts"`
+	FailedAttempts       int                                       `bson:"failed_attempts"`
+	LastPaymentError     *string                                   `bson:"last_payment_error,omitempty"`
+	NextPaymentAttemptAt *string                                   `bson:"next_payment_attempt_at,omitempty"`
+	PendingPayment       *BillingInvoicePendingPaymentMongoDBModel `bson:"pending_payment,omitempty"`
+	InvoiceKey           string                                    `bson:"invoice_key"`
+	DueDate              string                                    `bson:"due_date"`
+	IssuedAt             string                                    `bson:"issued_at"`
+	PaidAt               *string                                   `bson:"paid_at,omitempty"`
+	WrittenOffAt         *string                                   `bson:"written_off_at,omitempty"`
+	CreatedAt            string                                    `bson:"created_at"`
+	UpdatedAt            string                                    `bson:"updated_at"`
+	Revision             int64                                     `bson:"revision"`
+}
+
+// ToEntity converts BillingInvoiceMongoDBModel to model.BillingInvoice.
+func (m *BillingInvoiceMongoDBModel) ToEntity() (*model.BillingInvoice, error) {
+	invoice := &model.BillingInvoice{
+		ID:                   m.ID,
+		OrganizationID:       m.OrganizationID,
+		LedgerID:             m.LedgerID,
+		Period:               m.Period,
+		Type:                 m.Type,
+		AccountAlias:         m.AccountAlias,
+		AssetCode:            m.AssetCode,
+		Status:               m.Status,
+		PaymentAttempts:      m.PaymentAttempts,
+		FailedAttempts:       m.FailedAttempts,
+		LastPaymentError:     m.LastPaymentError,
+		NextPaymentAttemptAt: m.NextPaymentAttemptAt,
+		InvoiceKey:           m.InvoiceKey,
+		DueDate:              m.DueDate,
+		IssuedAt:             m.IssuedAt,
+		PaidAt:               m.PaidAt,
+		WrittenOffAt:         m.WrittenOffAt,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+		Revision:             m.Revision,
+	}
+
+	for _, field := range []struct {
+		name string
+		raw  string
+		dst  *decimal.Decimal
+	}{
+		{"gross_amount", m.GrossAmount, &invoice.GrossAmount},
+		{"discount_amount", m.DiscountAmount, &invoice.DiscountAmount},
+		{"total_amount", m.TotalAmount, &invoice.TotalAmount},
+		{"paid_amount", m.PaidAmount, &invoice.PaidAmount},
+	} {
+		value, err := decimal.NewFromString(field.raw)
+		if err != nil {
+			return nil, fmt.Errorf("billing_invoice %s: invalid %s %q: %w", m.ID, field.name, field.raw, err)
+		}
+
+		*field.dst = value
+	}
+
+	lines := make([]model.BillingInvoiceLine, 0, len(m.Lines))
+
+	for _, l := range m.Lines {
+		line, err := l.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("billing_invoice %s: %w", m.ID, err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	payments := make([]model.BillingInvoicePayment, 0, len(m.Payments))
+
+	for _, p := range m.Payments {
+		amount, err := decimal.NewFromString(p.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("billing_invoice %s: invalid payment amount %q: %w", m.ID, p.Amount, err)
+		}
+
+		payments = append(payments, model.BillingInvoicePayment{
+			Amount:         amount,
+			TransactionID:  p.TransactionID,
+			IdempotencyKey: p.IdempotencyKey,
+			PaidAt:         p.PaidAt,
+		})
+	}
+
+	if m.PendingPayment != nil {
+		amount, err := decimal.NewFromString(m.PendingPayment.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("billing_invoice %s: invalid pending payment amount %q: %w", m.ID, m.PendingPayment.Amount, err)
+		}
+
+		invoice.PendingPayment = &model.BillingInvoicePendingPayment{
+			Amount:         amount,
+			IdempotencyKey: m.PendingPayment.IdempotencyKey,
+			StartedAt:      m.PendingPayment.StartedAt,
+		}
+	}
+
+	invoice.Lines = lines
+	invoice.Payments = payments
+	invoice.AmountDue = invoice.Outstanding()
+
+	return invoice, nil
+}
+
+// FromEntity converts model.BillingInvoice to BillingInvoiceMongoDBModel.
+func (m *BillingInvoiceMongoDBModel) FromEntity(i *model.BillingInvoice) {
+	m.ID = i.ID
+	m.OrganizationID = i.OrganizationID
+	m.LedgerID = i.LedgerID
+	m.Period = i.Period
+	m.Type = i.Type
+	m.AccountAlias = i.AccountAlias
+	m.AssetCode = i.AssetCode
+	m.Status = i.Status
+	m.GrossAmount = i.GrossAmount.String()
+	m.DiscountAmount = i.DiscountAmount.String()
+	m.TotalAmount = i.TotalAmount.String()
+	m.PaidAmount = i.PaidAmount.String()
+	m.PaymentAttempts = i.PaymentAttempts
+	m.FailedAttempts = i.FailedAttempts
+	m.LastPaymentError = i.LastPaymentError
+	m.NextPaymentAttemptAt = i.NextPaymentAttemptAt
+	m.InvoiceKey = i.InvoiceKey
+	m.DueDate = i.DueDate
+	m.IssuedAt = i.IssuedAt
+	m.PaidAt = i.PaidAt
+	m.WrittenOffAt = i.WrittenOffAt
+	m.CreatedAt = i.CreatedAt
+	m.UpdatedAt = i.UpdatedAt
+	m.Revision = i.Revision
+
+	m.PendingPayment = nil
+	if i.PendingPayment != nil {
+		m.PendingPayment = &BillingInvoicePendingPaymentMongoDBModel{
+			Amount:         i.PendingPayment.Amount.String(),
+			IdempotencyKey: i.PendingPayment.IdempotencyKey,
+			StartedAt:      i.PendingPayment.StartedAt,
+		}
+	}
+
+	m.Lines = make([]BillingInvoiceLineMongoDBModel, 0, len(i.Lines))
+	for _, l := range i.Lines {
+		m.Lines = append(m.Lines, fromEntityLine(l))
+	}
+
+	m.Payments = make([]BillingInvoicePaymentMongoDBModel, 0, len(i.Payments))
+	for _, p := range i.Payments {
+		m.Payments = append(m.Payments, BillingInvoicePaymentMongoDBModel{
+			Amount:         p.Amount.String(),
+			TransactionID:  p.TransactionID,
+			IdempotencyKey: p.IdempotencyKey,
+			PaidAt:         p.PaidAt,
+		})
+	}
+}
+
+// toEntity converts an embedded line to model.BillingInvoiceLine.
+func (l BillingInvoiceLineMongoDBModel) toEntity() (model.BillingInvoiceLine, error) {
+	line := model.BillingInvoiceLine{
+		BillingPackageID:    l.BillingPackageID,
+		BillingPackageLabel: l.BillingPackageLabel,
+		BillingType:         l.BillingType,
+		CreditAccountAlias:  l.CreditAccountAlias,
+		TotalEvents:         l.TotalEvents,
+		FreeQuotaUsed:       l.FreeQuotaUsed,
+		Quantity:            l.Quantity,
+	}
+
+	if l.PricingModel != nil {
+		line.PricingModel = *l.PricingModel
+	}
+
+	for _, field := range []struct {
+		name string
+		raw  string
+		dst  *decimal.Decimal
+	}{
+		{"unit_price", l.UnitPrice, &line.UnitPrice},
+		{"gross_amount", l.GrossAmount, &line.GrossAmount},
+		{"discount_amount", l.DiscountAmount, &line.DiscountAmount},
+		{"net_amount", l.NetAmount, &line.NetAmount},
+		{"paid_amount", l.PaidAmount, &line.PaidAmount},
+	} {
+		value, err := decimal.NewFromString(field.raw)
+		if err != nil {
+			return model.BillingInvoiceLine{}, fmt.Errorf("line of package %s: invalid %s %q: %w", l.BillingPackageID, field.name, field.raw, err)
+		}
+
+		*field.dst = value
+	}
+
+	if l.DiscountPercentage != nil {
+		percentage, err := decimal.NewFromString(*l.DiscountPercentage)
+		if err != nil {
+			return model.BillingInvoiceLine{}, fmt.Errorf("line of package %s: invalid discount_percentage %q: %w", l.BillingPackageID, *l.DiscountPercentage, err)
+		}
+
+		line.DiscountPercentage = &percentage
+	}
+
+	return line, nil
+}
+
+// fromEntityLine converts model.BillingInvoiceLine to its embedded document.
+func fromEntityLine(l model.BillingInvoiceLine) BillingInvoiceLineMongoDBModel {
+	line := BillingInvoiceLineMongoDBModel{
+		BillingPackageID:    l.BillingPackageID,
+		BillingPackageLabel: l.BillingPackageLabel,
+		BillingType:         l.BillingType,
+		CreditAccountAlias:  l.CreditAccountAlias,
+		TotalEvents:         l.TotalEvents,
+		FreeQuotaUsed:       l.FreeQuotaUsed,
+		Quantity:            l.Quantity,
+		UnitPrice:           l.UnitPrice.String(),
+		GrossAmount:         l.GrossAmount.String(),
+		DiscountAmount:      l.DiscountAmount.String(),
+		NetAmount:           l.NetAmount.String(),
+		PaidAmount:          l.PaidAmount.String(),
+	}
+
+	if l.PricingModel != "" {
+		pricingModel := l.PricingModel
+		line.PricingModel = &pricingModel
+	}
+
+	if l.DiscountPercentage != nil {
+		percentage := l.DiscountPercentage.String()
+		line.DiscountPercentage = &percentage
+	}
+
+	return line
+}
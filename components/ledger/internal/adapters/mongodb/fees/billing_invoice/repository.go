@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_invoice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Repository provides an interface for billing invoices.
+//
+// An invoice's lines and totals are written once by InsertOrGetInvoice;
+// UpdateInvoice persists collection progress (payments, attempts, status).
+//
+//go:generate mockgen --destination=./billing_invoice_mock.go --package=billing_invoice . Repository
+type Repository interface {
+	InsertOrGetInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error)
+	UpdateInvoice(ctx context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, error)
+	FindInvoiceByID(ctx context.Context, id, organizationID string) (*model.BillingInvoice, error)
+	FindInvoices(ctx context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error)
+}
+
+// BillingInvoiceMongoDBRepository is a MongoDB-specific implementation of the Repository.
+type BillingInvoiceMongoDBRepository struct {
+	connection *mmongoDB.MongoConnection
+	Database   string
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// Multi-tenant: returns tenant-specific database from context.
+// Single-tenant: falls back to the static connection.
+func (r *BillingInvoiceMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	client, err := r.connection.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(strings.ToLower(r.Database)), nil
+}
+
+// NewBillingInvoiceMongoDBRepository returns a new instance of BillingInvoiceMongoDBRepository using the given MongoDB connection.
+func NewBillingInvoiceMongoDBRepository(mc *mmongoDB.MongoConnection, logger libLog.Logger) (*BillingInvoiceMongoDBRepository, error) {
+	r := &BillingInvoiceMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+
+	ctx := context.Background()
+
+	if _, err := r.connection.GetDB(ctx); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to connect mongo", libLog.Err(err))
+		return nil, err
+	}
+
+	if err := EnsureIndexes(ctx, mc); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to ensure mongo indexes for billing_invoice", libLog.Err(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewBillingInvoiceMongoDBRepositoryFromConnection creates a BillingInvoiceMongoDBRepository
+// directly from an already-connected MongoConnection, without calling GetDB or EnsureIndexes.
+// This is intended for integration tests where the caller manages connection and index setup.
+func NewBillingInvoiceMongoDBRepositoryFromConnection(mc *mmongoDB.MongoConnection) *BillingInvoiceMongoDBRepository {
+	return &BillingInvoiceMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=./billing_run_mock.go --package=billing_run . Repository
+//
+
+// Package billing_run is a generated GoMock package.
+package billing_run
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountStatementsByRun mocks base method.
+func (m *MockRepository) CountStatementsByRun(ctx context.Context, organizationID, runID string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStatementsByRun", ctx, organizationID, runID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStatementsByRun indicates an expected call of CountStatementsByRun.
+func (mr *MockRepositoryMockRecorder) CountStatementsByRun(ctx, organizationID, runID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStatementsByRun", reflect.TypeOf((*MockRepository)(nil).CountStatementsByRun), ctx, organizationID, runID)
+}
+
+// CreateRun mocks base method.
+func (m *MockRepository) CreateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", ctx, run)
+	ret0, _ := ret[0].(*model.BillingRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockRepositoryMockRecorder) CreateRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockRepository)(nil).CreateRun), ctx, run)
+}
+
+// FindRunByID mocks base method.
+func (m *MockRepository) FindRunByID(ctx context.Context, id, organizationID string) (*model.BillingRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRunByID", ctx, id, organizationID)
+	ret0, _ := ret[0].(*model.BillingRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRunByID indicates an expected call of FindRunByID.
+func (mr *MockRepositoryMockRecorder) FindRunByID(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRunByID", reflect.TypeOf((*MockRepository)(nil).FindRunByID), ctx, id, organizationID)
+}
+
+// FindStatementsByRun mocks base method.
+func (m *MockRepository) FindStatementsByRun(ctx context.Context, organizationID, runID string, statuses []string, limit, page int) ([]*model.BillingStatement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStatementsByRun", ctx, organizationID, runID, statuses, limit, page)
+	ret0, _ := ret[0].([]*model.BillingStatement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindStatementsByRun indicates an expected call of FindStatementsByRun.
+func (mr *MockRepositoryMockRecorder) FindStatementsByRun(ctx, organizationID, runID, statuses, limit, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStatementsByRun", reflect.TypeOf((*MockRepository)(nil).FindStatementsByRun), ctx, organizationID, runID, statuses, limit, page)
+}
+
+// InsertOrGetStatement mocks base method.
+func (m *MockRepository) InsertOrGetStatement(ctx context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrGetStatement", ctx, statement)
+	ret0, _ := ret[0].(*model.BillingStatement)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InsertOrGetStatement indicates an expected call of InsertOrGetStatement.
+func (mr *MockRepositoryMockRecorder) InsertOrGetStatement(ctx, statement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrGetStatement", reflect.TypeOf((*MockRepository)(nil).InsertOrGetStatement), ctx, statement)
+}
+
+// MarkStatementFailed mocks base method.
+func (m *MockRepository) MarkStatementFailed(ctx context.Context, id, organizationID, reason, failedAt string) (*model.BillingStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStatementFailed", ctx, id, organizationID, reason, failedAt)
+	ret0, _ := ret[0].(*model.BillingStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkStatementFailed indicates an expected call of MarkStatementFailed.
+func (mr *MockRepositoryMockRecorder) MarkStatementFailed(ctx, id, organizationID, reason, failedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStatementFailed", reflect.TypeOf((*MockRepository)(nil).MarkStatementFailed), ctx, id, organizationID, reason, failedAt)
+}
+
+// MarkStatementPosted mocks base method.
+func (m *MockRepository) MarkStatementPosted(ctx context.Context, id, organizationID, transactionID, postedAt string) (*model.BillingStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStatementPosted", ctx, id, organizationID, transactionID, postedAt)
+	ret0, _ := ret[0].(*model.BillingStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkStatementPosted indicates an expected call of MarkStatementPosted.
+func (mr *MockRepositoryMockRecorder) MarkStatementPosted(ctx, id, organizationID, transactionID, postedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStatementPosted", reflect.TypeOf((*MockRepository)(nil).MarkStatementPosted), ctx, id, organizationID, transactionID, postedAt)
+}
+
+// UpdateRun mocks base method.
+func (m *MockRepository) UpdateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRun", ctx, run)
+	ret0, _ := ret[0].(*model.BillingRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRun indicates an expected call of UpdateRun.
+func (mr *MockRepositoryMockRecorder) UpdateRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRun", reflect.TypeOf((*MockRepository)(nil).UpdateRun), ctx, run)
+}
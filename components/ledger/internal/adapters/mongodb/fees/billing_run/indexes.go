@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_run
+
+import (
+	"context"
+	"strings"
+
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// EnsureIndexes creates the billing run and billing statement indexes. The
+// unique statement index is load-bearing: it is what makes a second run for the
+// same (package, period, account) reuse the existing statement instead of
+// charging the account again.
+func EnsureIndexes(ctx context.Context, mc *mmongoDB.MongoConnection) error {
+	db, err := mc.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	database := db.Database(strings.ToLower(mc.Database))
+
+	runIndexes := []mongo.IndexModel{
+		// Index 1: _id + org (for FindRunByID)
+		{
+			Keys: bson.D{
+				{Key: "_id", Value: 1},
+				{Key: "organization_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_br_id_org"),
+		},
+	}
+
+	if _, err = database.Collection(strings.ToLower(feeconstant.BillingRunCollection)).Indexes().CreateMany(ctx, runIndexes); err != nil {
+		return err
+	}
+
+	statementIndexes := []mongo.IndexModel{
+		// Index 1: org + ledger + idempotency_key UNIQUE (one statement per charge)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "ledger_id", Value: 1},
+				{Key: "idempotency_key", Value: 1},
+			},
+			Options: options.Index().
+				SetName("uidx_bs_org_ledger_idempotency_key").
+				SetUnique(true),
+		},
+
+		// Index 2: org + run + status + created_at (for FindStatementsByRun / CountStatementsByRun)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "run_id", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_bs_org_run_status_created"),
+		},
+	}
+
+	_, err = database.Collection(strings.ToLower(feeconstant.BillingStatementCollection)).Indexes().CreateMany(ctx, statementIndexes)
+
+	return err
+}
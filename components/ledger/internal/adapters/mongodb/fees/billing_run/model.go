@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_run
+
+import (
+	"fmt"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillingRunMongoDBModel represents the MongoDB document for a billing run.
+type BillingRunMongoDBModel struct {
+	ID                 string  `bson:"_id"`
+	OrganizationID     string  `bson:"organization_id"`
+	LedgerID           string  `bson:"ledger_id"`
+	Period             string  `bson:"period"`
+	Type               *string `bson:"type,omitempty"`
+	Status             string  `bson:"status"`
+	TotalStatements    int     `bson:"total_statements"`
+	TotalPosted        int     `bson:"total_posted"`
+	TotalFailed        int     `bson:"total_failed"`
+	TotalPending       int     `bson:"total_pending"`
+	TotalAlreadyPosted int     `bson:"total_already_posted"`
+	TotalInvoiced      int     `bson:"total_invoiced"`
+	TotalAmount        string  `bson:"total_amount"`
+	CreatedAt          string  `bson:"created_at"`
+	UpdatedAt          string  `bson:"updated_at"`
+}
+
+// ToEntity converts BillingRunMongoDBModel to model.BillingRun.
+func (m *BillingRunMongoDBModel) ToEntity() (*model.BillingRun, error) {
+	totalAmount, err := decimal.NewFromString(m.TotalAmount)
+	if err != nil {
+		return nil, fmt.Errorf("billing_run %s: invalid total_amount %q: %w", m.ID, m.TotalAmount, err)
+	}
+
+	run := &model.BillingRun{
+		ID:                 m.ID,
+		OrganizationID:     m.OrganizationID,
+		LedgerID:           m.LedgerID,
+		Period:             m.Period,
+		Status:             m.Status,
+		TotalStatements:    m.TotalStatements,
+		TotalPosted:        m.TotalPosted,
+		TotalFailed:        m.TotalFailed,
+		TotalPending:       m.TotalPending,
+		TotalAlreadyPosted: m.TotalAlreadyPosted,
+		TotalInvoiced:      m.TotalInvoiced,
+		TotalAmount:        totalAmount,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+
+	if m.Type != nil {
+		run.Type = *m.Type
+	}
+
+	return run, nil
+}
+
+// FromEntity converts model.BillingRun to BillingRunMongoDBModel.
+func (m *BillingRunMongoDBModel) FromEntity(run *model.BillingRun) {
+	m.ID = run.ID
+	m.OrganizationID = run.OrganizationID
+	m.LedgerID = run.LedgerID
+	m.Period = run.Period
+	m.Status = run.Status
+	m.TotalStatements = run.TotalStatements
+	m.TotalPosted = run.TotalPosted
+	m.TotalFailed = run.TotalFailed
+	m.TotalPending = run.TotalPending
+	m.TotalAlreadyPosted = run.TotalAlreadyPosted
+	m.TotalInvoiced = run.TotalInvoiced
+	m.TotalAmount = run.TotalAmount.String()
+	m.CreatedAt = run.CreatedAt
+	m.UpdatedAt = run.UpdatedAt
+
+	if run.Type != "" {
+		t := run.Type
+		m.Type = &t
+	}
+}
+
+// BillingStatementMongoDBModel represents the MongoDB document for a billing statement.
+type BillingStatementMongoDBModel struct {
+	ID                  string         `bson:"_id"`
+	RunID               string         `bson:"run_id"`
+	OrganizationID      string         `bson:"organization_id"`
+	LedgerID            string         `bson:"ledger_id"`
+	BillingPackageID    string         `bson:"billing_package_id"`
+	BillingPackageLabel string         `bson:"billing_package_label"`
+	BillingType         string         `bson:"billing_type"`
+	Period              string         `bson:"period"`
+	AccountAlias        string         `bson:"account_alias"`
+	CreditAccountAlias  string         `bson:"credit_account_alias"`
+	AssetCode           string         `bson:"asset_code"`
+	Amount              string         `bson:"amount"`
+	Code                string         `bson:"code"`
+	Description         string         `bson:"description"`
+	Metadata            map[string]any `bson:"metadata,omitempty"`
+	IdempotencyKey      string         `bson:"idempotency_key"`
+	Status              string         `bson:"status"`
+	TransactionID       *string        `bson:"transaction_id,omitempty"`
+	Attempts            int            `bson:"attempts"`
+	LastError           *string        `bson:"last_error,omitempty"`
+	CreatedAt           string         `bson:"created_at"`
+	UpdatedAt           string         `bson:"updated_at"`
+	PostedAt            *string        `bson:"posted_at,omitempty"`
+}
+
+// ToEntity converts BillingStatementMongoDBModel to model.BillingStatement.
+func (m *BillingStatementMongoDBModel) ToEntity() (*model.BillingStatement, error) {
+	amount, err := decimal.NewFromString(m.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("billing_statement %s: invalid amount %q: %w", m.ID, m.Amount, err)
+	}
+
+	return &model.BillingStatement{
+		ID:                  m.ID,
+		RunID:               m.RunID,
+		OrganizationID:      m.OrganizationID,
+		LedgerID:            m.LedgerID,
+		BillingPackageID:    m.BillingPackageID,
+		BillingPackageLabel: m.BillingPackageLabel,
+		BillingType:         m.BillingType,
+		Period:              m.Period,
+		AccountAlias:        m.AccountAlias,
+		CreditAccountAlias:  m.CreditAccountAlias,
+		AssetCode:           m.AssetCode,
+		Amount:              amount,
+		Code:                m.Code,
+		Description:         m.Description,
+		Metadata:            m.Metadata,
+		IdempotencyKey:      m.IdempotencyKey,
+		Status:              m.Status,
+		TransactionID:       m.TransactionID,
+		Attempts:            m.Attempts,
+		LastError:           m.LastError,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+		PostedAt:            m.PostedAt,
+	}, nil
+}
+
+// FromEntity converts model.BillingStatement to BillingStatementMongoDBModel.
+func (m *BillingStatementMongoDBModel) FromEntity(s *model.BillingStatement) {
+	m.ID = s.ID
+	m.RunID = s.RunID
+	m.OrganizationID = s.OrganizationID
+	m.LedgerID = s.LedgerID
+	m.BillingPackageID = s.BillingPackageID
+	m.BillingPackageLabel = s.BillingPackageLabel
+	m.BillingType = s.BillingType
+	m.Period = s.Period
+	m.AccountAlias = s.AccountAlias
+	m.CreditAccountAlias = s.CreditAccountAlias
+	m.AssetCode = s.AssetCode
+	m.Amount = s.Amount.String()
+	m.Code = s.Code
+	m.Description = s.Description
+	m.Metadata = s.Metadata
+	m.IdempotencyKey = s.IdempotencyKey
+	m.Status = s.Status
+	m.TransactionID = s.TransactionID
+	m.Attempts = s.Attempts
+	m.LastError = s.LastError
+	m.CreatedAt = s.CreatedAt
+	m.UpdatedAt = s.UpdatedAt
+	m.PostedAt = s.PostedAt
+}
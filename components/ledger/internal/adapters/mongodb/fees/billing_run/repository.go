@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_run
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Repository provides an interface for billing runs and the statements they produce.
+//
+// Statements are append-only for their charge fields: the repository exposes no
+// generic update, only the posting-state transitions (MarkStatementPosted /
+// MarkStatementFailed), and neither transition ever touches a POSTED statement.
+//
+//go:generate mockgen --destination=./billing_run_mock.go --package=billing_run . Repository
+type Repository interface {
+	CreateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error)
+	UpdateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error)
+	FindRunByID(ctx context.Context, id, organizationID string) (*model.BillingRun, error)
+	InsertOrGetStatement(ctx context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error)
+	FindStatementsByRun(ctx context.Context, organizationID, runID string, statuses []string, limit, page int) ([]*model.BillingStatement, int64, error)
+	CountStatementsByRun(ctx context.Context, organizationID, runID string) (map[string]int, error)
+	MarkStatementPosted(ctx context.Context, id, organizationID, transactionID, postedAt string) (*model.BillingStatement, error)
+	MarkStatementFailed(ctx context.Context, id, organizationID, reason, failedAt string) (*model.BillingStatement, error)
+}
+
+// BillingRunMongoDBRepository is a MongoDB-specific implementation of the Repository.
+type BillingRunMongoDBRepository struct {
+	connection *mmongoDB.MongoConnection
+	Database   string
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// Multi-tenant: returns tenant-specific database from context.
+// Single-tenant: falls back to the static connection.
+func (r *BillingRunMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	client, err := r.connection.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(strings.ToLower(r.Database)), nil
+}
+
+// NewBillingRunMongoDBRepository returns a new instance of BillingRunMongoDBRepository using the given MongoDB connection.
+func NewBillingRunMongoDBRepository(mc *mmongoDB.MongoConnection, logger libLog.Logger) (*BillingRunMongoDBRepository, error) {
+	r := &BillingRunMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+
+	ctx := context.Background()
+
+	if _, err := r.connection.GetDB(ctx); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to connect mongo", libLog.Err(err))
+		return nil, err
+	}
+
+	if err := EnsureIndexes(ctx, mc); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to ensure mongo indexes for billing_run", libLog.Err(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewBillingRunMongoDBRepositoryFromConnection creates a BillingRunMongoDBRepository
+// directly from an already-connected MongoConnection, without calling GetDB or EnsureIndexes.
+// This is intended for integration tests where the caller manages connection and index setup.
+func NewBillingRunMongoDBRepositoryFromConnection(mc *mmongoDB.MongoConnection) *BillingRunMongoDBRepository {
+	return &BillingRunMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_run
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateRun inserts a new billing run.
+func (r *BillingRunMongoDBRepository) CreateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+	if run == nil {
+		return nil, errors.New("billing run cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_run.create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", run.OrganizationID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	record := &BillingRunMongoDBModel{}
+	record.FromEntity(run)
+
+	if _, err = db.Collection(strings.ToLower(feeconstant.BillingRunCollection)).InsertOne(ctx, record); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to insert billing run", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
+
+// UpdateRun replaces the status and counters of an existing billing run.
+func (r *BillingRunMongoDBRepository) UpdateRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+	if run == nil {
+		return nil, errors.New("billing run cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_run.update")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", run.OrganizationID),
+		attribute.String("app.request.billing_run_id", run.ID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	record := &BillingRunMongoDBModel{}
+	record.FromEntity(run)
+
+	filter := bson.M{"_id": run.ID, "organization_id": run.OrganizationID}
+
+	result, err := db.Collection(strings.ToLower(feeconstant.BillingRunCollection)).ReplaceOne(ctx, filter, record)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update billing run", err)
+
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing run not found", mongo.ErrNoDocuments)
+
+		return nil, mongo.ErrNoDocuments
+	}
+
+	return record.ToEntity()
+}
+
+// FindRunByID finds a billing run by ID and organization ID. It returns
+// mongo.ErrNoDocuments when the run does not exist.
+func (r *BillingRunMongoDBRepository) FindRunByID(ctx context.Context, id, organizationID string) (*model.BillingRun, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_run.find_by_id")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.billing_run_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	var record BillingRunMongoDBModel
+
+	filter := bson.M{"_id": id, "organization_id": organizationID}
+
+	if err = db.Collection(strings.ToLower(feeconstant.BillingRunCollection)).FindOne(ctx, filter).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing run not found", err)
+
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find billing run by ID", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package billing_run
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// InsertOrGetStatement persists a new statement, or — when a statement with the
+// same (organization, ledger, idempotency key) already exists — returns the
+// stored one untouched. The boolean reports whether the statement was created
+// by this call. Losing the insert race to a concurrent run lands in the same
+// branch, so two runs for the same period converge on one statement.
+func (r *BillingRunMongoDBRepository) InsertOrGetStatement(ctx context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+	if statement == nil {
+		return nil, false, errors.New("billing statement cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_statement.insert_or_get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", statement.OrganizationID),
+		attribute.String("app.request.billing_package_id", statement.BillingPackageID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, false, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingStatementCollection))
+
+	record := &BillingStatementMongoDBModel{}
+	record.FromEntity(statement)
+
+	_, err = coll.InsertOne(ctx, record)
+	if err == nil {
+		entity, errConv := record.ToEntity()
+
+		return entity, true, errConv
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		libOpentelemetry.HandleSpanError(span, "Failed to insert billing statement", err)
+
+		return nil, false, err
+	}
+
+	filter := bson.M{
+		"organization_id": statement.OrganizationID,
+		"ledger_id":       statement.LedgerID,
+		"idempotency_key": statement.IdempotencyKey,
+	}
+
+	var existing BillingStatementMongoDBModel
+
+	if err = coll.FindOne(ctx, filter).Decode(&existing); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to load existing billing statement", err)
+
+		return nil, false, err
+	}
+
+	entity, err := existing.ToEntity()
+
+	return entity, false, err
+}
+
+// FindStatementsByRun returns a page of the statements created by a run,
+// oldest first, optionally restricted to the given statuses. A non-positive
+// limit returns every matching statement.
+func (r *BillingRunMongoDBRepository) FindStatementsByRun(ctx context.Context, organizationID, runID string, statuses []string, limit, page int) ([]*model.BillingStatement, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_statement.find_by_run")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.billing_run_id", runID),
+		attribute.Int("app.request.limit", limit),
+		attribute.Int("app.request.page", page),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, 0, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingStatementCollection))
+
+	filter := bson.M{"organization_id": organizationID, "run_id": runID}
+	if len(statuses) > 0 {
+		filter["status"] = bson.M{"$in": statuses}
+	}
+
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to count billing statements", err)
+
+		return nil, 0, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	if limit > 0 {
+		if page < 1 {
+			page = 1
+		}
+
+		opts.SetLimit(int64(limit)).SetSkip(int64(page*limit - limit))
+	}
+
+	cur, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find billing statements", err)
+
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	statements := make([]*model.BillingStatement, 0)
+
+	for cur.Next(ctx) {
+		var record BillingStatementMongoDBModel
+		if err := cur.Decode(&record); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decode billing statement", err)
+
+			return nil, 0, err
+		}
+
+		entity, err := record.ToEntity()
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert billing statement record to entity", err)
+
+			return nil, 0, err
+		}
+
+		statements = append(statements, entity)
+	}
+
+	if err := cur.Err(); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to iterate billing statements", err)
+
+		return nil, 0, err
+	}
+
+	return statements, total, nil
+}
+
+// CountStatementsByRun returns the number of statements of a run per status.
+func (r *BillingRunMongoDBRepository) CountStatementsByRun(ctx context.Context, organizationID, runID string) (map[string]int, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.billing_statement.count_by_run")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.billing_run_id", runID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"organization_id": organizationID, "run_id": runID}}},
+		{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cur, err := db.Collection(strings.ToLower(feeconstant.BillingStatementCollection)).Aggregate(ctx, pipeline)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to aggregate billing statements", err)
+
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	counts := make(map[string]int)
+
+	for cur.Next(ctx) {
+		var row struct {
+			Status string `bson:"_id"`
+			Count  int    `bson:"count"`
+		}
+
+		if err := cur.Decode(&row); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decode billing statement count", err)
+
+			return nil, err
+		}
+
+		counts[row.Status] = row.Count
+	}
+
+	if err := cur.Err(); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to iterate billing statement counts", err)
+
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// MarkStatementPosted records the transaction that carried a statement's charge.
+// A statement that is already POSTED is returned unchanged.
+func (r *BillingRunMongoDBRepository) MarkStatementPosted(ctx context.Context, id, organizationID, transactionID, postedAt string) (*model.BillingStatement, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"status":         model.BillingStatementStatusPosted,
+			"transaction_id": transactionID,
+			"posted_at":      postedAt,
+			"updated_at":     postedAt,
+		},
+		"$unset": bson.M{"last_error": ""},
+		"$inc":   bson.M{"attempts": 1},
+	}
+
+	return r.transitionStatement(ctx, "repository.billing_statement.mark_posted", id, organizationID, update)
+}
+
+// MarkStatementFailed records a rejected posting attempt. A statement that is
+// already POSTED is returned unchanged, so a late failure report can never
+// demote a charge that reached the ledger.
+func (r *BillingRunMongoDBRepository) MarkStatementFailed(ctx context.Context, id, organizationID, reason, failedAt string) (*model.BillingStatement, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"status":     model.BillingStatementStatusFailed,
+			"last_error": reason,
+			"updated_at": failedAt,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+
+	return r.transitionStatement(ctx, "repository.billing_statement.mark_failed", id, organizationID, update)
+}
+
+// transitionStatement applies a posting-state update to a not-yet-posted
+// statement and returns the persisted document. When the guard filters the
+// statement out because it is already POSTED, the stored document is returned.
+func (r *BillingRunMongoDBRepository) transitionStatement(ctx context.Context, spanName, id, organizationID string, update bson.M) (*model.BillingStatement, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, spanName)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.billing_statement_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.BillingStatementCollection))
+
+	filter := bson.M{
+		"_id":             id,
+		"organization_id": organizationID,
+		"status":          bson.M{"$ne": model.BillingStatementStatusPosted},
+	}
+
+	var record BillingStatementMongoDBModel
+
+	err = coll.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&record)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = coll.FindOne(ctx, bson.M{"_id": id, "organization_id": organizationID}).Decode(&record)
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to transition billing statement", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
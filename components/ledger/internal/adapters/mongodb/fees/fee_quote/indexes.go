@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_quote
+
+import (
+	"context"
+	"strings"
+
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// quoteRetentionSeconds is how long a quote document is kept after it expires,
+// so the quote a transaction was charged with can still be looked up.
+const quoteRetentionSeconds = 30 * 24 * 60 * 60
+
+// EnsureIndexes creates the fee quote indexes. Quotes are read by _id only, so
+// the one index is the TTL that purges them once retention has passed.
+func EnsureIndexes(ctx context.Context, mc *mmongoDB.MongoConnection) error {
+	db, err := mc.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	database := db.Database(strings.ToLower(mc.Database))
+
+	indexes := []mongo.IndexModel{
+		// Index 1: expires_at TTL (retention after expiry)
+		{
+			Keys: bson.D{
+				{Key: "expires_at", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_fq_expires_at_ttl").
+				SetExpireAfterSeconds(quoteRetentionSeconds),
+		},
+	}
+
+	_, err = database.Collection(strings.ToLower(feeconstant.FeeQuoteCollection)).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_quote
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+)
+
+// FeeQuoteMongoDBModel represents the MongoDB document for a fee quote. The
+// quoted send and fee-adjusted transaction are stored as their JSON encoding:
+// they are never queried, and JSON keeps every decimal amount exactly as it
+// was quoted.
+type FeeQuoteMongoDBModel struct {
+	ID             string     `bson:"_id"`
+	OrganizationID string     `bson:"organization_id"`
+	LedgerID       string     `bson:"ledger_id"`
+	PackageID      string     `bson:"package_id"`
+	Send           string     `bson:"send"`
+	FeesApplied    string     `bson:"fees_applied"`
+	ExpiresAt      time.Time  `bson:"expires_at"`
+	UsedAt         *time.Time `bson:"used_at"`
+	CreatedAt      time.Time  `bson:"created_at"`
+}
+
+// ToEntity converts FeeQuoteMongoDBModel to model.FeeQuote. The status is
+// left for the caller to derive, since it depends on the time of reading.
+func (m *FeeQuoteMongoDBModel) ToEntity() (*model.FeeQuote, error) {
+	quote := &model.FeeQuote{
+		ID:             m.ID,
+		OrganizationID: m.OrganizationID,
+		LedgerID:       m.LedgerID,
+		PackageID:      m.PackageID,
+		ExpiresAt:      m.ExpiresAt,
+		UsedAt:         m.UsedAt,
+		CreatedAt:      m.CreatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(m.Send), &quote.Send); err != nil {
+		return nil, fmt.Errorf("fee_quote %s: invalid send: %w", m.ID, err)
+	}
+
+	if err := json.Unmarshal([]byte(m.FeesApplied), &quote.FeesApplied); err != nil {
+		return nil, fmt.Errorf("fee_quote %s: invalid fees_applied: %w", m.ID, err)
+	}
+
+	return quote, nil
+}
+
+// FromEntity converts model.FeeQuote to FeeQuoteMongoDBModel.
+func (m *FeeQuoteMongoDBModel) FromEntity(quote *model.FeeQuote) error {
+	send, err := json.Marshal(quote.Send)
+	if err != nil {
+		return fmt.Errorf("fee_quote %s: encode send: %w", quote.ID, err)
+	}
+
+	feesApplied, err := json.Marshal(quote.FeesApplied)
+	if err != nil {
+		return fmt.Errorf("fee_quote %s: encode fees_applied: %w", quote.ID, err)
+	}
+
+	m.ID = quote.ID
+	m.OrganizationID = quote.OrganizationID
+	m.LedgerID = quote.LedgerID
+	m.PackageID = quote.PackageID
+	m.Send = string(send)
+	m.FeesApplied = string(feesApplied)
+	m.ExpiresAt = quote.ExpiresAt
+	m.UsedAt = quote.UsedAt
+	m.CreatedAt = quote.CreatedAt
+
+	return nil
+}
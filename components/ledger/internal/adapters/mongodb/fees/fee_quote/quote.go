@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_quote
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Create inserts a new fee quote.
+func (r *FeeQuoteMongoDBRepository) Create(ctx context.Context, quote *model.FeeQuote) error {
+	if quote == nil {
+		return errors.New("fee quote cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_quote.create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", quote.OrganizationID),
+		attribute.String("app.request.fee_quote_id", quote.ID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	record := &FeeQuoteMongoDBModel{}
+	if err := record.FromEntity(quote); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert fee quote to record", err)
+
+		return err
+	}
+
+	if _, err = db.Collection(strings.ToLower(feeconstant.FeeQuoteCollection)).InsertOne(ctx, record); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to insert fee quote", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// FindByID finds a fee quote by ID and organization ID. It returns
+// mongo.ErrNoDocuments when the quote does not exist.
+func (r *FeeQuoteMongoDBRepository) FindByID(ctx context.Context, id, organizationID string) (*model.FeeQuote, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_quote.find_by_id")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.fee_quote_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	var record FeeQuoteMongoDBModel
+
+	filter := bson.M{"_id": id, "organization_id": organizationID}
+
+	if err = db.Collection(strings.ToLower(feeconstant.FeeQuoteCollection)).FindOne(ctx, filter).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Fee quote not found", err)
+
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find fee quote by ID", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
+
+// MarkUsed records that a transaction charged the quote at usedAt. It reports
+// false, without changing anything, when the quote is already used or has
+// expired by usedAt, so two transactions racing on one quote cannot both use it.
+func (r *FeeQuoteMongoDBRepository) MarkUsed(ctx context.Context, id, organizationID string, usedAt time.Time) (bool, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_quote.mark_used")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.fee_quote_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return false, err
+	}
+
+	filter := bson.M{
+		"_id":             id,
+		"organization_id": organizationID,
+		"used_at":         nil,
+		"expires_at":      bson.M{"$gt": usedAt},
+	}
+
+	result, err := db.Collection(strings.ToLower(feeconstant.FeeQuoteCollection)).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"used_at": usedAt}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to mark fee quote as used", err)
+
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// Release reopens a quote marked used by a transaction that then failed to
+// commit, so a retry can still be charged the quoted fees. Releasing a quote
+// that is not used changes nothing.
+func (r *FeeQuoteMongoDBRepository) Release(ctx context.Context, id, organizationID string) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_quote.release")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.fee_quote_id", id),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	filter := bson.M{
+		"_id":             id,
+		"organization_id": organizationID,
+		"used_at":         bson.M{"$ne": nil},
+	}
+
+	if _, err := db.Collection(strings.ToLower(feeconstant.FeeQuoteCollection)).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"used_at": nil}}); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to release fee quote", err)
+
+		return err
+	}
+
+	return nil
+}
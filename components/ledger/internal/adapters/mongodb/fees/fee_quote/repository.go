@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_quote
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Repository provides an interface for fee quotes.
+//
+// A quote's send and fees are immutable once created; the only transitions are
+// MarkUsed, which succeeds at most once per quote, and Release, which reopens a
+// quote whose transaction failed to commit.
+//
+//go:generate mockgen --destination=./fee_quote_mock.go --package=fee_quote . Repository
+type Repository interface {
+	Create(ctx context.Context, quote *model.FeeQuote) error
+	FindByID(ctx context.Context, id, organizationID string) (*model.FeeQuote, error)
+	MarkUsed(ctx context.Context, id, organizationID string, usedAt time.Time) (bool, error)
+	Release(ctx context.Context, id, organizationID string) error
+}
+
+// FeeQuoteMongoDBRepository is a MongoDB-specific implementation of the Repository.
+type FeeQuoteMongoDBRepository struct {
+	connection *mmongoDB.MongoConnection
+	Database   string
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// Multi-tenant: returns tenant-specific database from context.
+// Single-tenant: falls back to the static connection.
+func (r *FeeQuoteMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	client, err := r.connection.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(strings.ToLower(r.Database)), nil
+}
+
+// NewFeeQuoteMongoDBRepository returns a new instance of FeeQuoteMongoDBRepository using the given MongoDB connection.
+func NewFeeQuoteMongoDBRepository(mc *mmongoDB.MongoConnection, logger libLog.Logger) (*FeeQuoteMongoDBRepository, error) {
+	r := &FeeQuoteMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+
+	ctx := context.Background()
+
+	if _, err := r.connection.GetDB(ctx); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to connect mongo", libLog.Err(err))
+		return nil, err
+	}
+
+	if err := EnsureIndexes(ctx, mc); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to ensure mongo indexes for fee_quote", libLog.Err(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewFeeQuoteMongoDBRepositoryFromConnection creates a FeeQuoteMongoDBRepository
+// directly from an already-connected MongoConnection, without calling GetDB or EnsureIndexes.
+// This is intended for integration tests where the caller manages connection and index setup.
+func NewFeeQuoteMongoDBRepositoryFromConnection(mc *mmongoDB.MongoConnection) *FeeQuoteMongoDBRepository {
+	return &FeeQuoteMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_revenue (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=fee_revenue_mock.go --package=fee_revenue . Repository
+//
+
+// Package fee_revenue is a generated GoMock package.
+package fee_revenue
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Aggregate mocks base method.
+func (m *MockRepository) Aggregate(ctx context.Context, organizationID string, query *model.FeeRevenueQuery) ([]*model.FeeRevenueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, organizationID, query)
+	ret0, _ := ret[0].([]*model.FeeRevenueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockRepositoryMockRecorder) Aggregate(ctx, organizationID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockRepository)(nil).Aggregate), ctx, organizationID, query)
+}
+
+// ClearPending mocks base method.
+func (m *MockRepository) ClearPending(ctx context.Context, organizationID, transactionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPending", ctx, organizationID, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPending indicates an expected call of ClearPending.
+func (mr *MockRepositoryMockRecorder) ClearPending(ctx, organizationID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPending", reflect.TypeOf((*MockRepository)(nil).ClearPending), ctx, organizationID, transactionID)
+}
+
+// FindPending mocks base method.
+func (m *MockRepository) FindPending(ctx context.Context, organizationID string, limit int) ([]*model.PendingFeeRevenue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, organizationID, limit)
+	ret0, _ := ret[0].([]*model.PendingFeeRevenue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockRepositoryMockRecorder) FindPending(ctx, organizationID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockRepository)(nil).FindPending), ctx, organizationID, limit)
+}
+
+// MarkPending mocks base method.
+func (m *MockRepository) MarkPending(ctx context.Context, pending *model.PendingFeeRevenue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPending", ctx, pending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPending indicates an expected call of MarkPending.
+func (mr *MockRepositoryMockRecorder) MarkPending(ctx, pending any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPending", reflect.TypeOf((*MockRepository)(nil).MarkPending), ctx, pending)
+}
+
+// Record mocks base method.
+func (m *MockRepository) Record(ctx context.Context, entries []*model.FeeRevenueEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRepositoryMockRecorder) Record(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRepository)(nil).Record), ctx, entries)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_revenue
+
+import (
+	"context"
+	"strings"
+
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// EnsureIndexes creates the fee revenue indexes. Reports always filter on the
+// organization and a range of occurred_at, usually narrowed to one package;
+// retries read an organization's pending revenue oldest first.
+func EnsureIndexes(ctx context.Context, mc *mmongoDB.MongoConnection) error {
+	db, err := mc.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	database := db.Database(strings.ToLower(mc.Database))
+
+	indexes := []mongo.IndexModel{
+		// Index 1: org + occurred_at (report range)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "occurred_at", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_fr_org_occurred"),
+		},
+
+		// Index 2: org + package + occurred_at (report of one package)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "package_id", Value: 1},
+				{Key: "occurred_at", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_fr_org_package_occurred"),
+		},
+	}
+
+	if _, err = database.Collection(strings.ToLower(feeconstant.FeeRevenueCollection)).Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+
+	_, err = database.Collection(strings.ToLower(feeconstant.FeeRevenuePendingCollection)).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "organization_id", Value: 1},
+			{Key: "created_at", Value: 1},
+		},
+		Options: options.Index().
+			SetName("idx_frp_org_created"),
+	})
+
+	return err
+}
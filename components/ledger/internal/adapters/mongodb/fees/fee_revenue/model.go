@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_revenue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// decimal128Scale bounds the fractional digits stored in an amount so every
+// amount fits the 34 significant digits of a BSON Decimal128.
+const decimal128Scale = 18
+
+// FeeRevenueMongoDBModel represents the MongoDB document for a fee revenue
+// entry. The amount is a Decimal128 rather than a string so that reports can
+// $sum it.
+type FeeRevenueMongoDBModel struct {
+	ID             string          `bson:"_id"`
+	OrganizationID string          `bson:"organization_id"`
+	LedgerID       string          `bson:"ledger_id"`
+	TransactionID  string          `bson:"transaction_id"`
+	PackageID      string          `bson:"package_id"`
+	PackageVersion int             `bson:"package_version"`
+	FeeKey         string          `bson:"fee_key"`
+	FeeLabel       string          `bson:"fee_label"`
+	Route          string          `bson:"route"`
+	SegmentID      string          `bson:"segment_id"`
+	Asset          string          `bson:"asset"`
+	CreditAccount  string          `bson:"credit_account"`
+	Kind           string          `bson:"kind"`
+	Reason         string          `bson:"reason"`
+	Amount         bson.Decimal128 `bson:"amount"`
+	OccurredAt     time.Time       `bson:"occurred_at"`
+	CreatedAt      time.Time       `bson:"created_at"`
+}
+
+// FromEntity converts model.FeeRevenueEntry to FeeRevenueMongoDBModel.
+func (m *FeeRevenueMongoDBModel) FromEntity(entry *model.FeeRevenueEntry) error {
+	amount, err := toDecimal128(entry.Amount)
+	if err != nil {
+		return fmt.Errorf("fee_revenue %s: invalid amount: %w", entry.ID, err)
+	}
+
+	m.ID = entry.ID
+	m.OrganizationID = entry.OrganizationID
+	m.LedgerID = entry.LedgerID
+	m.TransactionID = entry.TransactionID
+	m.PackageID = entry.PackageID
+	m.PackageVersion = entry.PackageVersion
+	m.FeeKey = entry.FeeKey
+	m.FeeLabel = entry.FeeLabel
+	m.Route = entry.Route
+	m.SegmentID = entry.SegmentID
+	m.Asset = entry.Asset
+	m.CreditAccount = entry.CreditAccount
+	m.Kind = entry.Kind
+	m.Reason = entry.Reason
+	m.Amount = amount
+	m.OccurredAt = entry.OccurredAt
+	m.CreatedAt = entry.CreatedAt
+
+	return nil
+}
+
+// ToEntity converts FeeRevenueMongoDBModel to model.FeeRevenueEntry.
+func (m *FeeRevenueMongoDBModel) ToEntity() (*model.FeeRevenueEntry, error) {
+	amount, err := fromDecimal128(m.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("fee_revenue %s: invalid amount: %w", m.ID, err)
+	}
+
+	return &model.FeeRevenueEntry{
+		ID:             m.ID,
+		OrganizationID: m.OrganizationID,
+		LedgerID:       m.LedgerID,
+		TransactionID:  m.TransactionID,
+		PackageID:      m.PackageID,
+		PackageVersion: m.PackageVersion,
+		FeeKey:         m.FeeKey,
+		FeeLabel:       m.FeeLabel,
+		Route:          m.Route,
+		SegmentID:      m.SegmentID,
+		Asset:          m.Asset,
+		CreditAccount:  m.CreditAccount,
+		Kind:           m.Kind,
+		Reason:         m.Reason,
+		Amount:         amount,
+		OccurredAt:     m.OccurredAt,
+		CreatedAt:      m.CreatedAt,
+	}, nil
+}
+
+// FeeRevenuePendingMongoDBModel represents the MongoDB document of the revenue
+// of a transaction waiting to be recorded. It is keyed by transaction.
+type FeeRevenuePendingMongoDBModel struct {
+	ID             string                   `bson:"_id"`
+	OrganizationID string                   `bson:"organization_id"`
+	Entries        []FeeRevenueMongoDBModel `bson:"entries"`
+	Attempts       int64                    `bson:"attempts"`
+	LastAttemptAt  time.Time                `bson:"last_attempt_at"`
+	CreatedAt      time.Time                `bson:"created_at"`
+}
+
+// ToEntity converts FeeRevenuePendingMongoDBModel to model.PendingFeeRevenue.
+func (m *FeeRevenuePendingMongoDBModel) ToEntity() (*model.PendingFeeRevenue, error) {
+	entries := make([]*model.FeeRevenueEntry, 0, len(m.Entries))
+
+	for i := range m.Entries {
+		entry, err := m.Entries[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &model.PendingFeeRevenue{
+		TransactionID:  m.ID,
+		OrganizationID: m.OrganizationID,
+		Entries:        entries,
+		Attempts:       m.Attempts,
+		LastAttemptAt:  m.LastAttemptAt,
+	}, nil
+}
+
+// FeeRevenueRowMongoDBModel is one group of the revenue aggregation.
+type FeeRevenueRowMongoDBModel struct {
+	Group struct {
+		Period    string `bson:"period"`
+		PackageID string `bson:"package_id"`
+		FeeLabel  string `bson:"fee_label"`
+		Route     string `bson:"route"`
+		SegmentID string `bson:"segment_id"`
+		Asset     string `bson:"asset"`
+	} `bson:"_id"`
+	Charged         bson.Decimal128 `bson:"charged"`
+	Refunded        bson.Decimal128 `bson:"refunded"`
+	WaivedExemption bson.Decimal128 `bson:"waived_exemption"`
+	WaivedPromotion bson.Decimal128 `bson:"waived_promotion"`
+	Transactions    int64           `bson:"transactions"`
+}
+
+// ToEntity converts FeeRevenueRowMongoDBModel to model.FeeRevenueRow.
+func (m *FeeRevenueRowMongoDBModel) ToEntity() (*model.FeeRevenueRow, error) {
+	charged, err := fromDecimal128(m.Charged)
+	if err != nil {
+		return nil, fmt.Errorf("fee_revenue row: invalid charged: %w", err)
+	}
+
+	refunded, err := fromDecimal128(m.Refunded)
+	if err != nil {
+		return nil, fmt.Errorf("fee_revenue row: invalid refunded: %w", err)
+	}
+
+	exemption, err := fromDecimal128(m.WaivedExemption)
+	if err != nil {
+		return nil, fmt.Errorf("fee_revenue row: invalid waived_exemption: %w", err)
+	}
+
+	promotion, err := fromDecimal128(m.WaivedPromotion)
+	if err != nil {
+		return nil, fmt.Errorf("fee_revenue row: invalid waived_promotion: %w", err)
+	}
+
+	return &model.FeeRevenueRow{
+		Period:          m.Group.Period,
+		PackageID:       m.Group.PackageID,
+		FeeLabel:        m.Group.FeeLabel,
+		Route:           m.Group.Route,
+		SegmentID:       m.Group.SegmentID,
+		Asset:           m.Group.Asset,
+		Charged:         charged,
+		Refunded:        refunded,
+		Net:             charged.Sub(refunded),
+		Waived:          exemption.Add(promotion),
+		WaivedExemption: exemption,
+		WaivedPromotion: promotion,
+		Transactions:    m.Transactions,
+	}, nil
+}
+
+func toDecimal128(d decimal.Decimal) (bson.Decimal128, error) {
+	return bson.ParseDecimal128(d.Round(decimal128Scale).String())
+}
+
+func fromDecimal128(d bson.Decimal128) (decimal.Decimal, error) {
+	if d.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return decimal.NewFromString(d.String())
+}
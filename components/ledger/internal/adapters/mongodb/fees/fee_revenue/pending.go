@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_revenue
+
+import (
+	"context"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MarkPending keeps the revenue of a transaction for a retry, or counts another
+// failed attempt of a transaction already pending. Its entries are replaced, so
+// the labels found by the last attempt are kept.
+func (r *FeeRevenueMongoDBRepository) MarkPending(ctx context.Context, pending *model.PendingFeeRevenue) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_revenue.mark_pending")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", pending.OrganizationID),
+		attribute.String("app.request.transaction_id", pending.TransactionID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	entries := make([]FeeRevenueMongoDBModel, len(pending.Entries))
+
+	for i, entry := range pending.Entries {
+		if err := entries[i].FromEntity(entry); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert fee revenue entry to record", err)
+
+			return err
+		}
+	}
+
+	update := bson.D{
+		{Key: "$setOnInsert", Value: bson.D{
+			{Key: "organization_id", Value: pending.OrganizationID},
+			{Key: "created_at", Value: pending.LastAttemptAt},
+		}},
+		{Key: "$set", Value: bson.D{
+			{Key: "entries", Value: entries},
+			{Key: "last_attempt_at", Value: pending.LastAttemptAt},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: int64(1)}}},
+	}
+
+	filter := bson.D{{Key: "_id", Value: pending.TransactionID}}
+
+	if _, err := db.Collection(strings.ToLower(feeconstant.FeeRevenuePendingCollection)).UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true)); err != nil {
+		// Two failures of the same transaction at once: the loser's upsert hits
+		// the _id, and the transaction is pending either way.
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to mark pending fee revenue", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// FindPending returns up to limit transactions of the organization whose
+// revenue is pending, oldest first.
+func (r *FeeRevenueMongoDBRepository) FindPending(ctx context.Context, organizationID string, limit int) ([]*model.PendingFeeRevenue, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_revenue.find_pending")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.query.limit", limit),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := db.Collection(strings.ToLower(feeconstant.FeeRevenuePendingCollection)).Find(ctx, bson.D{{Key: "organization_id", Value: organizationID}}, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find pending fee revenue", err)
+
+		return nil, err
+	}
+
+	var records []FeeRevenuePendingMongoDBModel
+
+	if err = cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode pending fee revenue", err)
+
+		return nil, err
+	}
+
+	pending := make([]*model.PendingFeeRevenue, 0, len(records))
+
+	for i := range records {
+		p, err := records[i].ToEntity()
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert pending fee revenue", err)
+
+			return nil, err
+		}
+
+		pending = append(pending, p)
+	}
+
+	return pending, nil
+}
+
+// ClearPending drops the pending revenue of a transaction once it is recorded.
+func (r *FeeRevenueMongoDBRepository) ClearPending(ctx context.Context, organizationID, transactionID string) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_revenue.clear_pending")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.transaction_id", transactionID),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: transactionID},
+		{Key: "organization_id", Value: organizationID},
+	}
+
+	if _, err := db.Collection(strings.ToLower(feeconstant.FeeRevenuePendingCollection)).DeleteOne(ctx, filter); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to clear pending fee revenue", err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_revenue
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Repository provides an interface for the fee revenue entries reports are
+// built from.
+//
+// Entries are keyed by transaction, kind, package, fee and reason, so recording
+// a transaction again replaces its entries instead of counting them twice. The
+// revenue of a transaction that could not be recorded is kept pending, keyed by
+// transaction, until a retry records it.
+//
+//go:generate mockgen --destination=./fee_revenue_mock.go --package=fee_revenue . Repository
+type Repository interface {
+	Record(ctx context.Context, entries []*model.FeeRevenueEntry) error
+	Aggregate(ctx context.Context, organizationID string, query *model.FeeRevenueQuery) ([]*model.FeeRevenueRow, error)
+	MarkPending(ctx context.Context, pending *model.PendingFeeRevenue) error
+	FindPending(ctx context.Context, organizationID string, limit int) ([]*model.PendingFeeRevenue, error)
+	ClearPending(ctx context.Context, organizationID, transactionID string) error
+}
+
+// FeeRevenueMongoDBRepository is a MongoDB-specific implementation of the Repository.
+type FeeRevenueMongoDBRepository struct {
+	connection *mmongoDB.MongoConnection
+	Database   string
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// Multi-tenant: returns tenant-specific database from context.
+// Single-tenant: falls back to the static connection.
+func (r *FeeRevenueMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	client, err := r.connection.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(strings.ToLower(r.Database)), nil
+}
+
+// NewFeeRevenueMongoDBRepository returns a new instance of FeeRevenueMongoDBRepository using the given MongoDB connection.
+func NewFeeRevenueMongoDBRepository(mc *mmongoDB.MongoConnection, logger libLog.Logger) (*FeeRevenueMongoDBRepository, error) {
+	r := &FeeRevenueMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+
+	ctx := context.Background()
+
+	if _, err := r.connection.GetDB(ctx); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to connect mongo", libLog.Err(err))
+		return nil, err
+	}
+
+	if err := EnsureIndexes(ctx, mc); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to ensure mongo indexes for fee_revenue", libLog.Err(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewFeeRevenueMongoDBRepositoryFromConnection creates a FeeRevenueMongoDBRepository
+// directly from an already-connected MongoConnection, without calling GetDB or EnsureIndexes.
+// This is intended for integration tests where the caller manages connection and index setup.
+func NewFeeRevenueMongoDBRepositoryFromConnection(mc *mmongoDB.MongoConnection) *FeeRevenueMongoDBRepository {
+	return &FeeRevenueMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+}
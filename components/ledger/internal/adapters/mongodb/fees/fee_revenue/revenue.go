@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee_revenue
+
+import (
+	"context"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// periodFormats maps a report period to the $dateToString format of its key.
+var periodFormats = map[string]string{
+	model.FeeRevenuePeriodDay:   "%Y-%m-%d",
+	model.FeeRevenuePeriodMonth: "%Y-%m",
+}
+
+// groupFields maps a report dimension to the document field it groups on.
+var groupFields = map[string]string{
+	model.FeeRevenueByPackage:  "package_id",
+	model.FeeRevenueByFeeLabel: "fee_label",
+	model.FeeRevenueByRoute:    "route",
+	model.FeeRevenueBySegment:  "segment_id",
+}
+
+// Record upserts the entries by _id, so a transaction recorded twice keeps a
+// single copy of each of its entries.
+func (r *FeeRevenueMongoDBRepository) Record(ctx context.Context, entries []*model.FeeRevenueEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_revenue.record")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", entries[0].OrganizationID),
+		attribute.String("app.request.transaction_id", entries[0].TransactionID),
+		attribute.Int("app.request.entries", len(entries)),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(entries))
+
+	for _, entry := range entries {
+		record := &FeeRevenueMongoDBModel{}
+		if err := record.FromEntity(entry); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert fee revenue entry to record", err)
+
+			return err
+		}
+
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": record.ID}).
+			SetReplacement(record).
+			SetUpsert(true))
+	}
+
+	if _, err = db.Collection(strings.ToLower(feeconstant.FeeRevenueCollection)).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to record fee revenue", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// Aggregate sums the organization's entries matching query into one row per
+// period, asset and combination of the grouped dimensions, ordered by those
+// keys. The query is expected to be validated.
+func (r *FeeRevenueMongoDBRepository) Aggregate(ctx context.Context, organizationID string, query *model.FeeRevenueQuery) ([]*model.FeeRevenueRow, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.fee_revenue.aggregate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.period", query.Period),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	cursor, err := db.Collection(strings.ToLower(feeconstant.FeeRevenueCollection)).Aggregate(ctx, aggregationPipeline(organizationID, query))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to aggregate fee revenue", err)
+
+		return nil, err
+	}
+
+	var records []FeeRevenueRowMongoDBModel
+
+	if err = cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode fee revenue rows", err)
+
+		return nil, err
+	}
+
+	rows := make([]*model.FeeRevenueRow, 0, len(records))
+
+	for i := range records {
+		row, err := records[i].ToEntity()
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert fee revenue row", err)
+
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// aggregationPipeline builds the $match/$group/$group/$sort pipeline of a report.
+// Sums are seeded with a Decimal128 zero so every total stays a Decimal128.
+func aggregationPipeline(organizationID string, query *model.FeeRevenueQuery) mongo.Pipeline {
+	match := bson.D{
+		{Key: "organization_id", Value: organizationID},
+		{Key: "occurred_at", Value: bson.M{"$gte": query.From, "$lt": query.To}},
+	}
+
+	for _, filter := range []bson.E{
+		{Key: "ledger_id", Value: query.LedgerID},
+		{Key: "package_id", Value: query.PackageID},
+		{Key: "fee_label", Value: query.FeeLabel},
+		{Key: "route", Value: query.Route},
+		{Key: "segment_id", Value: query.SegmentID},
+		{Key: "asset", Value: query.Asset},
+	} {
+		if filter.Value != "" {
+			match = append(match, filter)
+		}
+	}
+
+	group := bson.D{{Key: "asset", Value: "$asset"}}
+	sort := bson.D{}
+
+	if format, ok := periodFormats[query.Period]; ok {
+		group = append(group, bson.E{Key: "period", Value: bson.M{
+			"$dateToString": bson.M{"format": format, "date": "$occurred_at", "timezone": "UTC"},
+		}})
+		sort = append(sort, bson.E{Key: "_id.period", Value: 1})
+	}
+
+	for _, dimension := range query.GroupBy {
+		field := groupFields[dimension]
+		group = append(group, bson.E{Key: field, Value: "$" + field})
+		sort = append(sort, bson.E{Key: "_id." + field, Value: 1})
+	}
+
+	sort = append(sort, bson.E{Key: "_id.asset", Value: 1})
+
+	zero, _ := bson.ParseDecimal128("0")
+
+	sumOf := func(conditions ...bson.M) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$and": conditions}, "$amount", zero}}}
+	}
+
+	kindIs := func(kind string) bson.M { return bson.M{"$eq": bson.A{"$kind", kind}} }
+	reasonIs := func(reason string) bson.M { return bson.M{"$eq": bson.A{"$reason", reason}} }
+
+	// The first $group also keys on transaction_id so the second one can count
+	// distinct transactions with $sum instead of collecting their ids.
+	byTransaction := append(bson.D{{Key: "transaction_id", Value: "$transaction_id"}}, group...)
+	regroup := make(bson.D, 0, len(group))
+
+	for _, key := range group {
+		regroup = append(regroup, bson.E{Key: key.Key, Value: "$_id." + key.Key})
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: byTransaction},
+			{Key: "charged", Value: sumOf(kindIs(model.FeeRevenueCharged))},
+			{Key: "refunded", Value: sumOf(kindIs(model.FeeRevenueRefunded))},
+			{Key: "waived_exemption", Value: sumOf(kindIs(model.FeeRevenueWaived), reasonIs(model.FeeWaiverExemption))},
+			{Key: "waived_promotion", Value: sumOf(kindIs(model.FeeRevenueWaived), reasonIs(model.FeeWaiverPromotion))},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: regroup},
+			{Key: "charged", Value: bson.M{"$sum": "$charged"}},
+			{Key: "refunded", Value: bson.M{"$sum": "$refunded"}},
+			{Key: "waived_exemption", Value: bson.M{"$sum": "$waived_exemption"}},
+			{Key: "waived_promotion", Value: bson.M{"$sum": "$waived_promotion"}},
+			{Key: "transactions", Value: bson.M{"$sum": 1}},
+		}}},
+		{{Key: "$sort", Value: sort}},
+	}
+}
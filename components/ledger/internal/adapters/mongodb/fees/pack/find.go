@@ -290,6 +290,7 @@ func (pm *PackageMongoDBRepository) FindFeesAndAmountDataByPackageID(ctx context
 		"ledger_id":         1,
 		"segment_id":        1,
 		"transaction_route": 1,
+		"asset":             1,
 	}
 
 	var result struct {
@@ -299,6 +300,7 @@ func (pm *PackageMongoDBRepository) FindFeesAndAmountDataByPackageID(ctx context
 		LedgerID         uuid.UUID           `bson:"ledger_id"`
 		SegmentID        *uuid.UUID          `bson:"segment_id"`
 		TransactionRoute *string             `bson:"transaction_route"`
+		Asset            *string             `bson:"asset"`
 	}
 
 	_, spanFindOne := tracer.Start(ctx, "repository.package.find_fees_by_package_id.find_one")
@@ -327,6 +329,7 @@ func (pm *PackageMongoDBRepository) FindFeesAndAmountDataByPackageID(ctx context
 		LedgerID:         result.LedgerID,
 		SegmentID:        result.SegmentID,
 		TransactionRoute: result.TransactionRoute,
+		Asset:            result.Asset,
 	}
 
 	return amountData, nil
@@ -31,8 +31,10 @@ var (
 
 // Calculation represents the calculation details for a fee
 type Calculation struct {
-	Type  string              `bson:"type"`
-	Value bsondecimal.Decimal `bson:"value"`
+	Type        string                         `bson:"type"`
+	Value       bsondecimal.Decimal            `bson:"value"`
+	UpTo        *bsondecimal.Decimal           `bson:"up_to,omitempty"`
+	AssetValues map[string]bsondecimal.Decimal `bson:"asset_values,omitempty"`
 }
 
 // CalculationModel represents the model used to calculate fees
@@ -43,33 +45,51 @@ type CalculationModel struct {
 
 // Fee represents an individual fee in the fees array
 type Fee struct {
-	FeeLabel         string           `bson:"fee_label"`
-	CalculationModel CalculationModel `bson:"calculation_model"`
-	ReferenceAmount  string           `bson:"reference_amount"`
-	Priority         int              `bson:"priority"`
-	IsDeductibleFrom *bool            `bson:"is_deductible_from"`
-	CreditAccount    string           `bson:"credit_account"`
-	RouteFrom        *string          `bson:"route_from"`
-	RouteTo          *string          `bson:"route_to"`
+	FeeLabel         string               `bson:"fee_label"`
+	CalculationModel CalculationModel     `bson:"calculation_model"`
+	ReferenceAmount  string               `bson:"reference_amount"`
+	Priority         int                  `bson:"priority"`
+	IsDeductibleFrom *bool                `bson:"is_deductible_from"`
+	CreditAccount    string               `bson:"credit_account"`
+	RouteFrom        *string              `bson:"route_from"`
+	RouteTo          *string              `bson:"route_to"`
+	MinimumFee       *bsondecimal.Decimal `bson:"minimum_fee,omitempty"`
+	MaximumFee       *bsondecimal.Decimal `bson:"maximum_fee,omitempty"`
+	BaseFee          *string              `bson:"base_fee,omitempty"`
+	Asset            *string              `bson:"asset,omitempty"`
+	SettlementAsset  *string              `bson:"settlement_asset,omitempty"`
+}
+
+// ReversalPolicy represents the reversal policy of a package
+type ReversalPolicy struct {
+	Mode                     string               `bson:"mode"`
+	NonRefundableFees        []string             `bson:"non_refundable_fees,omitempty"`
+	ReversalFee              *bsondecimal.Decimal `bson:"reversal_fee,omitempty"`
+	ReversalFeeCreditAccount *string              `bson:"reversal_fee_credit_account,omitempty"`
 }
 
 // PackageMongoDBModel represents the MongoDB model for a pack
 type PackageMongoDBModel struct {
-	ID               uuid.UUID           `bson:"_id"`
-	FeeGroupLabel    string              `bson:"fee_group_label"`
-	Description      *string             `bson:"description"`
-	OrganizationID   uuid.UUID           `bson:"organization_id"`
-	SegmentID        *uuid.UUID          `bson:"segment_id"`
-	LedgerID         uuid.UUID           `bson:"ledger_id"`
-	TransactionRoute *string             `bson:"transaction_route"`
-	MinimumAmount    bsondecimal.Decimal `bson:"minimum_amount"`
-	MaximumAmount    bsondecimal.Decimal `bson:"maximum_amount"`
-	WaivedAccounts   *[]string           `bson:"waived_accounts"`
-	Fees             map[string]Fee      `bson:"fees"`
-	Enable           *bool               `bson:"enable"`
-	CreatedAt        time.Time           `bson:"created_at"`
-	UpdatedAt        time.Time           `bson:"updated_at"`
-	DeletedAt        *time.Time          `bson:"deleted_at"`
+	ID               uuid.UUID                      `bson:"_id"`
+	FeeGroupLabel    string                         `bson:"fee_group_label"`
+	Description      *string                        `bson:"description"`
+	OrganizationID   uuid.UUID                      `bson:"organization_id"`
+	SegmentID        *uuid.UUID                     `bson:"segment_id"`
+	LedgerID         uuid.UUID                      `bson:"ledger_id"`
+	TransactionRoute *string                        `bson:"transaction_route"`
+	Asset            *string                        `bson:"asset,omitempty"`
+	MinimumAmount    bsondecimal.Decimal            `bson:"minimum_amount"`
+	MaximumAmount    bsondecimal.Decimal            `bson:"maximum_amount"`
+	WaivedAccounts   *[]string                      `bson:"waived_accounts"`
+	Fees             map[string]Fee                 `bson:"fees"`
+	Enable           *bool                          `bson:"enable"`
+	Version          int                            `bson:"version"`
+	Versions         []PackageVersionMongoDBModel   `bson:"versions,omitempty"`
+	ReversalPolicy   *ReversalPolicy                `bson:"reversal_policy,omitempty"`
+	Promotions       []PackagePromotionMongoDBModel `bson:"promotions,omitempty"`
+	CreatedAt        time.Time                      `bson:"created_at"`
+	UpdatedAt        time.Time                      `bson:"updated_at"`
+	DeletedAt        *time.Time                     `bson:"deleted_at"`
 }
 
 // Package represents the entity model for a pack
@@ -80,14 +100,27 @@ type Package struct {
 	SegmentID        *uuid.UUID           `json:"segmentId" example:"00000000-0000-0000-0000-000000000000"`
 	LedgerID         uuid.UUID            `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000"`
 	TransactionRoute *string              `json:"transactionRoute" example:"debitoted"`
+	Asset            *string              `json:"asset,omitempty" example:"USD"`
 	MinimumAmount    decimal.Decimal      `json:"minimumAmount" example:"100" minimum:"0"`
 	MaximumAmount    decimal.Decimal      `json:"maximumAmount" example:"2" minimum:"0"`
 	WaivedAccounts   *[]string            `json:"waivedAccounts" example:"acc001,acc002"`
 	Fees             map[string]model.Fee `json:"fees"`
 	Enable           *bool                `json:"enable"`
-	CreatedAt        time.Time            `json:"createdAt" example:"2021-01-01T00:00:00Z"`
-	UpdatedAt        time.Time            `json:"updatedAt" example:"2021-01-01T00:00:00Z"`
-	DeletedAt        *time.Time           `json:"deletedAt" example:"2021-01-01T00:00:00Z"`
+	// Version, EffectiveFrom and EffectiveTo identify the schedule version the
+	// amount range, waived accounts and fees above belong to.
+	Version       int              `json:"version" example:"1"`
+	EffectiveFrom *time.Time       `json:"effectiveFrom" example:"2021-01-01T00:00:00Z"`
+	EffectiveTo   *time.Time       `json:"effectiveTo" example:"2021-01-01T00:00:00Z"`
+	Versions      []PackageVersion `json:"versions,omitempty"`
+	// ReversalPolicy defines what happens to the fees above when the
+	// transaction they were charged on is reverted. Nil refunds every fee.
+	ReversalPolicy *model.ReversalPolicy `json:"reversalPolicy,omitempty"`
+	// Promotions waive the fees above for given accounts or holders while
+	// they are active.
+	Promotions []PackagePromotion `json:"promotions,omitempty"`
+	CreatedAt  time.Time          `json:"createdAt" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt  time.Time          `json:"updatedAt" example:"2021-01-01T00:00:00Z"`
+	DeletedAt  *time.Time         `json:"deletedAt" example:"2021-01-01T00:00:00Z"`
 }
 
 // NewPackage creates a new Package with validation of required fields.
@@ -129,6 +162,7 @@ func NewPackage(
 		MaximumAmount: maxAmount,
 		Fees:          fees,
 		Enable:        enable,
+		Version:       1,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}, nil
@@ -187,6 +221,16 @@ func (p *Package) GetTransactionRoute() string {
 	return *p.TransactionRoute
 }
 
+// GetAsset returns the asset the package is scoped to, or "" when it applies
+// to every asset.
+func (p *Package) GetAsset() string {
+	if p.Asset == nil {
+		return ""
+	}
+
+	return *p.Asset
+}
+
 // ToEntity converts PackageMongoDBModel to Package
 func (pmm *PackageMongoDBModel) ToEntity() *Package {
 	return &Package{
@@ -196,6 +240,7 @@ func (pmm *PackageMongoDBModel) ToEntity() *Package {
 		LedgerID:         pmm.LedgerID,
 		SegmentID:        pmm.SegmentID,
 		TransactionRoute: pmm.TransactionRoute,
+		Asset:            pmm.Asset,
 		MinimumAmount:    pmm.MinimumAmount.Decimal,
 		MaximumAmount:    pmm.MaximumAmount.Decimal,
 		WaivedAccounts:   pmm.WaivedAccounts,
@@ -204,6 +249,10 @@ func (pmm *PackageMongoDBModel) ToEntity() *Package {
 		UpdatedAt:        pmm.UpdatedAt,
 		DeletedAt:        pmm.DeletedAt,
 		Enable:           pmm.Enable,
+		Version:          pmm.Version,
+		Versions:         toEntityVersions(pmm.Versions),
+		ReversalPolicy:   ToEntityReversalPolicy(pmm.ReversalPolicy),
+		Promotions:       toEntityPromotions(pmm.Promotions),
 	}
 }
 
@@ -218,6 +267,7 @@ func (pmm *PackageMongoDBModel) FromEntity(p *Package, organizationID uuid.UUID)
 	pmm.FeeGroupLabel = p.FeeGroupLabel
 	pmm.Description = p.Description
 	pmm.TransactionRoute = p.TransactionRoute
+	pmm.Asset = p.Asset
 	pmm.SegmentID = p.SegmentID
 	pmm.OrganizationID = organizationID
 	pmm.LedgerID = p.LedgerID
@@ -225,7 +275,21 @@ func (pmm *PackageMongoDBModel) FromEntity(p *Package, organizationID uuid.UUID)
 	pmm.MaximumAmount = bsondecimal.Decimal{Decimal: p.MaximumAmount}
 	pmm.WaivedAccounts = p.WaivedAccounts
 	pmm.Fees = fees
+	versions, err := FromEntityVersions(p.Versions)
+	if err != nil {
+		return err
+	}
+
+	reversalPolicy, err := FromEntityReversalPolicy(p.ReversalPolicy)
+	if err != nil {
+		return err
+	}
+
 	pmm.Enable = p.Enable
+	pmm.Version = p.Version
+	pmm.Versions = versions
+	pmm.ReversalPolicy = reversalPolicy
+	pmm.Promotions = fromEntityPromotions(p.Promotions)
 	pmm.CreatedAt = p.CreatedAt
 	pmm.UpdatedAt = p.UpdatedAt
 
@@ -251,6 +315,11 @@ func ToEntityFeeMap(fees map[string]Fee) map[string]model.Fee {
 			CreditAccount:    fee.CreditAccount,
 			RouteTo:          fee.RouteTo,
 			RouteFrom:        fee.RouteFrom,
+			MinimumFee:       toEntityOptionalDecimal(fee.MinimumFee),
+			MaximumFee:       toEntityOptionalDecimal(fee.MaximumFee),
+			BaseFee:          fee.BaseFee,
+			Asset:            fee.Asset,
+			SettlementAsset:  fee.SettlementAsset,
 		}
 	}
 
@@ -262,8 +331,10 @@ func ToEntityCalculationArray(calculations []Calculation) []model.Calculation {
 	calculationsModel := make([]model.Calculation, 0, len(calculations))
 	for _, calc := range calculations {
 		calculationsModel = append(calculationsModel, model.Calculation{
-			Type:  calc.Type,
-			Value: calc.Value.String(),
+			Type:        calc.Type,
+			Value:       calc.Value.String(),
+			UpTo:        toEntityOptionalDecimal(calc.UpTo),
+			AssetValues: ToEntityAssetValues(calc.AssetValues),
 		})
 	}
 
@@ -297,6 +368,22 @@ func FromEntityFeeMap(fees map[string]model.Fee) (map[string]Fee, error) {
 			fee.RouteTo = nil
 		}
 
+		// Fee keys are stored in lowerCamel form, so the reference is stored the same way.
+		var baseFee *string
+		if key := fee.GetBaseFee(); key != "" {
+			baseFee = &key
+		}
+
+		minimumFee, err := fromEntityOptionalDecimal(fee.MinimumFee)
+		if err != nil {
+			return nil, fmt.Errorf("fee %q: invalid minimumFee: %w", key, err)
+		}
+
+		maximumFee, err := fromEntityOptionalDecimal(fee.MaximumFee)
+		if err != nil {
+			return nil, fmt.Errorf("fee %q: invalid maximumFee: %w", key, err)
+		}
+
 		feesDBModel[strcase.ToLowerCamel(key)] = Fee{
 			FeeLabel:         fee.FeeLabel,
 			CalculationModel: calcModelDB,
@@ -306,6 +393,11 @@ func FromEntityFeeMap(fees map[string]model.Fee) (map[string]Fee, error) {
 			CreditAccount:    fee.CreditAccount,
 			RouteTo:          fee.RouteTo,
 			RouteFrom:        fee.RouteFrom,
+			MinimumFee:       minimumFee,
+			MaximumFee:       maximumFee,
+			BaseFee:          baseFee,
+			Asset:            fee.Asset,
+			SettlementAsset:  fee.SettlementAsset,
 		}
 	}
 
@@ -322,11 +414,122 @@ func FromEntityCalculationArray(calculations []model.Calculation) ([]Calculation
 			return nil, fmt.Errorf("invalid decimal value %q for calculation type %q: %w", calc.Value, calc.Type, err)
 		}
 
+		upTo, err := fromEntityOptionalDecimal(calc.UpTo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upTo for calculation type %q: %w", calc.Type, err)
+		}
+
+		assetValues, err := FromEntityAssetValues(calc.AssetValues)
+		if err != nil {
+			return nil, fmt.Errorf("invalid assetValues for calculation type %q: %w", calc.Type, err)
+		}
+
 		calculationsDBModel = append(calculationsDBModel, Calculation{
-			Type:  calc.Type,
-			Value: bsondecimal.Decimal{Decimal: value},
+			Type:        calc.Type,
+			Value:       bsondecimal.Decimal{Decimal: value},
+			UpTo:        upTo,
+			AssetValues: assetValues,
 		})
 	}
 
 	return calculationsDBModel, nil
 }
+
+// ToEntityAssetValues renders stored per-asset calculation values as strings.
+func ToEntityAssetValues(values map[string]bsondecimal.Decimal) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	entity := make(map[string]string, len(values))
+	for asset, value := range values {
+		entity[asset] = value.String()
+	}
+
+	return entity
+}
+
+// FromEntityAssetValues parses per-asset calculation values for storage.
+func FromEntityAssetValues(values map[string]string) (map[string]bsondecimal.Decimal, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	stored := make(map[string]bsondecimal.Decimal, len(values))
+
+	for asset, raw := range values {
+		value, err := decimal.NewFromString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimal value %q for asset %q: %w", raw, asset, err)
+		}
+
+		stored[asset] = bsondecimal.Decimal{Decimal: value}
+	}
+
+	return stored, nil
+}
+
+// ToEntityReversalPolicy converts a stored reversal policy to its entity form.
+func ToEntityReversalPolicy(rp *ReversalPolicy) *model.ReversalPolicy {
+	if rp == nil {
+		return nil
+	}
+
+	return &model.ReversalPolicy{
+		Mode:                     rp.Mode,
+		NonRefundableFees:        rp.NonRefundableFees,
+		ReversalFee:              toEntityOptionalDecimal(rp.ReversalFee),
+		ReversalFeeCreditAccount: rp.ReversalFeeCreditAccount,
+	}
+}
+
+// FromEntityReversalPolicy converts a reversal policy for storage. Fee keys are
+// stored in the same lowerCamel form as the package fee map.
+func FromEntityReversalPolicy(rp *model.ReversalPolicy) (*ReversalPolicy, error) {
+	if rp == nil {
+		return nil, nil
+	}
+
+	reversalFee, err := fromEntityOptionalDecimal(rp.ReversalFee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reversalFee: %w", err)
+	}
+
+	var nonRefundable []string
+
+	for _, key := range rp.NonRefundableFees {
+		nonRefundable = append(nonRefundable, strcase.ToLowerCamel(key))
+	}
+
+	return &ReversalPolicy{
+		Mode:                     rp.Mode,
+		NonRefundableFees:        nonRefundable,
+		ReversalFee:              reversalFee,
+		ReversalFeeCreditAccount: rp.ReversalFeeCreditAccount,
+	}, nil
+}
+
+// toEntityOptionalDecimal renders an optional stored decimal as its string form.
+func toEntityOptionalDecimal(d *bsondecimal.Decimal) *string {
+	if d == nil {
+		return nil
+	}
+
+	s := d.String()
+
+	return &s
+}
+
+// fromEntityOptionalDecimal parses an optional decimal string for storage.
+func fromEntityOptionalDecimal(s *string) (*bsondecimal.Decimal, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	value, err := decimal.NewFromString(*s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal value %q: %w", *s, err)
+	}
+
+	return &bsondecimal.Decimal{Decimal: value}, nil
+}
@@ -30,6 +30,8 @@ type Repository interface {
 	SoftDelete(ctx context.Context, id, organizationID uuid.UUID) error
 	FindByOrganizationIDAndLedgerID(ctx context.Context, organizationID, ledgerID uuid.UUID) ([]*Package, error)
 	FindFeesAndAmountDataByPackageID(ctx context.Context, organizationID, packageID uuid.UUID) (*model.AmountData, error)
+	UpdateVersions(ctx context.Context, id, organizationID uuid.UUID, versions []PackageVersion, current PackageVersion) (*Package, error)
+	UpdatePromotions(ctx context.Context, id, organizationID uuid.UUID, promotions []PackagePromotion) (*Package, error)
 }
 
 // PackageMongoDBRepository is a MongoDD-specific implementation of the PackageRepository.
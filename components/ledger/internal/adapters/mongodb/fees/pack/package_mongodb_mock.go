@@ -1,7 +1,7 @@
 // Copyright (c) 2026 Lerian Studio. All rights reserved.
 // Use of this source code is governed by the Elastic License 2.0
 // that can be found in the LICENSE file.
-
+// Code generated by MockGen. DO NOT EDIT.
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack (interfaces: Repository)
 //
@@ -151,3 +151,33 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, id, organizationID, updateFiel
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, id, organizationID, updateFields)
 }
+
+// UpdatePromotions mocks base method.
+func (m *MockRepository) UpdatePromotions(ctx context.Context, id, organizationID uuid.UUID, promotions []PackagePromotion) (*Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotions", ctx, id, organizationID, promotions)
+	ret0, _ := ret[0].(*Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePromotions indicates an expected call of UpdatePromotions.
+func (mr *MockRepositoryMockRecorder) UpdatePromotions(ctx, id, organizationID, promotions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotions", reflect.TypeOf((*MockRepository)(nil).UpdatePromotions), ctx, id, organizationID, promotions)
+}
+
+// UpdateVersions mocks base method.
+func (m *MockRepository) UpdateVersions(ctx context.Context, id, organizationID uuid.UUID, versions []PackageVersion, current PackageVersion) (*Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVersions", ctx, id, organizationID, versions, current)
+	ret0, _ := ret[0].(*Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVersions indicates an expected call of UpdateVersions.
+func (mr *MockRepositoryMockRecorder) UpdateVersions(ctx, id, organizationID, versions, current any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersions", reflect.TypeOf((*MockRepository)(nil).UpdateVersions), ctx, id, organizationID, versions, current)
+}
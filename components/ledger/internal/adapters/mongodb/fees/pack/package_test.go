@@ -1279,3 +1279,36 @@ func stringPtr(s string) *string {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestFromEntityFeeMap_ProgressiveBandsAndFeeLimits_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	upTo := "1000"
+	minFee := "1.5"
+	isDeductible := false
+
+	fees := map[string]model.Fee{"acquiring": {
+		FeeLabel: "acquiring",
+		CalculationModel: &model.CalculationModel{
+			ApplicationRule: "progressive",
+			Calculations: []model.Calculation{
+				{Type: "percentage", Value: "2", UpTo: &upTo},
+				{Type: "percentage", Value: "1"},
+			},
+		},
+		IsDeductibleFrom: &isDeductible,
+		MinimumFee:       &minFee,
+	}}
+
+	stored, err := FromEntityFeeMap(fees)
+	assert.NoError(t, err)
+	assert.NotNil(t, stored["acquiring"].CalculationModel.Calculations[0].UpTo)
+	assert.Nil(t, stored["acquiring"].CalculationModel.Calculations[1].UpTo)
+	assert.Nil(t, stored["acquiring"].MaximumFee)
+
+	back := ToEntityFeeMap(stored)["acquiring"]
+	assert.Equal(t, "1000", *back.CalculationModel.Calculations[0].UpTo)
+	assert.Nil(t, back.CalculationModel.Calculations[1].UpTo)
+	assert.Equal(t, "1.5", *back.MinimumFee)
+	assert.Nil(t, back.MaximumFee)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pack
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/bsondecimal"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PackagePromotionMongoDBModel is the MongoDB model for a promotion embedded in
+// its package document.
+type PackagePromotionMongoDBModel struct {
+	ID               uuid.UUID            `bson:"_id"`
+	Label            string               `bson:"label"`
+	AccountAlias     *string              `bson:"account_alias,omitempty"`
+	HolderID         *string              `bson:"holder_id,omitempty"`
+	StartsAt         *time.Time           `bson:"starts_at"`
+	EndsAt           *time.Time           `bson:"ends_at"`
+	FreeTransactions *int                 `bson:"free_transactions,omitempty"`
+	FeeCap           *bsondecimal.Decimal `bson:"fee_cap,omitempty"`
+	Period           string               `bson:"period"`
+	CreatedAt        time.Time            `bson:"created_at"`
+}
+
+// PackagePromotion waives the package's fees for one account alias or for the
+// accounts of one holder during [StartsAt, EndsAt). A nil StartsAt means the
+// promotion applies from its creation; a nil EndsAt means it is open-ended.
+type PackagePromotion struct {
+	ID               uuid.UUID        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Label            string           `json:"label" example:"First 10 transfers free"`
+	AccountAlias     *string          `json:"accountAlias,omitempty" example:"@customer"`
+	HolderID         *string          `json:"holderId,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	StartsAt         *time.Time       `json:"startsAt" example:"2026-01-01T00:00:00Z"`
+	EndsAt           *time.Time       `json:"endsAt" example:"2026-02-01T00:00:00Z"`
+	FreeTransactions *int             `json:"freeTransactions,omitempty" example:"10"`
+	FeeCap           *decimal.Decimal `json:"feeCap,omitempty" example:"50.00"`
+	Period           string           `json:"period" example:"month"`
+	CreatedAt        time.Time        `json:"createdAt" example:"2021-01-01T00:00:00Z"`
+}
+
+// NewPackagePromotion builds a promotion from a validated input.
+func NewPackagePromotion(in *model.CreatePromotionInput, now time.Time) (PackagePromotion, error) {
+	id, err := commons.GenerateUUIDv7()
+	if err != nil {
+		return PackagePromotion{}, fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	promotion := PackagePromotion{
+		ID:               id,
+		Label:            in.Label,
+		AccountAlias:     in.AccountAlias,
+		HolderID:         in.HolderID,
+		StartsAt:         in.StartsAt,
+		EndsAt:           in.EndsAt,
+		FreeTransactions: in.FreeTransactions,
+		Period:           in.GetPeriod(),
+		CreatedAt:        now,
+	}
+
+	if in.FeeCap != nil {
+		feeCap, err := decimal.NewFromString(*in.FeeCap)
+		if err != nil {
+			return PackagePromotion{}, err
+		}
+
+		promotion.FeeCap = &feeCap
+	}
+
+	return promotion, nil
+}
+
+// ActiveAt reports whether the promotion applies at t.
+func (pp *PackagePromotion) ActiveAt(t time.Time) bool {
+	if pp.StartsAt != nil && t.Before(*pp.StartsAt) {
+		return false
+	}
+
+	return pp.EndsAt == nil || t.Before(*pp.EndsAt)
+}
+
+// Subject returns the account alias or holder ID the promotion targets, which
+// is also the subject its usage is counted for.
+func (pp *PackagePromotion) Subject() string {
+	if pp.HolderID != nil {
+		return *pp.HolderID
+	}
+
+	if pp.AccountAlias != nil {
+		return *pp.AccountAlias
+	}
+
+	return ""
+}
+
+// FindPromotion returns the promotion with the given ID, or nil.
+func (p *Package) FindPromotion(id uuid.UUID) *PackagePromotion {
+	for i := range p.Promotions {
+		if p.Promotions[i].ID == id {
+			return &p.Promotions[i]
+		}
+	}
+
+	return nil
+}
+
+// ToMongoDBModel converts the promotion for storage.
+func (pp *PackagePromotion) ToMongoDBModel() PackagePromotionMongoDBModel {
+	record := PackagePromotionMongoDBModel{
+		ID:               pp.ID,
+		Label:            pp.Label,
+		AccountAlias:     pp.AccountAlias,
+		HolderID:         pp.HolderID,
+		StartsAt:         pp.StartsAt,
+		EndsAt:           pp.EndsAt,
+		FreeTransactions: pp.FreeTransactions,
+		Period:           pp.Period,
+		CreatedAt:        pp.CreatedAt,
+	}
+
+	if pp.FeeCap != nil {
+		record.FeeCap = &bsondecimal.Decimal{Decimal: *pp.FeeCap}
+	}
+
+	return record
+}
+
+func toEntityPromotions(records []PackagePromotionMongoDBModel) []PackagePromotion {
+	if len(records) == 0 {
+		return nil
+	}
+
+	promotions := make([]PackagePromotion, 0, len(records))
+
+	for _, r := range records {
+		promotion := PackagePromotion{
+			ID:               r.ID,
+			Label:            r.Label,
+			AccountAlias:     r.AccountAlias,
+			HolderID:         r.HolderID,
+			StartsAt:         r.StartsAt,
+			EndsAt:           r.EndsAt,
+			FreeTransactions: r.FreeTransactions,
+			Period:           r.Period,
+			CreatedAt:        r.CreatedAt,
+		}
+
+		if r.FeeCap != nil {
+			feeCap := r.FeeCap.Decimal
+			promotion.FeeCap = &feeCap
+		}
+
+		promotions = append(promotions, promotion)
+	}
+
+	return promotions
+}
+
+func fromEntityPromotions(promotions []PackagePromotion) []PackagePromotionMongoDBModel {
+	if len(promotions) == 0 {
+		return nil
+	}
+
+	records := make([]PackagePromotionMongoDBModel, 0, len(promotions))
+	for i := range promotions {
+		records = append(records, promotions[i].ToMongoDBModel())
+	}
+
+	return records
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	libObservability "github.com/LerianStudio/lib-observability"
 
@@ -117,3 +118,80 @@ func unsetFieldPaths(unset any) bson.A {
 
 	return paths
 }
+
+// UpdateVersions replaces the package's version history and mirrors the
+// schedule of current (the version in force now) onto the top-level fields that
+// readers without an effective date see. The write goes through the same
+// pipeline as Update, so a current version without fees disables the package in
+// the same atomic step.
+func (pm *PackageMongoDBRepository) UpdateVersions(ctx context.Context, id, organizationID uuid.UUID, versions []PackageVersion, current PackageVersion) (*Package, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.package.update_versions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.Int("app.request.package_version", current.Version),
+	)
+
+	versionsDB, err := FromEntityVersions(versions)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert package versions", err)
+
+		return nil, err
+	}
+
+	var currentDB PackageVersionMongoDBModel
+	if err := currentDB.FromEntity(current); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert current package version", err)
+
+		return nil, err
+	}
+
+	// Document-valued fields are wrapped in $literal: inside an aggregation
+	// pipeline a fee label or alias starting with "$" would otherwise be read as
+	// a field path.
+	updateFields := bson.M{"$set": bson.M{
+		"versions":        bson.M{"$literal": versionsDB},
+		"version":         current.Version,
+		"minimum_amount":  currentDB.MinimumAmount,
+		"maximum_amount":  currentDB.MaximumAmount,
+		"waived_accounts": bson.M{"$literal": currentDB.WaivedAccounts},
+		"fees":            bson.M{"$literal": currentDB.Fees},
+		"updated_at":      time.Now(),
+	}}
+
+	return pm.Update(ctx, id, organizationID, &updateFields)
+}
+
+// UpdatePromotions replaces the package's promotions.
+func (pm *PackageMongoDBRepository) UpdatePromotions(ctx context.Context, id, organizationID uuid.UUID, promotions []PackagePromotion) (*Package, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.package.update_promotions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.Int("app.request.promotion_count", len(promotions)),
+	)
+
+	records := fromEntityPromotions(promotions)
+	if records == nil {
+		records = []PackagePromotionMongoDBModel{}
+	}
+
+	// $literal for the same reason as in UpdateVersions: labels and aliases are
+	// free text.
+	updateFields := bson.M{"$set": bson.M{
+		"promotions": bson.M{"$literal": records},
+		"updated_at": time.Now(),
+	}}
+
+	return pm.Update(ctx, id, organizationID, &updateFields)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pack
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/bsondecimal"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/shopspring/decimal"
+)
+
+// PackageVersionMongoDBModel is the MongoDB model for one effective-dated fee
+// schedule of a package.
+type PackageVersionMongoDBModel struct {
+	Version        int                 `bson:"version"`
+	EffectiveFrom  *time.Time          `bson:"effective_from"`
+	EffectiveTo    *time.Time          `bson:"effective_to"`
+	MinimumAmount  bsondecimal.Decimal `bson:"minimum_amount"`
+	MaximumAmount  bsondecimal.Decimal `bson:"maximum_amount"`
+	WaivedAccounts *[]string           `bson:"waived_accounts"`
+	Fees           map[string]Fee      `bson:"fees"`
+	ReversalPolicy *ReversalPolicy     `bson:"reversal_policy,omitempty"`
+	CreatedAt      time.Time           `bson:"created_at"`
+}
+
+// PackageVersion is an immutable snapshot of the fee schedule a package applies
+// during [EffectiveFrom, EffectiveTo). A nil EffectiveFrom means the version has
+// applied since the package was created; a nil EffectiveTo means it is open-ended.
+//
+// ReversalPolicy is the policy reverts of fees charged under the version
+// follow. It is nil only on versions recorded before policies were versioned.
+type PackageVersion struct {
+	Version        int                   `json:"version" example:"2"`
+	EffectiveFrom  *time.Time            `json:"effectiveFrom" example:"2026-01-01T00:00:00Z"`
+	EffectiveTo    *time.Time            `json:"effectiveTo" example:"2026-02-01T00:00:00Z"`
+	MinimumAmount  decimal.Decimal       `json:"minimumAmount" example:"100"`
+	MaximumAmount  decimal.Decimal       `json:"maximumAmount" example:"1000"`
+	WaivedAccounts *[]string             `json:"waivedAccounts" example:"acc001,acc002"`
+	Fees           map[string]model.Fee  `json:"fees"`
+	ReversalPolicy *model.ReversalPolicy `json:"reversalPolicy,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt" example:"2021-01-01T00:00:00Z"`
+}
+
+// inForceAt reports whether the version applies at t.
+func (v *PackageVersion) inForceAt(t time.Time) bool {
+	if v.EffectiveFrom != nil && t.Before(*v.EffectiveFrom) {
+		return false
+	}
+
+	return v.EffectiveTo == nil || t.Before(*v.EffectiveTo)
+}
+
+// BaseVersions returns the package's version history. Packages written before
+// versioning carry no history; for them the top-level schedule is reported as
+// an implicit, open-ended version 1 so every package resolves the same way.
+func (p *Package) BaseVersions() []PackageVersion {
+	if len(p.Versions) > 0 {
+		return p.Versions
+	}
+
+	return []PackageVersion{{
+		Version:        1,
+		MinimumAmount:  p.MinimumAmount,
+		MaximumAmount:  p.MaximumAmount,
+		WaivedAccounts: p.WaivedAccounts,
+		Fees:           p.Fees,
+		ReversalPolicy: SnapshotReversalPolicy(p.ReversalPolicy),
+		CreatedAt:      p.CreatedAt,
+	}}
+}
+
+// SnapshotReversalPolicy returns the policy a new version records. A package
+// without a policy refunds every fee, so that is recorded explicitly: a nil
+// version policy is left to mean "recorded before policies were versioned".
+func SnapshotReversalPolicy(rp *model.ReversalPolicy) *model.ReversalPolicy {
+	if rp == nil {
+		return &model.ReversalPolicy{Mode: model.ReversalRefundAll}
+	}
+
+	return rp
+}
+
+// ReversalPolicyOf returns the reversal policy of the given version: the one
+// the version recorded or, for a version recorded before policies were
+// versioned (or one no longer in the history), the package's current policy.
+func (p *Package) ReversalPolicyOf(version int) *model.ReversalPolicy {
+	if v := p.FindVersion(version); v != nil && v.ReversalPolicy != nil {
+		return v.ReversalPolicy
+	}
+
+	return p.ReversalPolicy
+}
+
+// VersionAt returns the version in force at t, or nil when none applies.
+func (p *Package) VersionAt(t time.Time) *PackageVersion {
+	versions := p.BaseVersions()
+
+	for i := range versions {
+		if versions[i].inForceAt(t) {
+			return &versions[i]
+		}
+	}
+
+	return nil
+}
+
+// FindVersion returns the version with the given number, or nil.
+func (p *Package) FindVersion(version int) *PackageVersion {
+	versions := p.BaseVersions()
+
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i]
+		}
+	}
+
+	return nil
+}
+
+// AsOf returns a copy of the package whose schedule (amount range, waived
+// accounts and fees) is the version in force at t, with Version, EffectiveFrom
+// and EffectiveTo describing that version. The receiver is not mutated, so a
+// cached package can be resolved for many effective dates. It returns nil when
+// no version applies at t.
+func (p *Package) AsOf(t time.Time) *Package {
+	v := p.VersionAt(t)
+	if v == nil {
+		return nil
+	}
+
+	resolved := *p
+	resolved.Version = v.Version
+	resolved.EffectiveFrom = v.EffectiveFrom
+	resolved.EffectiveTo = v.EffectiveTo
+	resolved.MinimumAmount = v.MinimumAmount
+	resolved.MaximumAmount = v.MaximumAmount
+	resolved.WaivedAccounts = v.WaivedAccounts
+	resolved.Fees = v.Fees
+
+	return &resolved
+}
+
+// NextVersionNumber returns the number the next recorded version takes.
+func (p *Package) NextVersionNumber() int {
+	next := 1
+
+	for _, v := range p.BaseVersions() {
+		if v.Version >= next {
+			next = v.Version + 1
+		}
+	}
+
+	return next
+}
+
+// WithVersion returns the package history with v added. Versions are kept
+// ordered by EffectiveFrom and the EffectiveTo of each one is rewritten to the
+// EffectiveFrom of its successor, so the history always tiles time without gaps
+// or overlaps. The receiver is not mutated.
+func (p *Package) WithVersion(v PackageVersion) []PackageVersion {
+	base := p.BaseVersions()
+
+	versions := make([]PackageVersion, 0, len(base)+1)
+	versions = append(versions, base...)
+	versions = append(versions, v)
+
+	return chainVersions(versions)
+}
+
+// WithoutVersion returns the package history with the given version removed and
+// the EffectiveTo chain rewritten. The receiver is not mutated.
+func (p *Package) WithoutVersion(version int) []PackageVersion {
+	base := p.BaseVersions()
+
+	versions := make([]PackageVersion, 0, len(base))
+
+	for _, v := range base {
+		if v.Version != version {
+			versions = append(versions, v)
+		}
+	}
+
+	return chainVersions(versions)
+}
+
+// chainVersions sorts versions by EffectiveFrom (nil first) and links each
+// version's EffectiveTo to the next one's EffectiveFrom; the last stays open.
+func chainVersions(versions []PackageVersion) []PackageVersion {
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, b := versions[i].EffectiveFrom, versions[j].EffectiveFrom
+
+		switch {
+		case a == nil:
+			return b != nil
+		case b == nil:
+			return false
+		default:
+			return a.Before(*b)
+		}
+	})
+
+	for i := range versions {
+		if i+1 < len(versions) {
+			versions[i].EffectiveTo = versions[i+1].EffectiveFrom
+		} else {
+			versions[i].EffectiveTo = nil
+		}
+	}
+
+	return versions
+}
+
+// ToEntity converts PackageVersionMongoDBModel to PackageVersion
+func (vmm *PackageVersionMongoDBModel) ToEntity() PackageVersion {
+	return PackageVersion{
+		Version:        vmm.Version,
+		EffectiveFrom:  vmm.EffectiveFrom,
+		EffectiveTo:    vmm.EffectiveTo,
+		MinimumAmount:  vmm.MinimumAmount.Decimal,
+		MaximumAmount:  vmm.MaximumAmount.Decimal,
+		WaivedAccounts: vmm.WaivedAccounts,
+		Fees:           ToEntityFeeMap(vmm.Fees),
+		ReversalPolicy: ToEntityReversalPolicy(vmm.ReversalPolicy),
+		CreatedAt:      vmm.CreatedAt,
+	}
+}
+
+// FromEntity converts PackageVersion to PackageVersionMongoDBModel
+func (vmm *PackageVersionMongoDBModel) FromEntity(v PackageVersion) error {
+	fees, err := FromEntityFeeMap(v.Fees)
+	if err != nil {
+		return fmt.Errorf("failed to convert fees of version %d: %w", v.Version, err)
+	}
+
+	reversalPolicy, err := FromEntityReversalPolicy(v.ReversalPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to convert reversal policy of version %d: %w", v.Version, err)
+	}
+
+	vmm.Version = v.Version
+	vmm.EffectiveFrom = v.EffectiveFrom
+	vmm.EffectiveTo = v.EffectiveTo
+	vmm.MinimumAmount = bsondecimal.Decimal{Decimal: v.MinimumAmount}
+	vmm.MaximumAmount = bsondecimal.Decimal{Decimal: v.MaximumAmount}
+	vmm.WaivedAccounts = v.WaivedAccounts
+	vmm.Fees = fees
+	vmm.ReversalPolicy = reversalPolicy
+	vmm.CreatedAt = v.CreatedAt
+
+	return nil
+}
+
+// toEntityVersions converts the stored history to entities.
+func toEntityVersions(versions []PackageVersionMongoDBModel) []PackageVersion {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	out := make([]PackageVersion, 0, len(versions))
+	for i := range versions {
+		out = append(out, versions[i].ToEntity())
+	}
+
+	return out
+}
+
+// FromEntityVersions converts a version history to its MongoDB form.
+func FromEntityVersions(versions []PackageVersion) ([]PackageVersionMongoDBModel, error) {
+	out := make([]PackageVersionMongoDBModel, 0, len(versions))
+
+	for _, v := range versions {
+		var vmm PackageVersionMongoDBModel
+		if err := vmm.FromEntity(v); err != nil {
+			return nil, err
+		}
+
+		out = append(out, vmm)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackage_AsOf_LegacyPackageIsImplicitVersionOne(t *testing.T) {
+	t.Parallel()
+
+	p := &Package{
+		MinimumAmount: decimal.NewFromInt(1),
+		MaximumAmount: decimal.NewFromInt(100),
+		Fees:          map[string]model.Fee{"a": {FeeLabel: "a"}},
+	}
+
+	resolved := p.AsOf(time.Now())
+	require.NotNil(t, resolved)
+	assert.Equal(t, 1, resolved.Version)
+	assert.Nil(t, resolved.EffectiveFrom)
+	assert.Nil(t, resolved.EffectiveTo)
+	assert.Contains(t, resolved.Fees, "a")
+	assert.Equal(t, 2, p.NextVersionNumber())
+}
+
+func TestPackage_WithVersion_ChainsEffectiveWindows(t *testing.T) {
+	t.Parallel()
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.AddDate(0, 1, 0)
+	t2 := t0.AddDate(0, 2, 0)
+
+	p := &Package{MinimumAmount: decimal.NewFromInt(1), MaximumAmount: decimal.NewFromInt(100)}
+
+	// Added out of order: the later version first.
+	p.Versions = p.WithVersion(PackageVersion{Version: 2, EffectiveFrom: &t2, MinimumAmount: decimal.NewFromInt(3)})
+	p.Versions = p.WithVersion(PackageVersion{Version: 3, EffectiveFrom: &t1, MinimumAmount: decimal.NewFromInt(2)})
+
+	require.Len(t, p.Versions, 3)
+	assert.Equal(t, []int{1, 3, 2}, []int{p.Versions[0].Version, p.Versions[1].Version, p.Versions[2].Version})
+	assert.True(t, p.Versions[0].EffectiveTo.Equal(t1))
+	assert.True(t, p.Versions[1].EffectiveTo.Equal(t2))
+	assert.Nil(t, p.Versions[2].EffectiveTo)
+
+	assert.Equal(t, 1, p.AsOf(t0).Version)
+	assert.Equal(t, 3, p.AsOf(t1).Version, "EffectiveFrom is inclusive")
+	assert.Equal(t, 3, p.AsOf(t2.Add(-time.Nanosecond)).Version, "EffectiveTo is exclusive")
+	assert.Equal(t, 2, p.AsOf(t2.AddDate(1, 0, 0)).Version)
+	assert.True(t, p.AsOf(t1).MinimumAmount.Equal(decimal.NewFromInt(2)))
+
+	versions := p.WithoutVersion(3)
+	require.Len(t, versions, 2)
+	assert.True(t, versions[0].EffectiveTo.Equal(t2), "v1 now runs until v2")
+}
+
+func TestPackage_AsOf_NoVersionInForce(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &Package{Versions: []PackageVersion{{Version: 1, EffectiveFrom: &from}}}
+
+	assert.Nil(t, p.AsOf(from.Add(-time.Hour)))
+	assert.NotNil(t, p.AsOf(from))
+}
+
+func TestPackageVersionMongoDBModel_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	v := PackageVersion{
+		Version:        2,
+		EffectiveFrom:  &from,
+		MinimumAmount:  decimal.RequireFromString("10.5"),
+		MaximumAmount:  decimal.NewFromInt(500),
+		Fees:           map[string]model.Fee{},
+		ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalKeepFees, NonRefundableFees: []string{"processing_fee"}},
+	}
+
+	stored, err := FromEntityVersions([]PackageVersion{v})
+	require.NoError(t, err)
+
+	back := toEntityVersions(stored)
+	require.Len(t, back, 1)
+	assert.Equal(t, 2, back[0].Version)
+	assert.True(t, back[0].EffectiveFrom.Equal(from))
+	assert.True(t, back[0].MinimumAmount.Equal(v.MinimumAmount))
+	require.NotNil(t, back[0].ReversalPolicy)
+	assert.Equal(t, model.ReversalKeepFees, back[0].ReversalPolicy.Mode)
+	assert.Equal(t, []string{"processingFee"}, back[0].ReversalPolicy.NonRefundableFees)
+}
+
+func TestPackage_ReversalPolicyOf(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	keep := &model.ReversalPolicy{Mode: model.ReversalKeepFees}
+	charge := &model.ReversalPolicy{Mode: model.ReversalChargeFee}
+
+	p := &Package{
+		ReversalPolicy: charge,
+		Versions: []PackageVersion{
+			{Version: 1, ReversalPolicy: keep},
+			{Version: 2, EffectiveFrom: &from},
+		},
+	}
+
+	assert.Same(t, keep, p.ReversalPolicyOf(1), "the version that charged the fee decides")
+	assert.Same(t, charge, p.ReversalPolicyOf(2), "a version recorded before policies were versioned falls back to the package")
+	assert.Same(t, charge, p.ReversalPolicyOf(0), "a fee charged before versioning falls back to the package")
+
+	legacy := &Package{}
+	assert.Equal(t, model.ReversalRefundAll, legacy.ReversalPolicyOf(1).Mode, "a package without a policy records refundAll")
+}
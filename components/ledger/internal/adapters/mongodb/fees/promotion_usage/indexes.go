@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package promotion_usage
+
+import (
+	"context"
+	"strings"
+
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// EnsureIndexes creates the promotion usage indexes. The unique counter index
+// is what keeps concurrent upserts of the same (promotion, subject, period) on
+// a single document.
+func EnsureIndexes(ctx context.Context, mc *mmongoDB.MongoConnection) error {
+	db, err := mc.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	database := db.Database(strings.ToLower(mc.Database))
+
+	indexes := []mongo.IndexModel{
+		// Index 1: org + promotion + subject + period UNIQUE (one counter per period)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "promotion_id", Value: 1},
+				{Key: "subject", Value: 1},
+				{Key: "period", Value: 1},
+			},
+			Options: options.Index().
+				SetName("uidx_pu_org_promotion_subject_period").
+				SetUnique(true),
+		},
+
+		// Index 2: org + promotion + updated_at (for FindByPromotion)
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "promotion_id", Value: 1},
+				{Key: "updated_at", Value: -1},
+			},
+			Options: options.Index().
+				SetName("idx_pu_org_promotion_updated"),
+		},
+	}
+
+	_, err = database.Collection(strings.ToLower(feeconstant.PromotionUsageCollection)).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
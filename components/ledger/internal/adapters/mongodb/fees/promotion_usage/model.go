@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package promotion_usage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// decimal128Scale bounds the fractional digits stored in a counter so every
+// amount fits the 34 significant digits of a BSON Decimal128.
+const decimal128Scale = 18
+
+// PromotionUsageMongoDBModel represents the MongoDB document for the usage of a
+// promotion by one subject in one period. Amounts are Decimal128 rather than
+// strings so that $inc can move them atomically.
+type PromotionUsageMongoDBModel struct {
+	OrganizationID string          `bson:"organization_id"`
+	PackageID      string          `bson:"package_id"`
+	PromotionID    string          `bson:"promotion_id"`
+	Subject        string          `bson:"subject"`
+	Period         string          `bson:"period"`
+	Transactions   int64           `bson:"transactions"`
+	FeesCharged    bson.Decimal128 `bson:"fees_charged"`
+	FeesWaived     bson.Decimal128 `bson:"fees_waived"`
+	CreatedAt      time.Time       `bson:"created_at"`
+	UpdatedAt      time.Time       `bson:"updated_at"`
+}
+
+// ToEntity converts PromotionUsageMongoDBModel to model.PromotionUsage.
+func (m *PromotionUsageMongoDBModel) ToEntity() (*model.PromotionUsage, error) {
+	charged, err := fromDecimal128(m.FeesCharged)
+	if err != nil {
+		return nil, fmt.Errorf("promotion_usage %s/%s: invalid fees_charged: %w", m.PromotionID, m.Subject, err)
+	}
+
+	waived, err := fromDecimal128(m.FeesWaived)
+	if err != nil {
+		return nil, fmt.Errorf("promotion_usage %s/%s: invalid fees_waived: %w", m.PromotionID, m.Subject, err)
+	}
+
+	return &model.PromotionUsage{
+		OrganizationID: m.OrganizationID,
+		PackageID:      m.PackageID,
+		PromotionID:    m.PromotionID,
+		Subject:        m.Subject,
+		Period:         m.Period,
+		Transactions:   m.Transactions,
+		FeesCharged:    charged,
+		FeesWaived:     waived,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}, nil
+}
+
+func toDecimal128(d decimal.Decimal) (bson.Decimal128, error) {
+	return bson.ParseDecimal128(d.Round(decimal128Scale).String())
+}
+
+func fromDecimal128(d bson.Decimal128) (decimal.Decimal, error) {
+	if d.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return decimal.NewFromString(d.String())
+}
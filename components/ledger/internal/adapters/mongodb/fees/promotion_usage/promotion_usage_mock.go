@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/promotion_usage (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=./promotion_usage_mock.go --package=promotion_usage . Repository
+//
+
+// Package promotion_usage is a generated GoMock package.
+package promotion_usage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockRepository) Find(ctx context.Context, organizationID, promotionID, subject, period string) (*model.PromotionUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, organizationID, promotionID, subject, period)
+	ret0, _ := ret[0].(*model.PromotionUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRepositoryMockRecorder) Find(ctx, organizationID, promotionID, subject, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRepository)(nil).Find), ctx, organizationID, promotionID, subject, period)
+}
+
+// FindByPromotion mocks base method.
+func (m *MockRepository) FindByPromotion(ctx context.Context, organizationID, promotionID string, limit, page int) ([]*model.PromotionUsage, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPromotion", ctx, organizationID, promotionID, limit, page)
+	ret0, _ := ret[0].([]*model.PromotionUsage)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByPromotion indicates an expected call of FindByPromotion.
+func (mr *MockRepositoryMockRecorder) FindByPromotion(ctx, organizationID, promotionID, limit, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPromotion", reflect.TypeOf((*MockRepository)(nil).FindByPromotion), ctx, organizationID, promotionID, limit, page)
+}
+
+// Increment mocks base method.
+func (m *MockRepository) Increment(ctx context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, delta)
+	ret0, _ := ret[0].(*model.PromotionUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockRepositoryMockRecorder) Increment(ctx, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockRepository)(nil).Increment), ctx, delta)
+}
+
+// IncrementWithin mocks base method.
+func (m *MockRepository) IncrementWithin(ctx context.Context, delta *model.PromotionUsage, maxTransactions *int64, maxFeesCharged *decimal.Decimal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementWithin", ctx, delta, maxTransactions, maxFeesCharged)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementWithin indicates an expected call of IncrementWithin.
+func (mr *MockRepositoryMockRecorder) IncrementWithin(ctx, delta, maxTransactions, maxFeesCharged any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementWithin", reflect.TypeOf((*MockRepository)(nil).IncrementWithin), ctx, delta, maxTransactions, maxFeesCharged)
+}
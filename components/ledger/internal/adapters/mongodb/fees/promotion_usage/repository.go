@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package promotion_usage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	mmongoDB "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Repository provides an interface for the usage counters of fee promotions.
+//
+// Counters are only ever moved by Increment and IncrementWithin, atomic
+// upserts, so concurrent transactions of the same subject never lose each
+// other's usage.
+//
+//go:generate mockgen --destination=./promotion_usage_mock.go --package=promotion_usage . Repository
+type Repository interface {
+	Increment(ctx context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error)
+	IncrementWithin(ctx context.Context, delta *model.PromotionUsage, maxTransactions *int64, maxFeesCharged *decimal.Decimal) (bool, error)
+	Find(ctx context.Context, organizationID, promotionID, subject, period string) (*model.PromotionUsage, error)
+	FindByPromotion(ctx context.Context, organizationID, promotionID string, limit, page int) ([]*model.PromotionUsage, int64, error)
+}
+
+// PromotionUsageMongoDBRepository is a MongoDB-specific implementation of the Repository.
+type PromotionUsageMongoDBRepository struct {
+	connection *mmongoDB.MongoConnection
+	Database   string
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// Multi-tenant: returns tenant-specific database from context.
+// Single-tenant: falls back to the static connection.
+func (r *PromotionUsageMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	client, err := r.connection.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(strings.ToLower(r.Database)), nil
+}
+
+// NewPromotionUsageMongoDBRepository returns a new instance of PromotionUsageMongoDBRepository using the given MongoDB connection.
+func NewPromotionUsageMongoDBRepository(mc *mmongoDB.MongoConnection, logger libLog.Logger) (*PromotionUsageMongoDBRepository, error) {
+	r := &PromotionUsageMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+
+	ctx := context.Background()
+
+	if _, err := r.connection.GetDB(ctx); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to connect mongo", libLog.Err(err))
+		return nil, err
+	}
+
+	if err := EnsureIndexes(ctx, mc); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Failed to ensure mongo indexes for promotion_usage", libLog.Err(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewPromotionUsageMongoDBRepositoryFromConnection creates a PromotionUsageMongoDBRepository
+// directly from an already-connected MongoConnection, without calling GetDB or EnsureIndexes.
+// This is intended for integration tests where the caller manages connection and index setup.
+func NewPromotionUsageMongoDBRepositoryFromConnection(mc *mmongoDB.MongoConnection) *PromotionUsageMongoDBRepository {
+	return &PromotionUsageMongoDBRepository{
+		connection: mc,
+		Database:   mc.Database,
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package promotion_usage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Increment atomically adds the transactions and fee amounts of delta to the
+// counter of (organization, promotion, subject, period), creating it on first
+// use, and returns the counter after the change. Two first uses racing on the
+// unique index make one upsert fail with a duplicate key; that one is retried
+// once and then lands on the document the other created.
+func (r *PromotionUsageMongoDBRepository) Increment(ctx context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error) {
+	if delta == nil {
+		return nil, errors.New("promotion usage delta cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.promotion_usage.increment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", delta.OrganizationID),
+		attribute.String("app.request.promotion_id", delta.PromotionID),
+		attribute.String("app.request.period", delta.Period),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	charged, err := toDecimal128(delta.FeesCharged)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert fees charged", err)
+
+		return nil, err
+	}
+
+	waived, err := toDecimal128(delta.FeesWaived)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert fees waived", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	coll := db.Collection(strings.ToLower(feeconstant.PromotionUsageCollection))
+
+	filter := bson.M{
+		"organization_id": delta.OrganizationID,
+		"promotion_id":    delta.PromotionID,
+		"subject":         delta.Subject,
+		"period":          delta.Period,
+	}
+
+	update := bson.M{
+		"$inc": bson.M{
+			"transactions": delta.Transactions,
+			"fees_charged": charged,
+			"fees_waived":  waived,
+		},
+		"$set":         bson.M{"updated_at": now},
+		"$setOnInsert": bson.M{"package_id": delta.PackageID, "created_at": now},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var record PromotionUsageMongoDBModel
+
+	err = coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record)
+	if mongo.IsDuplicateKeyError(err) {
+		err = coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record)
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to increment promotion usage", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
+
+// IncrementWithin adds delta to the counter of (organization, promotion,
+// subject, period) like Increment, but only while the counter stays within the
+// given limits once it is added: at most maxTransactions transactions and at
+// most maxFeesCharged fees charged. A nil limit is not checked. The limits are
+// part of the update's filter, so the check and the increment are one atomic
+// write. It reports whether delta was added.
+func (r *PromotionUsageMongoDBRepository) IncrementWithin(ctx context.Context, delta *model.PromotionUsage, maxTransactions *int64, maxFeesCharged *decimal.Decimal) (bool, error) {
+	if delta == nil {
+		return false, errors.New("promotion usage delta cannot be nil")
+	}
+
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.promotion_usage.increment_within")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", delta.OrganizationID),
+		attribute.String("app.request.promotion_id", delta.PromotionID),
+		attribute.String("app.request.period", delta.Period),
+	)
+
+	// A delta that alone exceeds a limit would be inserted as a new counter.
+	if (maxTransactions != nil && delta.Transactions > *maxTransactions) ||
+		(maxFeesCharged != nil && delta.FeesCharged.GreaterThan(*maxFeesCharged)) {
+		return false, nil
+	}
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return false, err
+	}
+
+	charged, err := toDecimal128(delta.FeesCharged)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert fees charged", err)
+
+		return false, err
+	}
+
+	waived, err := toDecimal128(delta.FeesWaived)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to convert fees waived", err)
+
+		return false, err
+	}
+
+	filter := bson.M{
+		"organization_id": delta.OrganizationID,
+		"promotion_id":    delta.PromotionID,
+		"subject":         delta.Subject,
+		"period":          delta.Period,
+	}
+
+	if maxTransactions != nil {
+		filter["transactions"] = bson.M{"$lte": *maxTransactions - delta.Transactions}
+	}
+
+	if maxFeesCharged != nil {
+		headroom, err := toDecimal128(maxFeesCharged.Sub(delta.FeesCharged))
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert fee cap", err)
+
+			return false, err
+		}
+
+		filter["fees_charged"] = bson.M{"$lte": headroom}
+	}
+
+	now := time.Now().UTC()
+	coll := db.Collection(strings.ToLower(feeconstant.PromotionUsageCollection))
+
+	update := bson.M{
+		"$inc": bson.M{
+			"transactions": delta.Transactions,
+			"fees_charged": charged,
+			"fees_waived":  waived,
+		},
+		"$set":         bson.M{"updated_at": now},
+		"$setOnInsert": bson.M{"package_id": delta.PackageID, "created_at": now},
+	}
+
+	opts := options.UpdateOne().SetUpsert(true)
+
+	// A counter over the limits does not match the filter, so the upsert tries
+	// to insert a second one and hits the unique index. The first duplicate key
+	// may also be a concurrent first use, so it is retried once like Increment;
+	// a second one is a counter over the limits.
+	result, err := coll.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		result, err = coll.UpdateOne(ctx, filter, update, opts)
+	}
+
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to increment promotion usage", err)
+
+		return false, err
+	}
+
+	return result.MatchedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+// Find returns the counter of (organization, promotion, subject, period), or
+// nil when the subject has not used the promotion in that period.
+func (r *PromotionUsageMongoDBRepository) Find(ctx context.Context, organizationID, promotionID, subject, period string) (*model.PromotionUsage, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.promotion_usage.find")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.promotion_id", promotionID),
+		attribute.String("app.request.period", period),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	filter := bson.M{
+		"organization_id": organizationID,
+		"promotion_id":    promotionID,
+		"subject":         subject,
+		"period":          period,
+	}
+
+	var record PromotionUsageMongoDBModel
+
+	if err = db.Collection(strings.ToLower(feeconstant.PromotionUsageCollection)).FindOne(ctx, filter).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find promotion usage", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity()
+}
+
+// FindByPromotion returns a page of a promotion's counters, most recently used
+// first. A non-positive limit returns every counter.
+func (r *PromotionUsageMongoDBRepository) FindByPromotion(ctx context.Context, organizationID, promotionID string, limit, page int) ([]*model.PromotionUsage, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.promotion_usage.find_by_promotion")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.promotion_id", promotionID),
+		attribute.Int("app.request.limit", limit),
+		attribute.Int("app.request.page", page),
+	)
+
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, 0, err
+	}
+
+	coll := db.Collection(strings.ToLower(feeconstant.PromotionUsageCollection))
+	filter := bson.M{"organization_id": organizationID, "promotion_id": promotionID}
+
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to count promotion usage", err)
+
+		return nil, 0, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "subject", Value: 1}})
+
+	if limit > 0 {
+		if page < 1 {
+			page = 1
+		}
+
+		opts.SetLimit(int64(limit)).SetSkip(int64(page*limit - limit))
+	}
+
+	cur, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find promotion usage", err)
+
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	usage := make([]*model.PromotionUsage, 0)
+
+	for cur.Next(ctx) {
+		var record PromotionUsageMongoDBModel
+		if err := cur.Decode(&record); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decode promotion usage", err)
+
+			return nil, 0, err
+		}
+
+		entity, err := record.ToEntity()
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert promotion usage record to entity", err)
+
+			return nil, 0, err
+		}
+
+		usage = append(usage, entity)
+	}
+
+	if err := cur.Err(); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to iterate promotion usage", err)
+
+		return nil, 0, err
+	}
+
+	return usage, total, nil
+}
@@ -81,6 +81,16 @@ type Repository interface {
 	// holder-delete ownership guard, so it counts only active (deleted_at IS NULL)
 	// accounts; soft-deleted accounts no longer pin the holder.
 	CountByHolderID(ctx context.Context, organizationID, holderID uuid.UUID) (int64, error)
+	// ListByHolderID returns every account owned by the holder within the
+	// organization, across all ledgers and including soft-deleted accounts. It
+	// backs the CRM holder data export, which must cover all data held about
+	// the holder.
+	ListByHolderID(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error)
+	// ReassignHolder sets the holder of every account of fromHolderID within
+	// the organization, across all ledgers and including soft-deleted
+	// accounts, to toHolderID. It backs the CRM duplicate holder merge and
+	// returns the number of accounts changed.
+	ReassignHolder(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error)
 }
 
 // AccountPostgreSQLRepository is a Postgresql-specific implementation of the AccountRepository.
@@ -1383,3 +1393,167 @@ func (r *AccountPostgreSQLRepository) CountByHolderID(ctx context.Context, organ
 
 	return count, nil
 }
+
+// ListByHolderID returns every account owned by the holder within the
+// organization, across all ledgers and including soft-deleted accounts, oldest
+// first.
+func (r *AccountPostgreSQLRepository) ListByHolderID(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "postgres.list_accounts_by_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	db, err := r.getDB(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database connection", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to get database connection", libLog.Err(err))
+
+		return nil, err
+	}
+
+	accounts := make([]*mmodel.Account, 0)
+
+	findAll := squirrel.Select(accountColumnList...).
+		From(r.tableName).
+		Where(squirrel.Eq{"organization_id": organizationID}).
+		Where(squirrel.Eq{"holder_id": holderID}).
+		OrderBy("created_at ASC").
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := findAll.ToSql()
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to build query", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to build query", libLog.Err(err))
+
+		return nil, err
+	}
+
+	_, spanQuery := tracer.Start(ctx, "postgres.list_accounts_by_holder.query")
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(spanQuery, "Failed to execute query", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to execute query", libLog.Err(err))
+
+		spanQuery.End()
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	spanQuery.End()
+
+	for rows.Next() {
+		var acc AccountPostgreSQLModel
+		if err := rows.Scan(
+			&acc.ID,
+			&acc.Name,
+			&acc.ParentAccountID,
+			&acc.EntityID,
+			&acc.HolderID,
+			&acc.AssetCode,
+			&acc.OrganizationID,
+			&acc.LedgerID,
+			&acc.PortfolioID,
+			&acc.SegmentID,
+			&acc.Status,
+			&acc.StatusDescription,
+			&acc.Alias,
+			&acc.Type,
+			&acc.CreatedAt,
+			&acc.UpdatedAt,
+			&acc.DeletedAt,
+			&acc.Blocked,
+			&acc.HolderCheckSkipped,
+		); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to scan row", err)
+
+			logger.Log(ctx, libLog.LevelError, "Failed to scan row", libLog.Err(err))
+
+			return nil, err
+		}
+
+		accounts = append(accounts, acc.ToEntity())
+	}
+
+	if err := rows.Err(); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to iterate rows", err)
+
+		logger.Log(ctx, libLog.LevelError, "Failed to iterate rows", libLog.Err(err))
+
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("db.rows_returned", len(accounts)))
+
+	return accounts, nil
+}
+
+// ReassignHolder sets the holder of every account of fromHolderID within the
+// organization, across all ledgers and including soft-deleted accounts, to
+// toHolderID, in one statement.
+func (r *AccountPostgreSQLRepository) ReassignHolder(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "postgres.reassign_accounts_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.holder_id", fromHolderID.String()),
+		attribute.String("app.request.survivor_id", toHolderID.String()),
+	)
+
+	db, err := r.getDB(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database connection", err)
+
+		return 0, err
+	}
+
+	builder := squirrel.Update(r.tableName).
+		Set("holder_id", toHolderID).
+		Set("updated_at", time.Now()).
+		Where(squirrel.Eq{"organization_id": organizationID}).
+		Where(squirrel.Eq{"holder_id": fromHolderID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to build query", err)
+
+		return 0, err
+	}
+
+	_, spanExec := tracer.Start(ctx, "postgres.reassign_accounts_holder.exec")
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(spanExec, "Failed to execute update query", err)
+
+		spanExec.End()
+
+		return 0, err
+	}
+
+	spanExec.End()
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get rows affected", err)
+
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", rowsAffected))
+
+	return rowsAffected, nil
+}
@@ -1585,6 +1585,95 @@ func TestIntegration_AccountRepository_CountByHolderID_IsolatesByOrg(t *testing.
 	assert.Equal(t, int64(2), count2, "org2 should count 2 owned accounts")
 }
 
+func TestIntegration_AccountRepository_ListByHolderID_IncludesDeletedAcrossLedgers(t *testing.T) {
+	// Arrange
+	container := pgtestutil.SetupContainer(t)
+
+	repo := createRepository(t, container)
+
+	orgID := pgtestutil.CreateTestOrganization(t, container.DB)
+	ledger1ID := pgtestutil.CreateTestLedger(t, container.DB, orgID)
+	ledger2ID := pgtestutil.CreateTestLedger(t, container.DB, orgID)
+
+	holderID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	otherHolderID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+
+	createHolderAccount(t, repo, orgID, ledger1ID, holderID, "@l1-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+	createHolderAccount(t, repo, orgID, ledger2ID, holderID, "@l2-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+	// A soft-deleted account is still data held about the holder.
+	createHolderAccount(t, repo, orgID, ledger2ID, holderID, "@l2del-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], true)
+	createHolderAccount(t, repo, orgID, ledger1ID, otherHolderID, "@other-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+
+	holderUUID, err := uuid.Parse(holderID)
+	require.NoError(t, err)
+
+	// Act
+	accounts, err := repo.ListByHolderID(context.Background(), orgID, holderUUID)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	ledgers := map[string]bool{}
+	for _, acc := range accounts {
+		require.NotNil(t, acc.HolderID)
+		assert.Equal(t, holderID, *acc.HolderID)
+		ledgers[acc.LedgerID] = true
+	}
+
+	assert.Len(t, ledgers, 2, "accounts of every ledger are listed")
+	assert.NotNil(t, accounts[2].DeletedAt, "the soft-deleted account is listed, oldest first")
+}
+
+func TestIntegration_AccountRepository_ReassignHolder_MovesEveryAccount(t *testing.T) {
+	// Arrange
+	container := pgtestutil.SetupContainer(t)
+
+	repo := createRepository(t, container)
+
+	orgID := pgtestutil.CreateTestOrganization(t, container.DB)
+	ledger1ID := pgtestutil.CreateTestLedger(t, container.DB, orgID)
+	ledger2ID := pgtestutil.CreateTestLedger(t, container.DB, orgID)
+
+	otherOrgID := pgtestutil.CreateTestOrganization(t, container.DB)
+	otherLedgerID := pgtestutil.CreateTestLedger(t, container.DB, otherOrgID)
+
+	duplicateID := uuid.Must(libCommons.GenerateUUIDv7())
+	survivorID := uuid.Must(libCommons.GenerateUUIDv7())
+
+	createHolderAccount(t, repo, orgID, ledger1ID, duplicateID.String(), "@d1-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+	createHolderAccount(t, repo, orgID, ledger2ID, duplicateID.String(), "@d2-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+	createHolderAccount(t, repo, orgID, ledger2ID, duplicateID.String(), "@ddel-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], true)
+	createHolderAccount(t, repo, orgID, ledger1ID, survivorID.String(), "@s1-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+	// The same holder id under another organization is left alone.
+	createHolderAccount(t, repo, otherOrgID, otherLedgerID, duplicateID.String(), "@o1-"+uuid.Must(libCommons.GenerateUUIDv7()).String()[:8], false)
+
+	ctx := context.Background()
+
+	// Act
+	moved, err := repo.ReassignHolder(ctx, orgID, duplicateID, survivorID)
+	require.NoError(t, err)
+
+	again, err := repo.ReassignHolder(ctx, orgID, duplicateID, survivorID)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(3), moved, "active and soft-deleted accounts are moved")
+	assert.Zero(t, again, "a repeated reassignment changes nothing")
+
+	survivorAccounts, err := repo.ListByHolderID(ctx, orgID, survivorID)
+	require.NoError(t, err)
+	assert.Len(t, survivorAccounts, 4)
+
+	duplicateAccounts, err := repo.ListByHolderID(ctx, orgID, duplicateID)
+	require.NoError(t, err)
+	assert.Empty(t, duplicateAccounts)
+
+	otherAccounts, err := repo.ListByHolderID(ctx, otherOrgID, duplicateID)
+	require.NoError(t, err)
+	assert.Len(t, otherAccounts, 1)
+}
+
 // ============================================================================
 // FindAll Filter Tests (Phase 1 - Account Filters)
 // ============================================================================
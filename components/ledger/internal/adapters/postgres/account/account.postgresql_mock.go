@@ -222,6 +222,21 @@ func (mr *MockRepositoryMockRecorder) ListByAlias(ctx, organizationID, ledgerID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAlias", reflect.TypeOf((*MockRepository)(nil).ListByAlias), ctx, organizationID, ledgerID, portfolioID, alias)
 }
 
+// ListByHolderID mocks base method.
+func (m *MockRepository) ListByHolderID(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByHolderID", ctx, organizationID, holderID)
+	ret0, _ := ret[0].([]*mmodel.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByHolderID indicates an expected call of ListByHolderID.
+func (mr *MockRepositoryMockRecorder) ListByHolderID(ctx, organizationID, holderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByHolderID", reflect.TypeOf((*MockRepository)(nil).ListByHolderID), ctx, organizationID, holderID)
+}
+
 // ListByIDs mocks base method.
 func (m *MockRepository) ListByIDs(ctx context.Context, organizationID, ledgerID uuid.UUID, portfolioID, segmentID *uuid.UUID, ids []uuid.UUID) ([]*mmodel.Account, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +267,21 @@ func (mr *MockRepositoryMockRecorder) ListExternalAccountsByAssetCode(ctx, organ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalAccountsByAssetCode", reflect.TypeOf((*MockRepository)(nil).ListExternalAccountsByAssetCode), ctx, organizationID, ledgerID, assetCode)
 }
 
+// ReassignHolder mocks base method.
+func (m *MockRepository) ReassignHolder(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignHolder", ctx, organizationID, fromHolderID, toHolderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignHolder indicates an expected call of ReassignHolder.
+func (mr *MockRepositoryMockRecorder) ReassignHolder(ctx, organizationID, fromHolderID, toHolderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignHolder", reflect.TypeOf((*MockRepository)(nil).ReassignHolder), ctx, organizationID, fromHolderID, toHolderID)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, organizationID, ledgerID uuid.UUID, portfolioID *uuid.UUID, id uuid.UUID, acc *mmodel.Account) (*mmodel.Account, error) {
 	m.ctrl.T.Helper()
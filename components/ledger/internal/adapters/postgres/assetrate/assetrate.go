@@ -6,10 +6,12 @@ package assetrate
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // AssetRatePostgreSQLModel represents the entity AssetRatePostgreSQLModel into SQL context in Database
@@ -143,6 +145,19 @@ type AssetRate struct {
 	Metadata map[string]any `json:"metadata"`
 }
 
+// Factor returns the conversion factor the rate stands for, Rate / 10^Scale.
+// Rate and Scale are integers carried in float64 fields, so the factor is built
+// from their integer values rather than from the float, which would otherwise
+// leak binary rounding into every converted amount.
+func (a *AssetRate) Factor() decimal.Decimal {
+	var scale int32
+	if a.Scale != nil {
+		scale = int32(math.Round(*a.Scale))
+	}
+
+	return decimal.New(int64(math.Round(a.Rate)), -scale)
+}
+
 // ToEntity converts an TransactionPostgreSQLModel to entity Transaction
 func (a *AssetRatePostgreSQLModel) ToEntity() *AssetRate {
 	assetRate := &AssetRate{
@@ -245,3 +245,13 @@ func TestAssetRatePostgreSQLModel_FromEntity(t *testing.T) {
 		assert.EqualError(t, err, "asset rate scale is required")
 	})
 }
+
+func TestAssetRate_Factor(t *testing.T) {
+	t.Parallel()
+
+	scale := float64(4)
+	rate := &AssetRate{Rate: 52341, Scale: &scale}
+	assert.Equal(t, "5.2341", rate.Factor().String())
+
+	assert.Equal(t, "100", (&AssetRate{Rate: 100}).Factor().String(), "a rate without a scale is taken as is")
+}
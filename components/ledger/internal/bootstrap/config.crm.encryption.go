@@ -18,6 +18,9 @@ import (
 	mongoEncryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/encryption"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
 	"github.com/LerianStudio/midaz/v4/pkg/crypto"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/local"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/pkcs11"
 	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/vault"
 	"github.com/LerianStudio/midaz/v4/pkg/crypto/tink"
 )
@@ -30,7 +33,8 @@ var _ encryption.KeysetRepository = (*mongoEncryption.KeysetMongoDBRepository)(n
 
 // defaultMountPathMultiTenant and defaultMountPathSingleTenant are the mode-derived
 // shared Vault Transit engines. Tenant isolation lives in the KEK key name, not the
-// mount, so there is a single engine per mode (no per-tenant mounts).
+// mount, so there is a single engine per mode (no per-tenant mounts). The PKCS#11
+// and local backends have no mounts and bind the same value into the key scope.
 const (
 	defaultMountPathMultiTenant  = "transit-mt"
 	defaultMountPathSingleTenant = "transit-st"
@@ -50,23 +54,33 @@ func defaultMountPath(multiTenant bool) string {
 // This matches the Vault dev container's default root token.
 const DefaultVaultDevToken = "root"
 
-// kmsResult contains the results of KMS initialization.
+// kmsResult contains the results of KMS initialization. Vendor and Client are set
+// only in envelope mode.
 type kmsResult struct {
-	Mode        crypto.EncryptionMode
-	VaultClient *vault.Client
+	Mode   crypto.EncryptionMode
+	Vendor string
+	Client kms.Client
 }
 
 // crmEncryption holds the wired CRM field-encryption surface: the FieldEncryptor
 // injected into the holder/instrument repositories plus the envelope-only services
 // and audit repository consumed by the encryption/audit HTTP handlers and readyz.
-// In legacy mode (KMS_VENDOR=none) provisioningService and auditRepo are nil and
-// vaultClient is nil; fieldEncryptor is always non-nil so the holder repository's
-// non-nil guard is satisfied.
+// In legacy mode (KMS_VENDOR=none) provisioningService, newRotationService,
+// holderKeys, auditRepo, auditWriter and kmsClient are nil; fieldEncryptor is always non-nil so the
+// holder repository's non-nil guard is satisfied.
+//
+// newRotationService is a constructor rather than a service because its
+// re-encryption targets are the holder/instrument repositories, which are built
+// from fieldEncryptor after this surface is wired.
 type crmEncryption struct {
 	fieldEncryptor      encryption.FieldEncryptor
 	provisioningService encryption.ProvisioningService
+	newRotationService  func(targets ...encryption.NamedReencryptionTarget) encryption.KeyRotationService
+	holderKeys          *encryption.HolderKeyManager
 	auditRepo           mongoAudit.Repository
-	vaultClient         *vault.Client
+	auditWriter         encryption.AuditWriter
+	kmsClient           kms.Client
+	kmsVendor           string
 	mode                crypto.EncryptionMode
 }
 
@@ -83,27 +97,30 @@ type crmEncryption struct {
 // cfg.MultiTenantEnabled), so the keyset-manager and envelope-provisioning
 // namespace mode always matches the CRM repo mode the dispatcher selected.
 func initCRMEncryption(ctx context.Context, cfg *Config, mongoConnection *libMongo.Client, multiTenant bool, metricsFactory *metrics.MetricsFactory, logger libLog.Logger) (*crmEncryption, error) {
-	kms, err := initKMS(ctx, cfg, logger)
+	kmsInit, err := initKMS(ctx, cfg, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	legacyCrypto, err := initLegacyCrypto(cfg, kms, logger)
+	legacyCrypto, err := initLegacyCrypto(cfg, kmsInit, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	keysetRepo, registryRepo, auditRepo, auditWriter, err := initEncryptionRepos(kms, mongoConnection, logger)
+	repos, err := initEncryptionRepos(kmsInit, mongoConnection, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	wired := wireEncryptionServices(wireEncryptionServicesInput{
-		mode:             kms.Mode.String(),
-		vaultClient:      kms.VaultClient,
-		keysetRepo:       keysetRepo,
-		registryRepo:     registryRepo,
-		auditWriter:      auditWriter,
+		mode:             kmsInit.Mode.String(),
+		kmsClient:        kmsInit.Client,
+		kmsProvider:      kmsProviderLabel(kmsInit.Vendor),
+		keysetRepo:       repos.keysetRepo,
+		registryRepo:     repos.registryRepo,
+		rotationRepo:     repos.rotationRepo,
+		holderKeyRepo:    repos.holderKeyRepo,
+		auditWriter:      repos.auditWriter,
 		legacyCrypto:     legacyCrypto,
 		metricsFactory:   metricsFactory,
 		multiTenant:      multiTenant,
@@ -117,21 +134,27 @@ func initCRMEncryption(ctx context.Context, cfg *Config, mongoConnection *libMon
 	return &crmEncryption{
 		fieldEncryptor:      encryption.NewFieldEncryptorAdapter(wired.encryptionService),
 		provisioningService: wired.provisioningService,
-		auditRepo:           auditRepo,
-		vaultClient:         kms.VaultClient,
-		mode:                kms.Mode,
+		newRotationService:  wired.newRotationService,
+		holderKeys:          wired.holderKeys,
+		auditRepo:           repos.auditRepo,
+		auditWriter:         repos.auditWriter,
+		kmsClient:           kmsInit.Client,
+		kmsVendor:           kmsInit.Vendor,
+		mode:                kmsInit.Mode,
 	}, nil
 }
 
 // initKMS resolves the encryption mode, validates configuration, and initializes
-// the Vault client for envelope mode.
+// the KMS client of the configured vendor for envelope mode.
 func initKMS(ctx context.Context, cfg *Config, logger libLog.Logger) (*kmsResult, error) {
 	mode, err := resolveEncryptionMode(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve encryption mode: %w", err)
 	}
 
-	if err := validateVaultConfig(mode, cfg); err != nil {
+	vendor := resolveKMSVendor(cfg)
+
+	if err := validateKMSConfig(mode, vendor, cfg); err != nil {
 		return nil, err
 	}
 
@@ -141,17 +164,32 @@ func initKMS(ctx context.Context, cfg *Config, logger libLog.Logger) (*kmsResult
 	result := &kmsResult{Mode: mode}
 
 	if mode.IsEnvelope() {
-		client, err := initVaultClient(ctx, cfg, logger)
+		client, err := initKMSClient(ctx, vendor, cfg, logger)
 		if err != nil {
 			return nil, err
 		}
 
-		result.VaultClient = client
+		result.Vendor = vendor
+		result.Client = client
 	}
 
 	return result, nil
 }
 
+// initKMSClient creates the key-encryption client for an envelope-mode vendor.
+func initKMSClient(ctx context.Context, vendor string, cfg *Config, logger libLog.Logger) (kms.Client, error) {
+	switch vendor {
+	case crypto.VendorHashicorpVault:
+		return initVaultClient(ctx, cfg, logger)
+	case crypto.VendorPKCS11:
+		return initPKCS11Client(ctx, cfg, logger)
+	case crypto.VendorLocal:
+		return initLocalKMSClient(ctx, cfg, logger)
+	default:
+		return nil, fmt.Errorf("unsupported KMS vendor %q", vendor)
+	}
+}
+
 // initVaultClient creates and authenticates a Vault client.
 func initVaultClient(ctx context.Context, cfg *Config, logger libLog.Logger) (*vault.Client, error) {
 	vaultCfg, err := buildVaultConfig(cfg)
@@ -174,11 +212,51 @@ func initVaultClient(ctx context.Context, cfg *Config, logger libLog.Logger) (*v
 	return client, nil
 }
 
+// initPKCS11Client opens the PKCS#11 module, logs in to the token and resolves
+// the wrapping key. The binary must be built with CGO_ENABLED=1 -tags pkcs11.
+func initPKCS11Client(ctx context.Context, cfg *Config, logger libLog.Logger) (*pkcs11.Client, error) {
+	client, err := pkcs11.NewClient(buildPKCS11Config(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pkcs11 kms client: %w", err)
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "PKCS#11 KMS client initialized",
+		libLog.String("token_label", cfg.PKCS11TokenLabel),
+		libLog.String("key_label", client.KeyLabel()))
+
+	return client, nil
+}
+
+// initLocalKMSClient loads the local software keyring.
+func initLocalKMSClient(ctx context.Context, cfg *Config, logger libLog.Logger) (*local.Client, error) {
+	client, err := local.NewClient(buildLocalKMSConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local kms client: %w", err)
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Local KMS client initialized",
+		libLog.Int("primary_version", client.PrimaryVersion()))
+
+	return client, nil
+}
+
 // resolveEncryptionMode determines the encryption mode from the configuration.
 // Returns EncryptionModeLegacy when KMSVendor is empty or "none", and
-// EncryptionModeEnvelope when KMSVendor is "hashicorp-vault".
+// EncryptionModeEnvelope when KMSVendor is "hashicorp-vault", "pkcs11" or "local".
 func resolveEncryptionMode(cfg *Config) (crypto.EncryptionMode, error) {
-	resolver := crypto.NewModeResolver(func(key string) (string, bool) {
+	return newKMSModeResolver(cfg).Resolve()
+}
+
+// resolveKMSVendor returns the normalized KMS vendor, reading cfg.KMSVendor with
+// the same environment fallback as resolveEncryptionMode.
+func resolveKMSVendor(cfg *Config) string {
+	return newKMSModeResolver(cfg).GetVendor()
+}
+
+// newKMSModeResolver builds a ModeResolver that prefers cfg.KMSVendor and falls
+// back to the KMS_VENDOR environment variable.
+func newKMSModeResolver(cfg *Config) *crypto.ModeResolver {
+	return crypto.NewModeResolver(func(key string) (string, bool) {
 		if key == crypto.EnvKMSVendor {
 			if cfg.KMSVendor != "" {
 				return cfg.KMSVendor, true
@@ -191,8 +269,62 @@ func resolveEncryptionMode(cfg *Config) (crypto.EncryptionMode, error) {
 
 		return "", false
 	})
+}
+
+// kmsProviderLabel maps a KMS vendor to the closed-vocabulary provider label used
+// on the protection provider metrics.
+func kmsProviderLabel(vendor string) string {
+	if vendor == crypto.VendorHashicorpVault {
+		return "vault"
+	}
+
+	return vendor
+}
+
+// validateKMSConfig validates the configuration of the selected KMS vendor for
+// envelope mode. Legacy mode skips validation. Selecting pkcs11 in a binary
+// built without the native binding fails here, at startup, rather than on the
+// first wrap or unwrap.
+func validateKMSConfig(mode crypto.EncryptionMode, vendor string, cfg *Config) error {
+	if mode.IsLegacy() {
+		return nil
+	}
+
+	switch vendor {
+	case crypto.VendorPKCS11:
+		if err := buildPKCS11Config(cfg).Validate(); err != nil {
+			return fmt.Errorf("envelope encryption mode requires valid pkcs11 configuration: %w", err)
+		}
+
+		if !pkcs11.Supported {
+			return fmt.Errorf("KMS_VENDOR=pkcs11 is not available in this build: %w", pkcs11.ErrUnsupportedBuild)
+		}
+
+		return nil
+	case crypto.VendorLocal:
+		if err := buildLocalKMSConfig(cfg).Validate(); err != nil {
+			return fmt.Errorf("envelope encryption mode requires valid local kms configuration: %w", err)
+		}
+
+		return nil
+	default:
+		return validateVaultConfig(mode, cfg)
+	}
+}
+
+// buildPKCS11Config creates a pkcs11.Config from the bootstrap Config.
+func buildPKCS11Config(cfg *Config) pkcs11.Config {
+	return pkcs11.Config{
+		ModulePath: cfg.PKCS11ModulePath,
+		TokenLabel: cfg.PKCS11TokenLabel,
+		PIN:        cfg.PKCS11PIN,
+		KeyLabel:   cfg.PKCS11KeyLabel,
+	}
+}
 
-	return resolver.Resolve()
+// buildLocalKMSConfig creates a local.Config from the bootstrap Config.
+func buildLocalKMSConfig(cfg *Config) local.Config {
+	return local.Config{KeyringPath: cfg.LocalKMSKeyringPath}
 }
 
 // validateVaultConfig validates the Vault configuration for envelope mode.
@@ -271,8 +403,8 @@ func isLocalDeployment(deploymentMode string) bool {
 // initLegacyCrypto builds the LegacyCrypto for the active KMS mode: envelope mode
 // uses Tink-backed LegacyKeyMaterial (for reading legacy data during migration),
 // legacy mode uses lib-commons crypto directly.
-func initLegacyCrypto(cfg *Config, kms *kmsResult, logger libLog.Logger) (encryption.LegacyCrypto, error) {
-	if kms.Mode.IsEnvelope() {
+func initLegacyCrypto(cfg *Config, kmsInit *kmsResult, logger libLog.Logger) (encryption.LegacyCrypto, error) {
+	if kmsInit.Mode.IsEnvelope() {
 		legacyKeys, err := encryption.NewLegacyKeyMaterial(cfg.CrmEncryptSecretKey, cfg.CrmHashSecretKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize legacy key material: %w", err)
@@ -293,45 +425,77 @@ func initLegacyCrypto(cfg *Config, kms *kmsResult, logger libLog.Logger) (encryp
 	return cipher, nil
 }
 
-// initEncryptionRepos constructs the envelope-only encryption repositories, the
-// read-side audit Repository, and a repository-backed AuditWriter. In legacy mode
-// it returns nil for all of them. A single auditRepo instance backs both the read
-// path (returned directly) and the write path (wrapped by NewAuditWriter).
+// encryptionRepos holds the envelope-only encryption repositories built by
+// initEncryptionRepos.
+type encryptionRepos struct {
+	keysetRepo    encryption.KeysetRepository
+	registryRepo  mongoEncryption.RegistryRepository
+	rotationRepo  mongoEncryption.RotationRepository
+	holderKeyRepo mongoEncryption.HolderDataKeyRepository
+	auditRepo     mongoAudit.Repository
+	auditWriter   encryption.AuditWriter
+}
+
+// initEncryptionRepos constructs the envelope-only encryption repositories (keyset,
+// registry, key rotation, holder data keys), the read-side audit Repository, and a
+// repository-backed AuditWriter. In legacy mode every field is nil. A single
+// auditRepo instance backs both the read path and the write path (wrapped by
+// NewAuditWriter).
 func initEncryptionRepos(
-	kms *kmsResult,
+	kmsInit *kmsResult,
 	mongoConnection *libMongo.Client,
 	logger libLog.Logger,
-) (encryption.KeysetRepository, mongoEncryption.RegistryRepository, mongoAudit.Repository, encryption.AuditWriter, error) {
-	if !kms.Mode.IsEnvelope() {
-		return nil, nil, nil, nil, nil
+) (encryptionRepos, error) {
+	if !kmsInit.Mode.IsEnvelope() {
+		return encryptionRepos{}, nil
 	}
 
 	keysetRepo, err := mongoEncryption.NewKeysetMongoDBRepository(mongoConnection)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to initialize keyset repository: %w", err)
+		return encryptionRepos{}, fmt.Errorf("failed to initialize keyset repository: %w", err)
 	}
 
 	registryRepo, err := mongoEncryption.NewRegistryMongoDBRepository(mongoConnection)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to initialize registry repository: %w", err)
+		return encryptionRepos{}, fmt.Errorf("failed to initialize registry repository: %w", err)
+	}
+
+	rotationRepo, err := mongoEncryption.NewRotationMongoDBRepository(mongoConnection)
+	if err != nil {
+		return encryptionRepos{}, fmt.Errorf("failed to initialize key rotation repository: %w", err)
+	}
+
+	holderKeyRepo, err := mongoEncryption.NewHolderDataKeyMongoDBRepository(mongoConnection)
+	if err != nil {
+		return encryptionRepos{}, fmt.Errorf("failed to initialize holder data key repository: %w", err)
 	}
 
 	auditRepo, err := mongoAudit.NewMongoDBRepository(mongoConnection)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to initialize audit repository: %w", err)
+		return encryptionRepos{}, fmt.Errorf("failed to initialize audit repository: %w", err)
 	}
 
 	logger.Log(context.Background(), libLog.LevelInfo, "Encryption repositories initialized for envelope mode")
 
-	return keysetRepo, registryRepo, auditRepo, encryption.NewAuditWriter(auditRepo, logger), nil
+	return encryptionRepos{
+		keysetRepo:    keysetRepo,
+		registryRepo:  registryRepo,
+		rotationRepo:  rotationRepo,
+		holderKeyRepo: holderKeyRepo,
+		auditRepo:     auditRepo,
+		auditWriter:   encryption.NewAuditWriter(auditRepo, logger),
+	}, nil
 }
 
 // wireEncryptionServicesInput contains all dependencies for wiring encryption services.
 type wireEncryptionServicesInput struct {
 	mode             string
-	vaultClient      *vault.Client
+	kmsClient        kms.Client
+	kmsProvider      string
 	keysetRepo       encryption.KeysetRepository
 	registryRepo     mongoEncryption.RegistryRepository
+	rotationRepo     mongoEncryption.RotationRepository
+	holderKeyRepo    mongoEncryption.HolderDataKeyRepository
 	auditWriter      encryption.AuditWriter
 	legacyCrypto     encryption.LegacyCrypto
 	metricsFactory   *metrics.MetricsFactory
@@ -344,16 +508,21 @@ type wireEncryptionServicesInput struct {
 type wireEncryptionServicesOutput struct {
 	encryptionService   encryption.EncryptionService
 	provisioningService encryption.ProvisioningService
+	newRotationService  func(targets ...encryption.NamedReencryptionTarget) encryption.KeyRotationService
+	holderKeys          *encryption.HolderKeyManager
 	err                 error
 }
 
 // wireEncryptionServices wires up the encryption services based on the encryption
 // mode. Legacy mode wires an EncryptionService backed by lib-commons crypto (no
-// Tink, no keyset manager, no provisioning). Envelope mode validates the vault
+// Tink, no keyset manager, no provisioning). Envelope mode validates the KMS
 // client and keyset/registry repositories, then wires the Tink-backed keyset
-// wrapper/factory, ProvisioningService, KeysetManager, and EncryptionService.
+// wrapper/factory, ProvisioningService, KeysetManager, and EncryptionService, plus
+// the KeyRotationService constructor when a rotation repository is supplied. When
+// a holder data key repository is supplied, holder-scoped values are encrypted
+// under per-holder data keys (see HolderKeyManager).
 func wireEncryptionServices(input wireEncryptionServicesInput) wireEncryptionServicesOutput {
-	pm := encryption.NewProtectionMetrics(input.metricsFactory)
+	pm := encryption.NewProtectionMetrics(input.metricsFactory).WithProvider(input.kmsProvider)
 
 	if strings.EqualFold(input.mode, crypto.EncryptionModeLegacy.String()) {
 		protectionStateResolver := encryption.NewProtectionStateResolver(nil, pm)
@@ -369,8 +538,8 @@ func wireEncryptionServices(input wireEncryptionServicesInput) wireEncryptionSer
 		return wireEncryptionServicesOutput{encryptionService: encryptionService}
 	}
 
-	if input.vaultClient == nil {
-		return wireEncryptionServicesOutput{err: fmt.Errorf("envelope encryption requires vault client")}
+	if input.kmsClient == nil {
+		return wireEncryptionServicesOutput{err: fmt.Errorf("envelope encryption requires KMS client")}
 	}
 
 	if input.keysetRepo == nil {
@@ -384,17 +553,19 @@ func wireEncryptionServices(input wireEncryptionServicesInput) wireEncryptionSer
 	baseMountPath := defaultMountPath(input.multiTenant)
 
 	protectionStateResolver := encryption.NewProtectionStateResolver(input.registryRepo, pm)
-	keysetWrapper := tink.NewKeysetWrapper(input.vaultClient)
-	keysetFactory := tink.NewKeysetFactory(input.vaultClient)
+	keysetWrapper := tink.NewKeysetWrapper(input.kmsClient)
+	keysetFactory := tink.NewKeysetFactory(input.kmsClient)
+
+	keysetGenerator := &keysetGeneratorAdapter{
+		factory:          keysetFactory,
+		legacyAESHexKey:  input.legacyAESHexKey,
+		legacyHMACSecret: input.legacyHMACSecret,
+	}
 
 	provisioningService := encryption.NewProvisioningService(
 		input.keysetRepo,
 		input.registryRepo,
-		&keysetGeneratorAdapter{
-			factory:          keysetFactory,
-			legacyAESHexKey:  input.legacyAESHexKey,
-			legacyHMACSecret: input.legacyHMACSecret,
-		},
+		keysetGenerator,
 		encryption.ProvisioningConfig{KEKMountPath: baseMountPath, MultiTenant: input.multiTenant},
 		input.auditWriter,
 		pm,
@@ -422,9 +593,34 @@ func wireEncryptionServices(input wireEncryptionServicesInput) wireEncryptionSer
 		crypto.EncryptionModeEnvelope,
 	)
 
+	var holderKeys *encryption.HolderKeyManager
+	if input.holderKeyRepo != nil {
+		holderKeys = encryption.NewHolderKeyManager(input.holderKeyRepo, keysetManager, 0, pm)
+		encryptionService = encryption.WithHolderKeys(encryptionService, holderKeys)
+	}
+
+	var newRotationService func(targets ...encryption.NamedReencryptionTarget) encryption.KeyRotationService
+	if input.rotationRepo != nil {
+		newRotationService = func(targets ...encryption.NamedReencryptionTarget) encryption.KeyRotationService {
+			return encryption.NewKeyRotationService(
+				input.keysetRepo,
+				input.registryRepo,
+				input.rotationRepo,
+				keysetGenerator,
+				keysetManager,
+				protectionStateResolver,
+				input.auditWriter,
+				pm,
+				targets...,
+			)
+		}
+	}
+
 	return wireEncryptionServicesOutput{
 		encryptionService:   encryptionService,
 		provisioningService: provisioningService,
+		newRotationService:  newRotationService,
+		holderKeys:          holderKeys,
 	}
 }
 
@@ -6,11 +6,16 @@ package bootstrap
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	libLog "github.com/LerianStudio/lib-observability/log"
 	mongoEncryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/encryption"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
 	"github.com/LerianStudio/midaz/v4/pkg/crypto"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/local"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/pkcs11"
 	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms/vault"
 	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
 	"github.com/stretchr/testify/assert"
@@ -221,6 +226,138 @@ func TestValidateVaultConfig(t *testing.T) {
 	}
 }
 
+func TestValidateKMSConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		mode          crypto.EncryptionMode
+		vendor        string
+		cfg           *Config
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:   "legacy mode skips kms validation",
+			mode:   crypto.EncryptionModeLegacy,
+			vendor: crypto.VendorNone,
+			cfg:    &Config{},
+		},
+		{
+			name:          "vault vendor delegates to vault validation",
+			mode:          crypto.EncryptionModeEnvelope,
+			vendor:        crypto.VendorHashicorpVault,
+			cfg:           &Config{VaultAddr: "https://vault.example.com:8200"},
+			expectError:   true,
+			errorContains: "KMS_VAULT_AUTH_METHOD",
+		},
+		{
+			name:   "pkcs11 vendor with full config passes only with the native binding",
+			mode:   crypto.EncryptionModeEnvelope,
+			vendor: crypto.VendorPKCS11,
+			cfg: &Config{
+				PKCS11ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+				PKCS11TokenLabel: "midaz",
+				PKCS11PIN:        "1234",
+				PKCS11KeyLabel:   "midaz-kek-1",
+			},
+			expectError:   !pkcs11.Supported,
+			errorContains: "-tags pkcs11",
+		},
+		{
+			name:          "pkcs11 vendor with missing fields fails",
+			mode:          crypto.EncryptionModeEnvelope,
+			vendor:        crypto.VendorPKCS11,
+			cfg:           &Config{PKCS11ModulePath: "/usr/lib/softhsm/libsofthsm2.so"},
+			expectError:   true,
+			errorContains: "token_label, pin, key_label",
+		},
+		{
+			name:   "local vendor with keyring path passes",
+			mode:   crypto.EncryptionModeEnvelope,
+			vendor: crypto.VendorLocal,
+			cfg:    &Config{LocalKMSKeyringPath: "/etc/midaz/keyring.json"},
+		},
+		{
+			name:          "local vendor without keyring path fails",
+			mode:          crypto.EncryptionModeEnvelope,
+			vendor:        crypto.VendorLocal,
+			cfg:           &Config{},
+			expectError:   true,
+			errorContains: "local kms configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateKMSConfig(tt.mode, tt.vendor, tt.cfg)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestKMSProviderLabel(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "vault", kmsProviderLabel(crypto.VendorHashicorpVault))
+	assert.Equal(t, "pkcs11", kmsProviderLabel(crypto.VendorPKCS11))
+	assert.Equal(t, "local", kmsProviderLabel(crypto.VendorLocal))
+}
+
+func TestInitKMS_LocalVendor(t *testing.T) {
+	t.Parallel()
+
+	keyringPath := filepath.Join(t.TempDir(), "keyring.json")
+	require.NoError(t, os.WriteFile(keyringPath,
+		[]byte(`{"primary_version":1,"keys":[{"version":1,"key":"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="}]}`), 0o600))
+
+	result, err := initKMS(context.Background(), &Config{
+		KMSVendor:           " Local ",
+		LocalKMSKeyringPath: keyringPath,
+	}, libLog.NewNop())
+	require.NoError(t, err)
+
+	assert.True(t, result.Mode.IsEnvelope())
+	assert.Equal(t, crypto.VendorLocal, result.Vendor)
+	require.IsType(t, &local.Client{}, result.Client)
+
+	ciphertext, err := result.Client.Encrypt(context.Background(), defaultMountPathSingleTenant, "org-1", []byte("keyset"))
+	require.NoError(t, err)
+
+	plaintext, err := result.Client.Decrypt(context.Background(), defaultMountPathSingleTenant, "org-1", ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keyset"), plaintext)
+}
+
+func TestInitKMS_PKCS11VendorWithoutNativeBinding(t *testing.T) {
+	t.Parallel()
+
+	_, err := initKMS(context.Background(), &Config{
+		KMSVendor:        crypto.VendorPKCS11,
+		PKCS11ModulePath: filepath.Join(t.TempDir(), "missing.so"),
+		PKCS11TokenLabel: "midaz",
+		PKCS11PIN:        "1234",
+		PKCS11KeyLabel:   "midaz-kek-1",
+	}, libLog.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pkcs11")
+
+	if !pkcs11.Supported {
+		require.ErrorIs(t, err, pkcs11.ErrUnsupportedBuild)
+		assert.Contains(t, err.Error(), "-tags pkcs11")
+	}
+}
+
 func TestBuildVaultConfig(t *testing.T) {
 	t.Parallel()
 
@@ -444,23 +581,24 @@ func TestWireEncryptionServices_LegacyMode(t *testing.T) {
 	require.NoError(t, out.err)
 	assert.NotNil(t, out.encryptionService, "EncryptionService must be wired in legacy mode")
 	assert.Nil(t, out.provisioningService, "ProvisioningService must be nil in legacy mode")
+	assert.Nil(t, out.newRotationService, "KeyRotationService must not be wired in legacy mode")
 }
 
 func TestWireEncryptionServices_EnvelopeGuards(t *testing.T) {
 	t.Parallel()
 
-	t.Run("nil vault client fails closed", func(t *testing.T) {
+	t.Run("nil KMS client fails closed", func(t *testing.T) {
 		t.Parallel()
 
 		out := wireEncryptionServices(wireEncryptionServicesInput{
 			mode:           crypto.EncryptionModeEnvelope.String(),
-			vaultClient:    nil,
+			kmsClient:      nil,
 			keysetRepo:     &mockKeysetRepo{},
 			registryRepo:   &mockRegistryRepo{},
 		})
 
 		require.Error(t, out.err)
-		assert.Contains(t, out.err.Error(), "vault client")
+		assert.Contains(t, out.err.Error(), "KMS client")
 	})
 
 	t.Run("nil keyset repo fails closed", func(t *testing.T) {
@@ -468,7 +606,7 @@ func TestWireEncryptionServices_EnvelopeGuards(t *testing.T) {
 
 		out := wireEncryptionServices(wireEncryptionServicesInput{
 			mode:           crypto.EncryptionModeEnvelope.String(),
-			vaultClient:    newWiringVaultClient(t),
+			kmsClient:      newWiringVaultClient(t),
 			keysetRepo:     nil,
 			registryRepo:   &mockRegistryRepo{},
 		})
@@ -482,7 +620,7 @@ func TestWireEncryptionServices_EnvelopeGuards(t *testing.T) {
 
 		out := wireEncryptionServices(wireEncryptionServicesInput{
 			mode:           crypto.EncryptionModeEnvelope.String(),
-			vaultClient:    newWiringVaultClient(t),
+			kmsClient:      newWiringVaultClient(t),
 			keysetRepo:     &mockKeysetRepo{},
 			registryRepo:   nil,
 		})
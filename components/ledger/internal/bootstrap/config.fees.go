@@ -7,25 +7,31 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"time"
 
 	tmmongo "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/mongo"
 	libLog "github.com/LerianStudio/lib-observability/log"
 	libStreaming "github.com/LerianStudio/lib-streaming"
+	httpin "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/http/in"
 	feesservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/services/fees"
 	feesmidaz "github.com/LerianStudio/midaz/v4/components/ledger/internal/services/fees/midaz"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/query"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
 )
 
 // feesComponents holds the fee/billing slice of the unified ledger binary: the
 // fee package use case, the billing-package CRUD service, and the
 // billing-calculate service. They share a single in-process MidazResolver backed
 // by the ledger query.UseCase (Chunk B) so account/segment/count reads no longer
-// cross the network. The Mongo manager is carried for route-scoped tenant
+// cross the network; resolver and streaming are kept for the services wired
+// after the transaction handler (initBillingInvoices). The Mongo manager is carried for route-scoped tenant
 // middleware and eviction wiring (mirrors crmComponents.mongoManager).
 type feesComponents struct {
 	useCase                 *feesservices.UseCase
 	billingPackageService   *feesservices.BillingPackageService
 	billingCalculateService *feesservices.BillingCalculateService
+	resolver                feeshared.MidazResolver
+	streaming               libStreaming.Emitter
 	mongoManager            *tmmongo.Manager // nil in single-tenant mode
 }
 
@@ -63,6 +69,11 @@ func initFees(feeMongo *feesMongoComponents, queryUC *query.UseCase, cfg *Config
 
 	// Share the ledger emitter so fee services emit past-tense events.
 	useCase.Streaming = streamingEmitter
+	useCase.PromotionUsage = feeMongo.promotionUsageRepo
+	useCase.FeeQuotes = feeMongo.feeQuoteRepo
+	useCase.FeeRevenue = feeMongo.feeRevenueRepo
+	useCase.FeeQuoteTTL = time.Duration(cfg.FeesQuoteTTLSeconds) * time.Second
+	useCase.FeeRevenueRetryInterval = feesservices.DefaultFeeRevenueRetryInterval
 	billingPackageService.Streaming = streamingEmitter
 
 	// The billing-calculate path consumes the narrower midaz.AccountResolver /
@@ -88,6 +99,40 @@ func initFees(feeMongo *feesMongoComponents, queryUC *query.UseCase, cfg *Config
 		useCase:                 useCase,
 		billingPackageService:   billingPackageService,
 		billingCalculateService: billingCalculateService,
+		resolver:                resolver,
+		streaming:               streamingEmitter,
 		mongoManager:            feeMongo.mongoManager,
 	}, nil
 }
+
+// initBillingRuns wires the billing-run service and handler. It runs after the
+// transaction handler exists because a run posts its statements through that
+// handler's createTransaction core (the BillingTransactionPoster port) rather
+// than a side channel, and it reuses the billing-calculate service built by
+// initFees so a run charges exactly what the calculate endpoint reports.
+func initBillingRuns(fees *feesComponents, feeMongo *feesMongoComponents, transactionHandler *httpin.TransactionHandler) (*httpin.BillingRunHandler, error) {
+	billingRunService, err := feesservices.NewBillingRunService(fees.billingCalculateService, feeMongo.billingRunRepo, transactionHandler)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build billing run service: %w", err)
+	}
+
+	billingRunService.MetricsFactory = fees.billingCalculateService.MetricsFactory
+
+	return &httpin.BillingRunHandler{Service: billingRunService}, nil
+}
+
+// initBillingInvoices wires the billing-invoice service and handler. Like
+// initBillingRuns it runs after the transaction handler exists: invoices are
+// collected by posting through that handler's createTransaction core, and they
+// are itemized from the same billing-calculate service a run charges from.
+func initBillingInvoices(fees *feesComponents, feeMongo *feesMongoComponents, transactionHandler *httpin.TransactionHandler) (*httpin.BillingInvoiceHandler, error) {
+	billingInvoiceService, err := feesservices.NewBillingInvoiceService(fees.billingCalculateService, feeMongo.billingInvoiceRepo, feeMongo.billingRunRepo, transactionHandler, fees.resolver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build billing invoice service: %w", err)
+	}
+
+	billingInvoiceService.MetricsFactory = fees.billingCalculateService.MetricsFactory
+	billingInvoiceService.Streaming = fees.streaming
+
+	return &httpin.BillingInvoiceHandler{Service: billingInvoiceService}, nil
+}
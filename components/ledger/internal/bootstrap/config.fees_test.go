@@ -120,6 +120,7 @@ func TestFeesConfigFields_PresentWithCorrectTags(t *testing.T) {
 		"FeesPrefixedMaxPoolSize":       "MONGO_FEES_MAX_POOL_SIZE",
 		"FeesPrefixedMongoTLSCACert":    "MONGO_FEES_TLS_CA_CERT",
 		"FeesDefaultCurrency":           "DEFAULT_CURRENCY",
+		"FeesQuoteTTLSeconds":           "FEES_QUOTE_TTL_SECONDS",
 	}
 
 	for fieldName, expectedTag := range expectedFields {
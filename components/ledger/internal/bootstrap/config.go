@@ -186,6 +186,15 @@ type Config struct {
 	CrmPrefixedMaxPoolSize       int    `env:"MONGO_CRM_MAX_POOL_SIZE"`
 	CrmPrefixedMongoTLSCACert    string `env:"MONGO_CRM_TLS_CA_CERT"`
 
+	// CrmScreeningMatchThreshold is the name-match score, in percent, from which
+	// watch-list screening raises an alert. Unset keeps the default (90).
+	CrmScreeningMatchThreshold int `env:"CRM_SCREENING_MATCH_THRESHOLD"`
+
+	// CrmInstrumentAccountPropagationDisabled stops instrument lifecycle
+	// transitions (block, unblock, close) from changing the linked ledger
+	// account. Unset keeps the propagation on.
+	CrmInstrumentAccountPropagationDisabled bool `env:"CRM_INSTRUMENT_ACCOUNT_PROPAGATION_DISABLED"`
+
 	// --- CRM crypto keys (holder/alias PII at-rest encryption) ---
 	// These keep the BARE LCRYPTO_* env names (no CRM prefix) so the EXACT key
 	// VALUES used by the standalone CRM service carry over unchanged. Changing
@@ -195,14 +204,22 @@ type Config struct {
 
 	// --- CRM KMS / field-encryption (envelope) config ---
 	// KMS_VENDOR selects the encryption mode: "none" (or empty) keeps legacy
-	// lib-commons crypto; "hashicorp-vault" enables KMS-backed envelope encryption
-	// for holder/instrument fields. The Vault fields are required only for envelope
-	// mode and validated then; legacy mode ignores them.
+	// lib-commons crypto; "hashicorp-vault", "pkcs11" or "local" enables KMS-backed
+	// envelope encryption for holder/instrument fields. Each vendor's fields are
+	// required only when that vendor is selected and validated then; every other
+	// vendor ignores them.
 	KMSVendor       string `env:"KMS_VENDOR"`
 	VaultAddr       string `env:"KMS_VAULT_ADDR"`
 	VaultRoleID     string `env:"KMS_VAULT_ROLE_ID"`
 	VaultSecretID   string `env:"KMS_VAULT_SECRET_ID"`
 	VaultAuthMethod string `env:"KMS_VAULT_AUTH_METHOD"`
+	// PKCS#11 HSM (KMS_VENDOR=pkcs11). Needs a binary built with -tags pkcs11.
+	PKCS11ModulePath string `env:"KMS_PKCS11_MODULE_PATH"`
+	PKCS11TokenLabel string `env:"KMS_PKCS11_TOKEN_LABEL"`
+	PKCS11PIN        string `env:"KMS_PKCS11_PIN"`
+	PKCS11KeyLabel   string `env:"KMS_PKCS11_KEY_LABEL"`
+	// File-based software KMS for air-gapped deployments (KMS_VENDOR=local).
+	LocalKMSKeyringPath string `env:"KMS_LOCAL_KEYRING_PATH"`
 
 	// --- Fees MongoDB fields (MONGO_FEES_* env tags) ---
 	// Fee/billing-package collections collapsed into the unified ledger binary
@@ -224,6 +241,9 @@ type Config struct {
 	// fallback currency used by the fee calculation engine when a fee leg does
 	// not specify one. Defaults to "USD" in applyConfigDefaults when unset.
 	FeesDefaultCurrency string `env:"DEFAULT_CURRENCY"`
+	// FEES_QUOTE_TTL_SECONDS is how long a fee quote is honored after it is
+	// issued. Zero or unset uses the fee use case's default (15 minutes).
+	FeesQuoteTTLSeconds int `env:"FEES_QUOTE_TTL_SECONDS"`
 
 	// --- RabbitMQ (transaction domain only) ---
 	RabbitURI                                string `env:"RABBITMQ_URI"`
@@ -906,6 +926,30 @@ func InitServersWithOptions(opts *Options) (*Service, error) {
 	// CRM never imports the query package. Set once on the shared CRM use case.
 	crmMgo.holderHandler.Service.LedgerAccounts = ledgerAccountReaderAdapter{query: queryUseCase}
 
+	// === CRM holder data export ===
+	// The export reads the holder's accounts, balances and operations through
+	// a narrow adapter over the ledger query use case.
+	crmMgo.holderHandler.Service.HolderLedger = holderLedgerReaderAdapter{query: queryUseCase}
+
+	// === CRM duplicate holder merge ===
+	// A merge moves the duplicate's accounts to the surviving holder through a
+	// narrow adapter over the ledger command use case.
+	crmMgo.holderHandler.Service.HolderAccounts = holderAccountReassignerAdapter{command: commandUseCase}
+
+	// === CRM instrument lifecycle ===
+	// Blocking, unblocking and closing an instrument carry over to its ledger
+	// account through a narrow adapter over the ledger command and query use
+	// cases, unless CRM_INSTRUMENT_ACCOUNT_PROPAGATION_DISABLED is set.
+	if !cfg.CrmInstrumentAccountPropagationDisabled {
+		crmMgo.holderHandler.Service.InstrumentAccounts = instrumentAccountLifecycleAdapter{command: commandUseCase, query: queryUseCase}
+	}
+
+	// === CRM holder position ===
+	// The position view reads the holder's accounts, current or historical
+	// balances and asset rates through a narrow adapter over the ledger query
+	// use case.
+	crmMgo.holderHandler.Service.HolderPositions = holderPositionReaderAdapter{holderLedgerReaderAdapter{query: queryUseCase}}
+
 	// === Fee use cases ===
 	// Built from the fee Mongo slice + the ledger query.UseCase so fee
 	// account/segment/count reads run in-process. HTTP route mounting is
@@ -971,12 +1015,17 @@ func InitServersWithOptions(opts *Options) (*Service, error) {
 
 	// Transaction handlers
 	transactionHandler := &httpin.TransactionHandler{
-		Command:            commandUseCase,
-		Query:              queryUseCase,
-		FeeApplier:         fees.useCase,
-		TracerReserver:     tracerReserver,
-		FeesMongoManager:   feeMgo.mongoManager,
-		MultiTenantEnabled: cfg.MultiTenantEnabled,
+		Command:              commandUseCase,
+		Query:                queryUseCase,
+		FeeApplier:           fees.useCase,
+		FeeQuoteApplier:      fees.useCase,
+		FeeReverser:          fees.useCase,
+		FeeRevenueRecorder:   fees.useCase,
+		FeePromotionReleaser: fees.useCase,
+		TracerReserver:       tracerReserver,
+		HolderKYC:            crmMgo.holderHandler.Service,
+		FeesMongoManager:     feeMgo.mongoManager,
+		MultiTenantEnabled:   cfg.MultiTenantEnabled,
 	}
 	operationHandler := &httpin.OperationHandler{Command: commandUseCase, Query: queryUseCase}
 	assetRateHandler := &httpin.AssetRateHandler{Command: commandUseCase, Query: queryUseCase}
@@ -1050,6 +1099,22 @@ func InitServersWithOptions(opts *Options) (*Service, error) {
 	billingPackageHandler := &httpin.BillingPackageHandler{Service: fees.billingPackageService}
 	billingCalculateHandler := &httpin.BillingCalculateHandler{Service: fees.billingCalculateService}
 
+	// Billing runs and invoices post through the transaction handler, so they are
+	// wired only now and mounted under routeSetup.billingRunRouteOptions.
+	billingRunHandler, err := initBillingRuns(fees, feeMgo, transactionHandler)
+	if err != nil {
+		doCleanup()
+
+		return nil, err
+	}
+
+	billingInvoiceHandler, err := initBillingInvoices(fees, feeMgo, transactionHandler)
+	if err != nil {
+		doCleanup()
+
+		return nil, err
+	}
+
 	// Composition reuses the SAME account-create and instrument-create use-case instances
 	// the onboarding and CRM registrars already use — it composes them, it never
 	// reimplements them. The cross-store composition tenant middleware travels via
@@ -1116,8 +1181,10 @@ func InitServersWithOptions(opts *Options) (*Service, error) {
 		// accounts, encryption, audit) are preserved inside RegisterCRMRoutesToApp:
 		// a nil handler mounts neither the Fiber auth chain nor the Huma terminal,
 		// matching the pre-Huma `if hah/eh/auditHandler != nil` posture.
-		httpin.RegisterCRMRoutesToApp(group, api, auth, crmMgo.holderHandler, crmMgo.instrumentHandler, holderAccountsHandler, crmMgo.encryptionHandler, crmMgo.auditHandler, routeSetup.crmRouteOptions)
+		httpin.RegisterCRMRoutesToApp(group, api, auth, crmMgo.holderHandler, crmMgo.instrumentHandler, holderAccountsHandler, crmMgo.encryptionHandler, crmMgo.auditHandler, crmMgo.screeningHandler, routeSetup.crmRouteOptions)
 		httpin.RegisterFeesRoutesToApp(group, api, auth, feePackageHandler, feeHandler, billingPackageHandler, billingCalculateHandler, routeSetup.feesRouteOptions)
+		httpin.RegisterBillingRunRoutesToApp(group, api, auth, billingRunHandler, routeSetup.billingRunRouteOptions)
+		httpin.RegisterBillingInvoiceRoutesToApp(group, api, auth, billingInvoiceHandler, routeSetup.billingRunRouteOptions)
 		httpin.RegisterCompositionRoutesToApp(group, api, auth, compositionHandler, routeSetup.compositionRouteOptions)
 	}
 
@@ -1484,6 +1551,7 @@ type unifiedRouteSetup struct {
 	ledgerRouteOptions      *midazhttp.ProtectedRouteOptions
 	crmRouteOptions         *midazhttp.ProtectedRouteOptions
 	feesRouteOptions        *midazhttp.ProtectedRouteOptions
+	billingRunRouteOptions  *midazhttp.ProtectedRouteOptions
 	compositionRouteOptions *midazhttp.ProtectedRouteOptions
 }
 
@@ -1613,6 +1681,25 @@ func buildUnifiedRouteSetup(
 		tmmiddleware.WithTenantLoader(tenantLoader),
 	)
 
+	// Billing-run tenant middleware is its own SEPARATE instance because a run
+	// crosses both sides of the fee/ledger split: it reads billing packages and
+	// writes statements in the fee Mongo (generic key, as feesTenantMiddleware),
+	// then posts each charge through the transaction path, which needs the
+	// module-keyed onboarding/transaction PG + Mongo (as tenantMiddleware). The
+	// ledger Mongo repos prefer their module key over the generic one, so the
+	// generic fee-Mongo write here cannot shadow the ledger stores. It is
+	// attached ONLY to billing-run and billing-invoice routes (invoices cross
+	// the split the same way) via billingRunRouteOptions below.
+	billingRunTenantMiddleware := tmmiddleware.NewTenantMiddleware(
+		tmmiddleware.WithPG(onboardingPGManager, constant.ModuleOnboarding),
+		tmmiddleware.WithPG(transactionPGManager, constant.ModuleTransaction),
+		tmmiddleware.WithMB(onboardingMongoManager, constant.ModuleOnboarding),
+		tmmiddleware.WithMB(transactionMongoManager, constant.ModuleTransaction),
+		tmmiddleware.WithMB(feesMongoManager),
+		tmmiddleware.WithTenantCache(tenantCache),
+		tmmiddleware.WithTenantLoader(tenantLoader),
+	)
+
 	logger.Log(context.Background(), libLog.LevelInfo, "Tenant middleware configured",
 		libLog.String("modules", "onboarding,transaction,crm-api,plugin-fees"),
 	)
@@ -1642,6 +1729,12 @@ func buildUnifiedRouteSetup(
 		PostAuthMiddlewares: []fiber.Handler{authAssertion, feesTenantMiddleware.WithTenantDB},
 	}
 
+	// Billing-run and billing-invoice routes get the fee + ledger billing-run
+	// tenant middleware.
+	setup.billingRunRouteOptions = &midazhttp.ProtectedRouteOptions{
+		PostAuthMiddlewares: []fiber.Handler{authAssertion, billingRunTenantMiddleware.WithTenantDB},
+	}
+
 	// Composition routes get the cross-store composition tenant middleware
 	// instance, scoping the onboarding-PG + CRM-Mongo injection to composition
 	// routes only.
@@ -17,10 +17,15 @@ import (
 	"github.com/LerianStudio/lib-observability/metrics"
 	httpin "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/http/in"
 	mongoAudit "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/audit"
+	mongoExport "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/export"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	mongoMerge "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/merge"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership"
+	mongoScreening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
 	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/screening"
 	"github.com/LerianStudio/midaz/v4/pkg/constant"
 	pkgMongo "github.com/LerianStudio/midaz/v4/pkg/mongo"
 )
@@ -35,6 +40,7 @@ type crmComponents struct {
 	encryption        *crmEncryption
 	holderHandler     *httpin.HolderHandler
 	instrumentHandler *httpin.InstrumentHandler
+	screeningHandler  *httpin.ScreeningHandler
 	encryptionHandler *httpin.EncryptionHandler // nil in legacy mode
 	auditHandler      *httpin.AuditHandler      // nil in legacy mode
 	mongoManager      *tmmongo.Manager          // nil in single-tenant mode; exposed for middleware/eviction wiring
@@ -95,14 +101,35 @@ func initCRMMultiTenant(opts *Options, cfg *Config, metricsFactory *metrics.Metr
 		return nil, err
 	}
 
-	holderHandler, instrumentHandler := buildCRMHandlers(holderRepo, instrumentRepo)
+	screeningService, err := buildCRMScreening(cfg, nil, holderRepo, instrumentRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	ownershipRepo, err := ownership.NewMongoDBRepository(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM ownership repository: %w", err)
+	}
+
+	exportRepo, err := mongoExport.NewMongoDBRepository(nil, crmEnc.fieldEncryptor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM holder export repository: %w", err)
+	}
+
+	mergeRepo, err := mongoMerge.NewMongoDBRepository(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM holder merge repository: %w", err)
+	}
+
+	holderHandler, instrumentHandler := buildCRMHandlers(holderRepo, instrumentRepo, ownershipRepo, exportRepo, mergeRepo, crmEnc, screeningService)
 
 	return &crmComponents{
 		encryption:        crmEnc,
 		holderHandler:     holderHandler,
 		instrumentHandler: instrumentHandler,
-		encryptionHandler: newEncryptionHandler(crmEnc.provisioningService),
+		encryptionHandler: newEncryptionHandler(crmEnc, holderRepo, instrumentRepo),
 		auditHandler:      newAuditHandler(crmEnc.auditRepo),
+		screeningHandler:  &httpin.ScreeningHandler{Service: screeningService},
 		mongoManager:      mongoMgr,
 	}, nil
 }
@@ -148,27 +175,66 @@ func initCRMSingleTenant(multiTenantEnabled bool, cfg *Config, metricsFactory *m
 		return nil, err
 	}
 
-	holderHandler, instrumentHandler := buildCRMHandlers(holderRepo, instrumentRepo)
+	screeningService, err := buildCRMScreening(cfg, mongoConnection, holderRepo, instrumentRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	ownershipRepo, err := ownership.NewMongoDBRepository(mongoConnection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM ownership repository: %w", err)
+	}
+
+	exportRepo, err := mongoExport.NewMongoDBRepository(mongoConnection, crmEnc.fieldEncryptor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM holder export repository: %w", err)
+	}
+
+	mergeRepo, err := mongoMerge.NewMongoDBRepository(mongoConnection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM holder merge repository: %w", err)
+	}
+
+	holderHandler, instrumentHandler := buildCRMHandlers(holderRepo, instrumentRepo, ownershipRepo, exportRepo, mergeRepo, crmEnc, screeningService)
 
 	return &crmComponents{
 		connection:        mongoConnection,
 		encryption:        crmEnc,
 		holderHandler:     holderHandler,
 		instrumentHandler: instrumentHandler,
-		encryptionHandler: newEncryptionHandler(crmEnc.provisioningService),
+		encryptionHandler: newEncryptionHandler(crmEnc, holderRepo, instrumentRepo),
 		auditHandler:      newAuditHandler(crmEnc.auditRepo),
+		screeningHandler:  &httpin.ScreeningHandler{Service: screeningService},
 	}, nil
 }
 
-// newEncryptionHandler builds the encryption provisioning HTTP handler when a
-// provisioning service is available (envelope mode). In legacy mode the service is
-// nil, so this returns nil and the routes stay unregistered.
-func newEncryptionHandler(provisioningService encryption.ProvisioningService) *httpin.EncryptionHandler {
-	if provisioningService == nil {
+// newEncryptionHandler builds the encryption provisioning and key rotation HTTP
+// handler when a provisioning service is available (envelope mode). In legacy mode
+// the service is nil, so this returns nil and the routes stay unregistered. The
+// holder data keys (rewrapped first) and the holder and instrument repositories
+// are the rotation's re-encryption targets.
+func newEncryptionHandler(crmEnc *crmEncryption, holderRepo *holder.MongoDBRepository, instrumentRepo *instrument.MongoDBRepository) *httpin.EncryptionHandler {
+	if crmEnc.provisioningService == nil {
 		return nil
 	}
 
-	return &httpin.EncryptionHandler{ProvisioningService: provisioningService}
+	handler := &httpin.EncryptionHandler{ProvisioningService: crmEnc.provisioningService}
+
+	if crmEnc.newRotationService != nil {
+		targets := make([]encryption.NamedReencryptionTarget, 0, 3)
+		if crmEnc.holderKeys != nil {
+			targets = append(targets, encryption.NamedReencryptionTarget{Name: "holder_keys", Target: crmEnc.holderKeys})
+		}
+
+		targets = append(targets,
+			encryption.NamedReencryptionTarget{Name: "holders", Target: holderRepo},
+			encryption.NamedReencryptionTarget{Name: "instruments", Target: instrumentRepo},
+		)
+
+		handler.RotationService = crmEnc.newRotationService(targets...)
+	}
+
+	return handler
 }
 
 // newAuditHandler builds the protection-audit HTTP handler when an audit repository
@@ -237,11 +303,40 @@ func buildCRMRepositories(connection *libMongo.Client, fieldEncryptor encryption
 	return holderRepo, instrumentRepo, nil
 }
 
-// buildCRMHandlers assembles the CRM use cases and HTTP handlers.
-func buildCRMHandlers(holderRepo *holder.MongoDBRepository, instrumentRepo *instrument.MongoDBRepository) (*httpin.HolderHandler, *httpin.InstrumentHandler) {
+// buildCRMScreening constructs the watch-list screening service. connection is
+// nil in multi-tenant mode (database resolved per-request).
+// CRM_SCREENING_MATCH_THRESHOLD is a percentage; unset selects the default.
+func buildCRMScreening(cfg *Config, connection *libMongo.Client, holderRepo *holder.MongoDBRepository, instrumentRepo *instrument.MongoDBRepository) (screening.Service, error) {
+	screeningRepo, err := mongoScreening.NewMongoDBRepository(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize CRM screening repository: %w", err)
+	}
+
+	threshold := float64(cfg.CrmScreeningMatchThreshold) / 100
+
+	return screening.NewService(screeningRepo, holderRepo, instrumentRepo, threshold), nil
+}
+
+// buildCRMHandlers assembles the CRM use cases and HTTP handlers. In envelope
+// mode the use cases also get the holder key shredder and protection audit writer
+// used by holder erasure; in legacy mode both stay nil. screener screens holders
+// and related parties as they are created and updated. exportRepo stores holder
+// data exports, whose archives are encrypted under the holder's data key, and
+// mergeRepo the merges of duplicate holders.
+func buildCRMHandlers(holderRepo *holder.MongoDBRepository, instrumentRepo *instrument.MongoDBRepository, ownershipRepo *ownership.MongoDBRepository, exportRepo *mongoExport.MongoDBRepository, mergeRepo *mongoMerge.MongoDBRepository, crmEnc *crmEncryption, screener crmservices.HolderScreener) (*httpin.HolderHandler, *httpin.InstrumentHandler) {
 	useCases := &crmservices.UseCase{
-		HolderRepo:     holderRepo,
-		InstrumentRepo: instrumentRepo,
+		HolderRepo:      holderRepo,
+		InstrumentRepo:  instrumentRepo,
+		OwnershipRepo:   ownershipRepo,
+		ExportRepo:      exportRepo,
+		MergeRepo:       mergeRepo,
+		ProtectionAudit: crmEnc.auditWriter,
+		Screener:        screener,
+	}
+
+	// Assigned only when set: a nil *HolderKeyManager would be a non-nil interface.
+	if crmEnc.holderKeys != nil {
+		useCases.HolderKeys = crmEnc.holderKeys
 	}
 
 	return &httpin.HolderHandler{Service: useCases}, &httpin.InstrumentHandler{Service: useCases}
@@ -15,8 +15,13 @@ import (
 	tmmongo "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/mongo"
 	libLog "github.com/LerianStudio/lib-observability/log"
 	feesmongo "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_invoice"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_package"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_quote"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_revenue"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/promotion_usage"
 	"github.com/LerianStudio/midaz/v4/pkg/constant"
 	pkgMongo "github.com/LerianStudio/midaz/v4/pkg/mongo"
 )
@@ -35,13 +40,18 @@ type feesMongoComponents struct {
 	connection         *feesmongo.MongoConnection
 	packageRepo        pack.Repository
 	billingPackageRepo billing_package.Repository
+	billingRunRepo     billing_run.Repository
+	billingInvoiceRepo billing_invoice.Repository
+	promotionUsageRepo promotion_usage.Repository
+	feeQuoteRepo       fee_quote.Repository
+	feeRevenueRepo     fee_revenue.Repository
 	mongoManager       *tmmongo.Manager // nil in single-tenant mode
 }
 
 // initFeesMongo initializes the fee/billing-package Mongo slice. It builds a
 // static fee Mongo connection from the FeesPrefixed* config, constructs the
-// pack + billing_package repositories (whose constructors ensure the 11 compound
-// indexes on startup), and — in multi-tenant mode — additionally builds a fee
+// pack + billing_package + billing_run + billing_invoice + promotion_usage + fee_quote + fee_revenue
+// repositories (whose constructors ensure the 23 indexes on startup), and — in multi-tenant mode — additionally builds a fee
 // tenant-manager Mongo manager keyed on constant.ModuleFees for per-request DB
 // resolution.
 func initFeesMongo(opts *Options, cfg *Config, logger libLog.Logger) (*feesMongoComponents, error) {
@@ -51,7 +61,8 @@ func initFeesMongo(opts *Options, cfg *Config, logger libLog.Logger) (*feesMongo
 	}
 
 	// Constructing the repos validates the connection (GetDB) and ensures the
-	// compound indexes (pack=7, billing_package=4) on the static connection's DB.
+	// compound indexes (pack=7, billing_package=4, billing_run=3, billing_invoice=4,
+	// promotion_usage=2, fee_quote=1, fee_revenue=2) on the static connection's DB.
 	packageRepo, err := pack.NewPackageMongoDBRepository(connection, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize fee package repository: %w", err)
@@ -62,10 +73,40 @@ func initFeesMongo(opts *Options, cfg *Config, logger libLog.Logger) (*feesMongo
 		return nil, fmt.Errorf("failed to initialize billing package repository: %w", err)
 	}
 
+	billingRunRepo, err := billing_run.NewBillingRunMongoDBRepository(connection, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize billing run repository: %w", err)
+	}
+
+	billingInvoiceRepo, err := billing_invoice.NewBillingInvoiceMongoDBRepository(connection, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize billing invoice repository: %w", err)
+	}
+
+	promotionUsageRepo, err := promotion_usage.NewPromotionUsageMongoDBRepository(connection, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize fee promotion usage repository: %w", err)
+	}
+
+	feeQuoteRepo, err := fee_quote.NewFeeQuoteMongoDBRepository(connection, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize fee quote repository: %w", err)
+	}
+
+	feeRevenueRepo, err := fee_revenue.NewFeeRevenueMongoDBRepository(connection, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize fee revenue repository: %w", err)
+	}
+
 	components := &feesMongoComponents{
 		connection:         connection,
 		packageRepo:        packageRepo,
 		billingPackageRepo: billingPackageRepo,
+		billingRunRepo:     billingRunRepo,
+		billingInvoiceRepo: billingInvoiceRepo,
+		promotionUsageRepo: promotionUsageRepo,
+		feeQuoteRepo:       feeQuoteRepo,
+		feeRevenueRepo:     feeRevenueRepo,
 	}
 
 	if opts != nil && opts.MultiTenantEnabled {
@@ -409,7 +409,7 @@ func mountCRMHuma(app *fiber.App, auth *middleware.AuthClient, hh *httpin.Holder
 	hAPI := openapi.New(app, apiV1, openapi.Config{Title: "crm-integration", Version: "test", Servers: []string{"/v1"}})
 	http.InstallLedgerSchemaNamer(hAPI)
 
-	httpin.RegisterCRMRoutesToApp(apiV1, hAPI, auth, hh, ah, hah, eh, auditHandler, routeOptions)
+	httpin.RegisterCRMRoutesToApp(apiV1, hAPI, auth, hh, ah, hah, eh, auditHandler, nil, routeOptions)
 }
 
 // newCRMTestApp mounts the CRM registrar on a bare Fiber app with auth disabled
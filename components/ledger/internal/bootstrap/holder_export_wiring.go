@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/operation"
+	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// holderExportOperationPageSize bounds each operation page read while a holder
+// export walks an account's history.
+const holderExportOperationPageSize = 500
+
+// holderLedgerReaderAdapter satisfies crmservices.HolderLedgerReader over the
+// ledger query use case, letting the CRM holder export read a holder's
+// accounts, balances and operations without importing the query package
+// (dependency-inward).
+type holderLedgerReaderAdapter struct {
+	query *query.UseCase
+}
+
+var _ crmservices.HolderLedgerReader = holderLedgerReaderAdapter{}
+
+// ListAccountsByHolder lists every account of the holder across the
+// organization's ledgers, deleted ones included.
+func (a holderLedgerReaderAdapter) ListAccountsByHolder(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error) {
+	return a.query.ListAccountsByHolderID(ctx, organizationID, holderID)
+}
+
+// ListBalancesByAccounts lists the balances of the given accounts of a ledger.
+func (a holderLedgerReaderAdapter) ListBalancesByAccounts(ctx context.Context, organizationID, ledgerID uuid.UUID, accountIDs []uuid.UUID) ([]*mmodel.Balance, error) {
+	return a.query.ListBalancesByAccountIDs(ctx, organizationID, ledgerID, accountIDs)
+}
+
+// ListAccountOperations reads one page of an account's operations, oldest
+// first, flattened into export rows.
+func (a holderLedgerReaderAdapter) ListAccountOperations(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, cursor string) ([]mmodel.HolderExportOperation, string, error) {
+	operations, next, err := a.query.ListAccountOperationsPage(ctx, organizationID, ledgerID, accountID, cursor, holderExportOperationPageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows := make([]mmodel.HolderExportOperation, 0, len(operations))
+	for _, op := range operations {
+		if op != nil {
+			rows = append(rows, toHolderExportOperation(op))
+		}
+	}
+
+	return rows, next, nil
+}
+
+// toHolderExportOperation flattens a ledger operation into an export row.
+func toHolderExportOperation(op *operation.Operation) mmodel.HolderExportOperation {
+	row := mmodel.HolderExportOperation{
+		TransactionID: op.TransactionID,
+		OperationID:   op.ID,
+		CreatedAt:     op.CreatedAt,
+		LedgerID:      op.LedgerID,
+		AccountID:     op.AccountID,
+		AccountAlias:  op.AccountAlias,
+		BalanceKey:    op.BalanceKey,
+		Type:          op.Type,
+		Direction:     op.Direction,
+		AssetCode:     op.AssetCode,
+		Status:        op.Status.Code,
+		Description:   op.Description,
+	}
+
+	if op.Amount.Value != nil {
+		row.Amount = op.Amount.Value.String()
+	}
+
+	return row
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package bootstrap
+
+import (
+	"context"
+
+	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/command"
+	"github.com/google/uuid"
+)
+
+// holderAccountReassignerAdapter satisfies crmservices.HolderAccountReassigner
+// over the ledger command use case, letting the CRM holder merge move a
+// duplicate holder's accounts without importing the command package
+// (dependency-inward).
+type holderAccountReassignerAdapter struct {
+	command *command.UseCase
+}
+
+var _ crmservices.HolderAccountReassigner = holderAccountReassignerAdapter{}
+
+// ReassignAccounts moves every account of fromHolderID to toHolderID.
+func (a holderAccountReassignerAdapter) ReassignAccounts(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	return a.command.ReassignAccountsHolder(ctx, organizationID, fromHolderID, toHolderID)
+}
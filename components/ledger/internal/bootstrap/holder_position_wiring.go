@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// holderPositionReaderAdapter satisfies crmservices.HolderPositionReader over
+// the ledger query use case. Accounts and current balances are read exactly as
+// the holder export reads them, so it reuses holderLedgerReaderAdapter: current
+// balances carry the Redis overlay of ListBalancesByAccountIDs, like every other
+// balance read.
+type holderPositionReaderAdapter struct {
+	holderLedgerReaderAdapter
+}
+
+var _ crmservices.HolderPositionReader = holderPositionReaderAdapter{}
+
+// ListAccountBalancesAt reads the account's balances as they stood at the
+// given time.
+func (a holderPositionReaderAdapter) ListAccountBalancesAt(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, at time.Time) ([]*mmodel.Balance, error) {
+	return a.query.GetAccountBalancesAtTimestamp(ctx, organizationID, ledgerID, accountID, at)
+}
+
+// GetAssetRate returns the ledger's rate from one asset into another, as the
+// conversion factor its integer rate and scale stand for.
+func (a holderPositionReaderAdapter) GetAssetRate(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error) {
+	assetRate, err := a.query.GetAssetRateByCurrencyPair(ctx, organizationID, ledgerID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if assetRate == nil {
+		return nil, nil
+	}
+
+	rate := assetRate.Factor()
+
+	return &rate, nil
+}
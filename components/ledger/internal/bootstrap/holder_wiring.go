@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 
+	httpin "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/http/in"
+	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/query"
 	"github.com/LerianStudio/midaz/v4/pkg"
 	"github.com/LerianStudio/midaz/v4/pkg/constant"
@@ -77,3 +79,7 @@ func (a holderAccountsReaderAdapter) ListAccountsByHolder(ctx context.Context, o
 
 	return a.query.GetAllAccount(ctx, orgID, ledgerID, nil, nil, filter)
 }
+
+// The CRM holder service answers the transaction KYC gate directly: its
+// UnverifiedHolders reads only the KYC projection, never decrypting holder data.
+var _ httpin.HolderKYCReader = (*crmservices.UseCase)(nil)
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+
+	crmservices "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// instrumentAccountLifecycleAdapter satisfies
+// crmservices.InstrumentAccountLifecycle over the ledger command and query use
+// cases, letting CRM instrument transitions block, unblock and close the
+// linked account without importing either package (dependency-inward).
+type instrumentAccountLifecycleAdapter struct {
+	command *command.UseCase
+	query   *query.UseCase
+}
+
+var _ crmservices.InstrumentAccountLifecycle = instrumentAccountLifecycleAdapter{}
+
+// SetAccountBlocked blocks or unblocks the account. The portfolio filter is nil
+// because instruments address an account directly.
+func (a instrumentAccountLifecycleAdapter) SetAccountBlocked(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, blocked bool) error {
+	_, err := a.command.UpdateAccount(ctx, organizationID, ledgerID, nil, accountID, &mmodel.UpdateAccountInput{Blocked: &blocked})
+
+	return err
+}
+
+// ListAccountBalances lists the balances of the account.
+func (a instrumentAccountLifecycleAdapter) ListAccountBalances(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID) ([]*mmodel.Balance, error) {
+	return a.query.ListBalancesByAccountIDs(ctx, organizationID, ledgerID, []uuid.UUID{accountID})
+}
+
+// CloseAccount soft-deletes the account and its balances. An account-not-found
+// business error is mapped to success, so retrying a close whose instrument
+// update failed does not fail on the account it already closed.
+func (a instrumentAccountLifecycleAdapter) CloseAccount(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID) error {
+	if err := a.command.DeleteAccountByID(ctx, organizationID, ledgerID, nil, accountID, ""); err != nil {
+		var notFound pkg.EntityNotFoundError
+		if errors.As(err, &notFound) && notFound.Code == constant.ErrAccountIDNotFound.Error() {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	libLog "github.com/LerianStudio/lib-observability/log"
 	"github.com/LerianStudio/lib-observability/metrics"
 	feesmongo "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto"
 	"github.com/LerianStudio/midaz/v4/pkg/utils"
 	"github.com/gofiber/fiber/v2"
 	"go.opentelemetry.io/otel/attribute"
@@ -437,11 +438,17 @@ func buildReadyzHandler(
 		return nil, err
 	}
 
-	// Vault checker - present only in envelope encryption mode
-	// (KMS_VENDOR=hashicorp-vault); legacy mode has no Vault client.
-	if crmMgo.encryption != nil && crmMgo.encryption.vaultClient != nil {
-		checkers = append(checkers,
-			NewVaultChecker("vault", crmMgo.encryption.vaultClient, cfg.VaultAddr))
+	// KMS checker - present only in envelope encryption mode; legacy mode has no
+	// KMS client. Vault keeps the "vault" name and TLS detection from its address;
+	// the PKCS#11 and local backends report as "kms_<vendor>".
+	if crmMgo.encryption != nil && crmMgo.encryption.kmsClient != nil {
+		if crmMgo.encryption.kmsVendor == crypto.VendorHashicorpVault {
+			checkers = append(checkers,
+				NewVaultChecker("vault", crmMgo.encryption.kmsClient, cfg.VaultAddr))
+		} else {
+			checkers = append(checkers,
+				NewVaultChecker("kms_"+crmMgo.encryption.kmsVendor, crmMgo.encryption.kmsClient, ""))
+		}
 	}
 
 	// Redis checker
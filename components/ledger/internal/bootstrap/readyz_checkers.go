@@ -510,17 +510,17 @@ func (c *MongoDatabaseChecker) Check(ctx context.Context) DependencyCheck {
 	}
 }
 
-// VaultHealthChecker is the interface for checking Vault health status, allowing
-// testing without a real vault.Client.
+// VaultHealthChecker is the interface for checking KMS health status, allowing
+// testing without a real vault.Client. Every kms.Client satisfies it.
 type VaultHealthChecker interface {
-	// HealthCheck verifies Vault server availability via the sys/health endpoint.
-	// Returns nil if Vault is healthy, an error otherwise.
+	// HealthCheck verifies KMS availability (the sys/health endpoint for Vault).
+	// Returns nil if the KMS is healthy, an error otherwise.
 	HealthCheck(ctx context.Context) error
 }
 
-// VaultChecker probes Vault availability via the sys/health endpoint. It is wired
-// only in envelope encryption mode (KMS_VENDOR=hashicorp-vault); in legacy mode no
-// Vault client exists and the checker is not registered.
+// VaultChecker probes KMS availability through HealthCheck. It is wired only in
+// envelope encryption mode, for whichever KMS_VENDOR is configured; in legacy mode
+// no KMS client exists and the checker is not registered.
 type VaultChecker struct {
 	name       string
 	client     VaultHealthChecker
@@ -549,7 +549,7 @@ func (c *VaultChecker) TLSEnabled() bool {
 	return c.tlsEnabled
 }
 
-// Check probes Vault availability via the sys/health endpoint.
+// Check probes KMS availability via the client HealthCheck.
 func (c *VaultChecker) Check(ctx context.Context) DependencyCheck {
 	if c.client == nil {
 		return DependencyCheck{
@@ -219,6 +219,11 @@ func midazEventDefinitions() []routedDefinition {
 		{events.FeesBillingPackageUpdatedDefinition, serviceFee},
 		{events.FeesBillingPackageDeletedDefinition, serviceFee},
 		{events.FeesAppliedDefinition, serviceFee},
+		{events.FeesBillingInvoiceIssuedDefinition, serviceFee},
+		{events.FeesBillingInvoicePartiallyPaidDefinition, serviceFee},
+		{events.FeesBillingInvoicePaidDefinition, serviceFee},
+		{events.FeesBillingInvoiceOverdueDefinition, serviceFee},
+		{events.FeesBillingInvoiceWrittenOffDefinition, serviceFee},
 		// CRM
 		{events.HolderCreatedDefinition, serviceCRM},
 		{events.HolderUpdatedDefinition, serviceCRM},
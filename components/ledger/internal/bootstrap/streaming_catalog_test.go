@@ -123,13 +123,18 @@ func TestMidazCatalogRoutesAssembly(t *testing.T) {
 		events.TransactionCanceledDefinition.Key():     wantLedger,
 		events.TransactionRevertedDefinition.Key():     wantLedger,
 		// Fees.
-		events.FeesPackageCreatedDefinition.Key():        wantFee,
-		events.FeesPackageUpdatedDefinition.Key():        wantFee,
-		events.FeesPackageDeletedDefinition.Key():        wantFee,
-		events.FeesBillingPackageCreatedDefinition.Key(): wantFee,
-		events.FeesBillingPackageUpdatedDefinition.Key(): wantFee,
-		events.FeesBillingPackageDeletedDefinition.Key(): wantFee,
-		events.FeesAppliedDefinition.Key():               wantFee,
+		events.FeesPackageCreatedDefinition.Key():              wantFee,
+		events.FeesPackageUpdatedDefinition.Key():              wantFee,
+		events.FeesPackageDeletedDefinition.Key():              wantFee,
+		events.FeesBillingPackageCreatedDefinition.Key():       wantFee,
+		events.FeesBillingPackageUpdatedDefinition.Key():       wantFee,
+		events.FeesBillingPackageDeletedDefinition.Key():       wantFee,
+		events.FeesAppliedDefinition.Key():                     wantFee,
+		events.FeesBillingInvoiceIssuedDefinition.Key():        wantFee,
+		events.FeesBillingInvoicePartiallyPaidDefinition.Key(): wantFee,
+		events.FeesBillingInvoicePaidDefinition.Key():          wantFee,
+		events.FeesBillingInvoiceOverdueDefinition.Key():       wantFee,
+		events.FeesBillingInvoiceWrittenOffDefinition.Key():    wantFee,
 		// CRM.
 		events.HolderCreatedDefinition.Key():                 wantCRM,
 		events.HolderUpdatedDefinition.Key():                 wantCRM,
@@ -182,6 +187,11 @@ func TestFeesEventsRegistered(t *testing.T) {
 		"fee-billing-packages.updated",
 		"fee-billing-packages.deleted",
 		"fee-charge.applied",
+		"fee-billing-invoices.issued",
+		"fee-billing-invoices.partially-paid",
+		"fee-billing-invoices.paid",
+		"fee-billing-invoices.overdue",
+		"fee-billing-invoices.written-off",
 	}
 
 	catalog, err := buildCatalog()
@@ -287,7 +287,7 @@ func streamingITExpectations() []streamingITExpectation {
 			subject:    transactionID,
 			requireKey: []string{"transactionId", "organizationId", "ledgerId", "feePackageId", "appliedAt"},
 			emitReq: func(tenantID string) (libStreaming.EmitRequest, error) {
-				return events.NewFeesApplied(transactionID, orgID, ledgerID, packageID, streamingITFixedTime).
+				return events.NewFeesApplied(transactionID, orgID, ledgerID, packageID, 1, streamingITFixedTime).
 					ToEmitRequest(tenantID, streamingITFixedTime)
 			},
 		},
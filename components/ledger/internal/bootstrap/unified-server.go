@@ -17,6 +17,7 @@ import (
 	libLog "github.com/LerianStudio/lib-observability/log"
 	libObsMiddleware "github.com/LerianStudio/lib-observability/middleware"
 	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	httpin "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/http/in"
 	"github.com/LerianStudio/midaz/v4/pkg/buildinfo"
 	midazhttp "github.com/LerianStudio/midaz/v4/pkg/net/http"
 	"github.com/danielgtaylor/huma/v2"
@@ -65,6 +66,9 @@ func NewUnifiedServer(
 		AppName:               "Midaz Ledger API",
 		DisableStartupMessage: true,
 		ErrorHandler:          midazhttp.CanonicalFiberErrorHandler,
+		// Sized for watch-list uploads; every Huma operation keeps its own,
+		// smaller MaxBodyBytes.
+		BodyLimit: httpin.WatchListMaxPayloadSize,
 	})
 
 	// Add common middleware (only once for all routes).
@@ -37,6 +37,7 @@ type AuditDetailsModel struct {
 	AffectedKeyIDs    []uint32 `bson:"affected_key_ids"`
 	ProviderReference string   `bson:"provider_reference"`
 	ErrorCode         string   `bson:"error_code"`
+	SubjectID         string   `bson:"subject_id,omitempty"`
 }
 
 // FromEntity converts a domain ProtectionAuditEvent to its MongoDB model.
@@ -105,6 +106,7 @@ func detailsFromEntity(d *mmodel.AuditDetails) *AuditDetailsModel {
 		AffectedKeyIDs:    cloneKeyIDs(d.AffectedKeyIDs),
 		ProviderReference: d.ProviderReference,
 		ErrorCode:         d.ErrorCode,
+		SubjectID:         d.SubjectID,
 	}
 }
 
@@ -121,6 +123,7 @@ func (m *AuditDetailsModel) toEntity() *mmodel.AuditDetails {
 		AffectedKeyIDs:    cloneKeyIDs(m.AffectedKeyIDs),
 		ProviderReference: m.ProviderReference,
 		ErrorCode:         m.ErrorCode,
+		SubjectID:         m.SubjectID,
 	}
 }
 
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// HolderDataKeyMongoDBModel is the MongoDB representation of HolderDataKey.
+// A destroyed key keeps its document as a tombstone without wrapped_key.
+type HolderDataKeyMongoDBModel struct {
+	TenantID       string     `bson:"tenant_id,omitempty"`
+	OrganizationID string     `bson:"organization_id"`
+	HolderID       string     `bson:"holder_id"`
+	KeysetVersion  int        `bson:"keyset_version"`
+	WrappedKey     []byte     `bson:"wrapped_key,omitempty"`
+	Revision       int64      `bson:"revision"`
+	CreatedAt      time.Time  `bson:"created_at"`
+	UpdatedAt      time.Time  `bson:"updated_at"`
+	DestroyedAt    *time.Time `bson:"destroyed_at,omitempty"`
+	DestroyedBy    string     `bson:"destroyed_by,omitempty"`
+}
+
+// HolderDataKeyFromEntity converts a domain HolderDataKey to MongoDB model.
+func HolderDataKeyFromEntity(k *mmodel.HolderDataKey) *HolderDataKeyMongoDBModel {
+	if k == nil {
+		return nil
+	}
+
+	return &HolderDataKeyMongoDBModel{
+		TenantID:       k.TenantID,
+		OrganizationID: k.OrganizationID,
+		HolderID:       k.HolderID,
+		KeysetVersion:  k.KeysetVersion,
+		WrappedKey:     k.WrappedKey,
+		Revision:       k.Revision,
+		CreatedAt:      k.CreatedAt,
+		UpdatedAt:      k.UpdatedAt,
+		DestroyedAt:    k.DestroyedAt,
+		DestroyedBy:    k.DestroyedBy,
+	}
+}
+
+// ToEntity converts the MongoDB model to a domain HolderDataKey.
+func (m *HolderDataKeyMongoDBModel) ToEntity() *mmodel.HolderDataKey {
+	if m == nil {
+		return nil
+	}
+
+	return &mmodel.HolderDataKey{
+		TenantID:       m.TenantID,
+		OrganizationID: m.OrganizationID,
+		HolderID:       m.HolderID,
+		KeysetVersion:  m.KeysetVersion,
+		WrappedKey:     m.WrappedKey,
+		Revision:       m.Revision,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		DestroyedAt:    m.DestroyedAt,
+		DestroyedBy:    m.DestroyedBy,
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpenTelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const holderDataKeyCollection = "holder_data_key"
+
+// HolderDataKeyRepository persists per-holder data keys: one document per
+// (organization, holder) holding the holder's keyset wrapped by the
+// organization keyset, or a tombstone once the key was destroyed.
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=holder_data_key.mongodb_mock.go --package=encryption . HolderDataKeyRepository
+type HolderDataKeyRepository interface {
+	// Create persists a new holder data key. It is insert-only per holder and
+	// returns the already-exists sentinel when the holder already has a key or
+	// a tombstone.
+	Create(ctx context.Context, key *mmodel.HolderDataKey) error
+	// Get returns the holder's data key (live or destroyed), or the not-found
+	// sentinel when the holder has none.
+	Get(ctx context.Context, organizationID, holderID string) (*mmodel.HolderDataKey, error)
+	// Rewrap replaces the wrapped key and its keyset version under optimistic
+	// concurrency: it matches on expectedRevision and a live key, and returns the
+	// revision-conflict sentinel otherwise.
+	Rewrap(ctx context.Context, key *mmodel.HolderDataKey, expectedRevision int64) error
+	// Destroy drops the holder's wrapped key and leaves a tombstone. It is
+	// idempotent and records a tombstone even when the holder never had a key,
+	// so none is created afterwards.
+	Destroy(ctx context.Context, organizationID, holderID, actor string) error
+	// CountStale counts the organization's live keys not wrapped with
+	// currentVersion.
+	CountStale(ctx context.Context, organizationID string, currentVersion int) (int64, error)
+	// ListStale returns up to limit live keys not wrapped with currentVersion
+	// whose holder id sorts after afterHolderID, in holder id order.
+	ListStale(ctx context.Context, organizationID string, currentVersion int, afterHolderID string, limit int) ([]*mmodel.HolderDataKey, error)
+}
+
+// HolderDataKeyMongoDBRepository is a MongoDB-specific implementation of HolderDataKeyRepository.
+type HolderDataKeyMongoDBRepository struct {
+	connection *libMongo.Client
+}
+
+// NewHolderDataKeyMongoDBRepository returns a new instance of HolderDataKeyMongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewHolderDataKeyMongoDBRepository(connection *libMongo.Client) (*HolderDataKeyMongoDBRepository, error) {
+	r := &HolderDataKeyMongoDBRepository{
+		connection: connection,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for holder data key repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) Create(ctx context.Context, key *mmodel.HolderDataKey) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.create")
+	defer span.End()
+
+	if key == nil {
+		return fmt.Errorf("holder data key is required")
+	}
+
+	tenantID := extractTenantID(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", tenantID),
+		attribute.String("app.request.organization_id", key.OrganizationID),
+		attribute.String("app.request.holder_id", key.HolderID),
+		attribute.Int("app.request.keyset_version", key.KeysetVersion),
+	)
+
+	// Ensure tenant_id is set on the key
+	if key.TenantID == "" {
+		key.TenantID = tenantID
+	}
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return err
+	}
+
+	if err := r.ensureIndexes(ctx, collection); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to create holder data key indexes", err)
+		return fmt.Errorf("create holder data key indexes: %w", err)
+	}
+
+	model := HolderDataKeyFromEntity(key)
+
+	// Database isolation handles multi-tenancy - filter by organization_id and
+	// holder_id so each holder has a single key document.
+	filter := bson.M{"organization_id": key.OrganizationID, "holder_id": key.HolderID}
+	update := bson.M{"$setOnInsert": model}
+
+	result, err := collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return mmodel.ErrHolderDataKeyAlreadyExists
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "Failed to create holder data key", err)
+
+		return fmt.Errorf("create holder data key: %w", err)
+	}
+
+	if result.MatchedCount > 0 {
+		return mmodel.ErrHolderDataKeyAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) Get(ctx context.Context, organizationID, holderID string) (*mmodel.HolderDataKey, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", extractTenantID(ctx)),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID),
+	)
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return nil, err
+	}
+
+	var model HolderDataKeyMongoDBModel
+
+	filter := bson.M{"organization_id": organizationID, "holder_id": holderID}
+
+	if err := collection.FindOne(ctx, filter).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mmodel.ErrHolderDataKeyNotFound
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "Failed to get holder data key", err)
+
+		return nil, fmt.Errorf("get holder data key: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) Rewrap(ctx context.Context, key *mmodel.HolderDataKey, expectedRevision int64) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.rewrap")
+	defer span.End()
+
+	if key == nil {
+		return fmt.Errorf("holder data key is required")
+	}
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", extractTenantID(ctx)),
+		attribute.String("app.request.organization_id", key.OrganizationID),
+		attribute.String("app.request.holder_id", key.HolderID),
+		attribute.Int("app.request.keyset_version", key.KeysetVersion),
+		attribute.Int64("app.request.expected_revision", expectedRevision),
+	)
+
+	if len(key.WrappedKey) == 0 {
+		return fmt.Errorf("wrapped key is required")
+	}
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return err
+	}
+
+	filter := bson.M{
+		"organization_id": key.OrganizationID,
+		"holder_id":       key.HolderID,
+		"revision":        expectedRevision,
+		"destroyed_at":    nil,
+	}
+	update := bson.M{"$set": bson.M{
+		"keyset_version": key.KeysetVersion,
+		"wrapped_key":    key.WrappedKey,
+		"revision":       expectedRevision + 1,
+		"updated_at":     time.Now().UTC(),
+	}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to rewrap holder data key", err)
+		return fmt.Errorf("rewrap holder data key: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return mmodel.ErrKeysetRevisionConflict
+	}
+
+	return nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) Destroy(ctx context.Context, organizationID, holderID, actor string) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.destroy")
+	defer span.End()
+
+	tenantID := extractTenantID(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", tenantID),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID),
+	)
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return err
+	}
+
+	if err := r.ensureIndexes(ctx, collection); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to create holder data key indexes", err)
+		return fmt.Errorf("create holder data key indexes: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	// Matching only live keys keeps the first destruction's timestamp and actor.
+	// When the holder has no key yet the upsert inserts the tombstone; when it
+	// already has one the insert hits the unique index, which means there is
+	// nothing left to destroy.
+	filter := bson.M{"organization_id": organizationID, "holder_id": holderID, "destroyed_at": nil}
+	update := bson.M{
+		"$set": bson.M{
+			"destroyed_at": now,
+			"destroyed_by": actor,
+			"updated_at":   now,
+		},
+		"$unset": bson.M{"wrapped_key": ""},
+		"$inc":   bson.M{"revision": 1},
+		"$setOnInsert": bson.M{
+			"tenant_id":      tenantID,
+			"keyset_version": 0,
+			"created_at":     now,
+		},
+	}
+
+	if _, err := collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "Failed to destroy holder data key", err)
+
+		return fmt.Errorf("destroy holder data key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) CountStale(ctx context.Context, organizationID string, currentVersion int) (int64, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.count_stale")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.current_version", currentVersion),
+	)
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return 0, err
+	}
+
+	count, err := collection.CountDocuments(ctx, staleHolderDataKeyFilter(organizationID, currentVersion))
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to count stale holder data keys", err)
+		return 0, fmt.Errorf("count stale holder data keys: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *HolderDataKeyMongoDBRepository) ListStale(ctx context.Context, organizationID string, currentVersion int, afterHolderID string, limit int) ([]*mmodel.HolderDataKey, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.holder_data_key.list_stale")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.current_version", currentVersion),
+		attribute.Int("app.request.limit", limit),
+	)
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return nil, err
+	}
+
+	filter := staleHolderDataKeyFilter(organizationID, currentVersion)
+	if afterHolderID != "" {
+		filter["holder_id"] = bson.M{"$gt": afterHolderID}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "holder_id", Value: 1}}).SetLimit(int64(limit))
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to list stale holder data keys", err)
+		return nil, fmt.Errorf("list stale holder data keys: %w", err)
+	}
+
+	var models []HolderDataKeyMongoDBModel
+	if err := cursor.All(ctx, &models); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to decode stale holder data keys", err)
+		return nil, fmt.Errorf("decode stale holder data keys: %w", err)
+	}
+
+	keys := make([]*mmodel.HolderDataKey, len(models))
+	for i := range models {
+		keys[i] = models[i].ToEntity()
+	}
+
+	return keys, nil
+}
+
+// staleHolderDataKeyFilter matches the organization's live keys wrapped with a
+// keyset version other than currentVersion.
+func staleHolderDataKeyFilter(organizationID string, currentVersion int) bson.M {
+	return bson.M{
+		"organization_id": organizationID,
+		"destroyed_at":    nil,
+		"keyset_version":  bson.M{"$ne": currentVersion},
+	}
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *HolderDataKeyMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+func (r *HolderDataKeyMongoDBRepository) collection(ctx context.Context) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Collection(holderDataKeyCollection), nil
+}
+
+// ensureIndexes ensures indexes exist for the holder data key collection.
+// Uses per-database tracking to handle multi-tenant mode correctly.
+// Retries on failure — indexes are only marked as done after successful creation.
+func (r *HolderDataKeyMongoDBRepository) ensureIndexes(ctx context.Context, collection *mongo.Collection) error {
+	key := collection.Database().Name() + ":" + holderDataKeyCollection
+
+	return globalIndexTracker.ensureOnce(key, func() error {
+		return r.createIndexes(ctx, collection)
+	})
+}
+
+// createIndexes ensures indexes exist for the holder data key collection.
+func (r *HolderDataKeyMongoDBRepository) createIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexModels := []mongo.IndexModel{
+		{
+			// Compound unique index on organization_id + holder_id: one key (or
+			// tombstone) per holder. tenant_id is omitted because a tombstone
+			// upserted by Destroy must collide with an existing key regardless
+			// of how its tenant was recorded.
+			Keys:    bson.D{{Key: "organization_id", Value: 1}, {Key: "holder_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+
+	return err
+}
+
+var _ HolderDataKeyRepository = (*HolderDataKeyMongoDBRepository)(nil)
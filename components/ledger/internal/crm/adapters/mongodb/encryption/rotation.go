@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// RotationMongoDBModel is the MongoDB representation of OrganizationKeyRotation.
+type RotationMongoDBModel struct {
+	TenantID       string                       `bson:"tenant_id,omitempty"`
+	OrganizationID string                       `bson:"organization_id"`
+	FromVersion    int                          `bson:"from_version"`
+	ToVersion      int                          `bson:"to_version"`
+	Status         mmodel.KeyRotationStatus     `bson:"status"`
+	Targets        []RotationTargetMongoDBModel `bson:"targets"`
+	Revision       int64                        `bson:"revision"`
+	LastErrorCode  string                       `bson:"last_error_code,omitempty"`
+	StartedBy      string                       `bson:"started_by"`
+	Reason         string                       `bson:"reason"`
+	StartedAt      time.Time                    `bson:"started_at"`
+	UpdatedAt      time.Time                    `bson:"updated_at"`
+	CompletedAt    *time.Time                   `bson:"completed_at,omitempty"`
+}
+
+// RotationTargetMongoDBModel is the MongoDB representation of KeyRotationTargetProgress.
+type RotationTargetMongoDBModel struct {
+	Name      string `bson:"name"`
+	Total     int64  `bson:"total"`
+	Processed int64  `bson:"processed"`
+	Skipped   int64  `bson:"skipped"`
+	Cursor    string `bson:"cursor,omitempty"`
+	Done      bool   `bson:"done"`
+}
+
+// RotationFromEntity converts a domain OrganizationKeyRotation to MongoDB model.
+func RotationFromEntity(r *mmodel.OrganizationKeyRotation) *RotationMongoDBModel {
+	if r == nil {
+		return nil
+	}
+
+	targets := make([]RotationTargetMongoDBModel, len(r.Targets))
+	for i, t := range r.Targets {
+		targets[i] = RotationTargetMongoDBModel(t)
+	}
+
+	return &RotationMongoDBModel{
+		TenantID:       r.TenantID,
+		OrganizationID: r.OrganizationID,
+		FromVersion:    r.FromVersion,
+		ToVersion:      r.ToVersion,
+		Status:         r.Status,
+		Targets:        targets,
+		Revision:       r.Revision,
+		LastErrorCode:  r.LastErrorCode,
+		StartedBy:      r.StartedBy,
+		Reason:         r.Reason,
+		StartedAt:      r.StartedAt,
+		UpdatedAt:      r.UpdatedAt,
+		CompletedAt:    r.CompletedAt,
+	}
+}
+
+// ToEntity converts the MongoDB model to a domain OrganizationKeyRotation.
+func (m *RotationMongoDBModel) ToEntity() *mmodel.OrganizationKeyRotation {
+	if m == nil {
+		return nil
+	}
+
+	targets := make([]mmodel.KeyRotationTargetProgress, len(m.Targets))
+	for i, t := range m.Targets {
+		targets[i] = mmodel.KeyRotationTargetProgress(t)
+	}
+
+	return &mmodel.OrganizationKeyRotation{
+		TenantID:       m.TenantID,
+		OrganizationID: m.OrganizationID,
+		FromVersion:    m.FromVersion,
+		ToVersion:      m.ToVersion,
+		Status:         m.Status,
+		Targets:        targets,
+		Revision:       m.Revision,
+		LastErrorCode:  m.LastErrorCode,
+		StartedBy:      m.StartedBy,
+		Reason:         m.Reason,
+		StartedAt:      m.StartedAt,
+		UpdatedAt:      m.UpdatedAt,
+		CompletedAt:    m.CompletedAt,
+	}
+}
+
+// StaleCiphertextCondition returns the query condition matching a protected
+// string field whose value was NOT written with the given keyset version: an
+// envelope marker of any other version, or unmarked legacy ciphertext. Missing,
+// null and empty values do not match. Repositories OR these conditions over
+// their protected fields to find the records a re-encryption still has to
+// rewrite (for array sub-documents, wrap the condition in $elemMatch).
+func StaleCiphertextCondition(version int) bson.D {
+	return bson.D{
+		{Key: "$type", Value: "string"},
+		{Key: "$ne", Value: ""},
+		{Key: "$not", Value: bson.Regex{Pattern: "^" + MarkerPrefixForVersion(version)}},
+	}
+}
+
+// MarkerPrefixForVersion returns the envelope marker prefix ("tink:v{version}:")
+// of values written with the given keyset version. It mirrors
+// encryption.FormatEnvelopeMarker, which this package cannot import.
+func MarkerPrefixForVersion(version int) string {
+	return "tink:v" + strconv.Itoa(version) + ":"
+}
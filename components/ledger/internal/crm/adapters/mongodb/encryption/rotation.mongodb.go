@@ -0,0 +1,269 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpenTelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const rotationCollection = "organization_key_rotation"
+
+// RotationRepository persists organization key rotations: one document per
+// (organization, target version) holding the rotation status and the
+// per-collection re-encryption progress, so an interrupted sweep resumes from
+// its cursors.
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=rotation.mongodb_mock.go --package=encryption . RotationRepository
+type RotationRepository interface {
+	// Save persists a new rotation. It is insert-only per target version and
+	// returns the in-progress sentinel when that version already has a rotation.
+	Save(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) error
+	// GetLatest returns the organization's most recent (highest target version)
+	// rotation, or the not-found sentinel when it was never rotated.
+	GetLatest(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error)
+	// Update replaces the rotation under optimistic concurrency: it matches on
+	// expectedRevision and returns the in-progress sentinel when the stored
+	// revision has moved on (another worker is driving the rotation).
+	Update(ctx context.Context, rotation *mmodel.OrganizationKeyRotation, expectedRevision int64) error
+}
+
+// RotationMongoDBRepository is a MongoDB-specific implementation of RotationRepository.
+type RotationMongoDBRepository struct {
+	connection *libMongo.Client
+}
+
+// NewRotationMongoDBRepository returns a new instance of RotationMongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewRotationMongoDBRepository(connection *libMongo.Client) (*RotationMongoDBRepository, error) {
+	r := &RotationMongoDBRepository{
+		connection: connection,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for key rotation repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *RotationMongoDBRepository) Save(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.key_rotation.save")
+	defer span.End()
+
+	if rotation == nil {
+		return fmt.Errorf("key rotation is required")
+	}
+
+	tenantID := extractTenantID(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", tenantID),
+		attribute.String("app.request.organization_id", rotation.OrganizationID),
+		attribute.Int("app.request.to_version", rotation.ToVersion),
+	)
+
+	// Ensure tenant_id is set on the rotation
+	if rotation.TenantID == "" {
+		rotation.TenantID = tenantID
+	}
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return err
+	}
+
+	if err := r.ensureIndexes(ctx, collection); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to create key rotation indexes", err)
+		return fmt.Errorf("create key rotation indexes: %w", err)
+	}
+
+	model := RotationFromEntity(rotation)
+
+	// Database isolation handles multi-tenancy - filter by organization_id and
+	// to_version so each rotation is an independent document.
+	filter := bson.M{"organization_id": rotation.OrganizationID, "to_version": rotation.ToVersion}
+	update := bson.M{"$setOnInsert": model}
+
+	result, err := collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to save key rotation", err)
+		return fmt.Errorf("save key rotation: %w", err)
+	}
+
+	if result.MatchedCount > 0 {
+		return mmodel.ErrKeyRotationInProgress
+	}
+
+	return nil
+}
+
+func (r *RotationMongoDBRepository) GetLatest(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.key_rotation.get_latest")
+	defer span.End()
+
+	tenantID := extractTenantID(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", tenantID),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return nil, err
+	}
+
+	var model RotationMongoDBModel
+
+	// Database isolation handles multi-tenancy - filter by organization_id, select
+	// the highest target version document (descending sort, single result).
+	filter := bson.M{"organization_id": organizationID}
+	opts := options.FindOne().SetSort(bson.D{{Key: "to_version", Value: -1}})
+
+	if err := collection.FindOne(ctx, filter, opts).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mmodel.ErrKeyRotationNotFound
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "Failed to get latest key rotation", err)
+
+		return nil, fmt.Errorf("get latest key rotation: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *RotationMongoDBRepository) Update(ctx context.Context, rotation *mmodel.OrganizationKeyRotation, expectedRevision int64) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.key_rotation.update")
+	defer span.End()
+
+	if rotation == nil {
+		return fmt.Errorf("key rotation is required")
+	}
+
+	tenantID := extractTenantID(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.request.tenant_id", tenantID),
+		attribute.String("app.request.organization_id", rotation.OrganizationID),
+		attribute.Int("app.request.to_version", rotation.ToVersion),
+		attribute.Int64("app.request.expected_revision", expectedRevision),
+	)
+
+	// Ensure tenant_id is set on the rotation
+	if rotation.TenantID == "" {
+		rotation.TenantID = tenantID
+	}
+
+	collection, err := r.collection(ctx)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get collection", err)
+		return err
+	}
+
+	// Create model from entity and set the new revision on the model, not on the input entity.
+	// This prevents mutation of the caller's object if the database operation fails.
+	model := RotationFromEntity(rotation)
+	model.Revision = expectedRevision + 1
+
+	filter := bson.M{
+		"organization_id": rotation.OrganizationID,
+		"to_version":      rotation.ToVersion,
+		"revision":        expectedRevision,
+	}
+
+	result, err := collection.ReplaceOne(ctx, filter, model)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to update key rotation", err)
+		return fmt.Errorf("update key rotation: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return mmodel.ErrKeyRotationInProgress
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", result.ModifiedCount))
+
+	return nil
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *RotationMongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+func (r *RotationMongoDBRepository) collection(ctx context.Context) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Collection(rotationCollection), nil
+}
+
+// ensureIndexes ensures indexes exist for the key rotation collection.
+// Uses per-database tracking to handle multi-tenant mode correctly.
+// Retries on failure — indexes are only marked as done after successful creation.
+func (r *RotationMongoDBRepository) ensureIndexes(ctx context.Context, collection *mongo.Collection) error {
+	key := collection.Database().Name() + ":" + rotationCollection
+
+	return globalIndexTracker.ensureOnce(key, func() error {
+		return r.createIndexes(ctx, collection)
+	})
+}
+
+// createIndexes ensures indexes exist for the key rotation collection.
+func (r *RotationMongoDBRepository) createIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexModels := []mongo.IndexModel{
+		{
+			// Compound unique index on tenant_id + organization_id + to_version:
+			// one rotation per target keyset version.
+			Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "organization_id", Value: 1}, {Key: "to_version", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+
+	return err
+}
+
+var _ RotationRepository = (*RotationMongoDBRepository)(nil)
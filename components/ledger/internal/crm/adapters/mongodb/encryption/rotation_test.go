@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestRotationFromEntity_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	now := fixedRegistryTime
+	completed := now.Add(time.Hour)
+
+	entity := &mmodel.OrganizationKeyRotation{
+		TenantID:       "tenant-a",
+		OrganizationID: "org-a",
+		FromVersion:    1,
+		ToVersion:      2,
+		Status:         mmodel.KeyRotationStatusCompleted,
+		Targets: []mmodel.KeyRotationTargetProgress{
+			{Name: "holders", Total: 4, Processed: 3, Skipped: 1, Cursor: "c", Done: true},
+		},
+		Revision:      5,
+		LastErrorCode: "reencryption_failed",
+		StartedBy:     "admin",
+		Reason:        "annual rotation",
+		StartedAt:     now,
+		UpdatedAt:     now,
+		CompletedAt:   &completed,
+	}
+
+	model := RotationFromEntity(entity)
+
+	require.NotNil(t, model)
+	assert.Equal(t, entity, model.ToEntity())
+}
+
+func TestRotationFromEntity_Nil(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, RotationFromEntity(nil))
+
+	var model *RotationMongoDBModel
+	assert.Nil(t, model.ToEntity())
+}
+
+func TestStaleCiphertextCondition(t *testing.T) {
+	t.Parallel()
+
+	condition := StaleCiphertextCondition(2)
+
+	require.Len(t, condition, 3)
+	assert.Equal(t, bson.E{Key: "$type", Value: "string"}, condition[0])
+	assert.Equal(t, bson.E{Key: "$ne", Value: ""}, condition[1])
+
+	regex, ok := condition[2].Value.(bson.Regex)
+	require.True(t, ok)
+
+	pattern := regexp.MustCompile(regex.Pattern)
+
+	// The condition is $not: a match on the pattern means the value is current.
+	assert.True(t, pattern.MatchString("tink:v2:AAAA"))
+	assert.False(t, pattern.MatchString("tink:v1:AAAA"))
+	assert.False(t, pattern.MatchString("tink:v21:AAAA"), "a longer version must not match as a prefix")
+	assert.False(t, pattern.MatchString("bGVnYWN5LWJ5dGVz"))
+}
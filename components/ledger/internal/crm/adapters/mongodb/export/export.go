@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package export
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// HolderExportMongoDBModel is a holder export document. ActiveHolderID is set
+// only while the export is PENDING or RUNNING; its unique index allows one
+// active export per holder. UpdatedAt is the last write of the export,
+// refreshed with every archive chunk stored, so an active export it dates far
+// back was left behind by a stopped process.
+type HolderExportMongoDBModel struct {
+	ID             uuid.UUID                      `bson:"_id"`
+	HolderID       uuid.UUID                      `bson:"holder_id"`
+	ActiveHolderID *uuid.UUID                     `bson:"active_holder_id,omitempty"`
+	Status         string                         `bson:"status"`
+	RequestedBy    string                         `bson:"requested_by"`
+	Counts         HolderExportCountsMongoDBModel `bson:"counts"`
+	ArchiveSize    int64                          `bson:"archive_size,omitempty"`
+	ArchiveSHA256  string                         `bson:"archive_sha256,omitempty"`
+	LastErrorCode  string                         `bson:"last_error_code,omitempty"`
+	CreatedAt      time.Time                      `bson:"created_at"`
+	UpdatedAt      time.Time                      `bson:"updated_at"`
+	CompletedAt    *time.Time                     `bson:"completed_at,omitempty"`
+	ExpiresAt      *time.Time                     `bson:"expires_at,omitempty"`
+}
+
+// HolderExportCountsMongoDBModel counts the records of an export archive.
+type HolderExportCountsMongoDBModel struct {
+	Instruments         int64 `bson:"instruments"`
+	RelatedPartyEntries int64 `bson:"related_party_entries"`
+	Accounts            int64 `bson:"accounts"`
+	Balances            int64 `bson:"balances"`
+	Transactions        int64 `bson:"transactions"`
+}
+
+// ArchiveChunkMongoDBModel is one encrypted chunk of an export archive. Chunks
+// are removed by a TTL index once the archive expires.
+type ArchiveChunkMongoDBModel struct {
+	ExportID  uuid.UUID `bson:"export_id"`
+	Seq       int       `bson:"seq"`
+	Data      string    `bson:"data"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// FromEntity maps a holder export entity to its MongoDB model.
+func (he *HolderExportMongoDBModel) FromEntity(e *mmodel.HolderExport) {
+	*he = HolderExportMongoDBModel{
+		ID:            e.ID,
+		HolderID:      e.HolderID,
+		Status:        e.Status,
+		RequestedBy:   e.RequestedBy,
+		Counts:        HolderExportCountsMongoDBModel(e.Counts),
+		ArchiveSize:   e.ArchiveSize,
+		ArchiveSHA256: e.ArchiveSHA256,
+		LastErrorCode: e.LastErrorCode,
+		CreatedAt:     e.CreatedAt,
+		CompletedAt:   e.CompletedAt,
+		ExpiresAt:     e.ExpiresAt,
+	}
+
+	if e.Active() {
+		holderID := e.HolderID
+		he.ActiveHolderID = &holderID
+	}
+}
+
+// ToEntity maps a holder export MongoDB model to its entity.
+func (he *HolderExportMongoDBModel) ToEntity() *mmodel.HolderExport {
+	return &mmodel.HolderExport{
+		ID:            he.ID,
+		HolderID:      he.HolderID,
+		Status:        he.Status,
+		RequestedBy:   he.RequestedBy,
+		Counts:        mmodel.HolderExportCounts(he.Counts),
+		ArchiveSize:   he.ArchiveSize,
+		ArchiveSHA256: he.ArchiveSHA256,
+		LastErrorCode: he.LastErrorCode,
+		CreatedAt:     he.CreatedAt,
+		CompletedAt:   he.CompletedAt,
+		ExpiresAt:     he.ExpiresAt,
+	}
+}
@@ -0,0 +1,584 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package export
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// archiveChunkSize bounds the plaintext bytes of one archive chunk, keeping the
+// encrypted chunk document well under the 16 MiB BSON limit.
+const archiveChunkSize = 2 << 20
+
+// staleExportAge is how long an active export may go without a write before
+// it is considered left behind by a stopped process. A running export writes
+// at least once per archive chunk.
+const staleExportAge = 30 * time.Minute
+
+// exportErrorInterrupted is the static error code of an export failed because
+// the process running it stopped.
+const exportErrorInterrupted = "interrupted"
+
+// Collection prefixes of the export data.
+const (
+	exportsPrefix = "holder_exports_"
+	chunksPrefix  = "holder_export_chunks_"
+)
+
+// collectionIndexes lists the indexes of each export collection: one active
+// export per holder and the holder's exports newest first; chunks in order,
+// removed once the archive expires.
+var collectionIndexes = map[string][]mongo.IndexModel{
+	exportsPrefix: {
+		{
+			Keys: bson.D{{Key: "active_holder_id", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "active_holder_id", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		},
+		{Keys: bson.D{{Key: "holder_id", Value: 1}, {Key: "_id", Value: -1}}},
+	},
+	chunksPrefix: {
+		{Keys: bson.D{{Key: "export_id", Value: 1}, {Key: "seq", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	},
+}
+
+// Repository persists holder data exports and their archives in
+// per-organization collections (holder_exports_{org} and
+// holder_export_chunks_{org}). Archives hold decrypted personal data, so they
+// are stored encrypted under the holder's data key and expire.
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=export.mongodb_mock.go --package=export . Repository
+type Repository interface {
+	// Create stores a new export. It returns ErrHolderExportInProgress when the
+	// holder already has an active export.
+	Create(ctx context.Context, organizationID string, export *mmodel.HolderExport) error
+	// Find returns an export of the holder, or ErrHolderExportNotFound.
+	Find(ctx context.Context, organizationID string, holderID, id uuid.UUID) (*mmodel.HolderExport, error)
+	// FindAll returns the exports of the holder, newest first.
+	FindAll(ctx context.Context, organizationID string, holderID uuid.UUID) ([]*mmodel.HolderExport, error)
+	// Update stores the status, counts and archive summary of an export.
+	Update(ctx context.Context, organizationID string, export *mmodel.HolderExport) error
+	// SaveArchive stores the archive written by write, chunk by chunk as it is
+	// produced, until export.ExpiresAt. A failed write removes the chunks
+	// already stored.
+	SaveArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport, write func(w io.Writer) error) error
+	// LoadArchive returns the archive of an export; it is empty once expired.
+	LoadArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport) ([]byte, error)
+}
+
+// MongoDBRepository is a MongoDB-specific implementation of Repository.
+type MongoDBRepository struct {
+	connection     *libMongo.Client
+	FieldEncryptor encryption.FieldEncryptor
+}
+
+// NewMongoDBRepository returns a new instance of MongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewMongoDBRepository(connection *libMongo.Client, fieldEncryptor encryption.FieldEncryptor) (*MongoDBRepository, error) {
+	if fieldEncryptor == nil {
+		return nil, fmt.Errorf("export repository requires a non-nil FieldEncryptor")
+	}
+
+	r := &MongoDBRepository{
+		connection:     connection,
+		FieldEncryptor: fieldEncryptor,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for export repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *MongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+// collection resolves a per-organization collection and ensures its indexes.
+// The first time the process opens an organization's exports, it also fails
+// the stale ones, so exports interrupted by a restart stop blocking their
+// holders before anything reads them.
+func (r *MongoDBRepository) collection(ctx context.Context, prefix, organizationID string) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(prefix + organizationID))
+
+	err = globalIndexTracker.ensureOnce(db.Name()+":"+coll.Name(), func() error {
+		if _, err := coll.Indexes().CreateMany(ctx, collectionIndexes[prefix]); err != nil {
+			return fmt.Errorf("create indexes: %w", err)
+		}
+
+		if prefix != exportsPrefix {
+			return nil
+		}
+
+		return failStaleExports(ctx, coll)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prepare %q: %w", coll.Name(), err)
+	}
+
+	return coll, nil
+}
+
+// failStaleExports marks FAILED the active exports not written for
+// staleExportAge, releasing their holders. Exports older than the schema's
+// updated_at fall back to their request time.
+func failStaleExports(ctx context.Context, coll *mongo.Collection) error {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.fail_stale_holder_exports")
+	defer span.End()
+
+	now := time.Now().UTC()
+	staleBefore := now.Add(-staleExportAge)
+
+	filter := bson.D{
+		{Key: "active_holder_id", Value: bson.D{{Key: "$exists", Value: true}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "updated_at", Value: bson.D{{Key: "$lt", Value: staleBefore}}}},
+			bson.D{
+				{Key: "updated_at", Value: bson.D{{Key: "$exists", Value: false}}},
+				{Key: "created_at", Value: bson.D{{Key: "$lt", Value: staleBefore}}},
+			},
+		}},
+	}
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: mmodel.HolderExportFailed},
+			{Key: "last_error_code", Value: exportErrorInterrupted},
+			{Key: "completed_at", Value: now},
+			{Key: "updated_at", Value: now},
+		}},
+		{Key: "$unset", Value: bson.D{{Key: "active_holder_id", Value: ""}}},
+	}
+
+	result, err := coll.UpdateMany(ctx, filter, update)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to fail stale holder exports", err)
+
+		return fmt.Errorf("fail stale exports: %w", err)
+	}
+
+	if result.ModifiedCount > 0 {
+		logger.Log(ctx, libLog.LevelWarn, "Interrupted holder exports marked failed",
+			libLog.String("collection", coll.Name()),
+			libLog.Any("exports", result.ModifiedCount))
+	}
+
+	return nil
+}
+
+// Create stores a new export. A duplicate active_holder_id means the holder
+// already has an active export, whose id is reported in the conflict.
+func (r *MongoDBRepository) Create(ctx context.Context, organizationID string, export *mmodel.HolderExport) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.create_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", export.HolderID.String()),
+	)
+
+	coll, err := r.collection(ctx, exportsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderExportMongoDBModel{}
+	record.FromEntity(export)
+	record.UpdatedAt = time.Now().UTC()
+
+	if _, err := coll.InsertOne(ctx, record); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			libOpentelemetry.HandleSpanError(span, "Failed to insert holder export", err)
+
+			return err
+		}
+
+		var active HolderExportMongoDBModel
+
+		if err := coll.FindOne(ctx, bson.D{{Key: "active_holder_id", Value: export.HolderID}}).Decode(&active); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to find active holder export", err)
+
+			return err
+		}
+
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderExportInProgress, cn.EntityHolderExport, active.ID.String())
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder export in progress", businessErr)
+
+		return businessErr
+	}
+
+	return nil
+}
+
+// Find returns an export of the holder, or ErrHolderExportNotFound.
+func (r *MongoDBRepository) Find(ctx context.Context, organizationID string, holderID, id uuid.UUID) (*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.export_id", id.String()),
+	)
+
+	coll, err := r.collection(ctx, exportsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	var record HolderExportMongoDBModel
+
+	if err := coll.FindOne(ctx, bson.D{{Key: "_id", Value: id}, {Key: "holder_id", Value: holderID}}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			businessErr := pkg.ValidateBusinessError(cn.ErrHolderExportNotFound, cn.EntityHolderExport)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder export not found", businessErr)
+
+			return nil, businessErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder export", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity(), nil
+}
+
+// FindAll returns the exports of the holder. Export ids are UUIDv7, so
+// descending _id order is newest first.
+func (r *MongoDBRepository) FindAll(ctx context.Context, organizationID string, holderID uuid.UUID) ([]*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_exports")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	coll, err := r.collection(ctx, exportsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{{Key: "holder_id", Value: holderID}}, options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder exports", err)
+
+		return nil, err
+	}
+
+	var records []HolderExportMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holder exports", err)
+
+		return nil, err
+	}
+
+	exports := make([]*mmodel.HolderExport, 0, len(records))
+	for i := range records {
+		exports = append(exports, records[i].ToEntity())
+	}
+
+	return exports, nil
+}
+
+// Update replaces the export document. Leaving the active statuses unsets
+// active_holder_id, so the holder can request a new export.
+func (r *MongoDBRepository) Update(ctx context.Context, organizationID string, export *mmodel.HolderExport) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", export.HolderID.String()),
+		attribute.String("app.request.export_id", export.ID.String()),
+		attribute.String("app.request.export_status", export.Status),
+	)
+
+	coll, err := r.collection(ctx, exportsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderExportMongoDBModel{}
+	record.FromEntity(export)
+	record.UpdatedAt = time.Now().UTC()
+
+	result, err := coll.ReplaceOne(ctx, bson.D{{Key: "_id", Value: export.ID}, {Key: "holder_id", Value: export.HolderID}}, record)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update holder export", err)
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderExportNotFound, cn.EntityHolderExport)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder export not found", businessErr)
+
+		return businessErr
+	}
+
+	return nil
+}
+
+// SaveArchive streams the archive written by write into chunks, each
+// encrypted under the holder's data key and bound to the export and its
+// position, and stores them with the archive's expiry as they fill, so at
+// most one chunk is held in memory. Erasing the holder therefore also makes
+// its archives unreadable. The chunks of a failed write are removed.
+func (r *MongoDBRepository) SaveArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport, write func(w io.Writer) error) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.save_holder_export_archive")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.export_id", export.ID.String()),
+	)
+
+	if export.ExpiresAt == nil {
+		err := fmt.Errorf("export %s has no expiry", export.ID)
+		libOpentelemetry.HandleSpanError(span, "Archive without expiry", err)
+
+		return err
+	}
+
+	exports, err := r.collection(ctx, exportsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	chunks, err := r.collection(ctx, chunksPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	w := &archiveWriter{
+		ctx: ctx, repo: r, exports: exports, chunks: chunks, organizationID: organizationID, export: export,
+		buf: make([]byte, 0, archiveChunkSize),
+	}
+
+	err = write(w)
+	if err == nil && (len(w.buf) > 0 || w.seq == 0) {
+		err = w.flush()
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to store archive", err)
+
+		if _, errDel := chunks.DeleteMany(ctx, bson.D{{Key: "export_id", Value: export.ID}}); errDel != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to remove partial archive", errDel)
+		}
+
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("app.request.archive_chunks", w.seq))
+
+	return nil
+}
+
+// archiveWriter buffers an archive into chunks and stores each one as soon as
+// it fills, refreshing the export's updated_at so a long export is not taken
+// for an interrupted one.
+type archiveWriter struct {
+	ctx            context.Context
+	repo           *MongoDBRepository
+	exports        *mongo.Collection
+	chunks         *mongo.Collection
+	organizationID string
+	export         *mmodel.HolderExport
+	buf            []byte
+	seq            int
+}
+
+// Write buffers p, storing every chunk it fills.
+func (w *archiveWriter) Write(p []byte) (int, error) {
+	written := 0
+
+	for len(p) > 0 {
+		n := min(archiveChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == archiveChunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// flush encrypts and stores the buffered chunk at the next position.
+func (w *archiveWriter) flush() error {
+	data, err := w.repo.FieldEncryptor.EncryptField(w.ctx, w.repo.chunkContext(w.ctx, w.organizationID, w.export, w.seq),
+		base64.StdEncoding.EncodeToString(w.buf))
+	if err != nil {
+		return fmt.Errorf("encrypt archive chunk %d: %w", w.seq, err)
+	}
+
+	record := ArchiveChunkMongoDBModel{ExportID: w.export.ID, Seq: w.seq, Data: data, ExpiresAt: *w.export.ExpiresAt}
+
+	if _, err := w.chunks.ReplaceOne(w.ctx, bson.D{{Key: "export_id", Value: w.export.ID}, {Key: "seq", Value: w.seq}}, record,
+		options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("store archive chunk %d: %w", w.seq, err)
+	}
+
+	if _, err := w.exports.UpdateOne(w.ctx, bson.D{{Key: "_id", Value: w.export.ID}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now().UTC()}}}}); err != nil {
+		return fmt.Errorf("refresh export: %w", err)
+	}
+
+	w.seq++
+	w.buf = w.buf[:0]
+
+	return nil
+}
+
+// LoadArchive reads and decrypts the chunks of an export archive in order.
+func (r *MongoDBRepository) LoadArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport) ([]byte, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.load_holder_export_archive")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.export_id", export.ID.String()),
+	)
+
+	coll, err := r.collection(ctx, chunksPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{{Key: "export_id", Value: export.ID}}, options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find archive chunks", err)
+
+		return nil, err
+	}
+
+	var records []ArchiveChunkMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode archive chunks", err)
+
+		return nil, err
+	}
+
+	archive := make([]byte, 0, export.ArchiveSize)
+
+	for i := range records {
+		encoded, err := r.FieldEncryptor.DecryptField(ctx, r.chunkContext(ctx, organizationID, export, records[i].Seq), records[i].Data)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decrypt archive chunk", err)
+
+			return nil, err
+		}
+
+		chunk, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to decode archive chunk", err)
+
+			return nil, err
+		}
+
+		archive = append(archive, chunk...)
+	}
+
+	return archive, nil
+}
+
+// chunkContext is the encryption context of an archive chunk: the holder's
+// data key, with the export id and chunk position bound into the AAD.
+func (r *MongoDBRepository) chunkContext(ctx context.Context, organizationID string, export *mmodel.HolderExport, seq int) encryption.FieldContext {
+	return encryption.FieldContext{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		RecordID:       export.ID.String(),
+		FieldName:      "archive." + strconv.Itoa(seq),
+		HolderID:       export.HolderID.String(),
+	}
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/export (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=export.mongodb_mock.go --package=export . Repository
+//
+
+// Package export is a generated GoMock package.
+package export
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, organizationID string, export *mmodel.HolderExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organizationID, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, organizationID, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, organizationID, export)
+}
+
+// Find mocks base method.
+func (m *MockRepository) Find(ctx context.Context, organizationID string, holderID, id uuid.UUID) (*mmodel.HolderExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, organizationID, holderID, id)
+	ret0, _ := ret[0].(*mmodel.HolderExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRepositoryMockRecorder) Find(ctx, organizationID, holderID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRepository)(nil).Find), ctx, organizationID, holderID, id)
+}
+
+// FindAll mocks base method.
+func (m *MockRepository) FindAll(ctx context.Context, organizationID string, holderID uuid.UUID) ([]*mmodel.HolderExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, organizationID, holderID)
+	ret0, _ := ret[0].([]*mmodel.HolderExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRepositoryMockRecorder) FindAll(ctx, organizationID, holderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), ctx, organizationID, holderID)
+}
+
+// LoadArchive mocks base method.
+func (m *MockRepository) LoadArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadArchive", ctx, organizationID, export)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadArchive indicates an expected call of LoadArchive.
+func (mr *MockRepositoryMockRecorder) LoadArchive(ctx, organizationID, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadArchive", reflect.TypeOf((*MockRepository)(nil).LoadArchive), ctx, organizationID, export)
+}
+
+// SaveArchive mocks base method.
+func (m *MockRepository) SaveArchive(ctx context.Context, organizationID string, export *mmodel.HolderExport, write func(io.Writer) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveArchive", ctx, organizationID, export, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveArchive indicates an expected call of SaveArchive.
+func (mr *MockRepositoryMockRecorder) SaveArchive(ctx, organizationID, export, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveArchive", reflect.TypeOf((*MockRepository)(nil).SaveArchive), ctx, organizationID, export, write)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, organizationID string, export *mmodel.HolderExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, organizationID, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, organizationID, export any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, organizationID, export)
+}
@@ -6,6 +6,7 @@ package holder
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
@@ -45,6 +46,10 @@ type MongoDBModel struct {
 	CreatedAt        *time.Time                 `bson:"created_at,omitempty"`
 	UpdatedAt        *time.Time                 `bson:"updated_at"`
 	DeletedAt        *time.Time                 `bson:"deleted_at"`
+	ErasedAt         *time.Time                 `bson:"erased_at,omitempty"`
+	KYC              *KYCMongoDBModel           `bson:"kyc,omitempty"`
+	MergedInto       *uuid.UUID                 `bson:"merged_into,omitempty"`
+	MergedAt         *time.Time                 `bson:"merged_at,omitempty"`
 }
 
 type AddressesMongoDBModel struct {
@@ -107,6 +112,7 @@ func (hmm *MongoDBModel) FromEntity(ctx context.Context, h *mmodel.Holder, fe en
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	*hmm = MongoDBModel{
@@ -116,6 +122,9 @@ func (hmm *MongoDBModel) FromEntity(ctx context.Context, h *mmodel.Holder, fe en
 		CreatedAt:  &h.CreatedAt,
 		UpdatedAt:  &h.UpdatedAt,
 		DeletedAt:  h.DeletedAt,
+		KYC:        mapKYCFromEntity(h.KYC),
+		MergedInto: h.MergedInto,
+		MergedAt:   h.MergedAt,
 	}
 
 	if h.Name != nil {
@@ -171,25 +180,23 @@ func (hmm *MongoDBModel) FromEntity(ctx context.Context, h *mmodel.Holder, fe en
 		hmm.LegalPerson = legalPerson
 	}
 
-	// Generate search token for document field
+	// Generate search tokens for the document and the duplicate-detection contact fields
 	hmm.Search = make(map[string]string)
 
-	if h.Document != nil && *h.Document != "" {
+	for _, field := range searchFields(h) {
 		searchCtx := encryption.SearchTokenContext{
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
-			FieldName:      "document",
+			FieldName:      field.name,
 		}
 
-		searchToken, keyVersion, tokenErr := fe.GenerateSearchToken(ctx, searchCtx, *h.Document)
+		searchToken, keyVersion, tokenErr := fe.GenerateSearchToken(ctx, searchCtx, field.value)
 		if tokenErr != nil {
 			return tokenErr
 		}
 
-		hmm.Search["document"] = searchToken
-		// Holder has a single search field, so the version is assigned directly here;
-		// alias has multiple search fields and uses a first-non-zero-wins helper instead.
-		hmm.SearchKeyVersion = keyVersion
+		hmm.Search[field.key] = searchToken
+		hmm.stampSearchKeyVersion(keyVersion)
 	}
 
 	if h.Metadata == nil {
@@ -201,6 +208,75 @@ func (hmm *MongoDBModel) FromEntity(ctx context.Context, h *mmodel.Holder, fe en
 	return nil
 }
 
+// searchField is a holder value indexed by a blind-index search token: key is
+// the entry in the search map, name the token's field name.
+type searchField struct {
+	key   string
+	name  string
+	value string
+}
+
+// searchFields lists the holder's blind-indexed values: the document as
+// written, and the primary email and mobile phone normalized for duplicate
+// detection. Empty values are skipped.
+func searchFields(h *mmodel.Holder) []searchField {
+	fields := make([]searchField, 0, 3)
+
+	if h.Document != nil && *h.Document != "" {
+		fields = append(fields, searchField{key: "document", name: "document", value: *h.Document})
+	}
+
+	if h.Contact != nil {
+		if email := NormalizeEmail(h.Contact.PrimaryEmail); email != "" {
+			fields = append(fields, searchField{key: "email", name: "contact.primary_email", value: email})
+		}
+
+		if phone := NormalizePhone(h.Contact.MobilePhone); phone != "" {
+			fields = append(fields, searchField{key: "phone", name: "contact.mobile_phone", value: phone})
+		}
+	}
+
+	return fields
+}
+
+// NormalizeEmail trims and lowercases an email address so differently typed
+// copies of the same address share one search token.
+func NormalizeEmail(email *string) string {
+	if email == nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(*email))
+}
+
+// NormalizePhone keeps the digits of a phone number, so "+55 (11) 99999-0000"
+// and "5511999990000" share one search token.
+func NormalizePhone(phone *string) string {
+	if phone == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	for _, r := range *phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// stampSearchKeyVersion records the PRF keyset primary version used for the
+// holder's search tokens. All tokens share one version (same org primary), so
+// the first non-zero version observed is kept; a legacy write (version 0)
+// leaves it unset.
+func (hmm *MongoDBModel) stampSearchKeyVersion(keyVersion uint32) {
+	if hmm.SearchKeyVersion == 0 {
+		hmm.SearchKeyVersion = keyVersion
+	}
+}
+
 // mapAddressesFromEntity maps addresses entity to MongoDB model
 func mapAddressesFromEntity(a *mmodel.Addresses) *AddressesMongoDBModel {
 	return &AddressesMongoDBModel{
@@ -216,6 +292,7 @@ func mapContactFromEntity(ctx context.Context, fe encryption.FieldEncryptor, enc
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	contact := &ContactMongoDBModel{}
@@ -273,6 +350,7 @@ func mapNaturalPersonFromEntity(ctx context.Context, fe encryption.FieldEncrypto
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	result := &NaturalPersonMongoDBModel{
@@ -337,6 +415,7 @@ func mapLegalPersonFromEntity(ctx context.Context, fe encryption.FieldEncryptor,
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 		}
 
 		rep := &RepresentativeMongoDBModel{
@@ -406,6 +485,7 @@ func (hmm *MongoDBModel) ToEntity(ctx context.Context, fe encryption.FieldEncryp
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	holder := &mmodel.Holder{
@@ -416,6 +496,10 @@ func (hmm *MongoDBModel) ToEntity(ctx context.Context, fe encryption.FieldEncryp
 		CreatedAt:  utils.SafeTimePtr(hmm.CreatedAt),
 		UpdatedAt:  utils.SafeTimePtr(hmm.UpdatedAt),
 		DeletedAt:  hmm.DeletedAt,
+		ErasedAt:   hmm.ErasedAt,
+		KYC:        mapKYCToEntity(hmm.KYC),
+		MergedInto: hmm.MergedInto,
+		MergedAt:   hmm.MergedAt,
 	}
 
 	if hmm.Name != nil {
@@ -489,6 +573,7 @@ func mapContactToEntity(ctx context.Context, fe encryption.FieldEncryptor, encry
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	contact := &mmodel.Contact{}
@@ -546,6 +631,7 @@ func mapNaturalPersonToEntity(ctx context.Context, fe encryption.FieldEncryptor,
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	result := &mmodel.NaturalPerson{
@@ -619,6 +705,7 @@ func mapRepresentativeToEntity(ctx context.Context, fe encryption.FieldEncryptor
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	result := &mmodel.Representative{
@@ -39,6 +39,12 @@ type Repository interface {
 	FindAll(ctx context.Context, collection string, filter http.QueryHeader, includeDeleted bool) ([]*mmodel.Holder, error)
 	Update(ctx context.Context, collection string, id uuid.UUID, input *mmodel.Holder, fieldsToRemove []string) (*mmodel.Holder, error)
 	Delete(ctx context.Context, collection string, id uuid.UUID, hardDelete bool) error
+	Erase(ctx context.Context, organizationID string, id uuid.UUID, erasedAt time.Time) (time.Time, error)
+	UpdateKYC(ctx context.Context, organizationID string, id uuid.UUID, kyc *mmodel.HolderKYC, expectedRevision int64) error
+	FindKYCByIDs(ctx context.Context, organizationID string, ids []uuid.UUID) (map[uuid.UUID]*mmodel.HolderKYC, error)
+	FindKYCReviewsDue(ctx context.Context, organizationID string, dueBefore time.Time, query http.QueryHeader) ([]*mmodel.KYCReviewDue, error)
+	FindDuplicateCandidates(ctx context.Context, organizationID string, holder *mmodel.Holder, limit int) ([]*mmodel.Holder, error)
+	MarkMerged(ctx context.Context, organizationID string, id, survivorID uuid.UUID, mergedAt time.Time) error
 }
 
 // MongoDBRepository is a MongoDB-specific implementation of Repository
@@ -124,6 +130,7 @@ func (hm *MongoDBRepository) Create(ctx context.Context, organizationID string,
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       holder.ID.String(),
+		HolderID:       holder.ID.String(),
 	}
 
 	record := &MongoDBModel{}
@@ -211,6 +218,14 @@ func (hm *MongoDBRepository) Find(ctx context.Context, organizationID string, id
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			businessErr := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+
+			// A holder merged away as a duplicate is reported with its survivor.
+			if !includeDeleted {
+				if survivorID, mergedErr := findMergedInto(ctx, coll, id); mergedErr == nil && survivorID != nil {
+					businessErr = pkg.ValidateBusinessError(cn.ErrHolderMerged, cn.EntityHolder, id, *survivorID)
+				}
+			}
+
 			libOpentelemetry.HandleSpanBusinessErrorEvent(spanFind, "Holder not found", businessErr)
 
 			return nil, businessErr
@@ -226,6 +241,7 @@ func (hm *MongoDBRepository) Find(ctx context.Context, organizationID string, id
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       id.String(),
+		HolderID:       id.String(),
 	}
 
 	result, err := record.ToEntity(ctx, hm.FieldEncryptor, encryptionCtx)
@@ -273,6 +289,7 @@ func (hm *MongoDBRepository) Update(ctx context.Context, organizationID string,
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       id.String(),
+		HolderID:       id.String(),
 	}
 
 	holderToUpdate := &MongoDBModel{}
@@ -299,7 +316,7 @@ func (hm *MongoDBRepository) Update(ctx context.Context, organizationID string,
 		return nil, err
 	}
 
-	update := mongoUtils.BuildDocumentToPatch(updateDocument, fieldsToRemove)
+	update := mongoUtils.BuildDocumentToPatch(updateDocument, withSearchTokensToRemove(fieldsToRemove))
 
 	updateResult, err := coll.UpdateByID(ctx, id, update)
 	if err != nil {
@@ -430,3 +447,27 @@ func repositoryInputAttributes(m *MongoDBModel) []attribute.KeyValue {
 		attribute.Bool("app.request.repository_input.has_legal_person", m.LegalPerson != nil),
 	}
 }
+
+// withSearchTokensToRemove adds to fieldsToRemove the search tokens of the
+// removed contact fields, so a removed email or phone no longer matches
+// duplicate candidates.
+func withSearchTokensToRemove(fieldsToRemove []string) []string {
+	tokens := make([]string, 0, 2)
+
+	for _, field := range fieldsToRemove {
+		switch field {
+		case "contact":
+			tokens = append(tokens, "search.email", "search.phone")
+		case "contact.primaryEmail":
+			tokens = append(tokens, "search.email")
+		case "contact.mobilePhone":
+			tokens = append(tokens, "search.phone")
+		}
+	}
+
+	if len(tokens) == 0 {
+		return fieldsToRemove
+	}
+
+	return append(append(make([]string, 0, len(fieldsToRemove)+len(tokens)), fieldsToRemove...), tokens...)
+}
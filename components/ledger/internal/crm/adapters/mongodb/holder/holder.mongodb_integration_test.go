@@ -322,6 +322,63 @@ func TestIntegration_HolderRepo_Find_IncludesDeleted(t *testing.T) {
 	assert.NotNil(t, result.DeletedAt, "deleted_at should be set")
 }
 
+// ============================================================================
+// Duplicate Merge Tests
+// ============================================================================
+
+func TestIntegration_HolderRepo_FindDuplicateCandidates_AndMarkMerged(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-merge-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+
+	survivor := mongotestutil.CreateTestHolderWithContact(t, "Merge Survivor", "12312312300")
+	survivor.Contact.PrimaryEmail = testutils.Ptr("merge.user@example.com")
+	_, err := repo.Create(ctx, organizationID, survivor)
+	require.NoError(t, err)
+
+	duplicate := mongotestutil.CreateTestHolderWithContact(t, "Merge Duplicate", "32132132100")
+	duplicate.Contact.PrimaryEmail = testutils.Ptr(" Merge.User@Example.com")
+	_, err = repo.Create(ctx, organizationID, duplicate)
+	require.NoError(t, err)
+
+	unrelated := mongotestutil.CreateTestHolderSimple(t, "Unrelated User", "45645645600")
+	_, err = repo.Create(ctx, organizationID, unrelated)
+	require.NoError(t, err)
+
+	// Act
+	candidates, err := repo.FindDuplicateCandidates(ctx, organizationID, survivor, 10)
+
+	// Assert: the email is matched case-insensitively, and only the duplicate shares it.
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, *duplicate.ID, *candidates[0].ID)
+	assert.Equal(t, "Merge Duplicate", *candidates[0].Name, "candidates are decrypted")
+
+	// Act: tombstone the duplicate.
+	require.NoError(t, repo.MarkMerged(ctx, organizationID, *duplicate.ID, *survivor.ID, time.Now().UTC()))
+
+	// Assert: reads of the duplicate report the survivor, and the tombstone
+	// keeps the merge.
+	_, err = repo.Find(ctx, organizationID, *duplicate.ID, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), survivor.ID.String())
+
+	tombstone, err := repo.Find(ctx, organizationID, *duplicate.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, tombstone.MergedInto)
+	assert.Equal(t, *survivor.ID, *tombstone.MergedInto)
+	assert.NotNil(t, tombstone.DeletedAt)
+
+	candidates, err = repo.FindDuplicateCandidates(ctx, organizationID, survivor, 10)
+	require.NoError(t, err)
+	assert.Empty(t, candidates, "merged holders are no longer candidates")
+
+	err = repo.MarkMerged(ctx, organizationID, *duplicate.ID, *survivor.ID, time.Now().UTC())
+	assert.Error(t, err, "a merged holder cannot be merged again")
+}
+
 // ============================================================================
 // FindAll Tests
 // ============================================================================
@@ -918,7 +975,7 @@ func TestHolderRepository_Create_ConcurrentBurst_SingleIndexBuild(t *testing.T)
 	var indexes []bson.M
 	require.NoError(t, cursor.All(context.Background(), &indexes))
 
-	// indexModels() defines 5 indexes; MongoDB adds the implicit _id_ index, for 6 total.
+	// indexModels() defines 8 indexes; MongoDB adds the implicit _id_ index, for 9 total.
 	assert.Len(t, indexes, len(indexModels())+1,
-		"collection should have the 5 modeled indexes plus the implicit _id_ index")
+		"collection should have the 6 modeled indexes plus the implicit _id_ index")
 }
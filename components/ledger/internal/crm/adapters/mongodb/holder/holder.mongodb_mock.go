@@ -12,6 +12,7 @@ package holder
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
 	http "github.com/LerianStudio/midaz/v4/pkg/net/http"
@@ -71,6 +72,21 @@ func (mr *MockRepositoryMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), arg0, arg1, arg2, arg3)
 }
 
+// Erase mocks base method.
+func (m *MockRepository) Erase(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Erase", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Erase indicates an expected call of Erase.
+func (mr *MockRepositoryMockRecorder) Erase(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Erase", reflect.TypeOf((*MockRepository)(nil).Erase), arg0, arg1, arg2, arg3)
+}
+
 // Find mocks base method.
 func (m *MockRepository) Find(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 bool) (*mmodel.Holder, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +117,65 @@ func (mr *MockRepositoryMockRecorder) FindAll(arg0, arg1, arg2, arg3 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), arg0, arg1, arg2, arg3)
 }
 
+// FindDuplicateCandidates mocks base method.
+func (m *MockRepository) FindDuplicateCandidates(arg0 context.Context, arg1 string, arg2 *mmodel.Holder, arg3 int) ([]*mmodel.Holder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateCandidates", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*mmodel.Holder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateCandidates indicates an expected call of FindDuplicateCandidates.
+func (mr *MockRepositoryMockRecorder) FindDuplicateCandidates(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateCandidates", reflect.TypeOf((*MockRepository)(nil).FindDuplicateCandidates), arg0, arg1, arg2, arg3)
+}
+
+// FindKYCByIDs mocks base method.
+func (m *MockRepository) FindKYCByIDs(arg0 context.Context, arg1 string, arg2 []uuid.UUID) (map[uuid.UUID]*mmodel.HolderKYC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKYCByIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[uuid.UUID]*mmodel.HolderKYC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKYCByIDs indicates an expected call of FindKYCByIDs.
+func (mr *MockRepositoryMockRecorder) FindKYCByIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKYCByIDs", reflect.TypeOf((*MockRepository)(nil).FindKYCByIDs), arg0, arg1, arg2)
+}
+
+// FindKYCReviewsDue mocks base method.
+func (m *MockRepository) FindKYCReviewsDue(arg0 context.Context, arg1 string, arg2 time.Time, arg3 http.QueryHeader) ([]*mmodel.KYCReviewDue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKYCReviewsDue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*mmodel.KYCReviewDue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKYCReviewsDue indicates an expected call of FindKYCReviewsDue.
+func (mr *MockRepositoryMockRecorder) FindKYCReviewsDue(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKYCReviewsDue", reflect.TypeOf((*MockRepository)(nil).FindKYCReviewsDue), arg0, arg1, arg2, arg3)
+}
+
+// MarkMerged mocks base method.
+func (m *MockRepository) MarkMerged(arg0 context.Context, arg1 string, arg2, arg3 uuid.UUID, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMerged", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkMerged indicates an expected call of MarkMerged.
+func (mr *MockRepositoryMockRecorder) MarkMerged(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMerged", reflect.TypeOf((*MockRepository)(nil).MarkMerged), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 *mmodel.Holder, arg4 []string) (*mmodel.Holder, error) {
 	m.ctrl.T.Helper()
@@ -115,3 +190,17 @@ func (mr *MockRepositoryMockRecorder) Update(arg0, arg1, arg2, arg3, arg4 any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), arg0, arg1, arg2, arg3, arg4)
 }
+
+// UpdateKYC mocks base method.
+func (m *MockRepository) UpdateKYC(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 *mmodel.HolderKYC, arg4 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKYC", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKYC indicates an expected call of UpdateKYC.
+func (mr *MockRepositoryMockRecorder) UpdateKYC(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKYC", reflect.TypeOf((*MockRepository)(nil).UpdateKYC), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// erasedFields lists the holder fields removed by an erasure: every field that
+// carries personal data, plaintext ones (addresses, birth data) included, and
+// the blind-index search tokens. The id, external id, type and timestamps are
+// kept so ledger accounts and instruments keep a valid owner reference.
+var erasedFields = bson.A{
+	"name",
+	"document",
+	"addresses",
+	"contact",
+	"natural_person",
+	"legal_person",
+	"search",
+	"search_key_version",
+}
+
+// erasurePipeline builds the update pipeline shared by holder erasures: it
+// stamps erased_at and deleted_at only when unset, so repeating an erasure keeps
+// the original timestamps, clears the metadata and removes erasedFields.
+func erasurePipeline(erasedAt time.Time) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "erased_at", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$erased_at", erasedAt}}}},
+			{Key: "deleted_at", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$deleted_at", erasedAt}}}},
+			{Key: "updated_at", Value: erasedAt},
+			{Key: "metadata", Value: bson.D{{Key: "$literal", Value: bson.D{}}}},
+		}}},
+		{{Key: "$unset", Value: erasedFields}},
+	}
+}
+
+// Erase removes the holder's personal data and search tokens in place and
+// soft-deletes it, soft-deleted holders included. It is idempotent: erasing an
+// erased holder succeeds and keeps the first erasure's timestamps. It returns
+// the stored erasure time, or ErrHolderNotFound when the holder does not exist.
+func (hm *MongoDBRepository) Erase(ctx context.Context, organizationID string, id uuid.UUID, erasedAt time.Time) (time.Time, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.erase_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return time.Time{}, err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.D{{Key: "erased_at", Value: 1}})
+
+	var record MongoDBModel
+
+	err = coll.FindOneAndUpdate(ctx, bson.D{{Key: "_id", Value: id}}, erasurePipeline(erasedAt), opts).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			businessErr := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder not found", businessErr)
+
+			return time.Time{}, businessErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to erase holder", err)
+
+		return time.Time{}, err
+	}
+
+	if record.ErasedAt == nil {
+		return erasedAt, nil
+	}
+
+	return record.ErasedAt.UTC(), nil
+}
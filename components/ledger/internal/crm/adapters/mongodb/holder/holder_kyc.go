@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// KYCMongoDBModel is the KYC verification state embedded in a holder document.
+// It holds no personal data: evidence is stored by reference only, so it is
+// kept in plaintext and survives an erasure of the holder.
+type KYCMongoDBModel struct {
+	Status        string                      `bson:"status"`
+	Level         *string                     `bson:"level,omitempty"`
+	Documents     []KYCDocumentMongoDBModel   `bson:"documents"`
+	VerifiedLevel *string                     `bson:"verified_level,omitempty"`
+	VerifiedAt    *time.Time                  `bson:"verified_at,omitempty"`
+	ExpiresAt     *time.Time                  `bson:"expires_at,omitempty"`
+	History       []KYCTransitionMongoDBModel `bson:"history"`
+	Revision      int64                       `bson:"revision"`
+	UpdatedAt     *time.Time                  `bson:"updated_at,omitempty"`
+}
+
+type KYCDocumentMongoDBModel struct {
+	Type        string    `bson:"type"`
+	Reference   string    `bson:"reference"`
+	SubmittedBy string    `bson:"submitted_by"`
+	SubmittedAt time.Time `bson:"submitted_at"`
+}
+
+type KYCTransitionMongoDBModel struct {
+	From      string    `bson:"from"`
+	To        string    `bson:"to"`
+	Level     string    `bson:"level,omitempty"`
+	Actor     string    `bson:"actor"`
+	Reason    string    `bson:"reason,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// mapKYCFromEntity maps a KYC entity to its MongoDB model; nil maps to nil.
+func mapKYCFromEntity(k *mmodel.HolderKYC) *KYCMongoDBModel {
+	if k == nil {
+		return nil
+	}
+
+	documents := make([]KYCDocumentMongoDBModel, 0, len(k.Documents))
+	for _, d := range k.Documents {
+		documents = append(documents, KYCDocumentMongoDBModel(d))
+	}
+
+	history := make([]KYCTransitionMongoDBModel, 0, len(k.History))
+	for _, t := range k.History {
+		history = append(history, KYCTransitionMongoDBModel(t))
+	}
+
+	return &KYCMongoDBModel{
+		Status:        k.Status,
+		Level:         k.Level,
+		Documents:     documents,
+		VerifiedLevel: k.VerifiedLevel,
+		VerifiedAt:    k.VerifiedAt,
+		ExpiresAt:     k.ExpiresAt,
+		History:       history,
+		Revision:      k.Revision,
+		UpdatedAt:     k.UpdatedAt,
+	}
+}
+
+// mapKYCToEntity maps a KYC MongoDB model to its entity; nil maps to nil.
+func mapKYCToEntity(k *KYCMongoDBModel) *mmodel.HolderKYC {
+	if k == nil {
+		return nil
+	}
+
+	documents := make([]mmodel.KYCDocument, 0, len(k.Documents))
+	for _, d := range k.Documents {
+		documents = append(documents, mmodel.KYCDocument(d))
+	}
+
+	history := make([]mmodel.KYCTransition, 0, len(k.History))
+	for _, t := range k.History {
+		history = append(history, mmodel.KYCTransition(t))
+	}
+
+	return &mmodel.HolderKYC{
+		Status:        k.Status,
+		Level:         k.Level,
+		Documents:     documents,
+		VerifiedLevel: k.VerifiedLevel,
+		VerifiedAt:    k.VerifiedAt,
+		ExpiresAt:     k.ExpiresAt,
+		History:       history,
+		Revision:      k.Revision,
+		UpdatedAt:     k.UpdatedAt,
+	}
+}
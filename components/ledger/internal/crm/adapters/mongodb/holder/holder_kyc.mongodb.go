@@ -0,0 +1,246 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UpdateKYC stores the KYC state of an active holder, provided the stored state
+// is still at expectedRevision (0 for a holder without KYC state). It also
+// bumps the holder's updated_at, which guards the re-encryption sweep's
+// rewrite against a concurrent KYC change. It returns ErrHolderNotFound when
+// the holder does not exist or is deleted, and ErrKYCRevisionConflict when the
+// state was changed concurrently.
+func (hm *MongoDBRepository) UpdateKYC(ctx context.Context, organizationID string, id uuid.UUID, kyc *mmodel.HolderKYC, expectedRevision int64) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_holder_kyc")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.Int64("app.request.kyc_expected_revision", expectedRevision),
+	)
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	active := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	filter := append(bson.D{}, active...)
+	if expectedRevision == 0 {
+		filter = append(filter, bson.E{Key: "kyc", Value: bson.D{{Key: "$exists", Value: false}}})
+	} else {
+		filter = append(filter, bson.E{Key: "kyc.revision", Value: expectedRevision})
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "kyc", Value: mapKYCFromEntity(kyc)},
+		{Key: "updated_at", Value: kyc.UpdatedAt},
+	}}}
+
+	result, err := coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update holder KYC", err)
+
+		return err
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	count, err := coll.CountDocuments(ctx, active)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to check holder existence", err)
+
+		return err
+	}
+
+	if count == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder not found", businessErr)
+
+		return businessErr
+	}
+
+	businessErr := pkg.ValidateBusinessError(cn.ErrKYCRevisionConflict, cn.EntityHolder)
+	libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder KYC revision conflict", businessErr)
+
+	return businessErr
+}
+
+// FindKYCByIDs returns the KYC state of the active holders among ids, keyed by
+// holder id. Holders without KYC state map to nil; missing or deleted holders
+// are absent from the result. Only the KYC state is read, so no personal data
+// is decrypted.
+func (hm *MongoDBRepository) FindKYCByIDs(ctx context.Context, organizationID string, ids []uuid.UUID) (map[uuid.UUID]*mmodel.HolderKYC, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holders_kyc")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.holder_count", len(ids)),
+	)
+
+	result := make(map[uuid.UUID]*mmodel.HolderKYC, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	opts := options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}, {Key: "kyc", Value: 1}})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holders KYC", err)
+
+		return nil, err
+	}
+
+	var records []MongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holders KYC", err)
+
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.ID == nil {
+			continue
+		}
+
+		result[*record.ID] = mapKYCToEntity(record.KYC)
+	}
+
+	return result, nil
+}
+
+// FindKYCReviewsDue lists the active holders whose standing verification
+// expires at or before dueBefore, soonest first, paginated by query's limit
+// and page. Only identifiers and KYC state are read, so no personal data is
+// decrypted.
+func (hm *MongoDBRepository) FindKYCReviewsDue(ctx context.Context, organizationID string, dueBefore time.Time, query http.QueryHeader) ([]*mmodel.KYCReviewDue, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_kyc_reviews_due")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.query.limit", query.Limit),
+		attribute.Int("app.request.query.page", query.Page),
+	)
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	filter := bson.D{
+		{Key: "kyc.expires_at", Value: bson.D{{Key: "$lte", Value: dueBefore}}},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	limit := int64(query.Limit)
+	skip := int64(query.Page*query.Limit - query.Limit)
+	opts := options.Find().
+		SetLimit(limit).
+		SetSkip(skip).
+		SetSort(bson.D{{Key: "kyc.expires_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetProjection(bson.D{
+			{Key: "_id", Value: 1},
+			{Key: "external_id", Value: 1},
+			{Key: "type", Value: 1},
+			{Key: "kyc", Value: 1},
+		})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder KYC reviews due", err)
+
+		return nil, err
+	}
+
+	var records []MongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holder KYC reviews due", err)
+
+		return nil, err
+	}
+
+	results := make([]*mmodel.KYCReviewDue, 0, len(records))
+
+	for _, record := range records {
+		if record.ID == nil || record.KYC == nil || record.KYC.ExpiresAt == nil {
+			continue
+		}
+
+		due := &mmodel.KYCReviewDue{
+			HolderID:   *record.ID,
+			ExternalID: record.ExternalID,
+			Status:     record.KYC.Status,
+			ExpiresAt:  record.KYC.ExpiresAt.UTC(),
+		}
+
+		if record.Type != nil {
+			due.HolderType = *record.Type
+		}
+
+		if record.KYC.VerifiedLevel != nil {
+			due.VerifiedLevel = *record.KYC.VerifiedLevel
+		}
+
+		results = append(results, due)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	testutils "github.com/LerianStudio/midaz/v4/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapKYC_RoundTrip(t *testing.T) {
+	assert.Nil(t, mapKYCFromEntity(nil))
+	assert.Nil(t, mapKYCToEntity(nil))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := now.AddDate(2, 0, 0)
+
+	kyc := &mmodel.HolderKYC{
+		Status:        mmodel.KYCStatusVerified,
+		Level:         testutils.Ptr(mmodel.KYCLevelStandard),
+		VerifiedLevel: testutils.Ptr(mmodel.KYCLevelStandard),
+		VerifiedAt:    &now,
+		ExpiresAt:     &expiresAt,
+		Documents: []mmodel.KYCDocument{
+			{Type: mmodel.KYCDocumentIdentity, Reference: "dms://1", SubmittedBy: "ops", SubmittedAt: now},
+		},
+		History: []mmodel.KYCTransition{
+			{From: mmodel.KYCStatusInReview, To: mmodel.KYCStatusVerified, Level: mmodel.KYCLevelStandard, Actor: "compliance", CreatedAt: now},
+		},
+		Revision:  3,
+		UpdatedAt: &now,
+	}
+
+	assert.Equal(t, kyc, mapKYCToEntity(mapKYCFromEntity(kyc)))
+}
+
+func TestMongoDBModel_KYCSurvivesRewrite(t *testing.T) {
+	fe := setupTestFieldEncryptor(t)
+	ctx := context.Background()
+	holderID := uuid.New()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	holder := &mmodel.Holder{
+		ID:        &holderID,
+		Type:      testutils.Ptr(mmodel.HolderTypeNaturalPerson),
+		Name:      testutils.Ptr("John Doe"),
+		Document:  testutils.Ptr("12345678901"),
+		CreatedAt: now,
+		UpdatedAt: now,
+		KYC:       &mmodel.HolderKYC{Status: mmodel.KYCStatusPending, Documents: []mmodel.KYCDocument{}, History: []mmodel.KYCTransition{}, Revision: 1},
+	}
+
+	model := &MongoDBModel{}
+	require.NoError(t, model.FromEntity(ctx, holder, fe, testEncryptionContext(holderID.String())))
+	require.NotNil(t, model.KYC, "the re-encryption sweep rewrites whole documents and must carry the KYC state")
+
+	entity, err := model.ToEntity(ctx, fe, testEncryptionContext(holderID.String()))
+	require.NoError(t, err)
+	assert.Equal(t, holder.KYC, entity.KYC)
+}
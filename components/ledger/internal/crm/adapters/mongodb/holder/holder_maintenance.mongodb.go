@@ -18,8 +18,9 @@ func indexModels() []mongo.IndexModel {
 	return []mongo.IndexModel{
 		{
 			// Document uniqueness is enforced on the active-primary search token; it holds within a
-			// single keyset version. Cross-version uniqueness after PRF key rotation is a rotation-time
-			// concern (rotation is currently out of scope).
+			// single keyset version. While a key rotation's re-encryption sweep runs, a holder still
+			// indexed under the previous version does not collide with a new one; the sweep reports
+			// such a pair as a skipped rewrite, which keeps the old version from being retired.
 			Keys: bson.D{{Key: "search.document", Value: 1}},
 			Options: options.Index().
 				SetUnique(true).
@@ -50,6 +51,23 @@ func indexModels() []mongo.IndexModel {
 				{Key: "deleted_at", Value: 1},
 			},
 		},
+		{
+			// Serve duplicate detection, which looks holders up by their email and phone tokens.
+			Keys: bson.D{{Key: "search.email", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.D{{Key: "search.email", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		},
+		{
+			Keys: bson.D{{Key: "search.phone", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.D{{Key: "search.phone", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		},
+		{
+			// Serves the KYC re-verification listing; only holders with a standing verification carry the key.
+			Keys: bson.D{{Key: "kyc.expires_at", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.D{{Key: "kyc.expires_at", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		},
 	}
 }
 
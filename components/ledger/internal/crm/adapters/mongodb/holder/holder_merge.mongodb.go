@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	encryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FindDuplicateCandidates returns up to limit active holders, other than
+// holder itself, sharing its document, primary email or mobile phone. The
+// lookup runs on the blind-index search tokens of every enabled key version,
+// so it never decrypts holders that do not match.
+func (hm *MongoDBRepository) FindDuplicateCandidates(ctx context.Context, organizationID string, holder *mmodel.Holder, limit int) ([]*mmodel.Holder, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_duplicate_holder_candidates")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderIDString(holder.ID)),
+	)
+
+	fields := searchFields(holder)
+	if len(fields) == 0 || holder.ID == nil {
+		return []*mmodel.Holder{}, nil
+	}
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	matches := make(bson.A, 0, len(fields))
+
+	for _, field := range fields {
+		searchCtx := encryption.SearchTokenContext{
+			TenantID:       encryption.ExtractTenantID(ctx),
+			OrganizationID: organizationID,
+			FieldName:      field.name,
+		}
+
+		tokens, err := hm.FieldEncryptor.GenerateSearchTokenCandidates(ctx, searchCtx, field.value)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to generate search tokens", err)
+
+			return nil, err
+		}
+
+		matches = append(matches, bson.D{{Key: "search." + field.key, Value: bson.M{"$in": tokens}}})
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: bson.M{"$ne": *holder.ID}},
+		{Key: "deleted_at", Value: nil},
+		{Key: "$or", Value: matches},
+	}
+
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find duplicate holder candidates", err)
+
+		return nil, err
+	}
+
+	var records []*MongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode duplicate holder candidates", err)
+
+		return nil, err
+	}
+
+	results := make([]*mmodel.Holder, 0, len(records))
+
+	for _, record := range records {
+		encryptionCtx := encryption.EncryptionContext{
+			TenantID:       encryption.ExtractTenantID(ctx),
+			OrganizationID: organizationID,
+			RecordID:       record.ID.String(),
+			HolderID:       record.ID.String(),
+		}
+
+		candidate, err := record.ToEntity(ctx, hm.FieldEncryptor, encryptionCtx)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert holder to model", err)
+
+			return nil, err
+		}
+
+		results = append(results, candidate)
+	}
+
+	return results, nil
+}
+
+// MarkMerged tombstones a holder merged into survivorID: the holder is
+// soft-deleted, which frees its document for the survivor, and keeps
+// merged_into so reads of the old id can point to the surviving holder. It
+// returns ErrHolderNotFound when the holder is missing or already deleted.
+func (hm *MongoDBRepository) MarkMerged(ctx context.Context, organizationID string, id, survivorID uuid.UUID, mergedAt time.Time) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.mark_holder_merged")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.String("app.request.survivor_id", survivorID.String()),
+	)
+
+	db, err := hm.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	coll := db.Collection(strings.ToLower("holders_" + organizationID))
+
+	filter := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "merged_into", Value: survivorID},
+		{Key: "merged_at", Value: mergedAt},
+		{Key: "deleted_at", Value: mergedAt},
+		{Key: "updated_at", Value: mergedAt},
+	}}}
+
+	result, err := coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to mark holder merged", err)
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder not found", businessErr)
+
+		return businessErr
+	}
+
+	return nil
+}
+
+// findMergedInto returns the surviving holder of a holder merged away as a
+// duplicate, or nil when the holder was not merged.
+func findMergedInto(ctx context.Context, coll *mongo.Collection, id uuid.UUID) (*uuid.UUID, error) {
+	filter := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "merged_into", Value: bson.M{"$ne": nil}},
+	}
+
+	var tombstone struct {
+		MergedInto *uuid.UUID `bson:"merged_into"`
+	}
+
+	err := coll.FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "merged_into", Value: 1}})).Decode(&tombstone)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return tombstone.MergedInto, nil
+}
+
+// holderIDString renders an optional holder id for span attributes.
+func holderIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
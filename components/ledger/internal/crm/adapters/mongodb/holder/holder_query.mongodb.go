@@ -108,6 +108,7 @@ func (hm *MongoDBRepository) FindAll(ctx context.Context, organizationID string,
 			TenantID:       tenantID,
 			OrganizationID: organizationID,
 			RecordID:       holder.ID.String(),
+			HolderID:       holder.ID.String(),
 		}
 
 		results[i], err = holder.ToEntity(ctx, hm.FieldEncryptor, encryptionCtx)
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package holder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	testutils "github.com/LerianStudio/midaz/v4/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoSearchTokenEncryptor derives search tokens from the field name and the
+// tokenized value, so tests can assert what was tokenized.
+type echoSearchTokenEncryptor struct {
+	mockFieldEncryptorVersion
+}
+
+func (m *echoSearchTokenEncryptor) GenerateSearchToken(_ context.Context, searchCtx encryption.SearchTokenContext, value string) (string, uint32, error) {
+	return searchCtx.FieldName + ":" + value, 1, nil
+}
+
+func TestMongoDBModel_FromEntity_DuplicateSearchTokens(t *testing.T) {
+	t.Parallel()
+
+	holderID := uuid.New()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	holder := &mmodel.Holder{
+		ID:       &holderID,
+		Type:     testutils.Ptr("NATURAL_PERSON"),
+		Name:     testutils.Ptr("John Doe"),
+		Document: testutils.Ptr("12345678901"),
+		Contact: &mmodel.Contact{
+			PrimaryEmail: testutils.Ptr(" John.Doe@Example.com "),
+			MobilePhone:  testutils.Ptr("+55 (11) 99999-0000"),
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	var model MongoDBModel
+	require.NoError(t, model.FromEntity(context.Background(), holder, &echoSearchTokenEncryptor{}, testEncryptionContext(holderID.String())))
+
+	assert.Equal(t, "document:12345678901", model.Search["document"])
+	assert.Equal(t, "contact.primary_email:john.doe@example.com", model.Search["email"], "emails are tokenized trimmed and lowercased")
+	assert.Equal(t, "contact.mobile_phone:5511999990000", model.Search["phone"], "phones are tokenized as digits")
+	assert.Equal(t, uint32(1), model.SearchKeyVersion)
+}
+
+func TestWithSearchTokensToRemove(t *testing.T) {
+	t.Parallel()
+
+	assert.ElementsMatch(t,
+		[]string{"contact.primaryEmail", "search.email"},
+		withSearchTokensToRemove([]string{"contact.primaryEmail"}))
+	assert.ElementsMatch(t,
+		[]string{"contact", "search.email", "search.phone"},
+		withSearchTokensToRemove([]string{"contact"}))
+	assert.Equal(t, []string{"name"}, withSearchTokensToRemove([]string{"name"}))
+}
@@ -43,9 +43,11 @@ type MongoDBModel struct {
 	BankingDetails   *BankingMongoDBModel          `bson:"banking_details,omitempty"`
 	RegulatoryFields *RegulatoryFieldsMongoDBModel `bson:"regulatory_fields,omitempty"`
 	RelatedParties   []*RelatedPartyMongoDBModel   `bson:"related_parties,omitempty"`
+	Lifecycle        *LifecycleMongoDBModel        `bson:"lifecycle,omitempty"`
 	CreatedAt        *time.Time                    `bson:"created_at,omitempty"`
 	UpdatedAt        *time.Time                    `bson:"updated_at"`
 	DeletedAt        *time.Time                    `bson:"deleted_at"`
+	ErasedAt         *time.Time                    `bson:"erased_at,omitempty"`
 }
 
 type SearchMongoDB struct {
@@ -80,6 +82,16 @@ type RelatedPartyMongoDBModel struct {
 	EndDate   *time.Time `bson:"end_date,omitempty"`
 }
 
+// holderIDString returns the owning holder id of an instrument, or "" when it
+// is unknown; the encryption context then falls back to the organization keyset.
+func holderIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
 // stampSearchKeyVersion records the PRF keyset primary version used for the document's
 // search tokens. All of a document's tokens share one version (same org primary), so the
 // first non-zero version observed is kept; a legacy write (version 0) leaves it unset.
@@ -96,6 +108,7 @@ func mapBankingDetailsFromEntity(ctx context.Context, fe encryption.FieldEncrypt
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	model := &BankingMongoDBModel{
@@ -178,6 +191,7 @@ func mapBankingDetailsToEntity(ctx context.Context, fe encryption.FieldEncryptor
 		TenantID:       encryptionCtx.TenantID,
 		OrganizationID: encryptionCtx.OrganizationID,
 		RecordID:       encryptionCtx.RecordID,
+		HolderID:       encryptionCtx.HolderID,
 	}
 
 	bankingDetails := &mmodel.BankingDetails{
@@ -232,6 +246,7 @@ func mapRegulatoryFieldsFromEntity(ctx context.Context, fe encryption.FieldEncry
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 			FieldName:      "regulatory_fields.participant_document",
 		}
 
@@ -271,6 +286,7 @@ func mapRegulatoryFieldsToEntity(ctx context.Context, fe encryption.FieldEncrypt
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 			FieldName:      "regulatory_fields.participant_document",
 		}
 
@@ -298,6 +314,7 @@ func mapRelatedPartiesFromEntity(ctx context.Context, fe encryption.FieldEncrypt
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 			FieldName:      fmt.Sprintf("related_parties.%s.document", rp.ID),
 		}
 
@@ -350,6 +367,7 @@ func mapRelatedPartiesToEntity(ctx context.Context, fe encryption.FieldEncryptor
 				TenantID:       encryptionCtx.TenantID,
 				OrganizationID: encryptionCtx.OrganizationID,
 				RecordID:       encryptionCtx.RecordID,
+				HolderID:       encryptionCtx.HolderID,
 				FieldName:      fmt.Sprintf("related_parties.%s.document", rp.ID),
 			}
 
@@ -388,6 +406,7 @@ func (amm *MongoDBModel) FromEntity(ctx context.Context, a *mmodel.Instrument, f
 		LedgerID:  a.LedgerID,
 		AccountID: a.AccountID,
 		HolderID:  a.HolderID,
+		Lifecycle: mapLifecycleFromEntity(a.Lifecycle),
 		CreatedAt: &a.CreatedAt,
 		UpdatedAt: &a.UpdatedAt,
 		DeletedAt: a.DeletedAt,
@@ -400,6 +419,7 @@ func (amm *MongoDBModel) FromEntity(ctx context.Context, a *mmodel.Instrument, f
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 			FieldName:      "document",
 		}
 
@@ -481,9 +501,11 @@ func (amm *MongoDBModel) ToEntity(ctx context.Context, fe encryption.FieldEncryp
 		AccountID: amm.AccountID,
 		HolderID:  amm.HolderID,
 		Metadata:  amm.Metadata,
+		Lifecycle: mapLifecycleToEntity(amm.Lifecycle),
 		CreatedAt: utils.SafeTimePtr(amm.CreatedAt),
 		UpdatedAt: utils.SafeTimePtr(amm.UpdatedAt),
 		DeletedAt: amm.DeletedAt,
+		ErasedAt:  amm.ErasedAt,
 	}
 
 	if amm.Document != nil {
@@ -491,6 +513,7 @@ func (amm *MongoDBModel) ToEntity(ctx context.Context, fe encryption.FieldEncryp
 			TenantID:       encryptionCtx.TenantID,
 			OrganizationID: encryptionCtx.OrganizationID,
 			RecordID:       encryptionCtx.RecordID,
+			HolderID:       encryptionCtx.HolderID,
 			FieldName:      "document",
 		}
 
@@ -41,6 +41,11 @@ type Repository interface {
 	Delete(ctx context.Context, organizationID string, holderID, id uuid.UUID, hardDelete bool) error
 	DeleteRelatedParty(ctx context.Context, organizationID string, holderID, instrumentID, relatedPartyID uuid.UUID) error
 	Count(ctx context.Context, organizationID string, holderID uuid.UUID) (int64, error)
+	EraseByHolder(ctx context.Context, organizationID string, holderID uuid.UUID, erasedAt time.Time) (int64, error)
+	EraseRelatedParties(ctx context.Context, organizationID, document string) (int64, error)
+	ReassignHolder(ctx context.Context, organizationID string, fromHolderID, toHolderID uuid.UUID) (int64, error)
+	ReassignRelatedParties(ctx context.Context, organizationID, fromDocument, toDocument, toName string) (int64, error)
+	UpdateLifecycle(ctx context.Context, organizationID string, holderID, id uuid.UUID, lifecycle *mmodel.InstrumentLifecycle, closingDate *time.Time, expectedRevision int64) error
 }
 
 // MongoDBRepository is a MongoDB-specific implementation of Repository
@@ -119,6 +124,7 @@ func (am *MongoDBRepository) Create(ctx context.Context, organizationID string,
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       instrument.ID.String(),
+		HolderID:       holderIDString(instrument.HolderID),
 	}
 
 	record := &MongoDBModel{}
@@ -217,6 +223,7 @@ func (am *MongoDBRepository) Find(ctx context.Context, organizationID string, ho
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       id.String(),
+		HolderID:       holderID.String(),
 	}
 
 	result, err := record.ToEntity(ctx, am.FieldEncryptor, encryptionCtx)
@@ -265,6 +272,7 @@ func (am *MongoDBRepository) Update(ctx context.Context, organizationID string,
 		TenantID:       encryption.ExtractTenantID(ctx),
 		OrganizationID: organizationID,
 		RecordID:       id.String(),
+		HolderID:       holderID.String(),
 	}
 
 	instrumentToUpdate := &MongoDBModel{}
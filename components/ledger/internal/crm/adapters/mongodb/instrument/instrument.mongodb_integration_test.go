@@ -476,6 +476,154 @@ func TestIntegration_AliasRepo_Update_FieldsToRemove(t *testing.T) {
 	assert.False(t, hasKey1, "key1 should be removed")
 }
 
+// ============================================================================
+// Holder Merge Tests
+// ============================================================================
+
+func TestIntegration_AliasRepo_ReassignHolder(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-reassign-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+	duplicateID := uuid.New()
+	survivorID := uuid.New()
+
+	active := mongotestutil.CreateTestInstrumentWithBanking(t, duplicateID, "account-reassign-1", "12312312300")
+	_, err := repo.Create(ctx, organizationID, active)
+	require.NoError(t, err)
+
+	deleted := mongotestutil.CreateTestInstrumentSimple(t, duplicateID, "account-reassign-2", "12312312300")
+	_, err = repo.Create(ctx, organizationID, deleted)
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(ctx, organizationID, duplicateID, *deleted.ID, false))
+
+	// Act
+	moved, err := repo.ReassignHolder(ctx, organizationID, duplicateID, survivorID)
+	require.NoError(t, err)
+
+	again, err := repo.ReassignHolder(ctx, organizationID, duplicateID, survivorID)
+	require.NoError(t, err)
+
+	// Assert: both instruments moved and decrypt under the survivor's context.
+	assert.Equal(t, int64(2), moved)
+	assert.Zero(t, again, "a repeated reassignment moves nothing")
+
+	result, err := repo.Find(ctx, organizationID, survivorID, *active.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, *active.BankingDetails.IBAN, *result.BankingDetails.IBAN, "IBAN should be re-encrypted for the survivor")
+
+	result, err = repo.Find(ctx, organizationID, survivorID, *deleted.ID, true)
+	require.NoError(t, err)
+	assert.NotNil(t, result.DeletedAt, "deleted instruments stay deleted")
+
+	count, err := repo.Count(ctx, organizationID, duplicateID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestIntegration_AliasRepo_ReassignRelatedParties(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-reparties-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+	holderID := uuid.New()
+	relatedPartyID := uuid.New()
+
+	alias := mongotestutil.CreateTestInstrumentSimple(t, holderID, "account-reparties-1", "45645645600")
+	alias.RelatedParties = []*mmodel.RelatedParty{
+		{
+			ID:        &relatedPartyID,
+			Document:  "32132132100",
+			Name:      "Merge Duplicate",
+			Role:      "PRIMARY_HOLDER",
+			StartDate: mmodel.Date{Time: alias.CreatedAt},
+		},
+	}
+	_, err := repo.Create(ctx, organizationID, alias)
+	require.NoError(t, err)
+
+	// Act
+	renamed, err := repo.ReassignRelatedParties(ctx, organizationID, "32132132100", "12312312300", "Merge Survivor")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), renamed)
+
+	result, err := repo.Find(ctx, organizationID, holderID, *alias.ID, false)
+	require.NoError(t, err)
+	require.Len(t, result.RelatedParties, 1)
+	assert.Equal(t, "12312312300", result.RelatedParties[0].Document)
+	assert.Equal(t, "Merge Survivor", result.RelatedParties[0].Name)
+	assert.Equal(t, relatedPartyID, *result.RelatedParties[0].ID)
+}
+
+func TestIntegration_AliasRepo_EraseRelatedParties(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-eraseparties-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+	holderID := uuid.New()
+	erasedPartyID := uuid.New()
+	keptPartyID := uuid.New()
+
+	alias := mongotestutil.CreateTestInstrumentSimple(t, holderID, "account-eraseparties-1", "45645645600")
+	alias.RelatedParties = []*mmodel.RelatedParty{
+		{
+			ID:        &erasedPartyID,
+			Document:  "32132132100",
+			Name:      "Erased Holder",
+			Role:      "PRIMARY_HOLDER",
+			StartDate: mmodel.Date{Time: alias.CreatedAt},
+		},
+		{
+			ID:        &keptPartyID,
+			Document:  "78978978900",
+			Name:      "Other Party",
+			Role:      "LEGAL_REPRESENTATIVE",
+			StartDate: mmodel.Date{Time: alias.CreatedAt},
+		},
+	}
+	_, err := repo.Create(ctx, organizationID, alias)
+	require.NoError(t, err)
+
+	deleted := mongotestutil.CreateTestInstrumentSimple(t, holderID, "account-eraseparties-2", "45645645601")
+	deleted.RelatedParties = []*mmodel.RelatedParty{
+		{
+			Document:  "32132132100",
+			Name:      "Erased Holder",
+			Role:      "PRIMARY_HOLDER",
+			StartDate: mmodel.Date{Time: deleted.CreatedAt},
+		},
+	}
+	_, err = repo.Create(ctx, organizationID, deleted)
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(ctx, organizationID, holderID, *deleted.ID, false))
+
+	// Act
+	removed, err := repo.EraseRelatedParties(ctx, organizationID, "32132132100")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), removed, "soft-deleted instruments are scrubbed too")
+
+	result, err := repo.Find(ctx, organizationID, holderID, *alias.ID, false)
+	require.NoError(t, err)
+	require.Len(t, result.RelatedParties, 1)
+	assert.Equal(t, keptPartyID, *result.RelatedParties[0].ID)
+
+	result, err = repo.Find(ctx, organizationID, holderID, *deleted.ID, true)
+	require.NoError(t, err)
+	assert.Empty(t, result.RelatedParties)
+
+	// The search tokens went with the entries: a repeated erasure finds nothing.
+	removed, err = repo.EraseRelatedParties(ctx, organizationID, "32132132100")
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+}
+
 // ============================================================================
 // Delete Tests
 // ============================================================================
@@ -546,6 +694,81 @@ func TestIntegration_AliasRepo_Delete_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "instrument ID does not exist", "should return ErrInstrumentNotFound")
 }
 
+// ============================================================================
+// Lifecycle Tests
+// ============================================================================
+
+func TestIntegration_AliasRepo_UpdateLifecycle(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-lifecycle-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+	holderID := uuid.New()
+
+	alias := mongotestutil.CreateTestInstrumentSimple(t, holderID, "account-lifecycle-1", "33322211100")
+	_, err := repo.Create(ctx, organizationID, alias)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	blocked := &mmodel.InstrumentLifecycle{
+		Status: mmodel.InstrumentStatusBlocked,
+		History: []mmodel.InstrumentTransition{{
+			From: mmodel.InstrumentStatusActive, To: mmodel.InstrumentStatusBlocked,
+			Actor: "operations@example.com", Reason: "card reported stolen", CreatedAt: now,
+		}},
+		Revision:  1,
+		UpdatedAt: &now,
+	}
+
+	// Act - first transition, then a stale write at the same revision
+	err = repo.UpdateLifecycle(ctx, organizationID, holderID, *alias.ID, blocked, nil, 0)
+	require.NoError(t, err)
+
+	staleErr := repo.UpdateLifecycle(ctx, organizationID, holderID, *alias.ID, blocked, nil, 0)
+
+	closed := &mmodel.InstrumentLifecycle{
+		Status:    mmodel.InstrumentStatusClosed,
+		History:   append(blocked.History, mmodel.InstrumentTransition{From: mmodel.InstrumentStatusBlocked, To: mmodel.InstrumentStatusClosed, Actor: "operations@example.com", Reason: "customer request", CreatedAt: now}),
+		Revision:  2,
+		UpdatedAt: &now,
+	}
+
+	err = repo.UpdateLifecycle(ctx, organizationID, holderID, *alias.ID, closed, &now, 1)
+	require.NoError(t, err)
+
+	// Assert
+	require.Error(t, staleErr)
+	assert.Contains(t, staleErr.Error(), "lifecycle was changed by another request")
+
+	result, err := repo.Find(ctx, organizationID, holderID, *alias.ID, false)
+	require.NoError(t, err)
+	require.NotNil(t, result.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusClosed, result.Lifecycle.Status)
+	assert.Len(t, result.Lifecycle.History, 2)
+	require.NotNil(t, result.BankingDetails)
+	require.NotNil(t, result.BankingDetails.ClosingDate)
+	assert.True(t, now.Equal(result.BankingDetails.ClosingDate.Time))
+}
+
+func TestIntegration_AliasRepo_UpdateLifecycle_NotFound(t *testing.T) {
+	// Arrange
+	container := mongotestutil.SetupContainer(t)
+	organizationID := "org-lifecycle-nf-" + uuid.New().String()[:8]
+	repo := createRepository(t, container, organizationID)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// Act
+	err := repo.UpdateLifecycle(ctx, organizationID, uuid.New(), uuid.New(), &mmodel.InstrumentLifecycle{
+		Status: mmodel.InstrumentStatusBlocked, Revision: 1, UpdatedAt: &now,
+	}, nil, 0)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instrument ID does not exist", "should return ErrInstrumentNotFound")
+}
+
 // ============================================================================
 // Count Tests
 // ============================================================================
@@ -12,6 +12,7 @@ package instrument
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
 	http "github.com/LerianStudio/midaz/v4/pkg/net/http"
@@ -101,6 +102,36 @@ func (mr *MockRepositoryMockRecorder) DeleteRelatedParty(ctx, organizationID, ho
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelatedParty", reflect.TypeOf((*MockRepository)(nil).DeleteRelatedParty), ctx, organizationID, holderID, instrumentID, relatedPartyID)
 }
 
+// EraseByHolder mocks base method.
+func (m *MockRepository) EraseByHolder(ctx context.Context, organizationID string, holderID uuid.UUID, erasedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseByHolder", ctx, organizationID, holderID, erasedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseByHolder indicates an expected call of EraseByHolder.
+func (mr *MockRepositoryMockRecorder) EraseByHolder(ctx, organizationID, holderID, erasedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseByHolder", reflect.TypeOf((*MockRepository)(nil).EraseByHolder), ctx, organizationID, holderID, erasedAt)
+}
+
+// EraseRelatedParties mocks base method.
+func (m *MockRepository) EraseRelatedParties(ctx context.Context, organizationID, document string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseRelatedParties", ctx, organizationID, document)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseRelatedParties indicates an expected call of EraseRelatedParties.
+func (mr *MockRepositoryMockRecorder) EraseRelatedParties(ctx, organizationID, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseRelatedParties", reflect.TypeOf((*MockRepository)(nil).EraseRelatedParties), ctx, organizationID, document)
+}
+
 // Find mocks base method.
 func (m *MockRepository) Find(ctx context.Context, organizationID string, holderID, id uuid.UUID, includeDeleted bool) (*mmodel.Instrument, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +162,36 @@ func (mr *MockRepositoryMockRecorder) FindAll(ctx, organizationID, holderID, fil
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), ctx, organizationID, holderID, filter, includeDeleted)
 }
 
+// ReassignHolder mocks base method.
+func (m *MockRepository) ReassignHolder(ctx context.Context, organizationID string, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignHolder", ctx, organizationID, fromHolderID, toHolderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignHolder indicates an expected call of ReassignHolder.
+func (mr *MockRepositoryMockRecorder) ReassignHolder(ctx, organizationID, fromHolderID, toHolderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignHolder", reflect.TypeOf((*MockRepository)(nil).ReassignHolder), ctx, organizationID, fromHolderID, toHolderID)
+}
+
+// ReassignRelatedParties mocks base method.
+func (m *MockRepository) ReassignRelatedParties(ctx context.Context, organizationID, fromDocument, toDocument, toName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignRelatedParties", ctx, organizationID, fromDocument, toDocument, toName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignRelatedParties indicates an expected call of ReassignRelatedParties.
+func (mr *MockRepositoryMockRecorder) ReassignRelatedParties(ctx, organizationID, fromDocument, toDocument, toName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignRelatedParties", reflect.TypeOf((*MockRepository)(nil).ReassignRelatedParties), ctx, organizationID, fromDocument, toDocument, toName)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.Instrument, fieldsToRemove []string) (*mmodel.Instrument, error) {
 	m.ctrl.T.Helper()
@@ -145,3 +206,17 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, organizationID, holderID, id,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, organizationID, holderID, id, input, fieldsToRemove)
 }
+
+// UpdateLifecycle mocks base method.
+func (m *MockRepository) UpdateLifecycle(ctx context.Context, organizationID string, holderID, id uuid.UUID, lifecycle *mmodel.InstrumentLifecycle, closingDate *time.Time, expectedRevision int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLifecycle", ctx, organizationID, holderID, id, lifecycle, closingDate, expectedRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLifecycle indicates an expected call of UpdateLifecycle.
+func (mr *MockRepositoryMockRecorder) UpdateLifecycle(ctx, organizationID, holderID, id, lifecycle, closingDate, expectedRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLifecycle", reflect.TypeOf((*MockRepository)(nil).UpdateLifecycle), ctx, organizationID, holderID, id, lifecycle, closingDate, expectedRevision)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package instrument
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	encryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// erasedFields lists the instrument fields removed when the owning holder is
+// erased: the protected fields, the related parties (their names are stored in
+// plaintext) and the blind-index search tokens. The instrument id, type, holder
+// id and ledger/account links are kept for ledger integrity.
+var erasedFields = bson.A{
+	"document",
+	"banking_details.account",
+	"banking_details.iban",
+	"regulatory_fields",
+	"related_parties",
+	"search",
+	"search_key_version",
+}
+
+// ErrRelatedPartiesChanged is returned by EraseRelatedParties when an instrument
+// changed while its entries were removed; repeating the erasure removes them.
+var ErrRelatedPartiesChanged = errors.New("instruments changed while their related parties were erased")
+
+// EraseByHolder removes the personal data and search tokens of every instrument
+// of the holder, soft-deleted ones included, and stamps erased_at. Instruments
+// stay active so the linked ledger accounts keep resolving. It is idempotent
+// and returns the number of instruments matched.
+func (am *MongoDBRepository) EraseByHolder(ctx context.Context, organizationID string, holderID uuid.UUID, erasedAt time.Time) (int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.erase_instruments_by_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return 0, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "erased_at", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$erased_at", erasedAt}}}},
+			{Key: "updated_at", Value: erasedAt},
+			{Key: "metadata", Value: bson.D{{Key: "$literal", Value: bson.D{}}}},
+		}}},
+		{{Key: "$unset", Value: erasedFields}},
+	}
+
+	result, err := coll.UpdateMany(ctx, bson.D{{Key: "holder_id", Value: holderID}}, pipeline)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to erase holder instruments", err)
+
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", result.ModifiedCount))
+
+	return result.MatchedCount, nil
+}
+
+// EraseRelatedParties removes every related-party entry naming document from
+// the instruments of any holder, soft-deleted ones included, so an erased
+// holder's name and document no longer survive on instruments it does not own.
+// Each instrument is re-encrypted without the entries, which also drops their
+// search tokens. It is idempotent and returns the number of entries removed.
+func (am *MongoDBRepository) EraseRelatedParties(ctx context.Context, organizationID, document string) (int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.erase_related_parties")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return 0, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	searchCtx := encryption.SearchTokenContext{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		FieldName:      "related_parties.document",
+	}
+
+	tokens, err := am.FieldEncryptor.GenerateSearchTokenCandidates(ctx, searchCtx, document)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to generate search tokens", err)
+
+		return 0, err
+	}
+
+	records, err := findInstrumentRecords(ctx, coll, bson.D{{Key: "search.related_party_documents", Value: bson.M{"$in": tokens}}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find instruments by related party", err)
+
+		return 0, err
+	}
+
+	var removed int64
+
+	for _, record := range records {
+		if record.HolderID == nil {
+			continue
+		}
+
+		entity, err := record.ToEntity(ctx, am.FieldEncryptor, am.encryptionContext(ctx, organizationID, record.ID, *record.HolderID))
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert instrument to model", err)
+
+			return removed, err
+		}
+
+		kept := make([]*mmodel.RelatedParty, 0, len(entity.RelatedParties))
+
+		for _, party := range entity.RelatedParties {
+			if party == nil || party.Document != document {
+				kept = append(kept, party)
+			}
+		}
+
+		entries := int64(len(entity.RelatedParties) - len(kept))
+		if entries == 0 {
+			continue
+		}
+
+		entity.RelatedParties = kept
+		entity.UpdatedAt = time.Now()
+
+		replaced, err := am.replaceRecord(ctx, coll, organizationID, record, entity, *record.HolderID)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to erase related parties", err)
+
+			return removed, err
+		}
+
+		if !replaced {
+			libOpentelemetry.HandleSpanError(span, "Instrument changed during related party erasure", ErrRelatedPartiesChanged)
+
+			return removed, ErrRelatedPartiesChanged
+		}
+
+		removed += entries
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", removed))
+
+	return removed, nil
+}
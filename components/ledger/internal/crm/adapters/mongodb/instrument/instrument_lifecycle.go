@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package instrument
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// LifecycleMongoDBModel is the lifecycle state embedded in an instrument
+// document. It holds no personal data, so it is kept in plaintext.
+type LifecycleMongoDBModel struct {
+	Status    string                             `bson:"status"`
+	History   []InstrumentTransitionMongoDBModel `bson:"history"`
+	Revision  int64                              `bson:"revision"`
+	UpdatedAt *time.Time                         `bson:"updated_at,omitempty"`
+}
+
+type InstrumentTransitionMongoDBModel struct {
+	From              string    `bson:"from"`
+	To                string    `bson:"to"`
+	Actor             string    `bson:"actor"`
+	Reason            string    `bson:"reason"`
+	AccountPropagated bool      `bson:"account_propagated"`
+	CreatedAt         time.Time `bson:"created_at"`
+}
+
+// mapLifecycleFromEntity maps a lifecycle entity to its MongoDB model; nil maps
+// to nil.
+func mapLifecycleFromEntity(l *mmodel.InstrumentLifecycle) *LifecycleMongoDBModel {
+	if l == nil {
+		return nil
+	}
+
+	history := make([]InstrumentTransitionMongoDBModel, 0, len(l.History))
+	for _, t := range l.History {
+		history = append(history, InstrumentTransitionMongoDBModel(t))
+	}
+
+	return &LifecycleMongoDBModel{
+		Status:    l.Status,
+		History:   history,
+		Revision:  l.Revision,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+// mapLifecycleToEntity maps a lifecycle MongoDB model to its entity; nil maps
+// to nil.
+func mapLifecycleToEntity(l *LifecycleMongoDBModel) *mmodel.InstrumentLifecycle {
+	if l == nil {
+		return nil
+	}
+
+	history := make([]mmodel.InstrumentTransition, 0, len(l.History))
+	for _, t := range l.History {
+		history = append(history, mmodel.InstrumentTransition(t))
+	}
+
+	return &mmodel.InstrumentLifecycle{
+		Status:    l.Status,
+		History:   history,
+		Revision:  l.Revision,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package instrument
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UpdateLifecycle stores the lifecycle state of an active instrument, provided
+// the stored state is still at expectedRevision (0 for an instrument without
+// lifecycle state). A non-nil closingDate is stored as the banking details'
+// closing date. It also bumps the instrument's updated_at, which guards the
+// re-encryption and merge rewrites against a concurrent transition. It returns
+// ErrInstrumentNotFound when the instrument does not exist or is deleted, and
+// ErrInstrumentLifecycleConflict when the state was changed concurrently.
+func (am *MongoDBRepository) UpdateLifecycle(ctx context.Context, organizationID string, holderID, id uuid.UUID, lifecycle *mmodel.InstrumentLifecycle, closingDate *time.Time, expectedRevision int64) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_instrument_lifecycle")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.instrument_id", id.String()),
+		attribute.Int64("app.request.lifecycle_expected_revision", expectedRevision),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	active := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "holder_id", Value: holderID},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	filter := append(bson.D{}, active...)
+	if expectedRevision == 0 {
+		filter = append(filter, bson.E{Key: "lifecycle", Value: bson.D{{Key: "$exists", Value: false}}})
+	} else {
+		filter = append(filter, bson.E{Key: "lifecycle.revision", Value: expectedRevision})
+	}
+
+	set := bson.D{
+		{Key: "lifecycle", Value: mapLifecycleFromEntity(lifecycle)},
+		{Key: "updated_at", Value: lifecycle.UpdatedAt},
+	}
+
+	if closingDate != nil {
+		set = append(set, bson.E{Key: "banking_details.closing_date", Value: *closingDate})
+	}
+
+	result, err := coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update instrument lifecycle", err)
+
+		return err
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	count, err := coll.CountDocuments(ctx, active)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to check instrument existence", err)
+
+		return err
+	}
+
+	if count == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrInstrumentNotFound, cn.EntityInstrument)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Instrument not found", businessErr)
+
+		return businessErr
+	}
+
+	businessErr := pkg.ValidateBusinessError(cn.ErrInstrumentLifecycleConflict, cn.EntityInstrument)
+	libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Instrument lifecycle revision conflict", businessErr)
+
+	return businessErr
+}
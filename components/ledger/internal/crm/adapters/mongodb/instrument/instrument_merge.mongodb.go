@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package instrument
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	encryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ReassignHolder moves every instrument of fromHolderID, soft-deleted ones
+// included, to toHolderID. Instrument fields are encrypted under the owning
+// holder's data key, so each instrument is decrypted and re-encrypted for the
+// new holder rather than updated in place. It is idempotent: a repeated call
+// moves only the instruments left behind, and it returns how many it moved.
+func (am *MongoDBRepository) ReassignHolder(ctx context.Context, organizationID string, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.reassign_instruments_holder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", fromHolderID.String()),
+		attribute.String("app.request.survivor_id", toHolderID.String()),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return 0, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	records, err := findInstrumentRecords(ctx, coll, bson.D{{Key: "holder_id", Value: fromHolderID}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder instruments", err)
+
+		return 0, err
+	}
+
+	var moved int64
+
+	for _, record := range records {
+		entity, err := record.ToEntity(ctx, am.FieldEncryptor, am.encryptionContext(ctx, organizationID, record.ID, fromHolderID))
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert instrument to model", err)
+
+			return moved, err
+		}
+
+		entity.HolderID = &toHolderID
+		entity.UpdatedAt = time.Now()
+
+		replaced, err := am.replaceRecord(ctx, coll, organizationID, record, entity, toHolderID)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to move instrument", err)
+
+			return moved, err
+		}
+
+		if replaced {
+			moved++
+		}
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", moved))
+
+	return moved, nil
+}
+
+// ReassignRelatedParties renames to toDocument and toName every related-party
+// entry on an active instrument naming fromDocument, so entries naming a
+// merged-away holder point to the surviving one. It returns the number of
+// entries renamed.
+func (am *MongoDBRepository) ReassignRelatedParties(ctx context.Context, organizationID, fromDocument, toDocument, toName string) (int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.reassign_related_parties")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return 0, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	searchCtx := encryption.SearchTokenContext{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		FieldName:      "related_parties.document",
+	}
+
+	tokens, err := am.FieldEncryptor.GenerateSearchTokenCandidates(ctx, searchCtx, fromDocument)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to generate search tokens", err)
+
+		return 0, err
+	}
+
+	records, err := findInstrumentRecords(ctx, coll, bson.D{
+		{Key: "search.related_party_documents", Value: bson.M{"$in": tokens}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find instruments by related party", err)
+
+		return 0, err
+	}
+
+	var renamed int64
+
+	for _, record := range records {
+		if record.HolderID == nil {
+			continue
+		}
+
+		entity, err := record.ToEntity(ctx, am.FieldEncryptor, am.encryptionContext(ctx, organizationID, record.ID, *record.HolderID))
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to convert instrument to model", err)
+
+			return renamed, err
+		}
+
+		var entries int64
+
+		for _, party := range entity.RelatedParties {
+			if party != nil && party.Document == fromDocument {
+				party.Document = toDocument
+				party.Name = toName
+				entries++
+			}
+		}
+
+		if entries == 0 {
+			continue
+		}
+
+		entity.UpdatedAt = time.Now()
+
+		replaced, err := am.replaceRecord(ctx, coll, organizationID, record, entity, *record.HolderID)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to rename related parties", err)
+
+			return renamed, err
+		}
+
+		if replaced {
+			renamed += entries
+		}
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", renamed))
+
+	return renamed, nil
+}
+
+// encryptionContext is the encryption context of an instrument owned by
+// holderID.
+func (am *MongoDBRepository) encryptionContext(ctx context.Context, organizationID string, id *uuid.UUID, holderID uuid.UUID) encryption.EncryptionContext {
+	return encryption.EncryptionContext{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		RecordID:       id.String(),
+		HolderID:       holderID.String(),
+	}
+}
+
+// replaceRecord stores entity, encrypted for holderID, in place of record. The
+// replace is guarded by the stored holder and updated_at; an instrument changed
+// concurrently is reported as not replaced and left for a repeated call.
+func (am *MongoDBRepository) replaceRecord(ctx context.Context, coll *mongo.Collection, organizationID string, record *MongoDBModel, entity *mmodel.Instrument, holderID uuid.UUID) (bool, error) {
+	rewritten := &MongoDBModel{}
+	if err := rewritten.FromEntity(ctx, entity, am.FieldEncryptor, am.encryptionContext(ctx, organizationID, record.ID, holderID)); err != nil {
+		return false, err
+	}
+
+	rewritten.ErasedAt = record.ErasedAt
+
+	filter := bson.D{
+		{Key: "_id", Value: record.ID},
+		{Key: "holder_id", Value: record.HolderID},
+		{Key: "updated_at", Value: record.UpdatedAt},
+	}
+
+	result, err := coll.ReplaceOne(ctx, filter, rewritten)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// findInstrumentRecords returns the stored instruments matching filter, in _id
+// order.
+func findInstrumentRecords(ctx context.Context, coll *mongo.Collection, filter bson.D) ([]*MongoDBModel, error) {
+	cursor, err := coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*MongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -120,6 +120,7 @@ func (am *MongoDBRepository) FindAll(ctx context.Context, organizationID string,
 			TenantID:       encryption.ExtractTenantID(ctx),
 			OrganizationID: organizationID,
 			RecordID:       instrument.ID.String(),
+			HolderID:       holderIDString(instrument.HolderID),
 		}
 
 		results[i], err = instrument.ToEntity(ctx, am.FieldEncryptor, encryptionCtx)
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package instrument
+
+import (
+	"context"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/dupkey"
+	mongoEncryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/encryption"
+	encryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// protectedFields lists the instrument fields written through the
+// FieldEncryptor. Related-party documents live in an array and are matched
+// separately.
+var protectedFields = []string{
+	"document",
+	"banking_details.account",
+	"banking_details.iban",
+	"regulatory_fields.participant_document",
+}
+
+// staleFilter matches instruments, soft-deleted included, with at least one
+// protected field (or related-party document) not written with currentVersion.
+func staleFilter(currentVersion int) bson.D {
+	condition := mongoEncryption.StaleCiphertextCondition(currentVersion)
+
+	or := make(bson.A, 0, len(protectedFields)+1)
+	for _, field := range protectedFields {
+		or = append(or, bson.D{{Key: field, Value: condition}})
+	}
+
+	or = append(or, bson.D{{Key: "related_parties", Value: bson.D{
+		{Key: "$elemMatch", Value: bson.D{{Key: "document", Value: condition}}},
+	}}})
+
+	return bson.D{{Key: "$or", Value: or}}
+}
+
+// CountStaleRecords counts the organization's instruments still referencing a keyset
+// version other than currentVersion.
+func (am *MongoDBRepository) CountStaleRecords(ctx context.Context, organizationID string, currentVersion int) (int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.count_stale_instruments")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.protection.current_version", currentVersion),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return 0, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	count, err := coll.CountDocuments(ctx, staleFilter(currentVersion))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to count stale instruments", err)
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ReencryptRecords rewrites up to limit stale instruments after afterID, in _id
+// order, re-encrypting every protected field and search token with the active
+// keyset. Timestamps are preserved. An instrument updated concurrently
+// (updated_at moved) or whose new search tokens collide with another
+// instrument's is skipped and left for a later sweep.
+func (am *MongoDBRepository) ReencryptRecords(ctx context.Context, organizationID string, currentVersion int, afterID string, limit int) (encryption.ReencryptionBatch, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.reencrypt_instruments")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.protection.current_version", currentVersion),
+	)
+
+	db, err := am.getDatabase(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get database", err)
+
+		return encryption.ReencryptionBatch{}, err
+	}
+
+	coll := db.Collection(strings.ToLower("aliases_" + organizationID))
+
+	filter := staleFilter(currentVersion)
+
+	if afterID != "" {
+		cursorID, err := uuid.Parse(afterID)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Invalid re-encryption cursor", err)
+
+			return encryption.ReencryptionBatch{}, err
+		}
+
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: cursorID}}})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find stale instruments", err)
+
+		return encryption.ReencryptionBatch{}, err
+	}
+
+	var records []*MongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode stale instruments", err)
+
+		return encryption.ReencryptionBatch{}, err
+	}
+
+	batch := encryption.ReencryptionBatch{
+		LastID: afterID,
+		Done:   len(records) < limit,
+	}
+
+	for _, record := range records {
+		rewritten, err := am.reencryptRecord(ctx, coll, organizationID, record)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to re-encrypt instrument", err)
+
+			return batch, err
+		}
+
+		if rewritten {
+			batch.Rewritten++
+		} else {
+			batch.Skipped++
+		}
+
+		batch.LastID = record.ID.String()
+	}
+
+	span.SetAttributes(
+		attribute.Int("app.protection.rewritten", batch.Rewritten),
+		attribute.Int("app.protection.skipped", batch.Skipped),
+	)
+
+	return batch, nil
+}
+
+// reencryptRecord decrypts the stored instrument and replaces it with a freshly
+// encrypted copy, guarded by the stored updated_at.
+func (am *MongoDBRepository) reencryptRecord(ctx context.Context, coll *mongo.Collection, organizationID string, record *MongoDBModel) (bool, error) {
+	encryptionCtx := encryption.EncryptionContext{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		RecordID:       record.ID.String(),
+		HolderID:       holderIDString(record.HolderID),
+	}
+
+	entity, err := record.ToEntity(ctx, am.FieldEncryptor, encryptionCtx)
+	if err != nil {
+		return false, err
+	}
+
+	rewritten := &MongoDBModel{}
+	if err := rewritten.FromEntity(ctx, entity, am.FieldEncryptor, encryptionCtx); err != nil {
+		return false, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: record.ID},
+		{Key: "updated_at", Value: record.UpdatedAt},
+	}
+
+	result, err := coll.ReplaceOne(ctx, filter, rewritten)
+	if err != nil {
+		if _, ok := dupkey.ClassifyDuplicateKey(err); ok {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+var _ encryption.ReencryptionTarget = (*MongoDBRepository)(nil)
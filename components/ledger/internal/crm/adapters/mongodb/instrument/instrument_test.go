@@ -571,6 +571,68 @@ func TestMongoDBModel_FromEntity_RoundTrip_NilOptionalEncryptedFields(t *testing
 	assert.Nil(t, resultAlias.RegulatoryFields.ParticipantDocument)
 }
 
+func TestMongoDBModel_FromEntity_RoundTrip_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	fe := setupTestFieldEncryptor(t)
+	ctx := context.Background()
+	now := fixedTestTime
+	instrumentID := uuid.New()
+	holderID := uuid.New()
+
+	original := &mmodel.Instrument{
+		ID:        &instrumentID,
+		LedgerID:  testutils.Ptr("ledger-lifecycle"),
+		AccountID: testutils.Ptr("account-lifecycle"),
+		HolderID:  &holderID,
+		Lifecycle: &mmodel.InstrumentLifecycle{
+			Status: mmodel.InstrumentStatusBlocked,
+			History: []mmodel.InstrumentTransition{{
+				From:              mmodel.InstrumentStatusActive,
+				To:                mmodel.InstrumentStatusBlocked,
+				Actor:             "operations@example.com",
+				Reason:            "card reported stolen",
+				AccountPropagated: true,
+				CreatedAt:         now,
+			}},
+			Revision:  1,
+			UpdatedAt: &now,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	encryptionCtx := testEncryptionContext(instrumentID)
+
+	var model MongoDBModel
+	require.NoError(t, model.FromEntity(ctx, original, fe, encryptionCtx))
+
+	require.NotNil(t, model.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, model.Lifecycle.Status)
+	assert.Equal(t, int64(1), model.Lifecycle.Revision)
+
+	result, err := model.ToEntity(ctx, fe, encryptionCtx)
+	require.NoError(t, err)
+
+	assert.Equal(t, original.Lifecycle, result.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, result.LifecycleStatus())
+}
+
+func TestMongoDBModel_ToEntity_NoLifecycleIsActive(t *testing.T) {
+	t.Parallel()
+
+	fe := setupTestFieldEncryptor(t)
+	instrumentID := uuid.New()
+
+	model := MongoDBModel{ID: &instrumentID}
+
+	result, err := model.ToEntity(context.Background(), fe, testEncryptionContext(instrumentID))
+	require.NoError(t, err)
+
+	assert.Nil(t, result.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusActive, result.LifecycleStatus())
+}
+
 func TestMongoDBModel_ToEntity_InvalidOptionalCiphertextReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package merge
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// HolderMergeMongoDBModel is a holder merge document. The unique index on
+// duplicate_id allows a holder to be merged away only once.
+type HolderMergeMongoDBModel struct {
+	ID          uuid.UUID                     `bson:"_id"`
+	SurvivorID  uuid.UUID                     `bson:"survivor_id"`
+	DuplicateID uuid.UUID                     `bson:"duplicate_id"`
+	Status      string                        `bson:"status"`
+	Actor       string                        `bson:"actor"`
+	Reason      string                        `bson:"reason,omitempty"`
+	Counts      HolderMergeCountsMongoDBModel `bson:"counts"`
+	CreatedAt   time.Time                     `bson:"created_at"`
+	CompletedAt *time.Time                    `bson:"completed_at,omitempty"`
+}
+
+// HolderMergeCountsMongoDBModel counts the records a merge re-pointed.
+type HolderMergeCountsMongoDBModel struct {
+	Accounts       int64 `bson:"accounts"`
+	Instruments    int64 `bson:"instruments"`
+	RelatedParties int64 `bson:"related_parties"`
+}
+
+// FromEntity maps a holder merge to its document.
+func (m *HolderMergeMongoDBModel) FromEntity(merge *mmodel.HolderMerge) {
+	*m = HolderMergeMongoDBModel{
+		ID:          merge.ID,
+		SurvivorID:  merge.SurvivorID,
+		DuplicateID: merge.DuplicateID,
+		Status:      merge.Status,
+		Actor:       merge.Actor,
+		Reason:      merge.Reason,
+		Counts: HolderMergeCountsMongoDBModel{
+			Accounts:       merge.Counts.Accounts,
+			Instruments:    merge.Counts.Instruments,
+			RelatedParties: merge.Counts.RelatedParties,
+		},
+		CreatedAt:   merge.CreatedAt,
+		CompletedAt: merge.CompletedAt,
+	}
+}
+
+// ToEntity maps a holder merge document to the entity.
+func (m *HolderMergeMongoDBModel) ToEntity() *mmodel.HolderMerge {
+	return &mmodel.HolderMerge{
+		ID:          m.ID,
+		SurvivorID:  m.SurvivorID,
+		DuplicateID: m.DuplicateID,
+		Status:      m.Status,
+		Actor:       m.Actor,
+		Reason:      m.Reason,
+		Counts: mmodel.HolderMergeCounts{
+			Accounts:       m.Counts.Accounts,
+			Instruments:    m.Counts.Instruments,
+			RelatedParties: m.Counts.RelatedParties,
+		},
+		CreatedAt:   m.CreatedAt,
+		CompletedAt: m.CompletedAt,
+	}
+}
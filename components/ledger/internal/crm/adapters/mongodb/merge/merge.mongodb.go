@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// mergesPrefix is the collection prefix of the holder merges.
+const mergesPrefix = "holder_merges_"
+
+// indexModels lists the indexes of the merge collection: one merge per
+// duplicate, and the merges of a surviving holder newest first.
+var indexModels = []mongo.IndexModel{
+	{Keys: bson.D{{Key: "duplicate_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+	{Keys: bson.D{{Key: "survivor_id", Value: 1}, {Key: "_id", Value: -1}}},
+}
+
+// Repository persists the holder merges, the audit trail of duplicate holders
+// merged into surviving ones, in per-organization collections
+// (holder_merges_{org}).
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=merge.mongodb_mock.go --package=merge . Repository
+type Repository interface {
+	// Create stores a new merge. It returns ErrHolderMergeConflict when the
+	// duplicate already has a merge.
+	Create(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error
+	// FindByDuplicate returns the merge of a duplicate holder, or nil when the
+	// holder was never merged away.
+	FindByDuplicate(ctx context.Context, organizationID string, duplicateID uuid.UUID) (*mmodel.HolderMerge, error)
+	// FindAll returns the merges into the surviving holder, newest first.
+	FindAll(ctx context.Context, organizationID string, survivorID uuid.UUID) ([]*mmodel.HolderMerge, error)
+	// Update stores the status and counts of a merge.
+	Update(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error
+}
+
+// MongoDBRepository is a MongoDB-specific implementation of Repository.
+type MongoDBRepository struct {
+	connection *libMongo.Client
+}
+
+// NewMongoDBRepository returns a new instance of MongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewMongoDBRepository(connection *libMongo.Client) (*MongoDBRepository, error) {
+	r := &MongoDBRepository{
+		connection: connection,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for merge repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *MongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+// collection resolves the per-organization merge collection and ensures its
+// indexes.
+func (r *MongoDBRepository) collection(ctx context.Context, organizationID string) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(mergesPrefix + organizationID))
+
+	err = globalIndexTracker.ensureOnce(db.Name()+":"+coll.Name(), func() error {
+		_, err := coll.Indexes().CreateMany(ctx, indexModels)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create indexes for %q: %w", coll.Name(), err)
+	}
+
+	return coll, nil
+}
+
+// Create stores a new merge. A duplicate duplicate_id means a concurrent
+// merge of the same holder won, whose survivor is reported in the conflict.
+func (r *MongoDBRepository) Create(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.create_holder_merge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", merge.SurvivorID.String()),
+		attribute.String("app.request.duplicate_id", merge.DuplicateID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderMergeMongoDBModel{}
+	record.FromEntity(merge)
+
+	if _, err := coll.InsertOne(ctx, record); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			libOpentelemetry.HandleSpanError(span, "Failed to insert holder merge", err)
+
+			return err
+		}
+
+		var existing HolderMergeMongoDBModel
+
+		if err := coll.FindOne(ctx, bson.D{{Key: "duplicate_id", Value: merge.DuplicateID}}).Decode(&existing); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to find holder merge", err)
+
+			return err
+		}
+
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderMergeConflict, cn.EntityHolderMerge, merge.DuplicateID.String(), existing.SurvivorID.String())
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder merge conflict", businessErr)
+
+		return businessErr
+	}
+
+	return nil
+}
+
+// FindByDuplicate returns the merge of a duplicate holder, or nil.
+func (r *MongoDBRepository) FindByDuplicate(ctx context.Context, organizationID string, duplicateID uuid.UUID) (*mmodel.HolderMerge, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_merge_by_duplicate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.duplicate_id", duplicateID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	var record HolderMergeMongoDBModel
+
+	if err := coll.FindOne(ctx, bson.D{{Key: "duplicate_id", Value: duplicateID}}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder merge", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity(), nil
+}
+
+// FindAll returns the merges into the surviving holder. Merge ids are UUIDv7,
+// so descending _id order is newest first.
+func (r *MongoDBRepository) FindAll(ctx context.Context, organizationID string, survivorID uuid.UUID) ([]*mmodel.HolderMerge, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_merges")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", survivorID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{{Key: "survivor_id", Value: survivorID}}, options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder merges", err)
+
+		return nil, err
+	}
+
+	var records []HolderMergeMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holder merges", err)
+
+		return nil, err
+	}
+
+	merges := make([]*mmodel.HolderMerge, 0, len(records))
+	for i := range records {
+		merges = append(merges, records[i].ToEntity())
+	}
+
+	return merges, nil
+}
+
+// Update replaces the merge document.
+func (r *MongoDBRepository) Update(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_holder_merge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", merge.SurvivorID.String()),
+		attribute.String("app.request.duplicate_id", merge.DuplicateID.String()),
+		attribute.String("app.request.merge_status", merge.Status),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderMergeMongoDBModel{}
+	record.FromEntity(merge)
+
+	if _, err := coll.ReplaceOne(ctx, bson.D{{Key: "_id", Value: merge.ID}}, record); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update holder merge", err)
+
+		return err
+	}
+
+	return nil
+}
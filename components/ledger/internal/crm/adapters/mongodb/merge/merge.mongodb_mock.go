@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/merge (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=merge.mongodb_mock.go --package=merge . Repository
+//
+
+// Package merge is a generated GoMock package.
+package merge
+
+import (
+	context "context"
+	reflect "reflect"
+
+	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organizationID, merge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, organizationID, merge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, organizationID, merge)
+}
+
+// FindAll mocks base method.
+func (m *MockRepository) FindAll(ctx context.Context, organizationID string, survivorID uuid.UUID) ([]*mmodel.HolderMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, organizationID, survivorID)
+	ret0, _ := ret[0].([]*mmodel.HolderMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRepositoryMockRecorder) FindAll(ctx, organizationID, survivorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), ctx, organizationID, survivorID)
+}
+
+// FindByDuplicate mocks base method.
+func (m *MockRepository) FindByDuplicate(ctx context.Context, organizationID string, duplicateID uuid.UUID) (*mmodel.HolderMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDuplicate", ctx, organizationID, duplicateID)
+	ret0, _ := ret[0].(*mmodel.HolderMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDuplicate indicates an expected call of FindByDuplicate.
+func (mr *MockRepositoryMockRecorder) FindByDuplicate(ctx, organizationID, duplicateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDuplicate", reflect.TypeOf((*MockRepository)(nil).FindByDuplicate), ctx, organizationID, duplicateID)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, organizationID string, merge *mmodel.HolderMerge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, organizationID, merge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, organizationID, merge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, organizationID, merge)
+}
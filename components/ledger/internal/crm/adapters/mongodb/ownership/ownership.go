@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package ownership
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// HolderRelationshipMongoDBModel is an ownership or control relationship
+// document. Effective dates are stored as UTC midnights.
+type HolderRelationshipMongoDBModel struct {
+	ID            uuid.UUID                              `bson:"_id"`
+	HolderID      uuid.UUID                              `bson:"holder_id"`
+	OwnerID       uuid.UUID                              `bson:"owner_id"`
+	OwnerType     string                                 `bson:"owner_type"`
+	Type          string                                 `bson:"type"`
+	Percentage    *float64                               `bson:"percentage,omitempty"`
+	Description   string                                 `bson:"description,omitempty"`
+	EffectiveFrom time.Time                              `bson:"effective_from"`
+	EffectiveTo   *time.Time                             `bson:"effective_to,omitempty"`
+	History       []HolderRelationshipChangeMongoDBModel `bson:"history"`
+	CreatedAt     time.Time                              `bson:"created_at"`
+	UpdatedAt     time.Time                              `bson:"updated_at"`
+	DeletedAt     *time.Time                             `bson:"deleted_at"`
+}
+
+// HolderRelationshipChangeMongoDBModel is one entry of a relationship history.
+type HolderRelationshipChangeMongoDBModel struct {
+	Action        string     `bson:"action"`
+	Actor         string     `bson:"actor"`
+	Percentage    *float64   `bson:"percentage,omitempty"`
+	EffectiveFrom time.Time  `bson:"effective_from"`
+	EffectiveTo   *time.Time `bson:"effective_to,omitempty"`
+	ChangedAt     time.Time  `bson:"changed_at"`
+}
+
+// FromEntity maps a relationship to its document.
+func (hr *HolderRelationshipMongoDBModel) FromEntity(r *mmodel.HolderRelationship) {
+	hr.ID = r.ID
+	hr.HolderID = r.HolderID
+	hr.OwnerID = r.OwnerID
+	hr.OwnerType = r.OwnerType
+	hr.Type = r.Type
+	hr.Percentage = r.Percentage
+	hr.Description = r.Description
+	hr.EffectiveFrom = r.EffectiveFrom.Time
+	hr.EffectiveTo = dateToTime(r.EffectiveTo)
+	hr.CreatedAt = r.CreatedAt
+	hr.UpdatedAt = r.UpdatedAt
+	hr.DeletedAt = r.DeletedAt
+
+	hr.History = make([]HolderRelationshipChangeMongoDBModel, 0, len(r.History))
+	for _, change := range r.History {
+		hr.History = append(hr.History, HolderRelationshipChangeMongoDBModel{
+			Action:        change.Action,
+			Actor:         change.Actor,
+			Percentage:    change.Percentage,
+			EffectiveFrom: change.EffectiveFrom.Time,
+			EffectiveTo:   dateToTime(change.EffectiveTo),
+			ChangedAt:     change.ChangedAt,
+		})
+	}
+}
+
+// ToEntity maps a document to its relationship.
+func (hr *HolderRelationshipMongoDBModel) ToEntity() *mmodel.HolderRelationship {
+	r := &mmodel.HolderRelationship{
+		ID:            hr.ID,
+		HolderID:      hr.HolderID,
+		OwnerID:       hr.OwnerID,
+		OwnerType:     hr.OwnerType,
+		Type:          hr.Type,
+		Percentage:    hr.Percentage,
+		Description:   hr.Description,
+		EffectiveFrom: mmodel.Date{Time: hr.EffectiveFrom.UTC()},
+		EffectiveTo:   timeToDate(hr.EffectiveTo),
+		History:       make([]mmodel.HolderRelationshipChange, 0, len(hr.History)),
+		CreatedAt:     hr.CreatedAt,
+		UpdatedAt:     hr.UpdatedAt,
+		DeletedAt:     hr.DeletedAt,
+	}
+
+	for _, change := range hr.History {
+		r.History = append(r.History, mmodel.HolderRelationshipChange{
+			Action:        change.Action,
+			Actor:         change.Actor,
+			Percentage:    change.Percentage,
+			EffectiveFrom: mmodel.Date{Time: change.EffectiveFrom.UTC()},
+			EffectiveTo:   timeToDate(change.EffectiveTo),
+			ChangedAt:     change.ChangedAt,
+		})
+	}
+
+	return r
+}
+
+func dateToTime(d *mmodel.Date) *time.Time {
+	if d == nil {
+		return nil
+	}
+
+	t := d.Time
+
+	return &t
+}
+
+func timeToDate(t *time.Time) *mmodel.Date {
+	if t == nil {
+		return nil
+	}
+
+	return &mmodel.Date{Time: t.UTC()}
+}
@@ -0,0 +1,360 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package ownership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// collectionPrefix names the per-organization relationships collection.
+const collectionPrefix = "holder_relationships_"
+
+// Repository persists ownership and control relationships between holders in
+// a per-organization collection (holder_relationships_{org}). Relationships
+// are soft-deleted so their history stays auditable.
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=ownership.mongodb_mock.go --package=ownership . Repository
+type Repository interface {
+	// Create stores a new relationship.
+	Create(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship) error
+	// Find returns a relationship of the owned holder, or
+	// ErrHolderRelationshipNotFound.
+	Find(ctx context.Context, organizationID string, holderID, id uuid.UUID, includeDeleted bool) (*mmodel.HolderRelationship, error)
+	// FindByHolders returns the relationships in which any of the given
+	// holders is owned or controlled, ordered by creation.
+	FindByHolders(ctx context.Context, organizationID string, holderIDs []uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error)
+	// FindByOwner returns the relationships in which the holder owns or
+	// controls another holder, ordered by creation.
+	FindByOwner(ctx context.Context, organizationID string, ownerID uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error)
+	// Update stores the terms and deletion of a live relationship and appends
+	// change to its history. It returns ErrHolderRelationshipNotFound when the
+	// relationship is missing or already deleted.
+	Update(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship, change mmodel.HolderRelationshipChange) error
+}
+
+// MongoDBRepository is a MongoDB-specific implementation of Repository.
+type MongoDBRepository struct {
+	connection *libMongo.Client
+}
+
+// NewMongoDBRepository returns a new instance of MongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewMongoDBRepository(connection *libMongo.Client) (*MongoDBRepository, error) {
+	r := &MongoDBRepository{
+		connection: connection,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for ownership repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *MongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+// collection resolves the organization's relationships collection and ensures
+// its indexes: owned holder for the ownership walk, owner for the reverse
+// lookups.
+func (r *MongoDBRepository) collection(ctx context.Context, organizationID string) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(collectionPrefix + organizationID))
+
+	err = globalIndexTracker.ensureOnce(db.Name()+":"+coll.Name(), func() error {
+		_, err := coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "holder_id", Value: 1}, {Key: "deleted_at", Value: 1}}},
+			{Keys: bson.D{{Key: "owner_id", Value: 1}}},
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create indexes for %q: %w", coll.Name(), err)
+	}
+
+	return coll, nil
+}
+
+// Create stores a new relationship.
+func (r *MongoDBRepository) Create(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.create_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", relationship.HolderID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderRelationshipMongoDBModel{}
+	record.FromEntity(relationship)
+
+	if _, err := coll.InsertOne(ctx, record); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to insert holder relationship", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// Find returns a relationship of the owned holder, or ErrHolderRelationshipNotFound.
+func (r *MongoDBRepository) Find(ctx context.Context, organizationID string, holderID, id uuid.UUID, includeDeleted bool) (*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.relationship_id", id.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "holder_id", Value: holderID}}
+	if !includeDeleted {
+		filter = append(filter, bson.E{Key: "deleted_at", Value: nil})
+	}
+
+	var record HolderRelationshipMongoDBModel
+
+	if err := coll.FindOne(ctx, filter).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			businessErr := pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder relationship not found", businessErr)
+
+			return nil, businessErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder relationship", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity(), nil
+}
+
+// FindByHolders returns the relationships in which any of the given holders
+// is owned or controlled. Relationship ids are UUIDv7, so _id order is
+// creation order.
+func (r *MongoDBRepository) FindByHolders(ctx context.Context, organizationID string, holderIDs []uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_relationships")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.holder_count", len(holderIDs)),
+	)
+
+	if len(holderIDs) == 0 {
+		return []*mmodel.HolderRelationship{}, nil
+	}
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "holder_id", Value: bson.D{{Key: "$in", Value: holderIDs}}}}
+	if !includeDeleted {
+		filter = append(filter, bson.E{Key: "deleted_at", Value: nil})
+	}
+
+	cursor, err := coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder relationships", err)
+
+		return nil, err
+	}
+
+	var records []HolderRelationshipMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holder relationships", err)
+
+		return nil, err
+	}
+
+	relationships := make([]*mmodel.HolderRelationship, 0, len(records))
+	for i := range records {
+		relationships = append(relationships, records[i].ToEntity())
+	}
+
+	return relationships, nil
+}
+
+// FindByOwner returns the relationships in which the holder owns or controls
+// another holder, in creation order.
+func (r *MongoDBRepository) FindByOwner(ctx context.Context, organizationID string, ownerID uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_holder_relationships_by_owner")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.owner_id", ownerID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "owner_id", Value: ownerID}}
+	if !includeDeleted {
+		filter = append(filter, bson.E{Key: "deleted_at", Value: nil})
+	}
+
+	cursor, err := coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find holder relationships by owner", err)
+
+		return nil, err
+	}
+
+	var records []HolderRelationshipMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode holder relationships", err)
+
+		return nil, err
+	}
+
+	relationships := make([]*mmodel.HolderRelationship, 0, len(records))
+	for i := range records {
+		relationships = append(relationships, records[i].ToEntity())
+	}
+
+	return relationships, nil
+}
+
+// Update stores the terms and deletion of a live relationship and appends
+// change to its history in one atomic write.
+func (r *MongoDBRepository) Update(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship, change mmodel.HolderRelationshipChange) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", relationship.HolderID.String()),
+		attribute.String("app.request.relationship_id", relationship.ID.String()),
+	)
+
+	coll, err := r.collection(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	record := &HolderRelationshipMongoDBModel{}
+	record.FromEntity(relationship)
+
+	changeRecord := HolderRelationshipChangeMongoDBModel{
+		Action:        change.Action,
+		Actor:         change.Actor,
+		Percentage:    change.Percentage,
+		EffectiveFrom: change.EffectiveFrom.Time,
+		EffectiveTo:   dateToTime(change.EffectiveTo),
+		ChangedAt:     change.ChangedAt,
+	}
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "percentage", Value: record.Percentage},
+			{Key: "description", Value: record.Description},
+			{Key: "effective_to", Value: record.EffectiveTo},
+			{Key: "updated_at", Value: record.UpdatedAt},
+			{Key: "deleted_at", Value: record.DeletedAt},
+		}},
+		{Key: "$push", Value: bson.D{{Key: "history", Value: changeRecord}}},
+	}
+
+	result, err := coll.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: relationship.ID},
+		{Key: "holder_id", Value: relationship.HolderID},
+		{Key: "deleted_at", Value: nil},
+	}, update)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update holder relationship", err)
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Holder relationship not found", businessErr)
+
+		return businessErr
+	}
+
+	return nil
+}
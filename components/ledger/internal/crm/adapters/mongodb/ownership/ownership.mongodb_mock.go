@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=ownership.mongodb_mock.go --package=ownership . Repository
+//
+
+// Package ownership is a generated GoMock package.
+package ownership
+
+import (
+	context "context"
+	reflect "reflect"
+
+	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organizationID, relationship)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, organizationID, relationship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, organizationID, relationship)
+}
+
+// Find mocks base method.
+func (m *MockRepository) Find(ctx context.Context, organizationID string, holderID, id uuid.UUID, includeDeleted bool) (*mmodel.HolderRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, organizationID, holderID, id, includeDeleted)
+	ret0, _ := ret[0].(*mmodel.HolderRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRepositoryMockRecorder) Find(ctx, organizationID, holderID, id, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRepository)(nil).Find), ctx, organizationID, holderID, id, includeDeleted)
+}
+
+// FindByHolders mocks base method.
+func (m *MockRepository) FindByHolders(ctx context.Context, organizationID string, holderIDs []uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHolders", ctx, organizationID, holderIDs, includeDeleted)
+	ret0, _ := ret[0].([]*mmodel.HolderRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHolders indicates an expected call of FindByHolders.
+func (mr *MockRepositoryMockRecorder) FindByHolders(ctx, organizationID, holderIDs, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHolders", reflect.TypeOf((*MockRepository)(nil).FindByHolders), ctx, organizationID, holderIDs, includeDeleted)
+}
+
+// FindByOwner mocks base method.
+func (m *MockRepository) FindByOwner(ctx context.Context, organizationID string, ownerID uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, organizationID, ownerID, includeDeleted)
+	ret0, _ := ret[0].([]*mmodel.HolderRelationship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockRepositoryMockRecorder) FindByOwner(ctx, organizationID, ownerID, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockRepository)(nil).FindByOwner), ctx, organizationID, ownerID, includeDeleted)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, organizationID string, relationship *mmodel.HolderRelationship, change mmodel.HolderRelationshipChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, organizationID, relationship, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, organizationID, relationship, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, organizationID, relationship, change)
+}
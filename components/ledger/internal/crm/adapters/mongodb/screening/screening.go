@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// WatchListMongoDBModel is a watch list document, keyed by the list name.
+type WatchListMongoDBModel struct {
+	Name         string                    `bson:"_id"`
+	Format       string                    `bson:"format"`
+	Version      int64                     `bson:"version"`
+	EntryCount   int                       `bson:"entry_count"`
+	Checksum     string                    `bson:"checksum"`
+	UploadedBy   string                    `bson:"uploaded_by"`
+	LastRescreen *ScreeningRunMongoDBModel `bson:"last_rescreen,omitempty"`
+	CreatedAt    time.Time                 `bson:"created_at"`
+	UpdatedAt    time.Time                 `bson:"updated_at"`
+}
+
+type ScreeningRunMongoDBModel struct {
+	Status          string     `bson:"status"`
+	HoldersScreened int64      `bson:"holders_screened"`
+	PartiesScreened int64      `bson:"parties_screened"`
+	AlertsRaised    int64      `bson:"alerts_raised"`
+	LastErrorCode   string     `bson:"last_error_code,omitempty"`
+	StartedAt       time.Time  `bson:"started_at"`
+	CompletedAt     *time.Time `bson:"completed_at,omitempty"`
+}
+
+// WatchListEntryMongoDBModel is one entry of a watch list version. Entries of
+// a version are written before the list document points at it, and the
+// entries of older versions are removed afterwards.
+type WatchListEntryMongoDBModel struct {
+	ListName    string   `bson:"list_name"`
+	ListVersion int64    `bson:"list_version"`
+	SourceID    string   `bson:"source_id"`
+	EntityType  string   `bson:"entity_type,omitempty"`
+	Name        string   `bson:"name"`
+	Aliases     []string `bson:"aliases,omitempty"`
+	Documents   []string `bson:"documents,omitempty"`
+	Programs    []string `bson:"programs,omitempty"`
+}
+
+// ScreeningAlertMongoDBModel is a screening alert document. DedupeKey is
+// unique, so the same match found again by a later screening refreshes the
+// existing alert instead of raising a new one.
+type ScreeningAlertMongoDBModel struct {
+	ID             uuid.UUID  `bson:"_id"`
+	DedupeKey      string     `bson:"dedupe_key"`
+	HolderID       uuid.UUID  `bson:"holder_id"`
+	SubjectType    string     `bson:"subject_type"`
+	InstrumentID   *uuid.UUID `bson:"instrument_id,omitempty"`
+	RelatedPartyID *uuid.UUID `bson:"related_party_id,omitempty"`
+	MatchedField   string     `bson:"matched_field"`
+	ListName       string     `bson:"list_name"`
+	ListVersion    int64      `bson:"list_version"`
+	EntrySourceID  string     `bson:"entry_source_id"`
+	EntryName      string     `bson:"entry_name"`
+	EntryPrograms  []string   `bson:"entry_programs,omitempty"`
+	Score          float64    `bson:"score"`
+	Status         string     `bson:"status"`
+	Reviewer       string     `bson:"reviewer,omitempty"`
+	ReviewNote     string     `bson:"review_note,omitempty"`
+	ReviewedAt     *time.Time `bson:"reviewed_at,omitempty"`
+	CreatedAt      time.Time  `bson:"created_at"`
+	LastSeenAt     time.Time  `bson:"last_seen_at"`
+}
+
+// PendingScreeningMongoDBModel is a subject whose screening failed, keyed by
+// PendingScreeningKey so repeated failures update one document.
+type PendingScreeningMongoDBModel struct {
+	Key           string     `bson:"_id"`
+	HolderID      uuid.UUID  `bson:"holder_id"`
+	SubjectType   string     `bson:"subject_type"`
+	InstrumentID  *uuid.UUID `bson:"instrument_id,omitempty"`
+	Attempts      int64      `bson:"attempts"`
+	CreatedAt     time.Time  `bson:"created_at"`
+	LastAttemptAt time.Time  `bson:"last_attempt_at"`
+}
+
+// FromEntity maps a watch list entity to its MongoDB model.
+func (wl *WatchListMongoDBModel) FromEntity(l *mmodel.WatchList) {
+	*wl = WatchListMongoDBModel{
+		Name:         l.Name,
+		Format:       l.Format,
+		Version:      l.Version,
+		EntryCount:   l.EntryCount,
+		Checksum:     l.Checksum,
+		UploadedBy:   l.UploadedBy,
+		LastRescreen: mapRunFromEntity(l.LastRescreen),
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+// ToEntity maps a watch list MongoDB model to its entity.
+func (wl *WatchListMongoDBModel) ToEntity() *mmodel.WatchList {
+	l := &mmodel.WatchList{
+		Name:       wl.Name,
+		Format:     wl.Format,
+		Version:    wl.Version,
+		EntryCount: wl.EntryCount,
+		Checksum:   wl.Checksum,
+		UploadedBy: wl.UploadedBy,
+		CreatedAt:  wl.CreatedAt,
+		UpdatedAt:  wl.UpdatedAt,
+	}
+
+	if wl.LastRescreen != nil {
+		run := mmodel.ScreeningRun(*wl.LastRescreen)
+		l.LastRescreen = &run
+	}
+
+	return l
+}
+
+// mapRunFromEntity maps a screening run to its MongoDB model; nil maps to nil.
+func mapRunFromEntity(r *mmodel.ScreeningRun) *ScreeningRunMongoDBModel {
+	if r == nil {
+		return nil
+	}
+
+	run := ScreeningRunMongoDBModel(*r)
+
+	return &run
+}
+
+// mapEntryFromEntity maps a watch list entry of the given list version.
+func mapEntryFromEntity(listName string, listVersion int64, e mmodel.WatchListEntry) *WatchListEntryMongoDBModel {
+	return &WatchListEntryMongoDBModel{
+		ListName:    listName,
+		ListVersion: listVersion,
+		SourceID:    e.SourceID,
+		EntityType:  e.EntityType,
+		Name:        e.Name,
+		Aliases:     e.Aliases,
+		Documents:   e.Documents,
+		Programs:    e.Programs,
+	}
+}
+
+// ToEntity maps a watch list entry MongoDB model to its entity.
+func (we *WatchListEntryMongoDBModel) ToEntity() mmodel.WatchListEntry {
+	return mmodel.WatchListEntry{
+		SourceID:   we.SourceID,
+		EntityType: we.EntityType,
+		Name:       we.Name,
+		Aliases:    we.Aliases,
+		Documents:  we.Documents,
+		Programs:   we.Programs,
+	}
+}
+
+// FromEntity maps a screening alert entity to its MongoDB model.
+func (sa *ScreeningAlertMongoDBModel) FromEntity(a *mmodel.ScreeningAlert) {
+	*sa = ScreeningAlertMongoDBModel{
+		ID:             a.ID,
+		DedupeKey:      AlertDedupeKey(a),
+		HolderID:       a.HolderID,
+		SubjectType:    a.SubjectType,
+		InstrumentID:   a.InstrumentID,
+		RelatedPartyID: a.RelatedPartyID,
+		MatchedField:   a.MatchedField,
+		ListName:       a.ListName,
+		ListVersion:    a.ListVersion,
+		EntrySourceID:  a.EntrySourceID,
+		EntryName:      a.EntryName,
+		EntryPrograms:  a.EntryPrograms,
+		Score:          a.Score,
+		Status:         a.Status,
+		Reviewer:       a.Reviewer,
+		ReviewNote:     a.ReviewNote,
+		ReviewedAt:     a.ReviewedAt,
+		CreatedAt:      a.CreatedAt,
+		LastSeenAt:     a.LastSeenAt,
+	}
+}
+
+// ToEntity maps a screening alert MongoDB model to its entity.
+func (sa *ScreeningAlertMongoDBModel) ToEntity() *mmodel.ScreeningAlert {
+	return &mmodel.ScreeningAlert{
+		ID:             sa.ID,
+		HolderID:       sa.HolderID,
+		SubjectType:    sa.SubjectType,
+		InstrumentID:   sa.InstrumentID,
+		RelatedPartyID: sa.RelatedPartyID,
+		MatchedField:   sa.MatchedField,
+		ListName:       sa.ListName,
+		ListVersion:    sa.ListVersion,
+		EntrySourceID:  sa.EntrySourceID,
+		EntryName:      sa.EntryName,
+		EntryPrograms:  sa.EntryPrograms,
+		Score:          sa.Score,
+		Status:         sa.Status,
+		Reviewer:       sa.Reviewer,
+		ReviewNote:     sa.ReviewNote,
+		ReviewedAt:     sa.ReviewedAt,
+		CreatedAt:      sa.CreatedAt,
+		LastSeenAt:     sa.LastSeenAt,
+	}
+}
+
+// AlertDedupeKey identifies a match independently of when it was found: the
+// screened subject, the list entry and the matched field. It is hashed so the
+// key reveals nothing beyond what the alert itself stores.
+func AlertDedupeKey(a *mmodel.ScreeningAlert) string {
+	subject := a.HolderID.String()
+	if a.RelatedPartyID != nil {
+		subject += "/" + a.RelatedPartyID.String()
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		a.SubjectType, subject, a.ListName, a.EntrySourceID, a.MatchedField,
+	}, "|")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ToEntity maps a pending screening MongoDB model to its entity.
+func (ps *PendingScreeningMongoDBModel) ToEntity() *mmodel.PendingScreening {
+	return &mmodel.PendingScreening{
+		HolderID:      ps.HolderID,
+		SubjectType:   ps.SubjectType,
+		InstrumentID:  ps.InstrumentID,
+		Attempts:      ps.Attempts,
+		CreatedAt:     ps.CreatedAt,
+		LastAttemptAt: ps.LastAttemptAt,
+	}
+}
+
+// PendingScreeningKey identifies the screened subject: the holder, or the
+// instrument whose related parties are screened together.
+func PendingScreeningKey(p *mmodel.PendingScreening) string {
+	if p.SubjectType == mmodel.ScreeningSubjectRelatedParty && p.InstrumentID != nil {
+		return p.SubjectType + ":" + p.InstrumentID.String()
+	}
+
+	return p.SubjectType + ":" + p.HolderID.String()
+}
@@ -0,0 +1,856 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	libMongo "github.com/LerianStudio/lib-commons/v5/commons/mongo"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// entryInsertBatchSize bounds the documents of a single InsertMany when a list
+// version is written.
+const entryInsertBatchSize = 1000
+
+// Repository persists watch lists, their entries, screening alerts and the
+// subjects whose screening failed in per-organization collections
+// (watchlists_{org}, watchlist_entries_{org}, screening_alerts_{org} and
+// screening_pending_{org}).
+//
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 --destination=screening.mongodb_mock.go --package=screening . Repository
+type Repository interface {
+	// FindList returns a watch list, or ErrWatchListNotFound.
+	FindList(ctx context.Context, organizationID, name string) (*mmodel.WatchList, error)
+	// FindLists returns every watch list of the organization, ordered by name.
+	FindLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error)
+	// ReplaceList stores a new version of a list together with its entries,
+	// provided the stored list is still at expectedVersion (0 for a new list),
+	// and returns ErrWatchListUploadConflict otherwise.
+	ReplaceList(ctx context.Context, organizationID string, list *mmodel.WatchList, entries []mmodel.WatchListEntry, expectedVersion int64) error
+	// DeleteList removes a list and its entries, or returns ErrWatchListNotFound.
+	DeleteList(ctx context.Context, organizationID, name string) error
+	// FindEntries returns the entries of a list version.
+	FindEntries(ctx context.Context, organizationID, name string, version int64) ([]mmodel.WatchListEntry, error)
+	// UpdateRescreen records the progress of a re-screening on the named lists.
+	UpdateRescreen(ctx context.Context, organizationID string, names []string, run *mmodel.ScreeningRun) error
+	// UpsertAlert raises an alert, or refreshes last_seen_at of the alert of
+	// the same match. It reports whether a new alert was raised.
+	UpsertAlert(ctx context.Context, organizationID string, alert *mmodel.ScreeningAlert) (bool, error)
+	// FindAlert returns an alert, or ErrScreeningAlertNotFound.
+	FindAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error)
+	// FindAlerts returns a page of alerts, newest first.
+	FindAlerts(ctx context.Context, organizationID string, query AlertQuery) ([]*mmodel.ScreeningAlert, error)
+	// ReviewAlert closes an OPEN alert with the given status. It returns
+	// ErrScreeningAlertNotFound or ErrScreeningAlertAlreadyReviewed.
+	ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, status, reviewer, note string, reviewedAt time.Time) (*mmodel.ScreeningAlert, error)
+	// MarkPending records a failed screening of the subject, counting the
+	// attempts of a subject already pending.
+	MarkPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening) error
+	// FindPending returns a page of pending screenings, oldest first.
+	FindPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error)
+	// ClearPending removes the pending screening of the subject, or every
+	// pending screening when pending is nil, whose last failure is before
+	// screenedAt, so a failure recorded after the screening started stays.
+	ClearPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening, screenedAt time.Time) error
+}
+
+// AlertQuery filters and paginates FindAlerts. Empty filters match every alert.
+type AlertQuery struct {
+	Status   string
+	HolderID *uuid.UUID
+	ListName string
+	Page     http.QueryHeader
+}
+
+// MongoDBRepository is a MongoDB-specific implementation of Repository.
+type MongoDBRepository struct {
+	connection *libMongo.Client
+}
+
+// NewMongoDBRepository returns a new instance of MongoDBRepository using the given MongoDB connection.
+// In multi-tenant mode, connection may be nil — the per-request tenant context provides the database.
+func NewMongoDBRepository(connection *libMongo.Client) (*MongoDBRepository, error) {
+	r := &MongoDBRepository{
+		connection: connection,
+	}
+
+	if connection != nil {
+		if _, err := r.connection.Database(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to connect to MongoDB for screening repository: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// getDatabase resolves the MongoDB database for the current request.
+// In multi-tenant mode, the middleware injects a tenant-specific *mongo.Database into context.
+// In single-tenant mode (or when no tenant context exists), falls back to the static connection.
+func (r *MongoDBRepository) getDatabase(ctx context.Context) (*mongo.Database, error) {
+	if r.connection == nil {
+		if db := tmcore.GetMBContext(ctx); db != nil {
+			return db, nil
+		}
+
+		return nil, fmt.Errorf("no database connection available: multi-tenant context required but not present, and no static connection configured")
+	}
+
+	if db := tmcore.GetMBContext(ctx); db != nil {
+		return db, nil
+	}
+
+	return r.connection.Database(ctx)
+}
+
+// collection resolves a per-organization collection and ensures its indexes.
+func (r *MongoDBRepository) collection(ctx context.Context, prefix, organizationID string) (*mongo.Collection, error) {
+	db, err := r.getDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := db.Collection(strings.ToLower(prefix + organizationID))
+
+	indexes := collectionIndexes[prefix]
+	if len(indexes) == 0 {
+		return coll, nil
+	}
+
+	err = globalIndexTracker.ensureOnce(db.Name()+":"+coll.Name(), func() error {
+		_, err := coll.Indexes().CreateMany(ctx, indexes)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create indexes for %q: %w", coll.Name(), err)
+	}
+
+	return coll, nil
+}
+
+// Collection prefixes of the screening data.
+const (
+	listsPrefix   = "watchlists_"
+	entriesPrefix = "watchlist_entries_"
+	alertsPrefix  = "screening_alerts_"
+	pendingPrefix = "screening_pending_"
+)
+
+// collectionIndexes lists the indexes of each screening collection. Lists are
+// keyed by name (_id) and need none.
+var collectionIndexes = map[string][]mongo.IndexModel{
+	entriesPrefix: {
+		{Keys: bson.D{{Key: "list_name", Value: 1}, {Key: "list_version", Value: 1}}},
+	},
+	alertsPrefix: {
+		{Keys: bson.D{{Key: "dedupe_key", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "holder_id", Value: 1}, {Key: "_id", Value: -1}}},
+	},
+	pendingPrefix: {
+		{Keys: bson.D{{Key: "created_at", Value: 1}}},
+	},
+}
+
+// FindList returns a watch list, or ErrWatchListNotFound.
+func (r *MongoDBRepository) FindList(ctx context.Context, organizationID, name string) (*mmodel.WatchList, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_watch_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.watch_list", name),
+	)
+
+	coll, err := r.collection(ctx, listsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	var record WatchListMongoDBModel
+
+	if err := coll.FindOne(ctx, bson.D{{Key: "_id", Value: name}}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			businessErr := pkg.ValidateBusinessError(cn.ErrWatchListNotFound, cn.EntityWatchList, name)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Watch list not found", businessErr)
+
+			return nil, businessErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find watch list", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity(), nil
+}
+
+// FindLists returns every watch list of the organization, ordered by name.
+func (r *MongoDBRepository) FindLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_watch_lists")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	coll, err := r.collection(ctx, listsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find watch lists", err)
+
+		return nil, err
+	}
+
+	var records []WatchListMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode watch lists", err)
+
+		return nil, err
+	}
+
+	lists := make([]*mmodel.WatchList, 0, len(records))
+	for i := range records {
+		lists = append(lists, records[i].ToEntity())
+	}
+
+	return lists, nil
+}
+
+// ReplaceList writes the entries of the new version first, then points the
+// list document at it under optimistic concurrency, and finally removes the
+// entries of older versions. Readers only load the entries of the version the
+// list document names, so they never see a half-written version. A lost race
+// removes the entries written by this call.
+func (r *MongoDBRepository) ReplaceList(ctx context.Context, organizationID string, list *mmodel.WatchList, entries []mmodel.WatchListEntry, expectedVersion int64) error {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.replace_watch_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.watch_list", list.Name),
+		attribute.Int64("app.request.watch_list_version", list.Version),
+		attribute.Int("app.request.watch_list_entries", len(entries)),
+	)
+
+	listColl, err := r.collection(ctx, listsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	entryColl, err := r.collection(ctx, entriesPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	version := bson.D{{Key: "list_name", Value: list.Name}, {Key: "list_version", Value: list.Version}}
+
+	// Leftovers of an earlier attempt at the same version are discarded first.
+	if _, err := entryColl.DeleteMany(ctx, version); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to clear watch list version", err)
+
+		return err
+	}
+
+	for start := 0; start < len(entries); start += entryInsertBatchSize {
+		end := min(start+entryInsertBatchSize, len(entries))
+
+		docs := make([]any, 0, end-start)
+		for _, e := range entries[start:end] {
+			docs = append(docs, mapEntryFromEntity(list.Name, list.Version, e))
+		}
+
+		if _, err := entryColl.InsertMany(ctx, docs); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to insert watch list entries", err)
+
+			return err
+		}
+	}
+
+	var record WatchListMongoDBModel
+
+	record.FromEntity(list)
+
+	swapped, err := r.swapListVersion(ctx, listColl, &record, expectedVersion)
+	if err != nil || !swapped {
+		if _, cleanupErr := entryColl.DeleteMany(ctx, version); cleanupErr != nil {
+			logger.Log(ctx, libLog.LevelWarn, "Failed to remove entries of a rejected watch list version",
+				libLog.String("watch_list", list.Name), libLog.Err(cleanupErr))
+		}
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to store watch list", err)
+
+		return err
+	}
+
+	if !swapped {
+		businessErr := pkg.ValidateBusinessError(cn.ErrWatchListUploadConflict, cn.EntityWatchList)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Watch list replaced concurrently", businessErr)
+
+		return businessErr
+	}
+
+	stale := bson.D{
+		{Key: "list_name", Value: list.Name},
+		{Key: "list_version", Value: bson.D{{Key: "$ne", Value: list.Version}}},
+	}
+
+	if _, err := entryColl.DeleteMany(ctx, stale); err != nil {
+		// Stale entries are never read again; the next upload retries the cleanup.
+		logger.Log(ctx, libLog.LevelWarn, "Failed to remove entries of previous watch list versions",
+			libLog.String("watch_list", list.Name), libLog.Err(err))
+	}
+
+	return nil
+}
+
+// swapListVersion inserts a new list document (expectedVersion 0) or replaces
+// the stored one still at expectedVersion, and reports whether it did.
+func (r *MongoDBRepository) swapListVersion(ctx context.Context, coll *mongo.Collection, record *WatchListMongoDBModel, expectedVersion int64) (bool, error) {
+	if expectedVersion == 0 {
+		if _, err := coll.InsertOne(ctx, record); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	result, err := coll.ReplaceOne(ctx, bson.D{
+		{Key: "_id", Value: record.Name},
+		{Key: "version", Value: expectedVersion},
+	}, record)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// DeleteList removes a list and its entries, or returns ErrWatchListNotFound.
+// Alerts raised by the list are kept for the compliance record.
+func (r *MongoDBRepository) DeleteList(ctx context.Context, organizationID, name string) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.delete_watch_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.watch_list", name),
+	)
+
+	listColl, err := r.collection(ctx, listsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	entryColl, err := r.collection(ctx, entriesPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	result, err := listColl.DeleteOne(ctx, bson.D{{Key: "_id", Value: name}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to delete watch list", err)
+
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		businessErr := pkg.ValidateBusinessError(cn.ErrWatchListNotFound, cn.EntityWatchList, name)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Watch list not found", businessErr)
+
+		return businessErr
+	}
+
+	if _, err := entryColl.DeleteMany(ctx, bson.D{{Key: "list_name", Value: name}}); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to delete watch list entries", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// FindEntries returns the entries of a list version.
+func (r *MongoDBRepository) FindEntries(ctx context.Context, organizationID, name string, version int64) ([]mmodel.WatchListEntry, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_watch_list_entries")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.watch_list", name),
+		attribute.Int64("app.request.watch_list_version", version),
+	)
+
+	coll, err := r.collection(ctx, entriesPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{{Key: "list_name", Value: name}, {Key: "list_version", Value: version}})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find watch list entries", err)
+
+		return nil, err
+	}
+
+	var records []WatchListEntryMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode watch list entries", err)
+
+		return nil, err
+	}
+
+	entries := make([]mmodel.WatchListEntry, 0, len(records))
+	for i := range records {
+		entries = append(entries, records[i].ToEntity())
+	}
+
+	return entries, nil
+}
+
+// UpdateRescreen records the progress of a re-screening on the named lists.
+// Lists deleted in the meantime are skipped.
+func (r *MongoDBRepository) UpdateRescreen(ctx context.Context, organizationID string, names []string, run *mmodel.ScreeningRun) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.update_watch_list_rescreen")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.screening_run_status", run.Status),
+	)
+
+	coll, err := r.collection(ctx, listsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: names}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "last_rescreen", Value: mapRunFromEntity(run)}}}}
+
+	if _, err := coll.UpdateMany(ctx, filter, update); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to update watch list rescreen", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// UpsertAlert raises an alert, or refreshes last_seen_at of the alert with the
+// same dedupe key; a reviewed alert keeps its decision.
+func (r *MongoDBRepository) UpsertAlert(ctx context.Context, organizationID string, alert *mmodel.ScreeningAlert) (bool, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.upsert_screening_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", alert.HolderID.String()),
+		attribute.String("app.request.watch_list", alert.ListName),
+	)
+
+	coll, err := r.collection(ctx, alertsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return false, err
+	}
+
+	var record ScreeningAlertMongoDBModel
+
+	record.FromEntity(alert)
+
+	onInsert, err := bson.Marshal(record)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to encode screening alert", err)
+
+		return false, err
+	}
+
+	var insertDoc bson.D
+	if err := bson.Unmarshal(onInsert, &insertDoc); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to encode screening alert", err)
+
+		return false, err
+	}
+
+	setOnInsert := make(bson.D, 0, len(insertDoc))
+
+	for _, field := range insertDoc {
+		if field.Key != "last_seen_at" {
+			setOnInsert = append(setOnInsert, field)
+		}
+	}
+
+	update := bson.D{
+		{Key: "$setOnInsert", Value: setOnInsert},
+		{Key: "$set", Value: bson.D{{Key: "last_seen_at", Value: record.LastSeenAt}}},
+	}
+
+	result, err := coll.UpdateOne(ctx, bson.D{{Key: "dedupe_key", Value: record.DedupeKey}}, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		// Two screenings raising the same alert at once: the loser's upsert
+		// hits the unique dedupe key, and the alert exists either way.
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to upsert screening alert", err)
+
+		return false, err
+	}
+
+	return result.UpsertedCount > 0, nil
+}
+
+// FindAlert returns an alert, or ErrScreeningAlertNotFound.
+func (r *MongoDBRepository) FindAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_screening_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.screening_alert_id", id.String()),
+	)
+
+	coll, err := r.collection(ctx, alertsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	var record ScreeningAlertMongoDBModel
+
+	if err := coll.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			businessErr := pkg.ValidateBusinessError(cn.ErrScreeningAlertNotFound, cn.EntityScreeningAlert)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Screening alert not found", businessErr)
+
+			return nil, businessErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to find screening alert", err)
+
+		return nil, err
+	}
+
+	return record.ToEntity(), nil
+}
+
+// FindAlerts returns a page of alerts, newest first (alert ids are UUIDv7).
+func (r *MongoDBRepository) FindAlerts(ctx context.Context, organizationID string, query AlertQuery) ([]*mmodel.ScreeningAlert, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_screening_alerts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.query.limit", query.Page.Limit),
+		attribute.Int("app.request.query.page", query.Page.Page),
+	)
+
+	coll, err := r.collection(ctx, alertsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	filter := bson.D{}
+	if query.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: query.Status})
+	}
+
+	if query.HolderID != nil {
+		filter = append(filter, bson.E{Key: "holder_id", Value: *query.HolderID})
+	}
+
+	if query.ListName != "" {
+		filter = append(filter, bson.E{Key: "list_name", Value: query.ListName})
+	}
+
+	limit := int64(query.Page.Limit)
+	skip := int64(query.Page.Page*query.Page.Limit - query.Page.Limit)
+	opts := options.Find().SetLimit(limit).SetSkip(skip).SetSort(bson.D{{Key: "_id", Value: -1}})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find screening alerts", err)
+
+		return nil, err
+	}
+
+	var records []ScreeningAlertMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode screening alerts", err)
+
+		return nil, err
+	}
+
+	alerts := make([]*mmodel.ScreeningAlert, 0, len(records))
+	for i := range records {
+		alerts = append(alerts, records[i].ToEntity())
+	}
+
+	return alerts, nil
+}
+
+// ReviewAlert closes an OPEN alert with the given status. Only OPEN alerts
+// match the update, so a decision is never overwritten.
+func (r *MongoDBRepository) ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, status, reviewer, note string, reviewedAt time.Time) (*mmodel.ScreeningAlert, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.review_screening_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.screening_alert_id", id.String()),
+		attribute.String("app.request.screening_alert_status", status),
+	)
+
+	coll, err := r.collection(ctx, alertsPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "status", Value: mmodel.ScreeningAlertOpen}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: status},
+		{Key: "reviewer", Value: reviewer},
+		{Key: "review_note", Value: note},
+		{Key: "reviewed_at", Value: reviewedAt},
+	}}}
+
+	var record ScreeningAlertMongoDBModel
+
+	err = coll.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&record)
+	if err == nil {
+		return record.ToEntity(), nil
+	}
+
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		libOpentelemetry.HandleSpanError(span, "Failed to review screening alert", err)
+
+		return nil, err
+	}
+
+	current, err := r.FindAlert(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	businessErr := pkg.ValidateBusinessError(cn.ErrScreeningAlertAlreadyReviewed, cn.EntityScreeningAlert, current.Status)
+	libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Screening alert already reviewed", businessErr)
+
+	return nil, businessErr
+}
+
+var _ Repository = (*MongoDBRepository)(nil)
+
+// MarkPending records a failed screening of the subject.
+func (r *MongoDBRepository) MarkPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.mark_pending_screening")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", pending.HolderID.String()),
+		attribute.String("app.request.subject_type", pending.SubjectType),
+	)
+
+	coll, err := r.collection(ctx, pendingPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	setOnInsert := bson.D{
+		{Key: "holder_id", Value: pending.HolderID},
+		{Key: "subject_type", Value: pending.SubjectType},
+		{Key: "created_at", Value: pending.LastAttemptAt},
+	}
+	if pending.InstrumentID != nil {
+		setOnInsert = append(setOnInsert, bson.E{Key: "instrument_id", Value: *pending.InstrumentID})
+	}
+
+	update := bson.D{
+		{Key: "$setOnInsert", Value: setOnInsert},
+		{Key: "$set", Value: bson.D{{Key: "last_attempt_at", Value: pending.LastAttemptAt}}},
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: int64(1)}}},
+	}
+
+	filter := bson.D{{Key: "_id", Value: PendingScreeningKey(pending)}}
+
+	if _, err := coll.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true)); err != nil {
+		// Two failures of the same subject at once: the loser's upsert hits
+		// the _id, and the subject is pending either way.
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to mark pending screening", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// FindPending returns a page of pending screenings, oldest first.
+func (r *MongoDBRepository) FindPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.find_pending_screenings")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.query.limit", page.Limit),
+		attribute.Int("app.request.query.page", page.Page),
+	)
+
+	coll, err := r.collection(ctx, pendingPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return nil, err
+	}
+
+	limit := int64(page.Limit)
+	skip := int64(page.Page*page.Limit - page.Limit)
+	opts := options.Find().SetLimit(limit).SetSkip(skip).SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := coll.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find pending screenings", err)
+
+		return nil, err
+	}
+
+	var records []PendingScreeningMongoDBModel
+	if err := cursor.All(ctx, &records); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to decode pending screenings", err)
+
+		return nil, err
+	}
+
+	pending := make([]*mmodel.PendingScreening, 0, len(records))
+	for i := range records {
+		pending = append(pending, records[i].ToEntity())
+	}
+
+	return pending, nil
+}
+
+// ClearPending removes the pending screenings last failed before screenedAt.
+func (r *MongoDBRepository) ClearPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening, screenedAt time.Time) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "mongodb.clear_pending_screening")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	coll, err := r.collection(ctx, pendingPrefix, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get collection", err)
+
+		return err
+	}
+
+	filter := bson.D{{Key: "last_attempt_at", Value: bson.D{{Key: "$lt", Value: screenedAt}}}}
+	if pending != nil {
+		filter = append(filter, bson.E{Key: "_id", Value: PendingScreeningKey(pending)})
+	}
+
+	if _, err := coll.DeleteMany(ctx, filter); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to clear pending screening", err)
+
+		return err
+	}
+
+	return nil
+}
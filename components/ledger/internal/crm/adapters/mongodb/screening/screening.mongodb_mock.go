@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen --destination=screening.mongodb_mock.go --package=screening . Repository
+//
+
+// Package screening is a generated GoMock package.
+package screening
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	http "github.com/LerianStudio/midaz/v4/pkg/net/http"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearPending mocks base method.
+func (m *MockRepository) ClearPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening, screenedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPending", ctx, organizationID, pending, screenedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPending indicates an expected call of ClearPending.
+func (mr *MockRepositoryMockRecorder) ClearPending(ctx, organizationID, pending, screenedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPending", reflect.TypeOf((*MockRepository)(nil).ClearPending), ctx, organizationID, pending, screenedAt)
+}
+
+// DeleteList mocks base method.
+func (m *MockRepository) DeleteList(ctx context.Context, organizationID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, organizationID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockRepositoryMockRecorder) DeleteList(ctx, organizationID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockRepository)(nil).DeleteList), ctx, organizationID, name)
+}
+
+// FindAlert mocks base method.
+func (m *MockRepository) FindAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAlert", ctx, organizationID, id)
+	ret0, _ := ret[0].(*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAlert indicates an expected call of FindAlert.
+func (mr *MockRepositoryMockRecorder) FindAlert(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlert", reflect.TypeOf((*MockRepository)(nil).FindAlert), ctx, organizationID, id)
+}
+
+// FindAlerts mocks base method.
+func (m *MockRepository) FindAlerts(ctx context.Context, organizationID string, query AlertQuery) ([]*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAlerts", ctx, organizationID, query)
+	ret0, _ := ret[0].([]*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAlerts indicates an expected call of FindAlerts.
+func (mr *MockRepositoryMockRecorder) FindAlerts(ctx, organizationID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlerts", reflect.TypeOf((*MockRepository)(nil).FindAlerts), ctx, organizationID, query)
+}
+
+// FindEntries mocks base method.
+func (m *MockRepository) FindEntries(ctx context.Context, organizationID, name string, version int64) ([]mmodel.WatchListEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntries", ctx, organizationID, name, version)
+	ret0, _ := ret[0].([]mmodel.WatchListEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntries indicates an expected call of FindEntries.
+func (mr *MockRepositoryMockRecorder) FindEntries(ctx, organizationID, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntries", reflect.TypeOf((*MockRepository)(nil).FindEntries), ctx, organizationID, name, version)
+}
+
+// FindList mocks base method.
+func (m *MockRepository) FindList(ctx context.Context, organizationID, name string) (*mmodel.WatchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindList", ctx, organizationID, name)
+	ret0, _ := ret[0].(*mmodel.WatchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindList indicates an expected call of FindList.
+func (mr *MockRepositoryMockRecorder) FindList(ctx, organizationID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindList", reflect.TypeOf((*MockRepository)(nil).FindList), ctx, organizationID, name)
+}
+
+// FindLists mocks base method.
+func (m *MockRepository) FindLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLists", ctx, organizationID)
+	ret0, _ := ret[0].([]*mmodel.WatchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLists indicates an expected call of FindLists.
+func (mr *MockRepositoryMockRecorder) FindLists(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLists", reflect.TypeOf((*MockRepository)(nil).FindLists), ctx, organizationID)
+}
+
+// FindPending mocks base method.
+func (m *MockRepository) FindPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, organizationID, page)
+	ret0, _ := ret[0].([]*mmodel.PendingScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockRepositoryMockRecorder) FindPending(ctx, organizationID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockRepository)(nil).FindPending), ctx, organizationID, page)
+}
+
+// MarkPending mocks base method.
+func (m *MockRepository) MarkPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPending", ctx, organizationID, pending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPending indicates an expected call of MarkPending.
+func (mr *MockRepositoryMockRecorder) MarkPending(ctx, organizationID, pending any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPending", reflect.TypeOf((*MockRepository)(nil).MarkPending), ctx, organizationID, pending)
+}
+
+// ReplaceList mocks base method.
+func (m *MockRepository) ReplaceList(ctx context.Context, organizationID string, list *mmodel.WatchList, entries []mmodel.WatchListEntry, expectedVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceList", ctx, organizationID, list, entries, expectedVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceList indicates an expected call of ReplaceList.
+func (mr *MockRepositoryMockRecorder) ReplaceList(ctx, organizationID, list, entries, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceList", reflect.TypeOf((*MockRepository)(nil).ReplaceList), ctx, organizationID, list, entries, expectedVersion)
+}
+
+// ReviewAlert mocks base method.
+func (m *MockRepository) ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, status, reviewer, note string, reviewedAt time.Time) (*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAlert", ctx, organizationID, id, status, reviewer, note, reviewedAt)
+	ret0, _ := ret[0].(*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAlert indicates an expected call of ReviewAlert.
+func (mr *MockRepositoryMockRecorder) ReviewAlert(ctx, organizationID, id, status, reviewer, note, reviewedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAlert", reflect.TypeOf((*MockRepository)(nil).ReviewAlert), ctx, organizationID, id, status, reviewer, note, reviewedAt)
+}
+
+// UpdateRescreen mocks base method.
+func (m *MockRepository) UpdateRescreen(ctx context.Context, organizationID string, names []string, run *mmodel.ScreeningRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRescreen", ctx, organizationID, names, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRescreen indicates an expected call of UpdateRescreen.
+func (mr *MockRepositoryMockRecorder) UpdateRescreen(ctx, organizationID, names, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRescreen", reflect.TypeOf((*MockRepository)(nil).UpdateRescreen), ctx, organizationID, names, run)
+}
+
+// UpsertAlert mocks base method.
+func (m *MockRepository) UpsertAlert(ctx context.Context, organizationID string, alert *mmodel.ScreeningAlert) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlert", ctx, organizationID, alert)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAlert indicates an expected call of UpsertAlert.
+func (mr *MockRepositoryMockRecorder) UpsertAlert(ctx, organizationID, alert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlert", reflect.TypeOf((*MockRepository)(nil).UpsertAlert), ctx, organizationID, alert)
+}
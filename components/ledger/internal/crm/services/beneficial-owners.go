@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetBeneficialOwners resolves the ultimate beneficial owners of a
+// legal-person holder on asOf (today when zero): the natural persons whose
+// effective ownership, multiplied along every chain and summed over chains,
+// reaches threshold percent (DefaultBeneficialOwnershipThreshold when zero),
+// and those who control the holder through an unbroken chain of control
+// relationships or majority stakes.
+func (uc *UseCase) GetBeneficialOwners(ctx context.Context, organizationID string, holderID uuid.UUID, asOf mmodel.Date, threshold float64) (*mmodel.BeneficialOwnership, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_beneficial_owners")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	if threshold == 0 {
+		threshold = mmodel.DefaultBeneficialOwnershipThreshold
+	}
+
+	if threshold < 0 || threshold > 100 {
+		err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "threshold")
+		recordSpanError(span, "Invalid threshold", err)
+
+		return nil, err
+	}
+
+	if asOf.IsZero() {
+		asOf = mmodel.Date{Time: truncateToDay(time.Now().UTC())}
+	}
+
+	if _, err := uc.findLegalPersonHolder(ctx, organizationID, holderID); err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	graph, err := uc.loadOwnershipGraph(ctx, organizationID, holderID, asOf.Time)
+	if err != nil {
+		recordSpanError(span, "Failed to load ownership graph", err)
+
+		return nil, err
+	}
+
+	walk := &ownershipWalk{graph: graph, owners: map[uuid.UUID]*mmodel.BeneficialOwner{}}
+	walk.visit(holderID, 100, true, []uuid.UUID{holderID}, []uuid.UUID{})
+
+	result := &mmodel.BeneficialOwnership{
+		HolderID:               holderID,
+		AsOf:                   asOf,
+		Threshold:              threshold,
+		Owners:                 []*mmodel.BeneficialOwner{},
+		UnattributedPercentage: roundPercentage(walk.unattributed),
+		CycleDetected:          walk.cycle,
+		DepthLimitReached:      walk.depthLimit,
+	}
+
+	for id, owner := range walk.owners {
+		owner.Percentage = roundPercentage(owner.Percentage)
+
+		controls := slices.ContainsFunc(owner.Chains, func(c mmodel.OwnershipChain) bool { return c.Control })
+
+		if owner.Percentage >= threshold {
+			owner.Reasons = append(owner.Reasons, mmodel.BeneficialOwnerByOwnership)
+		}
+
+		if controls {
+			owner.Reasons = append(owner.Reasons, mmodel.BeneficialOwnerByControl)
+		}
+
+		if len(owner.Reasons) == 0 {
+			continue
+		}
+
+		holder, err := uc.HolderRepo.Find(ctx, organizationID, id, true)
+		if err != nil {
+			recordSpanError(span, "Failed to get beneficial owner", err)
+
+			return nil, err
+		}
+
+		if holder.Name != nil {
+			owner.Name = *holder.Name
+		}
+
+		result.Owners = append(result.Owners, owner)
+	}
+
+	slices.SortFunc(result.Owners, func(a, b *mmodel.BeneficialOwner) int {
+		if a.Percentage != b.Percentage {
+			if a.Percentage > b.Percentage {
+				return -1
+			}
+
+			return 1
+		}
+
+		return slices.Compare(a.HolderID[:], b.HolderID[:])
+	})
+
+	return result, nil
+}
+
+// loadOwnershipGraph loads, level by level, the relationships effective on day
+// above holderID, up to maxOwnershipDepth levels. The result maps every loaded
+// holder to the relationships in which it is owned or controlled; holders past
+// the depth limit have no entry.
+func (uc *UseCase) loadOwnershipGraph(ctx context.Context, organizationID string, holderID uuid.UUID, day time.Time) (map[uuid.UUID][]*mmodel.HolderRelationship, error) {
+	graph := map[uuid.UUID][]*mmodel.HolderRelationship{}
+	frontier := []uuid.UUID{holderID}
+
+	for depth := 0; depth < maxOwnershipDepth && len(frontier) > 0; depth++ {
+		for _, id := range frontier {
+			graph[id] = []*mmodel.HolderRelationship{}
+		}
+
+		relationships, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, frontier, false)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []uuid.UUID
+
+		for _, r := range relationships {
+			if !r.IsEffectiveOn(day) {
+				continue
+			}
+
+			graph[r.HolderID] = append(graph[r.HolderID], r)
+
+			if _, loaded := graph[r.OwnerID]; !loaded && !slices.Contains(next, r.OwnerID) && r.OwnerType != mmodel.HolderTypeNaturalPerson {
+				next = append(next, r.OwnerID)
+			}
+		}
+
+		frontier = next
+	}
+
+	return graph, nil
+}
+
+// ownershipWalk accumulates the beneficial owners found while walking the
+// ownership graph from the resolved holder upwards.
+type ownershipWalk struct {
+	graph        map[uuid.UUID][]*mmodel.HolderRelationship
+	owners       map[uuid.UUID]*mmodel.BeneficialOwner
+	unattributed float64
+	cycle        bool
+	depthLimit   bool
+}
+
+// visit distributes share percent of the resolved holder, held through node,
+// among node's owners. control reports whether every link from node down to
+// the resolved holder confers control; holders and relationships are the
+// chain from node down to the resolved holder.
+func (w *ownershipWalk) visit(node uuid.UUID, share float64, control bool, holders, relationships []uuid.UUID) {
+	edges := w.graph[node]
+
+	var owned float64
+
+	for _, r := range edges {
+		if r.Type == mmodel.HolderRelationshipOwnership {
+			owned += *r.Percentage
+		}
+	}
+
+	if owned < 100 {
+		w.unattributed += share * (100 - owned) / 100
+	}
+
+	for _, r := range edges {
+		s, c := 0.0, control
+
+		if r.Type == mmodel.HolderRelationshipOwnership {
+			s = share * *r.Percentage / 100
+			c = control && *r.Percentage > 50
+		}
+
+		if s == 0 && !c {
+			continue
+		}
+
+		if slices.Contains(holders, r.OwnerID) {
+			w.cycle = true
+			w.unattributed += s
+
+			continue
+		}
+
+		chainHolders := append([]uuid.UUID{r.OwnerID}, holders...)
+		chainRelationships := append([]uuid.UUID{r.ID}, relationships...)
+
+		if r.OwnerType == mmodel.HolderTypeNaturalPerson {
+			owner, ok := w.owners[r.OwnerID]
+			if !ok {
+				owner = &mmodel.BeneficialOwner{HolderID: r.OwnerID, Reasons: []string{}}
+				w.owners[r.OwnerID] = owner
+			}
+
+			owner.Percentage += s
+			owner.Chains = append(owner.Chains, mmodel.OwnershipChain{
+				HolderIDs:       chainHolders,
+				RelationshipIDs: chainRelationships,
+				Percentage:      roundPercentage(s),
+				Control:         c,
+			})
+
+			continue
+		}
+
+		if _, loaded := w.graph[r.OwnerID]; !loaded {
+			w.depthLimit = true
+			w.unattributed += s
+
+			continue
+		}
+
+		w.visit(r.OwnerID, s, c, chainHolders, chainRelationships)
+	}
+}
+
+// roundPercentage rounds p to four decimal places, hiding float noise from
+// multiplied stakes.
+func roundPercentage(p float64) float64 {
+	return math.Round(p*1e4) / 1e4
+}
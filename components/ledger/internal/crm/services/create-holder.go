@@ -68,6 +68,7 @@ func (uc *UseCase) CreateHolder(ctx context.Context, organizationID string, chi
 	}
 
 	uc.emitHolderCreatedEvent(ctx, span, logger, createdHolder, organizationID)
+	uc.screenHolder(ctx, organizationID, createdHolder)
 
 	return createdHolder, nil
 }
@@ -134,6 +134,7 @@ func (uc *UseCase) CreateInstrument(ctx context.Context, organizationID string,
 	}
 
 	uc.emitInstrumentCreatedEvent(ctx, span, logger, createdInstrument, organizationID)
+	uc.screenRelatedParties(ctx, organizationID, createdInstrument)
 
 	return createdInstrument, nil
 }
@@ -5,7 +5,8 @@
 // Package encryption provides field-level encryption for CRM holder and
 // instrument PII. Each operation routes between a legacy path (lib-commons
 // symmetric crypto, no KMS) and an envelope path (per-organization Tink data
-// keys wrapped by a Vault Transit key), selected by KMS_VENDOR and the
+// keys wrapped by a KMS-managed key: Vault Transit, a PKCS#11 HSM or the local
+// software KMS), selected by KMS_VENDOR and the
 // organization's protection state.
 //
 // FieldEncryptor is the repository-facing seam. Encryption binds ciphertext to
@@ -24,6 +25,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 
 	libObservability "github.com/LerianStudio/lib-observability"
 	libOpenTelemetry "github.com/LerianStudio/lib-observability/tracing"
@@ -77,7 +79,7 @@ var (
 // LegacyCrypto defines the interface for legacy encryption operations.
 // Implemented by:
 //   - lib-commons crypto.Crypto for KMS_VENDOR=none
-//   - LegacyKeyMaterial (Tink-backed) for KMS_VENDOR=hashicorp-vault
+//   - LegacyKeyMaterial (Tink-backed) for the envelope vendors (hashicorp-vault, pkcs11, local)
 type LegacyCrypto interface {
 	Encrypt(plaintext *string) (*string, error)
 	Decrypt(ciphertext *string) (*string, error)
@@ -85,7 +87,7 @@ type LegacyCrypto interface {
 }
 
 // LegacyKeyMaterial holds Tink-backed primitives for legacy encryption operations.
-// Used only in envelope mode (KMS_VENDOR=hashicorp-vault) for reading legacy data
+// Used only in envelope mode (any KMS_VENDOR other than none) for reading legacy data
 // during migration. Implements LegacyCrypto interface.
 type LegacyKeyMaterial struct {
 	aead    *cryptoTink.AEADPrimitive
@@ -235,13 +237,14 @@ type encryptionService struct {
 	legacyCrypto   LegacyCrypto
 	metrics        *protectionMetrics
 	encryptionMode crypto.EncryptionMode
+	holderKeys     *HolderKeyManager
 }
 
 // NewEncryptionService creates a new encryption service with the given dependencies.
 //
 // The legacyCrypto parameter provides legacy encryption operations:
 //   - For KMS_VENDOR=none: pass lib-commons *crypto.Crypto directly
-//   - For KMS_VENDOR=hashicorp-vault: pass *LegacyKeyMaterial (Tink-backed)
+//   - For an envelope KMS_VENDOR: pass *LegacyKeyMaterial (Tink-backed)
 //
 // The encryptionMode parameter determines the encryption strategy:
 //   - EncryptionModeEnvelope: Always use envelope encryption, triggering lazy
@@ -282,6 +285,23 @@ func NewEncryptionService(
 	}
 }
 
+// WithHolderKeys returns a copy of service that encrypts envelope values carrying
+// a FieldContext.HolderID with that holder's data key. Values already written
+// with the organization keyset stay readable; they move to the holder key on
+// their next write or re-encryption. Services not built by NewEncryptionService
+// are returned unchanged.
+func WithHolderKeys(service EncryptionService, holderKeys *HolderKeyManager) EncryptionService {
+	s, ok := service.(*encryptionService)
+	if !ok {
+		return service
+	}
+
+	clone := *s
+	clone.holderKeys = holderKeys
+
+	return &clone
+}
+
 // Encrypt encrypts a plaintext value for the given field context.
 // Uses envelope encryption if encryptionMode is envelope or organization is active,
 // legacy otherwise.
@@ -391,23 +411,37 @@ func (s *encryptionService) encryptEnvelope(ctx context.Context, fieldCtx FieldC
 		return "", fmt.Errorf("failed to get AEAD primitive: %w", err)
 	}
 
+	aead := prims.AEAD
+
+	holderKeyed := s.holderKeys != nil && fieldCtx.HolderID != ""
+	if holderKeyed {
+		aead, err = s.holderKeys.aeadForWrite(ctx, fieldCtx.OrganizationID, fieldCtx.HolderID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get holder data key: %w", err)
+		}
+	}
+
 	// Encrypt with canonical AAD
 	aad := fieldCtx.CanonicalAAD()
 
-	ciphertext, err := prims.AEAD.Encrypt([]byte(plaintext), aad)
+	ciphertext, err := aead.Encrypt([]byte(plaintext), aad)
 	if err != nil {
 		return "", fmt.Errorf("AEAD encryption failed: %w", err)
 	}
 
 	// Stamp the marker with the active keyset VERSION (NOT the Tink primary key id);
 	// decrypt routes on this version.
+	if holderKeyed {
+		return FormatHolderEnvelopeMarker(prims.Version, ciphertext), nil
+	}
+
 	marked := FormatEnvelopeMarker(prims.Version, ciphertext)
 
 	return marked, nil
 }
 
 // encryptLegacy performs legacy encryption using the LegacyCrypto interface.
-// Uses lib-commons crypto for KMS_VENDOR=none, Tink for KMS_VENDOR=hashicorp-vault.
+// Uses lib-commons crypto for KMS_VENDOR=none, Tink for the envelope vendors.
 func (s *encryptionService) encryptLegacy(ctx context.Context, plaintext string) (string, error) {
 	// Check context before crypto operation
 	if err := ctx.Err(); err != nil {
@@ -541,10 +575,26 @@ func (s *encryptionService) decryptEnvelope(ctx context.Context, fieldCtx FieldC
 		return "", fmt.Errorf("%w: failed to resolve protection state: %v", ErrEnvelopeDecryptFailed, err)
 	}
 
+	// A marker newer than the cached write version was written by a replica that
+	// already observed a key rotation: refresh the cached state once before
+	// failing closed.
+	if !versionIsReadable(marker.Version, state.ReadableVersions) && int(marker.Version) > state.CurrentKeysetVersion {
+		s.stateResolver.Invalidate(ExtractTenantID(ctx), fieldCtx.OrganizationID)
+
+		state, err = s.stateResolver.Resolve(ctx, fieldCtx.OrganizationID)
+		if err != nil {
+			return "", fmt.Errorf("%w: failed to resolve protection state: %v", ErrEnvelopeDecryptFailed, err)
+		}
+	}
+
 	if !versionIsReadable(marker.Version, state.ReadableVersions) {
 		return "", fmt.Errorf("%w: marker version %d is not in the organization's readable versions", ErrEnvelopeDecryptFailed, marker.Version)
 	}
 
+	if marker.HolderKeyed {
+		return s.decryptHolderKeyed(ctx, fieldCtx, marker)
+	}
+
 	// Load the primitives for the exact marker version (no auto-provision).
 	prims, err := s.keysetManager.GetPrimitivesForVersion(ctx, fieldCtx.OrganizationID, int(marker.Version))
 	if err != nil {
@@ -563,6 +613,27 @@ func (s *encryptionService) decryptEnvelope(ctx context.Context, fieldCtx FieldC
 	return string(plaintext), nil
 }
 
+// decryptHolderKeyed decrypts a value encrypted with the holder's data key. It
+// fails closed when holder keys are not enabled or the field context carries no
+// holder, and with the holder-erased business error once the key was destroyed.
+func (s *encryptionService) decryptHolderKeyed(ctx context.Context, fieldCtx FieldContext, marker EnvelopeMarker) (string, error) {
+	if s.holderKeys == nil || fieldCtx.HolderID == "" {
+		return "", fmt.Errorf("%w: holder-keyed value without a holder data key", ErrEnvelopeDecryptFailed)
+	}
+
+	aead, err := s.holderKeys.aeadForRead(ctx, fieldCtx.OrganizationID, fieldCtx.HolderID)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to get holder data key: %w", ErrEnvelopeDecryptFailed, err)
+	}
+
+	plaintext, err := aead.Decrypt(marker.Payload, fieldCtx.CanonicalAAD())
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEnvelopeDecryptFailed, err)
+	}
+
+	return string(plaintext), nil
+}
+
 // versionIsReadable reports whether the marker version is present in the
 // organization's readable-versions set. An empty/nil set is never readable
 // (fail-closed for legacy/unprovisioned organizations).
@@ -578,7 +649,7 @@ func versionIsReadable(version uint32, readable []int) bool {
 }
 
 // decryptLegacy performs legacy decryption using the LegacyCrypto interface.
-// Uses lib-commons crypto for KMS_VENDOR=none, Tink for KMS_VENDOR=hashicorp-vault.
+// Uses lib-commons crypto for KMS_VENDOR=none, Tink for the envelope vendors.
 // Returns ErrLegacyReadNotAllowed if the organization doesn't permit legacy reads.
 func (s *encryptionService) decryptLegacy(ctx context.Context, fieldCtx FieldContext, ciphertext string) (string, error) {
 	// Check protection state for legacy read permission
@@ -642,6 +713,10 @@ func (s *encryptionService) decryptLegacy(ctx context.Context, fieldCtx FieldCon
 // tag) written with nil associated data. The canonical envelope AAD MUST NOT be
 // used for legacy bytes. This helper performs decode + crypto ONLY; the
 // CanReadLegacy gate and legacy-read metric remain in the caller.
+//
+// After a key rotation the imported legacy key lives in an older keyset version,
+// not the active one, so a failed active-version decrypt falls back to the older
+// readable versions, newest first.
 func (s *encryptionService) decryptLegacyFromKeyset(ctx context.Context, fieldCtx FieldContext, ciphertext string) (string, error) {
 	if err := ctx.Err(); err != nil {
 		return "", err
@@ -661,11 +736,55 @@ func (s *encryptionService) decryptLegacyFromKeyset(ctx context.Context, fieldCt
 	// Legacy data was written with nil associated data; the canonical envelope
 	// AAD MUST NOT be applied here.
 	plainBytes, err := prims.AEAD.Decrypt(cipherBytes, nil)
-	if err != nil {
+	if err == nil {
+		return string(plainBytes), nil
+	}
+
+	older, rerr := s.olderReadableVersions(ctx, fieldCtx.OrganizationID, prims.Version)
+	if rerr != nil {
 		return "", fmt.Errorf("keyset legacy decrypt: %w", err)
 	}
 
-	return string(plainBytes), nil
+	for _, version := range older {
+		versionPrims, verr := s.keysetManager.GetPrimitivesForVersion(ctx, fieldCtx.OrganizationID, version)
+		if verr != nil {
+			continue
+		}
+
+		if plainBytes, derr := versionPrims.AEAD.Decrypt(cipherBytes, nil); derr == nil {
+			return string(plainBytes), nil
+		}
+	}
+
+	return "", fmt.Errorf("keyset legacy decrypt: %w", err)
+}
+
+// olderReadableVersions returns the organization's readable keyset versions other
+// than activeVersion, newest first. After a key rotation these are the versions
+// whose ciphertext and search tokens the re-encryption sweep has not rewritten
+// yet. It is empty when no state resolver is configured.
+func (s *encryptionService) olderReadableVersions(ctx context.Context, organizationID string, activeVersion uint32) ([]int, error) {
+	if s.stateResolver == nil {
+		return nil, nil
+	}
+
+	state, err := s.stateResolver.Resolve(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	older := make([]int, 0, len(state.ReadableVersions))
+
+	for _, v := range state.ReadableVersions {
+		if v != int(activeVersion) {
+			older = append(older, v)
+		}
+	}
+
+	slices.Sort(older)
+	slices.Reverse(older)
+
+	return older, nil
 }
 
 // GenerateSearchToken generates a deterministic search token for a field value.
@@ -788,10 +907,13 @@ func (s *encryptionService) GenerateSearchTokenCandidates(ctx context.Context, s
 //
 // Envelope-only organizations (LegacyHexTokenPRF nil) never wrote legacy tokens, so no
 // legacy candidate is appended and the process-global legacyCrypto is never consulted.
+//
+// After a key rotation, records not yet rewritten by the re-encryption sweep still
+// carry tokens of an older keyset version, so candidates fan out across every
+// readable version. The legacy token comes from whichever version carries the
+// imported legacy key.
 func (s *encryptionService) generateSearchTokenCandidatesEnvelope(ctx context.Context, searchCtx SearchTokenContext, normalizedValue string, canReadLegacy bool) ([]string, error) {
-	// Read path: candidates are generated from the ACTIVE version's MultiKeyPRF.
-	// Multi-version search fan-out (querying older keyset versions after rotation)
-	// is rotation work and is deferred/out of scope here.
+	// Read path: candidates start with the ACTIVE version's MultiKeyPRF.
 	prims, err := s.keysetManager.GetActivePrimitives(ctx, searchCtx.OrganizationID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get MultiKeyPRF primitive: %w", err)
@@ -805,11 +927,40 @@ func (s *encryptionService) generateSearchTokenCandidatesEnvelope(ctx context.Co
 		return nil, fmt.Errorf("failed to compute search token candidates: %w", err)
 	}
 
-	// Union the per-org keyset legacy token when legacy reads are permitted AND the
-	// keyset carries an imported legacy key (migrated org). Envelope-only orgs never
-	// wrote legacy tokens, so no legacy candidate is appended.
-	if canReadLegacy && prims.LegacyHexTokenPRF != nil {
-		legacyToken, err := prims.LegacyHexTokenPRF.ComputeLegacyHexToken([]byte(normalizedValue))
+	legacyPRF := prims.LegacyHexTokenPRF
+
+	older, err := s.olderReadableVersions(ctx, searchCtx.OrganizationID, prims.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve protection state: %w", err)
+	}
+
+	for _, version := range older {
+		versionPrims, err := s.keysetManager.GetPrimitivesForVersion(ctx, searchCtx.OrganizationID, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get MultiKeyPRF primitive for version %d: %w", version, err)
+		}
+
+		versionTokens, err := versionPrims.MultiKeyPRF.ComputeSearchTokenCandidates(canonicalInput)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute search token candidates for version %d: %w", version, err)
+		}
+
+		for _, token := range versionTokens {
+			if !slices.Contains(tokens, token) {
+				tokens = append(tokens, token)
+			}
+		}
+
+		if legacyPRF == nil {
+			legacyPRF = versionPrims.LegacyHexTokenPRF
+		}
+	}
+
+	// Union the per-org keyset legacy token when legacy reads are permitted AND a
+	// readable keyset carries an imported legacy key (migrated org). Envelope-only
+	// orgs never wrote legacy tokens, so no legacy candidate is appended.
+	if canReadLegacy && legacyPRF != nil {
+		legacyToken, err := legacyPRF.ComputeLegacyHexToken([]byte(normalizedValue))
 		if err != nil {
 			// Fail loud: a migrated org that cannot produce its legacy candidate would
 			// silently fail to find legacy rows.
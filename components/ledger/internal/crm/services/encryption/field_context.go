@@ -10,13 +10,18 @@ import (
 )
 
 // FieldContext contains the contextual information required for field-level encryption.
-// All fields are required and used to construct canonical associated data (AAD)
-// that binds the ciphertext to its originating context.
+// TenantID, OrganizationID, RecordID and FieldName are required and used to construct
+// canonical associated data (AAD) that binds the ciphertext to its originating context.
+//
+// HolderID is optional and not part of the AAD: when set, envelope encryption uses
+// the holder's data key instead of the organization keyset, so destroying that key
+// erases every value of the holder.
 type FieldContext struct {
 	TenantID       string // Tenant identifier from JWT/context
 	OrganizationID string // Organization owning the record
 	RecordID       string // Unique ID of the holder/alias record
 	FieldName      string // Name of the field being encrypted (e.g., "tax_id", "document_number")
+	HolderID       string // Holder whose data key protects the value (empty: organization keyset)
 }
 
 // Validate checks that all required fields are present and non-empty.
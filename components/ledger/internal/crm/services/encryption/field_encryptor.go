@@ -15,11 +15,13 @@ import (
 )
 
 // EncryptionContext provides the context for field encryption operations.
-// It carries tenant, organization, and record identifiers used to bind ciphertext.
+// It carries tenant, organization, and record identifiers used to bind ciphertext,
+// and the holder whose data key protects the record's values.
 type EncryptionContext struct {
 	TenantID       string
 	OrganizationID string
 	RecordID       string
+	HolderID       string
 }
 
 // ExtractTenantID extracts tenant ID from context or returns "default" for single-tenant mode.
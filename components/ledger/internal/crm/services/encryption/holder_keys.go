@@ -0,0 +1,376 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpenTelemetry "github.com/LerianStudio/lib-observability/tracing"
+
+	mongoEncryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/tink"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultHolderKeyCacheTTL bounds how long a replica keeps using a holder key
+// after another replica destroyed it. It is deliberately much shorter than the
+// organization keyset cache TTL.
+const defaultHolderKeyCacheTTL = time.Minute
+
+// cachedHolderKey is an unwrapped holder data key.
+type cachedHolderKey struct {
+	aead      *tink.AEADPrimitive
+	expiresAt time.Time
+}
+
+// HolderKeyManager creates, unwraps, caches and destroys per-holder data keys.
+//
+// Each holder gets its own AES256-GCM Tink keyset, created on the first write of
+// one of its protected values and stored wrapped by the organization's active
+// keyset (AAD-bound to tenant, organization and holder). Destroying the key is
+// crypto-shredding: every value encrypted with it — in the database and in
+// backups — becomes permanently unreadable.
+//
+// HolderKeyManager is also the "holder_keys" re-encryption target of a key
+// rotation: it rewraps live keys with the new organization keyset version so
+// superseded versions can be retired.
+type HolderKeyManager struct {
+	repo          mongoEncryption.HolderDataKeyRepository
+	keysetManager *KeysetManager
+	generator     *tink.AEADKeysetGenerator
+	metrics       *protectionMetrics
+	cacheTTL      time.Duration
+	cache         map[string]*cachedHolderKey // Key: "tenantID:organizationID:holderID"
+	mu            sync.RWMutex
+}
+
+// NewHolderKeyManager creates a holder key manager. cacheTTL bounds how long an
+// unwrapped key is reused; zero defaults to one minute. metrics is the nil-safe
+// protection metrics seam.
+func NewHolderKeyManager(
+	repo mongoEncryption.HolderDataKeyRepository,
+	keysetManager *KeysetManager,
+	cacheTTL time.Duration,
+	metrics *protectionMetrics,
+) *HolderKeyManager {
+	if cacheTTL == 0 {
+		cacheTTL = defaultHolderKeyCacheTTL
+	}
+
+	if metrics == nil {
+		metrics = NewProtectionMetrics(nil)
+	}
+
+	return &HolderKeyManager{
+		repo:          repo,
+		keysetManager: keysetManager,
+		generator:     tink.NewAEADKeysetGenerator(),
+		metrics:       metrics,
+		cacheTTL:      cacheTTL,
+		cache:         make(map[string]*cachedHolderKey),
+	}
+}
+
+// buildHolderKeyCacheKey constructs the tenant-scoped cache key of a holder key.
+func buildHolderKeyCacheKey(tenantID, organizationID, holderID string) string {
+	return tenantID + ":" + organizationID + ":" + holderID
+}
+
+// holderKeyAAD binds a wrapped holder key to its tenant, organization and holder,
+// so a wrapped key copied onto another holder's document fails to unwrap.
+func holderKeyAAD(tenantID, organizationID, holderID string) []byte {
+	return fmt.Appendf(nil, "tenant:%s:org:%s:holder:%s", tenantID, organizationID, holderID)
+}
+
+// errHolderErased is the business error returned when a destroyed holder key is
+// used.
+func errHolderErased() error {
+	return pkg.ValidateBusinessError(constant.ErrHolderErased, constant.EntityHolder)
+}
+
+// aeadForWrite returns the holder's data key, creating it (wrapped with the
+// organization's active keyset) when the holder has none yet. A destroyed key
+// fails with the holder-erased business error; it is never recreated.
+func (hk *HolderKeyManager) aeadForWrite(ctx context.Context, organizationID, holderID string) (*tink.AEADPrimitive, error) {
+	return hk.aeadFor(ctx, organizationID, holderID, true)
+}
+
+// aeadForRead returns the holder's existing data key. A missing or destroyed key
+// fails; values encrypted with it can no longer be read.
+func (hk *HolderKeyManager) aeadForRead(ctx context.Context, organizationID, holderID string) (*tink.AEADPrimitive, error) {
+	return hk.aeadFor(ctx, organizationID, holderID, false)
+}
+
+func (hk *HolderKeyManager) aeadFor(ctx context.Context, organizationID, holderID string, create bool) (*tink.AEADPrimitive, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tenantID := ExtractTenantID(ctx)
+	cacheKey := buildHolderKeyCacheKey(tenantID, organizationID, holderID)
+
+	hk.mu.RLock()
+	cached, ok := hk.cache[cacheKey]
+	hk.mu.RUnlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		hk.metrics.recordCache(ctx, "get_holder_key", "hit")
+
+		return cached.aead, nil
+	}
+
+	hk.metrics.recordCache(ctx, "get_holder_key", "miss")
+
+	key, err := hk.repo.Get(ctx, organizationID, holderID)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, mmodel.ErrHolderDataKeyNotFound) && create:
+		aead, cerr := hk.create(ctx, tenantID, organizationID, holderID)
+		if cerr == nil {
+			hk.store(cacheKey, aead)
+
+			return aead, nil
+		}
+
+		if !errors.Is(cerr, mmodel.ErrHolderDataKeyAlreadyExists) {
+			return nil, cerr
+		}
+
+		// A concurrent write created the key first: use theirs.
+		key, err = hk.repo.Get(ctx, organizationID, holderID)
+		if err != nil {
+			return nil, fmt.Errorf("get holder data key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("get holder data key: %w", err)
+	}
+
+	if key.IsDestroyed() {
+		return nil, errHolderErased()
+	}
+
+	aead, err := hk.unwrap(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	hk.store(cacheKey, aead)
+
+	return aead, nil
+}
+
+// create generates a holder keyset, wraps it with the organization's active
+// keyset and persists it. It returns the already-exists sentinel when another
+// writer created the holder's key (or a tombstone) first.
+func (hk *HolderKeyManager) create(ctx context.Context, tenantID, organizationID, holderID string) (*tink.AEADPrimitive, error) {
+	prims, err := hk.keysetManager.GetActivePrimitives(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AEAD primitive: %w", err)
+	}
+
+	_, serialized, err := hk.generator.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("generate holder keyset: %w", err)
+	}
+
+	aead, err := tink.ParseAEADKeyset(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("parse holder keyset: %w", err)
+	}
+
+	wrapped, err := prims.AEAD.Encrypt(serialized, holderKeyAAD(tenantID, organizationID, holderID))
+	if err != nil {
+		return nil, fmt.Errorf("wrap holder keyset: %w", err)
+	}
+
+	key, err := mmodel.NewHolderDataKey(tenantID, organizationID, holderID, int(prims.Version), wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := hk.repo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return aead, nil
+}
+
+// unwrap decrypts a live holder key with the organization keyset version that
+// wrapped it.
+func (hk *HolderKeyManager) unwrap(ctx context.Context, key *mmodel.HolderDataKey) (*tink.AEADPrimitive, error) {
+	serialized, err := hk.unwrapSerialized(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := tink.ParseAEADKeyset(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("parse holder keyset: %w", err)
+	}
+
+	return aead, nil
+}
+
+func (hk *HolderKeyManager) unwrapSerialized(ctx context.Context, key *mmodel.HolderDataKey) ([]byte, error) {
+	prims, err := hk.keysetManager.GetPrimitivesForVersion(ctx, key.OrganizationID, key.KeysetVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AEAD primitive: %w", err)
+	}
+
+	serialized, err := prims.AEAD.Decrypt(key.WrappedKey, holderKeyAAD(holderKeyTenant(ctx, key), key.OrganizationID, key.HolderID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap holder keyset: %w", err)
+	}
+
+	return serialized, nil
+}
+
+// holderKeyTenant returns the tenant a key was wrapped for: the stored tenant,
+// or the request tenant for keys persisted without one.
+func holderKeyTenant(ctx context.Context, key *mmodel.HolderDataKey) string {
+	if key.TenantID != "" {
+		return key.TenantID
+	}
+
+	return ExtractTenantID(ctx)
+}
+
+func (hk *HolderKeyManager) store(cacheKey string, aead *tink.AEADPrimitive) {
+	hk.mu.Lock()
+	hk.cache[cacheKey] = &cachedHolderKey{aead: aead, expiresAt: time.Now().Add(hk.cacheTTL)}
+	hk.mu.Unlock()
+}
+
+// Destroy crypto-shreds the holder's data key: the wrapped key is dropped from
+// storage and from this replica's cache, leaving a tombstone that prevents a new
+// key from being created. It is idempotent. Other replicas stop using the key
+// when their cache entry expires.
+func (hk *HolderKeyManager) Destroy(ctx context.Context, organizationID, holderID, actor string) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.destroy_holder_key")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID),
+	)
+
+	if strings.TrimSpace(organizationID) == "" || strings.TrimSpace(holderID) == "" {
+		return fmt.Errorf("organization_id and holder_id are required")
+	}
+
+	if err := hk.repo.Destroy(ctx, organizationID, holderID, actor); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to destroy holder data key", err)
+
+		return err
+	}
+
+	hk.mu.Lock()
+	delete(hk.cache, buildHolderKeyCacheKey(ExtractTenantID(ctx), organizationID, holderID))
+	hk.mu.Unlock()
+
+	return nil
+}
+
+// CountStaleRecords counts the organization's live holder keys not wrapped with
+// currentVersion.
+func (hk *HolderKeyManager) CountStaleRecords(ctx context.Context, organizationID string, currentVersion int) (int64, error) {
+	return hk.repo.CountStale(ctx, organizationID, currentVersion)
+}
+
+// ReencryptRecords rewraps up to limit stale holder keys after afterID, in
+// holder id order, with the organization keyset at currentVersion. The holder
+// keyset itself is unchanged, so values encrypted with it stay readable. A key
+// rewrapped or destroyed concurrently is skipped.
+func (hk *HolderKeyManager) ReencryptRecords(ctx context.Context, organizationID string, currentVersion int, afterID string, limit int) (ReencryptionBatch, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.rewrap_holder_keys")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.protection.current_version", currentVersion),
+	)
+
+	keys, err := hk.repo.ListStale(ctx, organizationID, currentVersion, afterID, limit)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to list stale holder data keys", err)
+
+		return ReencryptionBatch{}, err
+	}
+
+	batch := ReencryptionBatch{
+		LastID: afterID,
+		Done:   len(keys) < limit,
+	}
+
+	if len(keys) == 0 {
+		return batch, nil
+	}
+
+	prims, err := hk.keysetManager.GetPrimitivesForVersion(ctx, organizationID, currentVersion)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "Failed to get AEAD primitive", err)
+
+		return ReencryptionBatch{}, fmt.Errorf("failed to get AEAD primitive: %w", err)
+	}
+
+	for _, key := range keys {
+		serialized, err := hk.unwrapSerialized(ctx, key)
+		if err != nil {
+			libOpenTelemetry.HandleSpanError(span, "Failed to unwrap holder data key", err)
+
+			return ReencryptionBatch{}, err
+		}
+
+		wrapped, err := prims.AEAD.Encrypt(serialized, holderKeyAAD(holderKeyTenant(ctx, key), organizationID, key.HolderID))
+		if err != nil {
+			libOpenTelemetry.HandleSpanError(span, "Failed to wrap holder data key", err)
+
+			return ReencryptionBatch{}, fmt.Errorf("wrap holder keyset: %w", err)
+		}
+
+		rewrapped := *key
+		rewrapped.KeysetVersion = currentVersion
+		rewrapped.WrappedKey = wrapped
+
+		err = hk.repo.Rewrap(ctx, &rewrapped, key.Revision)
+
+		switch {
+		case err == nil:
+			batch.Rewritten++
+		case errors.Is(err, mmodel.ErrKeysetRevisionConflict):
+			batch.Skipped++
+		default:
+			libOpenTelemetry.HandleSpanError(span, "Failed to rewrap holder data key", err)
+
+			return ReencryptionBatch{}, err
+		}
+
+		batch.LastID = key.HolderID
+	}
+
+	span.SetAttributes(
+		attribute.Int("app.protection.rewritten", batch.Rewritten),
+		attribute.Int("app.protection.skipped", batch.Skipped),
+	)
+
+	return batch, nil
+}
+
+var _ ReencryptionTarget = (*HolderKeyManager)(nil)
@@ -0,0 +1,411 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/crypto"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHolderDataKeyRepo is an in-memory HolderDataKeyRepository keyed by
+// "organizationID:holderID".
+type fakeHolderDataKeyRepo struct {
+	mu   sync.Mutex
+	keys map[string]*mmodel.HolderDataKey
+}
+
+func newFakeHolderDataKeyRepo() *fakeHolderDataKeyRepo {
+	return &fakeHolderDataKeyRepo{keys: map[string]*mmodel.HolderDataKey{}}
+}
+
+func (f *fakeHolderDataKeyRepo) Create(_ context.Context, key *mmodel.HolderDataKey) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := key.OrganizationID + ":" + key.HolderID
+	if _, ok := f.keys[id]; ok {
+		return mmodel.ErrHolderDataKeyAlreadyExists
+	}
+
+	stored := *key
+	f.keys[id] = &stored
+
+	return nil
+}
+
+func (f *fakeHolderDataKeyRepo) Get(_ context.Context, organizationID, holderID string) (*mmodel.HolderDataKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key, ok := f.keys[organizationID+":"+holderID]
+	if !ok {
+		return nil, mmodel.ErrHolderDataKeyNotFound
+	}
+
+	out := *key
+
+	return &out, nil
+}
+
+func (f *fakeHolderDataKeyRepo) Rewrap(_ context.Context, key *mmodel.HolderDataKey, expectedRevision int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored, ok := f.keys[key.OrganizationID+":"+key.HolderID]
+	if !ok || stored.IsDestroyed() || stored.Revision != expectedRevision {
+		return mmodel.ErrKeysetRevisionConflict
+	}
+
+	stored.KeysetVersion = key.KeysetVersion
+	stored.WrappedKey = key.WrappedKey
+	stored.Revision++
+
+	return nil
+}
+
+func (f *fakeHolderDataKeyRepo) Destroy(_ context.Context, organizationID, holderID, actor string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := organizationID + ":" + holderID
+
+	stored, ok := f.keys[id]
+	if !ok {
+		stored = &mmodel.HolderDataKey{OrganizationID: organizationID, HolderID: holderID}
+		f.keys[id] = stored
+	}
+
+	if stored.IsDestroyed() {
+		return nil
+	}
+
+	now := time.Now()
+	stored.DestroyedAt = &now
+	stored.DestroyedBy = actor
+	stored.WrappedKey = nil
+	stored.Revision++
+
+	return nil
+}
+
+func (f *fakeHolderDataKeyRepo) stale(organizationID string, currentVersion int) []*mmodel.HolderDataKey {
+	var out []*mmodel.HolderDataKey
+
+	for _, key := range f.keys {
+		if key.OrganizationID == organizationID && !key.IsDestroyed() && key.KeysetVersion != currentVersion {
+			copied := *key
+			out = append(out, &copied)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].HolderID < out[j].HolderID })
+
+	return out
+}
+
+func (f *fakeHolderDataKeyRepo) CountStale(_ context.Context, organizationID string, currentVersion int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return int64(len(f.stale(organizationID, currentVersion))), nil
+}
+
+func (f *fakeHolderDataKeyRepo) ListStale(_ context.Context, organizationID string, currentVersion int, afterHolderID string, limit int) ([]*mmodel.HolderDataKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out []*mmodel.HolderDataKey
+
+	for _, key := range f.stale(organizationID, currentVersion) {
+		if key.HolderID > afterHolderID && len(out) < limit {
+			out = append(out, key)
+		}
+	}
+
+	return out, nil
+}
+
+// rotatedKeysetRepo serves the same keyset material under every version in
+// versions, standing in for an organization rotated from version 1 to 2 whose
+// version 1 is still readable.
+type rotatedKeysetRepo struct {
+	*serviceTestKeysetRepo
+	versions map[int]bool
+}
+
+func (r *rotatedKeysetRepo) GetByVersion(ctx context.Context, organizationID string, version int) (*mmodel.OrganizationKeyset, error) {
+	keyset, err := r.Get(ctx, organizationID)
+	if err != nil || !r.versions[version] {
+		return nil, mmodel.ErrKeysetNotFound
+	}
+
+	versioned := *keyset
+	versioned.Version = version
+
+	return &versioned, nil
+}
+
+// newHolderKeyedTestService wires an envelope encryption service with holder keys
+// backed by an in-memory repository. The organization's active keyset is version
+// 1; version 2 is also loadable so tests can rewrap holder keys to it.
+func newHolderKeyedTestService(t *testing.T) (EncryptionService, *HolderKeyManager, *fakeHolderDataKeyRepo) {
+	t.Helper()
+
+	aeadBytes, prfBytes, aeadKeyID, prfKeyID := generateServiceTestKeysets(t)
+
+	keysetRepo := &rotatedKeysetRepo{
+		serviceTestKeysetRepo: &serviceTestKeysetRepo{keysets: map[string]*mmodel.OrganizationKeyset{
+			"org-123": {
+				TenantID:          "default",
+				OrganizationID:    "org-123",
+				Version:           1,
+				KEKPath:           "test-kek",
+				WrappedKeyset:     "wrapped-aead",
+				KeysetInfo:        mmodel.KeysetInfo{PrimaryKeyID: aeadKeyID},
+				WrappedHMACKeyset: "wrapped-hmac",
+				HMACKeysetInfo:    mmodel.KeysetInfo{PrimaryKeyID: prfKeyID},
+			},
+		}},
+		versions: map[int]bool{1: true, 2: true},
+	}
+
+	keysetManager := NewKeysetManager(keysetRepo, &serviceTestKeysetUnwrapper{aeadKeyset: aeadBytes, macKeyset: prfBytes},
+		nil, DefaultKeysetManagerConfig(), NewProtectionMetrics(nil))
+
+	registryRepo := &serviceTestRegistryRepo{records: map[string]*mmodel.OrganizationRegistryRecord{
+		"org-123": {
+			TenantID:         "default",
+			OrganizationID:   "org-123",
+			Status:           mmodel.RegistryStatusActive,
+			CurrentVersion:   1,
+			ReadableVersions: []int{1, 2},
+		},
+	}}
+
+	svc := NewEncryptionService(NewProtectionStateResolver(registryRepo, NewProtectionMetrics(nil)),
+		keysetManager, keysetRepo, newTestLegacyKeyMaterial(t), NewProtectionMetrics(nil), crypto.EncryptionModeEnvelope)
+
+	repo := newFakeHolderDataKeyRepo()
+	holderKeys := NewHolderKeyManager(repo, keysetManager, 0, nil)
+
+	return WithHolderKeys(svc, holderKeys), holderKeys, repo
+}
+
+// assertHolderErased asserts err carries the holder-erased business error.
+func assertHolderErased(t *testing.T, err error) {
+	t.Helper()
+
+	var conflict pkg.EntityConflictError
+
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, constant.ErrHolderErased.Error(), conflict.Code)
+}
+
+func holderFieldContext(holderID string) FieldContext {
+	return FieldContext{
+		TenantID:       "default",
+		OrganizationID: "org-123",
+		RecordID:       holderID,
+		HolderID:       holderID,
+		FieldName:      "document",
+	}
+}
+
+func TestHolderKeys_EncryptDecryptRoundtrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, _, repo := newHolderKeyedTestService(t)
+	fieldCtx := holderFieldContext("holder-1")
+
+	ciphertext, err := svc.Encrypt(ctx, fieldCtx, "91315026015")
+	require.NoError(t, err)
+
+	marker, hasMarker, err := ParseEnvelopeMarker(ciphertext)
+	require.NoError(t, err)
+	require.True(t, hasMarker)
+	assert.True(t, marker.HolderKeyed, "a value with a holder must be holder-keyed")
+	assert.Equal(t, uint32(1), marker.Version)
+
+	key, err := repo.Get(ctx, "org-123", "holder-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, key.KeysetVersion)
+	assert.NotEmpty(t, key.WrappedKey)
+
+	plaintext, err := svc.Decrypt(ctx, fieldCtx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "91315026015", plaintext)
+}
+
+func TestHolderKeys_KeysAreIsolatedPerHolder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, _, _ := newHolderKeyedTestService(t)
+
+	ciphertext, err := svc.Encrypt(ctx, holderFieldContext("holder-1"), "secret")
+	require.NoError(t, err)
+
+	// Another holder's key must not open holder-1's value, even with matching AAD
+	// fields apart from the holder.
+	otherCtx := holderFieldContext("holder-2")
+	otherCtx.RecordID = "holder-1"
+
+	_, err = svc.Decrypt(ctx, otherCtx, ciphertext)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrEnvelopeDecryptFailed)
+}
+
+func TestHolderKeys_OrgKeyedValuesStayReadable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := ProtectionState{
+		Mode:                 crypto.EncryptionModeEnvelope,
+		CurrentKeysetVersion: 1,
+		OrganizationID:       "org-123",
+		TenantID:             "default",
+	}
+
+	orgKeyed, _ := createEncryptionTestService(t, state, newTestLegacyKeyMaterial(t))
+	fieldCtx := holderFieldContext("holder-1")
+
+	ciphertext, err := orgKeyed.Encrypt(ctx, fieldCtx, "written before holder keys")
+	require.NoError(t, err)
+
+	withKeys := WithHolderKeys(orgKeyed, NewHolderKeyManager(newFakeHolderDataKeyRepo(), orgKeyed.(*encryptionService).keysetManager, 0, nil))
+
+	plaintext, err := withKeys.Decrypt(ctx, fieldCtx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "written before holder keys", plaintext)
+}
+
+func TestHolderKeys_DestroyShredsValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, holderKeys, _ := newHolderKeyedTestService(t)
+	fieldCtx := holderFieldContext("holder-1")
+
+	ciphertext, err := svc.Encrypt(ctx, fieldCtx, "91315026015")
+	require.NoError(t, err)
+
+	require.NoError(t, holderKeys.Destroy(ctx, "org-123", "holder-1", "dpo@example.com"))
+	require.NoError(t, holderKeys.Destroy(ctx, "org-123", "holder-1", "dpo@example.com"), "destroy is idempotent")
+
+	_, err = svc.Decrypt(ctx, fieldCtx, ciphertext)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrEnvelopeDecryptFailed)
+	assertHolderErased(t, err)
+
+	// A destroyed key is never recreated: new writes for the holder fail too.
+	_, err = svc.Encrypt(ctx, fieldCtx, "new value")
+	assertHolderErased(t, err)
+}
+
+func TestHolderKeys_DestroyWithoutKeyBlocksCreation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, holderKeys, _ := newHolderKeyedTestService(t)
+
+	require.NoError(t, holderKeys.Destroy(ctx, "org-123", "holder-never-written", "dpo@example.com"))
+
+	_, err := svc.Encrypt(ctx, holderFieldContext("holder-never-written"), "value")
+	assertHolderErased(t, err)
+}
+
+func TestHolderKeys_ReencryptRecordsRewrapsStaleKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, holderKeys, repo := newHolderKeyedTestService(t)
+
+	ciphertexts := map[string]string{}
+
+	for _, holderID := range []string{"holder-a", "holder-b", "holder-c"} {
+		ciphertext, err := svc.Encrypt(ctx, holderFieldContext(holderID), "value-"+holderID)
+		require.NoError(t, err)
+
+		ciphertexts[holderID] = ciphertext
+	}
+
+	require.NoError(t, holderKeys.Destroy(ctx, "org-123", "holder-c", "dpo@example.com"))
+
+	stale, err := holderKeys.CountStaleRecords(ctx, "org-123", 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stale, "destroyed keys are never rewrapped")
+
+	batch, err := holderKeys.ReencryptRecords(ctx, "org-123", 2, "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, batch.Rewritten)
+	assert.Equal(t, "holder-b", batch.LastID)
+	assert.True(t, batch.Done)
+
+	stale, err = holderKeys.CountStaleRecords(ctx, "org-123", 2)
+	require.NoError(t, err)
+	assert.Zero(t, stale)
+
+	key, err := repo.Get(ctx, "org-123", "holder-a")
+	require.NoError(t, err)
+	assert.Equal(t, 2, key.KeysetVersion)
+
+	// Rewrapping keeps the holder keyset, so values written before the rewrap stay
+	// readable through a fresh manager (no cached keys).
+	fresh := WithHolderKeys(svc, NewHolderKeyManager(repo, holderKeys.keysetManager, 0, nil))
+
+	plaintext, err := fresh.Decrypt(ctx, holderFieldContext("holder-a"), ciphertexts["holder-a"])
+	require.NoError(t, err)
+	assert.Equal(t, "value-holder-a", plaintext)
+}
+
+func TestHolderKeys_ReencryptRecordsSkipsConcurrentChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, holderKeys, _ := newHolderKeyedTestService(t)
+
+	conflicting := &conflictingHolderDataKeyRepo{fakeHolderDataKeyRepo: newFakeHolderDataKeyRepo()}
+	manager := NewHolderKeyManager(conflicting, holderKeys.keysetManager, 0, nil)
+
+	_, err := manager.aeadForWrite(ctx, "org-123", "holder-a")
+	require.NoError(t, err)
+
+	batch, err := manager.ReencryptRecords(ctx, "org-123", 2, "", 10)
+	require.NoError(t, err)
+	assert.Zero(t, batch.Rewritten)
+	assert.Equal(t, 1, batch.Skipped)
+}
+
+// conflictingHolderDataKeyRepo fails every Rewrap with a revision conflict, as if
+// the key changed between the listing and the rewrap.
+type conflictingHolderDataKeyRepo struct {
+	*fakeHolderDataKeyRepo
+}
+
+func (c *conflictingHolderDataKeyRepo) Rewrap(_ context.Context, _ *mmodel.HolderDataKey, _ int64) error {
+	return mmodel.ErrKeysetRevisionConflict
+}
+
+func TestHolderKeys_DestroyRequiresIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	_, holderKeys, _ := newHolderKeyedTestService(t)
+
+	err := holderKeys.Destroy(context.Background(), "org-123", " ", "dpo@example.com")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, mmodel.ErrHolderDataKeyNotFound))
+}
@@ -347,12 +347,11 @@ func (km *KeysetManager) unwrapAndCache(ctx context.Context, cacheKey string, ke
 
 	span.SetAttributes(attribute.String("app.protection.mount_path", mount))
 
-	// Unwrap AEAD keyset. Provider is "vault" today (envelope encryption is
-	// Vault-only); this becomes dynamic when other KMS providers land.
-	// Provider operation timing is recorded even when the unwrap fails.
+	// Unwrap AEAD keyset. Provider operation timing is recorded, labelled with
+	// the configured KMS provider, even when the unwrap fails.
 	aeadStart := time.Now()
 	aeadBytes, err := km.unwrapper.UnwrapKeyset(ctx, mount, keyset.KEKPath, keyset.WrappedKeyset)
-	km.metrics.recordProviderOperation(ctx, providerOperationUnwrap, providerVault, time.Since(aeadStart).Milliseconds())
+	km.metrics.recordProviderOperation(ctx, providerOperationUnwrap, km.metrics.providerLabel(), time.Since(aeadStart).Milliseconds())
 
 	if err != nil {
 		km.metrics.recordProviderFailure(ctx, providerOperationUnwrap, errorCodeUnwrapAEADFailed)
@@ -378,7 +377,7 @@ func (km *KeysetManager) unwrapAndCache(ctx context.Context, cacheKey string, ke
 	// operation timing is recorded even on failure.
 	prfStart := time.Now()
 	prfBytes, err := km.unwrapper.UnwrapKeyset(ctx, mount, keyset.KEKPath, keyset.WrappedHMACKeyset)
-	km.metrics.recordProviderOperation(ctx, providerOperationUnwrap, providerVault, time.Since(prfStart).Milliseconds())
+	km.metrics.recordProviderOperation(ctx, providerOperationUnwrap, km.metrics.providerLabel(), time.Since(prfStart).Milliseconds())
 
 	if err != nil {
 		km.metrics.recordProviderFailure(ctx, providerOperationUnwrap, errorCodeUnwrapPRFFailed)
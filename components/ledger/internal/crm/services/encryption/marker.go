@@ -14,11 +14,18 @@ import (
 // MarkerPrefix is the prefix used to identify envelope-encrypted values.
 const MarkerPrefix = "tink:v"
 
+// holderKeyTag follows the version of values encrypted with a per-holder data
+// key. The URL-safe base64 alphabet has no ':', so the tag cannot be mistaken
+// for the start of a payload.
+const holderKeyTag = "h:"
+
 // EnvelopeMarker identifies ciphertext encrypted with envelope encryption.
-// Format: "tink:v{version}:{base64_payload}"
+// Format: "tink:v{version}:{base64_payload}", or "tink:v{version}:h:{base64_payload}"
+// when the payload was encrypted with the holder's data key.
 type EnvelopeMarker struct {
-	Version uint32 // The organization's keyset version that produced the payload
-	Payload []byte // The actual ciphertext (decoded from base64)
+	Version     uint32 // The organization's keyset version that produced the payload
+	HolderKeyed bool   // Whether the payload was encrypted with the holder's data key
+	Payload     []byte // The actual ciphertext (decoded from base64)
 }
 
 // ParseEnvelopeMarker parses a marked ciphertext string.
@@ -51,6 +58,8 @@ func ParseEnvelopeMarker(value string) (EnvelopeMarker, bool, error) {
 		return EnvelopeMarker{}, false, fmt.Errorf("invalid keyset version %q: %w", versionStr, err)
 	}
 
+	payloadB64, holderKeyed := strings.CutPrefix(payloadB64, holderKeyTag)
+
 	// Decode payload (URL-safe base64)
 	payload, err := base64.URLEncoding.DecodeString(payloadB64)
 	if err != nil {
@@ -58,8 +67,9 @@ func ParseEnvelopeMarker(value string) (EnvelopeMarker, bool, error) {
 	}
 
 	return EnvelopeMarker{
-		Version: uint32(version),
-		Payload: payload,
+		Version:     uint32(version),
+		HolderKeyed: holderKeyed,
+		Payload:     payload,
 	}, true, nil
 }
 
@@ -76,6 +86,15 @@ func FormatEnvelopeMarker(version uint32, payload []byte) string {
 	return fmt.Sprintf("%s%d:%s", MarkerPrefix, version, payloadB64)
 }
 
+// FormatHolderEnvelopeMarker creates a marked ciphertext string for a payload
+// encrypted with a holder's data key. version is the organization keyset version
+// active at write time, exactly as in FormatEnvelopeMarker, so re-encryption
+// sweeps treat holder-keyed values like any other value of that version.
+func FormatHolderEnvelopeMarker(version uint32, payload []byte) string {
+	payloadB64 := base64.URLEncoding.EncodeToString(payload)
+	return fmt.Sprintf("%s%d:%s%s", MarkerPrefix, version, holderKeyTag, payloadB64)
+}
+
 // HasEnvelopeMarker returns true if the value starts with the envelope marker prefix.
 func HasEnvelopeMarker(value string) bool {
 	return strings.HasPrefix(value, MarkerPrefix)
@@ -34,6 +34,17 @@ func TestParseEnvelopeMarker(t *testing.T) {
 			wantHasMarker: true,
 			wantErr:       false,
 		},
+		{
+			name:  "valid holder-keyed marker",
+			value: "tink:v2:h:" + validPayloadB64,
+			wantMarker: EnvelopeMarker{
+				Version:     2,
+				HolderKeyed: true,
+				Payload:     validPayload,
+			},
+			wantHasMarker: true,
+			wantErr:       false,
+		},
 		{
 			name:  "valid marker with large key ID",
 			value: "tink:v4294967295:" + validPayloadB64,
@@ -159,6 +170,10 @@ func TestParseEnvelopeMarker(t *testing.T) {
 					t.Errorf("ParseEnvelopeMarker() Version = %d, want %d", marker.Version, tt.wantMarker.Version)
 				}
 
+				if marker.HolderKeyed != tt.wantMarker.HolderKeyed {
+					t.Errorf("ParseEnvelopeMarker() HolderKeyed = %v, want %v", marker.HolderKeyed, tt.wantMarker.HolderKeyed)
+				}
+
 				if string(marker.Payload) != string(tt.wantMarker.Payload) {
 					t.Errorf("ParseEnvelopeMarker() Payload = %q, want %q", string(marker.Payload), string(tt.wantMarker.Payload))
 				}
@@ -281,6 +296,33 @@ func TestFormatEnvelopeMarker_Roundtrip(t *testing.T) {
 	}
 }
 
+func TestFormatHolderEnvelopeMarker_Roundtrip(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte{0x00, 0xfb, 0xff, 0x10}
+
+	formatted := FormatHolderEnvelopeMarker(3, payload)
+
+	if want := "tink:v3:h:" + base64.URLEncoding.EncodeToString(payload); formatted != want {
+		t.Fatalf("FormatHolderEnvelopeMarker() = %q, want %q", formatted, want)
+	}
+
+	marker, hasMarker, err := ParseEnvelopeMarker(formatted)
+	if err != nil || !hasMarker {
+		t.Fatalf("ParseEnvelopeMarker() = (%v, %v), want a marker", hasMarker, err)
+	}
+
+	if marker.Version != 3 || !marker.HolderKeyed || string(marker.Payload) != string(payload) {
+		t.Errorf("Roundtrip failed: got %+v", marker)
+	}
+
+	// An organization-keyed value of the same version is not holder-keyed.
+	marker, _, err = ParseEnvelopeMarker(FormatEnvelopeMarker(3, payload))
+	if err != nil || marker.HolderKeyed {
+		t.Errorf("ParseEnvelopeMarker(organization-keyed) HolderKeyed = %v, err = %v", marker.HolderKeyed, err)
+	}
+}
+
 func TestHasEnvelopeMarker(t *testing.T) {
 	t.Parallel()
 
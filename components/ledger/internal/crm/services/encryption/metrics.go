@@ -16,8 +16,9 @@ import (
 // on the provider operation metrics. They are short, stable classifiers and MUST
 // NOT be derived from raw provider error text, keysets, credentials, or PII.
 const (
-	// providerVault is the KMS provider label. Envelope encryption is Vault-only
-	// today; this becomes dynamic when other KMS providers land.
+	// providerVault is the default KMS provider label, used when the metrics seam
+	// was built without WithProvider. Bootstrap sets the label from KMS_VENDOR
+	// ("vault", "pkcs11" or "local").
 	providerVault = "vault"
 
 	// providerOperationWrap / providerOperationUnwrap are the operation labels.
@@ -43,7 +44,8 @@ const (
 // outcome, error_type, operation, provider, error_code, organization_status,
 // result) and never plaintext, keysets, credentials, PII, or financial values.
 type protectionMetrics struct {
-	factory *metrics.MetricsFactory
+	factory  *metrics.MetricsFactory
+	provider string
 }
 
 // NewProtectionMetrics builds the protection metrics seam. A nil factory
@@ -53,6 +55,28 @@ func NewProtectionMetrics(factory *metrics.MetricsFactory) *protectionMetrics {
 	return &protectionMetrics{factory: factory}
 }
 
+// WithProvider sets the KMS provider label recorded on the provider operation
+// metrics and returns m. An empty provider keeps the default (providerVault).
+// The value MUST come from the closed KMS vendor vocabulary.
+func (m *protectionMetrics) WithProvider(provider string) *protectionMetrics {
+	if m == nil {
+		return nil
+	}
+
+	m.provider = provider
+
+	return m
+}
+
+// providerLabel returns the KMS provider label for provider operation metrics.
+func (m *protectionMetrics) providerLabel() string {
+	if m == nil || m.provider == "" {
+		return providerVault
+	}
+
+	return m.provider
+}
+
 // emitCounter adds 1 to the named counter with the given attributes, swallowing
 // the factory error like readyz. It is the single guarded counter path.
 func (m *protectionMetrics) emitCounter(ctx context.Context, metric metrics.Metric, attrs ...attribute.KeyValue) {
@@ -105,11 +129,7 @@ func (m *protectionMetrics) recordEncryptDecrypt(ctx context.Context, path, outc
 // underlying histogram is int64-backed, so milliseconds (not seconds) are
 // recorded to avoid truncating sub-second KMS latencies to zero.
 //
-// provider is kept as a parameter even though every call site passes "vault"
-// today: envelope encryption is Vault-only now and this becomes dynamic when
-// other KMS providers land.
-//
-//nolint:unparam // provider is intentionally a parameter for future KMS providers
+// provider is the KMS provider label; call sites pass m.providerLabel().
 func (m *protectionMetrics) recordProviderOperation(ctx context.Context, operation, provider string, ms int64) {
 	m.emitHistogram(ctx, utils.CRMProtectionProviderOperationMs, ms,
 		attribute.String("operation", operation),
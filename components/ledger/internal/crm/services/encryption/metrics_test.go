@@ -72,6 +72,20 @@ func TestProtectionMetrics_NopFactory_NeverErrorsOrBlocks(t *testing.T) {
 	exerciseAllMethods(context.Background(), m)
 }
 
+// TestProtectionMetrics_ProviderLabel asserts the provider label defaults to
+// "vault", follows WithProvider, and stays safe on a nil receiver.
+func TestProtectionMetrics_ProviderLabel(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "vault", NewProtectionMetrics(nil).providerLabel())
+	assert.Equal(t, "vault", NewProtectionMetrics(nil).WithProvider("").providerLabel())
+	assert.Equal(t, "pkcs11", NewProtectionMetrics(nil).WithProvider("pkcs11").providerLabel())
+
+	var m *protectionMetrics
+	assert.Nil(t, m.WithProvider("local"))
+	assert.Equal(t, "vault", m.providerLabel())
+}
+
 // TestResolve_RepositoryError_Propagated asserts an unexpected repo error is
 // returned unchanged.
 func TestResolve_RepositoryError_Propagated(t *testing.T) {
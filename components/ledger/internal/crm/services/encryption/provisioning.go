@@ -421,8 +421,7 @@ func (s *provisioningService) generateMixedKeysets(ctx context.Context, mount, k
 //   - The bare ctx.Err() check between the two generations returns verbatim ==
 //     true: that context sentinel is returned Is-comparable, not masked.
 //
-// Provider is "vault" today (envelope is Vault-only); this becomes dynamic when
-// other KMS providers land.
+// The provider label is the configured KMS vendor (see protectionMetrics.WithProvider).
 func (s *provisioningService) generateKeysetPair(
 	ctx context.Context,
 	genAEAD func(context.Context) (tink.KeysetBundle, error),
@@ -430,7 +429,7 @@ func (s *provisioningService) generateKeysetPair(
 ) (tink.KeysetBundle, tink.KeysetBundle, bool, error) {
 	aeadWrapStart := time.Now()
 	aeadBundle, err := genAEAD(ctx)
-	s.metrics.recordProviderOperation(ctx, providerOperationWrap, providerVault, time.Since(aeadWrapStart).Milliseconds())
+	s.metrics.recordProviderOperation(ctx, providerOperationWrap, s.metrics.providerLabel(), time.Since(aeadWrapStart).Milliseconds())
 
 	if err != nil {
 		s.metrics.recordProviderFailure(ctx, providerOperationWrap, errorCodeWrapAEADFailed)
@@ -447,7 +446,7 @@ func (s *provisioningService) generateKeysetPair(
 
 	prfWrapStart := time.Now()
 	prfBundle, err := genPRF(ctx)
-	s.metrics.recordProviderOperation(ctx, providerOperationWrap, providerVault, time.Since(prfWrapStart).Milliseconds())
+	s.metrics.recordProviderOperation(ctx, providerOperationWrap, s.metrics.providerLabel(), time.Since(prfWrapStart).Milliseconds())
 
 	if err != nil {
 		s.metrics.recordProviderFailure(ctx, providerOperationWrap, errorCodeWrapPRFFailed)
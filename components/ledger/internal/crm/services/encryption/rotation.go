@@ -0,0 +1,864 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
+	libOpenTelemetry "github.com/LerianStudio/lib-observability/tracing"
+	mongoEncryption "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/encryption"
+	pkg "github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultReencryptionBatchSize is the number of records rewritten per
+// re-encryption batch. Progress is persisted after every batch, so it also
+// bounds the work repeated when an interrupted sweep resumes.
+const defaultReencryptionBatchSize = 100
+
+// Static error codes recorded on a failed re-encryption sweep. They never carry
+// dynamic error text or PII.
+const (
+	rotationErrorReencryptionFailed = "reencryption_failed"
+	rotationErrorTargetMissing      = "reencryption_target_missing"
+)
+
+// Static, error-free Reason phrases for the rotation audit events.
+const (
+	reasonRotationSuccess = "organization keysets rotated"
+	reasonRotationFailure = "organization keyset rotation failed"
+	reasonRetireSuccess   = "organization keyset versions retired"
+)
+
+// ReencryptionTarget is a collection of protected records that a key rotation
+// rewrites to the organization's current keyset version. The CRM holder and
+// instrument repositories implement it.
+type ReencryptionTarget interface {
+	// CountStaleRecords counts the organization's records holding at least one
+	// protected value that was not written with currentVersion (an older
+	// envelope version or unmarked legacy ciphertext).
+	CountStaleRecords(ctx context.Context, organizationID string, currentVersion int) (int64, error)
+	// ReencryptRecords rewrites up to limit stale records whose id sorts after
+	// afterID, in id order. Every protected value and search token of a
+	// rewritten record is produced with the active keyset.
+	ReencryptRecords(ctx context.Context, organizationID string, currentVersion int, afterID string, limit int) (ReencryptionBatch, error)
+}
+
+// ReencryptionBatch reports the outcome of one ReencryptRecords call.
+type ReencryptionBatch struct {
+	// LastID is the id of the last record visited; the next batch starts after it.
+	LastID string
+	// Rewritten counts records rewritten to the current keyset version.
+	Rewritten int
+	// Skipped counts records left unchanged (concurrently updated, or the
+	// rewrite collided with a unique search index).
+	Skipped int
+	// Done reports that no stale record remains after LastID.
+	Done bool
+}
+
+// NamedReencryptionTarget binds a ReencryptionTarget to the stable name used in
+// the rotation progress report (e.g. "holders").
+type NamedReencryptionTarget struct {
+	Name   string
+	Target ReencryptionTarget
+}
+
+// KeyRotationService rotates an organization's envelope keysets and drives the
+// background re-encryption of its protected records.
+//
+//   - Rotate: creates keyset version N+1, makes it the write version, and starts
+//     re-encrypting every record still referencing an older version
+//   - GetRotation: returns the latest rotation and its progress
+//   - Resume: restarts an interrupted or failed sweep from its cursors
+//   - Retire: drops older versions from the readable set once no record
+//     references them
+type KeyRotationService interface {
+	Rotate(ctx context.Context, req RotateInput) (*mmodel.OrganizationKeyRotation, error)
+	GetRotation(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error)
+	Resume(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error)
+	Retire(ctx context.Context, req RetireInput) (RetireResult, error)
+}
+
+// RotateInput contains the parameters for rotating an organization's keysets.
+type RotateInput struct {
+	TenantID       string
+	OrganizationID string
+	Actor          string // Who initiated the rotation
+	Reason         string // Why the rotation was requested
+}
+
+// Validate validates the rotate request.
+func (r RotateInput) Validate() error {
+	return validateRotationRequest(r.TenantID, r.OrganizationID, r.Actor, r.Reason)
+}
+
+// RetireInput contains the parameters for retiring an organization's older
+// keyset versions.
+type RetireInput struct {
+	TenantID       string
+	OrganizationID string
+	Actor          string // Who initiated the retirement
+	Reason         string // Why the retirement was requested
+}
+
+// Validate validates the retire request.
+func (r RetireInput) Validate() error {
+	return validateRotationRequest(r.TenantID, r.OrganizationID, r.Actor, r.Reason)
+}
+
+// RetireResult reports the outcome of a retirement. RetiredVersions is empty
+// when nothing was retired; StaleRecords then tells which collections still
+// reference an older version.
+type RetireResult struct {
+	OrganizationID   string
+	CurrentVersion   int
+	RetiredVersions  []int
+	ReadableVersions []int
+	StaleRecords     map[string]int64
+}
+
+func validateRotationRequest(tenantID, organizationID, actor, reason string) error {
+	if tenantID == "" {
+		return fmt.Errorf("tenant_id is required")
+	}
+
+	if organizationID == "" {
+		return fmt.Errorf("organization_id is required")
+	}
+
+	if actor == "" {
+		return fmt.Errorf("actor is required")
+	}
+
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+
+	return nil
+}
+
+// keyRotationService implements KeyRotationService. The re-encryption sweep runs
+// detached from the request; running guards against two sweeps of the same
+// organization in this process, and the rotation document's revision guards
+// against sweeps in other replicas.
+type keyRotationService struct {
+	keysetRepo    KeysetRepository
+	registryRepo  mongoEncryption.RegistryRepository
+	rotationRepo  mongoEncryption.RotationRepository
+	generator     *provisioningService
+	keysetManager *KeysetManager
+	stateResolver *ProtectionStateResolver
+	auditWriter   AuditWriter
+	metrics       *protectionMetrics
+	targets       []NamedReencryptionTarget
+	batchSize     int
+	running       sync.Map // Key: "tenantID:organizationID"
+}
+
+// NewKeyRotationService creates a key rotation service with the given
+// dependencies. targets lists the record collections re-encrypted by every
+// rotation, in sweep order.
+//
+// keysetManager and stateResolver are invalidated after the keyset switch so
+// this process writes with the new version immediately; other replicas pick it
+// up when their caches expire. metrics is the nil-safe protection metrics seam.
+func NewKeyRotationService(
+	keysetRepo KeysetRepository,
+	registryRepo mongoEncryption.RegistryRepository,
+	rotationRepo mongoEncryption.RotationRepository,
+	keysetGenerator KeysetGenerator,
+	keysetManager *KeysetManager,
+	stateResolver *ProtectionStateResolver,
+	auditWriter AuditWriter,
+	metrics *protectionMetrics,
+	targets ...NamedReencryptionTarget,
+) KeyRotationService {
+	if metrics == nil {
+		metrics = NewProtectionMetrics(nil)
+	}
+
+	return &keyRotationService{
+		keysetRepo:    keysetRepo,
+		registryRepo:  registryRepo,
+		rotationRepo:  rotationRepo,
+		generator:     &provisioningService{keysetGenerator: keysetGenerator, metrics: metrics},
+		keysetManager: keysetManager,
+		stateResolver: stateResolver,
+		auditWriter:   auditWriter,
+		metrics:       metrics,
+		targets:       targets,
+		batchSize:     defaultReencryptionBatchSize,
+	}
+}
+
+// Rotate creates keyset version N+1 for the organization, wrapped under the same
+// KEK as version N, and makes it the write version. Version N stays readable
+// until Retire. The re-encryption of the organization's records starts in the
+// background; the returned rotation reports its initial totals.
+//
+// The registry is advanced before the keyset is stored, so a version is always
+// readable before anything can be written with it. A rotation interrupted
+// between the two steps is completed by the next Rotate call.
+func (s *keyRotationService) Rotate(ctx context.Context, req RotateInput) (*mmodel.OrganizationKeyRotation, error) {
+	rotation, keyIDs, err := s.rotate(ctx, req)
+
+	s.emitRotationAudit(ctx, req, keyIDs, err)
+
+	return rotation, err
+}
+
+// rotate performs the rotation and also returns the new primary key IDs for the
+// audit event, so the exported Rotate emits exactly one event.
+func (s *keyRotationService) rotate(ctx context.Context, req RotateInput) (*mmodel.OrganizationKeyRotation, []uint32, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.rotate")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.organization_id", req.OrganizationID))
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "invalid rotate request", err)
+
+		return nil, nil, fmt.Errorf("invalid rotate request: %w", err)
+	}
+
+	registry, err := s.getRegistry(ctx, req.OrganizationID)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to get registry", err)
+
+		return nil, nil, err
+	}
+
+	if err := s.ensureNoUnfinishedRotation(ctx, req.OrganizationID); err != nil {
+		return nil, nil, err
+	}
+
+	active, err := s.keysetRepo.GetActive(ctx, req.OrganizationID)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to get active keyset", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	// A registry ahead of the stored keysets is a rotation interrupted after the
+	// registry switch: finish it at the version the registry already advertises.
+	toVersion := active.Version + 1
+	if registry.CurrentVersion > active.Version {
+		toVersion = registry.CurrentVersion
+	}
+
+	span.SetAttributes(attribute.Int("app.protection.to_version", toVersion))
+
+	if registry.CurrentVersion < toVersion {
+		if err := s.advanceRegistry(ctx, registry, toVersion, req); err != nil {
+			libOpenTelemetry.HandleSpanError(span, "failed to advance registry", err)
+
+			return nil, nil, err
+		}
+	}
+
+	keyset, verbatim, err := s.buildRotatedKeyset(ctx, active, toVersion)
+	if err != nil {
+		if verbatim {
+			return nil, nil, err
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "failed to wrap rotated keyset", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	if err := s.keysetRepo.Save(ctx, keyset); err != nil {
+		if errors.Is(err, mmodel.ErrKeysetAlreadyExists) {
+			return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption)
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "failed to save rotated keyset", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	s.markRotated(ctx, active)
+	s.invalidate(req.TenantID, req.OrganizationID)
+
+	rotation, err := mmodel.NewOrganizationKeyRotation(req.TenantID, req.OrganizationID, active.Version, toVersion, req.Actor, req.Reason, s.targetNames())
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to build key rotation", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	if err := s.countTotals(ctx, rotation); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to count stale records", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	if err := s.rotationRepo.Save(ctx, rotation); err != nil {
+		if errors.Is(err, mmodel.ErrKeyRotationInProgress) {
+			return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption)
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "failed to save key rotation", err)
+
+		return nil, nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+	}
+
+	s.startReencryption(ctx, rotation)
+
+	return cloneRotation(rotation), []uint32{keyset.KeysetInfo.PrimaryKeyID, keyset.HMACKeysetInfo.PrimaryKeyID}, nil
+}
+
+// GetRotation returns the organization's latest rotation.
+func (s *keyRotationService) GetRotation(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if organizationID == "" {
+		return nil, fmt.Errorf("organization_id is required")
+	}
+
+	rotation, err := s.rotationRepo.GetLatest(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, mmodel.ErrKeyRotationNotFound) {
+			return nil, pkg.ValidateBusinessError(constant.ErrKeyRotationNotFound, EntityOrganizationEncryption)
+		}
+
+		return nil, fmt.Errorf("failed to get key rotation: %w", err)
+	}
+
+	return rotation, nil
+}
+
+// Resume restarts the re-encryption sweep of the organization's latest rotation.
+// A failed or interrupted sweep continues from its persisted cursors; a
+// completed one is swept again from the start, which picks up records written
+// with an older version by replicas whose caches had not yet expired. A sweep
+// already running in this process is left alone.
+func (s *keyRotationService) Resume(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.resume_rotation")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.organization_id", organizationID))
+
+	rotation, err := s.GetRotation(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.isRunning(rotation.TenantID, rotation.OrganizationID) {
+		return rotation, nil
+	}
+
+	expected := rotation.Revision
+
+	if rotation.IsFinished() {
+		for i := range rotation.Targets {
+			rotation.Targets[i] = mmodel.KeyRotationTargetProgress{Name: rotation.Targets[i].Name}
+		}
+
+		if err := s.countTotals(ctx, rotation); err != nil {
+			libOpenTelemetry.HandleSpanError(span, "failed to count stale records", err)
+
+			return nil, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+		}
+
+		rotation.CompletedAt = nil
+	}
+
+	rotation.Status = mmodel.KeyRotationStatusRunning
+	rotation.LastErrorCode = ""
+	rotation.UpdatedAt = time.Now().UTC()
+
+	if err := s.rotationRepo.Update(ctx, rotation, expected); err != nil {
+		if errors.Is(err, mmodel.ErrKeyRotationInProgress) {
+			return nil, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption)
+		}
+
+		libOpenTelemetry.HandleSpanError(span, "failed to update key rotation", err)
+
+		return nil, fmt.Errorf("failed to update key rotation: %w", err)
+	}
+
+	rotation.Revision = expected + 1
+
+	s.startReencryption(ctx, rotation)
+
+	return cloneRotation(rotation), nil
+}
+
+// Retire drops every version but the current one from the organization's
+// readable set, and disables legacy reads, once no record references them.
+// When records still do, nothing is retired and the result reports the stale
+// record counts per collection. A running rotation blocks retirement.
+func (s *keyRotationService) Retire(ctx context.Context, req RetireInput) (RetireResult, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.retire")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.organization_id", req.OrganizationID))
+
+	if err := ctx.Err(); err != nil {
+		return RetireResult{}, err
+	}
+
+	if err := req.Validate(); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "invalid retire request", err)
+
+		return RetireResult{}, fmt.Errorf("invalid retire request: %w", err)
+	}
+
+	registry, err := s.getRegistry(ctx, req.OrganizationID)
+	if err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to get registry", err)
+
+		return RetireResult{}, err
+	}
+
+	if err := s.ensureNoUnfinishedRotation(ctx, req.OrganizationID); err != nil {
+		return RetireResult{}, err
+	}
+
+	result := RetireResult{
+		OrganizationID:   req.OrganizationID,
+		CurrentVersion:   registry.CurrentVersion,
+		RetiredVersions:  []int{},
+		ReadableVersions: registry.ReadableVersions,
+		StaleRecords:     make(map[string]int64, len(s.targets)),
+	}
+
+	var stale int64
+
+	for _, t := range s.targets {
+		count, err := t.Target.CountStaleRecords(ctx, req.OrganizationID, registry.CurrentVersion)
+		if err != nil {
+			libOpenTelemetry.HandleSpanError(span, "failed to count stale records", err)
+
+			return RetireResult{}, pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+		}
+
+		result.StaleRecords[t.Name] = count
+		stale += count
+	}
+
+	if stale > 0 {
+		return result, nil
+	}
+
+	retired := make([]int, 0, len(registry.ReadableVersions))
+
+	for _, v := range registry.ReadableVersions {
+		if v != registry.CurrentVersion {
+			retired = append(retired, v)
+		}
+	}
+
+	if len(retired) == 0 && !registry.LegacyReadable {
+		return result, nil
+	}
+
+	expected := registry.Revision
+	registry.ReadableVersions = []int{registry.CurrentVersion}
+	registry.LegacyReadable = false
+	registry.UpdatedAt = time.Now().UTC()
+	registry.UpdatedBy = req.Actor
+	registry.LastTransitionReason = req.Reason
+
+	if err := s.registryRepo.Update(ctx, registry, expected); err != nil {
+		libOpenTelemetry.HandleSpanError(span, "failed to update registry", err)
+
+		return RetireResult{}, s.mapRegistryUpdateError(ctx, err)
+	}
+
+	s.invalidate(req.TenantID, req.OrganizationID)
+
+	result.RetiredVersions = retired
+	result.ReadableVersions = registry.ReadableVersions
+
+	s.emitAudit(ctx, req.TenantID, req.OrganizationID, req.Actor, mmodel.AuditActionRetire, mmodel.AuditOutcomeSuccess, reasonRetireSuccess, nil)
+
+	return result, nil
+}
+
+// getRegistry loads the organization's registry record, mapping a missing record
+// to the not-provisioned business error.
+func (s *keyRotationService) getRegistry(ctx context.Context, organizationID string) (*mmodel.OrganizationRegistryRecord, error) {
+	registry, err := s.registryRepo.Get(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, mmodel.ErrRegistryNotFound) {
+			return nil, pkg.ValidateBusinessError(constant.ErrRegistryNotFound, EntityOrganizationEncryption)
+		}
+
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	if registry == nil {
+		return nil, pkg.ValidateBusinessError(constant.ErrRegistryNotFound, EntityOrganizationEncryption)
+	}
+
+	return registry, nil
+}
+
+// ensureNoUnfinishedRotation fails with the in-progress business error when the
+// organization's latest rotation has not completed.
+func (s *keyRotationService) ensureNoUnfinishedRotation(ctx context.Context, organizationID string) error {
+	latest, err := s.rotationRepo.GetLatest(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, mmodel.ErrKeyRotationNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get key rotation: %w", err)
+	}
+
+	if !latest.IsFinished() {
+		return pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption)
+	}
+
+	return nil
+}
+
+// advanceRegistry makes toVersion the organization's write version and adds it
+// to the readable set. A concurrent registry change maps to the in-progress error.
+func (s *keyRotationService) advanceRegistry(ctx context.Context, registry *mmodel.OrganizationRegistryRecord, toVersion int, req RotateInput) error {
+	expected := registry.Revision
+
+	readable := slices.Clone(registry.ReadableVersions)
+	if !slices.Contains(readable, toVersion) {
+		readable = append(readable, toVersion)
+	}
+
+	slices.Sort(readable)
+
+	registry.CurrentVersion = toVersion
+	registry.ReadableVersions = readable
+	registry.UpdatedAt = time.Now().UTC()
+	registry.UpdatedBy = req.Actor
+	registry.LastTransitionReason = req.Reason
+
+	if err := s.registryRepo.Update(ctx, registry, expected); err != nil {
+		return s.mapRegistryUpdateError(ctx, err)
+	}
+
+	s.invalidate(req.TenantID, req.OrganizationID)
+
+	return nil
+}
+
+// mapRegistryUpdateError maps a registry update failure: a revision conflict
+// means another rotation or retirement won the race.
+func (s *keyRotationService) mapRegistryUpdateError(ctx context.Context, err error) error {
+	if errors.Is(err, mmodel.ErrRegistryRevisionConflict) {
+		s.metrics.recordRegistryConflict(ctx)
+
+		return pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption)
+	}
+
+	return pkg.ValidateBusinessError(constant.ErrKeyRotationFailed, EntityOrganizationEncryption)
+}
+
+// buildRotatedKeyset generates fresh AEAD and PRF keysets wrapped under the
+// previous version's KEK and assembles them as toVersion. The verbatim flag
+// follows generateKeysetPair: true only for a bare context cancellation.
+func (s *keyRotationService) buildRotatedKeyset(ctx context.Context, previous *mmodel.OrganizationKeyset, toVersion int) (*mmodel.OrganizationKeyset, bool, error) {
+	aeadBundle, prfBundle, verbatim, err := s.generator.generateFreshKeysets(ctx, previous.KEKMountPath, previous.KEKPath)
+	if err != nil {
+		return nil, verbatim, err
+	}
+
+	return &mmodel.OrganizationKeyset{
+		TenantID:          previous.TenantID,
+		OrganizationID:    previous.OrganizationID,
+		Version:           toVersion,
+		KEKPath:           previous.KEKPath,
+		KEKMountPath:      previous.KEKMountPath,
+		WrappedKeyset:     aeadBundle.Wrapped.WrappedData,
+		KeysetInfo:        convertKeysetInfo(aeadBundle.Wrapped.Info),
+		WrappedHMACKeyset: prfBundle.Wrapped.WrappedData,
+		HMACKeysetInfo:    convertKeysetInfo(prfBundle.Wrapped.Info),
+		Revision:          1,
+		CreatedAt:         time.Now().UTC(),
+	}, false, nil
+}
+
+// markRotated stamps RotatedAt on the superseded keyset version. It is
+// informational only, so a failure is logged and never fails the rotation.
+func (s *keyRotationService) markRotated(ctx context.Context, previous *mmodel.OrganizationKeyset) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	now := time.Now().UTC()
+	previous.RotatedAt = &now
+
+	if err := s.keysetRepo.Update(ctx, previous, previous.Revision); err != nil && logger != nil {
+		logger.Log(ctx, libLog.LevelWarn, "failed to stamp rotated keyset",
+			libLog.String("organization_id", previous.OrganizationID),
+			libLog.Int("keyset_version", previous.Version))
+	}
+}
+
+// invalidate drops this process's cached protection state and primitives so
+// the next access observes the new registry and keyset versions.
+func (s *keyRotationService) invalidate(tenantID, organizationID string) {
+	if s.stateResolver != nil {
+		s.stateResolver.Invalidate(tenantID, organizationID)
+	}
+
+	if s.keysetManager != nil {
+		s.keysetManager.InvalidateCacheForTenant(tenantID, organizationID)
+	}
+}
+
+func (s *keyRotationService) targetNames() []string {
+	names := make([]string, len(s.targets))
+	for i, t := range s.targets {
+		names[i] = t.Name
+	}
+
+	return names
+}
+
+func (s *keyRotationService) target(name string) (ReencryptionTarget, bool) {
+	for _, t := range s.targets {
+		if t.Name == name {
+			return t.Target, true
+		}
+	}
+
+	return nil, false
+}
+
+// countTotals sets each target's Total to its current stale record count.
+func (s *keyRotationService) countTotals(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) error {
+	for i := range rotation.Targets {
+		target, ok := s.target(rotation.Targets[i].Name)
+		if !ok {
+			continue
+		}
+
+		total, err := target.CountStaleRecords(ctx, rotation.OrganizationID, rotation.ToVersion)
+		if err != nil {
+			return err
+		}
+
+		rotation.Targets[i].Total = total
+	}
+
+	return nil
+}
+
+func (s *keyRotationService) isRunning(tenantID, organizationID string) bool {
+	_, ok := s.running.Load(buildCacheKey(tenantID, organizationID))
+
+	return ok
+}
+
+// startReencryption launches the re-encryption sweep detached from the request
+// context, keeping its values (tenant database, tracking) but not its
+// cancellation. The sweep works on its own copy of the rotation. It is a no-op
+// when a sweep for the organization already runs in this process.
+func (s *keyRotationService) startReencryption(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	key := buildCacheKey(rotation.TenantID, rotation.OrganizationID)
+	if _, loaded := s.running.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	sweep := cloneRotation(rotation)
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(ctx), logger, "crm", "key_rotation.reencrypt",
+		libRuntime.KeepRunning, func(c context.Context) {
+			defer s.running.Delete(key)
+
+			s.reencrypt(c, sweep)
+		})
+}
+
+// reencrypt sweeps every unfinished target batch by batch, persisting the
+// cursor and counters after each batch. It stops without further writes when
+// the rotation document was changed by someone else (revision conflict).
+func (s *keyRotationService) reencrypt(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.protection.reencrypt")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", rotation.OrganizationID),
+		attribute.Int("app.protection.to_version", rotation.ToVersion),
+	)
+
+	for i := range rotation.Targets {
+		progress := &rotation.Targets[i]
+
+		target, ok := s.target(progress.Name)
+		if !ok && !progress.Done {
+			s.failRotation(ctx, rotation, rotationErrorTargetMissing)
+
+			return
+		}
+
+		for !progress.Done {
+			batch, err := target.ReencryptRecords(ctx, rotation.OrganizationID, rotation.ToVersion, progress.Cursor, s.batchSize)
+			if err != nil {
+				libOpenTelemetry.HandleSpanError(span, "failed to re-encrypt records", err)
+
+				if logger != nil {
+					logger.Log(ctx, libLog.LevelWarn, "key rotation re-encryption failed",
+						libLog.String("organization_id", rotation.OrganizationID),
+						libLog.String("target", progress.Name))
+				}
+
+				s.failRotation(ctx, rotation, rotationErrorReencryptionFailed)
+
+				return
+			}
+
+			progress.Processed += int64(batch.Rewritten)
+			progress.Skipped += int64(batch.Skipped)
+			progress.Done = batch.Done
+
+			if batch.LastID != "" {
+				progress.Cursor = batch.LastID
+			}
+
+			if !s.persistRotation(ctx, rotation) {
+				return
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	rotation.Status = mmodel.KeyRotationStatusCompleted
+	rotation.CompletedAt = &now
+
+	if s.persistRotation(ctx, rotation) && logger != nil {
+		_, processed, skipped := rotation.Totals()
+
+		logger.Log(ctx, libLog.LevelInfo, "key rotation re-encryption completed",
+			libLog.String("organization_id", rotation.OrganizationID),
+			libLog.Int("to_version", rotation.ToVersion),
+			libLog.Any("processed", processed),
+			libLog.Any("skipped", skipped))
+	}
+}
+
+// failRotation records a static error code and the failed status.
+func (s *keyRotationService) failRotation(ctx context.Context, rotation *mmodel.OrganizationKeyRotation, errorCode string) {
+	rotation.Status = mmodel.KeyRotationStatusFailed
+	rotation.LastErrorCode = errorCode
+
+	s.persistRotation(ctx, rotation)
+}
+
+// persistRotation writes the rotation under optimistic concurrency and reports
+// whether the sweep may continue.
+func (s *keyRotationService) persistRotation(ctx context.Context, rotation *mmodel.OrganizationKeyRotation) bool {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	expected := rotation.Revision
+	rotation.UpdatedAt = time.Now().UTC()
+
+	if err := s.rotationRepo.Update(ctx, rotation, expected); err != nil {
+		if logger != nil {
+			logger.Log(ctx, libLog.LevelWarn, "key rotation progress not persisted",
+				libLog.String("organization_id", rotation.OrganizationID),
+				libLog.Bool("revision_conflict", errors.Is(err, mmodel.ErrKeyRotationInProgress)))
+		}
+
+		return false
+	}
+
+	rotation.Revision = expected + 1
+
+	return true
+}
+
+// cloneRotation returns a copy of the rotation that shares no progress slice
+// with the original, so the detached sweep and the caller never race.
+func cloneRotation(rotation *mmodel.OrganizationKeyRotation) *mmodel.OrganizationKeyRotation {
+	clone := *rotation
+	clone.Targets = slices.Clone(rotation.Targets)
+
+	if rotation.CompletedAt != nil {
+		completedAt := *rotation.CompletedAt
+		clone.CompletedAt = &completedAt
+	}
+
+	return &clone
+}
+
+// emitRotationAudit emits one best-effort audit event for the terminal outcome
+// of Rotate: the new primary key IDs on success, the static rotation error code
+// on failure.
+func (s *keyRotationService) emitRotationAudit(ctx context.Context, req RotateInput, keyIDs []uint32, rotateErr error) {
+	if rotateErr != nil {
+		s.emitAudit(ctx, req.TenantID, req.OrganizationID, req.Actor, mmodel.AuditActionRotate, mmodel.AuditOutcomeFailure, reasonRotationFailure,
+			&mmodel.AuditDetails{ErrorCode: constant.ErrKeyRotationFailed.Error()})
+
+		return
+	}
+
+	s.emitAudit(ctx, req.TenantID, req.OrganizationID, req.Actor, mmodel.AuditActionRotate, mmodel.AuditOutcomeSuccess, reasonRotationSuccess,
+		&mmodel.AuditDetails{AffectedKeyIDs: keyIDs})
+}
+
+// emitAudit builds and emits a rotation audit event. Like provisioning audit it
+// is best-effort and never affects the operation's result.
+func (s *keyRotationService) emitAudit(ctx context.Context, tenantID, organizationID, actor string, action mmodel.AuditAction, outcome mmodel.AuditOutcome, reason string, details *mmodel.AuditDetails) {
+	if s.auditWriter == nil {
+		return
+	}
+
+	logger, _, reqID, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if actor == "" {
+		actor = defaultAuditActor
+	}
+
+	event, err := mmodel.NewProtectionAuditEvent(mmodel.ProtectionAuditEventInput{
+		TenantID:       tenantID,
+		OrganizationID: organizationID,
+		EventType:      mmodel.AuditEventTypeRotation,
+		Action:         action,
+		Outcome:        outcome,
+		ActorID:        actor,
+		ActorType:      auditActorTypeService,
+		Reason:         reason,
+		RequestID:      reqID,
+		Details:        details,
+	})
+	if err != nil {
+		if logger != nil {
+			logger.Log(ctx, libLog.LevelDebug, "audit event build skipped",
+				libLog.String("organization_id", organizationID),
+				libLog.String("outcome", string(outcome)))
+		}
+
+		return
+	}
+
+	s.auditWriter.EmitAsync(ctx, event)
+}
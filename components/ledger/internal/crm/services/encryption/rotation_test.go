@@ -0,0 +1,516 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Fakes and Helpers
+// ---------------------------------------------------------------------------
+
+// fakeVersionedKeysetRepo implements KeysetRepository with one document per
+// (organization, version), like the unique index of the MongoDB repository.
+type fakeVersionedKeysetRepo struct {
+	mu      sync.Mutex
+	keysets map[string]map[int]*mmodel.OrganizationKeyset
+}
+
+func newFakeVersionedKeysetRepo(keysets ...*mmodel.OrganizationKeyset) *fakeVersionedKeysetRepo {
+	f := &fakeVersionedKeysetRepo{keysets: make(map[string]map[int]*mmodel.OrganizationKeyset)}
+	for _, k := range keysets {
+		f.put(k)
+	}
+
+	return f
+}
+
+func (f *fakeVersionedKeysetRepo) put(keyset *mmodel.OrganizationKeyset) {
+	if f.keysets[keyset.OrganizationID] == nil {
+		f.keysets[keyset.OrganizationID] = make(map[int]*mmodel.OrganizationKeyset)
+	}
+
+	f.keysets[keyset.OrganizationID][keyset.Version] = keyset
+}
+
+func (f *fakeVersionedKeysetRepo) Save(_ context.Context, keyset *mmodel.OrganizationKeyset) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.keysets[keyset.OrganizationID][keyset.Version]; exists {
+		return mmodel.ErrKeysetAlreadyExists
+	}
+
+	f.put(keyset)
+
+	return nil
+}
+
+func (f *fakeVersionedKeysetRepo) Get(ctx context.Context, organizationID string) (*mmodel.OrganizationKeyset, error) {
+	return f.GetActive(ctx, organizationID)
+}
+
+func (f *fakeVersionedKeysetRepo) GetActive(_ context.Context, organizationID string) (*mmodel.OrganizationKeyset, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var active *mmodel.OrganizationKeyset
+
+	for _, k := range f.keysets[organizationID] {
+		if active == nil || k.Version > active.Version {
+			active = k
+		}
+	}
+
+	if active == nil {
+		return nil, mmodel.ErrKeysetNotFound
+	}
+
+	copied := *active
+
+	return &copied, nil
+}
+
+func (f *fakeVersionedKeysetRepo) GetByVersion(_ context.Context, organizationID string, version int) (*mmodel.OrganizationKeyset, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	keyset, ok := f.keysets[organizationID][version]
+	if !ok {
+		return nil, mmodel.ErrKeysetNotFound
+	}
+
+	return keyset, nil
+}
+
+func (f *fakeVersionedKeysetRepo) Update(_ context.Context, keyset *mmodel.OrganizationKeyset, _ int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	copied := *keyset
+	f.put(&copied)
+
+	return nil
+}
+
+// fakeRotationRepo implements mongoEncryption.RotationRepository in memory,
+// honoring the insert-only Save and the revision-guarded Update.
+type fakeRotationRepo struct {
+	mu        sync.Mutex
+	rotations map[string]*mmodel.OrganizationKeyRotation
+}
+
+func newFakeRotationRepo() *fakeRotationRepo {
+	return &fakeRotationRepo{rotations: make(map[string]*mmodel.OrganizationKeyRotation)}
+}
+
+func (f *fakeRotationRepo) Save(_ context.Context, rotation *mmodel.OrganizationKeyRotation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if existing, ok := f.rotations[rotation.OrganizationID]; ok && existing.ToVersion >= rotation.ToVersion {
+		return mmodel.ErrKeyRotationInProgress
+	}
+
+	f.rotations[rotation.OrganizationID] = cloneRotation(rotation)
+
+	return nil
+}
+
+func (f *fakeRotationRepo) GetLatest(_ context.Context, organizationID string) (*mmodel.OrganizationKeyRotation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rotation, ok := f.rotations[organizationID]
+	if !ok {
+		return nil, mmodel.ErrKeyRotationNotFound
+	}
+
+	return cloneRotation(rotation), nil
+}
+
+func (f *fakeRotationRepo) Update(_ context.Context, rotation *mmodel.OrganizationKeyRotation, expectedRevision int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	existing, ok := f.rotations[rotation.OrganizationID]
+	if !ok || existing.Revision != expectedRevision {
+		return mmodel.ErrKeyRotationInProgress
+	}
+
+	stored := cloneRotation(rotation)
+	stored.Revision = expectedRevision + 1
+	f.rotations[rotation.OrganizationID] = stored
+
+	return nil
+}
+
+// fakeReencryptionTarget simulates a collection of stale records re-encrypted
+// in id order.
+type fakeReencryptionTarget struct {
+	mu       sync.Mutex
+	stale    []string
+	skip     map[string]bool
+	failWith error
+	batches  int
+}
+
+func (f *fakeReencryptionTarget) CountStaleRecords(_ context.Context, _ string, _ int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return int64(len(f.stale)), nil
+}
+
+func (f *fakeReencryptionTarget) ReencryptRecords(_ context.Context, _ string, _ int, afterID string, limit int) (ReencryptionBatch, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.batches++
+
+	if f.failWith != nil {
+		return ReencryptionBatch{}, f.failWith
+	}
+
+	batch := ReencryptionBatch{LastID: afterID}
+	remaining := make([]string, 0, len(f.stale))
+	visited := 0
+
+	for _, id := range f.stale {
+		if id <= afterID || visited == limit {
+			remaining = append(remaining, id)
+			continue
+		}
+
+		visited++
+		batch.LastID = id
+
+		if f.skip[id] {
+			batch.Skipped++
+
+			remaining = append(remaining, id)
+
+			continue
+		}
+
+		batch.Rewritten++
+	}
+
+	f.stale = remaining
+	batch.Done = visited < limit
+
+	return batch, nil
+}
+
+func rotationTestKeyset(orgID string, version int) *mmodel.OrganizationKeyset {
+	return &mmodel.OrganizationKeyset{
+		TenantID:          "tenant-1",
+		OrganizationID:    orgID,
+		Version:           version,
+		KEKPath:           "org-" + orgID,
+		KEKMountPath:      "transit-st",
+		WrappedKeyset:     "vault:v1:aead",
+		KeysetInfo:        mmodel.KeysetInfo{PrimaryKeyID: 1},
+		WrappedHMACKeyset: "vault:v1:prf",
+		HMACKeysetInfo:    mmodel.KeysetInfo{PrimaryKeyID: 2},
+		Revision:          1,
+	}
+}
+
+func rotationTestRegistry(t *testing.T, orgID string) *fakeRegistryRepoForProv {
+	t.Helper()
+
+	record, err := mmodel.NewOrganizationRegistryRecord("tenant-1", orgID, "admin", "provisioned")
+	require.NoError(t, err)
+
+	registryRepo := newFakeRegistryRepoForProv()
+	registryRepo.records[orgID] = record
+
+	return registryRepo
+}
+
+type rotationTestEnv struct {
+	svc          *keyRotationService
+	keysetRepo   *fakeVersionedKeysetRepo
+	registryRepo *fakeRegistryRepoForProv
+	rotationRepo *fakeRotationRepo
+	generator    *fakeKeysetGenerator
+	audit        *spyAuditWriter
+}
+
+func newRotationTestEnv(t *testing.T, orgID string, targets ...NamedReencryptionTarget) *rotationTestEnv {
+	t.Helper()
+
+	env := &rotationTestEnv{
+		keysetRepo:   newFakeVersionedKeysetRepo(rotationTestKeyset(orgID, 1)),
+		registryRepo: rotationTestRegistry(t, orgID),
+		rotationRepo: newFakeRotationRepo(),
+		generator:    newFakeKeysetGenerator(),
+		audit:        newSpyAuditWriter(),
+	}
+
+	env.svc = NewKeyRotationService(
+		env.keysetRepo,
+		env.registryRepo,
+		env.rotationRepo,
+		env.generator,
+		nil,
+		nil,
+		env.audit,
+		NewProtectionMetrics(nil),
+		targets...,
+	).(*keyRotationService)
+	env.svc.batchSize = 2
+
+	return env
+}
+
+func (env *rotationTestEnv) waitForStatus(t *testing.T, orgID string, status mmodel.KeyRotationStatus) *mmodel.OrganizationKeyRotation {
+	t.Helper()
+
+	var latest *mmodel.OrganizationKeyRotation
+
+	require.Eventually(t, func() bool {
+		rotation, err := env.rotationRepo.GetLatest(context.Background(), orgID)
+		if err != nil || env.svc.isRunning(rotation.TenantID, orgID) {
+			return false
+		}
+
+		latest = rotation
+
+		return rotation.Status == status
+	}, 2*time.Second, 5*time.Millisecond)
+
+	return latest
+}
+
+func rotateInput(orgID string) RotateInput {
+	return RotateInput{TenantID: "tenant-1", OrganizationID: orgID, Actor: "admin", Reason: "annual rotation"}
+}
+
+func retireInput(orgID string) RetireInput {
+	return RetireInput{TenantID: "tenant-1", OrganizationID: orgID, Actor: "admin", Reason: "rotation complete"}
+}
+
+// ---------------------------------------------------------------------------
+// Rotate
+// ---------------------------------------------------------------------------
+
+func TestKeyRotationService_Rotate_AdvancesVersionAndReencrypts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	holders := &fakeReencryptionTarget{stale: []string{"a", "b", "c"}, skip: map[string]bool{}}
+	instruments := &fakeReencryptionTarget{}
+	env := newRotationTestEnv(t, "org-1",
+		NamedReencryptionTarget{Name: "holders", Target: holders},
+		NamedReencryptionTarget{Name: "instruments", Target: instruments},
+	)
+
+	rotation, err := env.svc.Rotate(ctx, rotateInput("org-1"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, rotation.FromVersion)
+	assert.Equal(t, 2, rotation.ToVersion)
+	assert.Equal(t, mmodel.KeyRotationStatusRunning, rotation.Status)
+	assert.Equal(t, int64(3), rotation.Targets[0].Total)
+	assert.Equal(t, int64(0), rotation.Targets[1].Total)
+
+	registry := env.registryRepo.records["org-1"]
+	assert.Equal(t, 2, registry.CurrentVersion)
+	assert.Equal(t, []int{1, 2}, registry.ReadableVersions)
+
+	rotated, err := env.keysetRepo.GetByVersion(ctx, "org-1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "org-org-1", rotated.KEKPath, "the rotated keyset must reuse the previous KEK")
+	assert.Equal(t, "transit-st", env.generator.aeadMountPath)
+
+	previous, err := env.keysetRepo.GetByVersion(ctx, "org-1", 1)
+	require.NoError(t, err)
+	assert.NotNil(t, previous.RotatedAt)
+
+	completed := env.waitForStatus(t, "org-1", mmodel.KeyRotationStatusCompleted)
+	assert.NotNil(t, completed.CompletedAt)
+	assert.Equal(t, mmodel.KeyRotationTargetProgress{Name: "holders", Total: 3, Processed: 3, Cursor: "c", Done: true}, completed.Targets[0])
+	assert.True(t, completed.Targets[1].Done)
+
+	events := env.audit.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, mmodel.AuditActionRotate, events[0].Action)
+	assert.Equal(t, mmodel.AuditOutcomeSuccess, events[0].Outcome)
+}
+
+func TestKeyRotationService_Rotate_RejectsUnfinishedRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newRotationTestEnv(t, "org-1", NamedReencryptionTarget{
+		Name:   "holders",
+		Target: &fakeReencryptionTarget{stale: []string{"a"}, failWith: errors.New("mongo down")},
+	})
+
+	_, err := env.svc.Rotate(ctx, rotateInput("org-1"))
+	require.NoError(t, err)
+
+	failed := env.waitForStatus(t, "org-1", mmodel.KeyRotationStatusFailed)
+	assert.Equal(t, rotationErrorReencryptionFailed, failed.LastErrorCode)
+
+	_, err = env.svc.Rotate(ctx, rotateInput("org-1"))
+	require.Error(t, err)
+	assert.Equal(t, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption), err)
+	assert.Equal(t, 2, env.registryRepo.records["org-1"].CurrentVersion, "a rejected rotation must not advance the registry")
+}
+
+func TestKeyRotationService_Rotate_NotProvisioned(t *testing.T) {
+	t.Parallel()
+
+	env := newRotationTestEnv(t, "org-1")
+
+	_, err := env.svc.Rotate(context.Background(), rotateInput("org-unknown"))
+
+	require.Error(t, err)
+	assert.Equal(t, pkg.ValidateBusinessError(constant.ErrRegistryNotFound, EntityOrganizationEncryption), err)
+}
+
+func TestKeyRotationService_Rotate_CompletesInterruptedRegistrySwitch(t *testing.T) {
+	t.Parallel()
+
+	env := newRotationTestEnv(t, "org-1")
+
+	// Simulate a crash after the registry switch but before the keyset save.
+	registry := env.registryRepo.records["org-1"]
+	registry.CurrentVersion = 2
+	registry.ReadableVersions = []int{1, 2}
+
+	rotation, err := env.svc.Rotate(context.Background(), rotateInput("org-1"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, rotation.ToVersion, "the rotation must finish the advertised version, not skip to 3")
+
+	_, err = env.keysetRepo.GetByVersion(context.Background(), "org-1", 2)
+	require.NoError(t, err)
+}
+
+// ---------------------------------------------------------------------------
+// Resume
+// ---------------------------------------------------------------------------
+
+func TestKeyRotationService_Resume_ContinuesFromCursor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	holders := &fakeReencryptionTarget{stale: []string{"a", "b", "c", "d"}, skip: map[string]bool{}}
+	env := newRotationTestEnv(t, "org-1", NamedReencryptionTarget{Name: "holders", Target: holders})
+
+	// Persist a rotation interrupted after the first batch.
+	rotation, err := mmodel.NewOrganizationKeyRotation("tenant-1", "org-1", 1, 2, "admin", "annual rotation", []string{"holders"})
+	require.NoError(t, err)
+
+	rotation.Status = mmodel.KeyRotationStatusFailed
+	rotation.Targets[0] = mmodel.KeyRotationTargetProgress{Name: "holders", Total: 4, Processed: 2, Cursor: "b"}
+	require.NoError(t, env.rotationRepo.Save(ctx, rotation))
+
+	holders.stale = []string{"c", "d"}
+
+	resumed, err := env.svc.Resume(ctx, "org-1")
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KeyRotationStatusRunning, resumed.Status)
+	assert.Empty(t, resumed.LastErrorCode)
+
+	completed := env.waitForStatus(t, "org-1", mmodel.KeyRotationStatusCompleted)
+	assert.Equal(t, int64(4), completed.Targets[0].Processed)
+	assert.Equal(t, "d", completed.Targets[0].Cursor)
+}
+
+func TestKeyRotationService_Resume_NeverRotated(t *testing.T) {
+	t.Parallel()
+
+	env := newRotationTestEnv(t, "org-1")
+
+	_, err := env.svc.Resume(context.Background(), "org-1")
+
+	require.Error(t, err)
+	assert.Equal(t, pkg.ValidateBusinessError(constant.ErrKeyRotationNotFound, EntityOrganizationEncryption), err)
+}
+
+// ---------------------------------------------------------------------------
+// Retire
+// ---------------------------------------------------------------------------
+
+func TestKeyRotationService_Retire_BlockedByStaleRecords(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	holders := &fakeReencryptionTarget{stale: []string{"a", "b"}, skip: map[string]bool{"b": true}}
+	env := newRotationTestEnv(t, "org-1", NamedReencryptionTarget{Name: "holders", Target: holders})
+
+	_, err := env.svc.Rotate(ctx, rotateInput("org-1"))
+	require.NoError(t, err)
+
+	completed := env.waitForStatus(t, "org-1", mmodel.KeyRotationStatusCompleted)
+	assert.Equal(t, int64(1), completed.Targets[0].Skipped)
+
+	result, err := env.svc.Retire(ctx, retireInput("org-1"))
+	require.NoError(t, err)
+
+	assert.Empty(t, result.RetiredVersions)
+	assert.Equal(t, []int{1, 2}, result.ReadableVersions)
+	assert.Equal(t, map[string]int64{"holders": 1}, result.StaleRecords)
+	assert.True(t, env.registryRepo.records["org-1"].LegacyReadable, "a blocked retirement must not touch the registry")
+}
+
+func TestKeyRotationService_Retire_DropsOlderVersions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	holders := &fakeReencryptionTarget{stale: []string{"a"}, skip: map[string]bool{}}
+	env := newRotationTestEnv(t, "org-1", NamedReencryptionTarget{Name: "holders", Target: holders})
+
+	_, err := env.svc.Rotate(ctx, rotateInput("org-1"))
+	require.NoError(t, err)
+
+	env.waitForStatus(t, "org-1", mmodel.KeyRotationStatusCompleted)
+
+	result, err := env.svc.Retire(ctx, retireInput("org-1"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1}, result.RetiredVersions)
+	assert.Equal(t, []int{2}, result.ReadableVersions)
+
+	registry := env.registryRepo.records["org-1"]
+	assert.Equal(t, []int{2}, registry.ReadableVersions)
+	assert.False(t, registry.LegacyReadable)
+
+	events := env.audit.events()
+	require.Len(t, events, 2)
+	assert.Equal(t, mmodel.AuditActionRetire, events[1].Action)
+}
+
+func TestKeyRotationService_Retire_BlockedByRunningRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newRotationTestEnv(t, "org-1")
+
+	rotation, err := mmodel.NewOrganizationKeyRotation("tenant-1", "org-1", 1, 2, "admin", "annual rotation", nil)
+	require.NoError(t, err)
+	require.NoError(t, env.rotationRepo.Save(ctx, rotation))
+
+	_, err = env.svc.Retire(ctx, retireInput("org-1"))
+
+	require.Error(t, err)
+	assert.Equal(t, pkg.ValidateBusinessError(constant.ErrKeyRotationInProgress, EntityOrganizationEncryption), err)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Static, error-free Reason phrases for the erasure audit events. The caller's
+// reason is deliberately not audited: it may reference the data subject.
+const (
+	reasonErasureSuccess = "holder personal data erased"
+	reasonErasureFailure = "holder erasure failed"
+)
+
+// HolderKeyShredder destroys a holder's data key (crypto-shredding). It is
+// satisfied by *encryption.HolderKeyManager.
+type HolderKeyShredder interface {
+	Destroy(ctx context.Context, organizationID, holderID, actor string) error
+}
+
+// EraseHolder fulfils a right-to-erasure request for a holder.
+//
+// It removes the personal data and blind-index search tokens of the holder and
+// of all its instruments (related parties included), removes the entries naming
+// the holder as a related party from other holders' instruments, soft-deletes
+// the holder and destroys the holder's data key, so copies of its ciphertext
+// left in backups can no longer be decrypted. Identifiers the ledger relies on
+// (holder, instrument, ledger and account ids) are kept. Every step is
+// idempotent, so a failed erasure is safe to retry and erasing an erased holder
+// succeeds again.
+func (uc *UseCase) EraseHolder(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.EraseHolderInput) (_ *mmodel.HolderErasure, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.erase_holder")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "erase_holder", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	holder, err := uc.HolderRepo.Find(ctx, organizationID, id, true)
+	if err != nil {
+		recordSpanError(span, "Failed to find holder", err)
+
+		if pkg.IsBusinessError(err) {
+			return nil, err
+		}
+
+		uc.emitErasureAudit(ctx, organizationID, id, input.Actor, err)
+
+		return nil, pkg.ValidateBusinessError(cn.ErrHolderErasureFailed, cn.EntityHolder)
+	}
+
+	// Other holders' instruments may name this holder as a related party. They
+	// are scrubbed first, while the holder's document is still readable: once
+	// the holder is erased a retry could no longer find them.
+	var relatedParties int64
+
+	if document := derefString(holder.Document); document != "" {
+		relatedParties, err = uc.InstrumentRepo.EraseRelatedParties(ctx, organizationID, document)
+		if err != nil {
+			recordSpanError(span, "Failed to erase holder related parties", err)
+			uc.emitErasureAudit(ctx, organizationID, id, input.Actor, err)
+
+			return nil, pkg.ValidateBusinessError(cn.ErrHolderErasureFailed, cn.EntityHolder)
+		}
+	}
+
+	erasedAt, err := uc.HolderRepo.Erase(ctx, organizationID, id, time.Now().UTC())
+	if err != nil {
+		recordSpanError(span, "Failed to erase holder", err)
+
+		if pkg.IsBusinessError(err) {
+			return nil, err
+		}
+
+		uc.emitErasureAudit(ctx, organizationID, id, input.Actor, err)
+
+		return nil, pkg.ValidateBusinessError(cn.ErrHolderErasureFailed, cn.EntityHolder)
+	}
+
+	instruments, err := uc.InstrumentRepo.EraseByHolder(ctx, organizationID, id, erasedAt)
+	if err != nil {
+		recordSpanError(span, "Failed to erase holder instruments", err)
+		uc.emitErasureAudit(ctx, organizationID, id, input.Actor, err)
+
+		return nil, pkg.ValidateBusinessError(cn.ErrHolderErasureFailed, cn.EntityHolder)
+	}
+
+	keyDestroyed := false
+
+	if uc.HolderKeys != nil {
+		if err := uc.HolderKeys.Destroy(ctx, organizationID, id.String(), input.Actor); err != nil {
+			recordSpanError(span, "Failed to destroy holder data key", err)
+			uc.emitErasureAudit(ctx, organizationID, id, input.Actor, err)
+
+			return nil, pkg.ValidateBusinessError(cn.ErrHolderErasureFailed, cn.EntityHolder)
+		}
+
+		keyDestroyed = true
+	}
+
+	uc.emitErasureAudit(ctx, organizationID, id, input.Actor, nil)
+
+	logger.Log(ctx, libLog.LevelInfo, "Holder erased",
+		libLog.String("holder_id", id.String()),
+		libLog.Bool("key_destroyed", keyDestroyed),
+		libLog.Int("instruments_erased", int(instruments)),
+		libLog.Int("related_parties_erased", int(relatedParties)))
+
+	return &mmodel.HolderErasure{
+		HolderID:             id,
+		OrganizationID:       organizationID,
+		KeyDestroyed:         keyDestroyed,
+		InstrumentsErased:    instruments,
+		RelatedPartiesErased: relatedParties,
+		ErasedAt:             erasedAt,
+	}, nil
+}
+
+// emitErasureAudit emits one best-effort protection audit event for the outcome
+// of an erasure. It carries the holder id as subject and, on failure, the static
+// erasure error code; it never affects the erasure's result.
+func (uc *UseCase) emitErasureAudit(ctx context.Context, organizationID string, id uuid.UUID, actor string, erasureErr error) {
+	if uc.ProtectionAudit == nil {
+		return
+	}
+
+	logger, _, reqID, _ := libObservability.NewTrackingFromContext(ctx)
+
+	outcome, reason := mmodel.AuditOutcomeSuccess, reasonErasureSuccess
+	details := &mmodel.AuditDetails{SubjectID: id.String(), NewStatus: "erased"}
+
+	if erasureErr != nil {
+		outcome, reason = mmodel.AuditOutcomeFailure, reasonErasureFailure
+		details = &mmodel.AuditDetails{SubjectID: id.String(), ErrorCode: cn.ErrHolderErasureFailed.Error()}
+	}
+
+	event, err := mmodel.NewProtectionAuditEvent(mmodel.ProtectionAuditEventInput{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		EventType:      mmodel.AuditEventTypeErasure,
+		Action:         mmodel.AuditActionErase,
+		Outcome:        outcome,
+		ActorID:        actor,
+		Reason:         reason,
+		RequestID:      reqID,
+		Details:        details,
+	})
+	if err != nil {
+		logger.Log(ctx, libLog.LevelDebug, "audit event build skipped",
+			libLog.String("organization_id", organizationID),
+			libLog.String("outcome", string(outcome)))
+
+		return
+	}
+
+	uc.ProtectionAudit.EmitAsync(ctx, event)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeHolderKeyShredder records Destroy calls and returns err.
+type fakeHolderKeyShredder struct {
+	err   error
+	calls []string
+}
+
+func (f *fakeHolderKeyShredder) Destroy(_ context.Context, _, holderID, _ string) error {
+	f.calls = append(f.calls, holderID)
+
+	return f.err
+}
+
+// erasureAuditSpy records every audit event synchronously.
+type erasureAuditSpy struct {
+	mu     sync.Mutex
+	events []*mmodel.ProtectionAuditEvent
+}
+
+func (s *erasureAuditSpy) Emit(_ context.Context, event *mmodel.ProtectionAuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, event)
+}
+
+func (s *erasureAuditSpy) EmitAsync(ctx context.Context, event *mmodel.ProtectionAuditEvent) {
+	s.Emit(ctx, event)
+}
+
+func TestEraseHolder(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+	erasedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	input := &mmodel.EraseHolderInput{Actor: "dpo@example.com", Reason: "DSR-2026-0042"}
+	storageErr := errors.New("mongo unavailable")
+	document := "12345678900"
+	active := &mmodel.Holder{ID: &holderID, Document: &document}
+	erased := &mmodel.Holder{ID: &holderID, ErasedAt: &erasedAt}
+
+	testCases := []struct {
+		name            string
+		shredderErr     error
+		withShredder    bool
+		mockSetup       func(h *holder.MockRepository, i *instrument.MockRepository)
+		expectedErr     error
+		expectedDestroy int
+		expectedOutcome mmodel.AuditOutcome
+		expectedKey     bool
+		expectedParties int64
+	}{
+		{
+			name:         "erases holder, instruments, related parties and destroys the holder key",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).Return(int64(2), nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(erasedAt, nil)
+				i.EXPECT().EraseByHolder(gomock.Any(), organizationID, holderID, erasedAt).Return(int64(3), nil)
+			},
+			expectedDestroy: 1,
+			expectedOutcome: mmodel.AuditOutcomeSuccess,
+			expectedKey:     true,
+			expectedParties: 2,
+		},
+		{
+			name: "scrub-only erasure without holder keys",
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).Return(int64(0), nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(erasedAt, nil)
+				i.EXPECT().EraseByHolder(gomock.Any(), organizationID, holderID, erasedAt).Return(int64(0), nil)
+			},
+			expectedOutcome: mmodel.AuditOutcomeSuccess,
+		},
+		{
+			name:         "erasing an erased holder skips the related parties it already removed",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(erased, nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(erasedAt, nil)
+				i.EXPECT().EraseByHolder(gomock.Any(), organizationID, holderID, erasedAt).Return(int64(3), nil)
+			},
+			expectedDestroy: 1,
+			expectedOutcome: mmodel.AuditOutcomeSuccess,
+			expectedKey:     true,
+		},
+		{
+			name:         "holder not found is returned unchanged and not audited",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, _ *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).
+					Return(nil, pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder))
+			},
+			expectedErr: cn.ErrHolderNotFound,
+		},
+		{
+			name:         "holder lookup failure maps to erasure failed",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, _ *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(nil, storageErr)
+			},
+			expectedErr:     cn.ErrHolderErasureFailed,
+			expectedOutcome: mmodel.AuditOutcomeFailure,
+		},
+		{
+			name:         "related party failure keeps the holder and maps to erasure failed",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).
+					Return(int64(1), instrument.ErrRelatedPartiesChanged)
+			},
+			expectedErr:     cn.ErrHolderErasureFailed,
+			expectedOutcome: mmodel.AuditOutcomeFailure,
+		},
+		{
+			name:         "holder storage failure maps to erasure failed",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).Return(int64(0), nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(time.Time{}, storageErr)
+			},
+			expectedErr:     cn.ErrHolderErasureFailed,
+			expectedOutcome: mmodel.AuditOutcomeFailure,
+		},
+		{
+			name:         "instrument failure keeps the key and maps to erasure failed",
+			withShredder: true,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).Return(int64(0), nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(erasedAt, nil)
+				i.EXPECT().EraseByHolder(gomock.Any(), organizationID, holderID, erasedAt).Return(int64(0), storageErr)
+			},
+			expectedErr:     cn.ErrHolderErasureFailed,
+			expectedOutcome: mmodel.AuditOutcomeFailure,
+		},
+		{
+			name:         "key destruction failure maps to erasure failed",
+			withShredder: true,
+			shredderErr:  storageErr,
+			mockSetup: func(h *holder.MockRepository, i *instrument.MockRepository) {
+				h.EXPECT().Find(gomock.Any(), organizationID, holderID, true).Return(active, nil)
+				i.EXPECT().EraseRelatedParties(gomock.Any(), organizationID, document).Return(int64(0), nil)
+				h.EXPECT().Erase(gomock.Any(), organizationID, holderID, gomock.Any()).Return(erasedAt, nil)
+				i.EXPECT().EraseByHolder(gomock.Any(), organizationID, holderID, erasedAt).Return(int64(1), nil)
+			},
+			expectedErr:     cn.ErrHolderErasureFailed,
+			expectedDestroy: 1,
+			expectedOutcome: mmodel.AuditOutcomeFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHolderRepo := holder.NewMockRepository(ctrl)
+			mockInstrumentRepo := instrument.NewMockRepository(ctrl)
+			tc.mockSetup(mockHolderRepo, mockInstrumentRepo)
+
+			audit := &erasureAuditSpy{}
+			shredder := &fakeHolderKeyShredder{err: tc.shredderErr}
+
+			uc := &UseCase{
+				HolderRepo:      mockHolderRepo,
+				InstrumentRepo:  mockInstrumentRepo,
+				ProtectionAudit: audit,
+			}
+			if tc.withShredder {
+				uc.HolderKeys = shredder
+			}
+
+			result, err := uc.EraseHolder(context.Background(), organizationID, holderID, input)
+
+			assert.Len(t, shredder.calls, tc.expectedDestroy)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, result)
+				assert.Equal(t, pkg.ValidateBusinessError(tc.expectedErr, cn.EntityHolder), err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, holderID, result.HolderID)
+				assert.Equal(t, tc.expectedKey, result.KeyDestroyed)
+				assert.Equal(t, tc.expectedParties, result.RelatedPartiesErased)
+				assert.True(t, erasedAt.Equal(result.ErasedAt))
+			}
+
+			if tc.expectedOutcome == "" {
+				assert.Empty(t, audit.events)
+
+				return
+			}
+
+			require.Len(t, audit.events, 1)
+
+			event := audit.events[0]
+			assert.Equal(t, mmodel.AuditEventTypeErasure, event.EventType)
+			assert.Equal(t, mmodel.AuditActionErase, event.Action)
+			assert.Equal(t, tc.expectedOutcome, event.Outcome)
+			assert.Equal(t, input.Actor, event.ActorID)
+			assert.NotContains(t, event.Reason, input.Reason, "the caller's reason must not reach the audit trail")
+			require.NotNil(t, event.Details)
+			assert.Equal(t, holderID.String(), event.Details.SubjectID)
+		})
+	}
+}
@@ -0,0 +1,630 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// holderExportRetention is how long a completed export archive is kept.
+const holderExportRetention = 7 * 24 * time.Hour
+
+// holderExportPageSize is the number of instruments read per page while an
+// archive is assembled.
+const holderExportPageSize = 100
+
+// Static error codes recorded on a failed export. They never carry dynamic
+// error text or PII.
+const (
+	exportErrorHolderUnreadable       = "holder_unreadable"
+	exportErrorInstrumentsUnreadable  = "instruments_unreadable"
+	exportErrorPartiesUnreadable      = "related_parties_unreadable"
+	exportErrorAccountsUnreadable     = "accounts_unreadable"
+	exportErrorBalancesUnreadable     = "balances_unreadable"
+	exportErrorTransactionsUnreadable = "transactions_unreadable"
+	exportErrorArchiveFailed          = "archive_write_failed"
+)
+
+// Static, error-free Reason phrases for the export audit events.
+const (
+	reasonExportSuccess = "holder data exported"
+	reasonExportFailure = "holder data export failed"
+)
+
+// holderExportCSVHeader is the header of the archive's transactions CSV.
+var holderExportCSVHeader = []string{
+	"transaction_id", "operation_id", "created_at", "ledger_id", "account_id", "account_alias", "balance_key",
+	"type", "direction", "asset_code", "amount", "status", "description",
+}
+
+// exportStepError is a failed archive step, carrying its static error code.
+type exportStepError struct {
+	code string
+	err  error
+}
+
+func (e *exportStepError) Error() string { return e.code + ": " + e.err.Error() }
+
+func (e *exportStepError) Unwrap() error { return e.err }
+
+// CreateHolderExport requests a data export of a holder (a subject access
+// request). The archive is assembled in the background; the export is returned
+// PENDING and its status polled until it is COMPLETED and can be downloaded. A
+// holder has at most one export in progress.
+func (uc *UseCase) CreateHolderExport(ctx context.Context, organizationID string, holderID uuid.UUID, input *mmodel.CreateHolderExportInput) (_ *mmodel.HolderExport, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.create_holder_export")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "create_holder_export", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	if _, err = uc.HolderRepo.Find(ctx, organizationID, holderID, false); err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	export := &mmodel.HolderExport{
+		ID:          uuid.Must(libCommons.GenerateUUIDv7()),
+		HolderID:    holderID,
+		Status:      mmodel.HolderExportPending,
+		RequestedBy: input.Actor,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if err = uc.ExportRepo.Create(ctx, organizationID, export); err != nil {
+		recordSpanError(span, "Failed to create holder export", err)
+
+		return nil, err
+	}
+
+	job := *export
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(ctx), logger, "crm", "holder.export",
+		libRuntime.KeepRunning, func(c context.Context) {
+			uc.runHolderExport(c, organizationID, &job)
+		})
+
+	return export, nil
+}
+
+// GetHolderExport returns an export of a holder.
+func (uc *UseCase) GetHolderExport(ctx context.Context, organizationID string, holderID, id uuid.UUID) (*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.export_id", id.String()),
+	)
+
+	export, err := uc.ExportRepo.Find(ctx, organizationID, holderID, id)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder export", err)
+
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// GetHolderExports returns the exports of a holder, newest first.
+func (uc *UseCase) GetHolderExports(ctx context.Context, organizationID string, holderID uuid.UUID) ([]*mmodel.HolderExport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_exports")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	exports, err := uc.ExportRepo.FindAll(ctx, organizationID, holderID)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder exports", err)
+
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// DownloadHolderExport returns the archive of a COMPLETED export. The archive
+// is checked against the digest recorded when it was stored.
+func (uc *UseCase) DownloadHolderExport(ctx context.Context, organizationID string, holderID, id uuid.UUID) ([]byte, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.download_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.export_id", id.String()),
+	)
+
+	export, err := uc.ExportRepo.Find(ctx, organizationID, holderID, id)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder export", err)
+
+		return nil, err
+	}
+
+	if export.Status != mmodel.HolderExportCompleted {
+		err := pkg.ValidateBusinessError(cn.ErrHolderExportNotReady, cn.EntityHolderExport, export.Status)
+		recordSpanError(span, "Holder export not ready", err)
+
+		return nil, err
+	}
+
+	if export.ExpiresAt != nil && !time.Now().Before(*export.ExpiresAt) {
+		err := pkg.ValidateBusinessError(cn.ErrHolderExportExpired, cn.EntityHolderExport)
+		recordSpanError(span, "Holder export expired", err)
+
+		return nil, err
+	}
+
+	archive, err := uc.ExportRepo.LoadArchive(ctx, organizationID, export)
+	if err != nil {
+		recordSpanError(span, "Failed to load holder export archive", err)
+
+		return nil, err
+	}
+
+	// The chunks are removed by a TTL index shortly after expiry; an empty
+	// archive is one removed early.
+	if len(archive) == 0 {
+		err := pkg.ValidateBusinessError(cn.ErrHolderExportExpired, cn.EntityHolderExport)
+		recordSpanError(span, "Holder export archive removed", err)
+
+		return nil, err
+	}
+
+	if sum := sha256.Sum256(archive); hex.EncodeToString(sum[:]) != export.ArchiveSHA256 {
+		err := pkg.ValidateInternalError(errors.New("holder export archive digest mismatch"), cn.EntityHolderExport)
+		recordSpanError(span, "Holder export archive corrupted", err)
+
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// runHolderExport assembles and stores the archive of an export, recording its
+// progress on the export and its outcome as a protection audit event.
+func (uc *UseCase) runHolderExport(ctx context.Context, organizationID string, export *mmodel.HolderExport) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.run_holder_export")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", export.HolderID.String()),
+		attribute.String("app.request.export_id", export.ID.String()),
+	)
+
+	export.Status = mmodel.HolderExportRunning
+	uc.recordHolderExport(ctx, organizationID, export)
+
+	fail := func(code string, err error) {
+		libOpentelemetry.HandleSpanError(span, "Holder export failed", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Holder export failed",
+			libLog.String("organization_id", organizationID),
+			libLog.String("export_id", export.ID.String()),
+			libLog.String("error_code", code),
+			libLog.Err(err))
+
+		now := time.Now().UTC()
+		export.Status = mmodel.HolderExportFailed
+		export.LastErrorCode = code
+		export.CompletedAt = &now
+		uc.recordHolderExport(ctx, organizationID, export)
+		uc.emitExportAudit(ctx, organizationID, export)
+	}
+
+	// The chunks carry the archive's expiry, so it is set before they are
+	// written; the archive is hashed and sized on its way to the store.
+	expiresAt := time.Now().UTC().Add(holderExportRetention)
+	export.ExpiresAt = &expiresAt
+
+	var counts mmodel.HolderExportCounts
+
+	digest := &digestWriter{hash: sha256.New()}
+
+	err := uc.ExportRepo.SaveArchive(ctx, organizationID, export, func(w io.Writer) error {
+		digest.w = w
+
+		var err error
+
+		counts, err = uc.writeHolderExportArchive(ctx, digest, organizationID, export)
+
+		return err
+	})
+	if err != nil {
+		code := exportErrorArchiveFailed
+
+		var stepErr *exportStepError
+		if errors.As(err, &stepErr) {
+			code = stepErr.code
+		}
+
+		export.ExpiresAt = nil
+		fail(code, err)
+
+		return
+	}
+
+	now := time.Now().UTC()
+
+	export.Counts = counts
+	export.ArchiveSize = digest.size
+	export.ArchiveSHA256 = hex.EncodeToString(digest.hash.Sum(nil))
+	export.Status = mmodel.HolderExportCompleted
+	export.CompletedAt = &now
+	uc.recordHolderExport(ctx, organizationID, export)
+	uc.emitExportAudit(ctx, organizationID, export)
+
+	logger.Log(ctx, libLog.LevelInfo, "Holder export completed",
+		libLog.String("organization_id", organizationID),
+		libLog.String("export_id", export.ID.String()),
+		libLog.Any("archive_size", export.ArchiveSize),
+		libLog.Any("transactions", counts.Transactions))
+}
+
+// recordHolderExport stores the export's progress. A failed write leaves the
+// export reporting its previous status, so it is logged and the job goes on.
+func (uc *UseCase) recordHolderExport(ctx context.Context, organizationID string, export *mmodel.HolderExport) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if err := uc.ExportRepo.Update(ctx, organizationID, export); err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Holder export progress not recorded",
+			libLog.String("organization_id", organizationID),
+			libLog.String("export_id", export.ID.String()),
+			libLog.Err(err))
+	}
+}
+
+// digestWriter hashes and counts the bytes written through it.
+type digestWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func (d *digestWriter) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	d.hash.Write(p[:n])
+	d.size += int64(n)
+
+	return n, err
+}
+
+// writeHolderExportArchive writes to out the ZIP archive of everything held
+// about the holder: the decrypted holder, its instruments, the instrument
+// entries in which it appears as a related party, its accounts with their
+// balances, and a CSV of the operations on those accounts, streamed page by
+// page. Deleted records are included: they are still held.
+func (uc *UseCase) writeHolderExportArchive(ctx context.Context, out io.Writer, organizationID string, export *mmodel.HolderExport) (mmodel.HolderExportCounts, error) {
+	var counts mmodel.HolderExportCounts
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return counts, &exportStepError{code: exportErrorAccountsUnreadable, err: err}
+	}
+
+	holder, err := uc.HolderRepo.Find(ctx, organizationID, export.HolderID, true)
+	if err != nil {
+		return counts, &exportStepError{code: exportErrorHolderUnreadable, err: err}
+	}
+
+	instruments, err := uc.collectHolderInstruments(ctx, organizationID, export.HolderID, http.QueryHeader{})
+	if err != nil {
+		return counts, &exportStepError{code: exportErrorInstrumentsUnreadable, err: err}
+	}
+
+	entries, err := uc.collectRelatedPartyEntries(ctx, organizationID, export.HolderID, holder.Document)
+	if err != nil {
+		return counts, &exportStepError{code: exportErrorPartiesUnreadable, err: err}
+	}
+
+	accounts, err := uc.HolderLedger.ListAccountsByHolder(ctx, orgID, export.HolderID)
+	if err != nil {
+		return counts, &exportStepError{code: exportErrorAccountsUnreadable, err: err}
+	}
+
+	ledgers, byLedger := groupAccountsByLedger(accounts)
+
+	balances := make([]*mmodel.Balance, 0, len(accounts))
+
+	for _, ledgerID := range ledgers {
+		ledgerBalances, err := uc.HolderLedger.ListBalancesByAccounts(ctx, orgID, ledgerID, byLedger[ledgerID])
+		if err != nil {
+			return counts, &exportStepError{code: exportErrorBalancesUnreadable, err: err}
+		}
+
+		balances = append(balances, ledgerBalances...)
+	}
+
+	zw := zip.NewWriter(out)
+
+	counts = mmodel.HolderExportCounts{
+		Instruments:         int64(len(instruments)),
+		RelatedPartyEntries: int64(len(entries)),
+		Accounts:            int64(len(accounts)),
+		Balances:            int64(len(balances)),
+	}
+
+	for _, file := range []struct {
+		name    string
+		content any
+	}{
+		{"holder.json", holder},
+		{"instruments.json", instruments},
+		{"related_party_entries.json", entries},
+		{"accounts.json", accounts},
+		{"balances.json", balances},
+	} {
+		if err := writeArchiveJSON(zw, file.name, file.content); err != nil {
+			return counts, &exportStepError{code: exportErrorArchiveFailed, err: err}
+		}
+	}
+
+	counts.Transactions, err = uc.writeHolderTransactionsCSV(ctx, zw, orgID, accounts)
+	if err != nil {
+		return counts, err
+	}
+
+	manifest := map[string]any{
+		"exportId":    export.ID,
+		"holderId":    export.HolderID,
+		"requestedBy": export.RequestedBy,
+		"generatedAt": time.Now().UTC(),
+		"counts":      counts,
+	}
+
+	if err := writeArchiveJSON(zw, "manifest.json", manifest); err != nil {
+		return counts, &exportStepError{code: exportErrorArchiveFailed, err: err}
+	}
+
+	if err := zw.Close(); err != nil {
+		return counts, &exportStepError{code: exportErrorArchiveFailed, err: err}
+	}
+
+	return counts, nil
+}
+
+// collectHolderInstruments reads every instrument matching filter, page by
+// page, deleted ones included. A nil holderID matches every holder.
+func (uc *UseCase) collectHolderInstruments(ctx context.Context, organizationID string, holderID uuid.UUID, filter http.QueryHeader) ([]*mmodel.Instrument, error) {
+	instruments := make([]*mmodel.Instrument, 0)
+
+	filter.Limit = holderExportPageSize
+
+	for filter.Page = 1; ; filter.Page++ {
+		page, err := uc.InstrumentRepo.FindAll(ctx, organizationID, holderID, filter, true)
+		if err != nil {
+			return nil, err
+		}
+
+		instruments = append(instruments, page...)
+
+		if len(page) < holderExportPageSize {
+			return instruments, nil
+		}
+	}
+}
+
+// collectRelatedPartyEntries finds the instruments of other holders in which
+// the holder appears as a related party, matched on its document through the
+// related-party blind index.
+func (uc *UseCase) collectRelatedPartyEntries(ctx context.Context, organizationID string, holderID uuid.UUID, document *string) ([]mmodel.HolderRelatedPartyEntry, error) {
+	entries := make([]mmodel.HolderRelatedPartyEntry, 0)
+
+	if document == nil || *document == "" {
+		return entries, nil
+	}
+
+	instruments, err := uc.collectHolderInstruments(ctx, organizationID, uuid.Nil, http.QueryHeader{InstrumentRelatedPartyDocument: document})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range instruments {
+		if i.ID == nil || i.HolderID == nil || *i.HolderID == holderID {
+			continue
+		}
+
+		for _, party := range i.RelatedParties {
+			if party != nil && party.Document == *document {
+				entries = append(entries, mmodel.HolderRelatedPartyEntry{InstrumentID: *i.ID, HolderID: *i.HolderID, RelatedParty: party})
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// writeHolderTransactionsCSV writes transactions.csv: one row per operation on
+// the holder's accounts, account by account, oldest first.
+func (uc *UseCase) writeHolderTransactionsCSV(ctx context.Context, zw *zip.Writer, organizationID uuid.UUID, accounts []*mmodel.Account) (int64, error) {
+	w, err := zw.Create("transactions.csv")
+	if err != nil {
+		return 0, &exportStepError{code: exportErrorArchiveFailed, err: err}
+	}
+
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(holderExportCSVHeader); err != nil {
+		return 0, &exportStepError{code: exportErrorArchiveFailed, err: err}
+	}
+
+	var rows int64
+
+	for _, account := range accounts {
+		ledgerID, err := uuid.Parse(account.LedgerID)
+		if err != nil {
+			return 0, &exportStepError{code: exportErrorTransactionsUnreadable, err: err}
+		}
+
+		accountID, err := uuid.Parse(account.ID)
+		if err != nil {
+			return 0, &exportStepError{code: exportErrorTransactionsUnreadable, err: err}
+		}
+
+		for cursor := ""; ; {
+			ops, next, err := uc.HolderLedger.ListAccountOperations(ctx, organizationID, ledgerID, accountID, cursor)
+			if err != nil {
+				return 0, &exportStepError{code: exportErrorTransactionsUnreadable, err: err}
+			}
+
+			for _, op := range ops {
+				if err := cw.Write([]string{
+					op.TransactionID, op.OperationID, op.CreatedAt.UTC().Format(time.RFC3339Nano), op.LedgerID, op.AccountID,
+					op.AccountAlias, op.BalanceKey, op.Type, op.Direction, op.AssetCode, op.Amount, op.Status, op.Description,
+				}); err != nil {
+					return 0, &exportStepError{code: exportErrorArchiveFailed, err: err}
+				}
+			}
+
+			rows += int64(len(ops))
+
+			if next == "" || len(ops) == 0 {
+				break
+			}
+
+			cursor = next
+		}
+	}
+
+	cw.Flush()
+
+	if err := cw.Error(); err != nil {
+		return 0, &exportStepError{code: exportErrorArchiveFailed, err: err}
+	}
+
+	return rows, nil
+}
+
+// groupAccountsByLedger groups account ids by ledger, keeping the order in
+// which ledgers first appear. Accounts with unparseable ids are skipped.
+func groupAccountsByLedger(accounts []*mmodel.Account) ([]uuid.UUID, map[uuid.UUID][]uuid.UUID) {
+	ledgers := make([]uuid.UUID, 0)
+	byLedger := make(map[uuid.UUID][]uuid.UUID)
+
+	for _, account := range accounts {
+		ledgerID, err := uuid.Parse(account.LedgerID)
+		if err != nil {
+			continue
+		}
+
+		accountID, err := uuid.Parse(account.ID)
+		if err != nil {
+			continue
+		}
+
+		if _, seen := byLedger[ledgerID]; !seen {
+			ledgers = append(ledgers, ledgerID)
+		}
+
+		byLedger[ledgerID] = append(byLedger[ledgerID], accountID)
+	}
+
+	return ledgers, byLedger
+}
+
+// writeArchiveJSON writes content as an indented JSON file of the archive.
+func writeArchiveJSON(zw *zip.Writer, name string, content any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(content)
+}
+
+// emitExportAudit emits one best-effort protection audit event for the outcome
+// of an export. It carries the holder id as subject and, on failure, the
+// static step error code; it never affects the export.
+func (uc *UseCase) emitExportAudit(ctx context.Context, organizationID string, export *mmodel.HolderExport) {
+	if uc.ProtectionAudit == nil {
+		return
+	}
+
+	logger, _, reqID, _ := libObservability.NewTrackingFromContext(ctx)
+
+	outcome, reason := mmodel.AuditOutcomeSuccess, reasonExportSuccess
+	details := &mmodel.AuditDetails{SubjectID: export.HolderID.String(), NewStatus: export.Status}
+
+	if export.Status == mmodel.HolderExportFailed {
+		outcome, reason = mmodel.AuditOutcomeFailure, reasonExportFailure
+		details.ErrorCode = export.LastErrorCode
+	}
+
+	event, err := mmodel.NewProtectionAuditEvent(mmodel.ProtectionAuditEventInput{
+		TenantID:       encryption.ExtractTenantID(ctx),
+		OrganizationID: organizationID,
+		EventType:      mmodel.AuditEventTypeExport,
+		Action:         mmodel.AuditActionExport,
+		Outcome:        outcome,
+		ActorID:        export.RequestedBy,
+		Reason:         reason,
+		RequestID:      reqID,
+		Details:        details,
+	})
+	if err != nil {
+		logger.Log(ctx, libLog.LevelDebug, "audit event build skipped",
+			libLog.String("organization_id", organizationID),
+			libLog.String("outcome", string(outcome)))
+
+		return
+	}
+
+	uc.ProtectionAudit.EmitAsync(ctx, event)
+}
@@ -0,0 +1,399 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/export"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeHolderLedger serves accounts, balances and paged operations from memory.
+type fakeHolderLedger struct {
+	accounts   []*mmodel.Account
+	accountErr error
+	balances   map[uuid.UUID][]*mmodel.Balance
+	// pages maps an account id to its operation pages, served one per cursor.
+	pages map[uuid.UUID][][]mmodel.HolderExportOperation
+}
+
+func (f *fakeHolderLedger) ListAccountsByHolder(_ context.Context, _, _ uuid.UUID) ([]*mmodel.Account, error) {
+	return f.accounts, f.accountErr
+}
+
+func (f *fakeHolderLedger) ListBalancesByAccounts(_ context.Context, _, ledgerID uuid.UUID, _ []uuid.UUID) ([]*mmodel.Balance, error) {
+	return f.balances[ledgerID], nil
+}
+
+func (f *fakeHolderLedger) ListAccountOperations(_ context.Context, _, _, accountID uuid.UUID, cursor string) ([]mmodel.HolderExportOperation, string, error) {
+	pages := f.pages[accountID]
+
+	page := 0
+	if cursor != "" {
+		page = int(cursor[0] - '0')
+	}
+
+	if page >= len(pages) {
+		return nil, "", nil
+	}
+
+	next := ""
+	if page+1 < len(pages) {
+		next = string(rune('0' + page + 1))
+	}
+
+	return pages[page], next, nil
+}
+
+// exportFixture wires a UseCase over mocked CRM repositories and the fake
+// ledger reader, recording the export's stored states and archive. A non-nil
+// storeErr fails every archive write, like an unreachable chunk store.
+type exportFixture struct {
+	uc       *UseCase
+	org      string
+	holderID uuid.UUID
+	ledger   *fakeHolderLedger
+	audit    *erasureAuditSpy
+	statuses []string
+	stored   *mmodel.HolderExport
+	archive  []byte
+	storeErr error
+}
+
+// failingWriter fails every write with err.
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func newExportFixture(t *testing.T) (*exportFixture, *holder.MockRepository, *instrument.MockRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+	instrumentRepo := instrument.NewMockRepository(ctrl)
+	exportRepo := export.NewMockRepository(ctrl)
+
+	f := &exportFixture{
+		org:      uuid.Must(libCommons.GenerateUUIDv7()).String(),
+		holderID: uuid.Must(libCommons.GenerateUUIDv7()),
+		ledger:   &fakeHolderLedger{},
+		audit:    &erasureAuditSpy{},
+	}
+
+	f.uc = &UseCase{
+		HolderRepo:      holderRepo,
+		InstrumentRepo:  instrumentRepo,
+		ExportRepo:      exportRepo,
+		HolderLedger:    f.ledger,
+		ProtectionAudit: f.audit,
+	}
+
+	exportRepo.EXPECT().Update(gomock.Any(), f.org, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, e *mmodel.HolderExport) error {
+			clone := *e
+			f.stored = &clone
+			f.statuses = append(f.statuses, e.Status)
+
+			return nil
+		}).AnyTimes()
+	exportRepo.EXPECT().SaveArchive(gomock.Any(), f.org, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, e *mmodel.HolderExport, write func(w io.Writer) error) error {
+			require.NotNil(t, e.ExpiresAt, "chunks are stored with the archive's expiry")
+
+			if f.storeErr != nil {
+				return write(failingWriter{err: f.storeErr})
+			}
+
+			var buf bytes.Buffer
+			if err := write(&buf); err != nil {
+				return err
+			}
+
+			f.archive = buf.Bytes()
+
+			return nil
+		}).AnyTimes()
+
+	return f, holderRepo, instrumentRepo
+}
+
+func (f *exportFixture) newJob() *mmodel.HolderExport {
+	return &mmodel.HolderExport{
+		ID:          uuid.Must(libCommons.GenerateUUIDv7()),
+		HolderID:    f.holderID,
+		Status:      mmodel.HolderExportPending,
+		RequestedBy: "dpo@example.com",
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// readArchive returns the files of a ZIP archive by name.
+func readArchive(t *testing.T, archive []byte) map[string][]byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[file.Name] = content
+	}
+
+	return files
+}
+
+func TestRunHolderExport_AssemblesArchive(t *testing.T) {
+	f, holderRepo, instrumentRepo := newExportFixture(t)
+
+	name, document := "Maria Silva", "91315026015"
+	otherHolderID := uuid.Must(libCommons.GenerateUUIDv7())
+	ownInstrumentID, otherInstrumentID := uuid.Must(libCommons.GenerateUUIDv7()), uuid.Must(libCommons.GenerateUUIDv7())
+	ledgerA, ledgerB := uuid.Must(libCommons.GenerateUUIDv7()), uuid.Must(libCommons.GenerateUUIDv7())
+	accountA, accountB := uuid.Must(libCommons.GenerateUUIDv7()), uuid.Must(libCommons.GenerateUUIDv7())
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, f.holderID, true).
+		Return(&mmodel.Holder{ID: &f.holderID, Name: &name, Document: &document}, nil).Times(1)
+
+	instrumentRepo.EXPECT().FindAll(gomock.Any(), f.org, f.holderID, gomock.Any(), true).
+		Return([]*mmodel.Instrument{{ID: &ownInstrumentID, HolderID: &f.holderID}}, nil).Times(1)
+	instrumentRepo.EXPECT().FindAll(gomock.Any(), f.org, uuid.Nil, gomock.Any(), true).
+		DoAndReturn(func(_ context.Context, _ string, _ uuid.UUID, filter http.QueryHeader, _ bool) ([]*mmodel.Instrument, error) {
+			require.NotNil(t, filter.InstrumentRelatedPartyDocument)
+			assert.Equal(t, document, *filter.InstrumentRelatedPartyDocument)
+
+			return []*mmodel.Instrument{
+				// The holder's own instrument is already exported as such.
+				{ID: &ownInstrumentID, HolderID: &f.holderID, RelatedParties: []*mmodel.RelatedParty{{Document: document, Name: name}}},
+				{ID: &otherInstrumentID, HolderID: &otherHolderID, RelatedParties: []*mmodel.RelatedParty{
+					{Document: "00000000000", Name: "Someone Else", Role: "PRIMARY_HOLDER"},
+					{Document: document, Name: name, Role: "LEGAL_REPRESENTATIVE"},
+				}},
+			}, nil
+		}).Times(1)
+
+	f.ledger.accounts = []*mmodel.Account{
+		{ID: accountA.String(), LedgerID: ledgerA.String()},
+		{ID: accountB.String(), LedgerID: ledgerB.String()},
+	}
+	f.ledger.balances = map[uuid.UUID][]*mmodel.Balance{
+		ledgerA: {{ID: uuid.NewString(), AccountID: accountA.String()}},
+		ledgerB: {{ID: uuid.NewString(), AccountID: accountB.String()}},
+	}
+	f.ledger.pages = map[uuid.UUID][][]mmodel.HolderExportOperation{
+		accountA: {
+			{{TransactionID: "t1", OperationID: "o1", AccountID: accountA.String(), Amount: "10.50", Direction: "credit"}},
+			{{TransactionID: "t2", OperationID: "o2", AccountID: accountA.String(), Amount: "3", Direction: "debit"}},
+		},
+		accountB: {
+			{{TransactionID: "t3", OperationID: "o3", AccountID: accountB.String(), Amount: "7", Description: "rent, march"}},
+		},
+	}
+
+	f.uc.runHolderExport(context.Background(), f.org, f.newJob())
+
+	assert.Equal(t, []string{mmodel.HolderExportRunning, mmodel.HolderExportCompleted}, f.statuses)
+	require.NotNil(t, f.stored)
+	assert.Equal(t, mmodel.HolderExportCounts{Instruments: 1, RelatedPartyEntries: 1, Accounts: 2, Balances: 2, Transactions: 3}, f.stored.Counts)
+	assert.Equal(t, int64(len(f.archive)), f.stored.ArchiveSize)
+	require.NotNil(t, f.stored.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(holderExportRetention), *f.stored.ExpiresAt, time.Minute)
+
+	sum := sha256.Sum256(f.archive)
+	assert.Equal(t, hex.EncodeToString(sum[:]), f.stored.ArchiveSHA256)
+
+	files := readArchive(t, f.archive)
+	for _, name := range []string{"holder.json", "instruments.json", "related_party_entries.json", "accounts.json", "balances.json", "transactions.csv", "manifest.json"} {
+		assert.Contains(t, files, name)
+	}
+
+	var exported mmodel.Holder
+	require.NoError(t, json.Unmarshal(files["holder.json"], &exported))
+	assert.Equal(t, document, *exported.Document, "the archive holds the decrypted holder")
+
+	var entries []mmodel.HolderRelatedPartyEntry
+	require.NoError(t, json.Unmarshal(files["related_party_entries.json"], &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, otherInstrumentID, entries[0].InstrumentID)
+	assert.Equal(t, "LEGAL_REPRESENTATIVE", entries[0].RelatedParty.Role)
+
+	rows, err := csv.NewReader(bytes.NewReader(files["transactions.csv"])).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, holderExportCSVHeader, rows[0])
+	assert.Equal(t, []string{"t1", "t2", "t3"}, []string{rows[1][0], rows[2][0], rows[3][0]})
+	assert.Equal(t, "rent, march", rows[3][12])
+
+	require.Len(t, f.audit.events, 1)
+	event := f.audit.events[0]
+	assert.Equal(t, mmodel.AuditEventTypeExport, event.EventType)
+	assert.Equal(t, mmodel.AuditActionExport, event.Action)
+	assert.Equal(t, mmodel.AuditOutcomeSuccess, event.Outcome)
+	assert.Equal(t, "dpo@example.com", event.ActorID)
+	assert.Equal(t, f.holderID.String(), event.Details.SubjectID)
+}
+
+func TestRunHolderExport_FailureRecordsStaticCode(t *testing.T) {
+	f, holderRepo, instrumentRepo := newExportFixture(t)
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, f.holderID, true).
+		Return(&mmodel.Holder{ID: &f.holderID}, nil).Times(1)
+	instrumentRepo.EXPECT().FindAll(gomock.Any(), f.org, f.holderID, gomock.Any(), true).
+		Return([]*mmodel.Instrument{}, nil).Times(1)
+
+	f.ledger.accountErr = errors.New("postgres unavailable: host 10.0.0.7")
+
+	f.uc.runHolderExport(context.Background(), f.org, f.newJob())
+
+	assert.Equal(t, []string{mmodel.HolderExportRunning, mmodel.HolderExportFailed}, f.statuses)
+	assert.Equal(t, exportErrorAccountsUnreadable, f.stored.LastErrorCode)
+	assert.NotNil(t, f.stored.CompletedAt)
+	assert.Nil(t, f.archive, "no archive is stored for a failed export")
+
+	require.Len(t, f.audit.events, 1)
+	assert.Equal(t, mmodel.AuditOutcomeFailure, f.audit.events[0].Outcome)
+	assert.Equal(t, exportErrorAccountsUnreadable, f.audit.events[0].Details.ErrorCode)
+	assert.NotContains(t, f.audit.events[0].Reason, "10.0.0.7")
+}
+
+func TestRunHolderExport_StoreFailureRecordsArchiveCode(t *testing.T) {
+	f, holderRepo, instrumentRepo := newExportFixture(t)
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, f.holderID, true).
+		Return(&mmodel.Holder{ID: &f.holderID}, nil).Times(1)
+	instrumentRepo.EXPECT().FindAll(gomock.Any(), f.org, f.holderID, gomock.Any(), true).
+		Return([]*mmodel.Instrument{}, nil).Times(1)
+
+	f.storeErr = errors.New("mongo unavailable")
+
+	f.uc.runHolderExport(context.Background(), f.org, f.newJob())
+
+	assert.Equal(t, []string{mmodel.HolderExportRunning, mmodel.HolderExportFailed}, f.statuses)
+	assert.Equal(t, exportErrorArchiveFailed, f.stored.LastErrorCode)
+	assert.Nil(t, f.stored.ExpiresAt, "a failed export has no archive to expire")
+	assert.Nil(t, f.archive)
+}
+
+func TestCreateHolderExport_Rejections(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+	exportRepo := export.NewMockRepository(ctrl)
+	uc := &UseCase{HolderRepo: holderRepo, ExportRepo: exportRepo}
+
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+	input := &mmodel.CreateHolderExportInput{Actor: "dpo@example.com"}
+
+	notFound := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+	holderRepo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).Return(nil, notFound).Times(1)
+
+	_, err := uc.CreateHolderExport(context.Background(), organizationID, holderID, input)
+	assert.Equal(t, notFound, err)
+
+	holderRepo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).
+		Return(&mmodel.Holder{ID: &holderID}, nil).Times(1)
+	inProgress := pkg.ValidateBusinessError(cn.ErrHolderExportInProgress, cn.EntityHolderExport, uuid.NewString())
+	exportRepo.EXPECT().Create(gomock.Any(), organizationID, gomock.Any()).Return(inProgress).Times(1)
+
+	_, err = uc.CreateHolderExport(context.Background(), organizationID, holderID, input)
+	assert.Equal(t, inProgress, err)
+}
+
+func TestDownloadHolderExport(t *testing.T) {
+	archive := []byte("PK-archive")
+	sum := sha256.Sum256(archive)
+	future, past := time.Now().Add(time.Hour), time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name        string
+		export      mmodel.HolderExport
+		stored      []byte
+		expectedErr error
+	}{
+		{
+			name:        "running export is not ready",
+			export:      mmodel.HolderExport{Status: mmodel.HolderExportRunning},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrHolderExportNotReady, cn.EntityHolderExport, mmodel.HolderExportRunning),
+		},
+		{
+			name:        "expired export",
+			export:      mmodel.HolderExport{Status: mmodel.HolderExportCompleted, ExpiresAt: &past},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrHolderExportExpired, cn.EntityHolderExport),
+		},
+		{
+			name:        "archive already removed",
+			export:      mmodel.HolderExport{Status: mmodel.HolderExportCompleted, ExpiresAt: &future},
+			stored:      []byte{},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrHolderExportExpired, cn.EntityHolderExport),
+		},
+		{
+			name:        "digest mismatch",
+			export:      mmodel.HolderExport{Status: mmodel.HolderExportCompleted, ExpiresAt: &future, ArchiveSHA256: "00"},
+			stored:      archive,
+			expectedErr: pkg.ValidateInternalError(errors.New("holder export archive digest mismatch"), cn.EntityHolderExport),
+		},
+		{
+			name:   "completed export",
+			export: mmodel.HolderExport{Status: mmodel.HolderExportCompleted, ExpiresAt: &future, ArchiveSHA256: hex.EncodeToString(sum[:])},
+			stored: archive,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			exportRepo := export.NewMockRepository(ctrl)
+			uc := &UseCase{ExportRepo: exportRepo}
+
+			organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+			holderID, id := uuid.Must(libCommons.GenerateUUIDv7()), uuid.Must(libCommons.GenerateUUIDv7())
+
+			job := tc.export
+			exportRepo.EXPECT().Find(gomock.Any(), organizationID, holderID, id).Return(&job, nil).Times(1)
+
+			if tc.stored != nil {
+				exportRepo.EXPECT().LoadArchive(gomock.Any(), organizationID, &job).Return(tc.stored, nil).Times(1)
+			}
+
+			got, err := uc.DownloadHolderExport(context.Background(), organizationID, holderID, id)
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, archive, got)
+		})
+	}
+}
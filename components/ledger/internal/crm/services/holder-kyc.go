@@ -0,0 +1,425 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// kycSystemActor is the actor recorded on transitions the service makes on its
+// own, such as the expiry of a verification.
+const kycSystemActor = "system"
+
+// kycMutation applies one workflow step to a holder's KYC state at now. It
+// returns a business error when the step is not allowed in the current state.
+type kycMutation func(holder *mmodel.Holder, kyc *mmodel.HolderKYC, now time.Time) error
+
+// GetHolderKYC returns the KYC state of a holder. A holder that never opened a
+// verification case is PENDING with an empty history.
+func (uc *UseCase) GetHolderKYC(ctx context.Context, organizationID string, id uuid.UUID) (_ *mmodel.HolderKYCView, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_kyc")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "get_holder_kyc", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	holder, err := uc.HolderRepo.Find(ctx, organizationID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	kyc := currentKYC(holder)
+	expireKYC(kyc, time.Now().UTC())
+
+	return newHolderKYCView(holder, kyc), nil
+}
+
+// OpenHolderKYC opens a verification case at the requested level. It is how a
+// holder starts onboarding, upgrades its level or is re-verified. Opening over
+// a PENDING case only changes its level and keeps the documents submitted so
+// far; opening over a closed case (VERIFIED, REJECTED or EXPIRED) starts with
+// no documents. A case in review cannot be reopened until it is decided.
+func (uc *UseCase) OpenHolderKYC(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.OpenKYCInput) (*mmodel.HolderKYCView, error) {
+	return uc.mutateHolderKYC(ctx, organizationID, id, "open_holder_kyc", func(holder *mmodel.Holder, kyc *mmodel.HolderKYC, now time.Time) error {
+		if kyc.Status == mmodel.KYCStatusInReview {
+			return pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, kyc.Status, "reopened")
+		}
+
+		if _, ok := mmodel.KYCRequiredDocuments(holderType(holder), input.Level); !ok {
+			return pkg.ValidateBusinessError(cn.ErrKYCHolderTypeUnsupported, cn.EntityHolder, holderType(holder))
+		}
+
+		if kyc.Status != mmodel.KYCStatusPending {
+			kyc.Documents = []mmodel.KYCDocument{}
+		}
+
+		kyc.Level = &input.Level
+		transitionKYC(kyc, mmodel.KYCStatusPending, input.Actor, input.Reason, now)
+
+		return nil
+	})
+}
+
+// SubmitHolderKYCDocument adds a document to a PENDING case, replacing any
+// document of the same type.
+func (uc *UseCase) SubmitHolderKYCDocument(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.SubmitKYCDocumentInput) (*mmodel.HolderKYCView, error) {
+	return uc.mutateHolderKYC(ctx, organizationID, id, "submit_holder_kyc_document", func(holder *mmodel.Holder, kyc *mmodel.HolderKYC, now time.Time) error {
+		if kyc.Status != mmodel.KYCStatusPending {
+			return pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, kyc.Status, "amended")
+		}
+
+		if _, ok := mmodel.KYCRequiredDocuments(holderType(holder), mmodel.KYCLevelBasic); !ok {
+			return pkg.ValidateBusinessError(cn.ErrKYCHolderTypeUnsupported, cn.EntityHolder, holderType(holder))
+		}
+
+		kyc.Documents = slices.DeleteFunc(kyc.Documents, func(d mmodel.KYCDocument) bool {
+			return d.Type == input.Type
+		})
+
+		kyc.Documents = append(kyc.Documents, mmodel.KYCDocument{
+			Type:        input.Type,
+			Reference:   input.Reference,
+			SubmittedBy: input.Actor,
+			SubmittedAt: now,
+		})
+
+		return nil
+	})
+}
+
+// SubmitHolderKYC sends a PENDING case to review once every document of its
+// checklist was submitted. A case that was never opened is reviewed at BASIC
+// level.
+func (uc *UseCase) SubmitHolderKYC(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.SubmitKYCInput) (*mmodel.HolderKYCView, error) {
+	return uc.mutateHolderKYC(ctx, organizationID, id, "submit_holder_kyc", func(holder *mmodel.Holder, kyc *mmodel.HolderKYC, now time.Time) error {
+		if kyc.Status != mmodel.KYCStatusPending {
+			return pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, kyc.Status, "submitted")
+		}
+
+		level := kycLevel(kyc)
+
+		required, ok := mmodel.KYCRequiredDocuments(holderType(holder), level)
+		if !ok {
+			return pkg.ValidateBusinessError(cn.ErrKYCHolderTypeUnsupported, cn.EntityHolder, holderType(holder))
+		}
+
+		if missing := missingKYCDocuments(kyc, required); len(missing) > 0 {
+			return pkg.ValidateBusinessError(cn.ErrKYCDocumentsMissing, cn.EntityHolder, strings.Join(missing, ", "))
+		}
+
+		kyc.Level = &level
+		transitionKYC(kyc, mmodel.KYCStatusInReview, input.Actor, "", now)
+
+		return nil
+	})
+}
+
+// DecideHolderKYC records the reviewer's decision on a case in review. The
+// reviewer must not be the actor who submitted the case. Approving grants the
+// standing verification at the case's level until the re-verification date;
+// rejecting revokes any standing verification.
+func (uc *UseCase) DecideHolderKYC(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.DecideKYCInput) (*mmodel.HolderKYCView, error) {
+	return uc.mutateHolderKYC(ctx, organizationID, id, "decide_holder_kyc", func(_ *mmodel.Holder, kyc *mmodel.HolderKYC, now time.Time) error {
+		if kyc.Status != mmodel.KYCStatusInReview {
+			return pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, kyc.Status, "decided")
+		}
+
+		if strings.EqualFold(kycSubmitter(kyc), input.Reviewer) {
+			return pkg.ValidateBusinessError(cn.ErrKYCSelfReview, cn.EntityHolder)
+		}
+
+		if input.Decision == mmodel.KYCDecisionReject {
+			if strings.TrimSpace(input.Reason) == "" {
+				return pkg.ValidateBusinessError(cn.ErrMissingFieldsInRequest, cn.EntityHolder, "reason")
+			}
+
+			kyc.VerifiedLevel, kyc.VerifiedAt, kyc.ExpiresAt = nil, nil, nil
+			transitionKYC(kyc, mmodel.KYCStatusRejected, input.Reviewer, input.Reason, now)
+
+			return nil
+		}
+
+		level := kycLevel(kyc)
+
+		expiresAt := mmodel.KYCReverificationDate(level, now)
+		if input.ExpiresAt != nil {
+			if !input.ExpiresAt.After(now) {
+				return pkg.ValidateBusinessError(cn.ErrKYCExpiryNotInFuture, cn.EntityHolder)
+			}
+
+			expiresAt = input.ExpiresAt.UTC()
+		}
+
+		kyc.VerifiedLevel, kyc.VerifiedAt, kyc.ExpiresAt = &level, &now, &expiresAt
+		transitionKYC(kyc, mmodel.KYCStatusVerified, input.Reviewer, input.Reason, now)
+
+		return nil
+	})
+}
+
+// ListKYCReviewsDue lists the holders whose verification expires at or before
+// dueBefore, the earliest first.
+func (uc *UseCase) ListKYCReviewsDue(ctx context.Context, organizationID string, dueBefore time.Time, query http.QueryHeader) (_ []*mmodel.KYCReviewDue, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.list_kyc_reviews_due")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "list_kyc_reviews_due", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+	)
+
+	reviews, err := uc.HolderRepo.FindKYCReviewsDue(ctx, organizationID, dueBefore, query)
+	if err != nil {
+		recordSpanError(span, "Failed to list KYC reviews due", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	for _, review := range reviews {
+		if review.Status == mmodel.KYCStatusVerified && !now.Before(review.ExpiresAt) {
+			review.Status = mmodel.KYCStatusExpired
+		}
+	}
+
+	return reviews, nil
+}
+
+// UnverifiedHolders returns the holders among ids without a standing
+// verification at level or above, in the order given. Holders that do not exist
+// are reported as unverified.
+func (uc *UseCase) UnverifiedHolders(ctx context.Context, organizationID string, ids []uuid.UUID, level string) ([]uuid.UUID, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.unverified_holders")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.Int("app.request.holder_count", len(ids)),
+	)
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	states, err := uc.HolderRepo.FindKYCByIDs(ctx, organizationID, ids)
+	if err != nil {
+		recordSpanError(span, "Failed to find holders KYC", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	var unverified []uuid.UUID
+
+	for _, id := range ids {
+		if !states[id].IsVerifiedAt(level, now) {
+			unverified = append(unverified, id)
+		}
+	}
+
+	return unverified, nil
+}
+
+// mutateHolderKYC loads a holder's KYC state, applies mutate and stores the
+// result guarded by the revision it was read at, so concurrent steps on the same
+// holder cannot both succeed.
+func (uc *UseCase) mutateHolderKYC(ctx context.Context, organizationID string, id uuid.UUID, operation string, mutate kycMutation) (_ *mmodel.HolderKYCView, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service."+operation)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", operation, start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	holder, err := uc.HolderRepo.Find(ctx, organizationID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	kyc := currentKYC(holder)
+	expectedRevision := kyc.Revision
+
+	expireKYC(kyc, now)
+
+	if err := mutate(holder, kyc, now); err != nil {
+		recordSpanError(span, "KYC step rejected", err)
+
+		return nil, err
+	}
+
+	kyc.Revision = expectedRevision + 1
+	kyc.UpdatedAt = &now
+
+	if err := uc.HolderRepo.UpdateKYC(ctx, organizationID, id, kyc, expectedRevision); err != nil {
+		recordSpanError(span, "Failed to update holder KYC", err)
+
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("app.kyc.status", kyc.Status))
+
+	return newHolderKYCView(holder, kyc), nil
+}
+
+// currentKYC returns the holder's KYC state, or a new PENDING state when the
+// holder has none.
+func currentKYC(holder *mmodel.Holder) *mmodel.HolderKYC {
+	if holder.KYC != nil {
+		return holder.KYC
+	}
+
+	return &mmodel.HolderKYC{
+		Status:    mmodel.KYCStatusPending,
+		Documents: []mmodel.KYCDocument{},
+		History:   []mmodel.KYCTransition{},
+	}
+}
+
+// expireKYC moves a VERIFIED case past its re-verification date to EXPIRED,
+// recording the transition at the expiry time.
+func expireKYC(kyc *mmodel.HolderKYC, now time.Time) {
+	if kyc.Status != mmodel.KYCStatusVerified || kyc.ExpiresAt == nil || now.Before(*kyc.ExpiresAt) {
+		return
+	}
+
+	transitionKYC(kyc, mmodel.KYCStatusExpired, kycSystemActor, "re-verification date reached", *kyc.ExpiresAt)
+}
+
+// transitionKYC moves the case to status and appends the transition to its
+// history. The first transition of a holder has no previous status.
+func transitionKYC(kyc *mmodel.HolderKYC, status, actor, reason string, at time.Time) {
+	from := kyc.Status
+	if kyc.Revision == 0 && len(kyc.History) == 0 {
+		from = ""
+	}
+
+	kyc.History = append(kyc.History, mmodel.KYCTransition{
+		From:      from,
+		To:        status,
+		Level:     kycLevel(kyc),
+		Actor:     actor,
+		Reason:    reason,
+		CreatedAt: at,
+	})
+	kyc.Status = status
+}
+
+// kycLevel returns the level of the current case, BASIC when none was opened.
+func kycLevel(kyc *mmodel.HolderKYC) string {
+	if kyc.Level == nil {
+		return mmodel.KYCLevelBasic
+	}
+
+	return *kyc.Level
+}
+
+// kycSubmitter returns the actor who sent the current case to review.
+func kycSubmitter(kyc *mmodel.HolderKYC) string {
+	for i := len(kyc.History) - 1; i >= 0; i-- {
+		if kyc.History[i].To == mmodel.KYCStatusInReview {
+			return kyc.History[i].Actor
+		}
+	}
+
+	return ""
+}
+
+// missingKYCDocuments returns the required document types not yet submitted.
+func missingKYCDocuments(kyc *mmodel.HolderKYC, required []string) []string {
+	var missing []string
+
+	for _, docType := range required {
+		if !slices.ContainsFunc(kyc.Documents, func(d mmodel.KYCDocument) bool { return d.Type == docType }) {
+			missing = append(missing, docType)
+		}
+	}
+
+	return missing
+}
+
+// holderType returns the holder's type, or an empty string when unset.
+func holderType(holder *mmodel.Holder) string {
+	if holder.Type == nil {
+		return ""
+	}
+
+	return *holder.Type
+}
+
+// newHolderKYCView builds the KYC view of a holder, with the checklist of the
+// current case.
+func newHolderKYCView(holder *mmodel.Holder, kyc *mmodel.HolderKYC) *mmodel.HolderKYCView {
+	view := &mmodel.HolderKYCView{
+		HolderType: holderType(holder),
+		ExternalID: holder.ExternalID,
+		HolderKYC:  *kyc,
+		Checklist:  []mmodel.KYCChecklistItem{},
+	}
+
+	if holder.ID != nil {
+		view.HolderID = *holder.ID
+	}
+
+	required, _ := mmodel.KYCRequiredDocuments(view.HolderType, kycLevel(kyc))
+	missing := missingKYCDocuments(kyc, required)
+
+	for _, docType := range required {
+		view.Checklist = append(view.Checklist, mmodel.KYCChecklistItem{
+			Type:      docType,
+			Submitted: !slices.Contains(missing, docType),
+		})
+	}
+
+	return view
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// kycHolder builds a natural-person holder carrying kyc.
+func kycHolder(id uuid.UUID, kyc *mmodel.HolderKYC) *mmodel.Holder {
+	holderType := mmodel.HolderTypeNaturalPerson
+
+	return &mmodel.Holder{ID: &id, Type: &holderType, KYC: kyc}
+}
+
+func TestHolderKYC_Workflow(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+
+	ctrl := gomock.NewController(t)
+	repo := holder.NewMockRepository(ctrl)
+	uc := &UseCase{HolderRepo: repo}
+	ctx := context.Background()
+
+	// stored mimics the repository: every read returns the last written state.
+	var stored *mmodel.HolderKYC
+
+	repo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).
+		DoAndReturn(func(context.Context, string, uuid.UUID, bool) (*mmodel.Holder, error) {
+			if stored == nil {
+				return kycHolder(holderID, nil), nil
+			}
+
+			clone := *stored
+
+			return kycHolder(holderID, &clone), nil
+		}).AnyTimes()
+	repo.EXPECT().UpdateKYC(gomock.Any(), organizationID, holderID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ uuid.UUID, kyc *mmodel.HolderKYC, expected int64) error {
+			current := int64(0)
+			if stored != nil {
+				current = stored.Revision
+			}
+
+			require.Equal(t, current, expected)
+			stored = kyc
+
+			return nil
+		}).AnyTimes()
+
+	view, err := uc.OpenHolderKYC(ctx, organizationID, holderID, &mmodel.OpenKYCInput{Level: mmodel.KYCLevelStandard, Actor: "ops"})
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KYCStatusPending, view.Status)
+	assert.Equal(t, int64(1), view.Revision)
+	assert.Equal(t, "", view.History[0].From)
+	assert.Len(t, view.Checklist, 2)
+
+	_, err = uc.SubmitHolderKYC(ctx, organizationID, holderID, &mmodel.SubmitKYCInput{Actor: "ops"})
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrKYCDocumentsMissing, cn.EntityHolder, "IDENTITY_DOCUMENT, PROOF_OF_ADDRESS"), err)
+
+	for _, docType := range []string{mmodel.KYCDocumentIdentity, mmodel.KYCDocumentProofOfAddress, mmodel.KYCDocumentIdentity} {
+		_, err = uc.SubmitHolderKYCDocument(ctx, organizationID, holderID, &mmodel.SubmitKYCDocumentInput{Type: docType, Reference: "dms://" + docType, Actor: "ops"})
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, stored.Documents, 2, "a document of the same type replaces the previous one")
+
+	view, err = uc.SubmitHolderKYC(ctx, organizationID, holderID, &mmodel.SubmitKYCInput{Actor: "ops"})
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KYCStatusInReview, view.Status)
+
+	_, err = uc.DecideHolderKYC(ctx, organizationID, holderID, &mmodel.DecideKYCInput{Decision: mmodel.KYCDecisionApprove, Reviewer: "OPS"})
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrKYCSelfReview, cn.EntityHolder), err)
+
+	past := time.Now().Add(-time.Hour)
+	_, err = uc.DecideHolderKYC(ctx, organizationID, holderID, &mmodel.DecideKYCInput{Decision: mmodel.KYCDecisionApprove, Reviewer: "compliance", ExpiresAt: &past})
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrKYCExpiryNotInFuture, cn.EntityHolder), err)
+
+	view, err = uc.DecideHolderKYC(ctx, organizationID, holderID, &mmodel.DecideKYCInput{Decision: mmodel.KYCDecisionApprove, Reviewer: "compliance"})
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KYCStatusVerified, view.Status)
+	require.NotNil(t, view.VerifiedLevel)
+	assert.Equal(t, mmodel.KYCLevelStandard, *view.VerifiedLevel)
+	assert.Equal(t, mmodel.KYCReverificationDate(mmodel.KYCLevelStandard, *view.VerifiedAt), *view.ExpiresAt)
+	assert.Len(t, view.History, 3)
+
+	// A re-verification case keeps the standing verification until decided.
+	view, err = uc.OpenHolderKYC(ctx, organizationID, holderID, &mmodel.OpenKYCInput{Level: mmodel.KYCLevelEnhanced, Actor: "ops"})
+	require.NoError(t, err)
+	assert.Empty(t, view.Documents)
+	assert.True(t, view.IsVerifiedAt(mmodel.KYCLevelStandard, time.Now()))
+	assert.Equal(t, mmodel.KYCStatusVerified, view.History[3].From)
+}
+
+func TestHolderKYC_InvalidTransitions(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+	ctx := context.Background()
+
+	inReview := &mmodel.HolderKYC{Status: mmodel.KYCStatusInReview, Revision: 3}
+
+	testCases := []struct {
+		name        string
+		holder      *mmodel.Holder
+		call        func(uc *UseCase) error
+		expectedErr error
+	}{
+		{
+			name:   "cannot reopen a case in review",
+			holder: kycHolder(holderID, inReview),
+			call: func(uc *UseCase) error {
+				_, err := uc.OpenHolderKYC(ctx, organizationID, holderID, &mmodel.OpenKYCInput{Level: mmodel.KYCLevelBasic, Actor: "ops"})
+				return err
+			},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, mmodel.KYCStatusInReview, "reopened"),
+		},
+		{
+			name:   "cannot amend a case in review",
+			holder: kycHolder(holderID, inReview),
+			call: func(uc *UseCase) error {
+				_, err := uc.SubmitHolderKYCDocument(ctx, organizationID, holderID, &mmodel.SubmitKYCDocumentInput{Type: mmodel.KYCDocumentIdentity, Reference: "dms://1", Actor: "ops"})
+				return err
+			},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, mmodel.KYCStatusInReview, "amended"),
+		},
+		{
+			name:   "cannot decide a pending case",
+			holder: kycHolder(holderID, nil),
+			call: func(uc *UseCase) error {
+				_, err := uc.DecideHolderKYC(ctx, organizationID, holderID, &mmodel.DecideKYCInput{Decision: mmodel.KYCDecisionReject, Reviewer: "compliance", Reason: "x"})
+				return err
+			},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrKYCTransitionInvalid, cn.EntityHolder, mmodel.KYCStatusPending, "decided"),
+		},
+		{
+			name:   "holder without a checklist cannot be verified",
+			holder: &mmodel.Holder{ID: &holderID},
+			call: func(uc *UseCase) error {
+				_, err := uc.OpenHolderKYC(ctx, organizationID, holderID, &mmodel.OpenKYCInput{Level: mmodel.KYCLevelBasic, Actor: "ops"})
+				return err
+			},
+			expectedErr: pkg.ValidateBusinessError(cn.ErrKYCHolderTypeUnsupported, cn.EntityHolder, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := holder.NewMockRepository(ctrl)
+			repo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).Return(tc.holder, nil)
+
+			err := tc.call(&UseCase{HolderRepo: repo})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestHolderKYC_RejectRevokesVerification(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+	level := mmodel.KYCLevelBasic
+	verifiedAt := time.Now().Add(-time.Hour)
+	expiresAt := time.Now().Add(time.Hour)
+
+	kyc := &mmodel.HolderKYC{
+		Status:        mmodel.KYCStatusInReview,
+		Level:         &level,
+		VerifiedLevel: &level,
+		VerifiedAt:    &verifiedAt,
+		ExpiresAt:     &expiresAt,
+		History:       []mmodel.KYCTransition{{To: mmodel.KYCStatusInReview, Actor: "ops"}},
+		Revision:      7,
+	}
+
+	ctrl := gomock.NewController(t)
+	repo := holder.NewMockRepository(ctrl)
+	repo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).Return(kycHolder(holderID, kyc), nil)
+	repo.EXPECT().UpdateKYC(gomock.Any(), organizationID, holderID, gomock.Any(), int64(7)).Return(nil)
+
+	view, err := (&UseCase{HolderRepo: repo}).DecideHolderKYC(context.Background(), organizationID, holderID,
+		&mmodel.DecideKYCInput{Decision: mmodel.KYCDecisionReject, Reviewer: "compliance", Reason: "forged document"})
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KYCStatusRejected, view.Status)
+	assert.Nil(t, view.VerifiedLevel)
+	assert.Equal(t, int64(8), view.Revision)
+	assert.Equal(t, "forged document", view.History[1].Reason)
+}
+
+func TestGetHolderKYC_ExpiresPastReverificationDate(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	holderID := uuid.Must(libCommons.GenerateUUIDv7())
+	level := mmodel.KYCLevelBasic
+	expiresAt := time.Now().Add(-time.Minute).UTC()
+
+	kyc := &mmodel.HolderKYC{Status: mmodel.KYCStatusVerified, Level: &level, VerifiedLevel: &level, ExpiresAt: &expiresAt, Revision: 2}
+
+	ctrl := gomock.NewController(t)
+	repo := holder.NewMockRepository(ctrl)
+	repo.EXPECT().Find(gomock.Any(), organizationID, holderID, false).Return(kycHolder(holderID, kyc), nil)
+
+	view, err := (&UseCase{HolderRepo: repo}).GetHolderKYC(context.Background(), organizationID, holderID)
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.KYCStatusExpired, view.Status)
+	require.Len(t, view.History, 1)
+	assert.Equal(t, kycSystemActor, view.History[0].Actor)
+	assert.Equal(t, expiresAt, view.History[0].CreatedAt)
+	assert.Equal(t, []mmodel.KYCChecklistItem{{Type: mmodel.KYCDocumentIdentity}}, view.Checklist)
+}
+
+func TestUnverifiedHolders(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+	verified := uuid.Must(libCommons.GenerateUUIDv7())
+	basicOnly := uuid.Must(libCommons.GenerateUUIDv7())
+	unknown := uuid.Must(libCommons.GenerateUUIDv7())
+
+	standard, basic := mmodel.KYCLevelStandard, mmodel.KYCLevelBasic
+	expiresAt := time.Now().Add(time.Hour)
+
+	ctrl := gomock.NewController(t)
+	repo := holder.NewMockRepository(ctrl)
+	repo.EXPECT().FindKYCByIDs(gomock.Any(), organizationID, []uuid.UUID{verified, basicOnly, unknown}).
+		Return(map[uuid.UUID]*mmodel.HolderKYC{
+			verified:  {VerifiedLevel: &standard, ExpiresAt: &expiresAt},
+			basicOnly: {VerifiedLevel: &basic, ExpiresAt: &expiresAt},
+		}, nil)
+
+	unverified, err := (&UseCase{HolderRepo: repo}).UnverifiedHolders(context.Background(), organizationID,
+		[]uuid.UUID{verified, basicOnly, unknown}, mmodel.KYCLevelStandard)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{basicOnly, unknown}, unverified)
+}
@@ -0,0 +1,476 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxOwnershipDepth bounds the chains walked for cycle detection and
+// beneficial-owner resolution.
+const maxOwnershipDepth = 16
+
+// ownershipEpsilon absorbs float rounding when stakes are summed.
+const ownershipEpsilon = 1e-9
+
+// CreateHolderRelationship records that a holder owns or controls a
+// legal-person holder. It rejects stakes that would take the holder's recorded
+// ownership above 100% on any day, a second relationship of the same type
+// between the same holders over an overlapping period, and relationships that
+// would close a cycle.
+func (uc *UseCase) CreateHolderRelationship(ctx context.Context, organizationID string, holderID uuid.UUID, input *mmodel.CreateHolderRelationshipInput) (_ *mmodel.HolderRelationship, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.create_holder_relationship")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "create_holder_relationship", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.relationship_type", input.Type),
+	)
+
+	ownerID, err := uuid.Parse(input.OwnerID)
+	if err != nil {
+		err = pkg.ValidateBusinessError(cn.ErrInvalidRequestBody, cn.EntityHolderRelationship, "ownerId")
+		recordSpanError(span, "Invalid owner id", err)
+
+		return nil, err
+	}
+
+	if ownerID == holderID {
+		err = pkg.ValidateBusinessError(cn.ErrHolderRelationshipSelf, cn.EntityHolderRelationship)
+		recordSpanError(span, "Holder cannot own itself", err)
+
+		return nil, err
+	}
+
+	if err = validateRelationshipTerms(input.Type, input.Percentage); err != nil {
+		recordSpanError(span, "Invalid relationship terms", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	from := mmodel.Date{Time: truncateToDay(now)}
+	if input.EffectiveFrom != nil && !input.EffectiveFrom.IsZero() {
+		from = *input.EffectiveFrom
+	}
+
+	to := input.EffectiveTo
+	if to != nil && to.IsZero() {
+		to = nil
+	}
+
+	if to != nil && !from.Before(*to) {
+		err = pkg.ValidateBusinessError(cn.ErrHolderRelationshipPeriodInvalid, cn.EntityHolderRelationship, "effectiveTo must be after effectiveFrom")
+		recordSpanError(span, "Invalid relationship period", err)
+
+		return nil, err
+	}
+
+	if _, err = uc.findLegalPersonHolder(ctx, organizationID, holderID); err != nil {
+		recordSpanError(span, "Failed to get owned holder", err)
+
+		return nil, err
+	}
+
+	owner, err := uc.HolderRepo.Find(ctx, organizationID, ownerID, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get owner holder", err)
+
+		return nil, err
+	}
+
+	relationship := &mmodel.HolderRelationship{
+		ID:            uuid.Must(libCommons.GenerateUUIDv7()),
+		HolderID:      holderID,
+		OwnerID:       ownerID,
+		OwnerType:     holderType(owner),
+		Type:          input.Type,
+		Percentage:    input.Percentage,
+		Description:   input.Description,
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	relationship.History = []mmodel.HolderRelationshipChange{newRelationshipChange(relationship, mmodel.HolderRelationshipCreated, input.Actor, now)}
+
+	existing, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, []uuid.UUID{holderID}, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationships", err)
+
+		return nil, err
+	}
+
+	if err = checkRelationshipFits(existing, relationship); err != nil {
+		recordSpanError(span, "Relationship does not fit the holder's ownership", err)
+
+		return nil, err
+	}
+
+	if err = uc.checkOwnershipCycle(ctx, organizationID, ownerID, holderID); err != nil {
+		recordSpanError(span, "Relationship would close a cycle", err)
+
+		return nil, err
+	}
+
+	if err = uc.OwnershipRepo.Create(ctx, organizationID, relationship); err != nil {
+		recordSpanError(span, "Failed to create holder relationship", err)
+
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// GetHolderRelationships returns the live relationships in which the holder is
+// owned or controlled; includeDeleted adds the deleted ones for audit.
+func (uc *UseCase) GetHolderRelationships(ctx context.Context, organizationID string, holderID uuid.UUID, includeDeleted bool) ([]*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_relationships")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+	)
+
+	if _, err := uc.HolderRepo.Find(ctx, organizationID, holderID, false); err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	relationships, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, []uuid.UUID{holderID}, includeDeleted)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationships", err)
+
+		return nil, err
+	}
+
+	return relationships, nil
+}
+
+// GetHolderRelationship returns a relationship of the holder, deleted or not,
+// with its full history.
+func (uc *UseCase) GetHolderRelationship(ctx context.Context, organizationID string, holderID, id uuid.UUID) (*mmodel.HolderRelationship, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_relationship")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.relationship_id", id.String()),
+	)
+
+	relationship, err := uc.OwnershipRepo.Find(ctx, organizationID, holderID, id, true)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationship", err)
+
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// UpdateHolderRelationship changes the share, description or end of a live
+// relationship. The owner, type and start are fixed: a different arrangement
+// is a new relationship.
+func (uc *UseCase) UpdateHolderRelationship(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.UpdateHolderRelationshipInput) (_ *mmodel.HolderRelationship, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.update_holder_relationship")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "update_holder_relationship", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.relationship_id", id.String()),
+	)
+
+	relationship, err := uc.OwnershipRepo.Find(ctx, organizationID, holderID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationship", err)
+
+		return nil, err
+	}
+
+	if input.Percentage != nil {
+		if err = validateRelationshipTerms(relationship.Type, input.Percentage); err != nil {
+			recordSpanError(span, "Invalid relationship terms", err)
+
+			return nil, err
+		}
+
+		relationship.Percentage = input.Percentage
+	}
+
+	if input.Description != nil {
+		relationship.Description = *input.Description
+	}
+
+	if input.EffectiveTo != nil {
+		relationship.EffectiveTo = input.EffectiveTo
+		if input.EffectiveTo.IsZero() {
+			relationship.EffectiveTo = nil
+		} else if !relationship.EffectiveFrom.Before(*input.EffectiveTo) {
+			err = pkg.ValidateBusinessError(cn.ErrHolderRelationshipPeriodInvalid, cn.EntityHolderRelationship, "effectiveTo must be after effectiveFrom")
+			recordSpanError(span, "Invalid relationship period", err)
+
+			return nil, err
+		}
+	}
+
+	existing, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, []uuid.UUID{holderID}, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationships", err)
+
+		return nil, err
+	}
+
+	if err = checkRelationshipFits(existing, relationship); err != nil {
+		recordSpanError(span, "Relationship does not fit the holder's ownership", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	relationship.UpdatedAt = now
+
+	change := newRelationshipChange(relationship, mmodel.HolderRelationshipUpdated, input.Actor, now)
+
+	if err = uc.OwnershipRepo.Update(ctx, organizationID, relationship, change); err != nil {
+		recordSpanError(span, "Failed to update holder relationship", err)
+
+		return nil, err
+	}
+
+	relationship.History = append(relationship.History, change)
+
+	return relationship, nil
+}
+
+// DeleteHolderRelationship removes a relationship recorded in error. It stays
+// readable, with the actor who deleted it, through GetHolderRelationship. A
+// stake that was sold is ended with an effectiveTo instead.
+func (uc *UseCase) DeleteHolderRelationship(ctx context.Context, organizationID string, holderID, id uuid.UUID, actor string) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.delete_holder_relationship")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "delete_holder_relationship", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.relationship_id", id.String()),
+	)
+
+	if actor == "" || len(actor) > 256 {
+		err = pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "actor")
+		recordSpanError(span, "Missing or invalid actor", err)
+
+		return err
+	}
+
+	relationship, err := uc.OwnershipRepo.Find(ctx, organizationID, holderID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder relationship", err)
+
+		return err
+	}
+
+	now := time.Now().UTC()
+	relationship.UpdatedAt = now
+	relationship.DeletedAt = &now
+
+	if err = uc.OwnershipRepo.Update(ctx, organizationID, relationship, newRelationshipChange(relationship, mmodel.HolderRelationshipDeleted, actor, now)); err != nil {
+		recordSpanError(span, "Failed to delete holder relationship", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// findLegalPersonHolder returns the holder, or ErrHolderRelationshipNotLegalPerson
+// when it is not a legal person.
+func (uc *UseCase) findLegalPersonHolder(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.Holder, error) {
+	holder, err := uc.HolderRepo.Find(ctx, organizationID, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if holderType(holder) != mmodel.HolderTypeLegalPerson {
+		return nil, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotLegalPerson, cn.EntityHolderRelationship, holderType(holder))
+	}
+
+	return holder, nil
+}
+
+// checkOwnershipCycle rejects ownerID → holderID when holderID already owns or
+// controls ownerID through any live relationship, whatever its period: a stake
+// sold today may be the stake restated tomorrow, and cycles make beneficial
+// ownership undefined.
+func (uc *UseCase) checkOwnershipCycle(ctx context.Context, organizationID string, ownerID, holderID uuid.UUID) error {
+	visited := map[uuid.UUID]bool{ownerID: true}
+	frontier := []uuid.UUID{ownerID}
+
+	for depth := 0; depth < maxOwnershipDepth && len(frontier) > 0; depth++ {
+		relationships, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, frontier, false)
+		if err != nil {
+			return err
+		}
+
+		frontier = frontier[:0]
+
+		for _, r := range relationships {
+			if r.OwnerID == holderID {
+				return pkg.ValidateBusinessError(cn.ErrHolderRelationshipCycle, cn.EntityHolderRelationship, ownerID, holderID)
+			}
+
+			if !visited[r.OwnerID] {
+				visited[r.OwnerID] = true
+				frontier = append(frontier, r.OwnerID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRelationshipTerms checks the percentage against the relationship
+// type; the validator has no conditional rules.
+func validateRelationshipTerms(relationshipType string, percentage *float64) error {
+	switch relationshipType {
+	case mmodel.HolderRelationshipOwnership:
+		if percentage != nil && *percentage > 0 && *percentage <= 100 {
+			return nil
+		}
+	case mmodel.HolderRelationshipControl:
+		if percentage == nil {
+			return nil
+		}
+	}
+
+	return pkg.ValidateBusinessError(cn.ErrHolderRelationshipPercentage, cn.EntityHolderRelationship, relationshipType)
+}
+
+// checkRelationshipFits checks candidate against the holder's other live
+// relationships: no other relationship of the same type with the same owner
+// may overlap its period, and the holder's stakes must not add up to more
+// than 100% on any day of it.
+func checkRelationshipFits(existing []*mmodel.HolderRelationship, candidate *mmodel.HolderRelationship) error {
+	from := candidate.EffectiveFrom.Time
+
+	var to *time.Time
+	if candidate.EffectiveTo != nil {
+		to = &candidate.EffectiveTo.Time
+	}
+
+	stakes := []*mmodel.HolderRelationship{candidate}
+
+	for _, r := range existing {
+		if r.ID == candidate.ID || !r.Overlaps(from, to) {
+			continue
+		}
+
+		if r.OwnerID == candidate.OwnerID && r.Type == candidate.Type {
+			return pkg.ValidateBusinessError(cn.ErrHolderRelationshipAlreadyExists, cn.EntityHolderRelationship, candidate.Type)
+		}
+
+		if r.Type == mmodel.HolderRelationshipOwnership {
+			stakes = append(stakes, r)
+		}
+	}
+
+	if candidate.Type != mmodel.HolderRelationshipOwnership {
+		return nil
+	}
+
+	// The total only changes when a stake starts, so checking the candidate's
+	// start and every later start within its period covers every day.
+	days := []time.Time{from}
+
+	for _, r := range stakes[1:] {
+		if r.EffectiveFrom.After(candidate.EffectiveFrom) && (to == nil || r.EffectiveFrom.Time.Before(*to)) {
+			days = append(days, r.EffectiveFrom.Time)
+		}
+	}
+
+	slices.SortFunc(days, func(a, b time.Time) int { return a.Compare(b) })
+
+	for _, day := range days {
+		var total float64
+
+		for _, r := range stakes {
+			if r.IsEffectiveOn(day) {
+				total += *r.Percentage
+			}
+		}
+
+		if total > 100+ownershipEpsilon {
+			return pkg.ValidateBusinessError(cn.ErrHolderOwnershipExceeded, cn.EntityHolderRelationship,
+				strconv.FormatFloat(total, 'f', -1, 64), day.Format(time.DateOnly))
+		}
+	}
+
+	return nil
+}
+
+// newRelationshipChange snapshots the terms of r as a history entry.
+func newRelationshipChange(r *mmodel.HolderRelationship, action, actor string, at time.Time) mmodel.HolderRelationshipChange {
+	return mmodel.HolderRelationshipChange{
+		Action:        action,
+		Actor:         actor,
+		Percentage:    r.Percentage,
+		EffectiveFrom: r.EffectiveFrom,
+		EffectiveTo:   r.EffectiveTo,
+		ChangedAt:     at,
+	}
+}
+
+// truncateToDay returns the UTC midnight of t.
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
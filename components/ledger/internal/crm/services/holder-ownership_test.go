@@ -0,0 +1,308 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ownershipFixture wires a UseCase over mocked repositories that behave like
+// an in-memory store of holders and relationships.
+type ownershipFixture struct {
+	uc            *UseCase
+	org           string
+	holders       map[uuid.UUID]*mmodel.Holder
+	relationships []*mmodel.HolderRelationship
+}
+
+func newOwnershipFixture(t *testing.T) *ownershipFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+	ownershipRepo := ownership.NewMockRepository(ctrl)
+
+	f := &ownershipFixture{
+		uc:      &UseCase{HolderRepo: holderRepo, OwnershipRepo: ownershipRepo},
+		org:     uuid.Must(libCommons.GenerateUUIDv7()).String(),
+		holders: map[uuid.UUID]*mmodel.Holder{},
+	}
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, id uuid.UUID, _ bool) (*mmodel.Holder, error) {
+			if h, ok := f.holders[id]; ok {
+				return h, nil
+			}
+
+			return nil, pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+		}).AnyTimes()
+	ownershipRepo.EXPECT().Create(gomock.Any(), f.org, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, r *mmodel.HolderRelationship) error {
+			f.relationships = append(f.relationships, r)
+
+			return nil
+		}).AnyTimes()
+	ownershipRepo.EXPECT().FindByHolders(gomock.Any(), f.org, gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, _ string, ids []uuid.UUID, _ bool) ([]*mmodel.HolderRelationship, error) {
+			var found []*mmodel.HolderRelationship
+
+			for _, r := range f.relationships {
+				for _, id := range ids {
+					if r.HolderID == id && r.DeletedAt == nil {
+						clone := *r
+						found = append(found, &clone)
+					}
+				}
+			}
+
+			return found, nil
+		}).AnyTimes()
+	ownershipRepo.EXPECT().Find(gomock.Any(), f.org, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, holderID, id uuid.UUID, includeDeleted bool) (*mmodel.HolderRelationship, error) {
+			for _, r := range f.relationships {
+				if r.ID == id && r.HolderID == holderID && (includeDeleted || r.DeletedAt == nil) {
+					clone := *r
+
+					return &clone, nil
+				}
+			}
+
+			return nil, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship)
+		}).AnyTimes()
+	ownershipRepo.EXPECT().Update(gomock.Any(), f.org, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, r *mmodel.HolderRelationship, change mmodel.HolderRelationshipChange) error {
+			for i, stored := range f.relationships {
+				if stored.ID == r.ID {
+					updated := *r
+					updated.History = append(append([]mmodel.HolderRelationshipChange{}, stored.History...), change)
+					f.relationships[i] = &updated
+
+					return nil
+				}
+			}
+
+			return pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship)
+		}).AnyTimes()
+
+	return f
+}
+
+// addHolder stores a holder of the given type and returns its id.
+func (f *ownershipFixture) addHolder(holderType, name string) uuid.UUID {
+	id := uuid.Must(libCommons.GenerateUUIDv7())
+	f.holders[id] = &mmodel.Holder{ID: &id, Type: &holderType, Name: &name}
+
+	return id
+}
+
+// own records that owner holds percentage of holder from 2025-01-01.
+func (f *ownershipFixture) own(t *testing.T, holderID, ownerID uuid.UUID, percentage float64) *mmodel.HolderRelationship {
+	t.Helper()
+
+	r, err := f.uc.CreateHolderRelationship(context.Background(), f.org, holderID, &mmodel.CreateHolderRelationshipInput{
+		OwnerID:       ownerID.String(),
+		Type:          mmodel.HolderRelationshipOwnership,
+		Percentage:    &percentage,
+		EffectiveFrom: date("2025-01-01"),
+		Actor:         "compliance",
+	})
+	require.NoError(t, err)
+
+	return r
+}
+
+func date(s string) *mmodel.Date {
+	t, _ := time.Parse(time.DateOnly, s)
+
+	return &mmodel.Date{Time: t}
+}
+
+func percent(p float64) *float64 { return &p }
+
+func TestCreateHolderRelationship_Validation(t *testing.T) {
+	f := newOwnershipFixture(t)
+	ctx := context.Background()
+
+	company := f.addHolder(mmodel.HolderTypeLegalPerson, "Acme Ltda")
+	parent := f.addHolder(mmodel.HolderTypeLegalPerson, "Acme Holding")
+	alice := f.addHolder(mmodel.HolderTypeNaturalPerson, "Alice")
+	bob := f.addHolder(mmodel.HolderTypeNaturalPerson, "Bob")
+
+	input := func(owner uuid.UUID, relationshipType string, percentage *float64) *mmodel.CreateHolderRelationshipInput {
+		return &mmodel.CreateHolderRelationshipInput{OwnerID: owner.String(), Type: relationshipType, Percentage: percentage, EffectiveFrom: date("2025-01-01"), Actor: "compliance"}
+	}
+
+	_, err := f.uc.CreateHolderRelationship(ctx, f.org, company, input(company, mmodel.HolderRelationshipOwnership, percent(10)))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipSelf, cn.EntityHolderRelationship), err)
+
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, alice, input(bob, mmodel.HolderRelationshipOwnership, percent(10)))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotLegalPerson, cn.EntityHolderRelationship, mmodel.HolderTypeNaturalPerson), err)
+
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, input(alice, mmodel.HolderRelationshipOwnership, nil))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipPercentage, cn.EntityHolderRelationship, mmodel.HolderRelationshipOwnership), err)
+
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, input(alice, mmodel.HolderRelationshipControl, percent(10)))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipPercentage, cn.EntityHolderRelationship, mmodel.HolderRelationshipControl), err)
+
+	ended := input(alice, mmodel.HolderRelationshipOwnership, percent(10))
+	ended.EffectiveTo = date("2025-01-01")
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, ended)
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipPeriodInvalid, cn.EntityHolderRelationship, "effectiveTo must be after effectiveFrom"), err)
+
+	created := f.own(t, company, alice, 60)
+	assert.Equal(t, mmodel.HolderTypeNaturalPerson, created.OwnerType)
+	require.Len(t, created.History, 1)
+	assert.Equal(t, mmodel.HolderRelationshipCreated, created.History[0].Action)
+
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, input(alice, mmodel.HolderRelationshipOwnership, percent(5)))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipAlreadyExists, cn.EntityHolderRelationship, mmodel.HolderRelationshipOwnership), err)
+
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, input(bob, mmodel.HolderRelationshipOwnership, percent(50)))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderOwnershipExceeded, cn.EntityHolderRelationship, "110", "2025-01-01"), err)
+
+	// A stake starting after Alice's ends fits.
+	_, err = f.uc.UpdateHolderRelationship(ctx, f.org, company, created.ID, &mmodel.UpdateHolderRelationshipInput{EffectiveTo: date("2026-01-01"), Actor: "compliance"})
+	require.NoError(t, err)
+
+	later := input(bob, mmodel.HolderRelationshipOwnership, percent(50))
+	later.EffectiveFrom = date("2026-01-01")
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, company, later)
+	require.NoError(t, err)
+
+	// Raising Alice's stake still breaks the 100% cap on her own period.
+	_, err = f.uc.UpdateHolderRelationship(ctx, f.org, company, created.ID, &mmodel.UpdateHolderRelationshipInput{EffectiveTo: &mmodel.Date{}, Actor: "compliance"})
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderOwnershipExceeded, cn.EntityHolderRelationship, "110", "2026-01-01"), err)
+
+	// parent owns company, so company cannot own parent.
+	f.own(t, company, parent, 40)
+	_, err = f.uc.CreateHolderRelationship(ctx, f.org, parent, input(company, mmodel.HolderRelationshipControl, nil))
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipCycle, cn.EntityHolderRelationship, company, parent), err)
+}
+
+func TestDeleteHolderRelationship(t *testing.T) {
+	f := newOwnershipFixture(t)
+	ctx := context.Background()
+
+	company := f.addHolder(mmodel.HolderTypeLegalPerson, "Acme Ltda")
+	alice := f.addHolder(mmodel.HolderTypeNaturalPerson, "Alice")
+
+	created := f.own(t, company, alice, 100)
+
+	err := f.uc.DeleteHolderRelationship(ctx, f.org, company, created.ID, "")
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "actor"), err)
+
+	require.NoError(t, f.uc.DeleteHolderRelationship(ctx, f.org, company, created.ID, "compliance"))
+
+	deleted, err := f.uc.GetHolderRelationship(ctx, f.org, company, created.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, deleted.DeletedAt)
+	require.Len(t, deleted.History, 2)
+	assert.Equal(t, mmodel.HolderRelationshipDeleted, deleted.History[1].Action)
+
+	live, err := f.uc.GetHolderRelationships(ctx, f.org, company, false)
+	require.NoError(t, err)
+	assert.Empty(t, live)
+
+	err = f.uc.DeleteHolderRelationship(ctx, f.org, company, created.ID, "compliance")
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotFound, cn.EntityHolderRelationship), err)
+
+	// The freed capital can be recorded again.
+	f.own(t, company, alice, 100)
+}
+
+func TestGetBeneficialOwners(t *testing.T) {
+	f := newOwnershipFixture(t)
+	ctx := context.Background()
+
+	company := f.addHolder(mmodel.HolderTypeLegalPerson, "Acme Ltda")
+	parent := f.addHolder(mmodel.HolderTypeLegalPerson, "Acme Holding")
+	fund := f.addHolder(mmodel.HolderTypeLegalPerson, "Offshore Fund")
+	alice := f.addHolder(mmodel.HolderTypeNaturalPerson, "Alice")
+	bob := f.addHolder(mmodel.HolderTypeNaturalPerson, "Bob")
+	carol := f.addHolder(mmodel.HolderTypeNaturalPerson, "Carol")
+	dave := f.addHolder(mmodel.HolderTypeNaturalPerson, "Dave")
+
+	// company: 60% parent, 30% bob, 10% fund (owners unknown).
+	// parent: 60% alice, 40% carol.
+	f.own(t, company, parent, 60)
+	f.own(t, company, bob, 30)
+	f.own(t, company, fund, 10)
+	f.own(t, parent, alice, 60)
+	carolStake := f.own(t, parent, carol, 40)
+
+	_, err := f.uc.CreateHolderRelationship(ctx, f.org, company, &mmodel.CreateHolderRelationshipInput{
+		OwnerID: dave.String(), Type: mmodel.HolderRelationshipControl, Description: "appoints the board",
+		EffectiveFrom: date("2025-01-01"), EffectiveTo: date("2025-07-01"), Actor: "compliance",
+	})
+	require.NoError(t, err)
+
+	result, err := f.uc.GetBeneficialOwners(ctx, f.org, company, *date("2025-03-01"), 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, mmodel.DefaultBeneficialOwnershipThreshold, result.Threshold)
+	assert.Equal(t, 10.0, result.UnattributedPercentage)
+	assert.False(t, result.CycleDetected)
+
+	// carol (24%) is below the threshold and controls nothing.
+	require.Len(t, result.Owners, 3)
+
+	assert.Equal(t, alice, result.Owners[0].HolderID)
+	assert.Equal(t, "Alice", result.Owners[0].Name)
+	assert.Equal(t, 36.0, result.Owners[0].Percentage)
+	assert.Equal(t, []string{mmodel.BeneficialOwnerByOwnership, mmodel.BeneficialOwnerByControl}, result.Owners[0].Reasons)
+	require.Len(t, result.Owners[0].Chains, 1)
+	assert.Equal(t, []uuid.UUID{alice, parent, company}, result.Owners[0].Chains[0].HolderIDs)
+
+	assert.Equal(t, bob, result.Owners[1].HolderID)
+	assert.Equal(t, []string{mmodel.BeneficialOwnerByOwnership}, result.Owners[1].Reasons)
+
+	assert.Equal(t, dave, result.Owners[2].HolderID)
+	assert.Equal(t, 0.0, result.Owners[2].Percentage)
+	assert.Equal(t, []string{mmodel.BeneficialOwnerByControl}, result.Owners[2].Reasons)
+
+	// After dave's control ended, with a lower threshold, carol is reported.
+	result, err = f.uc.GetBeneficialOwners(ctx, f.org, company, *date("2025-09-01"), 20)
+	require.NoError(t, err)
+	require.Len(t, result.Owners, 3)
+	assert.Equal(t, carol, result.Owners[2].HolderID)
+	assert.Equal(t, 24.0, result.Owners[2].Percentage)
+
+	// Before any relationship applies, nothing is attributed.
+	result, err = f.uc.GetBeneficialOwners(ctx, f.org, company, *date("2024-01-01"), 0)
+	require.NoError(t, err)
+	assert.Empty(t, result.Owners)
+	assert.Equal(t, 100.0, result.UnattributedPercentage)
+
+	// A cycle that slipped past validation is reported, not followed.
+	f.relationships = append(f.relationships, &mmodel.HolderRelationship{
+		ID: uuid.Must(libCommons.GenerateUUIDv7()), HolderID: parent, OwnerID: company, OwnerType: mmodel.HolderTypeLegalPerson,
+		Type: mmodel.HolderRelationshipControl, EffectiveFrom: *date("2025-01-01"),
+	})
+	require.NoError(t, f.uc.DeleteHolderRelationship(ctx, f.org, parent, carolStake.ID, "compliance"))
+
+	result, err = f.uc.GetBeneficialOwners(ctx, f.org, company, *date("2025-09-01"), 0)
+	require.NoError(t, err)
+	assert.True(t, result.CycleDetected)
+	assert.Equal(t, 34.0, result.UnattributedPercentage)
+
+	_, err = f.uc.GetBeneficialOwners(ctx, f.org, alice, mmodel.Date{}, 0)
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderRelationshipNotLegalPerson, cn.EntityHolderRelationship, mmodel.HolderTypeNaturalPerson), err)
+
+	_, err = f.uc.GetBeneficialOwners(ctx, f.org, company, mmodel.Date{}, 120)
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolderRelationship, "threshold"), err)
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// positionAssetKey identifies the balances of one asset within one ledger,
+// the granularity at which asset rates apply.
+type positionAssetKey struct {
+	ledgerID  uuid.UUID
+	assetCode string
+}
+
+// GetHolderPosition reads the consolidated position of a holder: every active
+// account it owns across the organization's ledgers with its balances per key
+// and asset, and the totals per asset. When asOf is set the balances are read
+// as they stood at that time. When referenceAsset is set the totals are also
+// converted into it with the current asset rates of each account's ledger;
+// assets a ledger has no rate for are reported rather than failing the read.
+func (uc *UseCase) GetHolderPosition(ctx context.Context, organizationID string, holderID uuid.UUID, asOf *time.Time, referenceAsset string) (*mmodel.HolderPosition, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_position")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.Bool("app.request.historical", asOf != nil),
+		attribute.String("app.request.reference_asset", referenceAsset),
+	)
+
+	now := time.Now().UTC()
+
+	if asOf != nil && asOf.After(now) {
+		err := pkg.ValidateBusinessError(cn.ErrInvalidTimestamp, cn.EntityHolder, asOf.Format(time.RFC3339))
+		recordSpanError(span, "Position timestamp is in the future", err)
+
+		return nil, err
+	}
+
+	if referenceAsset != "" && utils.ValidateCode(referenceAsset) != nil {
+		err := pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolder, "reference_asset")
+		recordSpanError(span, "Invalid reference asset", err)
+
+		return nil, err
+	}
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		err = pkg.ValidateBusinessError(cn.ErrInvalidPathParameter, cn.EntityHolder, "organizationId")
+		recordSpanError(span, "Invalid organization id", err)
+
+		return nil, err
+	}
+
+	if _, err := uc.HolderRepo.Find(ctx, organizationID, holderID, false); err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	accounts, err := uc.HolderPositions.ListAccountsByHolder(ctx, orgID, holderID)
+	if err != nil {
+		recordSpanError(span, "Failed to list holder accounts", err)
+
+		return nil, err
+	}
+
+	position := &mmodel.HolderPosition{
+		HolderID: holderID,
+		AsOf:     now,
+		Accounts: []*mmodel.HolderPositionAccount{},
+		Totals:   []*mmodel.HolderPositionTotal{},
+	}
+
+	if asOf != nil {
+		position.AsOf = asOf.UTC()
+		position.Historical = true
+	}
+
+	accounts = slices.DeleteFunc(slices.Clone(accounts), func(account *mmodel.Account) bool {
+		return !accountInPosition(account, asOf)
+	})
+
+	balances, err := uc.readPositionBalances(ctx, orgID, accounts, asOf)
+	if err != nil {
+		recordSpanError(span, "Failed to read holder balances", err)
+
+		return nil, err
+	}
+
+	byAccount := make(map[string]*mmodel.HolderPositionAccount, len(accounts))
+
+	for _, account := range accounts {
+		entry := &mmodel.HolderPositionAccount{
+			ID:        account.ID,
+			LedgerID:  account.LedgerID,
+			Name:      account.Name,
+			Alias:     account.Alias,
+			Type:      account.Type,
+			AssetCode: account.AssetCode,
+			Blocked:   account.Blocked != nil && *account.Blocked,
+			Balances:  []*mmodel.HolderPositionBalance{},
+		}
+
+		byAccount[account.ID] = entry
+		position.Accounts = append(position.Accounts, entry)
+	}
+
+	totals := make(map[string]*mmodel.HolderPositionTotal)
+	ledgerTotals := make(map[positionAssetKey]*mmodel.HolderPositionTotal)
+
+	for _, balance := range balances {
+		if balance == nil {
+			continue
+		}
+
+		entry, ok := byAccount[balance.AccountID]
+		if !ok {
+			continue
+		}
+
+		entry.Balances = append(entry.Balances, &mmodel.HolderPositionBalance{
+			Key:           balance.Key,
+			AssetCode:     balance.AssetCode,
+			Available:     balance.Available,
+			OnHold:        balance.OnHold,
+			OverdraftUsed: balance.OverdraftUsed,
+		})
+
+		addToPositionTotal(totals, balance.AssetCode, balance)
+
+		if ledgerID, err := uuid.Parse(entry.LedgerID); err == nil {
+			addToPositionTotal(ledgerTotals, positionAssetKey{ledgerID: ledgerID, assetCode: balance.AssetCode}, balance)
+		}
+	}
+
+	for _, entry := range position.Accounts {
+		slices.SortFunc(entry.Balances, func(a, b *mmodel.HolderPositionBalance) int {
+			return cmp.Or(cmp.Compare(a.Key, b.Key), cmp.Compare(a.AssetCode, b.AssetCode))
+		})
+	}
+
+	for _, total := range totals {
+		position.Totals = append(position.Totals, total)
+	}
+
+	slices.SortFunc(position.Totals, func(a, b *mmodel.HolderPositionTotal) int {
+		return cmp.Compare(a.AssetCode, b.AssetCode)
+	})
+
+	if referenceAsset != "" {
+		position.Converted, err = uc.convertHolderPosition(ctx, orgID, ledgerTotals, referenceAsset)
+		if err != nil {
+			recordSpanError(span, "Failed to convert holder position", err)
+
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("app.holder_position.accounts", len(position.Accounts)),
+		attribute.Int("app.holder_position.assets", len(position.Totals)),
+	)
+
+	return position, nil
+}
+
+// readPositionBalances reads the balances of the accounts: the current ones a
+// ledger at a time, or, for a historical position, each account's balances as
+// they stood at asOf.
+func (uc *UseCase) readPositionBalances(ctx context.Context, organizationID uuid.UUID, accounts []*mmodel.Account, asOf *time.Time) ([]*mmodel.Balance, error) {
+	ledgers, byLedger := groupAccountsByLedger(accounts)
+
+	balances := make([]*mmodel.Balance, 0, len(accounts))
+
+	for _, ledgerID := range ledgers {
+		if asOf == nil {
+			ledgerBalances, err := uc.HolderPositions.ListBalancesByAccounts(ctx, organizationID, ledgerID, byLedger[ledgerID])
+			if err != nil {
+				return nil, err
+			}
+
+			balances = append(balances, ledgerBalances...)
+
+			continue
+		}
+
+		for _, accountID := range byLedger[ledgerID] {
+			accountBalances, err := uc.HolderPositions.ListAccountBalancesAt(ctx, organizationID, ledgerID, accountID, *asOf)
+			if err != nil {
+				return nil, err
+			}
+
+			balances = append(balances, accountBalances...)
+		}
+	}
+
+	return balances, nil
+}
+
+// convertHolderPosition converts the per-ledger asset totals into
+// referenceAsset. Every ledger keeps its own rates, so each total is converted
+// with the rate of the ledger holding it.
+func (uc *UseCase) convertHolderPosition(ctx context.Context, organizationID uuid.UUID, ledgerTotals map[positionAssetKey]*mmodel.HolderPositionTotal, referenceAsset string) (*mmodel.HolderPositionConversion, error) {
+	converted := &mmodel.HolderPositionConversion{
+		HolderPositionTotal: mmodel.HolderPositionTotal{AssetCode: referenceAsset},
+		UnconvertedAssets:   []string{},
+	}
+
+	keys := make([]positionAssetKey, 0, len(ledgerTotals))
+	for key := range ledgerTotals {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b positionAssetKey) int {
+		return cmp.Or(cmp.Compare(a.ledgerID.String(), b.ledgerID.String()), cmp.Compare(a.assetCode, b.assetCode))
+	})
+
+	for _, key := range keys {
+		factor := decimal.NewFromInt(1)
+
+		if key.assetCode != referenceAsset {
+			rate, err := uc.HolderPositions.GetAssetRate(ctx, organizationID, key.ledgerID, key.assetCode, referenceAsset)
+			if err != nil {
+				return nil, err
+			}
+
+			if rate == nil {
+				if !slices.Contains(converted.UnconvertedAssets, key.assetCode) {
+					converted.UnconvertedAssets = append(converted.UnconvertedAssets, key.assetCode)
+				}
+
+				continue
+			}
+
+			factor = *rate
+		}
+
+		total := ledgerTotals[key]
+		converted.Available = converted.Available.Add(total.Available.Mul(factor))
+		converted.OnHold = converted.OnHold.Add(total.OnHold.Mul(factor))
+		converted.OverdraftUsed = converted.OverdraftUsed.Add(total.OverdraftUsed.Mul(factor))
+		converted.Net = converted.Net.Add(total.Net.Mul(factor))
+	}
+
+	slices.Sort(converted.UnconvertedAssets)
+
+	return converted, nil
+}
+
+// addToPositionTotal adds a balance to the total stored under key, creating
+// it on first use.
+func addToPositionTotal[K comparable](totals map[K]*mmodel.HolderPositionTotal, key K, balance *mmodel.Balance) {
+	total, ok := totals[key]
+	if !ok {
+		total = &mmodel.HolderPositionTotal{AssetCode: balance.AssetCode}
+		totals[key] = total
+	}
+
+	total.Available = total.Available.Add(balance.Available)
+	total.OnHold = total.OnHold.Add(balance.OnHold)
+	total.OverdraftUsed = total.OverdraftUsed.Add(balance.OverdraftUsed)
+	total.Net = total.Available.Add(total.OnHold).Sub(total.OverdraftUsed)
+}
+
+// accountInPosition reports whether an account belongs in a position read at
+// asOf (now when nil): it must exist and not be deleted at that time, so a
+// historical position keeps the accounts deleted since.
+func accountInPosition(account *mmodel.Account, asOf *time.Time) bool {
+	if account == nil {
+		return false
+	}
+
+	if asOf == nil {
+		return account.DeletedAt == nil
+	}
+
+	if account.CreatedAt.After(*asOf) {
+		return false
+	}
+
+	return account.DeletedAt == nil || account.DeletedAt.After(*asOf)
+}
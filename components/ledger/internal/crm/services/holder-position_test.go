@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeHolderPositions serves accounts, current and historical balances and
+// asset rates from memory.
+type fakeHolderPositions struct {
+	accounts   []*mmodel.Account
+	balances   map[uuid.UUID][]*mmodel.Balance
+	historical map[uuid.UUID][]*mmodel.Balance
+	// rates maps "ledger/from/to" to the conversion factor.
+	rates   map[string]decimal.Decimal
+	rateErr error
+	// readAt records the times historical balances were read at.
+	readAt []time.Time
+}
+
+func (f *fakeHolderPositions) ListAccountsByHolder(_ context.Context, _, _ uuid.UUID) ([]*mmodel.Account, error) {
+	return f.accounts, nil
+}
+
+func (f *fakeHolderPositions) ListBalancesByAccounts(_ context.Context, _, ledgerID uuid.UUID, _ []uuid.UUID) ([]*mmodel.Balance, error) {
+	return f.balances[ledgerID], nil
+}
+
+func (f *fakeHolderPositions) ListAccountBalancesAt(_ context.Context, _, _, accountID uuid.UUID, at time.Time) ([]*mmodel.Balance, error) {
+	f.readAt = append(f.readAt, at)
+
+	return f.historical[accountID], nil
+}
+
+func (f *fakeHolderPositions) GetAssetRate(_ context.Context, _, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error) {
+	if f.rateErr != nil {
+		return nil, f.rateErr
+	}
+
+	rate, ok := f.rates[ledgerID.String()+"/"+from+"/"+to]
+	if !ok {
+		return nil, nil
+	}
+
+	return &rate, nil
+}
+
+// positionFixture wires a UseCase over a mocked holder repository and the fake
+// position reader, with a holder owning accounts in two ledgers.
+type positionFixture struct {
+	uc                *UseCase
+	org               string
+	holderID          uuid.UUID
+	ledgerA, ledgerB  uuid.UUID
+	checking, savings uuid.UUID
+	wallet, closed    uuid.UUID
+	reader            *fakeHolderPositions
+}
+
+func newPositionFixture(t *testing.T) *positionFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+
+	newID := func() uuid.UUID { return uuid.Must(libCommons.GenerateUUIDv7()) }
+
+	f := &positionFixture{
+		org:      newID().String(),
+		holderID: newID(),
+		ledgerA:  newID(),
+		ledgerB:  newID(),
+		checking: newID(),
+		savings:  newID(),
+		wallet:   newID(),
+		closed:   newID(),
+	}
+
+	created := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	deleted := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	blocked := true
+
+	account := func(id, ledgerID uuid.UUID, asset string, createdAt time.Time) *mmodel.Account {
+		return &mmodel.Account{ID: id.String(), LedgerID: ledgerID.String(), Name: "Account " + asset, AssetCode: asset, Type: "deposit", CreatedAt: createdAt}
+	}
+
+	balance := func(accountID uuid.UUID, key, asset string, available, onHold, overdraft int64) *mmodel.Balance {
+		return &mmodel.Balance{
+			AccountID:     accountID.String(),
+			Key:           key,
+			AssetCode:     asset,
+			Available:     decimal.NewFromInt(available),
+			OnHold:        decimal.NewFromInt(onHold),
+			OverdraftUsed: decimal.NewFromInt(overdraft),
+		}
+	}
+
+	checking := account(f.checking, f.ledgerA, "BRL", created)
+	savings := account(f.savings, f.ledgerA, "USD", later)
+	savings.Blocked = &blocked
+	wallet := account(f.wallet, f.ledgerB, "BRL", created)
+	closed := account(f.closed, f.ledgerB, "BRL", created)
+	closed.DeletedAt = &deleted
+
+	f.reader = &fakeHolderPositions{
+		accounts: []*mmodel.Account{checking, savings, wallet, closed},
+		balances: map[uuid.UUID][]*mmodel.Balance{
+			f.ledgerA: {
+				balance(f.checking, "savings", "BRL", 300, 0, 0),
+				balance(f.checking, cn.DefaultBalanceKey, "BRL", 1000, 200, 0),
+				balance(f.savings, cn.DefaultBalanceKey, "USD", 50, 0, 0),
+			},
+			f.ledgerB: {
+				balance(f.wallet, cn.DefaultBalanceKey, "BRL", 0, 0, 100),
+			},
+		},
+		historical: map[uuid.UUID][]*mmodel.Balance{
+			f.checking: {balance(f.checking, cn.DefaultBalanceKey, "BRL", 400, 0, 0)},
+			f.wallet:   {balance(f.wallet, cn.DefaultBalanceKey, "BRL", 70, 30, 0)},
+			f.closed:   {balance(f.closed, cn.DefaultBalanceKey, "BRL", 25, 0, 0)},
+		},
+		rates: map[string]decimal.Decimal{
+			f.ledgerA.String() + "/BRL/USD": decimal.RequireFromString("0.2"),
+		},
+	}
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, f.holderID, false).Return(&mmodel.Holder{ID: &f.holderID}, nil).AnyTimes()
+
+	f.uc = &UseCase{HolderRepo: holderRepo, HolderPositions: f.reader}
+
+	return f
+}
+
+func TestGetHolderPosition_CurrentBalancesAndTotals(t *testing.T) {
+	f := newPositionFixture(t)
+
+	position, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, nil, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, f.holderID, position.HolderID)
+	assert.False(t, position.Historical)
+	assert.Nil(t, position.Converted)
+
+	require.Len(t, position.Accounts, 3, "the deleted account is left out")
+	assert.Equal(t, f.checking.String(), position.Accounts[0].ID)
+	require.Len(t, position.Accounts[0].Balances, 2)
+	assert.Equal(t, cn.DefaultBalanceKey, position.Accounts[0].Balances[0].Key, "balances are ordered by key")
+	assert.True(t, position.Accounts[1].Blocked)
+
+	require.Len(t, position.Totals, 2)
+	brl, usd := position.Totals[0], position.Totals[1]
+	assert.Equal(t, "BRL", brl.AssetCode)
+	assert.True(t, decimal.NewFromInt(1300).Equal(brl.Available))
+	assert.True(t, decimal.NewFromInt(200).Equal(brl.OnHold))
+	assert.True(t, decimal.NewFromInt(100).Equal(brl.OverdraftUsed))
+	assert.True(t, decimal.NewFromInt(1400).Equal(brl.Net))
+	assert.Equal(t, "USD", usd.AssetCode)
+	assert.True(t, decimal.NewFromInt(50).Equal(usd.Net))
+}
+
+func TestGetHolderPosition_ConvertsWithEachLedgersRates(t *testing.T) {
+	f := newPositionFixture(t)
+
+	position, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, nil, "USD")
+	require.NoError(t, err)
+	require.NotNil(t, position.Converted)
+
+	// Ledger A converts its BRL 1500 net at 0.2 and keeps its USD 50; ledger B
+	// has no BRL rate, so its BRL balances are left out and reported.
+	assert.Equal(t, "USD", position.Converted.AssetCode)
+	assert.True(t, decimal.NewFromInt(350).Equal(position.Converted.Net), position.Converted.Net.String())
+	assert.True(t, decimal.NewFromInt(310).Equal(position.Converted.Available), position.Converted.Available.String())
+	assert.Equal(t, []string{"BRL"}, position.Converted.UnconvertedAssets)
+}
+
+func TestGetHolderPosition_PointInTime(t *testing.T) {
+	f := newPositionFixture(t)
+
+	asOf := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	position, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, &asOf, "")
+	require.NoError(t, err)
+
+	assert.True(t, position.Historical)
+	assert.Equal(t, asOf, position.AsOf)
+	require.Len(t, position.Accounts, 2, "accounts opened after or deleted before as_of are left out")
+	assert.Equal(t, []time.Time{asOf, asOf}, f.reader.readAt)
+
+	require.Len(t, position.Totals, 1)
+	assert.True(t, decimal.NewFromInt(470).Equal(position.Totals[0].Available))
+	assert.True(t, decimal.NewFromInt(500).Equal(position.Totals[0].Net))
+}
+
+func TestGetHolderPosition_PointInTimeBeforeDeletion(t *testing.T) {
+	f := newPositionFixture(t)
+
+	asOf := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	position, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, &asOf, "")
+	require.NoError(t, err)
+
+	require.Len(t, position.Accounts, 3, "an account deleted after as_of was still open then")
+	assert.Equal(t, f.closed.String(), position.Accounts[2].ID)
+
+	require.Len(t, position.Totals, 1)
+	assert.True(t, decimal.NewFromInt(525).Equal(position.Totals[0].Net))
+}
+
+func TestGetHolderPosition_RejectsInvalidInput(t *testing.T) {
+	f := newPositionFixture(t)
+
+	future := time.Now().Add(time.Hour)
+
+	_, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, &future, "")
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInvalidTimestamp, cn.EntityHolder, future.Format(time.RFC3339)), err)
+
+	_, err = f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, nil, "usd")
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInvalidQueryParameter, cn.EntityHolder, "reference_asset"), err)
+}
+
+func TestGetHolderPosition_HolderNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+
+	org, holderID := uuid.NewString(), uuid.New()
+	notFound := pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+
+	holderRepo.EXPECT().Find(gomock.Any(), org, holderID, false).Return(nil, notFound).Times(1)
+
+	uc := &UseCase{HolderRepo: holderRepo, HolderPositions: &fakeHolderPositions{}}
+
+	_, err := uc.GetHolderPosition(context.Background(), org, holderID, nil, "")
+	assert.Equal(t, notFound, err)
+}
+
+func TestGetHolderPosition_RateReadFailure(t *testing.T) {
+	f := newPositionFixture(t)
+	f.reader.rateErr = errors.New("connection refused")
+
+	_, err := f.uc.GetHolderPosition(context.Background(), f.org, f.holderID, nil, "USD")
+	assert.EqualError(t, err, "connection refused")
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// HolderAccountReassigner is the port the holder merge uses to move the ledger
+// accounts of a duplicate holder to the surviving one.
+//
+// Like HolderLedgerReader it is defined here so CRM does not import the ledger
+// command package; bootstrap wires an adapter over the command use case.
+type HolderAccountReassigner interface {
+	// ReassignAccounts sets the holder of every account of fromHolderID in the
+	// organization, deleted accounts included, to toHolderID and returns how
+	// many accounts changed. Repeating the call changes nothing further.
+	ReassignAccounts(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// HolderLedgerReader is the port the holder data export uses to read the
+// ledger records of a holder: its accounts, their balances and operations.
+//
+// Like LedgerAccountReader it is defined here so CRM does not import the
+// ledger query package; bootstrap wires an adapter over the query use case.
+type HolderLedgerReader interface {
+	// ListAccountsByHolder returns every account the holder owns or owned in
+	// the organization, across ledgers and including deleted accounts.
+	ListAccountsByHolder(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error)
+	// ListBalancesByAccounts returns the balances of the given accounts of a
+	// ledger.
+	ListBalancesByAccounts(ctx context.Context, organizationID, ledgerID uuid.UUID, accountIDs []uuid.UUID) ([]*mmodel.Balance, error)
+	// ListAccountOperations returns one page of an account's operations,
+	// oldest first, and the cursor of the next page (empty on the last page).
+	ListAccountOperations(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, cursor string) ([]mmodel.HolderExportOperation, string, error)
+}
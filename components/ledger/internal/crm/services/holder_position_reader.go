@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HolderPositionReader is the port the holder position view uses to read a
+// holder's accounts, their current or historical balances and the ledgers'
+// asset rates.
+//
+// Like HolderLedgerReader it is defined here so CRM does not import the ledger
+// query package; bootstrap wires an adapter over the query use case.
+type HolderPositionReader interface {
+	// ListAccountsByHolder returns every account the holder owns or owned in
+	// the organization, across ledgers and including deleted accounts.
+	ListAccountsByHolder(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error)
+	// ListBalancesByAccounts returns the current balances of the given
+	// accounts of a ledger.
+	ListBalancesByAccounts(ctx context.Context, organizationID, ledgerID uuid.UUID, accountIDs []uuid.UUID) ([]*mmodel.Balance, error)
+	// ListAccountBalancesAt returns the balances of an account as they stood at
+	// the given time.
+	ListAccountBalancesAt(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, at time.Time) ([]*mmodel.Balance, error)
+	// GetAssetRate returns the ledger's factor converting an amount of from
+	// into to, or nil when the ledger has no such rate.
+	GetAssetRate(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// instrumentTransition describes one lifecycle transition: the statuses it
+// leaves from, the status it reaches, and the change it makes to the linked
+// ledger account when propagated.
+type instrumentTransition struct {
+	operation string
+	verb      string
+	from      []string
+	to        string
+	account   func(ctx context.Context, accounts InstrumentAccountLifecycle, organizationID, ledgerID, accountID uuid.UUID) error
+}
+
+var (
+	blockInstrumentTransition = instrumentTransition{
+		operation: "block_instrument",
+		verb:      "blocked",
+		from:      []string{mmodel.InstrumentStatusActive},
+		to:        mmodel.InstrumentStatusBlocked,
+		account: func(ctx context.Context, accounts InstrumentAccountLifecycle, organizationID, ledgerID, accountID uuid.UUID) error {
+			return accounts.SetAccountBlocked(ctx, organizationID, ledgerID, accountID, true)
+		},
+	}
+
+	unblockInstrumentTransition = instrumentTransition{
+		operation: "unblock_instrument",
+		verb:      "unblocked",
+		from:      []string{mmodel.InstrumentStatusBlocked},
+		to:        mmodel.InstrumentStatusActive,
+		account: func(ctx context.Context, accounts InstrumentAccountLifecycle, organizationID, ledgerID, accountID uuid.UUID) error {
+			return accounts.SetAccountBlocked(ctx, organizationID, ledgerID, accountID, false)
+		},
+	}
+
+	closeInstrumentTransition = instrumentTransition{
+		operation: "close_instrument",
+		verb:      "closed",
+		from:      []string{mmodel.InstrumentStatusActive, mmodel.InstrumentStatusBlocked},
+		to:        mmodel.InstrumentStatusClosed,
+		account:   closeInstrumentAccount,
+	}
+)
+
+// BlockInstrument blocks an ACTIVE instrument and, when propagated, blocks its
+// ledger account.
+func (uc *UseCase) BlockInstrument(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return uc.transitionInstrument(ctx, organizationID, holderID, id, blockInstrumentTransition, input)
+}
+
+// UnblockInstrument returns a BLOCKED instrument to ACTIVE and, when
+// propagated, unblocks its ledger account.
+func (uc *UseCase) UnblockInstrument(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return uc.transitionInstrument(ctx, organizationID, holderID, id, unblockInstrumentTransition, input)
+}
+
+// CloseInstrument closes an ACTIVE or BLOCKED instrument for good and stamps
+// its banking details' closing date when none was set. When propagated, the
+// ledger account must hold no funds in any balance and is soft-closed.
+func (uc *UseCase) CloseInstrument(ctx context.Context, organizationID string, holderID, id uuid.UUID, input *mmodel.InstrumentTransitionInput) (*mmodel.Instrument, error) {
+	return uc.transitionInstrument(ctx, organizationID, holderID, id, closeInstrumentTransition, input)
+}
+
+// transitionInstrument applies a lifecycle transition. The ledger account is
+// changed first, so a failed propagation leaves the instrument untouched and
+// the request can simply be retried: every account change is idempotent. The
+// lifecycle is then stored guarded by the revision it was read at, so
+// concurrent transitions of the same instrument cannot both succeed.
+func (uc *UseCase) transitionInstrument(ctx context.Context, organizationID string, holderID, id uuid.UUID, transition instrumentTransition, input *mmodel.InstrumentTransitionInput) (_ *mmodel.Instrument, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service."+transition.operation)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", transition.operation, start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", holderID.String()),
+		attribute.String("app.request.instrument_id", id.String()),
+	)
+
+	instrument, err := uc.InstrumentRepo.Find(ctx, organizationID, holderID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get instrument", err)
+
+		return nil, err
+	}
+
+	status := instrument.LifecycleStatus()
+	if !slices.Contains(transition.from, status) {
+		err = pkg.ValidateBusinessError(cn.ErrInstrumentTransitionInvalid, cn.EntityInstrument, status, transition.verb)
+		recordSpanError(span, "Instrument transition rejected", err)
+
+		return nil, err
+	}
+
+	propagate := uc.InstrumentAccounts != nil && (input.PropagateToAccount == nil || *input.PropagateToAccount)
+
+	if propagate {
+		if err := uc.propagateInstrumentTransition(ctx, organizationID, instrument, transition); err != nil {
+			recordSpanError(span, "Failed to apply instrument transition to ledger account", err)
+
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+
+	lifecycle := currentInstrumentLifecycle(instrument)
+	expectedRevision := lifecycle.Revision
+
+	lifecycle.History = append(lifecycle.History, mmodel.InstrumentTransition{
+		From:              lifecycle.Status,
+		To:                transition.to,
+		Actor:             input.Actor,
+		Reason:            input.Reason,
+		AccountPropagated: propagate,
+		CreatedAt:         now,
+	})
+	lifecycle.Status = transition.to
+	lifecycle.Revision = expectedRevision + 1
+	lifecycle.UpdatedAt = &now
+
+	var closingDate *time.Time
+
+	if transition.to == mmodel.InstrumentStatusClosed && (instrument.BankingDetails == nil || instrument.BankingDetails.ClosingDate == nil) {
+		date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		closingDate = &date
+	}
+
+	if err := uc.InstrumentRepo.UpdateLifecycle(ctx, organizationID, holderID, id, lifecycle, closingDate, expectedRevision); err != nil {
+		recordSpanError(span, "Failed to update instrument lifecycle", err)
+
+		return nil, err
+	}
+
+	instrument.Lifecycle = lifecycle
+	instrument.UpdatedAt = now
+
+	if closingDate != nil {
+		if instrument.BankingDetails == nil {
+			instrument.BankingDetails = &mmodel.BankingDetails{}
+		}
+
+		instrument.BankingDetails.ClosingDate = &mmodel.Date{Time: *closingDate}
+	}
+
+	span.SetAttributes(
+		attribute.String("app.instrument.status", transition.to),
+		attribute.Bool("app.instrument.account_propagated", propagate),
+	)
+
+	return instrument, nil
+}
+
+// propagateInstrumentTransition applies a transition to the instrument's
+// ledger account.
+func (uc *UseCase) propagateInstrumentTransition(ctx context.Context, organizationID string, instrument *mmodel.Instrument, transition instrumentTransition) error {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return pkg.ValidateBusinessError(cn.ErrInvalidPathParameter, cn.EntityInstrument, "organizationId")
+	}
+
+	ledgerID, err := uuid.Parse(derefString(instrument.LedgerID))
+	if err != nil {
+		return pkg.ValidateBusinessError(cn.ErrInvalidPathParameter, cn.EntityInstrument, "ledgerId")
+	}
+
+	accountID, err := uuid.Parse(derefString(instrument.AccountID))
+	if err != nil {
+		return pkg.ValidateBusinessError(cn.ErrInvalidPathParameter, cn.EntityInstrument, "accountId")
+	}
+
+	return transition.account(ctx, uc.InstrumentAccounts, orgID, ledgerID, accountID)
+}
+
+// closeInstrumentAccount soft-closes the ledger account once every balance is
+// empty. The ledger repeats the check when it deletes the balances, so funds
+// that arrive in between still block the close.
+func closeInstrumentAccount(ctx context.Context, accounts InstrumentAccountLifecycle, organizationID, ledgerID, accountID uuid.UUID) error {
+	balances, err := accounts.ListAccountBalances(ctx, organizationID, ledgerID, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, balance := range balances {
+		if balance != nil && (!balance.Available.IsZero() || !balance.OnHold.IsZero()) {
+			return pkg.ValidateBusinessError(cn.ErrInstrumentAccountBalanceNotZero, cn.EntityInstrument)
+		}
+	}
+
+	return accounts.CloseAccount(ctx, organizationID, ledgerID, accountID)
+}
+
+// currentInstrumentLifecycle returns a copy of the instrument's lifecycle
+// state, or a new ACTIVE state when the instrument has none.
+func currentInstrumentLifecycle(instrument *mmodel.Instrument) *mmodel.InstrumentLifecycle {
+	if instrument.Lifecycle == nil {
+		return &mmodel.InstrumentLifecycle{
+			Status:  mmodel.InstrumentStatusActive,
+			History: []mmodel.InstrumentTransition{},
+		}
+	}
+
+	lifecycle := *instrument.Lifecycle
+	lifecycle.History = slices.Clone(instrument.Lifecycle.History)
+
+	return &lifecycle
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeInstrumentAccounts records the changes made to a ledger account and
+// serves its balances from memory.
+type fakeInstrumentAccounts struct {
+	balances  []*mmodel.Balance
+	blocked   []bool
+	closed    int
+	accountID uuid.UUID
+	err       error
+}
+
+func (f *fakeInstrumentAccounts) SetAccountBlocked(_ context.Context, _, _, accountID uuid.UUID, blocked bool) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	f.accountID = accountID
+	f.blocked = append(f.blocked, blocked)
+
+	return nil
+}
+
+func (f *fakeInstrumentAccounts) ListAccountBalances(_ context.Context, _, _, _ uuid.UUID) ([]*mmodel.Balance, error) {
+	return f.balances, nil
+}
+
+func (f *fakeInstrumentAccounts) CloseAccount(_ context.Context, _, _, accountID uuid.UUID) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	f.accountID = accountID
+	f.closed++
+
+	return nil
+}
+
+// lifecycleFixture wires a UseCase over a mocked instrument repository that
+// stores one instrument's lifecycle in memory.
+type lifecycleFixture struct {
+	uc         *UseCase
+	org        string
+	holderID   uuid.UUID
+	accountID  uuid.UUID
+	instrument *mmodel.Instrument
+	accounts   *fakeInstrumentAccounts
+	repo       *instrument.MockRepository
+	closing    *time.Time
+}
+
+func newLifecycleFixture(t *testing.T) *lifecycleFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := instrument.NewMockRepository(ctrl)
+
+	id := uuid.Must(libCommons.GenerateUUIDv7())
+	ledgerID := uuid.Must(libCommons.GenerateUUIDv7()).String()
+
+	f := &lifecycleFixture{
+		org:       uuid.Must(libCommons.GenerateUUIDv7()).String(),
+		holderID:  uuid.Must(libCommons.GenerateUUIDv7()),
+		accountID: uuid.Must(libCommons.GenerateUUIDv7()),
+		accounts:  &fakeInstrumentAccounts{},
+		repo:      repo,
+	}
+
+	accountID := f.accountID.String()
+
+	f.instrument = &mmodel.Instrument{
+		ID:        &id,
+		LedgerID:  &ledgerID,
+		AccountID: &accountID,
+		HolderID:  &f.holderID,
+		CreatedAt: time.Now().Add(-24 * time.Hour),
+	}
+
+	f.uc = &UseCase{
+		InstrumentRepo:     repo,
+		InstrumentAccounts: f.accounts,
+	}
+
+	repo.EXPECT().Find(gomock.Any(), f.org, f.holderID, id, false).DoAndReturn(
+		func(context.Context, string, uuid.UUID, uuid.UUID, bool) (*mmodel.Instrument, error) {
+			stored := *f.instrument
+
+			return &stored, nil
+		}).AnyTimes()
+
+	repo.EXPECT().UpdateLifecycle(gomock.Any(), f.org, f.holderID, id, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _, _ uuid.UUID, lifecycle *mmodel.InstrumentLifecycle, closingDate *time.Time, expectedRevision int64) error {
+			if f.instrument.Lifecycle == nil && expectedRevision != 0 || f.instrument.Lifecycle != nil && f.instrument.Lifecycle.Revision != expectedRevision {
+				return pkg.ValidateBusinessError(cn.ErrInstrumentLifecycleConflict, cn.EntityInstrument)
+			}
+
+			f.instrument.Lifecycle = lifecycle
+			f.closing = closingDate
+
+			return nil
+		}).AnyTimes()
+
+	return f
+}
+
+func (f *lifecycleFixture) input() *mmodel.InstrumentTransitionInput {
+	return &mmodel.InstrumentTransitionInput{Actor: "operations@example.com", Reason: "card reported stolen"}
+}
+
+func TestBlockInstrument_BlocksLinkedAccount(t *testing.T) {
+	f := newLifecycleFixture(t)
+
+	result, err := f.uc.BlockInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	assert.Equal(t, []bool{true}, f.accounts.blocked)
+	assert.Equal(t, f.accountID, f.accounts.accountID)
+
+	require.NotNil(t, result.Lifecycle)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, result.Lifecycle.Status)
+	assert.Equal(t, int64(1), result.Lifecycle.Revision)
+	require.Len(t, result.Lifecycle.History, 1)
+	assert.Equal(t, mmodel.InstrumentStatusActive, result.Lifecycle.History[0].From)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, result.Lifecycle.History[0].To)
+	assert.Equal(t, "card reported stolen", result.Lifecycle.History[0].Reason)
+	assert.True(t, result.Lifecycle.History[0].AccountPropagated)
+	assert.Nil(t, f.closing)
+}
+
+func TestBlockInstrument_AlreadyBlocked(t *testing.T) {
+	f := newLifecycleFixture(t)
+	ctx := context.Background()
+
+	_, err := f.uc.BlockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	_, err = f.uc.BlockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInstrumentTransitionInvalid, cn.EntityInstrument, mmodel.InstrumentStatusBlocked, "blocked"), err)
+	assert.Equal(t, []bool{true}, f.accounts.blocked)
+}
+
+func TestUnblockInstrument_UnblocksLinkedAccount(t *testing.T) {
+	f := newLifecycleFixture(t)
+	ctx := context.Background()
+
+	_, err := f.uc.UnblockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInstrumentTransitionInvalid, cn.EntityInstrument, mmodel.InstrumentStatusActive, "unblocked"), err)
+
+	_, err = f.uc.BlockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	result, err := f.uc.UnblockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	assert.Equal(t, []bool{true, false}, f.accounts.blocked)
+	assert.Equal(t, mmodel.InstrumentStatusActive, result.Lifecycle.Status)
+	assert.Equal(t, int64(2), result.Lifecycle.Revision)
+	assert.Len(t, result.Lifecycle.History, 2)
+}
+
+func TestCloseInstrument_ClosesEmptyAccount(t *testing.T) {
+	f := newLifecycleFixture(t)
+	f.accounts.balances = []*mmodel.Balance{{Key: "default"}, {Key: "savings"}}
+	ctx := context.Background()
+
+	_, err := f.uc.BlockInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	result, err := f.uc.CloseInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, f.accounts.closed)
+	assert.Equal(t, mmodel.InstrumentStatusClosed, result.Lifecycle.Status)
+	require.NotNil(t, f.closing)
+	require.NotNil(t, result.BankingDetails)
+	require.NotNil(t, result.BankingDetails.ClosingDate)
+	assert.True(t, f.closing.Equal(result.BankingDetails.ClosingDate.Time))
+
+	_, err = f.uc.CloseInstrument(ctx, f.org, f.holderID, *f.instrument.ID, f.input())
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInstrumentTransitionInvalid, cn.EntityInstrument, mmodel.InstrumentStatusClosed, "closed"), err)
+	assert.Equal(t, 1, f.accounts.closed)
+}
+
+func TestCloseInstrument_KeepsClosingDate(t *testing.T) {
+	f := newLifecycleFixture(t)
+	closingDate := mmodel.Date{Time: time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)}
+	f.instrument.BankingDetails = &mmodel.BankingDetails{ClosingDate: &closingDate}
+
+	result, err := f.uc.CloseInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	assert.Nil(t, f.closing)
+	assert.Equal(t, closingDate, *result.BankingDetails.ClosingDate)
+}
+
+func TestCloseInstrument_AccountHoldsFunds(t *testing.T) {
+	for name, balance := range map[string]*mmodel.Balance{
+		"available": {Key: "default", Available: decimal.NewFromInt(10)},
+		"on hold":   {Key: "default", OnHold: decimal.NewFromInt(5)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := newLifecycleFixture(t)
+			f.accounts.balances = []*mmodel.Balance{{Key: "savings"}, balance}
+
+			_, err := f.uc.CloseInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+
+			assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInstrumentAccountBalanceNotZero, cn.EntityInstrument), err)
+			assert.Zero(t, f.accounts.closed)
+			assert.Nil(t, f.instrument.Lifecycle)
+		})
+	}
+}
+
+func TestTransitionInstrument_PropagationOptOut(t *testing.T) {
+	f := newLifecycleFixture(t)
+	f.accounts.balances = []*mmodel.Balance{{Key: "default", Available: decimal.NewFromInt(10)}}
+
+	input := f.input()
+	input.PropagateToAccount = new(bool)
+
+	result, err := f.uc.CloseInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, input)
+	require.NoError(t, err)
+
+	assert.Zero(t, f.accounts.closed)
+	assert.Equal(t, mmodel.InstrumentStatusClosed, result.Lifecycle.Status)
+	assert.False(t, result.Lifecycle.History[0].AccountPropagated)
+}
+
+func TestTransitionInstrument_PropagationDisabled(t *testing.T) {
+	f := newLifecycleFixture(t)
+	f.uc.InstrumentAccounts = nil
+
+	result, err := f.uc.BlockInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+	require.NoError(t, err)
+
+	assert.Empty(t, f.accounts.blocked)
+	assert.Equal(t, mmodel.InstrumentStatusBlocked, result.Lifecycle.Status)
+	assert.False(t, result.Lifecycle.History[0].AccountPropagated)
+}
+
+func TestTransitionInstrument_AccountFailureLeavesInstrument(t *testing.T) {
+	f := newLifecycleFixture(t)
+	f.accounts.err = errors.New("ledger unavailable")
+
+	_, err := f.uc.BlockInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+
+	assert.EqualError(t, err, "ledger unavailable")
+	assert.Nil(t, f.instrument.Lifecycle)
+}
+
+func TestTransitionInstrument_InvalidAccountReference(t *testing.T) {
+	f := newLifecycleFixture(t)
+	legacy := "legacy-account"
+	f.instrument.AccountID = &legacy
+
+	_, err := f.uc.BlockInstrument(context.Background(), f.org, f.holderID, *f.instrument.ID, f.input())
+
+	assert.Equal(t, pkg.ValidateBusinessError(cn.ErrInvalidPathParameter, cn.EntityInstrument, "accountId"), err)
+	assert.Empty(t, f.accounts.blocked)
+}
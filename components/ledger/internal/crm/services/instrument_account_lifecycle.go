@@ -0,0 +1,27 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// InstrumentAccountLifecycle is the port instrument lifecycle transitions use
+// to apply themselves to the linked ledger account.
+//
+// Like LedgerAccountReader it is defined here so CRM does not import the
+// ledger command or query packages; bootstrap wires an adapter over both.
+type InstrumentAccountLifecycle interface {
+	// SetAccountBlocked blocks or unblocks the account.
+	SetAccountBlocked(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, blocked bool) error
+	// ListAccountBalances returns the balances of the account.
+	ListAccountBalances(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID) ([]*mmodel.Balance, error)
+	// CloseAccount soft-deletes the account and its balances. An account that
+	// is already deleted is reported as closed.
+	CloseAccount(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID) error
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/screening"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultHolderDuplicateMinScore is the score from which a holder is reported
+// as a possible duplicate when the request sets no minimum.
+const DefaultHolderDuplicateMinScore = 0.5
+
+// holderDuplicateCandidateLimit bounds the holders read back from the search
+// tokens when looking for duplicates of one holder.
+const holderDuplicateCandidateLimit = 50
+
+// Weights of the duplicate signals. A shared document alone does not reach 1:
+// the same document under a different name is more often a typo than the same
+// customer. The name weighs its similarity, so near-identical names add almost
+// the full weight.
+const (
+	duplicateDocumentWeight = 0.6
+	duplicateEmailWeight    = 0.25
+	duplicatePhoneWeight    = 0.25
+	duplicateNameWeight     = 0.3
+)
+
+// GetHolderDuplicateCandidates returns the active holders of the same type that
+// share the holder's document, primary email or mobile phone and score at least
+// minScore, best first. Matches are read through the blind-index search tokens
+// and confirmed on the decrypted values; the name similarity then adds to the
+// score of each candidate.
+func (uc *UseCase) GetHolderDuplicateCandidates(ctx context.Context, organizationID string, id uuid.UUID, minScore float64) ([]*mmodel.HolderDuplicateCandidate, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_duplicate_candidates")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+		attribute.Float64("app.request.min_score", minScore),
+	)
+
+	target, err := uc.HolderRepo.Find(ctx, organizationID, id, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	found, err := uc.HolderRepo.FindDuplicateCandidates(ctx, organizationID, target, holderDuplicateCandidateLimit)
+	if err != nil {
+		recordSpanError(span, "Failed to find duplicate holder candidates", err)
+
+		return nil, err
+	}
+
+	candidates := make([]*mmodel.HolderDuplicateCandidate, 0, len(found))
+
+	for _, other := range found {
+		if other == nil || other.ID == nil || holderType(other) != holderType(target) {
+			continue
+		}
+
+		candidate := scoreHolderDuplicate(target, other)
+		if candidate != nil && candidate.Score >= minScore {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	span.SetAttributes(attribute.Int("app.response.candidate_count", len(candidates)))
+
+	return candidates, nil
+}
+
+// MergeHolder merges the duplicate holder of the input into the surviving
+// holder survivorID: the duplicate's accounts and instruments move to the
+// survivor, instrument related-party entries naming the duplicate are renamed
+// to the survivor, and the duplicate is tombstoned so reads of its id point to
+// the survivor. Both holders must be of the same type, and the duplicate must
+// not own or be owned by other holders, as those relationships need a
+// decision of their own.
+//
+// The merge record is written first and completed last, so a merge
+// interrupted midway is resumed by repeating the request; repeating a
+// completed merge returns it unchanged.
+func (uc *UseCase) MergeHolder(ctx context.Context, organizationID string, survivorID uuid.UUID, input *mmodel.MergeHolderInput) (_ *mmodel.HolderMerge, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.merge_holder")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "crm", "merge_holder", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", survivorID.String()),
+		attribute.String("app.request.duplicate_id", input.DuplicateID),
+	)
+
+	duplicateID, err := uuid.Parse(input.DuplicateID)
+	if err != nil {
+		err = pkg.ValidateBusinessError(cn.ErrInvalidRequestBody, cn.EntityHolderMerge, "duplicateId")
+		recordSpanError(span, "Invalid duplicate id", err)
+
+		return nil, err
+	}
+
+	if duplicateID == survivorID {
+		err = pkg.ValidateBusinessError(cn.ErrHolderMergeSelf, cn.EntityHolderMerge)
+		recordSpanError(span, "Holder cannot be merged into itself", err)
+
+		return nil, err
+	}
+
+	survivor, err := uc.HolderRepo.Find(ctx, organizationID, survivorID, false)
+	if err != nil {
+		recordSpanError(span, "Failed to get surviving holder", err)
+
+		return nil, err
+	}
+
+	merge, err := uc.MergeRepo.FindByDuplicate(ctx, organizationID, duplicateID)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder merge", err)
+
+		return nil, err
+	}
+
+	if merge != nil {
+		if merge.SurvivorID != survivorID {
+			err = pkg.ValidateBusinessError(cn.ErrHolderMergeConflict, cn.EntityHolderMerge, duplicateID, merge.SurvivorID)
+			recordSpanError(span, "Holder already merged into another holder", err)
+
+			return nil, err
+		}
+
+		if merge.Status == mmodel.HolderMergeCompleted {
+			return merge, nil
+		}
+	}
+
+	// A resumed merge may have tombstoned the duplicate already.
+	duplicate, err := uc.HolderRepo.Find(ctx, organizationID, duplicateID, merge != nil)
+	if err != nil {
+		recordSpanError(span, "Failed to get duplicate holder", err)
+
+		return nil, err
+	}
+
+	if err = uc.checkHolderMergeable(ctx, organizationID, survivor, duplicate); err != nil {
+		recordSpanError(span, "Holders cannot be merged", err)
+
+		return nil, err
+	}
+
+	if merge == nil {
+		merge = &mmodel.HolderMerge{
+			ID:          uuid.Must(libCommons.GenerateUUIDv7()),
+			SurvivorID:  survivorID,
+			DuplicateID: duplicateID,
+			Status:      mmodel.HolderMergeInProgress,
+			Actor:       input.Actor,
+			Reason:      input.Reason,
+			CreatedAt:   time.Now().UTC(),
+		}
+
+		if err = uc.MergeRepo.Create(ctx, organizationID, merge); err != nil {
+			recordSpanError(span, "Failed to create holder merge", err)
+
+			return nil, err
+		}
+	}
+
+	if err = uc.repointHolderRecords(ctx, organizationID, merge, survivor, duplicate); err != nil {
+		recordSpanError(span, "Failed to merge holder records", err)
+
+		return nil, err
+	}
+
+	if duplicate.DeletedAt == nil {
+		if err = uc.HolderRepo.MarkMerged(ctx, organizationID, duplicateID, survivorID, time.Now().UTC()); err != nil {
+			recordSpanError(span, "Failed to tombstone duplicate holder", err)
+
+			return nil, err
+		}
+	}
+
+	completedAt := time.Now().UTC()
+	merge.Status = mmodel.HolderMergeCompleted
+	merge.CompletedAt = &completedAt
+
+	if err = uc.MergeRepo.Update(ctx, organizationID, merge); err != nil {
+		recordSpanError(span, "Failed to complete holder merge", err)
+
+		return nil, err
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Merged duplicate holder",
+		libLog.String("holder_id", survivorID.String()),
+		libLog.String("duplicate_id", duplicateID.String()),
+		libLog.Int("accounts", int(merge.Counts.Accounts)),
+		libLog.Int("instruments", int(merge.Counts.Instruments)))
+
+	return merge, nil
+}
+
+// GetHolderMerges returns the duplicates merged into the holder, newest first.
+func (uc *UseCase) GetHolderMerges(ctx context.Context, organizationID string, id uuid.UUID) ([]*mmodel.HolderMerge, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_holder_merges")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.holder_id", id.String()),
+	)
+
+	if _, err := uc.HolderRepo.Find(ctx, organizationID, id, false); err != nil {
+		recordSpanError(span, "Failed to get holder", err)
+
+		return nil, err
+	}
+
+	merges, err := uc.MergeRepo.FindAll(ctx, organizationID, id)
+	if err != nil {
+		recordSpanError(span, "Failed to get holder merges", err)
+
+		return nil, err
+	}
+
+	return merges, nil
+}
+
+// checkHolderMergeable rejects merging holders of different types and
+// duplicates with live ownership or control relationships on either side.
+func (uc *UseCase) checkHolderMergeable(ctx context.Context, organizationID string, survivor, duplicate *mmodel.Holder) error {
+	if holderType(survivor) != holderType(duplicate) {
+		return pkg.ValidateBusinessError(cn.ErrHolderMergeTypeMismatch, cn.EntityHolderMerge, holderType(duplicate), holderType(survivor))
+	}
+
+	owned, err := uc.OwnershipRepo.FindByHolders(ctx, organizationID, []uuid.UUID{*duplicate.ID}, false)
+	if err != nil {
+		return err
+	}
+
+	owning, err := uc.OwnershipRepo.FindByOwner(ctx, organizationID, *duplicate.ID, false)
+	if err != nil {
+		return err
+	}
+
+	if len(owned)+len(owning) > 0 {
+		return pkg.ValidateBusinessError(cn.ErrHolderMergeHasRelationships, cn.EntityHolderMerge, *duplicate.ID)
+	}
+
+	return nil
+}
+
+// repointHolderRecords moves the duplicate's accounts and instruments to the
+// survivor and renames the related-party entries naming the duplicate,
+// accumulating the counts on merge. Every step is idempotent, so a resumed
+// merge repeats them all.
+func (uc *UseCase) repointHolderRecords(ctx context.Context, organizationID string, merge *mmodel.HolderMerge, survivor, duplicate *mmodel.Holder) error {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := uc.HolderAccounts.ReassignAccounts(ctx, orgID, merge.DuplicateID, merge.SurvivorID)
+	if err != nil {
+		return err
+	}
+
+	merge.Counts.Accounts += accounts
+
+	instruments, err := uc.InstrumentRepo.ReassignHolder(ctx, organizationID, merge.DuplicateID, merge.SurvivorID)
+	if err != nil {
+		return err
+	}
+
+	merge.Counts.Instruments += instruments
+
+	// An instrument changed while it was moved is left behind; the merge stays
+	// IN_PROGRESS so repeating the request moves it.
+	left, err := uc.InstrumentRepo.Count(ctx, organizationID, merge.DuplicateID)
+	if err != nil {
+		return err
+	}
+
+	if left > 0 {
+		return pkg.ValidateInternalError(errors.New("instruments changed while the holder was merged"), cn.EntityHolderMerge)
+	}
+
+	duplicateDocument, survivorDocument := derefString(duplicate.Document), derefString(survivor.Document)
+	if duplicateDocument != "" && duplicateDocument != survivorDocument {
+		parties, err := uc.InstrumentRepo.ReassignRelatedParties(ctx, organizationID, duplicateDocument, survivorDocument, derefString(survivor.Name))
+		if err != nil {
+			return err
+		}
+
+		merge.Counts.RelatedParties += parties
+	}
+
+	return nil
+}
+
+// scoreHolderDuplicate scores other as a duplicate of target on the signals
+// both share, or returns nil when they share no exact signal: a stale search
+// token can read back a holder whose values no longer match.
+func scoreHolderDuplicate(target, other *mmodel.Holder) *mmodel.HolderDuplicateCandidate {
+	var (
+		score     float64
+		matchedOn []string
+	)
+
+	if doc := derefString(target.Document); doc != "" && doc == derefString(other.Document) {
+		score += duplicateDocumentWeight
+		matchedOn = append(matchedOn, mmodel.HolderDuplicateByDocument)
+	}
+
+	var targetContact, otherContact mmodel.Contact
+	if target.Contact != nil {
+		targetContact = *target.Contact
+	}
+
+	if other.Contact != nil {
+		otherContact = *other.Contact
+	}
+
+	if email := holder.NormalizeEmail(targetContact.PrimaryEmail); email != "" && email == holder.NormalizeEmail(otherContact.PrimaryEmail) {
+		score += duplicateEmailWeight
+		matchedOn = append(matchedOn, mmodel.HolderDuplicateByEmail)
+	}
+
+	if phone := holder.NormalizePhone(targetContact.MobilePhone); phone != "" && phone == holder.NormalizePhone(otherContact.MobilePhone) {
+		score += duplicatePhoneWeight
+		matchedOn = append(matchedOn, mmodel.HolderDuplicateByPhone)
+	}
+
+	if len(matchedOn) == 0 {
+		return nil
+	}
+
+	similarity := screening.NameSimilarity(derefString(target.Name), derefString(other.Name))
+	score += duplicateNameWeight * similarity
+
+	if similarity >= screening.DefaultMatchThreshold {
+		matchedOn = append(matchedOn, mmodel.HolderDuplicateByName)
+	}
+
+	return &mmodel.HolderDuplicateCandidate{
+		HolderID:       *other.ID,
+		ExternalID:     other.ExternalID,
+		Name:           other.Name,
+		Score:          min(1, score),
+		NameSimilarity: similarity,
+		MatchedOn:      matchedOn,
+	}
+}
+
+// derefString returns the value of an optional string, or "".
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
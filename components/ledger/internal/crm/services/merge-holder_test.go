@@ -0,0 +1,328 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/merge"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeAccountReassigner records account reassignments and reports a fixed
+// number of moved accounts the first time a holder is reassigned.
+type fakeAccountReassigner struct {
+	accounts int64
+	calls    int
+}
+
+func (f *fakeAccountReassigner) ReassignAccounts(_ context.Context, _, _, _ uuid.UUID) (int64, error) {
+	f.calls++
+
+	if f.calls > 1 {
+		return 0, nil
+	}
+
+	return f.accounts, nil
+}
+
+// mergeFixture wires a UseCase over mocked repositories that behave like an
+// in-memory store of holders, relationships and merges.
+type mergeFixture struct {
+	uc            *UseCase
+	org           string
+	holders       map[uuid.UUID]*mmodel.Holder
+	relationships []*mmodel.HolderRelationship
+	merges        map[uuid.UUID]*mmodel.HolderMerge
+	accounts      *fakeAccountReassigner
+	instruments   *instrument.MockRepository
+	holderRepo    *holder.MockRepository
+}
+
+func newMergeFixture(t *testing.T) *mergeFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	holderRepo := holder.NewMockRepository(ctrl)
+	instrumentRepo := instrument.NewMockRepository(ctrl)
+	ownershipRepo := ownership.NewMockRepository(ctrl)
+	mergeRepo := merge.NewMockRepository(ctrl)
+
+	f := &mergeFixture{
+		org:         uuid.Must(libCommons.GenerateUUIDv7()).String(),
+		holders:     map[uuid.UUID]*mmodel.Holder{},
+		merges:      map[uuid.UUID]*mmodel.HolderMerge{},
+		accounts:    &fakeAccountReassigner{accounts: 2},
+		instruments: instrumentRepo,
+		holderRepo:  holderRepo,
+	}
+
+	f.uc = &UseCase{
+		HolderRepo:     holderRepo,
+		InstrumentRepo: instrumentRepo,
+		OwnershipRepo:  ownershipRepo,
+		MergeRepo:      mergeRepo,
+		HolderAccounts: f.accounts,
+	}
+
+	holderRepo.EXPECT().Find(gomock.Any(), f.org, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, id uuid.UUID, includeDeleted bool) (*mmodel.Holder, error) {
+			if h, ok := f.holders[id]; ok && (includeDeleted || h.DeletedAt == nil) {
+				return h, nil
+			}
+
+			return nil, pkg.ValidateBusinessError(cn.ErrHolderNotFound, cn.EntityHolder)
+		}).AnyTimes()
+	holderRepo.EXPECT().MarkMerged(gomock.Any(), f.org, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, id, survivorID uuid.UUID, at time.Time) error {
+			h := f.holders[id]
+			h.MergedInto, h.MergedAt, h.DeletedAt = &survivorID, &at, &at
+
+			return nil
+		}).AnyTimes()
+	ownershipRepo.EXPECT().FindByHolders(gomock.Any(), f.org, gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, _ string, ids []uuid.UUID, _ bool) ([]*mmodel.HolderRelationship, error) {
+			var out []*mmodel.HolderRelationship
+
+			for _, r := range f.relationships {
+				if r.HolderID == ids[0] {
+					out = append(out, r)
+				}
+			}
+
+			return out, nil
+		}).AnyTimes()
+	ownershipRepo.EXPECT().FindByOwner(gomock.Any(), f.org, gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, _ string, ownerID uuid.UUID, _ bool) ([]*mmodel.HolderRelationship, error) {
+			var out []*mmodel.HolderRelationship
+
+			for _, r := range f.relationships {
+				if r.OwnerID == ownerID {
+					out = append(out, r)
+				}
+			}
+
+			return out, nil
+		}).AnyTimes()
+	mergeRepo.EXPECT().FindByDuplicate(gomock.Any(), f.org, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, id uuid.UUID) (*mmodel.HolderMerge, error) {
+			if m, ok := f.merges[id]; ok {
+				clone := *m
+
+				return &clone, nil
+			}
+
+			return nil, nil
+		}).AnyTimes()
+	mergeRepo.EXPECT().Create(gomock.Any(), f.org, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, m *mmodel.HolderMerge) error {
+			clone := *m
+			f.merges[m.DuplicateID] = &clone
+
+			return nil
+		}).AnyTimes()
+	mergeRepo.EXPECT().Update(gomock.Any(), f.org, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, m *mmodel.HolderMerge) error {
+			clone := *m
+			f.merges[m.DuplicateID] = &clone
+
+			return nil
+		}).AnyTimes()
+
+	return f
+}
+
+// addHolder stores a natural-person holder with the given name, document and
+// primary email.
+func (f *mergeFixture) addHolder(name, document, email string) *mmodel.Holder {
+	id := uuid.Must(libCommons.GenerateUUIDv7())
+	holderType := mmodel.HolderTypeNaturalPerson
+
+	h := &mmodel.Holder{
+		ID:       &id,
+		Type:     &holderType,
+		Name:     &name,
+		Document: &document,
+		Contact:  &mmodel.Contact{PrimaryEmail: &email},
+	}
+	f.holders[id] = h
+
+	return h
+}
+
+// expectInstrumentsMoved expects the instrument steps of a merge of duplicate
+// into survivor, with left instruments still found on the duplicate after the
+// move.
+func (f *mergeFixture) expectInstrumentsMoved(survivor, duplicate *mmodel.Holder, moved, left int64) {
+	f.instruments.EXPECT().ReassignHolder(gomock.Any(), f.org, *duplicate.ID, *survivor.ID).Return(moved, nil)
+	f.instruments.EXPECT().Count(gomock.Any(), f.org, *duplicate.ID).Return(left, nil)
+}
+
+func TestGetHolderDuplicateCandidates(t *testing.T) {
+	f := newMergeFixture(t)
+
+	target := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+	sameEmail := f.addHolder("Silva, Jose da", "11111111111", " JOSE@example.com ")
+	sameDocument := f.addHolder("Maria Souza", "91315026015", "maria@example.com")
+	stale := f.addHolder("Pedro Alves", "22222222222", "pedro@example.com")
+
+	legalPerson := mmodel.HolderTypeLegalPerson
+	otherType := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+	otherType.Type = &legalPerson
+
+	f.holderRepo.EXPECT().FindDuplicateCandidates(gomock.Any(), f.org, target, gomock.Any()).
+		Return([]*mmodel.Holder{sameEmail, sameDocument, stale, otherType}, nil)
+
+	candidates, err := f.uc.GetHolderDuplicateCandidates(context.Background(), f.org, *target.ID, DefaultHolderDuplicateMinScore)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2, "holders of another type and stale token matches are left out")
+
+	assert.Equal(t, *sameDocument.ID, candidates[0].HolderID, "a shared document outranks a shared email")
+	assert.Equal(t, []string{mmodel.HolderDuplicateByDocument}, candidates[0].MatchedOn)
+
+	assert.Equal(t, *sameEmail.ID, candidates[1].HolderID)
+	assert.Equal(t, []string{mmodel.HolderDuplicateByEmail, mmodel.HolderDuplicateByName}, candidates[1].MatchedOn)
+	assert.InDelta(t, 1, candidates[1].NameSimilarity, 1e-9)
+	assert.InDelta(t, 0.55, candidates[1].Score, 1e-9)
+}
+
+func TestGetHolderDuplicateCandidates_MinScore(t *testing.T) {
+	f := newMergeFixture(t)
+
+	target := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+	sameEmail := f.addHolder("Maria Souza", "11111111111", "jose@example.com")
+
+	f.holderRepo.EXPECT().FindDuplicateCandidates(gomock.Any(), f.org, target, gomock.Any()).
+		Return([]*mmodel.Holder{sameEmail}, nil)
+
+	candidates, err := f.uc.GetHolderDuplicateCandidates(context.Background(), f.org, *target.ID, DefaultHolderDuplicateMinScore)
+	require.NoError(t, err)
+	assert.Empty(t, candidates, "a shared email under an unrelated name scores below the default minimum")
+}
+
+func TestMergeHolder(t *testing.T) {
+	f := newMergeFixture(t)
+
+	survivor := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+	duplicate := f.addHolder("Jose Silva", "91315026016", "jose@example.com")
+
+	f.expectInstrumentsMoved(survivor, duplicate, 1, 0)
+	f.instruments.EXPECT().ReassignRelatedParties(gomock.Any(), f.org, "91315026016", "91315026015", "José da Silva").Return(int64(3), nil)
+
+	merged, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, &mmodel.MergeHolderInput{
+		DuplicateID: duplicate.ID.String(),
+		Actor:       "compliance@example.com",
+		Reason:      "Same customer registered by two channels",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, mmodel.HolderMergeCompleted, merged.Status)
+	assert.NotNil(t, merged.CompletedAt)
+	assert.Equal(t, mmodel.HolderMergeCounts{Accounts: 2, Instruments: 1, RelatedParties: 3}, merged.Counts)
+	assert.Equal(t, mmodel.HolderMergeCompleted, f.merges[*duplicate.ID].Status)
+
+	require.NotNil(t, duplicate.MergedInto)
+	assert.Equal(t, *survivor.ID, *duplicate.MergedInto)
+	assert.NotNil(t, duplicate.DeletedAt)
+
+	// A repeated request returns the completed merge unchanged.
+	again, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, &mmodel.MergeHolderInput{
+		DuplicateID: duplicate.ID.String(),
+		Actor:       "compliance@example.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, merged.ID, again.ID)
+	assert.Equal(t, 1, f.accounts.calls)
+}
+
+func TestMergeHolder_ResumesInterruptedMerge(t *testing.T) {
+	f := newMergeFixture(t)
+
+	survivor := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+	duplicate := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+
+	// The first attempt stops while an instrument is being changed.
+	f.expectInstrumentsMoved(survivor, duplicate, 1, 1)
+
+	input := &mmodel.MergeHolderInput{DuplicateID: duplicate.ID.String(), Actor: "compliance@example.com"}
+
+	_, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, input)
+	require.Error(t, err)
+	assert.Equal(t, mmodel.HolderMergeInProgress, f.merges[*duplicate.ID].Status)
+	assert.Nil(t, duplicate.MergedInto, "the duplicate is only tombstoned once its records moved")
+
+	f.expectInstrumentsMoved(survivor, duplicate, 1, 0)
+
+	merged, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, input)
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.HolderMergeCompleted, merged.Status)
+	require.NotNil(t, duplicate.MergedInto)
+}
+
+func TestMergeHolder_Rejections(t *testing.T) {
+	t.Run("self", func(t *testing.T) {
+		f := newMergeFixture(t)
+		h := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+
+		_, err := f.uc.MergeHolder(context.Background(), f.org, *h.ID, &mmodel.MergeHolderInput{DuplicateID: h.ID.String(), Actor: "a"})
+		assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderMergeSelf, cn.EntityHolderMerge), err)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		f := newMergeFixture(t)
+		survivor := f.addHolder("Acme", "12345678000190", "ops@acme.example")
+		duplicate := f.addHolder("Acme", "12345678000190", "ops@acme.example")
+
+		legalPerson := mmodel.HolderTypeLegalPerson
+		survivor.Type = &legalPerson
+
+		_, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, &mmodel.MergeHolderInput{DuplicateID: duplicate.ID.String(), Actor: "a"})
+		assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderMergeTypeMismatch, cn.EntityHolderMerge, mmodel.HolderTypeNaturalPerson, mmodel.HolderTypeLegalPerson), err)
+		assert.Empty(t, f.merges)
+	})
+
+	t.Run("duplicate owns another holder", func(t *testing.T) {
+		f := newMergeFixture(t)
+		survivor := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+		duplicate := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+
+		f.relationships = append(f.relationships, &mmodel.HolderRelationship{
+			HolderID: uuid.Must(libCommons.GenerateUUIDv7()),
+			OwnerID:  *duplicate.ID,
+		})
+
+		_, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, &mmodel.MergeHolderInput{DuplicateID: duplicate.ID.String(), Actor: "a"})
+		assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderMergeHasRelationships, cn.EntityHolderMerge, *duplicate.ID), err)
+	})
+
+	t.Run("already merged into another holder", func(t *testing.T) {
+		f := newMergeFixture(t)
+		survivor := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+		duplicate := f.addHolder("José da Silva", "91315026015", "jose@example.com")
+		otherSurvivor := uuid.Must(libCommons.GenerateUUIDv7())
+
+		f.merges[*duplicate.ID] = &mmodel.HolderMerge{
+			ID:          uuid.Must(libCommons.GenerateUUIDv7()),
+			SurvivorID:  otherSurvivor,
+			DuplicateID: *duplicate.ID,
+			Status:      mmodel.HolderMergeInProgress,
+		}
+
+		_, err := f.uc.MergeHolder(context.Background(), f.org, *survivor.ID, &mmodel.MergeHolderInput{DuplicateID: duplicate.ID.String(), Actor: "a"})
+		assert.Equal(t, pkg.ValidateBusinessError(cn.ErrHolderMergeConflict, cn.EntityHolderMerge, *duplicate.ID, otherSurvivor), err)
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+)
+
+// HolderScreener screens holders and related parties against the
+// organization's watch lists, raising alerts for compliance review, and
+// records the subjects whose screening failed as pending. It is satisfied by
+// screening.Service.
+type HolderScreener interface {
+	ScreenHolder(ctx context.Context, organizationID string, h *mmodel.Holder) error
+	ScreenRelatedParties(ctx context.Context, organizationID string, holderID, instrumentID uuid.UUID, parties []*mmodel.RelatedParty) error
+}
+
+// screenHolder screens a holder after it was stored. Screening raises alerts
+// but never blocks onboarding: the screener records a holder whose screening
+// failed as pending, listed for compliance and retried in the background, so
+// errors are only logged here.
+func (uc *UseCase) screenHolder(ctx context.Context, organizationID string, h *mmodel.Holder) {
+	if uc.Screener == nil || h == nil || h.ID == nil {
+		return
+	}
+
+	if err := uc.Screener.ScreenHolder(ctx, organizationID, h); err != nil {
+		logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+		logger.Log(ctx, libLog.LevelWarn, "Failed to screen holder, recorded as pending",
+			libLog.String("holder_id", h.ID.String()), libLog.Err(err))
+	}
+}
+
+// screenRelatedParties screens the related parties of an instrument after it
+// was stored, with the same best-effort contract as screenHolder.
+func (uc *UseCase) screenRelatedParties(ctx context.Context, organizationID string, i *mmodel.Instrument) {
+	if uc.Screener == nil || i == nil || i.ID == nil || i.HolderID == nil || len(i.RelatedParties) == 0 {
+		return
+	}
+
+	if err := uc.Screener.ScreenRelatedParties(ctx, organizationID, *i.HolderID, *i.ID, i.RelatedParties); err != nil {
+		logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+		logger.Log(ctx, libLog.LevelWarn, "Failed to screen related parties, recorded as pending",
+			libLog.String("instrument_id", i.ID.String()), libLog.Err(err))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeScreener records the subjects it was asked to screen and fails with err.
+type fakeScreener struct {
+	holders []uuid.UUID
+	parties []uuid.UUID
+	err     error
+}
+
+func (f *fakeScreener) ScreenHolder(_ context.Context, _ string, h *mmodel.Holder) error {
+	f.holders = append(f.holders, *h.ID)
+
+	return f.err
+}
+
+func (f *fakeScreener) ScreenRelatedParties(_ context.Context, _ string, _, _ uuid.UUID, parties []*mmodel.RelatedParty) error {
+	for _, p := range parties {
+		f.parties = append(f.parties, *p.ID)
+	}
+
+	return f.err
+}
+
+func TestCreateHolder_ScreensCreatedHolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := holder.NewMockRepository(ctrl)
+
+	holderID := uuid.New()
+	name, document := "John Smith", "90217469051"
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&mmodel.Holder{ID: &holderID, Name: &name, Document: &document}, nil).Times(2)
+
+	screener := &fakeScreener{}
+	uc := &UseCase{HolderRepo: mockRepo, Screener: screener}
+
+	_, err := uc.CreateHolder(context.Background(), uuid.NewString(), &mmodel.CreateHolderInput{Name: name, Document: document})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{holderID}, screener.holders)
+
+	// A screening failure never fails the onboarding.
+	screener.err = errors.New("watch lists unavailable")
+
+	created, err := uc.CreateHolder(context.Background(), uuid.NewString(), &mmodel.CreateHolderInput{Name: name, Document: document})
+	require.NoError(t, err)
+	assert.Equal(t, holderID, *created.ID)
+	assert.Len(t, screener.holders, 2)
+}
+
+func TestScreenRelatedParties(t *testing.T) {
+	holderID, instrumentID, partyID := uuid.New(), uuid.New(), uuid.New()
+	screener := &fakeScreener{}
+	uc := &UseCase{Screener: screener}
+
+	uc.screenRelatedParties(context.Background(), uuid.NewString(), &mmodel.Instrument{ID: &instrumentID, HolderID: &holderID})
+	assert.Empty(t, screener.parties, "instruments without related parties are not screened")
+
+	uc.screenRelatedParties(context.Background(), uuid.NewString(), &mmodel.Instrument{
+		ID:             &instrumentID,
+		HolderID:       &holderID,
+		RelatedParties: []*mmodel.RelatedParty{{ID: &partyID, Name: "Jane Roe", Document: "12345678"}},
+	})
+	assert.Equal(t, []uuid.UUID{partyID}, screener.parties)
+
+	// A nil screener disables screening.
+	(&UseCase{}).screenRelatedParties(context.Background(), uuid.NewString(), &mmodel.Instrument{ID: &instrumentID})
+}
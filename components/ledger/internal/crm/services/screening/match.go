@@ -0,0 +1,356 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultMatchThreshold is the name score from which a screening raises an
+// alert. It favors recall: reviewers dismiss false positives, while a missed
+// match is a compliance breach.
+const DefaultMatchThreshold = 0.90
+
+// blockPrefixLength is the token prefix used to select candidate names. A
+// name is only scored against entries sharing at least one token prefix, so a
+// typo in the first letters of every token goes unnoticed.
+const blockPrefixLength = 3
+
+// minDocumentLength skips document numbers too short to identify anyone.
+const minDocumentLength = 5
+
+// legalSuffixes are company-form tokens ignored by name matching, so
+// "ACME TRADING LTD" matches "Acme Trading Limited".
+var legalSuffixes = map[string]bool{
+	"ag": true, "bv": true, "co": true, "company": true, "corp": true, "corporation": true,
+	"gmbh": true, "inc": true, "incorporated": true, "jsc": true, "limited": true, "llc": true,
+	"llp": true, "lp": true, "ltd": true, "ltda": true, "nv": true, "ojsc": true, "ooo": true,
+	"pjsc": true, "plc": true, "sa": true, "sas": true, "sl": true, "spa": true, "srl": true,
+	"zao": true,
+}
+
+// diacritics folds accented letters to their base letter ("José" → "Jose").
+var diacritics = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// normalizeName reduces a name to its matching tokens: diacritics folded,
+// lowercased, dotted abbreviations joined ("S.A." → "sa"), other punctuation
+// treated as a separator and legal-form suffixes dropped. Token order is kept
+// out of scoring, which also covers the "LAST, First" form of the lists.
+func normalizeName(name string) []string {
+	folded, _, err := transform.String(diacritics, name)
+	if err != nil {
+		folded = name
+	}
+
+	folded = strings.ToLower(strings.ReplaceAll(folded, ".", ""))
+
+	tokens := strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := tokens[:0]
+
+	for _, t := range tokens {
+		if !legalSuffixes[t] {
+			kept = append(kept, t)
+		}
+	}
+
+	// A name made only of legal-form tokens is matched as written.
+	if len(kept) == 0 {
+		return tokens
+	}
+
+	return kept
+}
+
+// normalizeDocument keeps the letters and digits of a document number,
+// uppercased, so "123.456.789-00" matches "12345678900".
+func normalizeDocument(document string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToUpper(document) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// NameSimilarity scores two names between 0 and 1 after normalizing them as
+// the screening does, so "JOSÉ DA SILVA" and "Silva, Jose da" score 1.
+func NameSimilarity(a, b string) float64 {
+	return nameScore(normalizeName(a), normalizeName(b))
+}
+
+// nameScore scores two normalized names between 0 and 1 as the better of the
+// Jaro-Winkler similarity of their sorted tokens and their token-set score.
+func nameScore(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	return max(jaroWinkler(sortedJoin(a), sortedJoin(b)), tokenSetScore(a, b))
+}
+
+// tokenSetScore averages, in both directions, the best Jaro-Winkler match of
+// each token among the other name's tokens. A missing middle name costs a
+// little; a name that only shares a surname scores well below the threshold.
+func tokenSetScore(a, b []string) float64 {
+	return (bestTokenAverage(a, b) + bestTokenAverage(b, a)) / 2
+}
+
+func bestTokenAverage(from, to []string) float64 {
+	var total float64
+
+	for _, x := range from {
+		var best float64
+
+		for _, y := range to {
+			best = max(best, jaroWinkler(x, y))
+		}
+
+		total += best
+	}
+
+	return total / float64(len(from))
+}
+
+// sortedJoin joins a copy of the tokens in lexical order.
+func sortedJoin(tokens []string) string {
+	sorted := append([]string(nil), tokens...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, " ")
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b, between 0 and 1.
+func jaroWinkler(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	window := max(len(ra), len(rb))/2 - 1
+	window = max(window, 0)
+
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+
+	for i := range ra {
+		lo, hi := max(0, i-window), min(len(rb), i+window+1)
+
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+
+		for !matchedB[j] {
+			j++
+		}
+
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// ListEntries are the entries of one watch-list version.
+type ListEntries struct {
+	Name    string
+	Version int64
+	Entries []mmodel.WatchListEntry
+}
+
+// Match is a hit of a screened name or document against a list entry.
+type Match struct {
+	ListName    string
+	ListVersion int64
+	Entry       *mmodel.WatchListEntry
+	Field       string
+	Score       float64
+}
+
+// indexedEntry is a list entry with its list identification.
+type indexedEntry struct {
+	listName    string
+	listVersion int64
+	entry       mmodel.WatchListEntry
+}
+
+// indexedName is a normalized primary name or alias of an entry.
+type indexedName struct {
+	entry  int
+	tokens []string
+}
+
+// Index is an in-memory matcher over the entries of an organization's watch
+// lists. It is immutable once built and safe for concurrent use.
+type Index struct {
+	entries    []indexedEntry
+	names      []indexedName
+	byPrefix   map[string][]int
+	byDocument map[string][]int
+}
+
+// NewIndex indexes the names, aliases and documents of the given lists.
+func NewIndex(lists []ListEntries) *Index {
+	ix := &Index{
+		byPrefix:   make(map[string][]int),
+		byDocument: make(map[string][]int),
+	}
+
+	for _, list := range lists {
+		for _, e := range list.Entries {
+			entry := len(ix.entries)
+			ix.entries = append(ix.entries, indexedEntry{listName: list.Name, listVersion: list.Version, entry: e})
+
+			for _, name := range append([]string{e.Name}, e.Aliases...) {
+				tokens := normalizeName(name)
+				if len(tokens) == 0 {
+					continue
+				}
+
+				n := len(ix.names)
+				ix.names = append(ix.names, indexedName{entry: entry, tokens: tokens})
+
+				for _, p := range tokenPrefixes(tokens) {
+					ix.byPrefix[p] = append(ix.byPrefix[p], n)
+				}
+			}
+
+			for _, document := range e.Documents {
+				if d := normalizeDocument(document); len(d) >= minDocumentLength {
+					ix.byDocument[d] = append(ix.byDocument[d], entry)
+				}
+			}
+		}
+	}
+
+	return ix
+}
+
+// Len returns the number of indexed entries.
+func (ix *Index) Len() int {
+	return len(ix.entries)
+}
+
+// Screen matches a name and a document against the index. Names match when
+// their score reaches threshold; documents match exactly. Each entry appears
+// at most once per field, with its best name score.
+func (ix *Index) Screen(name, document string, threshold float64) []Match {
+	var matches []Match
+
+	if tokens := normalizeName(name); len(tokens) > 0 {
+		best := make(map[int]float64)
+		scored := make(map[int]bool)
+
+		for _, p := range tokenPrefixes(tokens) {
+			for _, n := range ix.byPrefix[p] {
+				if scored[n] {
+					continue
+				}
+
+				scored[n] = true
+
+				candidate := ix.names[n]
+				if score := nameScore(tokens, candidate.tokens); score >= threshold && score > best[candidate.entry] {
+					best[candidate.entry] = score
+				}
+			}
+		}
+
+		for entry, score := range best {
+			matches = append(matches, ix.match(entry, mmodel.ScreeningFieldName, score))
+		}
+	}
+
+	if d := normalizeDocument(document); len(d) >= minDocumentLength {
+		for _, entry := range ix.byDocument[d] {
+			matches = append(matches, ix.match(entry, mmodel.ScreeningFieldDocument, 1))
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+
+		if matches[i].ListName != matches[j].ListName {
+			return matches[i].ListName < matches[j].ListName
+		}
+
+		return matches[i].Entry.SourceID < matches[j].Entry.SourceID
+	})
+
+	return matches
+}
+
+func (ix *Index) match(entry int, field string, score float64) Match {
+	e := &ix.entries[entry]
+
+	return Match{ListName: e.listName, ListVersion: e.listVersion, Entry: &e.entry, Field: field, Score: score}
+}
+
+// tokenPrefixes returns the distinct blocking prefixes of the tokens.
+func tokenPrefixes(tokens []string) []string {
+	out := make([]string, 0, len(tokens))
+	seen := make(map[string]bool, len(tokens))
+
+	for _, t := range tokens {
+		p := t
+		if r := []rune(t); len(r) > blockPrefixLength {
+			p = string(r[:blockPrefixLength])
+		}
+
+		if !seen[p] {
+			seen[p] = true
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, []string{"jose", "maria", "gonzalez"}, normalizeName("José-María GONZÁLEZ"))
+	assert.Equal(t, []string{"acme", "trading"}, normalizeName("ACME Trading Co., Ltd."))
+	assert.Equal(t, []string{"banco", "nacional", "de", "cuba"}, normalizeName("BANCO NACIONAL DE CUBA, S.A."))
+	assert.Equal(t, []string{"ltd"}, normalizeName("LTD"))
+	assert.Empty(t, normalizeName(" - "))
+}
+
+func TestJaroWinkler(t *testing.T) {
+	assert.InDelta(t, 1.0, jaroWinkler("martha", "martha"), 1e-9)
+	assert.InDelta(t, 0.9611, jaroWinkler("martha", "marhta"), 1e-4)
+	assert.InDelta(t, 0.8133, jaroWinkler("dixon", "dicksonx"), 1e-4)
+	assert.InDelta(t, 0.0, jaroWinkler("abc", "xyz"), 1e-9)
+	assert.InDelta(t, 0.0, jaroWinkler("", "xyz"), 1e-9)
+}
+
+func TestIndex_Screen(t *testing.T) {
+	index := NewIndex([]ListEntries{
+		{Name: "ofac-sdn", Version: 3, Entries: []mmodel.WatchListEntry{
+			{SourceID: "2674", Name: "ABU MARZOOK, Mousa Mohammed", Aliases: []string{"ABU-MARZOUK, Musa"}, Documents: []string{"92/664"}},
+			{SourceID: "36", Name: "AEROCARIBBEAN AIRLINES", Programs: []string{"CUBA"}},
+		}},
+		{Name: "internal", Version: 1, Entries: []mmodel.WatchListEntry{
+			{SourceID: "7", Name: "Fulano de Tal", Documents: []string{"123.456.789-00", "X1"}},
+		}},
+	})
+
+	require.Equal(t, 3, index.Len())
+
+	t.Run("reordered name with accents and a missing middle name", func(t *testing.T) {
+		matches := index.Screen("Moúsa Abu Marzook", "", DefaultMatchThreshold)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "2674", matches[0].Entry.SourceID)
+		assert.Equal(t, mmodel.ScreeningFieldName, matches[0].Field)
+		assert.Equal(t, int64(3), matches[0].ListVersion)
+		assert.GreaterOrEqual(t, matches[0].Score, DefaultMatchThreshold)
+	})
+
+	t.Run("alias with a typo", func(t *testing.T) {
+		matches := index.Screen("Musa Abu Marzuk", "", DefaultMatchThreshold)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "2674", matches[0].Entry.SourceID)
+	})
+
+	t.Run("legal form suffix is ignored", func(t *testing.T) {
+		matches := index.Screen("Aerocaribbean Airlines Ltd.", "", DefaultMatchThreshold)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "36", matches[0].Entry.SourceID)
+		assert.InDelta(t, 1.0, matches[0].Score, 1e-9)
+	})
+
+	t.Run("shared surname only does not match", func(t *testing.T) {
+		assert.Empty(t, index.Screen("Ahmed Marzook", "", DefaultMatchThreshold))
+	})
+
+	t.Run("document matches exactly after normalization", func(t *testing.T) {
+		matches := index.Screen("Someone Else", "12345678900", DefaultMatchThreshold)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "internal", matches[0].ListName)
+		assert.Equal(t, mmodel.ScreeningFieldDocument, matches[0].Field)
+		assert.InDelta(t, 1.0, matches[0].Score, 1e-9)
+	})
+
+	t.Run("short documents are not indexed", func(t *testing.T) {
+		assert.Empty(t, index.Screen("Someone Else", "X1", DefaultMatchThreshold))
+	})
+
+	t.Run("name and document hits are both reported", func(t *testing.T) {
+		matches := index.Screen("Fulano de Tal", "123.456.789-00", DefaultMatchThreshold)
+		require.Len(t, matches, 2)
+		assert.ElementsMatch(t, []string{mmodel.ScreeningFieldName, mmodel.ScreeningFieldDocument},
+			[]string{matches[0].Field, matches[1].Field})
+	})
+}
+
+func TestIndex_Empty(t *testing.T) {
+	index := NewIndex(nil)
+
+	assert.Equal(t, 0, index.Len())
+	assert.Empty(t, index.Screen("Anyone", "12345678900", DefaultMatchThreshold))
+}
@@ -0,0 +1,463 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+)
+
+// ofacNull is the OFAC CSV placeholder for an empty field.
+const ofacNull = "-0-"
+
+// OFAC SDN CSV columns (sdn.csv has no header row).
+const (
+	ofacColEntNum  = 0
+	ofacColName    = 1
+	ofacColType    = 2
+	ofacColProgram = 3
+	ofacColRemarks = 11
+	ofacMinColumns = 4
+)
+
+// ofacAliasPattern extracts the a.k.a. names of an OFAC remarks field, e.g.
+// "a.k.a. 'AERO-CARIBBEAN'; ".
+var ofacAliasPattern = regexp.MustCompile(`(?i)(?:a\.k\.a\.|f\.k\.a\.|n\.k\.a\.)\s+'([^']+)'`)
+
+// ofacDocumentPattern extracts identity document numbers of an OFAC remarks
+// field, e.g. "Passport A1234567 (Venezuela)" or "Tax ID No. 12345678-9".
+var ofacDocumentPattern = regexp.MustCompile(`(?i)(?:Passport|National ID No\.|Tax ID No\.|Cedula No\.|Identification Number|Registration ID|Registration Number|D\.N\.I\.|C\.U\.R\.P\.|R\.F\.C\.|NIT #|RUC #|SSN|Citizen's Card Number|Residency Number)\s+([A-Za-z0-9][A-Za-z0-9./-]{3,})`)
+
+// Parse reads a watch-list file of the given format. Malformed files yield
+// ErrWatchListParseFailed; the reason names the position of the problem but
+// never echoes file content.
+func Parse(format string, data []byte) ([]mmodel.WatchListEntry, error) {
+	var (
+		entries []mmodel.WatchListEntry
+		err     error
+	)
+
+	switch format {
+	case mmodel.WatchListFormatOFACSDNCSV:
+		entries, err = parseOFACCSV(data)
+	case mmodel.WatchListFormatOFACSDNXML:
+		entries, err = parseOFACXML(data)
+	case mmodel.WatchListFormatUNXML:
+		entries, err = parseUNXML(data)
+	case mmodel.WatchListFormatCustomCSV:
+		entries, err = parseCustomCSV(data)
+	default:
+		return nil, pkg.ValidateBusinessError(cn.ErrWatchListFormatInvalid, cn.EntityWatchList, format)
+	}
+
+	if err != nil {
+		return nil, pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, format, err.Error())
+	}
+
+	return entries, nil
+}
+
+// parseOFACCSV reads the OFAC SDN list in its CSV distribution (sdn.csv):
+// ent_num, SDN_Name, SDN_Type, Program, Title, Call_Sign, Vess_type, Tonnage,
+// GRT, Vess_flag, Vess_owner, Remarks. Aliases and documents are only
+// available inside the remarks.
+func parseOFACCSV(data []byte) ([]mmodel.WatchListEntry, error) {
+	reader := newCSVReader(data)
+
+	var entries []mmodel.WatchListEntry
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, csvError(err)
+		}
+
+		// sdn.csv ends with a lone SUB (0x1A) control character.
+		if len(record) == 1 && strings.Trim(record[0], "\x1a \t") == "" {
+			continue
+		}
+
+		if len(record) < ofacMinColumns {
+			return nil, fmt.Errorf("line %d has %d columns, expected at least %d", line, len(record), ofacMinColumns)
+		}
+
+		name := ofacField(record[ofacColName])
+		if name == "" {
+			return nil, fmt.Errorf("line %d has no name", line)
+		}
+
+		entry := mmodel.WatchListEntry{
+			SourceID:   ofacField(record[ofacColEntNum]),
+			EntityType: ofacEntityType(ofacField(record[ofacColType])),
+			Name:       name,
+			Programs:   splitOFACPrograms(ofacField(record[ofacColProgram])),
+		}
+
+		if entry.SourceID == "" {
+			entry.SourceID = strconv.Itoa(line)
+		}
+
+		if len(record) > ofacColRemarks {
+			remarks := ofacField(record[ofacColRemarks])
+			entry.Aliases = submatches(ofacAliasPattern, remarks)
+			entry.Documents = submatches(ofacDocumentPattern, remarks)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ofacField trims a CSV field and maps the OFAC null placeholder to "".
+func ofacField(value string) string {
+	value = strings.TrimSpace(value)
+	if value == ofacNull {
+		return ""
+	}
+
+	return value
+}
+
+// ofacEntityType maps an OFAC SDN_Type to an entry type. OFAC leaves the type
+// empty for entities; vessels and aircraft are screened as entities too.
+func ofacEntityType(sdnType string) string {
+	if strings.EqualFold(sdnType, "individual") {
+		return mmodel.WatchListEntityIndividual
+	}
+
+	return mmodel.WatchListEntityOrganization
+}
+
+// splitOFACPrograms splits an OFAC program field such as "SDGT] [IRGC".
+func splitOFACPrograms(value string) []string {
+	var programs []string
+
+	for _, p := range strings.Split(value, "] [") {
+		if p = strings.Trim(p, "[] "); p != "" {
+			programs = append(programs, p)
+		}
+	}
+
+	return programs
+}
+
+// submatches returns the distinct first capture groups of pattern in text.
+func submatches(pattern *regexp.Regexp, text string) []string {
+	var out []string
+
+	seen := make(map[string]bool)
+
+	for _, m := range pattern.FindAllStringSubmatch(text, -1) {
+		value := strings.TrimRight(strings.TrimSpace(m[1]), ".;,")
+		if value != "" && !seen[value] {
+			seen[value] = true
+			out = append(out, value)
+		}
+	}
+
+	return out
+}
+
+// ofacSDNList is the OFAC SDN list in its XML distribution (sdn.xml).
+type ofacSDNList struct {
+	Entries []struct {
+		UID       string   `xml:"uid"`
+		FirstName string   `xml:"firstName"`
+		LastName  string   `xml:"lastName"`
+		SDNType   string   `xml:"sdnType"`
+		Programs  []string `xml:"programList>program"`
+		IDs       []struct {
+			IDNumber string `xml:"idNumber"`
+		} `xml:"idList>id"`
+		Akas []struct {
+			FirstName string `xml:"firstName"`
+			LastName  string `xml:"lastName"`
+		} `xml:"akaList>aka"`
+	} `xml:"sdnEntry"`
+}
+
+// parseOFACXML reads the OFAC SDN list in its XML distribution (sdn.xml).
+func parseOFACXML(data []byte) ([]mmodel.WatchListEntry, error) {
+	var list ofacSDNList
+	if err := xml.Unmarshal(data, &list); err != nil {
+		return nil, xmlError(err)
+	}
+
+	entries := make([]mmodel.WatchListEntry, 0, len(list.Entries))
+
+	for i, e := range list.Entries {
+		name := joinName(e.FirstName, e.LastName)
+		if name == "" {
+			return nil, fmt.Errorf("sdnEntry %d has no name", i+1)
+		}
+
+		entry := mmodel.WatchListEntry{
+			SourceID:   strings.TrimSpace(e.UID),
+			EntityType: ofacEntityType(strings.TrimSpace(e.SDNType)),
+			Name:       name,
+			Programs:   trimAll(e.Programs),
+		}
+
+		for _, aka := range e.Akas {
+			if alias := joinName(aka.FirstName, aka.LastName); alias != "" {
+				entry.Aliases = append(entry.Aliases, alias)
+			}
+		}
+
+		for _, id := range e.IDs {
+			if number := strings.TrimSpace(id.IDNumber); number != "" {
+				entry.Documents = append(entry.Documents, number)
+			}
+		}
+
+		if entry.SourceID == "" {
+			entry.SourceID = strconv.Itoa(i + 1)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// unConsolidatedList is the UN Security Council consolidated list (XML).
+type unConsolidatedList struct {
+	Individuals []unListedParty `xml:"INDIVIDUALS>INDIVIDUAL"`
+	Entities    []unListedParty `xml:"ENTITIES>ENTITY"`
+}
+
+type unListedParty struct {
+	DataID          string   `xml:"DATAID"`
+	ReferenceNumber string   `xml:"REFERENCE_NUMBER"`
+	ListType        string   `xml:"UN_LIST_TYPE"`
+	FirstName       string   `xml:"FIRST_NAME"`
+	SecondName      string   `xml:"SECOND_NAME"`
+	ThirdName       string   `xml:"THIRD_NAME"`
+	FourthName      string   `xml:"FOURTH_NAME"`
+	IndividualAlias []string `xml:"INDIVIDUAL_ALIAS>ALIAS_NAME"`
+	EntityAlias     []string `xml:"ENTITY_ALIAS>ALIAS_NAME"`
+	Documents       []string `xml:"INDIVIDUAL_DOCUMENT>NUMBER"`
+}
+
+// parseUNXML reads the UN Security Council consolidated list (XML).
+func parseUNXML(data []byte) ([]mmodel.WatchListEntry, error) {
+	var list unConsolidatedList
+	if err := xml.Unmarshal(data, &list); err != nil {
+		return nil, xmlError(err)
+	}
+
+	entries := make([]mmodel.WatchListEntry, 0, len(list.Individuals)+len(list.Entities))
+
+	add := func(kind string, parties []unListedParty) error {
+		for i, p := range parties {
+			name := joinName(p.FirstName, p.SecondName, p.ThirdName, p.FourthName)
+			if name == "" {
+				return fmt.Errorf("%s %d has no name", kind, i+1)
+			}
+
+			entry := mmodel.WatchListEntry{
+				SourceID:   firstNonEmpty(p.ReferenceNumber, p.DataID),
+				EntityType: mmodel.WatchListEntityIndividual,
+				Name:       name,
+				Aliases:    trimAll(append(p.IndividualAlias, p.EntityAlias...)),
+				Documents:  trimAll(p.Documents),
+				Programs:   trimAll([]string{p.ListType}),
+			}
+
+			if kind == "ENTITY" {
+				entry.EntityType = mmodel.WatchListEntityOrganization
+			}
+
+			if entry.SourceID == "" {
+				entry.SourceID = kind + "-" + strconv.Itoa(i+1)
+			}
+
+			entries = append(entries, entry)
+		}
+
+		return nil
+	}
+
+	if err := add("INDIVIDUAL", list.Individuals); err != nil {
+		return nil, err
+	}
+
+	if err := add("ENTITY", list.Entities); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Custom CSV columns. Only name is required; multi-valued columns separate
+// values with ';'.
+const (
+	customColID        = "id"
+	customColName      = "name"
+	customColType      = "type"
+	customColAliases   = "aliases"
+	customColDocuments = "documents"
+	customColPrograms  = "programs"
+)
+
+// parseCustomCSV reads an organization's own list: a CSV with a header row
+// naming the columns id, name, type, aliases, documents and programs (any
+// order, case-insensitive; only name is required).
+func parseCustomCSV(data []byte) ([]mmodel.WatchListEntry, error) {
+	reader := newCSVReader(data)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, csvError(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	if _, ok := columns[customColName]; !ok {
+		return nil, errors.New("header has no name column")
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	var entries []mmodel.WatchListEntry
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, csvError(err)
+		}
+
+		name := field(record, customColName)
+		if name == "" {
+			return nil, fmt.Errorf("line %d has no name", line)
+		}
+
+		entries = append(entries, mmodel.WatchListEntry{
+			SourceID:   firstNonEmpty(field(record, customColID), strconv.Itoa(line)),
+			EntityType: customEntityType(field(record, customColType)),
+			Name:       name,
+			Aliases:    splitList(field(record, customColAliases)),
+			Documents:  splitList(field(record, customColDocuments)),
+			Programs:   splitList(field(record, customColPrograms)),
+		})
+	}
+
+	return entries, nil
+}
+
+// customEntityType maps the free-form type of a custom list entry.
+func customEntityType(value string) string {
+	switch strings.ToLower(value) {
+	case "individual", "person", "natural_person":
+		return mmodel.WatchListEntityIndividual
+	case "entity", "organization", "company", "legal_person":
+		return mmodel.WatchListEntityOrganization
+	default:
+		return ""
+	}
+}
+
+// newCSVReader returns a lenient reader: OFAC files carry stray quotes and
+// both list formats allow ragged rows.
+func newCSVReader(data []byte) *csv.Reader {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	return reader
+}
+
+// csvError keeps the position of a CSV syntax error but drops the content.
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("line %d: %s", parseErr.Line, parseErr.Err)
+	}
+
+	return errors.New("unreadable CSV")
+}
+
+// xmlError keeps the position of an XML syntax error but drops the content.
+func xmlError(err error) error {
+	var syntaxErr *xml.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Errorf("line %d: malformed XML", syntaxErr.Line)
+	}
+
+	return errors.New("unreadable XML")
+}
+
+// joinName joins the non-empty name parts with single spaces.
+func joinName(parts ...string) string {
+	return strings.Join(trimAll(parts), " ")
+}
+
+// trimAll trims values and drops the empty ones.
+func trimAll(values []string) []string {
+	var out []string
+
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// splitList splits a ';'-separated field.
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return trimAll(strings.Split(value, ";"))
+}
+
+// firstNonEmpty returns the first non-blank value, trimmed.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
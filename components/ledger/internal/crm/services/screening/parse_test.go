@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_OFACCSV(t *testing.T) {
+	data := `36,"AEROCARIBBEAN AIRLINES",-0- ,"CUBA",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"a.k.a. 'AERO-CARIBBEAN'."
+2674,"ABU MARZOOK, Mousa Mohammed","individual","SDGT] [SDT",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"DOB 09 Feb 1951; Passport 92/664 (Egypt); SSN 523-33-8386 (United States)."
+` + "\x1a"
+
+	entries, err := Parse(mmodel.WatchListFormatOFACSDNCSV, []byte(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, mmodel.WatchListEntry{
+		SourceID:   "36",
+		EntityType: mmodel.WatchListEntityOrganization,
+		Name:       "AEROCARIBBEAN AIRLINES",
+		Aliases:    []string{"AERO-CARIBBEAN"},
+		Programs:   []string{"CUBA"},
+	}, entries[0])
+
+	assert.Equal(t, "2674", entries[1].SourceID)
+	assert.Equal(t, mmodel.WatchListEntityIndividual, entries[1].EntityType)
+	assert.Equal(t, []string{"SDGT", "SDT"}, entries[1].Programs)
+	assert.Equal(t, []string{"92/664", "523-33-8386"}, entries[1].Documents)
+}
+
+func TestParse_OFACXML(t *testing.T) {
+	data := `<?xml version="1.0" standalone="yes"?>
+<sdnList xmlns="https://sanctionslistservice.ofac.treas.gov/api/PublicationPreview/exports/XML">
+  <sdnEntry>
+    <uid>2674</uid>
+    <firstName>Mousa Mohammed</firstName>
+    <lastName>ABU MARZOOK</lastName>
+    <sdnType>Individual</sdnType>
+    <programList><program>SDGT</program><program>SDT</program></programList>
+    <idList><id><uid>1</uid><idType>Passport</idType><idNumber>92/664</idNumber></id></idList>
+    <akaList><aka><uid>2</uid><type>a.k.a.</type><lastName>ABU-MARZOUK</lastName><firstName>Musa</firstName></aka></akaList>
+  </sdnEntry>
+  <sdnEntry>
+    <uid>36</uid>
+    <lastName>AEROCARIBBEAN AIRLINES</lastName>
+    <sdnType>Entity</sdnType>
+    <programList><program>CUBA</program></programList>
+  </sdnEntry>
+</sdnList>`
+
+	entries, err := Parse(mmodel.WatchListFormatOFACSDNXML, []byte(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, mmodel.WatchListEntry{
+		SourceID:   "2674",
+		EntityType: mmodel.WatchListEntityIndividual,
+		Name:       "Mousa Mohammed ABU MARZOOK",
+		Aliases:    []string{"Musa ABU-MARZOUK"},
+		Documents:  []string{"92/664"},
+		Programs:   []string{"SDGT", "SDT"},
+	}, entries[0])
+	assert.Equal(t, mmodel.WatchListEntityOrganization, entries[1].EntityType)
+}
+
+func TestParse_UNXML(t *testing.T) {
+	data := `<CONSOLIDATED_LIST dateGenerated="2025-01-01T00:00:00Z">
+  <INDIVIDUALS>
+    <INDIVIDUAL>
+      <DATAID>6908555</DATAID>
+      <FIRST_NAME>RI</FIRST_NAME>
+      <SECOND_NAME>WON HO</SECOND_NAME>
+      <UN_LIST_TYPE>DPRK</UN_LIST_TYPE>
+      <REFERENCE_NUMBER>KPi.033</REFERENCE_NUMBER>
+      <INDIVIDUAL_ALIAS><QUALITY>Good</QUALITY><ALIAS_NAME>Ri Won-ho</ALIAS_NAME></INDIVIDUAL_ALIAS>
+      <INDIVIDUAL_DOCUMENT><TYPE_OF_DOCUMENT>Passport</TYPE_OF_DOCUMENT><NUMBER>381310014</NUMBER></INDIVIDUAL_DOCUMENT>
+    </INDIVIDUAL>
+  </INDIVIDUALS>
+  <ENTITIES>
+    <ENTITY>
+      <DATAID>110404</DATAID>
+      <FIRST_NAME>AL-HARAMAIN FOUNDATION</FIRST_NAME>
+      <UN_LIST_TYPE>Al-Qaida</UN_LIST_TYPE>
+      <ENTITY_ALIAS><ALIAS_NAME>Vazir</ALIAS_NAME></ENTITY_ALIAS>
+    </ENTITY>
+  </ENTITIES>
+</CONSOLIDATED_LIST>`
+
+	entries, err := Parse(mmodel.WatchListFormatUNXML, []byte(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, mmodel.WatchListEntry{
+		SourceID:   "KPi.033",
+		EntityType: mmodel.WatchListEntityIndividual,
+		Name:       "RI WON HO",
+		Aliases:    []string{"Ri Won-ho"},
+		Documents:  []string{"381310014"},
+		Programs:   []string{"DPRK"},
+	}, entries[0])
+
+	assert.Equal(t, "110404", entries[1].SourceID)
+	assert.Equal(t, mmodel.WatchListEntityOrganization, entries[1].EntityType)
+	assert.Equal(t, []string{"Vazir"}, entries[1].Aliases)
+}
+
+func TestParse_CustomCSV(t *testing.T) {
+	data := "Name,Type,Aliases,Documents,Programs\n" +
+		"Fulano de Tal,person,Fulano T.; F. de Tal,123.456.789-00,INTERNAL\n" +
+		"Shell Co Ltd,company,,,\n"
+
+	entries, err := Parse(mmodel.WatchListFormatCustomCSV, []byte(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, mmodel.WatchListEntry{
+		SourceID:   "2",
+		EntityType: mmodel.WatchListEntityIndividual,
+		Name:       "Fulano de Tal",
+		Aliases:    []string{"Fulano T.", "F. de Tal"},
+		Documents:  []string{"123.456.789-00"},
+		Programs:   []string{"INTERNAL"},
+	}, entries[0])
+	assert.Equal(t, "3", entries[1].SourceID)
+	assert.Equal(t, mmodel.WatchListEntityOrganization, entries[1].EntityType)
+}
+
+func TestParse_Errors(t *testing.T) {
+	testCases := []struct {
+		name        string
+		format      string
+		data        string
+		expectedErr error
+	}{
+		{
+			name:        "unknown format",
+			format:      "PDF",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListFormatInvalid, cn.EntityWatchList, "PDF"),
+		},
+		{
+			name:        "custom csv without name column",
+			format:      mmodel.WatchListFormatCustomCSV,
+			data:        "id,alias\n1,x\n",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, mmodel.WatchListFormatCustomCSV, "header has no name column"),
+		},
+		{
+			name:        "custom csv row without name",
+			format:      mmodel.WatchListFormatCustomCSV,
+			data:        "name,id\n,7\n",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, mmodel.WatchListFormatCustomCSV, "line 2 has no name"),
+		},
+		{
+			name:        "ofac csv with too few columns",
+			format:      mmodel.WatchListFormatOFACSDNCSV,
+			data:        "36,NAME\n",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, mmodel.WatchListFormatOFACSDNCSV, "line 1 has 2 columns, expected at least 4"),
+		},
+		{
+			name:        "malformed xml",
+			format:      mmodel.WatchListFormatUNXML,
+			data:        "<CONSOLIDATED_LIST><INDIVIDUALS>",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListParseFailed, cn.EntityWatchList, mmodel.WatchListFormatUNXML, "line 1: malformed XML"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := Parse(tc.format, []byte(tc.data))
+			require.Error(t, err)
+			assert.Nil(t, entries)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"context"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultPendingRetryInterval is the minimum time between two retries of an
+// organization's pending screenings on one replica.
+const defaultPendingRetryInterval = time.Minute
+
+// markPending records a failed screening of the subject. Onboarding goes on
+// either way, so a failed write is logged: the subject is then covered only
+// by the re-screening of the next list upload.
+func (s *service) markPending(ctx context.Context, organizationID string, pending *mmodel.PendingScreening) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	pending.LastAttemptAt = time.Now().UTC()
+
+	if err := s.repo.MarkPending(ctx, organizationID, pending); err != nil {
+		logger.Log(ctx, libLog.LevelError, "Pending screening not recorded",
+			libLog.String("organization_id", organizationID),
+			libLog.String("holder_id", pending.HolderID.String()),
+			libLog.String("subject_type", pending.SubjectType),
+			libLog.Err(err))
+	}
+}
+
+// startPendingRetry retries the organization's pending screenings in the
+// background, detached from the request context like startRescreen. A
+// successful screening shows the lists and the alert store are reachable
+// again, so it triggers the retry, at most once per retryInterval.
+func (s *service) startPendingRetry(ctx context.Context, organizationID string) {
+	if s.retryInterval <= 0 {
+		return
+	}
+
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	key := cacheKey(ctx, organizationID)
+	now := time.Now()
+
+	s.mu.Lock()
+	if last, ok := s.retries[key]; ok && now.Sub(last) < s.retryInterval {
+		s.mu.Unlock()
+
+		return
+	}
+
+	s.retries[key] = now
+	s.mu.Unlock()
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(ctx), logger, "crm", "screening.retry_pending",
+		libRuntime.KeepRunning, func(c context.Context) {
+			s.retryPending(c, organizationID)
+		})
+}
+
+// retryPending screens again the oldest page of pending screenings and clears
+// the subjects screened. It stops at the first failure, which is recorded as
+// another attempt of that subject.
+func (s *service) retryPending(ctx context.Context, organizationID string) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.retry_pending")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.organization_id", organizationID))
+
+	pending, err := s.repo.FindPending(ctx, organizationID, http.QueryHeader{Limit: s.batchSize, Page: 1})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find pending screenings", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Pending screenings not retried",
+			libLog.String("organization_id", organizationID),
+			libLog.Err(err))
+
+		return
+	}
+
+	if len(pending) == 0 {
+		return
+	}
+
+	index, err := s.index(ctx, organizationID)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to load watch lists", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Pending screenings not retried",
+			libLog.String("organization_id", organizationID),
+			libLog.Err(err))
+
+		return
+	}
+
+	var screened int
+
+	for _, p := range pending {
+		startedAt := time.Now().UTC()
+
+		if err := s.screenPending(ctx, organizationID, index, p); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to retry pending screening", err)
+
+			logger.Log(ctx, libLog.LevelWarn, "Pending screening retry failed",
+				libLog.String("organization_id", organizationID),
+				libLog.String("holder_id", p.HolderID.String()),
+				libLog.String("subject_type", p.SubjectType),
+				libLog.Err(err))
+
+			s.markPending(ctx, organizationID, p)
+
+			break
+		}
+
+		if err := s.repo.ClearPending(ctx, organizationID, p, startedAt); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to clear pending screening", err)
+
+			logger.Log(ctx, libLog.LevelWarn, "Pending screening not cleared",
+				libLog.String("organization_id", organizationID),
+				libLog.String("holder_id", p.HolderID.String()),
+				libLog.Err(err))
+
+			break
+		}
+
+		screened++
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Pending screenings retried",
+		libLog.String("organization_id", organizationID),
+		libLog.Int("pending", len(pending)),
+		libLog.Int("screened", screened))
+}
+
+// screenPending screens the current state of a pending subject. A holder or
+// instrument removed since the failure has nothing left to screen.
+func (s *service) screenPending(ctx context.Context, organizationID string, index *Index, p *mmodel.PendingScreening) error {
+	if p.SubjectType == mmodel.ScreeningSubjectRelatedParty {
+		if p.InstrumentID == nil {
+			return nil
+		}
+
+		i, err := s.instruments.Find(ctx, organizationID, p.HolderID, *p.InstrumentID, false)
+		if err != nil {
+			if isNotFound(err, cn.ErrInstrumentNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		_, _, err = s.screenParties(ctx, organizationID, index, p.HolderID, *p.InstrumentID, i.RelatedParties)
+
+		return err
+	}
+
+	h, err := s.holders.Find(ctx, organizationID, p.HolderID, false)
+	if err != nil {
+		if isNotFound(err, cn.ErrHolderNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	_, err = s.screenHolder(ctx, organizationID, index, h)
+
+	return err
+}
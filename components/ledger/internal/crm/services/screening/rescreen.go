@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultRescreenBatchSize is the number of holders or instruments read per
+// page by the background re-screening; progress is recorded after each page.
+const defaultRescreenBatchSize = 100
+
+// Static error codes recorded on a failed re-screening. They never carry
+// dynamic error text or PII.
+const (
+	rescreenErrorListsUnreadable   = "watch_lists_unreadable"
+	rescreenErrorHoldersUnreadable = "holders_unreadable"
+	rescreenErrorPartiesUnreadable = "instruments_unreadable"
+	rescreenErrorAlertWriteFailed  = "alert_write_failed"
+)
+
+// startRescreen launches a re-screening of the organization's holder base,
+// detached from the request context (keeping its values, such as the tenant
+// database, but not its cancellation). Uploads arriving while a run is in
+// progress are coalesced: their lists are screened by one follow-up run.
+func (s *service) startRescreen(ctx context.Context, organizationID, listName string) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	key := cacheKey(ctx, organizationID)
+
+	s.mu.Lock()
+	if pending, running := s.rescreens[key]; running {
+		if !slices.Contains(pending, listName) {
+			s.rescreens[key] = append(pending, listName)
+		}
+
+		s.mu.Unlock()
+
+		return
+	}
+
+	s.rescreens[key] = nil
+	s.mu.Unlock()
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(ctx), logger, "crm", "screening.rescreen",
+		libRuntime.KeepRunning, func(c context.Context) {
+			lists := []string{listName}
+
+			for len(lists) > 0 {
+				s.rescreen(c, organizationID, lists)
+
+				s.mu.Lock()
+				lists = s.rescreens[key]
+
+				if len(lists) == 0 {
+					delete(s.rescreens, key)
+				} else {
+					s.rescreens[key] = nil
+				}
+				s.mu.Unlock()
+			}
+		})
+}
+
+// rescreen screens every holder and every instrument's related parties
+// against the current lists, recording progress on the lists that triggered
+// the run after each page.
+func (s *service) rescreen(ctx context.Context, organizationID string, lists []string) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.rescreen")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.StringSlice("app.screening.lists", lists),
+	)
+
+	run := &mmodel.ScreeningRun{Status: mmodel.ScreeningRunRunning, StartedAt: time.Now().UTC()}
+	s.recordRun(ctx, organizationID, lists, run)
+
+	fail := func(code string, err error) {
+		libOpentelemetry.HandleSpanError(span, "Screening re-run failed", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Screening re-run failed",
+			libLog.String("organization_id", organizationID),
+			libLog.String("error_code", code),
+			libLog.Err(err))
+
+		now := time.Now().UTC()
+		run.Status = mmodel.ScreeningRunFailed
+		run.LastErrorCode = code
+		run.CompletedAt = &now
+		s.recordRun(ctx, organizationID, lists, run)
+	}
+
+	index, err := s.index(ctx, organizationID)
+	if err != nil {
+		fail(rescreenErrorListsUnreadable, err)
+
+		return
+	}
+
+	for page := 1; index.Len() > 0; page++ {
+		holders, err := s.holders.FindAll(ctx, organizationID, http.QueryHeader{Limit: s.batchSize, Page: page}, false)
+		if err != nil {
+			fail(rescreenErrorHoldersUnreadable, err)
+
+			return
+		}
+
+		for _, h := range holders {
+			raised, err := s.screenHolder(ctx, organizationID, index, h)
+			if err != nil {
+				fail(rescreenErrorAlertWriteFailed, err)
+
+				return
+			}
+
+			run.HoldersScreened++
+			run.AlertsRaised += raised
+		}
+
+		s.recordRun(ctx, organizationID, lists, run)
+
+		if len(holders) < s.batchSize {
+			break
+		}
+	}
+
+	for page := 1; index.Len() > 0; page++ {
+		instruments, err := s.instruments.FindAll(ctx, organizationID, uuid.Nil, http.QueryHeader{Limit: s.batchSize, Page: page}, false)
+		if err != nil {
+			fail(rescreenErrorPartiesUnreadable, err)
+
+			return
+		}
+
+		for _, i := range instruments {
+			if i.ID == nil || i.HolderID == nil {
+				continue
+			}
+
+			screened, raised, err := s.screenParties(ctx, organizationID, index, *i.HolderID, *i.ID, i.RelatedParties)
+			if err != nil {
+				fail(rescreenErrorAlertWriteFailed, err)
+
+				return
+			}
+
+			run.PartiesScreened += screened
+			run.AlertsRaised += raised
+		}
+
+		s.recordRun(ctx, organizationID, lists, run)
+
+		if len(instruments) < s.batchSize {
+			break
+		}
+	}
+
+	now := time.Now().UTC()
+	run.Status = mmodel.ScreeningRunCompleted
+	run.CompletedAt = &now
+	s.recordRun(ctx, organizationID, lists, run)
+
+	// Every holder and instrument was screened: the subjects whose screening
+	// failed before the run started are no longer pending.
+	if err := s.repo.ClearPending(ctx, organizationID, nil, run.StartedAt); err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Pending screenings not cleared",
+			libLog.String("organization_id", organizationID),
+			libLog.Err(err))
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Screening re-run completed",
+		libLog.String("organization_id", organizationID),
+		libLog.Any("holders_screened", run.HoldersScreened),
+		libLog.Any("parties_screened", run.PartiesScreened),
+		libLog.Any("alerts_raised", run.AlertsRaised))
+}
+
+// recordRun stores the run's progress on the triggering lists. A failed write
+// only delays the progress report, so it is logged and the run goes on.
+func (s *service) recordRun(ctx context.Context, organizationID string, lists []string, run *mmodel.ScreeningRun) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if err := s.repo.UpdateRescreen(ctx, organizationID, lists, run); err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Screening progress not recorded",
+			libLog.String("organization_id", organizationID),
+			libLog.Err(err))
+	}
+}
@@ -0,0 +1,543 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Package screening screens holders and instrument related parties against
+// sanctions and watch lists (OFAC SDN, UN consolidated list, custom lists).
+// Hits are stored as alerts for compliance review. Holders are screened at
+// onboarding and on every change, and the whole holder base is screened again
+// in the background whenever a list is uploaded. A subject whose screening
+// fails is recorded as pending and retried in the background.
+package screening
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"maps"
+	"math"
+	"regexp"
+	"sync"
+	"time"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	mongoScreening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// listNamePattern restricts list names to URL-safe slugs.
+var listNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Service manages watch lists and screening alerts, and screens holders and
+// related parties.
+type Service interface {
+	// LoadList parses a watch-list file and stores it as the new version of
+	// the named list, then re-screens the holder base in the background.
+	LoadList(ctx context.Context, organizationID, name, format, uploadedBy string, data []byte) (*mmodel.WatchList, error)
+	// GetLists returns the organization's watch lists.
+	GetLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error)
+	// DeleteList removes a watch list; its alerts are kept.
+	DeleteList(ctx context.Context, organizationID, name string) error
+	// GetAlerts returns a page of screening alerts.
+	GetAlerts(ctx context.Context, organizationID string, query mongoScreening.AlertQuery) ([]*mmodel.ScreeningAlert, error)
+	// GetAlert returns a screening alert.
+	GetAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error)
+	// ReviewAlert confirms or dismisses an open alert.
+	ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.ReviewScreeningAlertInput) (*mmodel.ScreeningAlert, error)
+	// ScreenHolder screens a holder's name and document.
+	ScreenHolder(ctx context.Context, organizationID string, h *mmodel.Holder) error
+	// ScreenRelatedParties screens the related parties of an instrument.
+	ScreenRelatedParties(ctx context.Context, organizationID string, holderID, instrumentID uuid.UUID, parties []*mmodel.RelatedParty) error
+	// GetPending returns a page of the subjects whose screening failed and
+	// is awaiting a retry.
+	GetPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error)
+}
+
+// service implements Service. The match index of an organization is cached
+// and rebuilt when any of its lists changes version, so replicas pick up an
+// upload made elsewhere on their next screening.
+type service struct {
+	repo        mongoScreening.Repository
+	holders     holder.Repository
+	instruments instrument.Repository
+	threshold   float64
+	batchSize   int
+	indexes     sync.Map // Key: "tenantID:organizationID" -> *cachedIndex
+
+	// retryInterval is the minimum time between two retries of an
+	// organization's pending screenings; zero disables the retries.
+	retryInterval time.Duration
+
+	mu        sync.Mutex
+	rescreens map[string][]string  // Key: "tenantID:organizationID" -> lists awaiting the next run
+	retries   map[string]time.Time // Key: "tenantID:organizationID" -> start of the last pending retry
+}
+
+// cachedIndex is an organization's match index with the list versions it was
+// built from.
+type cachedIndex struct {
+	versions map[string]int64
+	index    *Index
+}
+
+// NewService creates a screening service. threshold is the name score from
+// which alerts are raised; a value outside (0, 1] selects
+// DefaultMatchThreshold. holders and instruments are read by the background
+// re-screening.
+func NewService(repo mongoScreening.Repository, holders holder.Repository, instruments instrument.Repository, threshold float64) Service {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultMatchThreshold
+	}
+
+	return &service{
+		repo:          repo,
+		holders:       holders,
+		instruments:   instruments,
+		threshold:     threshold,
+		batchSize:     defaultRescreenBatchSize,
+		retryInterval: defaultPendingRetryInterval,
+		rescreens:     make(map[string][]string),
+		retries:       make(map[string]time.Time),
+	}
+}
+
+// LoadList validates and parses the file, stores it as version N+1 of the list
+// and starts a re-screening of the holder base against every list.
+func (s *service) LoadList(ctx context.Context, organizationID, name, format, uploadedBy string, data []byte) (*mmodel.WatchList, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.load_list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.watch_list", name),
+		attribute.String("app.request.watch_list_format", format),
+	)
+
+	if !listNamePattern.MatchString(name) {
+		err := pkg.ValidateBusinessError(cn.ErrWatchListNameInvalid, cn.EntityWatchList, name)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid watch list name", err)
+
+		return nil, err
+	}
+
+	if !mmodel.IsWatchListFormat(format) {
+		err := pkg.ValidateBusinessError(cn.ErrWatchListFormatInvalid, cn.EntityWatchList, format)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid watch list format", err)
+
+		return nil, err
+	}
+
+	entries, err := Parse(format, data)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to parse watch list", err)
+
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		err := pkg.ValidateBusinessError(cn.ErrWatchListEmpty, cn.EntityWatchList)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Empty watch list", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	sum := sha256.Sum256(data)
+
+	list := &mmodel.WatchList{
+		Name:         name,
+		Format:       format,
+		Version:      1,
+		EntryCount:   len(entries),
+		Checksum:     hex.EncodeToString(sum[:]),
+		UploadedBy:   uploadedBy,
+		LastRescreen: &mmodel.ScreeningRun{Status: mmodel.ScreeningRunRunning, StartedAt: now},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	var expectedVersion int64
+
+	current, err := s.repo.FindList(ctx, organizationID, name)
+	switch {
+	case err == nil:
+		expectedVersion = current.Version
+		list.Version = current.Version + 1
+		list.CreatedAt = current.CreatedAt
+	case !isNotFound(err, cn.ErrWatchListNotFound):
+		recordSpanError(span, "Failed to find watch list", err)
+
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceList(ctx, organizationID, list, entries, expectedVersion); err != nil {
+		recordSpanError(span, "Failed to store watch list", err)
+
+		return nil, err
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Watch list loaded",
+		libLog.String("organization_id", organizationID),
+		libLog.String("watch_list", name),
+		libLog.Any("version", list.Version),
+		libLog.Int("entries", list.EntryCount))
+
+	s.indexes.Delete(cacheKey(ctx, organizationID))
+	s.startRescreen(ctx, organizationID, name)
+
+	return list, nil
+}
+
+// GetLists returns the organization's watch lists, ordered by name.
+func (s *service) GetLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.get_lists")
+	defer span.End()
+
+	lists, err := s.repo.FindLists(ctx, organizationID)
+	if err != nil {
+		recordSpanError(span, "Failed to find watch lists", err)
+
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// DeleteList removes a watch list. Alerts it raised stay for the record.
+func (s *service) DeleteList(ctx context.Context, organizationID, name string) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.delete_list")
+	defer span.End()
+
+	if err := s.repo.DeleteList(ctx, organizationID, name); err != nil {
+		recordSpanError(span, "Failed to delete watch list", err)
+
+		return err
+	}
+
+	s.indexes.Delete(cacheKey(ctx, organizationID))
+
+	return nil
+}
+
+// GetAlerts returns a page of screening alerts, newest first.
+func (s *service) GetAlerts(ctx context.Context, organizationID string, query mongoScreening.AlertQuery) ([]*mmodel.ScreeningAlert, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.get_alerts")
+	defer span.End()
+
+	alerts, err := s.repo.FindAlerts(ctx, organizationID, query)
+	if err != nil {
+		recordSpanError(span, "Failed to find screening alerts", err)
+
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// GetAlert returns a screening alert.
+func (s *service) GetAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.get_alert")
+	defer span.End()
+
+	alert, err := s.repo.FindAlert(ctx, organizationID, id)
+	if err != nil {
+		recordSpanError(span, "Failed to find screening alert", err)
+
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// ReviewAlert records the reviewer's decision on an open alert.
+func (s *service) ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.ReviewScreeningAlertInput) (*mmodel.ScreeningAlert, error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.review_alert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID),
+		attribute.String("app.request.screening_alert_id", id.String()),
+		attribute.String("app.request.screening_decision", input.Decision),
+	)
+
+	status := mmodel.ScreeningAlertDismissed
+	if input.Decision == mmodel.ScreeningDecisionConfirm {
+		status = mmodel.ScreeningAlertConfirmed
+	}
+
+	alert, err := s.repo.ReviewAlert(ctx, organizationID, id, status, input.Reviewer, input.Note, time.Now().UTC())
+	if err != nil {
+		recordSpanError(span, "Failed to review screening alert", err)
+
+		return nil, err
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Screening alert reviewed",
+		libLog.String("organization_id", organizationID),
+		libLog.String("screening_alert_id", id.String()),
+		libLog.String("status", status))
+
+	return alert, nil
+}
+
+// ScreenHolder screens a holder's name and document against every list of the
+// organization. Erased holders have nothing left to screen. A holder whose
+// screening fails is recorded as pending.
+func (s *service) ScreenHolder(ctx context.Context, organizationID string, h *mmodel.Holder) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.screen_holder")
+	defer span.End()
+
+	index, err := s.index(ctx, organizationID)
+	if err == nil {
+		_, err = s.screenHolder(ctx, organizationID, index, h)
+	}
+
+	if err != nil {
+		recordSpanError(span, "Failed to screen holder", err)
+
+		if h != nil && h.ID != nil {
+			s.markPending(ctx, organizationID, &mmodel.PendingScreening{HolderID: *h.ID, SubjectType: mmodel.ScreeningSubjectHolder})
+		}
+
+		return err
+	}
+
+	s.startPendingRetry(ctx, organizationID)
+
+	return nil
+}
+
+// ScreenRelatedParties screens the related parties of an instrument. An
+// instrument whose screening fails is recorded as pending.
+func (s *service) ScreenRelatedParties(ctx context.Context, organizationID string, holderID, instrumentID uuid.UUID, parties []*mmodel.RelatedParty) error {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.screen_related_parties")
+	defer span.End()
+
+	index, err := s.index(ctx, organizationID)
+	if err == nil {
+		_, _, err = s.screenParties(ctx, organizationID, index, holderID, instrumentID, parties)
+	}
+
+	if err != nil {
+		recordSpanError(span, "Failed to screen related parties", err)
+
+		s.markPending(ctx, organizationID, &mmodel.PendingScreening{
+			HolderID:     holderID,
+			SubjectType:  mmodel.ScreeningSubjectRelatedParty,
+			InstrumentID: &instrumentID,
+		})
+
+		return err
+	}
+
+	s.startPendingRetry(ctx, organizationID)
+
+	return nil
+}
+
+// GetPending returns a page of pending screenings, oldest first.
+func (s *service) GetPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.screening.get_pending")
+	defer span.End()
+
+	pending, err := s.repo.FindPending(ctx, organizationID, page)
+	if err != nil {
+		recordSpanError(span, "Failed to find pending screenings", err)
+
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// screenHolder screens one holder and returns the number of alerts raised.
+func (s *service) screenHolder(ctx context.Context, organizationID string, index *Index, h *mmodel.Holder) (int64, error) {
+	if index.Len() == 0 || h == nil || h.ID == nil || h.Name == nil {
+		return 0, nil
+	}
+
+	document := ""
+	if h.Document != nil {
+		document = *h.Document
+	}
+
+	return s.raise(ctx, organizationID, index.Screen(*h.Name, document, s.threshold), &mmodel.ScreeningAlert{
+		HolderID:    *h.ID,
+		SubjectType: mmodel.ScreeningSubjectHolder,
+	})
+}
+
+// screenParties screens the related parties of an instrument and returns the
+// number of parties screened and of alerts raised.
+func (s *service) screenParties(ctx context.Context, organizationID string, index *Index, holderID, instrumentID uuid.UUID, parties []*mmodel.RelatedParty) (int64, int64, error) {
+	if index.Len() == 0 {
+		return 0, 0, nil
+	}
+
+	var screened, raised int64
+
+	for _, party := range parties {
+		if party == nil || party.ID == nil {
+			continue
+		}
+
+		n, err := s.raise(ctx, organizationID, index.Screen(party.Name, party.Document, s.threshold), &mmodel.ScreeningAlert{
+			HolderID:       holderID,
+			SubjectType:    mmodel.ScreeningSubjectRelatedParty,
+			InstrumentID:   &instrumentID,
+			RelatedPartyID: party.ID,
+		})
+		if err != nil {
+			return screened, raised, err
+		}
+
+		screened++
+		raised += n
+	}
+
+	return screened, raised, nil
+}
+
+// raise stores one alert per match for the subject described by template and
+// returns the number of new alerts. Matches already alerted only refresh the
+// existing alert.
+func (s *service) raise(ctx context.Context, organizationID string, matches []Match, template *mmodel.ScreeningAlert) (int64, error) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	var raised int64
+
+	for _, m := range matches {
+		id, err := libCommons.GenerateUUIDv7()
+		if err != nil {
+			return raised, err
+		}
+
+		now := time.Now().UTC()
+
+		alert := *template
+		alert.ID = id
+		alert.MatchedField = m.Field
+		alert.ListName = m.ListName
+		alert.ListVersion = m.ListVersion
+		alert.EntrySourceID = m.Entry.SourceID
+		alert.EntryName = m.Entry.Name
+		alert.EntryPrograms = m.Entry.Programs
+		alert.Score = math.Round(m.Score*10000) / 10000
+		alert.Status = mmodel.ScreeningAlertOpen
+		alert.CreatedAt = now
+		alert.LastSeenAt = now
+
+		created, err := s.repo.UpsertAlert(ctx, organizationID, &alert)
+		if err != nil {
+			return raised, err
+		}
+
+		if created {
+			raised++
+
+			logger.Log(ctx, libLog.LevelWarn, "Screening alert raised",
+				libLog.String("organization_id", organizationID),
+				libLog.String("holder_id", alert.HolderID.String()),
+				libLog.String("subject_type", alert.SubjectType),
+				libLog.String("watch_list", alert.ListName),
+				libLog.String("entry_source_id", alert.EntrySourceID),
+				libLog.String("matched_field", alert.MatchedField))
+		}
+	}
+
+	return raised, nil
+}
+
+// index returns the organization's match index, rebuilding it when the stored
+// list versions differ from the cached ones.
+func (s *service) index(ctx context.Context, organizationID string) (*Index, error) {
+	lists, err := s.repo.FindLists(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]int64, len(lists))
+	for _, l := range lists {
+		versions[l.Name] = l.Version
+	}
+
+	key := cacheKey(ctx, organizationID)
+
+	if v, ok := s.indexes.Load(key); ok {
+		if cached := v.(*cachedIndex); maps.Equal(cached.versions, versions) {
+			return cached.index, nil
+		}
+	}
+
+	loaded := make([]ListEntries, 0, len(lists))
+
+	for _, l := range lists {
+		entries, err := s.repo.FindEntries(ctx, organizationID, l.Name, l.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		loaded = append(loaded, ListEntries{Name: l.Name, Version: l.Version, Entries: entries})
+	}
+
+	index := NewIndex(loaded)
+	s.indexes.Store(key, &cachedIndex{versions: versions, index: index})
+
+	return index, nil
+}
+
+// cacheKey scopes per-organization state by tenant.
+func cacheKey(ctx context.Context, organizationID string) string {
+	return tmcore.GetTenantIDContext(ctx) + ":" + organizationID
+}
+
+// isNotFound reports whether err is the given not-found business error.
+func isNotFound(err, notFound error) bool {
+	var notFoundErr pkg.EntityNotFoundError
+
+	return errors.As(err, &notFoundErr) && notFoundErr.Code == notFound.Error()
+}
+
+// recordSpanError records err onto the span using the class-appropriate helper
+// (business errors keep the span status UNSET).
+func recordSpanError(span trace.Span, message string, err error) {
+	if pkg.IsBusinessError(err) {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, message, err)
+		return
+	}
+
+	libOpentelemetry.HandleSpanError(span, message, err)
+}
@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/screening (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen --destination=service_mock.go --package=screening . Service
+//
+
+// Package screening is a generated GoMock package.
+package screening
+
+import (
+	context "context"
+	reflect "reflect"
+
+	screening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
+	mmodel "github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	http "github.com/LerianStudio/midaz/v4/pkg/net/http"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteList mocks base method.
+func (m *MockService) DeleteList(ctx context.Context, organizationID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, organizationID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockServiceMockRecorder) DeleteList(ctx, organizationID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockService)(nil).DeleteList), ctx, organizationID, name)
+}
+
+// GetAlert mocks base method.
+func (m *MockService) GetAlert(ctx context.Context, organizationID string, id uuid.UUID) (*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlert", ctx, organizationID, id)
+	ret0, _ := ret[0].(*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlert indicates an expected call of GetAlert.
+func (mr *MockServiceMockRecorder) GetAlert(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlert", reflect.TypeOf((*MockService)(nil).GetAlert), ctx, organizationID, id)
+}
+
+// GetAlerts mocks base method.
+func (m *MockService) GetAlerts(ctx context.Context, organizationID string, query screening.AlertQuery) ([]*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", ctx, organizationID, query)
+	ret0, _ := ret[0].([]*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockServiceMockRecorder) GetAlerts(ctx, organizationID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockService)(nil).GetAlerts), ctx, organizationID, query)
+}
+
+// GetLists mocks base method.
+func (m *MockService) GetLists(ctx context.Context, organizationID string) ([]*mmodel.WatchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", ctx, organizationID)
+	ret0, _ := ret[0].([]*mmodel.WatchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockServiceMockRecorder) GetLists(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockService)(nil).GetLists), ctx, organizationID)
+}
+
+// GetPending mocks base method.
+func (m *MockService) GetPending(ctx context.Context, organizationID string, page http.QueryHeader) ([]*mmodel.PendingScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", ctx, organizationID, page)
+	ret0, _ := ret[0].([]*mmodel.PendingScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockServiceMockRecorder) GetPending(ctx, organizationID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockService)(nil).GetPending), ctx, organizationID, page)
+}
+
+// LoadList mocks base method.
+func (m *MockService) LoadList(ctx context.Context, organizationID, name, format, uploadedBy string, data []byte) (*mmodel.WatchList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadList", ctx, organizationID, name, format, uploadedBy, data)
+	ret0, _ := ret[0].(*mmodel.WatchList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadList indicates an expected call of LoadList.
+func (mr *MockServiceMockRecorder) LoadList(ctx, organizationID, name, format, uploadedBy, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadList", reflect.TypeOf((*MockService)(nil).LoadList), ctx, organizationID, name, format, uploadedBy, data)
+}
+
+// ReviewAlert mocks base method.
+func (m *MockService) ReviewAlert(ctx context.Context, organizationID string, id uuid.UUID, input *mmodel.ReviewScreeningAlertInput) (*mmodel.ScreeningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAlert", ctx, organizationID, id, input)
+	ret0, _ := ret[0].(*mmodel.ScreeningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAlert indicates an expected call of ReviewAlert.
+func (mr *MockServiceMockRecorder) ReviewAlert(ctx, organizationID, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAlert", reflect.TypeOf((*MockService)(nil).ReviewAlert), ctx, organizationID, id, input)
+}
+
+// ScreenHolder mocks base method.
+func (m *MockService) ScreenHolder(ctx context.Context, organizationID string, h *mmodel.Holder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenHolder", ctx, organizationID, h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScreenHolder indicates an expected call of ScreenHolder.
+func (mr *MockServiceMockRecorder) ScreenHolder(ctx, organizationID, h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenHolder", reflect.TypeOf((*MockService)(nil).ScreenHolder), ctx, organizationID, h)
+}
+
+// ScreenRelatedParties mocks base method.
+func (m *MockService) ScreenRelatedParties(ctx context.Context, organizationID string, holderID, instrumentID uuid.UUID, parties []*mmodel.RelatedParty) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenRelatedParties", ctx, organizationID, holderID, instrumentID, parties)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScreenRelatedParties indicates an expected call of ScreenRelatedParties.
+func (mr *MockServiceMockRecorder) ScreenRelatedParties(ctx, organizationID, holderID, instrumentID, parties any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenRelatedParties", reflect.TypeOf((*MockService)(nil).ScreenRelatedParties), ctx, organizationID, holderID, instrumentID, parties)
+}
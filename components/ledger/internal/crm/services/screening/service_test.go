@@ -0,0 +1,362 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package screening
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	mongoScreening "github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/screening"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	cn "github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const customList = "name,id\nFulano de Tal,7\n"
+
+type screeningMocks struct {
+	repo        *mongoScreening.MockRepository
+	holders     *holder.MockRepository
+	instruments *instrument.MockRepository
+}
+
+func newTestService(t *testing.T) (*service, screeningMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := screeningMocks{
+		repo:        mongoScreening.NewMockRepository(ctrl),
+		holders:     holder.NewMockRepository(ctrl),
+		instruments: instrument.NewMockRepository(ctrl),
+	}
+
+	svc := NewService(mocks.repo, mocks.holders, mocks.instruments, 0).(*service)
+	svc.batchSize = 2
+	svc.retryInterval = 0 // pending retries are exercised explicitly
+
+	return svc, mocks
+}
+
+// expectLists serves the given list versions and their entries to the index.
+func expectLists(m screeningMocks, orgID string, lists ...ListEntries) {
+	stored := make([]*mmodel.WatchList, 0, len(lists))
+	for _, l := range lists {
+		stored = append(stored, &mmodel.WatchList{Name: l.Name, Version: l.Version})
+		m.repo.EXPECT().FindEntries(gomock.Any(), orgID, l.Name, l.Version).Return(l.Entries, nil).MaxTimes(1)
+	}
+
+	m.repo.EXPECT().FindLists(gomock.Any(), orgID).Return(stored, nil).AnyTimes()
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestLoadList_RejectsInvalidInput(t *testing.T) {
+	svc, _ := newTestService(t)
+	orgID := uuid.NewString()
+
+	testCases := []struct {
+		name        string
+		listName    string
+		format      string
+		data        string
+		expectedErr error
+	}{
+		{
+			name:        "invalid name",
+			listName:    "OFAC SDN",
+			format:      mmodel.WatchListFormatCustomCSV,
+			data:        customList,
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListNameInvalid, cn.EntityWatchList, "OFAC SDN"),
+		},
+		{
+			name:        "invalid format",
+			listName:    "internal",
+			format:      "XLSX",
+			data:        customList,
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListFormatInvalid, cn.EntityWatchList, "XLSX"),
+		},
+		{
+			name:        "empty list",
+			listName:    "internal",
+			format:      mmodel.WatchListFormatCustomCSV,
+			data:        "name,id\n",
+			expectedErr: pkg.ValidateBusinessError(cn.ErrWatchListEmpty, cn.EntityWatchList),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, err := svc.LoadList(context.Background(), orgID, tc.listName, tc.format, "compliance@example.com", []byte(tc.data))
+			assert.Nil(t, list)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestLoadList_ReplacesListAndRescreensHolderBase(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	ctx := context.Background()
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m.repo.EXPECT().FindList(gomock.Any(), orgID, "internal").
+		Return(&mmodel.WatchList{Name: "internal", Version: 2, CreatedAt: created}, nil)
+	m.repo.EXPECT().ReplaceList(gomock.Any(), orgID, gomock.Any(), gomock.Any(), int64(2)).
+		DoAndReturn(func(_ context.Context, _ string, list *mmodel.WatchList, entries []mmodel.WatchListEntry, _ int64) error {
+			assert.Equal(t, int64(3), list.Version)
+			assert.Equal(t, created, list.CreatedAt)
+			assert.Equal(t, 1, list.EntryCount)
+			assert.Equal(t, "compliance@example.com", list.UploadedBy)
+			assert.Equal(t, mmodel.ScreeningRunRunning, list.LastRescreen.Status)
+			assert.Len(t, list.Checksum, 64)
+			assert.Equal(t, "Fulano de Tal", entries[0].Name)
+
+			return nil
+		})
+
+	expectLists(m, orgID, ListEntries{Name: "internal", Version: 3, Entries: []mmodel.WatchListEntry{{SourceID: "7", Name: "Fulano de Tal"}}})
+
+	hit, miss, third := uuid.New(), uuid.New(), uuid.New()
+	partyID, instrumentID := uuid.New(), uuid.New()
+
+	m.holders.EXPECT().FindAll(gomock.Any(), orgID, http.QueryHeader{Limit: 2, Page: 1}, false).
+		Return([]*mmodel.Holder{{ID: &hit, Name: strPtr("FULANO DE TAL")}, {ID: &miss, Name: strPtr("Beltrano Silva")}}, nil)
+	m.holders.EXPECT().FindAll(gomock.Any(), orgID, http.QueryHeader{Limit: 2, Page: 2}, false).
+		Return([]*mmodel.Holder{{ID: &third}}, nil) // erased holder: no name left to screen
+	m.instruments.EXPECT().FindAll(gomock.Any(), orgID, uuid.Nil, http.QueryHeader{Limit: 2, Page: 1}, false).
+		Return([]*mmodel.Instrument{{ID: &instrumentID, HolderID: &miss, RelatedParties: []*mmodel.RelatedParty{
+			{ID: &partyID, Name: "Fulano de Tal", Document: "999"},
+		}}}, nil)
+
+	var alerts []*mmodel.ScreeningAlert
+
+	m.repo.EXPECT().UpsertAlert(gomock.Any(), orgID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, alert *mmodel.ScreeningAlert) (bool, error) {
+			alerts = append(alerts, alert)
+
+			return true, nil
+		}).Times(2)
+
+	done := make(chan *mmodel.ScreeningRun, 1)
+
+	m.repo.EXPECT().UpdateRescreen(gomock.Any(), orgID, []string{"internal"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []string, run *mmodel.ScreeningRun) error {
+			if run.Status != mmodel.ScreeningRunRunning {
+				snapshot := *run
+				done <- &snapshot
+			}
+
+			return nil
+		}).MinTimes(2)
+	m.repo.EXPECT().ClearPending(gomock.Any(), orgID, nil, gomock.Any()).Return(nil)
+
+	list, err := svc.LoadList(ctx, orgID, "internal", mmodel.WatchListFormatCustomCSV, "compliance@example.com", []byte(customList))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), list.Version)
+
+	select {
+	case run := <-done:
+		assert.Equal(t, mmodel.ScreeningRunCompleted, run.Status)
+		assert.Equal(t, int64(3), run.HoldersScreened)
+		assert.Equal(t, int64(1), run.PartiesScreened)
+		assert.Equal(t, int64(2), run.AlertsRaised)
+		assert.NotNil(t, run.CompletedAt)
+	case <-time.After(5 * time.Second):
+		t.Fatal("re-screening did not complete")
+	}
+
+	require.Len(t, alerts, 2)
+	assert.Equal(t, hit, alerts[0].HolderID)
+	assert.Equal(t, mmodel.ScreeningSubjectHolder, alerts[0].SubjectType)
+	assert.Equal(t, mmodel.ScreeningAlertOpen, alerts[0].Status)
+	assert.Equal(t, "7", alerts[0].EntrySourceID)
+	assert.Equal(t, int64(3), alerts[0].ListVersion)
+
+	assert.Equal(t, miss, alerts[1].HolderID)
+	assert.Equal(t, mmodel.ScreeningSubjectRelatedParty, alerts[1].SubjectType)
+	assert.Equal(t, &partyID, alerts[1].RelatedPartyID)
+	assert.Equal(t, &instrumentID, alerts[1].InstrumentID)
+}
+
+func TestRescreen_RecordsFailure(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+
+	expectLists(m, orgID, ListEntries{Name: "internal", Version: 1, Entries: []mmodel.WatchListEntry{{SourceID: "7", Name: "Fulano de Tal"}}})
+	m.holders.EXPECT().FindAll(gomock.Any(), orgID, gomock.Any(), false).Return(nil, errors.New("connection reset"))
+
+	var last *mmodel.ScreeningRun
+
+	m.repo.EXPECT().UpdateRescreen(gomock.Any(), orgID, []string{"internal", "ofac"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []string, run *mmodel.ScreeningRun) error {
+			snapshot := *run
+			last = &snapshot
+
+			return nil
+		}).Times(2)
+
+	svc.rescreen(context.Background(), orgID, []string{"internal", "ofac"})
+
+	require.NotNil(t, last)
+	assert.Equal(t, mmodel.ScreeningRunFailed, last.Status)
+	assert.Equal(t, rescreenErrorHoldersUnreadable, last.LastErrorCode)
+}
+
+func TestScreenHolder_UsesCachedIndexUntilVersionsChange(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	ctx := context.Background()
+	holderID := uuid.New()
+	h := &mmodel.Holder{ID: &holderID, Name: strPtr("Someone Else"), Document: strPtr("123.456.789-00")}
+
+	lists := []*mmodel.WatchList{{Name: "internal", Version: 1}}
+
+	m.repo.EXPECT().FindLists(gomock.Any(), orgID).DoAndReturn(func(context.Context, string) ([]*mmodel.WatchList, error) {
+		return lists, nil
+	}).Times(3)
+	m.repo.EXPECT().FindEntries(gomock.Any(), orgID, "internal", int64(1)).
+		Return([]mmodel.WatchListEntry{{SourceID: "7", Name: "Fulano de Tal", Documents: []string{"12345678900"}}}, nil).Times(1)
+	m.repo.EXPECT().FindEntries(gomock.Any(), orgID, "internal", int64(2)).
+		Return([]mmodel.WatchListEntry{{SourceID: "8", Name: "Other"}}, nil).Times(1)
+	m.repo.EXPECT().UpsertAlert(gomock.Any(), orgID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, alert *mmodel.ScreeningAlert) (bool, error) {
+			assert.Equal(t, mmodel.ScreeningFieldDocument, alert.MatchedField)
+			assert.InDelta(t, 1.0, alert.Score, 1e-9)
+
+			return false, nil
+		}).Times(2)
+
+	require.NoError(t, svc.ScreenHolder(ctx, orgID, h))
+	require.NoError(t, svc.ScreenHolder(ctx, orgID, h))
+
+	lists = []*mmodel.WatchList{{Name: "internal", Version: 2}}
+
+	require.NoError(t, svc.ScreenHolder(ctx, orgID, h))
+}
+
+func TestScreen_RecordsFailedSubjectsAsPending(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	ctx := context.Background()
+	holderID, instrumentID, partyID := uuid.New(), uuid.New(), uuid.New()
+
+	m.repo.EXPECT().FindLists(gomock.Any(), orgID).Return(nil, errors.New("connection reset"))
+	m.repo.EXPECT().MarkPending(gomock.Any(), orgID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, pending *mmodel.PendingScreening) error {
+			assert.Equal(t, holderID, pending.HolderID)
+			assert.Equal(t, mmodel.ScreeningSubjectHolder, pending.SubjectType)
+			assert.Nil(t, pending.InstrumentID)
+			assert.False(t, pending.LastAttemptAt.IsZero())
+
+			return nil
+		})
+
+	err := svc.ScreenHolder(ctx, orgID, &mmodel.Holder{ID: &holderID, Name: strPtr("Fulano de Tal")})
+	require.Error(t, err)
+
+	expectLists(m, orgID, ListEntries{Name: "internal", Version: 1, Entries: []mmodel.WatchListEntry{{SourceID: "7", Name: "Fulano de Tal"}}})
+	m.repo.EXPECT().UpsertAlert(gomock.Any(), orgID, gomock.Any()).Return(false, errors.New("write conflict"))
+	m.repo.EXPECT().MarkPending(gomock.Any(), orgID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, pending *mmodel.PendingScreening) error {
+			assert.Equal(t, holderID, pending.HolderID)
+			assert.Equal(t, mmodel.ScreeningSubjectRelatedParty, pending.SubjectType)
+			assert.Equal(t, &instrumentID, pending.InstrumentID)
+
+			return nil
+		})
+
+	err = svc.ScreenRelatedParties(ctx, orgID, holderID, instrumentID, []*mmodel.RelatedParty{{ID: &partyID, Name: "Fulano de Tal"}})
+	require.Error(t, err)
+}
+
+func TestRetryPending_ScreensAndClearsSubjects(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	ctx := context.Background()
+	screenedID, erasedInstrumentID, failingID, holderOfInstrument := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	screened := &mmodel.PendingScreening{HolderID: screenedID, SubjectType: mmodel.ScreeningSubjectHolder, Attempts: 1}
+	removed := &mmodel.PendingScreening{HolderID: holderOfInstrument, SubjectType: mmodel.ScreeningSubjectRelatedParty, InstrumentID: &erasedInstrumentID, Attempts: 1}
+	failing := &mmodel.PendingScreening{HolderID: failingID, SubjectType: mmodel.ScreeningSubjectHolder, Attempts: 3}
+	notReached := &mmodel.PendingScreening{HolderID: uuid.New(), SubjectType: mmodel.ScreeningSubjectHolder, Attempts: 1}
+
+	m.repo.EXPECT().FindPending(gomock.Any(), orgID, http.QueryHeader{Limit: 2, Page: 1}).
+		Return([]*mmodel.PendingScreening{screened, removed, failing, notReached}, nil)
+	expectLists(m, orgID, ListEntries{Name: "internal", Version: 1, Entries: []mmodel.WatchListEntry{{SourceID: "7", Name: "Fulano de Tal"}}})
+
+	m.holders.EXPECT().Find(gomock.Any(), orgID, screenedID, false).
+		Return(&mmodel.Holder{ID: &screenedID, Name: strPtr("FULANO DE TAL")}, nil)
+	m.repo.EXPECT().UpsertAlert(gomock.Any(), orgID, gomock.Any()).Return(true, nil)
+	m.repo.EXPECT().ClearPending(gomock.Any(), orgID, screened, gomock.Any()).Return(nil)
+
+	m.instruments.EXPECT().Find(gomock.Any(), orgID, holderOfInstrument, erasedInstrumentID, false).
+		Return(nil, pkg.ValidateBusinessError(cn.ErrInstrumentNotFound, cn.EntityInstrument))
+	m.repo.EXPECT().ClearPending(gomock.Any(), orgID, removed, gomock.Any()).Return(nil)
+
+	m.holders.EXPECT().Find(gomock.Any(), orgID, failingID, false).Return(nil, errors.New("connection reset"))
+	m.repo.EXPECT().MarkPending(gomock.Any(), orgID, failing).Return(nil)
+
+	svc.retryPending(ctx, orgID)
+}
+
+func TestStartPendingRetry_RunsOncePerInterval(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	svc.retryInterval = time.Hour
+
+	done := make(chan struct{})
+
+	m.repo.EXPECT().FindPending(gomock.Any(), orgID, gomock.Any()).
+		DoAndReturn(func(context.Context, string, http.QueryHeader) ([]*mmodel.PendingScreening, error) {
+			close(done)
+
+			return nil, nil
+		}).Times(1)
+
+	svc.startPendingRetry(context.Background(), orgID)
+	svc.startPendingRetry(context.Background(), orgID)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending screenings were not retried")
+	}
+}
+
+func TestReviewAlert_MapsDecisionToStatus(t *testing.T) {
+	svc, m := newTestService(t)
+	orgID := uuid.NewString()
+	alertID := uuid.New()
+
+	m.repo.EXPECT().ReviewAlert(gomock.Any(), orgID, alertID, mmodel.ScreeningAlertConfirmed, "compliance@example.com", "same passport", gomock.Any()).
+		Return(&mmodel.ScreeningAlert{ID: alertID, Status: mmodel.ScreeningAlertConfirmed}, nil)
+
+	alert, err := svc.ReviewAlert(context.Background(), orgID, alertID, &mmodel.ReviewScreeningAlertInput{
+		Decision: mmodel.ScreeningDecisionConfirm,
+		Reviewer: "compliance@example.com",
+		Note:     "same passport",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mmodel.ScreeningAlertConfirmed, alert.Status)
+
+	reviewed := pkg.ValidateBusinessError(cn.ErrScreeningAlertAlreadyReviewed, cn.EntityScreeningAlert, mmodel.ScreeningAlertConfirmed)
+	m.repo.EXPECT().ReviewAlert(gomock.Any(), orgID, alertID, mmodel.ScreeningAlertDismissed, "ops@example.com", "", gomock.Any()).
+		Return(nil, reviewed)
+
+	_, err = svc.ReviewAlert(context.Background(), orgID, alertID, &mmodel.ReviewScreeningAlertInput{
+		Decision: mmodel.ScreeningDecisionDismiss,
+		Reviewer: "ops@example.com",
+	})
+	assert.Equal(t, reviewed, err)
+}
@@ -8,8 +8,12 @@ import (
 	"github.com/LerianStudio/lib-observability/metrics"
 	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
 	libStreaming "github.com/LerianStudio/lib-streaming"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/export"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/holder"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/instrument"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/merge"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/adapters/mongodb/ownership"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/crm/services/encryption"
 	"github.com/LerianStudio/midaz/v4/pkg"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -42,6 +46,47 @@ type UseCase struct {
 	// idempotency nil-guard); bootstrap injects libStreaming.NewNoopEmitter()
 	// when STREAMING_ENABLED=false.
 	Streaming libStreaming.Emitter
+
+	// HolderKeys destroys a holder's data key when the holder is erased
+	// (crypto-shredding). A nil value — legacy encryption mode, where holders
+	// have no data key of their own — erases by removing the stored personal
+	// data only.
+	HolderKeys HolderKeyShredder
+
+	// ProtectionAudit records holder erasures as protection audit events. A nil
+	// value disables the audit (legacy mode has no audit store).
+	ProtectionAudit encryption.AuditWriter
+
+	// Screener screens holders and related parties against the organization's
+	// watch lists after they are stored. A nil value disables screening.
+	Screener HolderScreener
+
+	// OwnershipRepo stores the ownership and control relationships between
+	// holders from which beneficial owners are resolved.
+	OwnershipRepo ownership.Repository
+
+	// ExportRepo stores holder data exports and their encrypted archives.
+	ExportRepo export.Repository
+
+	// HolderLedger reads the accounts, balances and operations of a holder for
+	// its data export. Like LedgerAccounts it is a hard dependency of the export.
+	HolderLedger HolderLedgerReader
+
+	// MergeRepo stores the merges of duplicate holders into surviving ones.
+	MergeRepo merge.Repository
+
+	// HolderAccounts re-points the accounts of a merged-away holder to the
+	// surviving one. It is a hard dependency of MergeHolder.
+	HolderAccounts HolderAccountReassigner
+
+	// InstrumentAccounts applies instrument lifecycle transitions to the linked
+	// ledger account. A nil value disables the propagation: transitions then
+	// change the instrument only.
+	InstrumentAccounts InstrumentAccountLifecycle
+
+	// HolderPositions reads the accounts, balances and asset rates behind a
+	// holder's position view. It is a hard dependency of GetHolderPosition.
+	HolderPositions HolderPositionReader
 }
 
 // recordSpanError records err onto the span using the class-appropriate helper:
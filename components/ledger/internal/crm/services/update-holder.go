@@ -57,6 +57,7 @@ func (uc *UseCase) UpdateHolderByID(ctx context.Context, organizationID string,
 	}
 
 	uc.emitHolderUpdatedEvent(ctx, span, logger, updatedHolder, organizationID)
+	uc.screenHolder(ctx, organizationID, updatedHolder)
 
 	return updatedHolder, nil
 }
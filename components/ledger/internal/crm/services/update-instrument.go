@@ -112,6 +112,7 @@ func (uc *UseCase) UpdateInstrumentByID(ctx context.Context, organizationID stri
 	}
 
 	uc.emitInstrumentUpdatedEvent(ctx, span, logger, updatedInstrument, organizationID)
+	uc.screenRelatedParties(ctx, organizationID, updatedInstrument)
 
 	return updatedInstrument, nil
 }
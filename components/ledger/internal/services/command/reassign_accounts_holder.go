@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+)
+
+// ReassignAccountsHolder moves every account of fromHolderID within the
+// organization, across all ledgers and including deleted accounts, to
+// toHolderID. It backs the CRM duplicate holder merge and returns the number
+// of accounts moved; a repeated call moves none.
+func (uc *UseCase) ReassignAccountsHolder(ctx context.Context, organizationID, fromHolderID, toHolderID uuid.UUID) (int64, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "command.reassign_accounts_holder")
+	defer span.End()
+
+	moved, err := uc.AccountRepo.ReassignHolder(ctx, organizationID, fromHolderID, toHolderID)
+	if err != nil {
+		logger.Log(ctx, libLog.LevelError, "Error reassigning accounts holder on repo", libLog.Err(err))
+
+		libOpentelemetry.HandleSpanError(span, "Failed to reassign accounts holder on repo", err)
+
+		return 0, err
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Reassigned accounts holder",
+		libLog.String("holder_id", fromHolderID.String()),
+		libLog.String("survivor_id", toHolderID.String()),
+		libLog.Int("accounts", int(moved)))
+
+	return moved, nil
+}
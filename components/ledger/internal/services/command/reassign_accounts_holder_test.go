@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/account"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReassignAccountsHolder(t *testing.T) {
+	ctx := context.Background()
+	organizationID := uuid.New()
+	duplicateID := uuid.New()
+	survivorID := uuid.New()
+
+	t.Run("returns the accounts moved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockAccountRepo := account.NewMockRepository(ctrl)
+		uc := &UseCase{AccountRepo: mockAccountRepo}
+
+		mockAccountRepo.EXPECT().
+			ReassignHolder(gomock.Any(), organizationID, duplicateID, survivorID).
+			Return(int64(3), nil)
+
+		moved, err := uc.ReassignAccountsHolder(ctx, organizationID, duplicateID, survivorID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), moved)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockAccountRepo := account.NewMockRepository(ctrl)
+		uc := &UseCase{AccountRepo: mockAccountRepo}
+		expectedErr := errors.New("update failed")
+
+		mockAccountRepo.EXPECT().
+			ReassignHolder(gomock.Any(), organizationID, duplicateID, survivorID).
+			Return(int64(0), expectedErr)
+
+		moved, err := uc.ReassignAccountsHolder(ctx, organizationID, duplicateID, survivorID)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Zero(t, moved)
+	})
+}
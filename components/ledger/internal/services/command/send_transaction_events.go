@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -262,15 +263,42 @@ func (uc *UseCase) emitFeesAppliedEvent(ctx context.Context, span trace.Span, lo
 		return
 	}
 
+	packageVersion := packageAppliedVersion(tran.Metadata["packageAppliedVersion"])
 	appliedAt := tran.CreatedAt
 
 	pkgStreaming.EmitImportant(ctx, span, logger, uc.Streaming, events.FeesAppliedDefinition.Key(),
 		func(tenantID string) (libStreaming.EmitRequest, error) {
-			return events.NewFeesApplied(tran.ID, tran.OrganizationID, tran.LedgerID, packageID, appliedAt).
+			return events.NewFeesApplied(tran.ID, tran.OrganizationID, tran.LedgerID, packageID, packageVersion, appliedAt).
 				ToEmitRequest(tenantID, appliedAt)
 		})
 }
 
+// packageAppliedVersion reads the packageAppliedVersion metadata value. The fee
+// engine writes an int, but metadata that went through a JSON or Mongo round
+// trip comes back as float64, int32/int64 or a string, so each is accepted.
+// Anything else (including a transaction charged before versioning) is 0.
+func packageAppliedVersion(raw any) int {
+	switch v := raw.(type) {
+	case int:
+		return v
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0
+		}
+
+		return n
+	default:
+		return 0
+	}
+}
+
 // buildTransactionEventSource maps a persisted Transaction into the
 // wire-decoupled TransactionSource consumed by the events package
 // constructors. The mapping does the one heavy lift the events package
@@ -0,0 +1,874 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/lib-observability/metrics"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	libStreaming "github.com/LerianStudio/lib-streaming"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	billing_invoice "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_invoice"
+	billing_run "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	pkgStreaming "github.com/LerianStudio/midaz/v4/pkg/streaming"
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// BillingInvoiceService issues itemized billing invoices and collects them.
+//
+// An invoicing pass calculates the period exactly like BillingCalculateService
+// and issues one invoice per debited account and asset, with one line per
+// billing package. Invoices are keyed by (period, type, account, asset), so a
+// second pass for the same period and type returns the invoices already issued.
+//
+// Before a charge is invoiced it is claimed in the billing statements a run
+// records its charges in, under the charge's run idempotency key. Whichever
+// claims a charge first bills it, so a run and an invoice, or invoices of
+// passes of different types, never bill the same package, period and account
+// twice.
+//
+// Collection debits the invoiced account for what it can pay — the lesser of
+// its available balance and what the invoice has outstanding — and credits the
+// packages' credit accounts. A new invoice is collected as soon as it is
+// issued; an attempt that collects nothing schedules the next one from
+// model.BillingInvoiceRetrySchedule, and RunDunning performs the attempts that
+// are due and moves unpaid invoices past their due date to OVERDUE.
+type BillingInvoiceService struct {
+	calculator         BillingCalculator
+	billingInvoiceRepo billing_invoice.Repository
+	billingRunRepo     billing_run.Repository
+	poster             BillingTransactionPoster
+	resolver           feeshared.MidazResolver
+
+	// MetricsFactory emits the bounded domain_operations_total /
+	// domain_operation_duration_ms metrics for the issue, collect, write-off
+	// and dunning entrypoints via utils.RecordDomainOperation. Assigned at
+	// bootstrap; a nil value is a no-op so the binary runs with telemetry
+	// disabled.
+	MetricsFactory *metrics.MetricsFactory
+
+	// Streaming emits the invoice lifecycle events; nil disables event emission.
+	Streaming libStreaming.Emitter
+}
+
+// ErrNilBillingInvoiceRepo is returned when a nil billing invoice repository is provided.
+var ErrNilBillingInvoiceRepo = errors.New("BillingInvoice repository is required")
+
+// ErrNilBillingInvoiceResolver is returned when a nil MidazResolver is provided.
+var ErrNilBillingInvoiceResolver = errors.New("MidazResolver is required")
+
+// openBillingInvoiceStatuses are the statuses an invoice is still collected in.
+var openBillingInvoiceStatuses = []string{
+	model.BillingInvoiceStatusIssued,
+	model.BillingInvoiceStatusPartiallyPaid,
+	model.BillingInvoiceStatusOverdue,
+}
+
+// NewBillingInvoiceService creates a new BillingInvoiceService with validated dependencies.
+func NewBillingInvoiceService(
+	calculator BillingCalculator,
+	repo billing_invoice.Repository,
+	runRepo billing_run.Repository,
+	poster BillingTransactionPoster,
+	resolver feeshared.MidazResolver,
+) (*BillingInvoiceService, error) {
+	if calculator == nil {
+		return nil, ErrNilBillingCalculator
+	}
+
+	if repo == nil {
+		return nil, ErrNilBillingInvoiceRepo
+	}
+
+	if runRepo == nil {
+		return nil, ErrNilBillingRunRepo
+	}
+
+	if poster == nil {
+		return nil, ErrNilBillingTransactionPoster
+	}
+
+	if resolver == nil {
+		return nil, ErrNilBillingInvoiceResolver
+	}
+
+	return &BillingInvoiceService{
+		calculator:         calculator,
+		billingInvoiceRepo: repo,
+		billingRunRepo:     runRepo,
+		poster:             poster,
+		resolver:           resolver,
+	}, nil
+}
+
+// invoiceGroup identifies the invoice a charge belongs to.
+type invoiceGroup struct {
+	accountAlias string
+	assetCode    string
+}
+
+// Issue runs an invoicing pass for the requested ledger and period. Invoices a
+// previous pass issued are returned unchanged and counted as existing; charges
+// a billing run or an invoice of another type bills are left out and counted
+// as already billed; new invoices are collected immediately. A rejected collection does not fail the
+// pass: it is recorded on the invoice and retried on schedule.
+func (s *BillingInvoiceService) Issue(ctx context.Context, req model.BillingInvoiceRequest) (_ *model.BillingInvoiceIssueResult, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.issue")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "issue_billing_invoices", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", req.OrganizationID),
+		attribute.String("app.request.ledger_id", req.LedgerID),
+		attribute.String("app.request.period", req.Period),
+		attribute.String("app.request.type", req.Type),
+	)
+
+	// Calculate validates the org/ledger UUIDs and the period, so the parses
+	// below cannot fail once it returns successfully.
+	calculation, err := s.calculator.Calculate(ctx, req.CalculateRequest())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing calculation failed", err)
+
+		return nil, err
+	}
+
+	orgUUID := uuid.MustParse(req.OrganizationID)
+	ledgerUUID := uuid.MustParse(req.LedgerID)
+
+	now := time.Now().UTC()
+	issuedAt := now.Format(time.RFC3339)
+	dueDate := now.AddDate(0, 0, req.GetDueInDays()).Format(time.RFC3339)
+
+	result := &model.BillingInvoiceIssueResult{
+		Period:      req.Period,
+		TotalAmount: decimal.Zero,
+	}
+
+	drafts, order, err := draftBillingInvoices(calculation.Results, func(charge *model.BillingStatement) (bool, error) {
+		claimed, errClaim := s.claimCharge(ctx, req, charge, issuedAt)
+		if errClaim == nil && !claimed {
+			result.TotalAlreadyBilled++
+		}
+
+		return claimed, errClaim
+	})
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to itemize billing results into invoices", err)
+
+		return nil, err
+	}
+
+	result.Invoices = make([]*model.BillingInvoice, 0, len(order))
+
+	for _, group := range order {
+		draft := drafts[group]
+
+		draft.ID = uuid.NewString()
+		draft.OrganizationID = req.OrganizationID
+		draft.LedgerID = req.LedgerID
+		draft.Period = req.Period
+		draft.Type = req.Type
+		draft.Status = model.BillingInvoiceStatusIssued
+		draft.InvoiceKey = model.BillingInvoiceKey(req.Period, req.Type, group.accountAlias, group.assetCode)
+		draft.DueDate = dueDate
+		draft.IssuedAt = issuedAt
+		draft.CreatedAt = issuedAt
+		draft.UpdatedAt = issuedAt
+
+		invoice, created, errInsert := s.billingInvoiceRepo.InsertOrGetInvoice(ctx, draft)
+		if errInsert != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to persist billing invoice", errInsert)
+
+			return nil, errInsert
+		}
+
+		result.TotalAmount = result.TotalAmount.Add(invoice.TotalAmount)
+
+		if !created {
+			result.TotalExisting++
+			result.Invoices = append(result.Invoices, invoice)
+
+			continue
+		}
+
+		result.TotalIssued++
+
+		s.emitBillingInvoiceEvent(ctx, span, logger, events.FeesBillingInvoiceIssuedDefinition, invoice)
+
+		invoice, _, err = s.collect(ctx, orgUUID, ledgerUUID, invoice)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to record billing invoice collection", err)
+
+			return nil, billingInvoiceUpdateError(err, draft.ID)
+		}
+
+		result.Invoices = append(result.Invoices, invoice)
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Billing invoices issued",
+		libLog.String("period", req.Period),
+		libLog.Int("total_issued", result.TotalIssued),
+		libLog.Int("total_existing", result.TotalExisting),
+		libLog.Int("total_already_billed", result.TotalAlreadyBilled),
+	)
+
+	return result, nil
+}
+
+// GetInvoice returns a billing invoice.
+func (s *BillingInvoiceService) GetInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	invoice, err := s.findInvoice(ctx, organizationID, invoiceID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing invoice", err)
+
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// ListInvoices returns a page of the invoices matching filter.
+func (s *BillingInvoiceService) ListInvoices(ctx context.Context, filter model.BillingInvoiceFilter, limit, page int) ([]*model.BillingInvoice, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.list")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", filter.OrganizationID),
+		attribute.String("app.request.ledger_id", filter.LedgerID),
+		attribute.String("app.request.period", filter.Period),
+	)
+
+	invoices, total, err := s.billingInvoiceRepo.FindInvoices(ctx, filter, limit, page)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list billing invoices", err)
+
+		return nil, 0, err
+	}
+
+	return invoices, total, nil
+}
+
+// Collect attempts to collect an open invoice now, regardless of its retry
+// schedule. An attempt that collects nothing is recorded on the invoice and
+// is not an error.
+func (s *BillingInvoiceService) Collect(ctx context.Context, organizationID, invoiceID uuid.UUID) (_ *model.BillingInvoice, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.collect")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "collect_billing_invoice", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	invoice, err := s.findInvoice(ctx, organizationID, invoiceID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing invoice", err)
+
+		return nil, err
+	}
+
+	if invoice.IsClosed() {
+		err = pkg.ValidateBusinessError(constant.ErrBillingInvoiceTransitionInvalid, "BillingInvoice", invoice.ID, invoice.Status, "collected")
+
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice is closed", err)
+
+		return nil, err
+	}
+
+	ledgerUUID, err := uuid.Parse(invoice.LedgerID)
+	if err != nil {
+		return nil, fmt.Errorf("billing invoice %s: invalid ledger id %q: %w", invoice.ID, invoice.LedgerID, err)
+	}
+
+	invoice, _, err = s.collect(ctx, organizationID, ledgerUUID, invoice)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to record billing invoice collection", err)
+
+		return nil, billingInvoiceUpdateError(err, invoiceID.String())
+	}
+
+	return invoice, nil
+}
+
+// WriteOff stops the collection of an open invoice. What was already paid
+// stays paid; the remainder is no longer collected. A payment an interrupted
+// attempt left pending is settled first, so a collection that did reach the
+// ledger is recorded before the invoice closes.
+func (s *BillingInvoiceService) WriteOff(ctx context.Context, organizationID, invoiceID uuid.UUID) (_ *model.BillingInvoice, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.write_off")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "write_off_billing_invoice", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_invoice_id", invoiceID.String()),
+	)
+
+	invoice, err := s.findInvoice(ctx, organizationID, invoiceID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing invoice", err)
+
+		return nil, err
+	}
+
+	if invoice.PendingPayment != nil {
+		ledgerUUID, errParse := uuid.Parse(invoice.LedgerID)
+		if errParse != nil {
+			return nil, fmt.Errorf("billing invoice %s: invalid ledger id %q: %w", invoice.ID, invoice.LedgerID, errParse)
+		}
+
+		invoice, _, err = s.collect(ctx, organizationID, ledgerUUID, invoice)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to settle pending billing invoice payment", err)
+
+			return nil, billingInvoiceUpdateError(err, invoiceID.String())
+		}
+	}
+
+	if !invoice.CanTransitionTo(model.BillingInvoiceStatusWrittenOff) {
+		err = pkg.ValidateBusinessError(constant.ErrBillingInvoiceTransitionInvalid, "BillingInvoice", invoice.ID, invoice.Status, "written off")
+
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice cannot be written off", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	invoice.Status = model.BillingInvoiceStatusWrittenOff
+	invoice.WrittenOffAt = &now
+	invoice.NextPaymentAttemptAt = nil
+	invoice.UpdatedAt = now
+
+	invoice, err = s.billingInvoiceRepo.UpdateInvoice(ctx, invoice)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to write off billing invoice", err)
+
+		return nil, billingInvoiceUpdateError(err, invoiceID.String())
+	}
+
+	s.emitBillingInvoiceEvent(ctx, span, logger, events.FeesBillingInvoiceWrittenOffDefinition, invoice)
+
+	return invoice, nil
+}
+
+// RunDunning performs one dunning pass over a ledger: unpaid invoices past
+// their due date move to OVERDUE, then every open invoice whose next payment
+// attempt is due is collected. An invoice another operation changed since the
+// pass listed it is skipped; the next pass sees its new state. It is meant to
+// be triggered periodically.
+func (s *BillingInvoiceService) RunDunning(ctx context.Context, organizationID, ledgerID uuid.UUID) (_ *model.BillingDunningResult, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.run_dunning")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "run_billing_dunning", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.ledger_id", ledgerID.String()),
+	)
+
+	result := &model.BillingDunningResult{}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	pastDue, _, err := s.billingInvoiceRepo.FindInvoices(ctx, model.BillingInvoiceFilter{
+		OrganizationID: organizationID.String(),
+		LedgerID:       ledgerID.String(),
+		Statuses:       []string{model.BillingInvoiceStatusIssued, model.BillingInvoiceStatusPartiallyPaid},
+		DueBefore:      now,
+	}, 0, 0)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list past-due billing invoices", err)
+
+		return nil, err
+	}
+
+	for _, invoice := range pastDue {
+		invoice.Status = model.BillingInvoiceStatusOverdue
+		invoice.UpdatedAt = now
+
+		updated, errUpdate := s.billingInvoiceRepo.UpdateInvoice(ctx, invoice)
+		if errors.Is(errUpdate, constant.ErrBillingInvoiceRevisionConflict) {
+			logSkippedBillingInvoice(ctx, logger, invoice.ID)
+
+			continue
+		}
+
+		if errUpdate != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to mark billing invoice overdue", errUpdate)
+
+			return nil, errUpdate
+		}
+
+		result.MarkedOverdue++
+
+		s.emitBillingInvoiceEvent(ctx, span, logger, events.FeesBillingInvoiceOverdueDefinition, updated)
+	}
+
+	due, _, err := s.billingInvoiceRepo.FindInvoices(ctx, model.BillingInvoiceFilter{
+		OrganizationID:          organizationID.String(),
+		LedgerID:                ledgerID.String(),
+		Statuses:                openBillingInvoiceStatuses,
+		PaymentAttemptDueBefore: now,
+	}, 0, 0)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list billing invoices due for collection", err)
+
+		return nil, err
+	}
+
+	for _, invoice := range due {
+		updated, collected, errCollect := s.collect(ctx, organizationID, ledgerID, invoice)
+		if errors.Is(errCollect, constant.ErrBillingInvoiceRevisionConflict) {
+			logSkippedBillingInvoice(ctx, logger, invoice.ID)
+
+			continue
+		}
+
+		if errCollect != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to record billing invoice collection", errCollect)
+
+			return nil, errCollect
+		}
+
+		result.Attempted++
+
+		switch {
+		case !collected:
+			result.Failed++
+		case updated.Status == model.BillingInvoiceStatusPaid:
+			result.Paid++
+		default:
+			result.PartiallyPaid++
+		}
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Billing dunning pass finished",
+		libLog.String("ledger_id", ledgerID.String()),
+		libLog.Int("marked_overdue", result.MarkedOverdue),
+		libLog.Int("attempted", result.Attempted),
+		libLog.Int("failed", result.Failed),
+	)
+
+	return result, nil
+}
+
+// findInvoice loads an invoice, mapping a missing document onto ErrBillingInvoiceNotFound.
+func (s *BillingInvoiceService) findInvoice(ctx context.Context, organizationID, invoiceID uuid.UUID) (*model.BillingInvoice, error) {
+	invoice, err := s.billingInvoiceRepo.FindInvoiceByID(ctx, invoiceID.String(), organizationID.String())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkg.ValidateBusinessError(constant.ErrBillingInvoiceNotFound, "BillingInvoice", invoiceID.String())
+		}
+
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// billingInvoiceUpdateError maps an update made from a stale copy of the
+// invoice onto ErrBillingInvoiceRevisionConflict; any other error is returned
+// as is.
+func billingInvoiceUpdateError(err error, invoiceID string) error {
+	if errors.Is(err, constant.ErrBillingInvoiceRevisionConflict) {
+		return pkg.ValidateBusinessError(constant.ErrBillingInvoiceRevisionConflict, "BillingInvoice", invoiceID)
+	}
+
+	return err
+}
+
+// logSkippedBillingInvoice records that a dunning pass left an invoice to the
+// operation that changed it concurrently.
+func logSkippedBillingInvoice(ctx context.Context, logger libLog.Logger, invoiceID string) {
+	logger.Log(ctx, libLog.LevelInfo, "Billing invoice changed concurrently; skipped by dunning",
+		libLog.String("billing_invoice_id", invoiceID),
+	)
+}
+
+// collect performs one payment attempt on an open invoice and persists the
+// outcome. The boolean reports whether the attempt collected anything. The
+// returned error is a resolution or persistence failure only; an empty
+// balance or a rejected posting is recorded on the invoice, which schedules
+// its next attempt from model.BillingInvoiceRetrySchedule.
+//
+// The attempt, with its idempotency key and amount, is persisted on the
+// invoice as its pending payment before anything is posted. An invoice that
+// already has one was interrupted mid-attempt: the pending payment is posted
+// again under its key, so it replays rather than collecting twice. Either way
+// the payment applied is the amount of the transaction the ledger reports.
+func (s *BillingInvoiceService) collect(ctx context.Context, organizationID, ledgerID uuid.UUID, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_invoice.collect_attempt")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.billing_invoice_id", invoice.ID))
+
+	previousStatus := invoice.Status
+
+	if invoice.PendingPayment == nil {
+		available, err := s.resolver.AvailableBalance(ctx, organizationID, ledgerID, invoice.AccountAlias, invoice.AssetCode)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to read available balance", err)
+
+			return nil, false, err
+		}
+
+		amount := decimal.Min(available, invoice.Outstanding())
+
+		invoice.PaymentAttempts++
+
+		if !amount.IsPositive() {
+			return s.recordCollection(ctx, span, logger, invoice, previousStatus, nil,
+				fmt.Errorf("insufficient funds: no available %s balance", invoice.AssetCode))
+		}
+
+		now := time.Now().UTC()
+		nowStr := now.Format(time.RFC3339)
+
+		// Should this attempt be interrupted before its outcome is recorded,
+		// dunning resumes it after the first retry delay.
+		next := now.Add(model.BillingInvoiceRetrySchedule[0]).Format(time.RFC3339)
+
+		invoice.PendingPayment = &model.BillingInvoicePendingPayment{
+			Amount:         amount,
+			IdempotencyKey: model.BillingInvoicePaymentKey(invoice.ID, invoice.PaymentAttempts),
+			StartedAt:      nowStr,
+		}
+		invoice.NextPaymentAttemptAt = &next
+		invoice.UpdatedAt = nowStr
+
+		invoice, err = s.billingInvoiceRepo.UpdateInvoice(ctx, invoice)
+		if err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to persist billing invoice payment attempt", err)
+
+			return nil, false, err
+		}
+	}
+
+	pending := invoice.PendingPayment
+
+	posting, errPost := s.poster.PostBillingTransaction(ctx, organizationID, ledgerID, BuildInvoicePaymentPayload(ctx, invoice, pending.Amount), pending.IdempotencyKey)
+
+	return s.recordCollection(ctx, span, logger, invoice, previousStatus, posting, errPost)
+}
+
+// recordCollection persists the outcome of a payment attempt: the posting it
+// made, or failure when it made none. It clears the pending payment and
+// schedules the next attempt.
+func (s *BillingInvoiceService) recordCollection(ctx context.Context, span trace.Span, logger libLog.Logger, invoice *model.BillingInvoice, previousStatus string, posting *model.BillingPosting, failure error) (*model.BillingInvoice, bool, error) {
+	now := time.Now().UTC()
+	nowStr := now.Format(time.RFC3339)
+
+	collected := failure == nil
+
+	if collected {
+		invoice.ApplyPayment(model.BillingInvoicePayment{
+			Amount:         posting.Amount,
+			TransactionID:  posting.TransactionID,
+			IdempotencyKey: invoice.PendingPayment.IdempotencyKey,
+			PaidAt:         nowStr,
+		})
+
+		invoice.FailedAttempts = 0
+		invoice.LastPaymentError = nil
+
+		// A partial payment drained the balance; try the rest after the
+		// first retry delay.
+		if !invoice.IsClosed() {
+			next := now.Add(model.BillingInvoiceRetrySchedule[0]).Format(time.RFC3339)
+			invoice.NextPaymentAttemptAt = &next
+		}
+	} else {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing invoice collection failed", failure)
+		logger.Log(ctx, libLog.LevelWarn, "Billing invoice collection failed",
+			libLog.String("billing_invoice_id", invoice.ID),
+			libLog.Err(failure),
+		)
+
+		reason := failure.Error()
+
+		invoice.FailedAttempts++
+		invoice.LastPaymentError = &reason
+		invoice.NextPaymentAttemptAt = nil
+
+		if invoice.FailedAttempts <= len(model.BillingInvoiceRetrySchedule) {
+			next := now.Add(model.BillingInvoiceRetrySchedule[invoice.FailedAttempts-1]).Format(time.RFC3339)
+			invoice.NextPaymentAttemptAt = &next
+		}
+	}
+
+	invoice.PendingPayment = nil
+	invoice.UpdatedAt = nowStr
+
+	updated, err := s.billingInvoiceRepo.UpdateInvoice(ctx, invoice)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to persist billing invoice collection", err)
+
+		return nil, false, err
+	}
+
+	if updated.Status != previousStatus {
+		switch updated.Status {
+		case model.BillingInvoiceStatusPaid:
+			s.emitBillingInvoiceEvent(ctx, span, logger, events.FeesBillingInvoicePaidDefinition, updated)
+		case model.BillingInvoiceStatusPartiallyPaid:
+			s.emitBillingInvoiceEvent(ctx, span, logger, events.FeesBillingInvoicePartiallyPaidDefinition, updated)
+		}
+	}
+
+	return updated, collected, nil
+}
+
+// emitBillingInvoiceEvent publishes the fee-billing-invoices lifecycle event
+// def for invoice. IMPORTANT posture.
+func (s *BillingInvoiceService) emitBillingInvoiceEvent(ctx context.Context, span trace.Span, logger libLog.Logger, def events.Definition, invoice *model.BillingInvoice) {
+	pkgStreaming.EmitImportant(ctx, span, logger, s.Streaming, def.Key(),
+		func(tenantID string) (libStreaming.EmitRequest, error) {
+			ts, err := time.Parse(time.RFC3339, invoice.UpdatedAt)
+			if err != nil {
+				return libStreaming.EmitRequest{}, err
+			}
+
+			return events.NewFeesBillingInvoice(
+				invoice.ID, invoice.OrganizationID, invoice.LedgerID, invoice.Period,
+				invoice.Status, invoice.AssetCode, invoice.TotalAmount.String(),
+				invoice.PaidAmount.String(), invoice.AmountDue.String(),
+				invoice.DueDate, invoice.UpdatedAt,
+			).ToEmitRequest(def, tenantID, ts)
+		})
+}
+
+// claimCharge claims a charge for the invoice of its account and asset by
+// recording it as an invoiced billing statement under the charge's run
+// idempotency key. It reports whether the charge is this invoice's to bill:
+// false when a billing run or an invoice with another key claimed it first.
+func (s *BillingInvoiceService) claimCharge(ctx context.Context, req model.BillingInvoiceRequest, charge *model.BillingStatement, at string) (bool, error) {
+	invoiceKey := model.BillingInvoiceKey(req.Period, req.Type, charge.AccountAlias, charge.AssetCode)
+
+	claim := *charge
+	claim.ID = uuid.NewString()
+	claim.OrganizationID = req.OrganizationID
+	claim.LedgerID = req.LedgerID
+	claim.Status = model.BillingStatementStatusInvoiced
+	claim.CreatedAt = at
+	claim.UpdatedAt = at
+	claim.Metadata = make(map[string]any, len(charge.Metadata)+1)
+
+	for key, value := range charge.Metadata {
+		claim.Metadata[key] = value
+	}
+
+	claim.Metadata["billingInvoiceKey"] = invoiceKey
+
+	statement, _, err := s.billingRunRepo.InsertOrGetStatement(ctx, &claim)
+	if err != nil {
+		return false, err
+	}
+
+	return statement.IsInvoiced() && statement.Metadata["billingInvoiceKey"] == invoiceKey, nil
+}
+
+// draftBillingInvoices itemizes calculation results into one draft invoice
+// per debited account and asset, returning the groups in the order they first
+// appear. Each charge claim accepts becomes a line; totals are summed from the
+// lines, and an account left without lines gets no invoice.
+func draftBillingInvoices(results []model.BillingCalculationResult, claim func(*model.BillingStatement) (bool, error)) (map[invoiceGroup]*model.BillingInvoice, []invoiceGroup, error) {
+	drafts := make(map[invoiceGroup]*model.BillingInvoice)
+	order := make([]invoiceGroup, 0)
+
+	for _, result := range results {
+		charges, err := splitBillingResult(result)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(charges) == 0 {
+			continue
+		}
+
+		discount := volumeDiscount(result)
+
+		for _, charge := range charges {
+			claimed, err := claim(charge)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !claimed {
+				continue
+			}
+
+			group := invoiceGroup{accountAlias: charge.AccountAlias, assetCode: charge.AssetCode}
+
+			draft, ok := drafts[group]
+			if !ok {
+				draft = &model.BillingInvoice{
+					AccountAlias:   charge.AccountAlias,
+					AssetCode:      charge.AssetCode,
+					Lines:          make([]model.BillingInvoiceLine, 0, 1),
+					GrossAmount:    decimal.Zero,
+					DiscountAmount: decimal.Zero,
+					TotalAmount:    decimal.Zero,
+					PaidAmount:     decimal.Zero,
+					Payments:       make([]model.BillingInvoicePayment, 0),
+				}
+				drafts[group] = draft
+				order = append(order, group)
+			}
+
+			line := billingInvoiceLine(charge, discount)
+
+			draft.Lines = append(draft.Lines, line)
+			draft.GrossAmount = draft.GrossAmount.Add(line.GrossAmount)
+			draft.DiscountAmount = draft.DiscountAmount.Add(line.DiscountAmount)
+			draft.TotalAmount = draft.TotalAmount.Add(line.NetAmount)
+			draft.AmountDue = draft.TotalAmount
+		}
+	}
+
+	return drafts, order, nil
+}
+
+// billingInvoiceLine itemizes one charge. A volume charge is priced per
+// billable event at the tier rate the calculation applied, less the discount
+// tier it reached; a maintenance charge is one unit at the package fee.
+func billingInvoiceLine(charge *model.BillingStatement, discount map[string]any) model.BillingInvoiceLine {
+	line := model.BillingInvoiceLine{
+		BillingPackageID:    charge.BillingPackageID,
+		BillingPackageLabel: charge.BillingPackageLabel,
+		BillingType:         charge.BillingType,
+		CreditAccountAlias:  charge.CreditAccountAlias,
+		Quantity:            1,
+		UnitPrice:           charge.Amount,
+		GrossAmount:         charge.Amount,
+		DiscountAmount:      decimal.Zero,
+		NetAmount:           charge.Amount,
+		PaidAmount:          decimal.Zero,
+	}
+
+	if charge.BillingType != model.BillingPackageTypeVolume {
+		return line
+	}
+
+	line.PricingModel, _ = charge.Metadata["pricingModel"].(string)
+	line.TotalEvents = metadataInt(charge.Metadata, "totalEvents")
+	line.FreeQuotaUsed = metadataInt(charge.Metadata, "freeQuotaUsed")
+
+	if raw, ok := charge.Metadata["grossAmount"].(string); ok {
+		if gross, err := decimal.NewFromString(raw); err == nil {
+			line.GrossAmount = gross
+			line.DiscountAmount = gross.Sub(line.NetAmount)
+		}
+	}
+
+	if raw, ok := discount["discountPercentage"].(string); ok {
+		if percentage, err := decimal.NewFromString(raw); err == nil {
+			line.DiscountPercentage = &percentage
+		}
+	}
+
+	if billable := metadataInt(charge.Metadata, "billableEvents"); billable > 0 {
+		line.Quantity = billable
+		line.UnitPrice = line.GrossAmount.Div(decimal.NewFromInt(billable))
+	}
+
+	return line
+}
+
+// volumeDiscount returns the discount breakdown of a volume result's payload,
+// which flatBillingMetadata drops from the charges, or nil when it has none.
+func volumeDiscount(result model.BillingCalculationResult) map[string]any {
+	var payload struct {
+		Metadata struct {
+			Discount map[string]any `json:"discount"`
+		} `json:"metadata"`
+	}
+
+	if err := json.Unmarshal(result.TransactionPayload, &payload); err != nil {
+		return nil
+	}
+
+	return payload.Metadata.Discount
+}
+
+// metadataInt reads an integer metadata entry decoded from a JSON payload.
+func metadataInt(metadata map[string]any, key string) int64 {
+	switch v := metadata[key].(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	}
+
+	return 0
+}
@@ -0,0 +1,628 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+
+	billing_invoice "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_invoice"
+	billing_run "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	pkgStreaming "github.com/LerianStudio/midaz/v4/pkg/streaming"
+)
+
+func newTestBillingInvoiceService(t *testing.T, calculator BillingCalculator, poster BillingTransactionPoster) (*BillingInvoiceService, *billing_invoice.MockRepository, *billing_run.MockRepository, *feeshared.MockMidazResolver, *pkgStreaming.MockEmitter) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockRepo := billing_invoice.NewMockRepository(ctrl)
+	mockStatements := billing_run.NewMockRepository(ctrl)
+	mockResolver := feeshared.NewMockMidazResolver(ctrl)
+
+	svc, err := NewBillingInvoiceService(calculator, mockRepo, mockStatements, poster, mockResolver)
+	require.NoError(t, err)
+
+	emitter := pkgStreaming.NewMockEmitter()
+	svc.Streaming = emitter
+
+	return svc, mockRepo, mockStatements, mockResolver, emitter
+}
+
+// claimAsNew stores an invoice's claim on a charge as a new statement.
+func claimAsNew(_ context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+	return statement, true, nil
+}
+
+// volumeCalculationResult returns a volume result charging debitAlias for
+// events at unitPrice, with discountPercentage off the gross amount.
+func volumeCalculationResult(t *testing.T, packageID, debitAlias string, events int64, unitPrice, discountPercentage decimal.Decimal) model.BillingCalculationResult {
+	t.Helper()
+
+	credit, pricing := "@volume-revenue", model.PricingModelFixed
+	bp := model.BillingPackage{
+		ID:                 packageID,
+		Label:              "Monthly Volume",
+		Type:               model.BillingPackageTypeVolume,
+		AssetCode:          stringPtr("BRL"),
+		PricingModel:       &pricing,
+		DebitAccountAlias:  &debitAlias,
+		CreditAccountAlias: &credit,
+	}
+
+	gross := unitPrice.Mul(decimal.NewFromInt(events))
+	discount := &model.DiscountDetail{
+		DiscountPercentage: discountPercentage,
+		DiscountAmount:     gross.Mul(discountPercentage).Div(decimal.NewFromInt(100)),
+		MinQuantity:        1000,
+	}
+	net := gross.Sub(discount.DiscountAmount)
+
+	payload, err := json.Marshal(BuildVolumePayload(context.Background(), bp, "2026-01", events, net, discount))
+	require.NoError(t, err)
+
+	return model.BillingCalculationResult{
+		BillingPackageID:    packageID,
+		BillingPackageLabel: bp.Label,
+		BillingType:         model.BillingPackageTypeVolume,
+		Period:              "2026-01",
+		TotalAccounts:       1,
+		TotalCharged:        1,
+		TotalNetAmount:      net,
+		TransactionPayload:  payload,
+	}
+}
+
+// openInvoice returns an ISSUED invoice of @alice with one maintenance line.
+func openInvoice(ledgerID string, total decimal.Decimal) *model.BillingInvoice {
+	return &model.BillingInvoice{
+		ID:             uuid.NewString(),
+		LedgerID:       ledgerID,
+		Period:         "2026-01",
+		AccountAlias:   "@alice",
+		AssetCode:      "BRL",
+		Status:         model.BillingInvoiceStatusIssued,
+		Lines:          []model.BillingInvoiceLine{{CreditAccountAlias: "@maintenance-revenue", NetAmount: total}},
+		TotalAmount:    total,
+		AmountDue:      total,
+		DueDate:        "2026-02-16T00:00:00Z",
+		IssuedAt:       "2026-02-01T00:00:00Z",
+		CreatedAt:      "2026-02-01T00:00:00Z",
+		UpdatedAt:      "2026-02-01T00:00:00Z",
+		InvoiceKey:     model.BillingInvoiceKey("2026-01", "", "@alice", "BRL"),
+		OrganizationID: uuid.NewString(),
+	}
+}
+
+func returnInvoice(_ context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, error) {
+	return invoice, nil
+}
+
+func TestNewBillingInvoiceService_NilDependencies(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := billing_invoice.NewMockRepository(ctrl)
+	statements := billing_run.NewMockRepository(ctrl)
+	resolver := feeshared.NewMockMidazResolver(ctrl)
+
+	_, err := NewBillingInvoiceService(nil, repo, statements, &stubBillingPoster{}, resolver)
+	assert.ErrorIs(t, err, ErrNilBillingCalculator)
+
+	_, err = NewBillingInvoiceService(&stubBillingCalculator{}, nil, statements, &stubBillingPoster{}, resolver)
+	assert.ErrorIs(t, err, ErrNilBillingInvoiceRepo)
+
+	_, err = NewBillingInvoiceService(&stubBillingCalculator{}, repo, nil, &stubBillingPoster{}, resolver)
+	assert.ErrorIs(t, err, ErrNilBillingRunRepo)
+
+	_, err = NewBillingInvoiceService(&stubBillingCalculator{}, repo, statements, nil, resolver)
+	assert.ErrorIs(t, err, ErrNilBillingTransactionPoster)
+
+	_, err = NewBillingInvoiceService(&stubBillingCalculator{}, repo, statements, &stubBillingPoster{}, nil)
+	assert.ErrorIs(t, err, ErrNilBillingInvoiceResolver)
+}
+
+func TestBillingInvoiceService_Issue_ItemizesAndCollectsPerAccount(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID := uuid.NewString(), uuid.NewString()
+	volumeID, maintenanceID := uuid.NewString(), uuid.NewString()
+
+	calculation := maintenanceCalculation(t, maintenanceID, decimal.NewFromInt(15), "@alice", "@bob")
+	calculation.Results = append([]model.BillingCalculationResult{
+		volumeCalculationResult(t, volumeID, "@alice", 1100, decimal.RequireFromString("0.10"), decimal.NewFromInt(10)),
+	}, calculation.Results...)
+
+	poster := &stubBillingPoster{}
+	svc, mockRepo, mockStatements, mockResolver, emitter := newTestBillingInvoiceService(t, &stubBillingCalculator{response: calculation}, poster)
+
+	mockStatements.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(_ context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			assert.Equal(t, model.BillingStatementStatusInvoiced, statement.Status)
+			assert.Empty(t, statement.RunID)
+			assert.Equal(t, model.BillingIdempotencyKey(statement.BillingPackageID, "2026-01", statement.AccountAlias), statement.IdempotencyKey)
+			assert.Equal(t, model.BillingInvoiceKey("2026-01", "", statement.AccountAlias, "BRL"), statement.Metadata["billingInvoiceKey"])
+
+			return statement, true, nil
+		})
+	mockRepo.EXPECT().InsertOrGetInvoice(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error) {
+			assert.Equal(t, model.BillingInvoiceStatusIssued, invoice.Status)
+			assert.Equal(t, model.BillingInvoiceKey("2026-01", "", invoice.AccountAlias, "BRL"), invoice.InvoiceKey)
+
+			return invoice, true, nil
+		})
+	// @alice's attempt is persisted before it is posted, then recorded; @bob's
+	// empty balance is recorded without posting.
+	mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(returnInvoice)
+
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), gomock.Any(), gomock.Any(), "@alice", "BRL").Return(decimal.NewFromInt(50), nil)
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), gomock.Any(), gomock.Any(), "@bob", "BRL").Return(decimal.Zero, nil)
+
+	result, err := svc.Issue(context.Background(), model.BillingInvoiceRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err, "an uncollected invoice must not fail the pass")
+
+	assert.Equal(t, 2, result.TotalIssued)
+	assert.True(t, decimal.NewFromInt(129).Equal(result.TotalAmount), "99 volume + 15 + 15 maintenance")
+	require.Len(t, result.Invoices, 2)
+
+	alice, bob := result.Invoices[0], result.Invoices[1]
+
+	require.Len(t, alice.Lines, 2)
+
+	volume := alice.Lines[0]
+	assert.Equal(t, int64(1100), volume.Quantity)
+	assert.True(t, decimal.RequireFromString("0.10").Equal(volume.UnitPrice))
+	assert.True(t, decimal.NewFromInt(110).Equal(volume.GrossAmount))
+	assert.True(t, decimal.NewFromInt(11).Equal(volume.DiscountAmount))
+	require.NotNil(t, volume.DiscountPercentage)
+	assert.True(t, decimal.NewFromInt(10).Equal(*volume.DiscountPercentage))
+	assert.True(t, decimal.NewFromInt(50).Equal(volume.PaidAmount), "payments settle lines in order")
+	assert.Equal(t, int64(1), alice.Lines[1].Quantity)
+
+	assert.Equal(t, model.BillingInvoiceStatusPartiallyPaid, alice.Status)
+	assert.True(t, decimal.NewFromInt(64).Equal(alice.AmountDue))
+	require.NotNil(t, alice.NextPaymentAttemptAt, "the rest is collected on schedule")
+
+	require.Len(t, poster.posted, 1, "an empty balance is not posted")
+	assert.Equal(t, model.BillingInvoicePaymentKey(alice.ID, 1), poster.posted[0].idempotencyKey)
+	require.Len(t, poster.posted[0].input.Send.Distribute.To, 1)
+	assert.Equal(t, "@volume-revenue", poster.posted[0].input.Send.Distribute.To[0].AccountAlias)
+
+	assert.Equal(t, model.BillingInvoiceStatusIssued, bob.Status)
+	assert.Equal(t, 1, bob.FailedAttempts)
+	require.NotNil(t, bob.LastPaymentError)
+	require.NotNil(t, bob.NextPaymentAttemptAt)
+
+	pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "issued")
+	pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "partially-paid")
+}
+
+func TestBillingInvoiceService_Issue_ReturnsInvoicesAlreadyIssued(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID := uuid.NewString(), uuid.NewString()
+
+	calculator := &stubBillingCalculator{response: maintenanceCalculation(t, uuid.NewString(), decimal.NewFromInt(15), "@alice")}
+	poster := &stubBillingPoster{}
+	svc, mockRepo, mockStatements, _, emitter := newTestBillingInvoiceService(t, calculator, poster)
+
+	existing := openInvoice(ledgerID, decimal.NewFromInt(15))
+
+	// The previous pass claimed the charge for the same invoice.
+	mockStatements.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			return statement, false, nil
+		})
+	mockRepo.EXPECT().InsertOrGetInvoice(gomock.Any(), gomock.Any()).Return(existing, false, nil)
+
+	result, err := svc.Issue(context.Background(), model.BillingInvoiceRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, result.TotalIssued)
+	assert.Equal(t, 1, result.TotalExisting)
+	assert.Equal(t, []*model.BillingInvoice{existing}, result.Invoices)
+	assert.Empty(t, poster.posted, "an existing invoice is collected by dunning, not re-issued")
+	assert.Empty(t, emitter.Events())
+}
+
+func TestBillingInvoiceService_Issue_SecondPassOfAnotherTypeIssuesItsOwnInvoices(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID := uuid.NewString(), uuid.NewString()
+
+	calculation := &model.BillingCalculateResponse{Results: []model.BillingCalculationResult{
+		volumeCalculationResult(t, uuid.NewString(), "@alice", 100, decimal.RequireFromString("0.10"), decimal.Zero),
+	}}
+
+	svc, mockRepo, mockStatements, mockResolver, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{response: calculation}, &stubBillingPoster{})
+
+	mockStatements.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).DoAndReturn(claimAsNew)
+
+	// A maintenance pass already invoiced @alice for the period.
+	maintenance := openInvoice(ledgerID, decimal.NewFromInt(15))
+	maintenance.Type = model.BillingPackageTypeMaintenance
+	maintenance.InvoiceKey = model.BillingInvoiceKey("2026-01", model.BillingPackageTypeMaintenance, "@alice", "BRL")
+
+	mockRepo.EXPECT().InsertOrGetInvoice(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, invoice *model.BillingInvoice) (*model.BillingInvoice, bool, error) {
+			assert.Equal(t, model.BillingPackageTypeVolume, invoice.Type)
+			assert.Equal(t, model.BillingInvoiceKey("2026-01", model.BillingPackageTypeVolume, "@alice", "BRL"), invoice.InvoiceKey)
+			assert.NotEqual(t, maintenance.InvoiceKey, invoice.InvoiceKey, "the volume charges must not collide with the maintenance invoice")
+
+			return invoice, true, nil
+		})
+	mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).DoAndReturn(returnInvoice)
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), gomock.Any(), gomock.Any(), "@alice", "BRL").Return(decimal.Zero, nil)
+
+	result, err := svc.Issue(context.Background(), model.BillingInvoiceRequest{
+		OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01", Type: model.BillingPackageTypeVolume,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.TotalIssued)
+	assert.Equal(t, 0, result.TotalExisting)
+	require.Len(t, result.Invoices, 1)
+	assert.True(t, decimal.NewFromInt(10).Equal(result.Invoices[0].TotalAmount))
+}
+
+func TestBillingInvoiceService_Issue_SkipsChargesAlreadyBilled(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID, packageID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	calculator := &stubBillingCalculator{response: maintenanceCalculation(t, packageID, decimal.NewFromInt(15), "@alice", "@bob")}
+	poster := &stubBillingPoster{}
+	svc, _, mockStatements, _, emitter := newTestBillingInvoiceService(t, calculator, poster)
+
+	// A billing run posted @alice's charge; a maintenance pass invoiced @bob's.
+	mockStatements.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, statement *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			existing := *statement
+			existing.ID = uuid.NewString()
+
+			if statement.AccountAlias == "@alice" {
+				existing.RunID = uuid.NewString()
+				existing.Status = model.BillingStatementStatusPosted
+				existing.Metadata = map[string]any{"billingRunId": existing.RunID}
+
+				return &existing, false, nil
+			}
+
+			existing.Metadata = map[string]any{
+				"billingInvoiceKey": model.BillingInvoiceKey("2026-01", model.BillingPackageTypeMaintenance, "@bob", "BRL"),
+			}
+
+			return &existing, false, nil
+		})
+
+	result, err := svc.Issue(context.Background(), model.BillingInvoiceRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, result.TotalIssued)
+	assert.Equal(t, 2, result.TotalAlreadyBilled)
+	assert.Empty(t, result.Invoices)
+	assert.True(t, result.TotalAmount.IsZero())
+	assert.Empty(t, poster.posted, "a charge is billed once")
+	assert.Empty(t, emitter.Events())
+}
+
+func TestBillingInvoiceService_Collect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pays an overdue invoice in full", func(t *testing.T) {
+		t.Parallel()
+
+		poster := &stubBillingPoster{}
+		svc, mockRepo, _, mockResolver, emitter := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.Status = model.BillingInvoiceStatusOverdue
+		invoice.FailedAttempts = 2
+		invoice.PaymentAttempts = 2
+
+		orgID := uuid.MustParse(invoice.OrganizationID)
+
+		key := model.BillingInvoicePaymentKey(invoice.ID, 3)
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockResolver.EXPECT().AvailableBalance(gomock.Any(), orgID, gomock.Any(), "@alice", "BRL").Return(decimal.NewFromInt(1000), nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, pending *model.BillingInvoice) (*model.BillingInvoice, error) {
+					assert.Empty(t, poster.posted, "the attempt is persisted before it is posted")
+					require.NotNil(t, pending.PendingPayment)
+					assert.Equal(t, key, pending.PendingPayment.IdempotencyKey)
+					assert.True(t, decimal.NewFromInt(15).Equal(pending.PendingPayment.Amount))
+
+					return returnInvoice(ctx, pending)
+				}),
+			mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).DoAndReturn(returnInvoice),
+		)
+
+		paid, err := svc.Collect(context.Background(), orgID, uuid.MustParse(invoice.ID))
+		require.NoError(t, err)
+
+		assert.Nil(t, paid.PendingPayment)
+		assert.Equal(t, model.BillingInvoiceStatusPaid, paid.Status)
+		assert.True(t, paid.AmountDue.IsZero())
+		assert.Equal(t, 0, paid.FailedAttempts)
+		assert.NotNil(t, paid.PaidAt)
+		assert.Nil(t, paid.NextPaymentAttemptAt)
+
+		require.Len(t, poster.posted, 1)
+		assert.True(t, decimal.NewFromInt(15).Equal(poster.posted[0].input.Send.Value), "only what is outstanding is debited")
+		assert.Equal(t, key, poster.posted[0].idempotencyKey)
+
+		pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "paid")
+	})
+
+	t.Run("resumes an interrupted attempt with the replayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.PaymentAttempts = 3
+
+		key := model.BillingInvoicePaymentKey(invoice.ID, 3)
+		invoice.PendingPayment = &model.BillingInvoicePendingPayment{Amount: decimal.NewFromInt(15), IdempotencyKey: key, StartedAt: invoice.UpdatedAt}
+
+		original := &model.BillingPosting{TransactionID: uuid.NewString(), Amount: decimal.NewFromInt(12)}
+		poster := &stubBillingPoster{replayed: map[string]*model.BillingPosting{key: original}}
+
+		// No balance read: the pending payment is posted as it was persisted.
+		svc, mockRepo, _, _, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).DoAndReturn(returnInvoice)
+
+		resumed, err := svc.Collect(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+		require.NoError(t, err)
+
+		require.Len(t, poster.posted, 1)
+		assert.Equal(t, key, poster.posted[0].idempotencyKey, "the interrupted attempt replays under its key")
+		assert.Equal(t, 3, resumed.PaymentAttempts)
+		assert.Nil(t, resumed.PendingPayment)
+
+		require.Len(t, resumed.Payments, 1)
+		assert.Equal(t, original.TransactionID, resumed.Payments[0].TransactionID)
+		assert.True(t, decimal.NewFromInt(12).Equal(resumed.Payments[0].Amount), "the payment is what the ledger posted")
+		assert.Equal(t, model.BillingInvoiceStatusPartiallyPaid, resumed.Status)
+	})
+
+	t.Run("stale invoice is not posted", func(t *testing.T) {
+		t.Parallel()
+
+		poster := &stubBillingPoster{}
+		svc, mockRepo, _, mockResolver, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockResolver.EXPECT().AvailableBalance(gomock.Any(), gomock.Any(), gomock.Any(), "@alice", "BRL").Return(decimal.NewFromInt(15), nil)
+		mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Return(nil, constant.ErrBillingInvoiceRevisionConflict)
+
+		_, err := svc.Collect(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+
+		var conflict pkg.EntityConflictError
+		require.True(t, errors.As(err, &conflict))
+		assert.Equal(t, constant.ErrBillingInvoiceRevisionConflict.Error(), conflict.Code)
+		assert.Empty(t, poster.posted)
+	})
+
+	t.Run("stops scheduling once the retry schedule is exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		poster := &stubBillingPoster{reject: map[string]error{"@alice": errors.New("insufficient funds")}}
+		svc, mockRepo, _, mockResolver, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.FailedAttempts = len(model.BillingInvoiceRetrySchedule)
+
+		next := time.Now().UTC().Format(time.RFC3339)
+		invoice.NextPaymentAttemptAt = &next
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockResolver.EXPECT().AvailableBalance(gomock.Any(), gomock.Any(), gomock.Any(), "@alice", "BRL").Return(decimal.NewFromInt(5), nil)
+		mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(returnInvoice)
+
+		failed, err := svc.Collect(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+		require.NoError(t, err, "a rejected posting is recorded, not returned")
+
+		assert.Equal(t, model.BillingInvoiceStatusIssued, failed.Status)
+		assert.Equal(t, len(model.BillingInvoiceRetrySchedule)+1, failed.FailedAttempts)
+		assert.Equal(t, "insufficient funds", *failed.LastPaymentError)
+		assert.Nil(t, failed.NextPaymentAttemptAt)
+		assert.Nil(t, failed.PendingPayment)
+	})
+
+	t.Run("closed invoice", func(t *testing.T) {
+		t.Parallel()
+
+		svc, mockRepo, _, _, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, &stubBillingPoster{})
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.Status = model.BillingInvoiceStatusPaid
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+
+		_, err := svc.Collect(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+
+		var unprocessable pkg.UnprocessableOperationError
+		require.True(t, errors.As(err, &unprocessable))
+		assert.Equal(t, constant.ErrBillingInvoiceTransitionInvalid.Error(), unprocessable.Code)
+	})
+
+	t.Run("missing invoice", func(t *testing.T) {
+		t.Parallel()
+
+		svc, mockRepo, _, _, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, &stubBillingPoster{})
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mongo.ErrNoDocuments)
+
+		_, err := svc.Collect(context.Background(), uuid.New(), uuid.New())
+
+		var notFound pkg.EntityNotFoundError
+		require.True(t, errors.As(err, &notFound))
+		assert.Equal(t, constant.ErrBillingInvoiceNotFound.Error(), notFound.Code)
+	})
+}
+
+func TestBillingInvoiceService_WriteOff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("overdue invoice", func(t *testing.T) {
+		t.Parallel()
+
+		svc, mockRepo, _, _, emitter := newTestBillingInvoiceService(t, &stubBillingCalculator{}, &stubBillingPoster{})
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.Status = model.BillingInvoiceStatusOverdue
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).DoAndReturn(returnInvoice)
+
+		writtenOff, err := svc.WriteOff(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+		require.NoError(t, err)
+
+		assert.Equal(t, model.BillingInvoiceStatusWrittenOff, writtenOff.Status)
+		assert.NotNil(t, writtenOff.WrittenOffAt)
+		assert.Nil(t, writtenOff.NextPaymentAttemptAt)
+
+		pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "written-off")
+	})
+
+	t.Run("settles a pending payment first", func(t *testing.T) {
+		t.Parallel()
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.Status = model.BillingInvoiceStatusOverdue
+		invoice.PaymentAttempts = 1
+
+		key := model.BillingInvoicePaymentKey(invoice.ID, 1)
+		invoice.PendingPayment = &model.BillingInvoicePendingPayment{Amount: decimal.NewFromInt(5), IdempotencyKey: key, StartedAt: invoice.UpdatedAt}
+
+		poster := &stubBillingPoster{replayed: map[string]*model.BillingPosting{key: {TransactionID: uuid.NewString(), Amount: decimal.NewFromInt(5)}}}
+		svc, mockRepo, _, _, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+		mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(returnInvoice)
+
+		writtenOff, err := svc.WriteOff(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+		require.NoError(t, err)
+
+		assert.Equal(t, model.BillingInvoiceStatusWrittenOff, writtenOff.Status)
+		assert.Nil(t, writtenOff.PendingPayment)
+		assert.True(t, decimal.NewFromInt(5).Equal(writtenOff.PaidAmount), "the collection that reached the ledger stays recorded")
+	})
+
+	t.Run("paid invoice", func(t *testing.T) {
+		t.Parallel()
+
+		svc, mockRepo, _, _, _ := newTestBillingInvoiceService(t, &stubBillingCalculator{}, &stubBillingPoster{})
+
+		invoice := openInvoice(uuid.NewString(), decimal.NewFromInt(15))
+		invoice.Status = model.BillingInvoiceStatusPaid
+
+		mockRepo.EXPECT().FindInvoiceByID(gomock.Any(), invoice.ID, invoice.OrganizationID).Return(invoice, nil)
+
+		_, err := svc.WriteOff(context.Background(), uuid.MustParse(invoice.OrganizationID), uuid.MustParse(invoice.ID))
+
+		var unprocessable pkg.UnprocessableOperationError
+		require.True(t, errors.As(err, &unprocessable))
+		assert.Equal(t, constant.ErrBillingInvoiceTransitionInvalid.Error(), unprocessable.Code)
+	})
+}
+
+func TestBillingInvoiceService_RunDunning(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID := uuid.New(), uuid.New()
+
+	poster := &stubBillingPoster{}
+	svc, mockRepo, _, mockResolver, emitter := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+	pastDue := openInvoice(ledgerID.String(), decimal.NewFromInt(15))
+
+	funded := openInvoice(ledgerID.String(), decimal.NewFromInt(15))
+	funded.AccountAlias = "@carol"
+
+	empty := openInvoice(ledgerID.String(), decimal.NewFromInt(15))
+	empty.AccountAlias = "@dave"
+
+	mockRepo.EXPECT().FindInvoices(gomock.Any(), gomock.Any(), 0, 0).
+		DoAndReturn(func(_ context.Context, filter model.BillingInvoiceFilter, _, _ int) ([]*model.BillingInvoice, int64, error) {
+			assert.Equal(t, ledgerID.String(), filter.LedgerID)
+
+			if filter.DueBefore != "" {
+				assert.ElementsMatch(t, []string{model.BillingInvoiceStatusIssued, model.BillingInvoiceStatusPartiallyPaid}, filter.Statuses)
+
+				return []*model.BillingInvoice{pastDue}, 1, nil
+			}
+
+			assert.NotEmpty(t, filter.PaymentAttemptDueBefore)
+			assert.Contains(t, filter.Statuses, model.BillingInvoiceStatusOverdue)
+
+			return []*model.BillingInvoice{funded, empty}, 2, nil
+		}).Times(2)
+
+	mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(returnInvoice)
+
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), orgID, ledgerID, "@carol", "BRL").Return(decimal.NewFromInt(20), nil)
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), orgID, ledgerID, "@dave", "BRL").Return(decimal.Zero, nil)
+
+	result, err := svc.RunDunning(context.Background(), orgID, ledgerID)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.BillingDunningResult{MarkedOverdue: 1, Attempted: 2, Paid: 1, Failed: 1}, *result)
+	assert.Equal(t, model.BillingInvoiceStatusOverdue, pastDue.Status)
+	assert.Len(t, poster.posted, 1)
+
+	pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "overdue")
+	pkgStreaming.AssertEventEmitted(t, emitter, "fee-billing-invoices", "paid")
+}
+
+func TestBillingInvoiceService_RunDunning_SkipsInvoicesChangedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID := uuid.New(), uuid.New()
+
+	poster := &stubBillingPoster{}
+	svc, mockRepo, _, mockResolver, emitter := newTestBillingInvoiceService(t, &stubBillingCalculator{}, poster)
+
+	pastDue := openInvoice(ledgerID.String(), decimal.NewFromInt(15))
+	due := openInvoice(ledgerID.String(), decimal.NewFromInt(15))
+
+	mockRepo.EXPECT().FindInvoices(gomock.Any(), gomock.Any(), 0, 0).
+		DoAndReturn(func(_ context.Context, filter model.BillingInvoiceFilter, _, _ int) ([]*model.BillingInvoice, int64, error) {
+			if filter.DueBefore != "" {
+				return []*model.BillingInvoice{pastDue}, 1, nil
+			}
+
+			return []*model.BillingInvoice{due}, 1, nil
+		}).Times(2)
+
+	mockRepo.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(2).Return(nil, constant.ErrBillingInvoiceRevisionConflict)
+	mockResolver.EXPECT().AvailableBalance(gomock.Any(), orgID, ledgerID, "@alice", "BRL").Return(decimal.NewFromInt(15), nil)
+
+	result, err := svc.RunDunning(context.Background(), orgID, ledgerID)
+	require.NoError(t, err, "a concurrent change skips the invoice, not the pass")
+
+	assert.Equal(t, model.BillingDunningResult{}, *result)
+	assert.Empty(t, poster.posted)
+	assert.Empty(t, emitter.Events())
+}
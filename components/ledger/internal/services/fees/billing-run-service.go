@@ -0,0 +1,503 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/lib-observability/metrics"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+
+	billing_run "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// BillingCalculator is the calculation step a billing run wraps. It is
+// satisfied by BillingCalculateService.
+type BillingCalculator interface {
+	Calculate(ctx context.Context, req model.BillingCalculateRequest) (*model.BillingCalculateResponse, error)
+}
+
+// BillingTransactionPoster posts one billing charge through the ledger's normal
+// transaction path under the given idempotency key and returns the resulting
+// transaction. Posting the same key twice must replay, not re-charge.
+type BillingTransactionPoster interface {
+	PostBillingTransaction(ctx context.Context, organizationID, ledgerID uuid.UUID, input transaction.Transaction, idempotencyKey string) (*model.BillingPosting, error)
+}
+
+// BillingRunService turns billing calculations into posted ledger transactions.
+//
+// A run calculates the period exactly like BillingCalculateService, splits
+// every result into one charge per (package, period, account), persists each
+// charge as an immutable BillingStatement BEFORE posting it, and then posts it
+// under the statement's deterministic idempotency key. The statement store is
+// the durable double-charge guard (its key is unique per ledger); the ledger's
+// idempotency key covers the window between a successful post and the status
+// write. Rejected postings are recorded on the statement and re-attempted by
+// Retry or by a later run for the same period.
+type BillingRunService struct {
+	calculator     BillingCalculator
+	billingRunRepo billing_run.Repository
+	poster         BillingTransactionPoster
+
+	// MetricsFactory emits the bounded domain_operations_total /
+	// domain_operation_duration_ms metrics for the run and retry entrypoints via
+	// utils.RecordDomainOperation. Assigned at bootstrap; a nil value is a no-op
+	// so the binary runs with telemetry disabled.
+	MetricsFactory *metrics.MetricsFactory
+}
+
+// ErrNilBillingCalculator is returned when a nil BillingCalculator is provided.
+var ErrNilBillingCalculator = errors.New("BillingCalculator is required")
+
+// ErrNilBillingRunRepo is returned when a nil billing run repository is provided.
+var ErrNilBillingRunRepo = errors.New("BillingRun repository is required")
+
+// ErrNilBillingTransactionPoster is returned when a nil BillingTransactionPoster is provided.
+var ErrNilBillingTransactionPoster = errors.New("BillingTransactionPoster is required")
+
+// retryableStatementStatuses are the statuses Retry re-attempts.
+var retryableStatementStatuses = []string{model.BillingStatementStatusPending, model.BillingStatementStatusFailed}
+
+// NewBillingRunService creates a new BillingRunService with validated dependencies.
+func NewBillingRunService(
+	calculator BillingCalculator,
+	repo billing_run.Repository,
+	poster BillingTransactionPoster,
+) (*BillingRunService, error) {
+	if calculator == nil {
+		return nil, ErrNilBillingCalculator
+	}
+
+	if repo == nil {
+		return nil, ErrNilBillingRunRepo
+	}
+
+	if poster == nil {
+		return nil, ErrNilBillingTransactionPoster
+	}
+
+	return &BillingRunService{
+		calculator:     calculator,
+		billingRunRepo: repo,
+		poster:         poster,
+	}, nil
+}
+
+// Run executes a billing run for the requested ledger and period. Charges
+// already posted by an earlier run are skipped and counted as already posted,
+// and charges an invoice already bills are skipped and counted as invoiced;
+// charges an earlier run left unposted are re-attempted but stay attached to
+// that run. A rejected posting does not fail the run: it is recorded on the
+// statement and the run completes with failures.
+func (s *BillingRunService) Run(ctx context.Context, req model.BillingRunRequest) (_ *model.BillingRun, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_run.run")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "run_billing", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", req.OrganizationID),
+		attribute.String("app.request.ledger_id", req.LedgerID),
+		attribute.String("app.request.period", req.Period),
+		attribute.String("app.request.type", req.Type),
+	)
+
+	// Calculate validates the org/ledger UUIDs and the period, so the parses
+	// below cannot fail once it returns successfully.
+	calculation, err := s.calculator.Calculate(ctx, req.CalculateRequest())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing calculation failed", err)
+
+		return nil, err
+	}
+
+	orgUUID := uuid.MustParse(req.OrganizationID)
+	ledgerUUID := uuid.MustParse(req.LedgerID)
+
+	charges := make([]*model.BillingStatement, 0, len(calculation.Results))
+
+	for _, result := range calculation.Results {
+		resultCharges, errSplit := splitBillingResult(result)
+		if errSplit != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to split billing result into charges", errSplit)
+
+			return nil, errSplit
+		}
+
+		charges = append(charges, resultCharges...)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	run, err := s.billingRunRepo.CreateRun(ctx, &model.BillingRun{
+		ID:             uuid.NewString(),
+		OrganizationID: req.OrganizationID,
+		LedgerID:       req.LedgerID,
+		Period:         req.Period,
+		Type:           req.Type,
+		Status:         model.BillingRunStatusRunning,
+		TotalAmount:    decimal.Zero,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to create billing run", err)
+
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("app.response.billing_run_id", run.ID),
+		attribute.Int("app.response.total_charges", len(charges)),
+	)
+
+	for _, charge := range charges {
+		stmtID := uuid.NewString()
+
+		charge.ID = stmtID
+		charge.RunID = run.ID
+		charge.OrganizationID = req.OrganizationID
+		charge.LedgerID = req.LedgerID
+		charge.Status = model.BillingStatementStatusPending
+		charge.CreatedAt = now
+		charge.UpdatedAt = now
+		charge.Metadata["billingRunId"] = run.ID
+		charge.Metadata["billingStatementId"] = stmtID
+
+		statement, created, errInsert := s.billingRunRepo.InsertOrGetStatement(ctx, charge)
+		if errInsert != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to persist billing statement", errInsert)
+
+			return nil, errInsert
+		}
+
+		if statement.IsPosted() {
+			run.TotalAlreadyPosted++
+			run.TotalAmount = run.TotalAmount.Add(statement.Amount)
+
+			continue
+		}
+
+		if statement.IsInvoiced() {
+			run.TotalInvoiced++
+
+			continue
+		}
+
+		if created {
+			run.TotalAmount = run.TotalAmount.Add(statement.Amount)
+		}
+
+		if _, errPost := s.postStatement(ctx, orgUUID, ledgerUUID, statement); errPost != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to record billing statement posting", errPost)
+
+			return nil, errPost
+		}
+	}
+
+	run, err = s.finishRun(ctx, run)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to finish billing run", err)
+
+		return nil, err
+	}
+
+	logger.Log(ctx, libLog.LevelInfo, "Billing run finished",
+		libLog.String("billing_run_id", run.ID),
+		libLog.String("status", run.Status),
+		libLog.Int("total_posted", run.TotalPosted),
+		libLog.Int("total_failed", run.TotalFailed),
+	)
+
+	return run, nil
+}
+
+// Retry re-posts every statement of a run that is not posted yet (FAILED, or
+// PENDING after an interrupted run) under its original idempotency key, then
+// recomputes the run's counters.
+func (s *BillingRunService) Retry(ctx context.Context, organizationID, runID uuid.UUID) (_ *model.BillingRun, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_run.retry")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, s.MetricsFactory, logger, "fees", "retry_billing_run", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+	)
+
+	run, err := s.findRun(ctx, organizationID, runID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing run", err)
+
+		return nil, err
+	}
+
+	ledgerUUID, err := uuid.Parse(run.LedgerID)
+	if err != nil {
+		return nil, fmt.Errorf("billing run %s: invalid ledger id %q: %w", run.ID, run.LedgerID, err)
+	}
+
+	statements, _, err := s.billingRunRepo.FindStatementsByRun(ctx, run.OrganizationID, run.ID, retryableStatementStatuses, 0, 0)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list retryable billing statements", err)
+
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("app.request.retryable_statements", len(statements)))
+
+	for _, statement := range statements {
+		if _, errPost := s.postStatement(ctx, organizationID, ledgerUUID, statement); errPost != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to record billing statement posting", errPost)
+
+			return nil, errPost
+		}
+	}
+
+	run, err = s.finishRun(ctx, run)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to finish billing run", err)
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// GetRun returns a billing run with counters computed from its statements.
+func (s *BillingRunService) GetRun(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_run.get")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+	)
+
+	run, err := s.findRun(ctx, organizationID, runID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing run", err)
+
+		return nil, err
+	}
+
+	if err := s.countStatements(ctx, run); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to count billing statements", err)
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// ListStatements returns a page of the statements created by a billing run,
+// optionally filtered by status.
+func (s *BillingRunService) ListStatements(ctx context.Context, organizationID, runID uuid.UUID, status string, limit, page int) ([]*model.BillingStatement, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_run.list_statements")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.billing_run_id", runID.String()),
+		attribute.String("app.request.status", status),
+	)
+
+	if _, err := s.findRun(ctx, organizationID, runID); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to load billing run", err)
+
+		return nil, 0, err
+	}
+
+	var statuses []string
+	if status != "" {
+		statuses = []string{status}
+	}
+
+	statements, total, err := s.billingRunRepo.FindStatementsByRun(ctx, organizationID.String(), runID.String(), statuses, limit, page)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list billing statements", err)
+
+		return nil, 0, err
+	}
+
+	return statements, total, nil
+}
+
+// findRun loads a run, mapping a missing document onto ErrBillingRunNotFound.
+func (s *BillingRunService) findRun(ctx context.Context, organizationID, runID uuid.UUID) (*model.BillingRun, error) {
+	run, err := s.billingRunRepo.FindRunByID(ctx, runID.String(), organizationID.String())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkg.ValidateBusinessError(constant.ErrBillingRunNotFound, "BillingRun", runID.String())
+		}
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// postStatement posts one statement and records the outcome on it. The
+// returned error is a persistence failure only; a rejected posting is recorded
+// as a FAILED statement and is not an error of the run.
+func (s *BillingRunService) postStatement(ctx context.Context, organizationID, ledgerID uuid.UUID, statement *model.BillingStatement) (*model.BillingStatement, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.billing_run.post_statement")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.billing_statement_id", statement.ID),
+		attribute.String("app.request.billing_package_id", statement.BillingPackageID),
+	)
+
+	posting, errPost := s.poster.PostBillingTransaction(ctx, organizationID, ledgerID, BuildStatementPayload(ctx, statement), statement.IdempotencyKey)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	if errPost != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Billing statement posting rejected", errPost)
+		logger.Log(ctx, libLog.LevelWarn, "Billing statement posting rejected",
+			libLog.String("billing_statement_id", statement.ID),
+			libLog.Err(errPost),
+		)
+
+		return s.billingRunRepo.MarkStatementFailed(ctx, statement.ID, statement.OrganizationID, errPost.Error(), now)
+	}
+
+	return s.billingRunRepo.MarkStatementPosted(ctx, statement.ID, statement.OrganizationID, posting.TransactionID, now)
+}
+
+// finishRun recomputes the run's counters from its statements, derives its
+// status and persists it.
+func (s *BillingRunService) finishRun(ctx context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+	if err := s.countStatements(ctx, run); err != nil {
+		return nil, err
+	}
+
+	run.Status = model.BillingRunStatusCompleted
+	if run.TotalFailed > 0 || run.TotalPending > 0 {
+		run.Status = model.BillingRunStatusCompletedWithFailures
+	}
+
+	run.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	return s.billingRunRepo.UpdateRun(ctx, run)
+}
+
+// countStatements refreshes the per-status counters of a run from the
+// statements it owns.
+func (s *BillingRunService) countStatements(ctx context.Context, run *model.BillingRun) error {
+	counts, err := s.billingRunRepo.CountStatementsByRun(ctx, run.OrganizationID, run.ID)
+	if err != nil {
+		return err
+	}
+
+	run.TotalPosted = counts[model.BillingStatementStatusPosted]
+	run.TotalFailed = counts[model.BillingStatementStatusFailed]
+	run.TotalPending = counts[model.BillingStatementStatusPending]
+	run.TotalStatements = run.TotalPosted + run.TotalFailed + run.TotalPending + run.TotalAlreadyPosted + run.TotalInvoiced
+
+	return nil
+}
+
+// splitBillingResult breaks one calculation result into one charge per debited
+// account. Volume payloads already carry a single source; maintenance payloads
+// are N:1 and become N statements, so a single rejected account cannot block
+// (or, on retry, re-charge) the rest. Zero-amount results yield no charges.
+func splitBillingResult(result model.BillingCalculationResult) ([]*model.BillingStatement, error) {
+	if result.TotalNetAmount.IsZero() {
+		return nil, nil
+	}
+
+	var payload transaction.Transaction
+	if err := json.Unmarshal(result.TransactionPayload, &payload); err != nil {
+		return nil, pkg.ValidateBusinessError(constant.ErrBillingCalculationFailed, "BillingRun",
+			fmt.Sprintf("billing package (id=%s, label=%s): invalid transaction payload: %v", result.BillingPackageID, result.BillingPackageLabel, err))
+	}
+
+	if len(payload.Send.Distribute.To) != 1 {
+		return nil, pkg.ValidateBusinessError(constant.ErrBillingCalculationFailed, "BillingRun",
+			fmt.Sprintf("billing package (id=%s, label=%s): expected exactly one credit account", result.BillingPackageID, result.BillingPackageLabel))
+	}
+
+	creditAlias := payload.Send.Distribute.To[0].AccountAlias
+	charges := make([]*model.BillingStatement, 0, len(payload.Send.Source.From))
+
+	for _, from := range payload.Send.Source.From {
+		if from.Amount == nil || from.Amount.Value.IsZero() {
+			continue
+		}
+
+		charges = append(charges, &model.BillingStatement{
+			BillingPackageID:    result.BillingPackageID,
+			BillingPackageLabel: result.BillingPackageLabel,
+			BillingType:         result.BillingType,
+			Period:              result.Period,
+			AccountAlias:        from.AccountAlias,
+			CreditAccountAlias:  creditAlias,
+			AssetCode:           payload.Send.Asset,
+			Amount:              from.Amount.Value,
+			Code:                payload.Code,
+			Description:         payload.Description,
+			Metadata:            flatBillingMetadata(payload.Metadata),
+			IdempotencyKey:      model.BillingIdempotencyKey(result.BillingPackageID, result.Period, from.AccountAlias),
+		})
+	}
+
+	return charges, nil
+}
+
+// flatBillingMetadata keeps the scalar entries of a calculated payload's
+// metadata. Nested objects (the volume discount breakdown) are dropped because
+// transaction metadata does not accept nesting.
+func flatBillingMetadata(metadata map[string]any) map[string]any {
+	flat := make(map[string]any, len(metadata)+2)
+
+	for key, value := range metadata {
+		switch value.(type) {
+		case string, bool, float64, int, int64:
+			flat[key] = value
+		}
+	}
+
+	return flat
+}
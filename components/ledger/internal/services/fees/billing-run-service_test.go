@@ -0,0 +1,335 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+
+	billing_run "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/billing_run"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+)
+
+// stubBillingCalculator returns a canned calculation.
+type stubBillingCalculator struct {
+	response *model.BillingCalculateResponse
+	err      error
+}
+
+func (s *stubBillingCalculator) Calculate(_ context.Context, _ model.BillingCalculateRequest) (*model.BillingCalculateResponse, error) {
+	return s.response, s.err
+}
+
+// postedBillingTransaction is one call recorded by stubBillingPoster.
+type postedBillingTransaction struct {
+	input          transaction.Transaction
+	idempotencyKey string
+}
+
+// stubBillingPoster records every posting and rejects the aliases in reject.
+// A key in replayed returns the posting it maps to, as the ledger does for a
+// key that was already posted.
+type stubBillingPoster struct {
+	reject   map[string]error
+	replayed map[string]*model.BillingPosting
+	posted   []postedBillingTransaction
+}
+
+func (s *stubBillingPoster) PostBillingTransaction(_ context.Context, _, _ uuid.UUID, input transaction.Transaction, idempotencyKey string) (*model.BillingPosting, error) {
+	s.posted = append(s.posted, postedBillingTransaction{input: input, idempotencyKey: idempotencyKey})
+
+	if posting, ok := s.replayed[idempotencyKey]; ok {
+		return posting, nil
+	}
+
+	if err := s.reject[input.Send.Source.From[0].AccountAlias]; err != nil {
+		return nil, err
+	}
+
+	return &model.BillingPosting{TransactionID: uuid.NewString(), Amount: input.Send.Value}, nil
+}
+
+func newTestBillingRunService(t *testing.T, calculator BillingCalculator, poster BillingTransactionPoster) (*BillingRunService, *billing_run.MockRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockRepo := billing_run.NewMockRepository(ctrl)
+
+	svc, err := NewBillingRunService(calculator, mockRepo, poster)
+	require.NoError(t, err)
+
+	return svc, mockRepo
+}
+
+// maintenanceCalculation returns a calculation with one maintenance result
+// charging fee to every alias.
+func maintenanceCalculation(t *testing.T, packageID string, fee decimal.Decimal, aliases ...string) *model.BillingCalculateResponse {
+	t.Helper()
+
+	accounts := make([]feeshared.Account, 0, len(aliases))
+	for _, alias := range aliases {
+		accounts = append(accounts, feeshared.Account{ID: uuid.NewString(), Alias: alias})
+	}
+
+	credit := "@maintenance-revenue"
+	bp := model.BillingPackage{
+		ID:                       packageID,
+		Label:                    "Monthly Maintenance",
+		Type:                     model.BillingPackageTypeMaintenance,
+		AssetCode:                stringPtr("BRL"),
+		FeeAmount:                &fee,
+		MaintenanceCreditAccount: &credit,
+	}
+
+	payload, err := json.Marshal(BuildMaintenancePayload(context.Background(), bp, "2026-01", accounts))
+	require.NoError(t, err)
+
+	return &model.BillingCalculateResponse{Results: []model.BillingCalculationResult{{
+		BillingPackageID:    packageID,
+		BillingPackageLabel: bp.Label,
+		BillingType:         model.BillingPackageTypeMaintenance,
+		Period:              "2026-01",
+		TotalAccounts:       len(aliases),
+		TotalCharged:        len(aliases),
+		TotalNetAmount:      fee.Mul(decimal.NewFromInt(int64(len(aliases)))),
+		TransactionPayload:  payload,
+	}}}
+}
+
+func TestNewBillingRunService_NilDependencies(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := billing_run.NewMockRepository(ctrl)
+
+	_, err := NewBillingRunService(nil, repo, &stubBillingPoster{})
+	assert.ErrorIs(t, err, ErrNilBillingCalculator)
+
+	_, err = NewBillingRunService(&stubBillingCalculator{}, nil, &stubBillingPoster{})
+	assert.ErrorIs(t, err, ErrNilBillingRunRepo)
+
+	_, err = NewBillingRunService(&stubBillingCalculator{}, repo, nil)
+	assert.ErrorIs(t, err, ErrNilBillingTransactionPoster)
+}
+
+func TestBillingRunService_Run_PostsOneIdempotentChargePerAccount(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID, packageID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	fee := decimal.NewFromInt(15)
+
+	calculator := &stubBillingCalculator{response: maintenanceCalculation(t, packageID, fee, "@alice", "@bob")}
+	poster := &stubBillingPoster{reject: map[string]error{"@bob": errors.New("insufficient funds")}}
+
+	svc, mockRepo := newTestBillingRunService(t, calculator, poster)
+
+	mockRepo.EXPECT().CreateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) {
+			assert.Equal(t, model.BillingRunStatusRunning, run.Status)
+
+			return run, nil
+		})
+
+	mockRepo.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, stmt *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			assert.Equal(t, model.BillingStatementStatusPending, stmt.Status)
+			assert.True(t, fee.Equal(stmt.Amount), "each statement carries one account's fee")
+			assert.Equal(t, model.BillingIdempotencyKey(packageID, "2026-01", stmt.AccountAlias), stmt.IdempotencyKey)
+
+			return stmt, true, nil
+		})
+
+	mockRepo.EXPECT().MarkStatementPosted(gomock.Any(), gomock.Any(), orgID, gomock.Any(), gomock.Any()).
+		Return(&model.BillingStatement{Status: model.BillingStatementStatusPosted}, nil)
+	mockRepo.EXPECT().MarkStatementFailed(gomock.Any(), gomock.Any(), orgID, "insufficient funds", gomock.Any()).
+		Return(&model.BillingStatement{Status: model.BillingStatementStatusFailed}, nil)
+
+	mockRepo.EXPECT().CountStatementsByRun(gomock.Any(), orgID, gomock.Any()).
+		Return(map[string]int{model.BillingStatementStatusPosted: 1, model.BillingStatementStatusFailed: 1}, nil)
+	mockRepo.EXPECT().UpdateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+
+	run, err := svc.Run(context.Background(), model.BillingRunRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err, "a rejected posting must not fail the run")
+
+	assert.Equal(t, model.BillingRunStatusCompletedWithFailures, run.Status)
+	assert.Equal(t, 1, run.TotalPosted)
+	assert.Equal(t, 1, run.TotalFailed)
+	assert.Equal(t, 2, run.TotalStatements)
+	assert.True(t, decimal.NewFromInt(30).Equal(run.TotalAmount))
+
+	require.Len(t, poster.posted, 2)
+
+	for _, p := range poster.posted {
+		alias := p.input.Send.Source.From[0].AccountAlias
+
+		assert.Equal(t, model.BillingIdempotencyKey(packageID, "2026-01", alias), p.idempotencyKey)
+		require.Len(t, p.input.Send.Distribute.To, 1)
+		assert.Equal(t, "@maintenance-revenue", p.input.Send.Distribute.To[0].AccountAlias)
+		assert.True(t, fee.Equal(p.input.Send.Value), "the N:1 maintenance charge is posted 1:1 per account")
+	}
+}
+
+func TestBillingRunService_Run_SkipsChargesAlreadyPosted(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID, packageID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	fee := decimal.NewFromInt(15)
+
+	calculator := &stubBillingCalculator{response: maintenanceCalculation(t, packageID, fee, "@alice")}
+	poster := &stubBillingPoster{}
+
+	svc, mockRepo := newTestBillingRunService(t, calculator, poster)
+
+	txID := uuid.NewString()
+
+	mockRepo.EXPECT().CreateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+	mockRepo.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, stmt *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			existing := *stmt
+			existing.RunID = uuid.NewString()
+			existing.Status = model.BillingStatementStatusPosted
+			existing.TransactionID = &txID
+
+			return &existing, false, nil
+		})
+	mockRepo.EXPECT().CountStatementsByRun(gomock.Any(), orgID, gomock.Any()).Return(map[string]int{}, nil)
+	mockRepo.EXPECT().UpdateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+
+	run, err := svc.Run(context.Background(), model.BillingRunRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err)
+
+	assert.Empty(t, poster.posted, "a charge posted by an earlier run must never be posted again")
+	assert.Equal(t, model.BillingRunStatusCompleted, run.Status)
+	assert.Equal(t, 1, run.TotalAlreadyPosted)
+	assert.Equal(t, 1, run.TotalStatements)
+}
+
+func TestBillingRunService_Run_SkipsChargesAlreadyInvoiced(t *testing.T) {
+	t.Parallel()
+
+	orgID, ledgerID, packageID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	calculator := &stubBillingCalculator{response: maintenanceCalculation(t, packageID, decimal.NewFromInt(15), "@alice")}
+	poster := &stubBillingPoster{}
+
+	svc, mockRepo := newTestBillingRunService(t, calculator, poster)
+
+	mockRepo.EXPECT().CreateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+	mockRepo.EXPECT().InsertOrGetStatement(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, stmt *model.BillingStatement) (*model.BillingStatement, bool, error) {
+			existing := *stmt
+			existing.RunID = ""
+			existing.Status = model.BillingStatementStatusInvoiced
+			existing.Metadata = map[string]any{"billingInvoiceKey": model.BillingInvoiceKey("2026-01", "", "@alice", "BRL")}
+
+			return &existing, false, nil
+		})
+	mockRepo.EXPECT().CountStatementsByRun(gomock.Any(), orgID, gomock.Any()).Return(map[string]int{}, nil)
+	mockRepo.EXPECT().UpdateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+
+	run, err := svc.Run(context.Background(), model.BillingRunRequest{OrganizationID: orgID, LedgerID: ledgerID, Period: "2026-01"})
+	require.NoError(t, err)
+
+	assert.Empty(t, poster.posted, "a charge an invoice bills must not be posted by a run")
+	assert.Equal(t, model.BillingRunStatusCompleted, run.Status)
+	assert.Equal(t, 1, run.TotalInvoiced)
+	assert.Equal(t, 0, run.TotalAlreadyPosted)
+	assert.True(t, run.TotalAmount.IsZero())
+	assert.Equal(t, 1, run.TotalStatements)
+}
+
+func TestBillingRunService_Run_CalculationError(t *testing.T) {
+	t.Parallel()
+
+	calcErr := errors.New("calculation failed")
+	svc, _ := newTestBillingRunService(t, &stubBillingCalculator{err: calcErr}, &stubBillingPoster{})
+
+	run, err := svc.Run(context.Background(), model.BillingRunRequest{OrganizationID: uuid.NewString(), LedgerID: uuid.NewString(), Period: "2026-01"})
+
+	assert.ErrorIs(t, err, calcErr)
+	assert.Nil(t, run)
+}
+
+func TestBillingRunService_Retry_RepostsUnpostedStatements(t *testing.T) {
+	t.Parallel()
+
+	orgID, runID := uuid.New(), uuid.New()
+	poster := &stubBillingPoster{}
+
+	svc, mockRepo := newTestBillingRunService(t, &stubBillingCalculator{}, poster)
+
+	failed := &model.BillingStatement{
+		ID:                 uuid.NewString(),
+		OrganizationID:     orgID.String(),
+		AccountAlias:       "@bob",
+		CreditAccountAlias: "@maintenance-revenue",
+		AssetCode:          "BRL",
+		Amount:             decimal.NewFromInt(15),
+		IdempotencyKey:     "billing:original",
+		Status:             model.BillingStatementStatusFailed,
+	}
+
+	mockRepo.EXPECT().FindRunByID(gomock.Any(), runID.String(), orgID.String()).
+		Return(&model.BillingRun{ID: runID.String(), OrganizationID: orgID.String(), LedgerID: uuid.NewString()}, nil)
+	mockRepo.EXPECT().FindStatementsByRun(gomock.Any(), orgID.String(), runID.String(), retryableStatementStatuses, 0, 0).
+		Return([]*model.BillingStatement{failed}, int64(1), nil)
+	mockRepo.EXPECT().MarkStatementPosted(gomock.Any(), failed.ID, orgID.String(), gomock.Any(), gomock.Any()).
+		Return(&model.BillingStatement{Status: model.BillingStatementStatusPosted}, nil)
+	mockRepo.EXPECT().CountStatementsByRun(gomock.Any(), orgID.String(), runID.String()).
+		Return(map[string]int{model.BillingStatementStatusPosted: 2}, nil)
+	mockRepo.EXPECT().UpdateRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *model.BillingRun) (*model.BillingRun, error) { return run, nil })
+
+	run, err := svc.Retry(context.Background(), orgID, runID)
+	require.NoError(t, err)
+
+	require.Len(t, poster.posted, 1)
+	assert.Equal(t, "billing:original", poster.posted[0].idempotencyKey, "a retry must reuse the statement's original key")
+	assert.Equal(t, model.BillingRunStatusCompleted, run.Status)
+	assert.Equal(t, 2, run.TotalPosted)
+}
+
+func TestBillingRunService_Retry_NotFound(t *testing.T) {
+	t.Parallel()
+
+	orgID, runID := uuid.New(), uuid.New()
+	svc, mockRepo := newTestBillingRunService(t, &stubBillingCalculator{}, &stubBillingPoster{})
+
+	mockRepo.EXPECT().FindRunByID(gomock.Any(), runID.String(), orgID.String()).Return(nil, mongo.ErrNoDocuments)
+
+	run, err := svc.Retry(context.Background(), orgID, runID)
+	assert.Nil(t, run)
+
+	var notFoundErr pkg.EntityNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, constant.ErrBillingRunNotFound.Error(), notFoundErr.Code)
+}
+
+func TestSplitBillingResult_ZeroAmountYieldsNoCharges(t *testing.T) {
+	t.Parallel()
+
+	charges, err := splitBillingResult(model.BillingCalculationResult{BillingPackageID: uuid.NewString(), TotalNetAmount: decimal.Zero})
+
+	require.NoError(t, err)
+	assert.Empty(t, charges)
+}
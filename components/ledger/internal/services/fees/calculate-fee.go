@@ -60,6 +60,11 @@ func (uc *UseCase) CalculateFee(ctx context.Context, cf *model.FeeCalculate, org
 		return err
 	}
 
+	// Price the transaction with the package versions in force at its effective
+	// date, so a replayed or back-dated transaction uses the schedule that applied
+	// then. Packages with no version in force at that date drop out.
+	packages = feeUtils.ResolvePackagesAt(packages, feeUtils.EffectiveDate(cf))
+
 	if len(packages) == 0 {
 		return nil
 	}
@@ -173,7 +178,7 @@ func (uc *UseCase) calculateFeeForSinglePackage(
 	// path uses so a single SCOPED package (route and/or segment) is applied only
 	// when its scope matches the transaction. An unscoped single package (nil
 	// route, nil segment) still survives every filter and is selected as before.
-	packFilter, errFilterPack := feeUtils.FindPackageToCalculateFee([]*pack.Package{feePackage}, cf.Transaction.Route, cf.SegmentID, sendModel.Value) //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
+	packFilter, errFilterPack := feeUtils.FindPackageToCalculateFee([]*pack.Package{feePackage}, feeUtils.FeeAsset(cf, uc.defaultCurrency), cf.Transaction.Route, cf.SegmentID, sendModel.Value) //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
 	if errFilterPack != nil {
 		return pkg.ValidateBusinessError(constant.ErrFilterPackage, "")
 	}
@@ -199,7 +204,13 @@ func (uc *UseCase) calculateFeeForSinglePackage(
 		return errCalculateFee
 	}
 
-	uc.updateFeeMetadataIfNeeded(cf, validationResult, validationResultFromSize, validationResultToSize, packFilter.ID)
+	if err := uc.applyFeePromotions(ctx, logger, cf, packFilter, organizationID, true); err != nil {
+		return err
+	}
+
+	uc.annotateFeeSettlement(ctx, cf, packFilter, organizationID)
+
+	uc.updateFeeMetadataIfNeeded(cf, validationResult, validationResultFromSize, validationResultToSize, packFilter)
 
 	return nil
 }
@@ -214,7 +225,7 @@ func (uc *UseCase) calculateFeeForMultiplePackages(
 	validationResultFromSize, validationResultToSize int,
 	organizationID uuid.UUID,
 ) error {
-	packFilter, errFilterPack := feeUtils.FindPackageToCalculateFee(packages, cf.Transaction.Route, cf.SegmentID, sendModel.Value) //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
+	packFilter, errFilterPack := feeUtils.FindPackageToCalculateFee(packages, feeUtils.FeeAsset(cf, uc.defaultCurrency), cf.Transaction.Route, cf.SegmentID, sendModel.Value) //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
 	if errFilterPack != nil {
 		return pkg.ValidateBusinessError(constant.ErrFilterPackage, "")
 	}
@@ -240,7 +251,13 @@ func (uc *UseCase) calculateFeeForMultiplePackages(
 		return errCalculateFee
 	}
 
-	uc.updateFeeMetadataIfNeeded(cf, validationResult, validationResultFromSize, validationResultToSize, packFilter.ID)
+	if err := uc.applyFeePromotions(ctx, logger, cf, packFilter, organizationID, true); err != nil {
+		return err
+	}
+
+	uc.annotateFeeSettlement(ctx, cf, packFilter, organizationID)
+
+	uc.updateFeeMetadataIfNeeded(cf, validationResult, validationResultFromSize, validationResultToSize, packFilter)
 
 	return nil
 }
@@ -249,7 +266,7 @@ func (uc *UseCase) updateFeeMetadataIfNeeded(
 	cf *model.FeeCalculate,
 	validationResult *transaction.Responses,
 	validationResultFromSize, validationResultToSize int,
-	packageID uuid.UUID,
+	feePackage *pack.Package,
 ) {
 	feeApplied := len(validationResult.From) != validationResultFromSize ||
 		len(validationResult.To) != validationResultToSize
@@ -260,7 +277,8 @@ func (uc *UseCase) updateFeeMetadataIfNeeded(
 			cf.Transaction.Metadata = make(map[string]any)
 		}
 
-		cf.Transaction.Metadata["packageAppliedID"] = packageID.String()
+		cf.Transaction.Metadata["packageAppliedID"] = feePackage.ID.String()
+		cf.Transaction.Metadata["packageAppliedVersion"] = feePackage.Version
 
 		// feeApplied marks a real charge (not a pure exemption); it gates
 		// the fee-charge.applied streaming emit downstream.
@@ -7,6 +7,7 @@ package services
 import (
 	"testing"
 
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
 	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
 	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
 	"github.com/google/uuid"
@@ -32,10 +33,11 @@ func TestUpdateFeeMetadataIfNeeded_RealChargeSetsFeeApplied(t *testing.T) {
 		To:   map[string]transaction.Amount{},
 	}
 
-	uc.updateFeeMetadataIfNeeded(cf, validationResult, 0, 0, packID)
+	uc.updateFeeMetadataIfNeeded(cf, validationResult, 0, 0, &pack.Package{ID: packID, Version: 2})
 
 	assert.NotNil(t, cf.Transaction.Metadata)
 	assert.Equal(t, packID.String(), cf.Transaction.Metadata["packageAppliedID"])
+	assert.Equal(t, 2, cf.Transaction.Metadata["packageAppliedVersion"])
 	assert.Equal(t, "true", cf.Transaction.Metadata["feeApplied"],
 		"feeApplied must be set on the real-charge branch")
 }
@@ -61,7 +63,7 @@ func TestUpdateFeeMetadataIfNeeded_ExemptionOnlyOmitsFeeApplied(t *testing.T) {
 		To:   map[string]transaction.Amount{},
 	}
 
-	uc.updateFeeMetadataIfNeeded(cf, validationResult, 0, 0, packID)
+	uc.updateFeeMetadataIfNeeded(cf, validationResult, 0, 0, &pack.Package{ID: packID, Version: 2})
 
 	assert.Equal(t, packID.String(), cf.Transaction.Metadata["packageAppliedID"])
 	_, hasFeeApplied := cf.Transaction.Metadata["feeApplied"]
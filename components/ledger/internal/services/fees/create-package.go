@@ -58,7 +58,7 @@ func (uc *UseCase) CreatePackage(ctx context.Context, cpi *model.CreatePackageIn
 		return nil, errAccountOnMidaz
 	}
 
-	if errRange := uc.ValidatePackageMaxAndMinAmountRange(ctx, logger, cpi.MaxAmount, cpi.MinAmount, cpi.GetTransactionRoute(), organizationID, ledgerID, newSegmentID, nil); errRange != nil {
+	if errRange := uc.ValidatePackageMaxAndMinAmountRange(ctx, logger, cpi.MaxAmount, cpi.MinAmount, cpi.GetTransactionRoute(), cpi.GetAsset(), organizationID, ledgerID, newSegmentID, nil); errRange != nil {
 		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to validate package max and min amount range", errRange)
 
 		return nil, errRange
@@ -89,7 +89,12 @@ func (uc *UseCase) CreatePackage(ctx context.Context, cpi *model.CreatePackageIn
 	packModel.Description = cpi.Description
 	packModel.SegmentID = newSegmentID
 	packModel.TransactionRoute = cpi.TransactionRoute
+	packModel.Asset = cpi.Asset
 	packModel.WaivedAccounts = cpi.WaivedAccounts
+	packModel.ReversalPolicy = cpi.ReversalPolicy
+
+	// The initial schedule is recorded as version 1, in force since creation.
+	packModel.Versions = packModel.BaseVersions()
 
 	resultPackModel, err := uc.packageRepo.Create(ctx, packModel, organizationID)
 	if err != nil {
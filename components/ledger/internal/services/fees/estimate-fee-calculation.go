@@ -86,6 +86,15 @@ func (uc *UseCase) EstimateFeeCalculation(ctx context.Context, cf *model.FeeEsti
 	validationResultToSize := len(validationResult.To)
 	validationResultFromSize := len(validationResult.From)
 
+	// Estimate with the version in force at the transaction's effective date,
+	// exactly as the transaction would be charged.
+	packModel = packModel.AsOf(feeUtils.EffectiveDate(feeModel))
+	if packModel == nil {
+		result := model.NewFeeEstimateResult(feeModel)
+
+		return &result, nil
+	}
+
 	if !feeModel.Transaction.Send.Value.GreaterThanOrEqual(packModel.MinimumAmount) || !feeModel.Transaction.Send.Value.LessThanOrEqual(packModel.MaximumAmount) {
 		const outOfRangeMsg = "Transaction value is not between minimum and maximum amount package."
 
@@ -110,6 +119,16 @@ func (uc *UseCase) EstimateFeeCalculation(ctx context.Context, cf *model.FeeEsti
 		return nil, errCalculateFee
 	}
 
+	// Promotions are applied as the transaction would see them, without using
+	// up any of their allowance.
+	if err := uc.applyFeePromotions(ctx, logger, feeModel, packModel, organizationID, false); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to apply fee promotions", err)
+
+		return nil, err
+	}
+
+	uc.annotateFeeSettlement(ctx, feeModel, packModel, organizationID)
+
 	if len(validationResult.From) == validationResultFromSize &&
 		len(validationResult.To) == validationResultToSize {
 		result := model.NewFeeEstimateResult(feeModel)
@@ -122,6 +141,7 @@ func (uc *UseCase) EstimateFeeCalculation(ctx context.Context, cf *model.FeeEsti
 	}
 
 	feeModel.Transaction.Metadata["packageAppliedID"] = cf.PackageID.String()
+	feeModel.Transaction.Metadata["packageAppliedVersion"] = packModel.Version
 
 	result := model.NewFeeEstimateResult(feeModel)
 
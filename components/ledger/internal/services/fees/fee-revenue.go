@@ -0,0 +1,330 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libRuntime "github.com/LerianStudio/lib-observability/runtime"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultFeeRevenueRetryBatch is how many pending transactions one retry of an
+// organization's fee revenue records at most.
+const defaultFeeRevenueRetryBatch = 100
+
+// DefaultFeeRevenueRetryInterval is the minimum time between two retries of an
+// organization's pending fee revenue on one replica.
+const DefaultFeeRevenueRetryInterval = time.Minute
+
+// RecordFeeRevenue records what the package fees of a posted transaction
+// charged, waived or refunded, dated at. revert tells whether the transaction
+// reverts another one, so its fee legs are refunds rather than charges.
+//
+// Each entry carries the label of its fee and the segment of its package as
+// they are when recorded; a package deleted since then leaves them empty, and
+// the fee key stands in for the label. A nil FeeRevenue repository disables
+// recording.
+//
+// Revenue that cannot be recorded is kept pending and recorded again in the
+// background once a later recording of the organization succeeds; only a
+// failure to keep it pending is returned. Entries are keyed, so a retry never
+// counts a fee twice.
+func (uc *UseCase) RecordFeeRevenue(ctx context.Context, organizationID, ledgerID uuid.UUID, transactionID string, t *transaction.Transaction, revert bool, at time.Time) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if uc.FeeRevenue == nil {
+		return nil
+	}
+
+	lines := feeUtils.RevenueLines(t, revert)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "service.record_fee_revenue")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "record_fee_revenue", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.ledger_id", ledgerID.String()),
+		attribute.String("app.request.transaction_id", transactionID),
+		attribute.Int("app.request.revenue_lines", len(lines)),
+	)
+
+	route := t.Route //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
+	if route == "" && t.RouteID != nil {
+		route = *t.RouteID
+	}
+
+	entries := make([]*model.FeeRevenueEntry, 0, len(lines))
+	now := time.Now()
+
+	for _, line := range lines {
+		entries = append(entries, &model.FeeRevenueEntry{
+			ID:             transactionID + ":" + line.Kind + ":" + line.PackageID + ":" + line.FeeKey + ":" + line.Reason,
+			OrganizationID: organizationID.String(),
+			LedgerID:       ledgerID.String(),
+			TransactionID:  transactionID,
+			PackageID:      line.PackageID,
+			PackageVersion: line.PackageVersion,
+			FeeKey:         line.FeeKey,
+			Route:          route,
+			Asset:          line.Asset,
+			CreditAccount:  line.CreditAccount,
+			Kind:           line.Kind,
+			Reason:         line.Reason,
+			Amount:         line.Amount,
+			OccurredAt:     at.UTC(),
+			CreatedAt:      now,
+		})
+	}
+
+	if errRecord := uc.recordFeeRevenueEntries(ctx, organizationID, entries); errRecord != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to record fee revenue", errRecord)
+
+		pending := &model.PendingFeeRevenue{
+			TransactionID:  transactionID,
+			OrganizationID: organizationID.String(),
+			Entries:        entries,
+			LastAttemptAt:  now,
+		}
+
+		if err := uc.FeeRevenue.MarkPending(ctx, pending); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to keep fee revenue pending", err)
+
+			return errors.Join(errRecord, err)
+		}
+
+		logger.Log(ctx, libLog.LevelWarn, "Fee revenue kept pending for retry",
+			libLog.String("transaction_id", transactionID), libLog.Err(errRecord))
+
+		return nil
+	}
+
+	uc.startFeeRevenueRetry(ctx, organizationID)
+
+	return nil
+}
+
+// recordFeeRevenueEntries labels the entries not labeled yet from their
+// packages, loading each package once, and records them.
+func (uc *UseCase) recordFeeRevenueEntries(ctx context.Context, organizationID uuid.UUID, entries []*model.FeeRevenueEntry) error {
+	packages := make(map[string]*pack.Package)
+
+	for _, entry := range entries {
+		if entry.FeeLabel != "" {
+			continue
+		}
+
+		p, err := uc.findRevenuePackage(ctx, packages, entry.PackageID, organizationID)
+		if err != nil {
+			return err
+		}
+
+		entry.FeeLabel = revenueFeeLabel(p, entry.PackageVersion, entry.FeeKey)
+
+		if p != nil && p.SegmentID != nil {
+			entry.SegmentID = p.SegmentID.String()
+		}
+	}
+
+	return uc.FeeRevenue.Record(ctx, entries)
+}
+
+// startFeeRevenueRetry records the organization's pending fee revenue in the
+// background, detached from the request context. A successful recording shows
+// the store is reachable again, so it triggers the retry, at most once per
+// FeeRevenueRetryInterval.
+func (uc *UseCase) startFeeRevenueRetry(ctx context.Context, organizationID uuid.UUID) {
+	if uc.FeeRevenueRetryInterval <= 0 {
+		return
+	}
+
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	key := tmcore.GetTenantIDContext(ctx) + ":" + organizationID.String()
+	now := time.Now()
+
+	uc.feeRevenueRetryMu.Lock()
+	if last, ok := uc.feeRevenueRetries[key]; ok && now.Sub(last) < uc.FeeRevenueRetryInterval {
+		uc.feeRevenueRetryMu.Unlock()
+
+		return
+	}
+
+	if uc.feeRevenueRetries == nil {
+		uc.feeRevenueRetries = make(map[string]time.Time)
+	}
+
+	uc.feeRevenueRetries[key] = now
+	uc.feeRevenueRetryMu.Unlock()
+
+	libRuntime.SafeGoWithContextAndComponent(context.WithoutCancel(ctx), logger, "fees", "fee_revenue.retry_pending",
+		libRuntime.KeepRunning, func(c context.Context) {
+			uc.retryPendingFeeRevenue(c, organizationID)
+		})
+}
+
+// retryPendingFeeRevenue records the oldest pending fee revenue of the
+// organization and clears the transactions recorded. It stops at the first
+// failure, which is counted as another attempt of that transaction.
+func (uc *UseCase) retryPendingFeeRevenue(ctx context.Context, organizationID uuid.UUID) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.fee_revenue.retry_pending")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("app.request.organization_id", organizationID.String()))
+
+	pending, err := uc.FeeRevenue.FindPending(ctx, organizationID.String(), defaultFeeRevenueRetryBatch)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to find pending fee revenue", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Pending fee revenue not retried",
+			libLog.String("organization_id", organizationID.String()), libLog.Err(err))
+
+		return
+	}
+
+	for _, p := range pending {
+		if err := uc.recordFeeRevenueEntries(ctx, organizationID, p.Entries); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to retry pending fee revenue", err)
+
+			logger.Log(ctx, libLog.LevelWarn, "Pending fee revenue retry failed",
+				libLog.String("transaction_id", p.TransactionID), libLog.Err(err))
+
+			p.LastAttemptAt = time.Now()
+
+			if err := uc.FeeRevenue.MarkPending(ctx, p); err != nil {
+				logger.Log(ctx, libLog.LevelWarn, "Pending fee revenue attempt not counted",
+					libLog.String("transaction_id", p.TransactionID), libLog.Err(err))
+			}
+
+			return
+		}
+
+		if err := uc.FeeRevenue.ClearPending(ctx, organizationID.String(), p.TransactionID); err != nil {
+			libOpentelemetry.HandleSpanError(span, "Failed to clear pending fee revenue", err)
+
+			logger.Log(ctx, libLog.LevelWarn, "Pending fee revenue not cleared",
+				libLog.String("transaction_id", p.TransactionID), libLog.Err(err))
+
+			return
+		}
+	}
+}
+
+// GetFeeRevenueReport aggregates the organization's fee revenue over the
+// query's range. A nil FeeRevenue repository reports no revenue.
+func (uc *UseCase) GetFeeRevenueReport(ctx context.Context, organizationID uuid.UUID, query *model.FeeRevenueQuery) (*model.FeeRevenueReport, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_fee_revenue_report")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	if err := query.Validate(); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid fee revenue query", err)
+
+		return nil, err
+	}
+
+	report := &model.FeeRevenueReport{
+		From:    query.From,
+		To:      query.To,
+		GroupBy: query.GroupBy,
+		Period:  query.Period,
+		Items:   []*model.FeeRevenueRow{},
+	}
+
+	if report.GroupBy == nil {
+		report.GroupBy = []string{}
+	}
+
+	if uc.FeeRevenue == nil {
+		return report, nil
+	}
+
+	rows, err := uc.FeeRevenue.Aggregate(ctx, organizationID.String(), query)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to aggregate fee revenue", err)
+
+		return nil, err
+	}
+
+	report.Items = append(report.Items, rows...)
+
+	return report, nil
+}
+
+// findRevenuePackage returns the package a revenue line belongs to, loading it
+// once per call. A deleted package, or one whose ID does not parse, is nil.
+func (uc *UseCase) findRevenuePackage(ctx context.Context, packages map[string]*pack.Package, packageID string, organizationID uuid.UUID) (*pack.Package, error) {
+	if p, ok := packages[packageID]; ok {
+		return p, nil
+	}
+
+	id, err := uuid.Parse(packageID)
+	if err != nil {
+		packages[packageID] = nil
+
+		return nil, nil
+	}
+
+	p, err := uc.packageRepo.FindByID(ctx, id, organizationID)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	packages[packageID] = p
+
+	return p, nil
+}
+
+// revenueFeeLabel returns the label of a fee in the package version that
+// charged it, falling back to the package's current fees and then to the key.
+func revenueFeeLabel(p *pack.Package, version int, feeKey string) string {
+	if p == nil {
+		return feeKey
+	}
+
+	if v := p.FindVersion(version); v != nil {
+		if fee, ok := v.Fees[feeKey]; ok && fee.FeeLabel != "" {
+			return fee.FeeLabel
+		}
+	}
+
+	if fee, ok := p.Fees[feeKey]; ok && fee.FeeLabel != "" {
+		return fee.FeeLabel
+	}
+
+	return feeKey
+}
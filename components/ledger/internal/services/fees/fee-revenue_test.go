@@ -0,0 +1,337 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_revenue"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+// revenueTransfer is a posted 1000 BRL transfer on which a package charged a 10
+// service fee and a promotion waived 5 more.
+func revenueTransfer(packageID uuid.UUID) *transaction.Transaction {
+	tx := quoteTransfer()
+	tx.Route = "pix_out" //nolint:staticcheck // legacy field kept for backward compatibility; RouteID is canonical
+	tx.Send.Distribute.To = append(tx.Send.Distribute.To, transaction.FromTo{
+		AccountAlias: "@fee_account",
+		Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(10)},
+		Metadata: map[string]any{
+			feeUtils.MetadataPackageID:      packageID.String(),
+			feeUtils.MetadataPackageVersion: 1,
+			feeUtils.MetadataFeeKey:         "service",
+		},
+	})
+	tx.Metadata = map[string]any{feeUtils.MetadataFeeWaivers: []any{map[string]any{
+		"packageId": packageID.String(), "packageVersion": 1, "feeKey": "service",
+		"reason": model.FeeWaiverPromotion, "asset": "BRL", "amount": "5",
+	}}}
+
+	return &tx
+}
+
+func TestRecordFeeRevenue(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	ledgerID := uuid.New()
+	segmentID := uuid.New()
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	p := &pack.Package{
+		ID:        uuid.New(),
+		SegmentID: &segmentID,
+		Fees:      map[string]model.Fee{"service": {FeeLabel: "Transfer fee"}},
+	}
+
+	t.Run("records charged and waived entries", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil).Times(1)
+
+		var recorded []*model.FeeRevenueEntry
+
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entries []*model.FeeRevenueEntry) error {
+				recorded = entries
+
+				return nil
+			})
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+		require.Len(t, recorded, 2)
+
+		byKind := make(map[string]*model.FeeRevenueEntry)
+		for _, entry := range recorded {
+			byKind[entry.Kind] = entry
+
+			assert.Equal(t, "tx-1", entry.TransactionID)
+			assert.Equal(t, orgID.String(), entry.OrganizationID)
+			assert.Equal(t, ledgerID.String(), entry.LedgerID)
+			assert.Equal(t, "Transfer fee", entry.FeeLabel)
+			assert.Equal(t, "pix_out", entry.Route)
+			assert.Equal(t, segmentID.String(), entry.SegmentID)
+			assert.Equal(t, at, entry.OccurredAt)
+		}
+
+		require.Contains(t, byKind, model.FeeRevenueCharged)
+		require.Contains(t, byKind, model.FeeRevenueWaived)
+		assert.True(t, byKind[model.FeeRevenueCharged].Amount.Equal(decimal.NewFromInt(10)))
+		assert.Equal(t, "@fee_account", byKind[model.FeeRevenueCharged].CreditAccount)
+		assert.True(t, byKind[model.FeeRevenueWaived].Amount.Equal(decimal.NewFromInt(5)))
+		assert.Equal(t, model.FeeWaiverPromotion, byKind[model.FeeRevenueWaived].Reason)
+		assert.NotEqual(t, byKind[model.FeeRevenueCharged].ID, byKind[model.FeeRevenueWaived].ID)
+	})
+
+	t.Run("deleted package falls back to the fee key", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(nil, mongo.ErrNoDocuments)
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entries []*model.FeeRevenueEntry) error {
+				for _, entry := range entries {
+					assert.Equal(t, "service", entry.FeeLabel)
+					assert.Empty(t, entry.SegmentID)
+				}
+
+				return nil
+			})
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+	})
+
+	t.Run("transaction without fees records nothing", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl), FeeRevenue: fee_revenue.NewMockRepository(ctrl)}
+
+		tx := quoteTransfer()
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", &tx, false, at))
+	})
+
+	t.Run("nil repository disables recording", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl)}
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+	})
+
+	t.Run("repository failure keeps the revenue pending", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("mongo down"))
+		revenue.EXPECT().MarkPending(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, pending *model.PendingFeeRevenue) error {
+				assert.Equal(t, "tx-1", pending.TransactionID)
+				assert.Equal(t, orgID.String(), pending.OrganizationID)
+				require.Len(t, pending.Entries, 2)
+
+				for _, entry := range pending.Entries {
+					assert.Equal(t, "Transfer fee", entry.FeeLabel)
+				}
+
+				return nil
+			})
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+	})
+
+	t.Run("package lookup failure keeps the revenue pending unlabeled", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(nil, errors.New("mongo down"))
+		revenue.EXPECT().MarkPending(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, pending *model.PendingFeeRevenue) error {
+				for _, entry := range pending.Entries {
+					assert.Empty(t, entry.FeeLabel)
+				}
+
+				return nil
+			})
+
+		require.NoError(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+	})
+
+	t.Run("failure to keep the revenue pending is returned", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("mongo down"))
+		revenue.EXPECT().MarkPending(gomock.Any(), gomock.Any()).Return(errors.New("mongo down"))
+
+		require.Error(t, uc.RecordFeeRevenue(context.Background(), orgID, ledgerID, "tx-1", revenueTransfer(p.ID), false, at))
+	})
+}
+
+func TestRetryPendingFeeRevenue(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	p := &pack.Package{ID: uuid.New(), Fees: map[string]model.Fee{"service": {FeeLabel: "Transfer fee"}}}
+
+	pendingOf := func(transactionID, label string) *model.PendingFeeRevenue {
+		return &model.PendingFeeRevenue{
+			TransactionID:  transactionID,
+			OrganizationID: orgID.String(),
+			Entries: []*model.FeeRevenueEntry{{
+				ID:            transactionID + ":charged:" + p.ID.String() + ":service:",
+				TransactionID: transactionID,
+				PackageID:     p.ID.String(),
+				FeeKey:        "service",
+				FeeLabel:      label,
+				Kind:          model.FeeRevenueCharged,
+				Amount:        decimal.NewFromInt(10),
+			}},
+		}
+	}
+
+	t.Run("records and clears pending transactions", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		packRepo := pack.NewMockRepository(ctrl)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: packRepo, FeeRevenue: revenue}
+
+		revenue.EXPECT().FindPending(gomock.Any(), orgID.String(), defaultFeeRevenueRetryBatch).
+			Return([]*model.PendingFeeRevenue{pendingOf("tx-1", ""), pendingOf("tx-2", "Transfer fee")}, nil)
+		packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil).Times(1)
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entries []*model.FeeRevenueEntry) error {
+				assert.Equal(t, "Transfer fee", entries[0].FeeLabel)
+
+				return nil
+			}).Times(2)
+		revenue.EXPECT().ClearPending(gomock.Any(), orgID.String(), "tx-1").Return(nil)
+		revenue.EXPECT().ClearPending(gomock.Any(), orgID.String(), "tx-2").Return(nil)
+
+		uc.retryPendingFeeRevenue(context.Background(), orgID)
+	})
+
+	t.Run("stops at the first failure and counts the attempt", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl), FeeRevenue: revenue}
+
+		revenue.EXPECT().FindPending(gomock.Any(), orgID.String(), defaultFeeRevenueRetryBatch).
+			Return([]*model.PendingFeeRevenue{pendingOf("tx-1", "Transfer fee"), pendingOf("tx-2", "Transfer fee")}, nil)
+		revenue.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("mongo down")).Times(1)
+		revenue.EXPECT().MarkPending(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, pending *model.PendingFeeRevenue) error {
+				assert.Equal(t, "tx-1", pending.TransactionID)
+				assert.False(t, pending.LastAttemptAt.IsZero())
+
+				return nil
+			})
+
+		uc.retryPendingFeeRevenue(context.Background(), orgID)
+	})
+}
+
+func TestGetFeeRevenueReport(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("aggregates a valid query", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		revenue := fee_revenue.NewMockRepository(ctrl)
+
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl), FeeRevenue: revenue}
+
+		query := &model.FeeRevenueQuery{From: from, To: from.AddDate(0, 1, 0), Period: model.FeeRevenuePeriodMonth}
+		rows := []*model.FeeRevenueRow{{Period: "2026-10", Asset: "BRL", Charged: decimal.NewFromInt(10)}}
+
+		revenue.EXPECT().Aggregate(gomock.Any(), orgID.String(), query).Return(rows, nil)
+
+		report, err := uc.GetFeeRevenueReport(context.Background(), orgID, query)
+		require.NoError(t, err)
+		assert.Equal(t, rows, report.Items)
+		assert.Equal(t, []string{}, report.GroupBy)
+		assert.Equal(t, model.FeeRevenuePeriodMonth, report.Period)
+	})
+
+	t.Run("invalid range is rejected before the repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl), FeeRevenue: fee_revenue.NewMockRepository(ctrl)}
+
+		_, err := uc.GetFeeRevenueReport(context.Background(), orgID, &model.FeeRevenueQuery{From: from, To: from.AddDate(2, 0, 0), Period: model.FeeRevenuePeriodMonth})
+
+		var validation pkg.ValidationError
+		require.True(t, errors.As(err, &validation))
+		assert.Equal(t, constant.ErrFeeRevenueRangeInvalid.Error(), validation.Code)
+	})
+
+	t.Run("nil repository reports no revenue", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl)}
+
+		report, err := uc.GetFeeRevenueReport(context.Background(), orgID, &model.FeeRevenueQuery{From: from, To: from.AddDate(0, 0, 1), Period: model.FeeRevenuePeriodDay})
+		require.NoError(t, err)
+		assert.Empty(t, report.Items)
+	})
+}
@@ -58,5 +58,15 @@ func (uc *UseCase) GetAllPackages(ctx context.Context, filters http.QueryHeader,
 		return []*pack.Package{}, nil
 	}
 
+	// Report each package with the schedule in force now; the full history,
+	// including scheduled versions, stays available under versions.
+	now := time.Now()
+
+	for i, p := range packs {
+		if resolved := p.AsOf(now); resolved != nil {
+			packs[i] = resolved
+		}
+	}
+
 	return packs, nil
 }
@@ -54,5 +54,9 @@ func (uc *UseCase) GetPackageByID(ctx context.Context, id, organizationID uuid.U
 		return nil, err
 	}
 
+	if resolved := packModel.AsOf(time.Now()); resolved != nil {
+		return resolved, nil
+	}
+
 	return packModel, nil
 }
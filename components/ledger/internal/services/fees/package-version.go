@@ -0,0 +1,333 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SchedulePackageVersion records a fee schedule that takes effect at a future
+// instant. The package keeps applying its current version until then, and
+// transactions dated after EffectiveFrom are priced with the new one without
+// anyone having to edit the package at that moment.
+func (uc *UseCase) SchedulePackageVersion(ctx context.Context, id, organizationID uuid.UUID, in *model.CreatePackageVersionInput) (_ *pack.Package, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.schedule_package_version")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "schedule_package_version", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+	)
+
+	now := time.Now()
+	effectiveFrom := in.EffectiveFrom.UTC()
+
+	if !effectiveFrom.After(now) {
+		bizErr := pkg.ValidateBusinessError(constant.ErrPackageVersionNotInFuture, constant.EntityPackage)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Package version effectiveFrom is not in the future", bizErr)
+
+		return nil, bizErr
+	}
+
+	current, err := uc.findPackageForVersioning(ctx, span, id, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range current.BaseVersions() {
+		if v.EffectiveFrom != nil && v.EffectiveFrom.Equal(effectiveFrom) {
+			bizErr := pkg.ValidateBusinessError(constant.ErrPackageVersionConflict, constant.EntityPackage)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Package version already scheduled for effectiveFrom", bizErr)
+
+			return nil, bizErr
+		}
+	}
+
+	version, err := uc.buildScheduledVersion(ctx, current, organizationID, effectiveFrom, in)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid package version", err)
+
+		return nil, err
+	}
+
+	version.Version = current.NextVersionNumber()
+	version.CreatedAt = now
+
+	updated, err := uc.saveVersions(ctx, span, current, organizationID, current.WithVersion(version), now)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidatePackageCache(ctx, logger, organizationID, current.LedgerID)
+
+	uc.emitFeesPackageUpdatedEvent(ctx, span, logger, updated, organizationID)
+
+	return updated.AsOf(now), nil
+}
+
+// CancelPackageVersion removes a scheduled version before it takes effect.
+// Versions already in force are part of the audit trail of what was charged and
+// cannot be removed.
+func (uc *UseCase) CancelPackageVersion(ctx context.Context, id, organizationID uuid.UUID, version int) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.cancel_package_version")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "cancel_package_version", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.Int("app.request.package_version", version),
+	)
+
+	current, err := uc.findPackageForVersioning(ctx, span, id, organizationID)
+	if err != nil {
+		return err
+	}
+
+	target := current.FindVersion(version)
+	if target == nil {
+		bizErr := pkg.ValidateBusinessError(constant.ErrPackageVersionNotFound, constant.EntityPackage, strconv.Itoa(version))
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Package version not found", bizErr)
+
+		return bizErr
+	}
+
+	now := time.Now()
+
+	if target.EffectiveFrom == nil || !target.EffectiveFrom.After(now) {
+		bizErr := pkg.ValidateBusinessError(constant.ErrPackageVersionNotInFuture, constant.EntityPackage)
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Package version already in force", bizErr)
+
+		return bizErr
+	}
+
+	updated, err := uc.saveVersions(ctx, span, current, organizationID, current.WithoutVersion(version), now)
+	if err != nil {
+		return err
+	}
+
+	uc.invalidatePackageCache(ctx, logger, organizationID, current.LedgerID)
+
+	uc.emitFeesPackageUpdatedEvent(ctx, span, logger, updated, organizationID)
+
+	return nil
+}
+
+// buildScheduledVersion assembles the schedule a new version applies: fields the
+// input omits are inherited from the version in force just before effectiveFrom.
+// The resulting amount range and fee map are validated as a package create
+// would validate them.
+func (uc *UseCase) buildScheduledVersion(ctx context.Context, current *pack.Package, organizationID uuid.UUID, effectiveFrom time.Time, in *model.CreatePackageVersionInput) (pack.PackageVersion, error) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	base := current.VersionAt(effectiveFrom)
+	if base == nil {
+		base = &pack.PackageVersion{
+			MinimumAmount:  current.MinimumAmount,
+			MaximumAmount:  current.MaximumAmount,
+			WaivedAccounts: current.WaivedAccounts,
+			Fees:           current.Fees,
+		}
+	}
+
+	version := pack.PackageVersion{
+		EffectiveFrom:  &effectiveFrom,
+		MinimumAmount:  base.MinimumAmount,
+		MaximumAmount:  base.MaximumAmount,
+		WaivedAccounts: base.WaivedAccounts,
+		Fees:           base.Fees,
+		ReversalPolicy: pack.SnapshotReversalPolicy(current.ReversalPolicyOf(base.Version)),
+	}
+
+	if in.MinAmount != nil {
+		minAmount, err := decimal.NewFromString(*in.MinAmount)
+		if err != nil {
+			return pack.PackageVersion{}, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, "minimumAmount")
+		}
+
+		version.MinimumAmount = minAmount
+	}
+
+	if in.MaxAmount != nil {
+		maxAmount, err := decimal.NewFromString(*in.MaxAmount)
+		if err != nil {
+			return pack.PackageVersion{}, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, "maximumAmount")
+		}
+
+		version.MaximumAmount = maxAmount
+	}
+
+	if version.MinimumAmount.GreaterThan(version.MaximumAmount) {
+		return pack.PackageVersion{}, pkg.ValidateBusinessError(constant.ErrMinAmountGreaterThanMaxAmount, "")
+	}
+
+	if in.MinAmount != nil || in.MaxAmount != nil {
+		if errRange := uc.ValidatePackageMaxAndMinAmountRange(
+			ctx, logger, version.MaximumAmount.String(), version.MinimumAmount.String(), current.GetTransactionRoute(), current.GetAsset(),
+			organizationID, current.LedgerID, current.SegmentID, &current.ID,
+		); errRange != nil {
+			return pack.PackageVersion{}, errRange
+		}
+	}
+
+	if in.WaivedAccounts != nil {
+		version.WaivedAccounts = in.WaivedAccounts
+	}
+
+	if in.Fee != nil {
+		if err := in.ValidateFees(version.MinimumAmount.String()); err != nil {
+			return pack.PackageVersion{}, err
+		}
+
+		if err := model.ValidateFeeAssets(in.Fee, current.GetAsset(), version.MinimumAmount.String()); err != nil {
+			return pack.PackageVersion{}, err
+		}
+
+		uniqueAliases := make(map[string]struct{}, len(in.Fee))
+		for _, fee := range in.Fee {
+			uniqueAliases[fee.CreditAccount] = struct{}{}
+		}
+
+		for alias := range uniqueAliases {
+			if err := uc.resolver.AccountExistsByAlias(ctx, organizationID, current.LedgerID, alias); err != nil {
+				return pack.PackageVersion{}, err
+			}
+		}
+
+		version.Fees = in.Fee
+	}
+
+	for key, fee := range version.Fees {
+		if err := fee.ValidateNewFee(key, version.MinimumAmount); err != nil {
+			return pack.PackageVersion{}, err
+		}
+	}
+
+	return version, nil
+}
+
+// syncInForceVersion prepares a package for an in-place schedule update. The
+// top-level schedule mirrors the version in force at the last write; when a
+// scheduled version has taken effect since, the mirror is refreshed first so the
+// update edits the schedule customers are actually charged, not a superseded one.
+func (uc *UseCase) syncInForceVersion(ctx context.Context, span trace.Span, id, organizationID uuid.UUID) (*pack.Package, error) {
+	current, err := uc.findPackageForVersioning(ctx, span, id, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(current.Versions) == 0 {
+		return current, nil
+	}
+
+	inForce := current.VersionAt(time.Now())
+	if inForce == nil || inForce.Version == current.Version {
+		return current, nil
+	}
+
+	synced, err := uc.packageRepo.UpdateVersions(ctx, id, organizationID, current.Versions, *inForce)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to sync package with version in force", err)
+
+		return nil, err
+	}
+
+	return synced, nil
+}
+
+// recordPackageVersion appends the schedule an in-place update produced as a new
+// version effective now. before is the package as it stood ahead of the update,
+// so a package written before versioning keeps its original schedule as version 1.
+func (uc *UseCase) recordPackageVersion(ctx context.Context, span trace.Span, before, after *pack.Package, organizationID uuid.UUID) (*pack.Package, error) {
+	now := time.Now()
+
+	version := pack.PackageVersion{
+		Version:        before.NextVersionNumber(),
+		EffectiveFrom:  &now,
+		MinimumAmount:  after.MinimumAmount,
+		MaximumAmount:  after.MaximumAmount,
+		WaivedAccounts: after.WaivedAccounts,
+		Fees:           after.Fees,
+		ReversalPolicy: pack.SnapshotReversalPolicy(after.ReversalPolicy),
+		CreatedAt:      now,
+	}
+
+	return uc.saveVersions(ctx, span, before, organizationID, before.WithVersion(version), now)
+}
+
+// saveVersions persists a new version history, mirroring the version in force
+// at now onto the package's top-level schedule.
+func (uc *UseCase) saveVersions(ctx context.Context, span trace.Span, current *pack.Package, organizationID uuid.UUID, versions []pack.PackageVersion, now time.Time) (*pack.Package, error) {
+	history := *current
+	history.Versions = versions
+
+	inForce := history.VersionAt(now)
+	if inForce == nil {
+		inForce = &versions[0]
+	}
+
+	updated, err := uc.packageRepo.UpdateVersions(ctx, current.ID, organizationID, versions, *inForce)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to save package versions", err)
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// findPackageForVersioning loads a package, mapping a missing document to the
+// package not-found business error.
+func (uc *UseCase) findPackageForVersioning(ctx context.Context, span trace.Span, id, organizationID uuid.UUID) (*pack.Package, error) {
+	current, err := uc.packageRepo.FindByID(ctx, id, organizationID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			bizErr := pkg.ValidateBusinessError(constant.ErrEntityNotFound, constant.EntityPackage)
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Package not found", bizErr)
+
+			return nil, bizErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get package on repo by id", err)
+
+		return nil, err
+	}
+
+	return current, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+func versionedPackageFixture(id uuid.UUID, scheduledFrom time.Time) *pack.Package {
+	waived := []string{"acc001"}
+
+	return &pack.Package{
+		ID:             id,
+		LedgerID:       uuid.New(),
+		Version:        1,
+		MinimumAmount:  decimal.NewFromInt(10),
+		MaximumAmount:  decimal.NewFromInt(1000),
+		WaivedAccounts: &waived,
+		Versions: []pack.PackageVersion{
+			{Version: 1, MinimumAmount: decimal.NewFromInt(10), MaximumAmount: decimal.NewFromInt(1000), WaivedAccounts: &waived, EffectiveTo: &scheduledFrom},
+			{Version: 2, EffectiveFrom: &scheduledFrom, MinimumAmount: decimal.NewFromInt(20), MaximumAmount: decimal.NewFromInt(1000), WaivedAccounts: &waived},
+		},
+	}
+}
+
+func TestSchedulePackageVersion(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	packID := uuid.New()
+	scheduledFrom := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	t.Run("rejects an effectiveFrom that is not in the future", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := &UseCase{packageRepo: pack.NewMockRepository(ctrl)}
+
+		_, err := uc.SchedulePackageVersion(context.Background(), packID, orgID, &model.CreatePackageVersionInput{
+			EffectiveFrom: time.Now().Add(-time.Hour),
+		})
+
+		var bizErr pkg.ValidationError
+		require.True(t, errors.As(err, &bizErr), "got %T: %v", err, err)
+		assert.Equal(t, constant.ErrPackageVersionNotInFuture.Error(), bizErr.Code)
+	})
+
+	t.Run("rejects a second version at the same effectiveFrom", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(versionedPackageFixture(packID, scheduledFrom), nil)
+
+		_, err := uc.SchedulePackageVersion(context.Background(), packID, orgID, &model.CreatePackageVersionInput{
+			EffectiveFrom: scheduledFrom,
+		})
+
+		var bizErr pkg.EntityConflictError
+		require.True(t, errors.As(err, &bizErr), "got %T: %v", err, err)
+		assert.Equal(t, constant.ErrPackageVersionConflict.Error(), bizErr.Code)
+	})
+
+	t.Run("maps a missing package to not found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(nil, mongo.ErrNoDocuments)
+
+		_, err := uc.SchedulePackageVersion(context.Background(), packID, orgID, &model.CreatePackageVersionInput{
+			EffectiveFrom: scheduledFrom,
+		})
+
+		var bizErr pkg.EntityNotFoundError
+		require.True(t, errors.As(err, &bizErr), "got %T: %v", err, err)
+		assert.Equal(t, constant.ErrEntityNotFound.Error(), bizErr.Code)
+	})
+
+	t.Run("inherits omitted fields from the version in force before it", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		current := versionedPackageFixture(packID, scheduledFrom)
+		later := scheduledFrom.Add(7 * 24 * time.Hour)
+		waived := []string{"acc009"}
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(current, nil)
+		repo.EXPECT().
+			UpdateVersions(gomock.Any(), packID, orgID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, versions []pack.PackageVersion, inForce pack.PackageVersion) (*pack.Package, error) {
+				require.Len(t, versions, 3)
+
+				added := versions[2]
+				assert.Equal(t, 3, added.Version)
+				assert.True(t, added.EffectiveFrom.Equal(later))
+				assert.Nil(t, added.EffectiveTo)
+				assert.True(t, added.MinimumAmount.Equal(decimal.NewFromInt(20)), "minimum inherited from v2")
+				assert.Equal(t, waived, *added.WaivedAccounts)
+				assert.True(t, versions[1].EffectiveTo.Equal(later), "v2 is closed by v3")
+
+				assert.Equal(t, 1, inForce.Version, "v1 stays in force")
+
+				out := *current
+				out.Versions = versions
+
+				return &out, nil
+			})
+
+		got, err := uc.SchedulePackageVersion(context.Background(), packID, orgID, &model.CreatePackageVersionInput{
+			EffectiveFrom:  later,
+			WaivedAccounts: &waived,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Version)
+		assert.Len(t, got.Versions, 3)
+	})
+}
+
+func TestCancelPackageVersion(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	packID := uuid.New()
+	scheduledFrom := time.Now().Add(30 * 24 * time.Hour).UTC()
+
+	t.Run("unknown version", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(versionedPackageFixture(packID, scheduledFrom), nil)
+
+		err := uc.CancelPackageVersion(context.Background(), packID, orgID, 7)
+
+		var bizErr pkg.EntityNotFoundError
+		require.True(t, errors.As(err, &bizErr), "got %T: %v", err, err)
+		assert.Equal(t, constant.ErrPackageVersionNotFound.Error(), bizErr.Code)
+	})
+
+	t.Run("version already in force", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(versionedPackageFixture(packID, scheduledFrom), nil)
+
+		err := uc.CancelPackageVersion(context.Background(), packID, orgID, 1)
+
+		var bizErr pkg.ValidationError
+		require.True(t, errors.As(err, &bizErr), "got %T: %v", err, err)
+		assert.Equal(t, constant.ErrPackageVersionNotInFuture.Error(), bizErr.Code)
+	})
+
+	t.Run("removes a scheduled version and reopens its predecessor", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		uc := &UseCase{packageRepo: repo}
+
+		current := versionedPackageFixture(packID, scheduledFrom)
+
+		repo.EXPECT().FindByID(gomock.Any(), packID, orgID).Return(current, nil)
+		repo.EXPECT().
+			UpdateVersions(gomock.Any(), packID, orgID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, versions []pack.PackageVersion, inForce pack.PackageVersion) (*pack.Package, error) {
+				require.Len(t, versions, 1)
+				assert.Equal(t, 1, versions[0].Version)
+				assert.Nil(t, versions[0].EffectiveTo)
+				assert.Equal(t, 1, inForce.Version)
+
+				return current, nil
+			})
+
+		require.NoError(t, uc.CancelPackageVersion(context.Background(), packID, orgID, 2))
+	})
+}
@@ -220,3 +220,80 @@ func BuildMaintenancePayload(
 
 	return tx
 }
+
+// BuildStatementPayload assembles the 1:1 Midaz Transaction that posts a single
+// billing statement: the statement's account is debited and its credit account
+// receives the same amount. The payload is derived from persisted statement
+// fields only, so every retry of a statement yields an identical transaction
+// under its idempotency key.
+func BuildStatementPayload(ctx context.Context, statement *model.BillingStatement) transaction.Transaction {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	_, span := tracer.Start(ctx, "service.payload_builder.build_statement_payload")
+	defer span.End()
+
+	return transaction.Transaction{
+		Code:        statement.Code,
+		Description: statement.Description,
+		Metadata:    statement.Metadata,
+		Send: transaction.Send{
+			Asset: statement.AssetCode,
+			Value: statement.Amount,
+			Source: transaction.Source{
+				From: []transaction.FromTo{{
+					AccountAlias: statement.AccountAlias,
+					Amount:       &transaction.Amount{Asset: statement.AssetCode, Value: statement.Amount},
+				}},
+			},
+			Distribute: transaction.Distribute{
+				To: []transaction.FromTo{{
+					AccountAlias: statement.CreditAccountAlias,
+					Amount:       &transaction.Amount{Asset: statement.AssetCode, Value: statement.Amount},
+				}},
+			},
+		},
+	}
+}
+
+// BuildInvoicePaymentPayload assembles the Midaz Transaction that collects
+// amount on an invoice: the invoiced account is debited and the amount is
+// split across the credit accounts of the lines it pays, in line order.
+func BuildInvoicePaymentPayload(ctx context.Context, invoice *model.BillingInvoice, amount decimal.Decimal) transaction.Transaction {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	_, span := tracer.Start(ctx, "service.payload_builder.build_invoice_payment_payload")
+	defer span.End()
+
+	aliases, shares := invoice.CreditShares(amount)
+
+	toEntries := make([]transaction.FromTo, 0, len(aliases))
+	for _, alias := range aliases {
+		toEntries = append(toEntries, transaction.FromTo{
+			AccountAlias: alias,
+			Amount:       &transaction.Amount{Asset: invoice.AssetCode, Value: shares[alias]},
+		})
+	}
+
+	return transaction.Transaction{
+		Code:        "billing-invoice",
+		Description: fmt.Sprintf("Billing invoice - %s", invoice.Period),
+		Metadata: map[string]any{
+			"billingInvoiceId": invoice.ID,
+			"period":           invoice.Period,
+			"paymentAttempt":   invoice.PaymentAttempts,
+		},
+		Send: transaction.Send{
+			Asset: invoice.AssetCode,
+			Value: amount,
+			Source: transaction.Source{
+				From: []transaction.FromTo{{
+					AccountAlias: invoice.AccountAlias,
+					Amount:       &transaction.Amount{Asset: invoice.AssetCode, Value: amount},
+				}},
+			},
+			Distribute: transaction.Distribute{
+				To: toEntries,
+			},
+		},
+	}
+}
@@ -0,0 +1,526 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreatePromotion adds a promotion to a package. It takes effect on the next
+// transaction priced after the package cache is invalidated, so campaigns no
+// longer require cloning a package without fees for the targeted accounts.
+func (uc *UseCase) CreatePromotion(ctx context.Context, id, organizationID uuid.UUID, in *model.CreatePromotionInput) (_ *pack.Package, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.create_promotion")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "create_promotion", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+	)
+
+	now := time.Now()
+
+	if err := in.Validate(now); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid promotion", err)
+
+		return nil, err
+	}
+
+	current, err := uc.findPackageForVersioning(ctx, span, id, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.AccountAlias != nil {
+		if err := uc.resolver.AccountExistsByAlias(ctx, organizationID, current.LedgerID, *in.AccountAlias); err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Promotion account not found", err)
+
+			return nil, err
+		}
+	}
+
+	promotion, err := pack.NewPackagePromotion(in, now)
+	if err != nil {
+		bizErr := pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, "feeCap")
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid promotion fee cap", bizErr)
+
+		return nil, bizErr
+	}
+
+	promotions := make([]pack.PackagePromotion, 0, len(current.Promotions)+1)
+	promotions = append(promotions, current.Promotions...)
+	promotions = append(promotions, promotion)
+
+	updated, err := uc.packageRepo.UpdatePromotions(ctx, id, organizationID, promotions)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to save package promotions", err)
+
+		return nil, err
+	}
+
+	uc.invalidatePackageCache(ctx, logger, organizationID, current.LedgerID)
+
+	uc.emitFeesPackageUpdatedEvent(ctx, span, logger, updated, organizationID)
+
+	return updated, nil
+}
+
+// DeletePromotion removes a promotion from a package. Its usage counters are
+// kept, so what it waived stays visible.
+func (uc *UseCase) DeletePromotion(ctx context.Context, id, organizationID, promotionID uuid.UUID) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.delete_promotion")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "delete_promotion", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.String("app.request.promotion_id", promotionID.String()),
+	)
+
+	current, err := uc.findPromotionPackage(ctx, id, organizationID, promotionID)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to find promotion", err)
+
+		return err
+	}
+
+	promotions := make([]pack.PackagePromotion, 0, len(current.Promotions))
+
+	for _, promotion := range current.Promotions {
+		if promotion.ID != promotionID {
+			promotions = append(promotions, promotion)
+		}
+	}
+
+	updated, err := uc.packageRepo.UpdatePromotions(ctx, id, organizationID, promotions)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to save package promotions", err)
+
+		return err
+	}
+
+	uc.invalidatePackageCache(ctx, logger, organizationID, current.LedgerID)
+
+	uc.emitFeesPackageUpdatedEvent(ctx, span, logger, updated, organizationID)
+
+	return nil
+}
+
+// GetPromotionUsage returns a page of a promotion's usage counters, one per
+// subject and period, most recently used first.
+func (uc *UseCase) GetPromotionUsage(ctx context.Context, id, organizationID, promotionID uuid.UUID, limit, page int) ([]*model.PromotionUsage, int64, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_promotion_usage")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", id.String()),
+		attribute.String("app.request.promotion_id", promotionID.String()),
+	)
+
+	if _, err := uc.findPromotionPackage(ctx, id, organizationID, promotionID); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to find promotion", err)
+
+		return nil, 0, err
+	}
+
+	if uc.PromotionUsage == nil {
+		return []*model.PromotionUsage{}, 0, nil
+	}
+
+	usage, total, err := uc.PromotionUsage.FindByPromotion(ctx, organizationID.String(), promotionID.String(), limit, page)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list promotion usage", err)
+
+		return nil, 0, err
+	}
+
+	return usage, total, nil
+}
+
+// findPromotionPackage loads a package and checks that it holds the promotion.
+func (uc *UseCase) findPromotionPackage(ctx context.Context, id, organizationID, promotionID uuid.UUID) (*pack.Package, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.find_promotion_package")
+	defer span.End()
+
+	current, err := uc.findPackageForVersioning(ctx, span, id, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.FindPromotion(promotionID) == nil {
+		return nil, pkg.ValidateBusinessError(constant.ErrPromotionNotFound, constant.EntityPackage, promotionID.String())
+	}
+
+	return current, nil
+}
+
+// promotionReserveAttempts bounds how many times a fee cap is read and
+// reserved again when transactions of the same subject priced at the same time
+// move its counter between the read and the guarded increment.
+const promotionReserveAttempts = 3
+
+// applyFeePromotions waives the fees p charged on cf for every payer targeted
+// by one of p's promotions active at the transaction's effective date. The
+// first matching promotion, in creation order, applies to a payer.
+//
+// With reserve, pricing a transaction also reserves its use of each promotion:
+// the usage counters move at once, by guarded increments that never let two
+// transactions take the last free transaction or the last part of a fee cap.
+// What each promotion charged and waived is listed in the feePromotions
+// metadata, and a transaction that is rejected, fails to commit or is
+// cancelled gives it back (see ReleasePromotionUsage). Without reserve, as for
+// estimates and quotes, the counters are only read. A nil PromotionUsage
+// repository disables promotions.
+func (uc *UseCase) applyFeePromotions(ctx context.Context, logger libLog.Logger, cf *model.FeeCalculate, p *pack.Package, organizationID uuid.UUID, reserve bool) error {
+	if uc.PromotionUsage == nil || p == nil || len(p.Promotions) == 0 {
+		return nil
+	}
+
+	at := feeUtils.EffectiveDate(cf)
+
+	active := make([]pack.PackagePromotion, 0, len(p.Promotions))
+
+	for _, promotion := range p.Promotions {
+		if promotion.ActiveAt(at) {
+			active = append(active, promotion)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil
+	}
+
+	packageID := p.ID.String()
+	applied := make([]map[string]any, 0)
+	reserved := make([]model.PromotionUsage, 0)
+
+	for _, payer := range feeUtils.FeePayers(&cf.Transaction, packageID) {
+		promotion := uc.matchPromotion(ctx, logger, active, payer.Alias, organizationID, cf.LedgerID)
+		if promotion == nil {
+			continue
+		}
+
+		key := model.PromotionUsage{
+			OrganizationID: organizationID.String(),
+			PackageID:      packageID,
+			PromotionID:    promotion.ID.String(),
+			Subject:        promotion.Subject(),
+			Period:         model.PromotionPeriodKey(promotion.Period, at),
+		}
+
+		waive, err := uc.promotionWaiver(ctx, promotion, key, payer.Total, reserve)
+		if err != nil {
+			// The transaction is rejected, so the payers already priced give
+			// back what they reserved.
+			if errRelease := uc.releasePromotionUsages(ctx, reserved); errRelease != nil {
+				logger.Log(ctx, libLog.LevelWarn, "Failed to release fee promotion usage", libLog.Err(errRelease))
+			}
+
+			return err
+		}
+
+		waived := decimal.Zero
+		if waive.IsPositive() {
+			waived = feeUtils.WaivePayerFees(&cf.Transaction, packageID, payer.Key, waive)
+		}
+
+		if reserve {
+			key.Transactions = 1
+			key.FeesCharged = payer.Total.Sub(waive)
+			key.FeesWaived = waive
+			reserved = append(reserved, key)
+		}
+
+		applied = append(applied, map[string]any{
+			"promotionId": key.PromotionID,
+			"packageId":   packageID,
+			"account":     payer.Alias,
+			"subject":     key.Subject,
+			"period":      key.Period,
+			"charged":     payer.Total.Sub(waived).String(),
+			"waived":      waived.String(),
+		})
+	}
+
+	if len(applied) > 0 {
+		if cf.Transaction.Metadata == nil {
+			cf.Transaction.Metadata = make(map[string]any)
+		}
+
+		cf.Transaction.Metadata[feeUtils.MetadataFeePromotions] = applied
+	}
+
+	return nil
+}
+
+// ReleasePromotionUsage gives back the promotion usage a transaction reserved
+// when it was priced, by what its feePromotions metadata records. It runs when
+// the transaction fails before its balance commit or a pending one is
+// cancelled, so only transactions that post use up a promotion. A quoted
+// transaction is skipped, since the quote, not the package, decided what it
+// paid and nothing was reserved. A nil PromotionUsage repository disables
+// releasing.
+func (uc *UseCase) ReleasePromotionUsage(ctx context.Context, organizationID uuid.UUID, t *transaction.Transaction) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if uc.PromotionUsage == nil || t == nil || t.FeeQuoteID != nil {
+		return nil
+	}
+
+	usages := feeUtils.PromotionUsages(t)
+	if len(usages) == 0 {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "service.release_promotion_usage")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "release_promotion_usage", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.Int("app.request.promotion_usages", len(usages)),
+	)
+
+	for i := range usages {
+		usages[i].OrganizationID = organizationID.String()
+	}
+
+	if err := uc.releasePromotionUsages(ctx, usages); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to release promotion usage", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// releasePromotionUsages moves each counter back by the usage reserved on it.
+// Every counter is attempted; the failures are returned together.
+func (uc *UseCase) releasePromotionUsages(ctx context.Context, usages []model.PromotionUsage) error {
+	var errs []error
+
+	for _, usage := range usages {
+		delta := usage
+		delta.Transactions = -usage.Transactions
+		delta.FeesCharged = usage.FeesCharged.Neg()
+		delta.FeesWaived = usage.FeesWaived.Neg()
+
+		if _, err := uc.PromotionUsage.Increment(ctx, &delta); err != nil {
+			errs = append(errs, fmt.Errorf("promotion %s of %s: %w", usage.PromotionID, usage.Subject, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// matchPromotion returns the first promotion targeting the payer's alias or its
+// holder. The holder is only looked up when a holder promotion is active; a
+// failed lookup is logged and leaves only alias promotions eligible, so a read
+// failure can at worst charge a fee the payer would have been spared.
+func (uc *UseCase) matchPromotion(ctx context.Context, logger libLog.Logger, promotions []pack.PackagePromotion, alias string, organizationID, ledgerID uuid.UUID) *pack.PackagePromotion {
+	var (
+		holderID       string
+		holderResolved bool
+	)
+
+	for i := range promotions {
+		promotion := &promotions[i]
+
+		if promotion.AccountAlias != nil && *promotion.AccountAlias == alias {
+			return promotion
+		}
+
+		if promotion.HolderID == nil {
+			continue
+		}
+
+		if !holderResolved {
+			holderResolved = true
+			holderID = uc.resolvePayerHolder(ctx, logger, alias, organizationID, ledgerID)
+		}
+
+		if holderID != "" && strings.EqualFold(*promotion.HolderID, holderID) {
+			return promotion
+		}
+	}
+
+	return nil
+}
+
+// resolvePayerHolder returns the holder of the account behind alias, or an
+// empty string when it has none or cannot be resolved.
+func (uc *UseCase) resolvePayerHolder(ctx context.Context, logger libLog.Logger, alias string, organizationID, ledgerID uuid.UUID) string {
+	if uc.resolver == nil || strings.HasPrefix(alias, "@external/") {
+		return ""
+	}
+
+	account, err := uc.resolver.GetAccountByAlias(ctx, organizationID, ledgerID, alias)
+	if err != nil {
+		logger.Log(ctx, libLog.LevelWarn, "Failed to resolve fee payer holder; holder promotions skipped",
+			libLog.String("alias", alias), libLog.Err(err))
+
+		return ""
+	}
+
+	if account == nil || account.HolderID == nil {
+		return ""
+	}
+
+	return account.HolderID.String()
+}
+
+// promotionWaiver returns how much of total the promotion waives, given the
+// subject's usage in the period so far. Without reserve it only reads the
+// counter: free transactions are left while the subject has posted fewer than
+// the allowance, and a fee cap charges what is left of it.
+func (uc *UseCase) promotionWaiver(ctx context.Context, promotion *pack.PackagePromotion, key model.PromotionUsage, total decimal.Decimal, reserve bool) (decimal.Decimal, error) {
+	if reserve {
+		return uc.reservePromotionWaiver(ctx, promotion, key, total)
+	}
+
+	if promotion.FreeTransactions == nil && promotion.FeeCap == nil {
+		return total, nil
+	}
+
+	usage, err := uc.PromotionUsage.Find(ctx, key.OrganizationID, key.PromotionID, key.Subject, key.Period)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if usage == nil {
+		usage = &model.PromotionUsage{}
+	}
+
+	if promotion.FreeTransactions != nil {
+		if usage.Transactions < int64(*promotion.FreeTransactions) {
+			return total, nil
+		}
+
+		return decimal.Zero, nil
+	}
+
+	charged := decimal.Min(decimal.Max(promotion.FeeCap.Sub(usage.FeesCharged), decimal.Zero), total)
+
+	return total.Sub(charged), nil
+}
+
+// reservePromotionWaiver counts the transaction's use of the promotion and
+// returns how much of total it waives. A free transaction is taken by an
+// increment guarded by the allowance, and a transaction past it is counted as
+// charged. A fee cap is read, then what is left of it is charged by an
+// increment guarded by the cap, read again if another transaction moved it in
+// between.
+func (uc *UseCase) reservePromotionWaiver(ctx context.Context, promotion *pack.PackagePromotion, key model.PromotionUsage, total decimal.Decimal) (decimal.Decimal, error) {
+	delta := key
+	delta.Transactions = 1
+
+	switch {
+	case promotion.FreeTransactions != nil:
+		free := int64(*promotion.FreeTransactions)
+		delta.FeesWaived = total
+
+		ok, err := uc.PromotionUsage.IncrementWithin(ctx, &delta, &free, nil)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		if ok {
+			return total, nil
+		}
+
+		delta.FeesCharged, delta.FeesWaived = total, decimal.Zero
+
+		if _, err := uc.PromotionUsage.Increment(ctx, &delta); err != nil {
+			return decimal.Zero, err
+		}
+
+		return decimal.Zero, nil
+	case promotion.FeeCap != nil:
+		for range promotionReserveAttempts {
+			usage, err := uc.PromotionUsage.Find(ctx, key.OrganizationID, key.PromotionID, key.Subject, key.Period)
+			if err != nil {
+				return decimal.Zero, err
+			}
+
+			if usage == nil {
+				usage = &model.PromotionUsage{}
+			}
+
+			// A cap lowered below what was already charged charges nothing more.
+			limit := decimal.Max(*promotion.FeeCap, usage.FeesCharged)
+
+			delta.FeesCharged = decimal.Min(decimal.Max(promotion.FeeCap.Sub(usage.FeesCharged), decimal.Zero), total)
+			delta.FeesWaived = total.Sub(delta.FeesCharged)
+
+			ok, err := uc.PromotionUsage.IncrementWithin(ctx, &delta, nil, &limit)
+			if err != nil {
+				return decimal.Zero, err
+			}
+
+			if ok {
+				return delta.FeesWaived, nil
+			}
+		}
+
+		return decimal.Zero, fmt.Errorf("promotion %s of %s: fee cap usage kept changing while it was reserved", key.PromotionID, key.Subject)
+	default:
+		delta.FeesWaived = total
+
+		if _, err := uc.PromotionUsage.Increment(ctx, &delta); err != nil {
+			return decimal.Zero, err
+		}
+
+		return total, nil
+	}
+}
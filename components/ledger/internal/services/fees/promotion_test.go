@@ -0,0 +1,574 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/promotion_usage"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+
+	libZap "github.com/LerianStudio/lib-observability/zap"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// promotionPackage is a package charging the payer a flat 10 fee on top of
+// the principal, with the given promotions.
+func promotionPackage(promotions ...pack.PackagePromotion) *pack.Package {
+	notDeductible := false
+
+	return &pack.Package{
+		ID:             uuid.New(),
+		LedgerID:       uuid.New(),
+		MinimumAmount:  decimal.Zero,
+		MaximumAmount:  decimal.NewFromInt(100000),
+		WaivedAccounts: &[]string{},
+		Fees: map[string]model.Fee{"service": {
+			FeeLabel: "service",
+			CalculationModel: &model.CalculationModel{
+				ApplicationRule: "flatFee",
+				Calculations:    []model.Calculation{{Type: "flat", Value: "10"}},
+			},
+			ReferenceAmount:  "originalAmount",
+			Priority:         1,
+			IsDeductibleFrom: &notDeductible,
+			CreditAccount:    "@fee_account",
+		}},
+		Promotions: promotions,
+	}
+}
+
+// pricedTransfer runs the fee engine on a 1000 transfer from @payer.
+func pricedTransfer(t *testing.T, p *pack.Package) *model.FeeCalculate {
+	t.Helper()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	cf := &model.FeeCalculate{
+		LedgerID:    p.LedgerID,
+		Transaction: transaction.Transaction{Send: transaction.Send{Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+	}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@payer": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		To:   map[string]transaction.Amount{"@payee": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+	}
+
+	require.NoError(t, feeUtils.CalculateFee(logger, cf, p, resp, "BRL", nil))
+	require.True(t, cf.Transaction.Send.Value.Equal(decimal.NewFromInt(1010)))
+
+	return cf
+}
+
+func TestApplyFeePromotions(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	holderID := uuid.New()
+	alias := "@payer"
+	holder := holderID.String()
+	past := time.Now().Add(-time.Hour)
+	free := 3
+	feeCap := decimal.NewFromInt(25)
+
+	// Without reserve pricing only reads the counters: the mock has no
+	// increment expectation, so moving one fails the test.
+	tests := []struct {
+		name        string
+		promotion   pack.PackagePromotion
+		mockSetup   func(usage *promotion_usage.MockRepository, resolver *feeshared.MockMidazResolver)
+		wantValue   int64
+		wantCharged string
+		wantWaived  string
+	}{
+		{
+			name:        "unlimited promotion waives every fee",
+			promotion:   pack.PackagePromotion{AccountAlias: &alias, Period: model.PromotionPeriodLifetime},
+			mockSetup:   func(_ *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {},
+			wantValue:   1000,
+			wantCharged: "0",
+			wantWaived:  "10",
+		},
+		{
+			name:      "free transaction within the allowance",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(usage *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+					Return(&model.PromotionUsage{Transactions: 2}, nil)
+			},
+			wantValue:   1000,
+			wantCharged: "0",
+			wantWaived:  "10",
+		},
+		{
+			name:      "first use of a free transaction allowance",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(usage *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).Return(nil, nil)
+			},
+			wantValue:   1000,
+			wantCharged: "0",
+			wantWaived:  "10",
+		},
+		{
+			name:      "free transactions used up charges the fee",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(usage *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+					Return(&model.PromotionUsage{Transactions: 3}, nil)
+			},
+			wantValue:   1010,
+			wantCharged: "10",
+			wantWaived:  "0",
+		},
+		{
+			name:      "fee cap charges only what is left of the allowance",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FeeCap: &feeCap, Period: model.PromotionPeriodMonth},
+			mockSetup: func(usage *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {
+				// 20 already paid this month, so 5 of this transaction's 10.
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+					Return(&model.PromotionUsage{FeesCharged: decimal.NewFromInt(20)}, nil)
+			},
+			wantValue:   1005,
+			wantCharged: "5",
+			wantWaived:  "5",
+		},
+		{
+			name:      "holder promotion applies to the holder's accounts",
+			promotion: pack.PackagePromotion{HolderID: &holder, Period: model.PromotionPeriodLifetime},
+			mockSetup: func(_ *promotion_usage.MockRepository, resolver *feeshared.MockMidazResolver) {
+				resolver.EXPECT().GetAccountByAlias(gomock.Any(), orgID, gomock.Any(), alias).
+					Return(&feeshared.Account{Alias: alias, HolderID: &holderID}, nil)
+			},
+			wantValue:   1000,
+			wantCharged: "0",
+			wantWaived:  "10",
+		},
+		{
+			name:      "ended promotion is ignored",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, EndsAt: &past, Period: model.PromotionPeriodLifetime},
+			mockSetup: func(_ *promotion_usage.MockRepository, _ *feeshared.MockMidazResolver) {},
+			wantValue: 1010,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			usage := promotion_usage.NewMockRepository(ctrl)
+			resolver := feeshared.NewMockMidazResolver(ctrl)
+			tt.mockSetup(usage, resolver)
+
+			uc := &UseCase{resolver: resolver, PromotionUsage: usage}
+
+			promotion := tt.promotion
+			promotion.ID = uuid.New()
+
+			p := promotionPackage(promotion)
+			cf := pricedTransfer(t, p)
+
+			logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+			require.NoError(t, uc.applyFeePromotions(context.Background(), logger, cf, p, orgID, false))
+
+			assert.True(t, cf.Transaction.Send.Value.Equal(decimal.NewFromInt(tt.wantValue)), "value %s", cf.Transaction.Send.Value)
+
+			usages := feeUtils.PromotionUsages(&cf.Transaction)
+
+			if tt.wantWaived == "" {
+				assert.Empty(t, usages)
+
+				return
+			}
+
+			require.Len(t, usages, 1)
+			assert.Equal(t, promotion.ID.String(), usages[0].PromotionID)
+			assert.Equal(t, promotion.Subject(), usages[0].Subject)
+			assert.True(t, usages[0].FeesCharged.Equal(decimal.RequireFromString(tt.wantCharged)), "charged %s", usages[0].FeesCharged)
+			assert.True(t, usages[0].FeesWaived.Equal(decimal.RequireFromString(tt.wantWaived)), "waived %s", usages[0].FeesWaived)
+		})
+	}
+}
+
+func TestApplyFeePromotions_WithoutUsageRepositoryChargesFees(t *testing.T) {
+	t.Parallel()
+
+	alias := "@payer"
+	p := promotionPackage(pack.PackagePromotion{ID: uuid.New(), AccountAlias: &alias, Period: model.PromotionPeriodLifetime})
+	cf := pricedTransfer(t, p)
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	require.NoError(t, (&UseCase{}).applyFeePromotions(context.Background(), logger, cf, p, uuid.New(), true))
+	assert.True(t, cf.Transaction.Send.Value.Equal(decimal.NewFromInt(1010)))
+}
+
+func TestApplyFeePromotions_Reserve(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	alias := "@payer"
+	free := 3
+	freeLimit := int64(free)
+	feeCap := decimal.NewFromInt(25)
+
+	// assertUsage checks the usage one transaction reserves.
+	assertUsage := func(t *testing.T, delta *model.PromotionUsage, charged, waived int64) {
+		t.Helper()
+
+		assert.Equal(t, orgID.String(), delta.OrganizationID)
+		assert.Equal(t, alias, delta.Subject)
+		assert.Equal(t, int64(1), delta.Transactions)
+		assert.True(t, delta.FeesCharged.Equal(decimal.NewFromInt(charged)), "charged %s", delta.FeesCharged)
+		assert.True(t, delta.FeesWaived.Equal(decimal.NewFromInt(waived)), "waived %s", delta.FeesWaived)
+	}
+
+	// reserved accepts a guarded increment of the usage one transaction reserves.
+	reserved := func(t *testing.T, charged, waived int64) func(context.Context, *model.PromotionUsage, *int64, *decimal.Decimal) (bool, error) {
+		return func(_ context.Context, delta *model.PromotionUsage, _ *int64, _ *decimal.Decimal) (bool, error) {
+			assertUsage(t, delta, charged, waived)
+
+			return true, nil
+		}
+	}
+
+	// counted accepts an unguarded increment of the usage one transaction reserves.
+	counted := func(t *testing.T, charged, waived int64) func(context.Context, *model.PromotionUsage) (*model.PromotionUsage, error) {
+		return func(_ context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error) {
+			assertUsage(t, delta, charged, waived)
+
+			return delta, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		promotion pack.PackagePromotion
+		mockSetup func(t *testing.T, usage *promotion_usage.MockRepository)
+		wantValue int64
+		wantErr   bool
+	}{
+		{
+			name:      "unlimited promotion counts the waived transaction",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, Period: model.PromotionPeriodLifetime},
+			mockSetup: func(t *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().Increment(gomock.Any(), gomock.Any()).DoAndReturn(counted(t, 0, 10))
+			},
+			wantValue: 1000,
+		},
+		{
+			name:      "free transaction is taken within the allowance",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(t *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), &freeLimit, nil).DoAndReturn(reserved(t, 0, 10))
+			},
+			wantValue: 1000,
+		},
+		{
+			name:      "free transactions used up count a charged transaction",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(t *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), &freeLimit, nil).Return(false, nil)
+				usage.EXPECT().Increment(gomock.Any(), gomock.Any()).DoAndReturn(counted(t, 10, 0))
+			},
+			wantValue: 1010,
+		},
+		{
+			name:      "fee cap reserves what is left of it",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FeeCap: &feeCap, Period: model.PromotionPeriodMonth},
+			mockSetup: func(t *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+					Return(&model.PromotionUsage{FeesCharged: decimal.NewFromInt(20)}, nil)
+				usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), nil, &feeCap).DoAndReturn(reserved(t, 5, 5))
+			},
+			wantValue: 1005,
+		},
+		{
+			name:      "fee cap moved by another transaction is read again",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FeeCap: &feeCap, Period: model.PromotionPeriodMonth},
+			mockSetup: func(t *testing.T, usage *promotion_usage.MockRepository) {
+				gomock.InOrder(
+					usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+						Return(&model.PromotionUsage{FeesCharged: decimal.NewFromInt(20)}, nil),
+					usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), nil, &feeCap).Return(false, nil),
+					usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+						Return(&model.PromotionUsage{FeesCharged: decimal.NewFromInt(25)}, nil),
+					usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), nil, &feeCap).DoAndReturn(reserved(t, 0, 10)),
+				)
+			},
+			wantValue: 1000,
+		},
+		{
+			name:      "fee cap that keeps moving rejects pricing",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FeeCap: &feeCap, Period: model.PromotionPeriodMonth},
+			mockSetup: func(_ *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().Find(gomock.Any(), orgID.String(), gomock.Any(), alias, gomock.Any()).
+					Return(&model.PromotionUsage{}, nil).Times(promotionReserveAttempts)
+				usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), nil, &feeCap).
+					Return(false, nil).Times(promotionReserveAttempts)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "counter failure rejects pricing",
+			promotion: pack.PackagePromotion{AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(_ *testing.T, usage *promotion_usage.MockRepository) {
+				usage.EXPECT().IncrementWithin(gomock.Any(), gomock.Any(), &freeLimit, nil).Return(false, errors.New("mongo down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			usage := promotion_usage.NewMockRepository(ctrl)
+			tt.mockSetup(t, usage)
+
+			uc := &UseCase{PromotionUsage: usage}
+
+			promotion := tt.promotion
+			promotion.ID = uuid.New()
+
+			p := promotionPackage(promotion)
+			cf := pricedTransfer(t, p)
+
+			logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+			err := uc.applyFeePromotions(context.Background(), logger, cf, p, orgID, true)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, cf.Transaction.Send.Value.Equal(decimal.NewFromInt(tt.wantValue)), "value %s", cf.Transaction.Send.Value)
+			require.Len(t, feeUtils.PromotionUsages(&cf.Transaction), 1)
+		})
+	}
+}
+
+func TestReleasePromotionUsage(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	alias := "@payer"
+
+	// promotedTransfer prices a transfer under an unlimited promotion, as the
+	// create path does before committing it.
+	promotedTransfer := func(t *testing.T) *transaction.Transaction {
+		t.Helper()
+
+		p := promotionPackage(pack.PackagePromotion{ID: uuid.New(), AccountAlias: &alias, Period: model.PromotionPeriodLifetime})
+		cf := pricedTransfer(t, p)
+
+		logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+		ctrl := gomock.NewController(t)
+		usage := promotion_usage.NewMockRepository(ctrl)
+		usage.EXPECT().Increment(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error) { return delta, nil })
+
+		require.NoError(t, (&UseCase{PromotionUsage: usage}).applyFeePromotions(context.Background(), logger, cf, p, orgID, true))
+
+		return &cf.Transaction
+	}
+
+	t.Run("transaction that does not post gives back what it reserved", func(t *testing.T) {
+		t.Parallel()
+
+		tx := promotedTransfer(t)
+
+		ctrl := gomock.NewController(t)
+		usage := promotion_usage.NewMockRepository(ctrl)
+		usage.EXPECT().Increment(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, delta *model.PromotionUsage) (*model.PromotionUsage, error) {
+				assert.Equal(t, orgID.String(), delta.OrganizationID)
+				assert.Equal(t, alias, delta.Subject)
+				assert.Equal(t, model.PromotionPeriodLifetime, delta.Period)
+				assert.Equal(t, int64(-1), delta.Transactions)
+				assert.True(t, delta.FeesCharged.IsZero())
+				assert.True(t, delta.FeesWaived.Equal(decimal.NewFromInt(-10)))
+
+				return delta, nil
+			})
+
+		require.NoError(t, (&UseCase{PromotionUsage: usage}).ReleasePromotionUsage(context.Background(), orgID, tx))
+	})
+
+	t.Run("quoted transaction is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		tx := promotedTransfer(t)
+		quoteID := uuid.NewString()
+		tx.FeeQuoteID = &quoteID
+
+		ctrl := gomock.NewController(t)
+
+		require.NoError(t, (&UseCase{PromotionUsage: promotion_usage.NewMockRepository(ctrl)}).ReleasePromotionUsage(context.Background(), orgID, tx))
+	})
+
+	t.Run("counter failure is returned", func(t *testing.T) {
+		t.Parallel()
+
+		tx := promotedTransfer(t)
+
+		ctrl := gomock.NewController(t)
+		usage := promotion_usage.NewMockRepository(ctrl)
+		usage.EXPECT().Increment(gomock.Any(), gomock.Any()).Return(nil, errors.New("mongo down"))
+
+		require.Error(t, (&UseCase{PromotionUsage: usage}).ReleasePromotionUsage(context.Background(), orgID, tx))
+	})
+}
+
+func TestCreatePromotion(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	alias := "@customer"
+	free := 10
+
+	tests := []struct {
+		name      string
+		input     model.CreatePromotionInput
+		mockSetup func(repo *pack.MockRepository, resolver *feeshared.MockMidazResolver, p *pack.Package)
+		wantErr   error
+	}{
+		{
+			name:      "promotion without a target is rejected",
+			input:     model.CreatePromotionInput{Label: "Launch"},
+			mockSetup: func(_ *pack.MockRepository, _ *feeshared.MockMidazResolver, _ *pack.Package) {},
+			wantErr:   constant.ErrPromotionTargetInvalid,
+		},
+		{
+			name:  "promotion is appended to the package",
+			input: model.CreatePromotionInput{Label: "Launch", AccountAlias: &alias, FreeTransactions: &free, Period: model.PromotionPeriodMonth},
+			mockSetup: func(repo *pack.MockRepository, resolver *feeshared.MockMidazResolver, p *pack.Package) {
+				repo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+				resolver.EXPECT().AccountExistsByAlias(gomock.Any(), orgID, p.LedgerID, alias).Return(nil)
+				repo.EXPECT().UpdatePromotions(gomock.Any(), p.ID, orgID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ uuid.UUID, promotions []pack.PackagePromotion) (*pack.Package, error) {
+						require.Len(t, promotions, 2)
+						assert.Equal(t, "Launch", promotions[1].Label)
+						assert.Equal(t, model.PromotionPeriodMonth, promotions[1].Period)
+						assert.NotEqual(t, uuid.Nil, promotions[1].ID)
+
+						updated := *p
+						updated.Promotions = promotions
+
+						return &updated, nil
+					})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := pack.NewMockRepository(ctrl)
+			resolver := feeshared.NewMockMidazResolver(ctrl)
+
+			existing := "@existing"
+			p := promotionPackage(pack.PackagePromotion{ID: uuid.New(), AccountAlias: &existing, Period: model.PromotionPeriodLifetime})
+			tt.mockSetup(repo, resolver, p)
+
+			uc := &UseCase{packageRepo: repo, resolver: resolver}
+
+			input := tt.input
+
+			updated, err := uc.CreatePromotion(context.Background(), p.ID, orgID, &input)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, ""), err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, updated.Promotions, 2)
+		})
+	}
+}
+
+func TestDeletePromotion(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	alias := "@customer"
+
+	t.Run("unknown promotion is not found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		p := promotionPackage()
+		missing := uuid.New()
+
+		repo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+
+		err := (&UseCase{packageRepo: repo}).DeletePromotion(context.Background(), p.ID, orgID, missing)
+		require.Error(t, err)
+		assert.Equal(t, pkg.ValidateBusinessError(constant.ErrPromotionNotFound, constant.EntityPackage, missing.String()), err)
+	})
+
+	t.Run("promotion is removed from the package", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := pack.NewMockRepository(ctrl)
+		promotionID := uuid.New()
+		p := promotionPackage(pack.PackagePromotion{ID: promotionID, AccountAlias: &alias, Period: model.PromotionPeriodLifetime})
+
+		repo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+		repo.EXPECT().UpdatePromotions(gomock.Any(), p.ID, orgID, []pack.PackagePromotion{}).Return(p, nil)
+
+		require.NoError(t, (&UseCase{packageRepo: repo}).DeletePromotion(context.Background(), p.ID, orgID, promotionID))
+	})
+}
+
+func TestGetPromotionUsage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := pack.NewMockRepository(ctrl)
+	usage := promotion_usage.NewMockRepository(ctrl)
+
+	orgID := uuid.New()
+	alias := "@customer"
+	promotionID := uuid.New()
+	p := promotionPackage(pack.PackagePromotion{ID: promotionID, AccountAlias: &alias, Period: model.PromotionPeriodMonth})
+
+	counters := []*model.PromotionUsage{{PromotionID: promotionID.String(), Subject: alias, Period: "2026-10", Transactions: 2}}
+
+	repo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+	usage.EXPECT().FindByPromotion(gomock.Any(), orgID.String(), promotionID.String(), 10, 1).Return(counters, int64(1), nil)
+
+	uc := &UseCase{packageRepo: repo, PromotionUsage: usage}
+
+	got, total, err := uc.GetPromotionUsage(context.Background(), p.ID, orgID, promotionID, 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, counters, got)
+}
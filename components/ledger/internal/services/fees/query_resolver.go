@@ -9,6 +9,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/assetrate"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/transaction"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/services/query"
 	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
@@ -16,6 +17,7 @@ import (
 	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
 	libHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // feeQueryPort is the narrow slice of the ledger query.UseCase that fee
@@ -25,6 +27,8 @@ type feeQueryPort interface {
 	GetAccountByAlias(ctx context.Context, organizationID, ledgerID uuid.UUID, portfolioID *uuid.UUID, alias string) (*mmodel.Account, error)
 	GetAllAccount(ctx context.Context, organizationID, ledgerID uuid.UUID, portfolioID, segmentID *uuid.UUID, filter libHTTP.QueryHeader) ([]*mmodel.Account, error)
 	CountTransactionsByFilters(ctx context.Context, organizationID, ledgerID uuid.UUID, filter transaction.CountFilter) (int64, error)
+	GetAssetRateByCurrencyPair(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*assetrate.AssetRate, error)
+	GetAllBalancesByAlias(ctx context.Context, organizationID, ledgerID uuid.UUID, alias string) ([]*mmodel.Balance, error)
 }
 
 // Compile-time assurance that the concrete query.UseCase satisfies the port.
@@ -136,6 +140,48 @@ func (r *queryResolver) CountTransactionsByRoute(ctx context.Context, organizati
 	})
 }
 
+// GetAssetRate returns the ledger's rate from one asset into another. Rates are
+// stored as an integer rate and a scale, so the conversion factor is
+// Rate / 10^Scale.
+func (r *queryResolver) GetAssetRate(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error) {
+	assetRate, err := r.query.GetAssetRateByCurrencyPair(ctx, organizationID, ledgerID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if assetRate == nil {
+		return nil, nil
+	}
+
+	rate := assetRate.Factor()
+
+	return &rate, nil
+}
+
+// AvailableBalance returns the available amount of the alias' default balance
+// in asset. Any other balance key is a purpose-specific bucket the account
+// does not spend from, so it is never counted.
+func (r *queryResolver) AvailableBalance(ctx context.Context, organizationID, ledgerID uuid.UUID, alias, asset string) (decimal.Decimal, error) {
+	balances, err := r.query.GetAllBalancesByAlias(ctx, organizationID, ledgerID, alias)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	for _, b := range balances {
+		if b == nil || b.Key != constant.DefaultBalanceKey || b.AssetCode != asset {
+			continue
+		}
+
+		if !b.AllowSending || !b.Available.IsPositive() {
+			return decimal.Zero, nil
+		}
+
+		return b.Available, nil
+	}
+
+	return decimal.Zero, nil
+}
+
 // toFeeAccount maps a ledger domain account onto the fee-side account shape.
 func toFeeAccount(a *mmodel.Account) *feeshared.Account {
 	out := &feeshared.Account{
@@ -166,5 +212,11 @@ func toFeeAccount(a *mmodel.Account) *feeshared.Account {
 		}
 	}
 
+	if a.HolderID != nil {
+		if id, err := uuid.Parse(*a.HolderID); err == nil {
+			out.HolderID = &id
+		}
+	}
+
 	return out
 }
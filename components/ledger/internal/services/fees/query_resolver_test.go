@@ -11,11 +11,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/assetrate"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/transaction"
 	pkgconstant "github.com/LerianStudio/midaz/v4/pkg/constant"
 	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
 	libHTTP "github.com/LerianStudio/midaz/v4/pkg/net/http"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,6 +27,8 @@ type fakeQueryPort struct {
 	getByAliasFn func(ctx context.Context, org, ledger uuid.UUID, portfolio *uuid.UUID, alias string) (*mmodel.Account, error)
 	getAllFn     func(ctx context.Context, org, ledger uuid.UUID, portfolio, segment *uuid.UUID, filter libHTTP.QueryHeader) ([]*mmodel.Account, error)
 	countFn      func(ctx context.Context, org, ledger uuid.UUID, filter transaction.CountFilter) (int64, error)
+	assetRateFn  func(ctx context.Context, org, ledger uuid.UUID, from, to string) (*assetrate.AssetRate, error)
+	balancesFn   func(ctx context.Context, org, ledger uuid.UUID, alias string) ([]*mmodel.Balance, error)
 
 	getAllCalls []libHTTP.QueryHeader
 }
@@ -42,6 +46,14 @@ func (f *fakeQueryPort) CountTransactionsByFilters(ctx context.Context, org, led
 	return f.countFn(ctx, org, ledger, filter)
 }
 
+func (f *fakeQueryPort) GetAssetRateByCurrencyPair(ctx context.Context, org, ledger uuid.UUID, from, to string) (*assetrate.AssetRate, error) {
+	return f.assetRateFn(ctx, org, ledger, from, to)
+}
+
+func (f *fakeQueryPort) GetAllBalancesByAlias(ctx context.Context, org, ledger uuid.UUID, alias string) ([]*mmodel.Balance, error) {
+	return f.balancesFn(ctx, org, ledger, alias)
+}
+
 func newResolverWithPort(port feeQueryPort) *queryResolver {
 	return &queryResolver{query: port}
 }
@@ -64,11 +76,17 @@ func TestQueryResolver_GetAccountByAlias_MapsFields(t *testing.T) {
 	org, ledger := uuid.New(), uuid.New()
 	segID := uuid.New()
 	segStr := segID.String()
+	holderID := uuid.New()
+	holderStr := holderID.String()
 
 	port := &fakeQueryPort{
 		getByAliasFn: func(_ context.Context, _, _ uuid.UUID, _ *uuid.UUID, alias string) (*mmodel.Account, error) {
 			assert.Equal(t, "alice", alias)
-			return mmodelAccount("acc-1", "alice", "ACTIVE", &segStr), nil
+
+			a := mmodelAccount("acc-1", "alice", "ACTIVE", &segStr)
+			a.HolderID = &holderStr
+
+			return a, nil
 		},
 	}
 
@@ -83,6 +101,8 @@ func TestQueryResolver_GetAccountByAlias_MapsFields(t *testing.T) {
 	assert.Equal(t, "ACTIVE", acc.Status.Code)
 	assert.NotNil(t, acc.SegmentID)
 	assert.Equal(t, segID, *acc.SegmentID)
+	assert.NotNil(t, acc.HolderID)
+	assert.Equal(t, holderID, *acc.HolderID)
 }
 
 func TestQueryResolver_GetAccountByAlias_NotFoundCollapsesToNil(t *testing.T) {
@@ -264,3 +284,74 @@ func TestQueryResolver_CountTransactionsByRoute_MapsToCountFilter(t *testing.T)
 	assert.Equal(t, start, captured.StartDate)
 	assert.Equal(t, end, captured.EndDate)
 }
+
+func TestQueryResolver_GetAssetRate_AppliesScale(t *testing.T) {
+	t.Parallel()
+
+	scale := float64(2)
+
+	port := &fakeQueryPort{
+		assetRateFn: func(_ context.Context, _, _ uuid.UUID, from, to string) (*assetrate.AssetRate, error) {
+			assert.Equal(t, "USD", from)
+			assert.Equal(t, "BRL", to)
+
+			return &assetrate.AssetRate{From: from, To: to, Rate: 525, Scale: &scale}, nil
+		},
+	}
+
+	rate, err := newResolverWithPort(port).GetAssetRate(context.Background(), uuid.New(), uuid.New(), "USD", "BRL")
+	assert.NoError(t, err)
+	assert.NotNil(t, rate)
+	assert.Equal(t, "5.25", rate.String())
+}
+
+func TestQueryResolver_GetAssetRate_MissingPairIsNil(t *testing.T) {
+	t.Parallel()
+
+	port := &fakeQueryPort{
+		assetRateFn: func(_ context.Context, _, _ uuid.UUID, _, _ string) (*assetrate.AssetRate, error) {
+			return nil, nil
+		},
+	}
+
+	rate, err := newResolverWithPort(port).GetAssetRate(context.Background(), uuid.New(), uuid.New(), "USD", "EUR")
+	assert.NoError(t, err)
+	assert.Nil(t, rate)
+}
+
+func TestQueryResolver_AvailableBalance(t *testing.T) {
+	t.Parallel()
+
+	balances := []*mmodel.Balance{
+		{Key: "asset-freeze", AssetCode: "BRL", Available: decimal.NewFromInt(500), AllowSending: true},
+		{Key: "default", AssetCode: "USD", Available: decimal.NewFromInt(70), AllowSending: true},
+		{Key: "default", AssetCode: "BRL", Available: decimal.NewFromInt(120), AllowSending: true},
+		{Key: "default", AssetCode: "EUR", Available: decimal.NewFromInt(90), AllowSending: false},
+	}
+
+	port := &fakeQueryPort{
+		balancesFn: func(_ context.Context, _, _ uuid.UUID, alias string) ([]*mmodel.Balance, error) {
+			assert.Equal(t, "@customer", alias)
+
+			return balances, nil
+		},
+	}
+
+	resolver := newResolverWithPort(port)
+
+	tests := []struct {
+		asset string
+		want  string
+	}{
+		{asset: "BRL", want: "120"},
+		{asset: "USD", want: "70"},
+		{asset: "EUR", want: "0"},
+		{asset: "GBP", want: "0"},
+	}
+
+	for _, tt := range tests {
+		available, err := resolver.AvailableBalance(context.Background(), uuid.New(), uuid.New(), "@customer", tt.asset)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, available.String(), tt.asset)
+	}
+}
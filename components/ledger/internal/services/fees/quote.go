@@ -0,0 +1,363 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/LerianStudio/lib-commons/v5/commons"
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultFeeQuoteTTL is how long a fee quote is honored when the use case has
+// no FeeQuoteTTL configured.
+const DefaultFeeQuoteTTL = 15 * time.Minute
+
+// quotedFeeMetadataKeys are the transaction metadata keys the fee engine writes.
+// A quoted transaction takes them from the quote, so it carries the same
+// package, exemption, promotion and waiver stamps as a transaction priced in
+// place.
+var quotedFeeMetadataKeys = []string{"packageAppliedID", "packageAppliedVersion", "feeExemption", feeUtils.MetadataFeePromotions, feeUtils.MetadataFeeWaivers}
+
+// CreateFeeQuote estimates the fees of a transaction exactly as
+// EstimateFeeCalculation does and stores the result as a quote. A transaction
+// that references the quote before it expires is charged those fees, even if
+// the package changed in between.
+//
+// Promotions are applied as the estimate sees them. The quoted transaction is
+// charged the waived fees without moving the promotion's usage counters, since
+// the quote, not the package, decides what it pays.
+func (uc *UseCase) CreateFeeQuote(ctx context.Context, in *model.FeeEstimate, organizationID uuid.UUID) (_ *model.FeeQuote, err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if in == nil {
+		return nil, pkg.ValidationError{
+			Code:    constant.ErrInvalidRequestBody.Error(),
+			Title:   "Invalid Request Body",
+			Message: "The request body is required. Please check the documentation and try again.",
+		}
+	}
+
+	ctx, span := tracer.Start(ctx, "service.create_fee_quote")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "create_fee_quote", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.package_id", in.PackageID.String()),
+		attribute.String("app.request.ledger_id", in.LedgerID.String()),
+	)
+
+	if uc.FeeQuotes == nil {
+		return nil, errors.New("fee quote repository is not configured")
+	}
+
+	// The engine mutates the legs it is given, so the send the transaction is
+	// matched against is copied before estimating.
+	quotedSend, err := copySend(in.Transaction.Send)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to copy quoted send", err)
+
+		return nil, err
+	}
+
+	estimate, err := uc.EstimateFeeCalculation(ctx, in, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := commons.GenerateUUIDv7()
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to generate fee quote ID", err)
+
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	ttl := uc.FeeQuoteTTL
+	if ttl <= 0 {
+		ttl = DefaultFeeQuoteTTL
+	}
+
+	quote := &model.FeeQuote{
+		ID:             id.String(),
+		OrganizationID: organizationID.String(),
+		LedgerID:       in.LedgerID.String(),
+		PackageID:      in.PackageID.String(),
+		Send:           quotedSend,
+		FeesApplied:    *estimate,
+		ExpiresAt:      now.Add(ttl),
+		CreatedAt:      now,
+	}
+
+	if err := uc.FeeQuotes.Create(ctx, quote); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to create fee quote", err)
+
+		return nil, err
+	}
+
+	quote.Status = quote.StatusAt(now)
+
+	return quote, nil
+}
+
+// GetFeeQuote returns a fee quote with its status as of now.
+func (uc *UseCase) GetFeeQuote(ctx context.Context, id, organizationID uuid.UUID) (*model.FeeQuote, error) {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.get_fee_quote")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.fee_quote_id", id.String()),
+	)
+
+	quote, err := uc.findFeeQuote(ctx, id, organizationID)
+	if err != nil {
+		handleFeeQuoteSpanError(span, "Failed to get fee quote", err)
+
+		return nil, err
+	}
+
+	quote.Status = quote.StatusAt(time.Now())
+
+	return quote, nil
+}
+
+// ApplyFeeQuote charges cf the fees frozen in a quote instead of recalculating
+// them. The quote must belong to the organization and ledger, be unused and
+// unexpired, and cf's send must move the same money the quote was computed
+// for.
+//
+// The quote is only checked here. The transaction consumes it with
+// ConsumeFeeQuote right before its balance commit, so one rejected before that
+// leaves the quote open for a retry.
+//
+// The signature mirrors CalculateFee: cf.Transaction.Send is replaced by the
+// quoted fee-inclusive send and the quote's fee metadata stamps are copied on.
+func (uc *UseCase) ApplyFeeQuote(ctx context.Context, cf *model.FeeCalculate, organizationID, quoteID uuid.UUID) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if cf == nil {
+		return pkg.ValidateBusinessError(constant.ErrCalculateFee, "")
+	}
+
+	ctx, span := tracer.Start(ctx, "service.apply_fee_quote")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "apply_fee_quote", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.ledger_id", cf.LedgerID.String()),
+		attribute.String("app.request.fee_quote_id", quoteID.String()),
+	)
+
+	quote, err := uc.findFeeQuote(ctx, quoteID, organizationID)
+	if err != nil {
+		handleFeeQuoteSpanError(span, "Failed to find fee quote", err)
+
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	switch quote.StatusAt(now) {
+	case model.FeeQuoteStatusUsed:
+		bizErr := pkg.ValidateBusinessError(constant.ErrFeeQuoteAlreadyUsed, constant.EntityFeeQuote, quoteID.String())
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Fee quote already used", bizErr)
+
+		return bizErr
+	case model.FeeQuoteStatusExpired:
+		bizErr := pkg.ValidateBusinessError(constant.ErrFeeQuoteExpired, constant.EntityFeeQuote, quoteID.String())
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Fee quote expired", bizErr)
+
+		return bizErr
+	}
+
+	if quote.LedgerID != cf.LedgerID.String() || !feeUtils.SendMatchesQuote(quote.Send, cf.Transaction.Send) {
+		bizErr := pkg.ValidateBusinessError(constant.ErrFeeQuoteMismatch, constant.EntityFeeQuote, quoteID.String())
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction does not match fee quote", bizErr)
+
+		return bizErr
+	}
+
+	quoted := quote.FeesApplied.Transaction
+	cf.Transaction.Send = quoted.Send
+
+	for _, key := range quotedFeeMetadataKeys {
+		value, ok := quoted.Metadata[key]
+		if !ok {
+			continue
+		}
+
+		if cf.Transaction.Metadata == nil {
+			cf.Transaction.Metadata = make(map[string]any)
+		}
+
+		cf.Transaction.Metadata[key] = value
+	}
+
+	// Mirror updateFeeMetadataIfNeeded: feeApplied marks a real charge, which
+	// gates the fee-charge.applied streaming emit downstream.
+	if len(quoted.Send.Source.From) != len(quote.Send.Source.From) ||
+		len(quoted.Send.Distribute.To) != len(quote.Send.Distribute.To) {
+		cf.Transaction.Metadata["feeApplied"] = "true"
+	}
+
+	return nil
+}
+
+// ConsumeFeeQuote marks a quote applied by ApplyFeeQuote as used. It runs right
+// before the transaction's balance commit, and the conditional update lets only
+// one of the transactions racing on a quote through: the others, and one whose
+// quote expired since it was applied, are rejected before moving any balance.
+func (uc *UseCase) ConsumeFeeQuote(ctx context.Context, organizationID, quoteID uuid.UUID) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.consume_fee_quote")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "consume_fee_quote", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.fee_quote_id", quoteID.String()),
+	)
+
+	if uc.FeeQuotes == nil {
+		return pkg.ValidateBusinessError(constant.ErrFeeQuoteNotFound, constant.EntityFeeQuote, quoteID.String())
+	}
+
+	now := time.Now().UTC()
+
+	used, err := uc.FeeQuotes.MarkUsed(ctx, quoteID.String(), organizationID.String(), now)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to mark fee quote as used", err)
+
+		return err
+	}
+
+	if used {
+		return nil
+	}
+
+	bizErr := pkg.ValidateBusinessError(constant.ErrFeeQuoteAlreadyUsed, constant.EntityFeeQuote, quoteID.String())
+
+	if quote, errFind := uc.findFeeQuote(ctx, quoteID, organizationID); errFind == nil && quote.StatusAt(now) == model.FeeQuoteStatusExpired {
+		bizErr = pkg.ValidateBusinessError(constant.ErrFeeQuoteExpired, constant.EntityFeeQuote, quoteID.String())
+	}
+
+	libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Fee quote no longer open", bizErr)
+
+	return bizErr
+}
+
+// ReleaseFeeQuote reopens a quote consumed by a transaction whose balance
+// commit failed, so a retry can still be honored until the quote expires.
+func (uc *UseCase) ReleaseFeeQuote(ctx context.Context, organizationID, quoteID uuid.UUID) error {
+	_, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.release_fee_quote")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+		attribute.String("app.request.fee_quote_id", quoteID.String()),
+	)
+
+	if uc.FeeQuotes == nil {
+		return nil
+	}
+
+	if err := uc.FeeQuotes.Release(ctx, quoteID.String(), organizationID.String()); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to release fee quote", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// findFeeQuote loads a quote, mapping a missing one to ErrFeeQuoteNotFound. A
+// nil FeeQuotes repository has no quotes at all.
+func (uc *UseCase) findFeeQuote(ctx context.Context, id, organizationID uuid.UUID) (*model.FeeQuote, error) {
+	if uc.FeeQuotes == nil {
+		return nil, pkg.ValidateBusinessError(constant.ErrFeeQuoteNotFound, constant.EntityFeeQuote, id.String())
+	}
+
+	quote, err := uc.FeeQuotes.FindByID(ctx, id.String(), organizationID.String())
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, pkg.ValidateBusinessError(constant.ErrFeeQuoteNotFound, constant.EntityFeeQuote, id.String())
+		}
+
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// handleFeeQuoteSpanError records a not-found quote as a business event and
+// anything else as a span error.
+func handleFeeQuoteSpanError(span trace.Span, message string, err error) {
+	var notFound pkg.EntityNotFoundError
+	if errors.As(err, &notFound) {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, message, err)
+
+		return
+	}
+
+	libOpentelemetry.HandleSpanError(span, message, err)
+}
+
+// copySend returns a deep copy of send.
+func copySend(send transaction.Send) (transaction.Send, error) {
+	var out transaction.Send
+
+	raw, err := json.Marshal(send)
+	if err != nil {
+		return out, err
+	}
+
+	err = json.Unmarshal(raw, &out)
+
+	return out, err
+}
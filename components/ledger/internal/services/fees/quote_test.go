@@ -0,0 +1,360 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_quote"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+// quoteTransfer is a 1000 BRL transfer from @payer to @payee.
+func quoteTransfer() transaction.Transaction {
+	return transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(1000),
+		Source: transaction.Source{From: []transaction.FromTo{
+			{AccountAlias: "@payer", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		}},
+		Distribute: transaction.Distribute{To: []transaction.FromTo{
+			{AccountAlias: "@payee", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		}},
+	}}
+}
+
+// openQuote is an unused quote for quoteTransfer charging a 10 fee to @fee_account.
+func openQuote(orgID, ledgerID uuid.UUID) *model.FeeQuote {
+	quoted := quoteTransfer()
+
+	charged := quoteTransfer()
+	charged.Send.Value = decimal.NewFromInt(1010)
+	charged.Send.Source.From[0].Amount.Value = decimal.NewFromInt(1010)
+	charged.Send.Distribute.To = append(charged.Send.Distribute.To, transaction.FromTo{
+		AccountAlias: "@fee_account",
+		Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(10)},
+	})
+	charged.Metadata = map[string]any{"packageAppliedID": "pkg-1", "packageAppliedVersion": 2, "customer": "quoted"}
+
+	return &model.FeeQuote{
+		ID:             uuid.New().String(),
+		OrganizationID: orgID.String(),
+		LedgerID:       ledgerID.String(),
+		Send:           quoted.Send,
+		FeesApplied:    model.NewFeeEstimateResult(&model.FeeCalculate{LedgerID: ledgerID, Transaction: charged}),
+		ExpiresAt:      time.Now().Add(time.Minute),
+		CreatedAt:      time.Now(),
+	}
+}
+
+func TestCreateFeeQuote(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	packRepo := pack.NewMockRepository(ctrl)
+	quotes := fee_quote.NewMockRepository(ctrl)
+
+	orgID := uuid.New()
+	p := promotionPackage()
+
+	uc := &UseCase{packageRepo: packRepo, FeeQuotes: quotes, FeeQuoteTTL: 5 * time.Minute}
+
+	in := &model.FeeEstimate{PackageID: p.ID, LedgerID: p.LedgerID, Transaction: quoteTransfer()}
+
+	packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+
+	var stored *model.FeeQuote
+
+	quotes.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, q *model.FeeQuote) error {
+		stored = q
+
+		return nil
+	})
+
+	before := time.Now()
+
+	quote, err := uc.CreateFeeQuote(context.Background(), in, orgID)
+	require.NoError(t, err)
+	require.Same(t, stored, quote)
+
+	assert.Equal(t, model.FeeQuoteStatusOpen, quote.Status)
+	assert.Equal(t, orgID.String(), quote.OrganizationID)
+	assert.Equal(t, p.LedgerID.String(), quote.LedgerID)
+	assert.WithinDuration(t, before.Add(5*time.Minute), quote.ExpiresAt, 5*time.Second)
+
+	// The quote charges the fee and is matched against the send as requested.
+	assert.True(t, quote.FeesApplied.Transaction.Send.Value.Equal(decimal.NewFromInt(1010)))
+	assert.Equal(t, p.ID.String(), quote.FeesApplied.Transaction.Metadata["packageAppliedID"])
+	assert.True(t, quote.Send.Value.Equal(decimal.NewFromInt(1000)))
+	require.Len(t, quote.Send.Distribute.To, 1)
+	assert.True(t, quote.Send.Source.From[0].Amount.Value.Equal(decimal.NewFromInt(1000)))
+}
+
+func TestCreateFeeQuote_DefaultTTL(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	packRepo := pack.NewMockRepository(ctrl)
+	quotes := fee_quote.NewMockRepository(ctrl)
+
+	orgID := uuid.New()
+	p := promotionPackage()
+
+	uc := &UseCase{packageRepo: packRepo, FeeQuotes: quotes}
+
+	packRepo.EXPECT().FindByID(gomock.Any(), p.ID, orgID).Return(p, nil)
+	quotes.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	quote, err := uc.CreateFeeQuote(context.Background(), &model.FeeEstimate{PackageID: p.ID, LedgerID: p.LedgerID, Transaction: quoteTransfer()}, orgID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(DefaultFeeQuoteTTL), quote.ExpiresAt, 5*time.Second)
+}
+
+func TestApplyFeeQuote(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	ledgerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		quote     func(q *model.FeeQuote)
+		cf        func(cf *model.FeeCalculate)
+		findErr   error
+		wantCode  string
+		wantValue int64
+	}{
+		{
+			name:      "open quote charges the quoted fees",
+			wantValue: 1010,
+		},
+		{
+			name:     "unknown quote",
+			findErr:  mongo.ErrNoDocuments,
+			wantCode: constant.ErrFeeQuoteNotFound.Error(),
+		},
+		{
+			name:     "expired quote",
+			quote:    func(q *model.FeeQuote) { q.ExpiresAt = time.Now().Add(-time.Second) },
+			wantCode: constant.ErrFeeQuoteExpired.Error(),
+		},
+		{
+			name: "used quote",
+			quote: func(q *model.FeeQuote) {
+				usedAt := time.Now().Add(-time.Second)
+				q.UsedAt = &usedAt
+			},
+			wantCode: constant.ErrFeeQuoteAlreadyUsed.Error(),
+		},
+		{
+			name:     "different value",
+			cf:       func(cf *model.FeeCalculate) { cf.Transaction.Send.Value = decimal.NewFromInt(999) },
+			wantCode: constant.ErrFeeQuoteMismatch.Error(),
+		},
+		{
+			name:     "different ledger",
+			cf:       func(cf *model.FeeCalculate) { cf.LedgerID = uuid.New() },
+			wantCode: constant.ErrFeeQuoteMismatch.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			quotes := fee_quote.NewMockRepository(ctrl)
+
+			quote := openQuote(orgID, ledgerID)
+			if tt.quote != nil {
+				tt.quote(quote)
+			}
+
+			cf := &model.FeeCalculate{LedgerID: ledgerID, Transaction: quoteTransfer()}
+			cf.Transaction.Metadata = map[string]any{"customer": "request"}
+
+			if tt.cf != nil {
+				tt.cf(cf)
+			}
+
+			quoteID := uuid.MustParse(quote.ID)
+
+			if tt.findErr != nil {
+				quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(nil, tt.findErr)
+			} else {
+				quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(quote, nil)
+			}
+
+			uc := &UseCase{FeeQuotes: quotes}
+
+			err := uc.ApplyFeeQuote(context.Background(), cf, orgID, quoteID)
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, businessErrorCode(err))
+				assert.Len(t, cf.Transaction.Send.Distribute.To, 1, "a rejected quote must leave the transaction unmutated")
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, cf.Transaction.Send.Value.Equal(decimal.NewFromInt(tt.wantValue)))
+			require.Len(t, cf.Transaction.Send.Distribute.To, 2)
+			assert.Equal(t, "@fee_account", cf.Transaction.Send.Distribute.To[1].AccountAlias)
+			assert.Equal(t, "pkg-1", cf.Transaction.Metadata["packageAppliedID"])
+			assert.Equal(t, "true", cf.Transaction.Metadata["feeApplied"])
+			assert.Equal(t, "request", cf.Transaction.Metadata["customer"], "only the fee stamps are taken from the quote")
+		})
+	}
+}
+
+func TestConsumeFeeQuote(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	quote := openQuote(orgID, uuid.New())
+	quoteID := uuid.MustParse(quote.ID)
+
+	t.Run("open quote is marked used", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		quotes := fee_quote.NewMockRepository(ctrl)
+		quotes.EXPECT().MarkUsed(gomock.Any(), quote.ID, orgID.String(), gomock.Any()).Return(true, nil)
+
+		require.NoError(t, (&UseCase{FeeQuotes: quotes}).ConsumeFeeQuote(context.Background(), orgID, quoteID))
+	})
+
+	t.Run("quote used concurrently is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		used := *quote
+		usedAt := time.Now()
+		used.UsedAt = &usedAt
+
+		ctrl := gomock.NewController(t)
+		quotes := fee_quote.NewMockRepository(ctrl)
+		quotes.EXPECT().MarkUsed(gomock.Any(), quote.ID, orgID.String(), gomock.Any()).Return(false, nil)
+		quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(&used, nil)
+
+		err := (&UseCase{FeeQuotes: quotes}).ConsumeFeeQuote(context.Background(), orgID, quoteID)
+		require.Error(t, err)
+		assert.Equal(t, constant.ErrFeeQuoteAlreadyUsed.Error(), businessErrorCode(err))
+	})
+
+	t.Run("quote expired since it was applied is rejected as expired", func(t *testing.T) {
+		t.Parallel()
+
+		expired := *quote
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+
+		ctrl := gomock.NewController(t)
+		quotes := fee_quote.NewMockRepository(ctrl)
+		quotes.EXPECT().MarkUsed(gomock.Any(), quote.ID, orgID.String(), gomock.Any()).Return(false, nil)
+		quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(&expired, nil)
+
+		err := (&UseCase{FeeQuotes: quotes}).ConsumeFeeQuote(context.Background(), orgID, quoteID)
+		require.Error(t, err)
+		assert.Equal(t, constant.ErrFeeQuoteExpired.Error(), businessErrorCode(err))
+	})
+}
+
+func TestReleaseFeeQuote(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	quote := openQuote(orgID, uuid.New())
+	quoteID := uuid.MustParse(quote.ID)
+
+	t.Run("failed commit reopens the consumed quote", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		quotes := fee_quote.NewMockRepository(ctrl)
+
+		// Applying only checks the quote: the strict mock has no MarkUsed
+		// expectation until the transaction reaches its balance commit.
+		quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(quote, nil)
+
+		uc := &UseCase{FeeQuotes: quotes}
+		cf := &model.FeeCalculate{LedgerID: uuid.MustParse(quote.LedgerID), Transaction: quoteTransfer()}
+		require.NoError(t, uc.ApplyFeeQuote(context.Background(), cf, orgID, quoteID))
+
+		gomock.InOrder(
+			quotes.EXPECT().MarkUsed(gomock.Any(), quote.ID, orgID.String(), gomock.Any()).Return(true, nil),
+			quotes.EXPECT().Release(gomock.Any(), quote.ID, orgID.String()).Return(nil),
+		)
+
+		require.NoError(t, uc.ConsumeFeeQuote(context.Background(), orgID, quoteID))
+
+		// The balance commit fails here, so the create path releases the quote.
+		require.NoError(t, uc.ReleaseFeeQuote(context.Background(), orgID, quoteID))
+	})
+
+	t.Run("repository failure is returned", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		quotes := fee_quote.NewMockRepository(ctrl)
+		quotes.EXPECT().Release(gomock.Any(), quote.ID, orgID.String()).Return(errors.New("mongo down"))
+
+		require.Error(t, (&UseCase{FeeQuotes: quotes}).ReleaseFeeQuote(context.Background(), orgID, quoteID))
+	})
+}
+
+func TestGetFeeQuote_Status(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	quotes := fee_quote.NewMockRepository(ctrl)
+
+	orgID := uuid.New()
+	quote := openQuote(orgID, uuid.New())
+	quote.ExpiresAt = time.Now().Add(-time.Minute)
+
+	quotes.EXPECT().FindByID(gomock.Any(), quote.ID, orgID.String()).Return(quote, nil)
+
+	uc := &UseCase{FeeQuotes: quotes}
+
+	got, err := uc.GetFeeQuote(context.Background(), uuid.MustParse(quote.ID), orgID)
+	require.NoError(t, err)
+	assert.Equal(t, model.FeeQuoteStatusExpired, got.Status)
+}
+
+// businessErrorCode returns the code of the canonical business error err wraps.
+func businessErrorCode(err error) string {
+	var (
+		notFound      pkg.EntityNotFoundError
+		unprocessable pkg.UnprocessableOperationError
+		conflict      pkg.EntityConflictError
+	)
+
+	switch {
+	case errors.As(err, &notFound):
+		return notFound.Code
+	case errors.As(err, &unprocessable):
+		return unprocessable.Code
+	case errors.As(err, &conflict):
+		return conflict.Code
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ApplyFeeReversalPolicy applies the reversal policies of the packages that
+// charged fees on a transaction to its revert. The packages are the ones
+// stamped on the revert's fee legs, and each policy is the one recorded on the
+// package version that charged the fee, so a policy changed since the charge
+// does not apply retroactively. A package deleted since the charge no longer
+// has a policy, so its fees are refunded.
+func (uc *UseCase) ApplyFeeReversalPolicy(ctx context.Context, organizationID uuid.UUID, revert *transaction.Transaction) (err error) {
+	logger, tracer, reqId, _ := libObservability.NewTrackingFromContext(ctx)
+
+	if revert == nil {
+		return pkg.ValidateBusinessError(constant.ErrTransactionCantRevert, "RevertTransaction")
+	}
+
+	ctx, span := tracer.Start(ctx, "service.apply_fee_reversal_policy")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, uc.MetricsFactory, logger, "fees", "apply_fee_reversal_policy", start, err)
+	}()
+
+	span.SetAttributes(
+		attribute.String("app.request.request_id", reqId),
+		attribute.String("app.request.organization_id", organizationID.String()),
+	)
+
+	// Fee legs carry the version that priced them; the transaction-level
+	// packageAppliedVersion covers legs written without it.
+	appliedVersion := feeUtils.MetadataInt(revert.Metadata["packageAppliedVersion"])
+
+	policies := make(map[string]*model.ReversalPolicy)
+	versions := make(map[string]int)
+	packageIDs := make([]string, 0)
+
+	for _, legs := range [][]transaction.FromTo{revert.Send.Source.From, revert.Send.Distribute.To} {
+		for _, leg := range legs {
+			packageID, _ := leg.Metadata[feeUtils.MetadataPackageID].(string)
+			if _, seen := policies[packageID]; packageID != "" && !seen {
+				policies[packageID] = nil
+				packageIDs = append(packageIDs, packageID)
+
+				versions[packageID] = appliedVersion
+				if version := feeUtils.MetadataInt(leg.Metadata[feeUtils.MetadataPackageVersion]); version > 0 {
+					versions[packageID] = version
+				}
+			}
+		}
+	}
+
+	for _, packageID := range packageIDs {
+		id, errParse := uuid.Parse(packageID)
+		if errParse != nil {
+			continue
+		}
+
+		p, errFind := uc.packageRepo.FindByID(ctx, id, organizationID)
+		if errFind != nil {
+			if errors.Is(errFind, mongo.ErrNoDocuments) {
+				continue
+			}
+
+			libOpentelemetry.HandleSpanError(span, "Failed to find package for fee reversal", errFind)
+
+			return errFind
+		}
+
+		policies[packageID] = p.ReversalPolicyOf(versions[packageID])
+	}
+
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if err := feeUtils.ApplyReversalPolicy(revert, policies); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to apply fee reversal policy", err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+// feeRevert is the revert of a 100 transfer on which the payer paid a 2 fee
+// charged by packageID.
+func feeRevert(packageID uuid.UUID) *transaction.Transaction {
+	feeMetadata := func() map[string]any {
+		return map[string]any{feeUtils.MetadataPackageID: packageID.String(), feeUtils.MetadataFeeKey: "serviceFee"}
+	}
+
+	return &transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(102),
+		Source: transaction.Source{From: []transaction.FromTo{
+			{AccountAlias: "@payee", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(100)}},
+			{AccountAlias: "@revenue", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(2)}, Metadata: feeMetadata()},
+		}},
+		Distribute: transaction.Distribute{To: []transaction.FromTo{
+			{AccountAlias: "@payer", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(100)}},
+			{AccountAlias: "@payer", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(2)}, Metadata: feeMetadata()},
+		}},
+	}}
+}
+
+func TestApplyFeeReversalPolicy(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	packageID := uuid.New()
+	repoErr := errors.New("mongo unavailable")
+
+	tests := []struct {
+		name      string
+		revert    *transaction.Transaction
+		mockSetup func(repo *pack.MockRepository)
+		wantErr   error
+		wantValue int64
+		wantLegs  int
+	}{
+		{
+			name: "revert without fee legs does not load packages",
+			revert: &transaction.Transaction{Send: transaction.Send{
+				Value:      decimal.NewFromInt(100),
+				Source:     transaction.Source{From: []transaction.FromTo{{AccountAlias: "@payee", Amount: &transaction.Amount{Value: decimal.NewFromInt(100)}}}},
+				Distribute: transaction.Distribute{To: []transaction.FromTo{{AccountAlias: "@payer", Amount: &transaction.Amount{Value: decimal.NewFromInt(100)}}}},
+			}},
+			mockSetup: func(_ *pack.MockRepository) {},
+			wantValue: 100,
+			wantLegs:  2,
+		},
+		{
+			name:   "package keeping its fees removes the fee legs",
+			revert: feeRevert(packageID),
+			mockSetup: func(repo *pack.MockRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), packageID, orgID).
+					Return(&pack.Package{ID: packageID, ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalKeepFees}}, nil)
+			},
+			wantValue: 100,
+			wantLegs:  2,
+		},
+		{
+			name: "policy of the version that charged the fee applies",
+			revert: func() *transaction.Transaction {
+				revert := feeRevert(packageID)
+				for _, leg := range append(revert.Send.Source.From, revert.Send.Distribute.To...) {
+					if leg.Metadata != nil {
+						leg.Metadata[feeUtils.MetadataPackageVersion] = float64(1)
+					}
+				}
+
+				return revert
+			}(),
+			mockSetup: func(repo *pack.MockRepository) {
+				// The policy changed to refundAll in version 2; the fee was
+				// charged under version 1, which kept fees.
+				repo.EXPECT().FindByID(gomock.Any(), packageID, orgID).
+					Return(&pack.Package{
+						ID:             packageID,
+						ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalRefundAll},
+						Versions: []pack.PackageVersion{
+							{Version: 1, ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalKeepFees}},
+							{Version: 2, ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalRefundAll}},
+						},
+					}, nil)
+			},
+			wantValue: 100,
+			wantLegs:  2,
+		},
+		{
+			name:   "deleted package refunds its fees",
+			revert: feeRevert(packageID),
+			mockSetup: func(repo *pack.MockRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), packageID, orgID).Return(nil, mongo.ErrNoDocuments)
+			},
+			wantValue: 102,
+			wantLegs:  4,
+		},
+		{
+			name:   "repository failure is returned",
+			revert: feeRevert(packageID),
+			mockSetup: func(repo *pack.MockRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), packageID, orgID).Return(nil, repoErr)
+			},
+			wantErr: repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := pack.NewMockRepository(ctrl)
+			tt.mockSetup(repo)
+
+			uc := &UseCase{packageRepo: repo}
+
+			err := uc.ApplyFeeReversalPolicy(context.Background(), orgID, tt.revert)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.revert.Send.Value.Equal(decimal.NewFromInt(tt.wantValue)))
+			assert.Equal(t, tt.wantLegs, len(tt.revert.Send.Source.From)+len(tt.revert.Send.Distribute.To))
+		})
+	}
+}
@@ -10,13 +10,17 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"sync"
 	"time"
 
 	libLog "github.com/LerianStudio/lib-observability/log"
 	"github.com/LerianStudio/lib-observability/metrics"
 	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
 	libStreaming "github.com/LerianStudio/lib-streaming"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_quote"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/fee_revenue"
 	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/promotion_usage"
 	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -96,6 +100,34 @@ type UseCase struct {
 
 	// Streaming emits past-tense fee domain events; nil disables event emission.
 	Streaming libStreaming.Emitter
+
+	// PromotionUsage holds the per-subject, per-period usage counters of
+	// package promotions. Assigned at bootstrap; a nil value disables
+	// promotions, so every fee is charged as the package schedule says.
+	PromotionUsage promotion_usage.Repository
+
+	// FeeQuotes stores the fee quotes transactions can reference to be charged
+	// the fees they were quoted. Assigned at bootstrap; a nil value means no
+	// quote can be created or found.
+	FeeQuotes fee_quote.Repository
+
+	// FeeQuoteTTL is how long a new fee quote is honored. Zero uses
+	// DefaultFeeQuoteTTL.
+	FeeQuoteTTL time.Duration
+
+	// FeeRevenue stores what package fees charged, waived and refunded, for
+	// revenue reports. Assigned at bootstrap; a nil value disables recording
+	// and reports no revenue.
+	FeeRevenue fee_revenue.Repository
+
+	// FeeRevenueRetryInterval is the minimum time between two background
+	// retries of an organization's pending fee revenue. Zero disables them.
+	FeeRevenueRetryInterval time.Duration
+
+	// feeRevenueRetries holds when each tenant's organization last retried its
+	// pending fee revenue, guarded by feeRevenueRetryMu.
+	feeRevenueRetryMu sync.Mutex
+	feeRevenueRetries map[string]time.Time
 }
 
 // ErrNilPackageRepo is returned when a nil PackageRepo is provided to NewUseCase.
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"sort"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// annotateFeeSettlement annotates the fee legs p charged on cf with the
+// equivalent of every fee whose settlement asset differs from the asset it was
+// charged in, at the ledger's asset rate. The legs are still posted in the
+// charged asset: the annotation is informational, so a rate that is missing or
+// cannot be read leaves the fee unannotated and is only logged, never failing
+// the transaction.
+func (uc *UseCase) annotateFeeSettlement(ctx context.Context, cf *model.FeeCalculate, p *pack.Package, organizationID uuid.UUID) {
+	if p == nil {
+		return
+	}
+
+	feeAsset := feeUtils.FeeAsset(cf, uc.defaultCurrency)
+
+	settlements := feeUtils.FeeSettlements(cf, p, feeAsset)
+	if len(settlements) == 0 {
+		return
+	}
+
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	feeKeys := make([]string, 0, len(settlements))
+	for feeKey := range settlements {
+		feeKeys = append(feeKeys, feeKey)
+	}
+
+	sort.Strings(feeKeys)
+
+	rates := make(map[string]decimal.Decimal)
+	missing := make(map[string]struct{})
+
+	for _, feeKey := range feeKeys {
+		asset := settlements[feeKey]
+		if _, ok := rates[asset]; ok {
+			continue
+		}
+
+		if _, ok := missing[asset]; ok {
+			continue
+		}
+
+		rate, err := uc.resolver.GetAssetRate(ctx, organizationID, cf.LedgerID, feeAsset, asset)
+		if err != nil || rate == nil {
+			missing[asset] = struct{}{}
+
+			logger.Log(ctx, libLog.LevelWarn, "Fee settlement rate unavailable, fee left unannotated",
+				libLog.String("package_id", p.ID.String()),
+				libLog.String("fee_key", feeKey),
+				libLog.String("fee_asset", feeAsset),
+				libLog.String("settlement_asset", asset),
+				libLog.Err(err))
+
+			continue
+		}
+
+		rates[asset] = *rate
+	}
+
+	feeUtils.AnnotateFeeSettlement(&cf.Transaction, p.ID.String(), settlements, rates)
+}
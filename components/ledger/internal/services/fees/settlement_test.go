@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeUtils "github.com/LerianStudio/midaz/v4/components/ledger/pkg/fee"
+	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// settledPackage is promotionPackage with its fee settled in asset.
+func settledPackage(asset string) *pack.Package {
+	p := promotionPackage()
+
+	fee := p.Fees["service"]
+	fee.SettlementAsset = &asset
+	p.Fees["service"] = fee
+
+	return p
+}
+
+func settledFeeLeg(t *testing.T, tx *transaction.Transaction) transaction.FromTo {
+	t.Helper()
+
+	for _, leg := range tx.Send.Distribute.To {
+		if _, ok := leg.Metadata[feeUtils.MetadataFeeKey]; ok && leg.Metadata[feeUtils.MetadataPackageID] != nil {
+			return leg
+		}
+	}
+
+	require.Fail(t, "no fee credit leg")
+
+	return transaction.FromTo{}
+}
+
+func TestAnnotateFeeSettlement(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+
+	t.Run("fee annotated at the ledger rate", func(t *testing.T) {
+		t.Parallel()
+
+		p := settledPackage("USD")
+		cf := pricedTransfer(t, p)
+
+		rate := decimal.RequireFromString("0.2")
+		resolver := feeshared.NewMockMidazResolver(gomock.NewController(t))
+		resolver.EXPECT().GetAssetRate(gomock.Any(), orgID, p.LedgerID, "BRL", "USD").Return(&rate, nil).Times(1)
+
+		uc := &UseCase{resolver: resolver}
+		uc.annotateFeeSettlement(context.Background(), cf, p, orgID)
+
+		leg := settledFeeLeg(t, &cf.Transaction)
+		assert.Equal(t, "BRL", leg.Amount.Asset)
+		assert.Equal(t, "USD", leg.Metadata[feeUtils.MetadataSettlementAsset])
+		assert.Equal(t, "2", leg.Metadata[feeUtils.MetadataSettlementAmount])
+	})
+
+	t.Run("missing rate leaves the fee unannotated", func(t *testing.T) {
+		t.Parallel()
+
+		p := settledPackage("USD")
+		cf := pricedTransfer(t, p)
+
+		resolver := feeshared.NewMockMidazResolver(gomock.NewController(t))
+		resolver.EXPECT().GetAssetRate(gomock.Any(), orgID, p.LedgerID, "BRL", "USD").Return(nil, nil).Times(1)
+
+		uc := &UseCase{resolver: resolver}
+		uc.annotateFeeSettlement(context.Background(), cf, p, orgID)
+
+		leg := settledFeeLeg(t, &cf.Transaction)
+		assert.Equal(t, "BRL", leg.Amount.Asset)
+		assert.NotContains(t, leg.Metadata, feeUtils.MetadataSettlementAsset)
+	})
+
+	t.Run("rate read failure leaves the fee unannotated", func(t *testing.T) {
+		t.Parallel()
+
+		p := settledPackage("USD")
+		cf := pricedTransfer(t, p)
+
+		resolver := feeshared.NewMockMidazResolver(gomock.NewController(t))
+		resolver.EXPECT().GetAssetRate(gomock.Any(), orgID, p.LedgerID, "BRL", "USD").Return(nil, errors.New("postgres down")).Times(1)
+
+		uc := &UseCase{resolver: resolver}
+		uc.annotateFeeSettlement(context.Background(), cf, p, orgID)
+
+		assert.NotContains(t, settledFeeLeg(t, &cf.Transaction).Metadata, feeUtils.MetadataSettlementAmount)
+	})
+
+	t.Run("fee settled in the charged asset needs no rate", func(t *testing.T) {
+		t.Parallel()
+
+		p := settledPackage("BRL")
+		cf := pricedTransfer(t, p)
+
+		uc := &UseCase{resolver: feeshared.NewMockMidazResolver(gomock.NewController(t))}
+		uc.annotateFeeSettlement(context.Background(), cf, p, orgID)
+
+		assert.NotContains(t, settledFeeLeg(t, &cf.Transaction).Metadata, feeUtils.MetadataSettlementAsset)
+	})
+}
@@ -53,6 +53,18 @@ func (uc *UseCase) UpdatePackageByID(ctx context.Context, id, organizationID uui
 		attribute.String("app.request.package_id", id.String()),
 	)
 
+	// Schedule changes are versioned: load (and, if a scheduled version took
+	// effect since the last write, refresh) the package first so the previous
+	// schedule is kept in the history once the update lands.
+	var beforeUpdate *pack.Package
+
+	if up.HasScheduleChanges() {
+		beforeUpdate, err = uc.syncInForceVersion(ctx, span, id, organizationID)
+		if err != nil {
+			return err
+		}
+	}
+
 	setOperationFields, unsetOperationFields, ledgerID, errUpdateFields := uc.buildUpdateFields(ctx, logger, id, organizationID, up)
 	if errUpdateFields != nil {
 		return errUpdateFields
@@ -81,6 +93,13 @@ func (uc *UseCase) UpdatePackageByID(ctx context.Context, id, organizationID uui
 		return err
 	}
 
+	if beforeUpdate != nil {
+		updatedPackage, err = uc.recordPackageVersion(ctx, span, beforeUpdate, updatedPackage, organizationID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Invalidate the cached enabled-package set for this (org,ledger): an update
 	// can change amounts, fees, waivers, or the enable flag, all of which the
 	// cached set carries. The ledger is the one resolved while building the
@@ -144,12 +163,29 @@ func (uc *UseCase) buildUpdateFields(ctx context.Context, logger libLog.Logger,
 
 	// Update fee map
 	if up.Fee != nil {
+		if errAssets := model.ValidateFeeAssets(up.Fee, feesAmountData.GetAsset(), feesAmountData.MinAmount.String()); errAssets != nil {
+			return nil, nil, ledgerID, errAssets
+		}
+
 		errValidationFeesSet := uc.validationFeesSetUnset(ctx, feesAmountData.MinAmount, organizationID, feesAmountData.LedgerID, feesAmountData.Fees, up.Fee, setFields, unsetFields)
 		if errValidationFeesSet != nil {
 			return nil, nil, ledgerID, errValidationFeesSet
 		}
 	}
 
+	if up.ReversalPolicy != nil {
+		if errPolicy := up.ReversalPolicy.Validate(model.FeeKeys(feesAmountData.Fees, up.Fee)); errPolicy != nil {
+			return nil, nil, ledgerID, errPolicy
+		}
+
+		reversalPolicy, errConvert := pack.FromEntityReversalPolicy(up.ReversalPolicy)
+		if errConvert != nil {
+			return nil, nil, ledgerID, pkg.ValidateBusinessError(constant.ErrReversalPolicyInvalid, constant.EntityPackage)
+		}
+
+		setFields["reversal_policy"] = reversalPolicy
+	}
+
 	if len(setFields) == 0 && len(unsetFields) == 0 {
 		return setFields, unsetFields, ledgerID, pkg.ValidateBusinessError(constant.ErrNothingToUpdate, constant.EntityPackage)
 	}
@@ -211,8 +247,8 @@ func (uc *UseCase) validationFeesSetUnset(ctx context.Context, minAmount decimal
 
 				delete(finalFees, keyFormatted)
 			} else {
-				// Fee is being updated - update in final state
-				finalFees[keyFormatted] = fee
+				// Fee is being updated - merge the patch into the final state
+				finalFees[keyFormatted] = existingFees[keyFormatted].ApplyUpdate(fee)
 			}
 		}
 	}
@@ -227,7 +263,9 @@ func (uc *UseCase) validationFeesSetUnset(ctx context.Context, minAmount decimal
 		finalPrioritySet[fee.Priority] = struct{}{}
 	}
 
-	return nil
+	// Fees calculated on other fees are validated against the final fee map, since
+	// the update may add, patch or remove either end of a chain.
+	return model.ValidateFeeChain(finalFees)
 }
 
 // SetAmountsDataToUpdate Setting the amounts data existent of update object
@@ -274,7 +312,7 @@ func (uc *UseCase) SetAmountsDataToUpdate(ctx context.Context, logger libLog.Log
 
 	// validating max and min amount range of a package
 	if errRange := uc.ValidatePackageMaxAndMinAmountRange(
-		ctx, logger, maxAmount, minAmount, feesAmountData.GetTransactionRoute(),
+		ctx, logger, maxAmount, minAmount, feesAmountData.GetTransactionRoute(), feesAmountData.GetAsset(),
 		organizationID, feesAmountData.LedgerID, feesAmountData.SegmentID, packageID,
 	); errRange != nil {
 		return errRange
@@ -294,12 +332,39 @@ func (uc *UseCase) convertFeeToMongoFormat(fee model.Fee) (pack.Fee, error) {
 			return pack.Fee{}, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, "calculationModel.calculations.value")
 		}
 
+		upTo, err := optionalBSONDecimal(calc.UpTo, "calculationModel.calculations.upTo")
+		if err != nil {
+			return pack.Fee{}, err
+		}
+
+		assetValues, err := pack.FromEntityAssetValues(calc.AssetValues)
+		if err != nil {
+			return pack.Fee{}, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, "calculationModel.calculations.assetValues")
+		}
+
 		calculations = append(calculations, pack.Calculation{
-			Type:  calc.Type,
-			Value: bsondecimal.Decimal{Decimal: value},
+			Type:        calc.Type,
+			Value:       bsondecimal.Decimal{Decimal: value},
+			UpTo:        upTo,
+			AssetValues: assetValues,
 		})
 	}
 
+	minimumFee, err := optionalBSONDecimal(fee.MinimumFee, "minimumFee")
+	if err != nil {
+		return pack.Fee{}, err
+	}
+
+	maximumFee, err := optionalBSONDecimal(fee.MaximumFee, "maximumFee")
+	if err != nil {
+		return pack.Fee{}, err
+	}
+
+	var baseFee *string
+	if key := fee.GetBaseFee(); key != "" {
+		baseFee = &key
+	}
+
 	// Convert calculation model
 	calcModel := pack.CalculationModel{
 		ApplicationRule: fee.CalculationModel.ApplicationRule,
@@ -315,5 +380,24 @@ func (uc *UseCase) convertFeeToMongoFormat(fee model.Fee) (pack.Fee, error) {
 		CreditAccount:    fee.CreditAccount,
 		RouteFrom:        fee.RouteFrom,
 		RouteTo:          fee.RouteTo,
+		BaseFee:          baseFee,
+		MinimumFee:       minimumFee,
+		MaximumFee:       maximumFee,
+		Asset:            fee.Asset,
+		SettlementAsset:  fee.SettlementAsset,
 	}, nil
 }
+
+// optionalBSONDecimal converts an optional decimal string to its MongoDB form.
+func optionalBSONDecimal(raw *string, field string) (*bsondecimal.Decimal, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := decimal.NewFromString(*raw)
+	if err != nil {
+		return nil, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, constant.EntityPackage, field)
+	}
+
+	return &bsondecimal.Decimal{Decimal: value}, nil
+}
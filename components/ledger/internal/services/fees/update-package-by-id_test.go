@@ -160,10 +160,18 @@ func TestUpdatePackage(t *testing.T) {
 			packId:    packID,
 			packInput: &model.UpdatePackageInput{Fee: feeRemove},
 			mockSetup: func() {
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
 				mockPackageRepo.EXPECT().
 					Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(updatedPkg, nil)
 
+				mockPackageRepo.EXPECT().
+					UpdateVersions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(updatedPkg, nil)
+
 				mockPackageRepo.EXPECT().
 					FindFeesAndAmountDataByPackageID(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(amountData, nil)
@@ -177,6 +185,10 @@ func TestUpdatePackage(t *testing.T) {
 			filter:    filter,
 			packInput: packToUpdate,
 			mockSetup: func() {
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
 				mockResolver.EXPECT().
 					AccountExistsByAlias(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -189,6 +201,10 @@ func TestUpdatePackage(t *testing.T) {
 					Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(updatedPkg, nil)
 
+				mockPackageRepo.EXPECT().
+					UpdateVersions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(updatedPkg, nil)
+
 				mockPackageRepo.EXPECT().
 					FindFeesAndAmountDataByPackageID(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(amountData, nil)
@@ -202,6 +218,10 @@ func TestUpdatePackage(t *testing.T) {
 			filter:    filter,
 			packInput: packToUpdate,
 			mockSetup: func() {
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
 				mockResolver.EXPECT().
 					AccountExistsByAlias(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -228,6 +248,10 @@ func TestUpdatePackage(t *testing.T) {
 			filter:    filter,
 			packInput: packToUpdate,
 			mockSetup: func() {
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
 				mockResolver.EXPECT().
 					AccountExistsByAlias(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -247,6 +271,63 @@ func TestUpdatePackage(t *testing.T) {
 			expectErr:   true,
 			errContains: "0047",
 		},
+		{
+			name:      "Success - Update package reversal policy",
+			packId:    packID,
+			orgId:     orgId,
+			packInput: &model.UpdatePackageInput{ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalKeepFees, NonRefundableFees: []string{"fees"}}},
+			mockSetup: func() {
+				policy := &model.ReversalPolicy{Mode: model.ReversalKeepFees, NonRefundableFees: []string{"fees"}}
+				withPolicy := *updatedPkg
+				withPolicy.ReversalPolicy = policy
+
+				// A policy change is versioned like a schedule change, so reverts
+				// of fees charged before it keep the previous policy.
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
+				mockPackageRepo.EXPECT().
+					FindFeesAndAmountDataByPackageID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(amountData, nil)
+
+				mockPackageRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, updateFields *bson.M) (*mongoPack.Package, error) {
+						set := (*updateFields)["$set"].(bson.M)
+						assert.Equal(t, &mongoPack.ReversalPolicy{Mode: model.ReversalKeepFees, NonRefundableFees: []string{"fees"}}, set["reversal_policy"])
+
+						return &withPolicy, nil
+					})
+
+				mockPackageRepo.EXPECT().
+					UpdateVersions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, versions []mongoPack.PackageVersion, current mongoPack.PackageVersion) (*mongoPack.Package, error) {
+						assert.Equal(t, policy, current.ReversalPolicy)
+						assert.Equal(t, model.ReversalRefundAll, versions[0].ReversalPolicy.Mode, "the previous version keeps the previous policy")
+
+						return &withPolicy, nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:      "Error - Reversal policy names an unknown fee",
+			packId:    packID,
+			orgId:     orgId,
+			packInput: &model.UpdatePackageInput{ReversalPolicy: &model.ReversalPolicy{Mode: model.ReversalRefundAll, NonRefundableFees: []string{"wireFee"}}},
+			mockSetup: func() {
+				mockPackageRepo.EXPECT().
+					FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(packEntity[0], nil)
+
+				mockPackageRepo.EXPECT().
+					FindFeesAndAmountDataByPackageID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(amountData, nil)
+			},
+			expectErr:   true,
+			errContains: "0538",
+		},
 		{
 			name:      "Error - No fields to update package by id",
 			packId:    uuid.New(),
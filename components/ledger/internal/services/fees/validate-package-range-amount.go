@@ -21,7 +21,7 @@ import (
 
 // ValidatePackageMaxAndMinAmountRange validating max and min amount range of a package
 func (uc *UseCase) ValidatePackageMaxAndMinAmountRange(ctx context.Context, logger libLog.Logger,
-	maxAmount, minAmount, transactionRoute string,
+	maxAmount, minAmount, transactionRoute, asset string,
 	organizationID, ledgerID uuid.UUID,
 	segmentID, packageID *uuid.UUID,
 ) error {
@@ -56,6 +56,12 @@ func (uc *UseCase) ValidatePackageMaxAndMinAmountRange(ctx context.Context, logg
 
 		// Validate if the account exists on midaz
 		for _, p := range packs {
+			// Packages scoped to different assets price different transactions,
+			// so neither their ranges nor their settings can clash.
+			if p.GetAsset() != asset {
+				continue
+			}
+
 			if packageID == nil || p.ID != *packageID {
 				// Validate if all package data equals the new package
 				if isSamePackage(p, newMinAmount, newMaxAmount, transactionRoute, segmentID) {
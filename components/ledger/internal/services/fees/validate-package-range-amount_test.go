@@ -256,7 +256,7 @@ func TestValidatePackageMaxAndMinAmountRange(t *testing.T) {
 
 			err := uc.ValidatePackageMaxAndMinAmountRange(
 				ctx, nil,
-				tt.maxAmount, tt.minAmount, tt.transactionRoute,
+				tt.maxAmount, tt.minAmount, tt.transactionRoute, "",
 				orgID, ledgerID,
 				tt.segmentID, tt.packageID,
 			)
@@ -31,7 +31,7 @@ import (
 //     stomping legitimate configuration with cache defaults.
 //
 // This helper centralises the overlay logic shared by GetAllBalancesByAccountID,
-// GetAllBalances, and GetAllBalancesByAlias. Any future overlay caller MUST
+// GetAllBalances, GetAllBalancesByAlias and ListBalancesByAccountIDs. Any future overlay caller MUST
 // route through this function so the cache-to-response contract stays uniform.
 func applyBalanceCacheOverlay(b *mmodel.Balance, c *mmodel.BalanceRedis) {
 	if b == nil || c == nil {
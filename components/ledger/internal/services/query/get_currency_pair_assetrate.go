@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/assetrate"
+	"github.com/google/uuid"
+)
+
+// GetAssetRateByCurrencyPair gets the asset rate converting from into to, or
+// nil when the ledger has none.
+func (uc *UseCase) GetAssetRateByCurrencyPair(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*assetrate.AssetRate, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "query.get_asset_rate_by_currency_pair")
+	defer span.End()
+
+	assetRate, err := uc.AssetRateRepo.FindByCurrencyPair(ctx, organizationID, ledgerID, from, to)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to get asset rate by currency pair on repository", err)
+
+		logger.Log(ctx, libLog.LevelError, "Error getting asset rate by currency pair", libLog.Err(err))
+
+		return nil, err
+	}
+
+	return assetRate, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	libPointers "github.com/LerianStudio/lib-commons/v5/commons/pointers"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/assetrate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAssetRateByCurrencyPair(t *testing.T) {
+	orgID := uuid.Must(libCommons.GenerateUUIDv7())
+	ledgerID := uuid.Must(libCommons.GenerateUUIDv7())
+
+	t.Run("returns the rate of the pair", func(t *testing.T) {
+		mockAssetRateRepo := assetrate.NewMockRepository(gomock.NewController(t))
+		uc := UseCase{AssetRateRepo: mockAssetRateRepo}
+
+		assetRate := &assetrate.AssetRate{From: "USD", To: "BRL", Rate: 525, Scale: libPointers.Float64(2)}
+
+		mockAssetRateRepo.EXPECT().
+			FindByCurrencyPair(gomock.Any(), orgID, ledgerID, "USD", "BRL").
+			Return(assetRate, nil).
+			Times(1)
+
+		res, err := uc.GetAssetRateByCurrencyPair(context.Background(), orgID, ledgerID, "USD", "BRL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, assetRate, res)
+	})
+
+	t.Run("missing pair is nil", func(t *testing.T) {
+		mockAssetRateRepo := assetrate.NewMockRepository(gomock.NewController(t))
+		uc := UseCase{AssetRateRepo: mockAssetRateRepo}
+
+		mockAssetRateRepo.EXPECT().
+			FindByCurrencyPair(gomock.Any(), orgID, ledgerID, "USD", "EUR").
+			Return(nil, nil).
+			Times(1)
+
+		res, err := uc.GetAssetRateByCurrencyPair(context.Background(), orgID, ledgerID, "USD", "EUR")
+
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository error is returned", func(t *testing.T) {
+		mockAssetRateRepo := assetrate.NewMockRepository(gomock.NewController(t))
+		uc := UseCase{AssetRateRepo: mockAssetRateRepo}
+
+		mockAssetRateRepo.EXPECT().
+			FindByCurrencyPair(gomock.Any(), orgID, ledgerID, "USD", "BRL").
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+
+		res, err := uc.GetAssetRateByCurrencyPair(context.Background(), orgID, ledgerID, "USD", "BRL")
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/operation"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+)
+
+// ListAccountsByHolderID returns every account owned by the holder within the
+// organization, across all ledgers and including deleted accounts, oldest
+// first. It backs the holder data export, which must report every account the
+// holder ever owned.
+func (uc *UseCase) ListAccountsByHolderID(ctx context.Context, organizationID, holderID uuid.UUID) ([]*mmodel.Account, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "query.list_accounts_by_holder")
+	defer span.End()
+
+	accounts, err := uc.AccountRepo.ListByHolderID(ctx, organizationID, holderID)
+	if err != nil {
+		logger.Log(ctx, libLog.LevelError, "Error listing accounts by holder on repo", libLog.Err(err))
+
+		libOpentelemetry.HandleSpanError(span, "Failed to list accounts by holder on repo", err)
+
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// ListBalancesByAccountIDs returns the balances of the given accounts of a
+// ledger. Like GetAllBalancesByAccountID, the Redis cache state is overlaid
+// onto the database rows, which the write-behind worker may not have synced
+// yet.
+func (uc *UseCase) ListBalancesByAccountIDs(ctx context.Context, organizationID, ledgerID uuid.UUID, accountIDs []uuid.UUID) ([]*mmodel.Balance, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "query.list_balances_by_account_ids")
+	defer span.End()
+
+	balances, err := uc.BalanceRepo.ListByAccountIDs(ctx, organizationID, ledgerID, accountIDs)
+	if err != nil {
+		logger.Log(ctx, libLog.LevelError, "Error listing balances by account ids on repo", libLog.Err(err))
+
+		libOpentelemetry.HandleSpanError(span, "Failed to list balances by account ids on repo", err)
+
+		return nil, err
+	}
+
+	if len(balances) == 0 {
+		return balances, nil
+	}
+
+	balanceCacheKeys := make([]string, len(balances))
+
+	for i, b := range balances {
+		balanceCacheKeys[i] = utils.BalanceInternalKey(organizationID, ledgerID, b.Alias+"#"+b.Key)
+	}
+
+	balanceCacheValues, err := uc.TransactionRedisRepo.MGet(ctx, balanceCacheKeys)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Failed to get balance cache values on redis", err)
+
+		logger.Log(ctx, libLog.LevelWarn, "Failed to get balance cache values on redis", libLog.Err(err))
+	}
+
+	for i := range balances {
+		if data, ok := balanceCacheValues[balanceCacheKeys[i]]; ok {
+			cachedBalance := mmodel.BalanceRedis{}
+
+			if err := json.Unmarshal([]byte(data), &cachedBalance); err != nil {
+				logger.Log(ctx, libLog.LevelWarn, "Error unmarshalling balance cache value", libLog.Err(err))
+
+				continue
+			}
+
+			applyBalanceCacheOverlay(balances[i], &cachedBalance)
+		}
+	}
+
+	return balances, nil
+}
+
+// ListAccountOperationsPage returns one page of an account's operations,
+// oldest first and without the default date window of GetAllOperationsByAccount,
+// together with the cursor of the next page (empty on the last page).
+func (uc *UseCase) ListAccountOperationsPage(ctx context.Context, organizationID, ledgerID, accountID uuid.UUID, cursor string, limit int) ([]*operation.Operation, string, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "query.list_account_operations_page")
+	defer span.End()
+
+	ops, page, err := uc.OperationRepo.FindAllByAccount(ctx, organizationID, ledgerID, accountID, operation.OperationFilter{},
+		http.Pagination{Limit: limit, Cursor: cursor, SortOrder: "asc"})
+	if err != nil {
+		logger.Log(ctx, libLog.LevelError, "Error listing account operations on repo", libLog.Err(err))
+
+		libOpentelemetry.HandleSpanError(span, "Failed to list account operations on repo", err)
+
+		return nil, "", err
+	}
+
+	return ops, page.Next, nil
+}
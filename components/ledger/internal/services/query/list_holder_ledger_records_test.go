@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	libCommons "github.com/LerianStudio/lib-commons/v5/commons"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/postgres/balance"
+	redis "github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/redis/transaction"
+	"github.com/LerianStudio/midaz/v4/pkg/mmodel"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestListBalancesByAccountIDs validates the Redis overlay of the balances
+// read for the holder export and position.
+func TestListBalancesByAccountIDs(t *testing.T) {
+	organizationID := uuid.Must(libCommons.GenerateUUIDv7())
+	ledgerID := uuid.Must(libCommons.GenerateUUIDv7())
+	accountIDs := []uuid.UUID{uuid.Must(libCommons.GenerateUUIDv7()), uuid.Must(libCommons.GenerateUUIDv7())}
+
+	t.Parallel()
+
+	newBalances := func() []*mmodel.Balance {
+		return []*mmodel.Balance{
+			{Alias: "@alice", Key: "default", Available: decimal.NewFromInt(10), OnHold: decimal.Zero, Version: 1},
+			{Alias: "@bob", Key: "default", Available: decimal.NewFromInt(20), OnHold: decimal.Zero, Version: 3},
+		}
+	}
+
+	t.Run("overlays the cached balances", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockBalanceRepo := balance.NewMockRepository(ctrl)
+		mockRedisRepo := redis.NewMockRedisRepository(ctrl)
+
+		mockBalanceRepo.EXPECT().ListByAccountIDs(gomock.Any(), organizationID, ledgerID, accountIDs).Return(newBalances(), nil)
+
+		aliceKey := utils.BalanceInternalKey(organizationID, ledgerID, "@alice#default")
+		bobKey := utils.BalanceInternalKey(organizationID, ledgerID, "@bob#default")
+		mockRedisRepo.EXPECT().MGet(gomock.Any(), []string{aliceKey, bobKey}).
+			Return(map[string]string{aliceKey: `{"available":"7.5","onHold":"2.5","version":2}`}, nil)
+
+		uc := UseCase{BalanceRepo: mockBalanceRepo, TransactionRedisRepo: mockRedisRepo}
+		res, err := uc.ListBalancesByAccountIDs(context.TODO(), organizationID, ledgerID, accountIDs)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.True(t, res[0].Available.Equal(decimal.NewFromFloat(7.5)))
+		assert.True(t, res[0].OnHold.Equal(decimal.NewFromFloat(2.5)))
+		assert.Equal(t, int64(2), res[0].Version)
+		assert.True(t, res[1].Available.Equal(decimal.NewFromInt(20)), "uncached balances keep the database values")
+		assert.Equal(t, int64(3), res[1].Version)
+	})
+
+	t.Run("keeps the database values when redis fails", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockBalanceRepo := balance.NewMockRepository(ctrl)
+		mockRedisRepo := redis.NewMockRedisRepository(ctrl)
+
+		mockBalanceRepo.EXPECT().ListByAccountIDs(gomock.Any(), organizationID, ledgerID, accountIDs).Return(newBalances(), nil)
+		mockRedisRepo.EXPECT().MGet(gomock.Any(), gomock.Any()).Return(nil, errors.New("redis down"))
+
+		uc := UseCase{BalanceRepo: mockBalanceRepo, TransactionRedisRepo: mockRedisRepo}
+		res, err := uc.ListBalancesByAccountIDs(context.TODO(), organizationID, ledgerID, accountIDs)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.True(t, res[0].Available.Equal(decimal.NewFromInt(10)))
+	})
+
+	t.Run("no balances skips the cache", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		mockBalanceRepo := balance.NewMockRepository(ctrl)
+		mockBalanceRepo.EXPECT().ListByAccountIDs(gomock.Any(), organizationID, ledgerID, accountIDs).Return([]*mmodel.Balance{}, nil)
+
+		uc := UseCase{BalanceRepo: mockBalanceRepo}
+		res, err := uc.ListBalancesByAccountIDs(context.TODO(), organizationID, ledgerID, accountIDs)
+
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+}
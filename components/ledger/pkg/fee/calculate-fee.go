@@ -21,6 +21,7 @@ import (
 	libLog "github.com/LerianStudio/lib-observability/log"
 	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
 	"github.com/google/uuid"
+	"github.com/iancoleman/strcase"
 	"github.com/shopspring/decimal"
 )
 
@@ -84,34 +85,42 @@ func isSegmentReference(entry string) (bool, uuid.UUID, error) {
 // trip ErrTransactionValueMismatch or silently create a multi-asset imbalance.
 // The defaultCurrency parameter is accepted for the value-only fallback when the
 // transaction carries no Send.Asset; it NEVER denominates a leg in a different
-// asset than the transaction. Send.Asset is the single source of truth.
+// asset than the transaction. Send.Asset is the single source of truth. Fees
+// scoped to another asset are skipped, and flat values are taken from the
+// calculation's per-asset values when it sets one for that asset.
 //
 // The segCtx parameter is optional: when non-nil, segment-based waivedAccounts resolution
 // is enabled (entries like "segment:<uuid>" trigger a Midaz API call to check account membership).
 // When segCtx is nil, only exact alias matching is used for waivedAccounts.
+//
+// The package is priced with the version in force at the transaction's effective
+// date (see EffectiveDate); when no version is in force at that date no fee is
+// applied. Resolution is idempotent, so callers that already resolved p pay nothing.
 func CalculateFee(logger libLog.Logger, f *model.FeeCalculate, p *pack.Package, resp *transaction.Responses, defaultCurrency string, segCtx *SegmentContext) error {
-	if defaultCurrency == "" {
-		defaultCurrency = DefaultCurrencyBRL
+	p = p.AsOf(EffectiveDate(f))
+	if p == nil {
+		return nil
 	}
 
-	// Fee legs are denominated in the transaction's Send.Asset. The configured
-	// default currency only acts as a fallback when the transaction omits one.
-	feeAsset := f.Transaction.Send.Asset
-	if feeAsset == "" {
-		feeAsset = defaultCurrency
-	}
+	feeAsset := FeeAsset(f, defaultCurrency)
 
 	originalTransactionValue := f.Transaction.Send.Value
 
-	fees := make([]model.Fee, 0, len(p.Fees))
-	for _, fee := range p.Fees {
-		fees = append(fees, fee)
+	fees := make([]keyedFee, 0, len(p.Fees))
+	for key, fee := range p.Fees {
+		fees = append(fees, keyedFee{key: key, fee: fee})
 	}
 
 	sort.Slice(fees, func(i, j int) bool {
-		return fees[i].Priority < fees[j].Priority
+		return fees[i].fee.Priority < fees[j].fee.Priority
 	})
 
+	// feeKeys maps each fee's position in priority order (the index embedded in
+	// its leg keys) back to its package key; applied holds the amount each fee
+	// actually charged, the base of any fee calculated on it.
+	feeKeys := make([]string, len(fees))
+	applied := make(map[string]decimal.Decimal, len(fees))
+
 	// Create a local copy of WaivedAccounts to avoid mutating the cached package.
 	// This prevents state accumulation across multiple API calls when the package is cached.
 	var waivedAccounts []string
@@ -130,9 +139,28 @@ func CalculateFee(logger libLog.Logger, f *model.FeeCalculate, p *pack.Package,
 
 	directAliasesPtr := &directAliases
 
-	for feeIndex, fee := range fees {
+	for feeIndex, kf := range fees {
+		fee := kf.fee
+		feeKeys[feeIndex] = kf.key
+
+		if asset := fee.GetAsset(); asset != "" && asset != feeAsset {
+			// The fee is scoped to another asset.
+			continue
+		}
+
 		valueToCalculate := selectReferenceAmount(fee, f.Transaction.Send.Value, originalTransactionValue)
 
+		if fee.ReferenceAmount == feeconstant.ReferenceAmountFeeAmount {
+			base, ok := applied[fee.GetBaseFee()]
+			if !ok || base.IsZero() {
+				// The base fee was not charged (waived, exempt or zero), so
+				// there is nothing to levy this fee on.
+				continue
+			}
+
+			valueToCalculate = base
+		}
+
 		var result transaction.Amount
 
 		var err error
@@ -144,6 +172,8 @@ func CalculateFee(logger libLog.Logger, f *model.FeeCalculate, p *pack.Package,
 			result, err = calculateFlatFee(fee, feeAsset)
 		case feeconstant.AppRulePercentual:
 			result, err = calculatePercentualFee(fee, valueToCalculate, feeAsset)
+		case feeconstant.AppRuleProgressive:
+			result, err = calculateProgressiveFee(fee, valueToCalculate, feeAsset)
 		default:
 			return pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("unknown application rule: %s", fee.CalculationModel.ApplicationRule))
 		}
@@ -152,22 +182,57 @@ func CalculateFee(logger libLog.Logger, f *model.FeeCalculate, p *pack.Package,
 			return err
 		}
 
+		result, err = applyFeeLimits(fee, result, feeAsset)
+		if err != nil {
+			return err
+		}
+
 		// Fee total is emitted unrounded: the ledger is arbitrary-precision and
 		// every serialization seam round-trips full precision (P4-T23). The
 		// residual-to-max reconciliation in applyFeeCorrection holds sum(legs) ==
 		// fee total exactly without any asset-scale rounding.
 
+		legsBefore := len(resp.From) + len(resp.To)
+
 		if err := applyDeductibleAndReferenceAmountRules(logger, feeIndex, directAliasesPtr, segmentIDs, segCtx, fee, resp, result, f); err != nil {
 			return err
 		}
+
+		if len(resp.From)+len(resp.To) != legsBefore {
+			applied[strcase.ToLowerCamel(kf.key)] = result.Value
+		} else {
+			// No payer was charged: every one of them is exempt, so the fee
+			// is recorded as waived for revenue reporting.
+			recordFeeWaiver(&f.Transaction, p.ID.String(), p.Version, kf.key, model.FeeWaiverExemption, result)
+		}
 	}
 
-	f.Transaction.Send.Source.From = updatedAmountsFromFee(resp.From)
-	f.Transaction.Send.Distribute.To = updatedAmountsFromFee(resp.To)
+	f.Transaction.Send.Source.From = updatedAmountsFromFee(resp.From, p, feeKeys)
+	f.Transaction.Send.Distribute.To = updatedAmountsFromFee(resp.To, p, feeKeys)
 
 	return nil
 }
 
+// FeeAsset returns the asset fees are denominated in: the transaction's
+// Send.Asset, or defaultCurrency (BRL when empty) when the transaction omits one.
+func FeeAsset(f *model.FeeCalculate, defaultCurrency string) string {
+	if f.Transaction.Send.Asset != "" {
+		return f.Transaction.Send.Asset
+	}
+
+	if defaultCurrency == "" {
+		return DefaultCurrencyBRL
+	}
+
+	return defaultCurrency
+}
+
+// keyedFee pairs a package fee with its key in the package's fee map.
+type keyedFee struct {
+	key string
+	fee model.Fee
+}
+
 // selectReferenceAmount chooses the correct transaction value for fee calculation based on the fee's reference amount rule.
 func selectReferenceAmount(fee model.Fee, currentValue, originalValue decimal.Decimal) decimal.Decimal {
 	if fee.ReferenceAmount == feeconstant.ReferenceAmountAfterFeesAmount {
@@ -192,7 +257,7 @@ func calculateMaxBetweenTypesFee(fee model.Fee, valueToCalculate decimal.Decimal
 
 		switch calc.Type {
 		case feeconstant.FeeTypeFlat:
-			realValue, err = decimal.NewFromString(calc.Value)
+			realValue, err = decimal.NewFromString(calc.ValueFor(feeAsset))
 			if err != nil {
 				return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid flat fee value: %v", err))
 			}
@@ -230,7 +295,7 @@ func calculateFlatFee(fee model.Fee, feeAsset string) (transaction.Amount, error
 
 	calc := fee.CalculationModel.Calculations[0]
 
-	value, err := decimal.NewFromString(calc.Value)
+	value, err := decimal.NewFromString(calc.ValueFor(feeAsset))
 	if err != nil {
 		return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid flat fee value: %v", err))
 	}
@@ -257,6 +322,88 @@ func calculatePercentualFee(fee model.Fee, valueToCalculate decimal.Decimal, fee
 	return findPercentualOfValue(percentValue, valueToCalculate, feeAsset), nil
 }
 
+// calculateProgressiveFee calculates a banded fee: each band prices only the part
+// of valueToCalculate that falls inside it, like tax brackets. A percentage band
+// applies its rate to that part; a flat band adds its value once the amount
+// reaches the band. Bands are validated on write to be contiguous and ordered,
+// starting at zero, with only the last one open-ended.
+func calculateProgressiveFee(fee model.Fee, valueToCalculate decimal.Decimal, feeAsset string) (transaction.Amount, error) {
+	if len(fee.CalculationModel.Calculations) == 0 {
+		return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrCalculationRequired, "", "progressive requires at least one band")
+	}
+
+	total := decimal.Zero
+	lowerBound := decimal.Zero
+
+	for _, band := range fee.CalculationModel.Calculations {
+		if !valueToCalculate.GreaterThan(lowerBound) {
+			break
+		}
+
+		value, err := decimal.NewFromString(band.ValueFor(feeAsset))
+		if err != nil {
+			return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid progressive band value: %v", err))
+		}
+
+		upperBound := valueToCalculate
+
+		if band.UpTo != nil {
+			upTo, err := decimal.NewFromString(*band.UpTo)
+			if err != nil {
+				return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid progressive band limit: %v", err))
+			}
+
+			upperBound = decimal.Min(upTo, valueToCalculate)
+		}
+
+		switch band.Type {
+		case feeconstant.FeeTypePercentage:
+			total = total.Add(findPercentualOfValue(value, upperBound.Sub(lowerBound), feeAsset).Value)
+		case feeconstant.FeeTypeFlat:
+			total = total.Add(value)
+		default:
+			return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("unknown fee type: %s", band.Type))
+		}
+
+		lowerBound = upperBound
+	}
+
+	return transaction.Amount{
+		Asset: feeAsset,
+		Value: total,
+	}, nil
+}
+
+// applyFeeLimits raises result to the fee's minimumFee and lowers it to its
+// maximumFee, when those are set. A floor can turn a zero result (which some
+// rules return without an asset) into a charge, so the raised amount is always
+// denominated in feeAsset.
+func applyFeeLimits(fee model.Fee, result transaction.Amount, feeAsset string) (transaction.Amount, error) {
+	if fee.MinimumFee != nil {
+		minFee, err := decimal.NewFromString(*fee.MinimumFee)
+		if err != nil {
+			return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid minimum fee value: %v", err))
+		}
+
+		if result.Value.LessThan(minFee) {
+			result = transaction.Amount{Asset: feeAsset, Value: minFee}
+		}
+	}
+
+	if fee.MaximumFee != nil {
+		maxFee, err := decimal.NewFromString(*fee.MaximumFee)
+		if err != nil {
+			return transaction.Amount{}, pkg.ValidateBusinessError(constant.ErrApplicationRule, "", fmt.Sprintf("invalid maximum fee value: %v", err))
+		}
+
+		if result.Value.GreaterThan(maxFee) {
+			result.Value = maxFee
+		}
+	}
+
+	return result, nil
+}
+
 // findPercentualOfValue finds the percentual of value
 func findPercentualOfValue(feeValue, transactionValue decimal.Decimal, feeAsset string) transaction.Amount {
 	percentConverted := feeValue.Div(decimal.NewFromInt(100))
@@ -71,6 +71,14 @@ func (r *countingResolver) CountTransactionsByRoute(_ context.Context, _, _ uuid
 	return 0, nil
 }
 
+func (r *countingResolver) GetAssetRate(_ context.Context, _, _ uuid.UUID, _, _ string) (*decimal.Decimal, error) {
+	return nil, nil
+}
+
+func (r *countingResolver) AvailableBalance(_ context.Context, _, _ uuid.UUID, _, _ string) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
 func (r *countingResolver) maxPerAliasCalls() int {
 	max := 0
 	for _, n := range r.perAliasCalls {
@@ -364,7 +364,7 @@ func TestFindPackageToCalculateFee(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := FindPackageToCalculateFee(tt.packages, tt.transactionRoute, tt.segmentID, tt.amount)
+			result, err := FindPackageToCalculateFee(tt.packages, "BRL", tt.transactionRoute, tt.segmentID, tt.amount)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -1724,7 +1724,7 @@ func TestUpdatedAmountsFromFee(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := updatedAmountsFromFee(tt.amounts)
+			result := updatedAmountsFromFee(tt.amounts, nil, nil)
 			assert.Len(t, result, tt.expected)
 
 			if tt.expected > 0 {
@@ -2604,3 +2604,218 @@ func TestCalculateFee_DeductibleFitsAmount_Applied(t *testing.T) {
 	assert.True(t, feeLeg.Value.Equal(decimal.NewFromInt(10)),
 		"fee account should receive 10, got %s", feeLeg.Value.String())
 }
+
+func TestCalculateProgressiveFee(t *testing.T) {
+	t.Parallel()
+
+	upTo := func(s string) *string { return &s }
+
+	fee := model.Fee{CalculationModel: &model.CalculationModel{
+		ApplicationRule: feeconstant.AppRuleProgressive,
+		Calculations: []model.Calculation{
+			{Type: feeconstant.FeeTypePercentage, Value: "2", UpTo: upTo("1000")},
+			{Type: feeconstant.FeeTypePercentage, Value: "1", UpTo: upTo("5000")},
+			{Type: feeconstant.FeeTypeFlat, Value: "7"},
+		},
+	}}
+
+	tests := []struct {
+		name   string
+		amount int64
+		want   string
+	}{
+		{name: "inside the first band", amount: 500, want: "10"},
+		{name: "at the first limit", amount: 1000, want: "20"},
+		{name: "spans two bands", amount: 3000, want: "40"},
+		{name: "reaches the open flat band", amount: 8000, want: "67"},
+		{name: "zero amount", amount: 0, want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := calculateProgressiveFee(fee, decimal.NewFromInt(tt.amount), "BRL")
+			assert.NoError(t, err)
+			assert.Equal(t, "BRL", got.Asset)
+			assert.True(t, got.Value.Equal(decimal.RequireFromString(tt.want)), "want %s, got %s", tt.want, got.Value)
+		})
+	}
+}
+
+func TestApplyFeeLimits(t *testing.T) {
+	t.Parallel()
+
+	limit := func(s string) *string { return &s }
+	fee := model.Fee{MinimumFee: limit("2.50"), MaximumFee: limit("40")}
+
+	raised, err := applyFeeLimits(fee, transaction.Amount{}, "BRL")
+	assert.NoError(t, err)
+	assert.Equal(t, "BRL", raised.Asset)
+	assert.True(t, raised.Value.Equal(decimal.RequireFromString("2.50")))
+
+	capped, err := applyFeeLimits(fee, transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(90)}, "BRL")
+	assert.NoError(t, err)
+	assert.True(t, capped.Value.Equal(decimal.NewFromInt(40)))
+
+	within, err := applyFeeLimits(fee, transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(12)}, "BRL")
+	assert.NoError(t, err)
+	assert.True(t, within.Value.Equal(decimal.NewFromInt(12)))
+
+	unbounded, err := applyFeeLimits(model.Fee{}, transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(900)}, "BRL")
+	assert.NoError(t, err)
+	assert.True(t, unbounded.Value.Equal(decimal.NewFromInt(900)))
+}
+
+func TestCalculateFee_ProgressiveWithCap(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	upTo := "1000"
+	maxFee := "25"
+	notDeductible := false
+
+	feeCalc := &model.FeeCalculate{
+		Transaction: transaction.Transaction{
+			Send: transaction.Send{
+				Asset: "BRL",
+				Value: decimal.NewFromInt(5000),
+				Source: transaction.Source{From: []transaction.FromTo{{
+					AccountAlias: "@from_account",
+					Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(5000)},
+				}}},
+				Distribute: transaction.Distribute{To: []transaction.FromTo{{
+					AccountAlias: "@to_account",
+					Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(5000)},
+				}}},
+			},
+		},
+	}
+
+	p := &pack.Package{
+		ID:             uuid.New(),
+		WaivedAccounts: &[]string{},
+		Fees: map[string]model.Fee{"acquiring": {
+			FeeLabel: "acquiring",
+			CalculationModel: &model.CalculationModel{
+				ApplicationRule: feeconstant.AppRuleProgressive,
+				Calculations: []model.Calculation{
+					{Type: feeconstant.FeeTypePercentage, Value: "2", UpTo: &upTo},
+					{Type: feeconstant.FeeTypePercentage, Value: "1"},
+				},
+			},
+			ReferenceAmount:  "originalAmount",
+			Priority:         1,
+			IsDeductibleFrom: &notDeductible,
+			CreditAccount:    "@fee_account",
+			MaximumFee:       &maxFee,
+		}},
+	}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@from_account": {Asset: "BRL", Value: decimal.NewFromInt(5000)}},
+		To:   map[string]transaction.Amount{"@to_account": {Asset: "BRL", Value: decimal.NewFromInt(5000)}},
+	}
+
+	assert.NoError(t, CalculateFee(logger, feeCalc, p, resp, "BRL", nil))
+
+	// Bands yield 20 + 40 = 60, capped at 25.
+	assert.True(t, feeCalc.Transaction.Send.Value.Equal(decimal.NewFromInt(5025)),
+		"expected Send.Value=5025, got %s", feeCalc.Transaction.Send.Value)
+}
+
+func TestCalculateFee_FeeOnFee(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	notDeductible := false
+	baseFee := "serviceFee"
+
+	newPackage := func(waived []string) *pack.Package {
+		return &pack.Package{
+			ID:             uuid.New(),
+			Version:        1,
+			WaivedAccounts: &waived,
+			Fees: map[string]model.Fee{
+				"serviceFee": {
+					FeeLabel: "service",
+					CalculationModel: &model.CalculationModel{
+						ApplicationRule: feeconstant.AppRuleFlatFee,
+						Calculations:    []model.Calculation{{Type: feeconstant.FeeTypeFlat, Value: "100"}},
+					},
+					ReferenceAmount:  feeconstant.ReferenceAmountOriginalAmount,
+					Priority:         1,
+					IsDeductibleFrom: &notDeductible,
+					CreditAccount:    "@revenue",
+				},
+				"iss": {
+					FeeLabel: "ISS",
+					CalculationModel: &model.CalculationModel{
+						ApplicationRule: feeconstant.AppRulePercentual,
+						Calculations:    []model.Calculation{{Type: feeconstant.FeeTypePercentage, Value: "5"}},
+					},
+					ReferenceAmount:  feeconstant.ReferenceAmountFeeAmount,
+					BaseFee:          &baseFee,
+					Priority:         2,
+					IsDeductibleFrom: &notDeductible,
+					CreditAccount:    "@iss_payable",
+				},
+			},
+		}
+	}
+
+	newFeeCalc := func() (*model.FeeCalculate, *transaction.Responses) {
+		fc := &model.FeeCalculate{Transaction: transaction.Transaction{Send: transaction.Send{
+			Asset: "BRL",
+			Value: decimal.NewFromInt(1000),
+		}}}
+		resp := &transaction.Responses{
+			From: map[string]transaction.Amount{"@from_account": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+			To:   map[string]transaction.Amount{"@to_account": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		}
+
+		return fc, resp
+	}
+
+	t.Run("tax is levied on the applied fee", func(t *testing.T) {
+		t.Parallel()
+
+		fc, resp := newFeeCalc()
+		assert.NoError(t, CalculateFee(logger, fc, newPackage(nil), resp, "BRL", nil))
+
+		// 1000 + service fee 100 + 5% ISS on that fee.
+		assert.True(t, fc.Transaction.Send.Value.Equal(decimal.NewFromInt(1105)), "got %s", fc.Transaction.Send.Value)
+
+		issTotal := decimal.Zero
+
+		for _, leg := range fc.Transaction.Send.Distribute.To {
+			switch leg.AccountAlias {
+			case "@iss_payable":
+				issTotal = issTotal.Add(leg.Amount.Value)
+
+				assert.Equal(t, "iss", leg.Metadata[MetadataFeeKey])
+				assert.Equal(t, "serviceFee", leg.Metadata[MetadataBaseFee])
+			case "@revenue":
+				assert.Equal(t, "serviceFee", leg.Metadata[MetadataFeeKey])
+				assert.NotContains(t, leg.Metadata, MetadataBaseFee)
+			}
+		}
+
+		assert.True(t, issTotal.Equal(decimal.NewFromInt(5)), "ISS legs sum to %s", issTotal)
+	})
+
+	t.Run("no tax when the base fee is waived", func(t *testing.T) {
+		t.Parallel()
+
+		fc, resp := newFeeCalc()
+		assert.NoError(t, CalculateFee(logger, fc, newPackage([]string{"@from_account"}), resp, "BRL", nil))
+
+		assert.True(t, fc.Transaction.Send.Value.Equal(decimal.NewFromInt(1000)), "got %s", fc.Transaction.Send.Value)
+
+		for _, leg := range fc.Transaction.Send.Distribute.To {
+			assert.NotEqual(t, "@iss_payable", leg.AccountAlias)
+		}
+	})
+}
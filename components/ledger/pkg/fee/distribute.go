@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
 	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
 	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
 	"github.com/LerianStudio/midaz/v4/pkg"
@@ -153,8 +154,13 @@ func setFeeExemptionMetadata(f *model.FeeCalculate, reason string) {
 	}
 }
 
-// updatedAmountsFromFee updates the amounts from the fee
-func updatedAmountsFromFee(amounts map[string]transaction.Amount) []transaction.FromTo {
+// updatedAmountsFromFee updates the amounts from the fee. When p is non-nil,
+// every leg the fee engine appended is stamped with the package ID and the
+// package version that priced it, so the persisted operations record which
+// schedule applied. feeKeys maps a leg's fee index to its package fee key; legs
+// are stamped with that key and, for a fee calculated on another fee, with the
+// base fee's key, so the chain can be followed from the operations.
+func updatedAmountsFromFee(amounts map[string]transaction.Amount, p *pack.Package, feeKeys []string) []transaction.FromTo {
 	newFromTo := make([]transaction.FromTo, 0, len(amounts))
 
 	for account, amount := range amounts {
@@ -168,6 +174,17 @@ func updatedAmountsFromFee(amounts map[string]transaction.Amount) []transaction.
 			cleanAccount, metadata = processAccount(account)
 		}
 
+		if idx, ok := feeLegIndex(account); ok {
+			if p != nil {
+				metadata[MetadataPackageID] = p.ID.String()
+				metadata[MetadataPackageVersion] = p.Version
+			}
+
+			if idx < len(feeKeys) {
+				stampFeeChain(metadata, p, feeKeys[idx])
+			}
+		}
+
 		if len(parts) > 2 && parts[len(parts)-1] != "" {
 			route = parts[len(parts)-1]
 		}
@@ -190,6 +207,20 @@ func updatedAmountsFromFee(amounts map[string]transaction.Amount) []transaction.
 	return newFromTo
 }
 
+// stampFeeChain records on a fee leg which package fee produced it and, when
+// that fee is calculated on another fee, which one.
+func stampFeeChain(metadata map[string]any, p *pack.Package, feeKey string) {
+	metadata[MetadataFeeKey] = feeKey
+
+	if p == nil {
+		return
+	}
+
+	if fee, ok := p.Fees[feeKey]; ok && fee.BaseFee != nil {
+		metadata[MetadataBaseFee] = fee.GetBaseFee()
+	}
+}
+
 // trimFeeSuffix trims the fee suffix
 func trimFeeSuffix(s string) string {
 	if i := strings.Index(s, "->"); i != -1 {
@@ -207,7 +238,7 @@ func processAccount(account string) (string, map[string]any) {
 	if len(parts) >= 3 && strings.Contains(parts[1], "fee_source") {
 		cleanAccount := parts[0]
 		sourceAccount := parts[2]
-		metadata["source"] = sourceAccount
+		metadata[metadataFeeSource] = sourceAccount
 
 		return cleanAccount, metadata
 	}
@@ -16,16 +16,21 @@ import (
 
 // FindPackageToCalculateFee returns the Package to calculate Fee or an error if not exactly one Package is found.
 //
-// Scope is an AND of route, segment, and amount: a package applies only when
-// every constraint it carries matches the transaction. The early returns after
-// the route and segment filters are short-circuits that must not skip an
+// Scope is an AND of asset, route, segment, and amount: a package applies only
+// when every constraint it carries matches the transaction. The early returns
+// after the route and segment filters are short-circuits that must not skip an
 // unverified constraint — a lone route survivor that still carries a segment
 // constraint must fall through to the segment filter, otherwise a package scoped
 // to route=A AND segment=X would be selected on the route match alone.
-func FindPackageToCalculateFee(packages []*pack.Package, transactionRoute string,
+//
+// Unlike route and segment, an unscoped asset matches every asset, so a ledger
+// can keep one generic package and price selected assets differently: when both
+// an asset-scoped and an unscoped package survive the other filters, the one
+// scoped to the transaction asset is selected.
+func FindPackageToCalculateFee(packages []*pack.Package, transactionAsset, transactionRoute string,
 	segmentID *uuid.UUID, amount decimal.Decimal,
 ) (*pack.Package, error) {
-	byRoute := filterByTransactionRoute(packages, transactionRoute)
+	byRoute := filterByTransactionRoute(filterByAsset(packages, transactionAsset), transactionRoute)
 	if len(byRoute) == 1 && byRoute[0].SegmentID == nil {
 		return byRoute[0], nil
 	}
@@ -35,7 +40,7 @@ func FindPackageToCalculateFee(packages []*pack.Package, transactionRoute string
 		return bySegment[0], nil
 	}
 
-	byAmount := filterByAmount(bySegment, amount)
+	byAmount := preferAssetScoped(filterByAmount(bySegment, amount))
 	if len(byAmount) == 1 {
 		return byAmount[0], nil
 	} else if byAmount == nil {
@@ -45,6 +50,37 @@ func FindPackageToCalculateFee(packages []*pack.Package, transactionRoute string
 	return nil, errors.New("more than one package was found")
 }
 
+// filterByAsset Filters out the packages scoped to an asset other than the transaction's
+func filterByAsset(packages []*pack.Package, transactionAsset string) []*pack.Package {
+	var filtered []*pack.Package
+
+	for _, packValue := range packages {
+		if packValue.Asset == nil || *packValue.Asset == transactionAsset {
+			filtered = append(filtered, packValue)
+		}
+	}
+
+	return filtered
+}
+
+// preferAssetScoped keeps only the asset-scoped packages when any is left,
+// since they take precedence over unscoped ones.
+func preferAssetScoped(packages []*pack.Package) []*pack.Package {
+	var scoped []*pack.Package
+
+	for _, packValue := range packages {
+		if packValue.Asset != nil {
+			scoped = append(scoped, packValue)
+		}
+	}
+
+	if scoped == nil {
+		return packages
+	}
+
+	return scoped
+}
+
 // filterByTransactionRoute Filters the packages by transaction route
 func filterByTransactionRoute(packages []*pack.Package, transactionRoute string) []*pack.Package {
 	var filtered []*pack.Package
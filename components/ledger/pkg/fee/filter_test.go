@@ -123,7 +123,7 @@ func TestFindPackageToCalculateFee_Scoping(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := FindPackageToCalculateFee(tc.packages, tc.route, tc.segmentID, amount)
+			got, err := FindPackageToCalculateFee(tc.packages, "BRL", tc.route, tc.segmentID, amount)
 
 			if tc.expectedError {
 				assert.Error(t, err)
@@ -155,3 +155,40 @@ func TestFindPackageToCalculateFee_Scoping(t *testing.T) {
 		})
 	}
 }
+
+// TestFindPackageToCalculateFee_AssetScoping locks the asset scope: a package
+// scoped to another asset never applies, an unscoped one applies to every
+// asset, and a package scoped to the transaction asset beats an unscoped one.
+func TestFindPackageToCalculateFee_AssetScoping(t *testing.T) {
+	t.Parallel()
+
+	min0 := decimal.Zero
+	max := decimal.NewFromInt(1_000_000)
+	amount := decimal.NewFromInt(100)
+
+	generic := &pack.Package{ID: uuid.New(), MinimumAmount: min0, MaximumAmount: max}
+	usd := &pack.Package{ID: uuid.New(), Asset: strPtr("USD"), MinimumAmount: min0, MaximumAmount: max}
+	usdSmall := &pack.Package{ID: uuid.New(), Asset: strPtr("USD"), MinimumAmount: min0, MaximumAmount: decimal.NewFromInt(50)}
+
+	tests := []struct {
+		name     string
+		packages []*pack.Package
+		asset    string
+		want     *pack.Package
+	}{
+		{name: "asset-scoped package beats the unscoped one", packages: []*pack.Package{generic, usd}, asset: "USD", want: usd},
+		{name: "unscoped package prices other assets", packages: []*pack.Package{generic, usd}, asset: "BRL", want: generic},
+		{name: "package scoped to another asset never applies", packages: []*pack.Package{usd}, asset: "BRL", want: nil},
+		{name: "unscoped package applies when the scoped one is out of range", packages: []*pack.Package{generic, usdSmall}, asset: "USD", want: generic},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FindPackageToCalculateFee(tc.packages, tc.asset, "", nil, amount)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
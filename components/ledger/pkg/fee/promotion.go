@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"sort"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+)
+
+// metadataFeeSource is the credit fee-leg metadata key holding the transaction
+// key of the account that pays the fee.
+const metadataFeeSource = "source"
+
+// MetadataFeePromotions is the transaction metadata key listing the promotions
+// that priced the transaction's fees, with what each charged and waived the
+// payer. It is what the promotions' usage counters moved by when the
+// transaction was priced, and what a transaction that does not post gives back.
+const MetadataFeePromotions = "feePromotions"
+
+// FeePayer is an account charged fees by a package on a transaction.
+type FeePayer struct {
+	// Key is the payer's transaction key, as stamped on its fee legs.
+	Key string
+	// Alias is the payer's bare account alias.
+	Alias string
+	// Total is the sum of the fees the package charged the payer.
+	Total decimal.Decimal
+}
+
+// FeePayers returns the accounts charged fees by the package on t, ordered by
+// key, with the total each one pays. It reads the credit fee legs, which carry
+// the payer's key whether or not the fee was deducted from the principal.
+func FeePayers(t *transaction.Transaction, packageID string) []FeePayer {
+	totals := make(map[string]decimal.Decimal)
+
+	for _, leg := range t.Send.Distribute.To {
+		payer, ok := feeLegPayer(leg, packageID)
+		if !ok {
+			continue
+		}
+
+		totals[payer] = totals[payer].Add(leg.Amount.Value)
+	}
+
+	payers := make([]FeePayer, 0, len(totals))
+
+	for key, total := range totals {
+		payers = append(payers, FeePayer{Key: key, Alias: transaction.SplitAlias(key), Total: total})
+	}
+
+	sort.Slice(payers, func(i, j int) bool { return payers[i].Key < payers[j].Key })
+
+	return payers
+}
+
+// WaivePayerFees removes up to amount of the fees the package charged payer on
+// t and returns how much was waived. Each waived credit leg is reduced together
+// with what funded it: for a fee paid on top of the principal, the payer's fee
+// debit leg and the transaction value; for a deducted fee, the deduction, which
+// goes back to the payer's principal credit. Fee legs reaching zero are dropped.
+// What is waived is recorded in the feeWaivers metadata.
+func WaivePayerFees(t *transaction.Transaction, packageID, payer string, amount decimal.Decimal) decimal.Decimal {
+	send := &t.Send
+	remaining := amount
+
+	order := make([]int, 0)
+
+	for i, leg := range send.Distribute.To {
+		if legPayer, ok := feeLegPayer(leg, packageID); ok && legPayer == payer {
+			order = append(order, i)
+		}
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		keyA, _ := send.Distribute.To[order[a]].Metadata[MetadataFeeKey].(string)
+		keyB, _ := send.Distribute.To[order[b]].Metadata[MetadataFeeKey].(string)
+
+		return keyA < keyB
+	})
+
+	for _, i := range order {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		credit := send.Distribute.To[i]
+		feeKey, _ := credit.Metadata[MetadataFeeKey].(string)
+		waive := decimal.Min(remaining, credit.Amount.Value)
+
+		send.Distribute.To[i].Amount = &transaction.Amount{Asset: credit.Amount.Asset, Value: credit.Amount.Value.Sub(waive)}
+
+		recordFeeWaiver(t, packageID, MetadataInt(credit.Metadata[MetadataPackageVersion]), feeKey, model.FeeWaiverPromotion, transaction.Amount{Asset: credit.Amount.Asset, Value: waive})
+
+		if debit := findPayerFeeDebit(send.Source.From, packageID, payer, feeKey); debit >= 0 {
+			reduceLeg(send.Source.From, debit, waive)
+			send.Value = send.Value.Sub(waive)
+		} else if principal := findPrincipalLeg(send.Distribute.To, payer); principal >= 0 {
+			reduceLeg(send.Distribute.To, principal, waive.Neg())
+		}
+
+		remaining = remaining.Sub(waive)
+	}
+
+	send.Source.From = dropEmptyFeeLegs(send.Source.From)
+	send.Distribute.To = dropEmptyFeeLegs(send.Distribute.To)
+
+	return amount.Sub(remaining)
+}
+
+// feeLegPayer returns the payer of a credit fee leg charged by the package.
+func feeLegPayer(leg transaction.FromTo, packageID string) (string, bool) {
+	origin, _, ok := feeLegOrigin(leg)
+	if !ok || origin != packageID {
+		return "", false
+	}
+
+	payer, _ := leg.Metadata[metadataFeeSource].(string)
+
+	return payer, payer != ""
+}
+
+// findPayerFeeDebit returns the index of the payer's debit leg for a fee paid
+// on top of the principal, or -1 when the fee was deducted.
+func findPayerFeeDebit(from []transaction.FromTo, packageID, payer, feeKey string) int {
+	for i, leg := range from {
+		origin, key, ok := feeLegOrigin(leg)
+		if ok && origin == packageID && key == feeKey && leg.AccountAlias == payer {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// findPrincipalLeg returns the index of the payer's non-fee leg, or -1.
+func findPrincipalLeg(legs []transaction.FromTo, payer string) int {
+	for i, leg := range legs {
+		if _, _, isFee := feeLegOrigin(leg); !isFee && leg.Amount != nil && leg.AccountAlias == payer {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// reduceLeg subtracts amount from the leg at index i.
+func reduceLeg(legs []transaction.FromTo, i int, amount decimal.Decimal) {
+	legs[i].Amount = &transaction.Amount{Asset: legs[i].Amount.Asset, Value: legs[i].Amount.Value.Sub(amount)}
+}
+
+// dropEmptyFeeLegs removes the fee legs whose amount reached zero.
+func dropEmptyFeeLegs(legs []transaction.FromTo) []transaction.FromTo {
+	kept := make([]transaction.FromTo, 0, len(legs))
+
+	for _, leg := range legs {
+		if _, _, isFee := feeLegOrigin(leg); !isFee || !leg.Amount.Value.IsZero() {
+			kept = append(kept, leg)
+		}
+	}
+
+	return kept
+}
+
+// PromotionUsages returns the usage the feePromotions metadata of t records,
+// one entry per promotion and payer, without the organization. Entries missing
+// the promotion, subject or period are skipped.
+func PromotionUsages(t *transaction.Transaction) []model.PromotionUsage {
+	if t == nil {
+		return nil
+	}
+
+	var entries []map[string]any
+
+	switch v := t.Metadata[MetadataFeePromotions].(type) {
+	case []map[string]any:
+		entries = v
+	case []any:
+		for _, item := range v {
+			if entry, ok := item.(map[string]any); ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	usages := make([]model.PromotionUsage, 0, len(entries))
+
+	for _, entry := range entries {
+		usage := model.PromotionUsage{Transactions: 1}
+		usage.PackageID, _ = entry["packageId"].(string)
+		usage.PromotionID, _ = entry["promotionId"].(string)
+		usage.Subject, _ = entry["subject"].(string)
+		usage.Period, _ = entry["period"].(string)
+
+		if usage.PromotionID == "" || usage.Subject == "" || usage.Period == "" {
+			continue
+		}
+
+		usage.FeesCharged = metadataDecimal(entry["charged"])
+		usage.FeesWaived = metadataDecimal(entry["waived"])
+
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+// metadataDecimal reads a decimal metadata value written as a string. Anything
+// else is zero.
+func metadataDecimal(raw any) decimal.Decimal {
+	s, _ := raw.(string)
+
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero
+	}
+
+	return d
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	libZap "github.com/LerianStudio/lib-observability/zap"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chargedTransfer runs the fee engine on a 1000 transfer priced with a flat 10
+// fee, deducted from the payee or paid by the payer on top of the principal.
+func chargedTransfer(t *testing.T, deductible bool) (*transaction.Transaction, string) {
+	t.Helper()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	p := &pack.Package{
+		ID:             uuid.New(),
+		WaivedAccounts: &[]string{},
+		Fees: map[string]model.Fee{"service": {
+			FeeLabel: "service",
+			CalculationModel: &model.CalculationModel{
+				ApplicationRule: feeconstant.AppRuleFlatFee,
+				Calculations:    []model.Calculation{{Type: feeconstant.FeeTypeFlat, Value: "10"}},
+			},
+			ReferenceAmount:  "originalAmount",
+			Priority:         1,
+			IsDeductibleFrom: &deductible,
+			CreditAccount:    "@fee_account",
+		}},
+	}
+
+	feeCalc := &model.FeeCalculate{Transaction: transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(1000),
+	}}}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@payer": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		To:   map[string]transaction.Amount{"@payee": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+	}
+
+	require.NoError(t, CalculateFee(logger, feeCalc, p, resp, "BRL", nil))
+
+	return &feeCalc.Transaction, p.ID.String()
+}
+
+func TestFeePayers(t *testing.T) {
+	t.Parallel()
+
+	tx, packageID := chargedTransfer(t, false)
+
+	payers := FeePayers(tx, packageID)
+	require.Len(t, payers, 1)
+	assert.Equal(t, "@payer", payers[0].Alias)
+	assert.True(t, payers[0].Total.Equal(decimal.NewFromInt(10)))
+
+	assert.Empty(t, FeePayers(tx, uuid.NewString()), "legs of other packages are ignored")
+
+	deducted, deductedPackageID := chargedTransfer(t, true)
+
+	payers = FeePayers(deducted, deductedPackageID)
+	require.Len(t, payers, 1)
+	assert.Equal(t, "@payee", payers[0].Alias)
+}
+
+func TestWaivePayerFees(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		deductible bool
+		waive      int64
+		wantWaived string
+		wantValue  string
+		wantFrom   map[string]string
+		wantTo     map[string]string
+	}{
+		{
+			name:       "whole fee paid on top of the principal",
+			waive:      10,
+			wantWaived: "10",
+			wantValue:  "1000",
+			wantFrom:   map[string]string{"@payer": "1000"},
+			wantTo:     map[string]string{"@payee": "1000"},
+		},
+		{
+			name:       "part of a fee paid on top of the principal",
+			waive:      4,
+			wantWaived: "4",
+			wantValue:  "1006",
+			wantFrom:   map[string]string{"@payer": "1000", "@payer/service": "6"},
+			wantTo:     map[string]string{"@payee": "1000", "@fee_account/service": "6"},
+		},
+		{
+			name:       "deducted fee goes back to the payee",
+			deductible: true,
+			waive:      10,
+			wantWaived: "10",
+			wantValue:  "1000",
+			wantFrom:   map[string]string{"@payer": "1000"},
+			wantTo:     map[string]string{"@payee": "1000"},
+		},
+		{
+			name:       "waiving more than the fees stops at the fees",
+			deductible: true,
+			waive:      25,
+			wantWaived: "10",
+			wantValue:  "1000",
+			wantFrom:   map[string]string{"@payer": "1000"},
+			wantTo:     map[string]string{"@payee": "1000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx, packageID := chargedTransfer(t, tt.deductible)
+			payers := FeePayers(tx, packageID)
+			require.Len(t, payers, 1)
+
+			waived := WaivePayerFees(tx, packageID, payers[0].Key, decimal.NewFromInt(tt.waive))
+
+			assert.True(t, waived.Equal(decimal.RequireFromString(tt.wantWaived)), "waived %s", waived)
+			assert.True(t, tx.Send.Value.Equal(decimal.RequireFromString(tt.wantValue)), "value %s", tx.Send.Value)
+			assert.Equal(t, tt.wantFrom, legAmounts(tx.Send.Source.From))
+			assert.Equal(t, tt.wantTo, legAmounts(tx.Send.Distribute.To))
+
+			// Both sides still balance against the transaction value.
+			assert.True(t, sumLegs(tx.Send.Source.From).Equal(tx.Send.Value))
+			assert.True(t, sumLegs(tx.Send.Distribute.To).Equal(tx.Send.Value))
+		})
+	}
+}
+
+func TestPromotionUsages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads entries written by the engine and after a JSON round trip", func(t *testing.T) {
+		t.Parallel()
+
+		entry := map[string]any{
+			"promotionId": "promo-1",
+			"packageId":   "pkg-1",
+			"account":     "@payer",
+			"subject":     "@payer",
+			"period":      "2026-10",
+			"charged":     "4",
+			"waived":      "6",
+		}
+
+		for _, raw := range []any{[]map[string]any{entry}, []any{entry}} {
+			usages := PromotionUsages(&transaction.Transaction{Metadata: map[string]any{MetadataFeePromotions: raw}})
+			require.Len(t, usages, 1)
+
+			assert.Equal(t, "promo-1", usages[0].PromotionID)
+			assert.Equal(t, "pkg-1", usages[0].PackageID)
+			assert.Equal(t, "@payer", usages[0].Subject)
+			assert.Equal(t, "2026-10", usages[0].Period)
+			assert.Equal(t, int64(1), usages[0].Transactions)
+			assert.True(t, usages[0].FeesCharged.Equal(decimal.NewFromInt(4)))
+			assert.True(t, usages[0].FeesWaived.Equal(decimal.NewFromInt(6)))
+		}
+	})
+
+	t.Run("skips entries without a counter key", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.Transaction{Metadata: map[string]any{MetadataFeePromotions: []any{
+			map[string]any{"promotionId": "promo-1", "waived": "10"},
+		}}}
+
+		assert.Empty(t, PromotionUsages(tx))
+		assert.Empty(t, PromotionUsages(&transaction.Transaction{}))
+	})
+}
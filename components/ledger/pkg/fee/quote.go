@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+)
+
+// SendMatchesQuote reports whether send moves the same money as the send a fee
+// quote was computed for: same asset and value, and the same source and
+// destination legs in any order. Legs are compared on the fields that decide
+// what is posted (account, balance key, amount, share, remaining, route and
+// chart of accounts); aliases may be in their bare or indexed form and an
+// empty balance key is the default one. Leg rates, descriptions and metadata
+// are not compared, because a quoted transaction is posted with the quote's.
+func SendMatchesQuote(quoted, send transaction.Send) bool {
+	if quoted.Asset != send.Asset || !quoted.Value.Equal(send.Value) {
+		return false
+	}
+
+	return legsMatch(quoted.Source.From, send.Source.From) &&
+		legsMatch(quoted.Distribute.To, send.Distribute.To)
+}
+
+func legsMatch(quoted, legs []transaction.FromTo) bool {
+	if len(quoted) != len(legs) {
+		return false
+	}
+
+	want := make([]string, 0, len(quoted))
+	for _, leg := range quoted {
+		want = append(want, legFingerprint(leg))
+	}
+
+	got := make([]string, 0, len(legs))
+	for _, leg := range legs {
+		got = append(got, legFingerprint(leg))
+	}
+
+	slices.Sort(want)
+	slices.Sort(got)
+
+	return slices.Equal(want, got)
+}
+
+// legFingerprint renders the posting fields of a leg as a comparable string.
+func legFingerprint(leg transaction.FromTo) string {
+	balanceKey := leg.BalanceKey
+	if balanceKey == "" {
+		balanceKey = constant.DefaultBalanceKey
+	}
+
+	amount := ""
+	if leg.Amount != nil {
+		amount = leg.Amount.Asset + " " + leg.Amount.Value.String()
+	}
+
+	share := ""
+	if leg.Share != nil {
+		share = fmt.Sprintf("%d/%d", leg.Share.Percentage, leg.Share.PercentageOfPercentage)
+	}
+
+	routeID := ""
+	if leg.RouteID != nil {
+		routeID = *leg.RouteID
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s|%s",
+		leg.SplitAlias(), balanceKey, amount, share, leg.Remaining, routeID, leg.Route, leg.ChartOfAccounts) //nolint:staticcheck // legacy field kept for backward compatibility
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"testing"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func quotedSend() transaction.Send {
+	return transaction.Send{
+		Asset: "BRL",
+		Value: decimal.RequireFromString("100.00"),
+		Source: transaction.Source{From: []transaction.FromTo{
+			{AccountAlias: "@payer", Amount: &transaction.Amount{Asset: "BRL", Value: decimal.RequireFromString("100.00")}},
+		}},
+		Distribute: transaction.Distribute{To: []transaction.FromTo{
+			{AccountAlias: "@payee", Share: &transaction.Share{Percentage: 60}},
+			{AccountAlias: "@savings", Remaining: "remaining"},
+		}},
+	}
+}
+
+func TestSendMatchesQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(send *transaction.Send)
+		want   bool
+	}{
+		{
+			name:   "same send",
+			mutate: func(_ *transaction.Send) {},
+			want:   true,
+		},
+		{
+			name: "indexed aliases, default balance keys and reordered legs",
+			mutate: func(send *transaction.Send) {
+				send.Value = decimal.NewFromInt(100)
+				send.Source.From[0].AccountAlias = "0#@payer#default"
+				send.Source.From[0].BalanceKey = "default"
+				send.Source.From[0].IsFrom = true
+				send.Distribute.To[0], send.Distribute.To[1] = send.Distribute.To[1], send.Distribute.To[0]
+			},
+			want: true,
+		},
+		{
+			name: "leg rate and description are not compared",
+			mutate: func(send *transaction.Send) {
+				send.Source.From[0].Description = "changed"
+				send.Source.From[0].Rate = &transaction.Rate{From: "BRL", To: "USD"}
+			},
+			want: true,
+		},
+		{
+			name:   "different value",
+			mutate: func(send *transaction.Send) { send.Value = decimal.NewFromInt(101) },
+			want:   false,
+		},
+		{
+			name:   "different asset",
+			mutate: func(send *transaction.Send) { send.Asset = "USD" },
+			want:   false,
+		},
+		{
+			name:   "different destination",
+			mutate: func(send *transaction.Send) { send.Distribute.To[0].AccountAlias = "@someone_else" },
+			want:   false,
+		},
+		{
+			name:   "different share",
+			mutate: func(send *transaction.Send) { send.Distribute.To[0].Share = &transaction.Share{Percentage: 50} },
+			want:   false,
+		},
+		{
+			name:   "different balance key",
+			mutate: func(send *transaction.Send) { send.Source.From[0].BalanceKey = "asset-freeze" },
+			want:   false,
+		},
+		{
+			name: "extra leg",
+			mutate: func(send *transaction.Send) {
+				send.Distribute.To = append(send.Distribute.To, transaction.FromTo{AccountAlias: "@extra"})
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			send := quotedSend()
+			tt.mutate(&send)
+
+			assert.Equal(t, tt.want, SendMatchesQuote(quotedSend(), send))
+		})
+	}
+}
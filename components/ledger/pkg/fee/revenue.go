@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+)
+
+// MetadataFeeWaivers is the transaction metadata key listing the package fees
+// the engine priced but did not charge, with the amount and why.
+const MetadataFeeWaivers = "feeWaivers"
+
+// RevenueLine is the amount of one package fee charged, waived or refunded on a
+// transaction.
+type RevenueLine struct {
+	PackageID      string
+	PackageVersion int
+	FeeKey         string
+	// Kind is model.FeeRevenueCharged, model.FeeRevenueWaived or
+	// model.FeeRevenueRefunded.
+	Kind string
+	// Reason is the waiver reason of a waived line, empty otherwise.
+	Reason        string
+	Asset         string
+	CreditAccount string
+	Amount        decimal.Decimal
+}
+
+// RevenueLines returns what each package fee charged, waived or refunded on a
+// posted transaction, one line per fee, kind and reason.
+//
+// On a charge, each fee's credit legs are its revenue and the feeWaivers
+// metadata what it did not collect. On a revert (as adjusted by
+// ApplyReversalPolicy) the fee legs debiting the credit accounts are refunds and
+// reversal fee legs are charges; waivers are not read, since the revert carries
+// its parent's metadata.
+func RevenueLines(t *transaction.Transaction, revert bool) []RevenueLine {
+	if t == nil {
+		return nil
+	}
+
+	lines := make(map[string]*RevenueLine)
+	order := make([]string, 0)
+
+	add := func(line RevenueLine) {
+		if !line.Amount.IsPositive() {
+			return
+		}
+
+		key := line.Kind + "|" + line.PackageID + "|" + line.FeeKey + "|" + line.Reason + "|" + line.Asset
+		if existing, ok := lines[key]; ok {
+			existing.Amount = existing.Amount.Add(line.Amount)
+
+			return
+		}
+
+		lines[key] = &line
+		order = append(order, key)
+	}
+
+	if revert {
+		for _, leg := range t.Send.Source.From {
+			if line, ok := legRevenueLine(leg, model.FeeRevenueRefunded); ok {
+				add(line)
+			}
+		}
+	}
+
+	for _, leg := range t.Send.Distribute.To {
+		if revert {
+			if reversal, _ := leg.Metadata[MetadataFeeReversal].(string); reversal != FeeReversalCharged {
+				continue
+			}
+		}
+
+		if line, ok := legRevenueLine(leg, model.FeeRevenueCharged); ok {
+			add(line)
+		}
+	}
+
+	if !revert {
+		for _, waiver := range feeWaivers(t.Metadata) {
+			add(waiverRevenueLine(waiver))
+		}
+	}
+
+	sort.Strings(order)
+
+	out := make([]RevenueLine, 0, len(order))
+	for _, key := range order {
+		out = append(out, *lines[key])
+	}
+
+	return out
+}
+
+// legRevenueLine returns the revenue line of a fee leg, or false for a leg no
+// package fee produced.
+func legRevenueLine(leg transaction.FromTo, kind string) (RevenueLine, bool) {
+	packageID, feeKey, ok := feeLegOrigin(leg)
+	if !ok {
+		return RevenueLine{}, false
+	}
+
+	return RevenueLine{
+		PackageID:      packageID,
+		PackageVersion: MetadataInt(leg.Metadata[MetadataPackageVersion]),
+		FeeKey:         feeKey,
+		Kind:           kind,
+		Asset:          leg.Amount.Asset,
+		CreditAccount:  transaction.SplitAlias(leg.AccountAlias),
+		Amount:         leg.Amount.Value,
+	}, true
+}
+
+// waiverRevenueLine returns the revenue line of a feeWaivers entry.
+func waiverRevenueLine(waiver map[string]any) RevenueLine {
+	packageID, _ := waiver["packageId"].(string)
+	feeKey, _ := waiver["feeKey"].(string)
+	reason, _ := waiver["reason"].(string)
+	asset, _ := waiver["asset"].(string)
+	raw, _ := waiver["amount"].(string)
+
+	amount, err := decimal.NewFromString(raw)
+	if err != nil {
+		amount = decimal.Zero
+	}
+
+	return RevenueLine{
+		PackageID:      packageID,
+		PackageVersion: MetadataInt(waiver["packageVersion"]),
+		FeeKey:         feeKey,
+		Kind:           model.FeeRevenueWaived,
+		Reason:         reason,
+		Asset:          asset,
+		Amount:         amount,
+	}
+}
+
+// recordFeeWaiver adds amount to the feeWaivers entry of the package fee and
+// reason, creating it on first use.
+func recordFeeWaiver(t *transaction.Transaction, packageID string, packageVersion int, feeKey, reason string, amount transaction.Amount) {
+	if !amount.Value.IsPositive() {
+		return
+	}
+
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]any)
+	}
+
+	waivers := feeWaivers(t.Metadata)
+
+	for _, waiver := range waivers {
+		if waiver["packageId"] == packageID && waiver["feeKey"] == feeKey && waiver["reason"] == reason {
+			raw, _ := waiver["amount"].(string)
+			current, _ := decimal.NewFromString(raw)
+			waiver["amount"] = current.Add(amount.Value).String()
+
+			t.Metadata[MetadataFeeWaivers] = waivers
+
+			return
+		}
+	}
+
+	waivers = append(waivers, map[string]any{
+		"packageId":      packageID,
+		"packageVersion": packageVersion,
+		"feeKey":         feeKey,
+		"reason":         reason,
+		"asset":          amount.Asset,
+		"amount":         amount.Value.String(),
+	})
+
+	t.Metadata[MetadataFeeWaivers] = waivers
+}
+
+// feeWaivers reads the feeWaivers metadata. The engine writes a slice of maps;
+// metadata that went through a JSON or Mongo round trip comes back as a slice
+// of any, so both are accepted.
+func feeWaivers(metadata map[string]any) []map[string]any {
+	switch v := metadata[MetadataFeeWaivers].(type) {
+	case []map[string]any:
+		return v
+	case []any:
+		waivers := make([]map[string]any, 0, len(v))
+
+		for _, item := range v {
+			if waiver, ok := item.(map[string]any); ok {
+				waivers = append(waivers, waiver)
+			}
+		}
+
+		return waivers
+	default:
+		return nil
+	}
+}
+
+// MetadataInt reads an integer metadata value written as an int and possibly
+// read back as a float64, a sized int or a string. Anything else, such as the
+// version of a fee charged before packages were versioned, is 0.
+func MetadataInt(raw any) int {
+	switch v := raw.(type) {
+	case int:
+		return v
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0
+		}
+
+		return n
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"encoding/json"
+	"testing"
+
+	libZap "github.com/LerianStudio/lib-observability/zap"
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func revenueAmounts(lines []RevenueLine) map[string]string {
+	amounts := make(map[string]string)
+
+	for _, line := range lines {
+		amounts[line.Kind+"/"+line.FeeKey+"/"+line.Reason] = line.Amount.String()
+	}
+
+	return amounts
+}
+
+func TestRevenueLines_Charged(t *testing.T) {
+	t.Parallel()
+
+	for _, deductible := range []bool{false, true} {
+		tx, packageID := chargedTransfer(t, deductible)
+
+		lines := RevenueLines(tx, false)
+		require.Len(t, lines, 1)
+
+		assert.Equal(t, model.FeeRevenueCharged, lines[0].Kind)
+		assert.Equal(t, packageID, lines[0].PackageID)
+		assert.Equal(t, "service", lines[0].FeeKey)
+		assert.Equal(t, "BRL", lines[0].Asset)
+		assert.Equal(t, "@fee_account", lines[0].CreditAccount)
+		assert.True(t, lines[0].Amount.Equal(decimal.NewFromInt(10)), "deductible=%v: %s", deductible, lines[0].Amount)
+	}
+}
+
+func TestRevenueLines_PromotionWaiver(t *testing.T) {
+	t.Parallel()
+
+	tx, packageID := chargedTransfer(t, false)
+	payers := FeePayers(tx, packageID)
+	require.Len(t, payers, 1)
+
+	WaivePayerFees(tx, packageID, payers[0].Key, decimal.NewFromInt(4))
+
+	assert.Equal(t, map[string]string{
+		model.FeeRevenueCharged + "/service/":                           "6",
+		model.FeeRevenueWaived + "/service/" + model.FeeWaiverPromotion: "4",
+	}, revenueAmounts(RevenueLines(tx, false)))
+}
+
+func TestRevenueLines_ExemptPayer(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	deductible := false
+	p := &pack.Package{
+		ID:             uuid.New(),
+		WaivedAccounts: &[]string{"@payer"},
+		Fees: map[string]model.Fee{"service": {
+			FeeLabel: "service",
+			CalculationModel: &model.CalculationModel{
+				ApplicationRule: feeconstant.AppRuleFlatFee,
+				Calculations:    []model.Calculation{{Type: feeconstant.FeeTypeFlat, Value: "10"}},
+			},
+			ReferenceAmount:  "originalAmount",
+			Priority:         1,
+			IsDeductibleFrom: &deductible,
+			CreditAccount:    "@fee_account",
+		}},
+	}
+
+	feeCalc := &model.FeeCalculate{Transaction: transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(1000),
+	}}}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@payer": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		To:   map[string]transaction.Amount{"@payee": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+	}
+
+	require.NoError(t, CalculateFee(logger, feeCalc, p, resp, "BRL", nil))
+
+	assert.Equal(t, map[string]string{
+		model.FeeRevenueWaived + "/service/" + model.FeeWaiverExemption: "10",
+	}, revenueAmounts(RevenueLines(&feeCalc.Transaction, false)))
+}
+
+func TestRevenueLines_WaiversSurviveJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tx, packageID := chargedTransfer(t, true)
+	payers := FeePayers(tx, packageID)
+	require.Len(t, payers, 1)
+
+	WaivePayerFees(tx, packageID, payers[0].Key, decimal.NewFromInt(10))
+
+	raw, err := json.Marshal(tx)
+	require.NoError(t, err)
+
+	var decoded transaction.Transaction
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+
+	assert.Equal(t, map[string]string{
+		model.FeeRevenueWaived + "/service/" + model.FeeWaiverPromotion: "10",
+	}, revenueAmounts(RevenueLines(&decoded, false)))
+}
+
+func TestRevenueLines_Revert(t *testing.T) {
+	t.Parallel()
+
+	revert := nonDeductibleRevert()
+	revert.Metadata = map[string]any{MetadataFeeWaivers: []any{map[string]any{
+		"packageId": reversalTestPackageID, "feeKey": "processingFee", "reason": model.FeeWaiverPromotion, "asset": "BRL", "amount": "3",
+	}}}
+
+	reversalFee := "2"
+	reversalAccount := "@reversal_fees"
+
+	policies := map[string]*model.ReversalPolicy{reversalTestPackageID: {
+		Mode:                     model.ReversalChargeFee,
+		ReversalFee:              &reversalFee,
+		ReversalFeeCreditAccount: &reversalAccount,
+	}}
+	require.NoError(t, ApplyReversalPolicy(&revert, policies))
+
+	assert.Equal(t, map[string]string{
+		model.FeeRevenueRefunded + "/processingFee/": "10",
+		model.FeeRevenueRefunded + "/fxFee/":         "5",
+		model.FeeRevenueCharged + "/reversalFee/":    "2",
+	}, revenueAmounts(RevenueLines(&revert, true)), "a revert carries its parent's waivers, which must not count twice")
+}
+
+func TestRevenueLines_NoFees(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, RevenueLines(nil, false))
+	assert.Empty(t, RevenueLines(&transaction.Transaction{Send: transaction.Send{
+		Distribute: transaction.Distribute{To: []transaction.FromTo{reversalLeg("@payee", 1000, "")}},
+	}}, false))
+}
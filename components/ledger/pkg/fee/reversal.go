@@ -0,0 +1,244 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"sort"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MetadataFeeReversal is the revert-leg metadata key recording what the
+	// package reversal policy did with the fee leg.
+	MetadataFeeReversal = "feeReversal"
+
+	// FeeReversalRefunded labels a fee leg returned in full to its payer.
+	FeeReversalRefunded = "refunded"
+
+	// FeeReversalPartiallyRefunded labels a fee leg of which only part is
+	// returned; the rest is kept by the fee's credit account.
+	FeeReversalPartiallyRefunded = "partiallyRefunded"
+
+	// FeeReversalCharged labels the leg crediting a reversal fee.
+	FeeReversalCharged = "reversalFee"
+
+	// ReversalFeeKey is the feeKey stamped on reversal fee legs.
+	ReversalFeeKey = "reversalFee"
+)
+
+// ApplyReversalPolicy applies the reversal policy of every package that charged
+// fees on a transaction to its revert, as built by TransactionRevert from the
+// persisted operations. Fee legs are recognized by the package and fee keys
+// stamped on them when they were charged.
+//
+// Kept fees are removed from both sides of the revert: the fee's credit account
+// returns only what is refunded, and the payer gets back correspondingly less.
+// A reversal fee is carved out of the payer's refund and credited to the
+// policy's account. Packages missing from policies refund every fee.
+func ApplyReversalPolicy(revert *transaction.Transaction, policies map[string]*model.ReversalPolicy) error {
+	send := &revert.Send
+
+	kept := make(map[string]decimal.Decimal)
+	keptTotal := decimal.Zero
+	charging := make(map[string]struct{})
+
+	from := make([]transaction.FromTo, 0, len(send.Source.From))
+
+	for _, leg := range send.Source.From {
+		packageID, feeKey, ok := feeLegOrigin(leg)
+		if !ok {
+			from = append(from, leg)
+
+			continue
+		}
+
+		policy := policies[packageID]
+		if policy != nil && policy.Mode == model.ReversalChargeFee {
+			charging[packageID] = struct{}{}
+		}
+
+		keep := keptFeeAmount(policy, feeKey, leg.Amount.Value)
+		if keep.IsPositive() {
+			kept[packageID+"/"+feeKey] = kept[packageID+"/"+feeKey].Add(keep)
+			keptTotal = keptTotal.Add(keep)
+		}
+
+		if refund := leg.Amount.Value.Sub(keep); refund.IsPositive() {
+			from = append(from, relabelFeeLeg(leg, refund, keep.IsPositive()))
+		}
+	}
+
+	to := make([]transaction.FromTo, 0, len(send.Distribute.To)+len(charging))
+	residual := decimal.Zero
+
+	// The payer's own fee legs shrink first; whatever a fee leg cannot absorb
+	// (a deductible fee has no payer fee leg) comes off the principal refund.
+	for _, leg := range send.Distribute.To {
+		packageID, feeKey, ok := feeLegOrigin(leg)
+		if !ok {
+			to = append(to, leg)
+
+			continue
+		}
+
+		origin := packageID + "/" + feeKey
+		reduce := decimal.Min(kept[origin], leg.Amount.Value)
+		kept[origin] = kept[origin].Sub(reduce)
+
+		if refund := leg.Amount.Value.Sub(reduce); refund.IsPositive() {
+			to = append(to, relabelFeeLeg(leg, refund, reduce.IsPositive()))
+		}
+	}
+
+	for _, remaining := range kept {
+		residual = residual.Add(remaining)
+	}
+
+	var ok bool
+
+	if to, ok = deductFromRefunds(to, residual); !ok {
+		return pkg.ValidateBusinessError(constant.ErrTransactionCantRevert, "RevertTransaction")
+	}
+
+	send.Value = send.Value.Sub(keptTotal)
+
+	chargingIDs := make([]string, 0, len(charging))
+	for packageID := range charging {
+		chargingIDs = append(chargingIDs, packageID)
+	}
+
+	sort.Strings(chargingIDs)
+
+	for _, packageID := range chargingIDs {
+		policy := policies[packageID]
+
+		amount, err := decimal.NewFromString(*policy.ReversalFee)
+		if err != nil {
+			return pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", "reversalFee")
+		}
+
+		if to, ok = deductFromRefunds(to, amount); !ok {
+			return pkg.ValidateBusinessError(constant.ErrReversalFeeExceedsRefund, "RevertTransaction")
+		}
+
+		to = append(to, transaction.FromTo{
+			AccountAlias: policy.GetReversalFeeCreditAccount(),
+			BalanceKey:   constant.DefaultBalanceKey,
+			Amount:       &transaction.Amount{Asset: send.Asset, Value: amount},
+			Description:  "Reversal fee",
+			Metadata: map[string]any{
+				MetadataPackageID:   packageID,
+				MetadataFeeKey:      ReversalFeeKey,
+				MetadataFeeReversal: FeeReversalCharged,
+			},
+		})
+	}
+
+	send.Source.From = from
+	send.Distribute.To = to
+
+	return nil
+}
+
+// feeLegOrigin returns the package and fee that produced a fee leg.
+func feeLegOrigin(leg transaction.FromTo) (packageID, feeKey string, ok bool) {
+	if leg.Amount == nil {
+		return "", "", false
+	}
+
+	packageID, _ = leg.Metadata[MetadataPackageID].(string)
+	feeKey, _ = leg.Metadata[MetadataFeeKey].(string)
+
+	return packageID, feeKey, packageID != "" && feeKey != ""
+}
+
+// keptFeeAmount returns how much of a fee leg the policy keeps on revert.
+func keptFeeAmount(policy *model.ReversalPolicy, feeKey string, amount decimal.Decimal) decimal.Decimal {
+	if policy == nil {
+		return decimal.Zero
+	}
+
+	if policy.IsNonRefundable(feeKey) {
+		return amount
+	}
+
+	if policy.Mode == model.ReversalKeepFees {
+		return amount
+	}
+
+	return decimal.Zero
+}
+
+// relabelFeeLeg returns a copy of a fee leg carrying the refunded amount and
+// the label of what the reversal policy did with it.
+func relabelFeeLeg(leg transaction.FromTo, refund decimal.Decimal, partial bool) transaction.FromTo {
+	metadata := make(map[string]any, len(leg.Metadata)+1)
+	for k, v := range leg.Metadata {
+		metadata[k] = v
+	}
+
+	metadata[MetadataFeeReversal] = FeeReversalRefunded
+	if partial {
+		metadata[MetadataFeeReversal] = FeeReversalPartiallyRefunded
+	}
+
+	leg.Amount = &transaction.Amount{Asset: leg.Amount.Asset, Value: refund}
+	leg.Metadata = metadata
+
+	return leg
+}
+
+// deductFromRefunds takes amount off the principal refund legs, largest first,
+// dropping legs that reach zero. It reports false when the refund legs do not
+// cover amount.
+func deductFromRefunds(to []transaction.FromTo, amount decimal.Decimal) ([]transaction.FromTo, bool) {
+	if !amount.IsPositive() {
+		return to, true
+	}
+
+	order := make([]int, 0, len(to))
+
+	for i, leg := range to {
+		if _, _, isFee := feeLegOrigin(leg); !isFee && leg.Amount != nil {
+			order = append(order, i)
+		}
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return to[order[a]].Amount.Value.GreaterThan(to[order[b]].Amount.Value)
+	})
+
+	remaining := amount
+
+	for _, i := range order {
+		if remaining.IsZero() {
+			break
+		}
+
+		reduce := decimal.Min(remaining, to[i].Amount.Value)
+		to[i].Amount = &transaction.Amount{Asset: to[i].Amount.Asset, Value: to[i].Amount.Value.Sub(reduce)}
+		remaining = remaining.Sub(reduce)
+	}
+
+	if remaining.IsPositive() {
+		return to, false
+	}
+
+	result := to[:0]
+
+	for _, leg := range to {
+		if leg.Amount == nil || leg.Amount.Value.IsPositive() {
+			result = append(result, leg)
+		}
+	}
+
+	return result, true
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reversalTestPackageID = "0190b6a2-0000-7000-8000-000000000001"
+
+func reversalLeg(alias string, value int64, feeKey string) transaction.FromTo {
+	leg := transaction.FromTo{
+		AccountAlias: alias,
+		Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(value)},
+	}
+
+	if feeKey != "" {
+		leg.Metadata = map[string]any{MetadataPackageID: reversalTestPackageID, MetadataFeeKey: feeKey}
+	}
+
+	return leg
+}
+
+// nonDeductibleRevert is the revert of a 1000 transfer on which the payer paid a
+// 10 processing fee and a 5 FX fee on top of the principal.
+func nonDeductibleRevert() transaction.Transaction {
+	return transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(1015),
+		Source: transaction.Source{From: []transaction.FromTo{
+			reversalLeg("@payee", 1000, ""),
+			reversalLeg("@revenue", 10, "processingFee"),
+			reversalLeg("@fx_revenue", 5, "fxFee"),
+		}},
+		Distribute: transaction.Distribute{To: []transaction.FromTo{
+			reversalLeg("@payer", 1000, ""),
+			reversalLeg("@payer", 10, "processingFee"),
+			reversalLeg("@payer", 5, "fxFee"),
+		}},
+	}}
+}
+
+// deductibleRevert is the revert of a 1000 transfer from which a 10 fee was
+// deducted from the payee.
+func deductibleRevert() transaction.Transaction {
+	return transaction.Transaction{Send: transaction.Send{
+		Asset: "BRL",
+		Value: decimal.NewFromInt(1000),
+		Source: transaction.Source{From: []transaction.FromTo{
+			reversalLeg("@payee", 990, ""),
+			reversalLeg("@revenue", 10, "processingFee"),
+		}},
+		Distribute: transaction.Distribute{To: []transaction.FromTo{
+			reversalLeg("@payer", 1000, ""),
+		}},
+	}}
+}
+
+func legAmounts(legs []transaction.FromTo) map[string]string {
+	amounts := make(map[string]string)
+
+	for _, leg := range legs {
+		key := leg.AccountAlias
+		if feeKey, ok := leg.Metadata[MetadataFeeKey].(string); ok {
+			key += "/" + feeKey
+		}
+
+		amounts[key] = leg.Amount.Value.String()
+	}
+
+	return amounts
+}
+
+func sumLegs(legs []transaction.FromTo) decimal.Decimal {
+	total := decimal.Zero
+	for _, leg := range legs {
+		total = total.Add(leg.Amount.Value)
+	}
+
+	return total
+}
+
+func TestApplyReversalPolicy(t *testing.T) {
+	t.Parallel()
+
+	reversalFee := "2"
+	tooHighReversalFee := "2000"
+	reversalAccount := "@reversal_fees"
+
+	tests := []struct {
+		name       string
+		revert     func() transaction.Transaction
+		policy     *model.ReversalPolicy
+		wantValue  string
+		wantFrom   map[string]string
+		wantTo     map[string]string
+		wantLabels map[string]string
+		wantErr    error
+	}{
+		{
+			name:      "no policy refunds every fee",
+			revert:    nonDeductibleRevert,
+			wantValue: "1015",
+			wantFrom:  map[string]string{"@payee": "1000", "@revenue/processingFee": "10", "@fx_revenue/fxFee": "5"},
+			wantTo:    map[string]string{"@payer": "1000", "@payer/processingFee": "10", "@payer/fxFee": "5"},
+			wantLabels: map[string]string{
+				"@revenue/processingFee": FeeReversalRefunded,
+				"@fx_revenue/fxFee":      FeeReversalRefunded,
+			},
+		},
+		{
+			name:      "non-refundable fee is kept while the others are refunded",
+			revert:    nonDeductibleRevert,
+			policy:    &model.ReversalPolicy{Mode: model.ReversalRefundAll, NonRefundableFees: []string{"processingFee"}},
+			wantValue: "1005",
+			wantFrom:  map[string]string{"@payee": "1000", "@fx_revenue/fxFee": "5"},
+			wantTo:    map[string]string{"@payer": "1000", "@payer/fxFee": "5"},
+			wantLabels: map[string]string{
+				"@fx_revenue/fxFee": FeeReversalRefunded,
+			},
+		},
+		{
+			name:      "keepFees returns the principal only",
+			revert:    nonDeductibleRevert,
+			policy:    &model.ReversalPolicy{Mode: model.ReversalKeepFees},
+			wantValue: "1000",
+			wantFrom:  map[string]string{"@payee": "1000"},
+			wantTo:    map[string]string{"@payer": "1000"},
+		},
+		{
+			name:      "kept deductible fee comes off the principal refund",
+			revert:    deductibleRevert,
+			policy:    &model.ReversalPolicy{Mode: model.ReversalKeepFees},
+			wantValue: "990",
+			wantFrom:  map[string]string{"@payee": "990"},
+			wantTo:    map[string]string{"@payer": "990"},
+		},
+		{
+			name:      "reversal fee is carved out of the payer refund",
+			revert:    nonDeductibleRevert,
+			policy:    &model.ReversalPolicy{Mode: model.ReversalChargeFee, ReversalFee: &reversalFee, ReversalFeeCreditAccount: &reversalAccount},
+			wantValue: "1015",
+			wantFrom:  map[string]string{"@payee": "1000", "@revenue/processingFee": "10", "@fx_revenue/fxFee": "5"},
+			wantTo: map[string]string{
+				"@payer": "998", "@payer/processingFee": "10", "@payer/fxFee": "5",
+				"@reversal_fees/" + ReversalFeeKey: "2",
+			},
+			wantLabels: map[string]string{
+				"@revenue/processingFee": FeeReversalRefunded,
+				"@fx_revenue/fxFee":      FeeReversalRefunded,
+			},
+		},
+		{
+			name:    "reversal fee greater than the refund is rejected",
+			revert:  nonDeductibleRevert,
+			policy:  &model.ReversalPolicy{Mode: model.ReversalChargeFee, ReversalFee: &tooHighReversalFee, ReversalFeeCreditAccount: &reversalAccount},
+			wantErr: constant.ErrReversalFeeExceedsRefund,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			revert := tt.revert()
+			policies := map[string]*model.ReversalPolicy{reversalTestPackageID: tt.policy}
+
+			err := ApplyReversalPolicy(&revert, policies)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, "RevertTransaction"), err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.True(t, revert.Send.Value.Equal(decimal.RequireFromString(tt.wantValue)), "value %s", revert.Send.Value)
+			assert.Equal(t, tt.wantFrom, legAmounts(revert.Send.Source.From))
+			assert.Equal(t, tt.wantTo, legAmounts(revert.Send.Distribute.To))
+
+			// Both sides still balance against the revert value.
+			assert.True(t, sumLegs(revert.Send.Source.From).Equal(revert.Send.Value))
+			assert.True(t, sumLegs(revert.Send.Distribute.To).Equal(revert.Send.Value))
+
+			for _, leg := range revert.Send.Source.From {
+				feeKey, isFee := leg.Metadata[MetadataFeeKey].(string)
+				if !isFee {
+					continue
+				}
+
+				assert.Equal(t, tt.wantLabels[leg.AccountAlias+"/"+feeKey], leg.Metadata[MetadataFeeReversal])
+			}
+		})
+	}
+}
+
+// TestApplyReversalPolicy_DoesNotMutateSourceMetadata checks that labelling a
+// revert leg leaves the metadata map it was built from untouched, since that
+// map is shared with the reverted transaction's operations.
+func TestApplyReversalPolicy_DoesNotMutateSourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	revert := nonDeductibleRevert()
+	original := revert.Send.Source.From[1].Metadata
+
+	require.NoError(t, ApplyReversalPolicy(&revert, map[string]*model.ReversalPolicy{}))
+
+	assert.NotContains(t, original, MetadataFeeReversal)
+	assert.Equal(t, FeeReversalRefunded, revert.Send.Source.From[1].Metadata[MetadataFeeReversal])
+}
@@ -13,6 +13,7 @@ import (
 	feeshared "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -67,6 +68,22 @@ func (m *mockSegmentResolver) CountTransactionsByRoute(
 	return 0, nil
 }
 
+func (m *mockSegmentResolver) GetAssetRate(
+	_ context.Context,
+	_, _ uuid.UUID,
+	_, _ string,
+) (*decimal.Decimal, error) {
+	return nil, nil
+}
+
+func (m *mockSegmentResolver) AvailableBalance(
+	_ context.Context,
+	_, _ uuid.UUID,
+	_, _ string,
+) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
 // Compile-time assertion: mockSegmentResolver implements feeshared.MidazResolver.
 var _ feeshared.MidazResolver = (*mockSegmentResolver)(nil)
 
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MetadataSettlementAsset is the credit fee-leg metadata key holding the
+	// asset the fee is to be settled in outside the ledger.
+	MetadataSettlementAsset = "settlementAsset"
+
+	// MetadataSettlementAmount is the credit fee-leg metadata key holding the
+	// fee's equivalent in its settlement asset.
+	MetadataSettlementAmount = "settlementAmount"
+
+	// MetadataSettlementRate is the credit fee-leg metadata key holding the
+	// rate the equivalent was computed with.
+	MetadataSettlementRate = "settlementRate"
+)
+
+// FeeSettlements returns, by fee key, the settlement asset of every fee of the
+// package version in force for f that settles in an asset other than feeAsset,
+// the asset its legs are charged in.
+func FeeSettlements(f *model.FeeCalculate, p *pack.Package, feeAsset string) map[string]string {
+	p = p.AsOf(EffectiveDate(f))
+	if p == nil {
+		return nil
+	}
+
+	settlements := make(map[string]string)
+
+	for key, fee := range p.Fees {
+		if asset := fee.GetSettlementAsset(); asset != "" && asset != feeAsset {
+			settlements[key] = asset
+		}
+	}
+
+	return settlements
+}
+
+// AnnotateFeeSettlement annotates the credit fee legs the package charged on t
+// with the equivalent of each fee in its settlement asset and the rate used,
+// taken from rates by settlement asset. Nothing is converted or posted: the
+// legs are charged and posted in the asset they were charged in, and the
+// annotation only tells whoever settles the fee outside the ledger how much it
+// is worth in the settlement asset.
+func AnnotateFeeSettlement(t *transaction.Transaction, packageID string, settlements map[string]string, rates map[string]decimal.Decimal) {
+	for i, leg := range t.Send.Distribute.To {
+		legPackageID, feeKey, ok := feeLegOrigin(leg)
+		if !ok || legPackageID != packageID {
+			continue
+		}
+
+		asset, ok := settlements[feeKey]
+		if !ok {
+			continue
+		}
+
+		rate, ok := rates[asset]
+		if !ok {
+			continue
+		}
+
+		if leg.Metadata == nil {
+			leg.Metadata = make(map[string]any)
+		}
+
+		leg.Metadata[MetadataSettlementAsset] = asset
+		leg.Metadata[MetadataSettlementAmount] = leg.Amount.Value.Mul(rate).String()
+		leg.Metadata[MetadataSettlementRate] = rate.String()
+
+		t.Send.Distribute.To[i] = leg
+	}
+}
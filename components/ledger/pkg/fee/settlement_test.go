@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	libZap "github.com/LerianStudio/lib-observability/zap"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// multiCurrencyPackage prices a flat "service" fee of 10, or of the value set
+// for the transaction asset in assetValues, settled in settlementAsset.
+func multiCurrencyPackage(assetValues map[string]string, settlementAsset *string) *pack.Package {
+	deductible := false
+
+	return &pack.Package{
+		ID:             uuid.New(),
+		WaivedAccounts: &[]string{},
+		Fees: map[string]model.Fee{"service": {
+			FeeLabel: "service",
+			CalculationModel: &model.CalculationModel{
+				ApplicationRule: feeconstant.AppRuleFlatFee,
+				Calculations:    []model.Calculation{{Type: feeconstant.FeeTypeFlat, Value: "10", AssetValues: assetValues}},
+			},
+			ReferenceAmount:  "originalAmount",
+			Priority:         1,
+			IsDeductibleFrom: &deductible,
+			CreditAccount:    "@fee_account",
+			SettlementAsset:  settlementAsset,
+		}},
+	}
+}
+
+func chargeIn(t *testing.T, p *pack.Package, asset string) *model.FeeCalculate {
+	t.Helper()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	feeCalc := &model.FeeCalculate{Transaction: transaction.Transaction{Send: transaction.Send{
+		Asset: asset,
+		Value: decimal.NewFromInt(1000),
+	}}}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@payer": {Asset: asset, Value: decimal.NewFromInt(1000)}},
+		To:   map[string]transaction.Amount{"@payee": {Asset: asset, Value: decimal.NewFromInt(1000)}},
+	}
+
+	require.NoError(t, CalculateFee(logger, feeCalc, p, resp, "BRL", nil))
+
+	return feeCalc
+}
+
+func feeCredit(t *testing.T, tx *transaction.Transaction) transaction.FromTo {
+	t.Helper()
+
+	for _, leg := range tx.Send.Distribute.To {
+		if _, _, ok := feeLegOrigin(leg); ok {
+			return leg
+		}
+	}
+
+	require.Fail(t, "no fee credit leg")
+
+	return transaction.FromTo{}
+}
+
+func TestCalculateFee_AssetValues(t *testing.T) {
+	t.Parallel()
+
+	p := multiCurrencyPackage(map[string]string{"USD": "2"}, nil)
+
+	usd := feeCredit(t, &chargeIn(t, p, "USD").Transaction)
+	assert.Equal(t, "USD", usd.Amount.Asset)
+	assert.True(t, usd.Amount.Value.Equal(decimal.NewFromInt(2)), "the value set for the asset is charged")
+
+	brl := feeCredit(t, &chargeIn(t, p, "BRL").Transaction)
+	assert.Equal(t, "BRL", brl.Amount.Asset)
+	assert.True(t, brl.Amount.Value.Equal(decimal.NewFromInt(10)), "assets without a value of their own fall back to value")
+}
+
+func TestFeeSettlements(t *testing.T) {
+	t.Parallel()
+
+	eur := "EUR"
+	brl := "BRL"
+
+	feeCalc := &model.FeeCalculate{}
+
+	assert.Equal(t, map[string]string{"service": "EUR"}, FeeSettlements(feeCalc, multiCurrencyPackage(nil, &eur), "BRL"))
+	assert.Empty(t, FeeSettlements(feeCalc, multiCurrencyPackage(nil, &brl), "BRL"), "settling in the charged asset needs no annotation")
+	assert.Empty(t, FeeSettlements(feeCalc, multiCurrencyPackage(nil, nil), "BRL"))
+}
+
+func TestAnnotateFeeSettlement(t *testing.T) {
+	t.Parallel()
+
+	eur := "EUR"
+	p := multiCurrencyPackage(nil, &eur)
+	feeCalc := chargeIn(t, p, "BRL")
+	tx := &feeCalc.Transaction
+
+	settlements := FeeSettlements(feeCalc, p, "BRL")
+
+	AnnotateFeeSettlement(tx, uuid.NewString(), settlements, map[string]decimal.Decimal{"EUR": decimal.RequireFromString("0.2")})
+	assert.NotContains(t, feeCredit(t, tx).Metadata, MetadataSettlementAsset, "legs of other packages are ignored")
+
+	AnnotateFeeSettlement(tx, p.ID.String(), settlements, map[string]decimal.Decimal{"EUR": decimal.RequireFromString("0.2")})
+
+	leg := feeCredit(t, tx)
+	assert.Equal(t, "BRL", leg.Amount.Asset, "the leg keeps the asset it was charged in")
+	assert.True(t, leg.Amount.Value.Equal(decimal.NewFromInt(10)))
+	assert.Equal(t, "EUR", leg.Metadata[MetadataSettlementAsset])
+	assert.Equal(t, "2", leg.Metadata[MetadataSettlementAmount])
+	assert.Equal(t, "0.2", leg.Metadata[MetadataSettlementRate])
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+)
+
+const (
+	// MetadataPackageID is the fee-leg operation metadata key carrying the ID of
+	// the package that produced the leg.
+	MetadataPackageID = "packageId"
+
+	// MetadataPackageVersion is the fee-leg operation metadata key carrying the
+	// package version whose schedule priced the leg.
+	MetadataPackageVersion = "packageVersion"
+
+	// MetadataFeeKey is the fee-leg operation metadata key carrying the package
+	// fee that produced the leg.
+	MetadataFeeKey = "feeKey"
+
+	// MetadataBaseFee is the fee-leg operation metadata key carrying, for a fee
+	// calculated on another fee, the key of that base fee.
+	MetadataBaseFee = "baseFee"
+)
+
+// EffectiveDate returns the instant whose fee schedule prices the transaction:
+// its transactionDate when one is set, the current time otherwise. Replaying a
+// back-dated transaction therefore selects the package version that was in
+// force at that date, not the one in force today.
+func EffectiveDate(f *model.FeeCalculate) time.Time {
+	if f != nil && f.Transaction.TransactionDate != nil {
+		if t := time.Time(*f.Transaction.TransactionDate); !t.IsZero() {
+			return t
+		}
+	}
+
+	return time.Now()
+}
+
+// ResolvePackagesAt resolves every package to the version in force at t and
+// drops packages that have no version in force. Candidates must be resolved
+// before FindPackageToCalculateFee because the amount range it filters on is
+// itself versioned.
+func ResolvePackagesAt(packages []*pack.Package, t time.Time) []*pack.Package {
+	resolved := make([]*pack.Package, 0, len(packages))
+
+	for _, p := range packages {
+		if p == nil {
+			continue
+		}
+
+		if asOf := p.AsOf(t); asOf != nil {
+			resolved = append(resolved, asOf)
+		}
+	}
+
+	return resolved
+}
+
+// feeLegIndex reports whether a response key denotes a leg the fee engine
+// appended ("<account>->fee<N>->..." or "<credit>->fee_source<N>->...") rather
+// than one of the transaction's own legs, and returns the N of the fee (its
+// position in priority order) that produced it.
+func feeLegIndex(key string) (int, bool) {
+	parts := strings.Split(key, "->")
+	if len(parts) < 2 {
+		return 0, false
+	}
+
+	marker := parts[1]
+
+	for _, prefix := range []string{"fee_source", "fee"} {
+		if idx, ok := strings.CutPrefix(marker, prefix); ok {
+			if n, err := strconv.Atoi(idx); err == nil {
+				return n, true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package fee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/ledger/internal/adapters/mongodb/fees/pack"
+	feeconstant "github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/constant"
+	"github.com/LerianStudio/midaz/v4/components/ledger/pkg/feeshared/model"
+
+	libZap "github.com/LerianStudio/lib-observability/zap"
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func flatFeeSchedule(value string) map[string]model.Fee {
+	isDeductible := false
+
+	return map[string]model.Fee{"service": {
+		FeeLabel: "service",
+		CalculationModel: &model.CalculationModel{
+			ApplicationRule: feeconstant.AppRuleFlatFee,
+			Calculations:    []model.Calculation{{Type: feeconstant.FeeTypeFlat, Value: value}},
+		},
+		ReferenceAmount:  "originalAmount",
+		Priority:         1,
+		IsDeductibleFrom: &isDeductible,
+		CreditAccount:    "@fee_account",
+	}}
+}
+
+// TestCalculateFee_SelectsVersionAtTransactionDate checks that a back-dated
+// transaction is priced with the schedule in force at its transactionDate and
+// that the resulting fee legs record which package version applied.
+func TestCalculateFee_SelectsVersionAtTransactionDate(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := libZap.New(libZap.Config{Environment: libZap.EnvironmentLocal, OTelLibraryName: "test"})
+
+	switchover := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	packID := uuid.New()
+	p := &pack.Package{
+		ID:             packID,
+		Version:        2,
+		WaivedAccounts: &[]string{},
+		Versions: []pack.PackageVersion{
+			{Version: 1, EffectiveTo: &switchover, Fees: flatFeeSchedule("100"), WaivedAccounts: &[]string{}},
+			{Version: 2, EffectiveFrom: &switchover, Fees: flatFeeSchedule("250"), WaivedAccounts: &[]string{}},
+		},
+	}
+
+	txDate := transaction.TransactionDate(switchover.AddDate(0, 0, -1))
+	feeCalc := &model.FeeCalculate{
+		Transaction: transaction.Transaction{
+			TransactionDate: &txDate,
+			Send: transaction.Send{
+				Asset: "BRL",
+				Value: decimal.NewFromInt(1000),
+				Source: transaction.Source{From: []transaction.FromTo{{
+					AccountAlias: "@from_account",
+					Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(1000)},
+				}}},
+				Distribute: transaction.Distribute{To: []transaction.FromTo{{
+					AccountAlias: "@to_account",
+					Amount:       &transaction.Amount{Asset: "BRL", Value: decimal.NewFromInt(1000)},
+				}}},
+			},
+		},
+	}
+
+	resp := &transaction.Responses{
+		From: map[string]transaction.Amount{"@from_account": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+		To:   map[string]transaction.Amount{"@to_account": {Asset: "BRL", Value: decimal.NewFromInt(1000)}},
+	}
+
+	require.NoError(t, CalculateFee(logger, feeCalc, p, resp, "BRL", nil))
+
+	assert.True(t, feeCalc.Transaction.Send.Value.Equal(decimal.NewFromInt(1100)),
+		"version 1 flat fee expected, got Send.Value=%s", feeCalc.Transaction.Send.Value)
+
+	var feeLegs int
+
+	for _, leg := range feeCalc.Transaction.Send.Distribute.To {
+		if leg.AccountAlias != "@fee_account" {
+			assert.NotContains(t, leg.Metadata, MetadataPackageVersion, "transaction legs are not stamped")
+
+			continue
+		}
+
+		feeLegs++
+
+		assert.Equal(t, packID.String(), leg.Metadata[MetadataPackageID])
+		assert.Equal(t, 1, leg.Metadata[MetadataPackageVersion])
+	}
+
+	assert.Equal(t, 1, feeLegs)
+}
+
+func TestResolvePackagesAt(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().AddDate(1, 0, 0)
+	legacy := &pack.Package{ID: uuid.New()}
+	notYet := &pack.Package{ID: uuid.New(), Versions: []pack.PackageVersion{{Version: 1, EffectiveFrom: &future}}}
+
+	resolved := ResolvePackagesAt([]*pack.Package{legacy, nil, notYet}, time.Now())
+
+	require.Len(t, resolved, 1)
+	assert.Equal(t, legacy.ID, resolved[0].ID)
+	assert.Equal(t, 1, resolved[0].Version)
+}
+
+func TestFeeLegIndex(t *testing.T) {
+	t.Parallel()
+
+	idx, ok := feeLegIndex("@fee_account->fee1->route")
+	assert.True(t, ok)
+	assert.Equal(t, 1, idx)
+
+	idx, ok = feeLegIndex("@fee_account->fee_source2->")
+	assert.True(t, ok)
+	assert.Equal(t, 2, idx)
+
+	_, ok = feeLegIndex("@to_account")
+	assert.False(t, ok)
+
+	_, ok = feeLegIndex("@to_account->feeder")
+	assert.False(t, ok)
+}
@@ -13,12 +13,13 @@ type AccountStatus struct {
 }
 
 // Account represents a Midaz account returned by the onboarding API.
-// Fields SegmentID and PortfolioID are optional (nullable) in Midaz responses.
+// Fields SegmentID, PortfolioID and HolderID are optional (nullable) in Midaz responses.
 type Account struct {
 	ID          string         `json:"id"`
 	Alias       string         `json:"alias"`
 	SegmentID   *uuid.UUID     `json:"segmentId,omitempty"`
 	PortfolioID *uuid.UUID     `json:"portfolioId,omitempty"`
+	HolderID    *uuid.UUID     `json:"holderId,omitempty"`
 	Status      *AccountStatus `json:"status,omitempty"`
 	Type        string         `json:"type"`
 }
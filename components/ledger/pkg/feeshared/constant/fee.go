@@ -9,8 +9,10 @@ const (
 	FeeTypePercentage              = "percentage"
 	ReferenceAmountOriginalAmount  = "originalAmount"
 	ReferenceAmountAfterFeesAmount = "afterFeesAmount"
+	ReferenceAmountFeeAmount       = "feeAmount"
 	AppRuleMaxBetweenTypes         = "maxBetweenTypes"
 	AppRuleFlatFee                 = "flatFee"
 	AppRulePercentual              = "percentual"
+	AppRuleProgressive             = "progressive"
 	SuffixFeeSource                = "->fee_source"
 )
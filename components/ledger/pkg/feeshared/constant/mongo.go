@@ -5,6 +5,13 @@
 package constant
 
 const (
-	PackageCollection        = "package"
-	BillingPackageCollection = "billing_package"
+	PackageCollection           = "package"
+	BillingPackageCollection    = "billing_package"
+	BillingRunCollection        = "billing_run"
+	BillingStatementCollection  = "billing_statement"
+	PromotionUsageCollection    = "fee_promotion_usage"
+	FeeQuoteCollection          = "fee_quote"
+	FeeRevenueCollection        = "fee_revenue"
+	FeeRevenuePendingCollection = "fee_revenue_pending"
+	BillingInvoiceCollection    = "billing_invoice"
 )
@@ -0,0 +1,325 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillingInvoiceStatus constants define the collection state of an invoice.
+const (
+	// BillingInvoiceStatusIssued is the state an invoice is created in.
+	BillingInvoiceStatusIssued = "ISSUED"
+	// BillingInvoiceStatusPartiallyPaid means part of the total was collected.
+	BillingInvoiceStatusPartiallyPaid = "PARTIALLY_PAID"
+	// BillingInvoiceStatusPaid is terminal: the total was collected.
+	BillingInvoiceStatusPaid = "PAID"
+	// BillingInvoiceStatusOverdue means the due date passed before the total
+	// was collected. Collection continues while overdue.
+	BillingInvoiceStatusOverdue = "OVERDUE"
+	// BillingInvoiceStatusWrittenOff is terminal: what is left unpaid is no
+	// longer collected.
+	BillingInvoiceStatusWrittenOff = "WRITTEN_OFF"
+)
+
+// DefaultBillingInvoiceDueDays is the number of days between issuing an
+// invoice and its due date when the request does not set one.
+const DefaultBillingInvoiceDueDays = 15
+
+// BillingInvoiceRetrySchedule is the delay before each automatic payment
+// attempt that follows a failed one: the n-th consecutive failure schedules
+// the next attempt BillingInvoiceRetrySchedule[n-1] later. Once the schedule
+// is exhausted the invoice is only collected on demand.
+var BillingInvoiceRetrySchedule = []time.Duration{24 * time.Hour, 72 * time.Hour, 7 * 24 * time.Hour}
+
+// billingInvoiceTransitions lists, per status, the statuses an invoice may move to.
+var billingInvoiceTransitions = map[string][]string{
+	BillingInvoiceStatusIssued:        {BillingInvoiceStatusPartiallyPaid, BillingInvoiceStatusPaid, BillingInvoiceStatusOverdue, BillingInvoiceStatusWrittenOff},
+	BillingInvoiceStatusPartiallyPaid: {BillingInvoiceStatusPaid, BillingInvoiceStatusOverdue, BillingInvoiceStatusWrittenOff},
+	BillingInvoiceStatusOverdue:       {BillingInvoiceStatusPaid, BillingInvoiceStatusWrittenOff},
+}
+
+// BillingInvoiceRequest carries the parameters of an invoicing pass. Like a
+// billing run it calculates exactly what the calculate endpoint would return,
+// but instead of posting the charges it issues one invoice per debited account
+// and asset, collected by debiting the account. Each charge is billed once:
+// charges a billing run or another invoice already bills are skipped.
+type BillingInvoiceRequest struct {
+	OrganizationID string `json:"-"`
+	LedgerID       string `json:"ledgerId"          validate:"required" example:"00000000-0000-0000-0000-000000000000"`
+	Period         string `json:"period"            validate:"required" example:"2026-01"` // YYYY-MM, YYYY-Www, or YYYY-MM-DD format
+	Type           string `json:"type,omitempty"    example:"maintenance"`                 // "volume", "maintenance", or empty for both
+	DueInDays      *int   `json:"dueInDays,omitempty" validate:"omitempty,min=0,max=365" example:"15"`
+}
+
+// CalculateRequest projects the invoice request onto the calculate request it wraps.
+func (r BillingInvoiceRequest) CalculateRequest() BillingCalculateRequest {
+	return BillingCalculateRequest{
+		OrganizationID: r.OrganizationID,
+		LedgerID:       r.LedgerID,
+		Period:         r.Period,
+		Type:           r.Type,
+	}
+}
+
+// GetDueInDays returns DueInDays, or DefaultBillingInvoiceDueDays when unset.
+func (r BillingInvoiceRequest) GetDueInDays() int {
+	if r.DueInDays == nil {
+		return DefaultBillingInvoiceDueDays
+	}
+
+	return *r.DueInDays
+}
+
+// BillingInvoiceLine is the charge of one billing package on an invoice.
+// Quantity is the number of billable units (billable events for volume
+// packages, 1 for maintenance) and UnitPrice the price each was charged at, so
+// GrossAmount = Quantity × UnitPrice and NetAmount = GrossAmount − DiscountAmount.
+type BillingInvoiceLine struct {
+	BillingPackageID    string           `json:"billingPackageId" example:"00000000-0000-0000-0000-000000000000"`
+	BillingPackageLabel string           `json:"billingPackageLabel" example:"Monthly Volume Billing"`
+	BillingType         string           `json:"billingType" example:"volume" enums:"volume,maintenance"`
+	CreditAccountAlias  string           `json:"creditAccountAlias" example:"@revenue"`
+	PricingModel        string           `json:"pricingModel,omitempty" example:"tiered" enums:"fixed,tiered"`
+	TotalEvents         int64            `json:"totalEvents,omitempty" example:"1200"`
+	FreeQuotaUsed       int64            `json:"freeQuotaUsed,omitempty" example:"100"`
+	Quantity            int64            `json:"quantity" example:"1100"`
+	UnitPrice           decimal.Decimal  `json:"unitPrice" swaggertype:"string" example:"0.10"`
+	GrossAmount         decimal.Decimal  `json:"grossAmount" swaggertype:"string" example:"110.00"`
+	DiscountPercentage  *decimal.Decimal `json:"discountPercentage,omitempty" swaggertype:"string" example:"10"`
+	DiscountAmount      decimal.Decimal  `json:"discountAmount" swaggertype:"string" example:"11.00"`
+	NetAmount           decimal.Decimal  `json:"netAmount" swaggertype:"string" example:"99.00"`
+	PaidAmount          decimal.Decimal  `json:"paidAmount" swaggertype:"string" example:"99.00"`
+}
+
+// Outstanding returns what is left to collect on the line.
+func (l *BillingInvoiceLine) Outstanding() decimal.Decimal {
+	return l.NetAmount.Sub(l.PaidAmount)
+}
+
+// BillingInvoicePayment records one collection posted against an invoice.
+type BillingInvoicePayment struct {
+	Amount         decimal.Decimal `json:"amount" swaggertype:"string" example:"99.00"`
+	TransactionID  string          `json:"transactionId" example:"00000000-0000-0000-0000-000000000000"`
+	IdempotencyKey string          `json:"idempotencyKey" example:"billing:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	PaidAt         string          `json:"paidAt" example:"2026-02-01T00:00:01Z"`
+}
+
+// BillingInvoicePendingPayment is a collection persisted on its invoice
+// before it is posted. If the attempt is interrupted, the next one posts it
+// again under the same idempotency key, which replays the original posting
+// instead of collecting twice.
+type BillingInvoicePendingPayment struct {
+	Amount         decimal.Decimal `json:"amount" swaggertype:"string" example:"99.00"`
+	IdempotencyKey string          `json:"idempotencyKey" example:"billing:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	StartedAt      string          `json:"startedAt" example:"2026-02-01T00:00:00Z"`
+}
+
+// BillingInvoice is the itemized bill of one account for one period and asset.
+// Its lines and totals never change once issued; collection only moves the
+// paid amounts, the payments and the status forward.
+//
+// PaymentAttempts counts every collection attempt and keys each payment's
+// idempotency; FailedAttempts counts the consecutive failed ones and drives
+// BillingInvoiceRetrySchedule. NextPaymentAttemptAt is nil when no automatic
+// attempt is scheduled. PendingPayment is set while a collection is being
+// posted.
+//
+// Revision is bumped by every update and guards it: an update made from a
+// stale copy of the invoice is rejected rather than overwriting the other
+// writer.
+type BillingInvoice struct {
+	ID                   string                        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	OrganizationID       string                        `json:"organizationId" example:"00000000-0000-0000-0000-000000000000"`
+	LedgerID             string                        `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000"`
+	Period               string                        `json:"period" example:"2026-01"`
+	Type                 string                        `json:"type,omitempty" example:"maintenance"`
+	AccountAlias         string                        `json:"accountAlias" example:"@customer_1"`
+	AssetCode            string                        `json:"assetCode" example:"BRL"`
+	Status               string                        `json:"status" example:"ISSUED" enums:"ISSUED,PARTIALLY_PAID,PAID,OVERDUE,WRITTEN_OFF"`
+	Lines                []BillingInvoiceLine          `json:"lines"`
+	GrossAmount          decimal.Decimal               `json:"grossAmount" swaggertype:"string" example:"115.00"`
+	DiscountAmount       decimal.Decimal               `json:"discountAmount" swaggertype:"string" example:"11.00"`
+	TotalAmount          decimal.Decimal               `json:"totalAmount" swaggertype:"string" example:"104.00"`
+	PaidAmount           decimal.Decimal               `json:"paidAmount" swaggertype:"string" example:"0"`
+	AmountDue            decimal.Decimal               `json:"amountDue" swaggertype:"string" example:"104.00"`
+	Payments             []BillingInvoicePayment       `json:"payments"`
+	PaymentAttempts      int                           `json:"paymentAttempts" example:"1"`
+	FailedAttempts       int                           `json:"failedAttempts" example:"1"`
+	LastPaymentError     *string                       `json:"lastPaymentError,omitempty"`
+	NextPaymentAttemptAt *string                       `json:"nextPaymentAttemptAt,omitempty" example:"2026-02-02T00:00:00Z"`
+	PendingPayment       *BillingInvoicePendingPayment `json:"pendingPayment,omitempty"`
+	InvoiceKey           string                        `json:"invoiceKey" example:"billing-invoice:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	DueDate              string                        `json:"dueDate" example:"2026-02-16T00:00:00Z"`
+	IssuedAt             string                        `json:"issuedAt" example:"2026-02-01T00:00:00Z"`
+	PaidAt               *string                       `json:"paidAt,omitempty" example:"2026-02-01T00:00:01Z"`
+	WrittenOffAt         *string                       `json:"writtenOffAt,omitempty" example:"2026-05-01T00:00:00Z"`
+	CreatedAt            string                        `json:"createdAt" example:"2026-02-01T00:00:00Z"`
+	UpdatedAt            string                        `json:"updatedAt" example:"2026-02-01T00:00:01Z"`
+	Revision             int64                         `json:"revision" example:"2"`
+}
+
+// IsClosed reports whether the invoice is no longer collected.
+func (i *BillingInvoice) IsClosed() bool {
+	return i.Status == BillingInvoiceStatusPaid || i.Status == BillingInvoiceStatusWrittenOff
+}
+
+// CanTransitionTo reports whether the invoice may move to status.
+func (i *BillingInvoice) CanTransitionTo(status string) bool {
+	for _, next := range billingInvoiceTransitions[i.Status] {
+		if next == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Outstanding returns what is left to collect on the invoice.
+func (i *BillingInvoice) Outstanding() decimal.Decimal {
+	return i.TotalAmount.Sub(i.PaidAmount)
+}
+
+// ApplyPayment allocates the payment to the lines, records it and moves the
+// invoice to PAID once nothing is outstanding, or to PARTIALLY_PAID from
+// ISSUED. An overdue invoice stays OVERDUE until fully paid.
+func (i *BillingInvoice) ApplyPayment(payment BillingInvoicePayment) {
+	for idx, share := range i.allocate(payment.Amount) {
+		i.Lines[idx].PaidAmount = i.Lines[idx].PaidAmount.Add(share)
+	}
+
+	i.Payments = append(i.Payments, payment)
+	i.PaidAmount = i.PaidAmount.Add(payment.Amount)
+	i.AmountDue = i.Outstanding()
+
+	switch {
+	case !i.AmountDue.IsPositive():
+		paidAt := payment.PaidAt
+
+		i.Status = BillingInvoiceStatusPaid
+		i.PaidAt = &paidAt
+		i.NextPaymentAttemptAt = nil
+	case i.Status == BillingInvoiceStatusIssued:
+		i.Status = BillingInvoiceStatusPartiallyPaid
+	}
+}
+
+// CreditShares splits a payment of amount across the credit accounts of the
+// outstanding lines, returning the accounts in the order they first appear and
+// the share of each. Lines crediting the same account are merged.
+func (i *BillingInvoice) CreditShares(amount decimal.Decimal) ([]string, map[string]decimal.Decimal) {
+	order := make([]string, 0, len(i.Lines))
+	shares := make(map[string]decimal.Decimal, len(i.Lines))
+
+	allocated := i.allocate(amount)
+
+	for idx := range i.Lines {
+		share, ok := allocated[idx]
+		if !ok {
+			continue
+		}
+
+		alias := i.Lines[idx].CreditAccountAlias
+		if _, ok := shares[alias]; !ok {
+			order = append(order, alias)
+		}
+
+		shares[alias] = shares[alias].Add(share)
+	}
+
+	return order, shares
+}
+
+// allocate splits amount across the outstanding lines in order, each line
+// taking up to what it has outstanding. It returns the share of every line
+// that receives one, by line index.
+func (i *BillingInvoice) allocate(amount decimal.Decimal) map[int]decimal.Decimal {
+	shares := make(map[int]decimal.Decimal)
+	remaining := amount
+
+	for idx := range i.Lines {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		share := decimal.Min(remaining, i.Lines[idx].Outstanding())
+		if !share.IsPositive() {
+			continue
+		}
+
+		shares[idx] = share
+		remaining = remaining.Sub(share)
+	}
+
+	return shares
+}
+
+// BillingInvoiceKey derives the deterministic key of the invoice of an account
+// for a period, billing type and asset, so issuing the same period twice yields
+// the same invoice instead of billing the account again. billingType is the
+// type of the pass ("volume", "maintenance", or empty for both), so passes of
+// different types issue different invoices.
+func BillingInvoiceKey(period, billingType, accountAlias, assetCode string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{period, billingType, accountAlias, assetCode}, "|")))
+
+	return "billing-invoice:" + hex.EncodeToString(sum[:])
+}
+
+// BillingInvoicePaymentKey derives the idempotency key of the attempt-th
+// collection of an invoice. A retry of an interrupted attempt reuses its number
+// and therefore replays instead of collecting twice.
+func BillingInvoicePaymentKey(invoiceID string, attempt int) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{invoiceID, "payment", strconv.Itoa(attempt)}, "|")))
+
+	return billingIdempotencyKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// BillingInvoiceFilter selects invoices. Empty fields do not filter;
+// DueBefore and PaymentAttemptDueBefore are RFC3339 instants compared against
+// the due date and the next scheduled payment attempt.
+type BillingInvoiceFilter struct {
+	OrganizationID          string
+	LedgerID                string
+	Period                  string
+	AccountAlias            string
+	Statuses                []string
+	DueBefore               string
+	PaymentAttemptDueBefore string
+}
+
+// BillingDunningRequest carries the ledger a dunning pass runs for.
+type BillingDunningRequest struct {
+	OrganizationID string `json:"-"`
+	LedgerID       string `json:"ledgerId" validate:"required,uuid" example:"00000000-0000-0000-0000-000000000000"`
+}
+
+// BillingDunningResult summarizes a dunning pass: invoices moved to OVERDUE,
+// and the outcome of the payment attempts that were due.
+type BillingDunningResult struct {
+	MarkedOverdue int `json:"markedOverdue" example:"3"`
+	Attempted     int `json:"attempted" example:"12"`
+	Paid          int `json:"paid" example:"8"`
+	PartiallyPaid int `json:"partiallyPaid" example:"1"`
+	Failed        int `json:"failed" example:"3"`
+}
+
+// BillingInvoiceIssueResult summarizes an invoicing pass. Invoices lists the
+// invoices of the period, both those issued by the pass and those an earlier
+// pass had issued. TotalAlreadyBilled counts the charges the pass skipped
+// because a billing run, or an invoice of a pass of another type, bills them.
+type BillingInvoiceIssueResult struct {
+	Period             string            `json:"period" example:"2026-01"`
+	TotalIssued        int               `json:"totalIssued" example:"480"`
+	TotalExisting      int               `json:"totalExisting" example:"0"`
+	TotalAlreadyBilled int               `json:"totalAlreadyBilled" example:"0"`
+	TotalAmount        decimal.Decimal   `json:"totalAmount" swaggertype:"string" example:"2400.00"`
+	Invoices           []*BillingInvoice `json:"invoices"`
+}
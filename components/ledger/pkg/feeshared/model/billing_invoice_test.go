@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBillingInvoice(netAmounts ...string) *BillingInvoice {
+	invoice := &BillingInvoice{Status: BillingInvoiceStatusIssued}
+
+	for idx, raw := range netAmounts {
+		net := decimal.RequireFromString(raw)
+		credit := "@maintenance-revenue"
+
+		if idx%2 == 0 {
+			credit = "@volume-revenue"
+		}
+
+		invoice.Lines = append(invoice.Lines, BillingInvoiceLine{CreditAccountAlias: credit, NetAmount: net})
+		invoice.TotalAmount = invoice.TotalAmount.Add(net)
+	}
+
+	invoice.AmountDue = invoice.Outstanding()
+
+	return invoice
+}
+
+func TestBillingInvoiceKey(t *testing.T) {
+	t.Parallel()
+
+	key := BillingInvoiceKey("2026-01", "", "@acme", "BRL")
+
+	assert.True(t, strings.HasPrefix(key, "billing-invoice:"), "key must carry the invoice namespace: %s", key)
+	assert.Equal(t, key, BillingInvoiceKey("2026-01", "", "@acme", "BRL"), "key must be deterministic")
+	assert.NotEqual(t, key, BillingInvoiceKey("2026-01", "", "@acme", "USD"))
+	assert.NotEqual(t, key, BillingInvoiceKey("2026-02", "", "@acme", "BRL"))
+	assert.NotEqual(t, key, BillingInvoiceKey("2026-01", BillingPackageTypeMaintenance, "@acme", "BRL"), "passes of different types issue different invoices")
+	assert.NotEqual(t,
+		BillingInvoiceKey("2026-01", BillingPackageTypeMaintenance, "@acme", "BRL"),
+		BillingInvoiceKey("2026-01", BillingPackageTypeVolume, "@acme", "BRL"))
+
+	payment := BillingInvoicePaymentKey("invoice-1", 1)
+
+	assert.True(t, strings.HasPrefix(payment, "billing:"), "payments share the billing idempotency namespace: %s", payment)
+	assert.Equal(t, payment, BillingInvoicePaymentKey("invoice-1", 1))
+	assert.NotEqual(t, payment, BillingInvoicePaymentKey("invoice-1", 2))
+}
+
+func TestBillingInvoiceRequest_GetDueInDays(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultBillingInvoiceDueDays, BillingInvoiceRequest{}.GetDueInDays())
+
+	days := 0
+	assert.Equal(t, 0, BillingInvoiceRequest{DueInDays: &days}.GetDueInDays())
+}
+
+func TestBillingInvoice_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{BillingInvoiceStatusIssued, BillingInvoiceStatusPartiallyPaid, true},
+		{BillingInvoiceStatusIssued, BillingInvoiceStatusOverdue, true},
+		{BillingInvoiceStatusPartiallyPaid, BillingInvoiceStatusPaid, true},
+		{BillingInvoiceStatusOverdue, BillingInvoiceStatusWrittenOff, true},
+		{BillingInvoiceStatusOverdue, BillingInvoiceStatusPartiallyPaid, false},
+		{BillingInvoiceStatusPaid, BillingInvoiceStatusWrittenOff, false},
+		{BillingInvoiceStatusWrittenOff, BillingInvoiceStatusPaid, false},
+	}
+
+	for _, tt := range tests {
+		invoice := BillingInvoice{Status: tt.from}
+
+		assert.Equal(t, tt.want, invoice.CanTransitionTo(tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestBillingInvoice_ApplyPayment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("partial payment settles lines in order", func(t *testing.T) {
+		t.Parallel()
+
+		invoice := newTestBillingInvoice("99", "15")
+
+		invoice.ApplyPayment(BillingInvoicePayment{Amount: decimal.NewFromInt(100), PaidAt: "2026-02-01T00:00:00Z"})
+
+		assert.Equal(t, BillingInvoiceStatusPartiallyPaid, invoice.Status)
+		assert.True(t, decimal.NewFromInt(99).Equal(invoice.Lines[0].PaidAmount))
+		assert.True(t, decimal.NewFromInt(1).Equal(invoice.Lines[1].PaidAmount))
+		assert.True(t, decimal.NewFromInt(14).Equal(invoice.AmountDue))
+		assert.Nil(t, invoice.PaidAt)
+		assert.Len(t, invoice.Payments, 1)
+	})
+
+	t.Run("overdue invoice stays overdue until fully paid", func(t *testing.T) {
+		t.Parallel()
+
+		invoice := newTestBillingInvoice("99", "15")
+		invoice.Status = BillingInvoiceStatusOverdue
+
+		invoice.ApplyPayment(BillingInvoicePayment{Amount: decimal.NewFromInt(50)})
+		assert.Equal(t, BillingInvoiceStatusOverdue, invoice.Status)
+
+		next := "2026-02-02T00:00:00Z"
+		invoice.NextPaymentAttemptAt = &next
+
+		invoice.ApplyPayment(BillingInvoicePayment{Amount: decimal.NewFromInt(64), PaidAt: "2026-02-02T00:00:00Z"})
+
+		assert.Equal(t, BillingInvoiceStatusPaid, invoice.Status)
+		assert.True(t, invoice.AmountDue.IsZero())
+		require.NotNil(t, invoice.PaidAt)
+		assert.Equal(t, "2026-02-02T00:00:00Z", *invoice.PaidAt)
+		assert.Nil(t, invoice.NextPaymentAttemptAt)
+	})
+}
+
+func TestBillingInvoice_CreditShares(t *testing.T) {
+	t.Parallel()
+
+	invoice := newTestBillingInvoice("10", "20", "30")
+	invoice.ApplyPayment(BillingInvoicePayment{Amount: decimal.NewFromInt(5)})
+
+	order, shares := invoice.CreditShares(decimal.NewFromInt(40))
+
+	assert.Equal(t, []string{"@volume-revenue", "@maintenance-revenue"}, order)
+	assert.True(t, decimal.NewFromInt(20).Equal(shares["@volume-revenue"]), "5 left on the first line and 15 on the third")
+	assert.True(t, decimal.NewFromInt(20).Equal(shares["@maintenance-revenue"]))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillingRunStatus constants define the outcome of a billing run.
+const (
+	// BillingRunStatusRunning is the state a run is created in. A run found
+	// RUNNING after the fact was interrupted; retrying it finishes the work.
+	BillingRunStatusRunning = "RUNNING"
+	// BillingRunStatusCompleted means every statement of the run is posted.
+	BillingRunStatusCompleted = "COMPLETED"
+	// BillingRunStatusCompletedWithFailures means at least one statement could
+	// not be posted and is waiting for a retry.
+	BillingRunStatusCompletedWithFailures = "COMPLETED_WITH_FAILURES"
+)
+
+// BillingStatementStatus constants define the posting state of a billing statement.
+const (
+	// BillingStatementStatusPending is the state a statement is persisted in
+	// before its transaction is posted. A statement left PENDING (e.g. the
+	// process died mid-post) is retried like a FAILED one.
+	BillingStatementStatusPending = "PENDING"
+	// BillingStatementStatusPosted is terminal: the charge reached the ledger.
+	BillingStatementStatusPosted = "POSTED"
+	// BillingStatementStatusFailed means the last posting attempt was rejected.
+	BillingStatementStatusFailed = "FAILED"
+	// BillingStatementStatusInvoiced is terminal: the charge is billed by the
+	// invoice named in the statement's billingInvoiceKey metadata, which
+	// collects it. Such a statement belongs to no run and is never posted.
+	BillingStatementStatusInvoiced = "INVOICED"
+)
+
+// billingIdempotencyKeyPrefix namespaces billing keys away from client-supplied
+// X-Idempotency keys sharing the same per-ledger idempotency space.
+const billingIdempotencyKeyPrefix = "billing:"
+
+// BillingRunRequest carries the parameters of a billing run. It mirrors
+// BillingCalculateRequest: the run calculates exactly what the calculate
+// endpoint would return and then posts it.
+type BillingRunRequest struct {
+	OrganizationID string `json:"-"`
+	LedgerID       string `json:"ledgerId"       validate:"required" example:"00000000-0000-0000-0000-000000000000"`
+	Period         string `json:"period"         validate:"required" example:"2026-01"` // YYYY-MM, YYYY-Www, or YYYY-MM-DD format
+	Type           string `json:"type,omitempty" example:"maintenance"`                 // "volume", "maintenance", or empty for both
+}
+
+// CalculateRequest projects the run request onto the calculate request it wraps.
+func (r BillingRunRequest) CalculateRequest() BillingCalculateRequest {
+	return BillingCalculateRequest{
+		OrganizationID: r.OrganizationID,
+		LedgerID:       r.LedgerID,
+		Period:         r.Period,
+		Type:           r.Type,
+	}
+}
+
+// BillingRun records one execution of the billing pipeline for a ledger and
+// period. TotalPosted, TotalFailed and TotalPending count the statements the
+// run created; TotalAlreadyPosted counts charges the run found already posted
+// by an earlier run for the same period, and TotalInvoiced charges an invoice
+// already bills, both of which it skipped.
+type BillingRun struct {
+	ID                 string          `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	OrganizationID     string          `json:"organizationId" example:"00000000-0000-0000-0000-000000000000"`
+	LedgerID           string          `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000"`
+	Period             string          `json:"period" example:"2026-01"`
+	Type               string          `json:"type,omitempty" example:"maintenance"`
+	Status             string          `json:"status" example:"COMPLETED" enums:"RUNNING,COMPLETED,COMPLETED_WITH_FAILURES"`
+	TotalStatements    int             `json:"totalStatements" example:"480"`
+	TotalPosted        int             `json:"totalPosted" example:"478"`
+	TotalFailed        int             `json:"totalFailed" example:"2"`
+	TotalPending       int             `json:"totalPending" example:"0"`
+	TotalAlreadyPosted int             `json:"totalAlreadyPosted" example:"0"`
+	TotalInvoiced      int             `json:"totalInvoiced" example:"0"`
+	TotalAmount        decimal.Decimal `json:"totalAmount" swaggertype:"string" example:"2400.00"`
+	CreatedAt          string          `json:"createdAt" example:"2026-02-01T00:00:00Z"`
+	UpdatedAt          string          `json:"updatedAt" example:"2026-02-01T00:00:05Z"`
+}
+
+// BillingStatement is the immutable record of one charge: a billing package
+// debiting one account for one period. The charge fields (accounts, asset,
+// amount, idempotency key) never change once persisted; only the posting
+// state (status, transaction ID, attempts, last error) moves forward, so a
+// retry re-posts exactly the charge that was first calculated.
+type BillingStatement struct {
+	ID                  string          `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	RunID               string          `json:"runId" example:"00000000-0000-0000-0000-000000000000"`
+	OrganizationID      string          `json:"organizationId" example:"00000000-0000-0000-0000-000000000000"`
+	LedgerID            string          `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000"`
+	BillingPackageID    string          `json:"billingPackageId" example:"00000000-0000-0000-0000-000000000000"`
+	BillingPackageLabel string          `json:"billingPackageLabel" example:"Monthly Maintenance"`
+	BillingType         string          `json:"billingType" example:"maintenance" enums:"volume,maintenance"`
+	Period              string          `json:"period" example:"2026-01"`
+	AccountAlias        string          `json:"accountAlias" example:"@customer_1"`
+	CreditAccountAlias  string          `json:"creditAccountAlias" example:"@revenue"`
+	AssetCode           string          `json:"assetCode" example:"BRL"`
+	Amount              decimal.Decimal `json:"amount" swaggertype:"string" example:"5.00"`
+	Code                string          `json:"code" example:"billing-maintenance"`
+	Description         string          `json:"description" example:"Billing - Monthly Maintenance - 2026-01"`
+	Metadata            map[string]any  `json:"metadata,omitempty"`
+	IdempotencyKey      string          `json:"idempotencyKey" example:"billing:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Status              string          `json:"status" example:"POSTED" enums:"PENDING,POSTED,FAILED,INVOICED"`
+	TransactionID       *string         `json:"transactionId,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	Attempts            int             `json:"attempts" example:"1"`
+	LastError           *string         `json:"lastError,omitempty"`
+	CreatedAt           string          `json:"createdAt" example:"2026-02-01T00:00:00Z"`
+	UpdatedAt           string          `json:"updatedAt" example:"2026-02-01T00:00:01Z"`
+	PostedAt            *string         `json:"postedAt,omitempty" example:"2026-02-01T00:00:01Z"`
+}
+
+// IsPosted reports whether the statement's charge already reached the ledger.
+func (s *BillingStatement) IsPosted() bool {
+	return s.Status == BillingStatementStatusPosted
+}
+
+// IsInvoiced reports whether the statement's charge is billed by an invoice
+// rather than posted by a run.
+func (s *BillingStatement) IsInvoiced() bool {
+	return s.Status == BillingStatementStatusInvoiced
+}
+
+// BillingPosting is the ledger transaction a billing charge was posted as:
+// the one created, or the original one when its idempotency key replayed.
+type BillingPosting struct {
+	TransactionID string
+	Amount        decimal.Decimal
+}
+
+// BillingIdempotencyKey derives the deterministic idempotency key of a charge
+// from its (package, period, account) triple. The same triple always yields
+// the same key, so re-running or retrying a period can never post a second
+// charge; the hash keeps the key within the idempotency header's length budget
+// regardless of alias length.
+func BillingIdempotencyKey(billingPackageID, period, accountAlias string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{billingPackageID, period, accountAlias}, "|")))
+
+	return billingIdempotencyKeyPrefix + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBillingIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	key := BillingIdempotencyKey("pkg-001", "2026-01", "@acme")
+
+	assert.True(t, strings.HasPrefix(key, "billing:"), "key must carry the billing namespace: %s", key)
+	assert.Equal(t, key, BillingIdempotencyKey("pkg-001", "2026-01", "@acme"), "key must be deterministic")
+
+	for _, other := range []string{
+		BillingIdempotencyKey("pkg-002", "2026-01", "@acme"),
+		BillingIdempotencyKey("pkg-001", "2026-02", "@acme"),
+		BillingIdempotencyKey("pkg-001", "2026-01", "@other"),
+		// The separator keeps adjacent fields from bleeding into each other.
+		BillingIdempotencyKey("pkg-001", "2026-0", "1@acme"),
+	} {
+		assert.NotEqual(t, key, other)
+	}
+}
+
+func TestBillingRunRequest_CalculateRequest(t *testing.T) {
+	t.Parallel()
+
+	req := BillingRunRequest{OrganizationID: "org", LedgerID: "ledger", Period: "2026-01", Type: BillingPackageTypeVolume}
+
+	assert.Equal(t, BillingCalculateRequest{OrganizationID: "org", LedgerID: "ledger", Period: "2026-01", Type: BillingPackageTypeVolume}, req.CalculateRequest())
+}
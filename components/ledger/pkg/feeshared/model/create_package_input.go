@@ -13,16 +13,18 @@ import (
 
 // CreatePackageInput is a struct designed to encapsulate request create payload data.
 type CreatePackageInput struct {
-	FeeGroupLabel    string         `json:"feeGroupLabel" validate:"required" example:"Pacote Padrão"`
-	Description      *string        `json:"description,omitempty" example:"Pacote de taxas administrativas padrão"`
-	SegmentID        *string        `json:"segmentId" example:"00000000-0000-0000-0000-000000000000"`
-	LedgerID         string         `json:"ledgerId" validate:"required" example:"00000000-0000-0000-0000-000000000000"`
-	TransactionRoute *string        `json:"transactionRoute,omitempty" example:"debitoted"`
-	MinAmount        string         `json:"minimumAmount" validate:"required" example:"100.00" minimum:"0"`
-	MaxAmount        string         `json:"maximumAmount" validate:"required" example:"1000.20" minimum:"0"`
-	WaivedAccounts   *[]string      `json:"waivedAccounts,omitempty" example:"[\"acc001\", \"acc002\"]"`
-	Fee              map[string]Fee `json:"fees" validate:"required,min=1,dive"`
-	Enable           *bool          `json:"enable" validate:"required"`
+	FeeGroupLabel    string          `json:"feeGroupLabel" validate:"required" example:"Pacote Padrão"`
+	Description      *string         `json:"description,omitempty" example:"Pacote de taxas administrativas padrão"`
+	SegmentID        *string         `json:"segmentId" example:"00000000-0000-0000-0000-000000000000"`
+	LedgerID         string          `json:"ledgerId" validate:"required" example:"00000000-0000-0000-0000-000000000000"`
+	TransactionRoute *string         `json:"transactionRoute,omitempty" example:"debitoted"`
+	Asset            *string         `json:"asset,omitempty" example:"USD"`
+	MinAmount        string          `json:"minimumAmount" validate:"required" example:"100.00" minimum:"0"`
+	MaxAmount        string          `json:"maximumAmount" validate:"required" example:"1000.20" minimum:"0"`
+	WaivedAccounts   *[]string       `json:"waivedAccounts,omitempty" example:"[\"acc001\", \"acc002\"]"`
+	Fee              map[string]Fee  `json:"fees" validate:"required,min=1,dive"`
+	Enable           *bool           `json:"enable" validate:"required"`
+	ReversalPolicy   *ReversalPolicy `json:"reversalPolicy,omitempty"`
 }
 
 func (cp *CreatePackageInput) GetTransactionRoute() string {
@@ -33,8 +35,24 @@ func (cp *CreatePackageInput) GetTransactionRoute() string {
 	return *cp.TransactionRoute
 }
 
+// GetAsset returns the asset the package is scoped to, or "" when it applies
+// to every asset.
+func (cp *CreatePackageInput) GetAsset() string {
+	if cp.Asset == nil {
+		return ""
+	}
+
+	return *cp.Asset
+}
+
 // ValidateFees Validating the Fee map values
 func (cp *CreatePackageInput) ValidateFees() error {
+	if cp.Asset != nil {
+		if err := validateAssetCode(*cp.Asset, "asset"); err != nil {
+			return err
+		}
+	}
+
 	for key, fee := range cp.Fee {
 		if fee.Priority == 1 && fee.ReferenceAmount != OriginalAmount {
 			return pkg.ValidateBusinessError(constant.ErrPriorityOne, "", key)
@@ -47,9 +65,21 @@ func (cp *CreatePackageInput) ValidateFees() error {
 		if err := validateCalculationModel(fee.CalculationModel, cp.MinAmount, key, fee.GetIsDeductibleFrom()); err != nil {
 			return err
 		}
+
+		if err := validateFeeLimits(fee, cp.MinAmount, key); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	if err := ValidateFeeAssets(cp.Fee, cp.GetAsset(), cp.MinAmount); err != nil {
+		return err
+	}
+
+	if err := cp.ReversalPolicy.Validate(FeeKeys(cp.Fee)); err != nil {
+		return err
+	}
+
+	return ValidateFeeChain(cp.Fee)
 }
 
 // ValidateMinAndMaxAmount Validating if minimum amount value is greater than maximum amount value
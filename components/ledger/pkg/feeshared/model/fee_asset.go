@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// ValidateFeeAssets validates the asset fields of fees belonging to a package
+// scoped to packageAsset ("" when the package applies to every asset): asset
+// codes are well formed, no fee is scoped to an asset its package never prices,
+// and per-asset values are only set on flat calculations, with the same
+// deductible bound against minAmount that Value has.
+func ValidateFeeAssets(fees map[string]Fee, packageAsset, minAmount string) error {
+	for key, fee := range fees {
+		if err := fee.validateAssets(key, packageAsset, minAmount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *Fee) validateAssets(feeKey, packageAsset, minAmount string) error {
+	if f.Asset != nil {
+		if err := validateAssetCode(*f.Asset, feeKey+".asset"); err != nil {
+			return err
+		}
+
+		if packageAsset != "" && *f.Asset != packageAsset {
+			return pkg.ValidateBusinessError(constant.ErrFeeAssetOutsidePackage, "", feeKey, *f.Asset, packageAsset)
+		}
+	}
+
+	if f.SettlementAsset != nil {
+		if err := validateAssetCode(*f.SettlementAsset, feeKey+".settlementAsset"); err != nil {
+			return err
+		}
+	}
+
+	if f.CalculationModel == nil {
+		return nil
+	}
+
+	for _, calc := range f.CalculationModel.Calculations {
+		if len(calc.AssetValues) == 0 {
+			continue
+		}
+
+		if calc.Type != Flat {
+			return pkg.ValidateBusinessError(constant.ErrFeeAssetValuesInvalid, "", feeKey)
+		}
+
+		for asset, raw := range calc.AssetValues {
+			field := feeKey + ".calculationModel.calculations.assetValues." + asset
+
+			if err := validateAssetCode(asset, field); err != nil {
+				return err
+			}
+
+			value, err := parseAmountDecimal(raw)
+			if err != nil || value.IsNegative() {
+				return pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", field)
+			}
+
+			if minAmount == "" || !f.GetIsDeductibleFrom() {
+				continue
+			}
+
+			minAmountDecimal, err := parseAmountDecimal(minAmount)
+			if err != nil {
+				return pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", feeKey+".minimumAmount")
+			}
+
+			if value.GreaterThan(minAmountDecimal) {
+				return pkg.ValidateBusinessError(constant.ErrCalculationValueFlatFee, "", minAmount, feeKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAssetCode rejects asset codes that are empty or not made of
+// uppercase letters only, the format assets are created with.
+func validateAssetCode(code, field string) error {
+	if code == "" || utils.ValidateCode(code) != nil {
+		return pkg.ValidateBusinessError(constant.ErrFeeAssetInvalid, "", field)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFeeAssets(t *testing.T) {
+	t.Parallel()
+
+	flat := func(assetValues map[string]string) *CalculationModel {
+		return &CalculationModel{
+			ApplicationRule: "flatFee",
+			Calculations:    []Calculation{{Type: Flat, Value: "10", AssetValues: assetValues}},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		fee          Fee
+		packageAsset string
+		wantErr      error
+		wantArgs     []any
+	}{
+		{
+			name: "asset, settlement asset and per-asset values",
+			fee:  Fee{Asset: stringPtr("USD"), SettlementAsset: stringPtr("BRL"), CalculationModel: flat(map[string]string{"USD": "2.50"})},
+		},
+		{
+			name:         "fee asset matching its package",
+			fee:          Fee{Asset: stringPtr("USD")},
+			packageAsset: "USD",
+		},
+		{
+			name:     "lowercase fee asset",
+			fee:      Fee{Asset: stringPtr("usd")},
+			wantErr:  constant.ErrFeeAssetInvalid,
+			wantArgs: []any{"service.asset"},
+		},
+		{
+			name:     "malformed settlement asset",
+			fee:      Fee{SettlementAsset: stringPtr("US1")},
+			wantErr:  constant.ErrFeeAssetInvalid,
+			wantArgs: []any{"service.settlementAsset"},
+		},
+		{
+			name:         "fee asset outside its package",
+			fee:          Fee{Asset: stringPtr("EUR")},
+			packageAsset: "USD",
+			wantErr:      constant.ErrFeeAssetOutsidePackage,
+			wantArgs:     []any{"service", "EUR", "USD"},
+		},
+		{
+			name: "per-asset values on a percentage",
+			fee: Fee{CalculationModel: &CalculationModel{
+				ApplicationRule: "percentual",
+				Calculations:    []Calculation{{Type: Percentage, Value: "1", AssetValues: map[string]string{"USD": "2"}}},
+			}},
+			wantErr:  constant.ErrFeeAssetValuesInvalid,
+			wantArgs: []any{"service"},
+		},
+		{
+			name:     "negative per-asset value",
+			fee:      Fee{CalculationModel: flat(map[string]string{"USD": "-1"})},
+			wantErr:  constant.ErrConvertToDecimal,
+			wantArgs: []any{"service.calculationModel.calculations.assetValues.USD"},
+		},
+		{
+			name:     "deductible per-asset value above the minimum amount",
+			fee:      Fee{IsDeductibleFrom: boolPtr(true), CalculationModel: flat(map[string]string{"USD": "150"})},
+			wantErr:  constant.ErrCalculationValueFlatFee,
+			wantArgs: []any{"100", "service"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateFeeAssets(map[string]Fee{"service": tt.fee}, tt.packageAsset, "100")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, "", tt.wantArgs...), err)
+		})
+	}
+}
+
+func TestCalculation_ValueFor(t *testing.T) {
+	t.Parallel()
+
+	calc := Calculation{Type: Flat, Value: "10", AssetValues: map[string]string{"USD": "2"}}
+
+	assert.Equal(t, "2", calc.ValueFor("USD"))
+	assert.Equal(t, "10", calc.ValueFor("BRL"))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"sort"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/iancoleman/strcase"
+)
+
+// ValidateFeeChain validates the fees of a package that are calculated on other
+// fees (referenceAmount feeAmount). Every chained fee must name an existing,
+// different fee as baseFee; following baseFee links must never lead back to the
+// starting fee; the base fee must have a lower priority so the engine, which
+// applies fees in priority order, has already calculated it; and the chained fee
+// must credit a different account through different routes, so the tax and the
+// fee it is levied on stay distinguishable in the ledger.
+//
+// fees must be the package's complete, final fee map. Keys are compared in
+// their stored (lowerCamel) form.
+func ValidateFeeChain(fees map[string]Fee) error {
+	normalized := make(map[string]Fee, len(fees))
+	for key, fee := range fees {
+		normalized[strcase.ToLowerCamel(key)] = fee
+	}
+
+	keys := make([]string, 0, len(normalized))
+	for key := range normalized {
+		keys = append(keys, key)
+	}
+
+	// Deterministic order so the same invalid package always reports the same fee.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fee := normalized[key]
+		isChained := fee.ReferenceAmount == FeeAmount
+
+		if isChained != (fee.BaseFee != nil) {
+			return pkg.ValidateBusinessError(constant.ErrBaseFeeInvalid, "", key)
+		}
+
+		if !isChained {
+			continue
+		}
+
+		baseKey := fee.GetBaseFee()
+
+		if _, ok := normalized[baseKey]; !ok || baseKey == key {
+			return pkg.ValidateBusinessError(constant.ErrBaseFeeInvalid, "", key)
+		}
+
+		if feeChainHasCycle(normalized, key) {
+			return pkg.ValidateBusinessError(constant.ErrFeeDependencyCycle, "", key)
+		}
+	}
+
+	for _, key := range keys {
+		fee := normalized[key]
+		if fee.ReferenceAmount != FeeAmount {
+			continue
+		}
+
+		baseKey := fee.GetBaseFee()
+		base := normalized[baseKey]
+
+		if base.Priority >= fee.Priority {
+			return pkg.ValidateBusinessError(constant.ErrFeeDependencyOrder, "", key, baseKey)
+		}
+
+		if sharesFeeDestination(fee, base) {
+			return pkg.ValidateBusinessError(constant.ErrChainedFeeDestinationConflict, "", key, baseKey)
+		}
+	}
+
+	return nil
+}
+
+// feeChainHasCycle reports whether following baseFee links from start returns
+// to a fee already visited.
+func feeChainHasCycle(fees map[string]Fee, start string) bool {
+	visited := map[string]bool{start: true}
+	current := fees[start]
+
+	for current.ReferenceAmount == FeeAmount {
+		next := current.GetBaseFee()
+		if visited[next] {
+			return true
+		}
+
+		visited[next] = true
+
+		var ok bool
+
+		if current, ok = fees[next]; !ok {
+			return false
+		}
+	}
+
+	return false
+}
+
+// sharesFeeDestination reports whether a chained fee would post to the same
+// credit account, or through the same route, as its base fee.
+func sharesFeeDestination(fee, base Fee) bool {
+	if fee.CreditAccount == base.CreditAccount {
+		return true
+	}
+
+	if fee.GetRouteFrom() != "" && fee.GetRouteFrom() == base.GetRouteFrom() {
+		return true
+	}
+
+	return fee.GetRouteTo() != "" && fee.GetRouteTo() == base.GetRouteTo()
+}
+
+// ApplyUpdate returns the fee that results from patching f with the non-empty
+// fields of update, mirroring how a package PATCH sets fee fields one by one.
+func (f Fee) ApplyUpdate(update Fee) Fee {
+	merged := f
+
+	if update.FeeLabel != "" {
+		merged.FeeLabel = update.FeeLabel
+	}
+
+	if update.CalculationModel != nil {
+		calc := CalculationModel{}
+		if f.CalculationModel != nil {
+			calc = *f.CalculationModel
+		}
+
+		if update.CalculationModel.ApplicationRule != "" {
+			calc.ApplicationRule = update.CalculationModel.ApplicationRule
+		}
+
+		if len(update.CalculationModel.Calculations) > 0 {
+			calc.Calculations = update.CalculationModel.Calculations
+		}
+
+		merged.CalculationModel = &calc
+	}
+
+	if update.ReferenceAmount != "" {
+		merged.ReferenceAmount = update.ReferenceAmount
+	}
+
+	if update.Priority != 0 {
+		merged.Priority = update.Priority
+	}
+
+	if update.IsDeductibleFrom != nil {
+		merged.IsDeductibleFrom = update.IsDeductibleFrom
+	}
+
+	if update.CreditAccount != "" {
+		merged.CreditAccount = update.CreditAccount
+	}
+
+	if update.GetRouteFrom() != "" {
+		merged.RouteFrom = update.RouteFrom
+	}
+
+	if update.GetRouteTo() != "" {
+		merged.RouteTo = update.RouteTo
+	}
+
+	if update.MinimumFee != nil {
+		merged.MinimumFee = update.MinimumFee
+	}
+
+	if update.MaximumFee != nil {
+		merged.MaximumFee = update.MaximumFee
+	}
+
+	if update.BaseFee != nil {
+		merged.BaseFee = update.BaseFee
+	}
+
+	if update.GetAsset() != "" {
+		merged.Asset = update.Asset
+	}
+
+	if update.GetSettlementAsset() != "" {
+		merged.SettlementAsset = update.SettlementAsset
+	}
+
+	return merged
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func chainFee(priority int, creditAccount string, baseFee string) Fee {
+	fee := Fee{
+		Priority:        priority,
+		ReferenceAmount: OriginalAmount,
+		CreditAccount:   creditAccount,
+	}
+
+	if baseFee != "" {
+		fee.ReferenceAmount = FeeAmount
+		fee.BaseFee = &baseFee
+	}
+
+	return fee
+}
+
+func TestValidateFeeChain(t *testing.T) {
+	t.Parallel()
+
+	route := "tax"
+
+	tests := []struct {
+		name     string
+		fees     map[string]Fee
+		wantErr  error
+		wantArgs []any
+	}{
+		{
+			name: "no chained fees",
+			fees: map[string]Fee{"serviceFee": chainFee(1, "@revenue", "")},
+		},
+		{
+			name: "tax on a fee, then a tax on the tax",
+			fees: map[string]Fee{
+				"serviceFee": chainFee(1, "@revenue", ""),
+				"iss":        chainFee(2, "@iss_payable", "serviceFee"),
+				"surcharge":  chainFee(3, "@surcharge", "iss"),
+			},
+		},
+		{
+			name: "base key given in input form",
+			fees: map[string]Fee{
+				"service_fee": chainFee(1, "@revenue", ""),
+				"iss":         chainFee(2, "@iss_payable", "service_fee"),
+			},
+		},
+		{
+			name:     "feeAmount without baseFee",
+			fees:     map[string]Fee{"iss": {Priority: 2, ReferenceAmount: FeeAmount, CreditAccount: "@iss"}},
+			wantErr:  constant.ErrBaseFeeInvalid,
+			wantArgs: []any{"iss"},
+		},
+		{
+			name: "baseFee with another reference amount",
+			fees: map[string]Fee{
+				"serviceFee": chainFee(1, "@revenue", ""),
+				"iss":        {Priority: 2, ReferenceAmount: OriginalAmount, CreditAccount: "@iss", BaseFee: stringPtr("serviceFee")},
+			},
+			wantErr:  constant.ErrBaseFeeInvalid,
+			wantArgs: []any{"iss"},
+		},
+		{
+			name:     "unknown base fee",
+			fees:     map[string]Fee{"iss": chainFee(2, "@iss", "serviceFee")},
+			wantErr:  constant.ErrBaseFeeInvalid,
+			wantArgs: []any{"iss"},
+		},
+		{
+			name:     "fee on itself",
+			fees:     map[string]Fee{"iss": chainFee(2, "@iss", "iss")},
+			wantErr:  constant.ErrBaseFeeInvalid,
+			wantArgs: []any{"iss"},
+		},
+		{
+			name: "cycle",
+			fees: map[string]Fee{
+				"a": chainFee(2, "@a", "b"),
+				"b": chainFee(3, "@b", "a"),
+			},
+			wantErr:  constant.ErrFeeDependencyCycle,
+			wantArgs: []any{"a"},
+		},
+		{
+			name: "base fee applied after the chained fee",
+			fees: map[string]Fee{
+				"serviceFee": chainFee(3, "@revenue", ""),
+				"iss":        chainFee(2, "@iss", "serviceFee"),
+			},
+			wantErr:  constant.ErrFeeDependencyOrder,
+			wantArgs: []any{"iss", "serviceFee"},
+		},
+		{
+			name: "same credit account as the base fee",
+			fees: map[string]Fee{
+				"serviceFee": chainFee(1, "@revenue", ""),
+				"iss":        chainFee(2, "@revenue", "serviceFee"),
+			},
+			wantErr:  constant.ErrChainedFeeDestinationConflict,
+			wantArgs: []any{"iss", "serviceFee"},
+		},
+		{
+			name: "same route as the base fee",
+			fees: map[string]Fee{
+				"serviceFee": func() Fee { f := chainFee(1, "@revenue", ""); f.RouteTo = &route; return f }(),
+				"iss":        func() Fee { f := chainFee(2, "@iss", "serviceFee"); f.RouteTo = &route; return f }(),
+			},
+			wantErr:  constant.ErrChainedFeeDestinationConflict,
+			wantArgs: []any{"iss", "serviceFee"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateFeeChain(tt.fees)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, "", tt.wantArgs...), err)
+		})
+	}
+}
+
+func TestFee_ApplyUpdate(t *testing.T) {
+	t.Parallel()
+
+	existing := Fee{
+		FeeLabel:         "service",
+		CalculationModel: &CalculationModel{ApplicationRule: FlatFee, Calculations: []Calculation{{Type: Flat, Value: "2"}}},
+		ReferenceAmount:  OriginalAmount,
+		Priority:         2,
+		CreditAccount:    "@revenue",
+	}
+
+	merged := existing.ApplyUpdate(Fee{ReferenceAmount: FeeAmount, BaseFee: stringPtr("other")})
+
+	assert.Equal(t, "service", merged.FeeLabel)
+	assert.Equal(t, 2, merged.Priority)
+	assert.Equal(t, FeeAmount, merged.ReferenceAmount)
+	assert.Equal(t, "other", merged.GetBaseFee())
+	assert.Equal(t, FlatFee, merged.CalculationModel.ApplicationRule)
+	assert.Equal(t, OriginalAmount, existing.ReferenceAmount, "receiver is not mutated")
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"time"
+
+	transaction "github.com/LerianStudio/midaz/v4/pkg/mtransaction"
+)
+
+const (
+	// FeeQuoteStatusOpen marks a quote that can still be used by a transaction.
+	FeeQuoteStatusOpen = "OPEN"
+
+	// FeeQuoteStatusUsed marks a quote already charged by a transaction.
+	FeeQuoteStatusUsed = "USED"
+
+	// FeeQuoteStatusExpired marks an unused quote past its expiry.
+	FeeQuoteStatusExpired = "EXPIRED"
+)
+
+// FeeQuote freezes the fees estimated for a transaction so that the
+// transaction referencing it is charged exactly those fees, whatever happens to
+// the package in between. A quote is single-use and stops being honored at
+// ExpiresAt.
+//
+// Send is the transaction's send as quoted, before fees, and is what an
+// incoming transaction is matched against. FeesApplied is the fee-adjusted
+// transaction the quote charges.
+type FeeQuote struct {
+	ID             string            `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	OrganizationID string            `json:"organizationId" example:"00000000-0000-0000-0000-000000000000"`
+	LedgerID       string            `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000"`
+	PackageID      string            `json:"packageId" example:"00000000-0000-0000-0000-000000000000"`
+	Status         string            `json:"status" example:"OPEN" enums:"OPEN,USED,EXPIRED"`
+	Send           transaction.Send  `json:"-"`
+	FeesApplied    FeeEstimateResult `json:"feesApplied"`
+	ExpiresAt      time.Time         `json:"expiresAt" example:"2026-01-01T00:15:00Z"`
+	UsedAt         *time.Time        `json:"usedAt,omitempty" example:"2026-01-01T00:05:00Z"`
+	CreatedAt      time.Time         `json:"createdAt" example:"2026-01-01T00:00:00Z"`
+}
+
+// StatusAt returns the quote's status at t: USED once a transaction charged
+// it, EXPIRED when it was never used and t is past its expiry, OPEN otherwise.
+func (q *FeeQuote) StatusAt(t time.Time) string {
+	switch {
+	case q.UsedAt != nil:
+		return FeeQuoteStatusUsed
+	case !t.Before(q.ExpiresAt):
+		return FeeQuoteStatusExpired
+	default:
+		return FeeQuoteStatusOpen
+	}
+}
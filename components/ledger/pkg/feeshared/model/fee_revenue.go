@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// FeeRevenueCharged marks fees collected by their credit accounts.
+	FeeRevenueCharged = "CHARGED"
+
+	// FeeRevenueWaived marks fees priced but not charged, because the payers
+	// were exempt or a promotion waived them.
+	FeeRevenueWaived = "WAIVED"
+
+	// FeeRevenueRefunded marks fees returned to their payers by a revert.
+	FeeRevenueRefunded = "REFUNDED"
+)
+
+const (
+	// FeeWaiverExemption is the reason of a fee waived because its payers were
+	// all exempt (waived accounts or waived segments).
+	FeeWaiverExemption = "exemption"
+
+	// FeeWaiverPromotion is the reason of a fee waived by a promotion.
+	FeeWaiverPromotion = "promotion"
+)
+
+const (
+	// FeeRevenueByPackage groups a revenue report by fee package.
+	FeeRevenueByPackage = "package"
+
+	// FeeRevenueByFeeLabel groups a revenue report by the label of the fee.
+	FeeRevenueByFeeLabel = "feeLabel"
+
+	// FeeRevenueByRoute groups a revenue report by transaction route.
+	FeeRevenueByRoute = "route"
+
+	// FeeRevenueBySegment groups a revenue report by the segment the package
+	// is scoped to.
+	FeeRevenueBySegment = "segment"
+)
+
+const (
+	// FeeRevenuePeriodDay splits a revenue report by calendar day (UTC).
+	FeeRevenuePeriodDay = "day"
+
+	// FeeRevenuePeriodMonth splits a revenue report by calendar month (UTC).
+	FeeRevenuePeriodMonth = "month"
+
+	// FeeRevenuePeriodNone reports the whole range as one period.
+	FeeRevenuePeriodNone = "none"
+)
+
+// MaxFeeRevenueRange is the widest range a revenue report covers.
+const MaxFeeRevenueRange = 366 * 24 * time.Hour
+
+// FeeRevenueEntry is what one package fee charged, waived or refunded on one
+// transaction. Entries are recorded once the transaction is posted and are what
+// revenue reports aggregate.
+type FeeRevenueEntry struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organizationId"`
+	LedgerID       string `json:"ledgerId"`
+	TransactionID  string `json:"transactionId"`
+	PackageID      string `json:"packageId"`
+	PackageVersion int    `json:"packageVersion"`
+	FeeKey         string `json:"feeKey"`
+	FeeLabel       string `json:"feeLabel"`
+	// Route is the transaction route; SegmentID the segment the package is
+	// scoped to. Both are empty when there is none.
+	Route         string          `json:"route,omitempty"`
+	SegmentID     string          `json:"segmentId,omitempty"`
+	Asset         string          `json:"asset"`
+	CreditAccount string          `json:"creditAccount,omitempty"`
+	Kind          string          `json:"kind"`
+	Reason        string          `json:"reason,omitempty"`
+	Amount        decimal.Decimal `json:"amount"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+// PendingFeeRevenue is the revenue of a posted transaction that could not be
+// recorded, kept until a retry records it. Entries without a FeeLabel have not
+// been labeled from their package yet.
+type PendingFeeRevenue struct {
+	TransactionID  string
+	OrganizationID string
+	Entries        []*FeeRevenueEntry
+	Attempts       int64
+	LastAttemptAt  time.Time
+}
+
+// FeeRevenueQuery selects and groups the entries of a revenue report. Rows are
+// always split by asset, since amounts of different assets cannot be added.
+type FeeRevenueQuery struct {
+	From      time.Time
+	To        time.Time
+	LedgerID  string
+	PackageID string
+	FeeLabel  string
+	Route     string
+	SegmentID string
+	Asset     string
+	GroupBy   []string
+	Period    string
+}
+
+// Validate checks the range and the grouping of the query.
+func (q *FeeRevenueQuery) Validate() error {
+	if !q.From.Before(q.To) || q.To.Sub(q.From) > MaxFeeRevenueRange {
+		return pkg.ValidateBusinessError(constant.ErrFeeRevenueRangeInvalid, constant.EntityFeeRevenue)
+	}
+
+	for _, dimension := range q.GroupBy {
+		switch dimension {
+		case FeeRevenueByPackage, FeeRevenueByFeeLabel, FeeRevenueByRoute, FeeRevenueBySegment:
+		default:
+			return pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityFeeRevenue, "groupBy")
+		}
+	}
+
+	switch q.Period {
+	case FeeRevenuePeriodDay, FeeRevenuePeriodMonth, FeeRevenuePeriodNone:
+	default:
+		return pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityFeeRevenue, "period")
+	}
+
+	return nil
+}
+
+// Groups reports whether the query groups rows by dimension.
+func (q *FeeRevenueQuery) Groups(dimension string) bool {
+	for _, d := range q.GroupBy {
+		if d == dimension {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FeeRevenueRow is the fee revenue of one period, asset and combination of the
+// grouped dimensions. Dimensions the report is not grouped by are empty.
+type FeeRevenueRow struct {
+	// Period is the UTC day ("2026-10-18") or month ("2026-10") of the row, or
+	// empty when the report is not split by period.
+	Period    string `json:"period,omitempty" example:"2026-10"`
+	PackageID string `json:"packageId,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	FeeLabel  string `json:"feeLabel,omitempty" example:"Transfer fee"`
+	Route     string `json:"route,omitempty" example:"pix_out"`
+	SegmentID string `json:"segmentId,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	Asset     string `json:"asset" example:"BRL"`
+	// Charged is what the credit accounts collected; Refunded what reverts
+	// returned of it and Net the difference.
+	Charged  decimal.Decimal `json:"charged" example:"1250.00"`
+	Refunded decimal.Decimal `json:"refunded" example:"50.00"`
+	Net      decimal.Decimal `json:"net" example:"1200.00"`
+	// Waived is what was priced but not charged, split into exemptions and
+	// promotions.
+	Waived          decimal.Decimal `json:"waived" example:"75.00"`
+	WaivedExemption decimal.Decimal `json:"waivedExemption" example:"25.00"`
+	WaivedPromotion decimal.Decimal `json:"waivedPromotion" example:"50.00"`
+	Transactions    int64           `json:"transactions" example:"420"`
+}
+
+// FeeRevenueReport is the fee revenue of an organization over a range.
+type FeeRevenueReport struct {
+	From    time.Time        `json:"from" example:"2026-10-01T00:00:00Z"`
+	To      time.Time        `json:"to" example:"2026-11-01T00:00:00Z"`
+	GroupBy []string         `json:"groupBy" example:"package,feeLabel"`
+	Period  string           `json:"period" example:"month"`
+	Items   []*FeeRevenueRow `json:"items"`
+}
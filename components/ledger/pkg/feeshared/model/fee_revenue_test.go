@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeRevenueQuery_Validate(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   FeeRevenueQuery
+		wantErr error
+	}{
+		{
+			name:  "month grouped by package and label",
+			query: FeeRevenueQuery{From: from, To: from.AddDate(0, 1, 0), Period: FeeRevenuePeriodMonth, GroupBy: []string{FeeRevenueByPackage, FeeRevenueByFeeLabel}},
+		},
+		{
+			name:  "a full leap year",
+			query: FeeRevenueQuery{From: from, To: from.Add(MaxFeeRevenueRange), Period: FeeRevenuePeriodNone},
+		},
+		{
+			name:    "to before from",
+			query:   FeeRevenueQuery{From: from, To: from.AddDate(0, 0, -1), Period: FeeRevenuePeriodDay},
+			wantErr: pkg.ValidateBusinessError(constant.ErrFeeRevenueRangeInvalid, constant.EntityFeeRevenue),
+		},
+		{
+			name:    "range too wide",
+			query:   FeeRevenueQuery{From: from, To: from.Add(MaxFeeRevenueRange + time.Second), Period: FeeRevenuePeriodMonth},
+			wantErr: pkg.ValidateBusinessError(constant.ErrFeeRevenueRangeInvalid, constant.EntityFeeRevenue),
+		},
+		{
+			name:    "unknown dimension",
+			query:   FeeRevenueQuery{From: from, To: from.AddDate(0, 1, 0), Period: FeeRevenuePeriodMonth, GroupBy: []string{"account"}},
+			wantErr: pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityFeeRevenue, "groupBy"),
+		},
+		{
+			name:    "unknown period",
+			query:   FeeRevenueQuery{From: from, To: from.AddDate(0, 1, 0), Period: "week"},
+			wantErr: pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityFeeRevenue, "period"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.query.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
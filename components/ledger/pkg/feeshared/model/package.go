@@ -11,6 +11,7 @@ import (
 	"github.com/LerianStudio/midaz/v4/pkg"
 	"github.com/LerianStudio/midaz/v4/pkg/constant"
 
+	"github.com/iancoleman/strcase"
 	"github.com/shopspring/decimal"
 )
 
@@ -44,8 +45,10 @@ func parseAmountDecimal(s string) (decimal.Decimal, error) {
 const (
 	FlatFee        = "flatFee"
 	OriginalAmount = "originalAmount"
+	FeeAmount      = "feeAmount"
 	Percentual     = "percentual"
 	MaxBetween     = "maxBetweenTypes"
+	Progressive    = "progressive"
 	Flat           = "flat"
 	Percentage     = "percentage"
 )
@@ -54,12 +57,30 @@ const (
 type Fee struct {
 	FeeLabel         string            `json:"feeLabel" validate:"required" example:"Taxa Administrativa"`
 	CalculationModel *CalculationModel `json:"calculationModel" validate:"required"`
-	ReferenceAmount  string            `json:"referenceAmount" validate:"oneof=originalAmount afterFeesAmount" example:"originalAmount"`
+	ReferenceAmount  string            `json:"referenceAmount" validate:"oneof=originalAmount afterFeesAmount feeAmount" example:"originalAmount"`
 	Priority         int               `json:"priority,omitempty" validate:"gte=0" example:"1"`
 	IsDeductibleFrom *bool             `json:"isDeductibleFrom" validate:"required" example:"true"`
 	CreditAccount    string            `json:"creditAccount" validate:"required" example:"conta_receita_taxas_adm"`
 	RouteFrom        *string           `json:"routeFrom,omitempty" example:"taxa_débito"`
 	RouteTo          *string           `json:"routeTo,omitempty" example:"taxa_crédito"`
+	// MinimumFee and MaximumFee bound the amount the calculation model yields:
+	// a smaller result is raised to MinimumFee and a larger one lowered to
+	// MaximumFee. Either may be omitted.
+	MinimumFee *string `json:"minimumFee,omitempty" example:"1.50" minimum:"0"`
+	MaximumFee *string `json:"maximumFee,omitempty" example:"50.00" minimum:"0"`
+	// BaseFee names the fee of the same package whose calculated amount this fee
+	// is calculated on (a tax levied on a fee). It is required with
+	// referenceAmount feeAmount and rejected with any other reference amount.
+	BaseFee *string `json:"baseFee,omitempty" example:"serviceFee"`
+	// Asset restricts the fee to transactions in that asset; the fee is skipped
+	// for any other. Unset applies it whatever the transaction asset.
+	Asset *string `json:"asset,omitempty" example:"USD"`
+	// SettlementAsset names the asset the fee is settled in outside the
+	// ledger. The fee is not converted into it: the fee is still charged and
+	// posted in the transaction asset, and its leg is only annotated with the
+	// equivalent in SettlementAsset at the ledger's asset rate. Without a rate
+	// for the pair the leg is left unannotated.
+	SettlementAsset *string `json:"settlementAsset,omitempty" example:"BRL"`
 }
 
 func (f *Fee) GetIsDeductibleFrom() bool {
@@ -70,6 +91,36 @@ func (f *Fee) GetIsDeductibleFrom() bool {
 	return *f.IsDeductibleFrom
 }
 
+// GetBaseFee returns the key of the fee this fee is calculated on, normalized
+// the way package fee keys are stored, or "" when it is not a chained fee.
+func (f *Fee) GetBaseFee() string {
+	if f.BaseFee == nil {
+		return ""
+	}
+
+	return strcase.ToLowerCamel(*f.BaseFee)
+}
+
+// GetAsset returns the asset the fee is restricted to, or "" when it applies
+// to every asset.
+func (f *Fee) GetAsset() string {
+	if f.Asset == nil {
+		return ""
+	}
+
+	return *f.Asset
+}
+
+// GetSettlementAsset returns the asset the fee is settled in, or "" when it is
+// settled in the transaction asset.
+func (f *Fee) GetSettlementAsset() string {
+	if f.SettlementAsset == nil {
+		return ""
+	}
+
+	return *f.SettlementAsset
+}
+
 func (f *Fee) GetRouteFrom() string {
 	if f.RouteFrom == nil {
 		return ""
@@ -88,14 +139,33 @@ func (f *Fee) GetRouteTo() string {
 
 // CalculationModel structure for marshaling/unmarshalling JSON.
 type CalculationModel struct {
-	ApplicationRule string        `json:"applicationRule" validate:"oneof=maxBetweenTypes flatFee percentual" example:"maxBetweenTypes"`
+	ApplicationRule string        `json:"applicationRule" validate:"oneof=maxBetweenTypes flatFee percentual progressive" example:"maxBetweenTypes"`
 	Calculations    []Calculation `json:"calculations" validate:"dive"`
 }
 
 // Calculation structure for marshaling/unmarshalling JSON.
+//
+// Under the progressive application rule each calculation is a band covering the
+// part of the reference amount between the previous band's UpTo (zero for the
+// first band) and its own UpTo; the last band leaves UpTo unset and covers the
+// rest. UpTo is rejected under every other rule.
+//
+// A flat calculation may set AssetValues to charge a different amount per
+// transaction asset; Value applies to any asset without an entry.
 type Calculation struct {
-	Type  string `json:"type" validate:"oneof=percentage flat" example:"percentage" enums:"percentage,flat"`
-	Value string `json:"value" validate:"required" example:"100.00"`
+	Type        string            `json:"type" validate:"oneof=percentage flat" example:"percentage" enums:"percentage,flat"`
+	Value       string            `json:"value" validate:"required" example:"100.00"`
+	UpTo        *string           `json:"upTo,omitempty" example:"5000.00"`
+	AssetValues map[string]string `json:"assetValues,omitempty"`
+}
+
+// ValueFor returns the calculation value that applies to a transaction in asset.
+func (c Calculation) ValueFor(asset string) string {
+	if value, ok := c.AssetValues[asset]; ok {
+		return value
+	}
+
+	return c.Value
 }
 
 // validateCalculationModel validate the calculation model
@@ -112,6 +182,10 @@ func validateCalculationModel(model *CalculationModel, minAmount, feeKey string,
 		return err
 	}
 
+	if err := validateCalculationBands(model, feeKey); err != nil {
+		return err
+	}
+
 	if err := validateCalculationValues(model, minAmount, feeKey, isDeductibleFrom); err != nil {
 		return err
 	}
@@ -129,11 +203,107 @@ func validateCalculationCountForRule(model *CalculationModel, feeKey string) err
 		if len(model.Calculations) == 1 || len(model.Calculations) == 0 {
 			return pkg.ValidateBusinessError(constant.ErrAppRuleMaxBetweenTypes, "", feeKey)
 		}
+	case Progressive:
+		if len(model.Calculations) == 0 {
+			return pkg.ValidateBusinessError(constant.ErrProgressiveBandsInvalid, "", feeKey)
+		}
 	}
 
 	return nil
 }
 
+// validateCalculationBands validates the band limits of a progressive model:
+// every band but the last closes at a positive UpTo greater than the previous
+// one, and the last band is open-ended. Other rules must not set UpTo at all.
+func validateCalculationBands(model *CalculationModel, feeKey string) error {
+	if model.ApplicationRule != Progressive {
+		for _, calc := range model.Calculations {
+			if calc.UpTo != nil {
+				return pkg.ValidateBusinessError(constant.ErrCalculationUpToNotAllowed, "", feeKey)
+			}
+		}
+
+		return nil
+	}
+
+	lowerBound := decimal.Zero
+	last := len(model.Calculations) - 1
+
+	for i, calc := range model.Calculations {
+		if i == last {
+			if calc.UpTo != nil {
+				return pkg.ValidateBusinessError(constant.ErrProgressiveBandsInvalid, "", feeKey)
+			}
+
+			break
+		}
+
+		if calc.UpTo == nil {
+			return pkg.ValidateBusinessError(constant.ErrProgressiveBandsInvalid, "", feeKey)
+		}
+
+		upTo, err := parseAmountDecimal(*calc.UpTo)
+		if err != nil {
+			return pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", feeKey+".calculationModel.calculations.upTo")
+		}
+
+		if !upTo.GreaterThan(lowerBound) {
+			return pkg.ValidateBusinessError(constant.ErrProgressiveBandsInvalid, "", feeKey)
+		}
+
+		lowerBound = upTo
+	}
+
+	return nil
+}
+
+// validateFeeLimits validates the optional minimumFee/maximumFee of a fee. Both
+// must be non-negative and the minimum may not exceed the maximum. A deductible
+// fee is taken out of the transaction itself, so its minimumFee may not exceed
+// the package's minimum amount either, mirroring the rule for flat calculations.
+func validateFeeLimits(fee Fee, minAmount, feeKey string) error {
+	minFee, hasMin, err := parseFeeLimit(fee.MinimumFee, feeKey+".minimumFee")
+	if err != nil {
+		return err
+	}
+
+	maxFee, hasMax, err := parseFeeLimit(fee.MaximumFee, feeKey+".maximumFee")
+	if err != nil {
+		return err
+	}
+
+	if hasMin && hasMax && minFee.GreaterThan(maxFee) {
+		return pkg.ValidateBusinessError(constant.ErrMinFeeGreaterThanMaxFee, "", feeKey)
+	}
+
+	if hasMin && minAmount != "" && fee.GetIsDeductibleFrom() {
+		minAmountDecimal, errMinAmt := parseAmountDecimal(minAmount)
+		if errMinAmt != nil {
+			return pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", feeKey+".minimumAmount")
+		}
+
+		if minFee.GreaterThan(minAmountDecimal) {
+			return pkg.ValidateBusinessError(constant.ErrCalculationValueFlatFee, "", minAmount, feeKey)
+		}
+	}
+
+	return nil
+}
+
+// parseFeeLimit parses an optional fee limit, reporting whether it was set.
+func parseFeeLimit(raw *string, field string) (decimal.Decimal, bool, error) {
+	if raw == nil {
+		return decimal.Decimal{}, false, nil
+	}
+
+	value, err := parseAmountDecimal(*raw)
+	if err != nil || value.IsNegative() {
+		return decimal.Decimal{}, false, pkg.ValidateBusinessError(constant.ErrConvertToDecimal, "", field)
+	}
+
+	return value, true, nil
+}
+
 func validateCalculationRuleAndTypes(model *CalculationModel, feeKey string) error {
 	switch model.ApplicationRule {
 	case FlatFee:
@@ -202,6 +372,14 @@ func (f *Fee) ValidateNewFee(feeKey string, minAmount decimal.Decimal) error {
 		return err
 	}
 
+	if err := validateCalculationBands(f.CalculationModel, feeKey); err != nil {
+		return err
+	}
+
+	if err := validateFeeLimits(*f, minAmount.String(), feeKey); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -280,20 +458,33 @@ func (f *Fee) validateApplicationRule(feeKey string) error {
 }
 
 func (f *Fee) formatCalculationFieldName(c Calculation) map[string]any {
-	return map[string]any{
+	fields := map[string]any{
 		"type":  c.Type,
 		"value": c.Value,
 	}
+
+	if c.UpTo != nil {
+		fields["up_to"] = *c.UpTo
+	}
+
+	if len(c.AssetValues) > 0 {
+		fields["asset_values"] = c.AssetValues
+	}
+
+	return fields
 }
 
 // Validation of reference amount possible values
 func (f *Fee) validateReferenceAmountIsInvalid() bool {
-	return f.ReferenceAmount != feeconstant.ReferenceAmountOriginalAmount && f.ReferenceAmount != feeconstant.ReferenceAmountAfterFeesAmount
+	return f.ReferenceAmount != feeconstant.ReferenceAmountOriginalAmount &&
+		f.ReferenceAmount != feeconstant.ReferenceAmountAfterFeesAmount &&
+		f.ReferenceAmount != feeconstant.ReferenceAmountFeeAmount
 }
 
 // Validation of application rule possible values
 func (f *Fee) validateAppRuleIsInvalid() bool {
 	return f.CalculationModel.ApplicationRule != "maxBetweenTypes" &&
 		f.CalculationModel.ApplicationRule != "flatFee" &&
-		f.CalculationModel.ApplicationRule != "percentual"
+		f.CalculationModel.ApplicationRule != "percentual" &&
+		f.CalculationModel.ApplicationRule != "progressive"
 }
@@ -2694,3 +2694,147 @@ func stringPtr(s string) *string {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestValidateCalculationModel_ProgressiveBands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		model   *CalculationModel
+		wantErr error
+	}{
+		{
+			name: "valid bands",
+			model: &CalculationModel{ApplicationRule: Progressive, Calculations: []Calculation{
+				{Type: Percentage, Value: "2", UpTo: stringPtr("1000")},
+				{Type: Percentage, Value: "1.5", UpTo: stringPtr("5000")},
+				{Type: Percentage, Value: "1"},
+			}},
+		},
+		{
+			name: "single open band",
+			model: &CalculationModel{ApplicationRule: Progressive, Calculations: []Calculation{
+				{Type: Flat, Value: "3"},
+			}},
+		},
+		{
+			name:    "no bands",
+			model:   &CalculationModel{ApplicationRule: Progressive},
+			wantErr: constant.ErrProgressiveBandsInvalid,
+		},
+		{
+			name: "inner band without upTo",
+			model: &CalculationModel{ApplicationRule: Progressive, Calculations: []Calculation{
+				{Type: Percentage, Value: "2"},
+				{Type: Percentage, Value: "1"},
+			}},
+			wantErr: constant.ErrProgressiveBandsInvalid,
+		},
+		{
+			name: "last band closed",
+			model: &CalculationModel{ApplicationRule: Progressive, Calculations: []Calculation{
+				{Type: Percentage, Value: "2", UpTo: stringPtr("1000")},
+			}},
+			wantErr: constant.ErrProgressiveBandsInvalid,
+		},
+		{
+			name: "limits not increasing",
+			model: &CalculationModel{ApplicationRule: Progressive, Calculations: []Calculation{
+				{Type: Percentage, Value: "2", UpTo: stringPtr("1000")},
+				{Type: Percentage, Value: "1", UpTo: stringPtr("1000")},
+				{Type: Percentage, Value: "0.5"},
+			}},
+			wantErr: constant.ErrProgressiveBandsInvalid,
+		},
+		{
+			name: "upTo on a non-progressive rule",
+			model: &CalculationModel{ApplicationRule: Percentual, Calculations: []Calculation{
+				{Type: Percentage, Value: "2", UpTo: stringPtr("1000")},
+			}},
+			wantErr: constant.ErrCalculationUpToNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateCalculationModel(tt.model, "", "fee1", false)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, "", "fee1"), err)
+		})
+	}
+}
+
+func TestValidateFeeLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fee       Fee
+		minAmount string
+		wantErr   error
+	}{
+		{name: "no limits", fee: Fee{}},
+		{name: "floor and cap", fee: Fee{MinimumFee: stringPtr("1"), MaximumFee: stringPtr("50")}},
+		{name: "floor above cap", fee: Fee{MinimumFee: stringPtr("60"), MaximumFee: stringPtr("50")}, wantErr: constant.ErrMinFeeGreaterThanMaxFee},
+		{name: "negative cap", fee: Fee{MaximumFee: stringPtr("-1")}, wantErr: constant.ErrConvertToDecimal},
+		{name: "malformed floor", fee: Fee{MinimumFee: stringPtr("1,5")}, wantErr: constant.ErrConvertToDecimal},
+		{
+			name:      "deductible floor above package minimum",
+			fee:       Fee{MinimumFee: stringPtr("150"), IsDeductibleFrom: boolPtr(true)},
+			minAmount: "100",
+			wantErr:   constant.ErrCalculationValueFlatFee,
+		},
+		{
+			name:      "non-deductible floor above package minimum",
+			fee:       Fee{MinimumFee: stringPtr("150"), IsDeductibleFrom: boolPtr(false)},
+			minAmount: "100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFeeLimits(tt.fee, tt.minAmount, "fee1")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			switch e := err.(type) {
+			case pkg.ValidationError:
+				assert.Equal(t, tt.wantErr.Error(), e.Code)
+			case pkg.UnprocessableOperationError:
+				assert.Equal(t, tt.wantErr.Error(), e.Code)
+			case pkg.InternalServerError:
+				assert.Equal(t, tt.wantErr.Error(), e.Code)
+			default:
+				t.Fatalf("unexpected error type %T", err)
+			}
+		})
+	}
+}
+
+func TestFee_updateFeeLimits(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]Fee{"fee1": {MaximumFee: stringPtr("50")}}
+
+	upFields := bson.M{}
+	updated, err := (&Fee{MinimumFee: stringPtr("5")}).updateFeeLimits(existing, "fee1", decimal.NewFromInt(100), upFields)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, "5", upFields["fees.fee1.minimum_fee"])
+	assert.NotContains(t, upFields, "fees.fee1.maximum_fee")
+
+	_, err = (&Fee{MinimumFee: stringPtr("60")}).updateFeeLimits(existing, "fee1", decimal.NewFromInt(100), bson.M{})
+	assert.Equal(t, pkg.ValidateBusinessError(constant.ErrMinFeeGreaterThanMaxFee, "", "fee1"), err)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// CreatePackageVersionInput schedules a future fee schedule for a package. Every
+// omitted field is inherited from the version in force just before EffectiveFrom;
+// when Fee is set it replaces that version's fee map as a whole.
+type CreatePackageVersionInput struct {
+	EffectiveFrom  time.Time      `json:"effectiveFrom" validate:"required" example:"2026-01-01T00:00:00Z" format:"date-time"`
+	MinAmount      *string        `json:"minimumAmount,omitempty" example:"100.00" minimum:"0"`
+	MaxAmount      *string        `json:"maximumAmount,omitempty" example:"1000.20" minimum:"0"`
+	WaivedAccounts *[]string      `json:"waivedAccounts,omitempty" example:"[\"acc001\", \"acc002\"]"`
+	Fee            map[string]Fee `json:"fees,omitempty" validate:"omitempty,min=1,dive"`
+}
+
+// ValidateMinAndMaxAmount validates the amounts that are present and, when both
+// are, that the minimum does not exceed the maximum.
+func (pvi *CreatePackageVersionInput) ValidateMinAndMaxAmount() error {
+	up := UpdatePackageInput{MinAmount: pvi.MinAmount, MaxAmount: pvi.MaxAmount}
+
+	if err := up.ValidateMinAndMaxAmount(); err != nil {
+		return err
+	}
+
+	return up.ValidateMinAndMaxAmountValue()
+}
+
+// ValidateFees validates the replacement fee map against the minimum amount the
+// version will apply, with the same rules a package create enforces.
+func (pvi *CreatePackageVersionInput) ValidateFees(minAmount string) error {
+	seenPriorities := make(map[int]bool, len(pvi.Fee))
+
+	for key, fee := range pvi.Fee {
+		if fee.Priority == 1 && fee.ReferenceAmount != OriginalAmount {
+			return pkg.ValidateBusinessError(constant.ErrPriorityOne, "", key)
+		}
+
+		if fee.GetIsDeductibleFrom() && fee.ReferenceAmount != OriginalAmount {
+			return pkg.ValidateBusinessError(constant.ErrIsDeductibleFrom, "", key)
+		}
+
+		if err := validateCalculationModel(fee.CalculationModel, minAmount, key, fee.GetIsDeductibleFrom()); err != nil {
+			return err
+		}
+
+		if err := validateFeeLimits(fee, minAmount, key); err != nil {
+			return err
+		}
+
+		if seenPriorities[fee.Priority] {
+			return pkg.ValidateBusinessError(constant.ErrPriorityInvalid, "")
+		}
+
+		seenPriorities[fee.Priority] = true
+	}
+
+	return ValidateFeeChain(pvi.Fee)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// PromotionPeriodDay resets a promotion's usage every calendar day (UTC).
+	PromotionPeriodDay = "day"
+
+	// PromotionPeriodMonth resets a promotion's usage every calendar month (UTC).
+	PromotionPeriodMonth = "month"
+
+	// PromotionPeriodYear resets a promotion's usage every calendar year (UTC).
+	PromotionPeriodYear = "year"
+
+	// PromotionPeriodLifetime never resets a promotion's usage. It is the
+	// period of promotions that do not set one.
+	PromotionPeriodLifetime = "lifetime"
+)
+
+// CreatePromotionInput is the payload for adding a promotion to a package.
+//
+// A promotion waives the package's fees for one account alias or for every
+// account of one holder while it is active. With no limit every fee is waived;
+// FreeTransactions waives the fees of the first N transactions of each period,
+// and FeeCap stops charging fees once the payer has paid that amount in the
+// period.
+type CreatePromotionInput struct {
+	Label            string     `json:"label" validate:"required,max=256" example:"First 10 transfers free"`
+	AccountAlias     *string    `json:"accountAlias,omitempty" validate:"omitempty,max=256" example:"@customer"`
+	HolderID         *string    `json:"holderId,omitempty" validate:"omitempty,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	StartsAt         *time.Time `json:"startsAt,omitempty" example:"2026-01-01T00:00:00Z" format:"date-time"`
+	EndsAt           *time.Time `json:"endsAt,omitempty" example:"2026-02-01T00:00:00Z" format:"date-time"`
+	FreeTransactions *int       `json:"freeTransactions,omitempty" example:"10"`
+	FeeCap           *string    `json:"feeCap,omitempty" example:"50.00"`
+	Period           string     `json:"period,omitempty" validate:"omitempty,oneof=day month year lifetime" example:"month"`
+}
+
+// Validate checks that the promotion targets exactly one account or holder, sets
+// at most one positive limit, and has a window that has not already ended.
+func (in *CreatePromotionInput) Validate(now time.Time) error {
+	hasAlias := in.AccountAlias != nil && strings.TrimSpace(*in.AccountAlias) != ""
+	hasHolder := in.HolderID != nil && *in.HolderID != ""
+
+	if hasAlias == hasHolder {
+		return pkg.ValidateBusinessError(constant.ErrPromotionTargetInvalid, "")
+	}
+
+	if in.FreeTransactions != nil && in.FeeCap != nil {
+		return pkg.ValidateBusinessError(constant.ErrPromotionLimitInvalid, "")
+	}
+
+	if in.FreeTransactions != nil && *in.FreeTransactions < 1 {
+		return pkg.ValidateBusinessError(constant.ErrPromotionLimitInvalid, "")
+	}
+
+	if in.FeeCap != nil {
+		feeCap, err := parseAmountDecimal(*in.FeeCap)
+		if err != nil || !feeCap.IsPositive() {
+			return pkg.ValidateBusinessError(constant.ErrPromotionLimitInvalid, "")
+		}
+	}
+
+	if in.EndsAt != nil {
+		if !in.EndsAt.After(now) || (in.StartsAt != nil && !in.EndsAt.After(*in.StartsAt)) {
+			return pkg.ValidateBusinessError(constant.ErrPromotionWindowInvalid, "")
+		}
+	}
+
+	return nil
+}
+
+// GetPeriod returns the usage period, defaulting to lifetime.
+func (in *CreatePromotionInput) GetPeriod() string {
+	if in.Period == "" {
+		return PromotionPeriodLifetime
+	}
+
+	return in.Period
+}
+
+// PromotionPeriodKey returns the key of the usage period containing t: the UTC
+// date for daily periods, the year and month for monthly ones, the year for
+// yearly ones and "lifetime" otherwise.
+func PromotionPeriodKey(period string, t time.Time) string {
+	t = t.UTC()
+
+	switch period {
+	case PromotionPeriodDay:
+		return t.Format("2006-01-02")
+	case PromotionPeriodMonth:
+		return t.Format("2006-01")
+	case PromotionPeriodYear:
+		return t.Format("2006")
+	default:
+		return PromotionPeriodLifetime
+	}
+}
+
+// PromotionUsage is the usage of a promotion by one subject (the account alias
+// or holder it targets) in one period.
+type PromotionUsage struct {
+	OrganizationID string `json:"organizationId" example:"00000000-0000-0000-0000-000000000000"`
+	PackageID      string `json:"packageId" example:"00000000-0000-0000-0000-000000000000"`
+	PromotionID    string `json:"promotionId" example:"00000000-0000-0000-0000-000000000000"`
+	// Subject is the account alias or holder ID the usage is counted for.
+	Subject string `json:"subject" example:"@customer"`
+	// Period is the key of the usage period, e.g. "2026-10" for a monthly one.
+	Period string `json:"period" example:"2026-10"`
+	// Transactions counts the subject's transactions posted while the promotion
+	// was active.
+	Transactions int64 `json:"transactions" example:"3"`
+	// FeesCharged is the amount of fees the subject still paid.
+	FeesCharged decimal.Decimal `json:"feesCharged" example:"12.50"`
+	// FeesWaived is the amount of fees the promotion waived.
+	FeesWaived decimal.Decimal `json:"feesWaived" example:"7.50"`
+	CreatedAt  time.Time       `json:"createdAt" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt  time.Time       `json:"updatedAt" example:"2021-01-01T00:00:00Z"`
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePromotionInput_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+	earlier := now.Add(-24 * time.Hour)
+	zero := 0
+	ten := 10
+
+	tests := []struct {
+		name    string
+		input   CreatePromotionInput
+		wantErr error
+	}{
+		{
+			name:  "unlimited promotion for an account",
+			input: CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer")},
+		},
+		{
+			name:  "capped promotion for a holder",
+			input: CreatePromotionInput{Label: "Cap", HolderID: stringPtr("0192f0a3-7a52-7d0e-9c3b-2d7a6f3e1a10"), FeeCap: stringPtr("50.00"), Period: PromotionPeriodMonth},
+		},
+		{
+			name:  "free transactions until a date",
+			input: CreatePromotionInput{Label: "Launch", AccountAlias: stringPtr("@customer"), FreeTransactions: &ten, EndsAt: &later},
+		},
+		{
+			name:    "no target",
+			input:   CreatePromotionInput{Label: "Free"},
+			wantErr: constant.ErrPromotionTargetInvalid,
+		},
+		{
+			name:    "account and holder",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), HolderID: stringPtr("0192f0a3-7a52-7d0e-9c3b-2d7a6f3e1a10")},
+			wantErr: constant.ErrPromotionTargetInvalid,
+		},
+		{
+			name:    "both limits",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), FreeTransactions: &ten, FeeCap: stringPtr("50")},
+			wantErr: constant.ErrPromotionLimitInvalid,
+		},
+		{
+			name:    "zero free transactions",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), FreeTransactions: &zero},
+			wantErr: constant.ErrPromotionLimitInvalid,
+		},
+		{
+			name:    "non-positive fee cap",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), FeeCap: stringPtr("0")},
+			wantErr: constant.ErrPromotionLimitInvalid,
+		},
+		{
+			name:    "already ended",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), EndsAt: &earlier},
+			wantErr: constant.ErrPromotionWindowInvalid,
+		},
+		{
+			name:    "ends before it starts",
+			input:   CreatePromotionInput{Label: "Free", AccountAlias: stringPtr("@customer"), StartsAt: &later, EndsAt: &later},
+			wantErr: constant.ErrPromotionWindowInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.input.Validate(now)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, ""), err)
+		})
+	}
+}
+
+func TestPromotionPeriodKey(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 10, 18, 23, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	assert.Equal(t, "2026-10-19", PromotionPeriodKey(PromotionPeriodDay, at))
+	assert.Equal(t, "2026-10", PromotionPeriodKey(PromotionPeriodMonth, at))
+	assert.Equal(t, "2026", PromotionPeriodKey(PromotionPeriodYear, at))
+	assert.Equal(t, PromotionPeriodLifetime, PromotionPeriodKey(PromotionPeriodLifetime, at))
+	assert.Equal(t, PromotionPeriodLifetime, (&CreatePromotionInput{}).GetPeriod())
+}
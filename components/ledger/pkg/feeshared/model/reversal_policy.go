@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+
+	"github.com/iancoleman/strcase"
+)
+
+const (
+	// ReversalRefundAll returns every fee to its payer when the transaction is
+	// reverted. It is the behavior of packages without a reversal policy.
+	ReversalRefundAll = "refundAll"
+
+	// ReversalKeepFees keeps every fee: the revert returns the principal only.
+	ReversalKeepFees = "keepFees"
+
+	// ReversalChargeFee returns every fee and charges the payer a flat reversal
+	// fee out of the refund.
+	ReversalChargeFee = "chargeReversalFee"
+)
+
+// ReversalPolicy defines what happens to the fees a package charged when the
+// transaction they were charged on is reverted.
+type ReversalPolicy struct {
+	// Mode selects how the package's fees are treated on revert.
+	Mode string `json:"mode" validate:"required,oneof=refundAll keepFees chargeReversalFee" example:"refundAll"`
+	// NonRefundableFees lists fees kept on revert whatever the mode, e.g. a
+	// processing fee in a package whose FX fee is refundable.
+	NonRefundableFees []string `json:"nonRefundableFees,omitempty" example:"[\"processingFee\"]"`
+	// ReversalFee is the flat amount charged on revert in mode chargeReversalFee.
+	ReversalFee *string `json:"reversalFee,omitempty" example:"2.50"`
+	// ReversalFeeCreditAccount is the account credited with the reversal fee.
+	ReversalFeeCreditAccount *string `json:"reversalFeeCreditAccount,omitempty" example:"@reversal_fees"`
+}
+
+// IsNonRefundable reports whether the fee stored under feeKey is kept on revert
+// regardless of the policy mode.
+func (rp *ReversalPolicy) IsNonRefundable(feeKey string) bool {
+	if rp == nil {
+		return false
+	}
+
+	for _, key := range rp.NonRefundableFees {
+		if strcase.ToLowerCamel(key) == feeKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetReversalFeeCreditAccount returns the reversal fee credit account, or an
+// empty string when none is set.
+func (rp *ReversalPolicy) GetReversalFeeCreditAccount() string {
+	if rp == nil || rp.ReversalFeeCreditAccount == nil {
+		return ""
+	}
+
+	return *rp.ReversalFeeCreditAccount
+}
+
+// Validate checks the policy against the package's fee keys: the mode must be
+// one of the reversal modes, the reversal fee fields must be set exactly when the mode charges
+// one, the reversal fee must be a positive amount, and every non-refundable fee
+// must exist in the package.
+func (rp *ReversalPolicy) Validate(feeKeys map[string]struct{}) error {
+	if rp == nil {
+		return nil
+	}
+
+	switch rp.Mode {
+	case ReversalRefundAll, ReversalKeepFees, ReversalChargeFee:
+	default:
+		return pkg.ValidateBusinessError(constant.ErrReversalPolicyInvalid, "")
+	}
+
+	charges := rp.Mode == ReversalChargeFee
+
+	if charges != (rp.ReversalFee != nil) || charges != (rp.GetReversalFeeCreditAccount() != "") {
+		return pkg.ValidateBusinessError(constant.ErrReversalPolicyInvalid, "")
+	}
+
+	if charges {
+		amount, err := parseAmountDecimal(*rp.ReversalFee)
+		if err != nil || !amount.IsPositive() {
+			return pkg.ValidateBusinessError(constant.ErrReversalPolicyInvalid, "")
+		}
+	}
+
+	for _, key := range rp.NonRefundableFees {
+		if _, ok := feeKeys[strcase.ToLowerCamel(key)]; !ok {
+			return pkg.ValidateBusinessError(constant.ErrReversalPolicyUnknownFee, "", key)
+		}
+	}
+
+	return nil
+}
+
+// FeeKeys returns the set of fee keys in their stored (lowerCamel) form.
+func FeeKeys(fees ...map[string]Fee) map[string]struct{} {
+	keys := make(map[string]struct{})
+
+	for _, m := range fees {
+		for key := range m {
+			keys[strcase.ToLowerCamel(key)] = struct{}{}
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReversalPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	fees := map[string]Fee{"processing_fee": {}, "fxFee": {}}
+
+	tests := []struct {
+		name     string
+		policy   *ReversalPolicy
+		wantErr  error
+		wantArgs []any
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+		},
+		{
+			name:   "refund all with a non-refundable fee in either key form",
+			policy: &ReversalPolicy{Mode: ReversalRefundAll, NonRefundableFees: []string{"processing_fee", "fxFee"}},
+		},
+		{
+			name:   "charge reversal fee",
+			policy: &ReversalPolicy{Mode: ReversalChargeFee, ReversalFee: stringPtr("2.50"), ReversalFeeCreditAccount: stringPtr("@reversal_fees")},
+		},
+		{
+			name:    "charge reversal fee without amount",
+			policy:  &ReversalPolicy{Mode: ReversalChargeFee, ReversalFeeCreditAccount: stringPtr("@reversal_fees")},
+			wantErr: constant.ErrReversalPolicyInvalid,
+		},
+		{
+			name:    "charge reversal fee without credit account",
+			policy:  &ReversalPolicy{Mode: ReversalChargeFee, ReversalFee: stringPtr("2.50")},
+			wantErr: constant.ErrReversalPolicyInvalid,
+		},
+		{
+			name:    "charge reversal fee with zero amount",
+			policy:  &ReversalPolicy{Mode: ReversalChargeFee, ReversalFee: stringPtr("0"), ReversalFeeCreditAccount: stringPtr("@reversal_fees")},
+			wantErr: constant.ErrReversalPolicyInvalid,
+		},
+		{
+			name:    "reversal fee outside chargeReversalFee mode",
+			policy:  &ReversalPolicy{Mode: ReversalKeepFees, ReversalFee: stringPtr("2.50")},
+			wantErr: constant.ErrReversalPolicyInvalid,
+		},
+		{
+			name:    "unknown mode",
+			policy:  &ReversalPolicy{Mode: "proportional"},
+			wantErr: constant.ErrReversalPolicyInvalid,
+		},
+		{
+			name:     "unknown non-refundable fee",
+			policy:   &ReversalPolicy{Mode: ReversalKeepFees, NonRefundableFees: []string{"wireFee"}},
+			wantErr:  constant.ErrReversalPolicyUnknownFee,
+			wantArgs: []any{"wireFee"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.policy.Validate(FeeKeys(fees))
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, pkg.ValidateBusinessError(tt.wantErr, "", tt.wantArgs...), err)
+		})
+	}
+}
+
+func TestReversalPolicy_IsNonRefundable(t *testing.T) {
+	t.Parallel()
+
+	policy := &ReversalPolicy{Mode: ReversalRefundAll, NonRefundableFees: []string{"processing_fee"}}
+
+	assert.True(t, policy.IsNonRefundable("processingFee"))
+	assert.False(t, policy.IsNonRefundable("fxFee"))
+	assert.False(t, (*ReversalPolicy)(nil).IsNonRefundable("processingFee"))
+}
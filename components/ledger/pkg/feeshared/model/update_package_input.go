@@ -21,13 +21,14 @@ import (
 
 // UpdatePackageInput is a struct designed to update data.
 type UpdatePackageInput struct {
-	FeeGroupLabel  string         `json:"feeGroupLabel" example:"Pacote Padrão"`
-	Description    string         `json:"description" example:"Pacote de taxas administrativas padrão"`
-	MinAmount      *string        `json:"minimumAmount" example:"100" minimum:"0"`
-	MaxAmount      *string        `json:"maximumAmount" example:"1000" minimum:"0"`
-	WaivedAccounts *[]string      `json:"waivedAccounts" example:"acc001,acc002"`
-	Fee            map[string]Fee `json:"fees"`
-	EnablePackage  *bool          `json:"enable,omitempty" example:"true"`
+	FeeGroupLabel  string          `json:"feeGroupLabel" example:"Pacote Padrão"`
+	Description    string          `json:"description" example:"Pacote de taxas administrativas padrão"`
+	MinAmount      *string         `json:"minimumAmount" example:"100" minimum:"0"`
+	MaxAmount      *string         `json:"maximumAmount" example:"1000" minimum:"0"`
+	WaivedAccounts *[]string       `json:"waivedAccounts" example:"acc001,acc002"`
+	Fee            map[string]Fee  `json:"fees"`
+	EnablePackage  *bool           `json:"enable,omitempty" example:"true"`
+	ReversalPolicy *ReversalPolicy `json:"reversalPolicy,omitempty"`
 }
 
 // GetMinimumAmount returns the minimum amount value
@@ -68,12 +69,23 @@ func (up *UpdatePackageInput) ValidateFees() error {
 					return err
 				}
 			}
+
+			if err := validateFeeLimits(fee, up.GetMinimumAmount(), key); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// HasScheduleChanges reports whether an update touches the fee schedule (amount
+// range, waived accounts, fees or reversal policy), as opposed to descriptive
+// fields only.
+func (up *UpdatePackageInput) HasScheduleChanges() bool {
+	return up.MinAmount != nil || up.MaxAmount != nil || up.WaivedAccounts != nil || up.Fee != nil || up.ReversalPolicy != nil
+}
+
 // ValidateMinAndMaxAmount Validating if minimum amount value is greater than maximum amount value
 func (up *UpdatePackageInput) ValidateMinAndMaxAmount() error {
 	var (
@@ -154,6 +166,7 @@ type AmountData struct {
 	LedgerID         uuid.UUID
 	SegmentID        *uuid.UUID
 	TransactionRoute *string
+	Asset            *string
 }
 
 func (a *AmountData) GetTransactionRoute() string {
@@ -164,6 +177,16 @@ func (a *AmountData) GetTransactionRoute() string {
 	return *a.TransactionRoute
 }
 
+// GetAsset returns the asset the package is scoped to, or "" when it applies
+// to every asset.
+func (a *AmountData) GetAsset() string {
+	if a.Asset == nil {
+		return ""
+	}
+
+	return *a.Asset
+}
+
 func (f *Fee) SetAndValidateHasFieldsToUpdate(ctx context.Context, updateDeductibleFrom *bool, minAmount decimal.Decimal, existingFees map[string]Fee, feeKey string, organizationID, ledgerID uuid.UUID, upFields bson.M, resolver feeshared.MidazResolver) (bool, error) {
 	hasValueToUpdate := false
 
@@ -207,6 +230,20 @@ func (f *Fee) SetAndValidateHasFieldsToUpdate(ctx context.Context, updateDeducti
 		hasValueToUpdate = true
 	}
 
+	if updated, err := f.updateFeeLimits(existingFees, feeKey, minAmount, upFields); err != nil {
+		return false, err
+	} else {
+		hasValueToUpdate = hasValueToUpdate || updated
+	}
+
+	if updated := f.updateBaseFee(feeKey, upFields); updated {
+		hasValueToUpdate = true
+	}
+
+	if updated := f.updateAssets(feeKey, upFields); updated {
+		hasValueToUpdate = true
+	}
+
 	return hasValueToUpdate, nil
 }
 
@@ -372,6 +409,72 @@ func (f *Fee) updateRouteTo(feeKey string, upFields bson.M) bool {
 	return false
 }
 
+// updateBaseFee sets the fee this fee is calculated on. The chain as a whole is
+// validated once every fee of the update has been merged.
+func (f *Fee) updateBaseFee(feeKey string, upFields bson.M) bool {
+	if commons.IsNilOrEmpty(f.BaseFee) {
+		return false
+	}
+
+	upFields["fees."+feeKey+".base_fee"] = f.GetBaseFee()
+
+	return true
+}
+
+// updateAssets sets the asset the fee is restricted to and the asset it is
+// settled in. Both are validated with the rest of the update's fee assets.
+func (f *Fee) updateAssets(feeKey string, upFields bson.M) bool {
+	updated := false
+
+	if !commons.IsNilOrEmpty(f.Asset) {
+		upFields["fees."+feeKey+".asset"] = *f.Asset
+		updated = true
+	}
+
+	if !commons.IsNilOrEmpty(f.SettlementAsset) {
+		upFields["fees."+feeKey+".settlement_asset"] = *f.SettlementAsset
+		updated = true
+	}
+
+	return updated
+}
+
+// updateFeeLimits validates minimumFee/maximumFee against the limit the update
+// leaves untouched and the fee's resulting deductibility, then sets them.
+func (f *Fee) updateFeeLimits(existingFees map[string]Fee, feeKey string, minAmount decimal.Decimal, upFields bson.M) (bool, error) {
+	if f.MinimumFee == nil && f.MaximumFee == nil {
+		return false, nil
+	}
+
+	merged := existingFees[feeKey]
+
+	if f.MinimumFee != nil {
+		merged.MinimumFee = f.MinimumFee
+	}
+
+	if f.MaximumFee != nil {
+		merged.MaximumFee = f.MaximumFee
+	}
+
+	if f.IsDeductibleFrom != nil {
+		merged.IsDeductibleFrom = f.IsDeductibleFrom
+	}
+
+	if err := validateFeeLimits(merged, minAmount.String(), feeKey); err != nil {
+		return false, err
+	}
+
+	if f.MinimumFee != nil {
+		upFields["fees."+feeKey+".minimum_fee"] = *f.MinimumFee
+	}
+
+	if f.MaximumFee != nil {
+		upFields["fees."+feeKey+".maximum_fee"] = *f.MaximumFee
+	}
+
+	return true, nil
+}
+
 // setAndValidateCalculationModel handles calculation model validation and update logic
 func (f *Fee) setAndValidateCalculationModel(existingFees map[string]Fee, updateDeductibleFrom *bool, feeKey string, minAmount decimal.Decimal, upFields bson.M) (bool, error) {
 	if f.hasNoCalculationModelUpdates() {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 //go:generate mockgen --destination=./resolver_mock.go --package=pkg . MidazResolver
@@ -39,4 +40,13 @@ type MidazResolver interface {
 	// CountTransactionsByRoute returns the number of transactions matching the
 	// given route, status, and created-at window.
 	CountTransactionsByRoute(ctx context.Context, organizationID, ledgerID uuid.UUID, route, status string, startDate, endDate time.Time) (int64, error)
+
+	// GetAssetRate returns the rate converting one unit of asset from into asset
+	// to, or (nil, nil) when the ledger has no rate for the pair.
+	GetAssetRate(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error)
+
+	// AvailableBalance returns what the account with the given alias can send
+	// from its default balance in asset. It is zero when the account has no
+	// such balance or the balance does not allow sending.
+	AvailableBalance(ctx context.Context, organizationID, ledgerID uuid.UUID, alias, asset string) (decimal.Decimal, error)
 }
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,6 +57,21 @@ func (mr *MockMidazResolverMockRecorder) AccountExistsByAlias(ctx, organizationI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountExistsByAlias", reflect.TypeOf((*MockMidazResolver)(nil).AccountExistsByAlias), ctx, organizationID, ledgerID, alias)
 }
 
+// AvailableBalance mocks base method.
+func (m *MockMidazResolver) AvailableBalance(ctx context.Context, organizationID, ledgerID uuid.UUID, alias, asset string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableBalance", ctx, organizationID, ledgerID, alias, asset)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableBalance indicates an expected call of AvailableBalance.
+func (mr *MockMidazResolverMockRecorder) AvailableBalance(ctx, organizationID, ledgerID, alias, asset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableBalance", reflect.TypeOf((*MockMidazResolver)(nil).AvailableBalance), ctx, organizationID, ledgerID, alias, asset)
+}
+
 // CountTransactionsByRoute mocks base method.
 func (m *MockMidazResolver) CountTransactionsByRoute(ctx context.Context, organizationID, ledgerID uuid.UUID, route, status string, startDate, endDate time.Time) (int64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +102,21 @@ func (mr *MockMidazResolverMockRecorder) GetAccountByAlias(ctx, organizationID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByAlias", reflect.TypeOf((*MockMidazResolver)(nil).GetAccountByAlias), ctx, organizationID, ledgerID, alias)
 }
 
+// GetAssetRate mocks base method.
+func (m *MockMidazResolver) GetAssetRate(ctx context.Context, organizationID, ledgerID uuid.UUID, from, to string) (*decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetRate", ctx, organizationID, ledgerID, from, to)
+	ret0, _ := ret[0].(*decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetRate indicates an expected call of GetAssetRate.
+func (mr *MockMidazResolverMockRecorder) GetAssetRate(ctx, organizationID, ledgerID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetRate", reflect.TypeOf((*MockMidazResolver)(nil).GetAssetRate), ctx, organizationID, ledgerID, from, to)
+}
+
 // ListAccounts mocks base method.
 func (m *MockMidazResolver) ListAccounts(ctx context.Context, organizationID, ledgerID uuid.UUID, segmentID, portfolioID *uuid.UUID) ([]Account, error) {
 	m.ctrl.T.Helper()
@@ -101,7 +101,7 @@ func (e *Environment) CELEnv() *cel.Env {
 
 // NewEnvironment creates a CEL environment with all required transaction context variables.
 // Variables are aligned with model.ValidationRequest structure:
-//   - transactionType (string): CARD, WIRE, PIX, CRYPTO
+//   - transactionType (string): transaction-type catalog code (built-ins: CARD, WIRE, PIX, CRYPTO)
 //   - subType (string): debit, credit, instant, etc. (optional, empty string if nil)
 //   - amount (dyn): Decimal amount as float64 — dyn enables cross-type == with int literals
 //   - currency (string): ISO 4217 currency code
@@ -109,10 +109,15 @@ func (e *Environment) CELEnv() *cel.Env {
 //   - segment (map[string]dyn): Segment context (optional, empty map if nil)
 //   - portfolio (map[string]dyn): Portfolio context (optional, empty map if nil)
 //   - merchant (map[string]dyn): Merchant context (optional, empty map if nil)
+//   - device (map[string]dyn): Device context (optional, empty map if nil)
+//   - geolocation (map[string]dyn): Geolocation context (optional, empty map if nil)
+//   - counterparty (map[string]dyn): Counterparty context (optional, empty map if nil)
 //   - metadata (map[string]dyn): Custom metadata fields
 //   - transactionTimestamp (int): Unix timestamp in nanoseconds
+//
+// The environment also declares the helper functions listed in helperFunctions.
 func NewEnvironment() (*Environment, error) {
-	env, err := cel.NewEnv(
+	opts := []cel.EnvOption{
 		cel.CrossTypeNumericComparisons(true),
 
 		// Transaction fields (from ValidationRequest)
@@ -126,13 +131,18 @@ func NewEnvironment() (*Environment, error) {
 		cel.Variable("segment", cel.MapType(cel.StringType, cel.DynType)),
 		cel.Variable("portfolio", cel.MapType(cel.StringType, cel.DynType)),
 		cel.Variable("merchant", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("device", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("geolocation", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("counterparty", cel.MapType(cel.StringType, cel.DynType)),
 
 		// Metadata (custom fields)
 		cel.Variable("metadata", cel.MapType(cel.StringType, cel.DynType)),
 
 		// Timestamp (Unix timestamp in nanoseconds for precise time-based expressions)
 		cel.Variable("transactionTimestamp", cel.IntType),
-	)
+	}
+
+	env, err := cel.NewEnv(append(opts, helperFunctions()...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
 	}
@@ -216,6 +226,11 @@ func BuildActivation(req *model.ValidationRequest) (map[string]any, error) {
 		activation["merchant"] = emptyMap()
 	}
 
+	// Device, geolocation and counterparty contexts (optional - empty map if nil)
+	activation["device"] = contextMap(req.Device.ToMap())
+	activation["geolocation"] = contextMap(req.Geolocation.ToMap())
+	activation["counterparty"] = contextMap(req.Counterparty.ToMap())
+
 	// Metadata (optional - empty map if nil)
 	activation["metadata"] = safeMetadata(req.Metadata)
 
@@ -231,6 +246,16 @@ func emptyMap() map[string]any {
 	return map[string]any{}
 }
 
+// contextMap returns the converted context or an empty map when the context
+// was absent (ToMap on a nil receiver returns nil).
+func contextMap(m map[string]any) map[string]any {
+	if m != nil {
+		return m
+	}
+
+	return emptyMap()
+}
+
 // safeMetadata returns metadata or empty map if nil.
 // Ensures CEL expressions can safely access metadata fields.
 func safeMetadata(metadata map[string]any) map[string]any {
@@ -472,6 +472,14 @@ func TestBuildActivation_NilOptionalFields(t *testing.T) {
 				assert.Empty(t, portfolioMap, "portfolio should be empty map when nil")
 			}
 
+			for _, key := range []string{"device", "geolocation", "counterparty"} {
+				val, found := activation[key]
+				assert.True(t, found, "Activation should contain %s", key)
+				contextMap, ok := val.(map[string]any)
+				require.True(t, ok, "%s should be a map", key)
+				assert.Empty(t, contextMap, "%s should be empty map when nil", key)
+			}
+
 			metadataVal, found := activation["metadata"]
 			assert.True(t, found, "Activation should contain metadata")
 			metadataMap, ok := metadataVal.(map[string]any)
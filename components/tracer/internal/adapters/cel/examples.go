@@ -11,7 +11,7 @@ type ExampleExpression struct {
 	Name        string // Descriptive name for the expression
 	Expression  string // CEL expression string
 	Description string // What the expression checks
-	Category    string // Category: amount, transaction, account, merchant, scope, metadata, combined
+	Category    string // Category: amount, transaction, account, merchant, scope, fraud, metadata, combined
 }
 
 // AmountExpressions contains expressions that check transaction amounts.
@@ -182,6 +182,43 @@ var SegmentPortfolioExpressions = []ExampleExpression{
 	},
 }
 
+// FraudContextExpressions contains expressions that use the device, geolocation
+// and counterparty contexts together with the helper functions.
+var FraudContextExpressions = []ExampleExpression{
+	{
+		Name:        "new_device",
+		Expression:  `"firstSeenAt" in device && transactionTimestamp - device["firstSeenAt"] < 86400000000000`,
+		Description: "Device first seen less than 24 hours ago",
+		Category:    "fraud",
+	},
+	{
+		Name:        "ip_country_mismatch",
+		Expression:  `countryMismatch(device["ipCountry"], geolocation["country"])`,
+		Description: "IP geolocates to a different country than the device",
+		Category:    "fraud",
+	},
+	{
+		Name:        "private_ip",
+		Expression:  `ipInCidr(device["ipAddress"], "10.0.0.0/8")`,
+		Description: "Request originated from a private network range",
+		Category:    "fraud",
+	},
+	{
+		Name: "impossible_travel",
+		Expression: `"lastKnown" in geolocation && "latitude" in geolocation && ` +
+			`geoDistanceKm(geolocation["latitude"], geolocation["longitude"], ` +
+			`geolocation["lastKnown"]["latitude"], geolocation["lastKnown"]["longitude"]) > 500`,
+		Description: "More than 500 km from the last known location",
+		Category:    "fraud",
+	},
+	{
+		Name:        "counterparty_watchlisted_bank",
+		Expression:  `counterparty["bankCode"] in ["999", "998"]`,
+		Description: "Destination at a watchlisted bank",
+		Category:    "fraud",
+	},
+}
+
 // MetadataExpressions contains expressions that check custom metadata.
 // Metadata is accessed as map: metadata["key"]
 var MetadataExpressions = []ExampleExpression{
@@ -252,6 +289,7 @@ func AllExpressions() []ExampleExpression {
 		len(AccountExpressions) +
 		len(MerchantExpressions) +
 		len(SegmentPortfolioExpressions) +
+		len(FraudContextExpressions) +
 		len(MetadataExpressions) +
 		len(CombinedExpressions)
 
@@ -262,6 +300,7 @@ func AllExpressions() []ExampleExpression {
 	all = append(all, AccountExpressions...)
 	all = append(all, MerchantExpressions...)
 	all = append(all, SegmentPortfolioExpressions...)
+	all = append(all, FraudContextExpressions...)
 	all = append(all, MetadataExpressions...)
 	all = append(all, CombinedExpressions...)
 
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package cel
+
+import (
+	"math"
+	"net/netip"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// earthRadiusKm is the mean Earth radius (IUGG) used by geoDistanceKm.
+const earthRadiusKm = 6371.0088
+
+// helperFunctions returns the custom functions available to rule expressions
+// on top of the CEL standard library:
+//
+//   - geoDistanceKm(lat1, lon1, lat2, lon2) double: great-circle (haversine)
+//     distance in kilometers between two coordinates
+//   - countryMismatch(a, b) bool: true when both ISO country codes are present
+//     and differ (case-insensitive), e.g. device.ipCountry vs geolocation.country
+//   - ipInCidr(ip, cidr) bool: true when ip parses and falls inside the CIDR
+//     block; malformed input never matches
+//
+// All helpers are pure, so they do not affect program caching.
+func helperFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("geoDistanceKm",
+			cel.Overload("geo_distance_km_double_double_double_double",
+				[]*cel.Type{cel.DoubleType, cel.DoubleType, cel.DoubleType, cel.DoubleType},
+				cel.DoubleType,
+				cel.FunctionBinding(geoDistanceKm),
+			),
+		),
+		cel.Function("countryMismatch",
+			cel.Overload("country_mismatch_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(countryMismatch),
+			),
+		),
+		cel.Function("ipInCidr",
+			cel.Overload("ip_in_cidr_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.BinaryBinding(ipInCidr),
+			),
+		),
+	}
+}
+
+func geoDistanceKm(args ...ref.Val) ref.Val {
+	coords := make([]float64, len(args))
+
+	for i, arg := range args {
+		v, ok := arg.(types.Double)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(arg)
+		}
+
+		coords[i] = float64(v)
+	}
+
+	return types.Double(haversineKm(coords[0], coords[1], coords[2], coords[3]))
+}
+
+// haversineKm returns the great-circle distance between two points given in
+// decimal degrees.
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	const degToRad = math.Pi / 180
+
+	dLat := (lat2 - lat1) * degToRad
+	dLon := (lon2 - lon1) * degToRad
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*degToRad)*math.Cos(lat2*degToRad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func countryMismatch(lhs, rhs ref.Val) ref.Val {
+	a, ok := lhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(lhs)
+	}
+
+	b, ok := rhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(rhs)
+	}
+
+	x := strings.TrimSpace(string(a))
+	y := strings.TrimSpace(string(b))
+
+	return types.Bool(x != "" && y != "" && !strings.EqualFold(x, y))
+}
+
+func ipInCidr(lhs, rhs ref.Val) ref.Val {
+	ip, ok := lhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(lhs)
+	}
+
+	cidr, ok := rhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(rhs)
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(string(ip)))
+	if err != nil {
+		return types.False
+	}
+
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(string(cidr)))
+	if err != nil {
+		return types.False
+	}
+
+	return types.Bool(prefix.Contains(addr.Unmap()))
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package cel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+func TestHaversineKm(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		expectedKm             float64
+	}{
+		{name: "same point", lat1: -23.5505, lon1: -46.6333, lat2: -23.5505, lon2: -46.6333, expectedKm: 0},
+		{name: "Sao Paulo to Rio de Janeiro", lat1: -23.5505, lon1: -46.6333, lat2: -22.9068, lon2: -43.1729, expectedKm: 361},
+		{name: "Sao Paulo to Lisbon", lat1: -23.5505, lon1: -46.6333, lat2: 38.7223, lon2: -9.1393, expectedKm: 7949},
+		{name: "across the antimeridian", lat1: 0, lon1: 179.5, lat2: 0, lon2: -179.5, expectedKm: 111},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expectedKm, haversineKm(tc.lat1, tc.lon1, tc.lat2, tc.lon2), 1)
+		})
+	}
+}
+
+func TestHelperFunctions_Evaluate(t *testing.T) {
+	adapter := newTestAdapter(t)
+
+	lat, lon := -23.5505, -46.6333
+	firstSeen := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		mutate     func(req *model.ValidationRequest)
+		expected   bool
+	}{
+		{
+			name:       "countryMismatch true when countries differ",
+			expression: `countryMismatch(device["ipCountry"], geolocation["country"])`,
+			expected:   true,
+		},
+		{
+			name:       "countryMismatch is case-insensitive",
+			expression: `countryMismatch(device["ipCountry"], geolocation["country"])`,
+			mutate:     func(req *model.ValidationRequest) { req.Device.IPCountry = "br" },
+			expected:   false,
+		},
+		{
+			name:       "countryMismatch false when one side is empty",
+			expression: `countryMismatch(device["ipCountry"], geolocation["country"])`,
+			mutate:     func(req *model.ValidationRequest) { req.Device.IPCountry = "" },
+			expected:   false,
+		},
+		{
+			name:       "ipInCidr matches address inside block",
+			expression: `ipInCidr(device["ipAddress"], "203.0.113.0/24")`,
+			expected:   true,
+		},
+		{
+			name:       "ipInCidr does not match address outside block",
+			expression: `ipInCidr(device["ipAddress"], "10.0.0.0/8")`,
+			expected:   false,
+		},
+		{
+			name:       "ipInCidr treats malformed CIDR as non-match",
+			expression: `ipInCidr(device["ipAddress"], "not-a-cidr")`,
+			expected:   false,
+		},
+		{
+			name: "geoDistanceKm detects impossible travel",
+			expression: `geoDistanceKm(geolocation["latitude"], geolocation["longitude"], ` +
+				`geolocation["lastKnown"]["latitude"], geolocation["lastKnown"]["longitude"]) > 500`,
+			expected: true,
+		},
+		{
+			name: "geoDistanceKm within range",
+			expression: `geoDistanceKm(geolocation["latitude"], geolocation["longitude"], ` +
+				`geolocation["lastKnown"]["latitude"], geolocation["lastKnown"]["longitude"]) > 500`,
+			mutate: func(req *model.ValidationRequest) {
+				req.Geolocation.LastKnown = &model.GeoPoint{Latitude: -22.9068, Longitude: -43.1729}
+			},
+			expected: false,
+		},
+		{
+			name:       "firstSeenAt is exposed in nanoseconds",
+			expression: `transactionTimestamp - device["firstSeenAt"] < 86400000000000`,
+			expected:   true,
+		},
+		{
+			name:       "counterparty bank code",
+			expression: `counterparty["bankCode"] == "341" && counterparty["document"] == "12345678000190"`,
+			expected:   true,
+		},
+		{
+			name:       "guarded expression is false without geolocation",
+			expression: `"latitude" in geolocation && geoDistanceKm(geolocation["latitude"], geolocation["longitude"], 0.0, 0.0) > 500`,
+			mutate:     func(req *model.ValidationRequest) { req.Geolocation = nil },
+			expected:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newExampleRequest()
+			req.TransactionTimestamp = firstSeen.Add(2 * time.Hour)
+			req.Device = &model.DeviceContext{
+				Fingerprint: "fp-abc123",
+				IPAddress:   "203.0.113.7",
+				IPCountry:   "PT",
+				FirstSeenAt: &firstSeen,
+			}
+			req.Geolocation = &model.GeolocationContext{
+				Country:   "BR",
+				Latitude:  &lat,
+				Longitude: &lon,
+				LastKnown: &model.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+			}
+			req.Counterparty = &model.CounterpartyContext{
+				AccountID: "0001-123456",
+				BankCode:  "341",
+				Document:  "12345678000190",
+			}
+
+			if tc.mutate != nil {
+				tc.mutate(req)
+			}
+
+			program := compileExampleExpression(t, tc.expression)
+			result, err := adapter.Evaluate(context.Background(), program, req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestHelperFunctions_RejectWrongArgumentTypes(t *testing.T) {
+	env, err := NewEnvironment()
+	require.NoError(t, err)
+
+	for _, expression := range []string{
+		`geoDistanceKm("a", 1.0, 2.0, 3.0)`,
+		`countryMismatch(1, "BR")`,
+		`ipInCidr("10.0.0.1")`,
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := env.Compile(expression)
+			require.Error(t, err)
+
+			var compileErr *CompileError
+			require.ErrorAs(t, err, &compileErr)
+			assert.True(t, compileErr.IsTypeError)
+		})
+	}
+}
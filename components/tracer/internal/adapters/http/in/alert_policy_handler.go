@@ -0,0 +1,373 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+//go:generate mockgen -source=alert_policy_handler.go -destination=alert_policy_service_mock.go -package=in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// AlertPolicyService defines the interface for alert policy operations.
+// Interface defined locally per Ring pattern.
+type AlertPolicyService interface {
+	CreateAlertPolicy(ctx context.Context, input *command.CreateAlertPolicyInput) (*model.AlertPolicy, error)
+	GetAlertPolicy(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error)
+	ListAlertPolicies(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error)
+	UpdateAlertPolicy(ctx context.Context, id uuid.UUID, input *command.UpdateAlertPolicyInput) (*model.AlertPolicy, error)
+	DeleteAlertPolicy(ctx context.Context, id uuid.UUID) error
+}
+
+// CreateAlertPolicyInput is the request body for POST /v1/alert-policies.
+type CreateAlertPolicyInput struct {
+	Name           string         `json:"name" maxLength:"255" example:"Card deny spike"`
+	Description    *string        `json:"description,omitempty" maxLength:"1000" example:"Alert when card denials exceed 40% over 5 minutes"`
+	RuleID         *uuid.UUID     `json:"ruleId,omitempty" swaggertype:"string" format:"uuid"`
+	Scope          *model.Scope   `json:"scope,omitempty"`
+	Decision       model.Decision `json:"decision" swaggertype:"string" enums:"ALLOW,DENY,REVIEW" example:"DENY"`
+	Threshold      float64        `json:"threshold" example:"0.4"`
+	WindowSeconds  int            `json:"windowSeconds" example:"300"`
+	MinSamples     int            `json:"minSamples,omitempty" example:"20"`
+	AutoDeactivate bool           `json:"autoDeactivate,omitempty" example:"false"`
+}
+
+// UpdateAlertPolicyInput is the request body for PATCH
+// /v1/alert-policies/{id}. Omitted fields keep their value; "scope": null
+// removes the scope. ruleId is immutable.
+type UpdateAlertPolicyInput struct {
+	Name           *string                  `json:"name,omitempty" maxLength:"255"`
+	Description    *string                  `json:"description,omitempty" maxLength:"1000"`
+	Scope          json.RawMessage          `json:"scope,omitempty" swaggertype:"object"`
+	Decision       *model.Decision          `json:"decision,omitempty" swaggertype:"string" enums:"ALLOW,DENY,REVIEW"`
+	Threshold      *float64                 `json:"threshold,omitempty"`
+	WindowSeconds  *int                     `json:"windowSeconds,omitempty"`
+	MinSamples     *int                     `json:"minSamples,omitempty"`
+	AutoDeactivate *bool                    `json:"autoDeactivate,omitempty"`
+	Status         *model.AlertPolicyStatus `json:"status,omitempty" swaggertype:"string" enums:"ACTIVE,INACTIVE"`
+}
+
+// IsEmpty reports whether the update carries no field to change.
+func (i *UpdateAlertPolicyInput) IsEmpty() bool {
+	return i.Name == nil && i.Description == nil && i.Scope == nil && i.Decision == nil &&
+		i.Threshold == nil && i.WindowSeconds == nil && i.MinSamples == nil &&
+		i.AutoDeactivate == nil && i.Status == nil
+}
+
+// ListAlertPoliciesResponse is the response body for GET /v1/alert-policies.
+// Policies are few per tenant, so the list is returned whole.
+type ListAlertPoliciesResponse struct {
+	AlertPolicies []*model.AlertPolicy `json:"alertPolicies"`
+}
+
+// AlertPolicyHandler handles HTTP requests for alert policies.
+type AlertPolicyHandler struct {
+	service AlertPolicyService
+}
+
+// NewAlertPolicyHandler creates a new alert policy handler.
+func NewAlertPolicyHandler(service AlertPolicyService) *AlertPolicyHandler {
+	return &AlertPolicyHandler{
+		service: service,
+	}
+}
+
+func (h *AlertPolicyHandler) CreateAlertPolicy(c *fiber.Ctx) error {
+	result, err := h.createAlertPolicy(c.UserContext(), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Created(c, result)
+}
+
+// createAlertPolicy is the transport-agnostic core of the create operation
+// shared by the Fiber method and the Huma func. Field validation lives in
+// model.NewAlertPolicy; its sentinels are canonicalized by
+// classifyAlertPolicyServiceError.
+func (h *AlertPolicyHandler) createAlertPolicy(ctx context.Context, rawBody []byte) (*model.AlertPolicy, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.alert_policy.create")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var input CreateAlertPolicyInput
+	if err := json.Unmarshal(rawBody, &input); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse request body", err)
+		return nil, pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or contains invalid JSON. Please verify the syntax and try again."}
+	}
+
+	result, err := h.service.CreateAlertPolicy(ctx, &command.CreateAlertPolicyInput{
+		Name:           input.Name,
+		Description:    input.Description,
+		RuleID:         input.RuleID,
+		Scope:          input.Scope,
+		Decision:       input.Decision,
+		Threshold:      input.Threshold,
+		WindowSeconds:  input.WindowSeconds,
+		MinSamples:     input.MinSamples,
+		AutoDeactivate: input.AutoDeactivate,
+	})
+	if err != nil {
+		return nil, classifyAlertPolicyServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.alert_policy.create"),
+		libLog.String("alert_policy.id", result.ID.String()),
+	).Log(ctx, libLog.LevelDebug, "Alert policy created")
+
+	return result, nil
+}
+
+func (h *AlertPolicyHandler) GetAlertPolicy(c *fiber.Ctx) error {
+	result, err := h.getAlertPolicy(c.UserContext(), c.Params("id"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// getAlertPolicy is the transport-agnostic core of the get operation.
+func (h *AlertPolicyHandler) getAlertPolicy(ctx context.Context, idParam string) (*model.AlertPolicy, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.alert_policy.get")
+	defer span.End()
+
+	id, err := parseAlertPolicyID(span, idParam)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.GetAlertPolicy(ctx, id)
+	if err != nil {
+		return nil, classifyAlertPolicyServiceError(span, err)
+	}
+
+	return result, nil
+}
+
+func (h *AlertPolicyHandler) ListAlertPolicies(c *fiber.Ctx) error {
+	result, err := h.listAlertPolicies(c.UserContext(), c.Query("status"), c.Query("ruleId"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// listAlertPolicies is the transport-agnostic core of the list operation.
+// Empty filters list every policy.
+func (h *AlertPolicyHandler) listAlertPolicies(ctx context.Context, status, ruleID string) (*ListAlertPoliciesResponse, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.alert_policy.list")
+	defer span.End()
+
+	filter := &model.ListAlertPoliciesFilter{}
+
+	if status != "" {
+		s := model.AlertPolicyStatus(strings.ToUpper(status))
+		if !s.IsValid() {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid status filter", constant.ErrAlertPolicyInvalidStatus)
+			return nil, pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityAlertPolicy, "status")
+		}
+
+		filter.Status = &s
+	}
+
+	if ruleID != "" {
+		id, err := uuid.Parse(ruleID)
+		if err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid ruleId filter", err)
+			return nil, pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityAlertPolicy, "ruleId")
+		}
+
+		filter.RuleID = &id
+	}
+
+	result, err := h.service.ListAlertPolicies(ctx, filter)
+	if err != nil {
+		return nil, classifyAlertPolicyServiceError(span, err)
+	}
+
+	if result == nil {
+		result = []*model.AlertPolicy{}
+	}
+
+	return &ListAlertPoliciesResponse{AlertPolicies: result}, nil
+}
+
+func (h *AlertPolicyHandler) UpdateAlertPolicy(c *fiber.Ctx) error {
+	result, err := h.updateAlertPolicy(c.UserContext(), c.Params("id"), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// updateAlertPolicy is the transport-agnostic core of the update operation.
+func (h *AlertPolicyHandler) updateAlertPolicy(ctx context.Context, idParam string, rawBody []byte) (*model.AlertPolicy, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.alert_policy.update")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	id, err := parseAlertPolicyID(span, idParam)
+	if err != nil {
+		return nil, err
+	}
+
+	invalidBody := pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or contains invalid JSON. Please verify the syntax and try again."}
+
+	var input UpdateAlertPolicyInput
+	if err := json.Unmarshal(rawBody, &input); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse request body", err)
+		return nil, invalidBody
+	}
+
+	if input.IsEmpty() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "No fields to update", nil)
+		return nil, pkg.ValidateBusinessError(constant.ErrNothingToUpdate, constant.EntityAlertPolicy)
+	}
+
+	cmdInput := &command.UpdateAlertPolicyInput{
+		Name:           input.Name,
+		Description:    input.Description,
+		Decision:       input.Decision,
+		Threshold:      input.Threshold,
+		WindowSeconds:  input.WindowSeconds,
+		MinSamples:     input.MinSamples,
+		AutoDeactivate: input.AutoDeactivate,
+		Status:         input.Status,
+	}
+
+	// A present-but-null scope clears it; an object replaces it.
+	if input.Scope != nil {
+		if bytes.Equal(bytes.TrimSpace(input.Scope), []byte("null")) {
+			cmdInput.ClearScope = true
+		} else {
+			var scope model.Scope
+			if err := json.Unmarshal(input.Scope, &scope); err != nil {
+				libOpentelemetry.HandleSpanError(span, "Failed to parse scope", err)
+				return nil, invalidBody
+			}
+
+			cmdInput.Scope = &scope
+		}
+	}
+
+	result, err := h.service.UpdateAlertPolicy(ctx, id, cmdInput)
+	if err != nil {
+		return nil, classifyAlertPolicyServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.alert_policy.update"),
+		libLog.String("alert_policy.id", result.ID.String()),
+		libLog.String("alert_policy.status", result.Status.String()),
+	).Log(ctx, libLog.LevelDebug, "Alert policy updated")
+
+	return result, nil
+}
+
+func (h *AlertPolicyHandler) DeleteAlertPolicy(c *fiber.Ctx) error {
+	if err := h.deleteAlertPolicy(c.UserContext(), c.Params("id")); err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.NoContent(c)
+}
+
+// deleteAlertPolicy is the transport-agnostic core of the delete operation.
+func (h *AlertPolicyHandler) deleteAlertPolicy(ctx context.Context, idParam string) error {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.alert_policy.delete")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	id, err := parseAlertPolicyID(span, idParam)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteAlertPolicy(ctx, id); err != nil {
+		return classifyAlertPolicyServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.alert_policy.delete"),
+		libLog.String("alert_policy.id", id.String()),
+	).Log(ctx, libLog.LevelDebug, "Alert policy deleted")
+
+	return nil
+}
+
+// parseAlertPolicyID validates the {id} path parameter.
+func parseAlertPolicyID(span trace.Span, idParam string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid alert policy ID", err)
+		return uuid.Nil, pkg.ValidateBusinessError(constant.ErrInvalidPathParameter, constant.EntityAlertPolicy, "id")
+	}
+
+	return id, nil
+}
+
+// classifyAlertPolicyServiceError maps a raw service error to its canonical
+// Midaz error. See classifyLimitServiceError for the pass-through rationale.
+func classifyAlertPolicyServiceError(span trace.Span, err error) error {
+	if pkg.IsBusinessError(err) {
+		return err
+	}
+
+	for _, sentinel := range []error{
+		constant.ErrAlertPolicyNotFound,
+		constant.ErrAlertPolicyInvalidName,
+		constant.ErrAlertPolicyInvalidDecision,
+		constant.ErrAlertPolicyInvalidThreshold,
+		constant.ErrAlertPolicyInvalidWindow,
+		constant.ErrAlertPolicyInvalidMinSamples,
+		constant.ErrAlertPolicyAutoDeactivateRequiresRule,
+		constant.ErrAlertPolicyInvalidScope,
+		constant.ErrAlertPolicyInvalidStatus,
+		constant.ErrAlertPolicyDescriptionTooLong,
+	} {
+		if errors.Is(err, sentinel) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Alert policy request rejected", err)
+			return pkg.ValidateBusinessError(sentinel, constant.EntityAlertPolicy)
+		}
+	}
+
+	libOpentelemetry.HandleSpanError(span, "Operation failed", err)
+
+	return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+}
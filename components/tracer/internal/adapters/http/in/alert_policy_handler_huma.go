@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// Huma surface for alert policies, following the reference pattern in
+// rule_handler_huma.go: raw bodies + SkipValidateBody, doc-only path/query
+// params, and humaProblem for errors.
+
+// CreateAlertPolicyInputHuma is the Huma request envelope for POST
+// /v1/alert-policies.
+type CreateAlertPolicyInputHuma struct {
+	RawBody []byte `contentType:"application/json"`
+}
+
+// AlertPolicyIDInputHuma is the Huma request envelope for the
+// /v1/alert-policies/{id} operations without a body.
+type AlertPolicyIDInputHuma struct {
+	ID string `path:"id" doc:"Alert policy ID (UUID)"`
+}
+
+// UpdateAlertPolicyInputHuma is the Huma request envelope for PATCH
+// /v1/alert-policies/{id}.
+type UpdateAlertPolicyInputHuma struct {
+	ID      string `path:"id" doc:"Alert policy ID (UUID)"`
+	RawBody []byte `contentType:"application/json"`
+}
+
+// ListAlertPoliciesInputHuma is the Huma request envelope for GET
+// /v1/alert-policies.
+type ListAlertPoliciesInputHuma struct {
+	Status string `query:"status" doc:"Filter by status (ACTIVE, INACTIVE)"`
+	RuleID string `query:"ruleId" doc:"Filter by monitored rule ID (UUID)"`
+}
+
+// AlertPolicyOutputHuma is the shared single-policy response envelope.
+type AlertPolicyOutputHuma struct {
+	Status int
+	Body   *model.AlertPolicy
+}
+
+// ListAlertPoliciesOutputHuma is the Huma response envelope for GET
+// /v1/alert-policies.
+type ListAlertPoliciesOutputHuma struct {
+	Status int
+	Body   *ListAlertPoliciesResponse
+}
+
+// DeleteAlertPolicyOutputHuma is the bodiless Huma response envelope for
+// DELETE /v1/alert-policies/{id}; see DeleteLimitOutputHuma.
+type DeleteAlertPolicyOutputHuma struct{}
+
+// CreateAlertPolicyHuma is the Huma handler for POST /v1/alert-policies.
+func (h *AlertPolicyHandler) CreateAlertPolicyHuma(ctx context.Context, in *CreateAlertPolicyInputHuma) (*AlertPolicyOutputHuma, error) {
+	result, err := h.createAlertPolicy(ctx, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &AlertPolicyOutputHuma{Status: http.StatusCreated, Body: result}, nil
+}
+
+// GetAlertPolicyHuma is the Huma handler for GET /v1/alert-policies/{id}.
+func (h *AlertPolicyHandler) GetAlertPolicyHuma(ctx context.Context, in *AlertPolicyIDInputHuma) (*AlertPolicyOutputHuma, error) {
+	result, err := h.getAlertPolicy(ctx, in.ID)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &AlertPolicyOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// ListAlertPoliciesHuma is the Huma handler for GET /v1/alert-policies.
+func (h *AlertPolicyHandler) ListAlertPoliciesHuma(ctx context.Context, in *ListAlertPoliciesInputHuma) (*ListAlertPoliciesOutputHuma, error) {
+	result, err := h.listAlertPolicies(ctx, in.Status, in.RuleID)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ListAlertPoliciesOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// UpdateAlertPolicyHuma is the Huma handler for PATCH /v1/alert-policies/{id}.
+func (h *AlertPolicyHandler) UpdateAlertPolicyHuma(ctx context.Context, in *UpdateAlertPolicyInputHuma) (*AlertPolicyOutputHuma, error) {
+	result, err := h.updateAlertPolicy(ctx, in.ID, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &AlertPolicyOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// DeleteAlertPolicyHuma is the Huma handler for DELETE /v1/alert-policies/{id}.
+func (h *AlertPolicyHandler) DeleteAlertPolicyHuma(ctx context.Context, in *AlertPolicyIDInputHuma) (*DeleteAlertPolicyOutputHuma, error) {
+	if err := h.deleteAlertPolicy(ctx, in.ID); err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &DeleteAlertPolicyOutputHuma{}, nil
+}
+
+// RegisterAlertPolicyRoutes registers the alert policy operations on the shared
+// Huma API. The auth middleware for these paths is attached in
+// registerTracerHumaRoutes.
+func RegisterAlertPolicyRoutes(api huma.API, h *AlertPolicyHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID:      "createAlertPolicy",
+		Method:           http.MethodPost,
+		Path:             "/alert-policies",
+		Summary:          "Create a decision-rate alert policy",
+		Tags:             []string{"Alert Policies"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.CreateAlertPolicyHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listAlertPolicies",
+		Method:      http.MethodGet,
+		Path:        "/alert-policies",
+		Summary:     "List alert policies",
+		Tags:        []string{"Alert Policies"},
+		Security:    secBearerOrAPIKey,
+	}, h.ListAlertPoliciesHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getAlertPolicy",
+		Method:      http.MethodGet,
+		Path:        "/alert-policies/{id}",
+		Summary:     "Get an alert policy by ID",
+		Tags:        []string{"Alert Policies"},
+		Security:    secBearerOrAPIKey,
+	}, h.GetAlertPolicyHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "updateAlertPolicy",
+		Method:           http.MethodPatch,
+		Path:             "/alert-policies/{id}",
+		Summary:          "Update or deactivate an alert policy",
+		Tags:             []string{"Alert Policies"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.UpdateAlertPolicyHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "deleteAlertPolicy",
+		Method:        http.MethodDelete,
+		Path:          "/alert-policies/{id}",
+		Summary:       "Delete an alert policy",
+		Tags:          []string{"Alert Policies"},
+		Security:      secBearerOrAPIKey,
+		DefaultStatus: http.StatusNoContent,
+	}, h.DeleteAlertPolicyHuma)
+}
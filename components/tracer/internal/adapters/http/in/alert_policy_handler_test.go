@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newTestAlertPolicyApp(service AlertPolicyService) *fiber.App {
+	handler := NewAlertPolicyHandler(service)
+
+	app := fiber.New()
+	app.Post("/alert-policies", handler.CreateAlertPolicy)
+	app.Get("/alert-policies", handler.ListAlertPolicies)
+	app.Get("/alert-policies/:id", handler.GetAlertPolicy)
+	app.Patch("/alert-policies/:id", handler.UpdateAlertPolicy)
+	app.Delete("/alert-policies/:id", handler.DeleteAlertPolicy)
+
+	return app
+}
+
+func testAlertPolicy(t *testing.T) *model.AlertPolicy {
+	t.Helper()
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:          "Card deny spike",
+		Decision:      model.DecisionDeny,
+		Threshold:     0.4,
+		WindowSeconds: 300,
+	}, testutil.FixedTime())
+	require.NoError(t, err)
+
+	return policy
+}
+
+func TestAlertPolicyHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(service *MockAlertPolicyService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "success",
+			body: `{"name":"Card deny spike","decision":"DENY","threshold":0.4,"windowSeconds":300}`,
+			mockSetup: func(service *MockAlertPolicyService) {
+				service.EXPECT().
+					CreateAlertPolicy(gomock.Any(), &command.CreateAlertPolicyInput{
+						Name:          "Card deny spike",
+						Decision:      model.DecisionDeny,
+						Threshold:     0.4,
+						WindowSeconds: 300,
+					}).
+					Return(testAlertPolicy(t), nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "malformed body",
+			body:           `{"name":`,
+			mockSetup:      func(*MockAlertPolicyService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidRequestBody.Error(),
+		},
+		{
+			name: "auto-deactivate without rule",
+			body: `{"name":"x","decision":"DENY","threshold":0.4,"windowSeconds":300,"autoDeactivate":true}`,
+			mockSetup: func(service *MockAlertPolicyService) {
+				service.EXPECT().CreateAlertPolicy(gomock.Any(), gomock.Any()).Return(nil, constant.ErrAlertPolicyAutoDeactivateRequiresRule)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrAlertPolicyAutoDeactivateRequiresRule.Error(),
+		},
+		{
+			name: "unexpected error",
+			body: `{"name":"x","decision":"DENY","threshold":0.4,"windowSeconds":300}`,
+			mockSetup: func(service *MockAlertPolicyService) {
+				service.EXPECT().CreateAlertPolicy(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   constant.ErrInternalServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockAlertPolicyService(ctrl)
+			tt.mockSetup(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/alert-policies", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := newTestAlertPolicyApp(service).Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, decodeErrorCode(t, resp.Body))
+			}
+		})
+	}
+}
+
+func TestAlertPolicyHandler_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockAlertPolicyService(ctrl)
+	app := newTestAlertPolicyApp(service)
+
+	policy := testAlertPolicy(t)
+	service.EXPECT().GetAlertPolicy(gomock.Any(), policy.ID).Return(policy, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/alert-policies/"+policy.ID.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	missing := uuid.New()
+	service.EXPECT().GetAlertPolicy(gomock.Any(), missing).Return(nil, constant.ErrAlertPolicyNotFound)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/alert-policies/"+missing.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, constant.ErrAlertPolicyNotFound.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/alert-policies/not-a-uuid", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidPathParameter.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestAlertPolicyHandler_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockAlertPolicyService(ctrl)
+	app := newTestAlertPolicyApp(service)
+
+	active := model.AlertPolicyStatusActive
+	ruleID := testutil.MustDeterministicUUID(4)
+
+	service.EXPECT().
+		ListAlertPolicies(gomock.Any(), &model.ListAlertPoliciesFilter{Status: &active, RuleID: &ruleID}).
+		Return([]*model.AlertPolicy{testAlertPolicy(t)}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/alert-policies?status=active&ruleId="+ruleID.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body ListAlertPoliciesResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.AlertPolicies, 1)
+	resp.Body.Close()
+
+	for _, query := range []string{"status=FIRING", "ruleId=nope"} {
+		resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/alert-policies?"+query, nil))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		assert.Equal(t, constant.ErrInvalidQueryParameter.Error(), decodeErrorCode(t, resp.Body), query)
+		resp.Body.Close()
+	}
+}
+
+func TestAlertPolicyHandler_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockAlertPolicyService(ctrl)
+	app := newTestAlertPolicyApp(service)
+
+	policy := testAlertPolicy(t)
+	threshold := 0.6
+
+	tests := []struct {
+		name     string
+		body     string
+		expected *command.UpdateAlertPolicyInput
+	}{
+		{
+			name:     "threshold only keeps scope",
+			body:     `{"threshold":0.6}`,
+			expected: &command.UpdateAlertPolicyInput{Threshold: &threshold},
+		},
+		{
+			name:     "null scope clears it",
+			body:     `{"scope":null}`,
+			expected: &command.UpdateAlertPolicyInput{ClearScope: true},
+		},
+		{
+			name:     "object scope replaces it",
+			body:     `{"scope":{"country":"BR"}}`,
+			expected: &command.UpdateAlertPolicyInput{Scope: &model.Scope{Country: testutil.StringPtr("BR")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.EXPECT().UpdateAlertPolicy(gomock.Any(), policy.ID, tt.expected).Return(policy, nil)
+
+			req := httptest.NewRequest(http.MethodPatch, "/alert-policies/"+policy.ID.String(), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			resp.Body.Close()
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/alert-policies/"+policy.ID.String(), strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrNothingToUpdate.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestAlertPolicyHandler_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockAlertPolicyService(ctrl)
+	app := newTestAlertPolicyApp(service)
+
+	id := uuid.New()
+	service.EXPECT().DeleteAlertPolicy(gomock.Any(), id).Return(nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/alert-policies/"+id.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp.Body.Close()
+
+	service.EXPECT().DeleteAlertPolicy(gomock.Any(), id).Return(constant.ErrAlertPolicyNotFound)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodDelete, "/alert-policies/"+id.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert_policy_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=alert_policy_handler.go -destination=alert_policy_service_mock.go -package=in
+//
+
+// Package in is a generated GoMock package.
+package in
+
+import (
+	context "context"
+	reflect "reflect"
+
+	command "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlertPolicyService is a mock of AlertPolicyService interface.
+type MockAlertPolicyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertPolicyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertPolicyServiceMockRecorder is the mock recorder for MockAlertPolicyService.
+type MockAlertPolicyServiceMockRecorder struct {
+	mock *MockAlertPolicyService
+}
+
+// NewMockAlertPolicyService creates a new mock instance.
+func NewMockAlertPolicyService(ctrl *gomock.Controller) *MockAlertPolicyService {
+	mock := &MockAlertPolicyService{ctrl: ctrl}
+	mock.recorder = &MockAlertPolicyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertPolicyService) EXPECT() *MockAlertPolicyServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAlertPolicy mocks base method.
+func (m *MockAlertPolicyService) CreateAlertPolicy(ctx context.Context, input *command.CreateAlertPolicyInput) (*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertPolicy", ctx, input)
+	ret0, _ := ret[0].(*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertPolicy indicates an expected call of CreateAlertPolicy.
+func (mr *MockAlertPolicyServiceMockRecorder) CreateAlertPolicy(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertPolicy", reflect.TypeOf((*MockAlertPolicyService)(nil).CreateAlertPolicy), ctx, input)
+}
+
+// DeleteAlertPolicy mocks base method.
+func (m *MockAlertPolicyService) DeleteAlertPolicy(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertPolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertPolicy indicates an expected call of DeleteAlertPolicy.
+func (mr *MockAlertPolicyServiceMockRecorder) DeleteAlertPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertPolicy", reflect.TypeOf((*MockAlertPolicyService)(nil).DeleteAlertPolicy), ctx, id)
+}
+
+// GetAlertPolicy mocks base method.
+func (m *MockAlertPolicyService) GetAlertPolicy(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertPolicy", ctx, id)
+	ret0, _ := ret[0].(*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertPolicy indicates an expected call of GetAlertPolicy.
+func (mr *MockAlertPolicyServiceMockRecorder) GetAlertPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertPolicy", reflect.TypeOf((*MockAlertPolicyService)(nil).GetAlertPolicy), ctx, id)
+}
+
+// ListAlertPolicies mocks base method.
+func (m *MockAlertPolicyService) ListAlertPolicies(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertPolicies", ctx, filter)
+	ret0, _ := ret[0].([]*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertPolicies indicates an expected call of ListAlertPolicies.
+func (mr *MockAlertPolicyServiceMockRecorder) ListAlertPolicies(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertPolicies", reflect.TypeOf((*MockAlertPolicyService)(nil).ListAlertPolicies), ctx, filter)
+}
+
+// UpdateAlertPolicy mocks base method.
+func (m *MockAlertPolicyService) UpdateAlertPolicy(ctx context.Context, id uuid.UUID, input *command.UpdateAlertPolicyInput) (*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertPolicy", ctx, id, input)
+	ret0, _ := ret[0].(*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlertPolicy indicates an expected call of UpdateAlertPolicy.
+func (mr *MockAlertPolicyServiceMockRecorder) UpdateAlertPolicy(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertPolicy", reflect.TypeOf((*MockAlertPolicyService)(nil).UpdateAlertPolicy), ctx, id, input)
+}
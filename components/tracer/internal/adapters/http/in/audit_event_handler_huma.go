@@ -59,7 +59,7 @@ type ListAuditEventsInputHuma struct {
 	AccountID       string `query:"account_id" doc:"Filter by account ID (UUID)"`
 	SegmentID       string `query:"segment_id" doc:"Filter by segment ID (UUID)"`
 	PortfolioID     string `query:"portfolio_id" doc:"Filter by portfolio ID (UUID)"`
-	TransactionType string `query:"transaction_type" doc:"Filter by transaction type (CARD, WIRE, PIX, CRYPTO)"`
+	TransactionType string `query:"transaction_type" doc:"Filter by transaction type (transaction-type catalog code, e.g. CARD, PIX)"`
 	MatchedRuleID   string `query:"matched_rule_id" doc:"Filter by matched rule ID (UUID)"`
 	Limit           string `query:"limit" doc:"Max items per page (1-1000, default: 100)"`
 	Cursor          string `query:"cursor" doc:"Pagination token (empty for first page)"`
@@ -185,7 +185,7 @@ func TestHuma_ListAuditEvents_Defaults(t *testing.T) {
 // Validate(), and produce the canonical 400 — NOT a native Huma 422 from an
 // `enum`/`validate` struct tag. event_type=INVALID hits the go-playground
 // auditeventtype validator whose formatValidationError default arm is
-// ErrMissingFieldsInRequest (0009); transaction_type=INVALID is 0009 too. These
+// ErrMissingFieldsInRequest (0009); transaction_type=invalid-type is 0009 too. These
 // codes are empirically anchored to the pre-Huma Fiber handler.
 func TestHuma_ListAuditEvents_InvalidEnumParams(t *testing.T) {
 	for _, tc := range []struct {
@@ -196,7 +196,7 @@ func TestHuma_ListAuditEvents_InvalidEnumParams(t *testing.T) {
 		{"invalid result", "result=INVALID", "0009"},
 		{"invalid resource_type", "resource_type=INVALID", "0009"},
 		{"invalid actor_type", "actor_type=INVALID", "0009"},
-		{"invalid transaction_type", "transaction_type=INVALID", "0009"},
+		{"invalid transaction_type", "transaction_type=invalid-type", "0009"},
 		{"invalid sort_by", "sort_by=priority", "0332"},     // ErrInvalidSortColumn
 		{"invalid sort_order", "sort_order=RANDOM", "0081"}, // ErrInvalidSortOrder
 	} {
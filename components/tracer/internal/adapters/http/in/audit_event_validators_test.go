@@ -124,7 +124,7 @@ func TestRuleAndTransactionTypeValidators(t *testing.T) {
 		assert.NoError(t, v.Var(string(model.TransactionTypeCard), "transactiontype"))
 	})
 
-	t.Run("transactiontype rejects unknown type", func(t *testing.T) {
-		assert.Error(t, v.Var("CARRIER_PIGEON", "transactiontype"))
+	t.Run("transactiontype rejects malformed code", func(t *testing.T) {
+		assert.Error(t, v.Var("carrier-pigeon", "transactiontype"))
 	})
 }
@@ -0,0 +1,323 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+//go:generate mockgen -source=config_bundle_handler.go -destination=config_bundle_service_mock.go -package=in
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/yaml.v3"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// Bundle export formats accepted by the format query parameter.
+const (
+	configBundleFormatJSON = "json"
+	configBundleFormatYAML = "yaml"
+
+	configBundleYAMLContentType = "application/yaml"
+)
+
+// ConfigBundleService defines the interface for bundle export and the
+// plan/apply workflow. Interface defined locally per Ring pattern.
+type ConfigBundleService interface {
+	Export(ctx context.Context) (*model.ConfigBundle, error)
+	Plan(ctx context.Context, bundle *model.ConfigBundle) (*model.BundlePlan, error)
+	Apply(ctx context.Context, bundle *model.ConfigBundle, opts model.BundleApplyOptions) (*model.BundleApplyResult, error)
+}
+
+// ConfigBundleHandler handles HTTP requests for configuration bundles.
+type ConfigBundleHandler struct {
+	service ConfigBundleService
+}
+
+// NewConfigBundleHandler creates a new configuration bundle handler.
+func NewConfigBundleHandler(service ConfigBundleService) *ConfigBundleHandler {
+	return &ConfigBundleHandler{
+		service: service,
+	}
+}
+
+func (h *ConfigBundleHandler) ExportConfigBundle(c *fiber.Ctx) error {
+	body, contentType, err := h.exportConfigBundle(c.UserContext(), c.Query("format"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+
+	return c.Status(fiber.StatusOK).Send(body)
+}
+
+// exportConfigBundle is the transport-agnostic core of the export. It returns
+// the encoded bundle and its content type: JSON by default, YAML when
+// format=yaml so the file can be committed and reviewed as-is.
+func (h *ConfigBundleHandler) exportConfigBundle(ctx context.Context, formatParam string) ([]byte, string, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.config_bundle.export")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	format := strings.ToLower(strings.TrimSpace(formatParam))
+	if format == "" {
+		format = configBundleFormatJSON
+	}
+
+	if format != configBundleFormatJSON && format != configBundleFormatYAML {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid bundle format", constant.ErrInvalidQueryParameter)
+		return nil, "", pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityConfigBundle, "format")
+	}
+
+	bundle, err := h.service.Export(ctx)
+	if err != nil {
+		return nil, "", classifyConfigBundleServiceError(span, err)
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+
+	if format == configBundleFormatYAML {
+		body, err = encodeConfigBundleYAML(bundle)
+		contentType = configBundleYAMLContentType
+	} else {
+		body, err = json.Marshal(bundle)
+		contentType = fiber.MIMEApplicationJSON
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to encode configuration bundle", err)
+		return nil, "", pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.config_bundle.export"),
+		libLog.String("bundle.format", format),
+		libLog.Int("bundle.rules", len(bundle.Rules)),
+		libLog.Int("bundle.limits", len(bundle.Limits)),
+	).Log(ctx, libLog.LevelDebug, "Configuration bundle exported")
+
+	return body, contentType, nil
+}
+
+func (h *ConfigBundleHandler) PlanConfigBundle(c *fiber.Ctx) error {
+	result, err := h.planConfigBundle(c.UserContext(), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// planConfigBundle is the transport-agnostic core of the plan operation.
+func (h *ConfigBundleHandler) planConfigBundle(ctx context.Context, rawBody []byte) (*model.BundlePlan, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.config_bundle.plan")
+	defer span.End()
+
+	bundle, err := decodeConfigBundle(span, rawBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.Plan(ctx, bundle)
+	if err != nil {
+		return nil, classifyConfigBundleServiceError(span, err)
+	}
+
+	return result, nil
+}
+
+func (h *ConfigBundleHandler) ApplyConfigBundle(c *fiber.Ctx) error {
+	result, err := h.applyConfigBundle(c.UserContext(), c.Body(), c.Query("dry_run"), c.Query("fingerprint"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// applyConfigBundle is the transport-agnostic core of the apply operation.
+func (h *ConfigBundleHandler) applyConfigBundle(ctx context.Context, rawBody []byte, dryRunParam, fingerprint string) (*model.BundleApplyResult, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.config_bundle.apply")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	opts := model.BundleApplyOptions{ExpectedFingerprint: strings.TrimSpace(fingerprint)}
+
+	if dryRunParam != "" {
+		dryRun, err := strconv.ParseBool(dryRunParam)
+		if err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid dry_run parameter", err)
+			return nil, pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityConfigBundle, "dry_run")
+		}
+
+		opts.DryRun = dryRun
+	}
+
+	bundle, err := decodeConfigBundle(span, rawBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.Apply(ctx, bundle, opts)
+	if err != nil {
+		return nil, classifyConfigBundleServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.config_bundle.apply"),
+		libLog.Bool("bundle.dry_run", result.DryRun),
+		libLog.Int("bundle.applied", result.Applied),
+	).Log(ctx, libLog.LevelDebug, "Configuration bundle applied")
+
+	return result, nil
+}
+
+// decodeConfigBundle accepts the bundle as JSON or YAML (JSON is a YAML
+// subset, so one YAML parse covers both). The document is normalized to JSON
+// and decoded strictly: an unknown key in a hand-edited file is far more
+// likely a typo than something to ignore.
+func decodeConfigBundle(span trace.Span, rawBody []byte) (*model.ConfigBundle, error) {
+	invalidBody := func(err error) error {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse configuration bundle", err)
+		return pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or is not a valid JSON or YAML configuration bundle. Please verify the syntax and try again."}
+	}
+
+	var doc any
+	if err := yaml.Unmarshal(rawBody, &doc); err != nil {
+		return nil, invalidBody(err)
+	}
+
+	if doc == nil {
+		return nil, invalidBody(errors.New("empty document"))
+	}
+
+	normalized, err := json.Marshal(doc)
+	if err != nil {
+		return nil, invalidBody(err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(normalized))
+	dec.DisallowUnknownFields()
+
+	var bundle model.ConfigBundle
+	if err := dec.Decode(&bundle); err != nil {
+		return nil, invalidBody(err)
+	}
+
+	return &bundle, nil
+}
+
+// encodeConfigBundleYAML renders the bundle as block-style YAML with the same
+// keys as the JSON form. Going through a yaml.Node built from the JSON keeps
+// the struct field order and the JSON names without duplicating yaml tags on
+// every model type.
+func encodeConfigBundleYAML(bundle *model.ConfigBundle) ([]byte, error) {
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return nil, err
+	}
+
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// resetYAMLStyle drops the flow/quoted styles inherited from the JSON source
+// so the encoder picks block style and quotes only where YAML requires it.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// classifyConfigBundleServiceError maps a raw bundle service error to its
+// canonical Midaz error. An apply that stopped at a rule or limit is
+// classified exactly as the one-by-one endpoint would have classified it.
+func classifyConfigBundleServiceError(span trace.Span, err error) error {
+	var entryErr *model.BundleEntryError
+	if errors.As(err, &entryErr) {
+		if entryErr.Kind == model.BundleResourceLimit {
+			return classifyLimitServiceError(span, entryErr.Err)
+		}
+
+		return classifyLifecycleError(span, entryErr.Err)
+	}
+
+	if pkg.IsBusinessError(err) {
+		return err
+	}
+
+	if errors.Is(err, constant.ErrInvalidConfigBundle) {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid configuration bundle", err)
+
+		// Validate wraps the sentinel with the offending entry; surface it.
+		detail := strings.TrimPrefix(err.Error(), constant.ErrInvalidConfigBundle.Error()+": ")
+		if detail == constant.ErrInvalidConfigBundle.Error() {
+			detail = "malformed document"
+		}
+
+		return pkg.ValidateBusinessError(constant.ErrInvalidConfigBundle, constant.EntityConfigBundle, detail)
+	}
+
+	for _, sentinel := range []error{
+		constant.ErrConfigBundleImmutableChange,
+		constant.ErrConfigBundleInvalidExpression,
+		constant.ErrConfigBundlePlanOutdated,
+	} {
+		if errors.Is(err, sentinel) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Configuration bundle rejected", err)
+			return pkg.ValidateBusinessError(sentinel, constant.EntityConfigBundle)
+		}
+	}
+
+	libOpentelemetry.HandleSpanError(span, "Operation failed", err)
+
+	return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+}
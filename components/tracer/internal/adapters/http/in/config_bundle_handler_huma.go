@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// Huma surface for configuration bundles, following the reference pattern in
+// rule_handler_huma.go: raw bodies + SkipValidateBody, doc-only query params,
+// and humaProblem for errors. Bodies are documented as JSON; YAML is accepted
+// on the same operations.
+
+// ExportConfigBundleInputHuma is the Huma request envelope for GET
+// /v1/bundles/export.
+type ExportConfigBundleInputHuma struct {
+	Format string `query:"format" doc:"Output format (json, yaml; default: json)"`
+}
+
+// ExportConfigBundleOutputHuma is the Huma response envelope for GET
+// /v1/bundles/export. The body is pre-encoded so the same operation can serve
+// JSON or YAML.
+type ExportConfigBundleOutputHuma struct {
+	Status      int
+	ContentType string `header:"Content-Type"`
+	Body        []byte
+}
+
+// PlanConfigBundleInputHuma is the Huma request envelope for POST
+// /v1/bundles/plan.
+type PlanConfigBundleInputHuma struct {
+	RawBody []byte `contentType:"application/json"`
+}
+
+// PlanConfigBundleOutputHuma is the Huma response envelope for POST
+// /v1/bundles/plan.
+type PlanConfigBundleOutputHuma struct {
+	Status int
+	Body   *model.BundlePlan
+}
+
+// ApplyConfigBundleInputHuma is the Huma request envelope for POST
+// /v1/bundles/apply.
+type ApplyConfigBundleInputHuma struct {
+	DryRun      string `query:"dry_run" doc:"Only compute the plan, change nothing (default: false)"`
+	Fingerprint string `query:"fingerprint" doc:"Fingerprint of the reviewed plan; apply is rejected if the current plan differs"`
+	RawBody     []byte `contentType:"application/json"`
+}
+
+// ApplyConfigBundleOutputHuma is the Huma response envelope for POST
+// /v1/bundles/apply.
+type ApplyConfigBundleOutputHuma struct {
+	Status int
+	Body   *model.BundleApplyResult
+}
+
+// ExportConfigBundleHuma is the Huma handler for GET /v1/bundles/export.
+func (h *ConfigBundleHandler) ExportConfigBundleHuma(ctx context.Context, in *ExportConfigBundleInputHuma) (*ExportConfigBundleOutputHuma, error) {
+	body, contentType, err := h.exportConfigBundle(ctx, in.Format)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ExportConfigBundleOutputHuma{Status: http.StatusOK, ContentType: contentType, Body: body}, nil
+}
+
+// PlanConfigBundleHuma is the Huma handler for POST /v1/bundles/plan.
+func (h *ConfigBundleHandler) PlanConfigBundleHuma(ctx context.Context, in *PlanConfigBundleInputHuma) (*PlanConfigBundleOutputHuma, error) {
+	result, err := h.planConfigBundle(ctx, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &PlanConfigBundleOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// ApplyConfigBundleHuma is the Huma handler for POST /v1/bundles/apply.
+func (h *ConfigBundleHandler) ApplyConfigBundleHuma(ctx context.Context, in *ApplyConfigBundleInputHuma) (*ApplyConfigBundleOutputHuma, error) {
+	result, err := h.applyConfigBundle(ctx, in.RawBody, in.DryRun, in.Fingerprint)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ApplyConfigBundleOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// RegisterConfigBundleRoutes registers the bundle export and plan/apply
+// operations on the shared Huma API. The auth middleware for these paths is
+// attached in registerTracerHumaRoutes.
+func RegisterConfigBundleRoutes(api huma.API, h *ConfigBundleHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "exportConfigBundle",
+		Method:      http.MethodGet,
+		Path:        "/bundles/export",
+		Summary:     "Export all rules and limits as a declarative bundle",
+		Tags:        []string{"Configuration Bundles"},
+		Security:    secBearerOrAPIKey,
+	}, h.ExportConfigBundleHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "planConfigBundle",
+		Method:           http.MethodPost,
+		Path:             "/bundles/plan",
+		Summary:          "Diff a bundle against the current rules and limits",
+		Tags:             []string{"Configuration Bundles"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.PlanConfigBundleHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "applyConfigBundle",
+		Method:           http.MethodPost,
+		Path:             "/bundles/apply",
+		Summary:          "Apply a bundle, creating, updating and transitioning rules and limits",
+		Tags:             []string{"Configuration Bundles"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.ApplyConfigBundleHuma)
+}
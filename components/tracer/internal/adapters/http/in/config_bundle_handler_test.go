@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/yaml.v3"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newTestConfigBundleApp(service ConfigBundleService) *fiber.App {
+	handler := NewConfigBundleHandler(service)
+
+	app := fiber.New()
+	app.Get("/bundles/export", handler.ExportConfigBundle)
+	app.Post("/bundles/plan", handler.PlanConfigBundle)
+	app.Post("/bundles/apply", handler.ApplyConfigBundle)
+
+	return app
+}
+
+const testBundleYAML = `apiVersion: tracer.midaz.io/v1
+kind: TracerConfigBundle
+rules:
+  - name: block high amount
+    expression: amount > 1000
+    action: DENY
+limits:
+  - name: daily-cap
+    limitType: DAILY
+    maxAmount: "500"
+    currency: BRL
+`
+
+func TestConfigBundleHandler_Export(t *testing.T) {
+	bundle := model.NewConfigBundle(
+		[]model.Rule{{Name: "block", Expression: "amount > 1000", Action: model.DecisionDeny, Status: model.RuleStatusActive}},
+		[]model.Limit{{Name: "daily-cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(500), Currency: "BRL", Status: model.LimitStatusActive}},
+		testutil.FixedTime(),
+	)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedType   string
+	}{
+		{name: "json by default", query: "", expectedStatus: http.StatusOK, expectedType: fiber.MIMEApplicationJSON},
+		{name: "yaml", query: "?format=yaml", expectedStatus: http.StatusOK, expectedType: configBundleYAMLContentType},
+		{name: "unknown format", query: "?format=toml", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockConfigBundleService(ctrl)
+
+			if tt.expectedStatus == http.StatusOK {
+				service.EXPECT().Export(gomock.Any()).Return(bundle, nil)
+			}
+
+			resp, err := newTestConfigBundleApp(service).Test(httptest.NewRequest(http.MethodGet, "/bundles/export"+tt.query, nil))
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus != http.StatusOK {
+				assert.Equal(t, constant.ErrInvalidQueryParameter.Error(), decodeErrorCode(t, resp.Body))
+				return
+			}
+
+			assert.Equal(t, tt.expectedType, resp.Header.Get(fiber.HeaderContentType))
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			// Both encodings must decode back into the same bundle.
+			decoded, err := decodeConfigBundle(nil, body)
+			require.NoError(t, err)
+			assert.Equal(t, bundle.Rules[0].Name, decoded.Rules[0].Name)
+			assert.True(t, bundle.Limits[0].MaxAmount.Equal(decoded.Limits[0].MaxAmount))
+		})
+	}
+}
+
+func TestEncodeConfigBundleYAML_BlockStyle(t *testing.T) {
+	bundle := model.NewConfigBundle([]model.Rule{{Name: "block", Status: model.RuleStatusActive}}, nil, testutil.FixedTime())
+
+	out, err := encodeConfigBundleYAML(bundle)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(string(out), "apiVersion: tracer.midaz.io/v1\n"), string(out))
+	assert.NotContains(t, string(out), "{")
+
+	var roundTrip map[string]any
+	require.NoError(t, yaml.Unmarshal(out, &roundTrip))
+	assert.Equal(t, model.ConfigBundleKind, roundTrip["kind"])
+}
+
+func TestConfigBundleHandler_Plan(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(service *MockConfigBundleService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "yaml body",
+			body: testBundleYAML,
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Plan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, bundle *model.ConfigBundle) (*model.BundlePlan, error) {
+						assert.Equal(t, "block high amount", bundle.Rules[0].Name)
+						assert.True(t, decimal.NewFromInt(500).Equal(bundle.Limits[0].MaxAmount))
+
+						return &model.BundlePlan{Fingerprint: "abc"}, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "json body",
+			body: `{"apiVersion":"tracer.midaz.io/v1","kind":"TracerConfigBundle","rules":[],"limits":[]}`,
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&model.BundlePlan{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown field",
+			body:           testBundleYAML + "    maxAmmount: \"10\"\n",
+			mockSetup:      func(*MockConfigBundleService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidRequestBody.Error(),
+		},
+		{
+			name:           "empty body",
+			body:           "",
+			mockSetup:      func(*MockConfigBundleService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidRequestBody.Error(),
+		},
+		{
+			name: "invalid bundle",
+			body: testBundleYAML,
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Plan(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: unsupported kind %q", constant.ErrInvalidConfigBundle, "Other"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidConfigBundle.Error(),
+		},
+		{
+			name: "unexpected error",
+			body: testBundleYAML,
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   constant.ErrInternalServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockConfigBundleService(ctrl)
+			tt.mockSetup(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/bundles/plan", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", configBundleYAMLContentType)
+
+			resp, err := newTestConfigBundleApp(service).Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, decodeErrorCode(t, resp.Body))
+			}
+		})
+	}
+}
+
+func TestConfigBundleHandler_Apply(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(service *MockConfigBundleService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "dry run with fingerprint",
+			query: "?dry_run=true&fingerprint=abc",
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Apply(gomock.Any(), gomock.Any(), model.BundleApplyOptions{DryRun: true, ExpectedFingerprint: "abc"}).
+					Return(&model.BundleApplyResult{DryRun: true, Plan: &model.BundlePlan{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid dry_run",
+			query:          "?dry_run=maybe",
+			mockSetup:      func(*MockConfigBundleService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidQueryParameter.Error(),
+		},
+		{
+			name: "plan outdated",
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, constant.ErrConfigBundlePlanOutdated)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   constant.ErrConfigBundlePlanOutdated.Error(),
+		},
+		{
+			name: "immutable change",
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, constant.ErrConfigBundleImmutableChange)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   constant.ErrConfigBundleImmutableChange.Error(),
+		},
+		{
+			name: "failing limit entry is classified as a limit error",
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, &model.BundleEntryError{Kind: model.BundleResourceLimit, Name: "daily-cap", Err: constant.ErrLimitNameAlreadyExists})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   constant.ErrLimitNameAlreadyExists.Error(),
+		},
+		{
+			name: "failing rule entry is classified as a rule error",
+			mockSetup: func(service *MockConfigBundleService) {
+				service.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, &model.BundleEntryError{Kind: model.BundleResourceRule, Name: "block", Err: constant.ErrRuleNameAlreadyExistsInCtx})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   constant.ErrRuleNameAlreadyExistsInCtx.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockConfigBundleService(ctrl)
+			tt.mockSetup(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/bundles/apply"+tt.query, strings.NewReader(testBundleYAML))
+			req.Header.Set("Content-Type", configBundleYAMLContentType)
+
+			resp, err := newTestConfigBundleApp(service).Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, decodeErrorCode(t, resp.Body))
+			}
+		})
+	}
+}
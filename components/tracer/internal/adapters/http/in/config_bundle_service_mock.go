@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: config_bundle_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=config_bundle_handler.go -destination=config_bundle_service_mock.go -package=in
+//
+
+// Package in is a generated GoMock package.
+package in
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigBundleService is a mock of ConfigBundleService interface.
+type MockConfigBundleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigBundleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockConfigBundleServiceMockRecorder is the mock recorder for MockConfigBundleService.
+type MockConfigBundleServiceMockRecorder struct {
+	mock *MockConfigBundleService
+}
+
+// NewMockConfigBundleService creates a new mock instance.
+func NewMockConfigBundleService(ctrl *gomock.Controller) *MockConfigBundleService {
+	mock := &MockConfigBundleService{ctrl: ctrl}
+	mock.recorder = &MockConfigBundleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigBundleService) EXPECT() *MockConfigBundleServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockConfigBundleService) Apply(ctx context.Context, bundle *model.ConfigBundle, opts model.BundleApplyOptions) (*model.BundleApplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, bundle, opts)
+	ret0, _ := ret[0].(*model.BundleApplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockConfigBundleServiceMockRecorder) Apply(ctx, bundle, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockConfigBundleService)(nil).Apply), ctx, bundle, opts)
+}
+
+// Export mocks base method.
+func (m *MockConfigBundleService) Export(ctx context.Context) (*model.ConfigBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx)
+	ret0, _ := ret[0].(*model.ConfigBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockConfigBundleServiceMockRecorder) Export(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockConfigBundleService)(nil).Export), ctx)
+}
+
+// Plan mocks base method.
+func (m *MockConfigBundleService) Plan(ctx context.Context, bundle *model.ConfigBundle) (*model.BundlePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, bundle)
+	ret0, _ := ret[0].(*model.BundlePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockConfigBundleServiceMockRecorder) Plan(ctx, bundle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockConfigBundleService)(nil).Plan), ctx, bundle)
+}
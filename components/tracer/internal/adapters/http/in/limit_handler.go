@@ -536,6 +536,12 @@ func classifyLimitServiceError(span trace.Span, err error) error {
 	case errors.Is(err, constant.ErrLimitInvalidScope):
 		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid scope", err)
 		return pkg.ValidateBusinessError(constant.ErrLimitInvalidScope, constant.EntityLimit)
+	case errors.Is(err, constant.ErrTransactionTypeNotRegistered):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type not registered", err)
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeNotRegistered, constant.EntityLimit)
+	case errors.Is(err, constant.ErrTransactionTypeSubTypeNotAllowed):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope subType not allowed", err)
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeSubTypeNotAllowed, constant.EntityLimit)
 	default:
 		libOpentelemetry.HandleSpanError(span, "Operation failed", err)
 		return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
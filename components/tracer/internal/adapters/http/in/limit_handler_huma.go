@@ -78,7 +78,7 @@ type ListLimitsInputHuma struct {
 	SegmentID       string `query:"segment_id" doc:"Filter by scope segment_id (UUID)"`
 	PortfolioID     string `query:"portfolio_id" doc:"Filter by scope portfolio_id (UUID)"`
 	MerchantID      string `query:"merchant_id" doc:"Filter by scope merchant_id (UUID)"`
-	TransactionType string `query:"transaction_type" doc:"Filter by scope transaction_type (CARD, WIRE, PIX, CRYPTO)"`
+	TransactionType string `query:"transaction_type" doc:"Filter by scope transaction_type (transaction-type catalog code, e.g. CARD, PIX)"`
 	SubType         string `query:"sub_type" doc:"Filter by scope sub_type (case-insensitive; max 50 chars)"`
 	Limit           string `query:"limit" doc:"Max items per page (1-100, default: 10)"`
 	Cursor          string `query:"cursor" doc:"Pagination cursor (empty for first page)"`
@@ -606,7 +606,7 @@ func TestLimitHandler_ListLimits(t *testing.T) {
 		},
 		{
 			name:        "invalid transactionType enum in scope filter returns validation error",
-			queryParams: "?transaction_type=INVALID_TYPE",
+			queryParams: "?transaction_type=invalid-type",
 			mockSetup: func(ctrl *gomock.Controller) *MockLimitService {
 				return NewMockLimitService(ctrl)
 			},
@@ -1630,7 +1630,7 @@ func TestValidateScopeFieldErrors(t *testing.T) {
 				Scopes: []model.Scope{
 					{
 						TransactionType: func() *model.TransactionType {
-							t := model.TransactionType("INVALID_TX_TYPE")
+							t := model.TransactionType("invalid-tx-type")
 							return &t
 						}(),
 					},
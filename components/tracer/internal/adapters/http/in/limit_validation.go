@@ -561,7 +561,7 @@ func formatLimitScopeFieldError(fieldError validator.FieldError) error {
 	case "oneof":
 		msg = fmt.Sprintf("%s must be one of [%s]", fieldName, fieldError.Param())
 	case "transactiontype":
-		msg = fmt.Sprintf("%s must be one of [CARD WIRE PIX CRYPTO]", fieldName)
+		msg = fmt.Sprintf("%s must be a valid transaction type code", fieldName)
 	case "max":
 		msg = fmt.Sprintf("%s must be a maximum of %s characters", fieldName, fieldError.Param())
 	default:
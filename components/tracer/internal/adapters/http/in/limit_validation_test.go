@@ -320,7 +320,7 @@ func TestLimitScopeInput_TransactionTypeValidation(t *testing.T) {
 	}
 
 	t.Run("invalid transactionType", func(t *testing.T) {
-		invalidType := model.TransactionType("INVALID")
+		invalidType := model.TransactionType("not-a-type")
 		input := CreateLimitInput{
 			Name:      "Test Limit",
 			LimitType: model.LimitTypeDaily,
@@ -332,7 +332,7 @@ func TestLimitScopeInput_TransactionTypeValidation(t *testing.T) {
 		}
 		err := input.Validate()
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "transactionType must be one of")
+		assert.Contains(t, err.Error(), "transactionType must be a valid transaction type code")
 	})
 }
 
@@ -739,11 +739,11 @@ func TestListLimitsInput_ValidateScopeFields(t *testing.T) {
 		{
 			name: "error - invalid transactionType enum",
 			input: ListLimitsInput{
-				TransactionType: testutil.StringPtr("INVALID_TYPE"),
+				TransactionType: testutil.StringPtr("invalid-type"),
 				Limit:           testutil.Ptr(10),
 			},
 			expectError: true,
-			errContains: "transaction_type must be one of",
+			errContains: "transaction_type must be a valid transaction type code",
 			errCode:     "0082",
 		},
 		{
@@ -35,7 +35,7 @@ const humaSpecPath = "../../../../api/openapi.huma.yaml"
 // ApiKeyAuth setup, then mounts every Huma op via the shared registerTracerHumaRoutes
 // seam (task-2). Registration reads handler types only — it never invokes them — so
 // zero-value handlers are safe. Reservation is wired non-nil (its 5 ops are in the
-// served spec, per routes_openapi_security_test.go's 28-op table); its tenant
+// served spec, per routes_openapi_security_test.go's 44-op table); its tenant
 // middleware is a no-op passthrough since registration doesn't execute it. The
 // returned huma.API's OpenAPI() is the same object openapi.ServeSpec serializes at
 // runtime — this just reads it offline, no server or DB.
@@ -93,6 +93,10 @@ func buildTracerHumaAPI() huma.API {
 		Reservation:           &ReservationHandler{},
 		ResTenantMW:           func(c *fiber.Ctx) error { return c.Next() },
 		AuditEvent:            &AuditEventHandler{},
+		TransactionType:       &TransactionTypeHandler{},
+		ValidationOutcome:     &ValidationOutcomeHandler{},
+		ConfigBundle:          &ConfigBundleHandler{},
+		AlertPolicy:           &AlertPolicyHandler{},
 	})
 
 	return humaAPI
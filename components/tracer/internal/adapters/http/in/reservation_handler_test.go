@@ -190,12 +190,12 @@ func TestReservationHandler_Reserve(t *testing.T) {
 			},
 		},
 		{
-			// An INVALID (non-empty, non-enum) transactionType is still rejected on
+			// An ill-formed (non-empty) transactionType is still rejected on
 			// the reserve path — relaxation makes it optional, not unvalidated.
 			name: "bad input - invalid transactionType returns 400, service not called",
 			requestBody: func() any {
 				r := newValidReserveRequest()
-				r.TransactionType = model.TransactionType("PIXIE")
+				r.TransactionType = model.TransactionType("pix-e")
 				return r
 			}(),
 			mockSetup: func(ctrl *gomock.Controller) *mocks.MockReservationService {
@@ -156,6 +156,10 @@ type RoutesDeps struct {
 	ReservationService           ReservationService
 	TransactionValidationService TransactionValidationService
 	AuditEventService            AuditEventService
+	TransactionTypeService       TransactionTypeService
+	ValidationOutcomeService     ValidationOutcomeService
+	ConfigBundleService          ConfigBundleService
+	AlertPolicyService           AlertPolicyService
 	Guard                        *middleware.AuthGuard
 	Clock                        clock.Clock
 	MultiTenantEnabled           bool
@@ -183,6 +187,10 @@ func NewRoutes(deps RoutesDeps) (*fiber.App, error) {
 	reservationService := deps.ReservationService
 	transactionValidationService := deps.TransactionValidationService
 	auditEventService := deps.AuditEventService
+	transactionTypeService := deps.TransactionTypeService
+	validationOutcomeService := deps.ValidationOutcomeService
+	configBundleService := deps.ConfigBundleService
+	alertPolicyService := deps.AlertPolicyService
 	guard := deps.Guard
 	clk := deps.Clock
 	multiTenantEnabled := deps.MultiTenantEnabled
@@ -418,6 +426,10 @@ func NewRoutes(deps RoutesDeps) (*fiber.App, error) {
 		Reservation:           reservationHandler,
 		ResTenantMW:           resTenantMW,
 		AuditEvent:            NewAuditEventHandler(auditEventService),
+		TransactionType:       NewTransactionTypeHandler(transactionTypeService),
+		ValidationOutcome:     NewValidationOutcomeHandler(validationOutcomeService),
+		ConfigBundle:          NewConfigBundleHandler(configBundleService),
+		AlertPolicy:           NewAlertPolicyHandler(alertPolicyService),
 	})
 
 	// Native Huma OpenAPI 3.1 spec + Scalar docs, gated on SwaggerEnabled. Mounted
@@ -459,9 +471,13 @@ type tracerHumaHandlers struct {
 	Reservation           *ReservationHandler
 	ResTenantMW           fiber.Handler
 	AuditEvent            *AuditEventHandler
+	TransactionType       *TransactionTypeHandler
+	ValidationOutcome     *ValidationOutcomeHandler
+	ConfigBundle          *ConfigBundleHandler
+	AlertPolicy           *AlertPolicyHandler
 }
 
-// registerTracerHumaRoutes mounts all 28 tracer Huma operations on the given
+// registerTracerHumaRoutes mounts all 44 tracer Huma operations on the given
 // Huma API, attaching each op's pre-Huma Fiber auth chain to the SAME /v1 group
 // first. It is the single registration seam shared by production (NewRoutes) and
 // the http/in tests, so the mounted surface is identical without a running
@@ -560,4 +576,38 @@ func registerTracerHumaRoutes(api fiber.Router, humaAPI huma.API, h tracerHumaHa
 	api.Get("/audit-events/:id", guard.With("audit-events", "get", false))
 	api.Get("/audit-events/:id/verify", guard.With("audit-events", "get", false))
 	RegisterAuditEventRoutes(humaAPI, h.AuditEvent)
+
+	// Transaction-type catalog endpoints — Huma. Fiber keeps :code; Huma
+	// registers the same paths as {code}.
+	api.Post("/transaction-types", guard.With("transaction-types", "post", false))
+	api.Get("/transaction-types", guard.With("transaction-types", "get", false))
+	api.Get("/transaction-types/:code", guard.With("transaction-types", "get", false))
+	api.Patch("/transaction-types/:code", guard.With("transaction-types", "patch", false))
+	RegisterTransactionTypeRoutes(humaAPI, h.TransactionType)
+
+	// Validation outcome labels and labeled-data reports — Huma. Labels are
+	// guarded as "validations" (they annotate a validation record); the reports
+	// have their own "outcomes" resource so read access can be granted separately.
+	api.Put("/validations/:id/outcome", guard.With("validations", "put", false))
+	api.Get("/validations/:id/outcome", guard.With("validations", "get", false))
+	api.Get("/outcomes/rule-performance", guard.With("outcomes", "get", false))
+	api.Get("/outcomes/dataset", guard.With("outcomes", "get", false))
+	RegisterValidationOutcomeRoutes(humaAPI, h.ValidationOutcome)
+
+	// Configuration bundle export and plan/apply — Huma. Apply writes rules and
+	// limits through their own commands, so it is guarded by "bundles" post here
+	// and every entry still leaves the per-resource audit trail.
+	api.Get("/bundles/export", guard.With("bundles", "get", false))
+	api.Post("/bundles/plan", guard.With("bundles", "post", false))
+	api.Post("/bundles/apply", guard.With("bundles", "post", false))
+	RegisterConfigBundleRoutes(humaAPI, h.ConfigBundle)
+
+	// Decision-rate alert policies — Huma. Fiber keeps :id; Huma registers the
+	// same paths as {id}.
+	api.Post("/alert-policies", guard.With("alert-policies", "post", false))
+	api.Get("/alert-policies", guard.With("alert-policies", "get", false))
+	api.Get("/alert-policies/:id", guard.With("alert-policies", "get", false))
+	api.Patch("/alert-policies/:id", guard.With("alert-policies", "patch", false))
+	api.Delete("/alert-policies/:id", guard.With("alert-policies", "delete", false))
+	RegisterAlertPolicyRoutes(humaAPI, h.AlertPolicy)
 }
@@ -140,7 +140,7 @@ func TestSpecLock_PerOpSecurity(t *testing.T) {
 	}
 }
 
-// TestSpecLock_AllOpsSecurity asserts EVERY one of the 28 Huma operations
+// TestSpecLock_AllOpsSecurity asserts EVERY one of the 44 Huma operations
 // advertises its expected per-op Security requirement in the served spec. This
 // is the CI backstop the tracer lacks otherwise: postman/generator/check-docs.sh
 // security-coverage gate is ledger-only (SECURITY_COVERAGE_COMPONENT="ledger"),
@@ -191,9 +191,29 @@ func TestSpecLock_AllOpsSecurity(t *testing.T) {
 		{"/audit-events", http.MethodGet, bearerOrAPIKey},
 		{"/audit-events/{id}", http.MethodGet, bearerOrAPIKey},
 		{"/audit-events/{id}/verify", http.MethodGet, bearerOrAPIKey},
+		// transaction-types (4)
+		{"/transaction-types", http.MethodPost, bearerOrAPIKey},
+		{"/transaction-types", http.MethodGet, bearerOrAPIKey},
+		{"/transaction-types/{code}", http.MethodGet, bearerOrAPIKey},
+		{"/transaction-types/{code}", http.MethodPatch, bearerOrAPIKey},
+		// validation outcomes (4)
+		{"/validations/{id}/outcome", http.MethodPut, bearerOrAPIKey},
+		{"/validations/{id}/outcome", http.MethodGet, bearerOrAPIKey},
+		{"/outcomes/rule-performance", http.MethodGet, bearerOrAPIKey},
+		{"/outcomes/dataset", http.MethodGet, bearerOrAPIKey},
+		// configuration bundles (3)
+		{"/bundles/export", http.MethodGet, bearerOrAPIKey},
+		{"/bundles/plan", http.MethodPost, bearerOrAPIKey},
+		{"/bundles/apply", http.MethodPost, bearerOrAPIKey},
+		// alert policies (5)
+		{"/alert-policies", http.MethodPost, bearerOrAPIKey},
+		{"/alert-policies", http.MethodGet, bearerOrAPIKey},
+		{"/alert-policies/{id}", http.MethodGet, bearerOrAPIKey},
+		{"/alert-policies/{id}", http.MethodPatch, bearerOrAPIKey},
+		{"/alert-policies/{id}", http.MethodDelete, bearerOrAPIKey},
 	}
 
-	require.Lenf(t, cases, 28, "the tracer has 28 protected Huma ops; keep this table complete")
+	require.Lenf(t, cases, 44, "the tracer has 44 protected Huma ops; keep this table complete")
 
 	for _, tc := range cases {
 		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
@@ -496,6 +496,14 @@ func classifyServiceError(span trace.Span, err error) error {
 		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid sort column", err)
 
 		return pkg.ValidateBusinessError(constant.ErrInvalidSortColumn, constant.EntityRule)
+	case errors.Is(err, constant.ErrTransactionTypeNotRegistered):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type not registered", err)
+
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeNotRegistered, constant.EntityRule)
+	case errors.Is(err, constant.ErrTransactionTypeSubTypeNotAllowed):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope subType not allowed", err)
+
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeSubTypeNotAllowed, constant.EntityRule)
 	default:
 		libOpentelemetry.HandleSpanError(span, "Operation failed", err)
 		return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
@@ -916,7 +916,7 @@ func TestHandler_ListRules(t *testing.T) {
 		},
 		{
 			name:        "error - invalid transactionType enum",
-			queryParams: "?transaction_type=INVALID",
+			queryParams: "?transaction_type=invalid-type",
 			mockSetup: func(ctrl *gomock.Controller) *MockRuleService {
 				mockService := NewMockRuleService(ctrl)
 				return mockService
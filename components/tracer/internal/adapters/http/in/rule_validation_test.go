@@ -473,7 +473,7 @@ func TestScope_TransactionTypeValidation(t *testing.T) {
 	}
 
 	t.Run("invalid transactionType", func(t *testing.T) {
-		invalidType := model.TransactionType("INVALID")
+		invalidType := model.TransactionType("not-a-type")
 		input := CreateRuleInput{
 			Name:       "Test Rule",
 			Expression: "amount > 1000",
@@ -1034,7 +1034,7 @@ func TestListRulesInput_Validate(t *testing.T) {
 			name: "invalid - transactionType not a valid enum",
 			input: ListRulesInput{
 				Limit:           testutil.Ptr(10),
-				TransactionType: testutil.StringPtr("INVALID_TYPE"),
+				TransactionType: testutil.StringPtr("invalid-type"),
 			},
 			wantErr: true,
 			errMsg:  "transaction_type",
@@ -0,0 +1,280 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+//go:generate mockgen -source=transaction_type_handler.go -destination=transaction_type_service_mock.go -package=in
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// TransactionTypeService defines the interface for transaction-type catalog
+// operations. Interface defined locally per Ring pattern.
+type TransactionTypeService interface {
+	CreateTransactionType(ctx context.Context, input *command.CreateTransactionTypeInput) (*model.TransactionTypeDefinition, error)
+	GetTransactionType(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error)
+	ListTransactionTypes(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error)
+	UpdateTransactionType(ctx context.Context, code model.TransactionType, input *command.UpdateTransactionTypeInput) (*model.TransactionTypeDefinition, error)
+}
+
+// CreateTransactionTypeInput is the request body for POST /v1/transaction-types.
+type CreateTransactionTypeInput struct {
+	Code        string   `json:"code" maxLength:"32" example:"BOLETO"`
+	Description *string  `json:"description,omitempty" maxLength:"1000" example:"Brazilian bank slip payment"`
+	SubTypes    []string `json:"subTypes,omitempty" example:"registered"`
+}
+
+// UpdateTransactionTypeInput is the request body for PATCH
+// /v1/transaction-types/{code}. The code is immutable; rename by registering a
+// new type and deactivating the old one.
+type UpdateTransactionTypeInput struct {
+	Description *string                      `json:"description,omitempty" maxLength:"1000"`
+	SubTypes    *[]string                    `json:"subTypes,omitempty"`
+	Status      *model.TransactionTypeStatus `json:"status,omitempty" swaggertype:"string" enums:"ACTIVE,INACTIVE"`
+}
+
+// IsEmpty reports whether the update carries no field to change.
+func (i *UpdateTransactionTypeInput) IsEmpty() bool {
+	return i.Description == nil && i.SubTypes == nil && i.Status == nil
+}
+
+// ListTransactionTypesResponse is the response body for GET
+// /v1/transaction-types. The catalog is small and bounded per tenant, so it is
+// returned whole rather than paginated.
+type ListTransactionTypesResponse struct {
+	TransactionTypes []*model.TransactionTypeDefinition `json:"transactionTypes"`
+}
+
+// TransactionTypeHandler handles HTTP requests for the transaction-type catalog.
+type TransactionTypeHandler struct {
+	service TransactionTypeService
+}
+
+// NewTransactionTypeHandler creates a new transaction-type handler.
+func NewTransactionTypeHandler(service TransactionTypeService) *TransactionTypeHandler {
+	return &TransactionTypeHandler{
+		service: service,
+	}
+}
+
+func (h *TransactionTypeHandler) CreateTransactionType(c *fiber.Ctx) error {
+	result, err := h.createTransactionType(c.UserContext(), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.Created(c, result)
+}
+
+// createTransactionType is the transport-agnostic core of the create operation
+// shared by the Fiber method and the Huma func. Field validation lives in
+// model.NewTransactionTypeDefinition; its sentinels are canonicalized by
+// classifyTransactionTypeServiceError.
+func (h *TransactionTypeHandler) createTransactionType(ctx context.Context, rawBody []byte) (*model.TransactionTypeDefinition, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.transaction_type.create")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var input CreateTransactionTypeInput
+	if err := json.Unmarshal(rawBody, &input); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse request body", err)
+		return nil, pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or contains invalid JSON. Please verify the syntax and try again."}
+	}
+
+	result, err := h.service.CreateTransactionType(ctx, &command.CreateTransactionTypeInput{
+		Code:        input.Code,
+		Description: input.Description,
+		SubTypes:    input.SubTypes,
+	})
+	if err != nil {
+		return nil, classifyTransactionTypeServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.transaction_type.create"),
+		libLog.String("transaction_type.code", result.Code.String()),
+	).Log(ctx, libLog.LevelDebug, "Transaction type created")
+
+	return result, nil
+}
+
+func (h *TransactionTypeHandler) GetTransactionType(c *fiber.Ctx) error {
+	result, err := h.getTransactionType(c.UserContext(), c.Params("code"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// getTransactionType is the transport-agnostic core of the get operation.
+func (h *TransactionTypeHandler) getTransactionType(ctx context.Context, codeParam string) (*model.TransactionTypeDefinition, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.transaction_type.get")
+	defer span.End()
+
+	code, err := parseTransactionTypeCode(span, codeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.GetTransactionType(ctx, code)
+	if err != nil {
+		return nil, classifyTransactionTypeServiceError(span, err)
+	}
+
+	return result, nil
+}
+
+func (h *TransactionTypeHandler) ListTransactionTypes(c *fiber.Ctx) error {
+	result, err := h.listTransactionTypes(c.UserContext(), c.Query("status"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// listTransactionTypes is the transport-agnostic core of the list operation.
+// An empty status lists every entry.
+func (h *TransactionTypeHandler) listTransactionTypes(ctx context.Context, status string) (*ListTransactionTypesResponse, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.transaction_type.list")
+	defer span.End()
+
+	filter := &model.ListTransactionTypesFilter{}
+
+	if status != "" {
+		s := model.TransactionTypeStatus(strings.ToUpper(status))
+		if !s.IsValid() {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid status filter", constant.ErrTransactionTypeInvalidStatus)
+			return nil, pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityTransactionType, "status")
+		}
+
+		filter.Status = &s
+	}
+
+	result, err := h.service.ListTransactionTypes(ctx, filter)
+	if err != nil {
+		return nil, classifyTransactionTypeServiceError(span, err)
+	}
+
+	if result == nil {
+		result = []*model.TransactionTypeDefinition{}
+	}
+
+	return &ListTransactionTypesResponse{TransactionTypes: result}, nil
+}
+
+func (h *TransactionTypeHandler) UpdateTransactionType(c *fiber.Ctx) error {
+	result, err := h.updateTransactionType(c.UserContext(), c.Params("code"), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// updateTransactionType is the transport-agnostic core of the update operation.
+func (h *TransactionTypeHandler) updateTransactionType(ctx context.Context, codeParam string, rawBody []byte) (*model.TransactionTypeDefinition, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.transaction_type.update")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	code, err := parseTransactionTypeCode(span, codeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	var input UpdateTransactionTypeInput
+	if err := json.Unmarshal(rawBody, &input); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse request body", err)
+		return nil, pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or contains invalid JSON. Please verify the syntax and try again."}
+	}
+
+	if input.IsEmpty() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "No fields to update", nil)
+		return nil, pkg.ValidateBusinessError(constant.ErrNothingToUpdate, constant.EntityTransactionType)
+	}
+
+	result, err := h.service.UpdateTransactionType(ctx, code, &command.UpdateTransactionTypeInput{
+		Description: input.Description,
+		SubTypes:    input.SubTypes,
+		Status:      input.Status,
+	})
+	if err != nil {
+		return nil, classifyTransactionTypeServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.transaction_type.update"),
+		libLog.String("transaction_type.code", result.Code.String()),
+		libLog.String("transaction_type.status", result.Status.String()),
+	).Log(ctx, libLog.LevelDebug, "Transaction type updated")
+
+	return result, nil
+}
+
+// parseTransactionTypeCode validates the {code} path parameter. Codes are
+// matched case-insensitively, so "boleto" addresses BOLETO.
+func parseTransactionTypeCode(span trace.Span, codeParam string) (model.TransactionType, error) {
+	code := model.TransactionType(strings.ToUpper(strings.TrimSpace(codeParam)))
+	if !code.IsValid() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction type code", constant.ErrTransactionTypeInvalidCode)
+		return "", pkg.ValidateBusinessError(constant.ErrInvalidPathParameter, constant.EntityTransactionType, "code")
+	}
+
+	return code, nil
+}
+
+// classifyTransactionTypeServiceError maps a raw service error to its canonical
+// Midaz error. See classifyLimitServiceError for the pass-through rationale.
+func classifyTransactionTypeServiceError(span trace.Span, err error) error {
+	if pkg.IsBusinessError(err) {
+		return err
+	}
+
+	for _, sentinel := range []error{
+		constant.ErrTransactionTypeNotFound,
+		constant.ErrTransactionTypeAlreadyExists,
+		constant.ErrTransactionTypeInvalidCode,
+		constant.ErrTransactionTypeInvalidSubTypes,
+		constant.ErrTransactionTypeInvalidStatus,
+		constant.ErrTransactionTypeDescriptionTooLong,
+	} {
+		if errors.Is(err, sentinel) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type request rejected", err)
+			return pkg.ValidateBusinessError(sentinel, constant.EntityTransactionType)
+		}
+	}
+
+	libOpentelemetry.HandleSpanError(span, "Operation failed", err)
+
+	return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+}
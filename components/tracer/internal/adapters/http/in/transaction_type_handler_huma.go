@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// Huma surface for the transaction-type catalog, following the reference
+// pattern in rule_handler_huma.go: raw bodies + SkipValidateBody, doc-only
+// path/query params, and humaProblem for errors.
+
+// CreateTransactionTypeInputHuma is the Huma request envelope for POST
+// /v1/transaction-types.
+type CreateTransactionTypeInputHuma struct {
+	RawBody []byte `contentType:"application/json"`
+}
+
+// TransactionTypeCodeInputHuma is the Huma request envelope for GET
+// /v1/transaction-types/{code}.
+type TransactionTypeCodeInputHuma struct {
+	Code string `path:"code" doc:"Transaction type code (e.g. CARD, BOLETO)"`
+}
+
+// UpdateTransactionTypeInputHuma is the Huma request envelope for PATCH
+// /v1/transaction-types/{code}.
+type UpdateTransactionTypeInputHuma struct {
+	Code    string `path:"code" doc:"Transaction type code (e.g. CARD, BOLETO)"`
+	RawBody []byte `contentType:"application/json"`
+}
+
+// ListTransactionTypesInputHuma is the Huma request envelope for GET
+// /v1/transaction-types.
+type ListTransactionTypesInputHuma struct {
+	Status string `query:"status" doc:"Filter by status (ACTIVE, INACTIVE)"`
+}
+
+// TransactionTypeOutputHuma is the shared single-entry response envelope.
+type TransactionTypeOutputHuma struct {
+	Status int
+	Body   *model.TransactionTypeDefinition
+}
+
+// ListTransactionTypesOutputHuma is the Huma response envelope for GET
+// /v1/transaction-types.
+type ListTransactionTypesOutputHuma struct {
+	Status int
+	Body   *ListTransactionTypesResponse
+}
+
+// CreateTransactionTypeHuma is the Huma handler for POST /v1/transaction-types.
+func (h *TransactionTypeHandler) CreateTransactionTypeHuma(ctx context.Context, in *CreateTransactionTypeInputHuma) (*TransactionTypeOutputHuma, error) {
+	result, err := h.createTransactionType(ctx, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &TransactionTypeOutputHuma{Status: http.StatusCreated, Body: result}, nil
+}
+
+// GetTransactionTypeHuma is the Huma handler for GET /v1/transaction-types/{code}.
+func (h *TransactionTypeHandler) GetTransactionTypeHuma(ctx context.Context, in *TransactionTypeCodeInputHuma) (*TransactionTypeOutputHuma, error) {
+	result, err := h.getTransactionType(ctx, in.Code)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &TransactionTypeOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// UpdateTransactionTypeHuma is the Huma handler for PATCH
+// /v1/transaction-types/{code}.
+func (h *TransactionTypeHandler) UpdateTransactionTypeHuma(ctx context.Context, in *UpdateTransactionTypeInputHuma) (*TransactionTypeOutputHuma, error) {
+	result, err := h.updateTransactionType(ctx, in.Code, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &TransactionTypeOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// ListTransactionTypesHuma is the Huma handler for GET /v1/transaction-types.
+func (h *TransactionTypeHandler) ListTransactionTypesHuma(ctx context.Context, in *ListTransactionTypesInputHuma) (*ListTransactionTypesOutputHuma, error) {
+	result, err := h.listTransactionTypes(ctx, in.Status)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ListTransactionTypesOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// RegisterTransactionTypeRoutes registers the transaction-type catalog
+// operations on the shared Huma API. The auth middleware for these paths is
+// attached in registerTracerHumaRoutes.
+func RegisterTransactionTypeRoutes(api huma.API, h *TransactionTypeHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID:      "createTransactionType",
+		Method:           http.MethodPost,
+		Path:             "/transaction-types",
+		Summary:          "Register a transaction type in the catalog",
+		Tags:             []string{"Transaction Types"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.CreateTransactionTypeHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "listTransactionTypes",
+		Method:      http.MethodGet,
+		Path:        "/transaction-types",
+		Summary:     "List the transaction-type catalog",
+		Tags:        []string{"Transaction Types"},
+		Security:    secBearerOrAPIKey,
+	}, h.ListTransactionTypesHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getTransactionType",
+		Method:      http.MethodGet,
+		Path:        "/transaction-types/{code}",
+		Summary:     "Get a transaction type by code",
+		Tags:        []string{"Transaction Types"},
+		Security:    secBearerOrAPIKey,
+	}, h.GetTransactionTypeHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID:      "updateTransactionType",
+		Method:           http.MethodPatch,
+		Path:             "/transaction-types/{code}",
+		Summary:          "Update or deactivate a transaction type",
+		Tags:             []string{"Transaction Types"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.UpdateTransactionTypeHuma)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newTestTransactionTypeApp(service TransactionTypeService) *fiber.App {
+	handler := NewTransactionTypeHandler(service)
+
+	app := fiber.New()
+	app.Post("/transaction-types", handler.CreateTransactionType)
+	app.Get("/transaction-types", handler.ListTransactionTypes)
+	app.Get("/transaction-types/:code", handler.GetTransactionType)
+	app.Patch("/transaction-types/:code", handler.UpdateTransactionType)
+
+	return app
+}
+
+func testTransactionTypeDefinition(t *testing.T, code string) *model.TransactionTypeDefinition {
+	t.Helper()
+
+	def, err := model.NewTransactionTypeDefinition(code, nil, nil, testutil.FixedTime())
+	require.NoError(t, err)
+
+	return def
+}
+
+func decodeErrorCode(t *testing.T, body io.Reader) string {
+	t.Helper()
+
+	var response map[string]any
+	require.NoError(t, json.NewDecoder(body).Decode(&response))
+
+	code, _ := response["code"].(string)
+
+	return code
+}
+
+func TestTransactionTypeHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(service *MockTransactionTypeService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "success",
+			body: `{"code":"boleto","subTypes":["registered"]}`,
+			mockSetup: func(service *MockTransactionTypeService) {
+				service.EXPECT().
+					CreateTransactionType(gomock.Any(), &command.CreateTransactionTypeInput{Code: "boleto", SubTypes: []string{"registered"}}).
+					Return(testTransactionTypeDefinition(t, "BOLETO"), nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "malformed body",
+			body:           `{"code":`,
+			mockSetup:      func(*MockTransactionTypeService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidRequestBody.Error(),
+		},
+		{
+			name: "invalid code",
+			body: `{"code":"9LIVES"}`,
+			mockSetup: func(service *MockTransactionTypeService) {
+				service.EXPECT().CreateTransactionType(gomock.Any(), gomock.Any()).Return(nil, constant.ErrTransactionTypeInvalidCode)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrTransactionTypeInvalidCode.Error(),
+		},
+		{
+			name: "already exists",
+			body: `{"code":"CARD"}`,
+			mockSetup: func(service *MockTransactionTypeService) {
+				service.EXPECT().CreateTransactionType(gomock.Any(), gomock.Any()).Return(nil, constant.ErrTransactionTypeAlreadyExists)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   constant.ErrTransactionTypeAlreadyExists.Error(),
+		},
+		{
+			name: "unexpected error",
+			body: `{"code":"CARD"}`,
+			mockSetup: func(service *MockTransactionTypeService) {
+				service.EXPECT().CreateTransactionType(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   constant.ErrInternalServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockTransactionTypeService(ctrl)
+			tt.mockSetup(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/transaction-types", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := newTestTransactionTypeApp(service).Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, decodeErrorCode(t, resp.Body))
+			}
+		})
+	}
+}
+
+func TestTransactionTypeHandler_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockTransactionTypeService(ctrl)
+	app := newTestTransactionTypeApp(service)
+
+	// Path codes are matched case-insensitively.
+	service.EXPECT().GetTransactionType(gomock.Any(), model.TransactionType("BOLETO")).Return(testTransactionTypeDefinition(t, "BOLETO"), nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/transaction-types/boleto", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	service.EXPECT().GetTransactionType(gomock.Any(), model.TransactionType("ACH")).Return(nil, constant.ErrTransactionTypeNotFound)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/transaction-types/ACH", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, constant.ErrTransactionTypeNotFound.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/transaction-types/not-a-type", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidPathParameter.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestTransactionTypeHandler_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockTransactionTypeService(ctrl)
+	app := newTestTransactionTypeApp(service)
+
+	active := model.TransactionTypeStatusActive
+
+	service.EXPECT().
+		ListTransactionTypes(gomock.Any(), &model.ListTransactionTypesFilter{Status: &active}).
+		Return([]*model.TransactionTypeDefinition{testTransactionTypeDefinition(t, "CARD")}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/transaction-types?status=active", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body ListTransactionTypesResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.TransactionTypes, 1)
+	assert.Equal(t, model.TransactionType("CARD"), body.TransactionTypes[0].Code)
+	resp.Body.Close()
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/transaction-types?status=ARCHIVED", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidQueryParameter.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestTransactionTypeHandler_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockTransactionTypeService(ctrl)
+	app := newTestTransactionTypeApp(service)
+
+	inactive := model.TransactionTypeStatusInactive
+	updated := testTransactionTypeDefinition(t, "BOLETO")
+	updated.Status = inactive
+
+	service.EXPECT().
+		UpdateTransactionType(gomock.Any(), model.TransactionType("BOLETO"), &command.UpdateTransactionTypeInput{Status: &inactive}).
+		Return(updated, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/transaction-types/BOLETO", strings.NewReader(`{"status":"INACTIVE"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	req = httptest.NewRequest(http.MethodPatch, "/transaction-types/BOLETO", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrNothingToUpdate.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transaction_type_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=transaction_type_handler.go -destination=transaction_type_service_mock.go -package=in
+//
+
+// Package in is a generated GoMock package.
+package in
+
+import (
+	context "context"
+	reflect "reflect"
+
+	command "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionTypeService is a mock of TransactionTypeService interface.
+type MockTransactionTypeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionTypeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionTypeServiceMockRecorder is the mock recorder for MockTransactionTypeService.
+type MockTransactionTypeServiceMockRecorder struct {
+	mock *MockTransactionTypeService
+}
+
+// NewMockTransactionTypeService creates a new mock instance.
+func NewMockTransactionTypeService(ctrl *gomock.Controller) *MockTransactionTypeService {
+	mock := &MockTransactionTypeService{ctrl: ctrl}
+	mock.recorder = &MockTransactionTypeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionTypeService) EXPECT() *MockTransactionTypeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionType mocks base method.
+func (m *MockTransactionTypeService) CreateTransactionType(ctx context.Context, input *command.CreateTransactionTypeInput) (*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionType", ctx, input)
+	ret0, _ := ret[0].(*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionType indicates an expected call of CreateTransactionType.
+func (mr *MockTransactionTypeServiceMockRecorder) CreateTransactionType(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionType", reflect.TypeOf((*MockTransactionTypeService)(nil).CreateTransactionType), ctx, input)
+}
+
+// GetTransactionType mocks base method.
+func (m *MockTransactionTypeService) GetTransactionType(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionType", ctx, code)
+	ret0, _ := ret[0].(*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionType indicates an expected call of GetTransactionType.
+func (mr *MockTransactionTypeServiceMockRecorder) GetTransactionType(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionType", reflect.TypeOf((*MockTransactionTypeService)(nil).GetTransactionType), ctx, code)
+}
+
+// ListTransactionTypes mocks base method.
+func (m *MockTransactionTypeService) ListTransactionTypes(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactionTypes", ctx, filter)
+	ret0, _ := ret[0].([]*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactionTypes indicates an expected call of ListTransactionTypes.
+func (mr *MockTransactionTypeServiceMockRecorder) ListTransactionTypes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactionTypes", reflect.TypeOf((*MockTransactionTypeService)(nil).ListTransactionTypes), ctx, filter)
+}
+
+// UpdateTransactionType mocks base method.
+func (m *MockTransactionTypeService) UpdateTransactionType(ctx context.Context, code model.TransactionType, input *command.UpdateTransactionTypeInput) (*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionType", ctx, code, input)
+	ret0, _ := ret[0].(*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransactionType indicates an expected call of UpdateTransactionType.
+func (mr *MockTransactionTypeServiceMockRecorder) UpdateTransactionType(ctx, code, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionType", reflect.TypeOf((*MockTransactionTypeService)(nil).UpdateTransactionType), ctx, code, input)
+}
@@ -444,7 +444,7 @@ type ValidationSummary struct {
 	Reason           string                `json:"reason" example:"All rules passed"`
 	Amount           decimal.Decimal       `json:"amount" swaggertype:"string" example:"100.00"`
 	Currency         string                `json:"currency" example:"USD"`
-	TransactionType  model.TransactionType `json:"transactionType" swaggertype:"string" enums:"CARD,WIRE,PIX,CRYPTO" example:"CARD"`
+	TransactionType  model.TransactionType `json:"transactionType" swaggertype:"string" example:"CARD"`
 	AccountID        uuid.UUID             `json:"accountId" swaggertype:"string" format:"uuid"`
 	SegmentID        *uuid.UUID            `json:"segmentId,omitempty" swaggertype:"string" format:"uuid"`
 	PortfolioID      *uuid.UUID            `json:"portfolioId,omitempty" swaggertype:"string" format:"uuid"`
@@ -67,7 +67,7 @@ type ListTransactionValidationsInputHuma struct {
 	ExceededLimitID string `query:"exceeded_limit_id" doc:"Filter by exceeded limit ID (UUID)"`
 	SegmentID       string `query:"segment_id" doc:"Filter by segment ID (UUID)"`
 	PortfolioID     string `query:"portfolio_id" doc:"Filter by portfolio ID (UUID)"`
-	TransactionType string `query:"transaction_type" doc:"Filter by transaction type (CARD, WIRE, PIX, CRYPTO)"`
+	TransactionType string `query:"transaction_type" doc:"Filter by transaction type (transaction-type catalog code, e.g. CARD, PIX)"`
 
 	// rawQuery is the request's parsed query, captured by Resolve. It is the
 	// binding source (NOT the struct-tag fields above), so present-but-empty keys
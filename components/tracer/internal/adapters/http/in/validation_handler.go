@@ -199,6 +199,14 @@ func (h *ValidationHandler) classifyValidationError(span trace.Span, err error)
 		libOpentelemetry.HandleSpanError(span, "Limit check failed", err)
 
 		return pkg.ValidateBusinessError(constant.ErrLimitCheckFailed, constant.EntityValidationRequest)
+	case errors.Is(err, constant.ErrTransactionTypeNotRegistered):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type not registered", err)
+
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeNotRegistered, constant.EntityValidationRequest)
+	case errors.Is(err, constant.ErrTransactionTypeSubTypeNotAllowed):
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "SubType not allowed for transaction type", err)
+
+		return pkg.ValidateBusinessError(constant.ErrTransactionTypeSubTypeNotAllowed, constant.EntityValidationRequest)
 	default:
 		libOpentelemetry.HandleSpanError(span, "Validation failed", err)
 
@@ -0,0 +1,429 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+//go:generate mockgen -source=validation_outcome_handler.go -destination=validation_outcome_service_mock.go -package=in
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/net/http"
+)
+
+// Dataset export formats accepted by the format query parameter.
+const (
+	outcomeDatasetFormatJSON = "json"
+	outcomeDatasetFormatCSV  = "csv"
+)
+
+// ValidationOutcomeService defines the interface for outcome labeling and the
+// labeled-data reports. Interface defined locally per Ring pattern.
+type ValidationOutcomeService interface {
+	LabelValidationOutcome(ctx context.Context, validationID uuid.UUID, input *command.LabelValidationOutcomeInput) (*model.ValidationOutcome, error)
+	GetValidationOutcome(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error)
+	GetRulePerformanceReport(ctx context.Context, filter *model.OutcomeReportFilter) (*model.RulePerformanceReport, error)
+	ExportOutcomeDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error)
+}
+
+// LabelValidationOutcomeInput is the request body for PUT
+// /v1/validations/{id}/outcome.
+type LabelValidationOutcomeInput struct {
+	Label string  `json:"label" enum:"CONFIRMED_FRAUD,CHARGEBACK,FALSE_POSITIVE,CUSTOMER_CONFIRMED" example:"CHARGEBACK"`
+	Note  *string `json:"note,omitempty" maxLength:"1000" example:"Chargeback reason code 10.4"`
+}
+
+// OutcomeReportQuery carries the raw query parameters shared by the
+// rule-performance report and the dataset export. Every field is a string so
+// parsing stays imperative and a bad value yields the canonical 400.
+type OutcomeReportQuery struct {
+	StartDate string
+	EndDate   string
+	RuleID    string
+	Label     string
+	Limit     string
+	Format    string
+}
+
+// OutcomeDatasetResponse is the JSON body of GET /v1/outcomes/dataset.
+type OutcomeDatasetResponse struct {
+	Rows []*model.OutcomeDatasetRow `json:"rows"`
+}
+
+// ValidationOutcomeHandler handles HTTP requests for outcome labels and the
+// labeled-data reports.
+type ValidationOutcomeHandler struct {
+	service ValidationOutcomeService
+}
+
+// NewValidationOutcomeHandler creates a new validation outcome handler.
+func NewValidationOutcomeHandler(service ValidationOutcomeService) *ValidationOutcomeHandler {
+	return &ValidationOutcomeHandler{
+		service: service,
+	}
+}
+
+func (h *ValidationOutcomeHandler) LabelValidationOutcome(c *fiber.Ctx) error {
+	result, err := h.labelValidationOutcome(c.UserContext(), c.Params("id"), c.Body())
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// labelValidationOutcome is the transport-agnostic core of the label
+// operation shared by the Fiber method and the Huma func.
+func (h *ValidationOutcomeHandler) labelValidationOutcome(ctx context.Context, idParam string, rawBody []byte) (*model.ValidationOutcome, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.validation_outcome.label")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	id, err := parseValidationOutcomeID(span, idParam)
+	if err != nil {
+		return nil, err
+	}
+
+	var input LabelValidationOutcomeInput
+	if err := json.Unmarshal(rawBody, &input); err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to parse request body", err)
+		return nil, pkg.ValidationError{Code: constant.ErrInvalidRequestBody.Error(), Title: "Bad Request", Message: "The request body is malformed or contains invalid JSON. Please verify the syntax and try again."}
+	}
+
+	result, err := h.service.LabelValidationOutcome(ctx, id, &command.LabelValidationOutcomeInput{
+		Label: input.Label,
+		Note:  input.Note,
+	})
+	if err != nil {
+		return nil, classifyValidationOutcomeServiceError(span, err)
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.validation_outcome.label"),
+		libLog.String("validation.id", id.String()),
+		libLog.String("outcome.label", result.Label.String()),
+	).Log(ctx, libLog.LevelDebug, "Validation outcome labeled")
+
+	return result, nil
+}
+
+func (h *ValidationOutcomeHandler) GetValidationOutcome(c *fiber.Ctx) error {
+	result, err := h.getValidationOutcome(c.UserContext(), c.Params("id"))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// getValidationOutcome is the transport-agnostic core of the get operation.
+func (h *ValidationOutcomeHandler) getValidationOutcome(ctx context.Context, idParam string) (*model.ValidationOutcome, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.validation_outcome.get")
+	defer span.End()
+
+	id, err := parseValidationOutcomeID(span, idParam)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.GetValidationOutcome(ctx, id)
+	if err != nil {
+		return nil, classifyValidationOutcomeServiceError(span, err)
+	}
+
+	return result, nil
+}
+
+func (h *ValidationOutcomeHandler) GetRulePerformanceReport(c *fiber.Ctx) error {
+	result, err := h.getRulePerformanceReport(c.UserContext(), outcomeReportQueryFromFiber(c))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	return http.OK(c, result)
+}
+
+// getRulePerformanceReport is the transport-agnostic core of the report operation.
+func (h *ValidationOutcomeHandler) getRulePerformanceReport(ctx context.Context, q OutcomeReportQuery) (*model.RulePerformanceReport, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.validation_outcome.rule_performance")
+	defer span.End()
+
+	filter, err := parseOutcomeReportQuery(span, q)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.GetRulePerformanceReport(ctx, filter)
+	if err != nil {
+		return nil, classifyValidationOutcomeServiceError(span, err)
+	}
+
+	return result, nil
+}
+
+func (h *ValidationOutcomeHandler) ExportOutcomeDataset(c *fiber.Ctx) error {
+	body, contentType, err := h.exportOutcomeDataset(c.UserContext(), outcomeReportQueryFromFiber(c))
+	if err != nil {
+		return http.WithError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+
+	return c.Status(fiber.StatusOK).Send(body)
+}
+
+// exportOutcomeDataset is the transport-agnostic core of the dataset export.
+// It returns the encoded body and its content type: JSON by default, CSV when
+// format=csv so the dataset can be loaded straight into analysis tooling.
+func (h *ValidationOutcomeHandler) exportOutcomeDataset(ctx context.Context, q OutcomeReportQuery) ([]byte, string, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "handler.validation_outcome.export_dataset")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	format := strings.ToLower(strings.TrimSpace(q.Format))
+	if format == "" {
+		format = outcomeDatasetFormatJSON
+	}
+
+	if format != outcomeDatasetFormatJSON && format != outcomeDatasetFormatCSV {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid dataset format", constant.ErrInvalidQueryParameter)
+		return nil, "", pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityValidationOutcome, "format")
+	}
+
+	filter, err := parseOutcomeReportQuery(span, q)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, err := h.service.ExportOutcomeDataset(ctx, filter)
+	if err != nil {
+		return nil, "", classifyValidationOutcomeServiceError(span, err)
+	}
+
+	if rows == nil {
+		rows = []*model.OutcomeDatasetRow{}
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+
+	if format == outcomeDatasetFormatCSV {
+		body, err = encodeOutcomeDatasetCSV(rows)
+		contentType = "text/csv; charset=utf-8"
+	} else {
+		body, err = json.Marshal(&OutcomeDatasetResponse{Rows: rows})
+		contentType = fiber.MIMEApplicationJSON
+	}
+
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to encode outcome dataset", err)
+		return nil, "", pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+	}
+
+	logger.With(
+		libLog.String("operation", "handler.validation_outcome.export_dataset"),
+		libLog.String("dataset.format", format),
+		libLog.Int("dataset.rows", len(rows)),
+	).Log(ctx, libLog.LevelDebug, "Outcome dataset exported")
+
+	return body, contentType, nil
+}
+
+// outcomeDatasetCSVHeader is the column order of the CSV export.
+var outcomeDatasetCSVHeader = []string{
+	"validation_id", "request_id", "transaction_type", "sub_type", "amount", "currency",
+	"transaction_timestamp", "account_id", "decision", "matched_rule_ids", "label", "labeled_at", "created_at",
+}
+
+// encodeOutcomeDatasetCSV renders the dataset as RFC 4180 CSV. Matched rule
+// IDs are joined with ';' to keep one row per validation.
+func encodeOutcomeDatasetCSV(rows []*model.OutcomeDatasetRow) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(outcomeDatasetCSVHeader); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		subType := ""
+		if row.SubType != nil {
+			subType = *row.SubType
+		}
+
+		ruleIDs := make([]string, len(row.MatchedRuleIDs))
+		for i, id := range row.MatchedRuleIDs {
+			ruleIDs[i] = id.String()
+		}
+
+		if err := w.Write([]string{
+			row.ValidationID.String(),
+			row.RequestID.String(),
+			row.TransactionType.String(),
+			subType,
+			row.Amount.String(),
+			row.Currency,
+			row.TransactionTimestamp.UTC().Format(time.RFC3339Nano),
+			row.AccountID.String(),
+			string(row.Decision),
+			strings.Join(ruleIDs, ";"),
+			row.Label.String(),
+			row.LabeledAt.UTC().Format(time.RFC3339Nano),
+			row.CreatedAt.UTC().Format(time.RFC3339Nano),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func outcomeReportQueryFromFiber(c *fiber.Ctx) OutcomeReportQuery {
+	return OutcomeReportQuery{
+		StartDate: c.Query("start_date"),
+		EndDate:   c.Query("end_date"),
+		RuleID:    c.Query("rule_id"),
+		Label:     c.Query("label"),
+		Limit:     c.Query("limit"),
+		Format:    c.Query("format"),
+	}
+}
+
+// parseOutcomeReportQuery converts the raw query into a filter. Range and
+// limit bounds are checked by the query layer (OutcomeReportFilter.Validate);
+// only malformed values are rejected here.
+func parseOutcomeReportQuery(span trace.Span, q OutcomeReportQuery) (*model.OutcomeReportFilter, error) {
+	filter := &model.OutcomeReportFilter{}
+
+	invalid := func(field string, err error) error {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid query parameter", err)
+		return pkg.ValidateBusinessError(constant.ErrInvalidQueryParameter, constant.EntityValidationOutcome, field)
+	}
+
+	if q.StartDate != "" {
+		t, err := time.Parse(time.RFC3339, q.StartDate)
+		if err != nil {
+			return nil, invalid("start_date", err)
+		}
+
+		filter.StartDate = t
+	}
+
+	if q.EndDate != "" {
+		t, err := time.Parse(time.RFC3339, q.EndDate)
+		if err != nil {
+			return nil, invalid("end_date", err)
+		}
+
+		filter.EndDate = t
+	}
+
+	if q.RuleID != "" {
+		id, err := uuid.Parse(q.RuleID)
+		if err != nil {
+			return nil, invalid("rule_id", err)
+		}
+
+		filter.RuleID = &id
+	}
+
+	if q.Label != "" {
+		label := model.OutcomeLabel(strings.ToUpper(q.Label))
+		if !label.IsValid() {
+			return nil, invalid("label", constant.ErrInvalidOutcomeLabel)
+		}
+
+		filter.Label = &label
+	}
+
+	if q.Limit != "" {
+		n, err := strconv.Atoi(q.Limit)
+		if err != nil {
+			return nil, invalid("limit", err)
+		}
+
+		filter.Limit = n
+	}
+
+	return filter, nil
+}
+
+// parseValidationOutcomeID validates the {id} path parameter.
+func parseValidationOutcomeID(span trace.Span, idParam string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction validation ID", err)
+		return uuid.Nil, pkg.ValidateBusinessError(constant.ErrInvalidPathParameter, constant.EntityValidationOutcome, "id")
+	}
+
+	return id, nil
+}
+
+// classifyValidationOutcomeServiceError maps a raw service error to its
+// canonical Midaz error. See classifyLimitServiceError for the pass-through
+// rationale.
+func classifyValidationOutcomeServiceError(span trace.Span, err error) error {
+	if pkg.IsBusinessError(err) {
+		return err
+	}
+
+	if errors.Is(err, constant.ErrTransactionValidationNotFound) {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction validation not found", err)
+		return pkg.ValidateBusinessError(constant.ErrTransactionValidationNotFound, constant.EntityTransactionValidation)
+	}
+
+	for _, sentinel := range []error{
+		constant.ErrValidationOutcomeNotFound,
+		constant.ErrInvalidOutcomeLabel,
+		constant.ErrOutcomeNoteTooLong,
+		constant.ErrInvalidOutcomeReportFilters,
+	} {
+		if errors.Is(err, sentinel) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Validation outcome request rejected", err)
+			return pkg.ValidateBusinessError(sentinel, constant.EntityValidationOutcome)
+		}
+	}
+
+	libOpentelemetry.HandleSpanError(span, "Operation failed", err)
+
+	return pkg.InternalServerError{Code: constant.ErrInternalServer.Error(), Title: "Internal Server Error", Message: "The server encountered an unexpected error. Please try again later or contact support."}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// Huma surface for outcome labels and the labeled-data reports, following the
+// reference pattern in rule_handler_huma.go: raw bodies + SkipValidateBody,
+// doc-only path/query params, and humaProblem for errors.
+
+// LabelValidationOutcomeInputHuma is the Huma request envelope for PUT
+// /v1/validations/{id}/outcome.
+type LabelValidationOutcomeInputHuma struct {
+	ID      string `path:"id" doc:"Transaction Validation ID (UUID)"`
+	RawBody []byte `contentType:"application/json"`
+}
+
+// GetValidationOutcomeInputHuma is the Huma request envelope for GET
+// /v1/validations/{id}/outcome.
+type GetValidationOutcomeInputHuma struct {
+	ID string `path:"id" doc:"Transaction Validation ID (UUID)"`
+}
+
+// ValidationOutcomeOutputHuma is the shared single-label response envelope.
+type ValidationOutcomeOutputHuma struct {
+	Status int
+	Body   *model.ValidationOutcome
+}
+
+// RulePerformanceReportInputHuma is the Huma request envelope for GET
+// /v1/outcomes/rule-performance.
+type RulePerformanceReportInputHuma struct {
+	StartDate string `query:"start_date" doc:"Include validations created from this date (RFC3339, default: 90 days ago)"`
+	EndDate   string `query:"end_date" doc:"Include validations created before this date (RFC3339, default: end of today)"`
+	RuleID    string `query:"rule_id" doc:"Restrict the report to one rule (UUID)"`
+}
+
+// RulePerformanceReportOutputHuma is the Huma response envelope for GET
+// /v1/outcomes/rule-performance.
+type RulePerformanceReportOutputHuma struct {
+	Status int
+	Body   *model.RulePerformanceReport
+}
+
+// ExportOutcomeDatasetInputHuma is the Huma request envelope for GET
+// /v1/outcomes/dataset.
+type ExportOutcomeDatasetInputHuma struct {
+	StartDate string `query:"start_date" doc:"Include validations created from this date (RFC3339, default: 90 days ago)"`
+	EndDate   string `query:"end_date" doc:"Include validations created before this date (RFC3339, default: end of today)"`
+	RuleID    string `query:"rule_id" doc:"Only validations that evaluated this rule (UUID)"`
+	Label     string `query:"label" doc:"Filter by outcome label (CONFIRMED_FRAUD, CHARGEBACK, FALSE_POSITIVE, CUSTOMER_CONFIRMED)"`
+	Limit     string `query:"limit" doc:"Max rows (1-10000, default: 1000)"`
+	Format    string `query:"format" doc:"Output format (json, csv; default: json)"`
+}
+
+// ExportOutcomeDatasetOutputHuma is the Huma response envelope for GET
+// /v1/outcomes/dataset. The body is pre-encoded so the same operation can
+// serve JSON (OutcomeDatasetResponse) or CSV.
+type ExportOutcomeDatasetOutputHuma struct {
+	Status      int
+	ContentType string `header:"Content-Type"`
+	Body        []byte
+}
+
+// LabelValidationOutcomeHuma is the Huma handler for PUT /v1/validations/{id}/outcome.
+func (h *ValidationOutcomeHandler) LabelValidationOutcomeHuma(ctx context.Context, in *LabelValidationOutcomeInputHuma) (*ValidationOutcomeOutputHuma, error) {
+	result, err := h.labelValidationOutcome(ctx, in.ID, in.RawBody)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ValidationOutcomeOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// GetValidationOutcomeHuma is the Huma handler for GET /v1/validations/{id}/outcome.
+func (h *ValidationOutcomeHandler) GetValidationOutcomeHuma(ctx context.Context, in *GetValidationOutcomeInputHuma) (*ValidationOutcomeOutputHuma, error) {
+	result, err := h.getValidationOutcome(ctx, in.ID)
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ValidationOutcomeOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// GetRulePerformanceReportHuma is the Huma handler for GET /v1/outcomes/rule-performance.
+func (h *ValidationOutcomeHandler) GetRulePerformanceReportHuma(ctx context.Context, in *RulePerformanceReportInputHuma) (*RulePerformanceReportOutputHuma, error) {
+	result, err := h.getRulePerformanceReport(ctx, OutcomeReportQuery{
+		StartDate: in.StartDate,
+		EndDate:   in.EndDate,
+		RuleID:    in.RuleID,
+	})
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &RulePerformanceReportOutputHuma{Status: http.StatusOK, Body: result}, nil
+}
+
+// ExportOutcomeDatasetHuma is the Huma handler for GET /v1/outcomes/dataset.
+func (h *ValidationOutcomeHandler) ExportOutcomeDatasetHuma(ctx context.Context, in *ExportOutcomeDatasetInputHuma) (*ExportOutcomeDatasetOutputHuma, error) {
+	body, contentType, err := h.exportOutcomeDataset(ctx, OutcomeReportQuery{
+		StartDate: in.StartDate,
+		EndDate:   in.EndDate,
+		RuleID:    in.RuleID,
+		Label:     in.Label,
+		Limit:     in.Limit,
+		Format:    in.Format,
+	})
+	if err != nil {
+		return nil, humaProblem(err)
+	}
+
+	return &ExportOutcomeDatasetOutputHuma{Status: http.StatusOK, ContentType: contentType, Body: body}, nil
+}
+
+// RegisterValidationOutcomeRoutes registers the outcome label and report
+// operations on the shared Huma API. The auth middleware for these paths is
+// attached in registerTracerHumaRoutes.
+func RegisterValidationOutcomeRoutes(api huma.API, h *ValidationOutcomeHandler) {
+	huma.Register(api, huma.Operation{
+		OperationID:      "labelValidationOutcome",
+		Method:           http.MethodPut,
+		Path:             "/validations/{id}/outcome",
+		Summary:          "Attach or replace the outcome label of a transaction validation",
+		Tags:             []string{"Validation Outcomes"},
+		Security:         secBearerOrAPIKey,
+		SkipValidateBody: true,
+	}, h.LabelValidationOutcomeHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getValidationOutcome",
+		Method:      http.MethodGet,
+		Path:        "/validations/{id}/outcome",
+		Summary:     "Get the outcome label of a transaction validation",
+		Tags:        []string{"Validation Outcomes"},
+		Security:    secBearerOrAPIKey,
+	}, h.GetValidationOutcomeHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getRulePerformanceReport",
+		Method:      http.MethodGet,
+		Path:        "/outcomes/rule-performance",
+		Summary:     "Per-rule precision and recall computed from labeled validations",
+		Tags:        []string{"Validation Outcomes"},
+		Security:    secBearerOrAPIKey,
+	}, h.GetRulePerformanceReportHuma)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "exportOutcomeDataset",
+		Method:      http.MethodGet,
+		Path:        "/outcomes/dataset",
+		Summary:     "Export labeled validations as JSON or CSV",
+		Tags:        []string{"Validation Outcomes"},
+		Security:    secBearerOrAPIKey,
+	}, h.ExportOutcomeDatasetHuma)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package in
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newTestValidationOutcomeApp(service ValidationOutcomeService) *fiber.App {
+	handler := NewValidationOutcomeHandler(service)
+
+	app := fiber.New()
+	app.Put("/validations/:id/outcome", handler.LabelValidationOutcome)
+	app.Get("/validations/:id/outcome", handler.GetValidationOutcome)
+	app.Get("/outcomes/rule-performance", handler.GetRulePerformanceReport)
+	app.Get("/outcomes/dataset", handler.ExportOutcomeDataset)
+
+	return app
+}
+
+func TestValidationOutcomeHandler_Label(t *testing.T) {
+	validationID := testutil.MustDeterministicUUID(1)
+
+	tests := []struct {
+		name           string
+		id             string
+		body           string
+		mockSetup      func(service *MockValidationOutcomeService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "success",
+			id:   validationID.String(),
+			body: `{"label":"chargeback","note":"reason 10.4"}`,
+			mockSetup: func(service *MockValidationOutcomeService) {
+				service.EXPECT().
+					LabelValidationOutcome(gomock.Any(), validationID, &command.LabelValidationOutcomeInput{Label: "chargeback", Note: testutil.StringPtr("reason 10.4")}).
+					Return(&model.ValidationOutcome{ValidationID: validationID, Label: model.OutcomeLabelChargeback}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid id",
+			id:             "not-a-uuid",
+			body:           `{"label":"CHARGEBACK"}`,
+			mockSetup:      func(*MockValidationOutcomeService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidPathParameter.Error(),
+		},
+		{
+			name:           "malformed body",
+			id:             validationID.String(),
+			body:           `{"label":`,
+			mockSetup:      func(*MockValidationOutcomeService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidRequestBody.Error(),
+		},
+		{
+			name: "invalid label",
+			id:   validationID.String(),
+			body: `{"label":"MAYBE"}`,
+			mockSetup: func(service *MockValidationOutcomeService) {
+				service.EXPECT().LabelValidationOutcome(gomock.Any(), validationID, gomock.Any()).Return(nil, constant.ErrInvalidOutcomeLabel)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   constant.ErrInvalidOutcomeLabel.Error(),
+		},
+		{
+			name: "validation not found",
+			id:   validationID.String(),
+			body: `{"label":"CONFIRMED_FRAUD"}`,
+			mockSetup: func(service *MockValidationOutcomeService) {
+				service.EXPECT().LabelValidationOutcome(gomock.Any(), validationID, gomock.Any()).Return(nil, constant.ErrTransactionValidationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   constant.ErrTransactionValidationNotFound.Error(),
+		},
+		{
+			name: "unexpected error",
+			id:   validationID.String(),
+			body: `{"label":"CONFIRMED_FRAUD"}`,
+			mockSetup: func(service *MockValidationOutcomeService) {
+				service.EXPECT().LabelValidationOutcome(gomock.Any(), validationID, gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   constant.ErrInternalServer.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockValidationOutcomeService(ctrl)
+			tt.mockSetup(service)
+
+			req := httptest.NewRequest(http.MethodPut, "/validations/"+tt.id+"/outcome", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := newTestValidationOutcomeApp(service).Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, decodeErrorCode(t, resp.Body))
+			}
+		})
+	}
+}
+
+func TestValidationOutcomeHandler_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockValidationOutcomeService(ctrl)
+	app := newTestValidationOutcomeApp(service)
+
+	validationID := testutil.MustDeterministicUUID(2)
+
+	service.EXPECT().GetValidationOutcome(gomock.Any(), validationID).Return(nil, constant.ErrValidationOutcomeNotFound)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/validations/"+validationID.String()+"/outcome", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, constant.ErrValidationOutcomeNotFound.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestValidationOutcomeHandler_RulePerformance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockValidationOutcomeService(ctrl)
+	app := newTestValidationOutcomeApp(service)
+
+	ruleID := testutil.MustDeterministicUUID(3)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	service.EXPECT().
+		GetRulePerformanceReport(gomock.Any(), &model.OutcomeReportFilter{StartDate: start, EndDate: end, RuleID: &ruleID}).
+		Return(&model.RulePerformanceReport{
+			StartDate: start,
+			EndDate:   end,
+			Rules:     []model.RulePerformance{model.NewRulePerformance(model.RuleOutcomeCounts{RuleID: ruleID, TruePositives: 3, FalsePositives: 1})},
+		}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet,
+		"/outcomes/rule-performance?start_date=2026-01-01T00:00:00Z&end_date=2026-02-01T00:00:00Z&rule_id="+ruleID.String(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var report model.RulePerformanceReport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	require.Len(t, report.Rules, 1)
+	require.NotNil(t, report.Rules[0].Precision)
+	assert.InDelta(t, 0.75, *report.Rules[0].Precision, 1e-9)
+	require.NotNil(t, report.Rules[0].Recall)
+	assert.InDelta(t, 1.0, *report.Rules[0].Recall, 1e-9)
+	resp.Body.Close()
+
+	service.EXPECT().GetRulePerformanceReport(gomock.Any(), gomock.Any()).Return(nil, constant.ErrInvalidOutcomeReportFilters)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/outcomes/rule-performance?start_date=2026-01-01T00:00:00Z", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidOutcomeReportFilters.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/outcomes/rule-performance?rule_id=nope", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidQueryParameter.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
+
+func TestValidationOutcomeHandler_ExportDataset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockValidationOutcomeService(ctrl)
+	app := newTestValidationOutcomeApp(service)
+
+	ts := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	row := &model.OutcomeDatasetRow{
+		ValidationID:         testutil.MustDeterministicUUID(4),
+		RequestID:            testutil.MustDeterministicUUID(5),
+		TransactionType:      model.TransactionType("CARD"),
+		Amount:               decimal.RequireFromString("150.25"),
+		Currency:             "BRL",
+		TransactionTimestamp: ts,
+		AccountID:            testutil.MustDeterministicUUID(6),
+		Decision:             model.DecisionDeny,
+		MatchedRuleIDs:       []uuid.UUID{testutil.MustDeterministicUUID(7), testutil.MustDeterministicUUID(8)},
+		Label:                model.OutcomeLabelConfirmedFraud,
+		LabeledAt:            ts,
+		CreatedAt:            ts,
+	}
+
+	fraud := model.OutcomeLabelConfirmedFraud
+
+	service.EXPECT().
+		ExportOutcomeDataset(gomock.Any(), &model.OutcomeReportFilter{Label: &fraud, Limit: 50}).
+		Return([]*model.OutcomeDatasetRow{row}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/outcomes/dataset?format=csv&label=confirmed_fraud&limit=50", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/csv")
+
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, outcomeDatasetCSVHeader, records[0])
+	assert.Equal(t, "150.25", records[1][4])
+	assert.Equal(t, row.MatchedRuleIDs[0].String()+";"+row.MatchedRuleIDs[1].String(), records[1][9])
+	assert.Equal(t, "CONFIRMED_FRAUD", records[1][10])
+	resp.Body.Close()
+
+	service.EXPECT().ExportOutcomeDataset(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/outcomes/dataset", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body OutcomeDatasetResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.NotNil(t, body.Rows)
+	assert.Empty(t, body.Rows)
+	resp.Body.Close()
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/outcomes/dataset?format=parquet", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, constant.ErrInvalidQueryParameter.Error(), decodeErrorCode(t, resp.Body))
+	resp.Body.Close()
+}
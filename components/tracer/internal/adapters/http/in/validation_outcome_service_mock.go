@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validation_outcome_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=validation_outcome_handler.go -destination=validation_outcome_service_mock.go -package=in
+//
+
+// Package in is a generated GoMock package.
+package in
+
+import (
+	context "context"
+	reflect "reflect"
+
+	command "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidationOutcomeService is a mock of ValidationOutcomeService interface.
+type MockValidationOutcomeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidationOutcomeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockValidationOutcomeServiceMockRecorder is the mock recorder for MockValidationOutcomeService.
+type MockValidationOutcomeServiceMockRecorder struct {
+	mock *MockValidationOutcomeService
+}
+
+// NewMockValidationOutcomeService creates a new mock instance.
+func NewMockValidationOutcomeService(ctrl *gomock.Controller) *MockValidationOutcomeService {
+	mock := &MockValidationOutcomeService{ctrl: ctrl}
+	mock.recorder = &MockValidationOutcomeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidationOutcomeService) EXPECT() *MockValidationOutcomeServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportOutcomeDataset mocks base method.
+func (m *MockValidationOutcomeService) ExportOutcomeDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOutcomeDataset", ctx, filter)
+	ret0, _ := ret[0].([]*model.OutcomeDatasetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOutcomeDataset indicates an expected call of ExportOutcomeDataset.
+func (mr *MockValidationOutcomeServiceMockRecorder) ExportOutcomeDataset(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOutcomeDataset", reflect.TypeOf((*MockValidationOutcomeService)(nil).ExportOutcomeDataset), ctx, filter)
+}
+
+// GetRulePerformanceReport mocks base method.
+func (m *MockValidationOutcomeService) GetRulePerformanceReport(ctx context.Context, filter *model.OutcomeReportFilter) (*model.RulePerformanceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRulePerformanceReport", ctx, filter)
+	ret0, _ := ret[0].(*model.RulePerformanceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRulePerformanceReport indicates an expected call of GetRulePerformanceReport.
+func (mr *MockValidationOutcomeServiceMockRecorder) GetRulePerformanceReport(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulePerformanceReport", reflect.TypeOf((*MockValidationOutcomeService)(nil).GetRulePerformanceReport), ctx, filter)
+}
+
+// GetValidationOutcome mocks base method.
+func (m *MockValidationOutcomeService) GetValidationOutcome(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationOutcome", ctx, validationID)
+	ret0, _ := ret[0].(*model.ValidationOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationOutcome indicates an expected call of GetValidationOutcome.
+func (mr *MockValidationOutcomeServiceMockRecorder) GetValidationOutcome(ctx, validationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationOutcome", reflect.TypeOf((*MockValidationOutcomeService)(nil).GetValidationOutcome), ctx, validationID)
+}
+
+// LabelValidationOutcome mocks base method.
+func (m *MockValidationOutcomeService) LabelValidationOutcome(ctx context.Context, validationID uuid.UUID, input *command.LabelValidationOutcomeInput) (*model.ValidationOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LabelValidationOutcome", ctx, validationID, input)
+	ret0, _ := ret[0].(*model.ValidationOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LabelValidationOutcome indicates an expected call of LabelValidationOutcome.
+func (mr *MockValidationOutcomeServiceMockRecorder) LabelValidationOutcome(ctx, validationID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LabelValidationOutcome", reflect.TypeOf((*MockValidationOutcomeService)(nil).LabelValidationOutcome), ctx, validationID, input)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// AlertPolicyPostgreSQLModel is the database representation of an AlertPolicy.
+// This model handles:
+// - sql.NullString for the nullable description and rule_id columns
+// - JSON serialization for the optional scope
+type AlertPolicyPostgreSQLModel struct {
+	ID             string         `db:"id"`
+	Name           string         `db:"name"`
+	Description    sql.NullString `db:"description"`
+	RuleID         sql.NullString `db:"rule_id"`
+	Scope          *string        `db:"scope"`
+	Decision       string         `db:"decision"`
+	Threshold      float64        `db:"threshold"`
+	WindowSeconds  int            `db:"window_seconds"`
+	MinSamples     int            `db:"min_samples"`
+	AutoDeactivate bool           `db:"auto_deactivate"`
+	Status         string         `db:"status"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+// ToEntity converts the database model to a domain entity.
+// Returns an error if the stored identifiers or scope JSON are corrupted.
+func (m *AlertPolicyPostgreSQLModel) ToEntity() (*model.AlertPolicy, error) {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse id: %w", err)
+	}
+
+	policy := &model.AlertPolicy{
+		ID:             id,
+		Name:           m.Name,
+		Decision:       model.Decision(m.Decision),
+		Threshold:      m.Threshold,
+		WindowSeconds:  m.WindowSeconds,
+		MinSamples:     m.MinSamples,
+		AutoDeactivate: m.AutoDeactivate,
+		Status:         model.AlertPolicyStatus(m.Status),
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+
+	if m.Description.Valid {
+		description := m.Description.String
+		policy.Description = &description
+	}
+
+	if m.RuleID.Valid {
+		ruleID, err := uuid.Parse(m.RuleID.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rule_id: %w", err)
+		}
+
+		policy.RuleID = &ruleID
+	}
+
+	if m.Scope != nil && *m.Scope != "" {
+		var scope model.Scope
+		if err := json.Unmarshal([]byte(*m.Scope), &scope); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scope: %w", err)
+		}
+
+		policy.Scope = &scope
+	}
+
+	return policy, nil
+}
+
+// FromEntity converts a domain entity to a database model.
+// Returns an error if JSON marshaling fails.
+func (m *AlertPolicyPostgreSQLModel) FromEntity(entity *model.AlertPolicy) error {
+	if entity == nil {
+		return fmt.Errorf("alert policy entity cannot be nil")
+	}
+
+	m.ID = entity.ID.String()
+	m.Name = entity.Name
+	m.Decision = entity.Decision.String()
+	m.Threshold = entity.Threshold
+	m.WindowSeconds = entity.WindowSeconds
+	m.MinSamples = entity.MinSamples
+	m.AutoDeactivate = entity.AutoDeactivate
+	m.Status = entity.Status.String()
+	m.CreatedAt = entity.CreatedAt
+	m.UpdatedAt = entity.UpdatedAt
+
+	if entity.Description != nil {
+		m.Description = sql.NullString{String: *entity.Description, Valid: true}
+	} else {
+		m.Description = sql.NullString{Valid: false}
+	}
+
+	if entity.RuleID != nil {
+		m.RuleID = sql.NullString{String: entity.RuleID.String(), Valid: true}
+	} else {
+		m.RuleID = sql.NullString{Valid: false}
+	}
+
+	m.Scope = nil
+
+	if entity.Scope != nil {
+		scopeJSON, err := json.Marshal(entity.Scope)
+		if err != nil {
+			return fmt.Errorf("failed to marshal scope: %w", err)
+		}
+
+		scope := string(scopeJSON)
+		m.Scope = &scope
+	}
+
+	return nil
+}
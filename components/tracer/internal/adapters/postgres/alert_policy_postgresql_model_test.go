@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+func TestAlertPolicyPostgreSQLModel_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := testutil.FixedTime()
+	ruleID := uuid.New()
+	txType := model.TransactionTypeCard
+
+	entity := &model.AlertPolicy{
+		ID:             uuid.New(),
+		Name:           "Card deny spike",
+		Description:    testutil.StringPtr("Denials above 40%"),
+		RuleID:         &ruleID,
+		Scope:          &model.Scope{TransactionType: &txType},
+		Decision:       model.DecisionDeny,
+		Threshold:      0.4,
+		WindowSeconds:  300,
+		MinSamples:     20,
+		AutoDeactivate: true,
+		Status:         model.AlertPolicyStatusActive,
+		CreatedAt:      fixedTime,
+		UpdatedAt:      fixedTime,
+	}
+
+	var dbModel AlertPolicyPostgreSQLModel
+	require.NoError(t, dbModel.FromEntity(entity))
+
+	assert.Equal(t, sql.NullString{String: ruleID.String(), Valid: true}, dbModel.RuleID)
+	require.NotNil(t, dbModel.Scope)
+	assert.JSONEq(t, `{"transactionType":"CARD"}`, *dbModel.Scope)
+	assert.Equal(t, "DENY", dbModel.Decision)
+
+	got, err := dbModel.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, entity, got)
+}
+
+func TestAlertPolicyPostgreSQLModel_RoundTrip_OptionalFieldsUnset(t *testing.T) {
+	t.Parallel()
+
+	entity := &model.AlertPolicy{
+		ID:            uuid.New(),
+		Name:          "Review volume",
+		Decision:      model.DecisionReview,
+		Threshold:     0.2,
+		WindowSeconds: 60,
+		MinSamples:    1,
+		Status:        model.AlertPolicyStatusInactive,
+	}
+
+	var dbModel AlertPolicyPostgreSQLModel
+	require.NoError(t, dbModel.FromEntity(entity))
+
+	assert.False(t, dbModel.Description.Valid)
+	assert.False(t, dbModel.RuleID.Valid)
+	assert.Nil(t, dbModel.Scope)
+
+	got, err := dbModel.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, entity, got)
+}
+
+func TestAlertPolicyPostgreSQLModel_FromEntity_Nil(t *testing.T) {
+	t.Parallel()
+
+	var dbModel AlertPolicyPostgreSQLModel
+	require.Error(t, dbModel.FromEntity(nil))
+}
+
+func TestAlertPolicyPostgreSQLModel_ToEntity_Corrupted(t *testing.T) {
+	t.Parallel()
+
+	badScope := "{not json"
+
+	tests := []struct {
+		name    string
+		dbModel AlertPolicyPostgreSQLModel
+	}{
+		{name: "invalid id", dbModel: AlertPolicyPostgreSQLModel{ID: "nope"}},
+		{name: "invalid rule_id", dbModel: AlertPolicyPostgreSQLModel{ID: uuid.NewString(), RuleID: sql.NullString{String: "nope", Valid: true}}},
+		{name: "invalid scope JSON", dbModel: AlertPolicyPostgreSQLModel{ID: uuid.NewString(), Scope: &badScope}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.dbModel.ToEntity()
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOtel "github.com/LerianStudio/lib-observability/tracing"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// alertPoliciesTable is the PostgreSQL table name for alert policies.
+// Using a constant prevents SQL injection via table name interpolation.
+const alertPoliciesTable = "alert_policies"
+
+// alertPolicyColumns is the column list shared by every alert policy SELECT so
+// scanAlertPolicy stays aligned with the query shape.
+var alertPolicyColumns = []string{
+	"id", "name", "description", "rule_id", "scope", "decision", "threshold",
+	"window_seconds", "min_samples", "auto_deactivate", "status", "created_at", "updated_at",
+}
+
+// AlertPolicyRepository implements alert policy persistence.
+//
+// Tenant resolution lives in the underlying pgdb.Connection, exactly as for the
+// rule and limit repositories: each tenant database carries its own policies.
+type AlertPolicyRepository struct {
+	conn pgdb.Connection
+}
+
+// NewAlertPolicyRepositoryWithConnection creates a new PostgreSQL alert policy
+// repository with a custom pgdb.Connection.
+func NewAlertPolicyRepositoryWithConnection(conn pgdb.Connection) *AlertPolicyRepository {
+	return &AlertPolicyRepository{
+		conn: conn,
+	}
+}
+
+// CreateWithTx inserts a new alert policy using the provided database handle.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *AlertPolicyRepository) CreateWithTx(ctx context.Context, db pgdb.DB, policy *model.AlertPolicy) error {
+	if db == nil {
+		return pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.alert_policy.create")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var dbModel AlertPolicyPostgreSQLModel
+	if err := dbModel.FromEntity(policy); err != nil {
+		return fmt.Errorf("failed to convert entity to database model: %w", err)
+	}
+
+	query := sq.Insert(alertPoliciesTable).
+		Columns(alertPolicyColumns...).
+		Values(
+			dbModel.ID, dbModel.Name, dbModel.Description, dbModel.RuleID, dbModel.Scope, dbModel.Decision,
+			dbModel.Threshold, dbModel.WindowSeconds, dbModel.MinSamples, dbModel.AutoDeactivate,
+			dbModel.Status, dbModel.CreatedAt, dbModel.UpdatedAt,
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.alert_policy.create"),
+		libLog.String("alert_policy.id", dbModel.ID),
+	).Log(ctx, libLog.LevelDebug, "Creating alert policy")
+
+	if _, err := db.ExecContext(ctx, sqlStr, args...); err != nil {
+		libOtel.HandleSpanError(span, "Failed to insert alert policy", err)
+		return fmt.Errorf("failed to insert alert policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an alert policy by ID, regardless of status.
+// Returns constant.ErrAlertPolicyNotFound when no policy exists.
+func (r *AlertPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.alert_policy.get_by_id")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	query := sq.Select(alertPolicyColumns...).
+		From(alertPoliciesTable).
+		Where(sq.Eq{"id": id.String()}).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.alert_policy.get_by_id"),
+		libLog.String("alert_policy.id", id.String()),
+	).Log(ctx, libLog.LevelDebug, "Getting alert policy by ID")
+
+	policy, err := scanAlertPolicy(db.QueryRowContext(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			libOtel.HandleSpanBusinessErrorEvent(span, "Alert policy not found", constant.ErrAlertPolicyNotFound)
+			return nil, constant.ErrAlertPolicyNotFound
+		}
+
+		libOtel.HandleSpanError(span, "Failed to get alert policy", err)
+
+		return nil, fmt.Errorf("failed to get alert policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// List returns alert policies ordered by creation time, optionally filtered by
+// status and rule. Policies are few per tenant, so the full result set is
+// returned.
+func (r *AlertPolicyRepository) List(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.alert_policy.list")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	query := sq.Select(alertPolicyColumns...).
+		From(alertPoliciesTable).
+		OrderBy("created_at ASC", "id ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if filter != nil {
+		if filter.Status != nil {
+			query = query.Where(sq.Eq{"status": filter.Status.String()})
+		}
+
+		if filter.RuleID != nil {
+			query = query.Where(sq.Eq{"rule_id": filter.RuleID.String()})
+		}
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to list alert policies", err)
+		return nil, fmt.Errorf("failed to list alert policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]*model.AlertPolicy, 0)
+
+	for rows.Next() {
+		policy, err := scanAlertPolicy(rows)
+		if err != nil {
+			libOtel.HandleSpanError(span, "Failed to scan alert policy", err)
+			return nil, fmt.Errorf("failed to scan alert policy: %w", err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		libOtel.HandleSpanError(span, "Error iterating alert policies", err)
+		return nil, fmt.Errorf("error iterating alert policies: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.alert_policy.list"),
+		libLog.Int("list.count", len(policies)),
+	).Log(ctx, libLog.LevelDebug, "Listed alert policies")
+
+	return policies, nil
+}
+
+// UpdateWithTx persists the mutable policy fields using the provided database
+// handle. rule_id and created_at are immutable and never written.
+// Returns constant.ErrAlertPolicyNotFound when no row matched.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *AlertPolicyRepository) UpdateWithTx(ctx context.Context, db pgdb.DB, policy *model.AlertPolicy) error {
+	if db == nil {
+		return pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.alert_policy.update")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var dbModel AlertPolicyPostgreSQLModel
+	if err := dbModel.FromEntity(policy); err != nil {
+		return fmt.Errorf("failed to convert entity to database model: %w", err)
+	}
+
+	query := sq.Update(alertPoliciesTable).
+		Set("name", dbModel.Name).
+		Set("description", dbModel.Description).
+		Set("scope", dbModel.Scope).
+		Set("decision", dbModel.Decision).
+		Set("threshold", dbModel.Threshold).
+		Set("window_seconds", dbModel.WindowSeconds).
+		Set("min_samples", dbModel.MinSamples).
+		Set("auto_deactivate", dbModel.AutoDeactivate).
+		Set("status", dbModel.Status).
+		Set("updated_at", dbModel.UpdatedAt).
+		Where(sq.Eq{"id": dbModel.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.alert_policy.update"),
+		libLog.String("alert_policy.id", dbModel.ID),
+	).Log(ctx, libLog.LevelDebug, "Updating alert policy")
+
+	result, err := db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to update alert policy", err)
+		return fmt.Errorf("failed to update alert policy: %w", err)
+	}
+
+	return alertPolicyRowsAffected(span, result)
+}
+
+// DeleteWithTx removes an alert policy using the provided database handle.
+// Policies are configuration, not compliance records, so the row is deleted
+// rather than soft-deleted; deactivate a policy to keep it around.
+// Returns constant.ErrAlertPolicyNotFound when no row matched.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *AlertPolicyRepository) DeleteWithTx(ctx context.Context, db pgdb.DB, id uuid.UUID) error {
+	if db == nil {
+		return pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.alert_policy.delete")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	query := sq.Delete(alertPoliciesTable).
+		Where(sq.Eq{"id": id.String()}).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.alert_policy.delete"),
+		libLog.String("alert_policy.id", id.String()),
+	).Log(ctx, libLog.LevelDebug, "Deleting alert policy")
+
+	result, err := db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to delete alert policy", err)
+		return fmt.Errorf("failed to delete alert policy: %w", err)
+	}
+
+	return alertPolicyRowsAffected(span, result)
+}
+
+// alertPolicyRowsAffected maps a zero-row write to constant.ErrAlertPolicyNotFound.
+func alertPolicyRowsAffected(span trace.Span, result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get rows affected", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		libOtel.HandleSpanBusinessErrorEvent(span, "Alert policy not found", constant.ErrAlertPolicyNotFound)
+		return constant.ErrAlertPolicyNotFound
+	}
+
+	return nil
+}
+
+// alertPolicyScanner is satisfied by both *sql.Row and *sql.Rows.
+type alertPolicyScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanAlertPolicy scans an alert policy row into a domain entity via ToEntity.
+func scanAlertPolicy(row alertPolicyScanner) (*model.AlertPolicy, error) {
+	var (
+		dbModel   AlertPolicyPostgreSQLModel
+		scopeJSON []byte
+	)
+
+	if err := row.Scan(
+		&dbModel.ID,
+		&dbModel.Name,
+		&dbModel.Description,
+		&dbModel.RuleID,
+		&scopeJSON,
+		&dbModel.Decision,
+		&dbModel.Threshold,
+		&dbModel.WindowSeconds,
+		&dbModel.MinSamples,
+		&dbModel.AutoDeactivate,
+		&dbModel.Status,
+		&dbModel.CreatedAt,
+		&dbModel.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if scopeJSON != nil {
+		scope := string(scopeJSON)
+		dbModel.Scope = &scope
+	}
+
+	policy, err := dbModel.ToEntity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert to entity: %w", err)
+	}
+
+	return policy, nil
+}
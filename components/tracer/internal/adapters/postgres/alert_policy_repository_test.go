@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func setupAlertPolicyRepositoryMockDB(t *testing.T) (*AlertPolicyRepository, *sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	mockConn := mocks.NewMockConnection(ctrl)
+	mockConn.EXPECT().GetDB(gomock.Any()).Return(db, nil).AnyTimes()
+
+	return NewAlertPolicyRepositoryWithConnection(mockConn), db, sqlMock
+}
+
+func newTestAlertPolicy(t *testing.T) *model.AlertPolicy {
+	t.Helper()
+
+	ruleID := testutil.MustDeterministicUUID(1)
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:           "Card deny spike",
+		RuleID:         &ruleID,
+		Decision:       model.DecisionDeny,
+		Threshold:      0.4,
+		WindowSeconds:  300,
+		AutoDeactivate: true,
+	}, testutil.FixedTime())
+	require.NoError(t, err)
+
+	return policy
+}
+
+func TestAlertPolicyRepository_CreateWithTx(t *testing.T) {
+	repo, db, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	policy := newTestAlertPolicy(t)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta("INSERT INTO alert_policies")).
+		WithArgs(policy.ID.String(), "Card deny spike", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			"DENY", 0.4, 300, model.DefaultAlertMinSamples, true, "ACTIVE", policy.CreatedAt, policy.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.CreateWithTx(context.Background(), db, policy))
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+
+	require.ErrorIs(t, repo.CreateWithTx(context.Background(), nil, policy), pgdb.ErrNilConnection)
+}
+
+func TestAlertPolicyRepository_GetByID(t *testing.T) {
+	repo, _, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	policy := newTestAlertPolicy(t)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("FROM alert_policies WHERE id = $1")).
+		WithArgs(policy.ID.String()).
+		WillReturnRows(sqlmock.NewRows(alertPolicyColumns).AddRow(
+			policy.ID.String(), policy.Name, nil, policy.RuleID.String(), []byte(`{"country":"BR"}`),
+			"DENY", 0.4, 300, 20, true, "ACTIVE", policy.CreatedAt, policy.UpdatedAt,
+		))
+
+	got, err := repo.GetByID(context.Background(), policy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, policy.ID, got.ID)
+	assert.Equal(t, *policy.RuleID, *got.RuleID)
+	require.NotNil(t, got.Scope)
+	assert.Equal(t, "BR", *got.Scope.Country)
+	assert.True(t, got.AutoDeactivate)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestAlertPolicyRepository_GetByID_NotFound(t *testing.T) {
+	repo, _, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	id := testutil.MustDeterministicUUID(2)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("FROM alert_policies WHERE id = $1")).
+		WithArgs(id.String()).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetByID(context.Background(), id)
+	require.ErrorIs(t, err, constant.ErrAlertPolicyNotFound)
+}
+
+func TestAlertPolicyRepository_List(t *testing.T) {
+	repo, _, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	policy := newTestAlertPolicy(t)
+	status := model.AlertPolicyStatusActive
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("FROM alert_policies WHERE status = $1 AND rule_id = $2 ORDER BY created_at ASC, id ASC")).
+		WithArgs("ACTIVE", policy.RuleID.String()).
+		WillReturnRows(sqlmock.NewRows(alertPolicyColumns).AddRow(
+			policy.ID.String(), policy.Name, "desc", policy.RuleID.String(), nil,
+			"DENY", 0.4, 300, 20, true, "ACTIVE", policy.CreatedAt, policy.UpdatedAt,
+		))
+
+	policies, err := repo.List(context.Background(), &model.ListAlertPoliciesFilter{Status: &status, RuleID: policy.RuleID})
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Nil(t, policies[0].Scope)
+	require.NotNil(t, policies[0].Description)
+	assert.Equal(t, "desc", *policies[0].Description)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestAlertPolicyRepository_UpdateWithTx_NotFound(t *testing.T) {
+	repo, db, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	policy := newTestAlertPolicy(t)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta("UPDATE alert_policies SET name = $1")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.UpdateWithTx(context.Background(), db, policy)
+	require.ErrorIs(t, err, constant.ErrAlertPolicyNotFound)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestAlertPolicyRepository_DeleteWithTx(t *testing.T) {
+	repo, db, sqlMock := setupAlertPolicyRepositoryMockDB(t)
+
+	id := testutil.MustDeterministicUUID(3)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM alert_policies WHERE id = $1")).
+		WithArgs(id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM alert_policies WHERE id = $1")).
+		WithArgs(id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, repo.DeleteWithTx(context.Background(), db, id))
+	require.ErrorIs(t, repo.DeleteWithTx(context.Background(), db, id), constant.ErrAlertPolicyNotFound)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
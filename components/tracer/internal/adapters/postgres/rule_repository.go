@@ -932,6 +932,16 @@ func buildSingleScopeCondition(scope model.Scope) (string, []any) {
 		args = append(args, strings.TrimSpace(*scope.SubType))
 	}
 
+	if scope.Country != nil {
+		conditions = append(conditions, "(scope->>'country' IS NULL OR UPPER(scope->>'country') = UPPER(?))")
+		args = append(args, strings.TrimSpace(*scope.Country))
+	}
+
+	if scope.CounterpartyBankCode != nil {
+		conditions = append(conditions, "(scope->>'counterpartyBankCode' IS NULL OR scope->>'counterpartyBankCode' = ?)")
+		args = append(args, *scope.CounterpartyBankCode)
+	}
+
 	if len(conditions) == 0 {
 		// Empty filter scope matches everything
 		return "1=1", nil
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// TransactionTypePostgreSQLModel is the database representation of a
+// TransactionTypeDefinition catalog entry.
+// This model handles:
+// - sql.NullString for the nullable description column
+// - JSON serialization for the sub_types array
+type TransactionTypePostgreSQLModel struct {
+	Code        string         `db:"code"`
+	Description sql.NullString `db:"description"`
+	SubTypes    string         `db:"sub_types"`
+	Builtin     bool           `db:"builtin"`
+	Status      string         `db:"status"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+// ToEntity converts the database model to a domain entity.
+// Returns an error if JSON unmarshaling fails (e.g., corrupted data in database).
+func (m *TransactionTypePostgreSQLModel) ToEntity() (*model.TransactionTypeDefinition, error) {
+	var description *string
+	if m.Description.Valid {
+		description = &m.Description.String
+	}
+
+	var subTypes []string
+	if m.SubTypes != "" {
+		if err := json.Unmarshal([]byte(m.SubTypes), &subTypes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal sub_types: %w", err)
+		}
+	}
+
+	// Ensure subTypes is never nil (return empty slice instead of null in JSON)
+	if subTypes == nil {
+		subTypes = []string{}
+	}
+
+	return &model.TransactionTypeDefinition{
+		Code:        model.TransactionType(m.Code),
+		Description: description,
+		SubTypes:    subTypes,
+		Builtin:     m.Builtin,
+		Status:      model.TransactionTypeStatus(m.Status),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}, nil
+}
+
+// FromEntity converts a domain entity to a database model.
+// Returns an error if JSON marshaling fails.
+func (m *TransactionTypePostgreSQLModel) FromEntity(entity *model.TransactionTypeDefinition) error {
+	if entity == nil {
+		return fmt.Errorf("transaction type entity cannot be nil")
+	}
+
+	m.Code = entity.Code.String()
+	m.Builtin = entity.Builtin
+	m.Status = entity.Status.String()
+	m.CreatedAt = entity.CreatedAt
+	m.UpdatedAt = entity.UpdatedAt
+
+	if entity.Description != nil {
+		m.Description = sql.NullString{String: *entity.Description, Valid: true}
+	} else {
+		m.Description = sql.NullString{Valid: false}
+	}
+
+	subTypes := entity.SubTypes
+	if subTypes == nil {
+		subTypes = []string{}
+	}
+
+	subTypesJSON, err := json.Marshal(subTypes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sub_types: %w", err)
+	}
+
+	m.SubTypes = string(subTypesJSON)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+func TestTransactionTypePostgreSQLModel_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := testutil.FixedTime()
+
+	entity := &model.TransactionTypeDefinition{
+		Code:        "BOLETO",
+		Description: testutil.StringPtr("Bank slip"),
+		SubTypes:    []string{"registered", "unregistered"},
+		Status:      model.TransactionTypeStatusActive,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	var dbModel TransactionTypePostgreSQLModel
+	require.NoError(t, dbModel.FromEntity(entity))
+
+	assert.Equal(t, "BOLETO", dbModel.Code)
+	assert.Equal(t, sql.NullString{String: "Bank slip", Valid: true}, dbModel.Description)
+	assert.JSONEq(t, `["registered","unregistered"]`, dbModel.SubTypes)
+	assert.False(t, dbModel.Builtin)
+	assert.Equal(t, "ACTIVE", dbModel.Status)
+
+	got, err := dbModel.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, entity, got)
+}
+
+func TestTransactionTypePostgreSQLModel_FromEntity_NilSubTypes(t *testing.T) {
+	t.Parallel()
+
+	var dbModel TransactionTypePostgreSQLModel
+	require.NoError(t, dbModel.FromEntity(&model.TransactionTypeDefinition{
+		Code:    model.TransactionTypeCard,
+		Builtin: true,
+		Status:  model.TransactionTypeStatusActive,
+	}))
+
+	assert.Equal(t, "[]", dbModel.SubTypes)
+	assert.False(t, dbModel.Description.Valid)
+	assert.True(t, dbModel.Builtin)
+}
+
+func TestTransactionTypePostgreSQLModel_FromEntity_Nil(t *testing.T) {
+	t.Parallel()
+
+	var dbModel TransactionTypePostgreSQLModel
+	require.Error(t, dbModel.FromEntity(nil))
+}
+
+func TestTransactionTypePostgreSQLModel_ToEntity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty sub_types yields empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		dbModel := TransactionTypePostgreSQLModel{Code: "PIX", SubTypes: "", Status: "INACTIVE"}
+
+		got, err := dbModel.ToEntity()
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, got.SubTypes)
+		assert.Nil(t, got.Description)
+		assert.Equal(t, model.TransactionTypeStatusInactive, got.Status)
+	})
+
+	t.Run("corrupted sub_types returns error", func(t *testing.T) {
+		t.Parallel()
+
+		dbModel := TransactionTypePostgreSQLModel{Code: "PIX", SubTypes: "{not-json"}
+
+		_, err := dbModel.ToEntity()
+		require.Error(t, err)
+	})
+}
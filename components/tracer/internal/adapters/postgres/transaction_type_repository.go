@@ -0,0 +1,302 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOtel "github.com/LerianStudio/lib-observability/tracing"
+	sq "github.com/Masterminds/squirrel"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// transactionTypesTable is the PostgreSQL table name for the transaction-type catalog.
+// Using a constant prevents SQL injection via table name interpolation.
+const transactionTypesTable = "transaction_types"
+
+// transactionTypesPKey is the primary-key constraint on transaction_types.code.
+const transactionTypesPKey = "transaction_types_pkey"
+
+// transactionTypeColumns is the column list shared by every catalog SELECT so
+// scanTransactionType stays aligned with the query shape.
+var transactionTypeColumns = []string{"code", "description", "sub_types", "builtin", "status", "created_at", "updated_at"}
+
+// TransactionTypeRepository implements the transaction-type catalog persistence.
+//
+// Tenant resolution lives in the underlying pgdb.Connection, exactly as for the
+// rule and limit repositories: each tenant database carries its own catalog.
+type TransactionTypeRepository struct {
+	conn pgdb.Connection
+}
+
+// NewTransactionTypeRepositoryWithConnection creates a new PostgreSQL
+// transaction-type repository with a custom pgdb.Connection.
+func NewTransactionTypeRepositoryWithConnection(conn pgdb.Connection) *TransactionTypeRepository {
+	return &TransactionTypeRepository{
+		conn: conn,
+	}
+}
+
+// CreateWithTx inserts a new catalog entry using the provided database handle.
+// Returns constant.ErrTransactionTypeAlreadyExists when the code is taken.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *TransactionTypeRepository) CreateWithTx(ctx context.Context, db pgdb.DB, def *model.TransactionTypeDefinition) error {
+	if db == nil {
+		return pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.transaction_type.create")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var dbModel TransactionTypePostgreSQLModel
+	if err := dbModel.FromEntity(def); err != nil {
+		return fmt.Errorf("failed to convert entity to database model: %w", err)
+	}
+
+	query := sq.Insert(transactionTypesTable).
+		Columns(transactionTypeColumns...).
+		Values(dbModel.Code, dbModel.Description, dbModel.SubTypes, dbModel.Builtin, dbModel.Status, dbModel.CreatedAt, dbModel.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.transaction_type.create"),
+		libLog.String("transaction_type.code", dbModel.Code),
+	).Log(ctx, libLog.LevelDebug, "Creating transaction type")
+
+	if _, err := db.ExecContext(ctx, sqlStr, args...); err != nil {
+		if IsUniqueViolationOf(err, transactionTypesPKey) {
+			libOtel.HandleSpanBusinessErrorEvent(span, "Transaction type already exists", constant.ErrTransactionTypeAlreadyExists)
+			return constant.ErrTransactionTypeAlreadyExists
+		}
+
+		libOtel.HandleSpanError(span, "Failed to insert transaction type", err)
+
+		return fmt.Errorf("failed to insert transaction type: %w", err)
+	}
+
+	return nil
+}
+
+// GetByCode retrieves a catalog entry by its code, regardless of status.
+// Returns constant.ErrTransactionTypeNotFound when no entry exists.
+func (r *TransactionTypeRepository) GetByCode(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.transaction_type.get_by_code")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	query := sq.Select(transactionTypeColumns...).
+		From(transactionTypesTable).
+		Where(sq.Eq{"code": code.String()}).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.transaction_type.get_by_code"),
+		libLog.String("transaction_type.code", code.String()),
+	).Log(ctx, libLog.LevelDebug, "Getting transaction type by code")
+
+	def, err := scanTransactionType(db.QueryRowContext(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			libOtel.HandleSpanBusinessErrorEvent(span, "Transaction type not found", constant.ErrTransactionTypeNotFound)
+			return nil, constant.ErrTransactionTypeNotFound
+		}
+
+		libOtel.HandleSpanError(span, "Failed to get transaction type", err)
+
+		return nil, fmt.Errorf("failed to get transaction type: %w", err)
+	}
+
+	return def, nil
+}
+
+// List returns catalog entries ordered by code, optionally filtered by status.
+// The catalog is small and bounded, so the full result set is returned.
+func (r *TransactionTypeRepository) List(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.transaction_type.list")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	query := sq.Select(transactionTypeColumns...).
+		From(transactionTypesTable).
+		OrderBy("code ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if filter != nil && filter.Status != nil {
+		query = query.Where(sq.Eq{"status": filter.Status.String()})
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to list transaction types", err)
+		return nil, fmt.Errorf("failed to list transaction types: %w", err)
+	}
+	defer rows.Close()
+
+	defs := make([]*model.TransactionTypeDefinition, 0)
+
+	for rows.Next() {
+		def, err := scanTransactionType(rows)
+		if err != nil {
+			libOtel.HandleSpanError(span, "Failed to scan transaction type", err)
+			return nil, fmt.Errorf("failed to scan transaction type: %w", err)
+		}
+
+		defs = append(defs, def)
+	}
+
+	if err := rows.Err(); err != nil {
+		libOtel.HandleSpanError(span, "Error iterating transaction types", err)
+		return nil, fmt.Errorf("error iterating transaction types: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.transaction_type.list"),
+		libLog.Int("list.count", len(defs)),
+	).Log(ctx, libLog.LevelDebug, "Listed transaction types")
+
+	return defs, nil
+}
+
+// UpdateWithTx persists the mutable catalog fields using the provided database
+// handle. Returns constant.ErrTransactionTypeNotFound when no row matched.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *TransactionTypeRepository) UpdateWithTx(ctx context.Context, db pgdb.DB, def *model.TransactionTypeDefinition) error {
+	if db == nil {
+		return pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.transaction_type.update")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var dbModel TransactionTypePostgreSQLModel
+	if err := dbModel.FromEntity(def); err != nil {
+		return fmt.Errorf("failed to convert entity to database model: %w", err)
+	}
+
+	query := sq.Update(transactionTypesTable).
+		Set("description", dbModel.Description).
+		Set("sub_types", dbModel.SubTypes).
+		Set("status", dbModel.Status).
+		Set("updated_at", dbModel.UpdatedAt).
+		Where(sq.Eq{"code": dbModel.Code}).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.transaction_type.update"),
+		libLog.String("transaction_type.code", dbModel.Code),
+	).Log(ctx, libLog.LevelDebug, "Updating transaction type")
+
+	result, err := db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to update transaction type", err)
+		return fmt.Errorf("failed to update transaction type: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get rows affected", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		libOtel.HandleSpanBusinessErrorEvent(span, "Transaction type not found", constant.ErrTransactionTypeNotFound)
+		return constant.ErrTransactionTypeNotFound
+	}
+
+	return nil
+}
+
+// transactionTypeScanner is satisfied by both *sql.Row and *sql.Rows.
+type transactionTypeScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTransactionType scans a catalog row into a domain entity via ToEntity.
+func scanTransactionType(row transactionTypeScanner) (*model.TransactionTypeDefinition, error) {
+	var (
+		dbModel      TransactionTypePostgreSQLModel
+		subTypesJSON []byte
+	)
+
+	if err := row.Scan(
+		&dbModel.Code,
+		&dbModel.Description,
+		&subTypesJSON,
+		&dbModel.Builtin,
+		&dbModel.Status,
+		&dbModel.CreatedAt,
+		&dbModel.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	dbModel.SubTypes = string(subTypesJSON)
+
+	def, err := dbModel.ToEntity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert to entity: %w", err)
+	}
+
+	return def, nil
+}
@@ -19,7 +19,7 @@ import (
 // It follows the ToEntity/FromEntity pattern from Ring Standards (golang/domain.md).
 // This model handles:
 // - UUID as string for database storage
-// - JSONB fields for complex nested objects (account, segment, portfolio, merchant, metadata, limit_usage_details)
+// - JSONB fields for complex nested objects (account, segment, portfolio, merchant, device, geolocation, counterparty, metadata, limit_usage_details)
 // - UUID arrays as string for PostgreSQL UUID[] type (matched_rule_ids, evaluated_rule_ids)
 // - Nullable fields using pointers for optional JSONB columns
 type TransactionValidationPostgreSQLModel struct {
@@ -30,11 +30,14 @@ type TransactionValidationPostgreSQLModel struct {
 	Amount               decimal.Decimal `db:"amount"`
 	Currency             string          `db:"currency"`
 	TransactionTimestamp time.Time       `db:"transaction_timestamp"`
-	Account              string          `db:"account"`   // JSONB
-	Segment              *string         `db:"segment"`   // JSONB (nullable)
-	Portfolio            *string         `db:"portfolio"` // JSONB (nullable)
-	Merchant             *string         `db:"merchant"`  // JSONB (nullable)
-	Metadata             string          `db:"metadata"`  // JSONB
+	Account              string          `db:"account"`      // JSONB
+	Segment              *string         `db:"segment"`      // JSONB (nullable)
+	Portfolio            *string         `db:"portfolio"`    // JSONB (nullable)
+	Merchant             *string         `db:"merchant"`     // JSONB (nullable)
+	Device               *string         `db:"device"`       // JSONB (nullable)
+	Geolocation          *string         `db:"geolocation"`  // JSONB (nullable)
+	Counterparty         *string         `db:"counterparty"` // JSONB (nullable)
+	Metadata             string          `db:"metadata"`     // JSONB
 	Decision             string          `db:"decision"`
 	Reason               string          `db:"reason"`
 	MatchedRuleIds       string          `db:"matched_rule_ids"`    // UUID[] as string
@@ -104,6 +107,21 @@ func (m *TransactionValidationPostgreSQLModel) ToEntity() (*model.TransactionVal
 		return nil, err
 	}
 
+	validation.Device, err = unmarshalOptionalJSON[model.DeviceContext](m.Device, "device")
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Geolocation, err = unmarshalOptionalJSON[model.GeolocationContext](m.Geolocation, "geolocation")
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Counterparty, err = unmarshalOptionalJSON[model.CounterpartyContext](m.Counterparty, "counterparty")
+	if err != nil {
+		return nil, err
+	}
+
 	if err := unmarshalJSONField(m.Metadata, &validation.Metadata, "metadata", "{}"); err != nil {
 		return nil, err
 	}
@@ -182,6 +200,21 @@ func (m *TransactionValidationPostgreSQLModel) FromEntity(entity *model.Transact
 		return err
 	}
 
+	m.Device, err = marshalOptionalJSON(entity.Device, "device")
+	if err != nil {
+		return err
+	}
+
+	m.Geolocation, err = marshalOptionalJSON(entity.Geolocation, "geolocation")
+	if err != nil {
+		return err
+	}
+
+	m.Counterparty, err = marshalOptionalJSON(entity.Counterparty, "counterparty")
+	if err != nil {
+		return err
+	}
+
 	// Marshal metadata to JSONB, defaulting to empty object for nil
 	metadata := entity.Metadata
 	if metadata == nil {
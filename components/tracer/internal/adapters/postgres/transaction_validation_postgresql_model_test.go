@@ -618,7 +618,9 @@ func TestTransactionValidationPostgreSQLModel_RoundTrip(t *testing.T) {
 			Category: "5411",
 			Country:  "BR",
 		},
-		Metadata: map[string]any{"source": "mobile", "version": float64(2)},
+		Device:      &model.DeviceContext{Fingerprint: "fp-1", IPAddress: "203.0.113.7", IPCountry: "BR", FirstSeenAt: &txTimestamp},
+		Geolocation: &model.GeolocationContext{Country: "BR", LastKnown: &model.GeoPoint{Latitude: -23.55, Longitude: -46.63}},
+		Metadata:    map[string]any{"source": "mobile", "version": float64(2)},
 		EvaluationResult: model.EvaluationResult{
 			Decision:         model.DecisionAllow,
 			Reason:           "Transaction allowed by rule evaluation",
@@ -679,6 +681,10 @@ func TestTransactionValidationPostgreSQLModel_RoundTrip(t *testing.T) {
 	assert.Equal(t, original.Merchant.Category, result.Merchant.Category, "Round-trip Merchant.Category mismatch")
 	assert.Equal(t, original.Merchant.Country, result.Merchant.Country, "Round-trip Merchant.Country mismatch")
 
+	assert.Equal(t, original.Device, result.Device, "Round-trip Device mismatch")
+	assert.Equal(t, original.Geolocation, result.Geolocation, "Round-trip Geolocation mismatch")
+	assert.Nil(t, result.Counterparty, "Counterparty should be nil")
+
 	// Validate UUID arrays
 	require.Len(t, result.MatchedRuleIDs, len(original.MatchedRuleIDs), "Round-trip MatchedRuleIDs length mismatch")
 	for i := range original.MatchedRuleIDs {
@@ -66,6 +66,9 @@ func transactionValidationColumns() []string {
 		"segment",
 		"portfolio",
 		"merchant",
+		"device",
+		"geolocation",
+		"counterparty",
 		"metadata",
 		"decision",
 		"reason",
@@ -78,8 +81,8 @@ func transactionValidationColumns() []string {
 }
 
 // TransactionValidationRepository implements TransactionValidationRepository using PostgreSQL with Squirrel query builder.
-// Handles JSONB fields (account, segment, portfolio, merchant, metadata, limit_usage_details) and
-// UUID[] arrays (matched_rule_ids, evaluated_rule_ids) for transaction validation persistence.
+// Handles JSONB fields (account, segment, portfolio, merchant, device, geolocation, counterparty,
+// metadata, limit_usage_details) and UUID[] arrays (matched_rule_ids, evaluated_rule_ids) for transaction validation persistence.
 // NOTE: Only INSERT operations are allowed - transaction validation trail is immutable per SOX/GLBA requirements.
 // Tenant resolution is handled by the underlying pgdb.Connection (M1).
 type TransactionValidationRepository struct {
@@ -189,6 +192,9 @@ func (r *TransactionValidationRepository) insertInternal(
 			"segment",
 			"portfolio",
 			"merchant",
+			"device",
+			"geolocation",
+			"counterparty",
 			"metadata",
 			"decision",
 			"reason",
@@ -210,6 +216,9 @@ func (r *TransactionValidationRepository) insertInternal(
 			dbModel.Segment,
 			dbModel.Portfolio,
 			dbModel.Merchant,
+			dbModel.Device,
+			dbModel.Geolocation,
+			dbModel.Counterparty,
 			dbModel.Metadata,
 			dbModel.Decision,
 			dbModel.Reason,
@@ -639,6 +648,9 @@ func (r *TransactionValidationRepository) scanValidation(ctx context.Context, ro
 		segmentJSON      []byte
 		portfolioJSON    []byte
 		merchantJSON     []byte
+		deviceJSON       []byte
+		geolocationJSON  []byte
+		counterpartyJSON []byte
 		matchedRuleIDs   StringArray
 		evaluatedRuleIDs StringArray
 	)
@@ -663,6 +675,9 @@ func (r *TransactionValidationRepository) scanValidation(ctx context.Context, ro
 		&segmentJSON,
 		&portfolioJSON,
 		&merchantJSON,
+		&deviceJSON,
+		&geolocationJSON,
+		&counterpartyJSON,
 		&metadataJSON,
 		&dbModel.Decision,
 		&dbModel.Reason,
@@ -697,6 +712,21 @@ func (r *TransactionValidationRepository) scanValidation(ctx context.Context, ro
 		dbModel.Merchant = &merchantStr
 	}
 
+	if len(deviceJSON) > 0 {
+		deviceStr := string(deviceJSON)
+		dbModel.Device = &deviceStr
+	}
+
+	if len(geolocationJSON) > 0 {
+		geolocationStr := string(geolocationJSON)
+		dbModel.Geolocation = &geolocationStr
+	}
+
+	if len(counterpartyJSON) > 0 {
+		counterpartyStr := string(counterpartyJSON)
+		dbModel.Counterparty = &counterpartyStr
+	}
+
 	// Convert UUID arrays from PostgreSQL format
 	dbModel.MatchedRuleIds = formatUUIDArrayFromStringArray(matchedRuleIDs)
 	dbModel.EvaluatedRuleIds = formatUUIDArrayFromStringArray(evaluatedRuleIDs)
@@ -718,6 +748,9 @@ func (r *TransactionValidationRepository) scanValidationFromRows(ctx context.Con
 		segmentJSON      []byte
 		portfolioJSON    []byte
 		merchantJSON     []byte
+		deviceJSON       []byte
+		geolocationJSON  []byte
+		counterpartyJSON []byte
 		matchedRuleIDs   StringArray
 		evaluatedRuleIDs StringArray
 	)
@@ -742,6 +775,9 @@ func (r *TransactionValidationRepository) scanValidationFromRows(ctx context.Con
 		&segmentJSON,
 		&portfolioJSON,
 		&merchantJSON,
+		&deviceJSON,
+		&geolocationJSON,
+		&counterpartyJSON,
 		&metadataJSON,
 		&dbModel.Decision,
 		&dbModel.Reason,
@@ -776,6 +812,21 @@ func (r *TransactionValidationRepository) scanValidationFromRows(ctx context.Con
 		dbModel.Merchant = &merchantStr
 	}
 
+	if len(deviceJSON) > 0 {
+		deviceStr := string(deviceJSON)
+		dbModel.Device = &deviceStr
+	}
+
+	if len(geolocationJSON) > 0 {
+		geolocationStr := string(geolocationJSON)
+		dbModel.Geolocation = &geolocationStr
+	}
+
+	if len(counterpartyJSON) > 0 {
+		counterpartyStr := string(counterpartyJSON)
+		dbModel.Counterparty = &counterpartyStr
+	}
+
 	// Convert UUID arrays from PostgreSQL format
 	dbModel.MatchedRuleIds = formatUUIDArrayFromStringArray(matchedRuleIDs)
 	dbModel.EvaluatedRuleIds = formatUUIDArrayFromStringArray(evaluatedRuleIDs)
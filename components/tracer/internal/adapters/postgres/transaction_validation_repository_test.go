@@ -150,6 +150,9 @@ func transactionValidationRow(t *testing.T, tv *model.TransactionValidation) *sq
 			mustMarshalJSONOrNil(t, tv.Segment),
 			mustMarshalJSONOrNil(t, tv.Portfolio),
 			mustMarshalJSONOrNil(t, tv.Merchant),
+			mustMarshalJSONOrNil(t, tv.Device),
+			mustMarshalJSONOrNil(t, tv.Geolocation),
+			mustMarshalJSONOrNil(t, tv.Counterparty),
 			mustMarshalJSONOrEmpty(t, tv.Metadata),
 			string(tv.Decision),
 			tv.Reason,
@@ -179,7 +182,7 @@ func mustMarshalJSONOrEmpty(t *testing.T, v any) []byte {
 }
 
 // mustMarshalJSONOrNil marshals a value to JSON, returning nil for nil values.
-// Used for nullable JSONB columns (segment, portfolio, merchant).
+// Used for nullable JSONB columns (segment, portfolio, merchant, device, geolocation, counterparty).
 func mustMarshalJSONOrNil(t *testing.T, v any) []byte {
 	t.Helper()
 	if v == nil {
@@ -248,6 +251,9 @@ func TestTransactionValidationPostgresRepository_Insert(t *testing.T) {
 						sqlmock.AnyArg(), // segment (JSONB)
 						sqlmock.AnyArg(), // portfolio (JSONB)
 						sqlmock.AnyArg(), // merchant (JSONB)
+						sqlmock.AnyArg(), // device (JSONB)
+						sqlmock.AnyArg(), // geolocation (JSONB)
+						sqlmock.AnyArg(), // counterparty (JSONB)
 						sqlmock.AnyArg(), // metadata (JSONB)
 						string(tv.Decision),
 						tv.Reason,
@@ -278,6 +284,9 @@ func TestTransactionValidationPostgresRepository_Insert(t *testing.T) {
 						sqlmock.AnyArg(), // segment (JSONB)
 						sqlmock.AnyArg(), // portfolio (JSONB)
 						sqlmock.AnyArg(), // merchant (JSONB)
+						sqlmock.AnyArg(), // device (JSONB)
+						sqlmock.AnyArg(), // geolocation (JSONB)
+						sqlmock.AnyArg(), // counterparty (JSONB)
 						sqlmock.AnyArg(), // metadata (JSONB)
 						string(tv.Decision),
 						tv.Reason,
@@ -489,6 +498,9 @@ func TestTransactionValidationPostgresRepository_List(t *testing.T) {
 					mustMarshalJSONOrNil(t, tv2.Segment),
 					mustMarshalJSONOrNil(t, tv2.Portfolio),
 					mustMarshalJSONOrNil(t, tv2.Merchant),
+					mustMarshalJSONOrNil(t, tv2.Device),
+					mustMarshalJSONOrNil(t, tv2.Geolocation),
+					mustMarshalJSONOrNil(t, tv2.Counterparty),
 					mustMarshalJSONOrEmpty(t, tv2.Metadata),
 					string(tv2.Decision),
 					tv2.Reason,
@@ -1495,6 +1507,9 @@ func TestTransactionValidationPostgresRepository_InsertWithTx(t *testing.T) {
 						sqlmock.AnyArg(), // segment (JSONB)
 						sqlmock.AnyArg(), // portfolio (JSONB)
 						sqlmock.AnyArg(), // merchant (JSONB)
+						sqlmock.AnyArg(), // device (JSONB)
+						sqlmock.AnyArg(), // geolocation (JSONB)
+						sqlmock.AnyArg(), // counterparty (JSONB)
 						sqlmock.AnyArg(), // metadata (JSONB)
 						string(tv.Decision),
 						tv.Reason,
@@ -1594,6 +1609,9 @@ func TestTransactionValidationPostgresRepository_InsertWithTx_UsesProvidedDB(t *
 			sqlmock.AnyArg(), // segment (JSONB)
 			sqlmock.AnyArg(), // portfolio (JSONB)
 			sqlmock.AnyArg(), // merchant (JSONB)
+			sqlmock.AnyArg(), // device (JSONB)
+			sqlmock.AnyArg(), // geolocation (JSONB)
+			sqlmock.AnyArg(), // counterparty (JSONB)
 			sqlmock.AnyArg(), // metadata (JSONB)
 			string(tv.Decision),
 			tv.Reason,
@@ -1666,6 +1684,9 @@ func TestTransactionValidationPostgresRepository_InsertWithTx_UniqueViolation(t
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
 			string(tv.Decision),
 			tv.Reason,
 			sqlmock.AnyArg(),
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// ValidationOutcomePostgreSQLModel is the database representation of a
+// ValidationOutcome label.
+type ValidationOutcomePostgreSQLModel struct {
+	ValidationID uuid.UUID      `db:"validation_id"`
+	Label        string         `db:"label"`
+	Note         sql.NullString `db:"note"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+// ToEntity converts the database model to a domain entity.
+func (m *ValidationOutcomePostgreSQLModel) ToEntity() *model.ValidationOutcome {
+	var note *string
+	if m.Note.Valid {
+		note = &m.Note.String
+	}
+
+	return &model.ValidationOutcome{
+		ValidationID: m.ValidationID,
+		Label:        model.OutcomeLabel(m.Label),
+		Note:         note,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// FromEntity converts a domain entity to a database model.
+func (m *ValidationOutcomePostgreSQLModel) FromEntity(entity *model.ValidationOutcome) error {
+	if entity == nil {
+		return fmt.Errorf("validation outcome entity cannot be nil")
+	}
+
+	m.ValidationID = entity.ValidationID
+	m.Label = entity.Label.String()
+	m.CreatedAt = entity.CreatedAt
+	m.UpdatedAt = entity.UpdatedAt
+
+	if entity.Note != nil {
+		m.Note = sql.NullString{String: *entity.Note, Valid: true}
+	} else {
+		m.Note = sql.NullString{Valid: false}
+	}
+
+	return nil
+}
@@ -0,0 +1,405 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOtel "github.com/LerianStudio/lib-observability/tracing"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// Compile-time interface implementation checks.
+var (
+	_ query.ValidationOutcomeRepository   = (*ValidationOutcomeRepository)(nil)
+	_ command.ValidationOutcomeRepository = (*ValidationOutcomeRepository)(nil)
+)
+
+// validationOutcomesTable is the PostgreSQL table name for outcome labels.
+// Using a constant prevents SQL injection via table name interpolation.
+const validationOutcomesTable = "validation_outcomes"
+
+// validationOutcomeColumns is the column list shared by every label SELECT and
+// the upsert RETURNING clause so scanValidationOutcome stays aligned.
+var validationOutcomeColumns = []string{"validation_id", "label", "note", "created_at", "updated_at"}
+
+// fraudLabelCondition matches the labels counted as fraud. The values are the
+// fixed model enum, not user input, so they are inlined as literals.
+var fraudLabelCondition = func() string {
+	labels := model.FraudOutcomeLabels()
+	quoted := make([]string, len(labels))
+
+	for i, l := range labels {
+		quoted[i] = "'" + l.String() + "'"
+	}
+
+	return "o.label IN (" + strings.Join(quoted, ", ") + ")"
+}()
+
+// ValidationOutcomeRepository persists outcome labels and computes the
+// labeled-data reports by joining them with transaction_validations.
+// Tenant resolution is handled by the underlying pgdb.Connection.
+type ValidationOutcomeRepository struct {
+	conn pgdb.Connection
+}
+
+// NewValidationOutcomeRepositoryWithConnection creates a new PostgreSQL
+// validation outcome repository with a custom pgdb.Connection.
+func NewValidationOutcomeRepositoryWithConnection(conn pgdb.Connection) *ValidationOutcomeRepository {
+	return &ValidationOutcomeRepository{
+		conn: conn,
+	}
+}
+
+// UpsertWithTx records the label for a validation, replacing any previous
+// label while keeping the original created_at. Returns the stored row.
+// The db handle MUST be non-nil; passing nil returns pgdb.ErrNilConnection.
+func (r *ValidationOutcomeRepository) UpsertWithTx(ctx context.Context, db pgdb.DB, outcome *model.ValidationOutcome) (*model.ValidationOutcome, error) {
+	if db == nil {
+		return nil, pgdb.ErrNilConnection
+	}
+
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.validation_outcome.upsert")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	var dbModel ValidationOutcomePostgreSQLModel
+	if err := dbModel.FromEntity(outcome); err != nil {
+		return nil, fmt.Errorf("failed to convert entity to database model: %w", err)
+	}
+
+	qb := sq.Insert(validationOutcomesTable).
+		Columns(validationOutcomeColumns...).
+		Values(dbModel.ValidationID, dbModel.Label, dbModel.Note, dbModel.CreatedAt, dbModel.UpdatedAt).
+		Suffix("ON CONFLICT (validation_id) DO UPDATE SET label = EXCLUDED.label, note = EXCLUDED.note, updated_at = EXCLUDED.updated_at").
+		Suffix("RETURNING " + strings.Join(validationOutcomeColumns, ", ")).
+		PlaceholderFormat(sq.Dollar)
+
+	sqlStr, args, err := qb.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.validation_outcome.upsert"),
+		libLog.String("validation.id", dbModel.ValidationID.String()),
+		libLog.String("outcome.label", dbModel.Label),
+	).Log(ctx, libLog.LevelDebug, "Upserting validation outcome")
+
+	stored, err := scanValidationOutcome(db.QueryRowContext(ctx, sqlStr, args...))
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to upsert validation outcome", err)
+		return nil, fmt.Errorf("failed to upsert validation outcome: %w", err)
+	}
+
+	return stored, nil
+}
+
+// GetByValidationID retrieves the label recorded for a validation.
+// Returns constant.ErrValidationOutcomeNotFound when the validation is unlabeled.
+func (r *ValidationOutcomeRepository) GetByValidationID(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.validation_outcome.get_by_validation_id")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	sqlStr, args, err := sq.Select(validationOutcomeColumns...).
+		From(validationOutcomesTable).
+		Where(sq.Eq{"validation_id": validationID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.validation_outcome.get_by_validation_id"),
+		libLog.String("validation.id", validationID.String()),
+	).Log(ctx, libLog.LevelDebug, "Getting validation outcome")
+
+	outcome, err := scanValidationOutcome(db.QueryRowContext(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			libOtel.HandleSpanBusinessErrorEvent(span, "Validation outcome not found", constant.ErrValidationOutcomeNotFound)
+			return nil, constant.ErrValidationOutcomeNotFound
+		}
+
+		libOtel.HandleSpanError(span, "Failed to get validation outcome", err)
+
+		return nil, fmt.Errorf("failed to get validation outcome: %w", err)
+	}
+
+	return outcome, nil
+}
+
+// RuleOutcomeCounts aggregates, per evaluated rule, the confusion matrix over
+// labeled validations created inside the filter window. Each labeled
+// validation contributes one row per rule in its evaluated_rule_ids; a rule
+// counts as flagging the transaction when it is also in matched_rule_ids.
+func (r *ValidationOutcomeRepository) RuleOutcomeCounts(ctx context.Context, filter *model.OutcomeReportFilter) ([]model.RuleOutcomeCounts, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.validation_outcome.rule_outcome_counts")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	matched := "er.rule_id = ANY(v.matched_rule_ids)"
+
+	qb := sq.Select(
+		"er.rule_id",
+		"COALESCE(r.name, '')",
+		"COALESCE(r.action::text, '')",
+		"COUNT(*) FILTER (WHERE "+matched+" AND "+fraudLabelCondition+")",
+		"COUNT(*) FILTER (WHERE "+matched+" AND NOT "+fraudLabelCondition+")",
+		"COUNT(*) FILTER (WHERE NOT "+matched+" AND "+fraudLabelCondition+")",
+		"COUNT(*) FILTER (WHERE NOT "+matched+" AND NOT "+fraudLabelCondition+")",
+	).
+		From(validationOutcomesTable+" o").
+		Join("transaction_validations v ON v.id = o.validation_id").
+		JoinClause("CROSS JOIN LATERAL unnest(v.evaluated_rule_ids) AS er(rule_id)").
+		LeftJoin("rules r ON r.id = er.rule_id").
+		Where(sq.GtOrEq{"v.created_at": filter.StartDate}).
+		Where(sq.Lt{"v.created_at": filter.EndDate}).
+		GroupBy("er.rule_id", "r.name", "r.action").
+		OrderBy("er.rule_id ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.RuleID != nil {
+		qb = qb.Where(sq.Eq{"er.rule_id": *filter.RuleID})
+	}
+
+	sqlStr, args, err := qb.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to aggregate rule outcomes", err)
+		return nil, fmt.Errorf("failed to aggregate rule outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]model.RuleOutcomeCounts, 0)
+
+	for rows.Next() {
+		var (
+			c      model.RuleOutcomeCounts
+			action string
+		)
+
+		if err := rows.Scan(&c.RuleID, &c.RuleName, &action, &c.TruePositives, &c.FalsePositives, &c.FalseNegatives, &c.TrueNegatives); err != nil {
+			libOtel.HandleSpanError(span, "Failed to scan rule outcome counts", err)
+			return nil, fmt.Errorf("failed to scan rule outcome counts: %w", err)
+		}
+
+		c.RuleAction = model.Decision(action)
+		counts = append(counts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		libOtel.HandleSpanError(span, "Error iterating rule outcome counts", err)
+		return nil, fmt.Errorf("error iterating rule outcome counts: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.validation_outcome.rule_outcome_counts"),
+		libLog.Int("rules.count", len(counts)),
+	).Log(ctx, libLog.LevelDebug, "Aggregated rule outcome counts")
+
+	return counts, nil
+}
+
+// ListDataset returns labeled validations created inside the filter window,
+// oldest first, bounded by filter.Limit.
+func (r *ValidationOutcomeRepository) ListDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "repository.validation_outcome.list_dataset")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	db, err := r.conn.GetDB(ctx)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to get database connection", err)
+		return nil, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	qb := sq.Select(
+		"v.id",
+		"v.request_id",
+		"v.transaction_type",
+		"v.sub_type",
+		"v.amount",
+		"v.currency",
+		"v.transaction_timestamp",
+		"(v.account->>'accountId')::uuid",
+		"v.decision",
+		"v.matched_rule_ids",
+		"o.label",
+		"o.updated_at",
+		"v.created_at",
+	).
+		From(validationOutcomesTable+" o").
+		Join("transaction_validations v ON v.id = o.validation_id").
+		Where(sq.GtOrEq{"v.created_at": filter.StartDate}).
+		Where(sq.Lt{"v.created_at": filter.EndDate}).
+		OrderBy("v.created_at ASC", "v.id ASC").
+		Limit(uint64(filter.Limit)). // #nosec G115 - bounded by OutcomeReportFilter.Validate
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Label != nil {
+		qb = qb.Where(sq.Eq{"o.label": filter.Label.String()})
+	}
+
+	if filter.RuleID != nil {
+		qb = qb.Where(sq.Expr("? = ANY(v.evaluated_rule_ids)", *filter.RuleID))
+	}
+
+	sqlStr, args, err := qb.ToSql()
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to build query", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		libOtel.HandleSpanError(span, "Failed to list outcome dataset", err)
+		return nil, fmt.Errorf("failed to list outcome dataset: %w", err)
+	}
+	defer rows.Close()
+
+	dataset := make([]*model.OutcomeDatasetRow, 0)
+
+	for rows.Next() {
+		row, err := scanOutcomeDatasetRow(rows)
+		if err != nil {
+			libOtel.HandleSpanError(span, "Failed to scan outcome dataset row", err)
+			return nil, fmt.Errorf("failed to scan outcome dataset row: %w", err)
+		}
+
+		dataset = append(dataset, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		libOtel.HandleSpanError(span, "Error iterating outcome dataset", err)
+		return nil, fmt.Errorf("error iterating outcome dataset: %w", err)
+	}
+
+	logger.With(
+		libLog.String("operation", "repository.validation_outcome.list_dataset"),
+		libLog.Int("list.count", len(dataset)),
+	).Log(ctx, libLog.LevelDebug, "Listed outcome dataset")
+
+	return dataset, nil
+}
+
+// validationOutcomeScanner is satisfied by both *sql.Row and *sql.Rows.
+type validationOutcomeScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanValidationOutcome scans a label row into a domain entity via ToEntity.
+func scanValidationOutcome(row validationOutcomeScanner) (*model.ValidationOutcome, error) {
+	var dbModel ValidationOutcomePostgreSQLModel
+
+	if err := row.Scan(
+		&dbModel.ValidationID,
+		&dbModel.Label,
+		&dbModel.Note,
+		&dbModel.CreatedAt,
+		&dbModel.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return dbModel.ToEntity(), nil
+}
+
+func scanOutcomeDatasetRow(row validationOutcomeScanner) (*model.OutcomeDatasetRow, error) {
+	var (
+		out             model.OutcomeDatasetRow
+		transactionType string
+		subType         sql.NullString
+		decision        string
+		label           string
+		matchedRuleIDs  StringArray
+	)
+
+	if err := row.Scan(
+		&out.ValidationID,
+		&out.RequestID,
+		&transactionType,
+		&subType,
+		&out.Amount,
+		&out.Currency,
+		&out.TransactionTimestamp,
+		&out.AccountID,
+		&decision,
+		&matchedRuleIDs,
+		&label,
+		&out.LabeledAt,
+		&out.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	out.TransactionType = model.TransactionType(transactionType)
+	out.Decision = model.Decision(decision)
+	out.Label = model.OutcomeLabel(label)
+
+	if subType.Valid {
+		out.SubType = &subType.String
+	}
+
+	out.MatchedRuleIDs = make([]uuid.UUID, 0, len(matchedRuleIDs))
+
+	for _, s := range matchedRuleIDs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matched rule id %q: %w", s, err)
+		}
+
+		out.MatchedRuleIDs = append(out.MatchedRuleIDs, id)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func setupValidationOutcomeRepositoryMockDB(t *testing.T) (*ValidationOutcomeRepository, *sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	mockConn := mocks.NewMockConnection(ctrl)
+	mockConn.EXPECT().GetDB(gomock.Any()).Return(db, nil).AnyTimes()
+
+	return NewValidationOutcomeRepositoryWithConnection(mockConn), db, sqlMock
+}
+
+func TestValidationOutcomeRepository_UpsertWithTx(t *testing.T) {
+	repo, db, sqlMock := setupValidationOutcomeRepositoryMockDB(t)
+
+	now := testutil.FixedTime()
+	firstLabeled := now.Add(-time.Hour)
+	outcome, err := model.NewValidationOutcome(testutil.MustDeterministicUUID(1), "CHARGEBACK", nil, now)
+	require.NoError(t, err)
+
+	// The stored row keeps the created_at of the first label.
+	sqlMock.ExpectQuery(regexp.QuoteMeta("ON CONFLICT (validation_id) DO UPDATE")).
+		WithArgs(outcome.ValidationID, "CHARGEBACK", sqlmock.AnyArg(), now, now).
+		WillReturnRows(sqlmock.NewRows(validationOutcomeColumns).
+			AddRow(outcome.ValidationID, "CHARGEBACK", nil, firstLabeled, now))
+
+	stored, err := repo.UpsertWithTx(context.Background(), db, outcome)
+	require.NoError(t, err)
+	assert.Equal(t, firstLabeled, stored.CreatedAt)
+	assert.Equal(t, now, stored.UpdatedAt)
+	assert.Nil(t, stored.Note)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+
+	_, err = repo.UpsertWithTx(context.Background(), nil, outcome)
+	require.Error(t, err)
+}
+
+func TestValidationOutcomeRepository_GetByValidationID_NotFound(t *testing.T) {
+	repo, _, sqlMock := setupValidationOutcomeRepositoryMockDB(t)
+
+	id := testutil.MustDeterministicUUID(2)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("FROM validation_outcomes WHERE validation_id = $1")).
+		WithArgs(id).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetByValidationID(context.Background(), id)
+	require.ErrorIs(t, err, constant.ErrValidationOutcomeNotFound)
+}
+
+func TestValidationOutcomeRepository_RuleOutcomeCounts(t *testing.T) {
+	repo, _, sqlMock := setupValidationOutcomeRepositoryMockDB(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	ruleID := testutil.MustDeterministicUUID(3)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("CROSS JOIN LATERAL unnest(v.evaluated_rule_ids) AS er(rule_id)")).
+		WithArgs(start, end, ruleID).
+		WillReturnRows(sqlmock.NewRows([]string{"rule_id", "name", "action", "tp", "fp", "fn", "tn"}).
+			AddRow(ruleID, "High value", "DENY", 4, 1, 2, 10))
+
+	counts, err := repo.RuleOutcomeCounts(context.Background(), &model.OutcomeReportFilter{StartDate: start, EndDate: end, RuleID: &ruleID})
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	assert.Equal(t, model.RuleOutcomeCounts{
+		RuleID:         ruleID,
+		RuleName:       "High value",
+		RuleAction:     model.DecisionDeny,
+		TruePositives:  4,
+		FalsePositives: 1,
+		FalseNegatives: 2,
+		TrueNegatives:  10,
+	}, counts[0])
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestValidationOutcomeRepository_ListDataset(t *testing.T) {
+	repo, _, sqlMock := setupValidationOutcomeRepositoryMockDB(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	label := model.OutcomeLabelFalsePositive
+	ruleID := testutil.MustDeterministicUUID(4)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta("ORDER BY v.created_at ASC, v.id ASC LIMIT 10")).
+		WithArgs(start, end, "FALSE_POSITIVE", ruleID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "request_id", "transaction_type", "sub_type", "amount", "currency", "transaction_timestamp",
+			"account_id", "decision", "matched_rule_ids", "label", "labeled_at", "created_at",
+		}).AddRow(
+			testutil.MustDeterministicUUID(5), testutil.MustDeterministicUUID(6), "PIX", nil, "10.50", "BRL", start,
+			testutil.MustDeterministicUUID(7), "REVIEW", "{"+ruleID.String()+"}", "FALSE_POSITIVE", end, start,
+		))
+
+	rows, err := repo.ListDataset(context.Background(), &model.OutcomeReportFilter{StartDate: start, EndDate: end, Label: &label, RuleID: &ruleID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, model.TransactionType("PIX"), rows[0].TransactionType)
+	assert.Nil(t, rows[0].SubType)
+	assert.Equal(t, "10.5", rows[0].Amount.String())
+	assert.Equal(t, []uuid.UUID{ruleID}, rows[0].MatchedRuleIDs)
+	assert.Equal(t, model.OutcomeLabelFalsePositive, rows[0].Label)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -864,6 +864,110 @@ func initPostgresConnection(ctx context.Context, cfg *Config, logger libLog.Logg
 	return postgresConn, nil
 }
 
+// transactionTypeDeps holds the transaction-type catalog service and the shared
+// catalog cache that the rule/limit commands and the validation service consult.
+type transactionTypeDeps struct {
+	service *services.TransactionTypeService
+	catalog *query.TransactionTypeCatalog
+}
+
+// initTransactionTypeService creates the transaction-type catalog service. The
+// returned catalog cache is shared so writes through the API invalidate the
+// same snapshots the validation path reads.
+func initTransactionTypeService(pgConn pgdb.Connection, clk clock.Clock, txBeginner pgdb.TxBeginner) (*transactionTypeDeps, error) {
+	repo := postgres.NewTransactionTypeRepositoryWithConnection(pgConn)
+
+	createCmd, err := command.NewCreateTransactionTypeCommand(repo, clk, txBeginner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct CreateTransactionTypeCommand: %w", err)
+	}
+
+	updateCmd, err := command.NewUpdateTransactionTypeCommand(repo, clk, txBeginner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct UpdateTransactionTypeCommand: %w", err)
+	}
+
+	catalog := query.NewTransactionTypeCatalog(repo, clk, query.DefaultTransactionTypeCatalogTTL)
+
+	service := services.NewTransactionTypeService(
+		createCmd,
+		updateCmd,
+		query.NewGetTransactionTypeQuery(repo),
+		query.NewListTransactionTypesQuery(repo),
+		catalog,
+	)
+
+	return &transactionTypeDeps{service: service, catalog: catalog}, nil
+}
+
+// initAlertPolicyService creates the alert policy service and the decision
+// alert monitor it invalidates on writes. The monitor emits on the shared
+// streaming emitter and auto-deactivates rules through the rule service, so
+// those deactivations carry the same audit trail and events as the API path.
+func initAlertPolicyService(
+	pgConn pgdb.Connection,
+	clk clock.Clock,
+	txBeginner pgdb.TxBeginner,
+	streaming libStreaming.Emitter,
+	deactivator services.RuleDeactivator,
+) (*services.AlertPolicyService, *services.DecisionAlertMonitor, error) {
+	repo := postgres.NewAlertPolicyRepositoryWithConnection(pgConn)
+
+	createCmd, err := command.NewCreateAlertPolicyCommand(repo, clk, txBeginner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct CreateAlertPolicyCommand: %w", err)
+	}
+
+	updateCmd, err := command.NewUpdateAlertPolicyCommand(repo, clk, txBeginner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct UpdateAlertPolicyCommand: %w", err)
+	}
+
+	deleteCmd, err := command.NewDeleteAlertPolicyCommand(repo, txBeginner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct DeleteAlertPolicyCommand: %w", err)
+	}
+
+	monitor, err := services.NewDecisionAlertMonitor(repo, clk, streaming, deactivator, services.DefaultAlertPolicyTTL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct DecisionAlertMonitor: %w", err)
+	}
+
+	service := services.NewAlertPolicyService(
+		createCmd,
+		updateCmd,
+		deleteCmd,
+		query.NewGetAlertPolicyQuery(repo),
+		query.NewListAlertPoliciesQuery(repo),
+		monitor,
+	)
+
+	return service, monitor, nil
+}
+
+// initValidationOutcomeService creates the outcome labeling service. Labels are
+// checked against the same transaction validation repository the read API uses.
+func initValidationOutcomeService(
+	pgConn pgdb.Connection,
+	validations command.TransactionValidationReader,
+	clk clock.Clock,
+	txBeginner pgdb.TxBeginner,
+) (*services.ValidationOutcomeService, error) {
+	repo := postgres.NewValidationOutcomeRepositoryWithConnection(pgConn)
+
+	labelCmd, err := command.NewLabelValidationOutcomeCommand(repo, validations, clk, txBeginner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct LabelValidationOutcomeCommand: %w", err)
+	}
+
+	return services.NewValidationOutcomeService(
+		labelCmd,
+		query.NewGetValidationOutcomeQuery(repo),
+		query.NewGetRulePerformanceReportQuery(repo),
+		query.NewExportOutcomeDatasetQuery(repo),
+	), nil
+}
+
 // initRuleService creates the rule service with all its dependencies.
 // The cacheWriter parameter is optional (nil-safe); when provided, activate and
 // deactivate commands will synchronously update the in-memory cache after a
@@ -871,7 +975,8 @@ func initPostgresConnection(ctx context.Context, cfg *Config, logger libLog.Logg
 // The txBeginner is shared with the limit lifecycle commands and the validation
 // service so the rule lifecycle commands persist the status/update and the
 // audit event atomically via executeInTx.
-func initRuleService(ruleRepo *postgres.Repository, celAdapter *cel.Adapter, auditWriter command.AuditWriter, cacheWriter command.RuleCacheWriter, clk clock.Clock, txBeginner pgdb.TxBeginner, streaming libStreaming.Emitter) (*services.RuleService, error) {
+// The catalog rejects rule scopes pinning an unregistered transaction type.
+func initRuleService(ruleRepo *postgres.Repository, celAdapter *cel.Adapter, auditWriter command.AuditWriter, cacheWriter command.RuleCacheWriter, clk clock.Clock, txBeginner pgdb.TxBeginner, streaming libStreaming.Emitter, catalog command.TransactionTypeChecker) (*services.RuleService, error) {
 	celCompiler := &celCompilerAdapter{adapter: celAdapter}
 
 	// Inject audit writer and cache writer into Rule commands
@@ -881,6 +986,7 @@ func initRuleService(ruleRepo *postgres.Repository, celAdapter *cel.Adapter, aud
 	}
 
 	createRuleCmd.Streaming = streaming
+	createRuleCmd.Catalog = catalog
 
 	updateRuleCmd, err := command.NewUpdateRuleCommand(ruleRepo, celCompiler, clk, auditWriter, txBeginner)
 	if err != nil {
@@ -888,6 +994,7 @@ func initRuleService(ruleRepo *postgres.Repository, celAdapter *cel.Adapter, aud
 	}
 
 	updateRuleCmd.Streaming = streaming
+	updateRuleCmd.Catalog = catalog
 
 	activateRuleCmd, err := command.NewActivateRuleService(ruleRepo, celCompiler, clk, auditWriter, cacheWriter, txBeginner)
 	if err != nil {
@@ -991,7 +1098,8 @@ type limitServiceDeps struct {
 // tenant pool fails fast in MT mode rather than silently using root (M1).
 // The txBeginner is shared with the validation service so the limit lifecycle
 // commands persist the status/update and the audit event atomically.
-func initLimitService(pgConn pgdb.Connection, auditWriter command.AuditWriter, clk clock.Clock, txBeginner pgdb.TxBeginner, streaming libStreaming.Emitter) (*limitServiceDeps, error) {
+// The catalog rejects limit scopes pinning an unregistered transaction type.
+func initLimitService(pgConn pgdb.Connection, auditWriter command.AuditWriter, clk clock.Clock, txBeginner pgdb.TxBeginner, streaming libStreaming.Emitter, catalog command.TransactionTypeChecker) (*limitServiceDeps, error) {
 	limitRepo := postgres.NewLimitRepositoryWithConnection(pgConn)
 
 	usageCounterRepo := postgres.NewUsageCounterRepositoryWithConnection(pgConn)
@@ -1002,6 +1110,7 @@ func initLimitService(pgConn pgdb.Connection, auditWriter command.AuditWriter, c
 	}
 
 	createLimitCmd.Streaming = streaming
+	createLimitCmd.Catalog = catalog
 
 	updateLimitCmd, err := command.NewUpdateLimitCommand(limitRepo, clk, auditWriter, txBeginner)
 	if err != nil {
@@ -1009,6 +1118,7 @@ func initLimitService(pgConn pgdb.Connection, auditWriter command.AuditWriter, c
 	}
 
 	updateLimitCmd.Streaming = streaming
+	updateLimitCmd.Catalog = catalog
 
 	activateLimitCmd, err := command.NewActivateLimitCommand(limitRepo, clk, auditWriter, txBeginner)
 	if err != nil {
@@ -1068,6 +1178,7 @@ func initHTTPServer(
 	cfg *Config,
 	pgConn pgdb.Connection,
 	limitDeps *limitServiceDeps,
+	txTypeDeps *transactionTypeDeps,
 	evaluateRulesQuery *query.EvaluateRulesQuery,
 	auditWriter *command.RecordAuditEventCommand,
 	auditEventRepo *postgres.AuditEventRepository,
@@ -1079,6 +1190,7 @@ func initHTTPServer(
 	mtComponents *componentsMT,
 	mtMetrics metrics.MultiTenantMetrics,
 	txBeginner pgdb.TxBeginner,
+	streaming libStreaming.Emitter,
 	authHost string,
 ) (*HTTPServer, *services.ReservationService, error) {
 	_ = ctx // reserved for future ctx-aware initialization (e.g., when NewValidationService takes ctx)
@@ -1114,12 +1226,39 @@ func initHTTPServer(
 		validationService.SetMultiTenantMetrics(mtMetrics)
 	}
 
+	// Reject transaction types that are not registered in the tenant's
+	// catalog before any rule or limit is evaluated.
+	validationService.SetTransactionTypeCatalog(txTypeDeps.catalog)
+
+	// Init alert policies. The monitor observes every fresh decision; it never
+	// blocks or fails a validation.
+	alertPolicyService, alertMonitor, err := initAlertPolicyService(pgConn, clk, txBeginner, streaming, ruleService)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validationService.SetDecisionObserver(alertMonitor)
+
 	// Init Transaction Validation service facade
 	transactionValidationService, err := services.NewTransactionValidationService(getTransactionValidationQuery, listTransactionValidationsQuery)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create transaction validation service: %w", err)
 	}
 
+	// Init Validation Outcome service (fraud feedback labels + labeled-data reports)
+	validationOutcomeService, err := initValidationOutcomeService(pgConn, transactionValidationRepo, clk, txBeginner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Init Configuration Bundle service (declarative rule/limit import/export).
+	// It drives the same rule and limit services as the CRUD endpoints so every
+	// applied change goes through the regular validation and audit trail.
+	configBundleService, err := services.NewConfigBundleService(ruleService, limitDeps.service, clk)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct ConfigBundleService: %w", err)
+	}
+
 	// Init Reservation service (two-phase capacity hold). It reuses the limit
 	// checker as the limit resolver and the shared audit writer / txBeginner so
 	// the reserve/confirm/release counter moves commit atomically with their
@@ -1207,6 +1346,10 @@ func initHTTPServer(
 		ReservationService:           reservationService,
 		TransactionValidationService: transactionValidationService,
 		AuditEventService:            auditEventService,
+		TransactionTypeService:       txTypeDeps.service,
+		ValidationOutcomeService:     validationOutcomeService,
+		ConfigBundleService:          configBundleService,
+		AlertPolicyService:           alertPolicyService,
 		Guard:                        authGuard,
 		Clock:                        clk,
 		MultiTenantEnabled:           cfg.MultiTenantEnabled,
@@ -1852,8 +1995,15 @@ func InitServers(ctx context.Context) (*Service, error) {
 		return nil, err
 	}
 
+	// Init the transaction-type catalog first: rule/limit writes and the
+	// validation path all consult it.
+	txTypeDeps, err := initTransactionTypeService(pgConn, clk, txBeginner)
+	if err != nil {
+		return nil, err
+	}
+
 	// Init Rule service with audit writer and rule cache for synchronous cache updates
-	ruleService, err := initRuleService(ruleRepo, celAdapter, auditWriter, ruleCache, clk, txBeginner, streamingEmitter)
+	ruleService, err := initRuleService(ruleRepo, celAdapter, auditWriter, ruleCache, clk, txBeginner, streamingEmitter, txTypeDeps.catalog)
 	if err != nil {
 		return nil, err
 	}
@@ -1877,7 +2027,7 @@ func InitServers(ctx context.Context) (*Service, error) {
 	}
 
 	// Init Limit service with audit writer for SOX/GLBA compliance
-	limitDeps, err := initLimitService(pgConn, auditWriter, clk, txBeginner, streamingEmitter)
+	limitDeps, err := initLimitService(pgConn, auditWriter, clk, txBeginner, streamingEmitter, txTypeDeps.catalog)
 	if err != nil {
 		return nil, err
 	}
@@ -1920,7 +2070,7 @@ func InitServers(ctx context.Context) (*Service, error) {
 	// Init HTTP server with all services. mtComponents is nil in single-tenant
 	// mode; the HTTP server builder threads pgManager + supervisor through to
 	// the TenantMiddleware when non-nil.
-	serverAPI, reservationService, err := initHTTPServer(ctx, cfg, pgConn, limitDeps, evaluateRulesQuery, auditWriter, auditEventRepo, ruleService, healthChecker, logger, telemetry, clk, mtComponents, mtMetrics, txBeginner, sd.authHost)
+	serverAPI, reservationService, err := initHTTPServer(ctx, cfg, pgConn, limitDeps, txTypeDeps, evaluateRulesQuery, auditWriter, auditEventRepo, ruleService, healthChecker, logger, telemetry, clk, mtComponents, mtMetrics, txBeginner, streamingEmitter, sd.authHost)
 	if err != nil {
 		return nil, err
 	}
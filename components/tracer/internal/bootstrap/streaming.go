@@ -300,7 +300,8 @@ func resolveSASLMechanism(cfg *Config) (sasl.Mechanism, string, error) {
 // change.
 //
 // Registers the full Rule and Limit lifecycle events (created, updated,
-// activated, deactivated, drafted, deleted) — six per resource, twelve total.
+// activated, deactivated, drafted, deleted) — six per resource — followed by
+// the two decision-alert events raised by alert policies, fourteen total.
 func tracerEventDefinitions() []events.Definition {
 	return []events.Definition{
 		events.RuleCreatedDefinition,
@@ -315,6 +316,8 @@ func tracerEventDefinitions() []events.Definition {
 		events.LimitDeactivatedDefinition,
 		events.LimitDraftedDefinition,
 		events.LimitDeletedDefinition,
+		events.DecisionAlertTriggeredDefinition,
+		events.DecisionAlertResolvedDefinition,
 	}
 }
 
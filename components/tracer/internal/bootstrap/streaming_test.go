@@ -43,14 +43,22 @@ var expectedLimitEventKeys = []string{
 	"limit.deleted",
 }
 
-// expectedAllEventKeys is the full ordered set of the twelve lifecycle events
-// tracer registers (six Rule, then six Limit). This is the drift lock the
-// catalog/routes/bijection tests assert against.
-var expectedAllEventKeys = append(append([]string{}, expectedRuleEventKeys...), expectedLimitEventKeys...)
+// expectedDecisionAlertEventKeys is the set of event keys tracer registers for
+// alert policies.
+var expectedDecisionAlertEventKeys = []string{
+	"decision-alert.triggered",
+	"decision-alert.resolved",
+}
+
+// expectedAllEventKeys is the full ordered set of the fourteen events tracer
+// registers (six Rule, six Limit, then two decision-alert). This is the drift
+// lock the catalog/routes/bijection tests assert against.
+var expectedAllEventKeys = append(append(append([]string{}, expectedRuleEventKeys...),
+	expectedLimitEventKeys...), expectedDecisionAlertEventKeys...)
 
 // TestTracerEventDefinitions_CoversAllLifecycles locks the Phase-3 contract:
-// tracerEventDefinitions() registers exactly the twelve lifecycle events (six
-// Rule, then six Limit), in the fixed order, with no extra and none missing.
+// tracerEventDefinitions() registers exactly the fourteen events (six Rule, six
+// Limit, then two decision-alert), in the fixed order, with no extra and none missing.
 // This is the single source of truth that feeds both the catalog and the
 // routes.
 func TestTracerEventDefinitions_CoversAllLifecycles(t *testing.T) {
@@ -58,7 +66,7 @@ func TestTracerEventDefinitions_CoversAllLifecycles(t *testing.T) {
 
 	defs := tracerEventDefinitions()
 	require.Len(t, defs, len(expectedAllEventKeys),
-		"tracerEventDefinitions must register exactly the twelve lifecycle events")
+		"tracerEventDefinitions must register exactly the fourteen events")
 
 	actualKeys := make([]string, 0, len(defs))
 	for _, d := range defs {
@@ -66,10 +74,10 @@ func TestTracerEventDefinitions_CoversAllLifecycles(t *testing.T) {
 	}
 
 	// Order is part of the contract: six Rule events (created, updated,
-	// activated, deactivated, drafted, deleted) then six Limit events in the
-	// same order.
+	// activated, deactivated, drafted, deleted), six Limit events in the
+	// same order, then decision-alert triggered and resolved.
 	assert.Equal(t, expectedAllEventKeys, actualKeys,
-		"tracerEventDefinitions must return the Rule then Limit events in the fixed order")
+		"tracerEventDefinitions must return the Rule, Limit then decision-alert events in the fixed order")
 }
 
 // TestBuildStreamingEmitter_DisabledReturnsNoop covers the master-flag-off
@@ -239,14 +247,14 @@ func TestBuildRoutes_CoversAllLifecycles(t *testing.T) {
 // exact 1:1:1 bijection between the registered event definitions, the
 // catalog entries, and the route table — no event registered without a
 // route, no route pointing at an unregistered event (ghost topic), and no
-// count drift between the three. It locks all twelve events (six Rule, six
-// Limit).
+// count drift between the three. It locks all fourteen events (six Rule, six
+// Limit, two decision-alert).
 func TestTracerCatalog_CoversAllEmittedEvents(t *testing.T) {
 	t.Parallel()
 
 	defs := tracerEventDefinitions()
 	require.Len(t, defs, len(expectedAllEventKeys),
-		"tracer must register all twelve lifecycle events")
+		"tracer must register all fourteen events")
 
 	catalog, err := buildCatalog()
 	require.NoError(t, err)
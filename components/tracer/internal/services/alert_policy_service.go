@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// AlertPolicyService is a facade that combines the alert policy commands and
+// queries. Successful writes invalidate the tenant's policy snapshot in the
+// decision alert monitor so the change applies to the next validation.
+type AlertPolicyService struct {
+	createCmd *command.CreateAlertPolicyCommand
+	updateCmd *command.UpdateAlertPolicyCommand
+	deleteCmd *command.DeleteAlertPolicyCommand
+	getQuery  *query.GetAlertPolicyQuery
+	listQuery *query.ListAlertPoliciesQuery
+	monitor   *DecisionAlertMonitor
+}
+
+// NewAlertPolicyService creates a new alert policy service facade.
+// monitor may be nil, in which case writes only rely on the snapshot TTL.
+func NewAlertPolicyService(
+	createCmd *command.CreateAlertPolicyCommand,
+	updateCmd *command.UpdateAlertPolicyCommand,
+	deleteCmd *command.DeleteAlertPolicyCommand,
+	getQuery *query.GetAlertPolicyQuery,
+	listQuery *query.ListAlertPoliciesQuery,
+	monitor *DecisionAlertMonitor,
+) *AlertPolicyService {
+	return &AlertPolicyService{
+		createCmd: createCmd,
+		updateCmd: updateCmd,
+		deleteCmd: deleteCmd,
+		getQuery:  getQuery,
+		listQuery: listQuery,
+		monitor:   monitor,
+	}
+}
+
+// CreateAlertPolicy creates a new alert policy.
+func (s *AlertPolicyService) CreateAlertPolicy(ctx context.Context, input *command.CreateAlertPolicyInput) (*model.AlertPolicy, error) {
+	policy, err := s.createCmd.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+
+	return policy, nil
+}
+
+// UpdateAlertPolicy updates an existing alert policy.
+func (s *AlertPolicyService) UpdateAlertPolicy(ctx context.Context, id uuid.UUID, input *command.UpdateAlertPolicyInput) (*model.AlertPolicy, error) {
+	policy, err := s.updateCmd.Execute(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+
+	return policy, nil
+}
+
+// DeleteAlertPolicy deletes an alert policy.
+func (s *AlertPolicyService) DeleteAlertPolicy(ctx context.Context, id uuid.UUID) error {
+	if err := s.deleteCmd.Execute(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx)
+
+	return nil
+}
+
+// GetAlertPolicy retrieves an alert policy by ID.
+func (s *AlertPolicyService) GetAlertPolicy(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error) {
+	return s.getQuery.Execute(ctx, id)
+}
+
+// ListAlertPolicies retrieves alert policies with filters.
+func (s *AlertPolicyService) ListAlertPolicies(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error) {
+	return s.listQuery.Execute(ctx, filter)
+}
+
+func (s *AlertPolicyService) invalidate(ctx context.Context) {
+	if s.monitor != nil {
+		s.monitor.Invalidate(ctx)
+	}
+}
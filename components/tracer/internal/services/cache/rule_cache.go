@@ -314,6 +314,16 @@ func deepCopy(src *CachedRule) *CachedRule {
 				st := *s.SubType
 				scopesCopy[i].SubType = &st
 			}
+
+			if s.Country != nil {
+				country := *s.Country
+				scopesCopy[i].Country = &country
+			}
+
+			if s.CounterpartyBankCode != nil {
+				bankCode := *s.CounterpartyBankCode
+				scopesCopy[i].CounterpartyBankCode = &bankCode
+			}
 		}
 
 		ruleCopy.Scopes = scopesCopy
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+//go:generate mockgen -source=alert_policy_repository.go -destination=alert_policy_repository_mock.go -package=command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// AlertPolicyRepository defines the interface for alert policy persistence in
+// commands. Separate from query.AlertPolicyRepository per CQRS.
+type AlertPolicyRepository interface {
+	// CreateWithTx persists a new policy using the provided database handle.
+	CreateWithTx(ctx context.Context, db pgdb.DB, policy *model.AlertPolicy) error
+	// GetByID reads the current policy before an update.
+	// Returns constant.ErrAlertPolicyNotFound if the policy does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error)
+	// UpdateWithTx persists the mutable fields of an existing policy.
+	// Returns constant.ErrAlertPolicyNotFound if no row matched.
+	UpdateWithTx(ctx context.Context, db pgdb.DB, policy *model.AlertPolicy) error
+	// DeleteWithTx removes a policy.
+	// Returns constant.ErrAlertPolicyNotFound if no row matched.
+	DeleteWithTx(ctx context.Context, db pgdb.DB, id uuid.UUID) error
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert_policy_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=alert_policy_repository.go -destination=alert_policy_repository_mock.go -package=command
+//
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlertPolicyRepository is a mock of AlertPolicyRepository interface.
+type MockAlertPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertPolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertPolicyRepositoryMockRecorder is the mock recorder for MockAlertPolicyRepository.
+type MockAlertPolicyRepositoryMockRecorder struct {
+	mock *MockAlertPolicyRepository
+}
+
+// NewMockAlertPolicyRepository creates a new mock instance.
+func NewMockAlertPolicyRepository(ctrl *gomock.Controller) *MockAlertPolicyRepository {
+	mock := &MockAlertPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockAlertPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertPolicyRepository) EXPECT() *MockAlertPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWithTx mocks base method.
+func (m *MockAlertPolicyRepository) CreateWithTx(ctx context.Context, arg1 db.DB, policy *model.AlertPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithTx", ctx, arg1, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithTx indicates an expected call of CreateWithTx.
+func (mr *MockAlertPolicyRepositoryMockRecorder) CreateWithTx(ctx, arg1, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithTx", reflect.TypeOf((*MockAlertPolicyRepository)(nil).CreateWithTx), ctx, arg1, policy)
+}
+
+// DeleteWithTx mocks base method.
+func (m *MockAlertPolicyRepository) DeleteWithTx(ctx context.Context, arg1 db.DB, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithTx", ctx, arg1, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithTx indicates an expected call of DeleteWithTx.
+func (mr *MockAlertPolicyRepositoryMockRecorder) DeleteWithTx(ctx, arg1, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithTx", reflect.TypeOf((*MockAlertPolicyRepository)(nil).DeleteWithTx), ctx, arg1, id)
+}
+
+// GetByID mocks base method.
+func (m *MockAlertPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAlertPolicyRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAlertPolicyRepository)(nil).GetByID), ctx, id)
+}
+
+// UpdateWithTx mocks base method.
+func (m *MockAlertPolicyRepository) UpdateWithTx(ctx context.Context, arg1 db.DB, policy *model.AlertPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithTx", ctx, arg1, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithTx indicates an expected call of UpdateWithTx.
+func (mr *MockAlertPolicyRepositoryMockRecorder) UpdateWithTx(ctx, arg1, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithTx", reflect.TypeOf((*MockAlertPolicyRepository)(nil).UpdateWithTx), ctx, arg1, policy)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pgdbMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newTestAlertPolicy(t *testing.T) *model.AlertPolicy {
+	t.Helper()
+
+	ruleID := testutil.MustDeterministicUUID(7)
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:          "Rule deny spike",
+		RuleID:        &ruleID,
+		Decision:      model.DecisionDeny,
+		Threshold:     0.4,
+		WindowSeconds: 300,
+	}, testutil.FixedTime())
+	require.NoError(t, err)
+
+	return policy
+}
+
+func TestNewAlertPolicyCommands_NilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	clk := testutil.NewDefaultMockClock()
+
+	_, err := NewCreateAlertPolicyCommand(nil, clk, txBeginner)
+	require.ErrorIs(t, err, ErrNilCreateAlertPolicyRepository)
+
+	_, err = NewCreateAlertPolicyCommand(repo, nil, txBeginner)
+	require.ErrorIs(t, err, ErrNilCreateAlertPolicyClock)
+
+	_, err = NewCreateAlertPolicyCommand(repo, clk, nil)
+	require.ErrorIs(t, err, ErrNilCreateAlertPolicyTxBeginner)
+
+	_, err = NewUpdateAlertPolicyCommand(nil, clk, txBeginner)
+	require.ErrorIs(t, err, ErrNilUpdateAlertPolicyRepository)
+
+	_, err = NewUpdateAlertPolicyCommand(repo, nil, txBeginner)
+	require.ErrorIs(t, err, ErrNilUpdateAlertPolicyClock)
+
+	_, err = NewUpdateAlertPolicyCommand(repo, clk, nil)
+	require.ErrorIs(t, err, ErrNilUpdateAlertPolicyTxBeginner)
+
+	_, err = NewDeleteAlertPolicyCommand(nil, txBeginner)
+	require.ErrorIs(t, err, ErrNilDeleteAlertPolicyRepository)
+
+	_, err = NewDeleteAlertPolicyCommand(repo, nil)
+	require.ErrorIs(t, err, ErrNilDeleteAlertPolicyTxBeginner)
+}
+
+func TestCreateAlertPolicy_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	gomock.InOrder(
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().CreateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(nil),
+		mockTx.EXPECT().Commit().Return(nil),
+	)
+
+	cmd, err := NewCreateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	ruleID := testutil.MustDeterministicUUID(3)
+
+	policy, err := cmd.Execute(context.Background(), &CreateAlertPolicyInput{
+		Name:           "New rule guard",
+		RuleID:         &ruleID,
+		Decision:       model.DecisionDeny,
+		Threshold:      0.3,
+		WindowSeconds:  600,
+		AutoDeactivate: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.AlertPolicyStatusActive, policy.Status)
+	assert.Equal(t, model.DefaultAlertMinSamples, policy.MinSamples)
+	assert.True(t, policy.AutoDeactivate)
+}
+
+func TestCreateAlertPolicy_InvalidInput_NoTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	cmd, err := NewCreateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), &CreateAlertPolicyInput{
+		Name:           "Decision guard",
+		Decision:       model.DecisionDeny,
+		Threshold:      0.3,
+		WindowSeconds:  600,
+		AutoDeactivate: true,
+	})
+	require.ErrorIs(t, err, constant.ErrAlertPolicyAutoDeactivateRequiresRule)
+
+	_, err = cmd.Execute(context.Background(), nil)
+	require.ErrorIs(t, err, constant.ErrAlertPolicyInvalidName)
+}
+
+func TestCreateAlertPolicy_RepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	dbErr := errors.New("connection reset")
+
+	gomock.InOrder(
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().CreateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(dbErr),
+		mockTx.EXPECT().Rollback().Return(nil),
+	)
+
+	cmd, err := NewCreateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), &CreateAlertPolicyInput{
+		Name:          "Decision guard",
+		Decision:      model.DecisionReview,
+		Threshold:     0.5,
+		WindowSeconds: 60,
+	})
+	require.ErrorIs(t, err, dbErr)
+}
+
+func TestUpdateAlertPolicy_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	existing := newTestAlertPolicy(t)
+
+	gomock.InOrder(
+		repo.EXPECT().GetByID(gomock.Any(), existing.ID).Return(existing, nil),
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().UpdateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(nil),
+		mockTx.EXPECT().Commit().Return(nil),
+	)
+
+	cmd, err := NewUpdateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	inactive := model.AlertPolicyStatusInactive
+
+	policy, err := cmd.Execute(context.Background(), existing.ID, &UpdateAlertPolicyInput{
+		Threshold: testutil.Ptr(0.6),
+		Status:    &inactive,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6, policy.Threshold, 1e-9)
+	assert.False(t, policy.IsActive())
+}
+
+func TestUpdateAlertPolicy_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	id := uuid.New()
+	repo.EXPECT().GetByID(gomock.Any(), id).Return(nil, constant.ErrAlertPolicyNotFound)
+
+	cmd, err := NewUpdateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), id, &UpdateAlertPolicyInput{Threshold: testutil.Ptr(0.6)})
+	require.ErrorIs(t, err, constant.ErrAlertPolicyNotFound)
+}
+
+func TestUpdateAlertPolicy_InvalidPatch_NoTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockAlertPolicyRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	existing := newTestAlertPolicy(t)
+	repo.EXPECT().GetByID(gomock.Any(), existing.ID).Return(existing, nil)
+
+	cmd, err := NewUpdateAlertPolicyCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), existing.ID, &UpdateAlertPolicyInput{WindowSeconds: testutil.Ptr(10)})
+	require.ErrorIs(t, err, constant.ErrAlertPolicyInvalidWindow)
+}
+
+func TestDeleteAlertPolicy(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "deleted"},
+		{name: "not found", repoErr: constant.ErrAlertPolicyNotFound, wantErr: constant.ErrAlertPolicyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockAlertPolicyRepository(ctrl)
+			txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+			mockTx := pgdbMocks.NewMockTx(ctrl)
+
+			txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil)
+			repo.EXPECT().DeleteWithTx(gomock.Any(), mockTx, id).Return(tt.repoErr)
+
+			if tt.repoErr == nil {
+				mockTx.EXPECT().Commit().Return(nil)
+			} else {
+				mockTx.EXPECT().Rollback().Return(nil)
+			}
+
+			cmd, err := NewDeleteAlertPolicyCommand(repo, txBeginner)
+			require.NoError(t, err)
+
+			err = cmd.Execute(context.Background(), id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewCreateAlertPolicyCommand.
+var (
+	// ErrNilCreateAlertPolicyRepository is returned when a nil
+	// AlertPolicyRepository is passed to NewCreateAlertPolicyCommand.
+	ErrNilCreateAlertPolicyRepository = errors.New("create alert policy repository is nil")
+	// ErrNilCreateAlertPolicyClock is returned when a nil clock is passed to
+	// NewCreateAlertPolicyCommand.
+	ErrNilCreateAlertPolicyClock = errors.New("create alert policy clock is nil")
+	// ErrNilCreateAlertPolicyTxBeginner is returned when a nil TxBeginner is
+	// passed to NewCreateAlertPolicyCommand.
+	ErrNilCreateAlertPolicyTxBeginner = errors.New("create alert policy tx beginner is nil")
+)
+
+// CreateAlertPolicyInput represents the input for creating an alert policy.
+// A zero MinSamples defaults to model.DefaultAlertMinSamples.
+type CreateAlertPolicyInput struct {
+	Name           string
+	Description    *string
+	RuleID         *uuid.UUID
+	Scope          *model.Scope
+	Decision       model.Decision
+	Threshold      float64
+	WindowSeconds  int
+	MinSamples     int
+	AutoDeactivate bool
+}
+
+// CreateAlertPolicyCommand creates a new alert policy. New policies start
+// ACTIVE and are picked up by the decision alert monitor on its next reload.
+type CreateAlertPolicyCommand struct {
+	repo       AlertPolicyRepository
+	clock      clock.Clock
+	txBeginner pgdb.TxBeginner
+}
+
+// NewCreateAlertPolicyCommand creates a new CreateAlertPolicyCommand.
+// Returns an error if any dependency is nil.
+func NewCreateAlertPolicyCommand(repo AlertPolicyRepository, clk clock.Clock, txBeginner pgdb.TxBeginner) (*CreateAlertPolicyCommand, error) {
+	if repo == nil {
+		return nil, ErrNilCreateAlertPolicyRepository
+	}
+
+	if clk == nil {
+		return nil, ErrNilCreateAlertPolicyClock
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilCreateAlertPolicyTxBeginner
+	}
+
+	return &CreateAlertPolicyCommand{
+		repo:       repo,
+		clock:      clk,
+		txBeginner: txBeginner,
+	}, nil
+}
+
+// Execute validates the input and persists the new policy.
+func (c *CreateAlertPolicyCommand) Execute(ctx context.Context, input *CreateAlertPolicyInput) (_ *model.AlertPolicy, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.alert_policy.create")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "alert_policy_create", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	if input == nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Nil input provided", constant.ErrAlertPolicyInvalidName)
+		return nil, constant.ErrAlertPolicyInvalidName
+	}
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:           input.Name,
+		Description:    input.Description,
+		RuleID:         input.RuleID,
+		Scope:          input.Scope,
+		Decision:       input.Decision,
+		Threshold:      input.Threshold,
+		WindowSeconds:  input.WindowSeconds,
+		MinSamples:     input.MinSamples,
+		AutoDeactivate: input.AutoDeactivate,
+	}, c.clock.Now())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid alert policy input", err)
+		logger.With(
+			libLog.String("operation", "service.alert_policy.create"),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Invalid alert policy input")
+
+		return nil, err
+	}
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		return c.repo.CreateWithTx(ctx, db, policy)
+	})
+	if txErr != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to create alert policy", txErr)
+		logger.With(
+			libLog.String("operation", "service.alert_policy.create"),
+			libLog.String("alert_policy.id", policy.ID.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to create alert policy")
+
+		return nil, fmt.Errorf("failed to create alert policy: %w", txErr)
+	}
+
+	logger.With(
+		libLog.String("operation", "service.alert_policy.create"),
+		libLog.String("alert_policy.id", policy.ID.String()),
+		libLog.String("alert_policy.decision", policy.Decision.String()),
+	).Log(ctx, libLog.LevelInfo, "Alert policy created")
+
+	return policy, nil
+}
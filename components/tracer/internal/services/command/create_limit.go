@@ -85,6 +85,11 @@ type CreateLimitCommand struct {
 	// events; nil disables emission and never fails the request. Set
 	// post-construction at bootstrap.
 	Streaming libStreaming.Emitter
+
+	// Catalog rejects scopes whose transaction type is not registered and
+	// ACTIVE in the tenant's transaction-type catalog. optional; nil skips the
+	// check. Set post-construction at bootstrap.
+	Catalog TransactionTypeChecker
 }
 
 // NewCreateLimitCommand creates a new CreateLimitCommand with dependencies.
@@ -253,6 +258,11 @@ func (c *CreateLimitCommand) Execute(ctx context.Context, input *CreateLimitInpu
 		return nil, err
 	}
 
+	if err := checkScopeTransactionTypes(ctx, c.Catalog, limit.Scopes); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type rejected by catalog", err)
+		return nil, err
+	}
+
 	// Check for context cancellation before repository call
 	if err := ctx.Err(); err != nil {
 		libOpentelemetry.HandleSpanError(span, "Context canceled before persist", err)
@@ -99,6 +99,11 @@ type CreateRuleCommand struct {
 	// substitute a mock or noop emitter without a broker. optional; nil disables
 	// emission and never fails the request. Set post-construction at bootstrap.
 	Streaming libStreaming.Emitter
+
+	// Catalog rejects scopes whose transaction type is not registered and
+	// ACTIVE in the tenant's transaction-type catalog. optional; nil skips the
+	// check. Set post-construction at bootstrap.
+	Catalog TransactionTypeChecker
 }
 
 // NewCreateRuleCommand creates a new CreateRuleCommand instance.
@@ -136,6 +141,14 @@ func NewCreateRuleCommand(repo RuleRepository, cel ExpressionCompiler, clk clock
 	}, nil
 }
 
+// ValidateExpression compiles expression the way Execute does, without
+// creating a rule.
+func (c *CreateRuleCommand) ValidateExpression(ctx context.Context, expression string) error {
+	_, err := c.cel.Compile(ctx, expression)
+
+	return err
+}
+
 // Execute creates a new rule with validation.
 //
 // The rule insert and the corresponding audit event are persisted atomically
@@ -197,6 +210,11 @@ func (c *CreateRuleCommand) Execute(ctx context.Context, input *CreateRuleInput)
 		return nil, err
 	}
 
+	if err := checkScopeTransactionTypes(ctx, c.Catalog, rule.Scopes); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type rejected by catalog", err)
+		return nil, err
+	}
+
 	// 3. Persist rule insert + audit event atomically. Audit failures roll the
 	// rule insert back so a successful Execute always implies a successful
 	// audit record.
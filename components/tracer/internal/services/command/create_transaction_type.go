@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewCreateTransactionTypeCommand.
+var (
+	// ErrNilCreateTransactionTypeRepository is returned when a nil
+	// TransactionTypeRepository is passed to NewCreateTransactionTypeCommand.
+	ErrNilCreateTransactionTypeRepository = errors.New("create transaction type repository is nil")
+	// ErrNilCreateTransactionTypeClock is returned when a nil clock is passed
+	// to NewCreateTransactionTypeCommand.
+	ErrNilCreateTransactionTypeClock = errors.New("create transaction type clock is nil")
+	// ErrNilCreateTransactionTypeTxBeginner is returned when a nil TxBeginner
+	// is passed to NewCreateTransactionTypeCommand.
+	ErrNilCreateTransactionTypeTxBeginner = errors.New("create transaction type tx beginner is nil")
+)
+
+// CreateTransactionTypeInput represents the input for registering a new
+// transaction type in the catalog.
+type CreateTransactionTypeInput struct {
+	Code        string
+	Description *string
+	SubTypes    []string
+}
+
+// CreateTransactionTypeCommand registers a new transaction type in the
+// tenant's catalog. New entries start ACTIVE.
+type CreateTransactionTypeCommand struct {
+	repo       TransactionTypeRepository
+	clock      clock.Clock
+	txBeginner pgdb.TxBeginner
+}
+
+// NewCreateTransactionTypeCommand creates a new CreateTransactionTypeCommand.
+// Returns an error if any dependency is nil.
+func NewCreateTransactionTypeCommand(repo TransactionTypeRepository, clk clock.Clock, txBeginner pgdb.TxBeginner) (*CreateTransactionTypeCommand, error) {
+	if repo == nil {
+		return nil, ErrNilCreateTransactionTypeRepository
+	}
+
+	if clk == nil {
+		return nil, ErrNilCreateTransactionTypeClock
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilCreateTransactionTypeTxBeginner
+	}
+
+	return &CreateTransactionTypeCommand{
+		repo:       repo,
+		clock:      clk,
+		txBeginner: txBeginner,
+	}, nil
+}
+
+// Execute validates the input and persists the new catalog entry.
+// Returns constant.ErrTransactionTypeAlreadyExists if the code is taken.
+func (c *CreateTransactionTypeCommand) Execute(ctx context.Context, input *CreateTransactionTypeInput) (_ *model.TransactionTypeDefinition, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.transaction_type.create")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "transaction_type_create", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	if input == nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Nil input provided", constant.ErrTransactionTypeInvalidCode)
+		return nil, constant.ErrTransactionTypeInvalidCode
+	}
+
+	def, err := model.NewTransactionTypeDefinition(input.Code, input.Description, input.SubTypes, c.clock.Now())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction type input", err)
+		logger.With(
+			libLog.String("operation", "service.transaction_type.create"),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Invalid transaction type input")
+
+		return nil, err
+	}
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		return c.repo.CreateWithTx(ctx, db, def)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, constant.ErrTransactionTypeAlreadyExists) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type already exists", txErr)
+			return nil, txErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to create transaction type", txErr)
+		logger.With(
+			libLog.String("operation", "service.transaction_type.create"),
+			libLog.String("transaction_type.code", def.Code.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to create transaction type")
+
+		return nil, fmt.Errorf("failed to create transaction type: %w", txErr)
+	}
+
+	logger.With(
+		libLog.String("operation", "service.transaction_type.create"),
+		libLog.String("transaction_type.code", def.Code.String()),
+	).Log(ctx, libLog.LevelInfo, "Transaction type registered")
+
+	return def, nil
+}
@@ -80,10 +80,21 @@ func NewDeactivateRuleService(repository RuleRepository, clk clock.Clock, auditW
 	}, nil
 }
 
+// deactivateRuleViaAPIReason is the audit reason recorded for deactivations
+// requested through the rules API.
+const deactivateRuleViaAPIReason = "Rule deactivated via API"
+
 // Execute deactivates a rule by updating status to INACTIVE.
 // Idempotent: if already INACTIVE, returns the rule without error.
 // Returns the updated rule for atomic deactivate-and-return pattern.
-func (s *DeactivateRuleService) Execute(ctx context.Context, ruleID uuid.UUID) (_ *model.Rule, retErr error) {
+func (s *DeactivateRuleService) Execute(ctx context.Context, ruleID uuid.UUID) (*model.Rule, error) {
+	return s.ExecuteWithReason(ctx, ruleID, deactivateRuleViaAPIReason)
+}
+
+// ExecuteWithReason behaves like Execute but records reason on the audit
+// event, so automated deactivations (e.g. an alert policy safeguard) remain
+// distinguishable from operator actions in the audit trail.
+func (s *DeactivateRuleService) ExecuteWithReason(ctx context.Context, ruleID uuid.UUID, reason string) (_ *model.Rule, retErr error) {
 	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
 
 	ctx, span := tracer.Start(ctx, "service.rule.deactivate")
@@ -211,7 +222,7 @@ func (s *DeactivateRuleService) Execute(ctx context.Context, ruleID uuid.UUID) (
 			rule.ID,
 			beforeState,
 			afterState,
-			"Rule deactivated via API",
+			reason,
 		); err != nil {
 			reportedInCallback = true
 
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewDeleteAlertPolicyCommand.
+var (
+	// ErrNilDeleteAlertPolicyRepository is returned when a nil
+	// AlertPolicyRepository is passed to NewDeleteAlertPolicyCommand.
+	ErrNilDeleteAlertPolicyRepository = errors.New("delete alert policy repository is nil")
+	// ErrNilDeleteAlertPolicyTxBeginner is returned when a nil TxBeginner is
+	// passed to NewDeleteAlertPolicyCommand.
+	ErrNilDeleteAlertPolicyTxBeginner = errors.New("delete alert policy tx beginner is nil")
+)
+
+// DeleteAlertPolicyCommand removes an alert policy.
+type DeleteAlertPolicyCommand struct {
+	repo       AlertPolicyRepository
+	txBeginner pgdb.TxBeginner
+}
+
+// NewDeleteAlertPolicyCommand creates a new DeleteAlertPolicyCommand.
+// Returns an error if any dependency is nil.
+func NewDeleteAlertPolicyCommand(repo AlertPolicyRepository, txBeginner pgdb.TxBeginner) (*DeleteAlertPolicyCommand, error) {
+	if repo == nil {
+		return nil, ErrNilDeleteAlertPolicyRepository
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilDeleteAlertPolicyTxBeginner
+	}
+
+	return &DeleteAlertPolicyCommand{
+		repo:       repo,
+		txBeginner: txBeginner,
+	}, nil
+}
+
+// Execute deletes the policy identified by id.
+// Returns constant.ErrAlertPolicyNotFound if the policy doesn't exist.
+func (c *DeleteAlertPolicyCommand) Execute(ctx context.Context, id uuid.UUID) (retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.alert_policy.delete")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "alert_policy_delete", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		return c.repo.DeleteWithTx(ctx, db, id)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, constant.ErrAlertPolicyNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Alert policy not found", txErr)
+			return txErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to delete alert policy", txErr)
+		logger.With(
+			libLog.String("operation", "service.alert_policy.delete"),
+			libLog.String("alert_policy.id", id.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to delete alert policy")
+
+		return fmt.Errorf("failed to delete alert policy: %w", txErr)
+	}
+
+	logger.With(
+		libLog.String("operation", "service.alert_policy.delete"),
+		libLog.String("alert_policy.id", id.String()),
+	).Log(ctx, libLog.LevelInfo, "Alert policy deleted")
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewLabelValidationOutcomeCommand.
+var (
+	// ErrNilLabelOutcomeRepository is returned when a nil
+	// ValidationOutcomeRepository is passed to NewLabelValidationOutcomeCommand.
+	ErrNilLabelOutcomeRepository = errors.New("label validation outcome repository is nil")
+	// ErrNilLabelOutcomeValidationReader is returned when a nil
+	// TransactionValidationReader is passed to NewLabelValidationOutcomeCommand.
+	ErrNilLabelOutcomeValidationReader = errors.New("label validation outcome validation reader is nil")
+	// ErrNilLabelOutcomeClock is returned when a nil clock is passed to
+	// NewLabelValidationOutcomeCommand.
+	ErrNilLabelOutcomeClock = errors.New("label validation outcome clock is nil")
+	// ErrNilLabelOutcomeTxBeginner is returned when a nil TxBeginner is passed
+	// to NewLabelValidationOutcomeCommand.
+	ErrNilLabelOutcomeTxBeginner = errors.New("label validation outcome tx beginner is nil")
+)
+
+// LabelValidationOutcomeInput represents the outcome reported for a validation.
+type LabelValidationOutcomeInput struct {
+	Label string
+	Note  *string
+}
+
+// LabelValidationOutcomeCommand attaches (or replaces) the outcome label of a
+// stored transaction validation. The validation itself is never modified.
+type LabelValidationOutcomeCommand struct {
+	repo        ValidationOutcomeRepository
+	validations TransactionValidationReader
+	clock       clock.Clock
+	txBeginner  pgdb.TxBeginner
+}
+
+// NewLabelValidationOutcomeCommand creates a new LabelValidationOutcomeCommand.
+// Returns an error if any dependency is nil.
+func NewLabelValidationOutcomeCommand(
+	repo ValidationOutcomeRepository,
+	validations TransactionValidationReader,
+	clk clock.Clock,
+	txBeginner pgdb.TxBeginner,
+) (*LabelValidationOutcomeCommand, error) {
+	if repo == nil {
+		return nil, ErrNilLabelOutcomeRepository
+	}
+
+	if validations == nil {
+		return nil, ErrNilLabelOutcomeValidationReader
+	}
+
+	if clk == nil {
+		return nil, ErrNilLabelOutcomeClock
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilLabelOutcomeTxBeginner
+	}
+
+	return &LabelValidationOutcomeCommand{
+		repo:        repo,
+		validations: validations,
+		clock:       clk,
+		txBeginner:  txBeginner,
+	}, nil
+}
+
+// Execute validates the label and stores it against the validation.
+// Returns constant.ErrTransactionValidationNotFound if the validation does not
+// exist, and constant.ErrInvalidOutcomeLabel / ErrOutcomeNoteTooLong for bad input.
+func (c *LabelValidationOutcomeCommand) Execute(ctx context.Context, validationID uuid.UUID, input *LabelValidationOutcomeInput) (_ *model.ValidationOutcome, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.validation_outcome.label")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "validation_outcome_label", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	if input == nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Nil input provided", constant.ErrInvalidOutcomeLabel)
+		return nil, constant.ErrInvalidOutcomeLabel
+	}
+
+	outcome, err := model.NewValidationOutcome(validationID, input.Label, input.Note, c.clock.Now())
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid outcome input", err)
+		return nil, err
+	}
+
+	if _, err := c.validations.GetByID(ctx, validationID); err != nil {
+		if errors.Is(err, constant.ErrTransactionValidationNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction validation not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to load transaction validation", err)
+
+		return nil, fmt.Errorf("failed to load transaction validation: %w", err)
+	}
+
+	var stored *model.ValidationOutcome
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		var upsertErr error
+
+		stored, upsertErr = c.repo.UpsertWithTx(ctx, db, outcome)
+
+		return upsertErr
+	})
+	if txErr != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to store validation outcome", txErr)
+		logger.With(
+			libLog.String("operation", "service.validation_outcome.label"),
+			libLog.String("validation.id", validationID.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to store validation outcome")
+
+		return nil, fmt.Errorf("failed to store validation outcome: %w", txErr)
+	}
+
+	logger.With(
+		libLog.String("operation", "service.validation_outcome.label"),
+		libLog.String("validation.id", validationID.String()),
+		libLog.String("outcome.label", stored.Label.String()),
+	).Log(ctx, libLog.LevelInfo, "Validation outcome recorded")
+
+	return stored, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pgdbMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func TestNewLabelValidationOutcomeCommand_NilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockValidationOutcomeRepository(ctrl)
+	reader := NewMockTransactionValidationReader(ctrl)
+	clk := testutil.NewDefaultMockClock()
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	_, err := NewLabelValidationOutcomeCommand(nil, reader, clk, txBeginner)
+	require.ErrorIs(t, err, ErrNilLabelOutcomeRepository)
+
+	_, err = NewLabelValidationOutcomeCommand(repo, nil, clk, txBeginner)
+	require.ErrorIs(t, err, ErrNilLabelOutcomeValidationReader)
+
+	_, err = NewLabelValidationOutcomeCommand(repo, reader, nil, txBeginner)
+	require.ErrorIs(t, err, ErrNilLabelOutcomeClock)
+
+	_, err = NewLabelValidationOutcomeCommand(repo, reader, clk, nil)
+	require.ErrorIs(t, err, ErrNilLabelOutcomeTxBeginner)
+}
+
+func TestLabelValidationOutcome_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockValidationOutcomeRepository(ctrl)
+	reader := NewMockTransactionValidationReader(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	validationID := testutil.MustDeterministicUUID(1)
+
+	gomock.InOrder(
+		reader.EXPECT().GetByID(gomock.Any(), validationID).Return(&model.TransactionValidation{ID: validationID}, nil),
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().UpsertWithTx(gomock.Any(), mockTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ any, o *model.ValidationOutcome) (*model.ValidationOutcome, error) {
+				return o, nil
+			}),
+		mockTx.EXPECT().Commit().Return(nil),
+	)
+
+	cmd, err := NewLabelValidationOutcomeCommand(repo, reader, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	outcome, err := cmd.Execute(context.Background(), validationID, &LabelValidationOutcomeInput{Label: "confirmed_fraud"})
+	require.NoError(t, err)
+	assert.Equal(t, validationID, outcome.ValidationID)
+	assert.Equal(t, model.OutcomeLabelConfirmedFraud, outcome.Label)
+}
+
+func TestLabelValidationOutcome_ValidationNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockValidationOutcomeRepository(ctrl)
+	reader := NewMockTransactionValidationReader(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	validationID := testutil.MustDeterministicUUID(2)
+
+	reader.EXPECT().GetByID(gomock.Any(), validationID).Return(nil, constant.ErrTransactionValidationNotFound)
+
+	cmd, err := NewLabelValidationOutcomeCommand(repo, reader, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), validationID, &LabelValidationOutcomeInput{Label: "CHARGEBACK"})
+	require.ErrorIs(t, err, constant.ErrTransactionValidationNotFound)
+}
+
+func TestLabelValidationOutcome_InvalidLabel_NoLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cmd, err := NewLabelValidationOutcomeCommand(
+		NewMockValidationOutcomeRepository(ctrl),
+		NewMockTransactionValidationReader(ctrl),
+		testutil.NewDefaultMockClock(),
+		pgdbMocks.NewMockTxBeginner(ctrl),
+	)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), testutil.MustDeterministicUUID(3), &LabelValidationOutcomeInput{Label: "SUSPICIOUS"})
+	require.ErrorIs(t, err, constant.ErrInvalidOutcomeLabel)
+
+	_, err = cmd.Execute(context.Background(), testutil.MustDeterministicUUID(3), nil)
+	require.ErrorIs(t, err, constant.ErrInvalidOutcomeLabel)
+}
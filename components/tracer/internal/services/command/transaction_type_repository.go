@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+//go:generate mockgen -source=transaction_type_repository.go -destination=transaction_type_repository_mock.go -package=command
+
+import (
+	"context"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// TransactionTypeRepository defines the interface for transaction-type catalog
+// persistence in commands. Separate from query.TransactionTypeRepository per CQRS.
+type TransactionTypeRepository interface {
+	// CreateWithTx persists a new catalog entry using the provided database handle.
+	// Returns constant.ErrTransactionTypeAlreadyExists if the code is taken.
+	CreateWithTx(ctx context.Context, db pgdb.DB, def *model.TransactionTypeDefinition) error
+	// GetByCode reads the current entry before an update.
+	// Returns constant.ErrTransactionTypeNotFound if the entry does not exist.
+	GetByCode(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error)
+	// UpdateWithTx persists the mutable fields of an existing entry.
+	UpdateWithTx(ctx context.Context, db pgdb.DB, def *model.TransactionTypeDefinition) error
+}
+
+// TransactionTypeChecker verifies a transaction type (and optional subtype)
+// against the tenant's transaction-type catalog. Implemented by
+// query.TransactionTypeCatalog.
+type TransactionTypeChecker interface {
+	Check(ctx context.Context, txType model.TransactionType, subType *string) error
+}
+
+// checkScopeTransactionTypes runs every scope that pins a transaction type
+// through the catalog. A nil checker disables the check, which keeps commands
+// usable in tests and tools that do not wire the catalog.
+func checkScopeTransactionTypes(ctx context.Context, checker TransactionTypeChecker, scopes []model.Scope) error {
+	if checker == nil {
+		return nil
+	}
+
+	for i := range scopes {
+		if scopes[i].TransactionType == nil {
+			continue
+		}
+
+		if err := checker.Check(ctx, *scopes[i].TransactionType, scopes[i].SubType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transaction_type_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=transaction_type_repository.go -destination=transaction_type_repository_mock.go -package=command
+//
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionTypeRepository is a mock of TransactionTypeRepository interface.
+type MockTransactionTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionTypeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionTypeRepositoryMockRecorder is the mock recorder for MockTransactionTypeRepository.
+type MockTransactionTypeRepositoryMockRecorder struct {
+	mock *MockTransactionTypeRepository
+}
+
+// NewMockTransactionTypeRepository creates a new mock instance.
+func NewMockTransactionTypeRepository(ctrl *gomock.Controller) *MockTransactionTypeRepository {
+	mock := &MockTransactionTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionTypeRepository) EXPECT() *MockTransactionTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWithTx mocks base method.
+func (m *MockTransactionTypeRepository) CreateWithTx(ctx context.Context, arg1 db.DB, def *model.TransactionTypeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithTx", ctx, arg1, def)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithTx indicates an expected call of CreateWithTx.
+func (mr *MockTransactionTypeRepositoryMockRecorder) CreateWithTx(ctx, arg1, def any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithTx", reflect.TypeOf((*MockTransactionTypeRepository)(nil).CreateWithTx), ctx, arg1, def)
+}
+
+// GetByCode mocks base method.
+func (m *MockTransactionTypeRepository) GetByCode(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockTransactionTypeRepositoryMockRecorder) GetByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockTransactionTypeRepository)(nil).GetByCode), ctx, code)
+}
+
+// UpdateWithTx mocks base method.
+func (m *MockTransactionTypeRepository) UpdateWithTx(ctx context.Context, arg1 db.DB, def *model.TransactionTypeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithTx", ctx, arg1, def)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithTx indicates an expected call of UpdateWithTx.
+func (mr *MockTransactionTypeRepositoryMockRecorder) UpdateWithTx(ctx, arg1, def any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithTx", reflect.TypeOf((*MockTransactionTypeRepository)(nil).UpdateWithTx), ctx, arg1, def)
+}
+
+// MockTransactionTypeChecker is a mock of TransactionTypeChecker interface.
+type MockTransactionTypeChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionTypeCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionTypeCheckerMockRecorder is the mock recorder for MockTransactionTypeChecker.
+type MockTransactionTypeCheckerMockRecorder struct {
+	mock *MockTransactionTypeChecker
+}
+
+// NewMockTransactionTypeChecker creates a new mock instance.
+func NewMockTransactionTypeChecker(ctrl *gomock.Controller) *MockTransactionTypeChecker {
+	mock := &MockTransactionTypeChecker{ctrl: ctrl}
+	mock.recorder = &MockTransactionTypeCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionTypeChecker) EXPECT() *MockTransactionTypeCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockTransactionTypeChecker) Check(ctx context.Context, txType model.TransactionType, subType *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, txType, subType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockTransactionTypeCheckerMockRecorder) Check(ctx, txType, subType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockTransactionTypeChecker)(nil).Check), ctx, txType, subType)
+}
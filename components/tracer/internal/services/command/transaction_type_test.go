@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pgdbMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func TestNewCreateTransactionTypeCommand_NilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	_, err := NewCreateTransactionTypeCommand(nil, testutil.NewDefaultMockClock(), txBeginner)
+	require.ErrorIs(t, err, ErrNilCreateTransactionTypeRepository)
+
+	_, err = NewCreateTransactionTypeCommand(repo, nil, txBeginner)
+	require.ErrorIs(t, err, ErrNilCreateTransactionTypeClock)
+
+	_, err = NewCreateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), nil)
+	require.ErrorIs(t, err, ErrNilCreateTransactionTypeTxBeginner)
+}
+
+func TestCreateTransactionType_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	gomock.InOrder(
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().CreateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(nil),
+		mockTx.EXPECT().Commit().Return(nil),
+	)
+
+	cmd, err := NewCreateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	def, err := cmd.Execute(context.Background(), &CreateTransactionTypeInput{
+		Code:     "boleto",
+		SubTypes: []string{"Registered"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.TransactionType("BOLETO"), def.Code)
+	assert.Equal(t, []string{"registered"}, def.SubTypes)
+	assert.Equal(t, model.TransactionTypeStatusActive, def.Status)
+	assert.False(t, def.Builtin)
+}
+
+func TestCreateTransactionType_AlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	gomock.InOrder(
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().CreateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(constant.ErrTransactionTypeAlreadyExists),
+		mockTx.EXPECT().Rollback().Return(nil),
+	)
+
+	cmd, err := NewCreateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), &CreateTransactionTypeInput{Code: "CARD"})
+	require.ErrorIs(t, err, constant.ErrTransactionTypeAlreadyExists)
+}
+
+func TestCreateTransactionType_InvalidCode_NoTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	cmd, err := NewCreateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), &CreateTransactionTypeInput{Code: "9LIVES"})
+	require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidCode)
+
+	_, err = cmd.Execute(context.Background(), nil)
+	require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidCode)
+}
+
+func TestUpdateTransactionType_Deactivate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	mockTx := pgdbMocks.NewMockTx(ctrl)
+
+	existing, err := model.NewTransactionTypeDefinition("BOLETO", nil, nil, testutil.FixedTime())
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		repo.EXPECT().GetByCode(gomock.Any(), model.TransactionType("BOLETO")).Return(existing, nil),
+		txBeginner.EXPECT().BeginTx(gomock.Any(), nil).Return(mockTx, nil),
+		repo.EXPECT().UpdateWithTx(gomock.Any(), mockTx, gomock.Any()).Return(nil),
+		mockTx.EXPECT().Commit().Return(nil),
+	)
+
+	cmd, err := NewUpdateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	inactive := model.TransactionTypeStatusInactive
+
+	def, err := cmd.Execute(context.Background(), "BOLETO", &UpdateTransactionTypeInput{Status: &inactive})
+	require.NoError(t, err)
+	assert.Equal(t, model.TransactionTypeStatusInactive, def.Status)
+}
+
+func TestUpdateTransactionType_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+
+	repo.EXPECT().GetByCode(gomock.Any(), model.TransactionType("ACH")).Return(nil, constant.ErrTransactionTypeNotFound)
+
+	cmd, err := NewUpdateTransactionTypeCommand(repo, testutil.NewDefaultMockClock(), txBeginner)
+	require.NoError(t, err)
+
+	_, err = cmd.Execute(context.Background(), "ACH", &UpdateTransactionTypeInput{Description: testutil.StringPtr("x")})
+	require.ErrorIs(t, err, constant.ErrTransactionTypeNotFound)
+}
+
+func TestCheckScopeTransactionTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	checker := NewMockTransactionTypeChecker(ctrl)
+	ctx := context.Background()
+
+	boleto := model.TransactionType("BOLETO")
+	scopes := []model.Scope{
+		{AccountID: testutil.UUIDPtr(testutil.MustDeterministicUUID(1))},
+		{TransactionType: &boleto, SubType: testutil.StringPtr("express")},
+	}
+
+	require.NoError(t, checkScopeTransactionTypes(ctx, nil, scopes))
+
+	checker.EXPECT().Check(ctx, boleto, scopes[1].SubType).Return(constant.ErrTransactionTypeSubTypeNotAllowed)
+	require.ErrorIs(t, checkScopeTransactionTypes(ctx, checker, scopes), constant.ErrTransactionTypeSubTypeNotAllowed)
+}
+
+// TestCreateLimit_CatalogRejectsScope verifies that a limit scoped to an
+// unregistered transaction type is rejected before any transaction is opened.
+func TestCreateLimit_CatalogRejectsScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := NewMockLimitRepository(ctrl)
+	auditWriter := NewMockAuditWriter(ctrl)
+	txBeginner := pgdbMocks.NewMockTxBeginner(ctrl)
+	checker := NewMockTransactionTypeChecker(ctrl)
+
+	cmd, err := NewCreateLimitCommand(mockRepo, testutil.NewDefaultMockClock(), auditWriter, txBeginner)
+	require.NoError(t, err)
+
+	cmd.Catalog = checker
+
+	cash := model.TransactionType("CASH")
+	checker.EXPECT().Check(gomock.Any(), cash, nil).Return(constant.ErrTransactionTypeNotRegistered)
+
+	_, err = cmd.Execute(context.Background(), &CreateLimitInput{
+		Name:      "Cash limit",
+		LimitType: model.LimitTypeDaily,
+		MaxAmount: decimal.RequireFromString("100"),
+		Currency:  "USD",
+		Scopes:    []model.Scope{{TransactionType: &cash}},
+	})
+	require.ErrorIs(t, err, constant.ErrTransactionTypeNotRegistered)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewUpdateAlertPolicyCommand.
+var (
+	// ErrNilUpdateAlertPolicyRepository is returned when a nil
+	// AlertPolicyRepository is passed to NewUpdateAlertPolicyCommand.
+	ErrNilUpdateAlertPolicyRepository = errors.New("update alert policy repository is nil")
+	// ErrNilUpdateAlertPolicyClock is returned when a nil clock is passed to
+	// NewUpdateAlertPolicyCommand.
+	ErrNilUpdateAlertPolicyClock = errors.New("update alert policy clock is nil")
+	// ErrNilUpdateAlertPolicyTxBeginner is returned when a nil TxBeginner is
+	// passed to NewUpdateAlertPolicyCommand.
+	ErrNilUpdateAlertPolicyTxBeginner = errors.New("update alert policy tx beginner is nil")
+)
+
+// UpdateAlertPolicyInput represents a partial update of an alert policy.
+// All fields are optional; nil keeps the current value. ClearScope removes the
+// scope. The monitored rule cannot be changed: create a new policy instead.
+type UpdateAlertPolicyInput struct {
+	Name           *string
+	Description    *string
+	Scope          *model.Scope
+	ClearScope     bool
+	Decision       *model.Decision
+	Threshold      *float64
+	WindowSeconds  *int
+	MinSamples     *int
+	AutoDeactivate *bool
+	Status         *model.AlertPolicyStatus
+}
+
+// UpdateAlertPolicyCommand updates an alert policy. Any change resets the
+// policy's in-memory window in the monitor, since the counters would no longer
+// describe the new definition.
+type UpdateAlertPolicyCommand struct {
+	repo       AlertPolicyRepository
+	clock      clock.Clock
+	txBeginner pgdb.TxBeginner
+}
+
+// NewUpdateAlertPolicyCommand creates a new UpdateAlertPolicyCommand.
+// Returns an error if any dependency is nil.
+func NewUpdateAlertPolicyCommand(repo AlertPolicyRepository, clk clock.Clock, txBeginner pgdb.TxBeginner) (*UpdateAlertPolicyCommand, error) {
+	if repo == nil {
+		return nil, ErrNilUpdateAlertPolicyRepository
+	}
+
+	if clk == nil {
+		return nil, ErrNilUpdateAlertPolicyClock
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilUpdateAlertPolicyTxBeginner
+	}
+
+	return &UpdateAlertPolicyCommand{
+		repo:       repo,
+		clock:      clk,
+		txBeginner: txBeginner,
+	}, nil
+}
+
+// Execute applies the partial update to the policy identified by id.
+// Returns constant.ErrAlertPolicyNotFound if the policy doesn't exist.
+func (c *UpdateAlertPolicyCommand) Execute(ctx context.Context, id uuid.UUID, input *UpdateAlertPolicyInput) (_ *model.AlertPolicy, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.alert_policy.update")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "alert_policy_update", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	policy, err := c.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constant.ErrAlertPolicyNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Alert policy not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get alert policy", err)
+
+		return nil, fmt.Errorf("failed to get alert policy: %w", err)
+	}
+
+	if input == nil {
+		return policy, nil
+	}
+
+	if err := policy.Update(model.AlertPolicyPatch{
+		Name:           input.Name,
+		Description:    input.Description,
+		Scope:          input.Scope,
+		ClearScope:     input.ClearScope,
+		Decision:       input.Decision,
+		Threshold:      input.Threshold,
+		WindowSeconds:  input.WindowSeconds,
+		MinSamples:     input.MinSamples,
+		AutoDeactivate: input.AutoDeactivate,
+		Status:         input.Status,
+	}, c.clock.Now()); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid alert policy update", err)
+		logger.With(
+			libLog.String("operation", "service.alert_policy.update"),
+			libLog.String("alert_policy.id", id.String()),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Invalid alert policy update")
+
+		return nil, err
+	}
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		return c.repo.UpdateWithTx(ctx, db, policy)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, constant.ErrAlertPolicyNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Alert policy not found", txErr)
+			return nil, txErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to update alert policy", txErr)
+		logger.With(
+			libLog.String("operation", "service.alert_policy.update"),
+			libLog.String("alert_policy.id", id.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to update alert policy")
+
+		return nil, fmt.Errorf("failed to update alert policy: %w", txErr)
+	}
+
+	return policy, nil
+}
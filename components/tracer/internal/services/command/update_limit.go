@@ -66,6 +66,11 @@ type UpdateLimitCommand struct {
 	// events; nil disables emission and never fails the request. Set
 	// post-construction at bootstrap.
 	Streaming libStreaming.Emitter
+
+	// Catalog rejects scopes whose transaction type is not registered and
+	// ACTIVE in the tenant's transaction-type catalog. optional; nil skips the
+	// check. Set post-construction at bootstrap.
+	Catalog TransactionTypeChecker
 }
 
 // NewUpdateLimitCommand creates a new UpdateLimitCommand with dependencies.
@@ -207,6 +212,13 @@ func (c *UpdateLimitCommand) Execute(ctx context.Context, id uuid.UUID, input *U
 		return nil, err
 	}
 
+	if normalizedInput.Scopes != nil {
+		if err := checkScopeTransactionTypes(ctx, c.Catalog, limit.Scopes); err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type rejected by catalog", err)
+			return nil, err
+		}
+	}
+
 	if ctx.Err() != nil {
 		libOpentelemetry.HandleSpanError(span, "Context cancelled", ctx.Err())
 		logger.With(
@@ -80,6 +80,11 @@ type UpdateRuleCommand struct {
 	// events; nil disables emission and never fails the request. Set
 	// post-construction at bootstrap.
 	Streaming libStreaming.Emitter
+
+	// Catalog rejects scopes whose transaction type is not registered and
+	// ACTIVE in the tenant's transaction-type catalog. optional; nil skips the
+	// check. Set post-construction at bootstrap.
+	Catalog TransactionTypeChecker
 }
 
 // NewUpdateRuleCommand creates a new UpdateRuleCommand instance.
@@ -202,6 +207,13 @@ func (c *UpdateRuleCommand) Execute(ctx context.Context, id uuid.UUID, input *Up
 		return nil, err
 	}
 
+	if input.Scopes != nil {
+		if err := checkScopeTransactionTypes(ctx, c.Catalog, rule.Scopes); err != nil {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Scope transaction type rejected by catalog", err)
+			return nil, err
+		}
+	}
+
 	// Persist rule update + audit event atomically. Audit failures roll the
 	// rule update back so a successful Execute always implies a successful
 	// audit record.
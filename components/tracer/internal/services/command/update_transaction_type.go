@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// Sentinel errors for nil dependencies passed to NewUpdateTransactionTypeCommand.
+var (
+	// ErrNilUpdateTransactionTypeRepository is returned when a nil
+	// TransactionTypeRepository is passed to NewUpdateTransactionTypeCommand.
+	ErrNilUpdateTransactionTypeRepository = errors.New("update transaction type repository is nil")
+	// ErrNilUpdateTransactionTypeClock is returned when a nil clock is passed
+	// to NewUpdateTransactionTypeCommand.
+	ErrNilUpdateTransactionTypeClock = errors.New("update transaction type clock is nil")
+	// ErrNilUpdateTransactionTypeTxBeginner is returned when a nil TxBeginner
+	// is passed to NewUpdateTransactionTypeCommand.
+	ErrNilUpdateTransactionTypeTxBeginner = errors.New("update transaction type tx beginner is nil")
+)
+
+// UpdateTransactionTypeInput represents a partial update of a catalog entry.
+// All fields are optional; nil keeps the current value. Setting Status to
+// INACTIVE stops the type from being accepted without deleting it.
+type UpdateTransactionTypeInput struct {
+	Description *string
+	SubTypes    *[]string
+	Status      *model.TransactionTypeStatus
+}
+
+// UpdateTransactionTypeCommand updates a catalog entry.
+type UpdateTransactionTypeCommand struct {
+	repo       TransactionTypeRepository
+	clock      clock.Clock
+	txBeginner pgdb.TxBeginner
+}
+
+// NewUpdateTransactionTypeCommand creates a new UpdateTransactionTypeCommand.
+// Returns an error if any dependency is nil.
+func NewUpdateTransactionTypeCommand(repo TransactionTypeRepository, clk clock.Clock, txBeginner pgdb.TxBeginner) (*UpdateTransactionTypeCommand, error) {
+	if repo == nil {
+		return nil, ErrNilUpdateTransactionTypeRepository
+	}
+
+	if clk == nil {
+		return nil, ErrNilUpdateTransactionTypeClock
+	}
+
+	if txBeginner == nil {
+		return nil, ErrNilUpdateTransactionTypeTxBeginner
+	}
+
+	return &UpdateTransactionTypeCommand{
+		repo:       repo,
+		clock:      clk,
+		txBeginner: txBeginner,
+	}, nil
+}
+
+// Execute applies the partial update to the entry identified by code.
+// Returns constant.ErrTransactionTypeNotFound if the entry doesn't exist.
+func (c *UpdateTransactionTypeCommand) Execute(ctx context.Context, code model.TransactionType, input *UpdateTransactionTypeInput) (_ *model.TransactionTypeDefinition, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.transaction_type.update")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "transaction_type_update", start, retErr)
+	}()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	if !code.IsValid() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction type code", constant.ErrTransactionTypeInvalidCode)
+		return nil, constant.ErrTransactionTypeInvalidCode
+	}
+
+	def, err := c.repo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, constant.ErrTransactionTypeNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get transaction type", err)
+
+		return nil, fmt.Errorf("failed to get transaction type: %w", err)
+	}
+
+	if input == nil {
+		return def, nil
+	}
+
+	if err := def.Update(input.Description, input.SubTypes, input.Status, c.clock.Now()); err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction type update", err)
+		logger.With(
+			libLog.String("operation", "service.transaction_type.update"),
+			libLog.String("transaction_type.code", code.String()),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Invalid transaction type update")
+
+		return nil, err
+	}
+
+	txErr := executeInTx(ctx, c.txBeginner, func(db pgdb.DB) error {
+		return c.repo.UpdateWithTx(ctx, db, def)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, constant.ErrTransactionTypeNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type not found", txErr)
+			return nil, txErr
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to update transaction type", txErr)
+		logger.With(
+			libLog.String("operation", "service.transaction_type.update"),
+			libLog.String("transaction_type.code", code.String()),
+			libLog.String("error.message", txErr.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to update transaction type")
+
+		return nil, fmt.Errorf("failed to update transaction type: %w", txErr)
+	}
+
+	return def, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package command
+
+//go:generate mockgen -source=validation_outcome_repository.go -destination=validation_outcome_repository_mock.go -package=command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pgdb "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// ValidationOutcomeRepository defines the interface for outcome label
+// persistence in commands. Separate from query.ValidationOutcomeRepository per CQRS.
+type ValidationOutcomeRepository interface {
+	// UpsertWithTx records the label for a validation, replacing any previous
+	// label while keeping its original creation time. Returns the stored row.
+	UpsertWithTx(ctx context.Context, db pgdb.DB, outcome *model.ValidationOutcome) (*model.ValidationOutcome, error)
+}
+
+// TransactionValidationReader looks up the validation a label is attached to.
+// Implemented by the postgres TransactionValidationRepository.
+type TransactionValidationReader interface {
+	// GetByID returns constant.ErrTransactionValidationNotFound if the record does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.TransactionValidation, error)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validation_outcome_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=validation_outcome_repository.go -destination=validation_outcome_repository_mock.go -package=command
+//
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidationOutcomeRepository is a mock of ValidationOutcomeRepository interface.
+type MockValidationOutcomeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidationOutcomeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockValidationOutcomeRepositoryMockRecorder is the mock recorder for MockValidationOutcomeRepository.
+type MockValidationOutcomeRepositoryMockRecorder struct {
+	mock *MockValidationOutcomeRepository
+}
+
+// NewMockValidationOutcomeRepository creates a new mock instance.
+func NewMockValidationOutcomeRepository(ctrl *gomock.Controller) *MockValidationOutcomeRepository {
+	mock := &MockValidationOutcomeRepository{ctrl: ctrl}
+	mock.recorder = &MockValidationOutcomeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidationOutcomeRepository) EXPECT() *MockValidationOutcomeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// UpsertWithTx mocks base method.
+func (m *MockValidationOutcomeRepository) UpsertWithTx(ctx context.Context, arg1 db.DB, outcome *model.ValidationOutcome) (*model.ValidationOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWithTx", ctx, arg1, outcome)
+	ret0, _ := ret[0].(*model.ValidationOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWithTx indicates an expected call of UpsertWithTx.
+func (mr *MockValidationOutcomeRepositoryMockRecorder) UpsertWithTx(ctx, arg1, outcome any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWithTx", reflect.TypeOf((*MockValidationOutcomeRepository)(nil).UpsertWithTx), ctx, arg1, outcome)
+}
+
+// MockTransactionValidationReader is a mock of TransactionValidationReader interface.
+type MockTransactionValidationReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionValidationReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionValidationReaderMockRecorder is the mock recorder for MockTransactionValidationReader.
+type MockTransactionValidationReaderMockRecorder struct {
+	mock *MockTransactionValidationReader
+}
+
+// NewMockTransactionValidationReader creates a new mock instance.
+func NewMockTransactionValidationReader(ctrl *gomock.Controller) *MockTransactionValidationReader {
+	mock := &MockTransactionValidationReader{ctrl: ctrl}
+	mock.recorder = &MockTransactionValidationReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionValidationReader) EXPECT() *MockTransactionValidationReaderMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockTransactionValidationReader) GetByID(ctx context.Context, id uuid.UUID) (*model.TransactionValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.TransactionValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransactionValidationReaderMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactionValidationReader)(nil).GetByID), ctx, id)
+}
@@ -0,0 +1,700 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+//go:generate mockgen -source=config_bundle_service.go -destination=mocks/config_bundle_service_mock.go -package=mocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	trcConstant "github.com/LerianStudio/midaz/v4/components/tracer/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// Sentinel errors for ConfigBundleService constructor validation.
+var (
+	ErrNilBundleRuleStore  = errors.New("config bundle: rule store cannot be nil")
+	ErrNilBundleLimitStore = errors.New("config bundle: limit store cannot be nil")
+)
+
+// BundleRuleStore is the slice of the rule API a bundle apply drives.
+// Implemented by RuleService, so every write goes through the same commands
+// (CEL compilation, audit trail, cache refresh) as the one-by-one endpoints.
+type BundleRuleStore interface {
+	ListRules(ctx context.Context, filter *model.ListRulesFilter) (*model.ListRulesResult, error)
+	CreateRule(ctx context.Context, input *command.CreateRuleInput) (*model.Rule, error)
+	UpdateRule(ctx context.Context, id uuid.UUID, input *command.UpdateRuleInput) (*model.Rule, error)
+	ActivateRule(ctx context.Context, id uuid.UUID) (*model.Rule, error)
+	DeactivateRule(ctx context.Context, id uuid.UUID) (*model.Rule, error)
+	DraftRule(ctx context.Context, id uuid.UUID) (*model.Rule, error)
+	ValidateExpression(ctx context.Context, expression string) error
+}
+
+// BundleLimitStore is the slice of the limit API a bundle apply drives.
+// Implemented by LimitService.
+type BundleLimitStore interface {
+	ListLimits(ctx context.Context, filter *model.ListLimitsFilter) (*model.ListLimitsResult, error)
+	CreateLimit(ctx context.Context, input *command.CreateLimitInput) (*model.Limit, error)
+	UpdateLimit(ctx context.Context, id uuid.UUID, input *command.UpdateLimitInput) (*model.Limit, error)
+	ActivateLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error)
+	DeactivateLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error)
+	DraftLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error)
+}
+
+// ConfigBundleService exports a tenant's rules and limits as a declarative
+// bundle and converges the tenant onto a bundle with a plan/apply workflow.
+//
+// Apply is not one database transaction: each entry goes through the regular
+// rule/limit commands so it gets its own audit event and cache update. A failed
+// apply stops at the failing entry; because plans are computed from current
+// state, re-running apply picks up exactly what is left.
+type ConfigBundleService struct {
+	rules  BundleRuleStore
+	limits BundleLimitStore
+	clock  clock.Clock
+}
+
+// NewConfigBundleService creates a ConfigBundleService. clk may be nil — a
+// RealClock is used.
+func NewConfigBundleService(rules BundleRuleStore, limits BundleLimitStore, clk clock.Clock) (*ConfigBundleService, error) {
+	if rules == nil {
+		return nil, ErrNilBundleRuleStore
+	}
+
+	if limits == nil {
+		return nil, ErrNilBundleLimitStore
+	}
+
+	if clk == nil {
+		clk = clock.RealClock{}
+	}
+
+	return &ConfigBundleService{rules: rules, limits: limits, clock: clk}, nil
+}
+
+// bundleStep pairs a plan entry with the declaration and current state apply
+// needs to carry it out.
+type bundleStep struct {
+	change       model.BundleChange
+	rule         *model.BundleRule
+	limit        *model.BundleLimit
+	currentRule  *model.Rule
+	currentLimit *model.Limit
+}
+
+// Export returns every non-deleted rule and limit as a bundle.
+func (s *ConfigBundleService) Export(ctx context.Context) (*model.ConfigBundle, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.config_bundle.export")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	rules, limits, err := s.loadState(ctx)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to load rules and limits", err)
+
+		logger.With(
+			libLog.String("operation", "service.config_bundle.export"),
+			libLog.String("error", err.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to load rules and limits for export")
+
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("app.response.rules_count", len(rules)),
+		attribute.Int("app.response.limits_count", len(limits)),
+	)
+
+	return model.NewConfigBundle(rules, limits, s.clock.Now()), nil
+}
+
+// Plan diffs the bundle against the tenant's current rules and limits without
+// changing anything.
+func (s *ConfigBundleService) Plan(ctx context.Context, bundle *model.ConfigBundle) (*model.BundlePlan, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.config_bundle.plan")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	plan, _, err := s.plan(ctx, bundle)
+	if err != nil {
+		logBundlePlanError(ctx, logger, span, "service.config_bundle.plan", err)
+
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// Apply plans the bundle and, unless opts.DryRun is set, carries out every
+// CREATE and UPDATE entry. It refuses to start when the plan has conflicts or
+// when opts.ExpectedFingerprint does not match the freshly computed plan.
+func (s *ConfigBundleService) Apply(ctx context.Context, bundle *model.ConfigBundle, opts model.BundleApplyOptions) (*model.BundleApplyResult, error) {
+	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.config_bundle.apply")
+	defer span.End()
+
+	logger = logging.WithTrace(ctx, logger)
+
+	plan, steps, err := s.plan(ctx, bundle)
+	if err != nil {
+		logBundlePlanError(ctx, logger, span, "service.config_bundle.apply", err)
+
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Bool("app.request.dry_run", opts.DryRun),
+		attribute.String("app.response.fingerprint", plan.Fingerprint),
+	)
+
+	if opts.ExpectedFingerprint != "" && opts.ExpectedFingerprint != plan.Fingerprint {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Plan fingerprint mismatch", constant.ErrConfigBundlePlanOutdated)
+
+		return nil, constant.ErrConfigBundlePlanOutdated
+	}
+
+	if plan.Summary.Conflict > 0 {
+		err := constant.ErrConfigBundleImmutableChange
+		if plan.HasInvalidExpression() {
+			err = constant.ErrConfigBundleInvalidExpression
+		}
+
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Plan has conflicts", err)
+
+		return nil, err
+	}
+
+	result := &model.BundleApplyResult{DryRun: opts.DryRun, Plan: plan}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	for i := range steps {
+		step := &steps[i]
+		if step.change.Action != model.BundleChangeCreate && step.change.Action != model.BundleChangeUpdate {
+			continue
+		}
+
+		if err := s.applyStep(ctx, step); err != nil {
+			err = &model.BundleEntryError{Kind: step.change.Kind, Name: step.change.Name, Err: err}
+
+			libOpentelemetry.HandleSpanError(span, "Failed to apply bundle entry", err)
+
+			logger.With(
+				libLog.String("operation", "service.config_bundle.apply"),
+				libLog.Int("applied", result.Applied),
+				libLog.String("error", err.Error()),
+			).Log(ctx, libLog.LevelError, "Bundle apply stopped at failing entry")
+
+			return nil, err
+		}
+
+		result.Applied++
+	}
+
+	logger.With(
+		libLog.String("operation", "service.config_bundle.apply"),
+		libLog.String("fingerprint", plan.Fingerprint),
+		libLog.Int("applied", result.Applied),
+	).Log(ctx, libLog.LevelInfo, "Configuration bundle applied")
+
+	return result, nil
+}
+
+func logBundlePlanError(ctx context.Context, logger libLog.Logger, span trace.Span, operation string, err error) {
+	if errors.Is(err, constant.ErrInvalidConfigBundle) {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid configuration bundle", err)
+
+		logger.With(
+			libLog.String("operation", operation),
+			libLog.String("error", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Rejected invalid configuration bundle")
+
+		return
+	}
+
+	libOpentelemetry.HandleSpanError(span, "Failed to plan configuration bundle", err)
+
+	logger.With(
+		libLog.String("operation", operation),
+		libLog.String("error", err.Error()),
+	).Log(ctx, libLog.LevelError, "Failed to plan configuration bundle")
+}
+
+// plan validates a copy of the bundle and diffs it against current state.
+// Declared entries come first in bundle order, then UNMANAGED ones sorted by key.
+func (s *ConfigBundleService) plan(ctx context.Context, bundle *model.ConfigBundle) (*model.BundlePlan, []bundleStep, error) {
+	if bundle == nil {
+		return nil, nil, fmt.Errorf("%w: empty bundle", constant.ErrInvalidConfigBundle)
+	}
+
+	declared := *bundle
+	declared.Rules = slices.Clone(bundle.Rules)
+	declared.Limits = slices.Clone(bundle.Limits)
+	declared.SetDefaults()
+
+	if err := declared.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	rules, limits, err := s.loadState(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan := &model.BundlePlan{Changes: make([]model.BundleChange, 0, len(declared.Rules)+len(declared.Limits))}
+	steps := make([]bundleStep, 0, cap(plan.Changes))
+
+	rulesByKey := make(map[string]*model.Rule, len(rules))
+	for i := range rules {
+		rulesByKey[model.RuleBundleKey(rules[i].Name, rules[i].Scopes)] = &rules[i]
+	}
+
+	for i := range declared.Rules {
+		decl := &declared.Rules[i]
+		key := decl.Key()
+		current := rulesByKey[key]
+
+		delete(rulesByKey, key)
+
+		step := bundleStep{change: planRuleChange(decl, current), rule: decl, currentRule: current}
+		s.checkRuleExpression(ctx, &step.change, decl.Expression)
+		plan.Add(step.change)
+		steps = append(steps, step)
+	}
+
+	for _, key := range sortedKeys(rulesByKey) {
+		r := rulesByKey[key]
+		plan.Add(model.BundleChange{Kind: model.BundleResourceRule, Name: r.Name, ID: &r.ID, Action: model.BundleChangeUnmanaged, CurrentStatus: string(r.Status)})
+	}
+
+	limitsByKey := make(map[string]*model.Limit, len(limits))
+	for i := range limits {
+		limitsByKey[strings.TrimSpace(limits[i].Name)] = &limits[i]
+	}
+
+	for i := range declared.Limits {
+		decl := &declared.Limits[i]
+		key := decl.Key()
+		current := limitsByKey[key]
+
+		delete(limitsByKey, key)
+
+		step := bundleStep{change: planLimitChange(decl, current), limit: decl, currentLimit: current}
+		plan.Add(step.change)
+		steps = append(steps, step)
+	}
+
+	for _, key := range sortedKeys(limitsByKey) {
+		l := limitsByKey[key]
+		plan.Add(model.BundleChange{Kind: model.BundleResourceLimit, Name: l.Name, ID: &l.ID, Action: model.BundleChangeUnmanaged, CurrentStatus: string(l.Status)})
+	}
+
+	fingerprint, err := bundleFingerprint(&declared, rules, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan.Fingerprint = fingerprint
+
+	return plan, steps, nil
+}
+
+// ruleTransitions splits a rule's status path around its field update. An
+// expression can only be edited in DRAFT, so such an update first walks the
+// rule back to DRAFT and then forward to the declared status.
+func ruleTransitions(current, target model.RuleStatus, expressionChanged bool) (before, after []string) {
+	if expressionChanged && current != model.RuleStatusDraft {
+		return model.BundleStatusPath(string(current), string(model.RuleStatusDraft)),
+			model.BundleStatusPath(string(model.RuleStatusDraft), string(target))
+	}
+
+	return nil, model.BundleStatusPath(string(current), string(target))
+}
+
+func planRuleChange(decl *model.BundleRule, current *model.Rule) model.BundleChange {
+	change := model.BundleChange{Kind: model.BundleResourceRule, Name: decl.Name, TargetStatus: string(decl.Status)}
+
+	if current == nil {
+		change.Action = model.BundleChangeCreate
+		change.Transitions = model.BundleStatusPath(string(model.RuleStatusDraft), string(decl.Status))
+
+		return change
+	}
+
+	change.ID = &current.ID
+	change.CurrentStatus = string(current.Status)
+	change.ChangedFields = decl.Diff(current)
+
+	before, after := ruleTransitions(current.Status, decl.Status, slices.Contains(change.ChangedFields, "expression"))
+	change.Transitions = append(before, after...)
+
+	change.Action = model.BundleChangeUpdate
+	if len(change.ChangedFields) == 0 && len(change.Transitions) == 0 {
+		change.Action = model.BundleChangeUnchanged
+	}
+
+	return change
+}
+
+// checkRuleExpression compiles the expression a CREATE or an expression UPDATE
+// would store and turns the change into a CONFLICT when it does not compile.
+// Caught here, a bad expression never reaches apply, which would otherwise
+// leave a previously ACTIVE rule parked in DRAFT.
+func (s *ConfigBundleService) checkRuleExpression(ctx context.Context, change *model.BundleChange, expression string) {
+	if change.Action != model.BundleChangeCreate &&
+		(change.Action != model.BundleChangeUpdate || !slices.Contains(change.ChangedFields, "expression")) {
+		return
+	}
+
+	if err := s.rules.ValidateExpression(ctx, expression); err != nil {
+		change.Action = model.BundleChangeConflict
+		change.ChangedFields = []string{"expression"}
+		change.Transitions = nil
+		change.Reason = err.Error()
+	}
+}
+
+func planLimitChange(decl *model.BundleLimit, current *model.Limit) model.BundleChange {
+	change := model.BundleChange{Kind: model.BundleResourceLimit, Name: decl.Name, TargetStatus: string(decl.Status)}
+
+	if current == nil {
+		change.Action = model.BundleChangeCreate
+		change.Transitions = model.BundleStatusPath(string(model.LimitStatusDraft), string(decl.Status))
+
+		return change
+	}
+
+	change.ID = &current.ID
+	change.CurrentStatus = string(current.Status)
+
+	changed, immutable := decl.Diff(current)
+	if len(immutable) > 0 {
+		change.Action = model.BundleChangeConflict
+		change.ChangedFields = immutable
+
+		return change
+	}
+
+	change.ChangedFields = changed
+	change.Transitions = model.BundleStatusPath(string(current.Status), string(decl.Status))
+
+	change.Action = model.BundleChangeUpdate
+	if len(change.ChangedFields) == 0 && len(change.Transitions) == 0 {
+		change.Action = model.BundleChangeUnchanged
+	}
+
+	return change
+}
+
+func (s *ConfigBundleService) applyStep(ctx context.Context, step *bundleStep) error {
+	if step.rule != nil {
+		return s.applyRule(ctx, step.rule, step.currentRule, step.change.ChangedFields)
+	}
+
+	return s.applyLimit(ctx, step.limit, step.currentLimit, step.change.ChangedFields)
+}
+
+func (s *ConfigBundleService) applyRule(ctx context.Context, decl *model.BundleRule, current *model.Rule, changed []string) error {
+	if current == nil {
+		input := &command.CreateRuleInput{
+			Name:       decl.Name,
+			Expression: decl.Expression,
+			Action:     decl.Action,
+			Scopes:     decl.Scopes,
+		}
+
+		if decl.Description != nil {
+			input.Description = *decl.Description
+		}
+
+		created, err := s.rules.CreateRule(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.transitionRule(ctx, created.ID, created.Status, model.BundleStatusPath(string(created.Status), string(decl.Status)))
+
+		return err
+	}
+
+	before, after := ruleTransitions(current.Status, decl.Status, slices.Contains(changed, "expression"))
+
+	reached, err := s.transitionRule(ctx, current.ID, current.Status, before)
+	if err != nil {
+		return s.restoreRule(ctx, current, reached, err)
+	}
+
+	if len(changed) > 0 {
+		input := &command.UpdateRuleInput{}
+
+		for _, field := range changed {
+			switch field {
+			case "description":
+				input.Description = descriptionOrEmpty(decl.Description)
+			case "expression":
+				input.Expression = &decl.Expression
+			case "action":
+				input.Action = &decl.Action
+			case "scopes":
+				input.Scopes = &decl.Scopes
+			}
+		}
+
+		if _, err := s.rules.UpdateRule(ctx, current.ID, input); err != nil {
+			return s.restoreRule(ctx, current, reached, err)
+		}
+	}
+
+	if reached, err = s.transitionRule(ctx, current.ID, reached, after); err != nil {
+		return s.restoreRule(ctx, current, reached, err)
+	}
+
+	return nil
+}
+
+// restoreRule walks a rule whose apply failed from the status it reached back
+// to the status it had before, so a failed expression edit does not leave a
+// live rule parked in DRAFT. A rule that was INACTIVE stays DRAFT: going back
+// would mean activating it. cause is returned, joined with any restore error.
+func (s *ConfigBundleService) restoreRule(ctx context.Context, current *model.Rule, reached model.RuleStatus, cause error) error {
+	if _, err := s.transitionRule(ctx, current.ID, reached, model.BundleStatusPath(string(reached), string(current.Status))); err != nil {
+		return errors.Join(cause, fmt.Errorf("restore rule status %s: %w", current.Status, err))
+	}
+
+	return cause
+}
+
+// transitionRule walks the rule along path starting from status from. It
+// returns the last status reached, which is from when the first step fails.
+func (s *ConfigBundleService) transitionRule(ctx context.Context, id uuid.UUID, from model.RuleStatus, path []string) (model.RuleStatus, error) {
+	reached := from
+
+	for _, status := range path {
+		var err error
+
+		switch model.RuleStatus(status) {
+		case model.RuleStatusActive:
+			_, err = s.rules.ActivateRule(ctx, id)
+		case model.RuleStatusInactive:
+			_, err = s.rules.DeactivateRule(ctx, id)
+		case model.RuleStatusDraft:
+			_, err = s.rules.DraftRule(ctx, id)
+		}
+
+		if err != nil {
+			return reached, err
+		}
+
+		reached = model.RuleStatus(status)
+	}
+
+	return reached, nil
+}
+
+func (s *ConfigBundleService) applyLimit(ctx context.Context, decl *model.BundleLimit, current *model.Limit, changed []string) error {
+	if current == nil {
+		created, err := s.limits.CreateLimit(ctx, &command.CreateLimitInput{
+			Name:            decl.Name,
+			Description:     decl.Description,
+			LimitType:       decl.LimitType,
+			MaxAmount:       decl.MaxAmount,
+			Currency:        decl.Currency,
+			Scopes:          decl.Scopes,
+			ActiveTimeStart: decl.ActiveTimeStart,
+			ActiveTimeEnd:   decl.ActiveTimeEnd,
+			CustomStartDate: formatBundleDate(decl.CustomStartDate),
+			CustomEndDate:   formatBundleDate(decl.CustomEndDate),
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.transitionLimit(ctx, created.ID, model.BundleStatusPath(string(created.Status), string(decl.Status)))
+	}
+
+	if len(changed) > 0 {
+		input := &command.UpdateLimitInput{}
+
+		for _, field := range changed {
+			switch field {
+			case "description":
+				input.Description = descriptionOrEmpty(decl.Description)
+			case "maxAmount":
+				input.MaxAmount = &decl.MaxAmount
+			case "scopes":
+				input.Scopes = &decl.Scopes
+			case "activeTimeWindow":
+				input.ActiveTimeStart = decl.ActiveTimeStart
+				input.ActiveTimeEnd = decl.ActiveTimeEnd
+			case "customPeriod":
+				input.CustomStartDate = formatBundleDate(decl.CustomStartDate)
+				input.CustomEndDate = formatBundleDate(decl.CustomEndDate)
+			}
+		}
+
+		if _, err := s.limits.UpdateLimit(ctx, current.ID, input); err != nil {
+			return err
+		}
+	}
+
+	return s.transitionLimit(ctx, current.ID, model.BundleStatusPath(string(current.Status), string(decl.Status)))
+}
+
+func (s *ConfigBundleService) transitionLimit(ctx context.Context, id uuid.UUID, path []string) error {
+	for _, status := range path {
+		var err error
+
+		switch model.LimitStatus(status) {
+		case model.LimitStatusActive:
+			_, err = s.limits.ActivateLimit(ctx, id)
+		case model.LimitStatusInactive:
+			_, err = s.limits.DeactivateLimit(ctx, id)
+		case model.LimitStatusDraft:
+			_, err = s.limits.DraftLimit(ctx, id)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadState pages through every non-deleted rule and limit.
+func (s *ConfigBundleService) loadState(ctx context.Context) ([]model.Rule, []model.Limit, error) {
+	var rules []model.Rule
+
+	ruleFilter := &model.ListRulesFilter{Limit: trcConstant.MaxPaginationLimit, SortBy: "created_at", SortOrder: string(trcConstant.Asc)}
+
+	for {
+		page, err := s.rules.ListRules(ctx, ruleFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list rules: %w", err)
+		}
+
+		rules = append(rules, page.Rules...)
+
+		if !page.HasMore || page.NextCursor == "" {
+			break
+		}
+
+		ruleFilter.Cursor = page.NextCursor
+	}
+
+	var limits []model.Limit
+
+	limitFilter := &model.ListLimitsFilter{Limit: trcConstant.MaxPaginationLimit, SortBy: model.DefaultLimitSortField, SortOrder: string(trcConstant.Asc)}
+
+	for {
+		page, err := s.limits.ListLimits(ctx, limitFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list limits: %w", err)
+		}
+
+		limits = append(limits, page.Limits...)
+
+		if !page.HasMore || page.NextCursor == "" {
+			break
+		}
+
+		limitFilter.Cursor = page.NextCursor
+	}
+
+	return rules, limits, nil
+}
+
+// bundleFingerprint hashes the declared bundle together with the identity,
+// version and status of every current rule and limit, so any write to the
+// tenant between plan and apply yields a different fingerprint.
+func bundleFingerprint(bundle *model.ConfigBundle, rules []model.Rule, limits []model.Limit) (string, error) {
+	declared := *bundle
+	declared.ExportedAt = nil
+
+	payload, err := json.Marshal(&declared)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode bundle: %w", err)
+	}
+
+	state := make([]string, 0, len(rules)+len(limits))
+	for i := range rules {
+		state = append(state, fmt.Sprintf("rule|%s|%s|%d", rules[i].ID, rules[i].Status, rules[i].UpdatedAt.UnixNano()))
+	}
+
+	for i := range limits {
+		state = append(state, fmt.Sprintf("limit|%s|%s|%d", limits[i].ID, limits[i].Status, limits[i].UpdatedAt.UnixNano()))
+	}
+
+	sort.Strings(state)
+
+	h := sha256.New()
+	h.Write(payload)
+
+	for _, line := range state {
+		h.Write([]byte{'\n'})
+		h.Write([]byte(line))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func descriptionOrEmpty(description *string) *string {
+	if description != nil {
+		return description
+	}
+
+	empty := ""
+
+	return &empty
+}
+
+func formatBundleDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.UTC().Format(time.RFC3339)
+
+	return &formatted
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	servicesMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+type configBundleDeps struct {
+	rules  *servicesMocks.MockBundleRuleStore
+	limits *servicesMocks.MockBundleLimitStore
+}
+
+func newConfigBundleServiceDeps(t *testing.T) (*ConfigBundleService, *configBundleDeps) {
+	t.Helper()
+
+	testutil.SetupTestTracing(t)
+
+	ctrl := gomock.NewController(t)
+
+	deps := &configBundleDeps{
+		rules:  servicesMocks.NewMockBundleRuleStore(ctrl),
+		limits: servicesMocks.NewMockBundleLimitStore(ctrl),
+	}
+
+	svc, err := NewConfigBundleService(deps.rules, deps.limits, testutil.NewMockClock(testutil.FixedTime()))
+	require.NoError(t, err)
+
+	return svc, deps
+}
+
+// expectState serves the given rules and limits as a single page each, every
+// time the service loads current state, and accepts every declared expression.
+// Tests rejecting an expression register their ValidateExpression call first.
+func (d *configBundleDeps) expectState(rules []model.Rule, limits []model.Limit) {
+	d.rules.EXPECT().ListRules(gomock.Any(), gomock.Any()).Return(&model.ListRulesResult{Rules: rules}, nil).AnyTimes()
+	d.limits.EXPECT().ListLimits(gomock.Any(), gomock.Any()).Return(&model.ListLimitsResult{Limits: limits}, nil).AnyTimes()
+	d.rules.EXPECT().ValidateExpression(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func bundleOf(rules []model.BundleRule, limits []model.BundleLimit) *model.ConfigBundle {
+	return &model.ConfigBundle{
+		APIVersion: model.ConfigBundleAPIVersion,
+		Kind:       model.ConfigBundleKind,
+		Rules:      rules,
+		Limits:     limits,
+	}
+}
+
+func TestNewConfigBundleService_NilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := NewConfigBundleService(nil, servicesMocks.NewMockBundleLimitStore(ctrl), nil)
+	require.ErrorIs(t, err, ErrNilBundleRuleStore)
+
+	_, err = NewConfigBundleService(servicesMocks.NewMockBundleRuleStore(ctrl), nil, nil)
+	require.ErrorIs(t, err, ErrNilBundleLimitStore)
+}
+
+func TestConfigBundleService_Export(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	deps.rules.EXPECT().ListRules(gomock.Any(), gomock.Any()).
+		Return(&model.ListRulesResult{Rules: []model.Rule{{Name: "b"}}, HasMore: true, NextCursor: "next"}, nil)
+	deps.rules.EXPECT().ListRules(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter *model.ListRulesFilter) (*model.ListRulesResult, error) {
+			assert.Equal(t, "next", filter.Cursor)
+			return &model.ListRulesResult{Rules: []model.Rule{{Name: "a"}}}, nil
+		})
+	deps.limits.EXPECT().ListLimits(gomock.Any(), gomock.Any()).
+		Return(&model.ListLimitsResult{Limits: []model.Limit{{Name: "cap"}}}, nil)
+
+	bundle, err := svc.Export(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, bundle.Rules, 2)
+	assert.Equal(t, "a", bundle.Rules[0].Name)
+	assert.Len(t, bundle.Limits, 1)
+	assert.Equal(t, testutil.FixedTime(), *bundle.ExportedAt)
+}
+
+func TestConfigBundleService_Plan(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	unchangedRule := model.Rule{ID: testutil.MustDeterministicUUID(1), Name: "keep", Expression: "true", Action: model.DecisionAllow, Status: model.RuleStatusActive}
+	editedRule := model.Rule{ID: testutil.MustDeterministicUUID(2), Name: "edit", Expression: "amount > 1", Action: model.DecisionDeny, Status: model.RuleStatusActive}
+	orphanRule := model.Rule{ID: testutil.MustDeterministicUUID(3), Name: "orphan", Expression: "true", Action: model.DecisionDeny, Status: model.RuleStatusInactive}
+	currencyLimit := model.Limit{ID: testutil.MustDeterministicUUID(4), Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(10), Currency: "BRL", Status: model.LimitStatusActive}
+
+	deps.expectState([]model.Rule{unchangedRule, editedRule, orphanRule}, []model.Limit{currencyLimit})
+
+	bundle := bundleOf(
+		[]model.BundleRule{
+			{Name: "Keep", Expression: "true", Action: model.DecisionAllow},
+			{Name: "edit", Expression: "amount > 2", Action: model.DecisionDeny},
+			{Name: "new", Expression: "true", Action: model.DecisionReview, Status: model.RuleStatusDraft},
+		},
+		[]model.BundleLimit{
+			{Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(10), Currency: "USD"},
+		},
+	)
+
+	plan, err := svc.Plan(context.Background(), bundle)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.BundlePlanSummary{Create: 1, Update: 1, Unchanged: 1, Conflict: 1, Unmanaged: 1}, plan.Summary)
+	assert.NotEmpty(t, plan.Fingerprint)
+
+	byName := make(map[string]model.BundleChange, len(plan.Changes))
+	for _, c := range plan.Changes {
+		byName[c.Name] = c
+	}
+
+	assert.Equal(t, model.BundleChangeUnchanged, byName["Keep"].Action)
+	assert.Equal(t, model.BundleChangeUpdate, byName["edit"].Action)
+	assert.Equal(t, []string{"expression"}, byName["edit"].ChangedFields)
+	assert.Equal(t, []string{"INACTIVE", "DRAFT", "ACTIVE"}, byName["edit"].Transitions)
+	assert.Equal(t, model.BundleChangeCreate, byName["new"].Action)
+	assert.Empty(t, byName["new"].Transitions)
+	assert.Equal(t, model.BundleChangeConflict, byName["cap"].Action)
+	assert.Equal(t, []string{"currency"}, byName["cap"].ChangedFields)
+	assert.Equal(t, model.BundleChangeUnmanaged, byName["orphan"].Action)
+
+	// The input bundle is not mutated by defaulting.
+	assert.Empty(t, bundle.Rules[0].Status)
+}
+
+func TestConfigBundleService_Plan_InvalidBundle(t *testing.T) {
+	svc, _ := newConfigBundleServiceDeps(t)
+
+	bundle := bundleOf(nil, nil)
+	bundle.APIVersion = "v0"
+
+	_, err := svc.Plan(context.Background(), bundle)
+	require.ErrorIs(t, err, constant.ErrInvalidConfigBundle)
+}
+
+func TestConfigBundleService_Apply_ExpressionChangeOnActiveRule(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	current := model.Rule{ID: testutil.MustDeterministicUUID(10), Name: "edit", Expression: "amount > 1", Action: model.DecisionDeny, Status: model.RuleStatusActive}
+	deps.expectState([]model.Rule{current}, nil)
+
+	gomock.InOrder(
+		deps.rules.EXPECT().DeactivateRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().DraftRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().UpdateRule(gomock.Any(), current.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ any, input *command.UpdateRuleInput) (*model.Rule, error) {
+				require.NotNil(t, input.Expression)
+				assert.Equal(t, "amount > 2", *input.Expression)
+				assert.Nil(t, input.Action)
+
+				return &model.Rule{}, nil
+			}),
+		deps.rules.EXPECT().ActivateRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+	)
+
+	bundle := bundleOf([]model.BundleRule{{Name: "edit", Expression: "amount > 2", Action: model.DecisionDeny}}, nil)
+
+	result, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Applied)
+	assert.False(t, result.DryRun)
+}
+
+func TestConfigBundleService_Plan_InvalidExpression(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	current := model.Rule{ID: testutil.MustDeterministicUUID(11), Name: "edit", Expression: "amount > 1", Action: model.DecisionDeny, Status: model.RuleStatusActive}
+
+	compileErr := errors.New("undeclared reference to 'amout'")
+	deps.rules.EXPECT().ValidateExpression(gomock.Any(), "amout > 2").Return(compileErr).AnyTimes()
+	deps.expectState([]model.Rule{current}, nil)
+
+	bundle := bundleOf([]model.BundleRule{{Name: "edit", Expression: "amout > 2", Action: model.DecisionDeny}}, nil)
+
+	plan, err := svc.Plan(context.Background(), bundle)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, 1, plan.Summary.Conflict)
+	assert.Equal(t, model.BundleChangeConflict, plan.Changes[0].Action)
+	assert.Equal(t, []string{"expression"}, plan.Changes[0].ChangedFields)
+	assert.Empty(t, plan.Changes[0].Transitions)
+	assert.Equal(t, compileErr.Error(), plan.Changes[0].Reason)
+
+	// Apply refuses before touching the live rule: no transition is expected.
+	_, err = svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.ErrorIs(t, err, constant.ErrConfigBundleInvalidExpression)
+}
+
+func TestConfigBundleService_Apply_RestoresStatusWhenUpdateFails(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	current := model.Rule{ID: testutil.MustDeterministicUUID(12), Name: "edit", Expression: "amount > 1", Action: model.DecisionDeny, Status: model.RuleStatusActive}
+	deps.expectState([]model.Rule{current}, nil)
+
+	storeErr := errors.New("boom")
+
+	gomock.InOrder(
+		deps.rules.EXPECT().DeactivateRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().DraftRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().UpdateRule(gomock.Any(), current.ID, gomock.Any()).Return(nil, storeErr),
+		deps.rules.EXPECT().ActivateRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+	)
+
+	bundle := bundleOf([]model.BundleRule{{Name: "edit", Expression: "amount > 2", Action: model.DecisionDeny}}, nil)
+
+	_, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.ErrorIs(t, err, storeErr)
+}
+
+func TestConfigBundleService_Apply_RestoresStatusWhenForwardTransitionFails(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	current := model.Rule{ID: testutil.MustDeterministicUUID(13), Name: "edit", Expression: "amount > 1", Action: model.DecisionDeny, Status: model.RuleStatusActive}
+	deps.expectState([]model.Rule{current}, nil)
+
+	activateErr := errors.New("activation failed")
+	restoreErr := errors.New("still failing")
+
+	gomock.InOrder(
+		deps.rules.EXPECT().DeactivateRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().DraftRule(gomock.Any(), current.ID).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().UpdateRule(gomock.Any(), current.ID, gomock.Any()).Return(&model.Rule{}, nil),
+		deps.rules.EXPECT().ActivateRule(gomock.Any(), current.ID).Return(nil, activateErr),
+		deps.rules.EXPECT().ActivateRule(gomock.Any(), current.ID).Return(nil, restoreErr),
+	)
+
+	bundle := bundleOf([]model.BundleRule{{Name: "edit", Expression: "amount > 2", Action: model.DecisionDeny}}, nil)
+
+	_, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.ErrorIs(t, err, activateErr)
+	require.ErrorIs(t, err, restoreErr)
+}
+
+func TestConfigBundleService_Apply_CreatesLimit(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	deps.expectState(nil, nil)
+
+	created := &model.Limit{ID: testutil.MustDeterministicUUID(20), Status: model.LimitStatusDraft}
+
+	gomock.InOrder(
+		deps.limits.EXPECT().CreateLimit(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *command.CreateLimitInput) (*model.Limit, error) {
+				assert.Equal(t, "cap", input.Name)
+				assert.Equal(t, "BRL", input.Currency)
+
+				return created, nil
+			}),
+		deps.limits.EXPECT().ActivateLimit(gomock.Any(), created.ID).Return(created, nil),
+	)
+
+	bundle := bundleOf(nil, []model.BundleLimit{{Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(10), Currency: "BRL"}})
+
+	result, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Applied)
+}
+
+func TestConfigBundleService_Apply_Guards(t *testing.T) {
+	current := model.Limit{ID: testutil.MustDeterministicUUID(30), Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(10), Currency: "BRL", Status: model.LimitStatusActive}
+
+	t.Run("fingerprint mismatch", func(t *testing.T) {
+		svc, deps := newConfigBundleServiceDeps(t)
+		deps.expectState(nil, []model.Limit{current})
+
+		bundle := bundleOf(nil, []model.BundleLimit{{Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(20), Currency: "BRL"}})
+
+		_, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{ExpectedFingerprint: "stale"})
+		require.ErrorIs(t, err, constant.ErrConfigBundlePlanOutdated)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		svc, deps := newConfigBundleServiceDeps(t)
+		deps.expectState(nil, []model.Limit{current})
+
+		bundle := bundleOf(nil, []model.BundleLimit{{Name: "cap", LimitType: model.LimitTypeMonthly, MaxAmount: decimal.NewFromInt(10), Currency: "BRL"}})
+
+		_, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+		require.ErrorIs(t, err, constant.ErrConfigBundleImmutableChange)
+	})
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		svc, deps := newConfigBundleServiceDeps(t)
+		deps.expectState(nil, []model.Limit{current})
+
+		bundle := bundleOf(nil, []model.BundleLimit{{Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(20), Currency: "BRL"}})
+
+		plan, err := svc.Plan(context.Background(), bundle)
+		require.NoError(t, err)
+
+		result, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{DryRun: true, ExpectedFingerprint: plan.Fingerprint})
+		require.NoError(t, err)
+
+		assert.True(t, result.DryRun)
+		assert.Zero(t, result.Applied)
+		assert.Equal(t, 1, result.Plan.Summary.Update)
+	})
+}
+
+func TestConfigBundleService_Apply_StopsAtFailingEntry(t *testing.T) {
+	svc, deps := newConfigBundleServiceDeps(t)
+
+	deps.expectState(nil, nil)
+
+	storeErr := errors.New("boom")
+	deps.rules.EXPECT().CreateRule(gomock.Any(), gomock.Any()).Return(nil, storeErr)
+
+	bundle := bundleOf(
+		[]model.BundleRule{{Name: "first", Expression: "true", Action: model.DecisionDeny}},
+		[]model.BundleLimit{{Name: "cap", LimitType: model.LimitTypeDaily, MaxAmount: decimal.NewFromInt(10), Currency: "BRL"}},
+	)
+
+	_, err := svc.Apply(context.Background(), bundle, model.BundleApplyOptions{})
+	require.ErrorIs(t, err, storeErr)
+
+	var entryErr *model.BundleEntryError
+	require.ErrorAs(t, err, &entryErr)
+	assert.Equal(t, model.BundleResourceRule, entryErr.Kind)
+	assert.Equal(t, "first", entryErr.Name)
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+	libStreaming "github.com/LerianStudio/lib-streaming"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	pkgStreaming "github.com/LerianStudio/midaz/v4/pkg/streaming"
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+)
+
+// ErrNilAlertPolicyRepository is returned when a nil policy repository is
+// passed to NewDecisionAlertMonitor.
+var ErrNilAlertPolicyRepository = errors.New("alert policy repository is nil")
+
+const (
+	// DefaultAlertPolicyTTL bounds how long a tenant's policy snapshot is
+	// served before it is reloaded. Writes made through this instance
+	// invalidate immediately; the TTL only bounds staleness for writes made by
+	// other replicas.
+	DefaultAlertPolicyTTL = 30 * time.Second
+
+	// alertWindowBuckets is the resolution of every sliding window: a policy's
+	// window is split into this many buckets, so the oldest bucket expires in
+	// steps of WindowSeconds/alertWindowBuckets.
+	alertWindowBuckets = 60
+
+	// alertActionTimeout bounds the event emission and rule deactivation run
+	// for a single alert transition.
+	alertActionTimeout = 10 * time.Second
+
+	// autoDeactivateReasonFormat is the audit reason recorded when a policy
+	// deactivates its rule.
+	autoDeactivateReasonFormat = "Rule deactivated by alert policy %s: %s rate %.4f over %ds reached threshold %.4f"
+)
+
+// RuleDeactivator deactivates a rule with an audit reason. Implemented by
+// RuleService.
+type RuleDeactivator interface {
+	DeactivateRuleWithReason(ctx context.Context, ruleID uuid.UUID, reason string) (*model.Rule, error)
+}
+
+// DecisionObserver receives the outcome of every fresh validation.
+// Implemented by DecisionAlertMonitor; installed on ValidationService via
+// SetDecisionObserver.
+type DecisionObserver interface {
+	Observe(ctx context.Context, req *model.ValidationRequest, resp *model.ValidationResponse)
+}
+
+// alertBucket counts validations in one slice of a policy window. index is the
+// absolute bucket number (unix time / bucket width), so a stale bucket left
+// over from an earlier lap of the ring is recognized and reset.
+type alertBucket struct {
+	index   int64
+	matched int64
+	total   int64
+}
+
+// policyWindow is the sliding-window state of one policy.
+type policyWindow struct {
+	// version is the policy UpdatedAt the window was built for; editing a
+	// policy starts a fresh window.
+	version time.Time
+	width   time.Duration
+	buckets [alertWindowBuckets]alertBucket
+	firing  bool
+}
+
+// tenantAlertState holds one tenant's policy snapshot and windows.
+type tenantAlertState struct {
+	policies []*model.AlertPolicy
+	loadedAt time.Time
+	loaded   bool
+	windows  map[uuid.UUID]*policyWindow
+}
+
+// alertTransition is a state change detected while observing a validation.
+type alertTransition struct {
+	policy    *model.AlertPolicy
+	triggered bool
+	matched   int64
+	total     int64
+	at        time.Time
+}
+
+// DecisionAlertMonitor evaluates the tenant's alert policies over sliding
+// windows of validation outcomes.
+//
+// Every fresh (non-duplicate) validation is counted against each ACTIVE policy
+// whose scope matches the transaction. When a policy's rate reaches its
+// threshold with at least MinSamples validations in the window, a
+// decision-alert.triggered event is published and, for AutoDeactivate
+// policies, the rule is deactivated; when the rate falls back below the
+// threshold, decision-alert.resolved follows. Policies are evaluated as
+// traffic arrives, so a window with no traffic holds its last state.
+//
+// Windows live in memory: each replica evaluates the traffic it serves and a
+// restart starts every window empty. Actions run off the request path and
+// never affect the validation response. Windows are partitioned per tenant via
+// tmcore.GetTenantIDContext, like TransactionTypeCatalog.
+type DecisionAlertMonitor struct {
+	repo        query.AlertPolicyRepository
+	clock       clock.Clock
+	streaming   libStreaming.Emitter
+	deactivator RuleDeactivator
+	ttl         time.Duration
+	// dispatch runs alert actions; asynchronous in production, replaced by a
+	// synchronous runner in tests.
+	dispatch func(func())
+
+	mu      sync.Mutex
+	tenants map[string]*tenantAlertState
+}
+
+// NewDecisionAlertMonitor creates a monitor over repo. streaming and
+// deactivator may be nil, which disables event emission and the
+// auto-deactivate safeguard respectively. A nil clock defaults to the real
+// clock and a non-positive ttl defaults to DefaultAlertPolicyTTL.
+func NewDecisionAlertMonitor(
+	repo query.AlertPolicyRepository,
+	clk clock.Clock,
+	streaming libStreaming.Emitter,
+	deactivator RuleDeactivator,
+	ttl time.Duration,
+) (*DecisionAlertMonitor, error) {
+	if repo == nil {
+		return nil, ErrNilAlertPolicyRepository
+	}
+
+	if clk == nil {
+		clk = clock.New()
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultAlertPolicyTTL
+	}
+
+	return &DecisionAlertMonitor{
+		repo:        repo,
+		clock:       clk,
+		streaming:   streaming,
+		deactivator: deactivator,
+		ttl:         ttl,
+		dispatch:    func(f func()) { go f() },
+		tenants:     make(map[string]*tenantAlertState),
+	}, nil
+}
+
+// Invalidate drops the policy snapshot of the tenant resolved from ctx so the
+// next observation reloads it. Windows of unchanged policies are kept.
+func (m *DecisionAlertMonitor) Invalidate(ctx context.Context) {
+	tenantID := tmcore.GetTenantIDContext(ctx)
+
+	m.mu.Lock()
+	if state := m.tenants[tenantID]; state != nil {
+		state.loaded = false
+	}
+	m.mu.Unlock()
+}
+
+// Observe counts one validation outcome against the tenant's policies and
+// dispatches any resulting alert transitions.
+func (m *DecisionAlertMonitor) Observe(ctx context.Context, req *model.ValidationRequest, resp *model.ValidationResponse) {
+	if req == nil || resp == nil {
+		return
+	}
+
+	tenantID := tmcore.GetTenantIDContext(ctx)
+
+	policies := m.policies(ctx, tenantID)
+	if len(policies) == 0 {
+		return
+	}
+
+	now := m.clock.Now().UTC()
+	scope := req.ToTransactionScope()
+
+	var transitions []alertTransition
+
+	m.mu.Lock()
+
+	state := m.tenantState(tenantID)
+	for _, policy := range policies {
+		if policy.Scope != nil && !policy.Scope.Matches(scope) {
+			continue
+		}
+
+		window := state.window(policy)
+		window.record(now, alertMatches(policy, resp))
+
+		matched, total := window.sums(now)
+		if transition, ok := window.evaluate(policy, matched, total); ok {
+			transition.at = now
+			transitions = append(transitions, transition)
+		}
+	}
+
+	m.mu.Unlock()
+
+	for _, transition := range transitions {
+		m.act(ctx, transition)
+	}
+}
+
+// policies returns the tenant's ACTIVE policies, reloading the snapshot when
+// missing or older than the TTL. A failed reload keeps serving the previous
+// snapshot (last-known-good), or no policies when none was ever loaded, and is
+// retried after another TTL rather than on every validation, so a policy store
+// outage never adds load or latency to the validation path.
+func (m *DecisionAlertMonitor) policies(ctx context.Context, tenantID string) []*model.AlertPolicy {
+	now := m.clock.Now()
+
+	m.mu.Lock()
+	state := m.tenants[tenantID]
+
+	if state != nil && state.loaded && now.Sub(state.loadedAt) < m.ttl {
+		policies := state.policies
+		m.mu.Unlock()
+
+		return policies
+	}
+	m.mu.Unlock()
+
+	status := model.AlertPolicyStatusActive
+
+	loaded, err := m.repo.List(ctx, &model.ListAlertPoliciesFilter{Status: &status})
+	if err != nil {
+		logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+		logging.WithTrace(ctx, logger).With(
+			libLog.String("operation", "service.decision_alert.policy_reload"),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelWarn, "Failed to reload alert policies; serving previous snapshot")
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		state := m.tenantState(tenantID)
+		state.loadedAt = now
+		state.loaded = true
+
+		return state.policies
+	}
+
+	policies := make([]*model.AlertPolicy, 0, len(loaded))
+
+	for _, policy := range loaded {
+		if policy != nil && policy.IsActive() {
+			policies = append(policies, policy.Clone())
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state = m.tenantState(tenantID)
+	state.policies = policies
+	state.loadedAt = now
+	state.loaded = true
+
+	// Drop windows of policies that were deleted or deactivated.
+	for id := range state.windows {
+		if !slices.ContainsFunc(policies, func(p *model.AlertPolicy) bool { return p.ID == id }) {
+			delete(state.windows, id)
+		}
+	}
+
+	return policies
+}
+
+// tenantState returns the tenant's state, creating it on first use. Callers
+// hold m.mu.
+func (m *DecisionAlertMonitor) tenantState(tenantID string) *tenantAlertState {
+	state := m.tenants[tenantID]
+	if state == nil {
+		state = &tenantAlertState{windows: make(map[uuid.UUID]*policyWindow)}
+		m.tenants[tenantID] = state
+	}
+
+	return state
+}
+
+// window returns the policy's window, starting a fresh one when the policy is
+// new or was edited since the window was built. Callers hold m.mu.
+func (s *tenantAlertState) window(policy *model.AlertPolicy) *policyWindow {
+	window := s.windows[policy.ID]
+	if window == nil || !window.version.Equal(policy.UpdatedAt) {
+		window = &policyWindow{
+			version: policy.UpdatedAt,
+			width:   policy.Window() / alertWindowBuckets,
+		}
+		s.windows[policy.ID] = window
+	}
+
+	return window
+}
+
+// record counts one validation in the bucket covering now.
+func (w *policyWindow) record(now time.Time, matched bool) {
+	index := now.UnixNano() / int64(w.width)
+	bucket := &w.buckets[index%alertWindowBuckets]
+
+	if bucket.index != index {
+		*bucket = alertBucket{index: index}
+	}
+
+	bucket.total++
+
+	if matched {
+		bucket.matched++
+	}
+}
+
+// sums returns the matched and total counts of the buckets inside the window
+// ending at now.
+func (w *policyWindow) sums(now time.Time) (matched, total int64) {
+	current := now.UnixNano() / int64(w.width)
+
+	for i := range w.buckets {
+		bucket := w.buckets[i]
+		if bucket.total > 0 && bucket.index > current-alertWindowBuckets && bucket.index <= current {
+			matched += bucket.matched
+			total += bucket.total
+		}
+	}
+
+	return matched, total
+}
+
+// evaluate applies the trigger/resolve hysteresis and reports a transition.
+func (w *policyWindow) evaluate(policy *model.AlertPolicy, matched, total int64) (alertTransition, bool) {
+	rate := 0.0
+	if total > 0 {
+		rate = float64(matched) / float64(total)
+	}
+
+	switch {
+	case !w.firing && total >= int64(policy.MinSamples) && rate >= policy.Threshold:
+		w.firing = true
+
+		return alertTransition{policy: policy, triggered: true, matched: matched, total: total}, true
+	case w.firing && rate < policy.Threshold:
+		w.firing = false
+
+		return alertTransition{policy: policy, triggered: false, matched: matched, total: total}, true
+	default:
+		return alertTransition{}, false
+	}
+}
+
+// alertMatches reports whether a validation counts toward the policy's
+// numerator: the decision matches and, for rule policies, the rule matched.
+func alertMatches(policy *model.AlertPolicy, resp *model.ValidationResponse) bool {
+	if resp.Decision != policy.Decision {
+		return false
+	}
+
+	if policy.RuleID == nil {
+		return true
+	}
+
+	return slices.Contains(resp.MatchedRuleIDs, *policy.RuleID)
+}
+
+// act logs a transition and dispatches its side effects detached from the
+// request: the caller's cancellation must not abort an alert, but the tenant
+// carried by ctx must still resolve.
+func (m *DecisionAlertMonitor) act(ctx context.Context, transition alertTransition) {
+	logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+	logger = logging.WithTrace(ctx, logger)
+
+	policy := transition.policy
+	fields := []libLog.Field{
+		libLog.String("operation", "service.decision_alert.evaluate"),
+		libLog.String("alert_policy.id", policy.ID.String()),
+		libLog.String("alert_policy.decision", policy.Decision.String()),
+		libLog.Any("alert.matched", transition.matched),
+		libLog.Any("alert.total", transition.total),
+	}
+
+	if transition.triggered {
+		logger.With(fields...).Log(ctx, libLog.LevelWarn, "Decision alert triggered")
+	} else {
+		logger.With(fields...).Log(ctx, libLog.LevelInfo, "Decision alert resolved")
+	}
+
+	actionCtx := context.WithoutCancel(ctx)
+
+	m.dispatch(func() {
+		actionCtx, cancel := context.WithTimeout(actionCtx, alertActionTimeout)
+		defer cancel()
+
+		m.runActions(actionCtx, logger, transition)
+	})
+}
+
+// runActions emits the transition event and, for a triggered AutoDeactivate
+// policy, deactivates the rule. Failures are logged, never propagated.
+func (m *DecisionAlertMonitor) runActions(ctx context.Context, logger libLog.Logger, transition alertTransition) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.decision_alert.act")
+	defer span.End()
+
+	policy := transition.policy
+
+	if !transition.triggered {
+		pkgStreaming.EmitImportant(ctx, span, logger, m.streaming, events.DecisionAlertResolvedDefinition.Key(),
+			func(tenantID string) (libStreaming.EmitRequest, error) {
+				return events.NewDecisionAlertResolved(policy, transition.matched, transition.total).ToEmitRequest(tenantID, transition.at)
+			})
+
+		return
+	}
+
+	pkgStreaming.EmitImportant(ctx, span, logger, m.streaming, events.DecisionAlertTriggeredDefinition.Key(),
+		func(tenantID string) (libStreaming.EmitRequest, error) {
+			return events.NewDecisionAlertTriggered(policy, transition.matched, transition.total).ToEmitRequest(tenantID, transition.at)
+		})
+
+	if !policy.AutoDeactivate || policy.RuleID == nil || m.deactivator == nil {
+		return
+	}
+
+	reason := fmt.Sprintf(autoDeactivateReasonFormat,
+		policy.ID, policy.Decision, float64(transition.matched)/float64(transition.total), policy.WindowSeconds, policy.Threshold)
+
+	if _, err := m.deactivator.DeactivateRuleWithReason(ctx, *policy.RuleID, reason); err != nil {
+		logger.With(
+			libLog.String("operation", "service.decision_alert.auto_deactivate"),
+			libLog.String("alert_policy.id", policy.ID.String()),
+			libLog.String("rule.id", policy.RuleID.String()),
+			libLog.String("error.message", err.Error()),
+		).Log(ctx, libLog.LevelError, "Failed to auto-deactivate rule")
+
+		return
+	}
+
+	logger.With(
+		libLog.String("operation", "service.decision_alert.auto_deactivate"),
+		libLog.String("alert_policy.id", policy.ID.String()),
+		libLog.String("rule.id", policy.RuleID.String()),
+	).Log(ctx, libLog.LevelWarn, "Rule auto-deactivated by alert policy")
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	pkgStreaming "github.com/LerianStudio/midaz/v4/pkg/streaming"
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+)
+
+type recordingRuleDeactivator struct {
+	mu      sync.Mutex
+	ruleIDs []uuid.UUID
+	reasons []string
+	err     error
+}
+
+func (d *recordingRuleDeactivator) DeactivateRuleWithReason(_ context.Context, ruleID uuid.UUID, reason string) (*model.Rule, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.ruleIDs = append(d.ruleIDs, ruleID)
+	d.reasons = append(d.reasons, reason)
+
+	return nil, d.err
+}
+
+var alertMonitorStart = time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+func alertTestRequest(txType model.TransactionType) *model.ValidationRequest {
+	return &model.ValidationRequest{
+		RequestID:            uuid.New(),
+		TransactionType:      txType,
+		Amount:               decimal.RequireFromString("100"),
+		Currency:             "USD",
+		TransactionTimestamp: alertMonitorStart,
+		Account:              model.AccountContext{ID: testutil.MustDeterministicUUID(1)},
+	}
+}
+
+func alertTestResponse(decision model.Decision, matched ...uuid.UUID) *model.ValidationResponse {
+	resp := model.NewValidationResponse(uuid.New(), uuid.New(), decision, alertMonitorStart)
+	resp.MatchedRuleIDs = matched
+
+	return resp
+}
+
+func newTestAlertMonitor(t *testing.T, repo query.AlertPolicyRepository, clk *testutil.MockClock) (*DecisionAlertMonitor, *pkgStreaming.MockEmitter, *recordingRuleDeactivator) {
+	t.Helper()
+
+	emitter := pkgStreaming.NewMockEmitter()
+	deactivator := &recordingRuleDeactivator{}
+
+	monitor, err := NewDecisionAlertMonitor(repo, clk, emitter, deactivator, time.Hour)
+	require.NoError(t, err)
+
+	monitor.dispatch = func(f func()) { f() }
+
+	return monitor, emitter, deactivator
+}
+
+func TestNewDecisionAlertMonitor_NilRepository(t *testing.T) {
+	_, err := NewDecisionAlertMonitor(nil, nil, nil, nil, 0)
+	require.ErrorIs(t, err, ErrNilAlertPolicyRepository)
+}
+
+func TestDecisionAlertMonitor_TriggerAutoDeactivateAndResolve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := query.NewMockAlertPolicyRepository(ctrl)
+
+	ruleID := testutil.MustDeterministicUUID(10)
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:           "Rule deny spike",
+		RuleID:         &ruleID,
+		Decision:       model.DecisionDeny,
+		Threshold:      0.4,
+		WindowSeconds:  60,
+		MinSamples:     5,
+		AutoDeactivate: true,
+	}, alertMonitorStart)
+	require.NoError(t, err)
+
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.AlertPolicy{policy}, nil).Times(1)
+
+	clk := &testutil.MockClock{FixedTime: alertMonitorStart}
+	monitor, emitter, deactivator := newTestAlertMonitor(t, repo, clk)
+	ctx := context.Background()
+
+	// 3 allows + 1 deny: rate 0.25 and below MinSamples.
+	for range 3 {
+		monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+	}
+
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny, ruleID))
+	assert.Empty(t, emitter.Events())
+
+	// A deny by another rule does not count toward this rule's policy.
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny, uuid.New()))
+	assert.Empty(t, emitter.Events())
+
+	// 2 of 6 = 0.33, then 3 of 7 = 0.43 crosses the threshold.
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny, ruleID))
+	assert.Empty(t, emitter.Events())
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny, ruleID))
+
+	emitted := emitter.Events()
+	require.Len(t, emitted, 1)
+	assert.Equal(t, events.DecisionAlertTriggeredDefinition.Key(), emitted[0].DefinitionKey)
+	assert.Equal(t, policy.ID.String(), emitted[0].Subject)
+
+	var triggered events.DecisionAlertTriggeredPayload
+	require.NoError(t, json.Unmarshal(emitted[0].Payload, &triggered))
+	assert.Equal(t, int64(3), triggered.Matched)
+	assert.Equal(t, int64(7), triggered.Total)
+	assert.True(t, triggered.AutoDeactivate)
+
+	require.Equal(t, []uuid.UUID{ruleID}, deactivator.ruleIDs)
+	assert.Contains(t, deactivator.reasons[0], policy.ID.String())
+
+	// Still firing: no duplicate trigger.
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny, ruleID))
+	assert.Len(t, emitter.Events(), 1)
+
+	// Once the window slides past, fresh allows resolve the alert.
+	clk.SetTime(alertMonitorStart.Add(2 * time.Minute))
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+
+	emitted = emitter.Events()
+	require.Len(t, emitted, 2)
+	assert.Equal(t, events.DecisionAlertResolvedDefinition.Key(), emitted[1].DefinitionKey)
+
+	var resolved events.DecisionAlertResolvedPayload
+	require.NoError(t, json.Unmarshal(emitted[1].Payload, &resolved))
+	assert.Equal(t, int64(0), resolved.Matched)
+	assert.Equal(t, int64(1), resolved.Total)
+	assert.Len(t, deactivator.ruleIDs, 1)
+}
+
+func TestDecisionAlertMonitor_ScopeFiltersDenominator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := query.NewMockAlertPolicyRepository(ctrl)
+
+	pix := model.TransactionTypePix
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:          "PIX review volume",
+		Scope:         &model.Scope{TransactionType: &pix},
+		Decision:      model.DecisionReview,
+		Threshold:     0.5,
+		WindowSeconds: 300,
+		MinSamples:    2,
+	}, alertMonitorStart)
+	require.NoError(t, err)
+
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.AlertPolicy{policy}, nil)
+
+	clk := &testutil.MockClock{FixedTime: alertMonitorStart}
+	monitor, emitter, deactivator := newTestAlertMonitor(t, repo, clk)
+	ctx := context.Background()
+
+	// Out-of-scope CARD traffic is ignored entirely.
+	for range 10 {
+		monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+	}
+
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypePix), alertTestResponse(model.DecisionReview))
+	assert.Empty(t, emitter.Events(), "one sample is below MinSamples")
+
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypePix), alertTestResponse(model.DecisionAllow))
+
+	emitted := emitter.Events()
+	require.Len(t, emitted, 1)
+	assert.Equal(t, events.DecisionAlertTriggeredDefinition.Key(), emitted[0].DefinitionKey)
+	assert.Empty(t, deactivator.ruleIDs, "decision-wide policies never deactivate rules")
+}
+
+func TestDecisionAlertMonitor_PolicyEditResetsWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := query.NewMockAlertPolicyRepository(ctrl)
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:          "Deny spike",
+		Decision:      model.DecisionDeny,
+		Threshold:     0.5,
+		WindowSeconds: 300,
+		MinSamples:    3,
+	}, alertMonitorStart)
+	require.NoError(t, err)
+
+	edited := policy.Clone()
+	require.NoError(t, edited.Update(model.AlertPolicyPatch{Threshold: testutil.Ptr(0.6)}, alertMonitorStart.Add(time.Second)))
+
+	gomock.InOrder(
+		repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.AlertPolicy{policy}, nil),
+		repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.AlertPolicy{edited}, nil),
+	)
+
+	clk := &testutil.MockClock{FixedTime: alertMonitorStart}
+	monitor, emitter, _ := newTestAlertMonitor(t, repo, clk)
+	ctx := context.Background()
+
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+
+	monitor.Invalidate(ctx)
+
+	// The edited policy starts from an empty window, so this is 1 sample.
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+	assert.Empty(t, emitter.Events())
+}
+
+func TestDecisionAlertMonitor_ReloadFailureServesPreviousSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := query.NewMockAlertPolicyRepository(ctrl)
+
+	policy, err := model.NewAlertPolicy(model.AlertPolicySpec{
+		Name:          "Deny spike",
+		Decision:      model.DecisionDeny,
+		Threshold:     0.5,
+		WindowSeconds: 300,
+		MinSamples:    2,
+	}, alertMonitorStart)
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.AlertPolicy{policy}, nil),
+		repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused")),
+	)
+
+	clk := &testutil.MockClock{FixedTime: alertMonitorStart}
+	monitor, emitter, _ := newTestAlertMonitor(t, repo, clk)
+	ctx := context.Background()
+
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+
+	// The reload fails but the previous snapshot keeps evaluating, and the
+	// failure is not retried on the next observation.
+	clk.SetTime(alertMonitorStart.Add(61 * time.Minute))
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+	monitor.Observe(ctx, alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionDeny))
+
+	emitted := emitter.Events()
+	require.Len(t, emitted, 1)
+	assert.Equal(t, events.DecisionAlertTriggeredDefinition.Key(), emitted[0].DefinitionKey)
+}
+
+func TestDecisionAlertMonitor_TenantsAreIsolated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := query.NewMockAlertPolicyRepository(ctrl)
+
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+	clk := &testutil.MockClock{FixedTime: alertMonitorStart}
+	monitor, _, _ := newTestAlertMonitor(t, repo, clk)
+
+	monitor.Observe(tmcore.ContextWithTenantID(context.Background(), "tenant-a"), alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+	monitor.Observe(tmcore.ContextWithTenantID(context.Background(), "tenant-b"), alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+	monitor.Observe(tmcore.ContextWithTenantID(context.Background(), "tenant-a"), alertTestRequest(model.TransactionTypeCard), alertTestResponse(model.DecisionAllow))
+}
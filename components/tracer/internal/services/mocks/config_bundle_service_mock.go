@@ -0,0 +1,262 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: config_bundle_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=config_bundle_service.go -destination=mocks/config_bundle_service_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	command "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBundleRuleStore is a mock of BundleRuleStore interface.
+type MockBundleRuleStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBundleRuleStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockBundleRuleStoreMockRecorder is the mock recorder for MockBundleRuleStore.
+type MockBundleRuleStoreMockRecorder struct {
+	mock *MockBundleRuleStore
+}
+
+// NewMockBundleRuleStore creates a new mock instance.
+func NewMockBundleRuleStore(ctrl *gomock.Controller) *MockBundleRuleStore {
+	mock := &MockBundleRuleStore{ctrl: ctrl}
+	mock.recorder = &MockBundleRuleStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBundleRuleStore) EXPECT() *MockBundleRuleStoreMockRecorder {
+	return m.recorder
+}
+
+// ActivateRule mocks base method.
+func (m *MockBundleRuleStore) ActivateRule(ctx context.Context, id uuid.UUID) (*model.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateRule", ctx, id)
+	ret0, _ := ret[0].(*model.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateRule indicates an expected call of ActivateRule.
+func (mr *MockBundleRuleStoreMockRecorder) ActivateRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateRule", reflect.TypeOf((*MockBundleRuleStore)(nil).ActivateRule), ctx, id)
+}
+
+// CreateRule mocks base method.
+func (m *MockBundleRuleStore) CreateRule(ctx context.Context, input *command.CreateRuleInput) (*model.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, input)
+	ret0, _ := ret[0].(*model.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockBundleRuleStoreMockRecorder) CreateRule(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockBundleRuleStore)(nil).CreateRule), ctx, input)
+}
+
+// DeactivateRule mocks base method.
+func (m *MockBundleRuleStore) DeactivateRule(ctx context.Context, id uuid.UUID) (*model.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateRule", ctx, id)
+	ret0, _ := ret[0].(*model.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateRule indicates an expected call of DeactivateRule.
+func (mr *MockBundleRuleStoreMockRecorder) DeactivateRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateRule", reflect.TypeOf((*MockBundleRuleStore)(nil).DeactivateRule), ctx, id)
+}
+
+// DraftRule mocks base method.
+func (m *MockBundleRuleStore) DraftRule(ctx context.Context, id uuid.UUID) (*model.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftRule", ctx, id)
+	ret0, _ := ret[0].(*model.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DraftRule indicates an expected call of DraftRule.
+func (mr *MockBundleRuleStoreMockRecorder) DraftRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftRule", reflect.TypeOf((*MockBundleRuleStore)(nil).DraftRule), ctx, id)
+}
+
+// ListRules mocks base method.
+func (m *MockBundleRuleStore) ListRules(ctx context.Context, filter *model.ListRulesFilter) (*model.ListRulesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, filter)
+	ret0, _ := ret[0].(*model.ListRulesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockBundleRuleStoreMockRecorder) ListRules(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockBundleRuleStore)(nil).ListRules), ctx, filter)
+}
+
+// UpdateRule mocks base method.
+func (m *MockBundleRuleStore) UpdateRule(ctx context.Context, id uuid.UUID, input *command.UpdateRuleInput) (*model.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, id, input)
+	ret0, _ := ret[0].(*model.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockBundleRuleStoreMockRecorder) UpdateRule(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockBundleRuleStore)(nil).UpdateRule), ctx, id, input)
+}
+
+// ValidateExpression mocks base method.
+func (m *MockBundleRuleStore) ValidateExpression(ctx context.Context, expression string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateExpression", ctx, expression)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateExpression indicates an expected call of ValidateExpression.
+func (mr *MockBundleRuleStoreMockRecorder) ValidateExpression(ctx, expression any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateExpression", reflect.TypeOf((*MockBundleRuleStore)(nil).ValidateExpression), ctx, expression)
+}
+
+// MockBundleLimitStore is a mock of BundleLimitStore interface.
+type MockBundleLimitStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBundleLimitStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockBundleLimitStoreMockRecorder is the mock recorder for MockBundleLimitStore.
+type MockBundleLimitStoreMockRecorder struct {
+	mock *MockBundleLimitStore
+}
+
+// NewMockBundleLimitStore creates a new mock instance.
+func NewMockBundleLimitStore(ctrl *gomock.Controller) *MockBundleLimitStore {
+	mock := &MockBundleLimitStore{ctrl: ctrl}
+	mock.recorder = &MockBundleLimitStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBundleLimitStore) EXPECT() *MockBundleLimitStoreMockRecorder {
+	return m.recorder
+}
+
+// ActivateLimit mocks base method.
+func (m *MockBundleLimitStore) ActivateLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateLimit", ctx, id)
+	ret0, _ := ret[0].(*model.Limit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateLimit indicates an expected call of ActivateLimit.
+func (mr *MockBundleLimitStoreMockRecorder) ActivateLimit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateLimit", reflect.TypeOf((*MockBundleLimitStore)(nil).ActivateLimit), ctx, id)
+}
+
+// CreateLimit mocks base method.
+func (m *MockBundleLimitStore) CreateLimit(ctx context.Context, input *command.CreateLimitInput) (*model.Limit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLimit", ctx, input)
+	ret0, _ := ret[0].(*model.Limit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLimit indicates an expected call of CreateLimit.
+func (mr *MockBundleLimitStoreMockRecorder) CreateLimit(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLimit", reflect.TypeOf((*MockBundleLimitStore)(nil).CreateLimit), ctx, input)
+}
+
+// DeactivateLimit mocks base method.
+func (m *MockBundleLimitStore) DeactivateLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateLimit", ctx, id)
+	ret0, _ := ret[0].(*model.Limit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateLimit indicates an expected call of DeactivateLimit.
+func (mr *MockBundleLimitStoreMockRecorder) DeactivateLimit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateLimit", reflect.TypeOf((*MockBundleLimitStore)(nil).DeactivateLimit), ctx, id)
+}
+
+// DraftLimit mocks base method.
+func (m *MockBundleLimitStore) DraftLimit(ctx context.Context, id uuid.UUID) (*model.Limit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftLimit", ctx, id)
+	ret0, _ := ret[0].(*model.Limit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DraftLimit indicates an expected call of DraftLimit.
+func (mr *MockBundleLimitStoreMockRecorder) DraftLimit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftLimit", reflect.TypeOf((*MockBundleLimitStore)(nil).DraftLimit), ctx, id)
+}
+
+// ListLimits mocks base method.
+func (m *MockBundleLimitStore) ListLimits(ctx context.Context, filter *model.ListLimitsFilter) (*model.ListLimitsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLimits", ctx, filter)
+	ret0, _ := ret[0].(*model.ListLimitsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLimits indicates an expected call of ListLimits.
+func (mr *MockBundleLimitStoreMockRecorder) ListLimits(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLimits", reflect.TypeOf((*MockBundleLimitStore)(nil).ListLimits), ctx, filter)
+}
+
+// UpdateLimit mocks base method.
+func (m *MockBundleLimitStore) UpdateLimit(ctx context.Context, id uuid.UUID, input *command.UpdateLimitInput) (*model.Limit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLimit", ctx, id, input)
+	ret0, _ := ret[0].(*model.Limit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLimit indicates an expected call of UpdateLimit.
+func (mr *MockBundleLimitStoreMockRecorder) UpdateLimit(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLimit", reflect.TypeOf((*MockBundleLimitStore)(nil).UpdateLimit), ctx, id, input)
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+//go:generate mockgen -source=alert_policy_repository.go -destination=alert_policy_repository_mock.go -package=query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// AlertPolicyRepository defines the interface for alert policy reads. Shared by
+// the get/list queries and the decision alert monitor's policy snapshot.
+type AlertPolicyRepository interface {
+	// GetByID retrieves a policy by ID, regardless of status.
+	// Returns constant.ErrAlertPolicyNotFound if the policy does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error)
+
+	// List retrieves policies ordered by creation time. A nil filter returns
+	// policies of every status.
+	List(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error)
+}
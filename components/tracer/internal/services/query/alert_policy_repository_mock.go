@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert_policy_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=alert_policy_repository.go -destination=alert_policy_repository_mock.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlertPolicyRepository is a mock of AlertPolicyRepository interface.
+type MockAlertPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertPolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertPolicyRepositoryMockRecorder is the mock recorder for MockAlertPolicyRepository.
+type MockAlertPolicyRepositoryMockRecorder struct {
+	mock *MockAlertPolicyRepository
+}
+
+// NewMockAlertPolicyRepository creates a new mock instance.
+func NewMockAlertPolicyRepository(ctrl *gomock.Controller) *MockAlertPolicyRepository {
+	mock := &MockAlertPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockAlertPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertPolicyRepository) EXPECT() *MockAlertPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockAlertPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAlertPolicyRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAlertPolicyRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockAlertPolicyRepository) List(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*model.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAlertPolicyRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAlertPolicyRepository)(nil).List), ctx, filter)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// GetAlertPolicyQuery handles retrieving a single alert policy.
+type GetAlertPolicyQuery struct {
+	repo AlertPolicyRepository
+}
+
+// NewGetAlertPolicyQuery creates a new GetAlertPolicyQuery instance.
+func NewGetAlertPolicyQuery(repo AlertPolicyRepository) *GetAlertPolicyQuery {
+	return &GetAlertPolicyQuery{repo: repo}
+}
+
+// Execute retrieves an alert policy by ID.
+// Returns constant.ErrAlertPolicyNotFound if the policy doesn't exist.
+func (q *GetAlertPolicyQuery) Execute(ctx context.Context, id uuid.UUID) (_ *model.AlertPolicy, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.alert_policy.get")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "alert_policy_get", start, retErr)
+	}()
+
+	policy, err := q.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constant.ErrAlertPolicyNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Alert policy not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get alert policy", err)
+
+		return nil, err
+	}
+
+	return policy, nil
+}
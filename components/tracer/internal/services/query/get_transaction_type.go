@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// GetTransactionTypeQuery handles retrieving a single catalog entry.
+type GetTransactionTypeQuery struct {
+	repo TransactionTypeRepository
+}
+
+// NewGetTransactionTypeQuery creates a new GetTransactionTypeQuery instance.
+func NewGetTransactionTypeQuery(repo TransactionTypeRepository) *GetTransactionTypeQuery {
+	return &GetTransactionTypeQuery{repo: repo}
+}
+
+// Execute retrieves a catalog entry by code.
+// Returns constant.ErrTransactionTypeInvalidCode for an ill-formed code and
+// constant.ErrTransactionTypeNotFound if the entry doesn't exist.
+func (q *GetTransactionTypeQuery) Execute(ctx context.Context, code model.TransactionType) (_ *model.TransactionTypeDefinition, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.transaction_type.get")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "transaction_type_get", start, retErr)
+	}()
+
+	if !code.IsValid() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid transaction type code", constant.ErrTransactionTypeInvalidCode)
+		return nil, constant.ErrTransactionTypeInvalidCode
+	}
+
+	def, err := q.repo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, constant.ErrTransactionTypeNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Transaction type not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get transaction type", err)
+
+		return nil, err
+	}
+
+	return def, nil
+}
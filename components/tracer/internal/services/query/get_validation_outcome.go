@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// GetValidationOutcomeQuery handles retrieving the label of a validation.
+type GetValidationOutcomeQuery struct {
+	repo ValidationOutcomeRepository
+}
+
+// NewGetValidationOutcomeQuery creates a new GetValidationOutcomeQuery instance.
+func NewGetValidationOutcomeQuery(repo ValidationOutcomeRepository) *GetValidationOutcomeQuery {
+	return &GetValidationOutcomeQuery{repo: repo}
+}
+
+// Execute retrieves the label recorded for a validation.
+// Returns constant.ErrValidationOutcomeNotFound if the validation is unlabeled.
+func (q *GetValidationOutcomeQuery) Execute(ctx context.Context, validationID uuid.UUID) (_ *model.ValidationOutcome, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.validation_outcome.get")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "validation_outcome_get", start, retErr)
+	}()
+
+	outcome, err := q.repo.GetByValidationID(ctx, validationID)
+	if err != nil {
+		if errors.Is(err, constant.ErrValidationOutcomeNotFound) {
+			libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Validation outcome not found", err)
+			return nil, err
+		}
+
+		libOpentelemetry.HandleSpanError(span, "Failed to get validation outcome", err)
+
+		return nil, err
+	}
+
+	return outcome, nil
+}
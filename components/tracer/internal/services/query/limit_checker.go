@@ -553,12 +553,14 @@ func buildTransactionScope(input *model.CheckLimitsInput) *model.Scope {
 	}
 
 	return &model.Scope{
-		AccountID:       &input.AccountID,
-		SegmentID:       input.SegmentID,
-		PortfolioID:     input.PortfolioID,
-		MerchantID:      input.MerchantID,
-		TransactionType: input.TransactionType,
-		SubType:         input.SubType,
+		AccountID:            &input.AccountID,
+		SegmentID:            input.SegmentID,
+		PortfolioID:          input.PortfolioID,
+		MerchantID:           input.MerchantID,
+		TransactionType:      input.TransactionType,
+		SubType:              input.SubType,
+		Country:              input.Country,
+		CounterpartyBankCode: input.CounterpartyBankCode,
 	}
 }
 
@@ -645,6 +647,14 @@ func formatScopeString(scopes []model.Scope) string {
 			fields = append(fields, "subType:"+*scope.SubType)
 		}
 
+		if scope.Country != nil {
+			fields = append(fields, "country:"+*scope.Country)
+		}
+
+		if scope.CounterpartyBankCode != nil {
+			fields = append(fields, "counterpartyBank:"+*scope.CounterpartyBankCode)
+		}
+
 		if len(fields) > 0 {
 			scopeGroups = append(scopeGroups, "("+strings.Join(fields, ",")+")")
 		}
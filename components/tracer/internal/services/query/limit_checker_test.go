@@ -1560,6 +1560,13 @@ func TestFormatScopeString(t *testing.T) {
 			},
 			expected: "(account:" + accountID1.String() + ",segment:" + segmentID.String() + ",portfolio:" + portfolioID.String() + ",merchant:" + merchantID.String() + ",transactionType:CARD,subType:online)",
 		},
+		{
+			name: "scope with country and counterparty bank",
+			scopes: []model.Scope{
+				{Country: testutil.StringPtr("BR"), CounterpartyBankCode: testutil.StringPtr("341")},
+			},
+			expected: "(country:BR,counterpartyBank:341)",
+		},
 		{
 			name: "scope with empty fields returns global",
 			scopes: []model.Scope{
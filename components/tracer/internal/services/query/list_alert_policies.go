@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// ListAlertPoliciesQuery handles listing alert policies.
+type ListAlertPoliciesQuery struct {
+	repo AlertPolicyRepository
+}
+
+// NewListAlertPoliciesQuery creates a new ListAlertPoliciesQuery instance.
+func NewListAlertPoliciesQuery(repo AlertPolicyRepository) *ListAlertPoliciesQuery {
+	return &ListAlertPoliciesQuery{repo: repo}
+}
+
+// Execute lists alert policies, optionally filtered by status and rule.
+// Returns constant.ErrAlertPolicyInvalidStatus for an unknown status filter.
+func (q *ListAlertPoliciesQuery) Execute(ctx context.Context, filter *model.ListAlertPoliciesFilter) ([]*model.AlertPolicy, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.alert_policy.list")
+	defer span.End()
+
+	if filter != nil && filter.Status != nil && !filter.Status.IsValid() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid status filter", constant.ErrAlertPolicyInvalidStatus)
+		return nil, constant.ErrAlertPolicyInvalidStatus
+	}
+
+	policies, err := q.repo.List(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list alert policies", err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("app.response.alert_policies_count", len(policies)))
+
+	return policies, nil
+}
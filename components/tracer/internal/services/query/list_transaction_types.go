@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// ListTransactionTypesQuery handles listing the transaction-type catalog.
+type ListTransactionTypesQuery struct {
+	repo TransactionTypeRepository
+}
+
+// NewListTransactionTypesQuery creates a new ListTransactionTypesQuery instance.
+func NewListTransactionTypesQuery(repo TransactionTypeRepository) *ListTransactionTypesQuery {
+	return &ListTransactionTypesQuery{repo: repo}
+}
+
+// Execute lists catalog entries, optionally filtered by status.
+// Returns constant.ErrTransactionTypeInvalidStatus for an unknown status filter.
+func (q *ListTransactionTypesQuery) Execute(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error) {
+	_, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.transaction_type.list")
+	defer span.End()
+
+	if filter != nil && filter.Status != nil && !filter.Status.IsValid() {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid status filter", constant.ErrTransactionTypeInvalidStatus)
+		return nil, constant.ErrTransactionTypeInvalidStatus
+	}
+
+	defs, err := q.repo.List(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list transaction types", err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("app.response.transaction_types_count", len(defs)))
+
+	return defs, nil
+}
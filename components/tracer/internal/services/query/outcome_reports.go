@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"time"
+
+	libObservability "github.com/LerianStudio/lib-observability"
+	libOpentelemetry "github.com/LerianStudio/lib-observability/tracing"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/utils"
+)
+
+// GetRulePerformanceReportQuery computes per-rule precision and recall from
+// labeled validations.
+type GetRulePerformanceReportQuery struct {
+	repo ValidationOutcomeRepository
+}
+
+// NewGetRulePerformanceReportQuery creates a new GetRulePerformanceReportQuery instance.
+func NewGetRulePerformanceReportQuery(repo ValidationOutcomeRepository) *GetRulePerformanceReportQuery {
+	return &GetRulePerformanceReportQuery{repo: repo}
+}
+
+// Execute builds the report for the filter window (default: last 90 days).
+// Returns constant.ErrInvalidOutcomeReportFilters for an invalid window.
+func (q *GetRulePerformanceReportQuery) Execute(ctx context.Context, filter *model.OutcomeReportFilter) (_ *model.RulePerformanceReport, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.validation_outcome.rule_performance")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "validation_outcome_rule_performance", start, retErr)
+	}()
+
+	filter, err := prepareOutcomeReportFilter(filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid outcome report filter", err)
+		return nil, err
+	}
+
+	counts, err := q.repo.RuleOutcomeCounts(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to aggregate rule outcomes", err)
+		return nil, err
+	}
+
+	report := &model.RulePerformanceReport{
+		StartDate: filter.StartDate,
+		EndDate:   filter.EndDate,
+		Rules:     make([]model.RulePerformance, 0, len(counts)),
+	}
+
+	for _, c := range counts {
+		report.Rules = append(report.Rules, model.NewRulePerformance(c))
+	}
+
+	span.SetAttributes(attribute.Int("app.response.rules_count", len(report.Rules)))
+
+	return report, nil
+}
+
+// ExportOutcomeDatasetQuery exports labeled validations as a training and
+// analysis dataset.
+type ExportOutcomeDatasetQuery struct {
+	repo ValidationOutcomeRepository
+}
+
+// NewExportOutcomeDatasetQuery creates a new ExportOutcomeDatasetQuery instance.
+func NewExportOutcomeDatasetQuery(repo ValidationOutcomeRepository) *ExportOutcomeDatasetQuery {
+	return &ExportOutcomeDatasetQuery{repo: repo}
+}
+
+// Execute returns the labeled validations for the filter window.
+// Returns constant.ErrInvalidOutcomeReportFilters for invalid filters.
+func (q *ExportOutcomeDatasetQuery) Execute(ctx context.Context, filter *model.OutcomeReportFilter) (_ []*model.OutcomeDatasetRow, retErr error) {
+	logger, tracer, _, factory := libObservability.NewTrackingFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "service.validation_outcome.export_dataset")
+	defer span.End()
+
+	start := time.Now()
+
+	defer func() {
+		utils.RecordDomainOperation(ctx, factory, logger, "tracer", "validation_outcome_export_dataset", start, retErr)
+	}()
+
+	filter, err := prepareOutcomeReportFilter(filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanBusinessErrorEvent(span, "Invalid outcome report filter", err)
+		return nil, err
+	}
+
+	rows, err := q.repo.ListDataset(ctx, filter)
+	if err != nil {
+		libOpentelemetry.HandleSpanError(span, "Failed to list outcome dataset", err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("app.response.rows_count", len(rows)))
+
+	return rows, nil
+}
+
+// prepareOutcomeReportFilter copies the filter, applies defaults and validates
+// it, so callers never see their input mutated.
+func prepareOutcomeReportFilter(filter *model.OutcomeReportFilter) (*model.OutcomeReportFilter, error) {
+	prepared := model.OutcomeReportFilter{}
+	if filter != nil {
+		prepared = *filter
+	}
+
+	prepared.SetDefaults()
+
+	if err := prepared.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &prepared, nil
+}
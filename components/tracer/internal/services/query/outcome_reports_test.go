@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func TestGetRulePerformanceReport_ComputesRatios(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockValidationOutcomeRepository(ctrl)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(30 * 24 * time.Hour)
+	ruleID := testutil.MustDeterministicUUID(1)
+
+	repo.EXPECT().
+		RuleOutcomeCounts(gomock.Any(), &model.OutcomeReportFilter{StartDate: start, EndDate: end, Limit: model.DefaultOutcomeDatasetLimit}).
+		Return([]model.RuleOutcomeCounts{{RuleID: ruleID, RuleName: "High value", RuleAction: model.DecisionDeny, TruePositives: 9, FalsePositives: 1, FalseNegatives: 3}}, nil)
+
+	report, err := NewGetRulePerformanceReportQuery(repo).Execute(context.Background(), &model.OutcomeReportFilter{StartDate: start, EndDate: end})
+	require.NoError(t, err)
+	assert.Equal(t, start, report.StartDate)
+	require.Len(t, report.Rules, 1)
+	assert.Equal(t, "High value", report.Rules[0].RuleName)
+	assert.InDelta(t, 0.9, *report.Rules[0].Precision, 1e-9)
+	assert.InDelta(t, 0.75, *report.Rules[0].Recall, 1e-9)
+}
+
+func TestOutcomeQueries_RejectInvalidWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockValidationOutcomeRepository(ctrl)
+
+	// Only start_date: the repository must not be reached.
+	filter := &model.OutcomeReportFilter{StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	_, err := NewGetRulePerformanceReportQuery(repo).Execute(context.Background(), filter)
+	require.ErrorIs(t, err, constant.ErrInvalidOutcomeReportFilters)
+
+	_, err = NewExportOutcomeDatasetQuery(repo).Execute(context.Background(), filter)
+	require.ErrorIs(t, err, constant.ErrInvalidOutcomeReportFilters)
+
+	// The caller's filter is not mutated by defaulting.
+	assert.True(t, filter.EndDate.IsZero())
+	assert.Zero(t, filter.Limit)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	libObservability "github.com/LerianStudio/lib-observability"
+	libLog "github.com/LerianStudio/lib-observability/log"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/clock"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// DefaultTransactionTypeCatalogTTL bounds how long a tenant's catalog snapshot
+// is served before it is reloaded. Writes made through this instance invalidate
+// immediately; the TTL only bounds staleness for writes made by other replicas.
+const DefaultTransactionTypeCatalogTTL = 30 * time.Second
+
+// transactionTypeSnapshot is one tenant's catalog, keyed by code.
+type transactionTypeSnapshot struct {
+	types    map[model.TransactionType]*model.TransactionTypeDefinition
+	loadedAt time.Time
+}
+
+// TransactionTypeCatalog answers "is this transaction type (and subtype)
+// accepted?" on the validation and rule/limit write paths without a database
+// round-trip per request.
+//
+// Snapshots are partitioned per tenant using tmcore.GetTenantIDContext, like
+// cache.RuleCache; single-tenant deployments use the "" bucket. When a reload
+// fails and a previous snapshot exists, the previous snapshot keeps being
+// served (last-known-good) and the failure is logged, so a transient database
+// error does not start rejecting every validation.
+type TransactionTypeCatalog struct {
+	repo  TransactionTypeRepository
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu        sync.RWMutex
+	snapshots map[string]*transactionTypeSnapshot
+}
+
+// NewTransactionTypeCatalog creates a catalog cache over repo.
+// A nil clock defaults to the real clock and a non-positive ttl defaults to
+// DefaultTransactionTypeCatalogTTL.
+func NewTransactionTypeCatalog(repo TransactionTypeRepository, clk clock.Clock, ttl time.Duration) *TransactionTypeCatalog {
+	if clk == nil {
+		clk = clock.New()
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTransactionTypeCatalogTTL
+	}
+
+	return &TransactionTypeCatalog{
+		repo:      repo,
+		clock:     clk,
+		ttl:       ttl,
+		snapshots: make(map[string]*transactionTypeSnapshot),
+	}
+}
+
+// Check verifies that txType is registered and ACTIVE in the tenant's catalog
+// and that subType (when non-nil) is allowed for it.
+//
+// Returns constant.ErrTransactionTypeNotRegistered for an unknown or inactive
+// type, constant.ErrTransactionTypeSubTypeNotAllowed for a rejected subtype, or
+// a wrapped infrastructure error when the catalog cannot be loaded at all.
+func (c *TransactionTypeCatalog) Check(ctx context.Context, txType model.TransactionType, subType *string) error {
+	snapshot, err := c.snapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	def, ok := snapshot.types[txType]
+	if !ok || !def.IsActive() {
+		return constant.ErrTransactionTypeNotRegistered
+	}
+
+	if !def.AllowsSubType(subType) {
+		return constant.ErrTransactionTypeSubTypeNotAllowed
+	}
+
+	return nil
+}
+
+// Invalidate drops the snapshot of the tenant resolved from ctx so the next
+// Check reloads it. Called after every successful catalog write.
+func (c *TransactionTypeCatalog) Invalidate(ctx context.Context) {
+	tenantID := tmcore.GetTenantIDContext(ctx)
+
+	c.mu.Lock()
+	delete(c.snapshots, tenantID)
+	c.mu.Unlock()
+}
+
+// snapshot returns the tenant's current snapshot, reloading it when missing or
+// older than the TTL.
+func (c *TransactionTypeCatalog) snapshot(ctx context.Context) (*transactionTypeSnapshot, error) {
+	tenantID := tmcore.GetTenantIDContext(ctx)
+	now := c.clock.Now()
+
+	c.mu.RLock()
+	current := c.snapshots[tenantID]
+	c.mu.RUnlock()
+
+	if current != nil && now.Sub(current.loadedAt) < c.ttl {
+		return current, nil
+	}
+
+	defs, err := c.repo.List(ctx, nil)
+	if err != nil {
+		if current != nil {
+			logger, _, _, _ := libObservability.NewTrackingFromContext(ctx)
+			logging.WithTrace(ctx, logger).With(
+				libLog.String("operation", "service.transaction_type.catalog_reload"),
+				libLog.String("error.message", err.Error()),
+			).Log(ctx, libLog.LevelWarn, "Failed to reload transaction-type catalog; serving previous snapshot")
+
+			return current, nil
+		}
+
+		return nil, fmt.Errorf("failed to load transaction-type catalog: %w", err)
+	}
+
+	next := &transactionTypeSnapshot{
+		types:    make(map[model.TransactionType]*model.TransactionTypeDefinition, len(defs)),
+		loadedAt: now,
+	}
+
+	for _, def := range defs {
+		if def != nil {
+			next.types[def.Code] = def.Clone()
+		}
+	}
+
+	c.mu.Lock()
+	c.snapshots[tenantID] = next
+	c.mu.Unlock()
+
+	return next, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tmcore "github.com/LerianStudio/lib-commons/v5/commons/tenant-manager/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func newCatalogEntry(t *testing.T, code string, subTypes []string, status model.TransactionTypeStatus) *model.TransactionTypeDefinition {
+	t.Helper()
+
+	def, err := model.NewTransactionTypeDefinition(code, nil, subTypes, testutil.FixedTime())
+	require.NoError(t, err)
+
+	def.Status = status
+
+	return def
+}
+
+func TestTransactionTypeCatalog_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+
+	repo.EXPECT().List(gomock.Any(), nil).Return([]*model.TransactionTypeDefinition{
+		newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive),
+		newCatalogEntry(t, "BOLETO", []string{"registered"}, model.TransactionTypeStatusActive),
+		newCatalogEntry(t, "ACH", nil, model.TransactionTypeStatusInactive),
+	}, nil).Times(1)
+
+	catalog := NewTransactionTypeCatalog(repo, testutil.NewDefaultMockClock(), time.Minute)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		txType  model.TransactionType
+		subType *string
+		wantErr error
+	}{
+		{name: "registered type without subtype", txType: "CARD"},
+		{name: "open type accepts any subtype", txType: "CARD", subType: testutil.StringPtr("debit")},
+		{name: "allowed subtype is case-insensitive", txType: "BOLETO", subType: testutil.StringPtr("Registered")},
+		{name: "subtype not allowed", txType: "BOLETO", subType: testutil.StringPtr("express"), wantErr: constant.ErrTransactionTypeSubTypeNotAllowed},
+		{name: "inactive type", txType: "ACH", wantErr: constant.ErrTransactionTypeNotRegistered},
+		{name: "unknown type", txType: "CASH", wantErr: constant.ErrTransactionTypeNotRegistered},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := catalog.Check(ctx, tt.txType, tt.subType)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionTypeCatalog_ReloadsAfterTTLAndInvalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+
+	clk := &testutil.MockClock{FixedTime: testutil.FixedTime()}
+	catalog := NewTransactionTypeCatalog(repo, clk, time.Minute)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		repo.EXPECT().List(gomock.Any(), nil).Return([]*model.TransactionTypeDefinition{
+			newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive),
+		}, nil),
+		repo.EXPECT().List(gomock.Any(), nil).Return([]*model.TransactionTypeDefinition{
+			newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive),
+			newCatalogEntry(t, "BOLETO", nil, model.TransactionTypeStatusActive),
+		}, nil),
+		repo.EXPECT().List(gomock.Any(), nil).Return([]*model.TransactionTypeDefinition{
+			newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusInactive),
+		}, nil),
+	)
+
+	require.ErrorIs(t, catalog.Check(ctx, "BOLETO", nil), constant.ErrTransactionTypeNotRegistered)
+
+	// Within TTL the snapshot is reused.
+	clk.SetTime(testutil.FixedTime().Add(30 * time.Second))
+	require.ErrorIs(t, catalog.Check(ctx, "BOLETO", nil), constant.ErrTransactionTypeNotRegistered)
+
+	// TTL elapsed: reloaded snapshot sees the new entry.
+	clk.SetTime(testutil.FixedTime().Add(2 * time.Minute))
+	require.NoError(t, catalog.Check(ctx, "BOLETO", nil))
+
+	// Invalidate forces a reload before the TTL.
+	catalog.Invalidate(ctx)
+	require.ErrorIs(t, catalog.Check(ctx, "CARD", nil), constant.ErrTransactionTypeNotRegistered)
+}
+
+func TestTransactionTypeCatalog_LoadFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	dbErr := errors.New("connection refused")
+
+	clk := &testutil.MockClock{FixedTime: testutil.FixedTime()}
+	catalog := NewTransactionTypeCatalog(repo, clk, time.Minute)
+	ctx := context.Background()
+
+	// No previous snapshot: the error surfaces.
+	repo.EXPECT().List(gomock.Any(), nil).Return(nil, dbErr)
+
+	err := catalog.Check(ctx, "CARD", nil)
+	require.ErrorIs(t, err, dbErr)
+
+	// With a previous snapshot, a failed reload keeps serving it.
+	repo.EXPECT().List(gomock.Any(), nil).Return([]*model.TransactionTypeDefinition{
+		newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive),
+	}, nil)
+	require.NoError(t, catalog.Check(ctx, "CARD", nil))
+
+	clk.SetTime(testutil.FixedTime().Add(2 * time.Minute))
+	repo.EXPECT().List(gomock.Any(), nil).Return(nil, dbErr)
+	require.NoError(t, catalog.Check(ctx, "CARD", nil))
+}
+
+func TestTransactionTypeCatalog_PerTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+
+	catalog := NewTransactionTypeCatalog(repo, testutil.NewDefaultMockClock(), time.Minute)
+	tenantA := tmcore.ContextWithTenantID(context.Background(), "tenant-a")
+	tenantB := tmcore.ContextWithTenantID(context.Background(), "tenant-b")
+
+	repo.EXPECT().List(tenantA, nil).Return([]*model.TransactionTypeDefinition{
+		newCatalogEntry(t, "BOLETO", nil, model.TransactionTypeStatusActive),
+	}, nil)
+	repo.EXPECT().List(tenantB, nil).Return([]*model.TransactionTypeDefinition{
+		newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive),
+	}, nil)
+
+	require.NoError(t, catalog.Check(tenantA, "BOLETO", nil))
+	require.ErrorIs(t, catalog.Check(tenantB, "BOLETO", nil), constant.ErrTransactionTypeNotRegistered)
+}
+
+func TestGetTransactionTypeQuery_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	q := NewGetTransactionTypeQuery(repo)
+	ctx := context.Background()
+
+	entry := newCatalogEntry(t, "BOLETO", nil, model.TransactionTypeStatusActive)
+
+	repo.EXPECT().GetByCode(gomock.Any(), model.TransactionType("BOLETO")).Return(entry, nil)
+
+	got, err := q.Execute(ctx, "BOLETO")
+	require.NoError(t, err)
+	assert.Equal(t, entry, got)
+
+	repo.EXPECT().GetByCode(gomock.Any(), model.TransactionType("ACH")).Return(nil, constant.ErrTransactionTypeNotFound)
+
+	_, err = q.Execute(ctx, "ACH")
+	require.ErrorIs(t, err, constant.ErrTransactionTypeNotFound)
+
+	_, err = q.Execute(ctx, "not-a-type")
+	require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidCode)
+}
+
+func TestListTransactionTypesQuery_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockTransactionTypeRepository(ctrl)
+	q := NewListTransactionTypesQuery(repo)
+	ctx := context.Background()
+
+	active := model.TransactionTypeStatusActive
+	filter := &model.ListTransactionTypesFilter{Status: &active}
+	entries := []*model.TransactionTypeDefinition{newCatalogEntry(t, "CARD", nil, model.TransactionTypeStatusActive)}
+
+	repo.EXPECT().List(gomock.Any(), filter).Return(entries, nil)
+
+	got, err := q.Execute(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, entries, got)
+
+	bogus := model.TransactionTypeStatus("ARCHIVED")
+
+	_, err = q.Execute(ctx, &model.ListTransactionTypesFilter{Status: &bogus})
+	require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidStatus)
+}
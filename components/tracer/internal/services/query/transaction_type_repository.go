@@ -0,0 +1,25 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+//go:generate mockgen -source=transaction_type_repository.go -destination=transaction_type_repository_mock.go -package=query
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// TransactionTypeRepository defines the interface for transaction-type catalog
+// reads. Shared by the get/list queries and the TransactionTypeCatalog cache.
+type TransactionTypeRepository interface {
+	// GetByCode retrieves a catalog entry by code, regardless of status.
+	// Returns constant.ErrTransactionTypeNotFound if the entry does not exist.
+	GetByCode(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error)
+
+	// List retrieves all catalog entries ordered by code. A nil filter (or a
+	// nil Status) returns entries of every status.
+	List(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transaction_type_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=transaction_type_repository.go -destination=transaction_type_repository_mock.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionTypeRepository is a mock of TransactionTypeRepository interface.
+type MockTransactionTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionTypeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionTypeRepositoryMockRecorder is the mock recorder for MockTransactionTypeRepository.
+type MockTransactionTypeRepositoryMockRecorder struct {
+	mock *MockTransactionTypeRepository
+}
+
+// NewMockTransactionTypeRepository creates a new mock instance.
+func NewMockTransactionTypeRepository(ctrl *gomock.Controller) *MockTransactionTypeRepository {
+	mock := &MockTransactionTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionTypeRepository) EXPECT() *MockTransactionTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByCode mocks base method.
+func (m *MockTransactionTypeRepository) GetByCode(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockTransactionTypeRepositoryMockRecorder) GetByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockTransactionTypeRepository)(nil).GetByCode), ctx, code)
+}
+
+// List mocks base method.
+func (m *MockTransactionTypeRepository) List(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*model.TransactionTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTransactionTypeRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTransactionTypeRepository)(nil).List), ctx, filter)
+}
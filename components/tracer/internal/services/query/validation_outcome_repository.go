@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package query
+
+//go:generate mockgen -source=validation_outcome_repository.go -destination=validation_outcome_repository_mock.go -package=query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// ValidationOutcomeRepository defines the interface for outcome label reads
+// and the labeled-data aggregations.
+type ValidationOutcomeRepository interface {
+	// GetByValidationID retrieves the label recorded for a validation.
+	// Returns constant.ErrValidationOutcomeNotFound if the validation is unlabeled.
+	GetByValidationID(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error)
+
+	// RuleOutcomeCounts aggregates the per-rule confusion matrix over labeled
+	// validations inside the filter window, ordered by rule ID.
+	RuleOutcomeCounts(ctx context.Context, filter *model.OutcomeReportFilter) ([]model.RuleOutcomeCounts, error)
+
+	// ListDataset returns labeled validations inside the filter window, oldest
+	// first, bounded by filter.Limit.
+	ListDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error)
+}
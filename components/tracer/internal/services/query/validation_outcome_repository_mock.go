@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validation_outcome_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=validation_outcome_repository.go -destination=validation_outcome_repository_mock.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidationOutcomeRepository is a mock of ValidationOutcomeRepository interface.
+type MockValidationOutcomeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidationOutcomeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockValidationOutcomeRepositoryMockRecorder is the mock recorder for MockValidationOutcomeRepository.
+type MockValidationOutcomeRepositoryMockRecorder struct {
+	mock *MockValidationOutcomeRepository
+}
+
+// NewMockValidationOutcomeRepository creates a new mock instance.
+func NewMockValidationOutcomeRepository(ctrl *gomock.Controller) *MockValidationOutcomeRepository {
+	mock := &MockValidationOutcomeRepository{ctrl: ctrl}
+	mock.recorder = &MockValidationOutcomeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidationOutcomeRepository) EXPECT() *MockValidationOutcomeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByValidationID mocks base method.
+func (m *MockValidationOutcomeRepository) GetByValidationID(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByValidationID", ctx, validationID)
+	ret0, _ := ret[0].(*model.ValidationOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByValidationID indicates an expected call of GetByValidationID.
+func (mr *MockValidationOutcomeRepositoryMockRecorder) GetByValidationID(ctx, validationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByValidationID", reflect.TypeOf((*MockValidationOutcomeRepository)(nil).GetByValidationID), ctx, validationID)
+}
+
+// ListDataset mocks base method.
+func (m *MockValidationOutcomeRepository) ListDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDataset", ctx, filter)
+	ret0, _ := ret[0].([]*model.OutcomeDatasetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDataset indicates an expected call of ListDataset.
+func (mr *MockValidationOutcomeRepositoryMockRecorder) ListDataset(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataset", reflect.TypeOf((*MockValidationOutcomeRepository)(nil).ListDataset), ctx, filter)
+}
+
+// RuleOutcomeCounts mocks base method.
+func (m *MockValidationOutcomeRepository) RuleOutcomeCounts(ctx context.Context, filter *model.OutcomeReportFilter) ([]model.RuleOutcomeCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RuleOutcomeCounts", ctx, filter)
+	ret0, _ := ret[0].([]model.RuleOutcomeCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RuleOutcomeCounts indicates an expected call of RuleOutcomeCounts.
+func (mr *MockValidationOutcomeRepositoryMockRecorder) RuleOutcomeCounts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuleOutcomeCounts", reflect.TypeOf((*MockValidationOutcomeRepository)(nil).RuleOutcomeCounts), ctx, filter)
+}
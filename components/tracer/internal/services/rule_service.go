@@ -55,6 +55,11 @@ func (s *RuleService) CreateRule(ctx context.Context, input *command.CreateRuleI
 	return s.createCmd.Execute(ctx, input)
 }
 
+// ValidateExpression reports whether a CEL expression compiles.
+func (s *RuleService) ValidateExpression(ctx context.Context, expression string) error {
+	return s.createCmd.ValidateExpression(ctx, expression)
+}
+
 // UpdateRule updates an existing rule.
 func (s *RuleService) UpdateRule(ctx context.Context, id uuid.UUID, input *command.UpdateRuleInput) (*model.Rule, error) {
 	return s.updateCmd.Execute(ctx, id, input)
@@ -82,6 +87,12 @@ func (s *RuleService) DeactivateRule(ctx context.Context, id uuid.UUID) (*model.
 	return s.deactivateCmd.Execute(ctx, id)
 }
 
+// DeactivateRuleWithReason deactivates a rule recording reason on its audit
+// event. Used by automated safeguards such as alert-policy auto-deactivation.
+func (s *RuleService) DeactivateRuleWithReason(ctx context.Context, id uuid.UUID, reason string) (*model.Rule, error) {
+	return s.deactivateCmd.ExecuteWithReason(ctx, id, reason)
+}
+
 // DraftRule transitions a rule to draft (INACTIVE → DRAFT).
 // Returns the updated rule for atomic draft-and-return pattern.
 func (s *RuleService) DraftRule(ctx context.Context, id uuid.UUID) (*model.Rule, error) {
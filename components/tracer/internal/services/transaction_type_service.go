@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// TransactionTypeService is a facade that combines the transaction-type
+// catalog commands and queries. Successful writes invalidate the tenant's
+// catalog snapshot so the new definition is enforced on the next validation.
+type TransactionTypeService struct {
+	createCmd *command.CreateTransactionTypeCommand
+	updateCmd *command.UpdateTransactionTypeCommand
+	getQuery  *query.GetTransactionTypeQuery
+	listQuery *query.ListTransactionTypesQuery
+	catalog   *query.TransactionTypeCatalog
+}
+
+// NewTransactionTypeService creates a new transaction-type service facade.
+// catalog may be nil, in which case writes only rely on the catalog TTL.
+func NewTransactionTypeService(
+	createCmd *command.CreateTransactionTypeCommand,
+	updateCmd *command.UpdateTransactionTypeCommand,
+	getQuery *query.GetTransactionTypeQuery,
+	listQuery *query.ListTransactionTypesQuery,
+	catalog *query.TransactionTypeCatalog,
+) *TransactionTypeService {
+	return &TransactionTypeService{
+		createCmd: createCmd,
+		updateCmd: updateCmd,
+		getQuery:  getQuery,
+		listQuery: listQuery,
+		catalog:   catalog,
+	}
+}
+
+// CreateTransactionType registers a new transaction type.
+func (s *TransactionTypeService) CreateTransactionType(ctx context.Context, input *command.CreateTransactionTypeInput) (*model.TransactionTypeDefinition, error) {
+	def, err := s.createCmd.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+
+	return def, nil
+}
+
+// UpdateTransactionType updates an existing transaction type.
+func (s *TransactionTypeService) UpdateTransactionType(ctx context.Context, code model.TransactionType, input *command.UpdateTransactionTypeInput) (*model.TransactionTypeDefinition, error) {
+	def, err := s.updateCmd.Execute(ctx, code, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+
+	return def, nil
+}
+
+// GetTransactionType retrieves a transaction type by code.
+func (s *TransactionTypeService) GetTransactionType(ctx context.Context, code model.TransactionType) (*model.TransactionTypeDefinition, error) {
+	return s.getQuery.Execute(ctx, code)
+}
+
+// ListTransactionTypes retrieves the catalog with filters.
+func (s *TransactionTypeService) ListTransactionTypes(ctx context.Context, filter *model.ListTransactionTypesFilter) ([]*model.TransactionTypeDefinition, error) {
+	return s.listQuery.Execute(ctx, filter)
+}
+
+func (s *TransactionTypeService) invalidate(ctx context.Context) {
+	if s.catalog != nil {
+		s.catalog.Invalidate(ctx)
+	}
+}
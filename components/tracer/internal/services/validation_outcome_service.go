@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// ValidationOutcomeService is a facade over the outcome labeling command and
+// the labeled-data report queries.
+type ValidationOutcomeService struct {
+	labelCmd     *command.LabelValidationOutcomeCommand
+	getQuery     *query.GetValidationOutcomeQuery
+	reportQuery  *query.GetRulePerformanceReportQuery
+	datasetQuery *query.ExportOutcomeDatasetQuery
+}
+
+// NewValidationOutcomeService creates a new validation outcome service facade.
+func NewValidationOutcomeService(
+	labelCmd *command.LabelValidationOutcomeCommand,
+	getQuery *query.GetValidationOutcomeQuery,
+	reportQuery *query.GetRulePerformanceReportQuery,
+	datasetQuery *query.ExportOutcomeDatasetQuery,
+) *ValidationOutcomeService {
+	return &ValidationOutcomeService{
+		labelCmd:     labelCmd,
+		getQuery:     getQuery,
+		reportQuery:  reportQuery,
+		datasetQuery: datasetQuery,
+	}
+}
+
+// LabelValidationOutcome attaches or replaces the outcome label of a validation.
+func (s *ValidationOutcomeService) LabelValidationOutcome(ctx context.Context, validationID uuid.UUID, input *command.LabelValidationOutcomeInput) (*model.ValidationOutcome, error) {
+	return s.labelCmd.Execute(ctx, validationID, input)
+}
+
+// GetValidationOutcome retrieves the label of a validation.
+func (s *ValidationOutcomeService) GetValidationOutcome(ctx context.Context, validationID uuid.UUID) (*model.ValidationOutcome, error) {
+	return s.getQuery.Execute(ctx, validationID)
+}
+
+// GetRulePerformanceReport computes per-rule precision and recall.
+func (s *ValidationOutcomeService) GetRulePerformanceReport(ctx context.Context, filter *model.OutcomeReportFilter) (*model.RulePerformanceReport, error) {
+	return s.reportQuery.Execute(ctx, filter)
+}
+
+// ExportOutcomeDataset returns the labeled validation dataset.
+func (s *ValidationOutcomeService) ExportOutcomeDataset(ctx context.Context, filter *model.OutcomeReportFilter) ([]*model.OutcomeDatasetRow, error) {
+	return s.datasetQuery.Execute(ctx, filter)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/logging"
 	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
 	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/sanitize"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
 )
 
 // validationPersistTimeout is the maximum duration for transaction validation record persistence.
@@ -82,6 +83,13 @@ type ValidationService struct {
 	// bootstrap wires a no-op when MULTI_TENANT_ENABLED=false, so existing
 	// tests do not need to pass an instance.
 	mtMetrics metrics.MultiTenantMetrics
+	// catalog rejects requests whose transactionType/subType is not
+	// registered and ACTIVE in the tenant's transaction-type catalog.
+	// Optional — nil skips the check. Installed via SetTransactionTypeCatalog.
+	catalog command.TransactionTypeChecker
+	// observer receives the outcome of every fresh validation to drive alert
+	// policies. Optional — nil disables it. Installed via SetDecisionObserver.
+	observer DecisionObserver
 }
 
 // NewValidationService creates a new ValidationService with dependency validation.
@@ -150,6 +158,18 @@ func (s *ValidationService) SetMultiTenantMetrics(m metrics.MultiTenantMetrics)
 	s.mtMetrics = m
 }
 
+// SetTransactionTypeCatalog installs the transaction-type catalog consulted
+// before rule evaluation. Passing nil disables the check.
+func (s *ValidationService) SetTransactionTypeCatalog(c command.TransactionTypeChecker) {
+	s.catalog = c
+}
+
+// SetDecisionObserver installs the observer notified of every fresh
+// validation outcome. Passing nil disables it.
+func (s *ValidationService) SetDecisionObserver(o DecisionObserver) {
+	s.observer = o
+}
+
 // Validate orchestrates the transaction validation flow with idempotency support.
 // Returns ValidateResult with IsDuplicate=true for duplicate requests (DD-3: Stripe model).
 // Decision precedence: DENY > Limit Exceeded > REVIEW > ALLOW > Default.
@@ -187,6 +207,10 @@ func (s *ValidationService) Validate(ctx context.Context, req *model.ValidationR
 		return nil, errors.New("validation request cannot be nil")
 	}
 
+	defer func() {
+		s.observeDecision(ctx, req, result, retErr)
+	}()
+
 	logger, tracer, _, _ := libObservability.NewTrackingFromContext(ctx)
 
 	ctx, span := tracer.Start(ctx, "service.validation.orchestrate")
@@ -219,6 +243,23 @@ func (s *ValidationService) Validate(ctx context.Context, req *model.ValidationR
 		}, nil
 	}
 
+	// Step 0.5: Reject transaction types the tenant has not registered. This
+	// runs after dedup so replays of an already-answered request keep
+	// returning the cached response even if the type was deactivated since.
+	if s.catalog != nil {
+		if err := s.catalog.Check(ctx, req.TransactionType, req.SubType); err != nil {
+			if errors.Is(err, constant.ErrTransactionTypeNotRegistered) || errors.Is(err, constant.ErrTransactionTypeSubTypeNotAllowed) {
+				libOpentelemetry.HandleSpanBusinessErrorEvent(span, "transaction type rejected by catalog", err)
+
+				return nil, err
+			}
+
+			libOpentelemetry.HandleSpanError(span, "failed to check transaction-type catalog", err)
+
+			return nil, fmt.Errorf("failed to check transaction-type catalog: %w", err)
+		}
+	}
+
 	startTime := time.Now() // Wall clock for latency measurement only
 	evaluatedAt := s.clock.Now().UTC()
 
@@ -352,6 +393,17 @@ func (s *ValidationService) Validate(ctx context.Context, req *model.ValidationR
 	}, nil
 }
 
+// observeDecision feeds a fresh validation outcome to the decision observer.
+// Duplicates are skipped so client retries do not inflate alert windows, and
+// errored validations never produced a decision to count.
+func (s *ValidationService) observeDecision(ctx context.Context, req *model.ValidationRequest, result *ValidateResult, err error) {
+	if s.observer == nil || err != nil || result == nil || result.IsDuplicate || result.Response == nil {
+		return
+	}
+
+	s.observer.Observe(ctx, req, result.Response)
+}
+
 // commitAllowPath persists the transaction validation and audit event inside
 // tx and commits. Extracted from Validate to keep it under the gocyclo budget;
 // control flow and side effects are identical to the inlined version.
@@ -668,6 +720,9 @@ func buildTransactionValidation(req *model.ValidationRequest, resp *model.Valida
 	tv.Segment = req.Segment
 	tv.Portfolio = req.Portfolio
 	tv.Merchant = req.Merchant
+	tv.Device = req.Device
+	tv.Geolocation = req.Geolocation
+	tv.Counterparty = req.Counterparty
 	tv.Metadata = sanitize.SanitizeMetadata(req.Metadata)
 	tv.EvaluationResult = resp.EvaluationResult
 	tv.LimitUsageDetails = resp.LimitUsageDetails
@@ -773,6 +828,20 @@ func buildRequestSnapshot(req *model.ValidationRequest) map[string]any {
 		}
 	}
 
+	// Fraud contexts are snapshotted as sent (JSON form) rather than in their
+	// CEL activation form, so timestamps stay RFC 3339 in the audit trail.
+	if req.Device != nil {
+		requestSnapshot["device"] = req.Device
+	}
+
+	if req.Geolocation != nil {
+		requestSnapshot["geolocation"] = req.Geolocation
+	}
+
+	if req.Counterparty != nil {
+		requestSnapshot["counterparty"] = req.Counterparty
+	}
+
 	return requestSnapshot
 }
 
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pgdbMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/adapters/postgres/db/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command"
+	commandMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/command/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/services/mocks"
+	queryMocks "github.com/LerianStudio/midaz/v4/components/tracer/internal/services/query/mocks"
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// TestValidate_TransactionTypeCatalog verifies that the catalog is consulted
+// after dedup and before rule evaluation, that catalog rejections surface as
+// their sentinel, and that load failures are wrapped.
+func TestValidate_TransactionTypeCatalog(t *testing.T) {
+	loadErr := errors.New("connection refused")
+
+	tests := []struct {
+		name     string
+		checkErr error
+		wantErr  error
+	}{
+		{name: "unregistered type", checkErr: constant.ErrTransactionTypeNotRegistered, wantErr: constant.ErrTransactionTypeNotRegistered},
+		{name: "subtype not allowed", checkErr: constant.ErrTransactionTypeSubTypeNotAllowed, wantErr: constant.ErrTransactionTypeSubTypeNotAllowed},
+		{name: "catalog unavailable", checkErr: loadErr, wantErr: loadErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			ruleEval := mocks.NewMockRuleEvaluator(ctrl)
+			transactionValidationQueryRepo := queryMocks.NewMockTransactionValidationRepository(ctrl)
+			catalog := command.NewMockTransactionTypeChecker(ctrl)
+
+			request := &model.ValidationRequest{
+				RequestID:            testutil.MustDeterministicUUID(1),
+				TransactionType:      "CASH",
+				SubType:              testutil.StringPtr("atm"),
+				Amount:               decimal.RequireFromString("100"),
+				Currency:             "USD",
+				TransactionTimestamp: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+				Account:              model.AccountContext{ID: testutil.MustDeterministicUUID(2)},
+			}
+
+			gomock.InOrder(
+				transactionValidationQueryRepo.EXPECT().FindByRequestID(gomock.Any(), request.RequestID).Return(nil, nil),
+				catalog.EXPECT().Check(gomock.Any(), model.TransactionType("CASH"), request.SubType).Return(tt.checkErr),
+			)
+
+			// Rule evaluation must not run for a rejected transaction type.
+			ruleEval.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+			service, err := NewValidationService(
+				pgdbMocks.NewMockTxBeginner(ctrl),
+				ruleEval,
+				mocks.NewMockLimitChecker(ctrl),
+				commandMocks.NewMockTransactionValidationRepository(ctrl),
+				transactionValidationQueryRepo,
+				mocks.NewMockAuditWriter(ctrl),
+				nil,
+			)
+			require.NoError(t, err)
+
+			service.SetTransactionTypeCatalog(catalog)
+
+			result, err := service.Validate(context.Background(), request)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+type countingDecisionObserver struct {
+	calls int
+}
+
+func (o *countingDecisionObserver) Observe(context.Context, *model.ValidationRequest, *model.ValidationResponse) {
+	o.calls++
+}
+
+// TestObserveDecision verifies that only fresh, successful validations reach
+// the decision observer: replays of a duplicate request must not be counted
+// twice in an alert window.
+func TestObserveDecision(t *testing.T) {
+	req := alertTestRequest(model.TransactionTypeCard)
+	resp := alertTestResponse(model.DecisionDeny)
+
+	tests := []struct {
+		name      string
+		result    *ValidateResult
+		err       error
+		wantCalls int
+	}{
+		{name: "fresh decision", result: &ValidateResult{Response: resp}, wantCalls: 1},
+		{name: "duplicate", result: &ValidateResult{Response: resp, IsDuplicate: true}},
+		{name: "error", result: &ValidateResult{Response: resp}, err: errors.New("boom")},
+		{name: "nil result"},
+		{name: "nil response", result: &ValidateResult{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observer := &countingDecisionObserver{}
+			service := &ValidationService{}
+			service.SetDecisionObserver(observer)
+
+			service.observeDecision(context.Background(), req, tt.result, tt.err)
+			assert.Equal(t, tt.wantCalls, observer.calls)
+		})
+	}
+
+	// Without an observer the hook is a no-op.
+	(&ValidationService{}).observeDecision(context.Background(), req, &ValidateResult{Response: resp}, nil)
+}
@@ -1035,7 +1035,7 @@ func TestValidateTransactionValidation(t *testing.T) {
 			name: "invalid transaction type",
 			tv: func() *model.TransactionValidation {
 				v := validTV()
-				v.TransactionType = model.TransactionType("INVALID")
+				v.TransactionType = model.TransactionType("not-a-type")
 				return v
 			}(),
 			wantError: true,
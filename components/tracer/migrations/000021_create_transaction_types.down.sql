@@ -0,0 +1,19 @@
+-- ============================================
+-- Migration: 000021_create_transaction_types (DOWN)
+-- Description: Restore transaction_type_enum and drop the catalog table.
+-- Date: 2026-10-18
+-- ============================================
+-- Note: the column can only be converted back while every stored validation
+-- uses one of the original four types. Validations recorded with a custom
+-- catalog type make the cast fail and abort the rollback, which is the intended
+-- outcome: silently rewriting immutable compliance rows is not acceptable.
+
+DO $$ BEGIN
+    CREATE TYPE transaction_type_enum AS ENUM ('CARD', 'WIRE', 'PIX', 'CRYPTO');
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE transaction_validations
+    ALTER COLUMN transaction_type TYPE transaction_type_enum USING transaction_type::transaction_type_enum;
+
+DROP TABLE IF EXISTS transaction_types;
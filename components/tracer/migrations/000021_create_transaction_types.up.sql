@@ -0,0 +1,44 @@
+-- ============================================
+-- Migration: 000021_create_transaction_types
+-- Description: Tenant-configurable transaction-type catalog.
+--              Replaces the hardcoded transaction_type_enum (CARD, WIRE, PIX,
+--              CRYPTO) with a catalog table so operators can register new
+--              types (e.g. BOLETO, ACH) and their allowed subtypes without a
+--              code change. The four original types are seeded as built-ins.
+-- Date: 2026-10-18
+-- ============================================
+
+-- transaction_types table
+-- code is the natural key referenced by validations and rule/limit scopes. It is
+-- NOT referenced by foreign key from transaction_validations: validations are an
+-- immutable compliance record and must survive a type being deactivated.
+-- sub_types holds the allowed lowercase subtypes; an empty array means any
+-- subtype is accepted.
+-- status is constrained by a CHECK (not a PG enum type), following 000019; the
+-- Go-side enum in pkg/model/transaction_type_catalog.go is authoritative.
+CREATE TABLE IF NOT EXISTS transaction_types (
+    code VARCHAR(32) PRIMARY KEY CHECK (code ~ '^[A-Z][A-Z0-9_]{1,31}$'),
+    description TEXT,
+    sub_types JSONB NOT NULL DEFAULT '[]',
+    builtin BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE'
+        CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Seed the built-in types so existing tenants keep accepting them unchanged.
+INSERT INTO transaction_types (code, description, builtin) VALUES
+    ('CARD', 'Card transaction', TRUE),
+    ('WIRE', 'Wire transfer', TRUE),
+    ('PIX', 'PIX instant payment', TRUE),
+    ('CRYPTO', 'Crypto asset transfer', TRUE)
+ON CONFLICT (code) DO NOTHING;
+
+-- transaction_validations.transaction_type moves from the closed enum to a
+-- VARCHAR matching the catalog key width. The index on the column is rebuilt
+-- automatically by the type change.
+ALTER TABLE transaction_validations
+    ALTER COLUMN transaction_type TYPE VARCHAR(32) USING transaction_type::text;
+
+DROP TYPE IF EXISTS transaction_type_enum;
@@ -0,0 +1,7 @@
+-- ============================================
+-- Migration: 000022_create_validation_outcomes (DOWN)
+-- Description: Drop the validation outcome labels table.
+-- Date: 2026-10-18
+-- ============================================
+
+DROP TABLE IF EXISTS validation_outcomes;
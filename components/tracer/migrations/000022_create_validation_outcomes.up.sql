@@ -0,0 +1,25 @@
+-- ============================================
+-- Migration: 000022_create_validation_outcomes
+-- Description: Outcome labels (fraud feedback) for transaction validations.
+--              Labels are attached after the fact (confirmed fraud, chargeback,
+--              false positive, customer confirmation) and feed the per-rule
+--              precision/recall report and the labeled dataset export.
+-- Date: 2026-10-18
+-- ============================================
+
+-- validation_outcomes table
+-- transaction_validations is an insert-only compliance record, so labels live
+-- beside it rather than as a mutable column on it. One label per validation:
+-- relabeling overwrites the row and bumps updated_at.
+-- label is constrained by a CHECK (not a PG enum type), following 000019; the
+-- Go-side enum in pkg/model/validation_outcome.go is authoritative.
+CREATE TABLE IF NOT EXISTS validation_outcomes (
+    validation_id UUID PRIMARY KEY REFERENCES transaction_validations(id),
+    label VARCHAR(32) NOT NULL
+        CHECK (label IN ('CONFIRMED_FRAUD', 'CHARGEBACK', 'FALSE_POSITIVE', 'CUSTOMER_CONFIRMED')),
+    note TEXT CHECK (note IS NULL OR char_length(note) <= 1000),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_validation_outcomes_label ON validation_outcomes(label);
@@ -0,0 +1,9 @@
+-- ============================================
+-- Migration: 000023_add_validation_fraud_contexts (DOWN)
+-- Description: Drop the device, geolocation and counterparty context columns.
+-- Date: 2026-10-18
+-- ============================================
+
+ALTER TABLE transaction_validations DROP COLUMN IF EXISTS counterparty;
+ALTER TABLE transaction_validations DROP COLUMN IF EXISTS geolocation;
+ALTER TABLE transaction_validations DROP COLUMN IF EXISTS device;
@@ -0,0 +1,13 @@
+-- ============================================
+-- Migration: 000023_add_validation_fraud_contexts
+-- Description: Persist the typed device, geolocation and counterparty contexts
+--              sent with a validation request, so fraud investigations and the
+--              labeled dataset export see the same inputs the rules evaluated.
+-- Date: 2026-10-18
+-- ============================================
+
+-- Nullable JSONB, like segment/portfolio/merchant: the contexts are optional
+-- and rows written before this migration simply have no value.
+ALTER TABLE transaction_validations ADD COLUMN IF NOT EXISTS device JSONB;
+ALTER TABLE transaction_validations ADD COLUMN IF NOT EXISTS geolocation JSONB;
+ALTER TABLE transaction_validations ADD COLUMN IF NOT EXISTS counterparty JSONB;
@@ -0,0 +1,8 @@
+-- ============================================
+-- Migration: 000024_create_alert_policies (DOWN)
+-- Description: Drop the alert policies table.
+-- Date: 2026-10-18
+-- ============================================
+
+DROP INDEX IF EXISTS idx_alert_policies_rule_id;
+DROP TABLE IF EXISTS alert_policies;
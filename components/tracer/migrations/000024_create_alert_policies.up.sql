@@ -0,0 +1,40 @@
+-- ============================================
+-- Migration: 000024_create_alert_policies
+-- Description: Decision-rate alert policies. Each policy watches the rate of a
+--              decision (optionally for one rule and/or a transaction scope)
+--              over a sliding window and raises decision-alert events when it
+--              crosses a threshold, optionally deactivating the rule.
+-- Date: 2026-10-18
+-- ============================================
+
+-- alert_policies table
+-- rule_id is deliberately not a foreign key: rules are soft-deleted, and a
+-- policy pointing at a deleted rule simply never matches again.
+-- scope reuses the model.Scope JSON shape of rules and limits (NULL = all
+-- validations).
+-- status is constrained by a CHECK (not a PG enum type), following 000019; the
+-- Go-side bounds in pkg/model/alert_policy.go are authoritative, the CHECKs
+-- here are a backstop.
+CREATE TABLE IF NOT EXISTS alert_policies (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    rule_id UUID,
+    scope JSONB,
+    decision VARCHAR(16) NOT NULL
+        CHECK (decision IN ('ALLOW', 'DENY', 'REVIEW')),
+    threshold DOUBLE PRECISION NOT NULL
+        CHECK (threshold > 0 AND threshold <= 1),
+    window_seconds INTEGER NOT NULL
+        CHECK (window_seconds BETWEEN 60 AND 86400),
+    min_samples INTEGER NOT NULL
+        CHECK (min_samples BETWEEN 1 AND 1000000),
+    auto_deactivate BOOLEAN NOT NULL DEFAULT FALSE
+        CHECK (NOT auto_deactivate OR rule_id IS NOT NULL),
+    status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE'
+        CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_policies_rule_id ON alert_policies(rule_id) WHERE rule_id IS NOT NULL;
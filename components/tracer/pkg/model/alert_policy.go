@@ -0,0 +1,362 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// AlertPolicyStatus represents whether an alert policy is being evaluated.
+type AlertPolicyStatus string
+
+const (
+	AlertPolicyStatusActive   AlertPolicyStatus = "ACTIVE"
+	AlertPolicyStatusInactive AlertPolicyStatus = "INACTIVE"
+)
+
+// Alert policy bounds.
+const (
+	MinAlertWindowSeconds  = 60
+	MaxAlertWindowSeconds  = 86400
+	DefaultAlertMinSamples = 20
+	MaxAlertMinSamples     = 1_000_000
+)
+
+// IsValid checks if the AlertPolicyStatus is a valid enum value.
+func (s AlertPolicyStatus) IsValid() bool {
+	switch s {
+	case AlertPolicyStatusActive, AlertPolicyStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the status.
+func (s AlertPolicyStatus) String() string {
+	return string(s)
+}
+
+// AlertPolicy watches the rate of a decision over a sliding window and raises
+// an alert when it crosses Threshold.
+//
+// The rate is matched/total over the last WindowSeconds, where total counts the
+// validations whose transaction scope matches Scope (all validations when Scope
+// is nil) and matched counts those that ended in Decision — and, when RuleID is
+// set, that the rule matched. No alert is raised before MinSamples validations
+// are in the window, so a quiet period cannot trip a policy on a handful of
+// requests.
+//
+// AutoDeactivate (rule policies only) deactivates the rule when the alert fires,
+// the safeguard against a freshly activated rule that blocks legitimate traffic.
+type AlertPolicy struct {
+	// Unique identifier of the alert policy
+	// format: uuid
+	ID uuid.UUID `json:"id" swaggertype:"string" format:"uuid" example:"00000000-0000-0000-0000-000000000000"`
+
+	// Human-readable name of the policy
+	// example: Card deny spike
+	// maxLength: 255
+	Name string `json:"name" example:"Card deny spike" maxLength:"255"`
+
+	// Optional description of the policy
+	// example: Alert when card denials exceed 40% over 5 minutes
+	Description *string `json:"description,omitempty" example:"Alert when card denials exceed 40% over 5 minutes"`
+
+	// Rule whose matches are counted (optional; omit for a decision-wide policy)
+	// format: uuid
+	RuleID *uuid.UUID `json:"ruleId,omitempty" swaggertype:"string" format:"uuid" example:"00000000-0000-0000-0000-000000000000"`
+
+	// Restricts the policy to validations matching this scope (optional)
+	Scope *Scope `json:"scope,omitempty"`
+
+	// Decision whose rate is monitored
+	// enums: ALLOW,DENY,REVIEW
+	Decision Decision `json:"decision" swaggertype:"string" enums:"ALLOW,DENY,REVIEW" example:"DENY"`
+
+	// Rate (0 < threshold <= 1) at or above which the alert fires
+	// example: 0.4
+	Threshold float64 `json:"threshold" example:"0.4"`
+
+	// Length of the sliding window in seconds
+	// example: 300
+	WindowSeconds int `json:"windowSeconds" example:"300"`
+
+	// Minimum validations in the window before the policy can fire
+	// example: 20
+	MinSamples int `json:"minSamples" example:"20"`
+
+	// Deactivate the rule when the alert fires (requires ruleId)
+	// example: false
+	AutoDeactivate bool `json:"autoDeactivate" example:"false"`
+
+	// Current status of the policy
+	// enums: ACTIVE,INACTIVE
+	Status AlertPolicyStatus `json:"status" swaggertype:"string" enums:"ACTIVE,INACTIVE" example:"ACTIVE"`
+
+	// Timestamp when the policy was created
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+
+	// Timestamp when the policy was last updated
+	// format: date-time
+	UpdatedAt time.Time `json:"updatedAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+}
+
+// AlertPolicySpec holds the configurable fields of a new alert policy.
+// A zero MinSamples defaults to DefaultAlertMinSamples.
+type AlertPolicySpec struct {
+	Name           string
+	Description    *string
+	RuleID         *uuid.UUID
+	Scope          *Scope
+	Decision       Decision
+	Threshold      float64
+	WindowSeconds  int
+	MinSamples     int
+	AutoDeactivate bool
+}
+
+// AlertPolicyPatch holds the fields of an alert policy update. Nil fields keep
+// their current value. ClearScope removes the scope; ruleId is immutable
+// because the monitored signal would otherwise silently change meaning.
+type AlertPolicyPatch struct {
+	Name           *string
+	Description    *string
+	Scope          *Scope
+	ClearScope     bool
+	Decision       *Decision
+	Threshold      *float64
+	WindowSeconds  *int
+	MinSamples     *int
+	AutoDeactivate *bool
+	Status         *AlertPolicyStatus
+}
+
+// NewAlertPolicy creates a new ACTIVE alert policy with validation.
+func NewAlertPolicy(spec AlertPolicySpec, createdAt time.Time) (*AlertPolicy, error) {
+	if spec.MinSamples == 0 {
+		spec.MinSamples = DefaultAlertMinSamples
+	}
+
+	policy := &AlertPolicy{
+		ID:             uuid.New(),
+		Name:           strings.TrimSpace(spec.Name),
+		RuleID:         cloneUUIDPtr(spec.RuleID),
+		Decision:       spec.Decision,
+		Threshold:      spec.Threshold,
+		WindowSeconds:  spec.WindowSeconds,
+		MinSamples:     spec.MinSamples,
+		AutoDeactivate: spec.AutoDeactivate,
+		Status:         AlertPolicyStatusActive,
+		CreatedAt:      createdAt.UTC(),
+		UpdatedAt:      createdAt.UTC(),
+	}
+
+	description, err := normalizeAlertPolicyDescription(spec.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Description = description
+
+	if spec.Scope != nil {
+		scope := cloneAndNormalizeScope(*spec.Scope)
+		policy.Scope = &scope
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Update applies patch with validation. All inputs are validated against a
+// copy before the receiver is mutated (atomicity guarantee); UpdatedAt only
+// moves when something changed.
+func (p *AlertPolicy) Update(patch AlertPolicyPatch, now time.Time) error {
+	next := *p
+	updated := false
+
+	if patch.Name != nil {
+		next.Name = strings.TrimSpace(*patch.Name)
+		updated = true
+	}
+
+	if patch.Description != nil {
+		description, err := normalizeAlertPolicyDescription(patch.Description)
+		if err != nil {
+			return err
+		}
+
+		next.Description = description
+		updated = true
+	}
+
+	switch {
+	case patch.ClearScope:
+		next.Scope = nil
+		updated = true
+	case patch.Scope != nil:
+		scope := cloneAndNormalizeScope(*patch.Scope)
+		next.Scope = &scope
+		updated = true
+	}
+
+	if patch.Decision != nil {
+		next.Decision = *patch.Decision
+		updated = true
+	}
+
+	if patch.Threshold != nil {
+		next.Threshold = *patch.Threshold
+		updated = true
+	}
+
+	if patch.WindowSeconds != nil {
+		next.WindowSeconds = *patch.WindowSeconds
+		updated = true
+	}
+
+	if patch.MinSamples != nil {
+		next.MinSamples = *patch.MinSamples
+		updated = true
+	}
+
+	if patch.AutoDeactivate != nil {
+		next.AutoDeactivate = *patch.AutoDeactivate
+		updated = true
+	}
+
+	if patch.Status != nil {
+		if !patch.Status.IsValid() {
+			return constant.ErrAlertPolicyInvalidStatus
+		}
+
+		next.Status = *patch.Status
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	if err := next.validate(); err != nil {
+		return err
+	}
+
+	next.UpdatedAt = now.UTC()
+	*p = next
+
+	return nil
+}
+
+// IsActive reports whether the policy is being evaluated.
+func (p *AlertPolicy) IsActive() bool {
+	return p.Status == AlertPolicyStatusActive
+}
+
+// Window returns the sliding window length as a duration.
+func (p *AlertPolicy) Window() time.Duration {
+	return time.Duration(p.WindowSeconds) * time.Second
+}
+
+// Clone returns a deep copy of the policy.
+func (p *AlertPolicy) Clone() *AlertPolicy {
+	if p == nil {
+		return nil
+	}
+
+	clone := *p
+	clone.RuleID = cloneUUIDPtr(p.RuleID)
+
+	if p.Description != nil {
+		description := *p.Description
+		clone.Description = &description
+	}
+
+	if p.Scope != nil {
+		scope := cloneAndNormalizeScope(*p.Scope)
+		clone.Scope = &scope
+	}
+
+	return &clone
+}
+
+// validate checks every invariant of a policy.
+func (p *AlertPolicy) validate() error {
+	if p.Name == "" || len(p.Name) > MaxNameLength || !safeNameRegex.MatchString(p.Name) {
+		return constant.ErrAlertPolicyInvalidName
+	}
+
+	if !p.Decision.IsValid() {
+		return constant.ErrAlertPolicyInvalidDecision
+	}
+
+	if math.IsNaN(p.Threshold) || p.Threshold <= 0 || p.Threshold > 1 {
+		return constant.ErrAlertPolicyInvalidThreshold
+	}
+
+	if p.WindowSeconds < MinAlertWindowSeconds || p.WindowSeconds > MaxAlertWindowSeconds {
+		return constant.ErrAlertPolicyInvalidWindow
+	}
+
+	if p.MinSamples < 1 || p.MinSamples > MaxAlertMinSamples {
+		return constant.ErrAlertPolicyInvalidMinSamples
+	}
+
+	if p.AutoDeactivate && p.RuleID == nil {
+		return constant.ErrAlertPolicyAutoDeactivateRequiresRule
+	}
+
+	if p.Scope != nil && (p.Scope.IsEmpty() || !p.Scope.hasValidValues()) {
+		return constant.ErrAlertPolicyInvalidScope
+	}
+
+	return nil
+}
+
+// normalizeAlertPolicyDescription trims the description; an empty description
+// after trimming is returned as nil.
+func normalizeAlertPolicyDescription(description *string) (*string, error) {
+	if description == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*description)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	if len(trimmed) > MaxDescriptionLength || !safeDescriptionRegex.MatchString(trimmed) {
+		return nil, constant.ErrAlertPolicyDescriptionTooLong
+	}
+
+	return &trimmed, nil
+}
+
+func cloneUUIDPtr(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+
+	idCopy := *id
+
+	return &idCopy
+}
+
+// ListAlertPoliciesFilter represents the filter criteria for listing alert
+// policies. Policies are few per tenant, so listing is not paginated.
+type ListAlertPoliciesFilter struct {
+	Status *AlertPolicyStatus
+	RuleID *uuid.UUID
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func validAlertPolicySpec() AlertPolicySpec {
+	return AlertPolicySpec{
+		Name:          "Card deny spike",
+		Decision:      DecisionDeny,
+		Threshold:     0.4,
+		WindowSeconds: 300,
+	}
+}
+
+func TestAlertPolicyStatus_IsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AlertPolicyStatusActive.IsValid())
+	assert.True(t, AlertPolicyStatusInactive.IsValid())
+	assert.False(t, AlertPolicyStatus("FIRING").IsValid())
+	assert.False(t, AlertPolicyStatus("").IsValid())
+}
+
+func TestNewAlertPolicy(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+
+	tests := []struct {
+		name    string
+		mutate  func(*AlertPolicySpec)
+		wantErr error
+	}{
+		{name: "minimal decision-wide policy", mutate: func(*AlertPolicySpec) {}},
+		{
+			name: "rule policy with auto-deactivate and scope",
+			mutate: func(s *AlertPolicySpec) {
+				s.RuleID = &ruleID
+				s.AutoDeactivate = true
+				s.Scope = &Scope{Country: testutil.StringPtr("br")}
+			},
+		},
+		{name: "blank name", mutate: func(s *AlertPolicySpec) { s.Name = "  " }, wantErr: constant.ErrAlertPolicyInvalidName},
+		{name: "name with markup", mutate: func(s *AlertPolicySpec) { s.Name = "<b>x</b>" }, wantErr: constant.ErrAlertPolicyInvalidName},
+		{name: "unknown decision", mutate: func(s *AlertPolicySpec) { s.Decision = "BLOCK" }, wantErr: constant.ErrAlertPolicyInvalidDecision},
+		{name: "zero threshold", mutate: func(s *AlertPolicySpec) { s.Threshold = 0 }, wantErr: constant.ErrAlertPolicyInvalidThreshold},
+		{name: "threshold above one", mutate: func(s *AlertPolicySpec) { s.Threshold = 1.5 }, wantErr: constant.ErrAlertPolicyInvalidThreshold},
+		{name: "NaN threshold", mutate: func(s *AlertPolicySpec) { s.Threshold = math.NaN() }, wantErr: constant.ErrAlertPolicyInvalidThreshold},
+		{name: "window too short", mutate: func(s *AlertPolicySpec) { s.WindowSeconds = 59 }, wantErr: constant.ErrAlertPolicyInvalidWindow},
+		{name: "window too long", mutate: func(s *AlertPolicySpec) { s.WindowSeconds = 86401 }, wantErr: constant.ErrAlertPolicyInvalidWindow},
+		{name: "negative min samples", mutate: func(s *AlertPolicySpec) { s.MinSamples = -1 }, wantErr: constant.ErrAlertPolicyInvalidMinSamples},
+		{name: "auto-deactivate without rule", mutate: func(s *AlertPolicySpec) { s.AutoDeactivate = true }, wantErr: constant.ErrAlertPolicyAutoDeactivateRequiresRule},
+		{name: "empty scope", mutate: func(s *AlertPolicySpec) { s.Scope = &Scope{} }, wantErr: constant.ErrAlertPolicyInvalidScope},
+		{
+			name:    "scope with invalid country",
+			mutate:  func(s *AlertPolicySpec) { s.Scope = &Scope{Country: testutil.StringPtr("BRA")} },
+			wantErr: constant.ErrAlertPolicyInvalidScope,
+		},
+		{
+			name: "oversized description",
+			mutate: func(s *AlertPolicySpec) {
+				s.Description = testutil.StringPtr(strings.Repeat("d", MaxDescriptionLength+1))
+			},
+			wantErr: constant.ErrAlertPolicyDescriptionTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := validAlertPolicySpec()
+			tt.mutate(&spec)
+
+			policy, err := NewAlertPolicy(spec, testutil.FixedTime())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, policy)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, policy.ID)
+			assert.Equal(t, AlertPolicyStatusActive, policy.Status)
+			assert.Equal(t, DefaultAlertMinSamples, policy.MinSamples)
+			assert.Equal(t, 5*time.Minute, policy.Window())
+		})
+	}
+}
+
+func TestNewAlertPolicy_NormalizesInput(t *testing.T) {
+	t.Parallel()
+
+	spec := validAlertPolicySpec()
+	spec.Name = "  Card deny spike  "
+	spec.Description = testutil.StringPtr("   ")
+	spec.Scope = &Scope{Country: testutil.StringPtr(" br ")}
+
+	policy, err := NewAlertPolicy(spec, testutil.FixedTime())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Card deny spike", policy.Name)
+	assert.Nil(t, policy.Description)
+	require.NotNil(t, policy.Scope)
+	assert.Equal(t, "BR", *policy.Scope.Country)
+}
+
+func TestAlertPolicy_Update(t *testing.T) {
+	t.Parallel()
+
+	created := testutil.FixedTime()
+	later := created.Add(time.Hour)
+
+	t.Run("applies fields", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewAlertPolicy(validAlertPolicySpec(), created)
+		require.NoError(t, err)
+
+		review := DecisionReview
+		status := AlertPolicyStatusInactive
+
+		require.NoError(t, policy.Update(AlertPolicyPatch{
+			Decision:      &review,
+			Threshold:     testutil.Ptr(0.25),
+			WindowSeconds: testutil.Ptr(600),
+			Status:        &status,
+		}, later))
+
+		assert.Equal(t, DecisionReview, policy.Decision)
+		assert.InDelta(t, 0.25, policy.Threshold, 1e-9)
+		assert.Equal(t, 600, policy.WindowSeconds)
+		assert.False(t, policy.IsActive())
+		assert.Equal(t, later.UTC(), policy.UpdatedAt)
+	})
+
+	t.Run("clears scope", func(t *testing.T) {
+		t.Parallel()
+
+		spec := validAlertPolicySpec()
+		spec.Scope = &Scope{Country: testutil.StringPtr("BR")}
+
+		policy, err := NewAlertPolicy(spec, created)
+		require.NoError(t, err)
+
+		require.NoError(t, policy.Update(AlertPolicyPatch{ClearScope: true}, later))
+		assert.Nil(t, policy.Scope)
+	})
+
+	t.Run("empty patch keeps timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewAlertPolicy(validAlertPolicySpec(), created)
+		require.NoError(t, err)
+
+		require.NoError(t, policy.Update(AlertPolicyPatch{}, later))
+		assert.Equal(t, created.UTC(), policy.UpdatedAt)
+	})
+
+	t.Run("invalid input leaves policy untouched", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewAlertPolicy(validAlertPolicySpec(), created)
+		require.NoError(t, err)
+
+		err = policy.Update(AlertPolicyPatch{
+			Name:           testutil.StringPtr("renamed"),
+			AutoDeactivate: testutil.Ptr(true),
+		}, later)
+		require.ErrorIs(t, err, constant.ErrAlertPolicyAutoDeactivateRequiresRule)
+		assert.Equal(t, "Card deny spike", policy.Name)
+		assert.False(t, policy.AutoDeactivate)
+		assert.Equal(t, created.UTC(), policy.UpdatedAt)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewAlertPolicy(validAlertPolicySpec(), created)
+		require.NoError(t, err)
+
+		status := AlertPolicyStatus("FIRING")
+		require.ErrorIs(t, policy.Update(AlertPolicyPatch{Status: &status}, later), constant.ErrAlertPolicyInvalidStatus)
+	})
+}
+
+func TestAlertPolicy_Clone(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	spec := validAlertPolicySpec()
+	spec.RuleID = &ruleID
+	spec.Description = testutil.StringPtr("desc")
+	spec.Scope = &Scope{Country: testutil.StringPtr("BR")}
+
+	original, err := NewAlertPolicy(spec, testutil.FixedTime())
+	require.NoError(t, err)
+
+	clone := original.Clone()
+	*clone.Description = "mutated"
+	*clone.RuleID = uuid.New()
+	*clone.Scope.Country = "US"
+
+	assert.Equal(t, "desc", *original.Description)
+	assert.Equal(t, ruleID, *original.RuleID)
+	assert.Equal(t, "BR", *original.Scope.Country)
+
+	var nilPolicy *AlertPolicy
+	assert.Nil(t, nilPolicy.Clone())
+}
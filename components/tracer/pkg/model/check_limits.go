@@ -20,7 +20,8 @@ import (
 
 // CheckLimitsInput represents the input for limit checking operations.
 // Amount is expressed as a decimal value (e.g., 1000.00 for USD/BRL).
-// AccountID is required; SegmentID, PortfolioID, MerchantID, TransactionType and SubType are optional for scope matching.
+// AccountID is required; SegmentID, PortfolioID, MerchantID, TransactionType, SubType, Country and
+// CounterpartyBankCode are optional for scope matching.
 type CheckLimitsInput struct {
 	Amount               decimal.Decimal  `json:"amount"`
 	Currency             string           `json:"currency"`
@@ -28,8 +29,10 @@ type CheckLimitsInput struct {
 	SegmentID            *uuid.UUID       `json:"segmentId,omitempty"`
 	PortfolioID          *uuid.UUID       `json:"portfolioId,omitempty"`
 	MerchantID           *uuid.UUID       `json:"merchantId,omitempty"`
-	TransactionType      *TransactionType `json:"transactionType,omitempty" swaggertype:"string" enums:"CARD,WIRE,PIX,CRYPTO" example:"CARD"`
+	TransactionType      *TransactionType `json:"transactionType,omitempty" swaggertype:"string" example:"CARD"`
 	SubType              *string          `json:"subType,omitempty" maxLength:"50"`
+	Country              *string          `json:"country,omitempty"`
+	CounterpartyBankCode *string          `json:"counterpartyBankCode,omitempty"`
 	TransactionTimestamp time.Time        `json:"transactionTimestamp"`
 }
 
@@ -723,7 +723,7 @@ func TestCheckLimitsInput_Validate_InvalidTransactionType(t *testing.T) {
 
 	accountID := testutil.MustDeterministicUUID(1)
 	fixedTime := testutil.FixedTime()
-	invalidTxType := model.TransactionType("INVALID")
+	invalidTxType := model.TransactionType("not-a-type")
 
 	input := &model.CheckLimitsInput{
 		Amount:               decimal.RequireFromString("100"),
@@ -0,0 +1,534 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// Bundle document identifiers. The version is bumped only on breaking changes
+// to the document shape, so older exports keep importing.
+const (
+	ConfigBundleAPIVersion = "tracer.midaz.io/v1"
+	ConfigBundleKind       = "TracerConfigBundle"
+)
+
+// MaxConfigBundleItems caps the number of rules plus limits in a single bundle.
+const MaxConfigBundleItems = 2000
+
+// BundleResourceKind identifies the resource a plan entry refers to.
+type BundleResourceKind string
+
+const (
+	BundleResourceRule  BundleResourceKind = "RULE"
+	BundleResourceLimit BundleResourceKind = "LIMIT"
+)
+
+// BundleChangeAction is what apply will do with a resource.
+type BundleChangeAction string
+
+const (
+	// BundleChangeCreate: declared in the bundle, missing in the tenant.
+	BundleChangeCreate BundleChangeAction = "CREATE"
+	// BundleChangeUpdate: present on both sides with field or status drift.
+	BundleChangeUpdate BundleChangeAction = "UPDATE"
+	// BundleChangeUnchanged: present on both sides and identical.
+	BundleChangeUnchanged BundleChangeAction = "UNCHANGED"
+	// BundleChangeConflict: the bundle changes a limit field that cannot be
+	// changed in place. Apply refuses to run while any conflict is planned.
+	BundleChangeConflict BundleChangeAction = "CONFLICT"
+	// BundleChangeUnmanaged: present in the tenant but not in the bundle. Reported
+	// so drift is visible; apply never touches it.
+	BundleChangeUnmanaged BundleChangeAction = "UNMANAGED"
+)
+
+// ConfigBundle is the declarative, versionable form of a tenant's rules and
+// limits. It carries no IDs or timestamps: rules are matched by normalized name
+// within their segment context (the same key the database enforces) and limits
+// by name, so a bundle exported from one environment applies cleanly to another.
+type ConfigBundle struct {
+	// Bundle format version
+	// example: tracer.midaz.io/v1
+	APIVersion string `json:"apiVersion" example:"tracer.midaz.io/v1"`
+
+	// Document kind
+	// example: TracerConfigBundle
+	Kind string `json:"kind" example:"TracerConfigBundle"`
+
+	// Timestamp of the export, informational only
+	// format: date-time
+	ExportedAt *time.Time `json:"exportedAt,omitempty" format:"date-time" example:"2026-01-01T00:00:00Z"`
+
+	// Declared rules
+	Rules []BundleRule `json:"rules"`
+
+	// Declared limits
+	Limits []BundleLimit `json:"limits"`
+}
+
+// BundleRule is the declarative form of a Rule.
+type BundleRule struct {
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	Expression  string     `json:"expression"`
+	Action      Decision   `json:"action" swaggertype:"string" enums:"ALLOW,DENY,REVIEW"`
+	Scopes      []Scope    `json:"scopes"`
+	Status      RuleStatus `json:"status" swaggertype:"string" enums:"DRAFT,ACTIVE,INACTIVE"`
+}
+
+// BundleLimit is the declarative form of a Limit.
+type BundleLimit struct {
+	Name            string          `json:"name"`
+	Description     *string         `json:"description,omitempty"`
+	LimitType       LimitType       `json:"limitType" swaggertype:"string" enums:"DAILY,WEEKLY,MONTHLY,CUSTOM,PER_TRANSACTION"`
+	MaxAmount       decimal.Decimal `json:"maxAmount" swaggertype:"string"`
+	Currency        string          `json:"currency"`
+	Scopes          []Scope         `json:"scopes"`
+	Status          LimitStatus     `json:"status" swaggertype:"string" enums:"DRAFT,ACTIVE,INACTIVE"`
+	ActiveTimeStart *TimeOfDay      `json:"activeTimeStart,omitempty" swaggertype:"string"`
+	ActiveTimeEnd   *TimeOfDay      `json:"activeTimeEnd,omitempty" swaggertype:"string"`
+	CustomStartDate *time.Time      `json:"customStartDate,omitempty" format:"date-time"`
+	CustomEndDate   *time.Time      `json:"customEndDate,omitempty" format:"date-time"`
+}
+
+// NewConfigBundle builds an export bundle from the tenant's current rules and
+// limits. Entries are sorted by key so repeated exports diff cleanly in git.
+func NewConfigBundle(rules []Rule, limits []Limit, exportedAt time.Time) *ConfigBundle {
+	utc := exportedAt.UTC()
+
+	bundle := &ConfigBundle{
+		APIVersion: ConfigBundleAPIVersion,
+		Kind:       ConfigBundleKind,
+		ExportedAt: &utc,
+		Rules:      make([]BundleRule, 0, len(rules)),
+		Limits:     make([]BundleLimit, 0, len(limits)),
+	}
+
+	for i := range rules {
+		r := &rules[i]
+		bundle.Rules = append(bundle.Rules, BundleRule{
+			Name:        r.Name,
+			Description: r.Description,
+			Expression:  r.Expression,
+			Action:      r.Action,
+			Scopes:      nonNilScopes(r.Scopes),
+			Status:      r.Status,
+		})
+	}
+
+	for i := range limits {
+		l := &limits[i]
+		bundle.Limits = append(bundle.Limits, BundleLimit{
+			Name:            l.Name,
+			Description:     l.Description,
+			LimitType:       l.LimitType,
+			MaxAmount:       l.MaxAmount,
+			Currency:        l.Currency,
+			Scopes:          nonNilScopes(l.Scopes),
+			Status:          l.Status,
+			ActiveTimeStart: l.ActiveTimeStart,
+			ActiveTimeEnd:   l.ActiveTimeEnd,
+			CustomStartDate: l.CustomStartDate,
+			CustomEndDate:   l.CustomEndDate,
+		})
+	}
+
+	slices.SortFunc(bundle.Rules, func(a, b BundleRule) int { return strings.Compare(a.Key(), b.Key()) })
+	slices.SortFunc(bundle.Limits, func(a, b BundleLimit) int { return strings.Compare(a.Key(), b.Key()) })
+
+	return bundle
+}
+
+// SetDefaults fills in omitted statuses (ACTIVE) and nil scope lists.
+func (b *ConfigBundle) SetDefaults() {
+	for i := range b.Rules {
+		if b.Rules[i].Status == "" {
+			b.Rules[i].Status = RuleStatusActive
+		}
+
+		b.Rules[i].Scopes = nonNilScopes(b.Rules[i].Scopes)
+	}
+
+	for i := range b.Limits {
+		if b.Limits[i].Status == "" {
+			b.Limits[i].Status = LimitStatusActive
+		}
+
+		b.Limits[i].Scopes = nonNilScopes(b.Limits[i].Scopes)
+	}
+}
+
+// Validate checks the document header and the structural invariants a plan
+// depends on: required names, declarable statuses and unique keys. Field-level
+// validation (CEL compilation, amounts, time windows) is left to the rule and
+// limit commands apply delegates to, so a bundle is held to exactly the same
+// rules as the one-by-one API. Errors wrap ErrInvalidConfigBundle with the
+// offending entry.
+func (b *ConfigBundle) Validate() error {
+	if b.APIVersion != ConfigBundleAPIVersion {
+		return fmt.Errorf("%w: unsupported apiVersion %q", constant.ErrInvalidConfigBundle, b.APIVersion)
+	}
+
+	if b.Kind != ConfigBundleKind {
+		return fmt.Errorf("%w: unsupported kind %q", constant.ErrInvalidConfigBundle, b.Kind)
+	}
+
+	if len(b.Rules)+len(b.Limits) > MaxConfigBundleItems {
+		return fmt.Errorf("%w: more than %d rules and limits", constant.ErrInvalidConfigBundle, MaxConfigBundleItems)
+	}
+
+	seen := make(map[string]struct{}, len(b.Rules)+len(b.Limits))
+
+	for i := range b.Rules {
+		r := &b.Rules[i]
+
+		if strings.TrimSpace(r.Name) == "" {
+			return fmt.Errorf("%w: rules[%d] has no name", constant.ErrInvalidConfigBundle, i)
+		}
+
+		if !isDeclarableStatus(string(r.Status)) {
+			return fmt.Errorf("%w: rule %q has undeclarable status %q", constant.ErrInvalidConfigBundle, r.Name, r.Status)
+		}
+
+		key := string(BundleResourceRule) + "/" + r.Key()
+		if _, dup := seen[key]; dup {
+			return fmt.Errorf("%w: rule %q is declared more than once", constant.ErrInvalidConfigBundle, r.Name)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	for i := range b.Limits {
+		l := &b.Limits[i]
+
+		if strings.TrimSpace(l.Name) == "" {
+			return fmt.Errorf("%w: limits[%d] has no name", constant.ErrInvalidConfigBundle, i)
+		}
+
+		if !isDeclarableStatus(string(l.Status)) {
+			return fmt.Errorf("%w: limit %q has undeclarable status %q", constant.ErrInvalidConfigBundle, l.Name, l.Status)
+		}
+
+		key := string(BundleResourceLimit) + "/" + l.Key()
+		if _, dup := seen[key]; dup {
+			return fmt.Errorf("%w: limit %q is declared more than once", constant.ErrInvalidConfigBundle, l.Name)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// isDeclarableStatus reports whether a bundle may ask for the status. DELETED is
+// not declarable: removing an entry from the bundle leaves it UNMANAGED instead.
+func isDeclarableStatus(status string) bool {
+	switch status {
+	case string(RuleStatusDraft), string(RuleStatusActive), string(RuleStatusInactive):
+		return true
+	default:
+		return false
+	}
+}
+
+// Key identifies the rule across environments: the normalized name (lowercase,
+// single-spaced, as CreateRule stores it) within its segment context.
+func (r *BundleRule) Key() string {
+	return RuleBundleKey(r.Name, r.Scopes)
+}
+
+// Key identifies the limit across environments: its trimmed name, which is
+// unique among non-deleted limits.
+func (l *BundleLimit) Key() string {
+	return strings.TrimSpace(l.Name)
+}
+
+// RuleBundleKey mirrors the (context_id, name) uniqueness of the rules table,
+// where the context is the smallest segmentId across the rule's scopes.
+func RuleBundleKey(name string, scopes []Scope) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(name)), " ")
+
+	var segment string
+
+	for _, s := range scopes {
+		if s.SegmentID != nil && (segment == "" || s.SegmentID.String() < segment) {
+			segment = s.SegmentID.String()
+		}
+	}
+
+	if segment == "" {
+		return normalized
+	}
+
+	return segment + "/" + normalized
+}
+
+// Diff lists the mutable fields that differ between the declaration and the
+// current rule. The name is part of the key, so it never differs; status is
+// compared separately (see BundleStatusPath).
+func (r *BundleRule) Diff(current *Rule) []string {
+	var changed []string
+
+	if !sameDescription(r.Description, current.Description) {
+		changed = append(changed, "description")
+	}
+
+	if strings.TrimSpace(r.Expression) != current.Expression {
+		changed = append(changed, "expression")
+	}
+
+	if r.Action != current.Action {
+		changed = append(changed, "action")
+	}
+
+	if !sameScopes(r.Scopes, current.Scopes) {
+		changed = append(changed, "scopes")
+	}
+
+	return changed
+}
+
+// Diff lists the mutable fields that differ between the declaration and the
+// current limit, and separately the ones apply cannot change in place (limitType,
+// currency, removing the active time window); those need the limit retired and a
+// new one declared under another name.
+func (l *BundleLimit) Diff(current *Limit) (changed, immutable []string) {
+	if l.LimitType != current.LimitType {
+		immutable = append(immutable, "limitType")
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(l.Currency), current.Currency) {
+		immutable = append(immutable, "currency")
+	}
+
+	if !sameDescription(l.Description, current.Description) {
+		changed = append(changed, "description")
+	}
+
+	if !l.MaxAmount.Equal(current.MaxAmount) {
+		changed = append(changed, "maxAmount")
+	}
+
+	if !sameScopes(l.Scopes, current.Scopes) {
+		changed = append(changed, "scopes")
+	}
+
+	if !sameTimeOfDay(l.ActiveTimeStart, current.ActiveTimeStart) || !sameTimeOfDay(l.ActiveTimeEnd, current.ActiveTimeEnd) {
+		// UpdateLimit replaces a window but has no way to clear one.
+		if l.ActiveTimeStart == nil && l.ActiveTimeEnd == nil {
+			immutable = append(immutable, "activeTimeWindow")
+		} else {
+			changed = append(changed, "activeTimeWindow")
+		}
+	}
+
+	if !sameInstant(l.CustomStartDate, current.CustomStartDate) || !sameInstant(l.CustomEndDate, current.CustomEndDate) {
+		changed = append(changed, "customPeriod")
+	}
+
+	return changed, immutable
+}
+
+// BundleStatusPath returns the status transitions that take a rule or limit
+// from current to target (both share the same lifecycle graph). DRAFT and
+// INACTIVE are both "not enforced": a DRAFT entry declared INACTIVE stays DRAFT,
+// because reaching INACTIVE from DRAFT would require activating it first.
+func BundleStatusPath(current, target string) []string {
+	if current == target {
+		return nil
+	}
+
+	switch {
+	case target == string(RuleStatusActive):
+		return []string{target}
+	case current == string(RuleStatusActive) && target == string(RuleStatusInactive):
+		return []string{target}
+	case current == string(RuleStatusActive) && target == string(RuleStatusDraft):
+		return []string{string(RuleStatusInactive), target}
+	case current == string(RuleStatusInactive) && target == string(RuleStatusDraft):
+		return []string{target}
+	default:
+		// DRAFT -> INACTIVE
+		return nil
+	}
+}
+
+// BundleChange is one entry of a plan.
+type BundleChange struct {
+	// Resource kind
+	// enums: RULE,LIMIT
+	Kind BundleResourceKind `json:"kind" swaggertype:"string" enums:"RULE,LIMIT" example:"RULE"`
+
+	// Resource name as declared (or as stored, for UNMANAGED entries)
+	Name string `json:"name" example:"block high-value checking transactions"`
+
+	// Existing resource ID, absent for CREATE
+	// format: uuid
+	ID *uuid.UUID `json:"id,omitempty" swaggertype:"string" format:"uuid"`
+
+	// Planned action
+	// enums: CREATE,UPDATE,UNCHANGED,CONFLICT,UNMANAGED
+	Action BundleChangeAction `json:"action" swaggertype:"string" enums:"CREATE,UPDATE,UNCHANGED,CONFLICT,UNMANAGED" example:"UPDATE"`
+
+	// Fields that will be changed (or, for CONFLICT, the immutable fields that differ)
+	ChangedFields []string `json:"changedFields,omitempty"`
+
+	// Current status, absent for CREATE
+	CurrentStatus string `json:"currentStatus,omitempty" example:"ACTIVE"`
+
+	// Declared status
+	TargetStatus string `json:"targetStatus,omitempty" example:"ACTIVE"`
+
+	// Status transitions apply will perform, in order. A CREATE starts from DRAFT.
+	Transitions []string `json:"transitions,omitempty"`
+
+	// Why a CONFLICT rule entry cannot be applied (its expression does not compile)
+	Reason string `json:"reason,omitempty" example:"ERROR: <input>:1:8: undeclared reference to 'amout'"`
+}
+
+// BundlePlanSummary counts plan entries per action.
+type BundlePlanSummary struct {
+	Create    int `json:"create"`
+	Update    int `json:"update"`
+	Unchanged int `json:"unchanged"`
+	Conflict  int `json:"conflict"`
+	Unmanaged int `json:"unmanaged"`
+}
+
+// BundlePlan is the diff between a bundle and the tenant's current state.
+type BundlePlan struct {
+	// Fingerprint of the bundle and of the state it was planned against. Pass it
+	// back to apply to make sure the reviewed plan is the one that runs.
+	Fingerprint string `json:"fingerprint" example:"3f1c..."`
+
+	Summary BundlePlanSummary `json:"summary"`
+	Changes []BundleChange    `json:"changes"`
+}
+
+// Add appends a change and updates the summary.
+func (p *BundlePlan) Add(change BundleChange) {
+	p.Changes = append(p.Changes, change)
+
+	switch change.Action {
+	case BundleChangeCreate:
+		p.Summary.Create++
+	case BundleChangeUpdate:
+		p.Summary.Update++
+	case BundleChangeUnchanged:
+		p.Summary.Unchanged++
+	case BundleChangeConflict:
+		p.Summary.Conflict++
+	case BundleChangeUnmanaged:
+		p.Summary.Unmanaged++
+	}
+}
+
+// HasInvalidExpression reports whether a rule entry conflicts. Rules have no
+// immutable fields, so a rule conflict always means its expression does not
+// compile.
+func (p *BundlePlan) HasInvalidExpression() bool {
+	return slices.ContainsFunc(p.Changes, func(change BundleChange) bool {
+		return change.Kind == BundleResourceRule && change.Action == BundleChangeConflict
+	})
+}
+
+// BundleApplyOptions controls an apply run.
+type BundleApplyOptions struct {
+	// DryRun computes the plan without changing anything.
+	DryRun bool
+	// ExpectedFingerprint, when set, must match the freshly computed plan.
+	ExpectedFingerprint string
+}
+
+// BundleApplyResult reports an apply run.
+type BundleApplyResult struct {
+	// Whether the run was a dry run
+	DryRun bool `json:"dryRun"`
+
+	// Number of CREATE and UPDATE entries applied
+	Applied int `json:"applied"`
+
+	// The plan that was (or, for a dry run, would be) applied
+	Plan *BundlePlan `json:"plan"`
+}
+
+// BundleEntryError reports the bundle entry an apply stopped at. Err is the
+// rule or limit error returned for it, unchanged.
+type BundleEntryError struct {
+	Kind BundleResourceKind
+	Name string
+	Err  error
+}
+
+func (e *BundleEntryError) Error() string {
+	return fmt.Sprintf("apply %s %q: %v", strings.ToLower(string(e.Kind)), e.Name, e.Err)
+}
+
+func (e *BundleEntryError) Unwrap() error {
+	return e.Err
+}
+
+func nonNilScopes(scopes []Scope) []Scope {
+	if scopes == nil {
+		return []Scope{}
+	}
+
+	return scopes
+}
+
+func sameDescription(a, b *string) bool {
+	var av, bv string
+
+	if a != nil {
+		av = strings.TrimSpace(*a)
+	}
+
+	if b != nil {
+		bv = strings.TrimSpace(*b)
+	}
+
+	return av == bv
+}
+
+// sameScopes compares scope lists in order after the same normalization the
+// entities apply on write.
+func sameScopes(declared, current []Scope) bool {
+	if len(declared) != len(current) {
+		return false
+	}
+
+	for i := range declared {
+		if !reflect.DeepEqual(cloneAndNormalizeScope(declared[i]), cloneAndNormalizeScope(current[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameTimeOfDay(a, b *TimeOfDay) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(*b)
+}
+
+func sameInstant(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(*b)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func validBundle() *ConfigBundle {
+	return &ConfigBundle{
+		APIVersion: ConfigBundleAPIVersion,
+		Kind:       ConfigBundleKind,
+		Rules: []BundleRule{
+			{Name: "Block High Amount", Expression: "amount > 1000", Action: DecisionDeny},
+		},
+		Limits: []BundleLimit{
+			{Name: "daily-cap", LimitType: LimitTypeDaily, MaxAmount: decimal.NewFromInt(500), Currency: "BRL"},
+		},
+	}
+}
+
+func TestConfigBundle_SetDefaults(t *testing.T) {
+	b := validBundle()
+	b.SetDefaults()
+
+	assert.Equal(t, RuleStatusActive, b.Rules[0].Status)
+	assert.NotNil(t, b.Rules[0].Scopes)
+	assert.Equal(t, LimitStatusActive, b.Limits[0].Status)
+	assert.NotNil(t, b.Limits[0].Scopes)
+}
+
+func TestConfigBundle_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(b *ConfigBundle)
+		wantErr string
+	}{
+		{name: "valid bundle", mutate: func(*ConfigBundle) {}},
+		{
+			name:    "wrong apiVersion",
+			mutate:  func(b *ConfigBundle) { b.APIVersion = "tracer.midaz.io/v0" },
+			wantErr: "unsupported apiVersion",
+		},
+		{
+			name:    "wrong kind",
+			mutate:  func(b *ConfigBundle) { b.Kind = "Other" },
+			wantErr: "unsupported kind",
+		},
+		{
+			name:    "rule without name",
+			mutate:  func(b *ConfigBundle) { b.Rules[0].Name = "  " },
+			wantErr: "rules[0] has no name",
+		},
+		{
+			name:    "deleted status is not declarable",
+			mutate:  func(b *ConfigBundle) { b.Limits[0].Status = LimitStatusDeleted },
+			wantErr: "undeclarable status",
+		},
+		{
+			name: "rule names collide after normalization",
+			mutate: func(b *ConfigBundle) {
+				b.Rules = append(b.Rules, BundleRule{Name: "block  high AMOUNT", Expression: "true", Action: DecisionDeny, Status: RuleStatusActive})
+			},
+			wantErr: "declared more than once",
+		},
+		{
+			name: "rule and limit may share a name",
+			mutate: func(b *ConfigBundle) {
+				b.Limits[0].Name = "block high amount"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := validBundle()
+			b.SetDefaults()
+			tt.mutate(b)
+
+			err := b.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, constant.ErrInvalidConfigBundle))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRuleBundleKey(t *testing.T) {
+	low := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	high := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	assert.Equal(t, "block high amount", RuleBundleKey("  Block   High Amount ", nil))
+	assert.Equal(t,
+		low.String()+"/block",
+		RuleBundleKey("Block", []Scope{{SegmentID: &high}, {SegmentID: &low}}),
+	)
+}
+
+func TestBundleRule_Diff(t *testing.T) {
+	desc := "blocks big tickets"
+	current := &Rule{Name: "block", Description: &desc, Expression: "amount > 1000", Action: DecisionDeny}
+
+	unchanged := &BundleRule{Name: "block", Description: &desc, Expression: " amount > 1000 ", Action: DecisionDeny}
+	assert.Empty(t, unchanged.Diff(current))
+
+	changed := &BundleRule{Name: "block", Expression: "amount > 2000", Action: DecisionReview}
+	assert.Equal(t, []string{"description", "expression", "action"}, changed.Diff(current))
+}
+
+func TestBundleLimit_Diff(t *testing.T) {
+	start, err := NewTimeOfDay("08:00")
+	require.NoError(t, err)
+
+	end, err := NewTimeOfDay("18:00")
+	require.NoError(t, err)
+
+	current := &Limit{
+		Name:            "daily-cap",
+		LimitType:       LimitTypeDaily,
+		MaxAmount:       decimal.NewFromInt(500),
+		Currency:        "BRL",
+		ActiveTimeStart: &start,
+		ActiveTimeEnd:   &end,
+	}
+
+	t.Run("mutable changes", func(t *testing.T) {
+		decl := &BundleLimit{Name: "daily-cap", LimitType: LimitTypeDaily, MaxAmount: decimal.NewFromInt(750), Currency: "brl", ActiveTimeStart: &start, ActiveTimeEnd: &end}
+
+		changed, immutable := decl.Diff(current)
+		assert.Equal(t, []string{"maxAmount"}, changed)
+		assert.Empty(t, immutable)
+	})
+
+	t.Run("immutable changes", func(t *testing.T) {
+		decl := &BundleLimit{Name: "daily-cap", LimitType: LimitTypeMonthly, MaxAmount: decimal.NewFromInt(500), Currency: "USD"}
+
+		_, immutable := decl.Diff(current)
+		assert.Equal(t, []string{"limitType", "currency", "activeTimeWindow"}, immutable)
+	})
+}
+
+func TestBundleStatusPath(t *testing.T) {
+	tests := []struct {
+		current, target string
+		want            []string
+	}{
+		{"ACTIVE", "ACTIVE", nil},
+		{"DRAFT", "ACTIVE", []string{"ACTIVE"}},
+		{"INACTIVE", "ACTIVE", []string{"ACTIVE"}},
+		{"ACTIVE", "INACTIVE", []string{"INACTIVE"}},
+		{"ACTIVE", "DRAFT", []string{"INACTIVE", "DRAFT"}},
+		{"INACTIVE", "DRAFT", []string{"DRAFT"}},
+		{"DRAFT", "INACTIVE", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+"->"+tt.target, func(t *testing.T) {
+			assert.Equal(t, tt.want, BundleStatusPath(tt.current, tt.target))
+		})
+	}
+}
+
+func TestNewConfigBundle_SortsByKey(t *testing.T) {
+	exportedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	b := NewConfigBundle(
+		[]Rule{{Name: "zeta", Status: RuleStatusActive}, {Name: "alpha", Status: RuleStatusDraft}},
+		[]Limit{{Name: "b-limit", Status: LimitStatusActive}, {Name: "a-limit", Status: LimitStatusInactive}},
+		exportedAt,
+	)
+
+	require.NoError(t, b.Validate())
+	assert.Equal(t, "alpha", b.Rules[0].Name)
+	assert.Equal(t, "a-limit", b.Limits[0].Name)
+	assert.Equal(t, exportedAt, *b.ExportedAt)
+}
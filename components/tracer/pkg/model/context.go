@@ -6,6 +6,7 @@ package model
 
 import (
 	"maps"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -189,3 +190,217 @@ func (p *PortfolioContext) ToMap() map[string]any {
 		"metadata":    metadata,
 	}
 }
+
+// DeviceContext describes the device the transaction was initiated from.
+// IPCountry and FirstSeenAt are resolved by the caller (IP intelligence,
+// device history); the tracer does not look them up.
+type DeviceContext struct {
+	Fingerprint string `json:"fingerprint" example:"f3b1c2d4e5a6"`
+	IPAddress   string `json:"ipAddress,omitempty" example:"203.0.113.10"`
+	IPCountry   string `json:"ipCountry,omitempty" example:"BR"` // ISO 3166-1 alpha-2 code
+	UserAgent   string `json:"userAgent,omitempty" example:"Mozilla/5.0"`
+	// FirstSeenAt is when the device was first seen for this account (optional)
+	FirstSeenAt *time.Time     `json:"firstSeenAt,omitempty" format:"date-time" example:"2021-01-01T00:00:00Z"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+}
+
+// Clone creates a copy of DeviceContext.
+// Returns nil if the receiver is nil.
+// Metadata map entries are shallow-copied; nested mutable values will be shared.
+func (d *DeviceContext) Clone() *DeviceContext {
+	if d == nil {
+		return nil
+	}
+
+	clone := *d
+	if d.FirstSeenAt != nil {
+		firstSeenAt := *d.FirstSeenAt
+		clone.FirstSeenAt = &firstSeenAt
+	}
+
+	if d.Metadata != nil {
+		clone.Metadata = make(map[string]any, len(d.Metadata))
+		maps.Copy(clone.Metadata, d.Metadata)
+	}
+
+	return &clone
+}
+
+// ToMap converts DeviceContext to map[string]any for CEL evaluation.
+// firstSeenAt is exposed in Unix nanoseconds, like transactionTimestamp, and
+// is omitted when unknown so rules referencing it do not match.
+// Returns nil if the receiver is nil.
+func (d *DeviceContext) ToMap() map[string]any {
+	if d == nil {
+		return nil
+	}
+
+	metadata := d.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	result := map[string]any{
+		"fingerprint": d.Fingerprint,
+		"ipAddress":   d.IPAddress,
+		"ipCountry":   d.IPCountry,
+		"userAgent":   d.UserAgent,
+		"metadata":    metadata,
+	}
+
+	if d.FirstSeenAt != nil {
+		result["firstSeenAt"] = d.FirstSeenAt.UnixNano()
+	}
+
+	return result
+}
+
+// GeoPoint is a coordinate pair, optionally with the time it was observed.
+type GeoPoint struct {
+	Latitude   float64    `json:"latitude" example:"-23.5505"`
+	Longitude  float64    `json:"longitude" example:"-46.6333"`
+	ObservedAt *time.Time `json:"observedAt,omitempty" format:"date-time" example:"2021-01-01T00:00:00Z"`
+}
+
+// toMap converts GeoPoint to map[string]any for CEL evaluation.
+func (p *GeoPoint) toMap() map[string]any {
+	result := map[string]any{
+		"latitude":  p.Latitude,
+		"longitude": p.Longitude,
+	}
+
+	if p.ObservedAt != nil {
+		result["observedAt"] = p.ObservedAt.UnixNano()
+	}
+
+	return result
+}
+
+// GeolocationContext contains where the transaction was initiated. LastKnown
+// is the previous location the caller has on record for the account, which
+// lets rules detect impossible travel without tracer-side history.
+type GeolocationContext struct {
+	Country   string         `json:"country,omitempty" example:"BR"` // ISO 3166-1 alpha-2 code
+	Latitude  *float64       `json:"latitude,omitempty" example:"-23.5505"`
+	Longitude *float64       `json:"longitude,omitempty" example:"-46.6333"`
+	LastKnown *GeoPoint      `json:"lastKnown,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// Clone creates a copy of GeolocationContext.
+// Returns nil if the receiver is nil.
+// Metadata map entries are shallow-copied; nested mutable values will be shared.
+func (g *GeolocationContext) Clone() *GeolocationContext {
+	if g == nil {
+		return nil
+	}
+
+	clone := *g
+	if g.Latitude != nil {
+		latitude := *g.Latitude
+		clone.Latitude = &latitude
+	}
+
+	if g.Longitude != nil {
+		longitude := *g.Longitude
+		clone.Longitude = &longitude
+	}
+
+	if g.LastKnown != nil {
+		lastKnown := *g.LastKnown
+		if g.LastKnown.ObservedAt != nil {
+			observedAt := *g.LastKnown.ObservedAt
+			lastKnown.ObservedAt = &observedAt
+		}
+
+		clone.LastKnown = &lastKnown
+	}
+
+	if g.Metadata != nil {
+		clone.Metadata = make(map[string]any, len(g.Metadata))
+		maps.Copy(clone.Metadata, g.Metadata)
+	}
+
+	return &clone
+}
+
+// ToMap converts GeolocationContext to map[string]any for CEL evaluation.
+// Coordinates and lastKnown are omitted when absent.
+// Returns nil if the receiver is nil.
+func (g *GeolocationContext) ToMap() map[string]any {
+	if g == nil {
+		return nil
+	}
+
+	metadata := g.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	result := map[string]any{
+		"country":  g.Country,
+		"metadata": metadata,
+	}
+
+	if g.Latitude != nil && g.Longitude != nil {
+		result["latitude"] = *g.Latitude
+		result["longitude"] = *g.Longitude
+	}
+
+	if g.LastKnown != nil {
+		result["lastKnown"] = g.LastKnown.toMap()
+	}
+
+	return result
+}
+
+// CounterpartyContext contains the other side of the transaction (destination
+// for outgoing transfers). AccountID is free-form because the counterparty is
+// usually held at another institution.
+type CounterpartyContext struct {
+	AccountID string         `json:"accountId,omitempty" example:"0001-123456-7"`
+	BankCode  string         `json:"bankCode,omitempty" example:"341"`
+	Document  string         `json:"document,omitempty" example:"12345678901"`
+	Name      string         `json:"name,omitempty" example:"Jane Doe"`
+	Country   string         `json:"country,omitempty" example:"BR"` // ISO 3166-1 alpha-2 code
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// Clone creates a copy of CounterpartyContext.
+// Returns nil if the receiver is nil.
+// Metadata map entries are shallow-copied; nested mutable values will be shared.
+func (c *CounterpartyContext) Clone() *CounterpartyContext {
+	if c == nil {
+		return nil
+	}
+
+	clone := *c
+	if c.Metadata != nil {
+		clone.Metadata = make(map[string]any, len(c.Metadata))
+		maps.Copy(clone.Metadata, c.Metadata)
+	}
+
+	return &clone
+}
+
+// ToMap converts CounterpartyContext to map[string]any for CEL evaluation.
+// Returns nil if the receiver is nil.
+func (c *CounterpartyContext) ToMap() map[string]any {
+	if c == nil {
+		return nil
+	}
+
+	metadata := c.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	return map[string]any{
+		"accountId": c.AccountID,
+		"bankCode":  c.BankCode,
+		"document":  c.Document,
+		"name":      c.Name,
+		"country":   c.Country,
+		"metadata":  metadata,
+	}
+}
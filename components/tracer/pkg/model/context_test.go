@@ -87,3 +87,79 @@ func TestPortfolioContext_ToMap_Success(t *testing.T) {
 	assert.Equal(t, id.String(), result["portfolioId"])
 	assert.Equal(t, "Growth", result["name"])
 }
+
+func TestDeviceContext_ToMap(t *testing.T) {
+	var nilDevice *DeviceContext
+	assert.Nil(t, nilDevice.ToMap(), "ToMap on nil DeviceContext should return nil")
+
+	d := &DeviceContext{Fingerprint: "fp-1", IPAddress: "203.0.113.7", IPCountry: "BR", UserAgent: "Mozilla/5.0"}
+	result := d.ToMap()
+	assert.Equal(t, "fp-1", result["fingerprint"])
+	assert.Equal(t, "203.0.113.7", result["ipAddress"])
+	assert.Equal(t, "BR", result["ipCountry"])
+	assert.NotContains(t, result, "firstSeenAt", "unknown firstSeenAt should be omitted")
+	assert.Equal(t, map[string]any{}, result["metadata"])
+
+	firstSeen := testutil.FixedTime()
+	d.FirstSeenAt = &firstSeen
+	assert.Equal(t, firstSeen.UnixNano(), d.ToMap()["firstSeenAt"])
+}
+
+func TestGeolocationContext_ToMap(t *testing.T) {
+	var nilGeo *GeolocationContext
+	assert.Nil(t, nilGeo.ToMap(), "ToMap on nil GeolocationContext should return nil")
+
+	g := &GeolocationContext{Country: "BR"}
+	result := g.ToMap()
+	assert.Equal(t, "BR", result["country"])
+	assert.NotContains(t, result, "latitude")
+	assert.NotContains(t, result, "lastKnown")
+
+	lat, lon := -23.5505, -46.6333
+	g.Latitude, g.Longitude = &lat, &lon
+	g.LastKnown = &GeoPoint{Latitude: 38.7223, Longitude: -9.1393}
+	result = g.ToMap()
+	assert.Equal(t, lat, result["latitude"])
+	assert.Equal(t, lon, result["longitude"])
+	assert.Equal(t, map[string]any{"latitude": 38.7223, "longitude": -9.1393}, result["lastKnown"])
+}
+
+func TestCounterpartyContext_ToMap(t *testing.T) {
+	var nilCounterparty *CounterpartyContext
+	assert.Nil(t, nilCounterparty.ToMap(), "ToMap on nil CounterpartyContext should return nil")
+
+	c := &CounterpartyContext{AccountID: "0001-123456", BankCode: "341", Document: "12345678000190", Country: "BR"}
+	result := c.ToMap()
+	assert.Equal(t, "0001-123456", result["accountId"])
+	assert.Equal(t, "341", result["bankCode"])
+	assert.Equal(t, "12345678000190", result["document"])
+	assert.Equal(t, "BR", result["country"])
+}
+
+func TestFraudContexts_Clone_IsIndependent(t *testing.T) {
+	firstSeen := testutil.FixedTime()
+	lat, lon := 1.0, 2.0
+
+	d := &DeviceContext{Fingerprint: "fp-1", FirstSeenAt: &firstSeen, Metadata: map[string]any{"k": "v"}}
+	dc := d.Clone()
+	dc.Metadata["k"] = "changed"
+	*dc.FirstSeenAt = firstSeen.AddDate(1, 0, 0)
+	assert.Equal(t, "v", d.Metadata["k"])
+	assert.Equal(t, testutil.FixedTime(), *d.FirstSeenAt)
+
+	g := &GeolocationContext{Latitude: &lat, Longitude: &lon, LastKnown: &GeoPoint{Latitude: 3, Longitude: 4}}
+	gc := g.Clone()
+	*gc.Latitude = 10
+	gc.LastKnown.Latitude = 30
+	assert.Equal(t, 1.0, *g.Latitude)
+	assert.Equal(t, 3.0, g.LastKnown.Latitude)
+
+	c := &CounterpartyContext{AccountID: "a", Metadata: map[string]any{"k": "v"}}
+	cc := c.Clone()
+	cc.Metadata["k"] = "changed"
+	assert.Equal(t, "v", c.Metadata["k"])
+
+	assert.Nil(t, (*DeviceContext)(nil).Clone())
+	assert.Nil(t, (*GeolocationContext)(nil).Clone())
+	assert.Nil(t, (*CounterpartyContext)(nil).Clone())
+}
@@ -259,11 +259,11 @@ func newLimitBase(
 	}
 
 	// Defensive copy of scopes to prevent external mutation.
-	// SubType is normalized to trimmed lowercase canonical form so DB state is
+	// SubType and Country are normalized to their canonical case so DB state is
 	// symmetric with runtime case-insensitive matching.
 	scopesCopy := append([]Scope(nil), scopes...)
 	for i := range scopesCopy {
-		normalizeScopeSubType(&scopesCopy[i])
+		normalizeScope(&scopesCopy[i])
 	}
 
 	return &Limit{
@@ -294,6 +294,10 @@ func validateScopes(scopes []Scope) error {
 		if scope.TransactionType != nil && !scope.TransactionType.IsValid() {
 			return constant.ErrLimitInvalidScope
 		}
+
+		if !scope.hasValidValues() {
+			return constant.ErrLimitInvalidScope
+		}
 	}
 
 	return nil
@@ -617,11 +621,11 @@ func (l *Limit) Update(
 		}
 
 		// Defensive copy to prevent external mutation.
-		// SubType is normalized to trimmed lowercase canonical form so DB state is
-		// symmetric with runtime case-insensitive matching.
+		// SubType and Country are normalized to their canonical case so DB state
+		// is symmetric with runtime case-insensitive matching.
 		scopesCopy := append([]Scope(nil), *scopes...)
 		for i := range scopesCopy {
-			normalizeScopeSubType(&scopesCopy[i])
+			normalizeScope(&scopesCopy[i])
 		}
 
 		l.Scopes = scopesCopy
@@ -153,7 +153,7 @@ func NewRule(name, expression string, action Decision, scopes []Scope, descripti
 	// symmetric with runtime case-insensitive matching.
 	scopesCopy := make([]Scope, 0, len(scopes))
 	for _, scope := range scopes {
-		if scope.IsEmpty() {
+		if scope.IsEmpty() || !scope.hasValidValues() {
 			return nil, constant.ErrRuleInvalidScope
 		}
 
@@ -223,7 +223,7 @@ func (r *Rule) Update(
 	// Validate scopes - each scope must have at least one field set
 	if scopes != nil {
 		for _, scope := range *scopes {
-			if scope.IsEmpty() {
+			if scope.IsEmpty() || !scope.hasValidValues() {
 				return constant.ErrRuleInvalidScope
 			}
 		}
@@ -70,12 +70,33 @@ func normalizeScopeSubType(s *Scope) {
 	s.SubType = normalizeSubTypeRaw(s.SubType)
 }
 
-// cloneAndNormalizeScope returns a deep copy of s with SubType normalized to
-// its canonical form. All pointer fields (SegmentID, PortfolioID, AccountID,
-// MerchantID, TransactionType, SubType) are copied to fresh allocations so the
-// returned Scope is fully independent of the caller's memory. Used by write
-// paths (e.g. NewRule, Rule.Update) to prevent external mutation of persisted
-// state and to keep the six-field deep-copy + normalize sequence in a single
+// normalizeScope brings every free-text Scope field to its canonical form:
+// SubType trimmed and lowercased, Country trimmed and uppercased,
+// CounterpartyBankCode trimmed. Nil pointers remain nil.
+func normalizeScope(s *Scope) {
+	if s == nil {
+		return
+	}
+
+	normalizeScopeSubType(s)
+
+	if s.Country != nil {
+		country := strings.ToUpper(strings.TrimSpace(*s.Country))
+		s.Country = &country
+	}
+
+	if s.CounterpartyBankCode != nil {
+		bankCode := strings.TrimSpace(*s.CounterpartyBankCode)
+		s.CounterpartyBankCode = &bankCode
+	}
+}
+
+// cloneAndNormalizeScope returns a deep copy of s with its free-text fields
+// in canonical form (see normalizeScope). The UUID and TransactionType pointers
+// are copied to fresh allocations and normalizeScope allocates the string
+// fields, so the returned Scope is fully independent of the caller's memory.
+// Used by write paths (e.g. NewRule, Rule.Update) to prevent external mutation
+// of persisted state and to keep the deep-copy + normalize sequence in a single
 // source of truth, eliminating drift when new Scope fields are added.
 func cloneAndNormalizeScope(s Scope) Scope {
 	scopeCopy := s
@@ -105,7 +126,7 @@ func cloneAndNormalizeScope(s Scope) Scope {
 		scopeCopy.TransactionType = &transactionTypeCopy
 	}
 
-	normalizeScopeSubType(&scopeCopy)
+	normalizeScope(&scopeCopy)
 
 	return scopeCopy
 }
@@ -134,12 +155,22 @@ type Scope struct {
 
 	// Transaction type the scope is restricted to (optional)
 	// example: CARD
-	TransactionType *TransactionType `json:"transactionType,omitempty" validate:"omitempty,transactiontype" swaggertype:"string" enums:"CARD,WIRE,PIX,CRYPTO" example:"CARD"`
+	TransactionType *TransactionType `json:"transactionType,omitempty" validate:"omitempty,transactiontype" swaggertype:"string" example:"CARD"`
 
 	// SubType is normalized to lowercase canonical form; matching is case-insensitive.
 	// example: purchase
 	// maxLength: 50
 	SubType *string `json:"subType,omitempty" validate:"omitempty,max=50" maxLength:"50" extensions:"x-normalization=lowercase" example:"purchase"`
+
+	// Country matches geolocation.country of the transaction (ISO 3166-1 alpha-2,
+	// normalized to uppercase)
+	// example: BR
+	Country *string `json:"country,omitempty" validate:"omitempty,len=2" minLength:"2" maxLength:"2" extensions:"x-normalization=uppercase" example:"BR"`
+
+	// CounterpartyBankCode matches counterparty.bankCode of the transaction
+	// example: 341
+	// maxLength: 100
+	CounterpartyBankCode *string `json:"counterpartyBankCode,omitempty" validate:"omitempty,max=100" maxLength:"100" example:"341"`
 }
 
 // IsEmpty returns true if all scope fields are nil.
@@ -149,7 +180,27 @@ func (s *Scope) IsEmpty() bool {
 		s.AccountID == nil &&
 		s.MerchantID == nil &&
 		s.TransactionType == nil &&
-		s.SubType == nil
+		s.SubType == nil &&
+		s.Country == nil &&
+		s.CounterpartyBankCode == nil
+}
+
+// hasValidValues reports whether the free-text fields hold values that can
+// match a transaction once normalized: a two-letter country code and a
+// non-empty counterparty bank code.
+func (s *Scope) hasValidValues() bool {
+	if s.Country != nil && !countryCodePattern.MatchString(strings.ToUpper(strings.TrimSpace(*s.Country))) {
+		return false
+	}
+
+	if s.CounterpartyBankCode != nil {
+		bankCode := strings.TrimSpace(*s.CounterpartyBankCode)
+		if bankCode == "" || len(bankCode) > maxCounterpartyIDLength {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Matches checks if this scope matches another scope.
@@ -161,7 +212,9 @@ func (s *Scope) Matches(other *Scope) bool {
 		ptrMatches(s.PortfolioID, other.PortfolioID) &&
 		ptrMatches(s.MerchantID, other.MerchantID) &&
 		ptrMatches(s.TransactionType, other.TransactionType) &&
-		ptrMatchesFold(s.SubType, other.SubType)
+		ptrMatchesFold(s.SubType, other.SubType) &&
+		ptrMatchesFold(s.Country, other.Country) &&
+		ptrMatches(s.CounterpartyBankCode, other.CounterpartyBankCode)
 }
 
 // ToMap converts Scope to map[string]any for CEL evaluation.
@@ -193,5 +246,13 @@ func (s *Scope) ToMap() map[string]any {
 		result["subType"] = *s.SubType
 	}
 
+	if s.Country != nil {
+		result["country"] = *s.Country
+	}
+
+	if s.CounterpartyBankCode != nil {
+		result["counterpartyBankCode"] = *s.CounterpartyBankCode
+	}
+
 	return result
 }
@@ -403,6 +403,30 @@ func TestScope_Matches(t *testing.T) {
 			other:    Scope{TransactionType: &txTypePix},
 			expected: false,
 		},
+		{
+			name:     "country matches case-insensitively",
+			scope:    Scope{Country: testutil.StringPtr("BR")},
+			other:    Scope{Country: testutil.StringPtr("br")},
+			expected: true,
+		},
+		{
+			name:     "country differs",
+			scope:    Scope{Country: testutil.StringPtr("BR")},
+			other:    Scope{Country: testutil.StringPtr("PT")},
+			expected: false,
+		},
+		{
+			name:     "counterparty bank code matches",
+			scope:    Scope{CounterpartyBankCode: testutil.StringPtr("341")},
+			other:    Scope{AccountID: &accountID1, CounterpartyBankCode: testutil.StringPtr("341")},
+			expected: true,
+		},
+		{
+			name:     "counterparty bank code missing in other",
+			scope:    Scope{CounterpartyBankCode: testutil.StringPtr("341")},
+			other:    Scope{AccountID: &accountID1},
+			expected: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -412,3 +436,34 @@ func TestScope_Matches(t *testing.T) {
 		})
 	}
 }
+
+func TestScope_HasValidValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    Scope
+		expected bool
+	}{
+		{name: "no free-text fields", scope: Scope{}, expected: true},
+		{name: "lowercase country is normalized", scope: Scope{Country: testutil.StringPtr(" br ")}, expected: true},
+		{name: "three-letter country", scope: Scope{Country: testutil.StringPtr("BRA")}, expected: false},
+		{name: "empty country", scope: Scope{Country: testutil.StringPtr("")}, expected: false},
+		{name: "bank code", scope: Scope{CounterpartyBankCode: testutil.StringPtr("341")}, expected: true},
+		{name: "blank bank code", scope: Scope{CounterpartyBankCode: testutil.StringPtr("  ")}, expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.scope.hasValidValues())
+		})
+	}
+}
+
+func TestCloneAndNormalizeScope_FraudFields(t *testing.T) {
+	original := Scope{Country: testutil.StringPtr(" br "), CounterpartyBankCode: testutil.StringPtr(" 341 ")}
+
+	normalized := cloneAndNormalizeScope(original)
+
+	assert.Equal(t, "BR", *normalized.Country)
+	assert.Equal(t, "341", *normalized.CounterpartyBankCode)
+	assert.Equal(t, " br ", *original.Country, "original must not be mutated")
+}
@@ -4,7 +4,12 @@
 
 package model
 
-// TransactionType represents the type of financial transaction
+import "regexp"
+
+// TransactionType represents the type of financial transaction.
+// The set of accepted types is tenant-configurable through the transaction-type
+// catalog (see TransactionTypeDefinition); the constants below are the built-in
+// types seeded into every catalog.
 type TransactionType string
 
 const (
@@ -14,8 +19,29 @@ const (
 	TransactionTypeCrypto TransactionType = "CRYPTO"
 )
 
-// IsValid checks if the transaction type is valid
+// MaxTransactionTypeLength defines the maximum length for a transaction type code
+// (aligned with VARCHAR(32) in database).
+const MaxTransactionTypeLength = 32
+
+// transactionTypePattern is the canonical shape of a transaction type code:
+// an uppercase letter followed by 1-31 uppercase letters, digits or underscores.
+var transactionTypePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,31}$`)
+
+// BuiltinTransactionTypes returns the transaction types seeded into every
+// tenant catalog. A fresh slice is returned on each call.
+func BuiltinTransactionTypes() []TransactionType {
+	return []TransactionType{TransactionTypeCard, TransactionTypeWire, TransactionTypePix, TransactionTypeCrypto}
+}
+
+// IsValid checks if the transaction type is a well-formed code.
+// It validates the shape only; whether the code is registered (and active) in
+// the tenant's catalog is checked by the service layer against the catalog.
 func (t TransactionType) IsValid() bool {
+	return transactionTypePattern.MatchString(string(t))
+}
+
+// IsBuiltin reports whether the transaction type is one of the built-in types.
+func (t TransactionType) IsBuiltin() bool {
 	switch t {
 	case TransactionTypeCard, TransactionTypeWire, TransactionTypePix, TransactionTypeCrypto:
 		return true
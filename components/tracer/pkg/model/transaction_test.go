@@ -47,18 +47,38 @@ func TestTransactionType_IsValid(t *testing.T) {
 			expected: false,
 		},
 		{
-			name:     "Error - random string is invalid",
-			txType:   TransactionType("INVALID"),
+			name:     "Success - catalog-style code is well-formed",
+			txType:   TransactionType("BOLETO"),
+			expected: true,
+		},
+		{
+			name:     "Success - ACH is well-formed",
+			txType:   TransactionType("ACH"),
+			expected: true,
+		},
+		{
+			name:     "Success - underscores and digits are allowed",
+			txType:   TransactionType("P2P_INSTANT"),
+			expected: true,
+		},
+		{
+			name:     "Error - single character is invalid",
+			txType:   TransactionType("A"),
 			expected: false,
 		},
 		{
-			name:     "Error - partial match is invalid",
-			txType:   TransactionType("CAR"),
+			name:     "Error - leading digit is invalid",
+			txType:   TransactionType("2FA"),
 			expected: false,
 		},
 		{
-			name:     "Error - ACH is invalid (not supported)",
-			txType:   TransactionType("ACH"),
+			name:     "Error - hyphen is invalid",
+			txType:   TransactionType("NOT-A-TYPE"),
+			expected: false,
+		},
+		{
+			name:     "Error - longer than 32 characters is invalid",
+			txType:   TransactionType("ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456"),
 			expected: false,
 		},
 	}
@@ -71,6 +91,15 @@ func TestTransactionType_IsValid(t *testing.T) {
 	}
 }
 
+func TestTransactionType_IsBuiltin(t *testing.T) {
+	for _, tt := range BuiltinTransactionTypes() {
+		assert.True(t, tt.IsBuiltin(), "%s should be builtin", tt)
+	}
+
+	assert.False(t, TransactionType("BOLETO").IsBuiltin())
+	assert.False(t, TransactionType("card").IsBuiltin())
+}
+
 func TestTransactionTypeConstants(t *testing.T) {
 	t.Run("Success - constants have expected values", func(t *testing.T) {
 		assert.Equal(t, TransactionType("CARD"), TransactionTypeCard)
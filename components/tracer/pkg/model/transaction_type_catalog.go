@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// TransactionTypeStatus represents the lifecycle status of a catalog entry.
+type TransactionTypeStatus string
+
+const (
+	TransactionTypeStatusActive   TransactionTypeStatus = "ACTIVE"
+	TransactionTypeStatusInactive TransactionTypeStatus = "INACTIVE"
+)
+
+// MaxTransactionTypeSubTypes caps the number of allowed subtypes per catalog entry.
+const MaxTransactionTypeSubTypes = 100
+
+// IsValid checks if the TransactionTypeStatus is a valid enum value.
+func (s TransactionTypeStatus) IsValid() bool {
+	switch s {
+	case TransactionTypeStatusActive, TransactionTypeStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the status.
+func (s TransactionTypeStatus) String() string {
+	return string(s)
+}
+
+// TransactionTypeDefinition is a tenant catalog entry describing a transaction
+// type accepted by validations, rule scopes and limit scopes.
+//
+// SubTypes lists the subtypes accepted for this type, in lowercase canonical form
+// (the same normalization applied to ValidationRequest.SubType and Scope.SubType).
+// An empty list means any subtype (or none) is accepted.
+//
+// Entries are never deleted: validations already persisted reference them, so a
+// type that must stop being accepted is moved to INACTIVE instead.
+type TransactionTypeDefinition struct {
+	// Transaction type code, used as the identifier
+	// example: BOLETO
+	// maxLength: 32
+	Code TransactionType `json:"code" swaggertype:"string" example:"BOLETO" maxLength:"32"`
+
+	// Optional description of the transaction type
+	// example: Brazilian bank slip payment
+	Description *string `json:"description,omitempty" example:"Brazilian bank slip payment"`
+
+	// Allowed subtypes (lowercase). Empty means any subtype is accepted.
+	SubTypes []string `json:"subTypes"`
+
+	// Whether this is one of the built-in types seeded into every catalog
+	Builtin bool `json:"builtin" example:"false"`
+
+	// Current lifecycle status of the catalog entry
+	// enums: ACTIVE,INACTIVE
+	Status TransactionTypeStatus `json:"status" swaggertype:"string" enums:"ACTIVE,INACTIVE" example:"ACTIVE"`
+
+	// Timestamp when the entry was created
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+
+	// Timestamp when the entry was last updated
+	// format: date-time
+	UpdatedAt time.Time `json:"updatedAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+}
+
+// NewTransactionTypeDefinition creates a new ACTIVE catalog entry with validation.
+// Code is trimmed and uppercased before validation; subtypes are normalized to
+// their lowercase canonical form and must be unique.
+func NewTransactionTypeDefinition(code string, description *string, subTypes []string, createdAt time.Time) (*TransactionTypeDefinition, error) {
+	normalizedCode := TransactionType(strings.ToUpper(strings.TrimSpace(code)))
+	if !normalizedCode.IsValid() {
+		return nil, constant.ErrTransactionTypeInvalidCode
+	}
+
+	normalizedDescription, err := normalizeTransactionTypeDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedSubTypes, err := normalizeTransactionTypeSubTypes(subTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionTypeDefinition{
+		Code:        normalizedCode,
+		Description: normalizedDescription,
+		SubTypes:    normalizedSubTypes,
+		Builtin:     normalizedCode.IsBuiltin(),
+		Status:      TransactionTypeStatusActive,
+		CreatedAt:   createdAt.UTC(),
+		UpdatedAt:   createdAt.UTC(),
+	}, nil
+}
+
+// Update modifies the mutable catalog fields with validation.
+// All parameters are optional (use nil to keep current value).
+// Validates ALL inputs before mutating ANY (atomicity guarantee).
+// Updates UpdatedAt timestamp on successful mutation.
+func (d *TransactionTypeDefinition) Update(description *string, subTypes *[]string, status *TransactionTypeStatus, now time.Time) error {
+	var (
+		normalizedDescription *string
+		normalizedSubTypes    []string
+		err                   error
+	)
+
+	if description != nil {
+		normalizedDescription, err = normalizeTransactionTypeDescription(description)
+		if err != nil {
+			return err
+		}
+	}
+
+	if subTypes != nil {
+		normalizedSubTypes, err = normalizeTransactionTypeSubTypes(*subTypes)
+		if err != nil {
+			return err
+		}
+	}
+
+	if status != nil && !status.IsValid() {
+		return constant.ErrTransactionTypeInvalidStatus
+	}
+
+	updated := false
+
+	if description != nil {
+		d.Description = normalizedDescription
+		updated = true
+	}
+
+	if subTypes != nil {
+		d.SubTypes = normalizedSubTypes
+		updated = true
+	}
+
+	if status != nil && d.Status != *status {
+		d.Status = *status
+		updated = true
+	}
+
+	if updated {
+		d.UpdatedAt = now.UTC()
+	}
+
+	return nil
+}
+
+// IsActive reports whether the catalog entry currently accepts transactions.
+func (d *TransactionTypeDefinition) IsActive() bool {
+	return d.Status == TransactionTypeStatusActive
+}
+
+// AllowsSubType reports whether subType is accepted by this entry.
+// A nil subType is always accepted, as is any subtype when the entry declares
+// no subtypes. Comparison uses the lowercase canonical form.
+func (d *TransactionTypeDefinition) AllowsSubType(subType *string) bool {
+	if subType == nil || len(d.SubTypes) == 0 {
+		return true
+	}
+
+	canonical := normalizeSubTypeRaw(subType)
+
+	for _, allowed := range d.SubTypes {
+		if allowed == *canonical {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clone returns a deep copy of the definition.
+func (d *TransactionTypeDefinition) Clone() *TransactionTypeDefinition {
+	if d == nil {
+		return nil
+	}
+
+	clone := *d
+
+	if d.Description != nil {
+		descriptionCopy := *d.Description
+		clone.Description = &descriptionCopy
+	}
+
+	clone.SubTypes = append([]string{}, d.SubTypes...)
+
+	return &clone
+}
+
+// normalizeTransactionTypeDescription trims the description and validates its
+// length and content. An empty description after trimming is returned as nil.
+func normalizeTransactionTypeDescription(description *string) (*string, error) {
+	if description == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*description)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	if len(trimmed) > MaxDescriptionLength || !safeDescriptionRegex.MatchString(trimmed) {
+		return nil, constant.ErrTransactionTypeDescriptionTooLong
+	}
+
+	return &trimmed, nil
+}
+
+// normalizeTransactionTypeSubTypes lowercases and trims every subtype and
+// rejects empty, oversized or duplicated entries. Always returns a non-nil slice
+// so the entry serializes subTypes as [] rather than null.
+func normalizeTransactionTypeSubTypes(subTypes []string) ([]string, error) {
+	if len(subTypes) > MaxTransactionTypeSubTypes {
+		return nil, constant.ErrTransactionTypeInvalidSubTypes
+	}
+
+	normalized := make([]string, 0, len(subTypes))
+	seen := make(map[string]struct{}, len(subTypes))
+
+	for _, subType := range subTypes {
+		canonical := *normalizeSubTypeRaw(&subType)
+		if canonical == "" || len(canonical) > MaxSubTypeLength {
+			return nil, constant.ErrTransactionTypeInvalidSubTypes
+		}
+
+		if _, dup := seen[canonical]; dup {
+			return nil, constant.ErrTransactionTypeInvalidSubTypes
+		}
+
+		seen[canonical] = struct{}{}
+		normalized = append(normalized, canonical)
+	}
+
+	return normalized, nil
+}
+
+// ListTransactionTypesFilter represents the filter criteria for listing catalog entries.
+// The catalog is small and bounded by design, so listing is not paginated.
+type ListTransactionTypesFilter struct {
+	Status *TransactionTypeStatus
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func TestTransactionTypeStatus_IsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, TransactionTypeStatusActive.IsValid())
+	assert.True(t, TransactionTypeStatusInactive.IsValid())
+	assert.False(t, TransactionTypeStatus("DELETED").IsValid())
+	assert.False(t, TransactionTypeStatus("").IsValid())
+}
+
+func TestNewTransactionTypeDefinition(t *testing.T) {
+	t.Parallel()
+
+	now := testutil.FixedTime()
+
+	tests := []struct {
+		name        string
+		code        string
+		description *string
+		subTypes    []string
+		wantErr     error
+		wantCode    TransactionType
+		wantSubs    []string
+		wantBuiltin bool
+	}{
+		{
+			name:     "custom type with normalized subtypes",
+			code:     " boleto ",
+			subTypes: []string{" Registered ", "UNREGISTERED"},
+			wantCode: "BOLETO",
+			wantSubs: []string{"registered", "unregistered"},
+		},
+		{
+			name:        "builtin code is flagged",
+			code:        "PIX",
+			wantCode:    TransactionTypePix,
+			wantSubs:    []string{},
+			wantBuiltin: true,
+		},
+		{
+			name:    "ill-formed code",
+			code:    "NOT-A-TYPE",
+			wantErr: constant.ErrTransactionTypeInvalidCode,
+		},
+		{
+			name:    "empty code",
+			code:    "   ",
+			wantErr: constant.ErrTransactionTypeInvalidCode,
+		},
+		{
+			name:     "duplicate subtypes after normalization",
+			code:     "ACH",
+			subTypes: []string{"same_day", "SAME_DAY"},
+			wantErr:  constant.ErrTransactionTypeInvalidSubTypes,
+		},
+		{
+			name:     "blank subtype",
+			code:     "ACH",
+			subTypes: []string{"  "},
+			wantErr:  constant.ErrTransactionTypeInvalidSubTypes,
+		},
+		{
+			name:     "oversized subtype",
+			code:     "ACH",
+			subTypes: []string{strings.Repeat("a", MaxSubTypeLength+1)},
+			wantErr:  constant.ErrTransactionTypeInvalidSubTypes,
+		},
+		{
+			name:        "description too long",
+			code:        "ACH",
+			description: testutil.StringPtr(strings.Repeat("d", MaxDescriptionLength+1)),
+			wantErr:     constant.ErrTransactionTypeDescriptionTooLong,
+		},
+		{
+			name:        "description with markup",
+			code:        "ACH",
+			description: testutil.StringPtr("<script>"),
+			wantErr:     constant.ErrTransactionTypeDescriptionTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			def, err := NewTransactionTypeDefinition(tt.code, tt.description, tt.subTypes, now)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, def)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCode, def.Code)
+			assert.Equal(t, tt.wantSubs, def.SubTypes)
+			assert.Equal(t, tt.wantBuiltin, def.Builtin)
+			assert.Equal(t, TransactionTypeStatusActive, def.Status)
+			assert.Equal(t, now.UTC(), def.CreatedAt)
+			assert.Equal(t, now.UTC(), def.UpdatedAt)
+		})
+	}
+}
+
+func TestNewTransactionTypeDefinition_TooManySubTypes(t *testing.T) {
+	t.Parallel()
+
+	subTypes := make([]string, MaxTransactionTypeSubTypes+1)
+	for i := range subTypes {
+		subTypes[i] = "sub_" + strings.Repeat("x", i%10) + string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+
+	_, err := NewTransactionTypeDefinition("ACH", nil, subTypes, testutil.FixedTime())
+	require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidSubTypes)
+}
+
+func TestTransactionTypeDefinition_Update(t *testing.T) {
+	t.Parallel()
+
+	created := testutil.FixedTime()
+	later := created.Add(time.Hour)
+
+	t.Run("applies all fields", func(t *testing.T) {
+		t.Parallel()
+
+		def, err := NewTransactionTypeDefinition("BOLETO", nil, nil, created)
+		require.NoError(t, err)
+
+		subTypes := []string{"Registered"}
+		status := TransactionTypeStatusInactive
+
+		require.NoError(t, def.Update(testutil.StringPtr("Bank slip"), &subTypes, &status, later))
+		assert.Equal(t, "Bank slip", *def.Description)
+		assert.Equal(t, []string{"registered"}, def.SubTypes)
+		assert.Equal(t, TransactionTypeStatusInactive, def.Status)
+		assert.Equal(t, later.UTC(), def.UpdatedAt)
+	})
+
+	t.Run("no-op keeps timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		def, err := NewTransactionTypeDefinition("BOLETO", nil, nil, created)
+		require.NoError(t, err)
+
+		status := TransactionTypeStatusActive
+
+		require.NoError(t, def.Update(nil, nil, &status, later))
+		assert.Equal(t, created.UTC(), def.UpdatedAt)
+	})
+
+	t.Run("invalid input leaves entry untouched", func(t *testing.T) {
+		t.Parallel()
+
+		def, err := NewTransactionTypeDefinition("BOLETO", testutil.StringPtr("original"), []string{"a"}, created)
+		require.NoError(t, err)
+
+		subTypes := []string{"b"}
+		status := TransactionTypeStatus("ARCHIVED")
+
+		err = def.Update(testutil.StringPtr("changed"), &subTypes, &status, later)
+		require.ErrorIs(t, err, constant.ErrTransactionTypeInvalidStatus)
+		assert.Equal(t, "original", *def.Description)
+		assert.Equal(t, []string{"a"}, def.SubTypes)
+		assert.Equal(t, created.UTC(), def.UpdatedAt)
+	})
+}
+
+func TestTransactionTypeDefinition_AllowsSubType(t *testing.T) {
+	t.Parallel()
+
+	open, err := NewTransactionTypeDefinition("CARD", nil, nil, testutil.FixedTime())
+	require.NoError(t, err)
+
+	restricted, err := NewTransactionTypeDefinition("BOLETO", nil, []string{"registered"}, testutil.FixedTime())
+	require.NoError(t, err)
+
+	assert.True(t, open.AllowsSubType(nil))
+	assert.True(t, open.AllowsSubType(testutil.StringPtr("anything")))
+	assert.True(t, restricted.AllowsSubType(nil))
+	assert.True(t, restricted.AllowsSubType(testutil.StringPtr(" REGISTERED ")))
+	assert.False(t, restricted.AllowsSubType(testutil.StringPtr("unregistered")))
+}
+
+func TestTransactionTypeDefinition_Clone(t *testing.T) {
+	t.Parallel()
+
+	original, err := NewTransactionTypeDefinition("BOLETO", testutil.StringPtr("desc"), []string{"registered"}, testutil.FixedTime())
+	require.NoError(t, err)
+
+	clone := original.Clone()
+	clone.SubTypes[0] = "mutated"
+	*clone.Description = "mutated"
+
+	assert.Equal(t, []string{"registered"}, original.SubTypes)
+	assert.Equal(t, "desc", *original.Description)
+
+	var nilDef *TransactionTypeDefinition
+	assert.Nil(t, nilDef.Clone())
+}
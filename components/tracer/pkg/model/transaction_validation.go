@@ -27,7 +27,7 @@ type TransactionValidation struct {
 
 	// Type of the transaction that was validated
 	// example: CARD
-	TransactionType TransactionType `json:"transactionType" swaggertype:"string" enums:"CARD,WIRE,PIX,CRYPTO" example:"CARD"`
+	TransactionType TransactionType `json:"transactionType" swaggertype:"string" example:"CARD"`
 
 	// SubType is stored in its lowercase canonical form; matching is case-insensitive.
 	// example: purchase
@@ -57,6 +57,15 @@ type TransactionValidation struct {
 	// Merchant context from the validation request (optional)
 	Merchant *MerchantContext `json:"merchant,omitempty"`
 
+	// Device context from the validation request (optional)
+	Device *DeviceContext `json:"device,omitempty"`
+
+	// Geolocation context from the validation request (optional)
+	Geolocation *GeolocationContext `json:"geolocation,omitempty"`
+
+	// Counterparty context from the validation request (optional)
+	Counterparty *CounterpartyContext `json:"counterparty,omitempty"`
+
 	// Additional metadata from the validation request
 	Metadata map[string]any `json:"metadata,omitempty"`
 
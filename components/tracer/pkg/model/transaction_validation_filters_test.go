@@ -163,7 +163,7 @@ func TestTransactionValidationFilters_Validate(t *testing.T) {
 		{
 			name: "error - invalid transactionType filter",
 			filters: &TransactionValidationFilters{
-				TransactionType: func() *TransactionType { t := TransactionType("INVALID"); return &t }(),
+				TransactionType: func() *TransactionType { t := TransactionType("not-a-type"); return &t }(),
 				Limit:           100,
 			},
 			expectErr: true,
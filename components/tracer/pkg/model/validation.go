@@ -6,6 +6,7 @@ package model
 
 import (
 	"maps"
+	"net/netip"
 	"regexp"
 	"strings"
 	"time"
@@ -37,6 +38,16 @@ var mccPattern = regexp.MustCompile(`^\d{4}$`)
 // countryCodePattern validates ISO 3166-1 alpha-2 codes (2 uppercase letters)
 var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
 
+// Length bounds for the device and counterparty contexts.
+const (
+	maxDeviceFingerprintLength = 255
+	maxDeviceUserAgentLength   = 512
+	maxCounterpartyIDLength    = 100
+	maxCounterpartyNameLength  = 255
+	maxLatitude                = 90
+	maxLongitude               = 180
+)
+
 // DefaultClockSkewTolerance is the default maximum allowed time difference between
 // the transaction timestamp and the server's current time to account for clock drift.
 const DefaultClockSkewTolerance = 1 * time.Minute
@@ -71,7 +82,7 @@ const (
 // SubType is normalized to lowercase canonical form; matching is case-insensitive.
 type ValidationRequest struct {
 	RequestID       uuid.UUID       `json:"requestId" validate:"required" swaggertype:"string" format:"uuid" example:"00000000-0000-0000-0000-000000000000"`
-	TransactionType TransactionType `json:"transactionType" validate:"required" swaggertype:"string" enums:"CARD,WIRE,PIX,CRYPTO" example:"CARD"`
+	TransactionType TransactionType `json:"transactionType" validate:"required" swaggertype:"string" example:"CARD"`
 	// SubType is normalized to lowercase canonical form; matching is case-insensitive.
 	SubType              *string           `json:"subType,omitempty" validate:"omitempty,max=50" maxLength:"50" extensions:"x-normalization=lowercase" example:"purchase"`
 	Amount               decimal.Decimal   `json:"amount" validate:"required" swaggertype:"string" example:"100.00"`
@@ -81,7 +92,12 @@ type ValidationRequest struct {
 	Segment              *SegmentContext   `json:"segment,omitempty"`
 	Portfolio            *PortfolioContext `json:"portfolio,omitempty"`
 	Merchant             *MerchantContext  `json:"merchant,omitempty"`
-	Metadata             map[string]any    `json:"metadata,omitempty"`
+	// Device, Geolocation and Counterparty are optional typed contexts for
+	// fraud rules (new devices, impossible travel, counterparty screening).
+	Device       *DeviceContext       `json:"device,omitempty"`
+	Geolocation  *GeolocationContext  `json:"geolocation,omitempty"`
+	Counterparty *CounterpartyContext `json:"counterparty,omitempty"`
+	Metadata     map[string]any       `json:"metadata,omitempty"`
 }
 
 // NewValidationRequest creates a new ValidationRequest with validation and normalization.
@@ -206,6 +222,9 @@ func (r *ValidationRequest) normalizeAndValidateWith(now time.Time, validate fun
 	temp.Segment = temp.Segment.Clone()
 	temp.Portfolio = temp.Portfolio.Clone()
 	temp.Merchant = temp.Merchant.Clone()
+	temp.Device = temp.Device.Clone()
+	temp.Geolocation = temp.Geolocation.Clone()
+	temp.Counterparty = temp.Counterparty.Clone()
 
 	// Validate on temp - if error, original r remains unchanged
 	if err := validate(&temp, now); err != nil {
@@ -218,6 +237,9 @@ func (r *ValidationRequest) normalizeAndValidateWith(now time.Time, validate fun
 	r.Segment = temp.Segment
 	r.Portfolio = temp.Portfolio
 	r.Merchant = temp.Merchant
+	r.Device = temp.Device
+	r.Geolocation = temp.Geolocation
+	r.Counterparty = temp.Counterparty
 
 	return nil
 }
@@ -354,6 +376,10 @@ func (r *ValidationRequest) Validate(now time.Time) error {
 		return err
 	}
 
+	if err := r.validateFraudContexts(); err != nil {
+		return err
+	}
+
 	return r.validateMetadata()
 }
 
@@ -394,6 +420,10 @@ func (r *ValidationRequest) ValidateForReserve(now time.Time) error {
 		return err
 	}
 
+	if err := r.validateFraudContexts(); err != nil {
+		return err
+	}
+
 	return r.validateMetadata()
 }
 
@@ -478,6 +508,69 @@ func (r *ValidationRequest) validateMerchant() error {
 	return nil
 }
 
+// validateFraudContexts validates the optional device, geolocation and
+// counterparty contexts. Country codes are strict ISO 3166-1 alpha-2, like
+// merchant.country.
+func (r *ValidationRequest) validateFraudContexts() error {
+	if d := r.Device; d != nil {
+		if d.Fingerprint == "" || len(d.Fingerprint) > maxDeviceFingerprintLength || len(d.UserAgent) > maxDeviceUserAgentLength {
+			return constant.ErrValidationInvalidDevice
+		}
+
+		if d.IPAddress != "" {
+			if _, err := netip.ParseAddr(d.IPAddress); err != nil {
+				return constant.ErrValidationInvalidDevice
+			}
+		}
+
+		if d.IPCountry != "" && !countryCodePattern.MatchString(d.IPCountry) {
+			return constant.ErrValidationInvalidDevice
+		}
+	}
+
+	if g := r.Geolocation; g != nil {
+		if g.Country != "" && !countryCodePattern.MatchString(g.Country) {
+			return constant.ErrValidationInvalidGeolocation
+		}
+
+		if (g.Latitude == nil) != (g.Longitude == nil) {
+			return constant.ErrValidationInvalidGeolocation
+		}
+
+		if g.Latitude != nil && !validCoordinates(*g.Latitude, *g.Longitude) {
+			return constant.ErrValidationInvalidGeolocation
+		}
+
+		if g.LastKnown != nil && !validCoordinates(g.LastKnown.Latitude, g.LastKnown.Longitude) {
+			return constant.ErrValidationInvalidGeolocation
+		}
+	}
+
+	if c := r.Counterparty; c != nil {
+		if c.AccountID == "" && c.Document == "" {
+			return constant.ErrValidationInvalidCounterparty
+		}
+
+		if len(c.AccountID) > maxCounterpartyIDLength || len(c.BankCode) > maxCounterpartyIDLength ||
+			len(c.Document) > maxCounterpartyIDLength || len(c.Name) > maxCounterpartyNameLength {
+			return constant.ErrValidationInvalidCounterparty
+		}
+
+		if c.Country != "" && !countryCodePattern.MatchString(c.Country) {
+			return constant.ErrValidationInvalidCounterparty
+		}
+	}
+
+	return nil
+}
+
+// validCoordinates reports whether latitude/longitude are in range (NaN fails
+// both comparisons).
+func validCoordinates(latitude, longitude float64) bool {
+	return latitude >= -maxLatitude && latitude <= maxLatitude &&
+		longitude >= -maxLongitude && longitude <= maxLongitude
+}
+
 func (r *ValidationRequest) validateMetadata() error {
 	if r.Metadata == nil {
 		return nil
@@ -514,6 +607,22 @@ func (r *ValidationRequest) transactionTypePtr() *TransactionType {
 	return &r.TransactionType
 }
 
+// fraudScopeFields returns the scope-matchable values of the geolocation and
+// counterparty contexts: the transaction country and the counterparty bank
+// code. Absent or empty values yield nil so scoped rules and limits skip the
+// transaction.
+func (r *ValidationRequest) fraudScopeFields() (country, counterpartyBankCode *string) {
+	if r.Geolocation != nil && r.Geolocation.Country != "" {
+		country = &r.Geolocation.Country
+	}
+
+	if r.Counterparty != nil && r.Counterparty.BankCode != "" {
+		counterpartyBankCode = &r.Counterparty.BankCode
+	}
+
+	return country, counterpartyBankCode
+}
+
 // ToCheckLimitsInput converts ValidationRequest to CheckLimitsInput for limit checking.
 // Used by Validation Orchestration to prepare input for Limit Checking.
 func (r *ValidationRequest) ToCheckLimitsInput() *CheckLimitsInput {
@@ -538,6 +647,8 @@ func (r *ValidationRequest) ToCheckLimitsInput() *CheckLimitsInput {
 		input.MerchantID = &r.Merchant.ID
 	}
 
+	input.Country, input.CounterpartyBankCode = r.fraudScopeFields()
+
 	return input
 }
 
@@ -545,7 +656,8 @@ func (r *ValidationRequest) ToCheckLimitsInput() *CheckLimitsInput {
 // This is used for scope matching in rule evaluation - rules with specific scopes
 // should only evaluate against transactions that have matching scopes.
 // A transaction has exactly one scope derived from its context
-// objects (Account, Segment, Portfolio, Merchant, TransactionType).
+// objects (Account, Segment, Portfolio, Merchant, TransactionType, Geolocation
+// country, Counterparty bank code).
 func (r *ValidationRequest) ToTransactionScope() *Scope {
 	scope := &Scope{
 		AccountID:       &r.Account.ID,
@@ -565,5 +677,7 @@ func (r *ValidationRequest) ToTransactionScope() *Scope {
 		scope.MerchantID = &r.Merchant.ID
 	}
 
+	scope.Country, scope.CounterpartyBankCode = r.fraudScopeFields()
+
 	return scope
 }
@@ -0,0 +1,311 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// OutcomeLabel is the real-world outcome attached to a transaction validation
+// after the fact (investigation result, chargeback, customer contact).
+type OutcomeLabel string
+
+const (
+	// OutcomeLabelConfirmedFraud marks a transaction confirmed as fraudulent by an investigation.
+	OutcomeLabelConfirmedFraud OutcomeLabel = "CONFIRMED_FRAUD"
+	// OutcomeLabelChargeback marks a transaction that received a fraud chargeback.
+	OutcomeLabelChargeback OutcomeLabel = "CHARGEBACK"
+	// OutcomeLabelFalsePositive marks a flagged transaction that turned out to be legitimate.
+	OutcomeLabelFalsePositive OutcomeLabel = "FALSE_POSITIVE"
+	// OutcomeLabelCustomerConfirmed marks a transaction the customer confirmed as their own.
+	OutcomeLabelCustomerConfirmed OutcomeLabel = "CUSTOMER_CONFIRMED"
+)
+
+// MaxOutcomeNoteLength caps the free-text note attached to an outcome label.
+const MaxOutcomeNoteLength = 1000
+
+// Outcome report window and dataset size bounds.
+const (
+	// MaxOutcomeReportRangeDays is the widest start/end window accepted by the
+	// rule-performance report and the dataset export.
+	MaxOutcomeReportRangeDays = 366
+	// DefaultOutcomeDatasetLimit is the number of rows exported when no limit is given.
+	DefaultOutcomeDatasetLimit = 1000
+	// MaxOutcomeDatasetLimit caps a single dataset export. Wider exports are
+	// split by date window.
+	MaxOutcomeDatasetLimit = 10000
+)
+
+// IsValid checks if the OutcomeLabel is a valid enum value.
+func (l OutcomeLabel) IsValid() bool {
+	switch l {
+	case OutcomeLabelConfirmedFraud, OutcomeLabelChargeback, OutcomeLabelFalsePositive, OutcomeLabelCustomerConfirmed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFraud reports whether the label counts as a fraud outcome (the positive
+// class) when computing rule precision and recall.
+func (l OutcomeLabel) IsFraud() bool {
+	return l == OutcomeLabelConfirmedFraud || l == OutcomeLabelChargeback
+}
+
+// String returns the string representation of the label.
+func (l OutcomeLabel) String() string {
+	return string(l)
+}
+
+// FraudOutcomeLabels returns the labels counted as fraud, in a stable order.
+func FraudOutcomeLabels() []OutcomeLabel {
+	return []OutcomeLabel{OutcomeLabelConfirmedFraud, OutcomeLabelChargeback}
+}
+
+// ValidationOutcome is the outcome label recorded for a transaction validation.
+//
+// TransactionValidation rows are immutable compliance records, so the label
+// lives beside them, keyed by the validation ID. A validation carries at most
+// one label; relabeling (e.g. CUSTOMER_CONFIRMED later disputed as CHARGEBACK)
+// replaces it and bumps UpdatedAt.
+type ValidationOutcome struct {
+	// ID of the labeled transaction validation
+	// format: uuid
+	ValidationID uuid.UUID `json:"validationId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Outcome label
+	// enums: CONFIRMED_FRAUD,CHARGEBACK,FALSE_POSITIVE,CUSTOMER_CONFIRMED
+	Label OutcomeLabel `json:"label" swaggertype:"string" enums:"CONFIRMED_FRAUD,CHARGEBACK,FALSE_POSITIVE,CUSTOMER_CONFIRMED" example:"CHARGEBACK"`
+
+	// Optional free-text note (case reference, analyst comment)
+	// example: Chargeback reason code 10.4
+	// maxLength: 1000
+	Note *string `json:"note,omitempty" example:"Chargeback reason code 10.4" maxLength:"1000"`
+
+	// Timestamp when the validation was first labeled
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+
+	// Timestamp when the label was last changed
+	// format: date-time
+	UpdatedAt time.Time `json:"updatedAt" format:"date-time" example:"2021-01-01T00:00:00Z"`
+}
+
+// NewValidationOutcome creates a new outcome label with validation.
+// The label is trimmed and uppercased; an empty note is stored as nil.
+func NewValidationOutcome(validationID uuid.UUID, label string, note *string, now time.Time) (*ValidationOutcome, error) {
+	outcomeLabel := OutcomeLabel(strings.ToUpper(strings.TrimSpace(label)))
+	if !outcomeLabel.IsValid() {
+		return nil, constant.ErrInvalidOutcomeLabel
+	}
+
+	normalizedNote, err := normalizeOutcomeNote(note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidationOutcome{
+		ValidationID: validationID,
+		Label:        outcomeLabel,
+		Note:         normalizedNote,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func normalizeOutcomeNote(note *string) (*string, error) {
+	if note == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*note)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	if utf8.RuneCountInString(trimmed) > MaxOutcomeNoteLength {
+		return nil, constant.ErrOutcomeNoteTooLong
+	}
+
+	return &trimmed, nil
+}
+
+// OutcomeReportFilter scopes the rule-performance report and the dataset
+// export to labeled validations created inside [StartDate, EndDate).
+type OutcomeReportFilter struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// RuleID restricts the report (or dataset) to validations that evaluated this rule.
+	RuleID *uuid.UUID
+	// Label restricts the dataset to one outcome label. Ignored by the report,
+	// which needs every label to compute its ratios.
+	Label *OutcomeLabel
+	// Limit bounds the dataset export. Ignored by the report.
+	Limit int
+}
+
+// SetDefaults fills the window with the last DefaultTransactionValidationDateRangeDays
+// days when neither bound is given, and the dataset limit when unset.
+func (f *OutcomeReportFilter) SetDefaults() {
+	if f.StartDate.IsZero() && f.EndDate.IsZero() {
+		now := nowFunc().UTC().Truncate(24 * time.Hour)
+		f.EndDate = now.Add(24 * time.Hour)
+		f.StartDate = now.Add(-DefaultTransactionValidationDateRangeDays * 24 * time.Hour)
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultOutcomeDatasetLimit
+	}
+}
+
+// Validate checks the window and the dataset bounds. Call after SetDefaults.
+func (f *OutcomeReportFilter) Validate() error {
+	if f.StartDate.IsZero() || f.EndDate.IsZero() {
+		return fmt.Errorf("%w: start_date and end_date must be provided together", constant.ErrInvalidOutcomeReportFilters)
+	}
+
+	if !f.EndDate.After(f.StartDate) {
+		return fmt.Errorf("%w: end_date must be after start_date", constant.ErrInvalidOutcomeReportFilters)
+	}
+
+	if f.EndDate.Sub(f.StartDate) > MaxOutcomeReportRangeDays*24*time.Hour {
+		return fmt.Errorf("%w: window cannot exceed %d days", constant.ErrInvalidOutcomeReportFilters, MaxOutcomeReportRangeDays)
+	}
+
+	if f.Label != nil && !f.Label.IsValid() {
+		return fmt.Errorf("%w: invalid label", constant.ErrInvalidOutcomeReportFilters)
+	}
+
+	if f.Limit < 1 || f.Limit > MaxOutcomeDatasetLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", constant.ErrInvalidOutcomeReportFilters, MaxOutcomeDatasetLimit)
+	}
+
+	return nil
+}
+
+// RuleOutcomeCounts is the confusion matrix of one rule over the labeled
+// validations that evaluated it. A match counts as the rule flagging the
+// transaction; fraud labels are the positive class.
+type RuleOutcomeCounts struct {
+	RuleID uuid.UUID
+	// RuleName and RuleAction are empty when the rule row no longer exists.
+	RuleName       string
+	RuleAction     Decision
+	TruePositives  int64
+	FalsePositives int64
+	FalseNegatives int64
+	TrueNegatives  int64
+}
+
+// RulePerformance is one row of the rule-performance report.
+type RulePerformance struct {
+	// Rule ID
+	// format: uuid
+	RuleID uuid.UUID `json:"ruleId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Rule name (empty if the rule no longer exists)
+	// example: Block high-value card transactions
+	RuleName string `json:"ruleName" example:"Block high-value card transactions"`
+
+	// Rule action. Precision of an ALLOW rule measures how often it let fraud through.
+	// enums: ALLOW,DENY,REVIEW
+	RuleAction Decision `json:"ruleAction,omitempty" swaggertype:"string" enums:"ALLOW,DENY,REVIEW" example:"DENY"`
+
+	// Labeled validations that evaluated the rule
+	// example: 120
+	Labeled int64 `json:"labeled" example:"120"`
+
+	// Rule matched and the outcome was fraud
+	// example: 40
+	TruePositives int64 `json:"truePositives" example:"40"`
+
+	// Rule matched and the outcome was legitimate
+	// example: 10
+	FalsePositives int64 `json:"falsePositives" example:"10"`
+
+	// Rule did not match and the outcome was fraud
+	// example: 5
+	FalseNegatives int64 `json:"falseNegatives" example:"5"`
+
+	// Rule did not match and the outcome was legitimate
+	// example: 65
+	TrueNegatives int64 `json:"trueNegatives" example:"65"`
+
+	// TP / (TP + FP); null when the rule never matched a labeled validation
+	// example: 0.8
+	Precision *float64 `json:"precision" example:"0.8"`
+
+	// TP / (TP + FN); null when no labeled validation it evaluated was fraud
+	// example: 0.888
+	Recall *float64 `json:"recall" example:"0.888"`
+}
+
+// NewRulePerformance derives precision and recall from a rule's counts.
+// Ratios with an empty denominator are left nil rather than reported as 0,
+// so "no data" is distinguishable from "always wrong".
+func NewRulePerformance(c RuleOutcomeCounts) RulePerformance {
+	return RulePerformance{
+		RuleID:         c.RuleID,
+		RuleName:       c.RuleName,
+		RuleAction:     c.RuleAction,
+		Labeled:        c.TruePositives + c.FalsePositives + c.FalseNegatives + c.TrueNegatives,
+		TruePositives:  c.TruePositives,
+		FalsePositives: c.FalsePositives,
+		FalseNegatives: c.FalseNegatives,
+		TrueNegatives:  c.TrueNegatives,
+		Precision:      ratio(c.TruePositives, c.TruePositives+c.FalsePositives),
+		Recall:         ratio(c.TruePositives, c.TruePositives+c.FalseNegatives),
+	}
+}
+
+func ratio(num, den int64) *float64 {
+	if den == 0 {
+		return nil
+	}
+
+	v := float64(num) / float64(den)
+
+	return &v
+}
+
+// RulePerformanceReport is the response of the rule-performance report.
+type RulePerformanceReport struct {
+	// Inclusive start of the validation window
+	// format: date-time
+	StartDate time.Time `json:"startDate" format:"date-time" example:"2021-01-01T00:00:00Z"`
+
+	// Exclusive end of the validation window
+	// format: date-time
+	EndDate time.Time `json:"endDate" format:"date-time" example:"2021-04-01T00:00:00Z"`
+
+	// Per-rule metrics, ordered by rule ID
+	Rules []RulePerformance `json:"rules"`
+}
+
+// OutcomeDatasetRow is one labeled validation in the exportable dataset: the
+// transaction features the rules saw, the decision taken and the outcome label.
+type OutcomeDatasetRow struct {
+	ValidationID         uuid.UUID       `json:"validationId" swaggertype:"string" format:"uuid"`
+	RequestID            uuid.UUID       `json:"requestId" swaggertype:"string" format:"uuid"`
+	TransactionType      TransactionType `json:"transactionType" swaggertype:"string" example:"CARD"`
+	SubType              *string         `json:"subType,omitempty" example:"debit"`
+	Amount               decimal.Decimal `json:"amount" swaggertype:"string" example:"150.00"`
+	Currency             string          `json:"currency" example:"BRL"`
+	TransactionTimestamp time.Time       `json:"transactionTimestamp" format:"date-time"`
+	AccountID            uuid.UUID       `json:"accountId" swaggertype:"string" format:"uuid"`
+	Decision             Decision        `json:"decision" swaggertype:"string" enums:"ALLOW,DENY,REVIEW" example:"DENY"`
+	MatchedRuleIDs       []uuid.UUID     `json:"matchedRuleIds" swaggertype:"array,string" format:"uuid"`
+	Label                OutcomeLabel    `json:"label" swaggertype:"string" enums:"CONFIRMED_FRAUD,CHARGEBACK,FALSE_POSITIVE,CUSTOMER_CONFIRMED" example:"CHARGEBACK"`
+	LabeledAt            time.Time       `json:"labeledAt" format:"date-time"`
+	CreatedAt            time.Time       `json:"createdAt" format:"date-time"`
+}
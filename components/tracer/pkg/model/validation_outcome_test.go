@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/internal/testutil"
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+func TestOutcomeLabel_IsFraud(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, OutcomeLabelConfirmedFraud.IsFraud())
+	assert.True(t, OutcomeLabelChargeback.IsFraud())
+	assert.False(t, OutcomeLabelFalsePositive.IsFraud())
+	assert.False(t, OutcomeLabelCustomerConfirmed.IsFraud())
+	assert.False(t, OutcomeLabel("UNKNOWN").IsValid())
+}
+
+func TestNewValidationOutcome(t *testing.T) {
+	t.Parallel()
+
+	now := testutil.FixedTime()
+	id := testutil.MustDeterministicUUID(1)
+
+	outcome, err := NewValidationOutcome(id, " chargeback ", testutil.StringPtr("  reason 10.4 "), now)
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeLabelChargeback, outcome.Label)
+	assert.Equal(t, "reason 10.4", *outcome.Note)
+	assert.Equal(t, now, outcome.CreatedAt)
+	assert.Equal(t, now, outcome.UpdatedAt)
+
+	outcome, err = NewValidationOutcome(id, "FALSE_POSITIVE", testutil.StringPtr("   "), now)
+	require.NoError(t, err)
+	assert.Nil(t, outcome.Note)
+
+	_, err = NewValidationOutcome(id, "MAYBE", nil, now)
+	require.ErrorIs(t, err, constant.ErrInvalidOutcomeLabel)
+
+	_, err = NewValidationOutcome(id, "CHARGEBACK", testutil.StringPtr(strings.Repeat("x", MaxOutcomeNoteLength+1)), now)
+	require.ErrorIs(t, err, constant.ErrOutcomeNoteTooLong)
+}
+
+func TestOutcomeReportFilter_DefaultsAndValidate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  OutcomeReportFilter
+		wantErr bool
+	}{
+		{name: "explicit window", filter: OutcomeReportFilter{StartDate: start, EndDate: start.Add(24 * time.Hour)}},
+		{name: "only start", filter: OutcomeReportFilter{StartDate: start}, wantErr: true},
+		{name: "end before start", filter: OutcomeReportFilter{StartDate: start, EndDate: start.Add(-time.Hour)}, wantErr: true},
+		{name: "window too wide", filter: OutcomeReportFilter{StartDate: start, EndDate: start.Add((MaxOutcomeReportRangeDays + 1) * 24 * time.Hour)}, wantErr: true},
+		{name: "limit too large", filter: OutcomeReportFilter{StartDate: start, EndDate: start.Add(time.Hour), Limit: MaxOutcomeDatasetLimit + 1}, wantErr: true},
+		{name: "negative limit", filter: OutcomeReportFilter{StartDate: start, EndDate: start.Add(time.Hour), Limit: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := tt.filter
+			f.SetDefaults()
+
+			err := f.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, constant.ErrInvalidOutcomeReportFilters)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, DefaultOutcomeDatasetLimit, f.Limit)
+		})
+	}
+}
+
+func TestOutcomeReportFilter_DefaultWindow(t *testing.T) {
+	t.Parallel()
+
+	var f OutcomeReportFilter
+
+	f.SetDefaults()
+	require.NoError(t, f.Validate())
+	assert.Equal(t, DefaultTransactionValidationDateRangeDays*24*time.Hour+24*time.Hour, f.EndDate.Sub(f.StartDate))
+}
+
+func TestNewRulePerformance(t *testing.T) {
+	t.Parallel()
+
+	id := testutil.MustDeterministicUUID(1)
+
+	perf := NewRulePerformance(RuleOutcomeCounts{RuleID: id, TruePositives: 8, FalsePositives: 2, FalseNegatives: 8, TrueNegatives: 82})
+	assert.Equal(t, int64(100), perf.Labeled)
+	require.NotNil(t, perf.Precision)
+	require.NotNil(t, perf.Recall)
+	assert.InDelta(t, 0.8, *perf.Precision, 1e-9)
+	assert.InDelta(t, 0.5, *perf.Recall, 1e-9)
+
+	// A rule that never matched and saw no fraud has neither ratio.
+	perf = NewRulePerformance(RuleOutcomeCounts{RuleID: id, TrueNegatives: 5})
+	assert.Nil(t, perf.Precision)
+	assert.Nil(t, perf.Recall)
+}
@@ -55,7 +55,7 @@ func TestValidationRequest_Validate(t *testing.T) {
 		{
 			name: "invalid transactionType fails",
 			modify: func(r *ValidationRequest) {
-				r.TransactionType = TransactionType("INVALID")
+				r.TransactionType = TransactionType("not-a-type")
 			},
 			expectedErr: constant.ErrValidationInvalidTransactionType,
 		},
@@ -236,9 +236,9 @@ func TestValidationRequest_ValidateForReserve(t *testing.T) {
 			expectedErr: nil,
 		},
 		{
-			name: "INVALID (non-empty, non-enum) transactionType still fails",
+			name: "ill-formed (non-empty) transactionType still fails",
 			modify: func(r *ValidationRequest) {
-				r.TransactionType = TransactionType("PIXIE")
+				r.TransactionType = TransactionType("pix-e")
 			},
 			expectedErr: constant.ErrValidationInvalidTransactionType,
 		},
@@ -457,6 +457,118 @@ func TestValidationRequest_Validate_MerchantID(t *testing.T) {
 	}
 }
 
+func TestValidationRequest_Validate_FraudContexts(t *testing.T) {
+	fixedTimestamp := testutil.FixedTime()
+	lat, lon := -23.5505, -46.6333
+	badLat := 91.0
+
+	baseRequest := func() ValidationRequest {
+		return ValidationRequest{
+			RequestID:            uuid.MustParse("550e8400-e29b-41d4-a716-446655440003"),
+			TransactionType:      TransactionTypePix,
+			Amount:               decimal.RequireFromString("10"),
+			Currency:             "BRL",
+			TransactionTimestamp: fixedTimestamp,
+			Account:              AccountContext{ID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*ValidationRequest)
+		wantErr error
+	}{
+		{
+			name: "valid device, geolocation and counterparty",
+			modify: func(r *ValidationRequest) {
+				r.Device = &DeviceContext{Fingerprint: "fp-1", IPAddress: "2001:db8::1", IPCountry: "BR"}
+				r.Geolocation = &GeolocationContext{
+					Country: "BR", Latitude: &lat, Longitude: &lon,
+					LastKnown: &GeoPoint{Latitude: 38.7, Longitude: -9.1},
+				}
+				r.Counterparty = &CounterpartyContext{Document: "12345678000190", BankCode: "341"}
+			},
+		},
+		{
+			name:    "device without fingerprint",
+			modify:  func(r *ValidationRequest) { r.Device = &DeviceContext{IPAddress: "203.0.113.7"} },
+			wantErr: constant.ErrValidationInvalidDevice,
+		},
+		{
+			name:    "device with malformed IP",
+			modify:  func(r *ValidationRequest) { r.Device = &DeviceContext{Fingerprint: "fp-1", IPAddress: "999.1.1.1"} },
+			wantErr: constant.ErrValidationInvalidDevice,
+		},
+		{
+			name:    "device with invalid IP country",
+			modify:  func(r *ValidationRequest) { r.Device = &DeviceContext{Fingerprint: "fp-1", IPCountry: "BRA"} },
+			wantErr: constant.ErrValidationInvalidDevice,
+		},
+		{
+			name:    "geolocation with latitude only",
+			modify:  func(r *ValidationRequest) { r.Geolocation = &GeolocationContext{Latitude: &lat} },
+			wantErr: constant.ErrValidationInvalidGeolocation,
+		},
+		{
+			name:    "geolocation with out-of-range latitude",
+			modify:  func(r *ValidationRequest) { r.Geolocation = &GeolocationContext{Latitude: &badLat, Longitude: &lon} },
+			wantErr: constant.ErrValidationInvalidGeolocation,
+		},
+		{
+			name: "geolocation with out-of-range last known point",
+			modify: func(r *ValidationRequest) {
+				r.Geolocation = &GeolocationContext{LastKnown: &GeoPoint{Latitude: 0, Longitude: 181}}
+			},
+			wantErr: constant.ErrValidationInvalidGeolocation,
+		},
+		{
+			name:    "counterparty without account or document",
+			modify:  func(r *ValidationRequest) { r.Counterparty = &CounterpartyContext{BankCode: "341"} },
+			wantErr: constant.ErrValidationInvalidCounterparty,
+		},
+		{
+			name:    "counterparty with invalid country",
+			modify:  func(r *ValidationRequest) { r.Counterparty = &CounterpartyContext{AccountID: "1", Country: "br"} },
+			wantErr: constant.ErrValidationInvalidCounterparty,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := baseRequest()
+			tc.modify(&req)
+
+			err := req.Validate(fixedTimestamp)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidationRequest_ToTransactionScope_FraudFields(t *testing.T) {
+	req := &ValidationRequest{
+		TransactionType: TransactionTypePix,
+		Account:         AccountContext{ID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")},
+		Geolocation:     &GeolocationContext{Country: "BR"},
+		Counterparty:    &CounterpartyContext{AccountID: "1", BankCode: "341"},
+	}
+
+	scope := req.ToTransactionScope()
+	require.NotNil(t, scope.Country)
+	require.NotNil(t, scope.CounterpartyBankCode)
+	assert.Equal(t, "BR", *scope.Country)
+	assert.Equal(t, "341", *scope.CounterpartyBankCode)
+
+	req.Geolocation, req.Counterparty = nil, nil
+	scope = req.ToTransactionScope()
+	assert.Nil(t, scope.Country)
+	assert.Nil(t, scope.CounterpartyBankCode)
+}
+
 func TestNormalizeAndValidate_NestedMetadataDefensiveCopy(t *testing.T) {
 	t.Run("nested context metadata are defensively copied", func(t *testing.T) {
 		// Create original metadata maps for nested contexts
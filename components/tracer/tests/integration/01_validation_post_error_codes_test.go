@@ -299,7 +299,7 @@ func TestValidation_MissingRequestID_ReturnsError(t *testing.T) {
 }
 
 // TestValidation_InvalidTransactionType_ReturnsError verifies invalid transactionType returns an error.
-// 0414: transactionType must be one of [CARD, WIRE, PIX, CRYPTO]
+// 0414: transactionType must be a well-formed transaction type code
 func TestValidation_InvalidTransactionType_ReturnsError(t *testing.T) {
 	baseURL := testutil.GetBaseURL()
 	apiKey := testutil.GetAPIKey()
@@ -311,8 +311,8 @@ func TestValidation_InvalidTransactionType_ReturnsError(t *testing.T) {
 	}{
 		{
 			name:            "invalid_value",
-			transactionType: "INVALID",
-			description:     "Unknown transaction type value",
+			transactionType: "NOT-A-TYPE",
+			description:     "Ill-formed transaction type value",
 		},
 		{
 			name:            "lowercase_card",
@@ -324,11 +324,6 @@ func TestValidation_InvalidTransactionType_ReturnsError(t *testing.T) {
 			transactionType: "",
 			description:     "Empty transaction type",
 		},
-		{
-			name:            "cash_type",
-			transactionType: "CASH",
-			description:     "CASH is not a valid transaction type",
-		},
 	}
 
 	for i, tc := range testCases {
@@ -363,7 +358,7 @@ func TestValidation_InvalidTransactionType_ReturnsError(t *testing.T) {
 
 			errResp := testutil.ParseErrorResponse(t, respBody)
 
-			// 0414 (ErrValidationInvalidTransactionType): transactionType must be one of [CARD, WIRE, PIX, CRYPTO]
+			// 0414 (ErrValidationInvalidTransactionType): transactionType is not a well-formed code
 			assert.Equal(t, "0414", errResp.Code, "Test case: %s - Expected 0414 for invalid transactionType", tc.description)
 			assert.Equal(t, "Validation Invalid Transaction Type", errResp.Title)
 			assert.Equal(t, "Invalid transactionType.", errResp.Detail)
@@ -371,6 +366,46 @@ func TestValidation_InvalidTransactionType_ReturnsError(t *testing.T) {
 	}
 }
 
+// TestValidation_UnregisteredTransactionType_ReturnsError verifies that a
+// well-formed code absent from the tenant's transaction-type catalog is rejected.
+// 0501: transactionType is not registered or is inactive
+func TestValidation_UnregisteredTransactionType_ReturnsError(t *testing.T) {
+	baseURL := testutil.GetBaseURL()
+	apiKey := testutil.GetAPIKey()
+
+	payload := map[string]any{
+		"requestId":            testutil.MustDeterministicUUID(2101).String(),
+		"transactionType":      "CASH",
+		"amount":               100,
+		"currency":             "BRL",
+		"transactionTimestamp": testutil.FixedTime().Add(-1 * time.Minute).Format(time.RFC3339),
+		"account": map[string]any{
+			"accountId": testutil.MustDeterministicUUID(2102).String(),
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/v1/validations", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-API-Key", apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := testutil.HTTPClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "Response: %s", string(respBody))
+
+	errResp := testutil.ParseErrorResponse(t, respBody)
+	assert.Equal(t, "0501", errResp.Code)
+	assert.Equal(t, "Transaction Type Not Registered", errResp.Title)
+}
+
 // TestValidation_AmountNonPositive_ReturnsError verifies that zero or negative amount returns an error.
 // 0415: amount must be positive
 func TestValidation_AmountNonPositive_ReturnsError(t *testing.T) {
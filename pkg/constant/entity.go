@@ -10,13 +10,20 @@ const (
 	EntityAccount               = "Account"
 	EntityAccountRule           = "AccountRule"
 	EntityAccountType           = "AccountType"
+	EntityAlertPolicy           = "AlertPolicy"
 	EntityAsset                 = "Asset"
 	EntityAssetRate             = "AssetRate"
 	EntityAuditEvent            = "AuditEvent"
 	EntityBalance               = "Balance"
 	EntityBillingPackage        = "BillingPackage"
+	EntityConfigBundle          = "ConfigBundle"
 	EntityFeeCalculation        = "FeeCalculation"
+	EntityFeeQuote              = "FeeQuote"
+	EntityFeeRevenue            = "FeeRevenue"
 	EntityHolder                = "Holder"
+	EntityHolderExport          = "HolderExport"
+	EntityHolderMerge           = "HolderMerge"
+	EntityHolderRelationship    = "HolderRelationship"
 	EntityInstrument            = "Instrument"
 	EntityLedger                = "Ledger"
 	EntityLimit                 = "Limit"
@@ -30,10 +37,14 @@ const (
 	EntityRelatedParty          = "RelatedParty"
 	EntityReservation           = "Reservation"
 	EntityRule                  = "Rule"
+	EntityScreeningAlert        = "ScreeningAlert"
 	EntitySegment               = "Segment"
 	EntityTransaction           = "Transaction"
 	EntityTransactionRoute      = "TransactionRoute"
+	EntityTransactionType       = "TransactionType"
 	EntityTransactionValidation = "TransactionValidation"
 	EntityUsageCounter          = "UsageCounter"
+	EntityValidationOutcome     = "ValidationOutcome"
 	EntityValidationRequest     = "ValidationRequest"
+	EntityWatchList             = "WatchList"
 )
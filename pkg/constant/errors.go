@@ -473,6 +473,65 @@ var (
 	ErrReadyzRedisPingFailed                  = errors.New("0494")
 	ErrReadyzTenantManagerUnavailable         = errors.New("0495")
 	ErrReadyzStreamingUnhealthy               = errors.New("0496")
+	ErrTransactionTypeNotFound                = errors.New("0498")
+	ErrTransactionTypeAlreadyExists           = errors.New("0499")
+	ErrTransactionTypeInvalidCode             = errors.New("0500")
+	ErrTransactionTypeNotRegistered           = errors.New("0501")
+	ErrTransactionTypeSubTypeNotAllowed       = errors.New("0502")
+	ErrTransactionTypeInvalidSubTypes         = errors.New("0503")
+	ErrTransactionTypeInvalidStatus           = errors.New("0504")
+	ErrTransactionTypeDescriptionTooLong      = errors.New("0505")
+	ErrValidationOutcomeNotFound              = errors.New("0506")
+	ErrInvalidOutcomeLabel                    = errors.New("0507")
+	ErrOutcomeNoteTooLong                     = errors.New("0508")
+	ErrInvalidOutcomeReportFilters            = errors.New("0509")
+	ErrInvalidConfigBundle                    = errors.New("0510")
+	ErrConfigBundleImmutableChange            = errors.New("0511")
+	ErrConfigBundlePlanOutdated               = errors.New("0512")
+	ErrValidationInvalidDevice                = errors.New("0513")
+	ErrValidationInvalidGeolocation           = errors.New("0514")
+	ErrValidationInvalidCounterparty          = errors.New("0515")
+	ErrAlertPolicyNotFound                    = errors.New("0516")
+	ErrAlertPolicyInvalidName                 = errors.New("0517")
+	ErrAlertPolicyInvalidDecision             = errors.New("0518")
+	ErrAlertPolicyInvalidThreshold            = errors.New("0519")
+	ErrAlertPolicyInvalidWindow               = errors.New("0520")
+	ErrAlertPolicyInvalidMinSamples           = errors.New("0521")
+	ErrAlertPolicyAutoDeactivateRequiresRule  = errors.New("0522")
+	ErrAlertPolicyInvalidScope                = errors.New("0523")
+	ErrAlertPolicyInvalidStatus               = errors.New("0524")
+	ErrAlertPolicyDescriptionTooLong          = errors.New("0525")
+	ErrBillingRunNotFound                     = errors.New("0526")
+	ErrPackageVersionNotInFuture              = errors.New("0527")
+	ErrPackageVersionNotFound                 = errors.New("0528")
+	ErrPackageVersionConflict                 = errors.New("0529")
+	ErrProgressiveBandsInvalid                = errors.New("0530")
+	ErrCalculationUpToNotAllowed              = errors.New("0531")
+	ErrMinFeeGreaterThanMaxFee                = errors.New("0532")
+	ErrBaseFeeInvalid                         = errors.New("0533")
+	ErrFeeDependencyCycle                     = errors.New("0534")
+	ErrFeeDependencyOrder                     = errors.New("0535")
+	ErrChainedFeeDestinationConflict          = errors.New("0536")
+	ErrReversalPolicyInvalid                  = errors.New("0537")
+	ErrReversalPolicyUnknownFee               = errors.New("0538")
+	ErrReversalFeeExceedsRefund               = errors.New("0539")
+	ErrPromotionTargetInvalid                 = errors.New("0540")
+	ErrPromotionLimitInvalid                  = errors.New("0541")
+	ErrPromotionWindowInvalid                 = errors.New("0542")
+	ErrPromotionNotFound                      = errors.New("0543")
+	ErrFeeQuoteNotFound                       = errors.New("0544")
+	ErrFeeQuoteExpired                        = errors.New("0545")
+	ErrFeeQuoteMismatch                       = errors.New("0546")
+	ErrFeeQuoteAlreadyUsed                    = errors.New("0547")
+	ErrFeeRevenueRangeInvalid                 = errors.New("0548")
+	ErrFeeAssetInvalid                        = errors.New("0549")
+	ErrFeeAssetValuesInvalid                  = errors.New("0550")
+	ErrFeeAssetOutsidePackage                 = errors.New("0551")
+	ErrBillingInvoiceNotFound                 = errors.New("0553")
+	ErrBillingInvoiceTransitionInvalid        = errors.New("0554")
+	ErrHolderNotVerified                      = errors.New("0555")
+	ErrConfigBundleInvalidExpression          = errors.New("0556")
+	ErrBillingInvoiceRevisionConflict         = errors.New("0558")
 )
 
 // List of CRM domain errors.
@@ -513,4 +572,69 @@ var (
 	ErrAuditEventRequired           = errors.New("CRM-0039")
 	ErrAuditWriteFailed             = errors.New("CRM-0040")
 	ErrReservedTenantID             = errors.New("CRM-0041")
+	ErrKeyRotationInProgress        = errors.New("CRM-0042")
+	ErrKeyRotationNotFound          = errors.New("CRM-0043")
+	ErrKeyRotationFailed            = errors.New("CRM-0044")
+	ErrHolderDataKeyNotFound        = errors.New("CRM-0045")
+	ErrHolderDataKeyAlreadyExists   = errors.New("CRM-0046")
+	ErrHolderErased                 = errors.New("CRM-0047")
+	ErrHolderErasureFailed          = errors.New("CRM-0048")
+)
+
+// KYC verification errors (CRM domain, string-namespaced family).
+var (
+	ErrKYCTransitionInvalid     = errors.New("CRM-0049")
+	ErrKYCDocumentsMissing      = errors.New("CRM-0050")
+	ErrKYCRevisionConflict      = errors.New("CRM-0051")
+	ErrKYCSelfReview            = errors.New("CRM-0052")
+	ErrKYCHolderTypeUnsupported = errors.New("CRM-0053")
+	ErrKYCExpiryNotInFuture     = errors.New("CRM-0054")
+)
+
+// Sanctions and watch-list screening errors (CRM domain, string-namespaced family).
+var (
+	ErrWatchListFormatInvalid        = errors.New("CRM-0055")
+	ErrWatchListParseFailed          = errors.New("CRM-0056")
+	ErrWatchListEmpty                = errors.New("CRM-0057")
+	ErrWatchListNameInvalid          = errors.New("CRM-0058")
+	ErrWatchListNotFound             = errors.New("CRM-0059")
+	ErrScreeningAlertNotFound        = errors.New("CRM-0060")
+	ErrScreeningAlertAlreadyReviewed = errors.New("CRM-0061")
+	ErrWatchListUploadConflict       = errors.New("CRM-0062")
+)
+
+// Beneficial-ownership errors (CRM domain, string-namespaced family).
+var (
+	ErrHolderRelationshipNotFound       = errors.New("CRM-0063")
+	ErrHolderRelationshipSelf           = errors.New("CRM-0064")
+	ErrHolderRelationshipNotLegalPerson = errors.New("CRM-0065")
+	ErrHolderRelationshipPercentage     = errors.New("CRM-0066")
+	ErrHolderRelationshipPeriodInvalid  = errors.New("CRM-0067")
+	ErrHolderRelationshipCycle          = errors.New("CRM-0068")
+	ErrHolderOwnershipExceeded          = errors.New("CRM-0069")
+	ErrHolderRelationshipAlreadyExists  = errors.New("CRM-0070")
+)
+
+// Holder data export errors (CRM domain, string-namespaced family).
+var (
+	ErrHolderExportNotFound   = errors.New("CRM-0071")
+	ErrHolderExportInProgress = errors.New("CRM-0072")
+	ErrHolderExportNotReady   = errors.New("CRM-0073")
+	ErrHolderExportExpired    = errors.New("CRM-0074")
+)
+
+// Holder duplicate merge errors (CRM domain, string-namespaced family).
+var (
+	ErrHolderMerged                = errors.New("CRM-0075")
+	ErrHolderMergeSelf             = errors.New("CRM-0076")
+	ErrHolderMergeTypeMismatch     = errors.New("CRM-0077")
+	ErrHolderMergeConflict         = errors.New("CRM-0078")
+	ErrHolderMergeHasRelationships = errors.New("CRM-0079")
+)
+
+// Instrument lifecycle errors (CRM domain, string-namespaced family).
+var (
+	ErrInstrumentTransitionInvalid     = errors.New("CRM-0080")
+	ErrInstrumentLifecycleConflict     = errors.New("CRM-0081")
+	ErrInstrumentAccountBalanceNotZero = errors.New("CRM-0082")
 )
@@ -23,6 +23,8 @@ var UUIDPathParameters = []string{
 	"holder_id",
 	"instrument_id",
 	"related_party_id",
+	"relationship_id",
+	"export_id",
 }
 
 const (
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Package kms defines the vendor-neutral key-encryption (KEK) contract that the
+// envelope encryption mode wraps Tink keysets with. Each subpackage implements
+// it for one KMS_VENDOR:
+//
+//   - vault:  HashiCorp Vault Transit (KMS_VENDOR=hashicorp-vault)
+//   - pkcs11: any PKCS#11 HSM, e.g. Thales Luna or SoftHSM (KMS_VENDOR=pkcs11)
+//   - local:  a file-based software keyring for air-gapped deployments
+//     (KMS_VENDOR=local)
+//
+// The ciphertext format is backend-specific and self-describing (a vendor
+// prefix plus a key version or label), so a wrapped keyset can only be
+// unwrapped by the backend that produced it.
+package kms
+
+import (
+	"context"
+	"errors"
+)
+
+// Client is the key-encryption contract shared by every KMS backend. It is a
+// superset of tink.KMSClient, so any Client can be passed directly to
+// tink.NewKeysetWrapper and tink.NewKeysetFactory.
+//
+// mountPath and keyName scope the KEK exactly as they do for Vault Transit:
+// mountPath is the shared engine for the tenant mode ("transit-mt" or
+// "transit-st") and keyName carries the tenant/organization scope. Backends
+// without a native mount concept must still bind both values to the
+// ciphertext, so a keyset wrapped for one organization never unwraps under
+// another organization's scope.
+type Client interface {
+	// Encrypt wraps plaintext under the KEK identified by mountPath and keyName
+	// and returns the backend-specific ciphertext string.
+	Encrypt(ctx context.Context, mountPath, keyName string, plaintext []byte) (string, error)
+
+	// Decrypt unwraps a ciphertext produced by Encrypt with the same mountPath
+	// and keyName.
+	Decrypt(ctx context.Context, mountPath, keyName string, ciphertext string) ([]byte, error)
+
+	// HealthCheck reports whether the backend can currently serve wrap/unwrap
+	// requests. It is probed by readyz.
+	HealthCheck(ctx context.Context) error
+
+	// Close releases sessions, connections and in-memory key material. The
+	// client must not be used after Close.
+	Close() error
+}
+
+// Sentinel errors shared by the non-Vault backends. Vault keeps its own
+// ErrMountNotFound because a missing Transit mount is a Vault-specific failure.
+var (
+	// ErrInvalidCiphertext indicates a ciphertext that is malformed or was not
+	// produced by this backend.
+	ErrInvalidCiphertext = errors.New("kms: invalid ciphertext")
+
+	// ErrKeyNotFound indicates that the KEK version or label referenced by a
+	// ciphertext (or by configuration) is not available to the backend.
+	ErrKeyNotFound = errors.New("kms: key not found")
+
+	// ErrClosed indicates an operation on a client after Close.
+	ErrClosed = errors.New("kms: client closed")
+)
+
+// KeyScope returns the canonical scope string that backends bind to a
+// ciphertext as additional authenticated data. It is "mountPath/keyName",
+// mirroring the Vault Transit op path minus the operation segment.
+func KeyScope(mountPath, keyName string) string {
+	return mountPath + "/" + keyName
+}
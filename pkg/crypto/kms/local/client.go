@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+)
+
+// ciphertextPrefix marks ciphertexts produced by this backend. The full format
+// is "local:v{version}:{base64(nonce || sealed)}".
+const ciphertextPrefix = "local"
+
+// kekInfoPrefix is the HKDF info label; the key scope is appended so every
+// mount/key name pair derives an independent KEK.
+const kekInfoPrefix = "midaz/kms/local/kek/v1:"
+
+var _ kms.Client = (*Client)(nil)
+
+// Client is a software KMS backed by a versioned keyring file. It is safe for
+// concurrent use.
+type Client struct {
+	mu      sync.RWMutex
+	keys    map[int][]byte
+	primary int
+	closed  bool
+}
+
+// NewClient loads the keyring referenced by cfg and returns a ready client.
+// Unlike Vault there is no login step: a client that constructs successfully
+// can serve requests.
+func NewClient(cfg Config) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid local kms config: %w", err)
+	}
+
+	keys, primary, err := LoadKeyring(cfg.KeyringPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{keys: keys, primary: primary}, nil
+}
+
+// PrimaryVersion returns the keyring version used for new wraps.
+func (c *Client) PrimaryVersion() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.primary
+}
+
+// Encrypt wraps plaintext under the KEK derived from the primary master key for
+// mountPath/keyName. The scope is also bound as GCM additional data.
+func (c *Client) Encrypt(_ context.Context, mountPath, keyName string, plaintext []byte) (string, error) {
+	if mountPath == "" {
+		return "", fmt.Errorf("local kms: empty mount path for key %q", keyName)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return "", kms.ErrClosed
+	}
+
+	aead, err := c.aeadFor(c.primary, mountPath, keyName)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("local kms: failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(kms.KeyScope(mountPath, keyName)))
+
+	return fmt.Sprintf("%s:v%d:%s", ciphertextPrefix, c.primary, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// Decrypt unwraps a ciphertext produced by Encrypt with the same mountPath and
+// keyName, using the master key version recorded in the ciphertext.
+func (c *Client) Decrypt(_ context.Context, mountPath, keyName, ciphertext string) ([]byte, error) {
+	if mountPath == "" {
+		return nil, fmt.Errorf("local kms: empty mount path for key %q", keyName)
+	}
+
+	version, sealed, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return nil, kms.ErrClosed
+	}
+
+	aead, err := c.aeadFor(version, mountPath, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: local ciphertext too short", kms.ErrInvalidCiphertext)
+	}
+
+	nonce, body := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, body, []byte(kms.KeyScope(mountPath, keyName)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: local decrypt failed for key %q", kms.ErrInvalidCiphertext, keyName)
+	}
+
+	return plaintext, nil
+}
+
+// HealthCheck reports whether the keyring is loaded. The keyring is held in
+// memory, so a loaded client is always available.
+func (c *Client) HealthCheck(_ context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return kms.ErrClosed
+	}
+
+	return nil
+}
+
+// Close zeroes the in-memory master keys. After Close every operation returns
+// kms.ErrClosed.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for version, key := range c.keys {
+		clear(key)
+		delete(c.keys, version)
+	}
+
+	c.closed = true
+
+	return nil
+}
+
+// aeadFor derives the scoped KEK for the given master key version and returns
+// its AES-256-GCM AEAD. Callers must hold c.mu.
+func (c *Client) aeadFor(version int, mountPath, keyName string) (cipher.AEAD, error) {
+	master, ok := c.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: local keyring version %d", kms.ErrKeyNotFound, version)
+	}
+
+	kek, err := hkdf.Key(sha256.New, master, nil, kekInfoPrefix+kms.KeyScope(mountPath, keyName), masterKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("local kms: failed to derive kek: %w", err)
+	}
+	defer clear(kek)
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("local kms: failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("local kms: failed to create gcm: %w", err)
+	}
+
+	return aead, nil
+}
+
+// parseCiphertext splits "local:v{version}:{base64}" into its version and
+// decoded payload.
+func parseCiphertext(ciphertext string) (int, []byte, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != ciphertextPrefix || !strings.HasPrefix(parts[1], "v") {
+		return 0, nil, fmt.Errorf("%w: expected %s:v<version>:<payload>", kms.ErrInvalidCiphertext, ciphertextPrefix)
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil || version <= 0 {
+		return 0, nil, fmt.Errorf("%w: bad local key version %q", kms.ErrInvalidCiphertext, parts[1])
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: local payload is not valid base64", kms.ErrInvalidCiphertext)
+	}
+
+	return version, sealed, nil
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMount   = "transit-st"
+	testKeyName = "org-0190b1c2-0000-7000-8000-000000000001"
+)
+
+// newTestKey returns a random base64-encoded master key.
+func newTestKey(t *testing.T) string {
+	t.Helper()
+
+	key := make([]byte, masterKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// writeKeyring writes keyring as JSON to a 0600 file in a temp dir and returns
+// its path.
+func writeKeyring(t *testing.T, keyring Keyring) string {
+	t.Helper()
+
+	raw, err := json.Marshal(keyring)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+
+	return path
+}
+
+func newTestClient(t *testing.T, keyring Keyring) *Client {
+	t.Helper()
+
+	client, err := NewClient(Config{KeyringPath: writeKeyring(t, keyring)})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestClient_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+	plaintext := []byte("serialized tink keyset")
+
+	ciphertext, err := client.Encrypt(context.Background(), testMount, testKeyName, plaintext)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "local:v1:"), ciphertext)
+	assert.NotContains(t, ciphertext, base64.StdEncoding.EncodeToString(plaintext))
+
+	decrypted, err := client.Decrypt(context.Background(), testMount, testKeyName, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	again, err := client.Encrypt(context.Background(), testMount, testKeyName, plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, again, "each wrap must use a fresh nonce")
+}
+
+func TestClient_ScopeIsBound(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+
+	ciphertext, err := client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		mountPath string
+		keyName   string
+	}{
+		{name: "other organization", mountPath: testMount, keyName: "org-other"},
+		{name: "other mount", mountPath: "transit-mt", keyName: testKeyName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.Decrypt(context.Background(), tt.mountPath, tt.keyName, ciphertext)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, kms.ErrInvalidCiphertext)
+		})
+	}
+}
+
+func TestClient_MasterKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+
+	before := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: oldKey}}})
+
+	wrappedV1, err := before.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset-v1"))
+	require.NoError(t, err)
+
+	after := newTestClient(t, Keyring{PrimaryVersion: 2, Keys: []KeyringKey{
+		{Version: 1, Key: oldKey},
+		{Version: 2, Key: newKey},
+	}})
+	assert.Equal(t, 2, after.PrimaryVersion())
+
+	unwrapped, err := after.Decrypt(context.Background(), testMount, testKeyName, wrappedV1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keyset-v1"), unwrapped)
+
+	wrappedV2, err := after.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset-v2"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(wrappedV2, "local:v2:"), wrappedV2)
+
+	_, err = before.Decrypt(context.Background(), testMount, testKeyName, wrappedV2)
+	assert.ErrorIs(t, err, kms.ErrKeyNotFound)
+}
+
+func TestClient_Decrypt_InvalidCiphertext(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+
+	valid, err := client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	require.NoError(t, err)
+
+	payload := valid[len("local:v1:"):]
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	require.NoError(t, err)
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 0xFF
+
+	tests := []struct {
+		name       string
+		ciphertext string
+	}{
+		{name: "vault ciphertext", ciphertext: "vault:v1:" + payload},
+		{name: "missing version", ciphertext: "local:" + payload},
+		{name: "non-numeric version", ciphertext: "local:vx:" + payload},
+		{name: "bad base64", ciphertext: "local:v1:!!!"},
+		{name: "too short", ciphertext: "local:v1:" + base64.StdEncoding.EncodeToString([]byte("abc"))},
+		{name: "tampered payload", ciphertext: "local:v1:" + base64.StdEncoding.EncodeToString(tampered)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.Decrypt(context.Background(), testMount, testKeyName, tt.ciphertext)
+			assert.ErrorIs(t, err, kms.ErrInvalidCiphertext)
+		})
+	}
+}
+
+func TestClient_EmptyMountPath(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+
+	_, err := client.Encrypt(context.Background(), "", testKeyName, []byte("keyset"))
+	assert.ErrorContains(t, err, "empty mount path")
+
+	_, err = client.Decrypt(context.Background(), "", testKeyName, "local:v1:AAAA")
+	assert.ErrorContains(t, err, "empty mount path")
+}
+
+func TestClient_Close(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+	require.NoError(t, client.HealthCheck(context.Background()))
+
+	ciphertext, err := client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Close())
+
+	assert.ErrorIs(t, client.HealthCheck(context.Background()), kms.ErrClosed)
+
+	_, err = client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	assert.ErrorIs(t, err, kms.ErrClosed)
+
+	_, err = client.Decrypt(context.Background(), testMount, testKeyName, ciphertext)
+	assert.ErrorIs(t, err, kms.ErrClosed)
+}
+
+func TestNewClient_KeyringValidation(t *testing.T) {
+	t.Parallel()
+
+	validKey := newTestKey(t)
+
+	tests := []struct {
+		name          string
+		keyring       Keyring
+		errorContains string
+	}{
+		{
+			name:          "no keys",
+			keyring:       Keyring{PrimaryVersion: 1},
+			errorContains: "no keys",
+		},
+		{
+			name:          "non-positive version",
+			keyring:       Keyring{PrimaryVersion: 0, Keys: []KeyringKey{{Version: 0, Key: validKey}}},
+			errorContains: "must be positive",
+		},
+		{
+			name: "duplicate version",
+			keyring: Keyring{PrimaryVersion: 1, Keys: []KeyringKey{
+				{Version: 1, Key: validKey},
+				{Version: 1, Key: validKey},
+			}},
+			errorContains: "duplicated",
+		},
+		{
+			name:          "invalid base64",
+			keyring:       Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: "not base64!"}}},
+			errorContains: "not valid base64",
+		},
+		{
+			name: "wrong key size",
+			keyring: Keyring{PrimaryVersion: 1, Keys: []KeyringKey{
+				{Version: 1, Key: base64.StdEncoding.EncodeToString([]byte("too short"))},
+			}},
+			errorContains: "must be 32 bytes",
+		},
+		{
+			name:          "primary version missing",
+			keyring:       Keyring{PrimaryVersion: 2, Keys: []KeyringKey{{Version: 1, Key: validKey}}},
+			errorContains: "primary_version 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewClient(Config{KeyringPath: writeKeyring(t, tt.keyring)})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+func TestNewClient_ConfigAndFileErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewClient(Config{KeyringPath: "  "})
+		assert.ErrorContains(t, err, "keyring_path")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewClient(Config{KeyringPath: filepath.Join(t.TempDir(), "absent.json")})
+		assert.ErrorContains(t, err, "failed to stat keyring")
+	})
+
+	t.Run("group readable file", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeKeyring(t, Keyring{PrimaryVersion: 1, Keys: []KeyringKey{{Version: 1, Key: newTestKey(t)}}})
+		require.NoError(t, os.Chmod(path, 0o640))
+
+		_, err := NewClient(Config{KeyringPath: path})
+		assert.ErrorContains(t, err, "must not be accessible by group or others")
+	})
+
+	t.Run("malformed json", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "keyring.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+		_, err := NewClient(Config{KeyringPath: path})
+		assert.ErrorContains(t, err, "failed to parse keyring")
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Package local provides a file-based software KMS for air-gapped deployments
+// that can run neither Vault nor an HSM. Master keys are read from an
+// operator-managed keyring file; a distinct KEK is derived per mount/key name
+// with HKDF-SHA256 and keysets are wrapped with AES-256-GCM.
+//
+// The keyring file is the root of trust: anyone who can read it can unwrap every
+// keyset. It must be readable only by the ledger process (mode 0600 or 0400)
+// and backed up out of band.
+package local
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config holds the configuration for the local software KMS.
+type Config struct {
+	// KeyringPath is the path to the JSON keyring file holding the versioned
+	// master keys (see Keyring).
+	KeyringPath string
+}
+
+// Validate checks that all required fields are present and non-empty.
+func (c Config) Validate() error {
+	if strings.TrimSpace(c.KeyringPath) == "" {
+		return fmt.Errorf("local kms config missing required fields: keyring_path")
+	}
+
+	return nil
+}
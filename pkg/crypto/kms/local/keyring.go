@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// masterKeySize is the required master key length (AES-256).
+const masterKeySize = 32
+
+// Keyring is the on-disk keyring format:
+//
+//	{
+//	  "primary_version": 2,
+//	  "keys": [
+//	    {"version": 1, "key": "<base64 32 bytes>"},
+//	    {"version": 2, "key": "<base64 32 bytes>"}
+//	  ]
+//	}
+//
+// New wraps always use PrimaryVersion; unwraps select the version recorded in
+// the ciphertext. Rotating the master key means appending a new version and
+// moving primary_version to it; old versions must stay in the file until every
+// keyset wrapped under them has been rotated. A key can be generated with
+// `openssl rand -base64 32`.
+type Keyring struct {
+	PrimaryVersion int          `json:"primary_version"`
+	Keys           []KeyringKey `json:"keys"`
+}
+
+// KeyringKey is one versioned master key in the keyring file.
+type KeyringKey struct {
+	Version int    `json:"version"`
+	Key     string `json:"key"`
+}
+
+// LoadKeyring reads and validates the keyring file at path and returns the
+// decoded master keys by version together with the primary version. The file
+// must not be readable or writable by group or others.
+func LoadKeyring(path string) (map[int][]byte, int, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("local kms: failed to stat keyring: %w", err)
+	}
+
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return nil, 0, fmt.Errorf("local kms: keyring %s has permissions %#o: must not be accessible by group or others", path, perm)
+	}
+
+	raw, err := os.ReadFile(path) // #nosec G304 -- operator-configured keyring path
+	if err != nil {
+		return nil, 0, fmt.Errorf("local kms: failed to read keyring: %w", err)
+	}
+
+	var keyring Keyring
+	if err := json.Unmarshal(raw, &keyring); err != nil {
+		return nil, 0, fmt.Errorf("local kms: failed to parse keyring: %w", err)
+	}
+
+	return keyring.decode()
+}
+
+// decode validates the keyring and decodes every master key.
+func (k Keyring) decode() (map[int][]byte, int, error) {
+	if len(k.Keys) == 0 {
+		return nil, 0, fmt.Errorf("local kms: keyring has no keys")
+	}
+
+	keys := make(map[int][]byte, len(k.Keys))
+
+	for _, entry := range k.Keys {
+		if entry.Version <= 0 {
+			return nil, 0, fmt.Errorf("local kms: keyring version %d must be positive", entry.Version)
+		}
+
+		if _, exists := keys[entry.Version]; exists {
+			return nil, 0, fmt.Errorf("local kms: keyring version %d is duplicated", entry.Version)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(entry.Key)
+		if err != nil {
+			return nil, 0, fmt.Errorf("local kms: keyring version %d is not valid base64: %w", entry.Version, err)
+		}
+
+		if len(key) != masterKeySize {
+			return nil, 0, fmt.Errorf("local kms: keyring version %d must be %d bytes, got %d", entry.Version, masterKeySize, len(key))
+		}
+
+		keys[entry.Version] = key
+	}
+
+	if _, ok := keys[k.PrimaryVersion]; !ok {
+		return nil, 0, fmt.Errorf("local kms: primary_version %d is not in the keyring", k.PrimaryVersion)
+	}
+
+	return keys, k.PrimaryVersion, nil
+}
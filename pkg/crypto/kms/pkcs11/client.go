@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+)
+
+// ciphertextPrefix marks ciphertexts produced by this backend. The full format
+// is "pkcs11:{keyLabel}:{base64(iv || ciphertext || tag)}".
+const ciphertextPrefix = "pkcs11"
+
+// gcmIVSize is the GCM IV length in bytes (96 bits, the NIST-recommended size).
+const gcmIVSize = 12
+
+// gcmTagSize is the GCM tag length in bytes.
+const gcmTagSize = 16
+
+var _ kms.Client = (*Client)(nil)
+
+// Client wraps keysets with an AES key held in a PKCS#11 token. All module
+// calls are serialized on one session, so the client is safe for concurrent use.
+type Client struct {
+	mu       sync.Mutex
+	module   Module
+	keyLabel string
+	handles  map[string]KeyHandle
+	closed   bool
+}
+
+// NewClient opens the PKCS#11 module described by cfg, logs in to the token and
+// resolves the wrapping key, so a misconfigured token or a missing key fails at
+// startup rather than on the first wrap.
+func NewClient(cfg Config) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid pkcs11 config: %w", err)
+	}
+
+	module, err := OpenModule(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(module, cfg.KeyLabel)
+	if err != nil {
+		_ = module.Close()
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// newClient builds a client over an already opened module and resolves the
+// wrapping key by label.
+func newClient(module Module, keyLabel string) (*Client, error) {
+	handle, err := module.FindKey(keyLabel)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11 kms: failed to resolve wrapping key %q: %w", keyLabel, err)
+	}
+
+	return &Client{
+		module:   module,
+		keyLabel: keyLabel,
+		handles:  map[string]KeyHandle{keyLabel: handle},
+	}, nil
+}
+
+// KeyLabel returns the label of the key used for new wraps.
+func (c *Client) KeyLabel() string {
+	return c.keyLabel
+}
+
+// Encrypt wraps plaintext under the configured HSM key, binding
+// mountPath/keyName as GCM additional data.
+func (c *Client) Encrypt(_ context.Context, mountPath, keyName string, plaintext []byte) (string, error) {
+	if mountPath == "" {
+		return "", fmt.Errorf("pkcs11 kms: empty mount path for key %q", keyName)
+	}
+
+	iv := make([]byte, gcmIVSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", fmt.Errorf("pkcs11 kms: failed to generate iv: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return "", kms.ErrClosed
+	}
+
+	sealed, err := c.module.EncryptGCM(c.handles[c.keyLabel], iv, []byte(kms.KeyScope(mountPath, keyName)), plaintext)
+	if err != nil {
+		return "", fmt.Errorf("pkcs11 kms: encrypt failed: %w", err)
+	}
+
+	payload := make([]byte, 0, len(iv)+len(sealed))
+	payload = append(payload, iv...)
+	payload = append(payload, sealed...)
+
+	return fmt.Sprintf("%s:%s:%s", ciphertextPrefix, c.keyLabel, base64.StdEncoding.EncodeToString(payload)), nil
+}
+
+// Decrypt unwraps a ciphertext produced by Encrypt with the same mountPath and
+// keyName, using the HSM key whose label is recorded in the ciphertext.
+func (c *Client) Decrypt(_ context.Context, mountPath, keyName, ciphertext string) ([]byte, error) {
+	if mountPath == "" {
+		return nil, fmt.Errorf("pkcs11 kms: empty mount path for key %q", keyName)
+	}
+
+	label, payload, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, kms.ErrClosed
+	}
+
+	handle, err := c.handleLocked(label)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := c.module.DecryptGCM(handle, payload[:gcmIVSize], []byte(kms.KeyScope(mountPath, keyName)), payload[gcmIVSize:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: pkcs11 decrypt failed for key %q: %w", kms.ErrInvalidCiphertext, keyName, err)
+	}
+
+	return plaintext, nil
+}
+
+// HealthCheck verifies that the token session is still usable.
+func (c *Client) HealthCheck(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return kms.ErrClosed
+	}
+
+	if err := c.module.Ping(); err != nil {
+		return fmt.Errorf("pkcs11 health check failed: %w", err)
+	}
+
+	return nil
+}
+
+// Close releases the token session and finalizes the module.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	c.closed = true
+
+	return c.module.Close()
+}
+
+// handleLocked returns the key handle for label, resolving and caching it on
+// first use. Callers must hold c.mu.
+func (c *Client) handleLocked(label string) (KeyHandle, error) {
+	if handle, ok := c.handles[label]; ok {
+		return handle, nil
+	}
+
+	handle, err := c.module.FindKey(label)
+	if err != nil {
+		return 0, fmt.Errorf("pkcs11 kms: failed to resolve key %q: %w", label, err)
+	}
+
+	c.handles[label] = handle
+
+	return handle, nil
+}
+
+// parseCiphertext splits "pkcs11:{label}:{base64}" into the key label and the
+// decoded iv||ciphertext||tag payload.
+func parseCiphertext(ciphertext string) (string, []byte, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != ciphertextPrefix || parts[1] == "" {
+		return "", nil, fmt.Errorf("%w: expected %s:<key label>:<payload>", kms.ErrInvalidCiphertext, ciphertextPrefix)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: pkcs11 payload is not valid base64", kms.ErrInvalidCiphertext)
+	}
+
+	if len(payload) < gcmIVSize+gcmTagSize {
+		return "", nil, fmt.Errorf("%w: pkcs11 ciphertext too short", kms.ErrInvalidCiphertext)
+	}
+
+	return parts[1], payload, nil
+}
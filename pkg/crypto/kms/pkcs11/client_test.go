@@ -0,0 +1,281 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMount   = "transit-st"
+	testKeyName = "org-0190b1c2-0000-7000-8000-000000000001"
+)
+
+// softModule is an in-memory Module that performs AES-GCM in software, standing
+// in for an HSM token.
+type softModule struct {
+	keys      map[string][]byte
+	handles   map[KeyHandle][]byte
+	findCalls int
+	pingErr   error
+	closed    bool
+}
+
+func newSoftModule(t *testing.T, labels ...string) *softModule {
+	t.Helper()
+
+	m := &softModule{keys: map[string][]byte{}, handles: map[KeyHandle][]byte{}}
+	for _, label := range labels {
+		m.addKey(t, label)
+	}
+
+	return m
+}
+
+func (m *softModule) addKey(t *testing.T, label string) {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	m.keys[label] = key
+}
+
+func (m *softModule) FindKey(label string) (KeyHandle, error) {
+	m.findCalls++
+
+	key, ok := m.keys[label]
+	if !ok {
+		return 0, fmt.Errorf("%w: no AES key labelled %q", kms.ErrKeyNotFound, label)
+	}
+
+	handle := KeyHandle(len(m.handles) + 1)
+	m.handles[handle] = key
+
+	return handle, nil
+}
+
+func (m *softModule) gcm(key KeyHandle) (cipher.AEAD, error) {
+	raw, ok := m.handles[key]
+	if !ok {
+		return nil, errors.New("CKR 0x82")
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (m *softModule) EncryptGCM(key KeyHandle, iv, aad, plaintext []byte) ([]byte, error) {
+	aead, err := m.gcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, iv, plaintext, aad), nil
+}
+
+func (m *softModule) DecryptGCM(key KeyHandle, iv, aad, ciphertext []byte) ([]byte, error) {
+	aead, err := m.gcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, iv, ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("CKR 0x40")
+	}
+
+	return plaintext, nil
+}
+
+func (m *softModule) Ping() error {
+	return m.pingErr
+}
+
+func (m *softModule) Close() error {
+	m.closed = true
+
+	return nil
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := Config{ModulePath: "/usr/lib/softhsm/libsofthsm2.so", TokenLabel: "midaz", PIN: "1234", KeyLabel: "midaz-kek-1"}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name          string
+		mutate        func(c *Config)
+		errorContains string
+	}{
+		{name: "missing module path", mutate: func(c *Config) { c.ModulePath = "" }, errorContains: "module_path"},
+		{name: "missing token label", mutate: func(c *Config) { c.TokenLabel = " " }, errorContains: "token_label"},
+		{name: "missing pin", mutate: func(c *Config) { c.PIN = "" }, errorContains: "pin"},
+		{name: "missing key label", mutate: func(c *Config) { c.KeyLabel = "" }, errorContains: "key_label"},
+		{name: "key label with colon", mutate: func(c *Config) { c.KeyLabel = "kek:1" }, errorContains: "must not contain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := valid
+			tt.mutate(&cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+func TestNewClient_MissingWrappingKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := newClient(newSoftModule(t), "midaz-kek-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, kms.ErrKeyNotFound)
+}
+
+func TestClient_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	client, err := newClient(newSoftModule(t, "midaz-kek-1"), "midaz-kek-1")
+	require.NoError(t, err)
+	assert.Equal(t, "midaz-kek-1", client.KeyLabel())
+
+	plaintext := []byte("serialized tink keyset")
+
+	ciphertext, err := client.Encrypt(context.Background(), testMount, testKeyName, plaintext)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "pkcs11:midaz-kek-1:"), ciphertext)
+
+	decrypted, err := client.Decrypt(context.Background(), testMount, testKeyName, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	again, err := client.Encrypt(context.Background(), testMount, testKeyName, plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, again, "each wrap must use a fresh iv")
+}
+
+func TestClient_ScopeIsBound(t *testing.T) {
+	t.Parallel()
+
+	client, err := newClient(newSoftModule(t, "midaz-kek-1"), "midaz-kek-1")
+	require.NoError(t, err)
+
+	ciphertext, err := client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	require.NoError(t, err)
+
+	_, err = client.Decrypt(context.Background(), testMount, "org-other", ciphertext)
+	assert.ErrorIs(t, err, kms.ErrInvalidCiphertext)
+
+	_, err = client.Decrypt(context.Background(), "transit-mt", testKeyName, ciphertext)
+	assert.ErrorIs(t, err, kms.ErrInvalidCiphertext)
+}
+
+func TestClient_KeyLabelRotation(t *testing.T) {
+	t.Parallel()
+
+	module := newSoftModule(t, "midaz-kek-1", "midaz-kek-2")
+
+	before, err := newClient(module, "midaz-kek-1")
+	require.NoError(t, err)
+
+	wrapped, err := before.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset-v1"))
+	require.NoError(t, err)
+
+	after, err := newClient(module, "midaz-kek-2")
+	require.NoError(t, err)
+
+	findsBefore := module.findCalls
+
+	unwrapped, err := after.Decrypt(context.Background(), testMount, testKeyName, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keyset-v1"), unwrapped)
+
+	_, err = after.Decrypt(context.Background(), testMount, testKeyName, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, findsBefore+1, module.findCalls, "the previous key handle must be resolved once and cached")
+
+	rewrapped, err := after.Encrypt(context.Background(), testMount, testKeyName, unwrapped)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rewrapped, "pkcs11:midaz-kek-2:"), rewrapped)
+
+	delete(module.keys, "midaz-kek-1")
+
+	fresh, err := newClient(module, "midaz-kek-2")
+	require.NoError(t, err)
+
+	_, err = fresh.Decrypt(context.Background(), testMount, testKeyName, wrapped)
+	assert.ErrorIs(t, err, kms.ErrKeyNotFound)
+}
+
+func TestClient_Decrypt_InvalidCiphertext(t *testing.T) {
+	t.Parallel()
+
+	client, err := newClient(newSoftModule(t, "midaz-kek-1"), "midaz-kek-1")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		ciphertext string
+	}{
+		{name: "vault ciphertext", ciphertext: "vault:v1:AAAA"},
+		{name: "missing label", ciphertext: "pkcs11::AAAA"},
+		{name: "bad base64", ciphertext: "pkcs11:midaz-kek-1:!!!"},
+		{name: "too short", ciphertext: "pkcs11:midaz-kek-1:AAAA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.Decrypt(context.Background(), testMount, testKeyName, tt.ciphertext)
+			assert.ErrorIs(t, err, kms.ErrInvalidCiphertext)
+		})
+	}
+}
+
+func TestClient_HealthCheckAndClose(t *testing.T) {
+	t.Parallel()
+
+	module := newSoftModule(t, "midaz-kek-1")
+
+	client, err := newClient(module, "midaz-kek-1")
+	require.NoError(t, err)
+
+	require.NoError(t, client.HealthCheck(context.Background()))
+
+	module.pingErr = errors.New("CKR 0xB3")
+	assert.ErrorContains(t, client.HealthCheck(context.Background()), "pkcs11 health check failed")
+
+	require.NoError(t, client.Close())
+	require.NoError(t, client.Close(), "Close must be idempotent")
+	assert.True(t, module.closed)
+
+	assert.ErrorIs(t, client.HealthCheck(context.Background()), kms.ErrClosed)
+
+	_, err = client.Encrypt(context.Background(), testMount, testKeyName, []byte("keyset"))
+	assert.ErrorIs(t, err, kms.ErrClosed)
+}
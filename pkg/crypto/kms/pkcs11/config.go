@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+// Package pkcs11 provides a key-encryption client for hardware security modules
+// reachable through a PKCS#11 module (Thales Luna, Entrust nShield, AWS
+// CloudHSM, SoftHSM for testing). Keysets are wrapped with CKM_AES_GCM under a
+// single operator-provisioned AES key that never leaves the HSM; the
+// mount/key name scope is bound as GCM additional data, so a keyset wrapped for
+// one organization does not unwrap under another organization's scope.
+//
+// The native binding needs cgo and the pkcs11 build tag
+// (CGO_ENABLED=1 go build -tags pkcs11). Default builds compile a stub whose
+// OpenModule returns ErrUnsupportedBuild and Supported is false.
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedBuild is returned by OpenModule when the binary was built
+// without the native PKCS#11 binding.
+var ErrUnsupportedBuild = errors.New("pkcs11 kms: binary built without PKCS#11 support: rebuild with CGO_ENABLED=1 -tags pkcs11")
+
+// Config holds the configuration for connecting to a PKCS#11 token.
+type Config struct {
+	// ModulePath is the filesystem path of the vendor PKCS#11 shared library
+	// (e.g. "/usr/lib/softhsm/libsofthsm2.so").
+	ModulePath string
+
+	// TokenLabel selects the token (slot) by its label.
+	TokenLabel string
+
+	// PIN is the user PIN used to log in to the token.
+	PIN string
+
+	// KeyLabel is the CKA_LABEL of the AES key used to wrap new keysets. It is
+	// recorded in every ciphertext, so moving to a new key only requires
+	// provisioning it and changing KeyLabel; keysets wrapped under the previous
+	// label stay readable while that key remains on the token.
+	KeyLabel string
+}
+
+// Validate checks that all required fields are present and non-empty.
+func (c Config) Validate() error {
+	var missing []string
+
+	if strings.TrimSpace(c.ModulePath) == "" {
+		missing = append(missing, "module_path")
+	}
+
+	if strings.TrimSpace(c.TokenLabel) == "" {
+		missing = append(missing, "token_label")
+	}
+
+	if strings.TrimSpace(c.PIN) == "" {
+		missing = append(missing, "pin")
+	}
+
+	if strings.TrimSpace(c.KeyLabel) == "" {
+		missing = append(missing, "key_label")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("pkcs11 config missing required fields: %s", strings.Join(missing, ", "))
+	}
+
+	if strings.Contains(c.KeyLabel, ":") {
+		return fmt.Errorf("pkcs11 key label %q must not contain ':'", c.KeyLabel)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+// KeyHandle is a PKCS#11 object handle (CK_OBJECT_HANDLE) for an AES key on
+// the token.
+type KeyHandle uint64
+
+// Module is the narrow slice of PKCS#11 the client needs: one logged-in session
+// on the configured token. It is the seam between the client's wire format and
+// the native binding, which lets the client be tested without an HSM.
+//
+// Implementations are not required to be safe for concurrent use; a PKCS#11
+// session handles one operation at a time, so the client serializes calls.
+type Module interface {
+	// FindKey returns the handle of the AES secret key with the given CKA_LABEL.
+	// It returns an error wrapping kms.ErrKeyNotFound when no such key exists.
+	FindKey(label string) (KeyHandle, error)
+
+	// EncryptGCM runs CKM_AES_GCM encryption with a 128-bit tag and returns the
+	// ciphertext with the tag appended.
+	EncryptGCM(key KeyHandle, iv, aad, plaintext []byte) ([]byte, error)
+
+	// DecryptGCM runs CKM_AES_GCM decryption of ciphertext (tag appended) and
+	// returns the plaintext, failing when the tag does not verify.
+	DecryptGCM(key KeyHandle, iv, aad, ciphertext []byte) ([]byte, error)
+
+	// Ping verifies that the session is still usable (C_GetSessionInfo).
+	Ping() error
+
+	// Close logs out, closes the session and finalizes the library.
+	Close() error
+}
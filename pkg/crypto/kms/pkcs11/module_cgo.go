@@ -0,0 +1,477 @@
+//go:build cgo && pkcs11
+
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+/*
+#cgo linux LDFLAGS: -ldl
+
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+// Minimal PKCS#11 v2.40 declarations. Only the types, constants and the
+// CK_FUNCTION_LIST prefix used by this binding are declared, so the build does
+// not depend on vendor headers. Field order and sizes follow the OASIS
+// pkcs11t.h/pkcs11f.h definitions for non-Windows platforms.
+
+typedef unsigned char CK_BYTE;
+typedef CK_BYTE CK_BBOOL;
+typedef unsigned long CK_ULONG;
+typedef CK_ULONG CK_RV;
+typedef CK_ULONG CK_FLAGS;
+typedef CK_ULONG CK_SLOT_ID;
+typedef CK_ULONG CK_SESSION_HANDLE;
+typedef CK_ULONG CK_OBJECT_HANDLE;
+typedef CK_ULONG CK_USER_TYPE;
+typedef CK_ULONG CK_ATTRIBUTE_TYPE;
+typedef CK_ULONG CK_MECHANISM_TYPE;
+
+#define CKR_OK                           0x000UL
+#define CKR_USER_ALREADY_LOGGED_IN       0x100UL
+#define CKR_CRYPTOKI_ALREADY_INITIALIZED 0x191UL
+#define CKF_OS_LOCKING_OK                0x002UL
+#define CKF_RW_SESSION                   0x002UL
+#define CKF_SERIAL_SESSION               0x004UL
+#define CKU_USER                         1UL
+#define CKA_CLASS                        0x000UL
+#define CKA_LABEL                        0x003UL
+#define CKA_KEY_TYPE                     0x100UL
+#define CKO_SECRET_KEY                   0x004UL
+#define CKK_AES                          0x01FUL
+#define CKM_AES_GCM                      0x1087UL
+
+typedef struct { CK_BYTE major; CK_BYTE minor; } CK_VERSION;
+
+typedef struct {
+	CK_BYTE label[32];
+	CK_BYTE manufacturerID[32];
+	CK_BYTE model[16];
+	CK_BYTE serialNumber[16];
+	CK_FLAGS flags;
+	CK_ULONG ulMaxSessionCount;
+	CK_ULONG ulSessionCount;
+	CK_ULONG ulMaxRwSessionCount;
+	CK_ULONG ulRwSessionCount;
+	CK_ULONG ulMaxPinLen;
+	CK_ULONG ulMinPinLen;
+	CK_ULONG ulTotalPublicMemory;
+	CK_ULONG ulFreePublicMemory;
+	CK_ULONG ulTotalPrivateMemory;
+	CK_ULONG ulFreePrivateMemory;
+	CK_VERSION hardwareVersion;
+	CK_VERSION firmwareVersion;
+	CK_BYTE utcTime[16];
+} CK_TOKEN_INFO;
+
+typedef struct {
+	CK_SLOT_ID slotID;
+	CK_ULONG state;
+	CK_FLAGS flags;
+	CK_ULONG ulDeviceError;
+} CK_SESSION_INFO;
+
+typedef struct {
+	void *CreateMutex;
+	void *DestroyMutex;
+	void *LockMutex;
+	void *UnlockMutex;
+	CK_FLAGS flags;
+	void *pReserved;
+} CK_C_INITIALIZE_ARGS;
+
+typedef struct {
+	CK_ATTRIBUTE_TYPE type;
+	void *pValue;
+	CK_ULONG ulValueLen;
+} CK_ATTRIBUTE;
+
+typedef struct {
+	CK_MECHANISM_TYPE mechanism;
+	void *pParameter;
+	CK_ULONG ulParameterLen;
+} CK_MECHANISM;
+
+typedef struct {
+	CK_BYTE *pIv;
+	CK_ULONG ulIvLen;
+	CK_ULONG ulIvBits;
+	CK_BYTE *pAAD;
+	CK_ULONG ulAADLen;
+	CK_ULONG ulTagBits;
+} CK_GCM_PARAMS;
+
+typedef struct {
+	CK_VERSION version;
+	CK_RV (*C_Initialize)(void *);
+	CK_RV (*C_Finalize)(void *);
+	void *C_GetInfo;
+	void *C_GetFunctionList;
+	CK_RV (*C_GetSlotList)(CK_BBOOL, CK_SLOT_ID *, CK_ULONG *);
+	void *C_GetSlotInfo;
+	CK_RV (*C_GetTokenInfo)(CK_SLOT_ID, CK_TOKEN_INFO *);
+	void *C_GetMechanismList;
+	void *C_GetMechanismInfo;
+	void *C_InitToken;
+	void *C_InitPIN;
+	void *C_SetPIN;
+	CK_RV (*C_OpenSession)(CK_SLOT_ID, CK_FLAGS, void *, void *, CK_SESSION_HANDLE *);
+	CK_RV (*C_CloseSession)(CK_SESSION_HANDLE);
+	void *C_CloseAllSessions;
+	CK_RV (*C_GetSessionInfo)(CK_SESSION_HANDLE, CK_SESSION_INFO *);
+	void *C_GetOperationState;
+	void *C_SetOperationState;
+	CK_RV (*C_Login)(CK_SESSION_HANDLE, CK_USER_TYPE, CK_BYTE *, CK_ULONG);
+	CK_RV (*C_Logout)(CK_SESSION_HANDLE);
+	void *C_CreateObject;
+	void *C_CopyObject;
+	void *C_DestroyObject;
+	void *C_GetObjectSize;
+	void *C_GetAttributeValue;
+	void *C_SetAttributeValue;
+	CK_RV (*C_FindObjectsInit)(CK_SESSION_HANDLE, CK_ATTRIBUTE *, CK_ULONG);
+	CK_RV (*C_FindObjects)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE *, CK_ULONG, CK_ULONG *);
+	CK_RV (*C_FindObjectsFinal)(CK_SESSION_HANDLE);
+	CK_RV (*C_EncryptInit)(CK_SESSION_HANDLE, CK_MECHANISM *, CK_OBJECT_HANDLE);
+	CK_RV (*C_Encrypt)(CK_SESSION_HANDLE, CK_BYTE *, CK_ULONG, CK_BYTE *, CK_ULONG *);
+	void *C_EncryptUpdate;
+	void *C_EncryptFinal;
+	CK_RV (*C_DecryptInit)(CK_SESSION_HANDLE, CK_MECHANISM *, CK_OBJECT_HANDLE);
+	CK_RV (*C_Decrypt)(CK_SESSION_HANDLE, CK_BYTE *, CK_ULONG, CK_BYTE *, CK_ULONG *);
+	// The remaining entries are never dereferenced.
+} CK_FUNCTION_LIST;
+
+typedef CK_RV (*mdz_get_function_list_fn)(CK_FUNCTION_LIST **);
+
+static void *mdz_load(const char *path, CK_FUNCTION_LIST **fl, CK_RV *rv) {
+	void *lib = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (lib == NULL) {
+		return NULL;
+	}
+
+	mdz_get_function_list_fn get = (mdz_get_function_list_fn)dlsym(lib, "C_GetFunctionList");
+	if (get == NULL) {
+		dlclose(lib);
+		return NULL;
+	}
+
+	*rv = get(fl);
+	if (*rv != CKR_OK) {
+		dlclose(lib);
+		return NULL;
+	}
+
+	return lib;
+}
+
+static const char *mdz_dlerror(void) {
+	const char *msg = dlerror();
+	return msg == NULL ? "C_GetFunctionList failed" : msg;
+}
+
+static void mdz_unload(void *lib) {
+	dlclose(lib);
+}
+
+static CK_RV mdz_initialize(CK_FUNCTION_LIST *fl) {
+	CK_C_INITIALIZE_ARGS args;
+	memset(&args, 0, sizeof(args));
+	args.flags = CKF_OS_LOCKING_OK;
+
+	CK_RV rv = fl->C_Initialize(&args);
+	if (rv == CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return CKR_OK;
+	}
+
+	return rv;
+}
+
+static CK_RV mdz_finalize(CK_FUNCTION_LIST *fl) {
+	return fl->C_Finalize(NULL);
+}
+
+static CK_RV mdz_slot_list(CK_FUNCTION_LIST *fl, CK_SLOT_ID *slots, CK_ULONG *count) {
+	return fl->C_GetSlotList(1, slots, count);
+}
+
+static CK_RV mdz_token_label(CK_FUNCTION_LIST *fl, CK_SLOT_ID slot, CK_BYTE *label) {
+	CK_TOKEN_INFO info;
+	CK_RV rv = fl->C_GetTokenInfo(slot, &info);
+	if (rv == CKR_OK) {
+		memcpy(label, info.label, sizeof(info.label));
+	}
+
+	return rv;
+}
+
+static CK_RV mdz_open_session(CK_FUNCTION_LIST *fl, CK_SLOT_ID slot, CK_SESSION_HANDLE *session) {
+	return fl->C_OpenSession(slot, CKF_SERIAL_SESSION, NULL, NULL, session);
+}
+
+static CK_RV mdz_close_session(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session) {
+	return fl->C_CloseSession(session);
+}
+
+static CK_RV mdz_login(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session, CK_BYTE *pin, CK_ULONG pinLen) {
+	CK_RV rv = fl->C_Login(session, CKU_USER, pin, pinLen);
+	if (rv == CKR_USER_ALREADY_LOGGED_IN) {
+		return CKR_OK;
+	}
+
+	return rv;
+}
+
+static CK_RV mdz_logout(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session) {
+	return fl->C_Logout(session);
+}
+
+static CK_RV mdz_session_info(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session) {
+	CK_SESSION_INFO info;
+	return fl->C_GetSessionInfo(session, &info);
+}
+
+static CK_RV mdz_find_key(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session, CK_BYTE *label, CK_ULONG labelLen,
+	CK_OBJECT_HANDLE *handles, CK_ULONG *count) {
+	CK_ULONG class = CKO_SECRET_KEY;
+	CK_ULONG keyType = CKK_AES;
+	CK_ATTRIBUTE tmpl[3] = {
+		{CKA_CLASS, &class, sizeof(class)},
+		{CKA_KEY_TYPE, &keyType, sizeof(keyType)},
+		{CKA_LABEL, label, labelLen},
+	};
+
+	CK_RV rv = fl->C_FindObjectsInit(session, tmpl, 3);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+
+	rv = fl->C_FindObjects(session, handles, *count, count);
+	CK_RV final = fl->C_FindObjectsFinal(session);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+
+	return final;
+}
+
+static CK_RV mdz_gcm(CK_FUNCTION_LIST *fl, CK_SESSION_HANDLE session, int encrypt, CK_OBJECT_HANDLE key,
+	CK_BYTE *iv, CK_ULONG ivLen, CK_BYTE *aad, CK_ULONG aadLen,
+	CK_BYTE *in, CK_ULONG inLen, CK_BYTE *out, CK_ULONG *outLen) {
+	CK_GCM_PARAMS params;
+	params.pIv = iv;
+	params.ulIvLen = ivLen;
+	params.ulIvBits = ivLen * 8;
+	params.pAAD = aad;
+	params.ulAADLen = aadLen;
+	params.ulTagBits = 128;
+
+	CK_MECHANISM mech = {CKM_AES_GCM, &params, sizeof(params)};
+
+	if (encrypt) {
+		CK_RV rv = fl->C_EncryptInit(session, &mech, key);
+		if (rv != CKR_OK) {
+			return rv;
+		}
+
+		return fl->C_Encrypt(session, in, inLen, out, outLen);
+	}
+
+	CK_RV rv = fl->C_DecryptInit(session, &mech, key);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+
+	return fl->C_Decrypt(session, in, inLen, out, outLen);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
+)
+
+// Supported reports whether this binary carries the native PKCS#11 binding.
+const Supported = true
+
+// maxSlots bounds the slot list read from the module.
+const maxSlots = 64
+
+// nativeModule is the cgo PKCS#11 binding: one dlopen'ed library and one
+// logged-in session on the configured token.
+type nativeModule struct {
+	lib     unsafe.Pointer
+	fl      *C.CK_FUNCTION_LIST
+	session C.CK_SESSION_HANDLE
+}
+
+// OpenModule loads the PKCS#11 library at cfg.ModulePath, initializes it, opens
+// a session on the token labelled cfg.TokenLabel and logs in with cfg.PIN.
+func OpenModule(cfg Config) (Module, error) {
+	path := C.CString(cfg.ModulePath)
+	defer C.free(unsafe.Pointer(path))
+
+	var (
+		fl *C.CK_FUNCTION_LIST
+		rv C.CK_RV
+	)
+
+	lib := C.mdz_load(path, &fl, &rv)
+	if lib == nil {
+		if rv != C.CKR_OK {
+			return nil, fmt.Errorf("pkcs11 kms: C_GetFunctionList failed: %w", rvError(rv))
+		}
+
+		return nil, fmt.Errorf("pkcs11 kms: failed to load module %s: %s", cfg.ModulePath, C.GoString(C.mdz_dlerror()))
+	}
+
+	m := &nativeModule{lib: lib, fl: fl}
+
+	if rv := C.mdz_initialize(fl); rv != C.CKR_OK {
+		C.mdz_unload(lib)
+
+		return nil, fmt.Errorf("pkcs11 kms: C_Initialize failed: %w", rvError(rv))
+	}
+
+	if err := m.openSession(cfg.TokenLabel, cfg.PIN); err != nil {
+		C.mdz_finalize(fl)
+		C.mdz_unload(lib)
+
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// openSession finds the token by label, opens a serial session on its slot and
+// logs in as the user.
+func (m *nativeModule) openSession(tokenLabel, pin string) error {
+	slots := make([]C.CK_SLOT_ID, maxSlots)
+	count := C.CK_ULONG(len(slots))
+
+	if rv := C.mdz_slot_list(m.fl, &slots[0], &count); rv != C.CKR_OK {
+		return fmt.Errorf("pkcs11 kms: C_GetSlotList failed: %w", rvError(rv))
+	}
+
+	var label [32]C.CK_BYTE
+
+	for _, slot := range slots[:count] {
+		if rv := C.mdz_token_label(m.fl, slot, &label[0]); rv != C.CKR_OK {
+			continue
+		}
+
+		raw := C.GoBytes(unsafe.Pointer(&label[0]), C.int(len(label)))
+		if string(bytes.TrimRight(raw, " \x00")) != tokenLabel {
+			continue
+		}
+
+		if rv := C.mdz_open_session(m.fl, slot, &m.session); rv != C.CKR_OK {
+			return fmt.Errorf("pkcs11 kms: C_OpenSession failed: %w", rvError(rv))
+		}
+
+		pinBytes := []byte(pin)
+		if rv := C.mdz_login(m.fl, m.session, (*C.CK_BYTE)(unsafe.Pointer(&pinBytes[0])), C.CK_ULONG(len(pinBytes))); rv != C.CKR_OK {
+			C.mdz_close_session(m.fl, m.session)
+
+			return fmt.Errorf("pkcs11 kms: C_Login failed: %w", rvError(rv))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("pkcs11 kms: no token labelled %q", tokenLabel)
+}
+
+// FindKey returns the handle of the AES secret key with the given label.
+func (m *nativeModule) FindKey(label string) (KeyHandle, error) {
+	labelBytes := []byte(label)
+	handles := make([]C.CK_OBJECT_HANDLE, 2)
+	count := C.CK_ULONG(len(handles))
+
+	rv := C.mdz_find_key(m.fl, m.session, (*C.CK_BYTE)(unsafe.Pointer(&labelBytes[0])), C.CK_ULONG(len(labelBytes)), &handles[0], &count)
+	if rv != C.CKR_OK {
+		return 0, fmt.Errorf("C_FindObjects failed: %w", rvError(rv))
+	}
+
+	switch count {
+	case 0:
+		return 0, fmt.Errorf("%w: no AES key labelled %q", kms.ErrKeyNotFound, label)
+	case 1:
+		return KeyHandle(handles[0]), nil
+	default:
+		return 0, fmt.Errorf("more than one AES key labelled %q", label)
+	}
+}
+
+// EncryptGCM runs CKM_AES_GCM encryption.
+func (m *nativeModule) EncryptGCM(key KeyHandle, iv, aad, plaintext []byte) ([]byte, error) {
+	return m.gcm(true, key, iv, aad, plaintext, len(plaintext)+gcmTagSize)
+}
+
+// DecryptGCM runs CKM_AES_GCM decryption.
+func (m *nativeModule) DecryptGCM(key KeyHandle, iv, aad, ciphertext []byte) ([]byte, error) {
+	return m.gcm(false, key, iv, aad, ciphertext, len(ciphertext))
+}
+
+// gcm runs one single-part CKM_AES_GCM operation. Buffers are passed as call
+// arguments only; the C side keeps no reference after it returns.
+func (m *nativeModule) gcm(encrypt bool, key KeyHandle, iv, aad, in []byte, outCap int) ([]byte, error) {
+	if len(iv) == 0 || len(aad) == 0 || len(in) == 0 {
+		return nil, fmt.Errorf("gcm requires non-empty iv, aad and input")
+	}
+
+	out := make([]byte, outCap)
+	outLen := C.CK_ULONG(len(out))
+
+	mode := C.int(0)
+	if encrypt {
+		mode = 1
+	}
+
+	rv := C.mdz_gcm(m.fl, m.session, mode, C.CK_OBJECT_HANDLE(key),
+		(*C.CK_BYTE)(unsafe.Pointer(&iv[0])), C.CK_ULONG(len(iv)),
+		(*C.CK_BYTE)(unsafe.Pointer(&aad[0])), C.CK_ULONG(len(aad)),
+		(*C.CK_BYTE)(unsafe.Pointer(&in[0])), C.CK_ULONG(len(in)),
+		(*C.CK_BYTE)(unsafe.Pointer(&out[0])), &outLen)
+	if rv != C.CKR_OK {
+		return nil, rvError(rv)
+	}
+
+	return out[:outLen], nil
+}
+
+// Ping checks that the session is still valid.
+func (m *nativeModule) Ping() error {
+	if rv := C.mdz_session_info(m.fl, m.session); rv != C.CKR_OK {
+		return fmt.Errorf("C_GetSessionInfo failed: %w", rvError(rv))
+	}
+
+	return nil
+}
+
+// Close logs out, closes the session, finalizes the library and unloads it.
+// Logout and close failures are ignored so the library is always released.
+func (m *nativeModule) Close() error {
+	C.mdz_logout(m.fl, m.session)
+	C.mdz_close_session(m.fl, m.session)
+
+	rv := C.mdz_finalize(m.fl)
+	C.mdz_unload(m.lib)
+
+	if rv != C.CKR_OK {
+		return fmt.Errorf("pkcs11 kms: C_Finalize failed: %w", rvError(rv))
+	}
+
+	return nil
+}
+
+// rvError converts a CK_RV into an error carrying its hex code.
+func rvError(rv C.CK_RV) error {
+	return fmt.Errorf("CKR 0x%X", uint64(rv))
+}
@@ -0,0 +1,15 @@
+//go:build !(cgo && pkcs11)
+
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+// Supported reports whether this binary carries the native PKCS#11 binding.
+const Supported = false
+
+// OpenModule always fails in builds without the native binding.
+func OpenModule(_ Config) (Module, error) {
+	return nil, ErrUnsupportedBuild
+}
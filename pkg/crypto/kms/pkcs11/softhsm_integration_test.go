@@ -0,0 +1,67 @@
+//go:build integration && cgo && pkcs11
+
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package pkcs11
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIntegration_SoftHSM_RoundTrip exercises the native binding against a
+// SoftHSM token. It expects an initialized token holding an AES key, e.g.:
+//
+//	softhsm2-util --init-token --free --label midaz --pin 1234 --so-pin 0000
+//	pkcs11-tool --module "$SOFTHSM2_MODULE" --token-label midaz --login --pin 1234 \
+//	  --keygen --key-type AES:32 --label midaz-kek-1
+//
+// and SOFTHSM2_MODULE pointing at libsofthsm2.so. PKCS11_TOKEN_LABEL,
+// PKCS11_PIN and PKCS11_KEY_LABEL default to the values above.
+func TestIntegration_SoftHSM_RoundTrip(t *testing.T) {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	if modulePath == "" {
+		t.Skip("Set SOFTHSM2_MODULE to run the SoftHSM integration test")
+	}
+
+	cfg := Config{
+		ModulePath: modulePath,
+		TokenLabel: envOr("PKCS11_TOKEN_LABEL", "midaz"),
+		PIN:        envOr("PKCS11_PIN", "1234"),
+		KeyLabel:   envOr("PKCS11_KEY_LABEL", "midaz-kek-1"),
+	}
+
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	require.NoError(t, client.HealthCheck(ctx))
+
+	plaintext := []byte("serialized tink keyset")
+
+	ciphertext, err := client.Encrypt(ctx, "transit-st", "org-softhsm", plaintext)
+	require.NoError(t, err)
+
+	decrypted, err := client.Decrypt(ctx, "transit-st", "org-softhsm", ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	_, err = client.Decrypt(ctx, "transit-st", "org-other", ciphertext)
+	require.Error(t, err, "the HSM must reject a ciphertext under another scope")
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
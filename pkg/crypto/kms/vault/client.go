@@ -11,9 +11,12 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/LerianStudio/midaz/v4/pkg/crypto/kms"
 	"github.com/hashicorp/vault/api"
 )
 
+var _ kms.Client = (*Client)(nil)
+
 // Client provides authenticated access to HashiCorp Vault Transit secrets engine.
 // It supports both AppRole and Token authentication methods, with automatic
 // re-authentication on token expiry for AppRole auth.
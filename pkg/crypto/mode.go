@@ -4,10 +4,11 @@
 
 // Package crypto selects the CRM field-encryption strategy from configuration.
 // KMS_VENDOR drives the EncryptionMode: unset or "none" yields legacy (local
-// symmetric keys, no external KMS), "hashicorp-vault" yields envelope (per-org
-// Tink data keys wrapped by a KMS-managed key). The subpackages supply the
-// primitives — tink for AEAD/PRF keysets and KMS wrapping, kms/vault for the
-// Transit key-encryption client.
+// symmetric keys, no external KMS); "hashicorp-vault", "pkcs11" and "local" yield
+// envelope (per-org Tink data keys wrapped by a KMS-managed key). The subpackages
+// supply the primitives — tink for AEAD/PRF keysets and KMS wrapping, kms for the
+// vendor-neutral key-encryption contract and kms/vault, kms/pkcs11 and kms/local
+// for its backends.
 package crypto
 
 // Environment variable constants for KMS configuration.
@@ -23,6 +24,12 @@ const (
 
 	// VendorHashicorpVault indicates HashiCorp Vault as the KMS vendor.
 	VendorHashicorpVault = "hashicorp-vault"
+
+	// VendorPKCS11 indicates a PKCS#11 HSM as the KMS vendor.
+	VendorPKCS11 = "pkcs11"
+
+	// VendorLocal indicates the file-based software KMS for air-gapped deployments.
+	VendorLocal = "local"
 )
 
 // EncryptionMode represents the encryption key protection strategy.
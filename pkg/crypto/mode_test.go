@@ -129,6 +129,16 @@ func TestVendorConstants(t *testing.T) {
 			constant: VendorHashicorpVault,
 			expected: "hashicorp-vault",
 		},
+		{
+			name:     "VendorPKCS11 is pkcs11",
+			constant: VendorPKCS11,
+			expected: "pkcs11",
+		},
+		{
+			name:     "VendorLocal is local",
+			constant: VendorLocal,
+			expected: "local",
+		},
 	}
 
 	for _, tt := range tests {
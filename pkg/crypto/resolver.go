@@ -39,7 +39,7 @@ func NewModeResolverFromEnv() *ModeResolver {
 // Resolve determines the encryption mode based on the KMS_VENDOR environment variable.
 // Returns:
 //   - EncryptionModeLegacy when env var is missing, empty, or set to "none"
-//   - EncryptionModeEnvelope when env var is set to "hashicorp-vault"
+//   - EncryptionModeEnvelope when env var is set to "hashicorp-vault", "pkcs11" or "local"
 //   - Error when env var contains an unsupported vendor value
 func (r *ModeResolver) Resolve() (EncryptionMode, error) {
 	vendor := r.GetVendor()
@@ -47,14 +47,16 @@ func (r *ModeResolver) Resolve() (EncryptionMode, error) {
 	switch vendor {
 	case "", VendorNone:
 		return EncryptionModeLegacy, nil
-	case VendorHashicorpVault:
+	case VendorHashicorpVault, VendorPKCS11, VendorLocal:
 		return EncryptionModeEnvelope, nil
 	default:
 		return EncryptionModeLegacy, fmt.Errorf(
-			"unsupported KMS vendor %q: supported vendors are %q, %q",
+			"unsupported KMS vendor %q: supported vendors are %q, %q, %q, %q",
 			vendor,
 			VendorNone,
 			VendorHashicorpVault,
+			VendorPKCS11,
+			VendorLocal,
 		)
 	}
 }
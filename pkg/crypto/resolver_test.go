@@ -50,6 +50,20 @@ func TestModeResolver_Resolve(t *testing.T) {
 			expectedMode: EncryptionModeEnvelope,
 			expectError:  false,
 		},
+		{
+			name:         "pkcs11 vendor maps to envelope mode",
+			envValue:     "pkcs11",
+			envSet:       true,
+			expectedMode: EncryptionModeEnvelope,
+			expectError:  false,
+		},
+		{
+			name:         "local vendor maps to envelope mode",
+			envValue:     "local",
+			envSet:       true,
+			expectedMode: EncryptionModeEnvelope,
+			expectError:  false,
+		},
 		{
 			name:          "invalid vendor returns error",
 			envValue:      "invalid-vendor",
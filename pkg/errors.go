@@ -1667,6 +1667,252 @@ func ValidateBusinessError(err error, entityType string, args ...any) error {
 			Message:    "The tenant id \"default\" is reserved for internal single-tenant use and cannot be used as a tenant identifier. Please use a different tenant id and try again.",
 			Err:        constant.ErrReservedTenantID,
 		},
+		constant.ErrKeyRotationInProgress: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrKeyRotationInProgress.Error(),
+			Title:      "Key Rotation In Progress",
+			Message:    "A key rotation for this organization is still running or did not finish. Wait for it to complete, or resume it, before starting another rotation or retiring key versions.",
+		},
+		constant.ErrKeyRotationNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrKeyRotationNotFound.Error(),
+			Title:      "Key Rotation Not Found",
+			Message:    "No key rotation has been started for this organization.",
+		},
+		constant.ErrKeyRotationFailed: InternalServerError{
+			EntityType: entityType,
+			Code:       constant.ErrKeyRotationFailed.Error(),
+			Title:      "Key Rotation Failed",
+			Message:    "The key rotation could not be completed. Please try again later.",
+		},
+		constant.ErrHolderDataKeyNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderDataKeyNotFound.Error(),
+			Title:      "Holder Data Key Not Found",
+			Message:    "No data key exists for this holder.",
+		},
+		constant.ErrHolderDataKeyAlreadyExists: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderDataKeyAlreadyExists.Error(),
+			Title:      "Holder Data Key Already Exists",
+			Message:    "A data key already exists for this holder.",
+		},
+		constant.ErrHolderErased: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderErased.Error(),
+			Title:      "Holder Erased",
+			Message:    "The personal data of this holder has been erased and can no longer be read or written.",
+		},
+		constant.ErrHolderErasureFailed: InternalServerError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderErasureFailed.Error(),
+			Title:      "Holder Erasure Failed",
+			Message:    "The holder could not be fully erased. The request is safe to retry.",
+		},
+		constant.ErrKYCTransitionInvalid: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCTransitionInvalid.Error(),
+			Title:      "KYC Transition Invalid",
+			Message:    fmt.Sprintf("The holder's KYC verification is %v and cannot be %v.", args...),
+		},
+		constant.ErrKYCDocumentsMissing: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCDocumentsMissing.Error(),
+			Title:      "KYC Documents Missing",
+			Message:    fmt.Sprintf("The verification case is missing required documents: %v. Submit them and try again.", args...),
+		},
+		constant.ErrKYCRevisionConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCRevisionConflict.Error(),
+			Title:      "KYC Revision Conflict",
+			Message:    "The holder's KYC verification was changed by another request. Reload it and try again.",
+		},
+		constant.ErrKYCSelfReview: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCSelfReview.Error(),
+			Title:      "KYC Self Review",
+			Message:    "A verification case must be decided by a reviewer other than the actor who submitted it.",
+		},
+		constant.ErrKYCHolderTypeUnsupported: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCHolderTypeUnsupported.Error(),
+			Title:      "KYC Holder Type Unsupported",
+			Message:    fmt.Sprintf("No KYC checklist is defined for holders of type '%v'. Only NATURAL_PERSON and LEGAL_PERSON holders can be verified.", args...),
+		},
+		constant.ErrKYCExpiryNotInFuture: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrKYCExpiryNotInFuture.Error(),
+			Title:      "KYC Expiry Not In Future",
+			Message:    "The re-verification date 'expiresAt' must be in the future. Omit it to use the default validity of the verification level.",
+		},
+		constant.ErrWatchListFormatInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListFormatInvalid.Error(),
+			Title:      "Watch List Format Invalid",
+			Message:    fmt.Sprintf("The watch-list format '%v' is not supported. Use one of OFAC_SDN_CSV, OFAC_SDN_XML, UN_CONSOLIDATED_XML or CUSTOM_CSV.", args...),
+		},
+		constant.ErrWatchListParseFailed: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListParseFailed.Error(),
+			Title:      "Watch List Parse Failed",
+			Message:    fmt.Sprintf("The uploaded file could not be read as a %v watch list: %v. Check the file and its format and try again.", args...),
+		},
+		constant.ErrWatchListEmpty: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListEmpty.Error(),
+			Title:      "Watch List Empty",
+			Message:    "The uploaded watch list contains no entries. Upload a file with at least one listed person or entity.",
+		},
+		constant.ErrWatchListNameInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListNameInvalid.Error(),
+			Title:      "Watch List Name Invalid",
+			Message:    fmt.Sprintf("The watch-list name '%v' is invalid. Use 1 to 64 lowercase letters, digits, '-' or '_', starting with a letter or digit.", args...),
+		},
+		constant.ErrWatchListNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListNotFound.Error(),
+			Title:      "Watch List Not Found",
+			Message:    fmt.Sprintf("No watch list named '%v' is loaded for this organization.", args...),
+		},
+		constant.ErrScreeningAlertNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrScreeningAlertNotFound.Error(),
+			Title:      "Screening Alert Not Found",
+			Message:    "The screening alert does not exist for this organization.",
+		},
+		constant.ErrScreeningAlertAlreadyReviewed: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrScreeningAlertAlreadyReviewed.Error(),
+			Title:      "Screening Alert Already Reviewed",
+			Message:    fmt.Sprintf("The screening alert was already reviewed and is %v. Reviewed alerts cannot be decided again.", args...),
+		},
+		constant.ErrWatchListUploadConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrWatchListUploadConflict.Error(),
+			Title:      "Watch List Upload Conflict",
+			Message:    "The watch list was replaced by another upload while this one was processed. Check the current version and upload again if needed.",
+		},
+		constant.ErrHolderRelationshipNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipNotFound.Error(),
+			Title:      "Holder Relationship Not Found",
+			Message:    "No ownership or control relationship was found for the given ID. Please verify the holder and relationship IDs.",
+		},
+		constant.ErrHolderRelationshipSelf: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipSelf.Error(),
+			Title:      "Holder Relationship With Itself",
+			Message:    "A holder cannot own or control itself. Please provide a different owner.",
+		},
+		constant.ErrHolderRelationshipNotLegalPerson: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipNotLegalPerson.Error(),
+			Title:      "Holder Is Not a Legal Person",
+			Message:    fmt.Sprintf("Only legal-person holders can be owned or controlled. The holder is a %v.", args...),
+		},
+		constant.ErrHolderRelationshipPercentage: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipPercentage.Error(),
+			Title:      "Invalid Relationship Percentage",
+			Message:    fmt.Sprintf("An OWNERSHIP relationship requires a percentage greater than 0 and at most 100, and a CONTROL relationship takes none. Received type %v.", args...),
+		},
+		constant.ErrHolderRelationshipPeriodInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipPeriodInvalid.Error(),
+			Title:      "Invalid Relationship Period",
+			Message:    fmt.Sprintf("The relationship period is invalid: %v.", args...),
+		},
+		constant.ErrHolderRelationshipCycle: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipCycle.Error(),
+			Title:      "Circular Ownership",
+			Message:    fmt.Sprintf("The relationship would close an ownership or control cycle: holder %v is already owned or controlled, directly or indirectly, by holder %v.", args...),
+		},
+		constant.ErrHolderOwnershipExceeded: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderOwnershipExceeded.Error(),
+			Title:      "Ownership Exceeds 100%",
+			Message:    fmt.Sprintf("The ownership of the holder would add up to %v%% on %v. The recorded stakes of a holder cannot exceed 100%%.", args...),
+		},
+		constant.ErrHolderRelationshipAlreadyExists: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderRelationshipAlreadyExists.Error(),
+			Title:      "Holder Relationship Already Exists",
+			Message:    fmt.Sprintf("A %v relationship between these holders already covers part of the requested period. Update or end the existing relationship instead.", args...),
+		},
+		constant.ErrHolderExportNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderExportNotFound.Error(),
+			Title:      "Holder Export Not Found",
+			Message:    "No data export was found for the given ID. Please verify the holder and export IDs.",
+		},
+		constant.ErrHolderExportInProgress: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderExportInProgress.Error(),
+			Title:      "Holder Export In Progress",
+			Message:    fmt.Sprintf("Export %v of this holder is still being prepared. Wait for it to complete before requesting another one.", args...),
+		},
+		constant.ErrHolderExportNotReady: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderExportNotReady.Error(),
+			Title:      "Holder Export Not Ready",
+			Message:    fmt.Sprintf("The export archive cannot be downloaded while the export is %v. Only COMPLETED exports can be downloaded.", args...),
+		},
+		constant.ErrHolderExportExpired: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderExportExpired.Error(),
+			Title:      "Holder Export Expired",
+			Message:    "The export archive has expired and was removed. Please request a new export.",
+		},
+		constant.ErrHolderMerged: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderMerged.Error(),
+			Title:      "Holder Merged",
+			Message:    fmt.Sprintf("Holder %v was merged into holder %v as a duplicate. Please use the surviving holder.", args...),
+		},
+		constant.ErrHolderMergeSelf: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderMergeSelf.Error(),
+			Title:      "Holder Merge With Itself",
+			Message:    "A holder cannot be merged into itself. Please provide a different duplicate holder.",
+		},
+		constant.ErrHolderMergeTypeMismatch: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderMergeTypeMismatch.Error(),
+			Title:      "Holder Merge Type Mismatch",
+			Message:    "A natural person and a legal person cannot be merged. Both holders must be of the same type.",
+		},
+		constant.ErrHolderMergeConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderMergeConflict.Error(),
+			Title:      "Holder Merge Conflict",
+			Message:    fmt.Sprintf("Holder %v is already being merged into holder %v. Complete that merge before merging it elsewhere.", args...),
+		},
+		constant.ErrHolderMergeHasRelationships: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderMergeHasRelationships.Error(),
+			Title:      "Holder Merge Blocked By Relationships",
+			Message:    "The duplicate holder still has ownership or control relationships. End them and record them on the surviving holder before merging.",
+		},
+		constant.ErrInstrumentTransitionInvalid: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrInstrumentTransitionInvalid.Error(),
+			Title:      "Instrument Transition Invalid",
+			Message:    fmt.Sprintf("The instrument is %v and cannot be %v.", args...),
+		},
+		constant.ErrInstrumentLifecycleConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrInstrumentLifecycleConflict.Error(),
+			Title:      "Instrument Lifecycle Conflict",
+			Message:    "The instrument's lifecycle was changed by another request. Reload it and try again.",
+		},
+		constant.ErrInstrumentAccountBalanceNotZero: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrInstrumentAccountBalanceNotZero.Error(),
+			Title:      "Instrument Account Balance Not Zero",
+			Message:    "The ledger account linked to the instrument still holds funds. Move the available and on-hold amounts out of every balance before closing the instrument.",
+		},
 		constant.ErrCalculationFieldOfFeeRequired: ValidationError{
 			EntityType: entityType,
 			Code:       constant.ErrCalculationFieldOfFeeRequired.Error(),
@@ -1677,13 +1923,13 @@ func ValidateBusinessError(err error, entityType string, args ...any) error {
 			EntityType: entityType,
 			Code:       constant.ErrReferenceAmountInvalid.Error(),
 			Title:      "referenceAmount is not valid",
-			Message:    "Field reference amount must be originalAmount or afterFeesAmount.",
+			Message:    "Field reference amount must be originalAmount, afterFeesAmount or feeAmount.",
 		},
 		constant.ErrAppRuleInvalid: ValidationError{
 			EntityType: entityType,
 			Code:       constant.ErrAppRuleInvalid.Error(),
 			Title:      "Invalid applicationRule",
-			Message:    "Field application rule must be maxBetweenTypes, flatFee or percentual.",
+			Message:    "Field application rule must be maxBetweenTypes, flatFee, percentual or progressive.",
 		},
 		constant.ErrCalculationTypeInvalid: ValidationError{
 			EntityType: entityType,
@@ -2880,6 +3126,360 @@ func ValidateBusinessError(err error, entityType string, args ...any) error {
 			Title:      "Reservation Already Terminal",
 			Message:    "Reservation: reservation is already in a terminal state.",
 		},
+		constant.ErrTransactionTypeNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeNotFound.Error(),
+			Title:      "Transaction Type Not Found",
+			Message:    "Transaction type not found in the catalog.",
+		},
+		constant.ErrTransactionTypeAlreadyExists: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeAlreadyExists.Error(),
+			Title:      "Transaction Type Already Exists",
+			Message:    "A transaction type with this code is already registered in the catalog.",
+		},
+		constant.ErrTransactionTypeInvalidCode: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeInvalidCode.Error(),
+			Title:      "Transaction Type Invalid Code",
+			Message:    "Transaction type code must start with an uppercase letter and contain only A-Z, 0-9 and underscores (2-32 characters).",
+		},
+		constant.ErrTransactionTypeNotRegistered: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeNotRegistered.Error(),
+			Title:      "Transaction Type Not Registered",
+			Message:    "transactionType is not registered or is inactive in the transaction-type catalog.",
+		},
+		constant.ErrTransactionTypeSubTypeNotAllowed: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeSubTypeNotAllowed.Error(),
+			Title:      "Transaction Type SubType Not Allowed",
+			Message:    "subType is not allowed for this transactionType in the transaction-type catalog.",
+		},
+		constant.ErrTransactionTypeInvalidSubTypes: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeInvalidSubTypes.Error(),
+			Title:      "Transaction Type Invalid SubTypes",
+			Message:    "subTypes must be unique, non-empty values of at most 50 characters (maximum 100 entries).",
+		},
+		constant.ErrTransactionTypeInvalidStatus: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeInvalidStatus.Error(),
+			Title:      "Transaction Type Invalid Status",
+			Message:    "Transaction type status must be one of ACTIVE, INACTIVE.",
+		},
+		constant.ErrTransactionTypeDescriptionTooLong: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrTransactionTypeDescriptionTooLong.Error(),
+			Title:      "Transaction Type Description Too Long",
+			Message:    "Transaction type description must be at most 1000 characters.",
+		},
+		constant.ErrValidationOutcomeNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrValidationOutcomeNotFound.Error(),
+			Title:      "Validation Outcome Not Found",
+			Message:    "No outcome label has been recorded for this transaction validation.",
+		},
+		constant.ErrInvalidOutcomeLabel: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrInvalidOutcomeLabel.Error(),
+			Title:      "Invalid Outcome Label",
+			Message:    "label must be one of CONFIRMED_FRAUD, CHARGEBACK, FALSE_POSITIVE, CUSTOMER_CONFIRMED.",
+		},
+		constant.ErrOutcomeNoteTooLong: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrOutcomeNoteTooLong.Error(),
+			Title:      "Outcome Note Too Long",
+			Message:    "Outcome note must be at most 1000 characters.",
+		},
+		constant.ErrInvalidOutcomeReportFilters: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrInvalidOutcomeReportFilters.Error(),
+			Title:      "Invalid Outcome Report Filters",
+			Message:    "Invalid outcome report parameters. start_date must not be after end_date and the window must not exceed 366 days.",
+		},
+		constant.ErrInvalidConfigBundle: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrInvalidConfigBundle.Error(),
+			Title:      "Invalid Configuration Bundle",
+			Message:    fmt.Sprintf("The configuration bundle is invalid: %v. Check apiVersion and kind, that every rule and limit has a name and a DRAFT, ACTIVE or INACTIVE status, and that no entry is declared twice.", args...),
+		},
+		constant.ErrConfigBundleImmutableChange: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrConfigBundleImmutableChange.Error(),
+			Title:      "Immutable Field Changed",
+			Message:    "The bundle changes the limitType or currency of an existing limit, or removes its active time window. These cannot be changed in place; declare the new configuration under another limit name instead.",
+		},
+		constant.ErrConfigBundleInvalidExpression: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrConfigBundleInvalidExpression.Error(),
+			Title:      "Invalid Rule Expression",
+			Message:    "The bundle declares a rule expression that does not compile. The plan lists the failing rules and the compiler error; fix the expressions before applying.",
+		},
+		constant.ErrConfigBundlePlanOutdated: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrConfigBundlePlanOutdated.Error(),
+			Title:      "Plan Outdated",
+			Message:    "The rules or limits changed since the plan was computed. Review a fresh plan and apply it with the new fingerprint.",
+		},
+		constant.ErrValidationInvalidDevice: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrValidationInvalidDevice.Error(),
+			Title:      "Validation Invalid Device",
+			Message:    "Device.fingerprint is required when device is provided (max 255 characters). device.ipAddress must be a valid IPv4 or IPv6 address, device.ipCountry ISO 3166-1 alpha-2, and device.userAgent at most 512 characters.",
+		},
+		constant.ErrValidationInvalidGeolocation: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrValidationInvalidGeolocation.Error(),
+			Title:      "Validation Invalid Geolocation",
+			Message:    "Geolocation.country must be ISO 3166-1 alpha-2. Latitude (-90 to 90) and longitude (-180 to 180) must be sent together, including in geolocation.lastKnown.",
+		},
+		constant.ErrValidationInvalidCounterparty: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrValidationInvalidCounterparty.Error(),
+			Title:      "Validation Invalid Counterparty",
+			Message:    "Counterparty needs an accountId or a document. Identifiers are limited to 100 characters, counterparty.name to 255, and counterparty.country must be ISO 3166-1 alpha-2.",
+		},
+		constant.ErrAlertPolicyNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyNotFound.Error(),
+			Title:      "Alert Policy Not Found",
+			Message:    "The alert policy could not be found. Please verify the ID and try again.",
+		},
+		constant.ErrAlertPolicyInvalidName: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidName.Error(),
+			Title:      "Alert Policy Invalid Name",
+			Message:    "Alert policy name is required and must be at most 255 characters.",
+		},
+		constant.ErrAlertPolicyInvalidDecision: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidDecision.Error(),
+			Title:      "Alert Policy Invalid Decision",
+			Message:    "Alert policy decision must be one of ALLOW, DENY, REVIEW.",
+		},
+		constant.ErrAlertPolicyInvalidThreshold: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidThreshold.Error(),
+			Title:      "Alert Policy Invalid Threshold",
+			Message:    "Alert policy threshold is a rate and must be greater than 0 and at most 1.",
+		},
+		constant.ErrAlertPolicyInvalidWindow: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidWindow.Error(),
+			Title:      "Alert Policy Invalid Window",
+			Message:    "Alert policy windowSeconds must be between 60 and 86400.",
+		},
+		constant.ErrAlertPolicyInvalidMinSamples: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidMinSamples.Error(),
+			Title:      "Alert Policy Invalid Minimum Samples",
+			Message:    "Alert policy minSamples must be between 1 and 1000000.",
+		},
+		constant.ErrAlertPolicyAutoDeactivateRequiresRule: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyAutoDeactivateRequiresRule.Error(),
+			Title:      "Alert Policy Auto-Deactivate Requires Rule",
+			Message:    "autoDeactivate can only be enabled on a policy that targets a rule (ruleId).",
+		},
+		constant.ErrAlertPolicyInvalidScope: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidScope.Error(),
+			Title:      "Alert Policy Invalid Scope",
+			Message:    "Alert policy scope must set at least one field with a valid value.",
+		},
+		constant.ErrAlertPolicyInvalidStatus: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyInvalidStatus.Error(),
+			Title:      "Alert Policy Invalid Status",
+			Message:    "Alert policy status must be one of ACTIVE, INACTIVE.",
+		},
+		constant.ErrAlertPolicyDescriptionTooLong: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrAlertPolicyDescriptionTooLong.Error(),
+			Title:      "Alert Policy Description Too Long",
+			Message:    "Alert policy description must be at most 1000 characters.",
+		},
+		constant.ErrBillingRunNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrBillingRunNotFound.Error(),
+			Title:      "Billing Run Not Found",
+			Message:    fmt.Sprintf("No billing run was found for the given ID '%v'.", args...),
+		},
+		constant.ErrPackageVersionNotInFuture: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrPackageVersionNotInFuture.Error(),
+			Title:      "Package Version Not In Future",
+			Message:    "Only future package versions can be scheduled or cancelled. Versions already in force are immutable; use 'effectiveFrom' later than the current time.",
+		},
+		constant.ErrPackageVersionNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrPackageVersionNotFound.Error(),
+			Title:      "Package Version Not Found",
+			Message:    fmt.Sprintf("No version '%v' was found for the given package.", args...),
+		},
+		constant.ErrPackageVersionConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrPackageVersionConflict.Error(),
+			Title:      "Package Version Conflict",
+			Message:    "A package version with the same 'effectiveFrom' already exists. Cancel it before scheduling a replacement.",
+		},
+		constant.ErrProgressiveBandsInvalid: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrProgressiveBandsInvalid.Error(),
+			Title:      "Failed to apply rule: progressive",
+			Message:    fmt.Sprintf("applicationRule progressive must list its bands with strictly increasing positive 'upTo' limits, leaving only the last band without 'upTo', for Fee %v.", args...),
+		},
+		constant.ErrCalculationUpToNotAllowed: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrCalculationUpToNotAllowed.Error(),
+			Title:      "Calculation upTo not allowed",
+			Message:    fmt.Sprintf("Field 'upTo' is only allowed on calculations of applicationRule progressive. Please check the calculations for Fee %v.", args...),
+		},
+		constant.ErrMinFeeGreaterThanMaxFee: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrMinFeeGreaterThanMaxFee.Error(),
+			Title:      "minimumFee greater than maximumFee",
+			Message:    fmt.Sprintf("minimumFee value is greater than maximumFee for Fee %v.", args...),
+		},
+		constant.ErrBaseFeeInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrBaseFeeInvalid.Error(),
+			Title:      "Invalid baseFee",
+			Message:    fmt.Sprintf("Fee %v is invalid: referenceAmount feeAmount requires 'baseFee' to name another fee of the same package, and 'baseFee' is only allowed with referenceAmount feeAmount.", args...),
+		},
+		constant.ErrFeeDependencyCycle: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeDependencyCycle.Error(),
+			Title:      "Fee dependency cycle",
+			Message:    fmt.Sprintf("Fee %v is calculated on itself through its 'baseFee' chain. Fees calculated on other fees must not form a cycle.", args...),
+		},
+		constant.ErrFeeDependencyOrder: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeDependencyOrder.Error(),
+			Title:      "Fee dependency order",
+			Message:    fmt.Sprintf("Fee %v must have a higher priority than its baseFee %v so that it is calculated after it.", args...),
+		},
+		constant.ErrChainedFeeDestinationConflict: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrChainedFeeDestinationConflict.Error(),
+			Title:      "Chained fee destination conflict",
+			Message:    fmt.Sprintf("Fee %v must use a different creditAccount and routes than its baseFee %v.", args...),
+		},
+		constant.ErrReversalPolicyInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrReversalPolicyInvalid.Error(),
+			Title:      "Invalid reversal policy",
+			Message:    "The reversalPolicy is invalid: the mode must be refundAll, keepFees or chargeReversalFee; mode chargeReversalFee requires a positive 'reversalFee' and a 'reversalFeeCreditAccount', and both are only allowed with that mode.",
+		},
+		constant.ErrReversalPolicyUnknownFee: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrReversalPolicyUnknownFee.Error(),
+			Title:      "Unknown non-refundable fee",
+			Message:    fmt.Sprintf("The reversalPolicy lists %v as non-refundable, but the package has no fee with that key.", args...),
+		},
+		constant.ErrReversalFeeExceedsRefund: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrReversalFeeExceedsRefund.Error(),
+			Title:      "Reversal fee exceeds refund",
+			Message:    "The reversal fee is greater than the amount the revert returns to the payer. Please review the package reversal policy.",
+		},
+		constant.ErrPromotionTargetInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrPromotionTargetInvalid.Error(),
+			Title:      "Invalid promotion target",
+			Message:    "A promotion must target exactly one of 'accountAlias' or 'holderId'.",
+		},
+		constant.ErrPromotionLimitInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrPromotionLimitInvalid.Error(),
+			Title:      "Invalid promotion limit",
+			Message:    "A promotion may set either a positive 'freeTransactions' or a positive 'feeCap', but not both.",
+		},
+		constant.ErrPromotionWindowInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrPromotionWindowInvalid.Error(),
+			Title:      "Invalid promotion window",
+			Message:    "The promotion 'endsAt' must be in the future and after 'startsAt'.",
+		},
+		constant.ErrPromotionNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrPromotionNotFound.Error(),
+			Title:      "Promotion Not Found",
+			Message:    fmt.Sprintf("No promotion '%v' was found for the given package.", args...),
+		},
+		constant.ErrFeeQuoteNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeQuoteNotFound.Error(),
+			Title:      "Fee Quote Not Found",
+			Message:    fmt.Sprintf("No fee quote '%v' was found for the given organization.", args...),
+		},
+		constant.ErrFeeQuoteExpired: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeQuoteExpired.Error(),
+			Title:      "Fee Quote Expired",
+			Message:    fmt.Sprintf("The fee quote '%v' has expired. Request a new quote and try again.", args...),
+		},
+		constant.ErrFeeQuoteMismatch: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeQuoteMismatch.Error(),
+			Title:      "Fee Quote Mismatch",
+			Message:    fmt.Sprintf("The transaction does not match the fee quote '%v'. The ledger, asset, value, sources and destinations must be the ones that were quoted.", args...),
+		},
+		constant.ErrFeeQuoteAlreadyUsed: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeQuoteAlreadyUsed.Error(),
+			Title:      "Fee Quote Already Used",
+			Message:    fmt.Sprintf("The fee quote '%v' was already used by another transaction. Request a new quote and try again.", args...),
+		},
+		constant.ErrFeeRevenueRangeInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeRevenueRangeInvalid.Error(),
+			Title:      "Invalid Fee Revenue Range",
+			Message:    "The report range is invalid. 'from' must be before 'to' and the range must not exceed 366 days.",
+		},
+		constant.ErrFeeAssetInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeAssetInvalid.Error(),
+			Title:      "Invalid Fee Asset",
+			Message:    fmt.Sprintf("The field '%v' must be an asset code made of uppercase letters only.", args...),
+		},
+		constant.ErrFeeAssetValuesInvalid: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeAssetValuesInvalid.Error(),
+			Title:      "Invalid Per-Asset Values",
+			Message:    fmt.Sprintf("The fee '%v' sets 'assetValues' on a percentage calculation. Per-asset values are only accepted on flat calculations.", args...),
+		},
+		constant.ErrFeeAssetOutsidePackage: ValidationError{
+			EntityType: entityType,
+			Code:       constant.ErrFeeAssetOutsidePackage.Error(),
+			Title:      "Fee Asset Outside Package",
+			Message:    fmt.Sprintf("The fee '%v' is scoped to asset '%v', but its package only applies to asset '%v'.", args...),
+		},
+		constant.ErrBillingInvoiceNotFound: EntityNotFoundError{
+			EntityType: entityType,
+			Code:       constant.ErrBillingInvoiceNotFound.Error(),
+			Title:      "Billing Invoice Not Found",
+			Message:    fmt.Sprintf("No billing invoice was found for the given ID '%v'.", args...),
+		},
+		constant.ErrBillingInvoiceTransitionInvalid: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrBillingInvoiceTransitionInvalid.Error(),
+			Title:      "Billing Invoice Transition Invalid",
+			Message:    fmt.Sprintf("The billing invoice '%v' is %v and cannot be %v.", args...),
+		},
+		constant.ErrBillingInvoiceRevisionConflict: EntityConflictError{
+			EntityType: entityType,
+			Code:       constant.ErrBillingInvoiceRevisionConflict.Error(),
+			Title:      "Billing Invoice Revision Conflict",
+			Message:    fmt.Sprintf("The billing invoice '%v' was changed by another operation. Reload it and retry.", args...),
+		},
+		constant.ErrHolderNotVerified: UnprocessableOperationError{
+			EntityType: entityType,
+			Code:       constant.ErrHolderNotVerified.Error(),
+			Title:      "Holder Not Verified",
+			Message:    fmt.Sprintf("The holder of account '%v' is not KYC-verified at level %v, which this ledger requires for transactions. Complete the holder's verification and try again.", args...),
+		},
 	}
 
 	if mappedError, found := errorMap[err]; found {
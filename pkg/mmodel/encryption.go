@@ -4,7 +4,10 @@
 
 package mmodel
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // ProvisionEncryptionInput represents the input for provisioning an organization for envelope encryption.
 type ProvisionEncryptionInput struct {
@@ -50,3 +53,125 @@ type ProvisioningStatusResponse struct {
 	// Whether the organization has been provisioned for envelope encryption.
 	Provisioned bool `json:"provisioned" example:"true"`
 }
+
+// RotateEncryptionKeysInput represents the input for rotating an organization's encryption keysets.
+type RotateEncryptionKeysInput struct {
+	// The actor performing the rotation.
+	Actor string `json:"actor" validate:"required" example:"admin@example.com"`
+	// The reason for rotating the keysets.
+	Reason string `json:"reason" validate:"required" example:"Annual key rotation"`
+}
+
+// Validate validates the rotate encryption keys input.
+func (p *RotateEncryptionKeysInput) Validate() error {
+	if p.Actor == "" {
+		return errors.New("actor is required")
+	}
+
+	if p.Reason == "" {
+		return errors.New("reason is required")
+	}
+
+	return nil
+}
+
+// RetireEncryptionKeysInput represents the input for retiring an organization's superseded keyset versions.
+type RetireEncryptionKeysInput struct {
+	// The actor performing the retirement.
+	Actor string `json:"actor" validate:"required" example:"admin@example.com"`
+	// The reason for retiring the superseded versions.
+	Reason string `json:"reason" validate:"required" example:"Re-encryption completed"`
+}
+
+// Validate validates the retire encryption keys input.
+func (p *RetireEncryptionKeysInput) Validate() error {
+	if p.Actor == "" {
+		return errors.New("actor is required")
+	}
+
+	if p.Reason == "" {
+		return errors.New("reason is required")
+	}
+
+	return nil
+}
+
+// KeyRotationTargetResponse reports the re-encryption progress of one record collection.
+type KeyRotationTargetResponse struct {
+	// The record collection being re-encrypted.
+	Name string `json:"name" example:"holders"`
+	// Records that referenced an older keyset version when the sweep started.
+	Total int64 `json:"total" example:"1200"`
+	// Records rewritten to the new keyset version.
+	Processed int64 `json:"processed" example:"800"`
+	// Records the sweep could not rewrite; they keep their old version.
+	Skipped int64 `json:"skipped" example:"0"`
+	// Whether the collection has been fully swept.
+	Done bool `json:"done" example:"false"`
+}
+
+// KeyRotationResponse represents an organization key rotation and its re-encryption progress.
+type KeyRotationResponse struct {
+	// The unique identifier of the organization.
+	OrganizationID string `json:"organization_id" example:"00000000-0000-0000-0000-000000000000"`
+	// The keyset version that was superseded.
+	FromVersion int `json:"from_version" example:"1"`
+	// The keyset version new writes use.
+	ToVersion int `json:"to_version" example:"2"`
+	// The rotation status: running, completed or failed.
+	Status string `json:"status" example:"running"`
+	// Re-encryption progress per record collection.
+	Targets []KeyRotationTargetResponse `json:"targets"`
+	// Static code of the last sweep failure, if any.
+	LastErrorCode string `json:"last_error_code,omitempty" example:"reencryption_failed"`
+	// Who started the rotation.
+	StartedBy string `json:"started_by" example:"admin@example.com"`
+	// When the rotation started.
+	StartedAt time.Time `json:"started_at" example:"2026-01-01T00:00:00Z"`
+	// When the progress was last updated.
+	UpdatedAt time.Time `json:"updated_at" example:"2026-01-01T00:05:00Z"`
+	// When the re-encryption completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty" example:"2026-01-01T00:10:00Z"`
+}
+
+// NewKeyRotationResponse builds the API view of a key rotation.
+func NewKeyRotationResponse(r *OrganizationKeyRotation) *KeyRotationResponse {
+	targets := make([]KeyRotationTargetResponse, len(r.Targets))
+	for i, t := range r.Targets {
+		targets[i] = KeyRotationTargetResponse{
+			Name:      t.Name,
+			Total:     t.Total,
+			Processed: t.Processed,
+			Skipped:   t.Skipped,
+			Done:      t.Done,
+		}
+	}
+
+	return &KeyRotationResponse{
+		OrganizationID: r.OrganizationID,
+		FromVersion:    r.FromVersion,
+		ToVersion:      r.ToVersion,
+		Status:         string(r.Status),
+		Targets:        targets,
+		LastErrorCode:  r.LastErrorCode,
+		StartedBy:      r.StartedBy,
+		StartedAt:      r.StartedAt,
+		UpdatedAt:      r.UpdatedAt,
+		CompletedAt:    r.CompletedAt,
+	}
+}
+
+// RetireEncryptionKeysResponse represents the outcome of a keyset retirement request.
+type RetireEncryptionKeysResponse struct {
+	// The unique identifier of the organization.
+	OrganizationID string `json:"organization_id" example:"00000000-0000-0000-0000-000000000000"`
+	// The keyset version new writes use.
+	CurrentVersion int `json:"current_version" example:"2"`
+	// The keyset versions retired by this request.
+	RetiredVersions []int `json:"retired_versions" example:"[1]"`
+	// The keyset versions that remain readable.
+	ReadableVersions []int `json:"readable_versions" example:"[2]"`
+	// Records per collection still referencing a superseded version. Retirement
+	// only happens when every count is zero.
+	StaleRecords map[string]int64 `json:"stale_records"`
+}
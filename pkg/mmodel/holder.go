@@ -151,6 +151,24 @@ type Holder struct {
 	// example: null
 	// format: date-time
 	DeletedAt *time.Time `json:"deletedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp when the holder's personal data was erased; absent unless the holder was erased (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ErasedAt *time.Time `json:"erasedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// KYC verification state of the holder; absent until a verification case is opened.
+	KYC *HolderKYC `json:"kyc,omitempty"`
+
+	// Holder this holder was merged into as a duplicate; absent unless the holder was merged.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	MergedInto *uuid.UUID `json:"mergedInto,omitempty" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Timestamp when the holder was merged into another holder (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	MergedAt *time.Time `json:"mergedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
 }
 
 // Addresses is a struct designed to store addresses data.
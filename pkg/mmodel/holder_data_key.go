@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/LerianStudio/midaz/v4/pkg/constant"
+)
+
+// Re-export errors from constant package for consistency with the keyset and
+// rotation models.
+var (
+	ErrHolderDataKeyNotFound      = constant.ErrHolderDataKeyNotFound
+	ErrHolderDataKeyAlreadyExists = constant.ErrHolderDataKeyAlreadyExists
+)
+
+// HolderDataKey is the per-holder data key that encrypts a holder's protected
+// fields (and those of the holder's instruments) in envelope mode.
+//
+// WrappedKey is the holder's serialized AEAD keyset encrypted with the
+// organization keyset at KeysetVersion; it is never stored in clear. Destroying
+// the key (crypto-shredding) clears WrappedKey and stamps DestroyedAt, leaving a
+// tombstone so the holder's remaining ciphertext — including copies in backups —
+// can never be decrypted again and no new key is created for the holder.
+type HolderDataKey struct {
+	TenantID       string
+	OrganizationID string
+	HolderID       string
+	KeysetVersion  int
+	WrappedKey     []byte
+	Revision       int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DestroyedAt    *time.Time
+	DestroyedBy    string
+}
+
+// NewHolderDataKey creates a live holder data key wrapped with the organization
+// keyset at keysetVersion.
+func NewHolderDataKey(tenantID, organizationID, holderID string, keysetVersion int, wrappedKey []byte) (*HolderDataKey, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	organizationID = strings.TrimSpace(organizationID)
+	holderID = strings.TrimSpace(holderID)
+
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant_id is required")
+	}
+
+	if organizationID == "" {
+		return nil, fmt.Errorf("organization_id is required")
+	}
+
+	if holderID == "" {
+		return nil, fmt.Errorf("holder_id is required")
+	}
+
+	if keysetVersion < 1 {
+		return nil, fmt.Errorf("keyset_version must be positive")
+	}
+
+	if len(wrappedKey) == 0 {
+		return nil, fmt.Errorf("wrapped_key is required")
+	}
+
+	now := time.Now().UTC()
+
+	return &HolderDataKey{
+		TenantID:       tenantID,
+		OrganizationID: organizationID,
+		HolderID:       holderID,
+		KeysetVersion:  keysetVersion,
+		WrappedKey:     wrappedKey,
+		Revision:       1,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// IsDestroyed reports whether the key was crypto-shredded.
+func (k *HolderDataKey) IsDestroyed() bool {
+	return k.DestroyedAt != nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EraseHolderInput is a struct designed to encapsulate the right-to-erasure request payload.
+type EraseHolderInput struct {
+	// The actor requesting the erasure (e.g., the data protection officer).
+	// required: true
+	// example: dpo@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"dpo@example.com" maxLength:"256"`
+
+	// Internal reference of the erasure request (e.g., the data subject request ticket).
+	// It is not copied to the audit trail, which may outlive the request.
+	// required: true
+	// example: DSR-2026-0042
+	// maxLength: 256
+	Reason string `json:"reason" validate:"required,max=256" example:"DSR-2026-0042" maxLength:"256"`
+}
+
+// HolderErasure is the outcome of a holder erasure.
+type HolderErasure struct {
+	// Unique identifier of the erased holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Unique identifier of the organization (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	OrganizationID string `json:"organizationId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Whether the holder's data key was destroyed (crypto-shredding). False when
+	// the organization does not use envelope encryption; the stored personal data
+	// is still removed.
+	// example: true
+	KeyDestroyed bool `json:"keyDestroyed" example:"true"`
+
+	// Number of the holder's instruments whose personal data was removed.
+	// example: 2
+	InstrumentsErased int64 `json:"instrumentsErased" example:"2"`
+
+	// Number of related-party entries naming the holder that were removed from
+	// instruments of other holders.
+	// example: 1
+	RelatedPartiesErased int64 `json:"relatedPartiesErased" example:"1"`
+
+	// Timestamp of the first successful erasure of the holder (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ErasedAt time.Time `json:"erasedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Holder export statuses. An export is PENDING until the background job picks
+// it up, RUNNING while the archive is assembled, and ends COMPLETED or FAILED.
+const (
+	HolderExportPending   = "PENDING"
+	HolderExportRunning   = "RUNNING"
+	HolderExportCompleted = "COMPLETED"
+	HolderExportFailed    = "FAILED"
+)
+
+// HolderExportContentType is the media type of a holder export archive.
+const HolderExportContentType = "application/zip"
+
+// CreateHolderExportInput is a struct designed to encapsulate the subject access
+// request payload.
+type CreateHolderExportInput struct {
+	// The actor requesting the export (e.g., the data protection officer).
+	// required: true
+	// example: dpo@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"dpo@example.com" maxLength:"256"`
+}
+
+// HolderExport is a data export of a holder: the job assembling the archive of
+// all data held about the holder, and the archive once it is ready.
+//
+// swagger:model HolderExport
+// @Description HolderExport is a data export of a holder.
+type HolderExport struct {
+	// Unique identifier of the export (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	ID uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Unique identifier of the exported holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Status of the export.
+	// example: COMPLETED
+	Status string `json:"status" example:"COMPLETED" enum:"PENDING,RUNNING,COMPLETED,FAILED"`
+
+	// The actor who requested the export.
+	// example: dpo@example.com
+	RequestedBy string `json:"requestedBy" example:"dpo@example.com"`
+
+	// Number of records of each kind included in the archive.
+	Counts HolderExportCounts `json:"counts"`
+
+	// Size of the archive in bytes, once completed.
+	// example: 48213
+	ArchiveSize int64 `json:"archiveSize,omitempty" example:"48213"`
+
+	// Hex-encoded SHA-256 digest of the archive, once completed.
+	// example: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+	ArchiveSHA256 string `json:"archiveSha256,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+
+	// Static code of the step that failed, when the export failed.
+	// example: accounts_unreadable
+	LastErrorCode string `json:"lastErrorCode,omitempty" example:"accounts_unreadable"`
+
+	// Timestamp of the request (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp at which the export completed or failed (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CompletedAt *time.Time `json:"completedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp after which the archive is removed (RFC3339 format).
+	// example: 2025-01-08T00:00:00Z
+	// format: date-time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" example:"2025-01-08T00:00:00Z" format:"date-time"`
+}
+
+// Active reports whether the export is still being prepared.
+func (e *HolderExport) Active() bool {
+	return e.Status == HolderExportPending || e.Status == HolderExportRunning
+}
+
+// HolderExportCounts counts the records of each kind in an export archive.
+type HolderExportCounts struct {
+	// example: 2
+	Instruments int64 `json:"instruments" example:"2"`
+	// Entries in which the holder appears as a related party of an instrument,
+	// its own instruments excluded.
+	// example: 1
+	RelatedPartyEntries int64 `json:"relatedPartyEntries" example:"1"`
+	// example: 3
+	Accounts int64 `json:"accounts" example:"3"`
+	// example: 3
+	Balances int64 `json:"balances" example:"3"`
+	// example: 120
+	Transactions int64 `json:"transactions" example:"120"`
+}
+
+// HolderExportOperation is one operation on an account of an exported holder,
+// written as a row of the archive's transactions CSV.
+type HolderExportOperation struct {
+	TransactionID string
+	OperationID   string
+	CreatedAt     time.Time
+	LedgerID      string
+	AccountID     string
+	AccountAlias  string
+	BalanceKey    string
+	Type          string
+	Direction     string
+	AssetCode     string
+	Amount        string
+	Status        string
+	Description   string
+}
+
+// HolderRelatedPartyEntry is an instrument entry in which an exported holder
+// appears as a related party.
+type HolderRelatedPartyEntry struct {
+	InstrumentID uuid.UUID     `json:"instrumentId"`
+	HolderID     uuid.UUID     `json:"holderId"`
+	RelatedParty *RelatedParty `json:"relatedParty"`
+}
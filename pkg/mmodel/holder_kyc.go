@@ -0,0 +1,367 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KYC verification statuses. A holder without a KYC record is PENDING.
+//
+//	PENDING ──submit──▶ IN_REVIEW ──approve──▶ VERIFIED ──expiry──▶ EXPIRED
+//	   ▲                    │                     │                    │
+//	   │                    └──reject──▶ REJECTED │                    │
+//	   └───────────────open─────────────┴─────────┴────────────────────┘
+const (
+	KYCStatusPending  = "PENDING"
+	KYCStatusInReview = "IN_REVIEW"
+	KYCStatusVerified = "VERIFIED"
+	KYCStatusRejected = "REJECTED"
+	KYCStatusExpired  = "EXPIRED"
+)
+
+// KYC verification levels, from the least to the most demanding. A holder
+// verified at a level also satisfies every lower level.
+const (
+	KYCLevelBasic    = "BASIC"
+	KYCLevelStandard = "STANDARD"
+	KYCLevelEnhanced = "ENHANCED"
+)
+
+// KYC reviewer decisions.
+const (
+	KYCDecisionApprove = "APPROVE"
+	KYCDecisionReject  = "REJECT"
+)
+
+// KYC evidence document types.
+const (
+	KYCDocumentIdentity                = "IDENTITY_DOCUMENT"
+	KYCDocumentProofOfAddress          = "PROOF_OF_ADDRESS"
+	KYCDocumentSourceOfFunds           = "SOURCE_OF_FUNDS"
+	KYCDocumentArticlesOfIncorporation = "ARTICLES_OF_INCORPORATION"
+	KYCDocumentRepresentativeIdentity  = "REPRESENTATIVE_IDENTITY"
+	KYCDocumentOwnershipStructure      = "OWNERSHIP_STRUCTURE"
+)
+
+// Holder types with a KYC checklist.
+const (
+	HolderTypeNaturalPerson = "NATURAL_PERSON"
+	HolderTypeLegalPerson   = "LEGAL_PERSON"
+)
+
+// kycLevels lists the verification levels in ascending order of rigor.
+var kycLevels = []string{KYCLevelBasic, KYCLevelStandard, KYCLevelEnhanced}
+
+// kycChecklists holds the documents each level adds, per holder type. Levels
+// are cumulative: a STANDARD verification also requires the BASIC documents.
+var kycChecklists = map[string]map[string][]string{
+	HolderTypeNaturalPerson: {
+		KYCLevelBasic:    {KYCDocumentIdentity},
+		KYCLevelStandard: {KYCDocumentProofOfAddress},
+		KYCLevelEnhanced: {KYCDocumentSourceOfFunds},
+	},
+	HolderTypeLegalPerson: {
+		KYCLevelBasic:    {KYCDocumentArticlesOfIncorporation},
+		KYCLevelStandard: {KYCDocumentRepresentativeIdentity, KYCDocumentProofOfAddress},
+		KYCLevelEnhanced: {KYCDocumentOwnershipStructure, KYCDocumentSourceOfFunds},
+	},
+}
+
+// kycReverificationPeriods is the default validity of a verification per
+// level, after which the holder must be verified again. More demanding levels
+// are reviewed more often.
+var kycReverificationPeriods = map[string]time.Duration{
+	KYCLevelBasic:    3 * 365 * 24 * time.Hour,
+	KYCLevelStandard: 2 * 365 * 24 * time.Hour,
+	KYCLevelEnhanced: 365 * 24 * time.Hour,
+}
+
+// KYCLevelRank returns the position of level in the ascending level order, or
+// -1 for an unknown level.
+func KYCLevelRank(level string) int {
+	return slices.Index(kycLevels, level)
+}
+
+// KYCLevelSatisfies reports whether a verification at level meets required.
+func KYCLevelSatisfies(level, required string) bool {
+	rank := KYCLevelRank(level)
+
+	return rank >= 0 && rank >= KYCLevelRank(required)
+}
+
+// KYCRequiredDocuments returns the document checklist of a holder type at a
+// level, or false when no checklist is defined for the type or level.
+func KYCRequiredDocuments(holderType, level string) ([]string, bool) {
+	checklist, ok := kycChecklists[holderType]
+	if !ok {
+		return nil, false
+	}
+
+	rank := KYCLevelRank(level)
+	if rank < 0 {
+		return nil, false
+	}
+
+	var required []string
+	for _, l := range kycLevels[:rank+1] {
+		required = append(required, checklist[l]...)
+	}
+
+	return required, true
+}
+
+// KYCReverificationDate returns the default re-verification date of a holder
+// verified at level on verifiedAt.
+func KYCReverificationDate(level string, verifiedAt time.Time) time.Time {
+	return verifiedAt.Add(kycReverificationPeriods[level])
+}
+
+// HolderKYC is the KYC verification state of a holder. It tracks the current
+// verification case (status, level and evidence) separately from the standing
+// verification (verified level and expiry), so a holder stays verified while a
+// re-verification is in progress until the standing verification expires or
+// the new case is rejected.
+type HolderKYC struct {
+	// Status of the current verification case.
+	// example: VERIFIED
+	Status string `json:"status" example:"VERIFIED" enums:"PENDING,IN_REVIEW,VERIFIED,REJECTED,EXPIRED"`
+
+	// Verification level requested by the current case.
+	// example: STANDARD
+	Level *string `json:"level,omitempty" example:"STANDARD" enums:"BASIC,STANDARD,ENHANCED"`
+
+	// Evidence submitted for the current case.
+	Documents []KYCDocument `json:"documents"`
+
+	// Level of the standing verification; absent when the holder is not verified.
+	// example: STANDARD
+	VerifiedLevel *string `json:"verifiedLevel,omitempty" example:"STANDARD" enums:"BASIC,STANDARD,ENHANCED"`
+
+	// Timestamp of the standing verification (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Re-verification date: the standing verification expires at this time (RFC3339 format).
+	// example: 2027-01-01T00:00:00Z
+	// format: date-time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" example:"2027-01-01T00:00:00Z" format:"date-time"`
+
+	// Every status transition of the holder's verification, oldest first.
+	History []KYCTransition `json:"history"`
+
+	// Revision of the KYC state, incremented on every change; used for optimistic concurrency.
+	// example: 4
+	Revision int64 `json:"revision" example:"4"`
+
+	// Timestamp of the last change (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// IsVerifiedAt reports whether the holder holds a standing verification that
+// meets the required level and has not expired at now.
+func (k *HolderKYC) IsVerifiedAt(required string, now time.Time) bool {
+	if k == nil || k.VerifiedLevel == nil || k.ExpiresAt == nil {
+		return false
+	}
+
+	return KYCLevelSatisfies(*k.VerifiedLevel, required) && now.Before(*k.ExpiresAt)
+}
+
+// KYCDocument is a piece of evidence submitted for a verification case. Only a
+// reference to the document is stored; the document itself stays in the
+// document store of record.
+type KYCDocument struct {
+	// Type of the document.
+	// example: IDENTITY_DOCUMENT
+	Type string `json:"type" example:"IDENTITY_DOCUMENT"`
+
+	// Reference of the document in the document store of record.
+	// example: dms://kyc/2025/0042.pdf
+	Reference string `json:"reference" example:"dms://kyc/2025/0042.pdf"`
+
+	// The actor who submitted the document.
+	// example: onboarding@example.com
+	SubmittedBy string `json:"submittedBy" example:"onboarding@example.com"`
+
+	// Timestamp of the submission (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	SubmittedAt time.Time `json:"submittedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// KYCTransition records one status transition of a holder's verification.
+type KYCTransition struct {
+	// Status before the transition; empty for the first case of a holder.
+	// example: IN_REVIEW
+	From string `json:"from" example:"IN_REVIEW"`
+
+	// Status after the transition.
+	// example: VERIFIED
+	To string `json:"to" example:"VERIFIED"`
+
+	// Verification level of the case at the time of the transition.
+	// example: STANDARD
+	Level string `json:"level,omitempty" example:"STANDARD"`
+
+	// The actor who caused the transition; "system" for expiries.
+	// example: compliance@example.com
+	Actor string `json:"actor" example:"compliance@example.com"`
+
+	// Reason given for the transition, if any.
+	// example: documents verified against the issuing registry
+	Reason string `json:"reason,omitempty" example:"documents verified against the issuing registry"`
+
+	// Timestamp of the transition (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// HolderKYCView is the KYC state of a holder together with the checklist of
+// the current case.
+type HolderKYCView struct {
+	// Unique identifier of the holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// External identifier of the holder, when set.
+	// example: G4K7N8M2
+	ExternalID *string `json:"externalId,omitempty" example:"G4K7N8M2"`
+
+	// Classification of the holder.
+	// example: NATURAL_PERSON
+	HolderType string `json:"holderType" example:"NATURAL_PERSON"`
+
+	HolderKYC
+
+	// Required documents of the current case and whether each was submitted.
+	Checklist []KYCChecklistItem `json:"checklist"`
+}
+
+// KYCChecklistItem is one required document of a verification case.
+type KYCChecklistItem struct {
+	// Type of the required document.
+	// example: PROOF_OF_ADDRESS
+	Type string `json:"type" example:"PROOF_OF_ADDRESS"`
+
+	// Whether a document of this type was submitted for the current case.
+	// example: true
+	Submitted bool `json:"submitted" example:"true"`
+}
+
+// OpenKYCInput opens a verification case at a level, e.g. for onboarding, a
+// level upgrade or a periodic re-verification.
+type OpenKYCInput struct {
+	// Verification level requested.
+	// required: true
+	// example: STANDARD
+	Level string `json:"level" validate:"required,oneof=BASIC STANDARD ENHANCED" example:"STANDARD" enums:"BASIC,STANDARD,ENHANCED"`
+
+	// The actor opening the case.
+	// required: true
+	// example: onboarding@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"onboarding@example.com" maxLength:"256"`
+
+	// Reason for opening the case.
+	// required: false
+	// example: periodic re-verification
+	// maxLength: 256
+	Reason string `json:"reason" validate:"max=256" example:"periodic re-verification" maxLength:"256"`
+}
+
+// SubmitKYCDocumentInput adds a document to a pending verification case. A
+// document of a type already submitted replaces the previous one.
+type SubmitKYCDocumentInput struct {
+	// Type of the document.
+	// required: true
+	// example: IDENTITY_DOCUMENT
+	Type string `json:"type" validate:"required,oneof=IDENTITY_DOCUMENT PROOF_OF_ADDRESS SOURCE_OF_FUNDS ARTICLES_OF_INCORPORATION REPRESENTATIVE_IDENTITY OWNERSHIP_STRUCTURE" example:"IDENTITY_DOCUMENT" enums:"IDENTITY_DOCUMENT,PROOF_OF_ADDRESS,SOURCE_OF_FUNDS,ARTICLES_OF_INCORPORATION,REPRESENTATIVE_IDENTITY,OWNERSHIP_STRUCTURE"`
+
+	// Reference of the document in the document store of record. It must not
+	// contain personal data.
+	// required: true
+	// example: dms://kyc/2025/0042.pdf
+	// maxLength: 512
+	Reference string `json:"reference" validate:"required,max=512" example:"dms://kyc/2025/0042.pdf" maxLength:"512"`
+
+	// The actor submitting the document.
+	// required: true
+	// example: onboarding@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"onboarding@example.com" maxLength:"256"`
+}
+
+// SubmitKYCInput sends a pending verification case to review.
+type SubmitKYCInput struct {
+	// The actor submitting the case.
+	// required: true
+	// example: onboarding@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"onboarding@example.com" maxLength:"256"`
+}
+
+// DecideKYCInput records a reviewer's decision on a case in review.
+type DecideKYCInput struct {
+	// Decision of the reviewer.
+	// required: true
+	// example: APPROVE
+	Decision string `json:"decision" validate:"required,oneof=APPROVE REJECT" example:"APPROVE" enums:"APPROVE,REJECT"`
+
+	// The reviewer; must differ from the actor who submitted the case.
+	// required: true
+	// example: compliance@example.com
+	// maxLength: 256
+	Reviewer string `json:"reviewer" validate:"required,max=256" example:"compliance@example.com" maxLength:"256"`
+
+	// Reason for the decision; required when rejecting.
+	// required: false
+	// example: proof of address older than three months
+	// maxLength: 256
+	Reason string `json:"reason" validate:"max=256" example:"proof of address older than three months" maxLength:"256"`
+
+	// Re-verification date overriding the level's default validity; must be in the future (RFC3339 format).
+	// required: false
+	// example: 2026-06-30T00:00:00Z
+	// format: date-time
+	ExpiresAt *time.Time `json:"expiresAt" example:"2026-06-30T00:00:00Z" format:"date-time"`
+}
+
+// KYCReviewDue is a holder whose verification is due for re-verification.
+type KYCReviewDue struct {
+	// Unique identifier of the holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// External identifier of the holder, when set.
+	// example: G4K7N8M2
+	ExternalID *string `json:"externalId,omitempty" example:"G4K7N8M2"`
+
+	// Classification of the holder.
+	// example: NATURAL_PERSON
+	HolderType string `json:"holderType" example:"NATURAL_PERSON"`
+
+	// Status of the current verification case.
+	// example: VERIFIED
+	Status string `json:"status" example:"VERIFIED"`
+
+	// Level of the standing verification.
+	// example: STANDARD
+	VerifiedLevel string `json:"verifiedLevel" example:"STANDARD"`
+
+	// Re-verification date (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ExpiresAt time.Time `json:"expiresAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKYCLevelSatisfies(t *testing.T) {
+	assert.True(t, KYCLevelSatisfies(KYCLevelEnhanced, KYCLevelBasic))
+	assert.True(t, KYCLevelSatisfies(KYCLevelStandard, KYCLevelStandard))
+	assert.False(t, KYCLevelSatisfies(KYCLevelBasic, KYCLevelStandard))
+	assert.False(t, KYCLevelSatisfies("PLATINUM", KYCLevelBasic))
+}
+
+func TestKYCRequiredDocuments(t *testing.T) {
+	basic, ok := KYCRequiredDocuments(HolderTypeNaturalPerson, KYCLevelBasic)
+	assert.True(t, ok)
+	assert.Equal(t, []string{KYCDocumentIdentity}, basic)
+
+	enhanced, ok := KYCRequiredDocuments(HolderTypeLegalPerson, KYCLevelEnhanced)
+	assert.True(t, ok)
+	assert.Equal(t, []string{
+		KYCDocumentArticlesOfIncorporation,
+		KYCDocumentRepresentativeIdentity,
+		KYCDocumentProofOfAddress,
+		KYCDocumentOwnershipStructure,
+		KYCDocumentSourceOfFunds,
+	}, enhanced, "levels are cumulative")
+
+	_, ok = KYCRequiredDocuments("TRUST", KYCLevelBasic)
+	assert.False(t, ok)
+
+	_, ok = KYCRequiredDocuments(HolderTypeNaturalPerson, "PLATINUM")
+	assert.False(t, ok)
+}
+
+func TestHolderKYC_IsVerifiedAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	level := KYCLevelStandard
+	expiresAt := now.Add(time.Hour)
+
+	kyc := &HolderKYC{Status: KYCStatusPending, VerifiedLevel: &level, ExpiresAt: &expiresAt}
+
+	assert.True(t, kyc.IsVerifiedAt(KYCLevelBasic, now), "a standing verification survives a re-verification case")
+	assert.True(t, kyc.IsVerifiedAt(KYCLevelStandard, now))
+	assert.False(t, kyc.IsVerifiedAt(KYCLevelEnhanced, now))
+	assert.False(t, kyc.IsVerifiedAt(KYCLevelBasic, expiresAt), "expired at the re-verification date")
+	assert.False(t, (&HolderKYC{Status: KYCStatusVerified}).IsVerifiedAt(KYCLevelBasic, now))
+	assert.False(t, (*HolderKYC)(nil).IsVerifiedAt(KYCLevelBasic, now))
+}
+
+func TestKYCReverificationDate(t *testing.T) {
+	verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, KYCReverificationDate(KYCLevelEnhanced, verifiedAt).Before(KYCReverificationDate(KYCLevelBasic, verifiedAt)),
+		"more demanding levels are reviewed more often")
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Signals on which a holder is reported as a possible duplicate of another.
+// DOCUMENT, EMAIL and PHONE are exact matches found through the blind-index
+// search tokens; NAME is a normalized name similarity at or above the name
+// threshold.
+const (
+	HolderDuplicateByDocument = "DOCUMENT"
+	HolderDuplicateByEmail    = "EMAIL"
+	HolderDuplicateByPhone    = "PHONE"
+	HolderDuplicateByName     = "NAME"
+)
+
+// Holder merge statuses. A merge is IN_PROGRESS while the duplicate's records
+// are re-pointed to the surviving holder and COMPLETED once the duplicate is
+// tombstoned. Repeating an interrupted merge resumes it.
+const (
+	HolderMergeInProgress = "IN_PROGRESS"
+	HolderMergeCompleted  = "COMPLETED"
+)
+
+// HolderDuplicateCandidate is a holder that may be the same person or company
+// as the holder it was found for.
+//
+// swagger:model HolderDuplicateCandidate
+// @Description HolderDuplicateCandidate is a possible duplicate of a holder.
+type HolderDuplicateCandidate struct {
+	// Unique identifier of the candidate holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// External identifier of the candidate holder.
+	// example: G4K7N8M
+	ExternalID *string `json:"externalId,omitempty" example:"G4K7N8M"`
+
+	// Name of the candidate holder.
+	// example: John Doe
+	Name *string `json:"name,omitempty" example:"John Doe"`
+
+	// Likelihood, between 0 and 1, that both holders are the same.
+	// example: 0.85
+	Score float64 `json:"score" example:"0.85"`
+
+	// Similarity, between 0 and 1, of the normalized names of both holders.
+	// example: 0.97
+	NameSimilarity float64 `json:"nameSimilarity" example:"0.97"`
+
+	// Signals on which both holders match.
+	// example: ["EMAIL","NAME"]
+	MatchedOn []string `json:"matchedOn" example:"EMAIL,NAME" enum:"DOCUMENT,EMAIL,PHONE,NAME"`
+}
+
+// MergeHolderInput is a struct designed to encapsulate the request to merge a
+// duplicate holder into the holder of the path.
+type MergeHolderInput struct {
+	// Unique identifier of the duplicate holder to merge away (UUID format).
+	// required: true
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	DuplicateID string `json:"duplicateId" validate:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// The actor performing the merge.
+	// required: true
+	// example: compliance@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"compliance@example.com" maxLength:"256"`
+
+	// Why both holders were found to be the same.
+	// example: Same customer registered by two channels
+	// maxLength: 1024
+	Reason string `json:"reason,omitempty" validate:"max=1024" example:"Same customer registered by two channels" maxLength:"1024"`
+}
+
+// HolderMerge records the merge of a duplicate holder into a surviving one.
+//
+// swagger:model HolderMerge
+// @Description HolderMerge records the merge of a duplicate holder into a surviving one.
+type HolderMerge struct {
+	// Unique identifier of the merge (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	ID uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Unique identifier of the surviving holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	SurvivorID uuid.UUID `json:"survivorId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Unique identifier of the merged-away duplicate holder (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	DuplicateID uuid.UUID `json:"duplicateId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Status of the merge.
+	// example: COMPLETED
+	Status string `json:"status" example:"COMPLETED" enum:"IN_PROGRESS,COMPLETED"`
+
+	// The actor who performed the merge.
+	// example: compliance@example.com
+	Actor string `json:"actor" example:"compliance@example.com"`
+
+	// Why both holders were found to be the same.
+	// example: Same customer registered by two channels
+	Reason string `json:"reason,omitempty" example:"Same customer registered by two channels"`
+
+	// Number of records of each kind re-pointed to the surviving holder.
+	Counts HolderMergeCounts `json:"counts"`
+
+	// Timestamp when the merge started (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp when the merge completed (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CompletedAt *time.Time `json:"completedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// HolderMergeCounts is the number of records of each kind a merge re-pointed
+// from the duplicate to the surviving holder.
+type HolderMergeCounts struct {
+	// Accounts whose holder was changed.
+	// example: 2
+	Accounts int64 `json:"accounts" example:"2"`
+
+	// Instruments moved to the surviving holder.
+	// example: 1
+	Instruments int64 `json:"instruments" example:"1"`
+
+	// Related-party entries on instruments renamed to the surviving holder.
+	// example: 1
+	RelatedParties int64 `json:"relatedParties" example:"1"`
+}
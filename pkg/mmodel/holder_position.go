@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HolderPosition is the consolidated position of a holder: every active
+// account it owns across the organization's ledgers with their balances, the
+// totals per asset and, when a reference asset is requested, the totals
+// converted into it.
+//
+// swagger:model HolderPosition
+// @Description HolderPosition is the consolidated position of a holder across accounts and assets.
+type HolderPosition struct {
+	// The holder whose position was read.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Point in time the position was read at (RFC3339 format).
+	// example: 2021-01-01T00:00:00Z
+	// format: date-time
+	AsOf time.Time `json:"asOf" example:"2021-01-01T00:00:00Z" format:"date-time"`
+
+	// Whether the balances are historical, read at asOf, rather than current.
+	// Historical balances carry no overdraft usage.
+	// example: false
+	Historical bool `json:"historical" example:"false"`
+
+	// Accounts of the holder with their balances.
+	Accounts []*HolderPositionAccount `json:"accounts"`
+
+	// Totals of every balance of the holder per asset, ordered by asset code.
+	Totals []*HolderPositionTotal `json:"totals"`
+
+	// Totals converted into the requested reference asset. Omitted when no
+	// reference asset was requested.
+	Converted *HolderPositionConversion `json:"converted,omitempty"`
+} // @name HolderPosition
+
+// HolderPositionAccount is one account of a holder position.
+//
+// swagger:model HolderPositionAccount
+// @Description HolderPositionAccount is one account of a holder position.
+type HolderPositionAccount struct {
+	// Unique identifier of the account (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	ID string `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Ledger containing the account.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	LedgerID string `json:"ledgerId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Name of the account.
+	// example: Checking Account
+	Name string `json:"name" example:"Checking Account"`
+
+	// Alias of the account.
+	// example: @person1
+	Alias *string `json:"alias" example:"@person1"`
+
+	// Type of the account.
+	// example: deposit
+	Type string `json:"type" example:"deposit"`
+
+	// Asset code the account was created with.
+	// example: USD
+	AssetCode string `json:"assetCode" example:"USD"`
+
+	// Whether the account is blocked.
+	// example: false
+	Blocked bool `json:"blocked" example:"false"`
+
+	// Balances of the account, ordered by key then asset code.
+	Balances []*HolderPositionBalance `json:"balances"`
+} // @name HolderPositionAccount
+
+// HolderPositionBalance is one balance of an account in a holder position.
+//
+// swagger:model HolderPositionBalance
+// @Description HolderPositionBalance is one balance of an account in a holder position.
+type HolderPositionBalance struct {
+	// Key of the balance.
+	// example: default
+	Key string `json:"key" example:"default"`
+
+	// Asset code of the balance.
+	// example: USD
+	AssetCode string `json:"assetCode" example:"USD"`
+
+	// Amount available for transactions.
+	// example: 1500
+	Available decimal.Decimal `json:"available" example:"1500"`
+
+	// Amount held by pending transactions.
+	// example: 500
+	OnHold decimal.Decimal `json:"onHold" example:"500"`
+
+	// Amount of overdraft consumed.
+	// example: 0
+	OverdraftUsed decimal.Decimal `json:"overdraftUsed" example:"0"`
+} // @name HolderPositionBalance
+
+// HolderPositionTotal sums the balances of a holder in one asset. Net is
+// Available plus OnHold minus OverdraftUsed.
+//
+// swagger:model HolderPositionTotal
+// @Description HolderPositionTotal sums the balances of a holder in one asset.
+type HolderPositionTotal struct {
+	// Asset code of the total.
+	// example: USD
+	AssetCode string `json:"assetCode" example:"USD"`
+
+	// Sum of the available amounts.
+	// example: 1500
+	Available decimal.Decimal `json:"available" example:"1500"`
+
+	// Sum of the amounts on hold.
+	// example: 500
+	OnHold decimal.Decimal `json:"onHold" example:"500"`
+
+	// Sum of the overdraft consumed.
+	// example: 0
+	OverdraftUsed decimal.Decimal `json:"overdraftUsed" example:"0"`
+
+	// Available plus on hold minus overdraft used.
+	// example: 2000
+	Net decimal.Decimal `json:"net" example:"2000"`
+} // @name HolderPositionTotal
+
+// HolderPositionConversion is the total of a holder position converted into a
+// reference asset with the current asset rates of each account's ledger.
+//
+// swagger:model HolderPositionConversion
+// @Description HolderPositionConversion is the total of a holder position converted into a reference asset.
+type HolderPositionConversion struct {
+	HolderPositionTotal
+
+	// Assets with no rate into the reference asset in a ledger holding them.
+	// Their balances in that ledger are left out of the converted total.
+	// example: ["BTC"]
+	UnconvertedAssets []string `json:"unconvertedAssets" example:"BTC"`
+} // @name HolderPositionConversion
@@ -151,6 +151,9 @@ type Instrument struct {
 	// List of parties associated with this instrument and their roles.
 	RelatedParties []*RelatedParty `json:"relatedParties,omitempty"`
 
+	// Lifecycle state of the instrument; absent for an instrument that was never blocked or closed, which is ACTIVE.
+	Lifecycle *InstrumentLifecycle `json:"lifecycle,omitempty"`
+
 	// Timestamp when the instrument was created (RFC3339 format).
 	// example: 2025-01-01T00:00:00Z
 	// format: date-time
@@ -165,6 +168,11 @@ type Instrument struct {
 	// example: null
 	// format: date-time
 	DeletedAt *time.Time `json:"deletedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp when the instrument's personal data was erased; absent unless the owning holder was erased (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ErasedAt *time.Time `json:"erasedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
 }
 
 // BankingDetails is a struct designed to store account banking details data.
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import "time"
+
+// Instrument lifecycle statuses. An instrument without a lifecycle record is
+// ACTIVE; CLOSED is final.
+//
+//	ACTIVE ──block──▶ BLOCKED
+//	  ▲                  │
+//	  └─────unblock──────┘
+//	ACTIVE, BLOCKED ──close──▶ CLOSED
+const (
+	InstrumentStatusActive  = "ACTIVE"
+	InstrumentStatusBlocked = "BLOCKED"
+	InstrumentStatusClosed  = "CLOSED"
+)
+
+// InstrumentLifecycle is the lifecycle state of an instrument.
+type InstrumentLifecycle struct {
+	// Current status of the instrument.
+	// example: BLOCKED
+	Status string `json:"status" example:"BLOCKED" enums:"ACTIVE,BLOCKED,CLOSED"`
+
+	// Every status transition of the instrument, oldest first.
+	History []InstrumentTransition `json:"history"`
+
+	// Revision of the lifecycle state, incremented on every change; used for optimistic concurrency.
+	// example: 2
+	Revision int64 `json:"revision" example:"2"`
+
+	// Timestamp of the last change (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// InstrumentTransition records one status transition of an instrument.
+type InstrumentTransition struct {
+	// Status before the transition.
+	// example: ACTIVE
+	From string `json:"from" example:"ACTIVE"`
+
+	// Status after the transition.
+	// example: BLOCKED
+	To string `json:"to" example:"BLOCKED"`
+
+	// The actor who caused the transition.
+	// example: operations@example.com
+	Actor string `json:"actor" example:"operations@example.com"`
+
+	// Reason given for the transition.
+	// example: card reported stolen
+	Reason string `json:"reason" example:"card reported stolen"`
+
+	// Whether the transition was applied to the linked ledger account.
+	// example: true
+	AccountPropagated bool `json:"accountPropagated" example:"true"`
+
+	// Timestamp of the transition (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// InstrumentTransitionInput requests a status transition of an instrument.
+type InstrumentTransitionInput struct {
+	// The actor requesting the transition.
+	// required: true
+	// example: operations@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"operations@example.com" maxLength:"256"`
+
+	// Reason for the transition.
+	// required: true
+	// example: card reported stolen
+	// maxLength: 256
+	Reason string `json:"reason" validate:"required,max=256" example:"card reported stolen" maxLength:"256"`
+
+	// Whether to apply the transition to the linked ledger account: blocking or
+	// unblocking it, or closing it. Defaults to true; ignored when propagation is
+	// disabled for the deployment.
+	// required: false
+	// example: true
+	PropagateToAccount *bool `json:"propagateToAccount" example:"true"`
+}
+
+// LifecycleStatus returns the instrument's lifecycle status, ACTIVE when it has
+// no lifecycle record.
+func (i *Instrument) LifecycleStatus() string {
+	if i.Lifecycle == nil {
+		return InstrumentStatusActive
+	}
+
+	return i.Lifecycle.Status
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganizationKeyRotation_SeedsRunningProgress(t *testing.T) {
+	t.Parallel()
+
+	rotation, err := NewOrganizationKeyRotation(" tenant-1 ", "org-1", 1, 2, "admin", "annual rotation", []string{"holders", "instruments"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-1", rotation.TenantID)
+	assert.Equal(t, KeyRotationStatusRunning, rotation.Status)
+	assert.Equal(t, int64(1), rotation.Revision)
+	assert.False(t, rotation.IsFinished())
+	assert.Nil(t, rotation.CompletedAt)
+	require.Len(t, rotation.Targets, 2)
+	assert.Equal(t, KeyRotationTargetProgress{Name: "holders"}, rotation.Targets[0])
+	assert.Equal(t, KeyRotationTargetProgress{Name: "instruments"}, rotation.Targets[1])
+}
+
+func TestNewOrganizationKeyRotation_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		tenantID    string
+		orgID       string
+		from, to    int
+		actor       string
+		reason      string
+		errContains string
+	}{
+		{name: "missing tenant", orgID: "org", from: 1, to: 2, actor: "a", reason: "r", errContains: "tenant_id"},
+		{name: "missing organization", tenantID: "t", from: 1, to: 2, actor: "a", reason: "r", errContains: "organization_id"},
+		{name: "zero from version", tenantID: "t", orgID: "org", from: 0, to: 1, actor: "a", reason: "r", errContains: "to_version"},
+		{name: "version not advanced", tenantID: "t", orgID: "org", from: 2, to: 2, actor: "a", reason: "r", errContains: "to_version"},
+		{name: "missing actor", tenantID: "t", orgID: "org", from: 1, to: 2, actor: " ", reason: "r", errContains: "actor"},
+		{name: "missing reason", tenantID: "t", orgID: "org", from: 1, to: 2, actor: "a", errContains: "reason"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rotation, err := NewOrganizationKeyRotation(tt.tenantID, tt.orgID, tt.from, tt.to, tt.actor, tt.reason, nil)
+
+			require.Error(t, err)
+			assert.Nil(t, rotation)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestOrganizationKeyRotation_Totals(t *testing.T) {
+	t.Parallel()
+
+	rotation := &OrganizationKeyRotation{
+		Targets: []KeyRotationTargetProgress{
+			{Name: "holders", Total: 10, Processed: 8, Skipped: 2},
+			{Name: "instruments", Total: 5, Processed: 5},
+		},
+	}
+
+	total, processed, skipped := rotation.Totals()
+
+	assert.Equal(t, int64(15), total)
+	assert.Equal(t, int64(13), processed)
+	assert.Equal(t, int64(2), skipped)
+}
+
+func TestNewKeyRotationResponse_OmitsCursors(t *testing.T) {
+	t.Parallel()
+
+	rotation := &OrganizationKeyRotation{
+		OrganizationID: "org-1",
+		FromVersion:    1,
+		ToVersion:      2,
+		Status:         KeyRotationStatusCompleted,
+		Targets:        []KeyRotationTargetProgress{{Name: "holders", Total: 3, Processed: 3, Cursor: "last-id", Done: true}},
+	}
+
+	response := NewKeyRotationResponse(rotation)
+
+	assert.Equal(t, "completed", response.Status)
+	assert.Equal(t, []KeyRotationTargetResponse{{Name: "holders", Total: 3, Processed: 3, Done: true}}, response.Targets)
+}
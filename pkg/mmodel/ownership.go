@@ -0,0 +1,326 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Holder relationship types. OWNERSHIP is a stake in the owned holder's capital
+// and carries a percentage; CONTROL is control by other means (voting
+// agreements, board appointment rights) and carries none.
+const (
+	HolderRelationshipOwnership = "OWNERSHIP"
+	HolderRelationshipControl   = "CONTROL"
+)
+
+// Holder relationship change actions recorded in the relationship history.
+const (
+	HolderRelationshipCreated = "CREATED"
+	HolderRelationshipUpdated = "UPDATED"
+	HolderRelationshipDeleted = "DELETED"
+)
+
+// Reasons a natural person is reported as an ultimate beneficial owner.
+const (
+	BeneficialOwnerByOwnership = "OWNERSHIP"
+	BeneficialOwnerByControl   = "CONTROL"
+)
+
+// DefaultBeneficialOwnershipThreshold is the effective ownership, in percent,
+// from which a natural person is an ultimate beneficial owner under the usual
+// AML rules.
+const DefaultBeneficialOwnershipThreshold = 25.0
+
+// HolderRelationship is an ownership or control relationship between two
+// holders: Owner owns or controls Holder, which is always a legal person.
+//
+// swagger:model HolderRelationship
+// @Description HolderRelationship is an ownership or control relationship between two holders.
+type HolderRelationship struct {
+	// Unique identifier of the relationship (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	ID uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// The owned or controlled holder; always a legal person.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// The owning or controlling holder.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	OwnerID uuid.UUID `json:"ownerId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Classification of the owning holder.
+	// example: NATURAL_PERSON
+	OwnerType string `json:"ownerType" example:"NATURAL_PERSON" enums:"NATURAL_PERSON,LEGAL_PERSON"`
+
+	// Relationship type.
+	// example: OWNERSHIP
+	Type string `json:"type" example:"OWNERSHIP" enums:"OWNERSHIP,CONTROL"`
+
+	// Share of the owned holder's capital, in percent; set for OWNERSHIP only.
+	// example: 60
+	Percentage *float64 `json:"percentage,omitempty" example:"60"`
+
+	// How control is exercised, for CONTROL relationships.
+	// example: appoints the majority of the board
+	Description string `json:"description,omitempty" example:"appoints the majority of the board"`
+
+	// First day the relationship applies.
+	// example: 2025-01-01
+	// format: date
+	EffectiveFrom Date `json:"effectiveFrom" example:"2025-01-01" format:"date"`
+
+	// First day the relationship no longer applies; open-ended when absent.
+	// example: 2026-01-01
+	// format: date
+	EffectiveTo *Date `json:"effectiveTo,omitempty" example:"2026-01-01" format:"date"`
+
+	// Every change made to the relationship, oldest first.
+	History []HolderRelationshipChange `json:"history"`
+
+	// Timestamp when the relationship was created.
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp when the relationship was last updated.
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp when the relationship was deleted.
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	DeletedAt *time.Time `json:"deletedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+} // @name HolderRelationship
+
+// IsEffectiveOn reports whether the relationship applies on day.
+func (r *HolderRelationship) IsEffectiveOn(day time.Time) bool {
+	if r.DeletedAt != nil || day.Before(r.EffectiveFrom.Time) {
+		return false
+	}
+
+	return r.EffectiveTo == nil || day.Before(r.EffectiveTo.Time)
+}
+
+// Overlaps reports whether the relationship applies on any day of the period
+// [from, to); a nil to is open-ended.
+func (r *HolderRelationship) Overlaps(from time.Time, to *time.Time) bool {
+	if r.DeletedAt != nil {
+		return false
+	}
+
+	if to != nil && !r.EffectiveFrom.Time.Before(*to) {
+		return false
+	}
+
+	return r.EffectiveTo == nil || from.Before(r.EffectiveTo.Time)
+}
+
+// HolderRelationshipChange is one audited change of a relationship, with the
+// terms that applied after it.
+//
+// swagger:model HolderRelationshipChange
+// @Description HolderRelationshipChange is one audited change of a relationship.
+type HolderRelationshipChange struct {
+	// The change made.
+	// example: UPDATED
+	Action string `json:"action" example:"UPDATED" enums:"CREATED,UPDATED,DELETED"`
+
+	// The actor who made the change.
+	// example: compliance@example.com
+	Actor string `json:"actor" example:"compliance@example.com"`
+
+	// Share after the change, for OWNERSHIP relationships.
+	// example: 60
+	Percentage *float64 `json:"percentage,omitempty" example:"60"`
+
+	// Start of the relationship after the change.
+	// example: 2025-01-01
+	// format: date
+	EffectiveFrom Date `json:"effectiveFrom" example:"2025-01-01" format:"date"`
+
+	// End of the relationship after the change.
+	// example: 2026-01-01
+	// format: date
+	EffectiveTo *Date `json:"effectiveTo,omitempty" example:"2026-01-01" format:"date"`
+
+	// Timestamp of the change.
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ChangedAt time.Time `json:"changedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+} // @name HolderRelationshipChange
+
+// CreateHolderRelationshipInput is the input payload to record that a holder
+// owns or controls a legal-person holder.
+//
+// swagger:model CreateHolderRelationshipInput
+// @Description CreateHolderRelationshipInput is the input payload to record an ownership or control relationship.
+type CreateHolderRelationshipInput struct {
+	// The owning or controlling holder (UUID format).
+	// required: true
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	OwnerID string `json:"ownerId" validate:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Relationship type.
+	// required: true
+	// example: OWNERSHIP
+	Type string `json:"type" validate:"required,oneof=OWNERSHIP CONTROL" example:"OWNERSHIP" enums:"OWNERSHIP,CONTROL"`
+
+	// Share of the owned holder's capital, in percent; required for OWNERSHIP
+	// and not accepted for CONTROL.
+	// required: false
+	// example: 60
+	Percentage *float64 `json:"percentage" validate:"omitempty,gt=0,lte=100" example:"60"`
+
+	// How control is exercised, for CONTROL relationships.
+	// required: false
+	// example: appoints the majority of the board
+	// maxLength: 256
+	Description string `json:"description" validate:"max=256" example:"appoints the majority of the board" maxLength:"256"`
+
+	// First day the relationship applies; defaults to today.
+	// required: false
+	// example: 2025-01-01
+	// format: date
+	EffectiveFrom *Date `json:"effectiveFrom" example:"2025-01-01" format:"date"`
+
+	// First day the relationship no longer applies; open-ended when absent.
+	// required: false
+	// example: 2026-01-01
+	// format: date
+	EffectiveTo *Date `json:"effectiveTo" example:"2026-01-01" format:"date"`
+
+	// The actor recording the relationship.
+	// required: true
+	// example: compliance@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"compliance@example.com" maxLength:"256"`
+} // @name CreateHolderRelationshipInput
+
+// UpdateHolderRelationshipInput is the input payload to change the terms of a
+// relationship. Absent fields keep their value.
+//
+// swagger:model UpdateHolderRelationshipInput
+// @Description UpdateHolderRelationshipInput is the input payload to change the terms of a relationship.
+type UpdateHolderRelationshipInput struct {
+	// New share, in percent; OWNERSHIP relationships only.
+	// required: false
+	// example: 45
+	Percentage *float64 `json:"percentage" validate:"omitempty,gt=0,lte=100" example:"45"`
+
+	// New description.
+	// required: false
+	// example: appoints the majority of the board
+	// maxLength: 256
+	Description *string `json:"description" validate:"omitempty,max=256" example:"appoints the majority of the board" maxLength:"256"`
+
+	// New first day the relationship no longer applies, e.g. when a stake is sold.
+	// required: false
+	// example: 2026-01-01
+	// format: date
+	EffectiveTo *Date `json:"effectiveTo" example:"2026-01-01" format:"date"`
+
+	// The actor changing the relationship.
+	// required: true
+	// example: compliance@example.com
+	// maxLength: 256
+	Actor string `json:"actor" validate:"required,max=256" example:"compliance@example.com" maxLength:"256"`
+} // @name UpdateHolderRelationshipInput
+
+// BeneficialOwnership lists the ultimate beneficial owners of a legal-person
+// holder on a given day.
+//
+// swagger:model BeneficialOwnership
+// @Description BeneficialOwnership lists the ultimate beneficial owners of a legal-person holder.
+type BeneficialOwnership struct {
+	// The legal-person holder whose owners were resolved.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Day the ownership graph was resolved for.
+	// example: 2025-01-01
+	// format: date
+	AsOf Date `json:"asOf" example:"2025-01-01" format:"date"`
+
+	// Effective ownership, in percent, from which a natural person is reported.
+	// example: 25
+	Threshold float64 `json:"threshold" example:"25"`
+
+	// Natural persons owning at least the threshold or controlling the holder,
+	// ordered by effective ownership.
+	Owners []*BeneficialOwner `json:"owners"`
+
+	// Share of the holder, in percent, not traced to any natural person because
+	// stakes are unrecorded or the graph ended in a cycle or the depth limit.
+	// example: 15
+	UnattributedPercentage float64 `json:"unattributedPercentage" example:"15"`
+
+	// Whether the walk met a cycle. Cycles are rejected when relationships are
+	// recorded, so this flags data that needs review.
+	// example: false
+	CycleDetected bool `json:"cycleDetected" example:"false"`
+
+	// Whether the walk stopped at the maximum chain length.
+	// example: false
+	DepthLimitReached bool `json:"depthLimitReached" example:"false"`
+} // @name BeneficialOwnership
+
+// BeneficialOwner is a natural person reported as an ultimate beneficial owner.
+//
+// swagger:model BeneficialOwner
+// @Description BeneficialOwner is a natural person reported as an ultimate beneficial owner.
+type BeneficialOwner struct {
+	// The natural-person holder.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Name of the holder.
+	// example: John Doe
+	Name string `json:"name,omitempty" example:"John Doe"`
+
+	// Effective ownership, in percent, summed over every chain.
+	// example: 36
+	Percentage float64 `json:"percentage" example:"36"`
+
+	// Why the holder is reported.
+	// example: ["OWNERSHIP"]
+	Reasons []string `json:"reasons" example:"OWNERSHIP" enums:"OWNERSHIP,CONTROL"`
+
+	// The ownership and control chains from the holder down to the resolved
+	// holder.
+	Chains []OwnershipChain `json:"chains"`
+} // @name BeneficialOwner
+
+// OwnershipChain is one path of relationships from a beneficial owner to the
+// resolved holder.
+//
+// swagger:model OwnershipChain
+// @Description OwnershipChain is one path of relationships from a beneficial owner to the resolved holder.
+type OwnershipChain struct {
+	// Holders along the chain, from the beneficial owner to the resolved holder.
+	HolderIDs []uuid.UUID `json:"holderIds"`
+
+	// Relationships along the chain, in the same order.
+	RelationshipIDs []uuid.UUID `json:"relationshipIds"`
+
+	// Effective ownership carried by the chain, in percent; 0 for control chains.
+	// example: 36
+	Percentage float64 `json:"percentage" example:"36"`
+
+	// Whether every link of the chain confers control (a CONTROL relationship or
+	// a majority stake).
+	// example: true
+	Control bool `json:"control" example:"true"`
+} // @name OwnershipChain
@@ -30,14 +30,21 @@ type AuditOutcome string
 // defaultActorType is assigned when an event input omits ActorType.
 const defaultActorType = "service"
 
-// Phase-1 protection audit constants.
+// Protection audit constants.
 //
-// The outcome set is limited to what ProvisioningService.Provision() actually
-// produces: success, failure, and the idempotent already_exists.
+// The outcome set is limited to what the provisioning, key rotation, holder
+// erasure and holder export services actually produce: success, failure, and the idempotent already_exists.
 const (
 	AuditEventTypeProvisioning AuditEventType = "provisioning"
+	AuditEventTypeRotation     AuditEventType = "rotation"
+	AuditEventTypeErasure      AuditEventType = "erasure"
+	AuditEventTypeExport       AuditEventType = "export"
 
 	AuditActionProvision AuditAction = "provision"
+	AuditActionRotate    AuditAction = "rotate"
+	AuditActionRetire    AuditAction = "retire"
+	AuditActionErase     AuditAction = "erase"
+	AuditActionExport    AuditAction = "export"
 
 	AuditOutcomeSuccess       AuditOutcome = "success"
 	AuditOutcomeFailure       AuditOutcome = "failure"
@@ -53,6 +60,9 @@ type AuditDetails struct {
 	AffectedKeyIDs    []uint32
 	ProviderReference string
 	ErrorCode         string
+	// SubjectID identifies the record the action applied to (e.g. the id of an
+	// erased or exported holder). It is an opaque identifier, never PII.
+	SubjectID string
 }
 
 // ProtectionAuditEvent is an immutable record of a protection-related action.
@@ -0,0 +1,299 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package mmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Watch-list file formats accepted by the screening list upload.
+const (
+	WatchListFormatOFACSDNCSV = "OFAC_SDN_CSV"
+	WatchListFormatOFACSDNXML = "OFAC_SDN_XML"
+	WatchListFormatUNXML      = "UN_CONSOLIDATED_XML"
+	WatchListFormatCustomCSV  = "CUSTOM_CSV"
+)
+
+// Watch-list entry types.
+const (
+	WatchListEntityIndividual   = "INDIVIDUAL"
+	WatchListEntityOrganization = "ENTITY"
+)
+
+// Screening run statuses of a list's last re-screening of the holder base.
+const (
+	ScreeningRunRunning   = "RUNNING"
+	ScreeningRunCompleted = "COMPLETED"
+	ScreeningRunFailed    = "FAILED"
+)
+
+// Screening alert statuses. An alert is raised OPEN and closed by a reviewer,
+// who either confirms the match or dismisses it as a false positive.
+const (
+	ScreeningAlertOpen      = "OPEN"
+	ScreeningAlertConfirmed = "CONFIRMED"
+	ScreeningAlertDismissed = "DISMISSED"
+)
+
+// Screening alert subjects and matched fields.
+const (
+	ScreeningSubjectHolder       = "HOLDER"
+	ScreeningSubjectRelatedParty = "RELATED_PARTY"
+	ScreeningFieldName           = "NAME"
+	ScreeningFieldDocument       = "DOCUMENT"
+)
+
+// Screening reviewer decisions.
+const (
+	ScreeningDecisionConfirm = "CONFIRM"
+	ScreeningDecisionDismiss = "DISMISS"
+)
+
+// IsWatchListFormat reports whether format is a supported watch-list format.
+func IsWatchListFormat(format string) bool {
+	switch format {
+	case WatchListFormatOFACSDNCSV, WatchListFormatOFACSDNXML, WatchListFormatUNXML, WatchListFormatCustomCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// WatchList is a sanctions or watch list loaded into an organization. Each
+// upload replaces the list's entries and bumps its version.
+type WatchList struct {
+	// Name of the list, unique within the organization.
+	// example: ofac-sdn
+	Name string `json:"name" example:"ofac-sdn"`
+
+	// Format of the last uploaded file.
+	// example: OFAC_SDN_CSV
+	Format string `json:"format" example:"OFAC_SDN_CSV" enums:"OFAC_SDN_CSV,OFAC_SDN_XML,UN_CONSOLIDATED_XML,CUSTOM_CSV"`
+
+	// Version of the list, incremented by every upload.
+	// example: 3
+	Version int64 `json:"version" example:"3"`
+
+	// Number of entries of the current version.
+	// example: 12045
+	EntryCount int `json:"entryCount" example:"12045"`
+
+	// SHA-256 checksum (hex) of the uploaded file.
+	// example: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+	Checksum string `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+
+	// The actor who uploaded the current version.
+	// example: compliance@example.com
+	UploadedBy string `json:"uploadedBy" example:"compliance@example.com"`
+
+	// Progress of the re-screening of the holder base triggered by the last
+	// upload, if any.
+	LastRescreen *ScreeningRun `json:"lastRescreen,omitempty"`
+
+	// Timestamp of the first upload (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp of the last upload (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// ScreeningRun reports a background re-screening of the holder base.
+type ScreeningRun struct {
+	// Status of the run.
+	// example: COMPLETED
+	Status string `json:"status" example:"COMPLETED" enums:"RUNNING,COMPLETED,FAILED"`
+
+	// Number of holders screened.
+	// example: 5210
+	HoldersScreened int64 `json:"holdersScreened" example:"5210"`
+
+	// Number of instrument related parties screened.
+	// example: 830
+	PartiesScreened int64 `json:"partiesScreened" example:"830"`
+
+	// Number of new alerts raised.
+	// example: 2
+	AlertsRaised int64 `json:"alertsRaised" example:"2"`
+
+	// Static error code of a failed run.
+	// example: screening_failed
+	LastErrorCode string `json:"lastErrorCode,omitempty" example:"screening_failed"`
+
+	// Timestamp of the start of the run (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	StartedAt time.Time `json:"startedAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp of the end of the run (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CompletedAt *time.Time `json:"completedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// WatchListEntry is one listed person or entity of a watch list.
+type WatchListEntry struct {
+	// Identifier of the entry in the source list (e.g. the OFAC ent_num).
+	SourceID string `json:"sourceId"`
+
+	// INDIVIDUAL or ENTITY; empty when the source does not say.
+	EntityType string `json:"entityType,omitempty"`
+
+	// Primary name of the listed party.
+	Name string `json:"name"`
+
+	// Alternative names (a.k.a.) of the listed party.
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Identity documents of the listed party (passport, tax id, registration
+	// numbers), as written in the source.
+	Documents []string `json:"documents,omitempty"`
+
+	// Sanctions programs the party is listed under.
+	Programs []string `json:"programs,omitempty"`
+}
+
+// ScreeningAlert is a potential match of a holder or related party against a
+// watch-list entry. It stores no personal data of the screened party: the
+// reviewer looks the subject up by its identifiers.
+type ScreeningAlert struct {
+	// Unique identifier of the alert (UUID format).
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	ID uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Holder screened, or owning the instrument of the screened related party.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// HOLDER or RELATED_PARTY.
+	// example: HOLDER
+	SubjectType string `json:"subjectType" example:"HOLDER" enums:"HOLDER,RELATED_PARTY"`
+
+	// Instrument of the screened related party.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	InstrumentID *uuid.UUID `json:"instrumentId,omitempty" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Screened related party.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	RelatedPartyID *uuid.UUID `json:"relatedPartyId,omitempty" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// NAME for a fuzzy name match, DOCUMENT for an exact document match.
+	// example: NAME
+	MatchedField string `json:"matchedField" example:"NAME" enums:"NAME,DOCUMENT"`
+
+	// Name of the watch list that matched.
+	// example: ofac-sdn
+	ListName string `json:"listName" example:"ofac-sdn"`
+
+	// Version of the watch list that raised the alert.
+	// example: 3
+	ListVersion int64 `json:"listVersion" example:"3"`
+
+	// Identifier of the matched entry in the source list.
+	// example: 36
+	EntrySourceID string `json:"entrySourceId" example:"36"`
+
+	// Primary name of the matched entry.
+	// example: AEROCARIBBEAN AIRLINES
+	EntryName string `json:"entryName" example:"AEROCARIBBEAN AIRLINES"`
+
+	// Sanctions programs of the matched entry.
+	// example: ["CUBA"]
+	EntryPrograms []string `json:"entryPrograms,omitempty" example:"CUBA"`
+
+	// Match score between 0 and 1; 1 for document matches.
+	// example: 0.94
+	Score float64 `json:"score" example:"0.94"`
+
+	// Status of the alert.
+	// example: OPEN
+	Status string `json:"status" example:"OPEN" enums:"OPEN,CONFIRMED,DISMISSED"`
+
+	// The reviewer who decided the alert.
+	// example: compliance@example.com
+	Reviewer string `json:"reviewer,omitempty" example:"compliance@example.com"`
+
+	// Note left by the reviewer.
+	// example: different date of birth
+	ReviewNote string `json:"reviewNote,omitempty" example:"different date of birth"`
+
+	// Timestamp of the review (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp the alert was raised (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp the match was last seen by a screening (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	LastSeenAt time.Time `json:"lastSeenAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// PendingScreening is a holder or an instrument's related parties whose
+// screening failed, at onboarding or on a change. It stays listed, and is
+// retried in the background, until a screening of the subject succeeds.
+type PendingScreening struct {
+	// Holder not screened, or owning the instrument of the unscreened related
+	// parties.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	HolderID uuid.UUID `json:"holderId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// HOLDER or RELATED_PARTY.
+	// example: HOLDER
+	SubjectType string `json:"subjectType" example:"HOLDER" enums:"HOLDER,RELATED_PARTY"`
+
+	// Instrument of the unscreened related parties.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	InstrumentID *uuid.UUID `json:"instrumentId,omitempty" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+
+	// Number of failed screenings of the subject.
+	// example: 2
+	Attempts int64 `json:"attempts" example:"2"`
+
+	// Timestamp of the first failed screening (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// Timestamp of the last failed screening (RFC3339 format).
+	// example: 2025-01-01T00:00:00Z
+	// format: date-time
+	LastAttemptAt time.Time `json:"lastAttemptAt" example:"2025-01-01T00:00:00Z" format:"date-time"`
+}
+
+// ReviewScreeningAlertInput is the reviewer's decision on an open alert.
+type ReviewScreeningAlertInput struct {
+	// CONFIRM a true match or DISMISS a false positive.
+	// required: true
+	// example: DISMISS
+	Decision string `json:"decision" validate:"required,oneof=CONFIRM DISMISS" example:"DISMISS" enums:"CONFIRM,DISMISS"`
+
+	// The reviewer deciding the alert.
+	// required: true
+	// example: compliance@example.com
+	// maxLength: 256
+	Reviewer string `json:"reviewer" validate:"required,max=256" example:"compliance@example.com" maxLength:"256"`
+
+	// Rationale of the decision.
+	// required: false
+	// example: different date of birth
+	// maxLength: 512
+	Note string `json:"note" validate:"max=512" example:"different date of birth" maxLength:"512"`
+}
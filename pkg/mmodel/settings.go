@@ -33,6 +33,9 @@ import (
 //	    "allowFeeSkip": false,
 //	    "allowTracerSkip": false,
 //	    "allowHolderSkip": false
+//	  },
+//	  "kyc": {
+//	    "requiredLevel": ""
 //	  }
 //	}
 type LedgerSettings struct {
@@ -45,6 +48,9 @@ type LedgerSettings struct {
 	// Overrides contains the per-ledger opt-ins that permit callers to skip
 	// individual controls (fees, tracer, holder) on a per-request basis.
 	Overrides OverridePolicy `json:"overrides"`
+
+	// KYC contains the per-ledger holder verification requirement for transactions.
+	KYC KYCSettings `json:"kyc"`
 }
 
 // AccountingValidation represents the accounting-related validation settings.
@@ -159,6 +165,30 @@ var allowedTracerFailPostures = map[string]struct{}{
 	TracerFailPostureClosed: {},
 }
 
+// KYCSettings represents the per-ledger holder verification requirement.
+type KYCSettings struct {
+	// RequiredLevel is the minimum KYC level the holder of every account in a
+	// transaction must be verified at. External accounts and accounts owned by
+	// the organization's own holder are exempt.
+	// One of: "" (no requirement), "BASIC", "STANDARD", "ENHANCED".
+	// Default: "" (permissive - no verification required).
+	RequiredLevel string `json:"requiredLevel" example:"STANDARD"`
+}
+
+// defaultKYCSettings is the canonical source of default KYC settings.
+// No verification is required by default for backwards compatibility.
+var defaultKYCSettings = KYCSettings{
+	RequiredLevel: "",
+}
+
+// allowedKYCRequiredLevels is the membership set for KYCSettings.RequiredLevel, checked at write time.
+var allowedKYCRequiredLevels = map[string]struct{}{
+	"":               {},
+	KYCLevelBasic:    {},
+	KYCLevelStandard: {},
+	KYCLevelEnhanced: {},
+}
+
 // DefaultLedgerSettings returns the default ledger settings as a typed struct.
 // All validation flags are false by default for backwards compatibility.
 func DefaultLedgerSettings() LedgerSettings {
@@ -166,6 +196,7 @@ func DefaultLedgerSettings() LedgerSettings {
 		Accounting: defaultAccountingValidation,
 		Tracer:     defaultTracerSettings,
 		Overrides:  defaultOverridePolicy,
+		KYC:        defaultKYCSettings,
 	}
 }
 
@@ -189,6 +220,9 @@ func DefaultLedgerSettingsMap() map[string]any {
 			"allowTracerSkip": defaultOverridePolicy.AllowTracerSkip,
 			"allowHolderSkip": defaultOverridePolicy.AllowHolderSkip,
 		},
+		"kyc": map[string]any{
+			"requiredLevel": defaultKYCSettings.RequiredLevel,
+		},
 	}
 }
 
@@ -211,6 +245,9 @@ func LedgerSettingsToMap(s LedgerSettings) map[string]any {
 			"allowTracerSkip": s.Overrides.AllowTracerSkip,
 			"allowHolderSkip": s.Overrides.AllowHolderSkip,
 		},
+		"kyc": map[string]any{
+			"requiredLevel": s.KYC.RequiredLevel,
+		},
 	}
 }
 
@@ -277,6 +314,12 @@ func ParseLedgerSettings(settings map[string]any) LedgerSettings {
 		}
 	}
 
+	if kycMap, ok := settings["kyc"].(map[string]any); ok {
+		if requiredLevel, ok := kycMap["requiredLevel"].(string); ok {
+			result.KYC.RequiredLevel = requiredLevel
+		}
+	}
+
 	return result
 }
 
@@ -321,6 +364,9 @@ var settingsSchema = map[string]map[string]string{
 		"allowTracerSkip": "bool",
 		"allowHolderSkip": "bool",
 	},
+	"kyc": {
+		"requiredLevel": "string",
+	},
 }
 
 // knownNestedFieldNames contains all field names that should be nested under a parent key.
@@ -446,6 +492,19 @@ func validateSettingsFieldType(value any, expectedType, fieldPath string) error
 // (e.g. tracer.mode = "enfroce"); this is where that is caught at write time.
 // Fields without an enum constraint pass through unchanged.
 func validateSettingsFieldValue(parentKey, nestedKey string, value any, fieldPath string) error {
+	if parentKey == "kyc" && nestedKey == "requiredLevel" {
+		str, ok := value.(string)
+		if !ok {
+			return pkg.ValidateBusinessError(constant.ErrInvalidSettingsFieldValue, "LedgerSettings", fieldPath, "empty, BASIC, STANDARD, ENHANCED")
+		}
+
+		if _, ok := allowedKYCRequiredLevels[str]; !ok {
+			return pkg.ValidateBusinessError(constant.ErrInvalidSettingsFieldValue, "LedgerSettings", fieldPath, "empty, BASIC, STANDARD, ENHANCED")
+		}
+
+		return nil
+	}
+
 	if parentKey != "tracer" {
 		return nil
 	}
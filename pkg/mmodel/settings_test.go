@@ -68,6 +68,10 @@ func TestDefaultLedgerSettingsMap(t *testing.T) {
 	assert.Equal(t, "off", tracer["mode"])
 	assert.Equal(t, "open", tracer["failPosture"])
 	assert.Equal(t, 250, tracer["timeoutMs"])
+
+	kyc, ok := settings["kyc"].(map[string]any)
+	assert.True(t, ok, "kyc section must exist")
+	assert.Equal(t, "", kyc["requiredLevel"])
 }
 
 // TestDefaultLedgerSettingsMap_SerializesIdenticallyForExistingLedgers asserts that the
@@ -574,6 +578,35 @@ func TestValidateSettings(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid kyc required level accepted",
+			input: map[string]any{
+				"kyc": map[string]any{
+					"requiredLevel": "STANDARD",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty kyc required level accepted",
+			input: map[string]any{
+				"kyc": map[string]any{
+					"requiredLevel": "",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown kyc required level rejected with field-value error",
+			input: map[string]any{
+				"kyc": map[string]any{
+					"requiredLevel": "standard",
+				},
+			},
+			wantErr:     true,
+			errContains: "kyc.requiredLevel",
+			wantErrCode: "0176",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1194,3 +1227,21 @@ func FuzzParseLedgerSettings(f *testing.F) {
 		_ = result.Accounting.ValidateRoutes
 	})
 }
+
+func TestSettingsParseKYC(t *testing.T) {
+	assert.Equal(t, defaultKYCSettings, ParseLedgerSettings(map[string]any{}).KYC,
+		"absent kyc group must resolve to no requirement")
+
+	parsed := ParseLedgerSettings(map[string]any{
+		"kyc": map[string]any{"requiredLevel": KYCLevelEnhanced},
+	})
+	assert.Equal(t, KYCLevelEnhanced, parsed.KYC.RequiredLevel)
+
+	wrongType := ParseLedgerSettings(map[string]any{
+		"kyc": map[string]any{"requiredLevel": true},
+	})
+	assert.Equal(t, defaultKYCSettings, wrongType.KYC)
+
+	roundTrip := ParseLedgerSettings(LedgerSettingsToMap(parsed))
+	assert.Equal(t, parsed, roundTrip)
+}
@@ -56,6 +56,11 @@ type CreateTransactionInput struct {
 
 	// Per-call control opt-outs. Each flag is honored only when the matching per-ledger override is enabled; otherwise the request is rejected with 422.
 	Skip *TransactionSkip `json:"skip,omitempty"`
+
+	// UUID of a fee quote. When set, the quoted fees are charged instead of recalculating them; the quote must be unused, unexpired and match this transaction.
+	// example: 00000000-0000-0000-0000-000000000000
+	// format: uuid
+	FeeQuoteID *string `json:"feeQuoteId,omitempty" validate:"omitempty,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
 }
 
 // BuildTransaction converts a CreateTransactionInput to a Transaction.
@@ -81,6 +86,7 @@ func (cti *CreateTransactionInput) BuildTransaction() *Transaction {
 		RouteID:                  cti.RouteID,
 		Send:                     send,
 		Skip:                     cti.Skip,
+		FeeQuoteID:               cti.FeeQuoteID,
 	}
 }
 
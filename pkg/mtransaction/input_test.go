@@ -488,6 +488,18 @@ func TestBuildTransaction_SkipPropagation(t *testing.T) {
 	}
 }
 
+func TestBuildTransaction_FeeQuotePropagation(t *testing.T) {
+	t.Parallel()
+
+	quoteID := "0192f0a3-7a52-7d0e-9c3b-2d7a6f3e1a10"
+
+	assert.Nil(t, (&CreateTransactionInput{}).BuildTransaction().FeeQuoteID)
+
+	result := (&CreateTransactionInput{FeeQuoteID: &quoteID}).BuildTransaction()
+	require.NotNil(t, result.FeeQuoteID)
+	assert.Equal(t, quoteID, *result.FeeQuoteID)
+}
+
 func TestBuildInflowEntry_SkipPropagation(t *testing.T) {
 	t.Parallel()
 
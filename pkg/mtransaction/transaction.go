@@ -272,6 +272,10 @@ type Transaction struct {
 	// while json persists it in the body JSONB so it survives commit/cancel
 	// re-resolution and propagates at runtime.
 	Skip *TransactionSkip `json:"skip,omitempty" swaggerignore:"true"`
+	// FeeQuoteID references the fee quote whose fees the transaction is charged
+	// instead of recalculating them.
+	// format: uuid
+	FeeQuoteID *string `json:"feeQuoteId,omitempty" validate:"omitempty,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
 	// OperationTypeOverride overrides the persisted Operation.Type label
 	// (for example BLOCK/UNBLOCK) without changing accounting direction or amount.
 	// Internal field; populated during processing and excluded from the API contract.
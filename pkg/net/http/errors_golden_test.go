@@ -535,6 +535,65 @@ func allSentinels() []error {
 		constant.ErrInstrumentAccountReferenceNotFound,
 		constant.ErrSkipNotPermitted,
 		constant.ErrHolderRequired,
+		constant.ErrTransactionTypeNotFound,
+		constant.ErrTransactionTypeAlreadyExists,
+		constant.ErrTransactionTypeInvalidCode,
+		constant.ErrTransactionTypeNotRegistered,
+		constant.ErrTransactionTypeSubTypeNotAllowed,
+		constant.ErrTransactionTypeInvalidSubTypes,
+		constant.ErrTransactionTypeInvalidStatus,
+		constant.ErrTransactionTypeDescriptionTooLong,
+		constant.ErrValidationOutcomeNotFound,
+		constant.ErrInvalidOutcomeLabel,
+		constant.ErrOutcomeNoteTooLong,
+		constant.ErrInvalidOutcomeReportFilters,
+		constant.ErrInvalidConfigBundle,
+		constant.ErrConfigBundleImmutableChange,
+		constant.ErrConfigBundlePlanOutdated,
+		constant.ErrValidationInvalidDevice,
+		constant.ErrValidationInvalidGeolocation,
+		constant.ErrValidationInvalidCounterparty,
+		constant.ErrAlertPolicyNotFound,
+		constant.ErrAlertPolicyInvalidName,
+		constant.ErrAlertPolicyInvalidDecision,
+		constant.ErrAlertPolicyInvalidThreshold,
+		constant.ErrAlertPolicyInvalidWindow,
+		constant.ErrAlertPolicyInvalidMinSamples,
+		constant.ErrAlertPolicyAutoDeactivateRequiresRule,
+		constant.ErrAlertPolicyInvalidScope,
+		constant.ErrAlertPolicyInvalidStatus,
+		constant.ErrAlertPolicyDescriptionTooLong,
+		constant.ErrBillingRunNotFound,
+		constant.ErrPackageVersionNotInFuture,
+		constant.ErrPackageVersionNotFound,
+		constant.ErrPackageVersionConflict,
+		constant.ErrProgressiveBandsInvalid,
+		constant.ErrCalculationUpToNotAllowed,
+		constant.ErrMinFeeGreaterThanMaxFee,
+		constant.ErrBaseFeeInvalid,
+		constant.ErrFeeDependencyCycle,
+		constant.ErrFeeDependencyOrder,
+		constant.ErrChainedFeeDestinationConflict,
+		constant.ErrReversalPolicyInvalid,
+		constant.ErrReversalPolicyUnknownFee,
+		constant.ErrReversalFeeExceedsRefund,
+		constant.ErrPromotionTargetInvalid,
+		constant.ErrPromotionLimitInvalid,
+		constant.ErrPromotionWindowInvalid,
+		constant.ErrPromotionNotFound,
+		constant.ErrFeeQuoteNotFound,
+		constant.ErrFeeQuoteExpired,
+		constant.ErrFeeQuoteMismatch,
+		constant.ErrFeeQuoteAlreadyUsed,
+		constant.ErrFeeRevenueRangeInvalid,
+		constant.ErrFeeAssetInvalid,
+		constant.ErrFeeAssetValuesInvalid,
+		constant.ErrFeeAssetOutsidePackage,
+		constant.ErrBillingInvoiceNotFound,
+		constant.ErrBillingInvoiceTransitionInvalid,
+		constant.ErrHolderNotVerified,
+		constant.ErrConfigBundleInvalidExpression,
+		constant.ErrBillingInvoiceRevisionConflict,
 		constant.ErrHolderNotFound,
 		constant.ErrInstrumentNotFound,
 		constant.ErrDocumentAssociationError,
@@ -563,6 +622,47 @@ func allSentinels() []error {
 		constant.ErrAuditEventRequired,
 		constant.ErrAuditWriteFailed,
 		constant.ErrReservedTenantID,
+		constant.ErrKeyRotationInProgress,
+		constant.ErrKeyRotationNotFound,
+		constant.ErrKeyRotationFailed,
+		constant.ErrHolderDataKeyNotFound,
+		constant.ErrHolderDataKeyAlreadyExists,
+		constant.ErrHolderErased,
+		constant.ErrHolderErasureFailed,
+		constant.ErrKYCTransitionInvalid,
+		constant.ErrKYCDocumentsMissing,
+		constant.ErrKYCRevisionConflict,
+		constant.ErrKYCSelfReview,
+		constant.ErrKYCHolderTypeUnsupported,
+		constant.ErrKYCExpiryNotInFuture,
+		constant.ErrWatchListFormatInvalid,
+		constant.ErrWatchListParseFailed,
+		constant.ErrWatchListEmpty,
+		constant.ErrWatchListNameInvalid,
+		constant.ErrWatchListNotFound,
+		constant.ErrScreeningAlertNotFound,
+		constant.ErrScreeningAlertAlreadyReviewed,
+		constant.ErrWatchListUploadConflict,
+		constant.ErrHolderRelationshipNotFound,
+		constant.ErrHolderRelationshipSelf,
+		constant.ErrHolderRelationshipNotLegalPerson,
+		constant.ErrHolderRelationshipPercentage,
+		constant.ErrHolderRelationshipPeriodInvalid,
+		constant.ErrHolderRelationshipCycle,
+		constant.ErrHolderOwnershipExceeded,
+		constant.ErrHolderRelationshipAlreadyExists,
+		constant.ErrHolderExportNotFound,
+		constant.ErrHolderExportInProgress,
+		constant.ErrHolderExportNotReady,
+		constant.ErrHolderExportExpired,
+		constant.ErrHolderMerged,
+		constant.ErrHolderMergeSelf,
+		constant.ErrHolderMergeTypeMismatch,
+		constant.ErrHolderMergeConflict,
+		constant.ErrHolderMergeHasRelationships,
+		constant.ErrInstrumentTransitionInvalid,
+		constant.ErrInstrumentLifecycleConflict,
+		constant.ErrInstrumentAccountBalanceNotZero,
 	}
 }
 
@@ -572,9 +672,9 @@ func allSentinels() []error {
 func TestGolden_SentinelInventoryComplete(t *testing.T) {
 	t.Parallel()
 
-	// pkg/constant/errors.go currently declares 423 Err* sentinels. Bump this
+	// pkg/constant/errors.go currently declares 473 Err* sentinels. Bump this
 	// number in lockstep with allSentinels() whenever a code is added/removed.
-	const wantSentinelCount = 423
+	const wantSentinelCount = 523
 
 	assert.Len(t, allSentinels(), wantSentinelCount,
 		"sentinel inventory drifted: update allSentinels() and this count together")
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	libStreaming "github.com/LerianStudio/lib-streaming"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// DecisionAlertResolvedDefinition is the routing contract for
+// decision-alert.resolved. Subject (ce-subject) is the alert policy ID.
+var DecisionAlertResolvedDefinition = Definition{
+	ResourceType:  "decision-alert",
+	EventType:     "resolved",
+	SchemaVersion: "1.0.0",
+}
+
+// DecisionAlertResolvedPayload is the wire payload for
+// decision-alert.resolved: a firing policy's rate dropped back below its
+// threshold. It pairs with the decision-alert.triggered event sharing the
+// same policyId and carries the counters observed at recovery.
+type DecisionAlertResolvedPayload struct {
+	PolicyID      string  `json:"policyId"`
+	RuleID        *string `json:"ruleId"`
+	Decision      string  `json:"decision"`
+	Threshold     float64 `json:"threshold"`
+	Rate          float64 `json:"rate"`
+	Matched       int64   `json:"matched"`
+	Total         int64   `json:"total"`
+	WindowSeconds int     `json:"windowSeconds"`
+}
+
+// NewDecisionAlertResolved maps a policy and the window counters observed at
+// recovery into the decision-alert.resolved wire payload.
+func NewDecisionAlertResolved(policy *model.AlertPolicy, matched, total int64) DecisionAlertResolvedPayload {
+	return DecisionAlertResolvedPayload{
+		PolicyID:      policy.ID.String(),
+		RuleID:        formatOptionalUUID(policy.RuleID),
+		Decision:      policy.Decision.String(),
+		Threshold:     policy.Threshold,
+		Rate:          decisionRate(matched, total),
+		Matched:       matched,
+		Total:         total,
+		WindowSeconds: policy.WindowSeconds,
+	}
+}
+
+// ToEmitRequest assembles a libStreaming.EmitRequest; ts is the moment the
+// rate recovered.
+func (p DecisionAlertResolvedPayload) ToEmitRequest(tenantID string, ts time.Time) (libStreaming.EmitRequest, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return libStreaming.EmitRequest{}, fmt.Errorf("marshal %s payload: %w", DecisionAlertResolvedDefinition.Key(), err)
+	}
+
+	return libStreaming.EmitRequest{
+		DefinitionKey: DecisionAlertResolvedDefinition.Key(),
+		TenantID:      tenantID,
+		Subject:       p.PolicyID,
+		Timestamp:     ts,
+		Payload:       data,
+	}, nil
+}
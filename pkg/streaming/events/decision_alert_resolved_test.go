@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+)
+
+func TestDecisionAlertResolvedDefinition_Key(t *testing.T) {
+	assert.Equal(t, "decision-alert.resolved", events.DecisionAlertResolvedDefinition.Key())
+	assert.Equal(t, "decision-alert", events.DecisionAlertResolvedDefinition.ResourceType)
+	assert.Equal(t, "resolved", events.DecisionAlertResolvedDefinition.EventType)
+	assert.Equal(t, "1.0.0", events.DecisionAlertResolvedDefinition.SchemaVersion)
+}
+
+func TestNewDecisionAlertResolved_Maps(t *testing.T) {
+	payload := events.NewDecisionAlertResolved(minimalAlertPolicy(), 5, 100)
+
+	assert.Equal(t, fixedAlertPolicyUUID.String(), payload.PolicyID)
+	require.NotNil(t, payload.RuleID)
+	assert.Equal(t, fixedRuleUUID.String(), *payload.RuleID)
+	assert.Equal(t, "DENY", payload.Decision)
+	assert.InDelta(t, 0.05, payload.Rate, 1e-9)
+	assert.Equal(t, int64(5), payload.Matched)
+	assert.Equal(t, int64(100), payload.Total)
+	assert.Equal(t, 300, payload.WindowSeconds)
+}
+
+func TestDecisionAlertResolvedPayload_ToEmitRequest(t *testing.T) {
+	payload := events.NewDecisionAlertResolved(minimalAlertPolicy(), 0, 40)
+
+	req, err := payload.ToEmitRequest("tenant-9", fixedTime)
+	require.NoError(t, err)
+
+	assert.Equal(t, events.DecisionAlertResolvedDefinition.Key(), req.DefinitionKey)
+	assert.Equal(t, "tenant-9", req.TenantID)
+	assert.Equal(t, payload.PolicyID, req.Subject)
+	assert.Equal(t, fixedTime, req.Timestamp)
+
+	var roundTrip events.DecisionAlertResolvedPayload
+	require.NoError(t, json.Unmarshal(req.Payload, &roundTrip))
+	assert.Equal(t, payload, roundTrip)
+
+	var generic map[string]any
+	require.NoError(t, json.Unmarshal(req.Payload, &generic))
+
+	_, present := generic["autoDeactivate"]
+	assert.False(t, present, "autoDeactivate is only carried on decision-alert.triggered")
+	assert.Len(t, generic, 8)
+}
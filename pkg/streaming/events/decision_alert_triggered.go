@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	libStreaming "github.com/LerianStudio/lib-streaming"
+
+	"github.com/google/uuid"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+)
+
+// formatOptionalUUID formats an optional UUID as a *string, returning nil when
+// the input is nil so the wire serializes JSON null.
+func formatOptionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+
+	return &s
+}
+
+// DecisionAlertTriggeredDefinition is the routing contract for
+// decision-alert.triggered. Subject (ce-subject) is the alert policy ID.
+var DecisionAlertTriggeredDefinition = Definition{
+	ResourceType:  "decision-alert",
+	EventType:     "triggered",
+	SchemaVersion: "1.0.0",
+}
+
+// DecisionAlertTriggeredPayload is the wire payload for
+// decision-alert.triggered: the observed decision rate crossed the policy
+// threshold. RuleID is a *string so decision-wide policies serialize it as
+// JSON null; the key is always present. AutoDeactivate tells consumers a
+// rule.deactivated event for RuleID is expected to follow.
+//
+// The fence keeps this shape to identifiers, enums and counters: the policy
+// name and description are free text and stay out of the stream.
+type DecisionAlertTriggeredPayload struct {
+	PolicyID       string  `json:"policyId"`
+	RuleID         *string `json:"ruleId"`
+	Decision       string  `json:"decision"`
+	Threshold      float64 `json:"threshold"`
+	Rate           float64 `json:"rate"`
+	Matched        int64   `json:"matched"`
+	Total          int64   `json:"total"`
+	WindowSeconds  int     `json:"windowSeconds"`
+	AutoDeactivate bool    `json:"autoDeactivate"`
+}
+
+// NewDecisionAlertTriggered maps a policy and the window counters that fired
+// it into the decision-alert.triggered wire payload.
+func NewDecisionAlertTriggered(policy *model.AlertPolicy, matched, total int64) DecisionAlertTriggeredPayload {
+	return DecisionAlertTriggeredPayload{
+		PolicyID:       policy.ID.String(),
+		RuleID:         formatOptionalUUID(policy.RuleID),
+		Decision:       policy.Decision.String(),
+		Threshold:      policy.Threshold,
+		Rate:           decisionRate(matched, total),
+		Matched:        matched,
+		Total:          total,
+		WindowSeconds:  policy.WindowSeconds,
+		AutoDeactivate: policy.AutoDeactivate,
+	}
+}
+
+// ToEmitRequest assembles a libStreaming.EmitRequest; ts is the moment the
+// threshold was crossed.
+func (p DecisionAlertTriggeredPayload) ToEmitRequest(tenantID string, ts time.Time) (libStreaming.EmitRequest, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return libStreaming.EmitRequest{}, fmt.Errorf("marshal %s payload: %w", DecisionAlertTriggeredDefinition.Key(), err)
+	}
+
+	return libStreaming.EmitRequest{
+		DefinitionKey: DecisionAlertTriggeredDefinition.Key(),
+		TenantID:      tenantID,
+		Subject:       p.PolicyID,
+		Timestamp:     ts,
+		Payload:       data,
+	}, nil
+}
+
+// decisionRate returns matched/total, or 0 for an empty window.
+func decisionRate(matched, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(matched) / float64(total)
+}
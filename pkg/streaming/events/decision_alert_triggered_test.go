@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LerianStudio/midaz/v4/components/tracer/pkg/model"
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+)
+
+var fixedAlertPolicyUUID = uuid.MustParse("018f5e2a-1c3d-7a4b-9e6f-0a1b2c3d4e60")
+
+func minimalAlertPolicy() *model.AlertPolicy {
+	ruleID := fixedRuleUUID
+
+	return &model.AlertPolicy{
+		ID:             fixedAlertPolicyUUID,
+		Name:           "Card deny spike",
+		RuleID:         &ruleID,
+		Decision:       model.DecisionDeny,
+		Threshold:      0.4,
+		WindowSeconds:  300,
+		MinSamples:     20,
+		AutoDeactivate: true,
+		Status:         model.AlertPolicyStatusActive,
+		CreatedAt:      fixedTime,
+		UpdatedAt:      fixedTime,
+	}
+}
+
+func TestDecisionAlertTriggeredDefinition_Key(t *testing.T) {
+	assert.Equal(t, "decision-alert.triggered", events.DecisionAlertTriggeredDefinition.Key())
+	assert.Equal(t, "decision-alert", events.DecisionAlertTriggeredDefinition.ResourceType)
+	assert.Equal(t, "triggered", events.DecisionAlertTriggeredDefinition.EventType)
+	assert.Equal(t, "1.0.0", events.DecisionAlertTriggeredDefinition.SchemaVersion)
+}
+
+func TestNewDecisionAlertTriggered_Maps(t *testing.T) {
+	payload := events.NewDecisionAlertTriggered(minimalAlertPolicy(), 45, 100)
+
+	assert.Equal(t, fixedAlertPolicyUUID.String(), payload.PolicyID)
+	require.NotNil(t, payload.RuleID)
+	assert.Equal(t, fixedRuleUUID.String(), *payload.RuleID)
+	assert.Equal(t, "DENY", payload.Decision)
+	assert.InDelta(t, 0.4, payload.Threshold, 1e-9)
+	assert.InDelta(t, 0.45, payload.Rate, 1e-9)
+	assert.Equal(t, int64(45), payload.Matched)
+	assert.Equal(t, int64(100), payload.Total)
+	assert.Equal(t, 300, payload.WindowSeconds)
+	assert.True(t, payload.AutoDeactivate)
+}
+
+func TestNewDecisionAlertTriggered_DecisionWidePolicy(t *testing.T) {
+	policy := minimalAlertPolicy()
+	policy.RuleID = nil
+	policy.AutoDeactivate = false
+
+	payload := events.NewDecisionAlertTriggered(policy, 0, 0)
+
+	assert.Nil(t, payload.RuleID)
+	assert.Zero(t, payload.Rate, "empty window must not divide by zero")
+}
+
+func TestDecisionAlertTriggeredPayload_ToEmitRequest(t *testing.T) {
+	payload := events.NewDecisionAlertTriggered(minimalAlertPolicy(), 30, 50)
+
+	req, err := payload.ToEmitRequest("tenant-9", fixedTime)
+	require.NoError(t, err)
+
+	assert.Equal(t, events.DecisionAlertTriggeredDefinition.Key(), req.DefinitionKey)
+	assert.Equal(t, "tenant-9", req.TenantID)
+	assert.Equal(t, payload.PolicyID, req.Subject)
+	assert.Equal(t, fixedTime, req.Timestamp)
+
+	var roundTrip events.DecisionAlertTriggeredPayload
+	require.NoError(t, json.Unmarshal(req.Payload, &roundTrip))
+	assert.Equal(t, payload, roundTrip)
+}
+
+func TestDecisionAlertTriggeredPayload_JSONShape(t *testing.T) {
+	policy := minimalAlertPolicy()
+	policy.RuleID = nil
+
+	data, err := json.Marshal(events.NewDecisionAlertTriggered(policy, 1, 2))
+	require.NoError(t, err)
+
+	var generic map[string]any
+	require.NoError(t, json.Unmarshal(data, &generic))
+
+	expectedKeys := []string{
+		"policyId", "ruleId", "decision", "threshold", "rate",
+		"matched", "total", "windowSeconds", "autoDeactivate",
+	}
+
+	for _, key := range expectedKeys {
+		_, ok := generic[key]
+		assert.Truef(t, ok, "must include %q", key)
+	}
+
+	val, present := generic["ruleId"]
+	require.True(t, present)
+	assert.Nil(t, val, "ruleId must serialize null for decision-wide policies")
+
+	for _, forbidden := range []string{"name", "description", "scope"} {
+		_, present := generic[forbidden]
+		assert.Falsef(t, present, "fenced field %q must NOT appear", forbidden)
+	}
+
+	assert.Lenf(t, generic, len(expectedKeys), "expected %d top-level fields, got %d (drift?)", len(expectedKeys), len(generic))
+}
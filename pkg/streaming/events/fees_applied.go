@@ -17,12 +17,12 @@ import (
 var FeesAppliedDefinition = Definition{
 	ResourceType:  "fee-charge",
 	EventType:     "applied",
-	SchemaVersion: "1.0.0",
+	SchemaVersion: "1.1.0",
 }
 
 // FeesAppliedPayload is the wire payload for fee-charge.applied. Only the transaction
-// identity, org/ledger scope, the applied fee package reference, and the
-// application timestamp cross the wire. Monetary and detail surface (amounts,
+// identity, org/ledger scope, the applied fee package reference and version, and
+// the application timestamp cross the wire. Monetary and detail surface (amounts,
 // asset codes, source/destination, operations, metadata, fee lines,
 // waivedAccounts) is DELIBERATELY ABSENT. The JSONShape test locks both the
 // present key set and the absence of every excluded key.
@@ -32,6 +32,10 @@ type FeesAppliedPayload struct {
 	LedgerID       string `json:"ledgerId"`
 	FeePackageID   string `json:"feePackageId"`
 
+	// Version of the fee package whose schedule priced the transaction (added
+	// in schema 1.1.0). Zero when the charge predates package versioning.
+	FeePackageVersion int `json:"feePackageVersion"`
+
 	// RFC3339-formatted timestamp of when fees were applied.
 	AppliedAt string `json:"appliedAt"`
 }
@@ -39,13 +43,14 @@ type FeesAppliedPayload struct {
 // NewFeesApplied maps identifiers and the application timestamp into the wire
 // payload. Params are primitives so this shared package never imports the
 // internal fees domain.
-func NewFeesApplied(transactionID, organizationID, ledgerID, feePackageID string, appliedAt time.Time) FeesAppliedPayload {
+func NewFeesApplied(transactionID, organizationID, ledgerID, feePackageID string, feePackageVersion int, appliedAt time.Time) FeesAppliedPayload {
 	return FeesAppliedPayload{
-		TransactionID:  transactionID,
-		OrganizationID: organizationID,
-		LedgerID:       ledgerID,
-		FeePackageID:   feePackageID,
-		AppliedAt:      appliedAt.Format(time.RFC3339),
+		TransactionID:     transactionID,
+		OrganizationID:    organizationID,
+		LedgerID:          ledgerID,
+		FeePackageID:      feePackageID,
+		FeePackageVersion: feePackageVersion,
+		AppliedAt:         appliedAt.Format(time.RFC3339),
 	}
 }
 
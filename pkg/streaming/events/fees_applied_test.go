@@ -35,7 +35,7 @@ func TestFeesAppliedDefinition_Key(t *testing.T) {
 	assert.Equal(t, "fee-charge.applied", events.FeesAppliedDefinition.Key())
 	assert.Equal(t, "fee-charge", events.FeesAppliedDefinition.ResourceType)
 	assert.Equal(t, "applied", events.FeesAppliedDefinition.EventType)
-	assert.Equal(t, "1.0.0", events.FeesAppliedDefinition.SchemaVersion)
+	assert.Equal(t, "1.1.0", events.FeesAppliedDefinition.SchemaVersion)
 }
 
 func TestNewFeesApplied_MapsMinimalPayload(t *testing.T) {
@@ -43,13 +43,14 @@ func TestNewFeesApplied_MapsMinimalPayload(t *testing.T) {
 
 	payload := events.NewFeesApplied(
 		feesAppliedTransactionID, feesAppliedOrgID, feesAppliedLedgerID,
-		feesAppliedPackageID, fixedTime,
+		feesAppliedPackageID, 3, fixedTime,
 	)
 
 	assert.Equal(t, feesAppliedTransactionID, payload.TransactionID)
 	assert.Equal(t, feesAppliedOrgID, payload.OrganizationID)
 	assert.Equal(t, feesAppliedLedgerID, payload.LedgerID)
 	assert.Equal(t, feesAppliedPackageID, payload.FeePackageID)
+	assert.Equal(t, 3, payload.FeePackageVersion)
 	assert.Equal(t, "2026-05-13T12:34:56Z", payload.AppliedAt)
 }
 
@@ -58,7 +59,7 @@ func TestFeesAppliedPayload_ToEmitRequest_AssemblesStreamingEvent(t *testing.T)
 
 	payload := events.NewFeesApplied(
 		feesAppliedTransactionID, feesAppliedOrgID, feesAppliedLedgerID,
-		feesAppliedPackageID, fixedTime,
+		feesAppliedPackageID, 3, fixedTime,
 	)
 
 	req, err := payload.ToEmitRequest("tenant-1", fixedTime)
@@ -81,7 +82,7 @@ func TestFeesAppliedPayload_JSONShape(t *testing.T) {
 
 	payload := events.NewFeesApplied(
 		feesAppliedTransactionID, feesAppliedOrgID, feesAppliedLedgerID,
-		feesAppliedPackageID, fixedTime,
+		feesAppliedPackageID, 3, fixedTime,
 	)
 
 	data, err := json.Marshal(payload)
@@ -91,7 +92,7 @@ func TestFeesAppliedPayload_JSONShape(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, &generic))
 
 	for _, key := range []string{
-		"transactionId", "organizationId", "ledgerId", "feePackageId", "appliedAt",
+		"transactionId", "organizationId", "ledgerId", "feePackageId", "feePackageVersion", "appliedAt",
 	} {
 		_, ok := generic[key]
 		assert.Truef(t, ok, "wire payload must include %q", key)
@@ -105,5 +106,5 @@ func TestFeesAppliedPayload_JSONShape(t *testing.T) {
 		assert.Falsef(t, present, "wire payload must NOT include excluded key %q", forbidden)
 	}
 
-	assert.Lenf(t, generic, 5, "expected 5 top-level fields, got %d (drift?)", len(generic))
+	assert.Lenf(t, generic, 6, "expected 6 top-level fields, got %d (drift?)", len(generic))
 }
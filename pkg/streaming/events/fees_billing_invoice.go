@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	libStreaming "github.com/LerianStudio/lib-streaming"
+)
+
+// FeesBillingInvoiceIssuedDefinition is the routing contract for
+// fee-billing-invoices.issued. Fires once per invoice, when an invoicing pass
+// creates it; a later pass for the same period that finds the invoice already
+// issued does not fire again. IMPORTANT posture: emit failures MUST NOT fail
+// the request.
+var FeesBillingInvoiceIssuedDefinition = Definition{
+	ResourceType:  "fee-billing-invoices",
+	EventType:     "issued",
+	SchemaVersion: "1.0.0",
+}
+
+// FeesBillingInvoicePartiallyPaidDefinition is the routing contract for
+// fee-billing-invoices.partially-paid. Fires when a payment collects part of
+// an ISSUED invoice. Further partial payments do not fire again; consumers
+// follow the paid amount on fee-billing-invoices.paid.
+var FeesBillingInvoicePartiallyPaidDefinition = Definition{
+	ResourceType:  "fee-billing-invoices",
+	EventType:     "partially-paid",
+	SchemaVersion: "1.0.0",
+}
+
+// FeesBillingInvoicePaidDefinition is the routing contract for
+// fee-billing-invoices.paid. Fires when the last outstanding amount of an
+// invoice is collected.
+var FeesBillingInvoicePaidDefinition = Definition{
+	ResourceType:  "fee-billing-invoices",
+	EventType:     "paid",
+	SchemaVersion: "1.0.0",
+}
+
+// FeesBillingInvoiceOverdueDefinition is the routing contract for
+// fee-billing-invoices.overdue. Fires when a dunning pass finds an unpaid
+// invoice past its due date.
+var FeesBillingInvoiceOverdueDefinition = Definition{
+	ResourceType:  "fee-billing-invoices",
+	EventType:     "overdue",
+	SchemaVersion: "1.0.0",
+}
+
+// FeesBillingInvoiceWrittenOffDefinition is the routing contract for
+// fee-billing-invoices.written-off. Fires when the unpaid remainder of an
+// invoice is written off.
+var FeesBillingInvoiceWrittenOffDefinition = Definition{
+	ResourceType:  "fee-billing-invoices",
+	EventType:     "written-off",
+	SchemaVersion: "1.0.0",
+}
+
+// FeesBillingInvoicePayload is the shared wire payload for the five invoice
+// lifecycle events; only the routing DefinitionKey differs between them.
+// Identifiers, the org/ledger scope, the period, the status reached and the
+// invoice totals cross the wire. The billed account alias and the line items
+// are DELIBERATELY ABSENT: consumers needing them read the invoice by ID.
+type FeesBillingInvoicePayload struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organizationId"`
+	LedgerID       string `json:"ledgerId"`
+	Period         string `json:"period"`
+	Status         string `json:"status"`
+	AssetCode      string `json:"assetCode"`
+
+	// Decimal amounts as strings, so no precision is lost on the wire.
+	TotalAmount string `json:"totalAmount"`
+	PaidAmount  string `json:"paidAmount"`
+	AmountDue   string `json:"amountDue"`
+
+	// RFC3339-formatted timestamps.
+	DueDate   string `json:"dueDate"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// NewFeesBillingInvoice maps an invoice's identifiers, status and totals into
+// the wire payload. Params are primitives so this shared package never imports
+// the internal fees domain.
+func NewFeesBillingInvoice(id, organizationID, ledgerID, period, status, assetCode, totalAmount, paidAmount, amountDue, dueDate, updatedAt string) FeesBillingInvoicePayload {
+	return FeesBillingInvoicePayload{
+		ID:             id,
+		OrganizationID: organizationID,
+		LedgerID:       ledgerID,
+		Period:         period,
+		Status:         status,
+		AssetCode:      assetCode,
+		TotalAmount:    totalAmount,
+		PaidAmount:     paidAmount,
+		AmountDue:      amountDue,
+		DueDate:        dueDate,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+// ToEmitRequest assembles a libStreaming.EmitRequest for the lifecycle event
+// def, which must be one of the fee-billing-invoices definitions. Subject is
+// the invoice ID.
+func (p FeesBillingInvoicePayload) ToEmitRequest(def Definition, tenantID string, ts time.Time) (libStreaming.EmitRequest, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return libStreaming.EmitRequest{}, fmt.Errorf("marshal %s payload: %w", def.Key(), err)
+	}
+
+	return libStreaming.EmitRequest{
+		DefinitionKey: def.Key(),
+		TenantID:      tenantID,
+		Subject:       p.ID,
+		Timestamp:     ts,
+		Payload:       data,
+	}, nil
+}
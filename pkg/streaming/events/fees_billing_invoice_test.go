@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Lerian Studio. All rights reserved.
+// Use of this source code is governed by the Elastic License 2.0
+// that can be found in the LICENSE file.
+
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/LerianStudio/midaz/v4/pkg/streaming/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// feesBillingInvoiceID is the deterministic aggregate ID reused across
+// fee-billing-invoices tests so Subject assertions are exact-match.
+const feesBillingInvoiceID = "0190d9e1-7c2a-7000-8000-0000000000c1"
+
+func newTestFeesBillingInvoice() events.FeesBillingInvoicePayload {
+	return events.NewFeesBillingInvoice(
+		feesBillingInvoiceID,
+		"0190d9e1-7c2a-7000-8000-0000000000c2",
+		"0190d9e1-7c2a-7000-8000-0000000000c3",
+		"2026-01", "PARTIALLY_PAID", "BRL",
+		"104", "40", "64",
+		"2026-02-16T00:00:00Z", "2026-02-01T00:00:01Z",
+	)
+}
+
+func TestFeesBillingInvoiceDefinitions_Keys(t *testing.T) {
+	t.Parallel()
+
+	for def, key := range map[events.Definition]string{
+		events.FeesBillingInvoiceIssuedDefinition:        "fee-billing-invoices.issued",
+		events.FeesBillingInvoicePartiallyPaidDefinition: "fee-billing-invoices.partially-paid",
+		events.FeesBillingInvoicePaidDefinition:          "fee-billing-invoices.paid",
+		events.FeesBillingInvoiceOverdueDefinition:       "fee-billing-invoices.overdue",
+		events.FeesBillingInvoiceWrittenOffDefinition:    "fee-billing-invoices.written-off",
+	} {
+		assert.Equal(t, key, def.Key())
+		assert.Equal(t, "1.0.0", def.SchemaVersion)
+	}
+}
+
+func TestFeesBillingInvoicePayload_ToEmitRequest_AssemblesStreamingEvent(t *testing.T) {
+	t.Parallel()
+
+	payload := newTestFeesBillingInvoice()
+
+	req, err := payload.ToEmitRequest(events.FeesBillingInvoicePartiallyPaidDefinition, "tenant-1", fixedTime)
+	require.NoError(t, err)
+
+	assert.Equal(t, "fee-billing-invoices.partially-paid", req.DefinitionKey)
+	assert.Equal(t, "tenant-1", req.TenantID)
+	assert.Equal(t, feesBillingInvoiceID, req.Subject)
+	assert.Equal(t, fixedTime, req.Timestamp)
+
+	var roundTrip events.FeesBillingInvoicePayload
+	require.NoError(t, json.Unmarshal(req.Payload, &roundTrip))
+	assert.Equal(t, payload, roundTrip)
+}
+
+// TestFeesBillingInvoicePayload_JSONShape locks the wire JSON layout and
+// proves the billed account and line items never cross the wire.
+func TestFeesBillingInvoicePayload_JSONShape(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(newTestFeesBillingInvoice())
+	require.NoError(t, err)
+
+	var generic map[string]any
+	require.NoError(t, json.Unmarshal(data, &generic))
+
+	for _, key := range []string{
+		"id", "organizationId", "ledgerId", "period", "status", "assetCode",
+		"totalAmount", "paidAmount", "amountDue", "dueDate", "updatedAt",
+	} {
+		_, ok := generic[key]
+		assert.Truef(t, ok, "wire payload must include %q", key)
+	}
+
+	for _, forbidden := range []string{"accountAlias", "lines", "payments", "lastPaymentError"} {
+		_, present := generic[forbidden]
+		assert.Falsef(t, present, "wire payload must NOT include excluded key %q", forbidden)
+	}
+
+	assert.Lenf(t, generic, 11, "expected 11 top-level fields, got %d (drift?)", len(generic))
+}